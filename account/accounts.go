@@ -84,6 +84,12 @@ type DefaultManager struct {
 	selectedChatAccount *SelectedExtKey // account that was processed during the last call to SelectAccount()
 	mainAccountAddress  types.Address
 	watchAddresses      []types.Address
+
+	// keyFilesMu serializes the writes to the key files, so that the key store
+	// isn't upgraded while it's re-encrypted with another password
+	keyFilesMu sync.Mutex
+	// keystoreUpgrades tracks the upgrades of the key files started on login
+	keystoreUpgrades sync.WaitGroup
 }
 
 // GetKeystore is only used in tests
@@ -174,10 +180,29 @@ func (m *DefaultManager) RecoverAccount(password, mnemonic string) (Info, error)
 // VerifyAccountPassword tries to decrypt a given account key file, with a provided password.
 // If no error is returned, then account is considered verified.
 func (m *DefaultManager) VerifyAccountPassword(keyStoreDir, address, password string) (*types.Key, error) {
-	var err error
+	addressObj := types.BytesToAddress(types.FromHex(address))
+	foundKeyFile, err := findKeyFile(keyStoreDir, addressObj)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keystore.DecryptKey(foundKeyFile, password)
+	if err != nil {
+		return nil, err
+	}
+
+	// avoid swap attack
+	if key.Address != addressObj {
+		return nil, fmt.Errorf("account mismatch: have %s, want %s", key.Address.Hex(), addressObj.Hex())
+	}
+
+	return key, nil
+}
+
+// findKeyFile locates the key file of the address within the key store directory
+func findKeyFile(keyStoreDir string, address types.Address) ([]byte, error) {
 	var foundKeyFile []byte
 
-	addressObj := types.BytesToAddress(types.FromHex(address))
 	checkAccountKey := func(path string, fileInfo os.FileInfo) error {
 		if len(foundKeyFile) > 0 || fileInfo.IsDir() {
 			return nil
@@ -194,14 +219,14 @@ func (m *DefaultManager) VerifyAccountPassword(keyStoreDir, address, password st
 		if e := json.Unmarshal(rawKeyFile, &accountKey); e != nil {
 			return fmt.Errorf("failed to read key file: %s", e)
 		}
-		if types.HexToAddress("0x"+accountKey.Address).Hex() == addressObj.Hex() {
+		if types.HexToAddress("0x"+accountKey.Address).Hex() == address.Hex() {
 			foundKeyFile = rawKeyFile
 		}
 
 		return nil
 	}
 	// locate key within key store directory (address should be within the file)
-	err = filepath.Walk(keyStoreDir, func(path string, fileInfo os.FileInfo, err error) error {
+	err := filepath.Walk(keyStoreDir, func(path string, fileInfo os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
@@ -212,20 +237,9 @@ func (m *DefaultManager) VerifyAccountPassword(keyStoreDir, address, password st
 	}
 
 	if len(foundKeyFile) == 0 {
-		return nil, &ErrCannotLocateKeyFile{fmt.Sprintf("cannot locate account for address: %s", addressObj.Hex())}
-	}
-
-	key, err := keystore.DecryptKey(foundKeyFile, password)
-	if err != nil {
-		return nil, err
+		return nil, &ErrCannotLocateKeyFile{fmt.Sprintf("cannot locate account for address: %s", address.Hex())}
 	}
-
-	// avoid swap attack
-	if key.Address != addressObj {
-		return nil, fmt.Errorf("account mismatch: have %s, want %s", key.Address.Hex(), addressObj.Hex())
-	}
-
-	return key, nil
+	return foundKeyFile, nil
 }
 
 // SelectAccount selects current account, by verifying that address has corresponding account which can be decrypted
@@ -243,6 +257,12 @@ func (m *DefaultManager) SelectAccount(loginParams LoginParams) error {
 	m.watchAddresses = loginParams.WatchAddresses
 	m.mainAccountAddress = loginParams.MainAccount
 	m.selectedChatAccount = selectedChatAccount
+
+	// The password was just verified, the key files encrypted with weak
+	// parameters are upgraded in the background
+	if m.Keydir != "" {
+		m.upgradeKeystoreInBackground(m.Keydir, loginParams.Password)
+	}
 	return nil
 }
 
@@ -320,6 +340,9 @@ func (m *DefaultManager) Logout() {
 
 // ImportAccount imports the account specified with privateKey.
 func (m *DefaultManager) ImportAccount(privateKey *ecdsa.PrivateKey, password string) (types.Address, error) {
+	m.keyFilesMu.Lock()
+	defer m.keyFilesMu.Unlock()
+
 	if m.keystore == nil {
 		return types.Address{}, ErrAccountKeyStoreMissing
 	}
@@ -334,6 +357,9 @@ func (m *DefaultManager) ImportAccount(privateKey *ecdsa.PrivateKey, password st
 // ImportExtendedKey is used in older version of Status where PrivateKey is set to be the BIP44 key at index 0,
 // and ExtendedKey is the extended key of the BIP44 key at index 1.
 func (m *DefaultManager) ImportSingleExtendedKey(extKey *extkeys.ExtendedKey, password string) (address, pubKey string, err error) {
+	m.keyFilesMu.Lock()
+	defer m.keyFilesMu.Unlock()
+
 	if m.keystore == nil {
 		return "", "", ErrAccountKeyStoreMissing
 	}
@@ -360,6 +386,9 @@ func (m *DefaultManager) ImportSingleExtendedKey(extKey *extkeys.ExtendedKey, pa
 // importExtendedKey processes incoming extended key, extracts required info and creates corresponding account key.
 // Once account key is formed, that key is put (if not already) into keystore i.e. key is *encoded* into key file.
 func (m *DefaultManager) importExtendedKey(keyPurpose extkeys.KeyPurpose, extKey *extkeys.ExtendedKey, password string) (address, pubKey string, err error) {
+	m.keyFilesMu.Lock()
+	defer m.keyFilesMu.Unlock()
+
 	if m.keystore == nil {
 		return "", "", ErrAccountKeyStoreMissing
 	}
@@ -479,6 +508,9 @@ func (m *DefaultManager) unlockExtendedKey(address, password string) (*SelectedE
 }
 
 func (m *DefaultManager) MigrateKeyStoreDir(oldDir, newDir string, addresses []string) error {
+	m.keyFilesMu.Lock()
+	defer m.keyFilesMu.Unlock()
+
 	paths := []string{}
 
 	addressesMap := map[string]struct{}{}
@@ -550,18 +582,25 @@ func (m *DefaultManager) ReEncryptKey(rawKey []byte, pass string, newPass string
 	n := int(cryptoJSON.KDFParams["n"].(float64))
 	p := int(cryptoJSON.KDFParams["p"].(float64))
 
+	return encryptKey(decryptedKey, newPass, n, p)
+}
+
+func encryptKey(key *types.Key, pass string, n, p int) ([]byte, error) {
 	gethKey := gethkeystore.Key{
-		Id:              decryptedKey.ID,
-		Address:         gethcommon.Address(decryptedKey.Address),
-		PrivateKey:      decryptedKey.PrivateKey,
-		ExtendedKey:     decryptedKey.ExtendedKey,
-		SubAccountIndex: decryptedKey.SubAccountIndex,
+		Id:              key.ID,
+		Address:         gethcommon.Address(key.Address),
+		PrivateKey:      key.PrivateKey,
+		ExtendedKey:     key.ExtendedKey,
+		SubAccountIndex: key.SubAccountIndex,
 	}
 
-	return gethkeystore.EncryptKey(&gethKey, newPass, n, p)
+	return gethkeystore.EncryptKey(&gethKey, pass, n, p)
 }
 
 func (m *DefaultManager) ReEncryptKeyStoreDir(keyDirPath, oldPass, newPass string) error {
+	m.keyFilesMu.Lock()
+	defer m.keyFilesMu.Unlock()
+
 	rencryptFileAtPath := func(tempKeyDirPath, path string, fileInfo os.FileInfo) error {
 		if fileInfo.IsDir() {
 			return nil
@@ -649,6 +688,9 @@ func (m *DefaultManager) ReEncryptKeyStoreDir(keyDirPath, oldPass, newPass strin
 }
 
 func (m *DefaultManager) DeleteAccount(address types.Address) error {
+	m.keyFilesMu.Lock()
+	defer m.keyFilesMu.Unlock()
+
 	return m.keystore.Delete(types.Account{Address: address})
 }
 
@@ -14,11 +14,12 @@ type GethManager struct {
 	*DefaultManager
 
 	gethAccManager *accounts.Manager
+	hardwareWallet *HardwareWallet
 }
 
 // NewGethManager returns new node account manager.
 func NewGethManager() *GethManager {
-	m := &GethManager{}
+	m := &GethManager{hardwareWallet: NewHardwareWallet()}
 	m.DefaultManager = &DefaultManager{accountsGenerator: generator.New(m)}
 	return m
 }
@@ -49,3 +50,8 @@ func (m *GethManager) GetManager() *accounts.Manager {
 	defer m.mu.RUnlock()
 	return m.gethAccManager
 }
+
+// HardwareWallet returns the signer of the accounts stored in a hardware wallet
+func (m *GethManager) HardwareWallet() *HardwareWallet {
+	return m.hardwareWallet
+}
@@ -163,6 +163,12 @@ func (s *ManagerTestSuite) SetupTest() {
 	}
 }
 
+// TearDownTest waits for the key files upgraded on login, so that they're
+// not written once the key store folder is removed
+func (s *ManagerTestSuite) TearDownTest() {
+	s.accManager.keystoreUpgrades.Wait()
+}
+
 func (s *ManagerTestSuite) TestRecoverAccount() {
 	accountInfo, err := s.accManager.RecoverAccount(s.password, s.mnemonic)
 	s.NoError(err)
@@ -214,6 +220,19 @@ func (s *ManagerTestSuite) TestSelectAccountSuccess() {
 	s.testSelectAccount(types.HexToAddress(s.testAccount.chatAddress), types.HexToAddress(s.testAccount.walletAddress), s.testAccount.password, nil)
 }
 
+func (s *ManagerTestSuite) TestSelectAccountUpgradesKeystore() {
+	paths, err := weakKeyFiles(s.keydir)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(paths)
+
+	s.testSelectAccount(types.HexToAddress(s.testAccount.chatAddress), types.HexToAddress(s.testAccount.walletAddress), s.testAccount.password, nil)
+	s.accManager.keystoreUpgrades.Wait()
+
+	paths, err = weakKeyFiles(s.keydir)
+	s.Require().NoError(err)
+	s.Require().Empty(paths)
+}
+
 func (s *ManagerTestSuite) TestSelectAccountWrongAddress() {
 	s.testSelectAccount(types.HexToAddress("0x0000000000000000000000000000000000000001"), types.HexToAddress(s.testAccount.walletAddress), s.testAccount.password, errors.New("cannot retrieve a valid key for a given account: no key for given address or file"))
 }
@@ -339,6 +339,14 @@ func (g *Generator) Reset() {
 	g.accounts = make(map[string]*Account)
 }
 
+// DeleteAccount removes the account from memory.
+func (g *Generator) DeleteAccount(accountID string) {
+	g.Lock()
+	defer g.Unlock()
+
+	delete(g.accounts, accountID)
+}
+
 func (g *Generator) findAccount(accountID string) (*Account, error) {
 	g.Lock()
 	defer g.Unlock()
@@ -112,6 +112,20 @@ func TestGenerator_ImportMnemonic(t *testing.T) {
 	assert.Equal(t, testAccount.extendedMasterKey, key.extendedKey.String())
 }
 
+func TestGenerator_DeleteAccount(t *testing.T) {
+	g := New(nil)
+
+	info, err := g.ImportMnemonic(testAccount.mnemonic, testAccount.bip39Passphrase)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(g.accounts))
+
+	g.DeleteAccount(info.ID)
+	assert.Equal(t, 0, len(g.accounts))
+
+	_, err = g.DeriveAddresses(info.ID, []string{path0})
+	assert.Equal(t, ErrAccountNotFoundByID, err)
+}
+
 func TestGenerator_ImportJSONKey(t *testing.T) {
 	g := New(nil)
 	assert.Equal(t, 0, len(g.accounts))
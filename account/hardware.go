@@ -0,0 +1,158 @@
+package account
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	gethaccounts "github.com/ethereum/go-ethereum/accounts"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/status-im/status-go/account/ledger"
+)
+
+var ErrNoHardwareTransport = errors.New("no hardware wallet transport set")
+
+// TransportOpener opens a connection to a hardware wallet, over HID or BLE
+// depending on the platform
+type TransportOpener func() (ledger.Transport, error)
+
+// HardwareWallet is the signer of the accounts stored in a Ledger, requests
+// are sent to the device one at a time
+type HardwareWallet struct {
+	mu       sync.Mutex
+	open     TransportOpener
+	accounts map[gethcommon.Address]gethaccounts.DerivationPath
+}
+
+func NewHardwareWallet() *HardwareWallet {
+	return &HardwareWallet{
+		accounts: make(map[gethcommon.Address]gethaccounts.DerivationPath),
+	}
+}
+
+func (w *HardwareWallet) SetTransportOpener(open TransportOpener) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.open = open
+}
+
+// withDevice opens the device for the duration of the call
+func (w *HardwareWallet) withDevice(call func(device *ledger.Ledger) error) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.open == nil {
+		return ErrNoHardwareTransport
+	}
+	transport, err := w.open()
+	if err != nil {
+		return err
+	}
+	device := ledger.New(transport)
+	defer device.Close()
+	return call(device)
+}
+
+// Derive returns the address of the path, displayed on the device for the user
+// to confirm it if confirm is true
+func (w *HardwareWallet) Derive(path gethaccounts.DerivationPath, confirm bool) (address gethcommon.Address, err error) {
+	err = w.withDevice(func(device *ledger.Ledger) error {
+		address, err = device.Derive(path, confirm)
+		return err
+	})
+	return address, err
+}
+
+// DerivedAddress is an address of a hardware wallet with its derivation path
+type DerivedAddress struct {
+	Path    string             `json:"path"`
+	Address gethcommon.Address `json:"address"`
+}
+
+// DeriveAddresses returns the addresses of count consecutive paths, starting
+// at the index start under the base path
+func (w *HardwareWallet) DeriveAddresses(base gethaccounts.DerivationPath, start uint32, count uint32) ([]DerivedAddress, error) {
+	addresses := make([]DerivedAddress, 0, count)
+	err := w.withDevice(func(device *ledger.Ledger) error {
+		for i := start; i < start+count; i++ {
+			path := append(gethaccounts.DerivationPath{}, base...)
+			path = append(path, i)
+			address, err := device.Derive(path, false)
+			if err != nil {
+				return err
+			}
+			addresses = append(addresses, DerivedAddress{Path: path.String(), Address: address})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+// AddAccount routes the signing requests of the address to the device
+func (w *HardwareWallet) AddAccount(address gethcommon.Address, path gethaccounts.DerivationPath) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.accounts[address] = path
+}
+
+func (w *HardwareWallet) RemoveAccount(address gethcommon.Address) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.accounts, address)
+}
+
+// Account returns the hardware account of the address, or nil if the address
+// isn't stored in a hardware wallet
+func (w *HardwareWallet) Account(address gethcommon.Address) *HardwareAccount {
+	if w == nil {
+		return nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	path, exists := w.accounts[address]
+	if !exists {
+		return nil
+	}
+	return &HardwareAccount{Address: address, Path: path, wallet: w}
+}
+
+// HardwareAccount is an account whose key never leaves the hardware wallet
+type HardwareAccount struct {
+	Address gethcommon.Address
+	Path    gethaccounts.DerivationPath
+	wallet  *HardwareWallet
+}
+
+// SignTx returns the transaction signed on the device once the user confirms it
+func (a *HardwareAccount) SignTx(tx *gethtypes.Transaction, chainID *big.Int) (signed *gethtypes.Transaction, err error) {
+	err = a.wallet.withDevice(func(device *ledger.Ledger) error {
+		signed, err = device.SignTx(a.Path, a.Address, tx, chainID)
+		return err
+	})
+	return signed, err
+}
+
+// SignMessage returns the EIP-191 signature of the message, with v being 27 or
+// 28
+func (a *HardwareAccount) SignMessage(message []byte) (signature []byte, err error) {
+	err = a.wallet.withDevice(func(device *ledger.Ledger) error {
+		signature, err = device.SignPersonalMessage(a.Path, message)
+		return err
+	})
+	return signature, err
+}
+
+// SignTypedData returns the EIP-712 signature of the message with the domain
+// separator, with v being 27 or 28
+func (a *HardwareAccount) SignTypedData(domainSeparator gethcommon.Hash, message gethcommon.Hash) (signature []byte, err error) {
+	err = a.wallet.withDevice(func(device *ledger.Ledger) error {
+		signature, err = device.SignTypedData(a.Path, domainSeparator, message)
+		return err
+	})
+	return signature, err
+}
@@ -0,0 +1,260 @@
+package account
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/status-im/status-go/eth-node/keystore"
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+const (
+	// KeystoreExportVersion is the version of the payload of exported keys
+	KeystoreExportVersion = 1
+	// keystoreExportChunkPrefix starts every chunk, to recognize scanned QR
+	// codes of an export
+	keystoreExportChunkPrefix = "kse"
+	// keystoreExportChunkSize is the size of the data of a chunk, small
+	// enough to be scanned from a screen
+	keystoreExportChunkSize = 512
+
+	exportSaltLength = 32
+	exportScryptN    = 1 << 15
+	exportScryptR    = 8
+	exportScryptP    = 1
+	exportKeyLength  = 32
+)
+
+var (
+	ErrInvalidExportChunk        = errors.New("invalid keystore export chunk")
+	ErrMissingExportChunks       = errors.New("keystore export chunks are missing")
+	ErrExportChecksumMismatch    = errors.New("keystore export checksum doesn't match")
+	ErrUnsupportedExportVersion  = errors.New("unsupported keystore export version")
+	ErrExportDecryptionFailed    = errors.New("failed to decrypt keystore export, wrong password")
+	ErrExportKeyAddressMismatch  = errors.New("exported key file doesn't match its address")
+	ErrKeystoreExportKeysMissing = errors.New("keystore export has no keys")
+)
+
+// KeystoreExport is a keypair exported from a device to be imported in
+// another one, with the key files encrypted by the password of the exporter
+type KeystoreExport struct {
+	Version int `json:"version"`
+	// Keypair describes the keypair, it's opaque to the key store
+	Keypair json.RawMessage `json:"keypair"`
+	// Keys are the key files by address
+	Keys map[string]json.RawMessage `json:"keys"`
+}
+
+func exportKey(password string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(password), salt, exportScryptN, exportScryptR, exportScryptP, exportKeyLength)
+}
+
+// EncryptKeystoreExport encrypts the export with the password and splits it in
+// chunks to be displayed as QR codes. Each chunk is
+// `kse:<version>:<index>/<total>:<checksum>:<data>`, the checksum being the
+// one of the whole encrypted payload
+func EncryptKeystoreExport(export *KeystoreExport, password string) ([]string, error) {
+	if len(export.Keys) == 0 {
+		return nil, ErrKeystoreExportKeysMissing
+	}
+	export.Version = KeystoreExportVersion
+	plaintext, err := json.Marshal(export)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, exportSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	key, err := exportKey(password, salt)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	payload := append(salt, nonce...)
+	payload = gcm.Seal(payload, nonce, plaintext, nil)
+
+	checksum := sha256.Sum256(payload)
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	total := (len(encoded) + keystoreExportChunkSize - 1) / keystoreExportChunkSize
+
+	chunks := make([]string, 0, total)
+	for i := 0; i < total; i++ {
+		end := (i + 1) * keystoreExportChunkSize
+		if end > len(encoded) {
+			end = len(encoded)
+		}
+		chunks = append(chunks, fmt.Sprintf("%s:%d:%d/%d:%s:%s", keystoreExportChunkPrefix, KeystoreExportVersion, i+1, total, hex.EncodeToString(checksum[:4]), encoded[i*keystoreExportChunkSize:end]))
+	}
+	return chunks, nil
+}
+
+type exportChunk struct {
+	index    int
+	total    int
+	checksum string
+	data     string
+}
+
+func parseExportChunk(chunk string) (*exportChunk, error) {
+	parts := strings.Split(strings.TrimSpace(chunk), ":")
+	if len(parts) != 5 || parts[0] != keystoreExportChunkPrefix {
+		return nil, ErrInvalidExportChunk
+	}
+	if parts[1] != strconv.Itoa(KeystoreExportVersion) {
+		return nil, ErrUnsupportedExportVersion
+	}
+
+	position := strings.Split(parts[2], "/")
+	if len(position) != 2 {
+		return nil, ErrInvalidExportChunk
+	}
+	index, err := strconv.Atoi(position[0])
+	if err != nil {
+		return nil, ErrInvalidExportChunk
+	}
+	total, err := strconv.Atoi(position[1])
+	if err != nil || index < 1 || index > total {
+		return nil, ErrInvalidExportChunk
+	}
+
+	return &exportChunk{index: index, total: total, checksum: parts[3], data: parts[4]}, nil
+}
+
+// DecryptKeystoreExport assembles the chunks, in any order, verifies their
+// checksum and decrypts the export with the password of the exporter
+func DecryptKeystoreExport(chunks []string, password string) (*KeystoreExport, error) {
+	if len(chunks) == 0 {
+		return nil, ErrMissingExportChunks
+	}
+
+	var total int
+	var checksum string
+	data := make(map[int]string, len(chunks))
+	for _, c := range chunks {
+		chunk, err := parseExportChunk(c)
+		if err != nil {
+			return nil, err
+		}
+		if total == 0 {
+			total = chunk.total
+			checksum = chunk.checksum
+		} else if chunk.total != total || chunk.checksum != checksum {
+			// Chunks of another export
+			return nil, ErrExportChecksumMismatch
+		}
+		data[chunk.index] = chunk.data
+	}
+	if len(data) != total {
+		return nil, ErrMissingExportChunks
+	}
+
+	var encoded strings.Builder
+	for i := 1; i <= total; i++ {
+		encoded.WriteString(data[i])
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded.String())
+	if err != nil {
+		return nil, ErrInvalidExportChunk
+	}
+	sum := sha256.Sum256(payload)
+	if hex.EncodeToString(sum[:4]) != checksum {
+		return nil, ErrExportChecksumMismatch
+	}
+
+	if len(payload) < exportSaltLength {
+		return nil, ErrInvalidExportChunk
+	}
+	key, err := exportKey(password, payload[:exportSaltLength])
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) < exportSaltLength+gcm.NonceSize() {
+		return nil, ErrInvalidExportChunk
+	}
+	nonce := payload[exportSaltLength : exportSaltLength+gcm.NonceSize()]
+	plaintext, err := gcm.Open(nil, nonce, payload[exportSaltLength+gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrExportDecryptionFailed
+	}
+
+	export := &KeystoreExport{}
+	if err := json.Unmarshal(plaintext, export); err != nil {
+		return nil, err
+	}
+	if export.Version != KeystoreExportVersion {
+		return nil, ErrUnsupportedExportVersion
+	}
+	return export, nil
+}
+
+// ExportKeyFile returns the key file of the address once the password is
+// verified
+func (m *DefaultManager) ExportKeyFile(keyStoreDir, address, password string) ([]byte, error) {
+	if _, err := m.VerifyAccountPassword(keyStoreDir, address, password); err != nil {
+		return nil, err
+	}
+	return findKeyFile(keyStoreDir, types.HexToAddress(address))
+}
+
+// ImportKeyFile stores the key file in the key store directory, encrypted with
+// the new password. Existing keys aren't overwritten
+func (m *DefaultManager) ImportKeyFile(keyStoreDir string, address types.Address, rawKey []byte, password string, newPassword string) error {
+	m.keyFilesMu.Lock()
+	defer m.keyFilesMu.Unlock()
+
+	key, err := keystore.DecryptKey(rawKey, password)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(key.Address.Bytes(), address.Bytes()) {
+		return ErrExportKeyAddressMismatch
+	}
+
+	if existing, _ := findKeyFile(keyStoreDir, address); existing != nil {
+		return nil
+	}
+
+	reEncryptedKey, err := m.ReEncryptKey(rawKey, password, newPassword)
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("UTC--%s--%s", time.Now().UTC().Format("2006-01-02T15-04-05.999999999Z"), hex.EncodeToString(address.Bytes()))
+	return ioutil.WriteFile(filepath.Join(keyStoreDir, name), reEncryptedKey, 0600)
+}
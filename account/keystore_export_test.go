@@ -0,0 +1,68 @@
+package account
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/t/utils"
+)
+
+func TestKeystoreExport(t *testing.T) {
+	accManager := NewGethManager()
+	keyStoreDir := t.TempDir()
+	importKeyStoreDir := t.TempDir()
+
+	utils.Init()
+	require.NoError(t, utils.ImportTestAccount(keyStoreDir, utils.GetAccount1PKFile()))
+	address := types.HexToAddress(utils.TestConfig.Account1.WalletAddress)
+	password := utils.TestConfig.Account1.Password
+
+	_, err := accManager.ExportKeyFile(keyStoreDir, address.Hex(), "wrong-password")
+	require.Error(t, err)
+
+	keyFile, err := accManager.ExportKeyFile(keyStoreDir, address.Hex(), password)
+	require.NoError(t, err)
+
+	chunks, err := EncryptKeystoreExport(&KeystoreExport{
+		Keypair: json.RawMessage(`{"key-uid":"0x01"}`),
+		Keys:    map[string]json.RawMessage{address.Hex(): keyFile},
+	}, password)
+	require.NoError(t, err)
+	require.Greater(t, len(chunks), 1)
+
+	// Chunks can be scanned in any order
+	reversed := make([]string, 0, len(chunks))
+	for i := len(chunks) - 1; i >= 0; i-- {
+		reversed = append(reversed, chunks[i])
+	}
+
+	_, err = DecryptKeystoreExport(reversed[1:], password)
+	require.Equal(t, ErrMissingExportChunks, err)
+
+	_, err = DecryptKeystoreExport(reversed, "wrong-password")
+	require.Equal(t, ErrExportDecryptionFailed, err)
+
+	tampered := []byte(chunks[0])
+	i := len(tampered) - 10
+	if tampered[i] == 'A' {
+		tampered[i] = 'B'
+	} else {
+		tampered[i] = 'A'
+	}
+	_, err = DecryptKeystoreExport(append([]string{string(tampered)}, chunks[1:]...), password)
+	require.Equal(t, ErrExportChecksumMismatch, err)
+
+	exported, err := DecryptKeystoreExport(reversed, password)
+	require.NoError(t, err)
+	require.Equal(t, KeystoreExportVersion, exported.Version)
+	require.JSONEq(t, `{"key-uid":"0x01"}`, string(exported.Keypair))
+
+	require.NoError(t, accManager.ImportKeyFile(importKeyStoreDir, address, exported.Keys[address.Hex()], password, newTestPassword))
+	_, err = accManager.VerifyAccountPassword(importKeyStoreDir, address.Hex(), newTestPassword)
+	require.NoError(t, err)
+
+	require.Equal(t, ErrExportKeyAddressMismatch, accManager.ImportKeyFile(importKeyStoreDir, types.HexToAddress("0x1"), exported.Keys[address.Hex()], password, newTestPassword))
+}
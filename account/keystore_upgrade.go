@@ -0,0 +1,211 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/eth-node/keystore"
+	"github.com/status-im/status-go/signal"
+)
+
+const (
+	// keystoreScryptN and keystoreScryptP are the scrypt parameters the key
+	// files are upgraded to on login. New key files are still encrypted with
+	// the light ones, so that creating an account stays fast
+	keystoreScryptN = 1 << 15
+	keystoreScryptP = 1
+	// minPBKDF2Iterations is the iterations count below which the key files
+	// encrypted with PBKDF2 are upgraded
+	minPBKDF2Iterations = 1 << 18
+	// keystoreUpgradeDirSuffix names the directory next to the key store where
+	// the upgraded key files are written before replacing the original ones
+	keystoreUpgradeDirSuffix = "-kdf-upgrade"
+)
+
+// hasWeakKDF returns whether the key file is encrypted with KDF parameters
+// weaker than the current ones
+func hasWeakKDF(rawKey []byte) (bool, error) {
+	cryptoJSON, err := keystore.RawKeyToCryptoJSON(rawKey)
+	if err != nil {
+		return false, err
+	}
+
+	switch cryptoJSON.KDF {
+	case "scrypt":
+		n, okN := cryptoJSON.KDFParams["n"].(float64)
+		p, okP := cryptoJSON.KDFParams["p"].(float64)
+		if !okN || !okP {
+			return false, errors.New("unable to determine `n` or `p`")
+		}
+		return int(n) < keystoreScryptN || int(p) < keystoreScryptP, nil
+	case "pbkdf2":
+		c, ok := cryptoJSON.KDFParams["c"].(float64)
+		if !ok {
+			return false, errors.New("unable to determine `c`")
+		}
+		return int(c) < minPBKDF2Iterations, nil
+	}
+	return false, fmt.Errorf("unsupported KDF: %s", cryptoJSON.KDF)
+}
+
+// upgradeKey re-encrypts the key file with the current KDF parameters and
+// checks that the result decrypts to the same key
+func upgradeKey(rawKey []byte, password string) ([]byte, error) {
+	decryptedKey, err := keystore.DecryptKey(rawKey, password)
+	if err != nil {
+		return nil, fmt.Errorf("decryption error: %v", err)
+	}
+
+	upgraded, err := encryptKey(decryptedKey, password, keystoreScryptN, keystoreScryptP)
+	if err != nil {
+		return nil, err
+	}
+
+	check, err := keystore.DecryptKey(upgraded, password)
+	if err != nil {
+		return nil, fmt.Errorf("upgraded key doesn't decrypt: %v", err)
+	}
+	if check.Address != decryptedKey.Address || check.PrivateKey.D.Cmp(decryptedKey.PrivateKey.D) != 0 ||
+		(decryptedKey.ExtendedKey != nil && (check.ExtendedKey == nil || check.ExtendedKey.String() != decryptedKey.ExtendedKey.String())) {
+		return nil, errors.New("upgraded key doesn't match the original one")
+	}
+	return upgraded, nil
+}
+
+// weakKeyFiles returns the paths of the key files of the directory encrypted
+// with weak KDF parameters
+func weakKeyFiles(keyDir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(keyDir)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(keyDir, entry.Name())
+		rawKey, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		weak, err := hasWeakKDF(rawKey)
+		if err != nil {
+			log.Debug("skipping key file with unknown KDF parameters", "path", path, "err", err)
+			continue
+		}
+		if weak {
+			paths = append(paths, path)
+		}
+	}
+	return paths, nil
+}
+
+// replaceKeyFile writes the upgraded key file in the upgrade directory and
+// moves it over the original one, so that an interruption leaves either of
+// them in the key store
+func replaceKeyFile(upgradeDir, path string, upgraded []byte) error {
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	tempPath := filepath.Join(upgradeDir, fileInfo.Name())
+	file, err := os.OpenFile(tempPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileInfo.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(upgraded)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tempPath)
+		return err
+	}
+
+	return os.Rename(tempPath, path)
+}
+
+// UpgradeKeystore re-encrypts with the current KDF parameters the key files
+// encrypted with weak ones, sending the progress as signals. The key files
+// which don't decrypt with the password are left as they are. It waits for
+// the other writes to the key files, a password change included
+func (m *DefaultManager) UpgradeKeystore(keyDir, password string) error {
+	m.keyFilesMu.Lock()
+	defer m.keyFilesMu.Unlock()
+
+	paths, err := weakKeyFiles(keyDir)
+	if err != nil {
+		return fmt.Errorf("cannot read key store folder: %v", err)
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	upgradeDir := strings.TrimRight(keyDir, "/\\") + keystoreUpgradeDirSuffix
+	// Leftovers of an interrupted upgrade, the originals are still in place
+	err = os.RemoveAll(upgradeDir)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(upgradeDir, 0700)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(upgradeDir); err != nil {
+			log.Error("unable to delete the keystore upgrade folder, manual cleanup required", "err", err)
+		}
+	}()
+
+	progress := signal.KeystoreUpgradeProgressSignal{Total: len(paths)}
+	signal.SendKeystoreUpgradeProgress(progress)
+	for _, path := range paths {
+		rawKey, err := ioutil.ReadFile(path)
+		if err == nil {
+			var upgraded []byte
+			upgraded, err = upgradeKey(rawKey, password)
+			if err == nil {
+				err = replaceKeyFile(upgradeDir, path, upgraded)
+			}
+		}
+		if err != nil {
+			log.Warn("unable to upgrade key file", "path", path, "err", err)
+			progress.Failed++
+		} else {
+			progress.Upgraded++
+		}
+		signal.SendKeystoreUpgradeProgress(progress)
+	}
+
+	progress.Done = true
+	signal.SendKeystoreUpgradeProgress(progress)
+	return nil
+}
+
+// upgradeKeystoreInBackground upgrades the key files once the password has
+// been verified, without delaying the login. The failures are reported with
+// the progress signal
+func (m *DefaultManager) upgradeKeystoreInBackground(keyDir, password string) {
+	m.keystoreUpgrades.Add(1)
+	go func() {
+		defer m.keystoreUpgrades.Done()
+
+		err := m.UpgradeKeystore(keyDir, password)
+		if err != nil {
+			log.Error("failed to upgrade the keystore", "err", err)
+			signal.SendKeystoreUpgradeProgress(signal.KeystoreUpgradeProgressSignal{Done: true, Error: err.Error()})
+		}
+	}()
+}
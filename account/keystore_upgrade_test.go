@@ -0,0 +1,96 @@
+package account
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/keystore"
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+func writeTestKeyFile(t *testing.T, dir, name, password string, n int) *types.Key {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	key := &types.Key{
+		ID:         uuid.New(),
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}
+	rawKey, err := encryptKey(key, password, n, gethkeystore.LightScryptP)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), rawKey, 0600))
+	return key
+}
+
+func TestUpgradeKeystore(t *testing.T) {
+	keyDir := filepath.Join(t.TempDir(), "keystore")
+	require.NoError(t, os.MkdirAll(keyDir, 0700))
+
+	weakKey := writeTestKeyFile(t, keyDir, "weak", testPassword, gethkeystore.LightScryptN)
+	writeTestKeyFile(t, keyDir, "strong", testPassword, keystoreScryptN)
+	writeTestKeyFile(t, keyDir, "other-password", newTestPassword, gethkeystore.LightScryptN)
+	strongRaw, err := ioutil.ReadFile(filepath.Join(keyDir, "strong"))
+	require.NoError(t, err)
+
+	paths, err := weakKeyFiles(keyDir)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{filepath.Join(keyDir, "weak"), filepath.Join(keyDir, "other-password")}, paths)
+
+	manager := NewGethManager()
+	require.NoError(t, manager.UpgradeKeystore(keyDir, testPassword))
+
+	rawKey, err := ioutil.ReadFile(filepath.Join(keyDir, "weak"))
+	require.NoError(t, err)
+	weak, err := hasWeakKDF(rawKey)
+	require.NoError(t, err)
+	require.False(t, weak)
+	decrypted, err := keystore.DecryptKey(rawKey, testPassword)
+	require.NoError(t, err)
+	require.Equal(t, weakKey.Address, decrypted.Address)
+	require.Equal(t, weakKey.PrivateKey.D, decrypted.PrivateKey.D)
+
+	// Key files of other passwords and already strong ones are left as they are
+	rawKey, err = ioutil.ReadFile(filepath.Join(keyDir, "other-password"))
+	require.NoError(t, err)
+	weak, err = hasWeakKDF(rawKey)
+	require.NoError(t, err)
+	require.True(t, weak)
+	rawKey, err = ioutil.ReadFile(filepath.Join(keyDir, "strong"))
+	require.NoError(t, err)
+	require.Equal(t, strongRaw, rawKey)
+
+	_, err = os.Stat(keyDir + keystoreUpgradeDirSuffix)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestUpgradeKeystoreDuringPasswordChange(t *testing.T) {
+	keyDir := filepath.Join(t.TempDir(), "keystore")
+	require.NoError(t, os.MkdirAll(keyDir, 0700))
+	for _, name := range []string{"a", "b", "c"} {
+		writeTestKeyFile(t, keyDir, name, testPassword, gethkeystore.LightScryptN)
+	}
+
+	manager := NewGethManager()
+	upgradeErr := make(chan error)
+	go func() {
+		upgradeErr <- manager.UpgradeKeystore(keyDir, testPassword)
+	}()
+	require.NoError(t, manager.ReEncryptKeyStoreDir(keyDir, testPassword, newTestPassword))
+	require.NoError(t, <-upgradeErr)
+
+	// Whichever ran first, every key file decrypts with the new password
+	for _, name := range []string{"a", "b", "c"} {
+		rawKey, err := ioutil.ReadFile(filepath.Join(keyDir, name))
+		require.NoError(t, err)
+		_, err = keystore.DecryptKey(rawKey, newTestPassword)
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,270 @@
+package ledger
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	claEthereum = 0xe0
+
+	insGetAddress          = 0x02
+	insSignTransaction     = 0x04
+	insSignPersonalMessage = 0x08
+	insSignTypedData       = 0x0c
+
+	p1NoConfirm   = 0x00
+	p1Confirm     = 0x01
+	p1FirstChunk  = 0x00
+	p1NextChunk   = 0x80
+	p2NoChainCode = 0x00
+	p2HashedData  = 0x00
+
+	swOK           = 0x9000
+	swUserRejected = 0x6985
+	swAppNotOpen   = 0x6e00
+	swDeviceLocked = 0x5515
+
+	maxChunkSize    = 255
+	maxPathLength   = 10
+	signatureLength = 65
+)
+
+var (
+	ErrUserRejected      = errors.New("request rejected on the device")
+	ErrAppNotOpen        = errors.New("ethereum app is not open on the device")
+	ErrDeviceLocked      = errors.New("device is locked")
+	ErrInvalidResponse   = errors.New("invalid response from the device")
+	ErrInvalidPath       = errors.New("invalid derivation path")
+	ErrAddressMismatch   = errors.New("transaction signed by another address")
+	ErrUnsupportedTxType = errors.New("transaction type not supported by the device")
+)
+
+// StatusError is returned for the status words without a known meaning
+type StatusError struct {
+	Code uint16
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("device returned status 0x%04x", e.Code)
+}
+
+// Ledger talks to the Ethereum app of a Ledger device
+type Ledger struct {
+	transport Transport
+}
+
+func New(transport Transport) *Ledger {
+	return &Ledger{transport: transport}
+}
+
+func (l *Ledger) Close() error {
+	return l.transport.Close()
+}
+
+func (l *Ledger) exchange(ins byte, p1 byte, p2 byte, data []byte) ([]byte, error) {
+	apdu := append([]byte{claEthereum, ins, p1, p2, byte(len(data))}, data...)
+	response, err := l.transport.Exchange(apdu)
+	if err != nil {
+		return nil, err
+	}
+	if len(response) < 2 {
+		return nil, ErrInvalidResponse
+	}
+
+	status := binary.BigEndian.Uint16(response[len(response)-2:])
+	switch status {
+	case swOK:
+		return response[:len(response)-2], nil
+	case swUserRejected:
+		return nil, ErrUserRejected
+	case swAppNotOpen:
+		return nil, ErrAppNotOpen
+	case swDeviceLocked:
+		return nil, ErrDeviceLocked
+	}
+	return nil, &StatusError{Code: status}
+}
+
+// exchangeChunks sends data too long for one APDU in chunks, the device only
+// responds to the last one
+func (l *Ledger) exchangeChunks(ins byte, data []byte) ([]byte, error) {
+	p1 := byte(p1FirstChunk)
+	for {
+		n := len(data)
+		if n > maxChunkSize {
+			n = maxChunkSize
+		}
+		response, err := l.exchange(ins, p1, p2NoChainCode, data[:n])
+		if err != nil {
+			return nil, err
+		}
+		data = data[n:]
+		if len(data) == 0 {
+			return response, nil
+		}
+		p1 = p1NextChunk
+	}
+}
+
+func encodePath(path accounts.DerivationPath) ([]byte, error) {
+	if len(path) == 0 || len(path) > maxPathLength {
+		return nil, ErrInvalidPath
+	}
+	encoded := []byte{byte(len(path))}
+	for _, component := range path {
+		encoded = binary.BigEndian.AppendUint32(encoded, component)
+	}
+	return encoded, nil
+}
+
+// Derive returns the address of the path. With confirm, the address is
+// displayed on the device and returned once the user confirms it
+func (l *Ledger) Derive(path accounts.DerivationPath, confirm bool) (common.Address, error) {
+	data, err := encodePath(path)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	p1 := byte(p1NoConfirm)
+	if confirm {
+		p1 = p1Confirm
+	}
+	response, err := l.exchange(insGetAddress, p1, p2NoChainCode, data)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	// The response is the public key and the hex address, prefixed by their
+	// length
+	if len(response) < 1 || len(response) < 1+int(response[0])+1 {
+		return common.Address{}, ErrInvalidResponse
+	}
+	response = response[1+int(response[0]):]
+	if int(response[0]) != 2*common.AddressLength || len(response) < 1+2*common.AddressLength {
+		return common.Address{}, ErrInvalidResponse
+	}
+
+	address, err := hex.DecodeString(string(response[1 : 1+2*common.AddressLength]))
+	if err != nil {
+		return common.Address{}, ErrInvalidResponse
+	}
+	return common.BytesToAddress(address), nil
+}
+
+// txPayload returns the unsigned encoding of the transaction, which the device
+// hashes and signs
+func txPayload(tx *types.Transaction, chainID *big.Int) ([]byte, error) {
+	switch tx.Type() {
+	case types.LegacyTxType:
+		return rlp.EncodeToBytes([]interface{}{
+			tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), chainID, uint(0), uint(0),
+		})
+	case types.AccessListTxType:
+		payload, err := rlp.EncodeToBytes([]interface{}{
+			chainID, tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), tx.AccessList(),
+		})
+		return append([]byte{types.AccessListTxType}, payload...), err
+	case types.DynamicFeeTxType:
+		payload, err := rlp.EncodeToBytes([]interface{}{
+			chainID, tx.Nonce(), tx.GasTipCap(), tx.GasFeeCap(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), tx.AccessList(),
+		})
+		return append([]byte{types.DynamicFeeTxType}, payload...), err
+	}
+	return nil, ErrUnsupportedTxType
+}
+
+// SignTx signs the transaction with the key of the path once the user confirms
+// it on the device, the signature is checked against the expected address
+func (l *Ledger) SignTx(path accounts.DerivationPath, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	encodedPath, err := encodePath(path)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := txPayload(tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := l.exchangeChunks(insSignTransaction, append(encodedPath, payload...))
+	if err != nil {
+		return nil, err
+	}
+	if len(response) != signatureLength {
+		return nil, ErrInvalidResponse
+	}
+
+	// The device returns v first, truncated to a byte for legacy transactions
+	// of chains with a large id, the parity is found by recovering the sender
+	signature := make([]byte, signatureLength)
+	copy(signature, response[1:])
+	signer := types.LatestSignerForChainID(chainID)
+	for parity := byte(0); parity < 2; parity++ {
+		signature[signatureLength-1] = parity
+		signed, err := tx.WithSignature(signer, signature)
+		if err != nil {
+			return nil, err
+		}
+		sender, err := types.Sender(signer, signed)
+		if err == nil && sender == address {
+			return signed, nil
+		}
+	}
+	return nil, ErrAddressMismatch
+}
+
+// SignPersonalMessage signs the message prefixed as defined by EIP-191 once
+// the user confirms it on the device. The signature is returned with v being
+// 27 or 28
+func (l *Ledger) SignPersonalMessage(path accounts.DerivationPath, message []byte) ([]byte, error) {
+	data, err := encodePath(path)
+	if err != nil {
+		return nil, err
+	}
+	data = binary.BigEndian.AppendUint32(data, uint32(len(message)))
+	data = append(data, message...)
+
+	response, err := l.exchangeChunks(insSignPersonalMessage, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(response) != signatureLength {
+		return nil, ErrInvalidResponse
+	}
+
+	signature := make([]byte, 0, signatureLength)
+	signature = append(signature, response[1:]...)
+	return append(signature, response[0]), nil
+}
+
+// SignTypedData signs the EIP-712 digest of the domain separator and the hash
+// of the message once the user confirms them on the device. The signature is
+// returned with v being 27 or 28
+func (l *Ledger) SignTypedData(path accounts.DerivationPath, domainSeparator common.Hash, message common.Hash) ([]byte, error) {
+	data, err := encodePath(path)
+	if err != nil {
+		return nil, err
+	}
+	data = append(data, domainSeparator.Bytes()...)
+	data = append(data, message.Bytes()...)
+
+	response, err := l.exchange(insSignTypedData, p1FirstChunk, p2HashedData, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(response) != signatureLength {
+		return nil, ErrInvalidResponse
+	}
+
+	signature := make([]byte, 0, signatureLength)
+	signature = append(signature, response[1:]...)
+	return append(signature, response[0]), nil
+}
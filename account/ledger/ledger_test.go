@@ -0,0 +1,198 @@
+package ledger
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// mockDevice is a HID device running the Ethereum app with a single key
+type mockDevice struct {
+	key      *ecdsa.PrivateKey
+	reject   bool
+	written  []byte
+	chunks   []byte
+	pending  [][]byte
+	received [][]byte
+}
+
+func (d *mockDevice) Write(packet []byte) (int, error) {
+	d.written = append(d.written, packet[5:]...)
+	length := int(binary.BigEndian.Uint16(d.written))
+	if len(d.written)-2 < length {
+		return len(packet), nil
+	}
+	apdu := d.written[2 : 2+length]
+	d.written = nil
+	d.received = append(d.received, apdu)
+
+	response := d.handle(apdu)
+	transport := &frameTransport{channel: hidChannel, packetSize: hidPacketSize, pad: true}
+	data := binary.BigEndian.AppendUint16(nil, uint16(len(response)))
+	data = append(data, response...)
+	for seq := uint16(0); len(data) > 0; seq++ {
+		reply := transport.header(seq)
+		n := hidPacketSize - len(reply)
+		if n > len(data) {
+			n = len(data)
+		}
+		reply = append(reply, data[:n]...)
+		data = data[n:]
+		d.pending = append(d.pending, append(reply, make([]byte, hidPacketSize-len(reply))...))
+	}
+	return len(packet), nil
+}
+
+func (d *mockDevice) Read(buf []byte) (int, error) {
+	packet := d.pending[0]
+	d.pending = d.pending[1:]
+	return copy(buf, packet), nil
+}
+
+func (d *mockDevice) Close() error {
+	return nil
+}
+
+func (d *mockDevice) handle(apdu []byte) []byte {
+	ins, p1, data := apdu[1], apdu[2], apdu[5:]
+	if d.reject && ins != insGetAddress {
+		return []byte{0x69, 0x85}
+	}
+
+	switch ins {
+	case insGetAddress:
+		pub := crypto.FromECDSAPub(&d.key.PublicKey)
+		address := hex.EncodeToString(crypto.PubkeyToAddress(d.key.PublicKey).Bytes())
+		response := append([]byte{byte(len(pub))}, pub...)
+		response = append(response, byte(len(address)))
+		response = append(response, address...)
+		return append(response, 0x90, 0x00)
+
+	case insSignTransaction, insSignPersonalMessage:
+		if p1 == p1FirstChunk {
+			d.chunks = nil
+		}
+		d.chunks = append(d.chunks, data...)
+		payload := d.chunks[1+4*int(d.chunks[0]):]
+
+		var hash []byte
+		if ins == insSignTransaction {
+			hash = crypto.Keccak256(payload)
+		} else {
+			hash = accounts.TextHash(payload[4:])
+		}
+		sig, _ := crypto.Sign(hash, d.key)
+		v := sig[64]
+		if ins == insSignPersonalMessage {
+			v += 27
+		}
+		response := append([]byte{v}, sig[:64]...)
+		return append(response, 0x90, 0x00)
+
+	case insSignTypedData:
+		hashes := data[1+4*int(data[0]):]
+		sig, _ := crypto.Sign(crypto.Keccak256([]byte{0x19, 0x01}, hashes), d.key)
+		response := append([]byte{sig[64] + 27}, sig[:64]...)
+		return append(response, 0x90, 0x00)
+	}
+	return []byte{0x6d, 0x00}
+}
+
+func newMockLedger(t *testing.T) (*Ledger, *mockDevice) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	device := &mockDevice{key: key}
+	return New(NewHIDTransport(device)), device
+}
+
+func TestDerive(t *testing.T) {
+	ledger, device := newMockLedger(t)
+
+	address, err := ledger.Derive(accounts.DefaultBaseDerivationPath, true)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(device.key.PublicKey), address)
+
+	apdu := device.received[0]
+	require.Equal(t, []byte{claEthereum, insGetAddress, p1Confirm, p2NoChainCode, 21, 5}, apdu[:6])
+	require.Equal(t, uint32(0x80000000+44), binary.BigEndian.Uint32(apdu[6:]))
+
+	_, err = ledger.Derive(accounts.DerivationPath{}, false)
+	require.Equal(t, ErrInvalidPath, err)
+}
+
+func TestSignTx(t *testing.T) {
+	ledger, device := newMockLedger(t)
+	address := crypto.PubkeyToAddress(device.key.PublicKey)
+	to := common.HexToAddress("0x1")
+	chainID := big.NewInt(1)
+
+	txs := []*types.Transaction{
+		types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1), Gas: 21000, To: &to, Value: big.NewInt(1)}),
+		types.NewTx(&types.DynamicFeeTx{
+			ChainID: chainID, Nonce: 2, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2), Gas: 100000, To: &to,
+			// Data longer than a chunk
+			Data: bytes.Repeat([]byte{1}, 600),
+		}),
+	}
+	for _, tx := range txs {
+		signed, err := ledger.SignTx(accounts.DefaultBaseDerivationPath, address, tx, chainID)
+		require.NoError(t, err)
+
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		require.Equal(t, address, sender)
+	}
+
+	_, err := ledger.SignTx(accounts.DefaultBaseDerivationPath, common.HexToAddress("0x2"), txs[0], chainID)
+	require.Equal(t, ErrAddressMismatch, err)
+
+	device.reject = true
+	_, err = ledger.SignTx(accounts.DefaultBaseDerivationPath, address, txs[0], chainID)
+	require.Equal(t, ErrUserRejected, err)
+}
+
+func TestSignPersonalMessage(t *testing.T) {
+	ledger, device := newMockLedger(t)
+
+	message := []byte("hello")
+	signature, err := ledger.SignPersonalMessage(accounts.DefaultBaseDerivationPath, message)
+	require.NoError(t, err)
+	require.Len(t, signature, 65)
+	require.True(t, signature[64] == 27 || signature[64] == 28)
+
+	signature[64] -= 27
+	pub, err := crypto.SigToPub(accounts.TextHash(message), signature)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(device.key.PublicKey), crypto.PubkeyToAddress(*pub))
+}
+
+func TestSignTypedData(t *testing.T) {
+	ledger, device := newMockLedger(t)
+
+	domainSeparator := common.HexToHash("0x1")
+	message := common.HexToHash("0x2")
+	signature, err := ledger.SignTypedData(accounts.DefaultBaseDerivationPath, domainSeparator, message)
+	require.NoError(t, err)
+	require.Len(t, signature, 65)
+	require.True(t, signature[64] == 27 || signature[64] == 28)
+
+	signature[64] -= 27
+	digest := crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator.Bytes(), message.Bytes())
+	pub, err := crypto.SigToPub(digest, signature)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(device.key.PublicKey), crypto.PubkeyToAddress(*pub))
+
+	device.reject = true
+	_, err = ledger.SignTypedData(accounts.DefaultBaseDerivationPath, domainSeparator, message)
+	require.Equal(t, ErrUserRejected, err)
+}
@@ -0,0 +1,131 @@
+package ledger
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+const (
+	// hidPacketSize is the size of the HID reports of Ledger devices
+	hidPacketSize = 64
+	// defaultBLEMTU is the MTU of BLE devices before it's negotiated
+	defaultBLEMTU = 20
+
+	apduTag = 0x05
+)
+
+var hidChannel = []byte{0x01, 0x01}
+
+var ErrInvalidFrame = errors.New("invalid frame received from the device")
+
+// Transport exchanges APDUs with a Ledger device. The HID and BLE framings
+// are implemented on top of the connection opened by the platform
+type Transport interface {
+	Exchange(apdu []byte) ([]byte, error)
+	Close() error
+}
+
+// frameTransport splits APDUs in packets of a fixed size, each one starting
+// with a header made of the channel, the tag and the sequence index. The first
+// packet also has the length of the APDU
+type frameTransport struct {
+	conn       io.ReadWriteCloser
+	channel    []byte
+	packetSize int
+	// pad fills the last packet with zeros, HID reports have a fixed size
+	pad bool
+}
+
+// NewHIDTransport returns a transport framing APDUs in HID reports written to
+// and read from the device
+func NewHIDTransport(device io.ReadWriteCloser) Transport {
+	return &frameTransport{
+		conn:       device,
+		channel:    hidChannel,
+		packetSize: hidPacketSize,
+		pad:        true,
+	}
+}
+
+// NewBLETransport returns a transport framing APDUs in packets of the MTU of
+// the connection, each read returning one notification of the device
+func NewBLETransport(conn io.ReadWriteCloser, mtu int) Transport {
+	if mtu <= 0 {
+		mtu = defaultBLEMTU
+	}
+	return &frameTransport{
+		conn:       conn,
+		packetSize: mtu,
+	}
+}
+
+func (t *frameTransport) header(seq uint16) []byte {
+	header := make([]byte, 0, len(t.channel)+3)
+	header = append(header, t.channel...)
+	header = append(header, apduTag)
+	return binary.BigEndian.AppendUint16(header, seq)
+}
+
+func (t *frameTransport) Exchange(apdu []byte) ([]byte, error) {
+	if err := t.write(apdu); err != nil {
+		return nil, err
+	}
+	return t.read()
+}
+
+func (t *frameTransport) write(apdu []byte) error {
+	data := binary.BigEndian.AppendUint16(nil, uint16(len(apdu)))
+	data = append(data, apdu...)
+
+	for seq := uint16(0); len(data) > 0; seq++ {
+		packet := t.header(seq)
+		n := t.packetSize - len(packet)
+		if n > len(data) {
+			n = len(data)
+		}
+		packet = append(packet, data[:n]...)
+		data = data[n:]
+		if t.pad {
+			packet = append(packet, make([]byte, t.packetSize-len(packet))...)
+		}
+		if _, err := t.conn.Write(packet); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *frameTransport) read() ([]byte, error) {
+	var response []byte
+	length := -1
+	buf := make([]byte, t.packetSize)
+
+	for seq := uint16(0); length < 0 || len(response) < length; seq++ {
+		n, err := t.conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		packet := buf[:n]
+
+		header := t.header(seq)
+		if len(packet) < len(header) || string(packet[:len(header)]) != string(header) {
+			return nil, ErrInvalidFrame
+		}
+		packet = packet[len(header):]
+
+		if seq == 0 {
+			if len(packet) < 2 {
+				return nil, ErrInvalidFrame
+			}
+			length = int(binary.BigEndian.Uint16(packet))
+			packet = packet[2:]
+		}
+		response = append(response, packet...)
+	}
+	return response[:length], nil
+}
+
+func (t *frameTransport) Close() error {
+	return t.conn.Close()
+}
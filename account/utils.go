@@ -1,9 +1,13 @@
 package account
 
 import (
+	"crypto/ecdsa"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
+
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/multiaccounts"
@@ -13,6 +17,7 @@ import (
 var (
 	ErrInvalidAccountAddressOrKey  = errors.New("cannot parse address or key to valid account address")
 	ErrInvalidMnemonicPhraseLength = errors.New("invalid mnemonic phrase length; valid lengths are 12, 15, 18, 21, and 24")
+	ErrCannotSignLocally           = errors.New("account can't sign locally, its key isn't stored on this device")
 )
 
 type LoginParams struct {
@@ -75,6 +80,31 @@ type SelectedExtKey struct {
 	Address     types.Address
 	AccountKey  *types.Key
 	SubAccounts []types.Account
+	// Hardware is set instead of AccountKey for accounts stored in a hardware
+	// wallet
+	Hardware *HardwareAccount
+}
+
+// SignTx signs the transaction with the key of the account, or on the hardware
+// wallet storing it
+func (k *SelectedExtKey) SignTx(tx *gethtypes.Transaction, chainID *big.Int) (*gethtypes.Transaction, error) {
+	if k.Hardware != nil {
+		return k.Hardware.SignTx(tx, chainID)
+	}
+	key, err := k.PrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return gethtypes.SignTx(tx, gethtypes.NewLondonSigner(chainID), key)
+}
+
+// PrivateKey returns the key of the account, ErrCannotSignLocally is returned
+// when the key isn't stored on this device, as for hardware wallet accounts
+func (k *SelectedExtKey) PrivateKey() (*ecdsa.PrivateKey, error) {
+	if k.AccountKey == nil || k.AccountKey.PrivateKey == nil {
+		return nil, ErrCannotSignLocally
+	}
+	return k.AccountKey.PrivateKey, nil
 }
 
 // Hex dumps address of a given extended key as hex string.
@@ -93,3 +93,22 @@ func TestMnemonicPhraseLengthToEntropyStrength(t *testing.T) {
 		assert.Equal(t, s.expectedStrength, strength)
 	}
 }
+
+func TestSelectedExtKeyPrivateKey(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	selected := &SelectedExtKey{AccountKey: &types.Key{PrivateKey: key}}
+	privateKey, err := selected.PrivateKey()
+	assert.NoError(t, err)
+	assert.Equal(t, key, privateKey)
+
+	// The key of a hardware wallet account is never on the device
+	selected = &SelectedExtKey{Hardware: &HardwareAccount{}}
+	_, err = selected.PrivateKey()
+	assert.Equal(t, ErrCannotSignLocally, err)
+
+	selected = &SelectedExtKey{AccountKey: &types.Key{}}
+	_, err = selected.PrivateKey()
+	assert.Equal(t, ErrCannotSignLocally, err)
+}
@@ -38,7 +38,7 @@ type StatusBackend interface {
 	CallRPC(inputJSON string) (string, error)
 	HashTransaction(sendArgs transactions.SendTxArgs) (transactions.SendTxArgs, types.Hash, error)
 	HashTypedData(typed typeddata.TypedData) (types.Hash, error)
-	HashTypedDataV4(typed signercore.TypedData) (types.Hash, error)
+	HashTypedDataV4(typed signercore.TypedData) (types.Hash, []typeddata.Warning, error)
 	ResetChainData() error
 	SendTransaction(sendArgs transactions.SendTxArgs, password string) (hash types.Hash, err error)
 	SendTransactionWithChainID(chainID uint64, sendArgs transactions.SendTxArgs, password string) (hash types.Hash, err error)
@@ -46,10 +46,11 @@ type StatusBackend interface {
 	SignHash(hexEncodedHash string) (string, error)
 	SignMessage(rpcParams personal.SignParams) (types.HexBytes, error)
 	SignTypedData(typed typeddata.TypedData, address string, password string) (types.HexBytes, error)
-	SignTypedDataV4(typed signercore.TypedData, address string, password string) (types.HexBytes, error)
+	SignTypedDataV4(typed signercore.TypedData, address string, password string) (types.HexBytes, []typeddata.Warning, error)
 
 	ConnectionChange(typ string, expensive bool)
 	AppStateChange(state string)
+	BatterySaverChange(enabled bool)
 
 	ExtractGroupMembershipSignatures(signaturePairs [][2]string) ([]string, error)
 	SignGroupMembership(content string) (string, error)
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"crypto/ecdsa"
 	"crypto/sha256"
 	"database/sql"
 	"encoding/hex"
@@ -702,6 +703,97 @@ func TestLoginWithKey(t *testing.T) {
 	require.NotNil(t, activeAccount.ColorHash)
 }
 
+func TestSwitchProfile(t *testing.T) {
+	utils.Init()
+
+	b := NewGethStatusBackend()
+	tmpdir := t.TempDir()
+	conf, err := params.NewNodeConfig(tmpdir, 1777)
+	require.NoError(t, err)
+
+	require.NoError(t, b.AccountManager().InitKeystore(conf.KeyStoreDir))
+	b.UpdateRootDataDir(conf.DataDir)
+	require.NoError(t, b.OpenAccounts())
+
+	var profiles []multiaccounts.Account
+	var chatKeys []*ecdsa.PrivateKey
+	for i := 0; i < 2; i++ {
+		chatKey, err := gethcrypto.GenerateKey()
+		require.NoError(t, err)
+		walletKey, err := gethcrypto.GenerateKey()
+		require.NoError(t, err)
+		keyUIDHex := sha256.Sum256(gethcrypto.FromECDSAPub(&chatKey.PublicKey))
+		keyUID := types.EncodeHex(keyUIDHex[:])
+		main := multiaccounts.Account{
+			KeyUID: keyUID,
+		}
+
+		settings := testSettings
+		settings.KeyUID = keyUID
+		settings.Address = crypto.PubkeyToAddress(walletKey.PublicKey)
+
+		chatPubKey := crypto.FromECDSAPub(&chatKey.PublicKey)
+		require.NoError(t, b.SaveAccountAndStartNodeWithKey(main, "test-pass", settings, conf,
+			[]*accounts.Account{
+				{Address: crypto.PubkeyToAddress(walletKey.PublicKey), KeyUID: keyUID, Wallet: true},
+				{Address: crypto.PubkeyToAddress(chatKey.PublicKey), KeyUID: keyUID, Chat: true, PublicKey: chatPubKey}},
+			hex.EncodeToString(gethcrypto.FromECDSA(chatKey))))
+		require.NoError(t, b.Logout())
+		require.NoError(t, b.StopNode())
+
+		profiles = append(profiles, main)
+		chatKeys = append(chatKeys, chatKey)
+	}
+
+	require.NoError(t, b.AccountManager().InitKeystore(conf.KeyStoreDir))
+	require.NoError(t, b.StartNodeWithKey(profiles[0], "test-pass", hex.EncodeToString(gethcrypto.FromECDSA(chatKeys[0]))))
+	defer func() {
+		assert.NoError(t, b.Logout())
+		assert.NoError(t, b.StopNode())
+	}()
+	require.Len(t, b.GetProfiles(), 1)
+	require.Equal(t, ErrProfileNotInitialized, b.SwitchProfile(profiles[1].KeyUID))
+
+	appDB, err := b.openAppDB(profiles[1], "test-pass")
+	require.NoError(t, err)
+	b.addProfile(profiles[1], appDB, chatKeys[1])
+
+	for _, i := range []int{1, 0, 1} {
+		require.NoError(t, b.SwitchProfile(profiles[i].KeyUID))
+		extkey, err := b.accountManager.SelectedChatAccount()
+		require.NoError(t, err)
+		require.Equal(t, crypto.PubkeyToAddress(chatKeys[i].PublicKey), extkey.Address)
+
+		activeAccount, err := b.GetActiveAccount()
+		require.NoError(t, err)
+		require.Equal(t, profiles[i].KeyUID, activeAccount.KeyUID)
+	}
+
+	// The active profile is logged in again when the new one fails to start
+	closedDB, err := b.openAppDB(profiles[0], "test-pass")
+	require.NoError(t, err)
+	require.NoError(t, closedDB.Close())
+	b.addProfile(profiles[0], closedDB, chatKeys[0])
+	require.Error(t, b.SwitchProfile(profiles[0].KeyUID))
+
+	extkey, err := b.accountManager.SelectedChatAccount()
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(chatKeys[1].PublicKey), extkey.Address)
+	activeAccount, err := b.GetActiveAccount()
+	require.NoError(t, err)
+	require.Equal(t, profiles[1].KeyUID, activeAccount.KeyUID)
+
+	result := b.GetProfiles()
+	require.Len(t, result, 2)
+	for _, p := range result {
+		require.Equal(t, p.KeyUID == profiles[1].KeyUID, p.Active)
+	}
+
+	require.NoError(t, b.LogoutProfile(profiles[0].KeyUID))
+	require.Len(t, b.GetProfiles(), 1)
+	require.Equal(t, ErrProfileNotInitialized, b.LogoutProfile(profiles[0].KeyUID))
+}
+
 func TestVerifyDatabasePassword(t *testing.T) {
 	utils.Init()
 
@@ -16,6 +16,7 @@ import (
 
 	"github.com/imdario/mergo"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
@@ -40,6 +41,7 @@ import (
 	"github.com/status-im/status-go/protocol/identity/colorhash"
 	"github.com/status-im/status-go/protocol/requests"
 	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/scheduler"
 	"github.com/status-im/status-go/services/ext"
 	"github.com/status-im/status-go/services/personal"
 	"github.com/status-im/status-go/services/typeddata"
@@ -88,6 +90,9 @@ type GethStatusBackend struct {
 	log                  log.Logger
 	allowAllRPC          bool // used only for tests, disables api method restrictions
 	localPairing         bool // used to disable login/logout signalling
+	// profiles are the multiaccounts kept initialized to switch between them,
+	// by key uid
+	profiles map[string]*profile
 }
 
 // NewGethStatusBackend create a new GethStatusBackend instance
@@ -242,6 +247,13 @@ func (b *GethStatusBackend) DeleteMultiaccount(keyUID string, keyStoreDir string
 		return err
 	}
 
+	if p, exists := b.profiles[keyUID]; exists {
+		if p.appDB != b.appDB {
+			_ = p.appDB.Close()
+		}
+		delete(b.profiles, keyUID)
+	}
+
 	dbFiles := []string{
 		filepath.Join(b.rootDataDir, fmt.Sprintf("app-%x.sql", keyUID)),
 		filepath.Join(b.rootDataDir, fmt.Sprintf("app-%x.sql-shm", keyUID)),
@@ -336,18 +348,26 @@ func (b *GethStatusBackend) ensureAppDBOpened(account multiaccounts.Account, pas
 		return errors.New("root datadir wasn't provided")
 	}
 
+	b.appDB, err = b.openAppDB(account, password)
+	if err != nil {
+		return err
+	}
+	b.statusNode.SetAppDB(b.appDB)
+	return nil
+}
+
+func (b *GethStatusBackend) openAppDB(account multiaccounts.Account, password string) (*sql.DB, error) {
 	dbFilePath, err := b.runDBFileMigrations(account, password)
 	if err != nil {
-		return errors.New("Failed to migrate db file: " + err.Error())
+		return nil, errors.New("Failed to migrate db file: " + err.Error())
 	}
 
-	b.appDB, err = appdatabase.InitializeDB(dbFilePath, password, account.KDFIterations)
+	db, err := appdatabase.InitializeDB(dbFilePath, password, account.KDFIterations)
 	if err != nil {
 		b.log.Error("failed to initialize db", "err", err)
-		return err
+		return nil, err
 	}
-	b.statusNode.SetAppDB(b.appDB)
-	return nil
+	return db, nil
 }
 
 func (b *GethStatusBackend) setupLogSettings() error {
@@ -742,6 +762,7 @@ func (b *GethStatusBackend) LoggedIn(keyUID string, err error) error {
 	if err != nil {
 		return err
 	}
+	b.rememberActiveProfile()
 
 	signal.SendLoggedIn(account, settings, nil)
 	return nil
@@ -808,15 +829,52 @@ func (b *GethStatusBackend) reEncryptKeyStoreDir(currentPassword string, newPass
 	return nil
 }
 
+// UpgradeKeystore re-encrypts with stronger KDF parameters the key files of
+// the logged in account encrypted with weak ones, like it's done on login
+func (b *GethStatusBackend) UpgradeKeystore(password string) error {
+	if _, err := b.accountManager.SelectedChatAccount(); err != nil {
+		return err
+	}
+
+	keyDir := b.accountManager.Keydir
+	if config := b.StatusNode().Config(); config != nil {
+		keyDir = config.KeyStoreDir
+	}
+	if keyDir == "" {
+		return nil
+	}
+	return b.accountManager.UpgradeKeystore(keyDir, password)
+}
+
 func (b *GethStatusBackend) ChangeDatabasePassword(keyUID string, password string, newPassword string) error {
+	return b.rekeyDatabase(keyUID, password, newPassword, 0)
+}
+
+// RotateDatabaseKey re-encrypts the database of the account with a new key
+// derived from the new password, which can be the current one, and the KDF
+// iterations number, the current one being kept when it's 0. The key store
+// is re-encrypted when the password changes
+func (b *GethStatusBackend) RotateDatabaseKey(keyUID string, password string, newPassword string, kdfIterations int) error {
+	if kdfIterations < 0 {
+		return errors.New("invalid kdf iterations number")
+	}
+	return b.rekeyDatabase(keyUID, password, newPassword, kdfIterations)
+}
+
+func (b *GethStatusBackend) rekeyDatabase(keyUID string, password string, newPassword string, newKDFIterations int) error {
 	dbPath := filepath.Join(b.rootDataDir, fmt.Sprintf("%s-v4.db", keyUID))
 
 	account, err := b.multiaccountsDB.GetAccount(keyUID)
 	if err != nil {
 		return err
 	}
+	if newKDFIterations == 0 {
+		newKDFIterations = account.KDFIterations
+	}
 
-	file, err := os.CreateTemp("", "*-v4.db")
+	// The new database is created next to the current one so that it can be
+	// moved over it atomically
+	file, err := os.CreateTemp(b.rootDataDir, "rekey-*-v4.db")
 	if err != nil {
 		return err
 	}
@@ -830,15 +888,39 @@ func (b *GethStatusBackend) ChangeDatabasePassword(keyUID string, password strin
 		_ = os.Remove(newDBPath + "-journal")
 	}()
 
-	// Exporting database to a temporary file with a new password
-	err = appdatabase.ExportDB(dbPath, password, account.KDFIterations, newDBPath, newPassword, signal.SendReEncryptionStarted, signal.SendReEncryptionFinished)
+	// Exporting database to a temporary file with the new key
+	err = appdatabase.RekeyDB(dbPath, password, account.KDFIterations, newDBPath, newPassword, newKDFIterations, signal.SendReEncryptionStarted, signal.SendReEncryptionFinished)
 	if err != nil {
 		return err
 	}
 
-	err = b.reEncryptKeyStoreDir(password, newPassword)
-	if err != nil {
-		return err
+	passwordChanged := password != newPassword
+	if passwordChanged {
+		err = b.reEncryptKeyStoreDir(password, newPassword)
+		if err != nil {
+			return err
+		}
+	}
+
+	restore := func() {
+		if passwordChanged {
+			_ = b.reEncryptKeyStoreDir(newPassword, password)
+		}
+	}
+
+	kdfIterationsChanged := newKDFIterations != account.KDFIterations
+	if kdfIterationsChanged {
+		err = b.multiaccountsDB.UpdateAccountKDFIterations(keyUID, newKDFIterations)
+		if err != nil {
+			restore()
+			return err
+		}
+		restore = func() {
+			if passwordChanged {
+				_ = b.reEncryptKeyStoreDir(newPassword, password)
+			}
+			_ = b.multiaccountsDB.UpdateAccountKDFIterations(keyUID, account.KDFIterations)
+		}
 	}
 
 	// Replacing the old database with the new one requires closing all connections to the database
@@ -853,7 +935,7 @@ func (b *GethStatusBackend) ChangeDatabasePassword(keyUID string, password strin
 	err = os.Rename(newDBPath, dbPath)
 	if err != nil {
 		// Restore the old account
-		_ = b.reEncryptKeyStoreDir(newPassword, password)
+		restore()
 		if changeCurrentAccountPassword {
 			_ = b.startNodeWithAccount(*account, password, nil)
 		}
@@ -866,6 +948,7 @@ func (b *GethStatusBackend) ChangeDatabasePassword(keyUID string, password strin
 	_ = os.Rename(newDBPath+"-shm", dbPath+"-shm")
 
 	if changeCurrentAccountPassword {
+		account.KDFIterations = newKDFIterations
 		return b.startNodeWithAccount(*account, newPassword, nil)
 	}
 	return nil
@@ -1375,7 +1458,10 @@ func (b *GethStatusBackend) saveAccountsAndSettings(settings settings.Settings,
 func (b *GethStatusBackend) loadNodeConfig(inputNodeCfg *params.NodeConfig) error {
 	b.mu.Lock()
 	defer b.mu.Unlock()
+	return b.readNodeConfig(inputNodeCfg)
+}
 
+func (b *GethStatusBackend) readNodeConfig(inputNodeCfg *params.NodeConfig) error {
 	conf, err := nodecfg.GetNodeConfigFromDB(b.appDB)
 	if err != nil {
 		return err
@@ -1644,7 +1730,19 @@ func (b *GethStatusBackend) SignTypedData(typed typeddata.TypedData, address str
 		return types.HexBytes{}, err
 	}
 	chain := new(big.Int).SetUint64(b.StatusNode().Config().NetworkID)
-	sig, err := typeddata.Sign(typed, account.AccountKey.PrivateKey, chain)
+	if account.Hardware != nil {
+		domainSeparator, message, err := typeddata.Hashes(typed, chain)
+		if err != nil {
+			return types.HexBytes{}, err
+		}
+		sig, err := account.Hardware.SignTypedData(domainSeparator, message)
+		return types.HexBytes(sig), err
+	}
+	key, err := account.PrivateKey()
+	if err != nil {
+		return types.HexBytes{}, err
+	}
+	sig, err := typeddata.Sign(typed, key, chain)
 	if err != nil {
 		return types.HexBytes{}, err
 	}
@@ -1652,17 +1750,38 @@ func (b *GethStatusBackend) SignTypedData(typed typeddata.TypedData, address str
 }
 
 // SignTypedDataV4 accepts data and password. Gets verified account and signs typed data.
-func (b *GethStatusBackend) SignTypedDataV4(typed signercore.TypedData, address string, password string) (types.HexBytes, error) {
+// The warnings of the typed data are returned with the signature.
+func (b *GethStatusBackend) SignTypedDataV4(typed signercore.TypedData, address string, password string) (types.HexBytes, []typeddata.Warning, error) {
+	chain := new(big.Int).SetUint64(b.StatusNode().Config().NetworkID)
+	analysis, err := typeddata.AnalyzeV4(typed, chain)
+	if err != nil {
+		return types.HexBytes{}, nil, err
+	}
 	account, err := b.getVerifiedWalletAccount(address, password)
 	if err != nil {
-		return types.HexBytes{}, err
+		return types.HexBytes{}, nil, err
 	}
-	chain := new(big.Int).SetUint64(b.StatusNode().Config().NetworkID)
-	sig, err := typeddata.SignTypedDataV4(typed, account.AccountKey.PrivateKey, chain)
+	var sig []byte
+	if account.Hardware != nil {
+		domainSeparator, message, err := typeddata.HashesV4(typed)
+		if err != nil {
+			return types.HexBytes{}, nil, err
+		}
+		sig, err = account.Hardware.SignTypedData(domainSeparator, message)
+		if err != nil {
+			return types.HexBytes{}, nil, err
+		}
+		return types.HexBytes(sig), analysis.Warnings, nil
+	}
+	key, err := account.PrivateKey()
 	if err != nil {
-		return types.HexBytes{}, err
+		return types.HexBytes{}, nil, err
 	}
-	return types.HexBytes(sig), err
+	sig, err = typeddata.SignTypedDataV4(typed, key, chain)
+	if err != nil {
+		return types.HexBytes{}, nil, err
+	}
+	return types.HexBytes(sig), analysis.Warnings, nil
 }
 
 // HashTypedData generates the hash of TypedData.
@@ -1675,14 +1794,15 @@ func (b *GethStatusBackend) HashTypedData(typed typeddata.TypedData) (types.Hash
 	return types.Hash(hash), err
 }
 
-// HashTypedDataV4 generates the hash of TypedData.
-func (b *GethStatusBackend) HashTypedDataV4(typed signercore.TypedData) (types.Hash, error) {
+// HashTypedDataV4 validates TypedData and generates its hash, with the warnings
+// to show before it's signed.
+func (b *GethStatusBackend) HashTypedDataV4(typed signercore.TypedData) (types.Hash, []typeddata.Warning, error) {
 	chain := new(big.Int).SetUint64(b.StatusNode().Config().NetworkID)
-	hash, err := typeddata.HashTypedDataV4(typed, chain)
+	analysis, err := typeddata.AnalyzeV4(typed, chain)
 	if err != nil {
-		return types.Hash{}, err
+		return types.Hash{}, nil, err
 	}
-	return types.Hash(hash), err
+	return types.Hash(analysis.Digest), analysis.Warnings, nil
 }
 
 func (b *GethStatusBackend) getVerifiedWalletAccount(address, password string) (*account.SelectedExtKey, error) {
@@ -1703,6 +1823,13 @@ func (b *GethStatusBackend) getVerifiedWalletAccount(address, password string) (
 		return nil, transactions.ErrAccountDoesntExist
 	}
 
+	if hardwareAccount := b.accountManager.HardwareWallet().Account(gethcommon.HexToAddress(address)); hardwareAccount != nil {
+		return &account.SelectedExtKey{
+			Address:  types.Address(hardwareAccount.Address),
+			Hardware: hardwareAccount,
+		}, nil
+	}
+
 	key, err := b.accountManager.VerifyAccountPassword(config.KeyStoreDir, address, password)
 	if _, ok := err.(*account.ErrCannotLocateKeyFile); ok {
 		key, err = b.generatePartialAccountKey(db, address, password)
@@ -1804,6 +1931,7 @@ func (b *GethStatusBackend) ConnectionChange(typ string, expensive bool) {
 
 	b.connectionState = state
 	b.statusNode.ConnectionChanged(state)
+	scheduler.Default().SetConnectionState(state)
 
 	// logic of handling state changes here
 	// restart node? force peers reconnect? etc
@@ -1820,6 +1948,7 @@ func (b *GethStatusBackend) AppStateChange(state string) {
 	}
 
 	b.appState = s
+	scheduler.Default().SetBackground(s != appStateForeground)
 
 	if b.statusNode == nil {
 		log.Warn("statusNode nil, not reporting app state change")
@@ -1849,6 +1978,13 @@ func (b *GethStatusBackend) AppStateChange(state string) {
 	// and normal mode if the app is in foreground.
 }
 
+// BatterySaverChange handles the battery saver being enabled or disabled, the
+// periodic jobs which can wait are paused while it's enabled
+func (b *GethStatusBackend) BatterySaverChange(enabled bool) {
+	b.log.Info("Battery saver change", "enabled", enabled)
+	scheduler.Default().SetBatterySaver(enabled)
+}
+
 func (b *GethStatusBackend) StopLocalNotifications() error {
 	if b.statusNode == nil {
 		return nil
@@ -1869,6 +2005,10 @@ func (b *GethStatusBackend) Logout() error {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	if b.account != nil {
+		delete(b.profiles, b.account.KeyUID)
+	}
+
 	err := b.cleanupServices()
 	if err != nil {
 		return err
@@ -1992,6 +2132,9 @@ func (b *GethStatusBackend) injectAccountsIntoWakuService(w types.WakuKeyManager
 		}
 		b.statusNode.ChatService(accDB).Init(messenger)
 		b.statusNode.EnsService().Init(messenger.SyncEnsNamesWithDispatchMessage)
+		if b.statusNode.CollectiblesService() != nil {
+			b.statusNode.CollectiblesService().Init(messenger)
+		}
 	}
 
 	return nil
@@ -0,0 +1,292 @@
+package api
+
+import (
+	"crypto/ecdsa"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"time"
+
+	"github.com/status-im/status-go/multiaccounts"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/nodecfg"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/signal"
+	"github.com/status-im/status-go/sqlite"
+)
+
+// ErrProfileNotInitialized is returned when switching to a profile which wasn't
+// logged in or added
+var ErrProfileNotInitialized = errors.New("profile is not initialized")
+
+// profile is a multiaccount whose database is open and chat key decrypted, so
+// that switching to it skips the key derivation of both
+type profile struct {
+	account multiaccounts.Account
+	appDB   *sql.DB
+	chatKey *ecdsa.PrivateKey
+}
+
+// Profile is an initialized multiaccount
+type Profile struct {
+	KeyUID string `json:"keyUid"`
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+}
+
+func (b *GethStatusBackend) addProfile(acc multiaccounts.Account, appDB *sql.DB, chatKey *ecdsa.PrivateKey) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.profiles == nil {
+		b.profiles = make(map[string]*profile)
+	}
+	// A profile logged in again has a new handle
+	if p, exists := b.profiles[acc.KeyUID]; exists && p.appDB != appDB && p.appDB != b.appDB {
+		_ = p.appDB.Close()
+	}
+	b.profiles[acc.KeyUID] = &profile{account: acc, appDB: appDB, chatKey: chatKey}
+}
+
+// rememberActiveProfile keeps the logged in multiaccount initialized, to
+// switch back to it
+func (b *GethStatusBackend) rememberActiveProfile() {
+	b.mu.Lock()
+	p := b.activeProfile()
+	b.mu.Unlock()
+	if p == nil {
+		return
+	}
+	b.addProfile(p.account, p.appDB, p.chatKey)
+}
+
+// AddProfile initializes a multiaccount without logging in, so that it can be
+// switched to. Keycard profiles are only initialized by logging in
+func (b *GethStatusBackend) AddProfile(request *requests.Login) error {
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	_, exists := b.profiles[request.KeyUID]
+	rootDataDir := b.rootDataDir
+	b.mu.Unlock()
+	if exists {
+		return nil
+	}
+	if len(rootDataDir) == 0 {
+		return errors.New("root datadir wasn't provided")
+	}
+
+	acc, err := b.getAccountByKeyUID(request.KeyUID)
+	if err != nil {
+		return err
+	}
+	acc.KDFIterations = request.KdfIterations
+	if acc.KDFIterations == 0 {
+		acc.KDFIterations = sqlite.ReducedKDFIterationsNumber
+	}
+
+	appDB, err := b.openAppDB(*acc, request.Password)
+	if err != nil {
+		return err
+	}
+
+	chatKey, err := b.profileChatKey(appDB, request.Password)
+	if err != nil {
+		_ = appDB.Close()
+		return err
+	}
+
+	b.addProfile(*acc, appDB, chatKey)
+	return nil
+}
+
+func (b *GethStatusBackend) profileChatKey(appDB *sql.DB, password string) (*ecdsa.PrivateKey, error) {
+	conf, err := nodecfg.GetNodeConfigFromDB(appDB)
+	if err != nil {
+		return nil, err
+	}
+	accountsDB, err := accounts.NewDB(appDB)
+	if err != nil {
+		return nil, err
+	}
+	chatAddress, err := accountsDB.GetChatAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := b.accountManager.VerifyAccountPassword(filepath.Join(b.rootDataDir, conf.KeyStoreDir), chatAddress.Hex(), password)
+	if err != nil {
+		return nil, err
+	}
+	return key.PrivateKey, nil
+}
+
+// GetProfiles returns the initialized multiaccounts
+func (b *GethStatusBackend) GetProfiles() []Profile {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	profiles := make([]Profile, 0, len(b.profiles))
+	for keyUID, p := range b.profiles {
+		profiles = append(profiles, Profile{
+			KeyUID: keyUID,
+			Name:   p.account.Name,
+			Active: b.account != nil && b.account.KeyUID == keyUID,
+		})
+	}
+	return profiles
+}
+
+// SwitchProfile logs in an initialized multiaccount, the active one stays
+// initialized. The services bound to the database of the active profile are
+// restarted with the database of the new one, and the chat identity of
+// the new profile replaces the previous one in waku. The previous profile is
+// logged in again if the new one fails to start
+func (b *GethStatusBackend) SwitchProfile(keyUID string) error {
+	switched, err := b.switchProfile(keyUID)
+	if err != nil || !switched || b.localPairing {
+		return err
+	}
+	return b.LoggedIn(keyUID, nil)
+}
+
+func (b *GethStatusBackend) switchProfile(keyUID string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	p, exists := b.profiles[keyUID]
+	if !exists {
+		return false, ErrProfileNotInitialized
+	}
+	if b.account != nil && b.account.KeyUID == keyUID {
+		return false, nil
+	}
+
+	previous := b.activeProfile()
+	if err := b.detachActiveProfile(); err != nil {
+		return false, err
+	}
+
+	err := b.startProfile(p)
+	if err == nil {
+		return true, nil
+	}
+	if previous == nil {
+		// Stop node for clean up
+		_ = b.stopNode()
+		return false, err
+	}
+	if detachErr := b.detachActiveProfile(); detachErr != nil {
+		b.log.Error("failed to detach profile", "keyUID", keyUID, "error", detachErr)
+		return false, err
+	}
+	if restoreErr := b.startProfile(previous); restoreErr != nil {
+		b.log.Error("failed to restore profile", "keyUID", previous.account.KeyUID, "error", restoreErr)
+		_ = b.stopNode()
+	}
+	return false, err
+}
+
+// activeProfile returns the logged in multiaccount, or nil if there's none
+func (b *GethStatusBackend) activeProfile() *profile {
+	if b.account == nil || b.appDB == nil {
+		return nil
+	}
+	chatAccount, err := b.accountManager.SelectedChatAccount()
+	if err != nil {
+		return nil
+	}
+	return &profile{account: *b.account, appDB: b.appDB, chatKey: chatAccount.AccountKey.PrivateKey}
+}
+
+// detachActiveProfile stops the node of the active profile without closing
+// its database
+func (b *GethStatusBackend) detachActiveProfile() error {
+	if b.appDB == nil {
+		return nil
+	}
+	if err := b.cleanupServices(); err != nil {
+		return err
+	}
+
+	b.AccountManager().Logout()
+	b.appDB = nil
+	b.account = nil
+
+	if b.statusNode != nil && b.statusNode.IsRunning() {
+		if err := b.statusNode.Stop(); err != nil {
+			return err
+		}
+	}
+	b.statusNode = nil
+	b.initialize()
+	return nil
+}
+
+func (b *GethStatusBackend) startProfile(p *profile) error {
+	b.appDB = p.appDB
+	b.statusNode.SetAppDB(p.appDB)
+
+	err := b.readNodeConfig(nil)
+	if err != nil {
+		return err
+	}
+
+	err = b.setupLogSettings()
+	if err != nil {
+		return err
+	}
+
+	accountsDB, err := accounts.NewDB(b.appDB)
+	if err != nil {
+		return err
+	}
+	acc := p.account
+	b.account = &acc
+
+	walletAddr, err := accountsDB.GetWalletAddress()
+	if err != nil {
+		return err
+	}
+	watchAddrs, err := accountsDB.GetWalletAddresses()
+	if err != nil {
+		return err
+	}
+
+	err = b.startNode(b.config)
+	if err != nil {
+		signal.SendNodeCrashed(err)
+		return err
+	}
+	if err := b.accountManager.SetChatAccount(p.chatKey); err != nil {
+		return err
+	}
+	b.accountManager.SetAccountAddresses(walletAddr, watchAddrs...)
+	err = b.injectAccountsIntoServices()
+	if err != nil {
+		return err
+	}
+	return b.multiaccountsDB.UpdateAccountTimestamp(acc.KeyUID, time.Now().Unix())
+}
+
+// LogoutProfile logs out the active profile, or closes the database of an
+// inactive one
+func (b *GethStatusBackend) LogoutProfile(keyUID string) error {
+	b.mu.Lock()
+	p, exists := b.profiles[keyUID]
+	active := b.account != nil && b.account.KeyUID == keyUID
+	if exists && !active {
+		delete(b.profiles, keyUID)
+	}
+	b.mu.Unlock()
+
+	if active {
+		return b.Logout()
+	}
+	if !exists {
+		return ErrProfileNotInitialized
+	}
+	return p.appDB.Close()
+}
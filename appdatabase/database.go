@@ -77,6 +77,12 @@ func ExportDB(path string, password string, kdfIterationsNumber int, newDbPAth s
 	return sqlite.ExportDB(path, password, kdfIterationsNumber, newDbPAth, newPassword, onStart, onEnd)
 }
 
+// RekeyDB creates a copy of the database encrypted with the new password and
+// KDF iterations number
+func RekeyDB(path string, password string, kdfIterationsNumber int, newDbPath string, newPassword string, newKDFIterationsNumber int, onStart func(), onEnd func()) error {
+	return sqlite.RekeyDB(path, password, kdfIterationsNumber, newDbPath, newPassword, newKDFIterationsNumber, onStart, onEnd)
+}
+
 func ChangeDatabasePassword(path string, password string, kdfIterationsNumber int, newPassword string, onStart func(), onEnd func()) error {
 	return sqlite.ChangeEncryptionKey(path, password, kdfIterationsNumber, newPassword, onStart, onEnd)
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -46,6 +47,35 @@ func Test_GetDBFilename(t *testing.T) {
 	require.Equal(t, "", fn)
 }
 
+func TestRekeyDB(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test-v4.db")
+	newPath := filepath.Join(dir, "rekeyed-v4.db")
+
+	db, err := InitializeDB(path, "password", sqlite.ReducedKDFIterationsNumber)
+	require.NoError(t, err)
+	_, err = db.Exec(`CREATE TABLE rekey_test (value TEXT)`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO rekey_test VALUES ('kept')`)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	newKDFIterations := sqlite.ReducedKDFIterationsNumber * 2
+	require.NoError(t, RekeyDB(path, "password", sqlite.ReducedKDFIterationsNumber, newPath, "new-password", newKDFIterations, nil, nil))
+
+	_, err = InitializeDB(newPath, "password", sqlite.ReducedKDFIterationsNumber)
+	require.Error(t, err)
+
+	db, err = InitializeDB(newPath, "new-password", newKDFIterations)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	var value string
+	require.NoError(t, db.QueryRow(`SELECT value FROM rekey_test`).Scan(&value))
+	require.Equal(t, "kept", value)
+}
+
 const (
 	erc20ReceiptTestDataTemplate = `{"type":"0x2","root":"0x","status":"0x%d","cumulativeGasUsed":"0x10f8d2c","logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000001008000000000000000000000000000000000000002000000000020000000000000000000800000000000000000000000010000000080000000000000000000000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000800000000000000000000","logs":[{"address":"0x98339d8c260052b7ad81c28c16c0b98420f2b46a","topics":["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef","0x0000000000000000000000000000000000000000000000000000000000000000","0x000000000000000000000000e2d622c817878da5143bbe06866ca8e35273ba8a"],"data":"0x0000000000000000000000000000000000000000000000000000000000989680","blockNumber":"0x825527","transactionHash":"0xdcaa0fc7fe2e0d1f1343d1f36807344bb4fd26cda62ad8f9d8700e2c458cc79a","transactionIndex":"0x6c","blockHash":"0x69e0f829a557052c134cd7e21c220507d91bc35c316d3c47217e9bd362270274","logIndex":"0xcd","removed":false}],"transactionHash":"0xdcaa0fc7fe2e0d1f1343d1f36807344bb4fd26cda62ad8f9d8700e2c458cc79a","contractAddress":"0x0000000000000000000000000000000000000000","gasUsed":"0x8623","blockHash":"0x69e0f829a557052c134cd7e21c220507d91bc35c316d3c47217e9bd362270274","blockNumber":"0x825527","transactionIndex":"0x6c"}`
 	erc20TxTestData              = `{"type":"0x2","nonce":"0x3d","gasPrice":"0x0","maxPriorityFeePerGas":"0x8c347c90","maxFeePerGas":"0x45964d43a4","gas":"0x8623","value":"0x0","input":"0x40c10f19000000000000000000000000e2d622c817878da5143bbe06866ca8e35273ba8a0000000000000000000000000000000000000000000000000000000000989680","v":"0x0","r":"0xbcac4bb290d48b467bb18ac67e98050b5f316d2c66b2f75dcc1d63a45c905d21","s":"0x10c15517ea9cabd7fe134b270daabf5d2e8335e935d3e021f54a4efaffb37cd2","to":"0x98339d8c260052b7ad81c28c16c0b98420f2b46a","chainId":"0x5","accessList":[],"hash":"0xdcaa0fc7fe2e0d1f1343d1f36807344bb4fd26cda62ad8f9d8700e2c458cc79a"}`
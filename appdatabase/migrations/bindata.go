@@ -76,6 +76,37 @@
 // 1687269871_add_device_name.up.sql (108B)
 // 1687506642_include_watch_only_account_setting.up.sql (81B)
 // 1688022264_add_include_watch_only_account_to_settings_sync_clock.up.sql (98B)
+// 1688114431_add_backup_interval_to_settings.up.sql (72B)
+// 1688160000_add_edit_history_limit_to_settings.up.sql (75B)
+// 1688180000_add_link_previews_filters_to_settings.up.sql (198B)
+// 1688210002_add_latency_telemetry_enabled_to_settings.up.sql (90B)
+// 1688210003_add_activity_timeline.up.sql (916B)
+// 1688210004_add_token_approvals.up.sql (611B)
+// 1688210005_add_ens_primary_names.up.sql (224B)
+// 1688210006_add_sticker_packs_index.up.sql (290B)
+// 1688210007_add_dapp_permission_grants.up.sql (150B)
+// 1688210008_add_erc4337_user_operations.up.sql (515B)
+// 1688210009_add_hardware_wallet_accounts.up.sql (160B)
+// 1688210010_add_privacy_mode_to_settings.up.sql (161B)
+// 1688210011_add_push_notifications_collapse_to_settings.up.sql (92B)
+// 1688210012_add_collectibles_ownership_cache.up.sql (904B)
+// 1688210013_add_token_spam.up.sql (757B)
+// 1688210014_add_network_custom_rpc_urls.up.sql (376B)
+// 1688210015_add_log_fetch_checkpoints.up.sql (376B)
+// 1688210016_add_transfers_checked_heads.up.sql (457B)
+// 1688210017_add_tracked_transactions.up.sql (706B)
+// 1688210018_add_attachment_auto_download_limits.up.sql (207B)
+// 1688210019_add_archive_transports_to_torrent_config.up.sql (264B)
+// 1688210020_add_outgoing_secrets_filter_enabled.up.sql (95B)
+// 1688210021_add_notification_keywords_to_settings.up.sql (173B)
+// 1688210022_add_contact_requests_throttling_enabled.up.sql (100B)
+// 1688210023_add_community_blocklists_enabled.up.sql (192B)
+// 1688210024_add_gif_search_cache.up.sql (146B)
+// 1688210025_add_video_auto_download.up.sql (181B)
+// 1688210026_add_wallet_accounts_position_change_clock.up.sql (98B)
+// 1688210027_add_test_networks_enabled_sync_clock.up.sql (93B)
+// 1688210028_add_tokens_sync_clock.up.sql (130B)
+// 1688210029_add_token_lists.up.sql (312B)
 // doc.go (74B)
 
 package migrations
@@ -1664,6 +1695,626 @@ func _1688022264_add_include_watch_only_account_to_settings_sync_clockUpSql() (*
 	return a, nil
 }
 
+var __1688114431_add_backup_interval_to_settingsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4e\x2d\x29\xc9\xcc\x4b\x2f\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\x4a\x4c\xce\x2e\x2d\x88\xcf\xcc\x2b\x49\x2d\x2a\x4b\xcc\x51\xf0\xf4\x0b\x51\xf0\xf3\x07\xe2\x50\x1f\x1f\x05\x17\x57\x37\xc7\x50\x9f\x10\x05\x03\x6b\x2e\x00\x70\xfc\x07\x5d\x48\x00\x00\x00")
+
+func _1688114431_add_backup_interval_to_settingsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688114431_add_backup_interval_to_settingsUpSql,
+		"1688114431_add_backup_interval_to_settings.up.sql",
+	)
+}
+
+func _1688114431_add_backup_interval_to_settingsUpSql() (*asset, error) {
+	bytes, err := _1688114431_add_backup_interval_to_settingsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688114431_add_backup_interval_to_settings.up.sql", size: 72, mode: os.FileMode(0644), modTime: time.Unix(1792107065, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x79, 0x7e, 0x53, 0xa9, 0xe, 0x2b, 0x78, 0xf1, 0xa9, 0x8e, 0x88, 0xb8, 0xa7, 0x4c, 0x1d, 0xc5, 0x4f, 0xe8, 0x52, 0x5e, 0xc6, 0xbf, 0xca, 0x4d, 0x6e, 0x6c, 0xdc, 0xd5, 0x39, 0x3f, 0x82, 0x59}}
+	return a, nil
+}
+
+var __1688160000_add_edit_history_limit_to_settingsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4e\x2d\x29\xc9\xcc\x4b\x2f\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\x4d\xc9\x2c\x89\xcf\xc8\x2c\x2e\xc9\x2f\xaa\x8c\xcf\xc9\xcc\xcd\x2c\x51\xf0\xf4\x0b\x51\xf0\xf3\x07\xe2\x50\x1f\x1f\x05\x17\x57\x37\xc7\x50\x9f\x10\x05\x03\x6b\x2e\x00\xb9\x18\xf8\x0e\x4b\x00\x00\x00")
+
+func _1688160000_add_edit_history_limit_to_settingsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688160000_add_edit_history_limit_to_settingsUpSql,
+		"1688160000_add_edit_history_limit_to_settings.up.sql",
+	)
+}
+
+func _1688160000_add_edit_history_limit_to_settingsUpSql() (*asset, error) {
+	bytes, err := _1688160000_add_edit_history_limit_to_settingsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688160000_add_edit_history_limit_to_settings.up.sql", size: 75, mode: os.FileMode(0644), modTime: time.Unix(1792112157, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x12, 0xfb, 0xce, 0xb5, 0xf6, 0x5a, 0x25, 0x63, 0x9c, 0xd8, 0x9, 0x6c, 0xf1, 0xc5, 0x4c, 0x3b, 0x28, 0x99, 0x70, 0xd4, 0xc0, 0xd2, 0xc9, 0x81, 0xf, 0x6d, 0x30, 0x5, 0xd0, 0xfb, 0x8e, 0x28}}
+	return a, nil
+}
+
+var __1688180000_add_link_previews_filters_to_settingsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4e\x2d\x29\xc9\xcc\x4b\x2f\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\xc8\xc9\xcc\xcb\x8e\x2f\x28\x4a\x2d\xcb\x4c\x2d\x2f\x8e\xcf\xcd\x4f\x49\x55\xf0\xf4\x0b\x51\xf0\xf3\x07\xe2\x50\x1f\x1f\x05\x17\x57\x37\xc7\x50\x9f\x10\x05\x03\x6b\x2e\x47\x92\x8c\x4a\xcc\xc9\xc9\x2f\xcf\xc9\x2c\x2e\x51\x70\xf2\xf1\x77\x22\x55\x77\x4a\x6a\x5e\x25\x92\x66\x00\xf2\xca\x52\x67\xc6\x00\x00\x00")
+
+func _1688180000_add_link_previews_filters_to_settingsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688180000_add_link_previews_filters_to_settingsUpSql,
+		"1688180000_add_link_previews_filters_to_settings.up.sql",
+	)
+}
+
+func _1688180000_add_link_previews_filters_to_settingsUpSql() (*asset, error) {
+	bytes, err := _1688180000_add_link_previews_filters_to_settingsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688180000_add_link_previews_filters_to_settings.up.sql", size: 198, mode: os.FileMode(0644), modTime: time.Unix(1792112600, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x57, 0x99, 0xda, 0x6c, 0x6d, 0x5a, 0xcb, 0x52, 0x5c, 0x44, 0xe5, 0xb4, 0x51, 0x68, 0xc0, 0xad, 0x7d, 0x6c, 0xfd, 0x50, 0x18, 0x9, 0xa2, 0x5e, 0xc7, 0x9f, 0xcf, 0x75, 0x3c, 0xe2, 0xa1, 0x98}}
+	return a, nil
+}
+
+var __1688210002_add_latency_telemetry_enabled_to_settingsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x05\xc1\x3d\x0e\x80\x20\x0c\x06\xd0\xdd\x53\x7c\xf7\x70\x2a\x52\xa7\x0a\x89\xc2\x6c\xfc\x69\x8c\x09\x32\x48\x17\x6f\xef\x7b\x24\x89\x67\x24\x72\xc2\x68\x6a\x76\xd7\xab\x81\xbc\xc7\x10\x25\x4f\x01\x65\x33\xad\xc7\xb7\x9a\x16\x7d\xd4\xde\x6f\xd5\xba\xed\x45\x4f\xb8\x18\x85\x29\x20\xc4\x84\x90\x45\xe0\x79\xa4\x2c\x09\x23\xc9\xc2\x7d\xf7\x03\xc3\xa2\xe5\xfd\x5a\x00\x00\x00")
+
+func _1688210002_add_latency_telemetry_enabled_to_settingsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210002_add_latency_telemetry_enabled_to_settingsUpSql,
+		"1688210002_add_latency_telemetry_enabled_to_settings.up.sql",
+	)
+}
+
+func _1688210002_add_latency_telemetry_enabled_to_settingsUpSql() (*asset, error) {
+	bytes, err := _1688210002_add_latency_telemetry_enabled_to_settingsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210002_add_latency_telemetry_enabled_to_settings.up.sql", size: 90, mode: os.FileMode(0644), modTime: time.Unix(1792122568, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6b, 0x38, 0xb8, 0x7, 0xfa, 0x86, 0xaa, 0x8d, 0xd5, 0xd2, 0x22, 0x6b, 0xd, 0xff, 0x62, 0x2e, 0x38, 0x41, 0x2b, 0xca, 0xc5, 0xc5, 0x62, 0x36, 0xd1, 0x6f, 0x56, 0x8b, 0xf, 0xe0, 0x30, 0x69}}
+	return a, nil
+}
+
+var __1688210003_add_activity_timelineUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x52\xcb\x4e\xc3\x30\x10\xbc\xe7\x2b\xf6\xd8\x4a\x8d\x04\x67\xc4\xa1\x0f\x53\xac\x86\x04\xa5\xa9\x80\x93\x65\x12\xd3\x58\x24\x76\x64\x9b\x47\xff\x9e\xad\x4d\xdb\x28\x54\x20\x91\x5b\xd6\xb3\xb3\xb3\xb3\x13\xc7\xc0\x4b\x27\xdf\xa5\xdb\x31\x27\x5b\xd1\x48\x25\xa0\x15\x66\x2b\x2c\xb8\x5a\x80\x33\x5c\xd9\x17\x61\xec\x04\x9c\x7e\x15\x0a\x78\xd7\x19\xfd\xce\x1b\x2c\x94\xba\x69\x04\x36\x3f\x37\xd8\x22\x95\xb3\x51\x8c\x6c\xaa\x82\xf6\xad\x71\x32\xf6\xad\x7b\x72\xad\x2c\xe8\x17\x10\xbc\xac\x71\x58\xa9\xdf\x94\x03\x84\x6b\xe0\x60\xa5\xda\x62\xf7\x61\x72\x34\xcf\xc9\xb4\x20\x50\x4c\x67\x09\x01\x7a\x03\x69\x56\x00\x79\xa4\xeb\x62\x7d\x46\xe6\x28\x02\xfc\x0e\x8c\xb3\x24\x9b\x79\x7c\xba\x49\x92\x89\x7f\x52\xc2\x7d\x68\xf3\xca\x64\x05\x9b\x74\x4d\x97\x29\x59\xc0\x8c\x2e\x69\x5a\x0c\x80\x35\xb7\xf5\x39\x02\xb7\xeb\x04\x20\x9c\x2c\x49\x3e\x7c\x42\x15\xd6\xf1\xb6\xfb\x83\xda\xbb\xc6\x7e\x23\xf2\x00\x5e\x55\x46\x58\xeb\x45\xf4\xeb\x28\xfd\x54\xe2\xed\x71\xd3\x50\xf0\x9b\x0b\xd3\x71\xe3\x76\xbd\xb2\xf7\x9f\xf5\xfc\xdf\xd3\x0c\xa7\xc3\x82\xdc\x4c\x37\x49\x01\x17\xa1\xe9\x3e\xa7\x77\xd3\xfc\x09\x56\xe4\x09\x46\xdf\xa6\x4e\x7a\x16\x4e\xbc\x4b\xe3\x68\x0c\x0f\xb4\xb8\xcd\x36\x05\xe4\xd9\x03\x5d\x5c\x45\x87\xa3\xd1\x74\x41\x1e\x07\x47\x93\xd5\x27\xfb\x71\x38\xf6\xcd\xce\x4e\x1e\x66\xe9\xb9\xfb\x1e\x55\x1c\x81\x63\x1c\x17\x9f\x89\x2c\xb3\x3b\x55\x42\xad\x9b\x2a\xc4\xd6\xe8\x0f\x5c\x19\x43\xb7\xff\x69\xb8\x75\xc7\x20\x87\x70\x57\x18\xc0\x90\xef\xff\x24\x2f\x4c\x0b\xf1\xeb\x39\xdb\x77\x70\x7e\x4b\xe6\x2b\x18\xe1\xeb\x35\x5c\x8e\x83\xc3\x7b\x1d\xec\xa0\x83\x05\x89\xc3\xab\x44\xb8\xe1\x17\x2d\x8e\xbb\xf9\x94\x03\x00\x00")
+
+func _1688210003_add_activity_timelineUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210003_add_activity_timelineUpSql,
+		"1688210003_add_activity_timeline.up.sql",
+	)
+}
+
+func _1688210003_add_activity_timelineUpSql() (*asset, error) {
+	bytes, err := _1688210003_add_activity_timelineUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210003_add_activity_timeline.up.sql", size: 916, mode: os.FileMode(0644), modTime: time.Unix(1792136593, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xde, 0xe, 0x8d, 0x28, 0x90, 0xf7, 0x60, 0x9b, 0xf, 0x1a, 0x3a, 0xde, 0xa1, 0xbc, 0x3f, 0x17, 0xc0, 0xf9, 0x32, 0xb3, 0x25, 0x9a, 0xb8, 0xc6, 0xf0, 0x5d, 0x2e, 0xeb, 0xab, 0x25, 0x7b, 0x16}}
+	return a, nil
+}
+
+var __1688210004_add_token_approvalsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x90\xcd\x6e\xc2\x30\x10\x84\xef\x79\x8a\xbd\x01\x92\x53\xb5\x5c\x7a\xe8\x29\x01\x43\xad\xa6\x49\x15\x1c\x51\x4e\xc8\x24\xa6\x89\x08\x76\x14\x9b\xbf\xb7\xaf\xe3\x04\x2a\x28\xc2\x47\xcf\xec\xb7\x33\xeb\xba\xa0\xe5\x86\x8b\x25\xab\xaa\x5a\xee\x59\xa9\x20\x97\x65\xa6\x40\xe7\x1c\x58\xaa\x8b\x3d\x07\x1c\x8f\x86\xcf\xc0\xca\x52\x1e\x98\x48\xb9\x42\xcd\xcf\xeb\xf0\xa5\x9d\x84\xcb\xa4\xe3\xba\xc0\x44\x06\xb2\xe2\x35\xd3\xb2\xfe\x53\x40\xae\x2d\xf0\x60\x20\x5c\x1b\x6e\x2a\x77\x42\xab\xa7\x6e\x77\x91\x41\xa1\x80\x6f\x2b\x7d\x82\x9d\x28\xb9\xb2\x28\x9b\xa0\x23\x34\xfa\xba\x21\x82\x2a\xc4\x4f\xc9\xaf\x12\x38\xa3\x18\x7b\x14\x03\xf5\xfc\x00\x03\x99\x40\x18\x51\xc0\xdf\x64\x46\x67\xff\xca\xf5\x1d\x30\x2f\xcd\x59\x61\xd7\x26\xe1\x8c\x4c\x43\x3c\x06\x9f\x4c\x49\x48\xed\x64\x98\x04\x01\xb2\x36\x79\x10\xbc\x06\x3f\x88\xfc\x1b\xa1\xa3\x66\x59\x6d\xb2\xde\x35\x9c\x2a\x0e\x06\x88\xa7\x38\xbe\x91\x54\xc5\x45\xf6\x08\x6b\x62\x5d\x69\x30\xc6\x13\x2f\x09\x28\x1c\x7b\xbd\xd6\xc7\xb6\xcd\xf9\xac\xab\xfd\x58\x95\x32\xdd\x2c\xc5\x6e\xbb\x32\xe0\xc7\x9d\xf4\x71\x99\x33\x95\xdf\x5b\xff\x15\x93\x4f\x2f\x5e\xc0\x07\x5e\x40\xff\x7c\x22\xd4\x5e\x01\x5d\x77\x46\xb6\x21\x3a\x97\x41\x97\xe8\x03\x67\x00\x73\x42\xdf\xa3\x84\x42\x1c\xcd\xc9\xf8\xcd\xf9\x05\xcc\x8b\x1e\x98\x63\x02\x00\x00")
+
+func _1688210004_add_token_approvalsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210004_add_token_approvalsUpSql,
+		"1688210004_add_token_approvals.up.sql",
+	)
+}
+
+func _1688210004_add_token_approvalsUpSql() (*asset, error) {
+	bytes, err := _1688210004_add_token_approvalsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210004_add_token_approvals.up.sql", size: 611, mode: os.FileMode(0644), modTime: time.Unix(1792164366, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x12, 0xc6, 0x6a, 0x6f, 0xec, 0x8f, 0x2d, 0xe8, 0x74, 0x3c, 0x3c, 0x8a, 0xc8, 0xdb, 0x94, 0x6c, 0x43, 0xb4, 0x96, 0x6b, 0x2d, 0xe7, 0x91, 0x26, 0xdb, 0x4a, 0x29, 0x43, 0x2e, 0xa1, 0x16, 0x2f}}
+	return a, nil
+}
+
+var __1688210005_add_ens_primary_namesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5d\x8e\xc1\x0a\x82\x40\x18\x84\xef\x3e\xc5\xe0\x49\xc1\x37\xe8\xb4\xea\xaa\x4b\xdb\x1a\xeb\x6f\xea\x49\x96\x14\x12\xd2\xc2\x8d\xa0\xb7\x4f\x03\x89\x3a\xcf\x7c\xdf\x4c\xa4\x39\x23\x0e\x62\xa1\xe4\x10\x09\x54\x4e\xe0\xb5\x28\xa8\x40\x3f\xd9\xf6\x3e\x0f\xa3\x99\x5f\xed\x64\xc6\xde\xc2\x73\x80\xf3\xc5\x0c\x53\x3b\x74\x28\x55\x21\x52\xc5\x63\x84\x22\x15\x8a\x3e\xa4\x2a\xa5\x0c\x96\x92\xe9\xba\xb9\xb7\x16\x27\xa6\xa3\x8c\xe9\x9f\x6c\x55\x81\x78\xfd\x25\x10\xf3\x84\x95\x92\xe0\xba\x6b\x61\x21\x6f\xd7\x67\xdf\xb5\xe6\x81\x7f\xf1\x51\x8b\x03\xd3\x0d\xf6\xbc\x81\xb7\x5d\x09\xb6\x3d\xdf\xf1\x51\x09\xca\xf2\x92\xa0\xf3\x4a\xc4\x3b\xe7\x0d\x77\xe4\x9f\xde\xe0\x00\x00\x00")
+
+func _1688210005_add_ens_primary_namesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210005_add_ens_primary_namesUpSql,
+		"1688210005_add_ens_primary_names.up.sql",
+	)
+}
+
+func _1688210005_add_ens_primary_namesUpSql() (*asset, error) {
+	bytes, err := _1688210005_add_ens_primary_namesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210005_add_ens_primary_names.up.sql", size: 224, mode: os.FileMode(0644), modTime: time.Unix(1792137678, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6f, 0x8e, 0x27, 0xa6, 0xe7, 0x40, 0x31, 0xff, 0x34, 0xb3, 0x1e, 0x8f, 0x6e, 0xb0, 0x58, 0xb4, 0x5b, 0x3, 0xba, 0x79, 0xbe, 0x5b, 0x35, 0xd4, 0x4d, 0x5e, 0xb1, 0x6, 0x2, 0xcb, 0xff, 0x2c}}
+	return a, nil
+}
+
+var __1688210006_add_sticker_packs_indexUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x8f\xcb\x0a\x82\x40\x18\x85\xf7\x3e\xc5\xc1\x95\x82\x6f\xd0\xca\xc9\xd1\x86\xa6\x99\xd0\x5f\xd4\x95\x0c\x2a\x28\x92\x85\x1a\xf4\xf8\xa5\x20\x51\x8b\xda\x1e\xbe\x73\xdb\xc7\xdc\x27\x0e\xf2\x99\xe4\x10\x21\x94\x26\xf0\x5c\x24\x94\x60\x9a\xbb\xaa\x6f\xc6\xf2\x66\xaa\x7e\x2a\xbb\xa1\x6e\x1e\x70\x2c\xa0\x6a\x4d\x37\x94\x5d\x8d\x54\x25\x22\x52\x3c\x00\x13\x91\x50\xb4\x7a\x55\x2a\xa5\xf7\x82\x16\xd3\x3f\x66\x30\x97\x06\xc4\xf3\xb7\x8a\x80\x87\x7e\x2a\x09\xb6\xbd\x00\xe6\x3e\xb7\xd7\xf1\x27\xb2\xf4\x80\x49\xcd\x3e\x92\xd7\xb1\x4d\x5d\x9a\x19\xdf\xa5\xe7\x58\x9c\xfc\xb8\xc0\x91\x17\x70\xb6\x2b\xde\xb6\xd7\xb5\x5c\x64\x82\x0e\x3a\x25\xc4\x3a\x13\xc1\xce\x7a\x02\x80\x97\xef\xe1\x22\x01\x00\x00")
+
+func _1688210006_add_sticker_packs_indexUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210006_add_sticker_packs_indexUpSql,
+		"1688210006_add_sticker_packs_index.up.sql",
+	)
+}
+
+func _1688210006_add_sticker_packs_indexUpSql() (*asset, error) {
+	bytes, err := _1688210006_add_sticker_packs_indexUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210006_add_sticker_packs_index.up.sql", size: 290, mode: os.FileMode(0644), modTime: time.Unix(1792137918, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4, 0xa9, 0x1a, 0xa, 0xaf, 0xcb, 0x9e, 0x9a, 0x55, 0x67, 0x12, 0x61, 0x62, 0xb6, 0x9b, 0x8f, 0x86, 0x76, 0x6a, 0xe8, 0xff, 0x1a, 0x41, 0x35, 0xdd, 0xc6, 0x3, 0xaf, 0xde, 0x3e, 0x3b, 0x79}}
+	return a, nil
+}
+
+var __1688210007_add_dapp_permission_grantsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x48\x2d\xca\xcd\x2c\x2e\xce\xcc\xcf\x2b\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\xce\x48\xcc\xcc\x8b\xcf\x4c\x51\x08\xf5\x0b\xf6\x74\xf7\x73\x75\x51\x70\xf2\x74\xf7\xf4\x0b\x51\xf0\xf3\x07\xe2\x50\x1f\x1f\x05\x17\x57\x37\xc7\x50\x9f\x10\x05\x03\x6b\x2e\x47\xc2\xe6\xa5\x56\x14\x64\x16\xa5\x16\xc7\x27\x96\x28\xe0\x32\x05\x00\x3b\x05\x71\x56\x96\x00\x00\x00")
+
+func _1688210007_add_dapp_permission_grantsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210007_add_dapp_permission_grantsUpSql,
+		"1688210007_add_dapp_permission_grants.up.sql",
+	)
+}
+
+func _1688210007_add_dapp_permission_grantsUpSql() (*asset, error) {
+	bytes, err := _1688210007_add_dapp_permission_grantsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210007_add_dapp_permission_grants.up.sql", size: 150, mode: os.FileMode(0644), modTime: time.Unix(1792138017, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbb, 0x23, 0xc4, 0xdd, 0x71, 0xda, 0x45, 0x70, 0xcf, 0xf4, 0x3e, 0xa6, 0xd0, 0x3, 0xaf, 0x4c, 0xfe, 0xa, 0x6f, 0xa0, 0xb0, 0xe6, 0x6a, 0x5b, 0xbb, 0x3b, 0xb6, 0x87, 0x52, 0x83, 0xb0, 0xcf}}
+	return a, nil
+}
+
+var __1688210008_add_erc4337_user_operationsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x91\xc1\x8e\x82\x30\x14\x45\xf7\x7c\xc5\x8b\x2b\x49\xdc\x69\x32\x0b\x57\x05\x8a\x36\x53\xdb\x49\x29\x23\xae\x9a\x86\x36\x81\x0d\x90\xb6\x44\x3f\x7f\x50\x67\x4c\xc6\xc0\xfa\x9c\x97\xdc\x77\x6f\x2a\x30\x92\x18\x24\x4a\x28\x06\x92\x03\xe3\x12\x70\x45\x0a\x59\x80\x75\xf5\x6e\xbb\xfd\x50\xa3\xb7\x4e\xf5\x83\x75\x3a\xb4\x7d\xe7\x61\x1d\x01\x34\xda\x37\xf0\x8d\x44\x7a\x44\xe2\x71\xc3\x4a\x4a\x37\x13\xa8\x1b\xdd\x76\xaa\x35\x50\xb2\x82\x1c\x18\xce\x20\x21\x07\xc2\xe4\x3f\xc9\xdb\xce\x58\x37\x7b\xdf\x5f\xbb\x05\xe2\x83\x0e\xa3\x9f\x47\xc3\x94\xab\x77\xd6\x40\xc2\x39\xc5\x88\xbd\x28\x64\x38\x47\x25\x95\x90\x23\x5a\xe0\xbb\x1b\x6e\x6a\x36\xfc\xcb\x5c\xad\xee\x9a\xb3\xda\xf7\x1d\x48\x5c\xc9\x25\xa5\x9e\x9c\x60\x8d\xd2\x01\xde\x1f\x1c\x07\xb3\x84\xbe\x04\x39\x21\x71\x81\x4f\x7c\x81\xf5\x5f\x5b\x9b\x47\xa1\x71\x14\xc3\x99\xc8\x23\x2f\x25\x08\x7e\x26\xd9\x3e\x8a\xd2\xe7\x3e\x84\x65\xb8\x7a\xdb\xa7\x35\x37\xb5\xb0\x91\xfa\x2d\x8b\xb3\xe5\x15\x9f\x4a\xbc\x8f\x7e\x00\x61\xd1\x21\x0b\x03\x02\x00\x00")
+
+func _1688210008_add_erc4337_user_operationsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210008_add_erc4337_user_operationsUpSql,
+		"1688210008_add_erc4337_user_operations.up.sql",
+	)
+}
+
+func _1688210008_add_erc4337_user_operationsUpSql() (*asset, error) {
+	bytes, err := _1688210008_add_erc4337_user_operationsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210008_add_erc4337_user_operations.up.sql", size: 515, mode: os.FileMode(0644), modTime: time.Unix(1792138428, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1a, 0x4a, 0x53, 0x3a, 0xaa, 0xab, 0x3, 0xfc, 0x3b, 0xa0, 0x16, 0x9e, 0x74, 0xfb, 0x6d, 0x6c, 0xa3, 0x77, 0x34, 0x2b, 0xa0, 0x3f, 0xfb, 0xc0, 0xcf, 0xa7, 0x2b, 0x8d, 0x52, 0x2f, 0x1c, 0xa9}}
+	return a, nil
+}
+
+var __1688210009_add_hardware_wallet_accountsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x65\x8c\xbb\x0a\xc2\x30\x14\x86\xf7\x3c\xc5\x3f\x2a\xf4\x0d\x9c\x62\x8d\x34\x98\x26\x92\x9e\x5a\x3b\x85\x43\x13\xe8\x50\x54\xda\x48\x5f\x5f\x51\x70\x71\xfe\x2e\xa5\x57\x92\x14\x48\xee\x8d\x82\x3e\xc2\x3a\x82\xba\xea\x86\x1a\x8c\x3c\xc7\x95\xe7\x14\x56\x9e\xa6\x94\x03\x0f\xc3\xfd\x79\xcb\x0b\x36\x02\xe0\x18\xe7\xb4\x2c\xb8\x48\x5f\x56\xd2\x7f\x3a\xdb\x1a\x83\xb3\xd7\xb5\xf4\x3d\x4e\xaa\x2f\xde\xde\x83\xf3\xf8\x27\x15\xdf\x41\x8a\x81\x33\xb4\xa5\x1f\x10\x5b\x74\x9a\x2a\xd7\x12\xbc\xeb\xf4\x61\x27\x5e\x6b\x60\xea\xf5\xa0\x00\x00\x00")
+
+func _1688210009_add_hardware_wallet_accountsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210009_add_hardware_wallet_accountsUpSql,
+		"1688210009_add_hardware_wallet_accounts.up.sql",
+	)
+}
+
+func _1688210009_add_hardware_wallet_accountsUpSql() (*asset, error) {
+	bytes, err := _1688210009_add_hardware_wallet_accountsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210009_add_hardware_wallet_accounts.up.sql", size: 160, mode: os.FileMode(0644), modTime: time.Unix(1792138753, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8c, 0x7b, 0x22, 0xd2, 0xf, 0xa6, 0x1a, 0xc6, 0x4f, 0x0, 0x8, 0x5f, 0x39, 0xf2, 0x29, 0xb3, 0x84, 0x91, 0xd7, 0xcd, 0xfd, 0xf, 0x30, 0xbc, 0x85, 0xe2, 0xc3, 0x40, 0xdc, 0x65, 0xfd, 0x21}}
+	return a, nil
+}
+
+var __1688210010_add_privacy_mode_to_settingsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\xcc\xc1\x0a\x82\x40\x10\x06\xe0\xbb\x4f\xf1\x3f\x42\x77\x4f\x63\x3b\x8a\x30\xcd\x42\xce\x9e\x17\x59\x25\xa4\x52\x69\x45\xf0\xed\xeb\x5e\x3d\xc0\xf7\x91\x18\x5f\x61\x54\x09\x23\x8f\xdb\x36\xcd\xb7\x0c\x72\x0e\x67\x2f\xe1\xa2\x58\x5f\xd3\xde\xa7\x23\x3e\x97\x61\x44\xe5\xbd\x30\x29\xd4\x1b\x34\x88\xc0\x71\x4d\x41\x0c\x35\x49\xc7\x65\x41\x3f\xb2\x98\x8f\x39\xc5\xf4\x58\xd2\xfd\xef\xdb\xaa\x71\xf3\x91\x5f\xef\xa9\x2c\xde\xd4\x00\x01\x26\xa1\x00\x00\x00")
+
+func _1688210010_add_privacy_mode_to_settingsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210010_add_privacy_mode_to_settingsUpSql,
+		"1688210010_add_privacy_mode_to_settings.up.sql",
+	)
+}
+
+func _1688210010_add_privacy_mode_to_settingsUpSql() (*asset, error) {
+	bytes, err := _1688210010_add_privacy_mode_to_settingsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210010_add_privacy_mode_to_settings.up.sql", size: 161, mode: os.FileMode(0644), modTime: time.Unix(1792140315, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x42, 0xf2, 0xa5, 0xe1, 0xcb, 0xa2, 0xbd, 0xd3, 0xcf, 0x1d, 0x7c, 0xe, 0xd1, 0x84, 0xf1, 0xaf, 0x3d, 0x79, 0x9c, 0xcb, 0x60, 0x63, 0xf2, 0x3e, 0x77, 0xe7, 0x78, 0xfa, 0xd6, 0xa2, 0xf8, 0x77}}
+	return a, nil
+}
+
+var __1688210011_add_push_notifications_collapse_to_settingsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x05\xc1\x41\x0a\x80\x20\x10\x05\xd0\x7d\xa7\xf8\xf7\x68\x35\xe5\xb4\x9a\x14\x4a\xd7\x12\x62\x25\x84\x06\x63\xf7\xef\x3d\x12\xcf\x1b\x3c\x4d\xc2\xd0\xdc\x7b\xa9\x97\x82\x8c\xc1\xec\x24\xac\x16\xef\xa7\x77\xac\xad\x97\xb3\xa4\xa3\x97\x56\x35\xa6\xf6\x3c\xc7\xab\x19\x93\x73\xc2\x64\x61\x9d\x87\x0d\x22\x30\xbc\x50\x10\x8f\x85\x64\xe7\x71\xf8\x01\x27\x35\xf8\xb6\x5c\x00\x00\x00")
+
+func _1688210011_add_push_notifications_collapse_to_settingsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210011_add_push_notifications_collapse_to_settingsUpSql,
+		"1688210011_add_push_notifications_collapse_to_settings.up.sql",
+	)
+}
+
+func _1688210011_add_push_notifications_collapse_to_settingsUpSql() (*asset, error) {
+	bytes, err := _1688210011_add_push_notifications_collapse_to_settingsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210011_add_push_notifications_collapse_to_settings.up.sql", size: 92, mode: os.FileMode(0644), modTime: time.Unix(1792141004, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2c, 0x15, 0x80, 0x52, 0x8e, 0x45, 0x32, 0x5a, 0x6a, 0x91, 0x5e, 0xf4, 0xe8, 0xb6, 0x72, 0xf3, 0x58, 0x62, 0x79, 0x4f, 0x1c, 0x9d, 0xb5, 0x6f, 0x56, 0xd6, 0xaa, 0x78, 0xf8, 0x29, 0xbf, 0x68}}
+	return a, nil
+}
+
+var __1688210012_add_collectibles_ownership_cacheUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x92\x31\x4f\xc3\x30\x10\x85\xf7\xfc\x8a\x1b\x5b\x29\x1d\x60\x61\x60\x6a\x5a\x53\x22\x42\x82\xd2\x54\xa5\x53\xe4\xda\x0e\xb6\x6a\xec\x28\x76\x89\xfa\xef\x71\xdc\x16\x50\x48\x2b\x21\xe1\xf5\xde\x3d\xdf\xbd\xef\x26\x13\x20\x5a\x4a\x46\xac\xd8\x4a\x66\x4a\xdd\x2a\xd6\x18\x2e\x6a\xe0\x5a\x52\x03\x96\x33\x40\xf9\xec\xee\xf6\x06\xac\xde\x31\x65\xa0\x53\x50\xd8\x1e\x7c\xa9\xc5\xae\xd7\x02\x26\x44\xef\x95\x35\x61\x30\x99\x00\x36\x50\x31\x4b\xb8\x53\x55\x8d\x7e\xf7\xba\xba\xd1\x1f\x82\x3a\x67\xc0\x8a\xc2\xbe\xa6\xd8\xba\x72\x2b\x2c\xf7\x65\xdb\x60\x65\x2a\xd6\x80\xd4\x6f\x06\x8c\x50\x84\x05\xb3\x1c\x4d\x0b\x04\xc5\x34\x4a\x10\xc4\x0f\x90\x66\x05\xa0\xd7\x78\x59\x2c\x2f\x4d\x3c\x0a\xc0\x3d\xc2\xb1\x50\xa5\xa0\xb0\x4a\x97\xf1\x22\x45\x73\x88\xe2\x45\x9c\x16\xde\x20\x5d\x25\x49\xe8\x65\xbe\x0d\xa2\x24\x8b\x7a\x05\xa2\x95\x1b\x87\xd8\x12\x53\xda\x30\x63\x86\x34\x3e\x8a\xee\x8f\x81\xda\x4b\x1e\x3f\x4f\xf3\x0d\x3c\xa1\x0d\x8c\xce\xc3\x84\xc7\xff\xc2\x5f\xee\xe1\x97\xd7\x38\x18\xc3\x3a\x2e\x1e\xb3\x55\x01\x79\xb6\x8e\xe7\xf7\x41\x97\xe6\xf0\xae\xa5\xb1\x2e\xc1\x1f\x8c\x24\x36\x16\xb6\x52\x93\x1d\xb4\x5c\x9b\x7e\xa4\x2d\x6b\x98\x67\x53\xd7\x52\xb8\xe4\xad\xf6\x5d\xdf\xd9\xe9\x0a\x18\x26\xfc\x4c\x32\xf4\x9c\x5a\xce\x54\x4f\xd7\x3a\xba\xdd\x5f\x9d\xd9\x99\xb2\x50\x50\xed\xa5\x1c\xa0\xfd\x77\x88\xa7\xc5\xfe\x07\x65\x37\x68\x69\x0e\xee\x9a\x68\x79\xcc\xe6\xba\x91\xd7\x77\xab\x94\x0d\xab\x1c\x1d\x5e\x62\x0b\x4e\x86\x16\x28\xef\x49\x4f\x27\x7c\x59\x70\xed\x0c\x06\x50\x7f\x02\x93\x93\xa8\x8e\x88\x03\x00\x00")
+
+func _1688210012_add_collectibles_ownership_cacheUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210012_add_collectibles_ownership_cacheUpSql,
+		"1688210012_add_collectibles_ownership_cache.up.sql",
+	)
+}
+
+func _1688210012_add_collectibles_ownership_cacheUpSql() (*asset, error) {
+	bytes, err := _1688210012_add_collectibles_ownership_cacheUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210012_add_collectibles_ownership_cache.up.sql", size: 904, mode: os.FileMode(0644), modTime: time.Unix(1792142635, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x15, 0x40, 0xc0, 0xb2, 0xf5, 0xf7, 0x4a, 0xe6, 0xdd, 0x58, 0xcf, 0xb8, 0x29, 0x94, 0x39, 0x3c, 0xcb, 0x4a, 0x97, 0x45, 0xaf, 0xa2, 0x8d, 0xe5, 0x67, 0x84, 0xfa, 0x32, 0x52, 0x19, 0xca, 0x98}}
+	return a, nil
+}
+
+var __1688210013_add_token_spamUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x51\xd1\x6e\x82\x30\x14\x7d\xe7\x2b\xee\xa3\x26\x90\x6c\xcf\x7b\x02\xed\xb4\x19\x83\x05\x71\xce\x27\x53\x69\x0d\x0d\x85\x92\xb6\x98\xec\xef\x57\x8a\xe2\x62\xd8\x22\x6f\xdc\x73\x7b\xce\xb9\xe7\x04\x01\x18\x59\xb1\xe6\xa0\x5b\x52\x1f\xe4\x99\x29\xc5\x29\xd3\x50\x4a\x41\x35\x98\x92\x0d\xb0\x06\xd2\x50\x28\xa4\x10\xac\x30\xfc\x28\xd8\x80\x75\x9a\x29\x28\x39\xf5\xbd\x20\x00\xc5\x5a\xa9\x0c\xa3\x40\x34\xf4\x6c\x20\x15\xd4\x44\x55\xc3\xa4\x91\xc6\x4d\x7d\xcb\x04\xac\x6e\xcd\xf7\x45\x98\xf7\xbc\x56\xd7\x4a\x08\xd1\xb3\xf6\x5c\x17\x51\x79\x72\x32\x85\x6c\x8c\x22\x85\xf1\x16\x19\x0a\x73\x04\x79\x18\xc5\x08\xf0\x2b\x24\x69\x0e\xe8\x0b\x6f\xf2\xcd\xf4\x15\x33\x0f\xec\x57\x94\x84\x3b\x9d\x6d\xb2\xc1\xab\x04\x2d\x21\xc2\x2b\x9c\xe4\xee\x79\xb2\x8d\x63\xdf\xad\x11\x4a\x15\xd3\x1a\xa2\x38\x8d\xee\xa0\xd1\xe9\x04\xa6\x0d\x31\x0c\x2c\x1d\x5a\xa1\xec\x0e\xeb\x5a\x6a\x41\x7a\x20\xe6\x8f\x85\x8f\x0c\xbf\x87\xd9\x1e\xde\xd0\x1e\x66\x57\x9f\xfe\xd5\x8a\x3f\x0a\xcf\xbd\x39\xec\x70\xbe\x4e\xb7\x39\x64\xe9\x0e\x2f\x5f\xbc\x31\xa6\xe1\xe8\xa3\x90\x45\x25\xb8\x36\xbf\xaa\x13\xc4\xfe\x9e\x98\x29\x4a\xd6\x87\x5c\xd7\x5d\xc3\x6d\xee\xe3\xaa\x0f\x15\xb7\xb5\x72\x0d\x4f\x3d\xdb\xc9\x16\x76\x8d\x7a\x28\xfc\xd9\xcd\xa8\xac\xad\x31\xfd\x60\xfa\x37\x23\x43\xfa\x4e\x62\xfa\xfa\x07\x8b\x39\x13\xd1\x31\xf8\x0c\xb3\xc5\x3a\xfc\x37\xc0\x5e\xca\x87\x5b\x8c\xee\xe1\x44\x74\x3f\xae\xc7\x2e\x55\xf5\x02\x00\x00")
+
+func _1688210013_add_token_spamUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210013_add_token_spamUpSql,
+		"1688210013_add_token_spam.up.sql",
+	)
+}
+
+func _1688210013_add_token_spamUpSql() (*asset, error) {
+	bytes, err := _1688210013_add_token_spamUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210013_add_token_spam.up.sql", size: 757, mode: os.FileMode(0644), modTime: time.Unix(1792142870, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4c, 0xf, 0x32, 0x48, 0xb8, 0xa2, 0xe8, 0xb9, 0xc6, 0x3, 0xb1, 0x65, 0xd1, 0xf1, 0x73, 0x5d, 0x7, 0xc2, 0x24, 0x11, 0x29, 0x5d, 0x82, 0xfc, 0x87, 0xbf, 0x66, 0x9d, 0xe1, 0x62, 0xab, 0x56}}
+	return a, nil
+}
+
+var __1688210014_add_network_custom_rpc_urlsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x8f\xc1\x6e\x83\x30\x10\x44\xef\x7c\xc5\x1c\x13\x09\xbe\xa0\x27\x42\x5c\x62\x95\x42\x64\x4c\xd3\x9c\x10\xc1\xa6\x58\x49\xbc\x08\x83\xaa\xfe\x7d\x1d\xa2\xf4\x10\xa9\x7b\x5a\xed\xcc\xbe\xd9\x8d\x22\x58\x3d\x7d\xd3\x78\xae\xdb\xd9\x4d\x74\xad\xc7\xa1\xad\xe7\xf1\xe2\xd0\xd3\x45\x39\x4c\xbd\x86\xd8\x27\xd0\x56\x0d\x64\xec\xe4\xd0\x28\xa5\x15\x4e\x3f\x8b\x34\x3b\x3d\xa2\xa3\x11\xba\x69\xfb\x20\x8a\xd0\xf6\x8d\xb1\x21\xc8\x62\xa2\x01\xd4\x2d\x2e\xb2\xda\x3d\x7a\x4b\x4a\xa3\x25\xdb\x99\xaf\x10\x03\x39\x33\x19\x6f\x3e\x6b\x3d\xdc\xc3\x68\x54\x1e\xe9\xbb\x1b\x6d\xc1\xdf\x03\xfd\xe4\x0a\x63\x83\x44\xb0\x58\x32\xc8\x78\x93\x31\xf0\x57\xe4\x85\x04\xfb\xe4\xa5\x2c\xff\xfd\x64\x15\xc0\xd7\x72\x59\x6d\x14\xaa\xbc\xe4\x69\xce\xb6\xd8\xf0\x94\xe7\x72\x21\xe4\x55\x96\x85\x8b\xcd\x6f\xe0\x23\x16\xc9\x2e\x16\x4f\xca\xdf\xb1\x7e\x89\xa5\xec\x59\xde\x0b\xfe\x1e\x8b\x23\xde\xd8\x11\xab\x47\x58\x78\xe3\xad\x83\x35\x0e\x5c\xee\x8a\x4a\x42\x14\x07\xbe\x7d\x09\x7e\x01\x29\x59\x46\x49\x78\x01\x00\x00")
+
+func _1688210014_add_network_custom_rpc_urlsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210014_add_network_custom_rpc_urlsUpSql,
+		"1688210014_add_network_custom_rpc_urls.up.sql",
+	)
+}
+
+func _1688210014_add_network_custom_rpc_urlsUpSql() (*asset, error) {
+	bytes, err := _1688210014_add_network_custom_rpc_urlsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210014_add_network_custom_rpc_urls.up.sql", size: 376, mode: os.FileMode(0644), modTime: time.Unix(1792143069, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa0, 0xf7, 0x31, 0x93, 0xf2, 0xde, 0x89, 0xa0, 0x33, 0xbe, 0x40, 0xd1, 0x9d, 0xc2, 0x3b, 0x4b, 0x16, 0x21, 0x16, 0x8b, 0xad, 0x9e, 0xef, 0xcc, 0xce, 0x5a, 0x1c, 0xf, 0x7d, 0xba, 0x4e, 0xdf}}
+	return a, nil
+}
+
+var __1688210015_add_log_fetch_checkpointsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x50\xcb\x6e\x83\x30\x10\xbc\xf3\x15\x73\x4c\xa4\xf0\x05\x3d\x91\x84\x26\x56\x29\x54\xc6\x34\xcd\x09\x39\x66\x5b\x23\xa8\x8d\xb0\x2b\x9a\xbf\xaf\x21\xed\xa5\x52\xbb\xc7\xd9\x79\xec\x6c\x1c\xa3\xb7\x6f\xf5\x2b\x79\xa5\x6b\xa5\x49\x75\x83\x6d\x8d\x77\xd0\xb6\x6f\x1c\xbc\x26\xf4\xd2\x79\x5c\x7a\xab\x3a\x4c\xda\x3a\x9a\x05\x0e\x13\x8d\x84\x61\xb4\x8a\x9c\xa3\x06\x97\x2b\xa4\x89\xe2\x18\xad\x69\xe8\x93\xc6\x0d\x3a\xba\xc2\xc8\x77\xba\x99\x7c\xc3\x81\xd4\x04\x17\xe9\xd1\x7a\x2c\xa1\x61\xef\x6c\xa0\x04\x48\xc2\x29\x69\x30\x92\xfb\x08\xb2\xd9\x6c\xd2\x73\x4a\xa0\x3a\x6f\x87\x81\x9a\x68\xc7\xd3\x44\xa4\x10\xc9\x36\x4b\xc1\xee\x91\x17\x02\xe9\x0b\x2b\x45\xf9\x47\x8d\x55\x84\x30\x4a\xcb\xd6\xd4\x6d\x83\x2a\x2f\xd9\x21\x4f\xf7\xd8\xb2\x03\xcb\xc5\xa2\xcf\xab\x2c\xdb\x2c\xb4\xf9\xe4\xe7\x84\xef\x8e\x09\xff\xb5\xb9\xd5\xff\x5f\xfd\xc4\xd9\x63\xc2\xcf\x78\x48\xcf\x58\xfd\x24\x2e\x7f\x58\x47\x6b\x9c\x98\x38\x16\x95\x00\x2f\x4e\x6c\x7f\x17\x7d\x01\xf2\xae\x68\xac\x78\x01\x00\x00")
+
+func _1688210015_add_log_fetch_checkpointsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210015_add_log_fetch_checkpointsUpSql,
+		"1688210015_add_log_fetch_checkpoints.up.sql",
+	)
+}
+
+func _1688210015_add_log_fetch_checkpointsUpSql() (*asset, error) {
+	bytes, err := _1688210015_add_log_fetch_checkpointsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210015_add_log_fetch_checkpoints.up.sql", size: 376, mode: os.FileMode(0644), modTime: time.Unix(1792143957, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x92, 0x2b, 0x71, 0xa8, 0xca, 0x18, 0x8, 0xe, 0x48, 0xf7, 0xc, 0xf2, 0xb7, 0x38, 0xfe, 0xc3, 0xf4, 0xad, 0x48, 0x56, 0x26, 0x42, 0xff, 0xae, 0x3b, 0xa0, 0x72, 0x95, 0xa7, 0xf5, 0xaf, 0x84}}
+	return a, nil
+}
+
+var __1688210016_add_transfers_checked_headsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x90\xcd\x6e\xc2\x30\x10\x84\xef\x79\x8a\x39\x82\x94\x3c\x41\x4f\x09\xa4\x60\x35\x4d\xaa\x60\x4a\x39\x45\x8b\x63\x70\x44\xb0\x2b\xdb\x28\x52\x9f\xbe\x4e\xfa\x2b\xd4\xfa\x60\x69\x76\x76\xbe\x5d\x3b\x49\xe0\x2d\x69\x77\x94\xd6\x35\x42\x49\x71\x96\x6d\xa3\x24\xb5\x0e\xca\xf4\xe1\xf6\x4a\x42\x91\x53\xd2\xc1\x1c\x27\xd5\x93\xf3\xf8\x68\x19\xe5\x77\x3c\xf8\x51\x92\x80\x34\x48\x08\x73\xd5\x1e\x83\xb4\x12\x9f\x50\x5c\x5f\xe1\x4d\x0c\x9a\xa2\x18\x54\x27\x14\x3a\x07\x6d\xd0\x1b\x7d\x92\x16\x9d\x9e\x78\x82\xb4\xd1\x9d\xa0\x7e\x84\x09\x45\xa1\x7c\x91\x61\xc4\x87\x39\xe9\x81\x1c\xac\x34\xf6\x44\xba\x7b\x0b\x68\x3a\xfa\x31\xef\xa3\x45\x9d\xa7\x3c\x07\x4f\xb3\x22\x07\xbb\x47\x59\x71\xe4\x2f\x6c\xc3\x37\xff\xbe\x72\x16\x21\x1c\x2d\xfd\x60\xec\xb9\xe9\x5a\x6c\xcb\x0d\x5b\x95\xf9\x12\x19\x5b\xb1\x92\x4f\x8c\x72\x5b\x14\xf1\xd4\x48\x6d\x6b\xa5\x73\x78\x4e\xeb\xc5\x3a\xad\x6f\xdc\x43\x7f\x6e\xf4\xf5\x72\x08\xdb\xfc\x99\x1e\xfd\xf1\x33\x91\x15\x55\x76\xe3\x3d\xd5\xec\x31\xad\xf7\x78\xc8\xf7\x98\xfd\xec\x13\x7f\x8d\x8c\x7f\xd1\xe7\xd1\x1c\x3b\xc6\xd7\xd5\x96\xa3\xae\x76\x6c\x79\x17\xbd\x03\x88\x93\x34\x6b\xc9\x01\x00\x00")
+
+func _1688210016_add_transfers_checked_headsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210016_add_transfers_checked_headsUpSql,
+		"1688210016_add_transfers_checked_heads.up.sql",
+	)
+}
+
+func _1688210016_add_transfers_checked_headsUpSql() (*asset, error) {
+	bytes, err := _1688210016_add_transfers_checked_headsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210016_add_transfers_checked_heads.up.sql", size: 457, mode: os.FileMode(0644), modTime: time.Unix(1792144108, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe6, 0xf8, 0xf9, 0x4f, 0x87, 0x2b, 0x19, 0x19, 0x5f, 0x27, 0xbc, 0xd9, 0xcd, 0x67, 0xfc, 0x51, 0xf6, 0xb2, 0x31, 0x2, 0xd9, 0xe5, 0xca, 0xa8, 0xac, 0xff, 0xd9, 0xc1, 0x91, 0x7a, 0x4e, 0x45}}
+	return a, nil
+}
+
+var __1688210017_add_tracked_transactionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x91\xd1\x4e\x83\x30\x14\x86\xef\x79\x8a\x73\x39\x12\xf0\x05\x76\x05\xd2\xcd\x46\x2c\x06\x4a\xb6\x5d\x91\x8e\x56\x69\xb6\xb5\x86\x76\xce\xbd\xbd\x05\x74\x32\x45\x63\x6f\x9a\x9c\xf3\xff\xff\x39\xfd\x1a\x86\x60\x5b\x56\xef\x04\xaf\xdc\xad\x0c\xab\xad\xd4\xca\x40\xa3\xf7\xdc\x80\x6d\x04\x5c\x95\x8d\x50\x16\x9e\x5a\x7d\x70\x2d\x69\x80\x8b\x57\x59\x8b\x00\xec\x1b\x48\xe3\x85\x61\x6f\x30\xf2\x59\x09\x3e\xf6\x81\x50\xb5\xe6\xae\x26\x15\x6c\xa5\x62\xed\xf9\x06\xa2\x2b\x81\xcb\x6a\xc5\xcb\x9e\xd5\x4e\x74\x6a\x84\xea\xb2\x98\xd2\x2e\xae\x05\xad\x04\x9c\xa4\x6d\x86\x70\x76\x10\xa0\xb4\xaa\x45\xe7\xe9\xd6\x09\x2e\xce\x6a\x7b\xee\x8a\xd2\xba\xf5\x99\x69\xbc\xdb\x1c\x45\x14\x01\x8d\xe2\x14\x01\x5e\x00\xc9\x28\xa0\x35\x2e\x68\x31\xfd\xe6\x99\x07\xee\x28\x61\x4f\xba\xdd\x55\x92\x43\x49\x0a\xbc\x24\x28\x81\x18\x2f\x31\xa1\x7d\x00\x29\xd3\x34\xe8\x85\xdd\x0c\x88\xd3\x2c\xfe\x56\xef\xf0\x54\x8c\xf3\x56\x18\x33\xd5\x1f\xb6\xff\x3b\xdb\x01\x9d\x70\x1a\xcb\xec\xd1\xc0\x4f\xb9\xb1\xc7\x7a\x07\x71\x96\xa5\x28\x22\x97\x1e\x24\x68\x11\x95\x29\x85\x45\x94\x16\x68\x50\x8e\x59\x75\x13\x3e\xfc\x8e\x63\xc5\xec\x44\xf2\x63\x8e\x1f\xa2\x7c\x03\xf7\x68\x03\xb3\x2f\x34\x41\xff\x7a\xdf\xf3\x61\x85\xe9\x5d\x56\x52\xc8\xb3\x15\x4e\xe6\xde\x27\x74\x4c\x12\xb4\xfe\x07\xf4\x6a\xa0\x91\x91\x5f\xbe\x64\x3c\x72\x0c\x36\x18\x30\xfa\x73\xef\x1d\x6d\x30\x03\xa9\xc2\x02\x00\x00")
+
+func _1688210017_add_tracked_transactionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210017_add_tracked_transactionsUpSql,
+		"1688210017_add_tracked_transactions.up.sql",
+	)
+}
+
+func _1688210017_add_tracked_transactionsUpSql() (*asset, error) {
+	bytes, err := _1688210017_add_tracked_transactionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210017_add_tracked_transactions.up.sql", size: 706, mode: os.FileMode(0644), modTime: time.Unix(1792144278, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc6, 0x20, 0xdf, 0x14, 0x58, 0xda, 0xfe, 0x91, 0xbd, 0x45, 0x38, 0x96, 0x20, 0x36, 0xb3, 0x9a, 0xa8, 0x3f, 0x84, 0x3a, 0x41, 0x88, 0xe8, 0xef, 0x10, 0x4f, 0xcc, 0x71, 0x3f, 0xc9, 0x9f, 0x9f}}
+	return a, nil
+}
+
+var __1688210018_add_attachment_auto_download_limitsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\xcd\x41\x0a\xc2\x30\x10\x00\xc0\xbb\xaf\xd8\x27\xd4\x10\xa5\xe0\x29\x9a\x28\x85\x75\x0b\x9a\x9c\x43\x68\xa3\x06\x62\x02\x76\x4b\xbf\x6f\x7f\x20\x78\x98\xf3\x28\xb4\xe6\x06\x56\x1d\xd1\xc0\x14\x99\x53\x79\x4e\xa0\xb4\x86\x53\x8f\xee\x4a\x10\x98\xc3\xf0\x7a\xc7\xc2\x3e\xcc\x5c\xfd\x58\x97\x92\x6b\x18\xfd\x92\x1e\x09\x1c\xdd\xbb\x0b\x19\x0d\x1d\x59\xa0\x7e\xe5\x10\x41\x9b\xb3\x72\x68\x41\xec\xc5\x56\xca\xa6\x39\x6c\xd4\xbf\xcb\x10\x73\x9e\x73\xf8\xfc\x98\x76\x42\x8a\xb6\x5d\xa3\x2f\xda\x7e\xf1\x13\xcf\x00\x00\x00")
+
+func _1688210018_add_attachment_auto_download_limitsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210018_add_attachment_auto_download_limitsUpSql,
+		"1688210018_add_attachment_auto_download_limits.up.sql",
+	)
+}
+
+func _1688210018_add_attachment_auto_download_limitsUpSql() (*asset, error) {
+	bytes, err := _1688210018_add_attachment_auto_download_limitsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210018_add_attachment_auto_download_limits.up.sql", size: 207, mode: os.FileMode(0644), modTime: time.Unix(1792145992, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xce, 0x63, 0x29, 0x3f, 0xe, 0xb6, 0x95, 0x15, 0xfe, 0x4d, 0x82, 0x7f, 0x55, 0x66, 0x7, 0xaf, 0xf1, 0x4, 0xd6, 0x1c, 0xd9, 0xcc, 0x6a, 0x3a, 0x25, 0x85, 0xa7, 0xc5, 0x4c, 0x68, 0x5f, 0xc5}}
+	return a, nil
+}
+
+var __1688210019_add_archive_transports_to_torrent_configUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\xc9\x2f\x2a\x4a\xcd\x2b\x89\x4f\xce\xcf\x4b\xcb\x4c\x57\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\x2c\x4a\xce\xc8\x2c\x4b\x8d\xcf\x28\x29\x29\x88\x2f\x2d\xca\x51\x08\x73\x0c\x72\xf6\x70\x0c\x52\xf0\xf3\x0f\x51\xf0\x0b\xf5\xf1\x51\x70\x71\x75\x73\x0c\xf5\x09\x51\x50\x57\xb7\xe6\x72\x24\xc9\xcc\xcc\x82\xb4\xe2\xf8\xc4\x82\x4c\x9a\x98\x9b\x9e\x58\x92\x5a\x9e\x58\x49\xd8\x6c\x00\x32\x2e\x67\xb2\x08\x01\x00\x00")
+
+func _1688210019_add_archive_transports_to_torrent_configUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210019_add_archive_transports_to_torrent_configUpSql,
+		"1688210019_add_archive_transports_to_torrent_config.up.sql",
+	)
+}
+
+func _1688210019_add_archive_transports_to_torrent_configUpSql() (*asset, error) {
+	bytes, err := _1688210019_add_archive_transports_to_torrent_configUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210019_add_archive_transports_to_torrent_config.up.sql", size: 264, mode: os.FileMode(0644), modTime: time.Unix(1792146288, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x79, 0xcc, 0x82, 0xf4, 0xff, 0x27, 0xe, 0x9f, 0x4d, 0x48, 0xb, 0x8, 0xc1, 0x68, 0xcc, 0xb2, 0x59, 0x85, 0x35, 0x82, 0x22, 0x43, 0x16, 0xe2, 0xc2, 0xab, 0x12, 0x96, 0x23, 0x65, 0x4a, 0xe3}}
+	return a, nil
+}
+
+var __1688210020_add_outgoing_secrets_filter_enabledUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x0d\xc8\x3d\x0a\x80\x30\x0c\x06\xd0\xdd\x53\x7c\xf7\x70\x8a\x36\x4e\xb1\x05\x6d\xe7\xe2\x4f\x14\x41\x14\x6c\xbc\xbf\xbe\xf1\x91\x44\x1e\x10\xa9\x11\x46\x51\xb3\xe3\xda\x0b\xc8\x39\xb4\x41\x52\xef\x71\xbf\xb6\xdf\x7f\xe6\xa2\xcb\xa3\x56\xf2\x76\x9c\xa6\x4f\xd6\x6b\x9a\x4f\x5d\xd1\x84\x20\x4c\x1e\x3e\x44\xf8\x24\x02\xc7\x1d\x25\x89\xe8\x48\x46\xae\xab\x0f\xee\x8f\x35\xd3\x60\x00\x00\x00")
+
+func _1688210020_add_outgoing_secrets_filter_enabledUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210020_add_outgoing_secrets_filter_enabledUpSql,
+		"1688210020_add_outgoing_secrets_filter_enabled.up.sql",
+	)
+}
+
+func _1688210020_add_outgoing_secrets_filter_enabledUpSql() (*asset, error) {
+	bytes, err := _1688210020_add_outgoing_secrets_filter_enabledUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210020_add_outgoing_secrets_filter_enabled.up.sql", size: 96, mode: os.FileMode(0644), modTime: time.Unix(1792146938, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8a, 0x91, 0x8e, 0x9a, 0x85, 0x22, 0xbf, 0xeb, 0x8d, 0x7d, 0x6d, 0x98, 0xad, 0x17, 0x9c, 0xc8, 0x22, 0x62, 0xc1, 0xfd, 0x1a, 0x75, 0x9e, 0x96, 0xf4, 0x2a, 0xf6, 0x75, 0xaf, 0xd, 0xab, 0xe9}}
+	return a, nil
+}
+
+var __1688210021_add_notification_keywords_to_settingsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4e\x2d\x29\xc9\xcc\x4b\x2f\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\xc8\xcb\x2f\xc9\x4c\xcb\x4c\x4e\x2c\xc9\xcc\xcf\x8b\xcf\x4e\xad\x2c\xcf\x2f\x4a\x29\x56\x08\x71\x8d\x08\x51\xf0\xf3\x07\xe2\x50\x1f\x1f\x05\x17\x57\x37\xc7\x50\x9f\x10\x05\x75\x75\x6b\x2e\x47\x2c\xe6\xc5\x17\x57\xe6\x25\xc7\x27\xe7\xe4\x27\x67\x13\x36\xda\xd3\x2f\xc4\xd5\x1d\x68\x04\x86\xe9\x06\xd6\x5c\x00\xed\xf2\xe5\x91\xad\x00\x00\x00")
+
+func _1688210021_add_notification_keywords_to_settingsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210021_add_notification_keywords_to_settingsUpSql,
+		"1688210021_add_notification_keywords_to_settings.up.sql",
+	)
+}
+
+func _1688210021_add_notification_keywords_to_settingsUpSql() (*asset, error) {
+	bytes, err := _1688210021_add_notification_keywords_to_settingsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210021_add_notification_keywords_to_settings.up.sql", size: 173, mode: os.FileMode(0644), modTime: time.Unix(1792147276, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xae, 0xde, 0x84, 0x4c, 0x87, 0xa6, 0xc2, 0x7b, 0xd6, 0x7, 0xef, 0x24, 0xda, 0x72, 0xe1, 0x9f, 0x96, 0x47, 0x9d, 0xfe, 0x5f, 0xb4, 0xe2, 0xd, 0x3a, 0x53, 0x21, 0x1b, 0xf6, 0x4e, 0x6a, 0x4c}}
+	return a, nil
+}
+
+var __1688210022_add_contact_requests_throttling_enabledUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x0d\xca\x31\x0e\x83\x30\x0c\x05\xd0\x9d\x53\xfc\x7b\x30\x39\x8d\x99\xdc\x44\x6a\x93\x39\x82\x60\x15\x24\x14\x54\x62\xee\xdf\xbe\xf9\x91\x24\x7e\x21\x91\x13\x46\x57\xb3\xbd\x7d\x3a\xc8\x7b\x3c\xa2\xe4\x67\x40\x3d\x9b\xcd\xd5\xca\xa5\xdf\x5b\xbb\xf5\x62\xdb\x75\x9a\x1d\xff\x57\xb4\xcd\xcb\xa1\x2b\x5c\x8c\xc2\x14\x10\x62\x42\xc8\x22\xf0\x3c\x51\x96\x84\x89\xe4\xcd\xe3\xf0\x03\xd2\xe4\xd8\x72\x64\x00\x00\x00")
+
+func _1688210022_add_contact_requests_throttling_enabledUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210022_add_contact_requests_throttling_enabledUpSql,
+		"1688210022_add_contact_requests_throttling_enabled.up.sql",
+	)
+}
+
+func _1688210022_add_contact_requests_throttling_enabledUpSql() (*asset, error) {
+	bytes, err := _1688210022_add_contact_requests_throttling_enabledUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210022_add_contact_requests_throttling_enabled.up.sql", size: 100, mode: os.FileMode(0644), modTime: time.Unix(1792147740, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x24, 0xc4, 0x15, 0x6c, 0x69, 0xbc, 0xdf, 0x36, 0x78, 0x5b, 0xdb, 0x97, 0x58, 0x76, 0xe, 0x8d, 0xc7, 0x6d, 0x3b, 0x8f, 0x2, 0xe1, 0x3d, 0x6f, 0x3f, 0x18, 0x78, 0x1d, 0xdb, 0x5b, 0xb2, 0xc9}}
+	return a, nil
+}
+
+var __1688210023_add_community_blocklists_enabledUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\xcd\x31\x0e\xc2\x30\x0c\x00\xc0\xbd\xaf\xf0\x13\xd8\x3b\xb9\xc4\x20\x24\xe3\x48\x95\x33\x47\x34\x44\x28\x22\x4d\x87\xa4\x43\x7f\x0f\x9d\xe9\xc0\x07\xee\x90\x95\x46\x50\x1c\x98\xa0\xc6\xd6\x52\x79\x55\x40\x63\xe0\x6c\xd9\xdd\x05\xc2\x32\xcf\x6b\x49\x6d\xf3\x53\x5e\xc2\x3b\xa7\xda\xaa\x8f\xe5\x31\xe5\xf8\x84\xc1\x5a\x26\x14\x10\xab\x20\x8e\x19\x0c\x5d\xd0\xb1\x82\x8e\x8e\xfa\x0e\x0f\x6c\x5f\xb7\x12\x7c\xd8\xad\xbf\x9b\x9b\x28\x5d\xbf\xd2\x4f\x73\xea\xbb\x0f\x07\x63\x17\x6f\xc0\x00\x00\x00")
+
+func _1688210023_add_community_blocklists_enabledUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210023_add_community_blocklists_enabledUpSql,
+		"1688210023_add_community_blocklists_enabled.up.sql",
+	)
+}
+
+func _1688210023_add_community_blocklists_enabledUpSql() (*asset, error) {
+	bytes, err := _1688210023_add_community_blocklists_enabledUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210023_add_community_blocklists_enabled.up.sql", size: 192, mode: os.FileMode(0644), modTime: time.Unix(1792147969, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfd, 0x7e, 0x92, 0xf5, 0x72, 0x76, 0x5c, 0x11, 0x3d, 0xf8, 0x97, 0x4c, 0x12, 0x7b, 0x8b, 0x39, 0xe3, 0xd4, 0x93, 0xe3, 0xb6, 0x4e, 0x65, 0x30, 0x7f, 0x28, 0x87, 0x9a, 0x98, 0x3, 0x8, 0x35}}
+	return a, nil
+}
+
+var __1688210024_add_gif_search_cacheUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x45\x8c\xbb\x0e\xc2\x20\x14\x86\x77\x9e\xe2\x1f\x35\xf1\x0d\x9c\x90\x9c\x26\x44\x84\x86\x1e\x93\x76\x22\x04\xa9\xb8\x68\x53\x78\xff\xd8\xc6\xc1\xf9\xbb\x28\x4f\x92\x09\x2c\x2f\x86\xa0\x3b\x58\xc7\xa0\x51\x0f\x3c\xe0\xf9\x9a\x43\xcd\x71\x4d\x25\xa4\x98\x4a\xc6\x41\x00\x4b\x6c\x05\x4c\x23\xa3\xf7\xfa\x26\xfd\x84\x2b\x4d\x70\x16\xca\xd9\xce\x68\xc5\xf0\xd4\x1b\xa9\xe8\xb4\xc9\x6b\xae\xcb\xe7\x5d\xf3\x2f\xd8\xd7\xf6\x6e\xcc\x4e\xe6\xdc\xb6\xe3\x23\xc4\x06\x6d\xff\x48\x1c\xcf\xe2\x0b\xfe\xc5\xa8\x28\x92\x00\x00\x00")
+
+func _1688210024_add_gif_search_cacheUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210024_add_gif_search_cacheUpSql,
+		"1688210024_add_gif_search_cache.up.sql",
+	)
+}
+
+func _1688210024_add_gif_search_cacheUpSql() (*asset, error) {
+	bytes, err := _1688210024_add_gif_search_cacheUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210024_add_gif_search_cache.up.sql", size: 146, mode: os.FileMode(0644), modTime: time.Unix(1792148175, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa6, 0x3, 0xa8, 0x1e, 0x8d, 0xc, 0xdb, 0x81, 0x6a, 0x96, 0xa8, 0xe1, 0x6f, 0x2c, 0xe1, 0xbc, 0x9a, 0x61, 0x1a, 0xa0, 0xac, 0x76, 0x4b, 0x37, 0xca, 0x66, 0x57, 0xa3, 0xa3, 0xcd, 0x19, 0x1f}}
+	return a, nil
+}
+
+var __1688210025_add_video_auto_downloadUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\xcc\x41\x0e\x83\x20\x10\x05\xd0\x7d\x4f\xf1\xef\xd1\xd5\x28\xe3\x6a\x0a\x89\x1d\xd6\x84\x08\x35\x24\x44\x92\x8a\xf5\xfa\xed\x05\xba\xf1\x1d\xe0\x91\x28\xcf\x50\x1a\x84\xb1\xe7\xde\xcb\xb6\xee\x20\x63\x30\x3a\xf1\x0f\x8b\x4f\x49\xb9\x85\x78\xf4\x16\x52\x3b\xb7\xda\x62\x0a\x67\x79\x15\x0c\xce\x09\x93\x85\x75\x0a\xeb\x45\x60\x78\x22\x2f\x0a\x9d\x3d\xdf\x6f\x74\xe1\x5d\x72\xad\x47\x8d\xef\xff\xf7\x44\xf2\xfc\xe5\x5f\x73\x9a\xc2\x74\xb5\x00\x00\x00")
+
+func _1688210025_add_video_auto_downloadUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210025_add_video_auto_downloadUpSql,
+		"1688210025_add_video_auto_download.up.sql",
+	)
+}
+
+func _1688210025_add_video_auto_downloadUpSql() (*asset, error) {
+	bytes, err := _1688210025_add_video_auto_downloadUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210025_add_video_auto_download.up.sql", size: 181, mode: os.FileMode(0644), modTime: time.Unix(1792148720, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xde, 0x96, 0xdd, 0x31, 0xd0, 0xc4, 0x58, 0x89, 0x93, 0x66, 0x5f, 0xfd, 0xdf, 0xc0, 0xcf, 0xe6, 0x7d, 0x14, 0xc4, 0x6c, 0xba, 0xb1, 0x52, 0x30, 0x23, 0x8c, 0x7a, 0xc1, 0x88, 0xa3, 0xf7, 0x1a}}
+	return a, nil
+}
+
+var __1688210026_add_wallet_accounts_position_change_clockUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x0d\xcc\x41\x0a\xc3\x20\x10\x05\xd0\x7d\x4f\xf1\x8f\xd0\x7d\x56\xa6\xda\x12\x98\x18\x28\xe3\x5a\x64\x90\x44\x2a\x5a\x70\x4a\xae\xdf\xbc\x03\x3c\x43\xec\xde\x60\x33\x93\xc3\xc8\xaa\xa5\xed\x03\xc6\x5a\x3c\x36\x0a\xab\xc7\x99\x6a\xcd\x1a\x93\x48\xff\x35\x1d\xf1\xdb\x47\xd1\xd2\x5b\x94\x23\xb5\x3d\x47\xa9\x5d\x3e\x58\x3c\xbb\xd7\xf5\xf8\x8d\xe1\x03\x11\xac\x7b\x9a\x40\x8c\xfb\x74\xfb\x03\xda\xc8\x40\xd2\x62\x00\x00\x00")
+
+func _1688210026_add_wallet_accounts_position_change_clockUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210026_add_wallet_accounts_position_change_clockUpSql,
+		"1688210026_add_wallet_accounts_position_change_clock.up.sql",
+	)
+}
+
+func _1688210026_add_wallet_accounts_position_change_clockUpSql() (*asset, error) {
+	bytes, err := _1688210026_add_wallet_accounts_position_change_clockUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210026_add_wallet_accounts_position_change_clock.up.sql", size: 98, mode: os.FileMode(0644), modTime: time.Unix(1792150816, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4a, 0x9c, 0x6, 0xe0, 0xbd, 0xb, 0x8e, 0x51, 0x32, 0x80, 0xda, 0xd0, 0x87, 0xf6, 0xe3, 0xb0, 0xe7, 0x9c, 0xc5, 0x85, 0xb0, 0x8f, 0xa6, 0xd, 0xb7, 0x9d, 0x28, 0x7e, 0x7c, 0xa8, 0x84, 0x60}}
+	return a, nil
+}
+
+var __1688210027_add_test_networks_enabled_sync_clockUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4e\x2d\x29\xc9\xcc\x4b\x2f\x8e\x2f\xae\xcc\x4b\x8e\x4f\xce\xc9\x4f\xce\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x28\x49\x2d\x2e\x89\xcf\x4b\x2d\x29\xcf\x2f\xca\x2e\x8e\x4f\xcd\x4b\x4c\xca\x49\x4d\x51\xf0\xf4\x0b\x71\x75\x07\x1a\xe1\xe7\x1f\xa2\xe0\x17\xea\xe3\xa3\xe0\xe2\xea\xe6\x18\xea\x13\xa2\x60\x60\xcd\x05\x00\x5e\x74\x77\xff\x5d\x00\x00\x00")
+
+func _1688210027_add_test_networks_enabled_sync_clockUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210027_add_test_networks_enabled_sync_clockUpSql,
+		"1688210027_add_test_networks_enabled_sync_clock.up.sql",
+	)
+}
+
+func _1688210027_add_test_networks_enabled_sync_clockUpSql() (*asset, error) {
+	bytes, err := _1688210027_add_test_networks_enabled_sync_clockUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210027_add_test_networks_enabled_sync_clock.up.sql", size: 93, mode: os.FileMode(0644), modTime: time.Unix(1792151235, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x27, 0x3c, 0xb7, 0xeb, 0xc4, 0x51, 0x29, 0xc8, 0xb5, 0x8d, 0xe8, 0x73, 0x65, 0x40, 0xd, 0x75, 0x4, 0x77, 0x1d, 0xd6, 0x5e, 0x26, 0x99, 0x18, 0xec, 0x7d, 0x6a, 0xd4, 0xb6, 0x3a, 0x5f, 0x10}}
+	return a, nil
+}
+
+var __1688210028_add_tokens_sync_clockUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\xc9\xcf\x4e\xcd\x2b\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\xce\xc9\x4f\xce\x56\xf0\xf4\x0b\x51\xf0\xf3\x07\xe2\x50\x1f\x1f\x05\x17\x57\x37\xc7\x50\x9f\x10\x05\x03\x6b\x2e\x47\xbc\x7a\x8b\x52\x73\xf3\xcb\x52\x53\x14\x9c\xfc\xfd\x7d\x5c\x1d\xfd\x30\x4d\x70\x73\xf4\x09\x76\xb5\xe6\x02\x00\x9e\xf0\xcb\xa7\x82\x00\x00\x00")
+
+func _1688210028_add_tokens_sync_clockUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210028_add_tokens_sync_clockUpSql,
+		"1688210028_add_tokens_sync_clock.up.sql",
+	)
+}
+
+func _1688210028_add_tokens_sync_clockUpSql() (*asset, error) {
+	bytes, err := _1688210028_add_tokens_sync_clockUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210028_add_tokens_sync_clock.up.sql", size: 130, mode: os.FileMode(0644), modTime: time.Unix(1792151344, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xad, 0xff, 0x17, 0x52, 0xeb, 0x99, 0xc4, 0x53, 0xdd, 0xc, 0x9f, 0x1c, 0xf8, 0xe, 0xa, 0x83, 0xb3, 0xb0, 0x10, 0x18, 0x31, 0x43, 0xfa, 0x1a, 0x8c, 0x77, 0xb0, 0x25, 0x6c, 0x77, 0x61, 0x26}}
+	return a, nil
+}
+
+var __1688210029_add_token_listsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x8f\x3d\x0f\x82\x30\x10\x86\x77\x7e\xc5\x6d\x68\xe2\xe0\xee\x54\xb4\x24\xc4\x0a\x06\x4a\x02\x13\x69\xa4\x89\x55\xfa\x11\x7a\xf8\xfb\x05\x06\x13\x24\xba\xde\xf3\xdc\x7b\xf7\x1e\x73\x4a\x38\x05\x4e\x22\x46\x21\x89\x21\xcd\x38\xd0\x2a\x29\x78\x01\x68\x9f\xd2\x34\x9d\xf2\xe8\x61\x13\x00\x0c\x7d\x07\x9c\x56\x7c\x76\xd2\x92\x31\xb8\xe6\xc9\x85\xe4\x35\x9c\x69\xbd\x1b\x05\x23\xb4\x5c\x1a\xd3\xf4\x25\x7b\xaf\xac\x69\xb4\x78\xd8\x1e\x92\xf4\x07\x55\xe6\x0f\x75\x02\x6f\xf7\x15\x45\xa5\xa5\x47\xa1\xdd\xd7\x5b\x27\x1a\x93\x92\x71\x08\xc3\xd9\x9a\x6a\x78\x88\x58\x16\x2d\xb6\x07\xd7\x0a\x94\x6d\x23\x70\x15\xec\xac\x57\x38\xde\x5d\x80\x4f\xec\x3e\xd8\x1e\x82\x37\xdf\xfa\xe4\x7d\x38\x01\x00\x00")
+
+func _1688210029_add_token_listsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210029_add_token_listsUpSql,
+		"1688210029_add_token_lists.up.sql",
+	)
+}
+
+func _1688210029_add_token_listsUpSql() (*asset, error) {
+	bytes, err := _1688210029_add_token_listsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210029_add_token_lists.up.sql", size: 312, mode: os.FileMode(0644), modTime: time.Unix(1792151641, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfa, 0x5, 0x87, 0x15, 0xc0, 0x19, 0x1c, 0xda, 0x44, 0x72, 0xae, 0x3f, 0x7e, 0xf0, 0xb6, 0x38, 0xe4, 0x53, 0x3a, 0x84, 0x5d, 0xb5, 0xd3, 0xf8, 0x73, 0x19, 0x64, 0x2a, 0x1c, 0x9d, 0x4f, 0x79}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xc9\xb1\x0d\xc4\x20\x0c\x05\xd0\x9e\x29\xfe\x02\xd8\xfd\x6d\xe3\x4b\xac\x2f\x44\x82\x09\x78\x7f\xa5\x49\xfd\xa6\x1d\xdd\xe8\xd8\xcf\x55\x8a\x2a\xe3\x47\x1f\xbe\x2c\x1d\x8c\xfa\x6f\xe3\xb4\x34\xd4\xd9\x89\xbb\x71\x59\xb6\x18\x1b\x35\x20\xa2\x9f\x0a\x03\xa2\xe5\x0d\x00\x00\xff\xff\x60\xcd\x06\xbe\x4a\x00\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -1851,7 +2502,38 @@ var _bindata = map[string]func() (*asset, error){
 	"1687269871_add_device_name.up.sql":                                       _1687269871_add_device_nameUpSql,
 	"1687506642_include_watch_only_account_setting.up.sql":                    _1687506642_include_watch_only_account_settingUpSql,
 	"1688022264_add_include_watch_only_account_to_settings_sync_clock.up.sql": _1688022264_add_include_watch_only_account_to_settings_sync_clockUpSql,
-	"doc.go": docGo,
+	"1688114431_add_backup_interval_to_settings.up.sql":                       _1688114431_add_backup_interval_to_settingsUpSql,
+	"1688160000_add_edit_history_limit_to_settings.up.sql":                    _1688160000_add_edit_history_limit_to_settingsUpSql,
+	"1688180000_add_link_previews_filters_to_settings.up.sql":                 _1688180000_add_link_previews_filters_to_settingsUpSql,
+	"1688210002_add_latency_telemetry_enabled_to_settings.up.sql":             _1688210002_add_latency_telemetry_enabled_to_settingsUpSql,
+	"1688210003_add_activity_timeline.up.sql":                                 _1688210003_add_activity_timelineUpSql,
+	"1688210004_add_token_approvals.up.sql":                                   _1688210004_add_token_approvalsUpSql,
+	"1688210005_add_ens_primary_names.up.sql":                                 _1688210005_add_ens_primary_namesUpSql,
+	"1688210006_add_sticker_packs_index.up.sql":                               _1688210006_add_sticker_packs_indexUpSql,
+	"1688210007_add_dapp_permission_grants.up.sql":                            _1688210007_add_dapp_permission_grantsUpSql,
+	"1688210008_add_erc4337_user_operations.up.sql":                           _1688210008_add_erc4337_user_operationsUpSql,
+	"1688210009_add_hardware_wallet_accounts.up.sql":                          _1688210009_add_hardware_wallet_accountsUpSql,
+	"1688210010_add_privacy_mode_to_settings.up.sql":                          _1688210010_add_privacy_mode_to_settingsUpSql,
+	"1688210011_add_push_notifications_collapse_to_settings.up.sql":           _1688210011_add_push_notifications_collapse_to_settingsUpSql,
+	"1688210012_add_collectibles_ownership_cache.up.sql":                      _1688210012_add_collectibles_ownership_cacheUpSql,
+	"1688210013_add_token_spam.up.sql":                                        _1688210013_add_token_spamUpSql,
+	"1688210014_add_network_custom_rpc_urls.up.sql":                           _1688210014_add_network_custom_rpc_urlsUpSql,
+	"1688210015_add_log_fetch_checkpoints.up.sql":                             _1688210015_add_log_fetch_checkpointsUpSql,
+	"1688210016_add_transfers_checked_heads.up.sql":                           _1688210016_add_transfers_checked_headsUpSql,
+	"1688210017_add_tracked_transactions.up.sql":                              _1688210017_add_tracked_transactionsUpSql,
+	"1688210018_add_attachment_auto_download_limits.up.sql":                   _1688210018_add_attachment_auto_download_limitsUpSql,
+	"1688210019_add_archive_transports_to_torrent_config.up.sql":              _1688210019_add_archive_transports_to_torrent_configUpSql,
+	"1688210020_add_outgoing_secrets_filter_enabled.up.sql":                   _1688210020_add_outgoing_secrets_filter_enabledUpSql,
+	"1688210021_add_notification_keywords_to_settings.up.sql":                 _1688210021_add_notification_keywords_to_settingsUpSql,
+	"1688210022_add_contact_requests_throttling_enabled.up.sql":               _1688210022_add_contact_requests_throttling_enabledUpSql,
+	"1688210023_add_community_blocklists_enabled.up.sql":                      _1688210023_add_community_blocklists_enabledUpSql,
+	"1688210024_add_gif_search_cache.up.sql":                                  _1688210024_add_gif_search_cacheUpSql,
+	"1688210025_add_video_auto_download.up.sql":                               _1688210025_add_video_auto_downloadUpSql,
+	"1688210026_add_wallet_accounts_position_change_clock.up.sql":             _1688210026_add_wallet_accounts_position_change_clockUpSql,
+	"1688210027_add_test_networks_enabled_sync_clock.up.sql":                  _1688210027_add_test_networks_enabled_sync_clockUpSql,
+	"1688210028_add_tokens_sync_clock.up.sql":                                 _1688210028_add_tokens_sync_clockUpSql,
+	"1688210029_add_token_lists.up.sql":                                       _1688210029_add_token_listsUpSql,
+	"doc.go":                                                                  docGo,
 }
 
 // AssetDebug is true if the assets were built with the debug flag enabled.
@@ -1976,7 +2658,38 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1687269871_add_device_name.up.sql":                                       {_1687269871_add_device_nameUpSql, map[string]*bintree{}},
 	"1687506642_include_watch_only_account_setting.up.sql":                    {_1687506642_include_watch_only_account_settingUpSql, map[string]*bintree{}},
 	"1688022264_add_include_watch_only_account_to_settings_sync_clock.up.sql": {_1688022264_add_include_watch_only_account_to_settings_sync_clockUpSql, map[string]*bintree{}},
-	"doc.go": {docGo, map[string]*bintree{}},
+	"1688114431_add_backup_interval_to_settings.up.sql":                       {_1688114431_add_backup_interval_to_settingsUpSql, map[string]*bintree{}},
+	"1688160000_add_edit_history_limit_to_settings.up.sql":                    {_1688160000_add_edit_history_limit_to_settingsUpSql, map[string]*bintree{}},
+	"1688180000_add_link_previews_filters_to_settings.up.sql":                 {_1688180000_add_link_previews_filters_to_settingsUpSql, map[string]*bintree{}},
+	"1688210002_add_latency_telemetry_enabled_to_settings.up.sql":             {_1688210002_add_latency_telemetry_enabled_to_settingsUpSql, map[string]*bintree{}},
+	"1688210003_add_activity_timeline.up.sql":                                 {_1688210003_add_activity_timelineUpSql, map[string]*bintree{}},
+	"1688210004_add_token_approvals.up.sql":                                   {_1688210004_add_token_approvalsUpSql, map[string]*bintree{}},
+	"1688210005_add_ens_primary_names.up.sql":                                 {_1688210005_add_ens_primary_namesUpSql, map[string]*bintree{}},
+	"1688210006_add_sticker_packs_index.up.sql":                               {_1688210006_add_sticker_packs_indexUpSql, map[string]*bintree{}},
+	"1688210007_add_dapp_permission_grants.up.sql":                            {_1688210007_add_dapp_permission_grantsUpSql, map[string]*bintree{}},
+	"1688210008_add_erc4337_user_operations.up.sql":                           {_1688210008_add_erc4337_user_operationsUpSql, map[string]*bintree{}},
+	"1688210009_add_hardware_wallet_accounts.up.sql":                          {_1688210009_add_hardware_wallet_accountsUpSql, map[string]*bintree{}},
+	"1688210010_add_privacy_mode_to_settings.up.sql":                          {_1688210010_add_privacy_mode_to_settingsUpSql, map[string]*bintree{}},
+	"1688210011_add_push_notifications_collapse_to_settings.up.sql":           {_1688210011_add_push_notifications_collapse_to_settingsUpSql, map[string]*bintree{}},
+	"1688210012_add_collectibles_ownership_cache.up.sql":                      {_1688210012_add_collectibles_ownership_cacheUpSql, map[string]*bintree{}},
+	"1688210013_add_token_spam.up.sql":                                        {_1688210013_add_token_spamUpSql, map[string]*bintree{}},
+	"1688210014_add_network_custom_rpc_urls.up.sql":                           {_1688210014_add_network_custom_rpc_urlsUpSql, map[string]*bintree{}},
+	"1688210015_add_log_fetch_checkpoints.up.sql":                             {_1688210015_add_log_fetch_checkpointsUpSql, map[string]*bintree{}},
+	"1688210016_add_transfers_checked_heads.up.sql":                           {_1688210016_add_transfers_checked_headsUpSql, map[string]*bintree{}},
+	"1688210017_add_tracked_transactions.up.sql":                              {_1688210017_add_tracked_transactionsUpSql, map[string]*bintree{}},
+	"1688210018_add_attachment_auto_download_limits.up.sql":                   {_1688210018_add_attachment_auto_download_limitsUpSql, map[string]*bintree{}},
+	"1688210019_add_archive_transports_to_torrent_config.up.sql":              {_1688210019_add_archive_transports_to_torrent_configUpSql, map[string]*bintree{}},
+	"1688210020_add_outgoing_secrets_filter_enabled.up.sql":                   {_1688210020_add_outgoing_secrets_filter_enabledUpSql, map[string]*bintree{}},
+	"1688210021_add_notification_keywords_to_settings.up.sql":                 {_1688210021_add_notification_keywords_to_settingsUpSql, map[string]*bintree{}},
+	"1688210022_add_contact_requests_throttling_enabled.up.sql":               {_1688210022_add_contact_requests_throttling_enabledUpSql, map[string]*bintree{}},
+	"1688210023_add_community_blocklists_enabled.up.sql":                      {_1688210023_add_community_blocklists_enabledUpSql, map[string]*bintree{}},
+	"1688210024_add_gif_search_cache.up.sql":                                  {_1688210024_add_gif_search_cacheUpSql, map[string]*bintree{}},
+	"1688210025_add_video_auto_download.up.sql":                               {_1688210025_add_video_auto_downloadUpSql, map[string]*bintree{}},
+	"1688210026_add_wallet_accounts_position_change_clock.up.sql":             {_1688210026_add_wallet_accounts_position_change_clockUpSql, map[string]*bintree{}},
+	"1688210027_add_test_networks_enabled_sync_clock.up.sql":                  {_1688210027_add_test_networks_enabled_sync_clockUpSql, map[string]*bintree{}},
+	"1688210028_add_tokens_sync_clock.up.sql":                                 {_1688210028_add_tokens_sync_clockUpSql, map[string]*bintree{}},
+	"1688210029_add_token_lists.up.sql":                                       {_1688210029_add_token_listsUpSql, map[string]*bintree{}},
+	"doc.go":                                                                  {docGo, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
@@ -0,0 +1 @@
+ALTER TABLE settings ADD COLUMN backup_interval INT NOT NULL DEFAULT 0;
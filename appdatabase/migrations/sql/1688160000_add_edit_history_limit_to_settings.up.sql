@@ -0,0 +1 @@
+ALTER TABLE settings ADD COLUMN edit_history_limit INT NOT NULL DEFAULT 0;
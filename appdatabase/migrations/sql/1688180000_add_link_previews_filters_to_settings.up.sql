@@ -0,0 +1,3 @@
+ALTER TABLE settings ADD COLUMN link_previews_mode INT NOT NULL DEFAULT 0;
+ALTER TABLE settings ADD COLUMN link_previews_allowlist BLOB;
+ALTER TABLE settings ADD COLUMN link_previews_denylist BLOB;
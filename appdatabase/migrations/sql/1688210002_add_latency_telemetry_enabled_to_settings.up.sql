@@ -0,0 +1 @@
+ALTER TABLE settings ADD COLUMN latency_telemetry_enabled BOOLEAN NOT NULL DEFAULT FALSE;
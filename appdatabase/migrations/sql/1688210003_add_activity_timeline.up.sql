@@ -0,0 +1,24 @@
+-- activity_timeline merges the transfers, token approvals, collectible mints
+-- and multi-transactions of each account into a single timeline
+CREATE TABLE IF NOT EXISTS activity_timeline (
+    account BLOB NOT NULL,
+    network_id UNSIGNED BIGINT NOT NULL,
+    hash BLOB NOT NULL,
+    type INTEGER NOT NULL,
+    timestamp UNSIGNED BIGINT NOT NULL,
+    token_type INTEGER NOT NULL,
+    token_address BLOB,
+    token_id BLOB,
+    amount BLOB,
+    counterparty BLOB,
+    multi_transaction_id INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (account, network_id, hash)
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS idx_activity_timeline_account_timestamp ON activity_timeline (account, timestamp);
+
+-- activity_timeline_sync holds the rowid of the last transfer merged in the timeline
+CREATE TABLE IF NOT EXISTS activity_timeline_sync (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    last_transfer_rowid INTEGER NOT NULL
+);
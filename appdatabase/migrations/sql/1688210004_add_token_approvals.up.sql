@@ -0,0 +1,15 @@
+-- token_approvals holds the active ERC20 allowances, ERC721 token approvals
+-- and operator approvals of the wallet accounts. token_id is empty unless
+-- the approval is for a single ERC721 token
+CREATE TABLE IF NOT EXISTS token_approvals (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    owner BLOB NOT NULL,
+    token_address BLOB NOT NULL,
+    type INTEGER NOT NULL,
+    spender BLOB NOT NULL,
+    token_id BLOB NOT NULL DEFAULT x'',
+    amount BLOB,
+    block_number UNSIGNED BIGINT NOT NULL,
+    tx_hash BLOB NOT NULL,
+    PRIMARY KEY (chain_id, owner, token_address, type, spender, token_id)
+) WITHOUT ROWID;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS ens_primary_names (
+  chain_id UNSIGNED BIGINT NOT NULL,
+  address VARCHAR NOT NULL,
+  name TEXT NOT NULL DEFAULT "",
+  resolved_at INT NOT NULL,
+  PRIMARY KEY (chain_id, address)
+) WITHOUT ROWID;
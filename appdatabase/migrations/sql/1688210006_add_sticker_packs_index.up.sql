@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS sticker_packs_index (
+  chain_id UNSIGNED BIGINT NOT NULL,
+  pack_id UNSIGNED BIGINT NOT NULL,
+  name TEXT NOT NULL DEFAULT "",
+  author TEXT NOT NULL DEFAULT "",
+  pack BLOB NOT NULL,
+  indexed_at INT NOT NULL,
+  PRIMARY KEY (chain_id, pack_id)
+) WITHOUT ROWID;
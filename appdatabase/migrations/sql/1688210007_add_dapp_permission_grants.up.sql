@@ -0,0 +1,2 @@
+ALTER TABLE permissions ADD COLUMN chain_id UNSIGNED BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE permissions ADD COLUMN expires_at INT NOT NULL DEFAULT 0;
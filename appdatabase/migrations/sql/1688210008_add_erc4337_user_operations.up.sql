@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS erc4337_user_operations (
+  hash VARCHAR NOT NULL,
+  chain_id UNSIGNED BIGINT NOT NULL,
+  sender VARCHAR NOT NULL,
+  owner VARCHAR NOT NULL,
+  status VARCHAR NOT NULL,
+  sponsored BOOLEAN NOT NULL DEFAULT FALSE,
+  tx_hash VARCHAR NOT NULL DEFAULT "",
+  reason TEXT NOT NULL DEFAULT "",
+  created_at INT NOT NULL,
+  updated_at INT NOT NULL,
+  PRIMARY KEY (chain_id, hash)
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS idx_erc4337_user_operations_status ON erc4337_user_operations (status);
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS hardware_wallet_accounts (
+  address VARCHAR NOT NULL PRIMARY KEY,
+  path VARCHAR NOT NULL,
+  added_at INT NOT NULL
+) WITHOUT ROWID;
@@ -0,0 +1,2 @@
+ALTER TABLE settings ADD COLUMN privacy_mode BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE settings_sync_clock ADD COLUMN privacy_mode INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE settings ADD COLUMN push_notifications_collapse BOOLEAN NOT NULL DEFAULT FALSE;
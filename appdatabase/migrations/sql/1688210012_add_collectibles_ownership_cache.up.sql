@@ -0,0 +1,21 @@
+-- collectibles_ownership holds the ERC721 tokens owned by the wallet accounts,
+-- as fetched from the providers and updated with the transfer logs since
+CREATE TABLE IF NOT EXISTS collectibles_ownership (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    owner BLOB NOT NULL,
+    contract_address BLOB NOT NULL,
+    token_id BLOB NOT NULL,
+    PRIMARY KEY (chain_id, owner, contract_address, token_id)
+) WITHOUT ROWID;
+
+-- collectibles_ownership_state holds the last block whose transfer logs were
+-- applied to the ownership of each account, and when the ownership was last
+-- fetched in full from the providers
+CREATE TABLE IF NOT EXISTS collectibles_ownership_state (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    owner BLOB NOT NULL,
+    last_synced_block UNSIGNED BIGINT NOT NULL,
+    last_full_refresh_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    PRIMARY KEY (chain_id, owner)
+) WITHOUT ROWID;
@@ -0,0 +1,20 @@
+-- token_spam_overrides holds the tokens and collectibles the user hid,
+-- reported as spam or marked as not spam, an empty token_id covers all the
+-- tokens of the contract
+CREATE TABLE IF NOT EXISTS token_spam_overrides (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    address BLOB NOT NULL,
+    token_id BLOB NOT NULL,
+    state INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    PRIMARY KEY (chain_id, address, token_id)
+) WITHOUT ROWID;
+
+-- token_spam_blocklist holds the last fetched community blocklist, kind is 0
+-- for contracts and 1 for domains
+CREATE TABLE IF NOT EXISTS token_spam_blocklist (
+    kind INTEGER NOT NULL,
+    chain_id UNSIGNED BIGINT NOT NULL,
+    value VARCHAR NOT NULL,
+    PRIMARY KEY (kind, chain_id, value)
+) WITHOUT ROWID;
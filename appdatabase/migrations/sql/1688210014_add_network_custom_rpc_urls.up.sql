@@ -0,0 +1,9 @@
+-- network_custom_rpc_urls holds the RPC endpoints added by the user for each
+-- chain, on top of the ones of the node config, position keeps the order the
+-- user added them in
+CREATE TABLE IF NOT EXISTS network_custom_rpc_urls (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    url VARCHAR NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (chain_id, url)
+) WITHOUT ROWID;
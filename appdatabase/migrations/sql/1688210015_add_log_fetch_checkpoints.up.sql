@@ -0,0 +1,9 @@
+-- log_fetch_checkpoints holds the last block whose logs were processed by an
+-- indexer, key names the indexer and what it fetches so that a scan resumes
+-- where it stopped
+CREATE TABLE IF NOT EXISTS log_fetch_checkpoints (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    key VARCHAR NOT NULL,
+    block UNSIGNED BIGINT NOT NULL,
+    PRIMARY KEY (chain_id, key)
+) WITHOUT ROWID;
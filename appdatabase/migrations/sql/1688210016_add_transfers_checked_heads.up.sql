@@ -0,0 +1,10 @@
+-- transfers_checked_heads holds the hashes of the last heads the transfers of
+-- an account were checked up to, a head which is no longer in the canonical
+-- chain means the chain was reorganized after it
+CREATE TABLE IF NOT EXISTS transfers_checked_heads (
+    network_id UNSIGNED BIGINT NOT NULL,
+    address VARCHAR NOT NULL,
+    blk_number BIGINT NOT NULL,
+    blk_hash BLOB NOT NULL,
+    PRIMARY KEY (network_id, address, blk_number)
+) WITHOUT ROWID;
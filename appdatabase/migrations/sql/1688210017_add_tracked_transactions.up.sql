@@ -0,0 +1,17 @@
+-- tracked_transactions holds the transactions sent from this device, tx is
+-- the signed transaction encoded in binary. A transaction is replaced when
+-- another one with the same nonce is sent, replaced_by is its hash
+CREATE TABLE IF NOT EXISTS tracked_transactions (
+    network_id UNSIGNED BIGINT NOT NULL,
+    hash BLOB NOT NULL,
+    from_address BLOB NOT NULL,
+    nonce UNSIGNED BIGINT NOT NULL,
+    tx BLOB NOT NULL,
+    status INT NOT NULL,
+    stuck BOOLEAN NOT NULL DEFAULT FALSE,
+    replaced_by BLOB,
+    sent_at INT NOT NULL,
+    PRIMARY KEY (network_id, hash)
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS tracked_transactions_nonce ON tracked_transactions (network_id, from_address, nonce);
@@ -0,0 +1,2 @@
+ALTER TABLE settings ADD COLUMN attachment_auto_download_wifi UNSIGNED INT NOT NULL DEFAULT 26214400;
+ALTER TABLE settings ADD COLUMN attachment_auto_download_cellular UNSIGNED INT NOT NULL DEFAULT 5242880;
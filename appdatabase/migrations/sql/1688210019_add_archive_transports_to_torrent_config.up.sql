@@ -0,0 +1,3 @@
+ALTER TABLE torrent_config ADD COLUMN archive_http_url VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE torrent_config ADD COLUMN archive_ipfs_api_url VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE torrent_config ADD COLUMN archive_ipfs_gateway_url VARCHAR NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE settings ADD COLUMN outgoing_secrets_filter_enabled BOOLEAN NOT NULL DEFAULT FALSE;
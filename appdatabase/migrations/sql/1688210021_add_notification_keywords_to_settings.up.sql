@@ -0,0 +1,2 @@
+ALTER TABLE settings ADD COLUMN notification_keywords TEXT NOT NULL DEFAULT '';
+ALTER TABLE settings_sync_clock ADD COLUMN notification_keywords INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE settings ADD COLUMN contact_requests_throttling_enabled BOOLEAN NOT NULL DEFAULT FALSE;
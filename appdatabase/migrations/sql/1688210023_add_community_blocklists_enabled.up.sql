@@ -0,0 +1,2 @@
+ALTER TABLE settings ADD COLUMN community_blocklists_enabled BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE settings_sync_clock ADD COLUMN community_blocklists_enabled INTEGER NOT NULL DEFAULT 0;
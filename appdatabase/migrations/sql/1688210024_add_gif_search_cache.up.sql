@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS gif_search_cache (
+  path TEXT PRIMARY KEY ON CONFLICT REPLACE,
+  response TEXT NOT NULL,
+  fetched_at INT NOT NULL
+);
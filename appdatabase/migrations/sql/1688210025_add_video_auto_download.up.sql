@@ -0,0 +1,2 @@
+ALTER TABLE settings ADD COLUMN video_auto_download_wifi BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE settings ADD COLUMN video_auto_download_cellular BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1 @@
+ALTER TABLE settings_sync_clock ADD COLUMN test_networks_enabled INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE tokens ADD COLUMN clock INT NOT NULL DEFAULT 0;
+ALTER TABLE tokens ADD COLUMN removed BOOLEAN NOT NULL DEFAULT FALSE;
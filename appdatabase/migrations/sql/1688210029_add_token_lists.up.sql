@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS token_lists (
+  url TEXT NOT NULL PRIMARY KEY,
+  name TEXT NOT NULL,
+  version_major INT NOT NULL,
+  version_minor INT NOT NULL,
+  version_patch INT NOT NULL,
+  timestamp TEXT NOT NULL DEFAULT '',
+  tokens BLOB NOT NULL,
+  updated_at INT NOT NULL,
+  position INT NOT NULL DEFAULT 0
+);
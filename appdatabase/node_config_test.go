@@ -287,5 +287,14 @@ func randomNodeConfig() *params.NodeConfig {
 			SoftBlacklistedPeerIDs: randomStringSlice(),
 			EnableConfirmations:    randomBool(),
 		},
+		TorrentConfig: params.TorrentConfig{
+			Enabled:               randomBool(),
+			Port:                  randomInt(math.MaxInt64),
+			DataDir:               randomString(),
+			TorrentDir:            randomString(),
+			ArchiveHTTPURL:        randomString(),
+			ArchiveIPFSAPIURL:     randomString(),
+			ArchiveIPFSGatewayURL: randomString(),
+		},
 	}
 }
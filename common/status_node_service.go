@@ -11,3 +11,9 @@ type StatusService interface {
 	Protocols() []p2p.Protocol
 	APIs() []rpc.API
 }
+
+// DeferredService is implemented by the services with work which can wait
+// until the messenger is responsive, not to delay the login
+type DeferredService interface {
+	StartDeferred() error
+}
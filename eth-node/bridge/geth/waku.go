@@ -200,6 +200,11 @@ func (w *gethWakuWrapper) GetStats() types.StatsSummary {
 	return w.waku.GetStats()
 }
 
+// BandwidthByProtocol function only added for compatibility with waku V2
+func (w *gethWakuWrapper) BandwidthByProtocol() map[string]types.ProtocolBandwidth {
+	return make(map[string]types.ProtocolBandwidth)
+}
+
 func (w *gethWakuWrapper) GetFilter(id string) types.Filter {
 	return NewWakuFilterWrapper(w.waku.GetFilter(id), id)
 }
@@ -148,6 +148,10 @@ func (w *gethWakuV2Wrapper) GetStats() types.StatsSummary {
 	return w.waku.GetStats()
 }
 
+func (w *gethWakuV2Wrapper) BandwidthByProtocol() map[string]types.ProtocolBandwidth {
+	return w.waku.BandwidthByProtocol()
+}
+
 func (w *gethWakuV2Wrapper) GetFilter(id string) types.Filter {
 	return NewWakuV2FilterWrapper(w.waku.GetFilter(id), id)
 }
@@ -4,3 +4,17 @@ type StatsSummary struct {
 	UploadRate   uint64 `json:"uploadRate"`
 	DownloadRate uint64 `json:"downloadRate"`
 }
+
+// Waku protocols of the bandwidth stats
+const (
+	RelayProtocol     = "relay"
+	FilterProtocol    = "filter"
+	StoreProtocol     = "store"
+	LightpushProtocol = "lightpush"
+)
+
+// ProtocolBandwidth are the bytes exchanged through a waku protocol
+type ProtocolBandwidth struct {
+	BytesIn  uint64 `json:"bytesIn"`
+	BytesOut uint64 `json:"bytesOut"`
+}
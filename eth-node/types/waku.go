@@ -20,6 +20,14 @@ type ConnStatus struct {
 	Peers      map[string]WakuV2Peer `json:"peers"`
 }
 
+// FilterHealth describes the filter subscriptions of a light client, it's
+// healthy when every filter is subscribed to at least one full node
+type FilterHealth struct {
+	IsHealthy   bool     `json:"isHealthy"`
+	Peers       []string `json:"peers"`
+	FailedPeers []string `json:"failedPeers"`
+}
+
 type WakuV2Peer struct {
 	Protocols []protocol.ID `json:"protocols"`
 	Addresses []string      `json:"addresses"`
@@ -128,6 +136,10 @@ type Waku interface {
 
 	GetStats() StatsSummary
 
+	// BandwidthByProtocol returns the bytes exchanged by each waku protocol
+	// since the node started
+	BandwidthByProtocol() map[string]ProtocolBandwidth
+
 	Subscribe(opts *SubscriptionOptions) (string, error)
 	GetFilter(id string) Filter
 	Unsubscribe(id string) error
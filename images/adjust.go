@@ -9,7 +9,9 @@ import (
 )
 
 const (
-	maxChatMessageImageSize = 400000
+	// MaxChatMessageImageSize is the size of the largest images sent within
+	// chat messages
+	MaxChatMessageImageSize = 400000
 	resizeTargetImageSize   = 350000
 	idealTargetImageSize    = 50000
 )
@@ -30,6 +32,7 @@ func OpenAndAdjustImage(inputImage CroppedImage, crop bool) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	img = applyOrientation(img, exifOrientation(payload))
 
 	if crop {
 		cropRect := image.Rectangle{
@@ -49,12 +52,12 @@ func OpenAndAdjustImage(inputImage CroppedImage, crop bool) ([]byte, error) {
 		return nil, err
 	}
 
-	// We keep the smallest one
-	if len(payload) > len(bb.Bytes()) {
+	// We keep the smallest one, unless the original carries EXIF metadata
+	if len(payload) > len(bb.Bytes()) || HasExif(payload) {
 		payload = bb.Bytes()
 	}
 
-	if len(payload) > maxChatMessageImageSize {
+	if len(payload) > MaxChatMessageImageSize {
 		return nil, errors.New("image too large")
 	}
 
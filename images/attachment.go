@@ -0,0 +1,139 @@
+package images
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"io/ioutil"
+
+	"github.com/nfnt/resize"
+)
+
+const (
+	AttachmentThumbnailName = "thumbnail"
+	AttachmentMediumName    = "medium"
+	AttachmentFullName      = "full"
+
+	attachmentThumbnailDim = 320
+	attachmentMediumDim    = 1024
+	attachmentFullDim      = 2000
+
+	blurhashXComponents = 4
+	blurhashYComponents = 3
+)
+
+var attachmentRenditions = []struct {
+	Name   string
+	Dim    uint
+	Limits FileSizeLimits
+}{
+	{AttachmentThumbnailName, attachmentThumbnailDim, FileSizeLimits{Ideal: 16384, Max: 38400}},
+	{AttachmentMediumName, attachmentMediumDim, FileSizeLimits{Ideal: 102400, Max: 204800}},
+	{AttachmentFullName, attachmentFullDim, FileSizeLimits{Ideal: idealTargetImageSize, Max: resizeTargetImageSize}},
+}
+
+// ImageRendition is one of the sizes an image attachment is sent in
+type ImageRendition struct {
+	Name     string `json:"name"`
+	Payload  []byte `json:"payload"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+	FileSize int    `json:"fileSize"`
+}
+
+// ImageAttachment is an image ready to be attached to a message, re-encoded
+// without its metadata
+type ImageAttachment struct {
+	Renditions []ImageRendition `json:"renditions"`
+	Width      int              `json:"width"`
+	Height     int              `json:"height"`
+	Blurhash   string           `json:"blurhash"`
+}
+
+// Rendition returns the rendition with the name, or nil
+func (a *ImageAttachment) Rendition(name string) *ImageRendition {
+	for i := range a.Renditions {
+		if a.Renditions[i].Name == name {
+			return &a.Renditions[i]
+		}
+	}
+	return nil
+}
+
+// PrepareImageAttachment decodes the image at the path, rotates it as its EXIF
+// orientation says and re-encodes it in a thumbnail, a medium and a full
+// rendition, which strips the EXIF and GPS metadata. The blurhash placeholder
+// is computed from the thumbnail
+func PrepareImageAttachment(imagePath string) (*ImageAttachment, error) {
+	payload, err := ioutil.ReadFile(imagePath)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := decodeImageData(payload, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	img = applyOrientation(img, exifOrientation(payload))
+
+	attachment := &ImageAttachment{
+		Width:  img.Bounds().Dx(),
+		Height: img.Bounds().Dy(),
+	}
+
+	for _, r := range attachmentRenditions {
+		resized := shrinkToLongSide(r.Dim, img)
+
+		bb := bytes.NewBuffer([]byte{})
+		err = CompressToFileLimits(bb, resized, r.Limits)
+		if err != nil {
+			return nil, err
+		}
+
+		config, err := jpeg.DecodeConfig(bytes.NewReader(bb.Bytes()))
+		if err != nil {
+			return nil, err
+		}
+
+		attachment.Renditions = append(attachment.Renditions, ImageRendition{
+			Name:     r.Name,
+			Payload:  bb.Bytes(),
+			Width:    config.Width,
+			Height:   config.Height,
+			FileSize: bb.Len(),
+		})
+
+		if r.Name == AttachmentThumbnailName {
+			xComponents, yComponents := blurhashXComponents, blurhashYComponents
+			if attachment.Height > attachment.Width {
+				xComponents, yComponents = yComponents, xComponents
+			}
+			attachment.Blurhash, err = Blurhash(resized, xComponents, yComponents)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return attachment, nil
+}
+
+// EncodeThumbnail encodes the image as small as the thumbnail rendition of
+// image attachments, it's used for the thumbnails of other attachments
+func EncodeThumbnail(img image.Image) ([]byte, error) {
+	bb := bytes.NewBuffer([]byte{})
+	err := CompressToFileLimits(bb, shrinkToLongSide(attachmentThumbnailDim, img), attachmentRenditions[0].Limits)
+	if err != nil {
+		return nil, err
+	}
+	return bb.Bytes(), nil
+}
+
+// shrinkToLongSide resizes the image so that its longest side isn't longer
+// than dim, smaller images are kept as they are
+func shrinkToLongSide(dim uint, img image.Image) image.Image {
+	if uint(img.Bounds().Dx()) <= dim && uint(img.Bounds().Dy()) <= dim {
+		return img
+	}
+	return resize.Thumbnail(dim, dim, img, resize.Bilinear)
+}
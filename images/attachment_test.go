@@ -0,0 +1,115 @@
+package images
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// exifJpeg returns a JPEG of the size, with an EXIF segment setting the
+// orientation
+func exifJpeg(t *testing.T, width, height int, orientation byte) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height/2; y++ {
+			img.Set(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+
+	bb := bytes.NewBuffer([]byte{})
+	require.NoError(t, jpeg.Encode(bb, img, nil))
+	encoded := bb.Bytes()
+
+	tiff := []byte{
+		'I', 'I', 0x2A, 0x00, 0x08, 0x00, 0x00, 0x00, // header, IFD0 at 8
+		0x01, 0x00, // one entry
+		0x12, 0x01, 0x03, 0x00, 0x01, 0x00, 0x00, 0x00, orientation, 0x00, 0x00, 0x00, // orientation
+		0x00, 0x00, 0x00, 0x00, // no next IFD
+	}
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	length := len(segment) + 2
+
+	payload := []byte{0xFF, 0xD8, 0xFF, 0xE1, byte(length >> 8), byte(length)}
+	payload = append(payload, segment...)
+	return append(payload, encoded[2:]...)
+}
+
+func TestExifOrientation(t *testing.T) {
+	payload := exifJpeg(t, 32, 16, 6)
+	require.True(t, HasExif(payload))
+	require.Equal(t, 6, exifOrientation(payload))
+
+	img, err := jpeg.Decode(bytes.NewReader(payload))
+	require.NoError(t, err)
+
+	rotated := applyOrientation(img, 6)
+	require.Equal(t, 16, rotated.Bounds().Dx())
+	require.Equal(t, 32, rotated.Bounds().Dy())
+
+	// The red top half ends up as the right half
+	r, _, _, _ := rotated.At(12, 16).RGBA()
+	require.Greater(t, r>>8, uint32(200))
+	r, _, _, _ = rotated.At(3, 16).RGBA()
+	require.Less(t, r>>8, uint32(50))
+
+	elephant, err := ioutil.ReadFile(path + "elephant.jpg")
+	require.NoError(t, err)
+	require.Equal(t, 1, exifOrientation(elephant))
+}
+
+func TestPrepareImageAttachment(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "image.jpg")
+	require.NoError(t, os.WriteFile(imagePath, exifJpeg(t, 1200, 600, 6), 0600))
+
+	attachment, err := PrepareImageAttachment(imagePath)
+	require.NoError(t, err)
+
+	require.Equal(t, 600, attachment.Width)
+	require.Equal(t, 1200, attachment.Height)
+	require.Len(t, attachment.Renditions, 3)
+
+	thumbnail := attachment.Rendition(AttachmentThumbnailName)
+	require.NotNil(t, thumbnail)
+	require.Equal(t, 160, thumbnail.Width)
+	require.Equal(t, 320, thumbnail.Height)
+
+	medium := attachment.Rendition(AttachmentMediumName)
+	require.NotNil(t, medium)
+	require.Equal(t, 1024, medium.Height)
+
+	full := attachment.Rendition(AttachmentFullName)
+	require.NotNil(t, full)
+	require.Equal(t, 1200, full.Height)
+
+	for _, rendition := range attachment.Renditions {
+		require.False(t, HasExif(rendition.Payload))
+		require.Equal(t, len(rendition.Payload), rendition.FileSize)
+	}
+
+	// 3x4 components for a portrait image
+	require.Len(t, attachment.Blurhash, 6+2*(3*4-1))
+	require.Equal(t, byte(blurhashCharacters[2+3*9]), attachment.Blurhash[0])
+}
+
+func TestBlurhash(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	for x := 0; x < 8; x++ {
+		for y := 0; y < 8; y++ {
+			img.Set(x, y, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+		}
+	}
+
+	hash, err := Blurhash(img, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, "00TSUA", hash)
+
+	_, err = Blurhash(img, 0, 10)
+	require.Error(t, err)
+}
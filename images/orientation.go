@@ -0,0 +1,134 @@
+package images
+
+import (
+	"encoding/binary"
+	"image"
+	"image/draw"
+)
+
+const (
+	jpegMarkerSOS  = 0xDA
+	jpegMarkerAPP1 = 0xE1
+
+	exifOrientationTag = 0x0112
+)
+
+var exifHeader = []byte("Exif\x00\x00")
+
+// exifSegment returns the EXIF segment of a JPEG, which holds the camera
+// details, the orientation and often the GPS position, and nil when there's none
+func exifSegment(payload []byte) []byte {
+	if !isJpeg(payload) {
+		return nil
+	}
+
+	offset := 2
+	for offset+4 <= len(payload) {
+		if payload[offset] != 0xFF {
+			return nil
+		}
+		marker := payload[offset+1]
+		if marker == jpegMarkerSOS {
+			return nil
+		}
+		length := int(binary.BigEndian.Uint16(payload[offset+2 : offset+4]))
+		if length < 2 || offset+2+length > len(payload) {
+			return nil
+		}
+		segment := payload[offset+4 : offset+2+length]
+		if marker == jpegMarkerAPP1 && len(segment) >= len(exifHeader) && string(segment[:len(exifHeader)]) == string(exifHeader) {
+			return segment[len(exifHeader):]
+		}
+		offset += 2 + length
+	}
+	return nil
+}
+
+// HasExif tells whether the payload is a JPEG carrying EXIF metadata
+func HasExif(payload []byte) bool {
+	return exifSegment(payload) != nil
+}
+
+// exifOrientation returns the EXIF orientation of a JPEG, from 1 to 8, and 1
+// when it's not set
+func exifOrientation(payload []byte) int {
+	tiff := exifSegment(payload)
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:8]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	entries := int(order.Uint16(tiff[ifd : ifd+2]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:entry+2]) != exifOrientationTag {
+			continue
+		}
+		orientation := int(order.Uint16(tiff[entry+8 : entry+10]))
+		if orientation < 1 || orientation > 8 {
+			return 1
+		}
+		return orientation
+	}
+	return 1
+}
+
+// applyOrientation rotates and flips the image as its EXIF orientation says,
+// so that it's displayed the same once the metadata is stripped
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	src := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Src)
+
+	dstBounds := image.Rect(0, 0, width, height)
+	if orientation >= 5 {
+		dstBounds = image.Rect(0, 0, height, width)
+	}
+	dst := image.NewRGBA(dstBounds)
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = width-1-x, y
+			case 3:
+				dx, dy = width-1-x, height-1-y
+			case 4:
+				dx, dy = x, height-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = height-1-y, x
+			case 7:
+				dx, dy = height-1-y, width-1-x
+			case 8:
+				dx, dy = y, width-1-x
+			}
+			dst.SetRGBA(dx, dy, src.RGBAAt(x, y))
+		}
+	}
+	return dst
+}
@@ -149,6 +149,13 @@ func decodeStringHash(input string) (string, error) {
 	return cid, nil
 }
 
+// ValidateHash checks that the hash is an IPFS content hash the downloader
+// can fetch
+func ValidateHash(hash string) error {
+	_, err := decodeStringHash(hash)
+	return err
+}
+
 // Get checks if an IPFS image exists and returns it from cache
 // otherwise downloads it from INFURA's ipfs gateway
 func (d *Downloader) Get(hash string, download bool) ([]byte, error) {
@@ -0,0 +1,133 @@
+package logutils
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// logBuffer keeps the most recent log lines in memory, to be exported for bug
+// reports
+type logBuffer struct {
+	mu    sync.Mutex
+	lines []string
+	next  int
+	full  bool
+}
+
+func (b *logBuffer) add(line string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.lines) == 0 {
+		return
+	}
+	b.lines[b.next] = line
+	b.next = (b.next + 1) % len(b.lines)
+	if b.next == 0 {
+		b.full = true
+	}
+}
+
+// recent returns the lines kept, oldest first
+func (b *logBuffer) recent() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.full {
+		return append([]string{}, b.lines[:b.next]...)
+	}
+	return append(append([]string{}, b.lines[b.next:]...), b.lines[:b.next]...)
+}
+
+var (
+	bufferMu sync.RWMutex
+	buffer   *logBuffer
+)
+
+// EnableLogBuffer keeps the last size log lines in memory, a size of 0
+// disables the buffer and drops the lines kept
+func EnableLogBuffer(size int) {
+	bufferMu.Lock()
+	defer bufferMu.Unlock()
+	if size <= 0 {
+		buffer = nil
+		return
+	}
+	buffer = &logBuffer{lines: make([]string, size)}
+}
+
+// bufferHandler passes the records to the handler and keeps them in the log
+// buffer when it's enabled
+func bufferHandler(h log.Handler) log.Handler {
+	format := log.LogfmtFormat()
+	return log.FuncHandler(func(r *log.Record) error {
+		bufferMu.RLock()
+		b := buffer
+		bufferMu.RUnlock()
+		if b != nil {
+			b.add(strings.TrimSuffix(string(format.Format(r)), "\n"))
+		}
+		return h.Log(r)
+	})
+}
+
+var (
+	// urlRegexp matches URLs, their path, query and credentials may hold the
+	// API keys of providers
+	urlRegexp = regexp.MustCompile(`\b([a-zA-Z][a-zA-Z0-9+.-]*://)([^/\s"'?#@\]]*@)?([^/\s"'?#\]]+)([^\s"'\]]*)`)
+	// longHexRegexp matches keys, signatures and hashes
+	longHexRegexp = regexp.MustCompile(`\b(0x)?[0-9a-fA-F]{64,}\b`)
+	addressRegexp = regexp.MustCompile(`\b(0x)?[0-9a-fA-F]{40}\b`)
+	// apiKeyRegexp matches the API keys of providers, such as Infura's
+	apiKeyRegexp = regexp.MustCompile(`\b[0-9a-fA-F]{32}\b`)
+	// chatKeyRegexp matches base58 encoded compressed chat keys
+	chatKeyRegexp = regexp.MustCompile(`\bzQ3[1-9A-HJ-NP-Za-km-z]{40,}\b`)
+)
+
+// hasHexLetter tells apart the hex strings without prefix from decimal
+// numbers, such as amounts
+func hasHexLetter(s string) bool {
+	return strings.ContainsAny(strings.TrimPrefix(s, "0x"), "abcdefABCDEF")
+}
+
+func redactHex(re *regexp.Regexp, line string, replacement string) string {
+	return re.ReplaceAllStringFunc(line, func(match string) string {
+		if !strings.HasPrefix(match, "0x") && !hasHexLetter(match) {
+			return match
+		}
+		return replacement
+	})
+}
+
+// Redact scrubs the keys, the addresses and the URL paths and queries of the
+// log line
+func Redact(line string) string {
+	line = urlRegexp.ReplaceAllStringFunc(line, func(match string) string {
+		parts := urlRegexp.FindStringSubmatch(match)
+		if parts[2] == "" && (parts[4] == "" || parts[4] == "/") {
+			return match
+		}
+		return parts[1] + parts[3] + "/<redacted>"
+	})
+	line = longHexRegexp.ReplaceAllString(line, "<redacted>")
+	line = redactHex(addressRegexp, line, "<address>")
+	line = redactHex(apiKeyRegexp, line, "<redacted>")
+	return chatKeyRegexp.ReplaceAllString(line, "<chat key>")
+}
+
+// ExportLogs returns the lines of the log buffer, redacted, oldest first
+func ExportLogs() []string {
+	bufferMu.RLock()
+	b := buffer
+	bufferMu.RUnlock()
+	if b == nil {
+		return []string{}
+	}
+
+	lines := b.recent()
+	for i, line := range lines {
+		lines[i] = Redact(line)
+	}
+	return lines
+}
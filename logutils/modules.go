@@ -0,0 +1,142 @@
+package logutils
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Subsystems whose log level can be changed at runtime
+const (
+	ModuleWaku         = "waku"
+	ModuleWallet       = "wallet"
+	ModuleMessenger    = "messenger"
+	ModuleCollectibles = "collectibles"
+)
+
+// modulePaths maps the source paths to the subsystems, the first matching
+// path wins so the more specific ones come first
+var modulePaths = []struct {
+	module string
+	path   string
+}{
+	{ModuleCollectibles, "/services/wallet/collectibles/"},
+	{ModuleWallet, "/services/wallet/"},
+	{ModuleWaku, "/go-waku/"},
+	{ModuleWaku, "/wakuv2/"},
+	{ModuleWaku, "/waku/"},
+	{ModuleWaku, "/services/wakuext/"},
+	{ModuleWaku, "/services/wakuv2ext/"},
+	{ModuleMessenger, "/protocol/"},
+}
+
+// zapAdapterFile is the source of the records forwarded from zap, they are
+// filtered by the adapter with the level of the subsystem logging them
+const zapAdapterFile = "logutils/zap_adapter.go"
+
+// moduleOf returns the subsystem of the source file, or "" when it's not part
+// of any
+func moduleOf(file string) string {
+	file = "/" + strings.ReplaceAll(file, "\\", "/")
+	if strings.Contains(file, "/vendor/") && !strings.Contains(file, "/go-waku/") {
+		return ""
+	}
+	for _, mp := range modulePaths {
+		if strings.Contains(file, mp.path) {
+			return mp.module
+		}
+	}
+	return ""
+}
+
+type moduleLevels struct {
+	mu           sync.RWMutex
+	defaultLevel log.Lvl
+	levels       map[string]log.Lvl
+}
+
+var levels = &moduleLevels{
+	defaultLevel: log.LvlInfo,
+	levels:       make(map[string]log.Lvl),
+}
+
+func (m *moduleLevels) setDefault(level log.Lvl) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.defaultLevel = level
+}
+
+// enabled returns whether the records of the level are logged for the
+// subsystem of the source file, computing the subsystem only when levels are
+// set for some of them
+func (m *moduleLevels) enabled(file func() string, level log.Lvl) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if len(m.levels) == 0 {
+		return level <= m.defaultLevel
+	}
+	if moduleLevel, ok := m.levels[moduleOf(file())]; ok {
+		return level <= moduleLevel
+	}
+	return level <= m.defaultLevel
+}
+
+func isModule(module string) bool {
+	switch module {
+	case ModuleWaku, ModuleWallet, ModuleMessenger, ModuleCollectibles:
+		return true
+	}
+	return false
+}
+
+// SetModuleLogLevel changes the log level of the subsystem at runtime, an
+// empty level makes it follow the global one again
+func SetModuleLogLevel(module string, levelStr string) error {
+	if !isModule(module) {
+		return fmt.Errorf("unknown log module: %s", module)
+	}
+
+	levels.mu.Lock()
+	defer levels.mu.Unlock()
+	if levelStr == "" {
+		delete(levels.levels, module)
+		return nil
+	}
+	level, err := log.LvlFromString(strings.ToLower(levelStr))
+	if err != nil {
+		return err
+	}
+	levels.levels[module] = level
+	return nil
+}
+
+// ModuleLogLevels returns the log levels set for the subsystems
+func ModuleLogLevels() map[string]string {
+	levels.mu.RLock()
+	defer levels.mu.RUnlock()
+	result := make(map[string]string, len(levels.levels))
+	for module, level := range levels.levels {
+		result[module] = level.String()
+	}
+	return result
+}
+
+// moduleFilterHandler passes the records of the level of their subsystem, or
+// of the global level, to the handler
+func moduleFilterHandler(h log.Handler) log.Handler {
+	return log.FuncHandler(func(r *log.Record) error {
+		var path string
+		file := func() string {
+			if path == "" {
+				path = r.Call.Frame().File
+			}
+			return path
+		}
+		if levels.enabled(file, r.Lvl) || strings.HasSuffix(file(), zapAdapterFile) {
+			return h.Log(r)
+		}
+		return nil
+	})
+}
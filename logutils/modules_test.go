@@ -0,0 +1,46 @@
+package logutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+func TestModuleOf(t *testing.T) {
+	require.Equal(t, ModuleCollectibles, moduleOf("/src/status-go/services/wallet/collectibles/manager.go"))
+	require.Equal(t, ModuleWallet, moduleOf("github.com/status-im/status-go/services/wallet/transfer/commands.go"))
+	require.Equal(t, ModuleWaku, moduleOf("/src/status-go/wakuv2/waku.go"))
+	require.Equal(t, ModuleWaku, moduleOf("/src/status-go/vendor/github.com/waku-org/go-waku/waku/v2/node/wakunode2.go"))
+	require.Equal(t, ModuleMessenger, moduleOf("C:\\src\\status-go\\protocol\\messenger.go"))
+	require.Equal(t, "", moduleOf("/src/status-go/vendor/github.com/libp2p/go-libp2p/p2p/protocol/identify/id.go"))
+	require.Equal(t, "", moduleOf("/src/status-go/api/geth_backend.go"))
+}
+
+func TestSetModuleLogLevel(t *testing.T) {
+	levels.setDefault(log.LvlInfo)
+	defer func() {
+		levels.mu.Lock()
+		levels.levels = make(map[string]log.Lvl)
+		levels.mu.Unlock()
+	}()
+
+	wallet := func() string { return "/src/status-go/services/wallet/service.go" }
+	messenger := func() string { return "/src/status-go/protocol/messenger.go" }
+
+	require.False(t, levels.enabled(wallet, log.LvlDebug))
+	require.NoError(t, SetModuleLogLevel(ModuleWallet, "DEBUG"))
+	require.NoError(t, SetModuleLogLevel(ModuleMessenger, "error"))
+	require.Equal(t, map[string]string{ModuleWallet: "dbug", ModuleMessenger: "eror"}, ModuleLogLevels())
+
+	require.True(t, levels.enabled(wallet, log.LvlDebug))
+	require.False(t, levels.enabled(messenger, log.LvlInfo))
+	require.True(t, levels.enabled(func() string { return "/src/status-go/api/geth_backend.go" }, log.LvlInfo))
+
+	require.NoError(t, SetModuleLogLevel(ModuleWallet, ""))
+	require.False(t, levels.enabled(wallet, log.LvlDebug))
+
+	require.Error(t, SetModuleLogLevel("unknown", "debug"))
+	require.Error(t, SetModuleLogLevel(ModuleWaku, "verbose"))
+}
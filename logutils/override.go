@@ -81,8 +81,8 @@ func enableRootLog(levelStr string, handler log.Handler) error {
 		return err
 	}
 
-	filteredHandler := log.LvlFilterHandler(level, handler)
-	log.Root().SetHandler(filteredHandler)
+	levels.setDefault(level)
+	log.Root().SetHandler(moduleFilterHandler(bufferHandler(handler)))
 
 	// go-libp2p logger
 	lvl, err := logging.LevelFromString(levelStr)
@@ -33,6 +33,15 @@ func (c gethLoggerCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapc
 	return ce
 }
 func (c gethLoggerCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	// The root handler doesn't know where the records forwarded from zap come
+	// from, they are filtered here with the level of their subsystem
+	caller := func() string {
+		return ent.Caller.File
+	}
+	if !levels.enabled(caller, gethLevel(ent.Level)) {
+		return nil
+	}
+
 	fields = append(c.fields[:], fields...)
 
 	var args []interface{}
@@ -100,6 +109,20 @@ func (c gethLoggerCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
 	return nil
 }
 
+func gethLevel(level zapcore.Level) log.Lvl {
+	switch level {
+	case zapcore.DebugLevel:
+		return log.LvlDebug
+	case zapcore.InfoLevel:
+		return log.LvlInfo
+	case zapcore.WarnLevel:
+		return log.LvlWarn
+	case zapcore.ErrorLevel:
+		return log.LvlError
+	}
+	return log.LvlCrit
+}
+
 func (gethLoggerCore) Sync() error {
 	return nil
 }
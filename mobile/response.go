@@ -5,6 +5,7 @@ import (
 
 	"github.com/status-im/status-go/account"
 	"github.com/status-im/status-go/eth-node/keystore"
+	"github.com/status-im/status-go/services/typeddata"
 	"github.com/status-im/status-go/transactions"
 )
 
@@ -29,6 +30,13 @@ type jsonrpcSuccessfulResponse struct {
 	Result interface{} `json:"result"`
 }
 
+// typedDataResponse is a successful response with the warnings of the typed
+// data, to show before it's signed
+type typedDataResponse struct {
+	Result   interface{}         `json:"result"`
+	Warnings []typeddata.Warning `json:"warnings"`
+}
+
 type jsonrpcErrorResponse struct {
 	Error jsonError `json:"error"`
 }
@@ -62,3 +70,15 @@ func prepareJSONResponseWithCode(result interface{}, err error, code int) string
 	}
 	return string(data)
 }
+
+func prepareTypedDataResponse(result interface{}, warnings []typeddata.Warning, err error) string {
+	if err != nil {
+		return prepareJSONResponse(nil, err)
+	}
+
+	data, err := json.Marshal(typedDataResponse{Result: result, Warnings: warnings})
+	if err != nil {
+		return prepareJSONResponseWithCode(nil, err, codeFailedParseResponse)
+	}
+	return string(data)
+}
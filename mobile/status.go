@@ -459,6 +459,46 @@ func LoginWithKeycard(accountData, password, keyHex string) string {
 	return makeJSONResponse(nil)
 }
 
+// AddProfile initializes an account without logging in, so that it can be
+// switched to with SwitchProfile.
+func AddProfile(requestJSON string) string {
+	var request requests.Login
+	err := json.Unmarshal([]byte(requestJSON), &request)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+	return makeJSONResponse(statusBackend.AddProfile(&request))
+}
+
+// GetProfiles returns the initialized accounts which can be switched to.
+func GetProfiles() string {
+	data, err := json.Marshal(statusBackend.GetProfiles())
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+	return string(data)
+}
+
+// SwitchProfile logs in an initialized account, keeping the active one
+// initialized. The login is signaled as with LoginAccount.
+func SwitchProfile(keyUID string) string {
+	api.RunAsync(func() error {
+		log.Debug("switching profile", "key-uid", keyUID)
+		err := statusBackend.SwitchProfile(keyUID)
+		if err != nil {
+			log.Error("failed to switch profile", "key-uid", keyUID, "error", err)
+			return err
+		}
+		return nil
+	})
+	return makeJSONResponse(nil)
+}
+
+// LogoutProfile logs out the account if it's active, otherwise closes it.
+func LogoutProfile(keyUID string) string {
+	return makeJSONResponse(statusBackend.LogoutProfile(keyUID))
+}
+
 // Logout is equivalent to clearing whisper identities.
 func Logout() string {
 	err := statusBackend.Logout()
@@ -514,7 +554,7 @@ func HashTypedData(data string) string {
 }
 
 // SignTypedDataV4 unmarshall data into TypedData, validate it and signs with selected account,
-// if password matches selected account.
+// if password matches selected account. The warnings of the typed data are returned with the signature.
 //
 //export SignTypedDataV4
 func SignTypedDataV4(data, address, password string) string {
@@ -523,11 +563,12 @@ func SignTypedDataV4(data, address, password string) string {
 	if err != nil {
 		return prepareJSONResponseWithCode(nil, err, codeFailedParseParams)
 	}
-	result, err := statusBackend.SignTypedDataV4(typed, address, password)
-	return prepareJSONResponse(result.String(), err)
+	result, warnings, err := statusBackend.SignTypedDataV4(typed, address, password)
+	return prepareTypedDataResponse(result.String(), warnings, err)
 }
 
-// HashTypedDataV4 unmarshalls data into TypedData, validates it and hashes it.
+// HashTypedDataV4 unmarshalls data into TypedData, validates it and hashes it. The warnings to show
+// before it's signed are returned with the hash.
 //
 //export HashTypedDataV4
 func HashTypedDataV4(data string) string {
@@ -536,8 +577,8 @@ func HashTypedDataV4(data string) string {
 	if err != nil {
 		return prepareJSONResponseWithCode(nil, err, codeFailedParseParams)
 	}
-	result, err := statusBackend.HashTypedDataV4(typed)
-	return prepareJSONResponse(result.String(), err)
+	result, warnings, err := statusBackend.HashTypedDataV4(typed)
+	return prepareTypedDataResponse(result.String(), warnings, err)
 }
 
 // Recover unmarshals rpc params {signDataString, signedData} and passes
@@ -693,6 +734,11 @@ func AppStateChange(state string) {
 	statusBackend.AppStateChange(state)
 }
 
+// BatterySaverChange handles the battery saver being enabled or disabled.
+func BatterySaverChange(enabled int) {
+	statusBackend.BatterySaverChange(enabled == 1)
+}
+
 // StartLocalNotifications
 func StartLocalNotifications() string {
 	err := statusBackend.StartLocalNotifications()
@@ -892,6 +938,25 @@ func ChangeDatabasePassword(KeyUID, password, newPassword string) string {
 	return makeJSONResponse(nil)
 }
 
+// UpgradeKeystore re-encrypts the key files encrypted with weak KDF
+// parameters, which is otherwise done in the background on login. It returns
+// once they're upgraded, the progress is sent as signals
+func UpgradeKeystore(password string) string {
+	err := statusBackend.UpgradeKeystore(password)
+	return makeJSONResponse(err)
+}
+
+// RotateDatabaseKey re-encrypts the database of the account with a key
+// derived from the new password, which can be the current one, and the KDF
+// iterations number, 0 keeping the current one
+func RotateDatabaseKey(KeyUID, password, newPassword string, kdfIterations int) string {
+	err := statusBackend.RotateDatabaseKey(KeyUID, password, newPassword, kdfIterations)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+	return makeJSONResponse(nil)
+}
+
 func ConvertToKeycardAccount(accountData, settingsJSON, keycardUID, password, newPassword string) string {
 	var account multiaccounts.Account
 	err := json.Unmarshal([]byte(accountData), &account)
@@ -1263,3 +1328,29 @@ func InitLogging(logSettingsJSON string) string {
 
 	return makeJSONResponse(err)
 }
+
+// SetModuleLogLevel changes at runtime the log level of a subsystem, one of
+// waku, wallet, messenger and collectibles. An empty level makes it follow the
+// global log level again
+func SetModuleLogLevel(module, level string) string {
+	err := logutils.SetModuleLogLevel(module, level)
+	return makeJSONResponse(err)
+}
+
+// GetModuleLogLevels returns the log levels set for the subsystems
+func GetModuleLogLevels() string {
+	return prepareJSONResponse(logutils.ModuleLogLevels(), nil)
+}
+
+// EnableLogBuffer keeps the last size log lines in memory to be exported with
+// ExportLogs, a size of 0 disables it
+func EnableLogBuffer(size int) string {
+	logutils.EnableLogBuffer(size)
+	return makeJSONResponse(nil)
+}
+
+// ExportLogs returns the log lines kept in memory, with the keys and the
+// addresses scrubbed, to be attached to bug reports
+func ExportLogs() string {
+	return prepareJSONResponse(logutils.ExportLogs(), nil)
+}
@@ -1162,6 +1162,11 @@ func (db *Database) UpdateAccountPosition(address types.Address, newPosition int
 		return err
 	}
 
+	err = db.setClockOfLastAccountsPositionChange(tx, clock)
+	if err != nil {
+		return err
+	}
+
 	// Update keypair clock if any but the watch only account was deleted.
 	if acc.KeyUID != "" {
 		err = db.updateKeypairClock(tx, acc.KeyUID, clock)
@@ -1170,3 +1175,79 @@ func (db *Database) UpdateAccountPosition(address types.Address, newPosition int
 
 	return nil
 }
+
+func (db *Database) setClockOfLastAccountsPositionChange(tx *sql.Tx, clock uint64) error {
+	if tx == nil {
+		return errDbTransactionIsNil
+	}
+
+	_, err := tx.Exec("UPDATE settings SET wallet_accounts_position_change_clock = ? WHERE synthetic_id = 'id'", clock)
+	return err
+}
+
+// GetClockOfLastAccountsPositionChange returns the clock of the last change
+// of the order of the accounts, made on this device or synced
+func (db *Database) GetClockOfLastAccountsPositionChange() (uint64, error) {
+	var clock uint64
+	err := db.db.QueryRow("SELECT wallet_accounts_position_change_clock FROM settings WHERE synthetic_id = 'id'").Scan(&clock)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return clock, err
+}
+
+// SetWalletAccountsPositions sets the positions of the accounts as they are
+// on a paired device, the accounts which aren't known are skipped
+func (db *Database) SetWalletAccountsPositions(accounts []*Account, clock uint64) (err error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	for _, acc := range accounts {
+		_, err = tx.Exec("UPDATE keypairs_accounts SET position = ? WHERE address = ?", acc.Position, acc.Address)
+		if err != nil {
+			return err
+		}
+	}
+
+	return db.setClockOfLastAccountsPositionChange(tx, clock)
+}
+
+// UpdateAccountHidden hides the account from the wallet, or shows it again
+func (db *Database) UpdateAccountHidden(address types.Address, hidden bool, clock uint64) (err error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	acc, err := db.getAccountByAddress(tx, address)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("UPDATE keypairs_accounts SET hidden = ?, clock = ? WHERE address = ?", hidden, clock, address)
+	if err != nil {
+		return err
+	}
+
+	if acc.KeyUID != "" {
+		return db.updateKeypairClock(tx, acc.KeyUID, clock)
+	}
+
+	return nil
+}
@@ -64,6 +64,47 @@ func TestUpdateAccountPosition(t *testing.T) {
 	require.Equal(t, accountsRes[1].Position, int64(1))
 }
 
+func TestSetWalletAccountsPositions(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+	accounts := []*Account{
+		{Address: types.Address{0x01}, Position: 0},
+		{Address: types.Address{0x02}, Position: 1},
+	}
+	require.NoError(t, db.SaveOrUpdateAccounts(accounts, false))
+
+	err := db.SetWalletAccountsPositions([]*Account{
+		{Address: types.Address{0x01}, Position: 1},
+		{Address: types.Address{0x02}, Position: 0},
+		{Address: types.Address{0x03}, Position: 2},
+	}, 10)
+	require.NoError(t, err)
+
+	accountsRes, err := db.GetAccounts()
+	require.NoError(t, err)
+	require.Len(t, accountsRes, 2)
+	require.Equal(t, types.Address{0x02}, accountsRes[0].Address)
+	require.Equal(t, types.Address{0x01}, accountsRes[1].Address)
+}
+
+func TestUpdateAccountHidden(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+	address := types.Address{0x01}
+	require.NoError(t, db.SaveOrUpdateAccounts([]*Account{{Address: address}}, false))
+
+	require.NoError(t, db.UpdateAccountHidden(address, true, 10))
+	acc, err := db.GetAccountByAddress(address)
+	require.NoError(t, err)
+	require.True(t, acc.Hidden)
+	require.Equal(t, uint64(10), acc.Clock)
+
+	require.NoError(t, db.UpdateAccountHidden(address, false, 11))
+	acc, err = db.GetAccountByAddress(address)
+	require.NoError(t, err)
+	require.False(t, acc.Hidden)
+}
+
 func TestGetWalletAddress(t *testing.T) {
 	db, stop := setupTestDB(t)
 	defer stop()
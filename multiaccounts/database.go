@@ -329,6 +329,11 @@ func (db *Database) UpdateAccountKeycardPairing(keyUID string, keycardPairing st
 	return err
 }
 
+func (db *Database) UpdateAccountKDFIterations(keyUID string, kdfIterations int) error {
+	_, err := db.db.Exec("UPDATE accounts SET kdfIterations = ? WHERE keyUid = ?", kdfIterations, keyUID)
+	return err
+}
+
 func (db *Database) UpdateAccountTimestamp(keyUID string, loginTimestamp int64) error {
 	_, err := db.db.Exec("UPDATE accounts SET loginTimestamp = ? WHERE keyUid = ?", loginTimestamp, keyUID)
 	return err
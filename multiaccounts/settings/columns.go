@@ -19,6 +19,14 @@ var (
 		reactFieldName: "appearance",
 		dBColumnName:   "appearance",
 	}
+	AttachmentAutoDownloadCellular = SettingField{
+		reactFieldName: "attachment-auto-download-cellular",
+		dBColumnName:   "attachment_auto_download_cellular",
+	}
+	AttachmentAutoDownloadWifi = SettingField{
+		reactFieldName: "attachment-auto-download-wifi",
+		dBColumnName:   "attachment_auto_download_wifi",
+	}
 	AutoMessageEnabled = SettingField{
 		reactFieldName: "auto-message-enabled?",
 		dBColumnName:   "auto_message_enabled",
@@ -34,11 +42,33 @@ var (
 		dBColumnName:   "backup_fetched",
 		valueHandler:   BoolHandler,
 	}
+	BackupInterval = SettingField{
+		reactFieldName: "backup-interval",
+		dBColumnName:   "backup_interval",
+	}
 	ChaosMode = SettingField{
 		reactFieldName: "chaos-mode?",
 		dBColumnName:   "chaos_mode",
 		valueHandler:   BoolHandler,
 	}
+	// CommunityBlocklistsEnabled applies the blocklists published by the
+	// owners of the joined communities to their chats
+	CommunityBlocklistsEnabled = SettingField{
+		reactFieldName: "community-blocklists-enabled?",
+		dBColumnName:   "community_blocklists_enabled",
+		valueHandler:   BoolHandler,
+		syncProtobufFactory: &SyncProtobufFactory{
+			fromInterface:     communityBlocklistsEnabledProtobufFactory,
+			fromStruct:        communityBlocklistsEnabledProtobufFactoryStruct,
+			valueFromProtobuf: BoolFromSyncProtobuf,
+			protobufType:      protobuf.SyncSetting_COMMUNITY_BLOCKLISTS_ENABLED,
+		},
+	}
+	ContactRequestsThrottlingEnabled = SettingField{
+		reactFieldName: "contact-requests-throttling-enabled?",
+		dBColumnName:   "contact_requests_throttling_enabled",
+		valueHandler:   BoolHandler,
+	}
 	Currency = SettingField{
 		reactFieldName: "currency",
 		dBColumnName:   "currency",
@@ -102,6 +132,10 @@ var (
 		dBColumnName:   "eip1581_address",
 		valueHandler:   AddressHandler,
 	}
+	EditHistoryLimit = SettingField{
+		reactFieldName: "edit-history-limit",
+		dBColumnName:   "edit_history_limit",
+	}
 	Fleet = SettingField{
 		reactFieldName: "fleet",
 		dBColumnName:   "fleet",
@@ -163,6 +197,10 @@ var (
 		reactFieldName: "last-updated",
 		dBColumnName:   "last_updated",
 	}
+	LatencyTelemetryEnabled = SettingField{
+		reactFieldName: "latency-telemetry-enabled?",
+		dBColumnName:   "latency_telemetry_enabled",
+	}
 	LatestDerivedPath = SettingField{
 		reactFieldName: "latest-derived-path",
 		dBColumnName:   "latest_derived_path",
@@ -177,6 +215,20 @@ var (
 		dBColumnName:   "link_previews_enabled_sites",
 		valueHandler:   JSONBlobHandler,
 	}
+	LinkPreviewsAllowlist = SettingField{
+		reactFieldName: "link-previews-allowlist",
+		dBColumnName:   "link_previews_allowlist",
+		valueHandler:   JSONBlobHandler,
+	}
+	LinkPreviewsDenylist = SettingField{
+		reactFieldName: "link-previews-denylist",
+		dBColumnName:   "link_previews_denylist",
+		valueHandler:   JSONBlobHandler,
+	}
+	LinkPreviewsMode = SettingField{
+		reactFieldName: "link-previews-mode",
+		dBColumnName:   "link_previews_mode",
+	}
 	LogLevel = SettingField{
 		reactFieldName: "log-level",
 		dBColumnName:   "log_level",
@@ -232,6 +284,18 @@ var (
 		dBColumnName:   "node_config",
 		valueHandler:   NodeConfigHandler,
 	}
+	// NotificationKeywords is a JSON array of the keywords which produce
+	// activity center notifications in the joined communities
+	NotificationKeywords = SettingField{
+		reactFieldName: "notification-keywords",
+		dBColumnName:   "notification_keywords",
+		syncProtobufFactory: &SyncProtobufFactory{
+			fromInterface:     notificationKeywordsProtobufFactory,
+			fromStruct:        notificationKeywordsProtobufFactoryStruct,
+			valueFromProtobuf: StringFromSyncProtobuf,
+			protobufType:      protobuf.SyncSetting_NOTIFICATION_KEYWORDS,
+		},
+	}
 	// NotificationsEnabled - we should remove this and realated things once mobile team starts usign `settings_notifications` package
 	NotificationsEnabled = SettingField{
 		reactFieldName: "notifications-enabled?",
@@ -243,6 +307,11 @@ var (
 		dBColumnName:   "opensea_enabled",
 		valueHandler:   BoolHandler,
 	}
+	OutgoingSecretsFilterEnabled = SettingField{
+		reactFieldName: "outgoing-secrets-filter-enabled?",
+		dBColumnName:   "outgoing_secrets_filter_enabled",
+		valueHandler:   BoolHandler,
+	}
 	PhotoPath = SettingField{
 		reactFieldName: "photo-path",
 		dBColumnName:   "photo_path",
@@ -277,6 +346,18 @@ var (
 			protobufType:      protobuf.SyncSetting_PREVIEW_PRIVACY,
 		},
 	}
+	// PrivacyMode disables link fetching, read receipts and typing indicators at once
+	PrivacyMode = SettingField{
+		reactFieldName: "privacy-mode?",
+		dBColumnName:   "privacy_mode",
+		valueHandler:   BoolHandler,
+		syncProtobufFactory: &SyncProtobufFactory{
+			fromInterface:     privacyModeProtobufFactory,
+			fromStruct:        privacyModeProtobufFactoryStruct,
+			valueFromProtobuf: BoolFromSyncProtobuf,
+			protobufType:      protobuf.SyncSetting_PRIVACY_MODE,
+		},
+	}
 	ProfilePicturesShowTo = SettingField{
 		reactFieldName: "profile-pictures-show-to",
 		dBColumnName:   "profile_pictures_show_to",
@@ -306,6 +387,11 @@ var (
 		dBColumnName:   "push_notifications_block_mentions",
 		valueHandler:   BoolHandler,
 	}
+	PushNotificationsCollapse = SettingField{
+		reactFieldName: "push-notifications-collapse?",
+		dBColumnName:   "push_notifications_collapse",
+		valueHandler:   BoolHandler,
+	}
 	PushNotificationsFromContactsOnly = SettingField{
 		reactFieldName: "push-notifications-from-contacts-only?",
 		dBColumnName:   "push_notifications_from_contacts_only",
@@ -389,10 +475,18 @@ var (
 		reactFieldName: "telemetry-server-url",
 		dBColumnName:   "telemetry_server_url",
 	}
+	// TestNetworksEnabled is the testnet mode, the wallet only uses the test
+	// networks when it's on
 	TestNetworksEnabled = SettingField{
 		reactFieldName: "test-networks-enabled?",
 		dBColumnName:   "test_networks_enabled",
 		valueHandler:   BoolHandler,
+		syncProtobufFactory: &SyncProtobufFactory{
+			fromInterface:     testNetworksEnabledProtobufFactory,
+			fromStruct:        testNetworksEnabledProtobufFactoryStruct,
+			valueFromProtobuf: BoolFromSyncProtobuf,
+			protobufType:      protobuf.SyncSetting_TEST_NETWORKS_ENABLED,
+		},
 	}
 	UseMailservers = SettingField{
 		reactFieldName: "use-mailservers?",
@@ -410,6 +504,16 @@ var (
 			protobufType:      protobuf.SyncSetting_ENS_USERNAMES,
 		},
 	}
+	VideoAutoDownloadCellular = SettingField{
+		reactFieldName: "video-auto-download-cellular?",
+		dBColumnName:   "video_auto_download_cellular",
+		valueHandler:   BoolHandler,
+	}
+	VideoAutoDownloadWifi = SettingField{
+		reactFieldName: "video-auto-download-wifi?",
+		dBColumnName:   "video_auto_download_wifi",
+		valueHandler:   BoolHandler,
+	}
 	WakuBloomFilterMode = SettingField{
 		reactFieldName: "waku-bloom-filter-mode",
 		dBColumnName:   "waku_bloom_filter_mode",
@@ -455,10 +559,15 @@ var (
 	SettingFieldRegister = []SettingField{
 		AnonMetricsShouldSend,
 		Appearance,
+		AttachmentAutoDownloadCellular,
+		AttachmentAutoDownloadWifi,
 		AutoMessageEnabled,
 		BackupEnabled,
 		BackupFetched,
+		BackupInterval,
 		ChaosMode,
+		CommunityBlocklistsEnabled,
+		ContactRequestsThrottlingEnabled,
 		Currency,
 		CurrentUserStatus,
 		CustomBootNodes,
@@ -469,6 +578,7 @@ var (
 		DisplayName,
 		Bio,
 		EIP1581Address,
+		EditHistoryLimit,
 		Fleet,
 		GifAPIKey,
 		GifFavourites,
@@ -479,9 +589,13 @@ var (
 		KeycardPairing,
 		LastBackup,
 		LastUpdated,
+		LatencyTelemetryEnabled,
 		LatestDerivedPath,
 		LinkPreviewRequestEnabled,
 		LinkPreviewsEnabledSites,
+		LinkPreviewsAllowlist,
+		LinkPreviewsDenylist,
+		LinkPreviewsMode,
 		LogLevel,
 		MessagesFromContactsOnly,
 		Mnemonic,
@@ -491,16 +605,20 @@ var (
 		NetworksCurrentNetwork,
 		NetworksNetworks,
 		NodeConfig,
+		NotificationKeywords,
 		NotificationsEnabled,
 		OpenseaEnabled,
+		OutgoingSecretsFilterEnabled,
 		PhotoPath,
 		PinnedMailservers,
 		PreferredName,
 		PreviewPrivacy,
+		PrivacyMode,
 		ProfilePicturesShowTo,
 		ProfilePicturesVisibility,
 		PublicKey,
 		PushNotificationsBlockMentions,
+		PushNotificationsCollapse,
 		PushNotificationsFromContactsOnly,
 		PushNotificationsServerEnabled,
 		RememberSyncingChoice,
@@ -515,6 +633,8 @@ var (
 		TestNetworksEnabled,
 		UseMailservers,
 		Usernames,
+		VideoAutoDownloadCellular,
+		VideoAutoDownloadWifi,
 		WakuBloomFilterMode,
 		WalletRootAddress,
 		WalletSetUpPassed,
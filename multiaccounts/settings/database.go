@@ -303,7 +303,7 @@ func (db *Database) SetSettingLastSynced(setting SettingField, clock uint64) err
 
 func (db *Database) GetSettings() (Settings, error) {
 	var s Settings
-	err := db.db.QueryRow("SELECT address, anon_metrics_should_send, chaos_mode, currency, current_network, custom_bootnodes, custom_bootnodes_enabled, dapps_address, display_name, bio, eip1581_address, fleet, hide_home_tooltip, installation_id, key_uid, keycard_instance_uid, keycard_paired_on, keycard_pairing, last_updated, latest_derived_path, link_preview_request_enabled, link_previews_enabled_sites, log_level, mnemonic, mnemonic_removed, name, networks, notifications_enabled, push_notifications_server_enabled, push_notifications_from_contacts_only, remote_push_notifications_enabled, send_push_notifications, push_notifications_block_mentions, photo_path, pinned_mailservers, preferred_name, preview_privacy, public_key, remember_syncing_choice, signing_phrase, stickers_packs_installed, stickers_packs_pending, stickers_recent_stickers, syncing_on_mobile_network, default_sync_period, use_mailservers, messages_from_contacts_only, usernames, appearance, profile_pictures_show_to, profile_pictures_visibility, wallet_root_address, wallet_set_up_passed, wallet_visible_tokens, waku_bloom_filter_mode, webview_allow_permission_requests, current_user_status, send_status_updates, gif_recents, gif_favorites, opensea_enabled, last_backup, backup_enabled, telemetry_server_url, auto_message_enabled, gif_api_key, test_networks_enabled, mutual_contact_enabled, include_watch_only_account FROM settings WHERE synthetic_id = 'id'").Scan(
+	err := db.db.QueryRow("SELECT address, anon_metrics_should_send, chaos_mode, currency, current_network, custom_bootnodes, custom_bootnodes_enabled, dapps_address, display_name, bio, eip1581_address, fleet, hide_home_tooltip, installation_id, key_uid, keycard_instance_uid, keycard_paired_on, keycard_pairing, last_updated, latest_derived_path, link_preview_request_enabled, link_previews_enabled_sites, log_level, mnemonic, mnemonic_removed, name, networks, notifications_enabled, push_notifications_server_enabled, push_notifications_from_contacts_only, remote_push_notifications_enabled, send_push_notifications, push_notifications_block_mentions, push_notifications_collapse, photo_path, pinned_mailservers, preferred_name, preview_privacy, public_key, remember_syncing_choice, signing_phrase, stickers_packs_installed, stickers_packs_pending, stickers_recent_stickers, syncing_on_mobile_network, default_sync_period, use_mailservers, messages_from_contacts_only, usernames, appearance, profile_pictures_show_to, profile_pictures_visibility, wallet_root_address, wallet_set_up_passed, wallet_visible_tokens, waku_bloom_filter_mode, webview_allow_permission_requests, current_user_status, send_status_updates, gif_recents, gif_favorites, opensea_enabled, last_backup, backup_enabled, telemetry_server_url, auto_message_enabled, gif_api_key, test_networks_enabled, mutual_contact_enabled, include_watch_only_account, privacy_mode, notification_keywords, community_blocklists_enabled FROM settings WHERE synthetic_id = 'id'").Scan(
 		&s.Address,
 		&s.AnonMetricsShouldSend,
 		&s.ChaosMode,
@@ -337,6 +337,7 @@ func (db *Database) GetSettings() (Settings, error) {
 		&s.RemotePushNotificationsEnabled,
 		&s.SendPushNotifications,
 		&s.PushNotificationsBlockMentions,
+		&s.PushNotificationsCollapse,
 		&s.PhotoPath,
 		&s.PinnedMailserver,
 		&s.PreferredName,
@@ -373,6 +374,9 @@ func (db *Database) GetSettings() (Settings, error) {
 		&s.TestNetworksEnabled,
 		&s.MutualContactEnabled,
 		&s.IncludeWatchOnlyAccount,
+		&s.PrivacyMode,
+		&s.NotificationKeywords,
+		&s.CommunityBlocklistsEnabled,
 	)
 
 	return s, err
@@ -517,6 +521,151 @@ func (db *Database) SetLastBackup(time uint64) error {
 	return db.SaveSettingField(LastBackup, time)
 }
 
+func (db *Database) BackupInterval() (result uint64, err error) {
+	err = db.makeSelectRow(BackupInterval).Scan(&result)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return result, err
+}
+
+func (db *Database) SetBackupInterval(seconds uint64) error {
+	return db.SaveSettingField(BackupInterval, seconds)
+}
+
+func (db *Database) LatencyTelemetryEnabled() (result bool, err error) {
+	err = db.makeSelectRow(LatencyTelemetryEnabled).Scan(&result)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return result, err
+}
+
+func (db *Database) SetLatencyTelemetryEnabled(enabled bool) error {
+	return db.SaveSettingField(LatencyTelemetryEnabled, enabled)
+}
+
+func (db *Database) EditHistoryLimit() (result uint64, err error) {
+	err = db.makeSelectRow(EditHistoryLimit).Scan(&result)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return result, err
+}
+
+func (db *Database) SetEditHistoryLimit(limit uint64) error {
+	return db.SaveSettingField(EditHistoryLimit, limit)
+}
+
+// AttachmentAutoDownloadLimits returns the size up to which the attachments
+// are downloaded automatically on Wi-Fi and on cellular connections
+func (db *Database) AttachmentAutoDownloadLimits() (wifi uint64, cellular uint64, err error) {
+	err = db.makeSelectRow(AttachmentAutoDownloadWifi).Scan(&wifi)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, 0, err
+	}
+	err = db.makeSelectRow(AttachmentAutoDownloadCellular).Scan(&cellular)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, 0, err
+	}
+	return wifi, cellular, nil
+}
+
+func (db *Database) SetAttachmentAutoDownloadLimits(wifi uint64, cellular uint64) error {
+	err := db.SaveSettingField(AttachmentAutoDownloadWifi, wifi)
+	if err != nil {
+		return err
+	}
+	return db.SaveSettingField(AttachmentAutoDownloadCellular, cellular)
+}
+
+// VideoAutoDownload tells whether the videos are downloaded automatically on
+// Wi-Fi and on cellular connections
+func (db *Database) VideoAutoDownload() (wifi bool, cellular bool, err error) {
+	err = db.makeSelectRow(VideoAutoDownloadWifi).Scan(&wifi)
+	if err != nil && err != sql.ErrNoRows {
+		return false, false, err
+	}
+	err = db.makeSelectRow(VideoAutoDownloadCellular).Scan(&cellular)
+	if err != nil && err != sql.ErrNoRows {
+		return false, false, err
+	}
+	return wifi, cellular, nil
+}
+
+func (db *Database) SetVideoAutoDownload(wifi bool, cellular bool) error {
+	err := db.SaveSettingField(VideoAutoDownloadWifi, wifi)
+	if err != nil {
+		return err
+	}
+	return db.SaveSettingField(VideoAutoDownloadCellular, cellular)
+}
+
+// OutgoingSecretsFilterEnabled tells whether the user is warned about the
+// messages looking like they contain a seed phrase or a private key
+func (db *Database) OutgoingSecretsFilterEnabled() (result bool, err error) {
+	err = db.makeSelectRow(OutgoingSecretsFilterEnabled).Scan(&result)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return result, err
+}
+
+func (db *Database) SetOutgoingSecretsFilterEnabled(enabled bool) error {
+	return db.SaveSettingField(OutgoingSecretsFilterEnabled, enabled)
+}
+
+// ContactRequestsThrottlingEnabled tells whether the contact requests and the
+// messages of non-contacts with a low trust score are kept out of the activity
+// center until they're reviewed
+func (db *Database) ContactRequestsThrottlingEnabled() (result bool, err error) {
+	err = db.makeSelectRow(ContactRequestsThrottlingEnabled).Scan(&result)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return result, err
+}
+
+func (db *Database) SetContactRequestsThrottlingEnabled(enabled bool) error {
+	return db.SaveSettingField(ContactRequestsThrottlingEnabled, enabled)
+}
+
+func (db *Database) LinkPreviewsMode() (result LinkPreviewsModeType, err error) {
+	err = db.makeSelectRow(LinkPreviewsMode).Scan(&result)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return result, err
+}
+
+func (db *Database) SetLinkPreviewsMode(mode LinkPreviewsModeType) error {
+	return db.SaveSettingField(LinkPreviewsMode, mode)
+}
+
+func (db *Database) LinkPreviewsAllowlist() (result []string, err error) {
+	err = db.makeSelectRow(LinkPreviewsAllowlist).Scan(&sqlite.JSONBlob{Data: &result})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return result, err
+}
+
+func (db *Database) SetLinkPreviewsAllowlist(hostnames []string) error {
+	return db.SaveSettingField(LinkPreviewsAllowlist, hostnames)
+}
+
+func (db *Database) LinkPreviewsDenylist() (result []string, err error) {
+	err = db.makeSelectRow(LinkPreviewsDenylist).Scan(&sqlite.JSONBlob{Data: &result})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return result, err
+}
+
+func (db *Database) SetLinkPreviewsDenylist(hostnames []string) error {
+	return db.SaveSettingField(LinkPreviewsDenylist, hostnames)
+}
+
 func (db *Database) SetBackupFetched(fetched bool) error {
 	return db.SaveSettingField(BackupFetched, fetched)
 }
@@ -658,3 +807,53 @@ func (db *Database) IncludeWatchOnlyAccount() (result bool, err error) {
 	err = db.makeSelectRow(IncludeWatchOnlyAccount).Scan(&result)
 	return result, err
 }
+
+func (db *Database) PrivacyMode() (result bool, err error) {
+	err = db.makeSelectRow(PrivacyMode).Scan(&result)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return result, err
+}
+
+func (db *Database) SetPrivacyMode(enabled bool) error {
+	return db.SaveSettingField(PrivacyMode, enabled)
+}
+
+// NotificationKeywords returns the keywords the user subscribed to
+func (db *Database) NotificationKeywords() ([]string, error) {
+	var encoded string
+	err := db.makeSelectRow(NotificationKeywords).Scan(&encoded)
+	if err == sql.ErrNoRows || (err == nil && encoded == "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var keywords []string
+	err = json.Unmarshal([]byte(encoded), &keywords)
+	return keywords, err
+}
+
+func (db *Database) SetNotificationKeywords(keywords []string) error {
+	encoded, err := json.Marshal(keywords)
+	if err != nil {
+		return err
+	}
+	return db.SaveSettingField(NotificationKeywords, string(encoded))
+}
+
+// CommunityBlocklistsEnabled tells whether the blocklists published by the
+// owners of the joined communities are applied to their chats
+func (db *Database) CommunityBlocklistsEnabled() (result bool, err error) {
+	err = db.makeSelectRow(CommunityBlocklistsEnabled).Scan(&result)
+	if err == sql.ErrNoRows {
+		return true, nil
+	}
+	return result, err
+}
+
+func (db *Database) SetCommunityBlocklistsEnabled(enabled bool) error {
+	return db.SaveSettingField(CommunityBlocklistsEnabled, enabled)
+}
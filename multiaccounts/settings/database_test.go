@@ -22,29 +22,30 @@ var (
 
 	networks = json.RawMessage("{}")
 	settings = Settings{
-		Address:                   types.HexToAddress("0xdC540f3745Ff2964AFC1171a5A0DD726d1F6B472"),
-		AnonMetricsShouldSend:     false,
-		CurrentNetwork:            "mainnet_rpc",
-		DappsAddress:              types.HexToAddress("0xD1300f99fDF7346986CbC766903245087394ecd0"),
-		InstallationID:            "d3efcff6-cffa-560e-a547-21d3858cbc51",
-		KeyUID:                    "0x4e8129f3edfc004875be17bf468a784098a9f69b53c095be1f52deff286935ab",
-		BackupEnabled:             true,
-		LatestDerivedPath:         0,
-		Name:                      "Jittery Cornflowerblue Kingbird",
-		Networks:                  &networks,
-		PhotoPath:                 "data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAADIAAAAyCAIAAACRXR/mAAAAjklEQVR4nOzXwQmFMBAAUZXUYh32ZB32ZB02sxYQQSZGsod55/91WFgSS0RM+SyjA56ZRZhFmEWYRRT6h+M6G16zrxv6fdJpmUWYRbxsYr13dKfanpN0WmYRZhGzXz6AWYRZRIfbaX26fT9Jk07LLMIsosPt9I/dTDotswizCG+nhFmEWYRZhFnEHQAA///z1CFkYamgfQAAAABJRU5ErkJggg==",
-		PreviewPrivacy:            false,
-		PublicKey:                 "0x04211fe0f69772ecf7eb0b5bfc7678672508a9fb01f2d699096f0d59ef7fe1a0cb1e648a80190db1c0f5f088872444d846f2956d0bd84069f3f9f69335af852ac0",
-		SigningPhrase:             "yurt joey vibe",
-		SendPushNotifications:     true,
-		ProfilePicturesShowTo:     ProfilePicturesShowToContactsOnly,
-		ProfilePicturesVisibility: ProfilePicturesVisibilityContactsOnly,
-		DefaultSyncPeriod:         777600,
-		UseMailservers:            true,
-		LinkPreviewRequestEnabled: true,
-		SendStatusUpdates:         true,
-		IncludeWatchOnlyAccount:   true,
-		WalletRootAddress:         types.HexToAddress("0x3B591fd819F86D0A6a2EF2Bcb94f77807a7De1a6")}
+		Address:                    types.HexToAddress("0xdC540f3745Ff2964AFC1171a5A0DD726d1F6B472"),
+		AnonMetricsShouldSend:      false,
+		CurrentNetwork:             "mainnet_rpc",
+		DappsAddress:               types.HexToAddress("0xD1300f99fDF7346986CbC766903245087394ecd0"),
+		InstallationID:             "d3efcff6-cffa-560e-a547-21d3858cbc51",
+		KeyUID:                     "0x4e8129f3edfc004875be17bf468a784098a9f69b53c095be1f52deff286935ab",
+		BackupEnabled:              true,
+		LatestDerivedPath:          0,
+		Name:                       "Jittery Cornflowerblue Kingbird",
+		Networks:                   &networks,
+		PhotoPath:                  "data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAADIAAAAyCAIAAACRXR/mAAAAjklEQVR4nOzXwQmFMBAAUZXUYh32ZB32ZB02sxYQQSZGsod55/91WFgSS0RM+SyjA56ZRZhFmEWYRRT6h+M6G16zrxv6fdJpmUWYRbxsYr13dKfanpN0WmYRZhGzXz6AWYRZRIfbaX26fT9Jk07LLMIsosPt9I/dTDotswizCG+nhFmEWYRZhFnEHQAA///z1CFkYamgfQAAAABJRU5ErkJggg==",
+		PreviewPrivacy:             false,
+		PublicKey:                  "0x04211fe0f69772ecf7eb0b5bfc7678672508a9fb01f2d699096f0d59ef7fe1a0cb1e648a80190db1c0f5f088872444d846f2956d0bd84069f3f9f69335af852ac0",
+		SigningPhrase:              "yurt joey vibe",
+		SendPushNotifications:      true,
+		ProfilePicturesShowTo:      ProfilePicturesShowToContactsOnly,
+		ProfilePicturesVisibility:  ProfilePicturesVisibilityContactsOnly,
+		DefaultSyncPeriod:          777600,
+		UseMailservers:             true,
+		LinkPreviewRequestEnabled:  true,
+		SendStatusUpdates:          true,
+		IncludeWatchOnlyAccount:    true,
+		CommunityBlocklistsEnabled: true,
+		WalletRootAddress:          types.HexToAddress("0x3B591fd819F86D0A6a2EF2Bcb94f77807a7De1a6")}
 )
 
 func setupTestDB(t *testing.T) (*Database, func()) {
@@ -187,3 +188,61 @@ func TestSyncColumnsSet(t *testing.T) {
 		}
 	}
 }
+
+func TestNotificationKeywords(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	require.NoError(t, db.CreateSettings(settings, config))
+
+	keywords, err := db.NotificationKeywords()
+	require.NoError(t, err)
+	require.Empty(t, keywords)
+
+	require.NoError(t, db.SetNotificationKeywords([]string{"status", "waku"}))
+	keywords, err = db.NotificationKeywords()
+	require.NoError(t, err)
+	require.Equal(t, []string{"status", "waku"}, keywords)
+
+	// The change is queued to be synced
+	synced := <-db.SyncQueue
+	require.Equal(t, NotificationKeywords.GetReactName(), synced.GetReactName())
+	require.Equal(t, `["status","waku"]`, synced.Value)
+}
+
+func TestTestNetworksEnabled(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	require.NoError(t, db.CreateSettings(settings, config))
+
+	require.NoError(t, db.SaveSettingField(TestNetworksEnabled, true))
+	enabled, err := db.GetTestNetworksEnabled()
+	require.NoError(t, err)
+	require.True(t, enabled)
+
+	// The mode is synced with the paired devices
+	synced := <-db.SyncQueue
+	require.Equal(t, TestNetworksEnabled.GetReactName(), synced.GetReactName())
+	require.Equal(t, true, synced.Value)
+}
+
+func TestCommunityBlocklistsEnabled(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	require.NoError(t, db.CreateSettings(settings, config))
+
+	// Opted in by default
+	enabled, err := db.CommunityBlocklistsEnabled()
+	require.NoError(t, err)
+	require.True(t, enabled)
+
+	require.NoError(t, db.SetCommunityBlocklistsEnabled(false))
+	enabled, err = db.CommunityBlocklistsEnabled()
+	require.NoError(t, err)
+	require.False(t, enabled)
+
+	synced := <-db.SyncQueue
+	require.Equal(t, CommunityBlocklistsEnabled.GetReactName(), synced.GetReactName())
+}
@@ -22,3 +22,17 @@ const (
 	ProfilePicturesShowToEveryone
 	ProfilePicturesShowToNone
 )
+
+type LinkPreviewsModeType int
+
+const (
+	// LinkPreviewsModeClient leaves it to the client to unfurl the links of
+	// outgoing messages
+	LinkPreviewsModeClient LinkPreviewsModeType = iota
+	// LinkPreviewsModeSender unfurls the links of outgoing messages before
+	// sending them, when no preview was provided by the client
+	LinkPreviewsModeSender
+	// LinkPreviewsModePrivacy never fetches third-party URLs, only the
+	// previews embedded by senders are shown
+	LinkPreviewsModePrivacy
+)
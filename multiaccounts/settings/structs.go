@@ -155,7 +155,9 @@ type Settings struct {
 	PushNotificationsFromContactsOnly bool `json:"push-notifications-from-contacts-only?,omitempty"`
 	// PushNotificationsBlockMentions indicates whether we should receive notifications for mentions
 	PushNotificationsBlockMentions bool `json:"push-notifications-block-mentions?,omitempty"`
-	RememberSyncingChoice          bool `json:"remember-syncing-choice?,omitempty"`
+	// PushNotificationsCollapse indicates whether the notifications of a chat should be grouped in a single summary
+	PushNotificationsCollapse bool `json:"push-notifications-collapse?,omitempty"`
+	RememberSyncingChoice     bool `json:"remember-syncing-choice?,omitempty"`
 	// RemotePushNotificationsEnabled indicates whether we should be using remote notifications (ios only for now)
 	RemotePushNotificationsEnabled bool             `json:"remote-push-notifications-enabled?,omitempty"`
 	SigningPhrase                  string           `json:"signing-phrase"`
@@ -191,6 +193,9 @@ type Settings struct {
 	GifAPIKey                      string                        `json:"gifs/api-key"`
 	TestNetworksEnabled            bool                          `json:"test-networks-enabled?,omitempty"`
 	IncludeWatchOnlyAccount        bool                          `json:"include-watch-only-account?,omitempty"`
+	PrivacyMode                    bool                          `json:"privacy-mode?,omitempty"`
+	NotificationKeywords           string                        `json:"notification-keywords,omitempty"`
+	CommunityBlocklistsEnabled     bool                          `json:"community-blocklists-enabled?,omitempty"`
 }
 
 func (s Settings) MarshalJSON() ([]byte, error) {
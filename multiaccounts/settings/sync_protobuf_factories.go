@@ -565,3 +565,103 @@ func includeWatchOnlyAccountProtobufFactory(value interface{}, clock uint64, cha
 func includeWatchOnlyAccountProtobufFactoryStruct(s Settings, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
 	return buildRawIncludeWatchOnlyAccountSyncMessage(s.IncludeWatchOnlyAccount, clock, chatID)
 }
+
+// PrivacyMode
+
+func buildRawPrivacyModeSyncMessage(v bool, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	pb := &protobuf.SyncSetting{
+		Type:  protobuf.SyncSetting_PRIVACY_MODE,
+		Value: &protobuf.SyncSetting_ValueBool{ValueBool: v},
+		Clock: clock,
+	}
+	rm, err := buildRawSyncSettingMessage(pb, chatID)
+	return rm, pb, err
+}
+
+func privacyModeProtobufFactory(value interface{}, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	v, err := assertBool(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buildRawPrivacyModeSyncMessage(v, clock, chatID)
+}
+
+func privacyModeProtobufFactoryStruct(s Settings, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	return buildRawPrivacyModeSyncMessage(s.PrivacyMode, clock, chatID)
+}
+
+// NotificationKeywords
+
+func buildRawNotificationKeywordsSyncMessage(v string, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	pb := &protobuf.SyncSetting{
+		Type:  protobuf.SyncSetting_NOTIFICATION_KEYWORDS,
+		Value: &protobuf.SyncSetting_ValueString{ValueString: v},
+		Clock: clock,
+	}
+	rm, err := buildRawSyncSettingMessage(pb, chatID)
+	return rm, pb, err
+}
+
+func notificationKeywordsProtobufFactory(value interface{}, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	v, err := assertString(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buildRawNotificationKeywordsSyncMessage(v, clock, chatID)
+}
+
+func notificationKeywordsProtobufFactoryStruct(s Settings, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	return buildRawNotificationKeywordsSyncMessage(s.NotificationKeywords, clock, chatID)
+}
+
+// CommunityBlocklistsEnabled
+
+func buildRawCommunityBlocklistsEnabledSyncMessage(v bool, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	pb := &protobuf.SyncSetting{
+		Type:  protobuf.SyncSetting_COMMUNITY_BLOCKLISTS_ENABLED,
+		Value: &protobuf.SyncSetting_ValueBool{ValueBool: v},
+		Clock: clock,
+	}
+	rm, err := buildRawSyncSettingMessage(pb, chatID)
+	return rm, pb, err
+}
+
+func communityBlocklistsEnabledProtobufFactory(value interface{}, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	v, err := assertBool(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buildRawCommunityBlocklistsEnabledSyncMessage(v, clock, chatID)
+}
+
+func communityBlocklistsEnabledProtobufFactoryStruct(s Settings, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	return buildRawCommunityBlocklistsEnabledSyncMessage(s.CommunityBlocklistsEnabled, clock, chatID)
+}
+
+// TestNetworksEnabled
+
+func buildRawTestNetworksEnabledSyncMessage(v bool, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	pb := &protobuf.SyncSetting{
+		Type:  protobuf.SyncSetting_TEST_NETWORKS_ENABLED,
+		Value: &protobuf.SyncSetting_ValueBool{ValueBool: v},
+		Clock: clock,
+	}
+	rm, err := buildRawSyncSettingMessage(pb, chatID)
+	return rm, pb, err
+}
+
+func testNetworksEnabledProtobufFactory(value interface{}, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	v, err := assertBool(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buildRawTestNetworksEnabledSyncMessage(v, clock, chatID)
+}
+
+func testNetworksEnabledProtobufFactoryStruct(s Settings, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	return buildRawTestNetworksEnabledSyncMessage(s.TestNetworksEnabled, clock, chatID)
+}
@@ -0,0 +1,130 @@
+package node
+
+import (
+	"path"
+	"reflect"
+	"time"
+
+	"github.com/status-im/status-go/common"
+	"github.com/status-im/status-go/signal"
+)
+
+// deferredServicesTimeout is how long after the start of the node the
+// deferred services are started when the messenger doesn't get started
+const deferredServicesTimeout = 30 * time.Second
+
+// deferredService is a service whose non-critical work starts after the
+// messenger is responsive
+type deferredService struct {
+	name    string
+	service common.DeferredService
+}
+
+// serviceName returns the name of the package of the service, used in the
+// readiness signals
+func serviceName(s interface{}) string {
+	t := reflect.TypeOf(s)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return path.Base(t.PkgPath())
+}
+
+// collectDeferredServices keeps the services with work to start after the
+// messenger, it must be called with the lock held
+func (n *StatusNode) collectDeferredServices(services []common.StatusService) {
+	n.deferredServices = nil
+	n.deferredStarted = false
+	for _, s := range services {
+		if ds, ok := s.(common.DeferredService); ok {
+			n.deferredServices = append(n.deferredServices, deferredService{name: serviceName(s), service: ds})
+		}
+	}
+}
+
+// scheduleDeferredServices starts the deferred services after a timeout, in
+// case the messenger isn't started, it must be called with the lock held
+func (n *StatusNode) scheduleDeferredServices() {
+	n.cancelDeferredServices()
+	n.deferredTimer = time.AfterFunc(deferredServicesTimeout, n.StartDeferredServices)
+}
+
+// cancelDeferredServices drops the pending deferred start, it must be called
+// with the lock held
+func (n *StatusNode) cancelDeferredServices() {
+	if n.deferredTimer != nil {
+		n.deferredTimer.Stop()
+		n.deferredTimer = nil
+	}
+}
+
+// StartDeferredServices starts the non-critical work of the services in the
+// background, sending a signal when each of them is ready and one when all of
+// them are. It's run once per start of the node
+func (n *StatusNode) StartDeferredServices() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !n.isRunning() || n.deferredStarted {
+		return
+	}
+	n.deferredStarted = true
+	n.cancelDeferredServices()
+	if len(n.deferredServices) == 0 {
+		signal.SendDeferredServicesReady()
+		return
+	}
+
+	n.deferredWG.Add(1)
+	go func() {
+		defer n.deferredWG.Done()
+		for i := 0; ; i++ {
+			started, running := n.startDeferredService(i)
+			if !running {
+				return
+			}
+			if started == nil {
+				signal.SendDeferredServicesReady()
+				return
+			}
+			signal.SendServiceReady(started.name, started.err)
+		}
+	}()
+}
+
+type startedService struct {
+	name string
+	err  error
+}
+
+// startDeferredService starts the i-th deferred service with the lock held, so
+// that the node can't be stopped meanwhile. It returns nil once all of them are
+// started, and false when the node was stopped
+func (n *StatusNode) startDeferredService(i int) (*startedService, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !n.isRunning() || n.deferredServices == nil {
+		return nil, false
+	}
+	if i >= len(n.deferredServices) {
+		return nil, true
+	}
+
+	s := n.deferredServices[i]
+	err := s.service.StartDeferred()
+	if err != nil {
+		n.log.Error("failed to start deferred service", "service", s.name, "err", err)
+	}
+	return &startedService{name: s.name, err: err}, true
+}
+
+// stopDeferredServices drops the deferred services which aren't started yet
+// and waits for the one being started, it must be called without the lock
+func (n *StatusNode) stopDeferredServices() {
+	n.mu.Lock()
+	n.cancelDeferredServices()
+	n.deferredServices = nil
+	n.deferredStarted = true
+	n.mu.Unlock()
+
+	n.deferredWG.Wait()
+}
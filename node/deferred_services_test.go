@@ -0,0 +1,45 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/common"
+	"github.com/status-im/status-go/services/browsers"
+	"github.com/status-im/status-go/services/collectibles"
+	"github.com/status-im/status-go/services/wallet"
+)
+
+func TestCollectDeferredServices(t *testing.T) {
+	n := New(nil)
+	n.deferredStarted = true
+
+	n.collectDeferredServices([]common.StatusService{&browsers.Service{}, &wallet.Service{}, &collectibles.Service{}})
+
+	require.False(t, n.deferredStarted)
+	require.Len(t, n.deferredServices, 2)
+	require.Equal(t, "wallet", n.deferredServices[0].name)
+	require.Equal(t, "collectibles", n.deferredServices[1].name)
+}
+
+func TestStartDeferredServicesNotRunning(t *testing.T) {
+	n := New(nil)
+	n.collectDeferredServices([]common.StatusService{&wallet.Service{}})
+
+	n.StartDeferredServices()
+	require.False(t, n.deferredStarted)
+}
+
+func TestStopDeferredServices(t *testing.T) {
+	n := New(nil)
+	n.collectDeferredServices([]common.StatusService{&wallet.Service{}})
+
+	n.stopDeferredServices()
+	require.True(t, n.deferredStarted)
+	require.Empty(t, n.deferredServices)
+
+	started, running := n.startDeferredService(0)
+	require.Nil(t, started)
+	require.False(t, running)
+}
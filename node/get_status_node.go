@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"sync"
+	"time"
 
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/syndtr/goleveldb/leveldb"
@@ -45,6 +46,7 @@ import (
 	"github.com/status-im/status-go/services/personal"
 	"github.com/status-im/status-go/services/rpcfilters"
 	"github.com/status-im/status-go/services/rpcstats"
+	"github.com/status-im/status-go/services/snapshot"
 	"github.com/status-im/status-go/services/status"
 	"github.com/status-im/status-go/services/stickers"
 	"github.com/status-im/status-go/services/subscriptions"
@@ -123,9 +125,16 @@ type StatusNode struct {
 	ensSrvc                *ens.Service
 	collectiblesSrvc       *collectibles.Service
 	gifSrvc                *gif.Service
+	snapshotSrvc           *snapshot.Service
 	stickersSrvc           *stickers.Service
 	chatSrvc               *chat.Service
 	updatesSrvc            *updates.Service
+
+	// services with work started after the messenger
+	deferredServices []deferredService
+	deferredStarted  bool
+	deferredTimer    *time.Timer
+	deferredWG       sync.WaitGroup
 }
 
 // New makes new instance of StatusNode.
@@ -288,7 +297,11 @@ func (n *StatusNode) startWithDB(config *params.NodeConfig, accs *accounts.Manag
 	if err := n.initServices(config, n.httpServer); err != nil {
 		return err
 	}
-	return n.startGethNode()
+	if err := n.startGethNode(); err != nil {
+		return err
+	}
+	n.scheduleDeferredServices()
+	return nil
 }
 
 func (n *StatusNode) createNode(config *params.NodeConfig, accs *accounts.Manager, db *leveldb.DB) (err error) {
@@ -311,6 +324,7 @@ func (n *StatusNode) setupRPCClient() (err error) {
 	if err != nil {
 		return
 	}
+	n.rpcClient.StartHealthChecks()
 
 	return
 }
@@ -431,6 +445,8 @@ func (n *StatusNode) startDiscovery() error {
 
 // Stop will stop current StatusNode. A stopped node cannot be resumed.
 func (n *StatusNode) Stop() error {
+	n.stopDeferredServices()
+
 	n.mu.Lock()
 	defer n.mu.Unlock()
 
@@ -443,6 +459,8 @@ func (n *StatusNode) Stop() error {
 
 // stop will stop current StatusNode. A stopped node cannot be resumed.
 func (n *StatusNode) stop() error {
+	n.cancelDeferredServices()
+
 	if n.isDiscoveryRunning() {
 		if err := n.stopDiscovery(); err != nil {
 			n.log.Error("Error stopping the discovery components", "error", err)
@@ -456,6 +474,9 @@ func (n *StatusNode) stop() error {
 		return err
 	}
 
+	if n.rpcClient != nil {
+		n.rpcClient.StopHealthChecks()
+	}
 	n.rpcClient = nil
 	// We need to clear `gethNode` because config is passed to `Start()`
 	// and may be completely different. Similarly with `config`.
@@ -498,6 +519,7 @@ func (n *StatusNode) stop() error {
 	n.wakuV2Srvc = nil
 	n.wakuV2ExtSrvc = nil
 	n.ensSrvc = nil
+	n.snapshotSrvc = nil
 	n.collectiblesSrvc = nil
 	n.stickersSrvc = nil
 	n.publicMethods = make(map[string]bool)
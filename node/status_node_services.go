@@ -38,6 +38,7 @@ import (
 	"github.com/status-im/status-go/services/personal"
 	"github.com/status-im/status-go/services/rpcfilters"
 	"github.com/status-im/status-go/services/rpcstats"
+	"github.com/status-im/status-go/services/snapshot"
 	"github.com/status-im/status-go/services/status"
 	"github.com/status-im/status-go/services/stickers"
 	"github.com/status-im/status-go/services/subscriptions"
@@ -86,6 +87,7 @@ func (b *StatusNode) initServices(config *params.NodeConfig, mediaServer *server
 	services = appendIf(config.MailserversConfig.Enabled, services, b.mailserversService())
 	services = appendIf(config.Web3ProviderConfig.Enabled, services, b.providerService(accDB))
 	services = append(services, b.gifService(accDB))
+	services = append(services, b.snapshotService(accDB))
 	services = append(services, b.ChatService(accDB))
 
 	if config.WakuConfig.Enabled {
@@ -152,6 +154,7 @@ func (b *StatusNode) initServices(config *params.NodeConfig, mediaServer *server
 	}
 
 	b.services = services
+	b.collectDeferredServices(services)
 
 	return nil
 }
@@ -184,6 +187,7 @@ func (b *StatusNode) wakuExtService(config *params.NodeConfig) (*wakuext.Service
 
 	if b.wakuExtSrvc == nil {
 		b.wakuExtSrvc = wakuext.New(*config, b.nodeBridge(), b.rpcClient, ext.EnvelopeSignalHandler{}, b.db)
+		b.wakuExtSrvc.SetOnMessengerStarted(b.StartDeferredServices)
 	}
 
 	b.wakuExtSrvc.SetP2PServer(b.gethNode.Server())
@@ -196,6 +200,7 @@ func (b *StatusNode) wakuV2ExtService(config *params.NodeConfig) (*wakuv2ext.Ser
 	}
 	if b.wakuV2ExtSrvc == nil {
 		b.wakuV2ExtSrvc = wakuv2ext.New(*config, b.nodeBridge(), b.rpcClient, ext.EnvelopeSignalHandler{}, b.db)
+		b.wakuV2ExtSrvc.SetOnMessengerStarted(b.StartDeferredServices)
 	}
 
 	b.wakuV2ExtSrvc.SetP2PServer(b.gethNode.Server())
@@ -221,6 +226,10 @@ func (b *StatusNode) BrowserService() *browsers.Service {
 	return b.browsersSrvc
 }
 
+func (b *StatusNode) CollectiblesService() *collectibles.Service {
+	return b.collectiblesSrvc
+}
+
 func (b *StatusNode) EnsService() *ens.Service {
 	return b.ensSrvc
 }
@@ -315,7 +324,7 @@ func (b *StatusNode) wakuV2Service(nodeConfig *params.NodeConfig, telemetryServe
 			cfg.MaxMessageSize = nodeConfig.WakuV2Config.MaxMessageSize
 		}
 
-		w, err := wakuv2.New(nodeConfig.NodeKey, nodeConfig.ClusterConfig.Fleet, cfg, logutils.ZapLogger(), b.appDB, b.timeSource(), signal.SendHistoricMessagesRequestFailed, signal.SendPeerStats)
+		w, err := wakuv2.New(nodeConfig.NodeKey, nodeConfig.ClusterConfig.Fleet, cfg, logutils.ZapLogger(), b.appDB, b.timeSource(), signal.SendHistoricMessagesRequestFailed, signal.SendPeerStats, signal.SendFilterHealth)
 
 		if err != nil {
 			return nil, err
@@ -387,6 +396,7 @@ func (b *StatusNode) accountsService(accountsFeed *event.Feed, accDB *accounts.D
 			b.config,
 			accountsFeed,
 			mediaServer,
+			b.rpcClient,
 		)
 	}
 
@@ -436,6 +446,13 @@ func (b *StatusNode) gifService(accountsDB *accounts.Database) *gif.Service {
 	return b.gifSrvc
 }
 
+func (b *StatusNode) snapshotService(accountsDB *accounts.Database) *snapshot.Service {
+	if b.snapshotSrvc == nil {
+		b.snapshotSrvc = snapshot.NewService(accountsDB, b.gethAccountManager, b.config)
+	}
+	return b.snapshotSrvc
+}
+
 func (b *StatusNode) ChatService(accountsDB *accounts.Database) *chat.Service {
 	if b.chatSrvc == nil {
 		b.chatSrvc = chat.NewService(accountsDB)
@@ -268,9 +268,10 @@ func insertShhExtConfig(tx *sql.Tx, c *params.NodeConfig) error {
 func insertTorrentConfig(tx *sql.Tx, c *params.NodeConfig) error {
 	_, err := tx.Exec(`
   INSERT OR REPLACE INTO torrent_config (
-    enabled, port, data_dir, torrent_dir, synthetic_id
-  ) VALUES (?, ?, ?, ?, 'id')`,
+    enabled, port, data_dir, torrent_dir, archive_http_url, archive_ipfs_api_url, archive_ipfs_gateway_url, synthetic_id
+  ) VALUES (?, ?, ?, ?, ?, ?, ?, 'id')`,
 		c.TorrentConfig.Enabled, c.TorrentConfig.Port, c.TorrentConfig.DataDir, c.TorrentConfig.TorrentDir,
+		c.TorrentConfig.ArchiveHTTPURL, c.TorrentConfig.ArchiveIPFSAPIURL, c.TorrentConfig.ArchiveIPFSGatewayURL,
 	)
 	return err
 }
@@ -719,10 +720,11 @@ func loadNodeConfig(tx *sql.Tx) (*params.NodeConfig, error) {
 	}
 
 	err = tx.QueryRow(`
-  SELECT enabled, port, data_dir, torrent_dir
+  SELECT enabled, port, data_dir, torrent_dir, archive_http_url, archive_ipfs_api_url, archive_ipfs_gateway_url
   FROM torrent_config WHERE synthetic_id = 'id'
   `).Scan(
 		&nodecfg.TorrentConfig.Enabled, &nodecfg.TorrentConfig.Port, &nodecfg.TorrentConfig.DataDir, &nodecfg.TorrentConfig.TorrentDir,
+		&nodecfg.TorrentConfig.ArchiveHTTPURL, &nodecfg.TorrentConfig.ArchiveIPFSAPIURL, &nodecfg.TorrentConfig.ArchiveIPFSGatewayURL,
 	)
 	if err != nil && err != sql.ErrNoRows {
 		return nil, err
@@ -522,6 +522,34 @@ type WalletConfig struct {
 	InfuraAPIKeySecret string            `json:"InfuraAPIKeySecret"`
 	// LoadAllTransfers should be false to reduce network traffic and harddrive space consumption when loading tranfers
 	LoadAllTransfers bool `json:"LoadAllTransfers"`
+	// BundlerURLs are the ERC-4337 bundler RPC endpoints, per chain ID
+	BundlerURLs map[uint64]string `json:"BundlerURLs"`
+	// PaymasterURLs are the RPC endpoints of the paymasters sponsoring user
+	// operations, per chain ID
+	PaymasterURLs map[uint64]string `json:"PaymasterURLs"`
+	// SpamBlocklistURL is the community blocklist of scam tokens and domains,
+	// the blocklist isn't fetched when empty
+	SpamBlocklistURL string `json:"SpamBlocklistURL"`
+	// TokenLists are the token lists in the format of Uniswap kept up to
+	// date, the former lists win when a token is in several of them
+	TokenLists []TokenListConfig `json:"TokenLists"`
+	// RPCProviderQuotas are the daily number of requests of the RPC providers,
+	// by host, the traffic shifts to the fallback providers once used up
+	RPCProviderQuotas map[string]uint64 `json:"RPCProviderQuotas"`
+}
+
+// TokenListConfig is a token list fetched over https, its content is
+// authenticated either by a pinned checksum or by the signature of its
+// publisher
+type TokenListConfig struct {
+	URL string `json:"URL"`
+	// SHA256 is the hex checksum of the list, the list is only updated when
+	// the checksum is
+	SHA256 string `json:"SHA256"`
+	// PublicKey is the hex public key of the publisher of the list, who signs
+	// the keccak256 hash of each version. The hex signature is fetched from
+	// the URL of the list followed by ".sig"
+	PublicKey string `json:"PublicKey"`
 }
 
 // LocalNotificationsConfig extra configuration for localnotifications.Service.
@@ -653,6 +681,15 @@ type TorrentConfig struct {
 	DataDir string
 	// TorrentDir is the file system folder Status should use for storing torrent metadata files.
 	TorrentDir string
+	// ArchiveHTTPURL is the endpoint control nodes upload the history archives
+	// to with PUT requests, members fetch them from it with GET requests
+	ArchiveHTTPURL string
+	// ArchiveIPFSAPIURL is the API of the IPFS node control nodes add the
+	// history archives to
+	ArchiveIPFSAPIURL string
+	// ArchiveIPFSGatewayURL is the gateway members fetch the history archives
+	// published on IPFS from, IpfsGatewayURL is used when empty
+	ArchiveIPFSGatewayURL string
 }
 
 // Validate validates the ShhextConfig struct and returns an error if inconsistent values are found
@@ -7,6 +7,7 @@ import (
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/verification"
+	"github.com/status-im/status-go/services/wallet/watchonly"
 )
 
 // The activity center is a place where we store incoming notifications before
@@ -28,6 +29,16 @@ const (
 	ActivityCenterNotificationTypeCommunityKicked
 	ActivityCenterNotificationTypeContactVerification
 	ActivityCenterNotificationTypeContactRemoved
+	ActivityCenterNotificationTypeWatchOnlyActivity
+	// ActivityCenterNotificationTypeKeyword is a message of a joined community
+	// containing a keyword the user subscribed to
+	ActivityCenterNotificationTypeKeyword
+	// ActivityCenterNotificationTypeSocialRecoveryShare is a share of the
+	// recovery key of a contact waiting to be accepted
+	ActivityCenterNotificationTypeSocialRecoveryShare
+	// ActivityCenterNotificationTypeSocialRecoveryRequest is a request to
+	// release the share of a contact to a new device
+	ActivityCenterNotificationTypeSocialRecoveryRequest
 )
 
 type ActivityCenterMembershipStatus int
@@ -66,6 +77,7 @@ type ActivityCenterNotification struct {
 	Deleted                   bool                           `json:"deleted"`
 	Accepted                  bool                           `json:"accepted"`
 	ContactVerificationStatus verification.RequestStatus     `json:"contactVerificationStatus"`
+	WalletActivity            *watchonly.Activity            `json:"walletActivity,omitempty"`
 	//Used for synchronization. Each update should increment the UpdatedAt.
 	//The value should represent the time when the update occurred.
 	UpdatedAt uint64 `json:"updatedAt"`
@@ -103,7 +115,7 @@ func (n *ActivityCenterNotification) Valid() error {
 }
 
 func showMentionOrReplyActivityCenterNotification(publicKey ecdsa.PublicKey, message *common.Message, chat *Chat, responseTo *common.Message) (bool, ActivityCenterType) {
-	if chat == nil || !chat.Active || (!chat.CommunityChat() && !chat.PrivateGroupChat()) || chat.Muted {
+	if chat == nil || !chat.Active || (!chat.CommunityChat() && !chat.PrivateGroupChat()) {
 		return false, ActivityCenterNotificationNoType
 	}
 
@@ -12,7 +12,7 @@ import (
 )
 
 const allFieldsForTableActivityCenterNotification = `id, timestamp, notification_type, chat_id, read, dismissed, accepted, message, author, 
-    reply_message, community_id, membership_status, contact_verification_status, deleted, updated_at`
+    reply_message, community_id, membership_status, contact_verification_status, deleted, updated_at, wallet_activity`
 
 func (db sqlitePersistence) DeleteActivityCenterNotificationByID(id []byte, updatedAt uint64) error {
 	_, err := db.db.Exec(`UPDATE activity_center_notifications SET deleted = 1, updated_at = ? WHERE id = ? AND NOT deleted`, updatedAt, id)
@@ -123,6 +123,14 @@ func (db sqlitePersistence) SaveActivityCenterNotification(notification *Activit
 		}
 	}
 
+	var encodedWalletActivity []byte
+	if notification.WalletActivity != nil {
+		encodedWalletActivity, err = json.Marshal(notification.WalletActivity)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	result, err := tx.Exec(`
 		INSERT OR REPLACE
 		INTO activity_center_notifications (
@@ -140,9 +148,10 @@ func (db sqlitePersistence) SaveActivityCenterNotification(notification *Activit
 			accepted,
 			dismissed,
 			deleted,
-		    updated_at
+		    updated_at,
+			wallet_activity
 		)
-		SELECT ?,?,?,?,?,?,?,?,?,?,?,?,?,?,? WHERE NOT EXISTS (SELECT 1 FROM activity_center_notifications WHERE id = ? AND updated_at >= ?)
+		SELECT ?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,? WHERE NOT EXISTS (SELECT 1 FROM activity_center_notifications WHERE id = ? AND updated_at >= ?)
 		`,
 		notification.ID,
 		notification.Timestamp,
@@ -159,6 +168,7 @@ func (db sqlitePersistence) SaveActivityCenterNotification(notification *Activit
 		notification.Dismissed,
 		notification.Deleted,
 		notification.UpdatedAt,
+		encodedWalletActivity,
 		notification.ID,
 		notification.UpdatedAt,
 	)
@@ -187,6 +197,7 @@ func (db sqlitePersistence) parseRowFromTableActivityCenterNotification(rows *sq
 		var messageBytes []byte
 		var replyMessageBytes []byte
 		var author sql.NullString
+		var walletActivityBytes []byte
 		notification := &ActivityCenterNotification{}
 		err := rows.Scan(
 			&notification.ID,
@@ -204,6 +215,7 @@ func (db sqlitePersistence) parseRowFromTableActivityCenterNotification(rows *sq
 			&notification.ContactVerificationStatus,
 			&notification.Deleted,
 			&notification.UpdatedAt,
+			&walletActivityBytes,
 		)
 		if err != nil {
 			return nil, err
@@ -235,6 +247,13 @@ func (db sqlitePersistence) parseRowFromTableActivityCenterNotification(rows *sq
 			}
 		}
 
+		if len(walletActivityBytes) > 0 {
+			err = json.Unmarshal(walletActivityBytes, &notification.WalletActivity)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		if withNotification != nil {
 			withNotification(notification)
 		}
@@ -252,6 +271,7 @@ func (db sqlitePersistence) unmarshalActivityCenterNotificationRow(row *sql.Row)
 	var replyMessageBytes []byte
 	var name sql.NullString
 	var author sql.NullString
+	var walletActivityBytes []byte
 	notification := &ActivityCenterNotification{}
 	err := row.Scan(
 		&notification.ID,
@@ -270,6 +290,7 @@ func (db sqlitePersistence) unmarshalActivityCenterNotificationRow(row *sql.Row)
 		&notification.ContactVerificationStatus,
 		&name,
 		&author,
+		&walletActivityBytes,
 		&notification.UpdatedAt)
 
 	if err != nil {
@@ -319,6 +340,13 @@ func (db sqlitePersistence) unmarshalActivityCenterNotificationRow(row *sql.Row)
 		notification.ReplyMessage = replyMessage
 	}
 
+	// Restore wallet activity
+	if walletActivityBytes != nil {
+		if err = json.Unmarshal(walletActivityBytes, &notification.WalletActivity); err != nil {
+			return nil, err
+		}
+	}
+
 	return notification, nil
 }
 
@@ -333,6 +361,7 @@ func (db sqlitePersistence) unmarshalActivityCenterNotificationRows(rows *sql.Ro
 		var replyMessageBytes []byte
 		var name sql.NullString
 		var author sql.NullString
+		var walletActivityBytes []byte
 		notification := &ActivityCenterNotification{}
 		err := rows.Scan(
 			&notification.ID,
@@ -350,6 +379,7 @@ func (db sqlitePersistence) unmarshalActivityCenterNotificationRows(rows *sql.Ro
 			&notification.ContactVerificationStatus,
 			&name,
 			&author,
+			&walletActivityBytes,
 			&latestCursor,
 			&notification.UpdatedAt)
 		if err != nil {
@@ -399,6 +429,13 @@ func (db sqlitePersistence) unmarshalActivityCenterNotificationRows(rows *sql.Ro
 			notification.ReplyMessage = replyMessage
 		}
 
+		// Restore wallet activity
+		if walletActivityBytes != nil {
+			if err = json.Unmarshal(walletActivityBytes, &notification.WalletActivity); err != nil {
+				return "", nil, err
+			}
+		}
+
 		notifications = append(notifications, notification)
 	}
 
@@ -502,6 +539,7 @@ func (db sqlitePersistence) buildActivityCenterQuery(tx *sql.Tx, params activity
 	a.contact_verification_status,
 	c.name,
 	a.author,
+	a.wallet_activity,
 	substr('0000000000000000000000000000000000000000000000000000000000000000' || a.timestamp, -64, 64) || hex(a.id) as cursor,
 	a.updated_at
 	FROM activity_center_notifications a
@@ -645,6 +683,7 @@ func (db sqlitePersistence) GetActivityCenterNotificationByID(id types.HexBytes)
 		a.contact_verification_status,
 		c.name,
 		a.author,
+		a.wallet_activity,
 		a.updated_at
 		FROM activity_center_notifications a
 		LEFT JOIN chats c
@@ -1074,6 +1113,7 @@ func (db sqlitePersistence) ActiveContactRequestNotification(contactID string) (
 			a.contact_verification_status,
 			c.name,
 			a.author,
+			a.wallet_activity,
 			a.updated_at
 		FROM activity_center_notifications a
 		LEFT JOIN chats c ON c.id = a.chat_id
@@ -1,14 +1,19 @@
 package protocol
 
 import (
+	"math/big"
 	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/services/wallet/watchonly"
 )
 
 func currentMilliseconds() uint64 {
@@ -1163,3 +1168,43 @@ func TestActivityCenterReadUnread(t *testing.T) {
 	require.Len(t, notifications, 1)
 	require.Equal(t, nID2, notifications[0].ID)
 }
+
+func TestWatchOnlyActivityNotification(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	token := gethcommon.HexToAddress("0x2")
+	activity := &watchonly.Activity{
+		Account:      gethcommon.HexToAddress("0x1"),
+		ChainID:      1,
+		Direction:    watchonly.DirectionIncoming,
+		TokenAddress: &token,
+		Amount:       (*hexutil.Big)(big.NewInt(10)),
+		TxHash:       gethcommon.HexToHash("0x3"),
+		BlockNumber:  5,
+	}
+
+	notifications := createNotifications(t, p, []*ActivityCenterNotification{
+		{
+			ID:             types.HexBytes(activity.ID().Bytes()),
+			Type:           ActivityCenterNotificationTypeWatchOnlyActivity,
+			WalletActivity: activity,
+		},
+		{
+			Type: ActivityCenterNotificationTypeMention,
+		},
+	})
+	require.Equal(t, activity, notifications[0].WalletActivity)
+	require.Nil(t, notifications[1].WalletActivity)
+
+	_, fetched, err := p.ActivityCenterNotifications("", 10, []ActivityCenterType{ActivityCenterNotificationTypeWatchOnlyActivity}, ActivityCenterQueryParamsReadAll, false)
+	require.NoError(t, err)
+	require.Len(t, fetched, 1)
+	require.Equal(t, activity, fetched[0].WalletActivity)
+
+	fetched, err = p.GetActivityCenterNotificationsByID([]types.HexBytes{notifications[0].ID})
+	require.NoError(t, err)
+	require.Len(t, fetched, 1)
+	require.Equal(t, activity, fetched[0].WalletActivity)
+}
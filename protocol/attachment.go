@@ -0,0 +1,24 @@
+package protocol
+
+import (
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// Attachment is an attachment sent in chunks after the message it belongs
+// to. Attachments are content addressed, so the chunks received for a
+// message complete the other messages with the same attachment as well
+type Attachment struct {
+	ID        string `json:"id"`
+	MessageID string `json:"messageId"`
+	ChatID    string `json:"chatId"`
+	From      string `json:"from"`
+	Size      uint64 `json:"size"`
+	// ContentType is the content type of the message, videos have their own
+	// download policy
+	ContentType protobuf.ChatMessage_ContentType `json:"contentType"`
+	Descriptor  *protobuf.AttachmentDescriptor   `json:"-"`
+	// Requested is set when the user asked for the attachment, it's then
+	// downloaded whatever its size
+	Requested bool `json:"requested"`
+	Complete  bool `json:"complete"`
+}
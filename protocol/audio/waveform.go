@@ -0,0 +1,121 @@
+package audio
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// WaveformPeaks is the number of peaks computed for the waveform of an audio message
+const WaveformPeaks = 64
+
+var ErrInvalidWAV = errors.New("invalid wav")
+var ErrUnsupportedWAV = errors.New("only 16-bit pcm wav is supported")
+
+// PCM is a decoded 16-bit PCM recording
+type PCM struct {
+	SampleRate uint32
+	Channels   uint16
+	// Samples are interleaved when there is more than one channel
+	Samples []int16
+}
+
+// DecodeWAV decodes a 16-bit PCM wav file
+func DecodeWAV(buf []byte) (*PCM, error) {
+	if len(buf) < 12 || string(buf[0:4]) != "RIFF" || string(buf[8:12]) != "WAVE" {
+		return nil, ErrInvalidWAV
+	}
+
+	pcm := &PCM{}
+	var data []byte
+	for offset := 12; offset+8 <= len(buf); {
+		id := string(buf[offset : offset+4])
+		size := int(binary.LittleEndian.Uint32(buf[offset+4 : offset+8]))
+		offset += 8
+		if size < 0 || offset+size > len(buf) {
+			size = len(buf) - offset
+		}
+		chunk := buf[offset : offset+size]
+
+		switch id {
+		case "fmt ":
+			if len(chunk) < 16 {
+				return nil, ErrInvalidWAV
+			}
+			format := binary.LittleEndian.Uint16(chunk[0:2])
+			bitsPerSample := binary.LittleEndian.Uint16(chunk[14:16])
+			if format != 1 || bitsPerSample != 16 {
+				return nil, ErrUnsupportedWAV
+			}
+			pcm.Channels = binary.LittleEndian.Uint16(chunk[2:4])
+			pcm.SampleRate = binary.LittleEndian.Uint32(chunk[4:8])
+		case "data":
+			data = chunk
+		}
+
+		// chunks are word aligned
+		offset += size + size%2
+	}
+
+	if pcm.Channels == 0 || pcm.SampleRate == 0 || data == nil {
+		return nil, ErrInvalidWAV
+	}
+
+	pcm.Samples = make([]int16, len(data)/2)
+	for i := range pcm.Samples {
+		pcm.Samples[i] = int16(binary.LittleEndian.Uint16(data[i*2:]))
+	}
+
+	return pcm, nil
+}
+
+// DurationMs returns the duration of the recording in milliseconds
+func (p *PCM) DurationMs() uint64 {
+	if p.SampleRate == 0 || p.Channels == 0 {
+		return 0
+	}
+	frames := uint64(len(p.Samples) / int(p.Channels))
+	return frames * 1000 / uint64(p.SampleRate)
+}
+
+// Waveform splits the recording in the given number of buckets and returns
+// the peak of each of them, normalized to 0-255 relatively to the loudest one
+func (p *PCM) Waveform(peaks int) []byte {
+	if peaks <= 0 || p.Channels == 0 {
+		return nil
+	}
+
+	frames := len(p.Samples) / int(p.Channels)
+	if frames == 0 {
+		return nil
+	}
+	if frames < peaks {
+		peaks = frames
+	}
+
+	amplitudes := make([]int32, peaks)
+	var max int32
+	for frame := 0; frame < frames; frame++ {
+		bucket := frame * peaks / frames
+		for c := 0; c < int(p.Channels); c++ {
+			amplitude := int32(p.Samples[frame*int(p.Channels)+c])
+			if amplitude < 0 {
+				amplitude = -amplitude
+			}
+			if amplitude > amplitudes[bucket] {
+				amplitudes[bucket] = amplitude
+			}
+		}
+		if amplitudes[bucket] > max {
+			max = amplitudes[bucket]
+		}
+	}
+
+	waveform := make([]byte, peaks)
+	if max == 0 {
+		return waveform
+	}
+	for i, amplitude := range amplitudes {
+		waveform[i] = byte(amplitude * 255 / max)
+	}
+	return waveform
+}
@@ -0,0 +1,72 @@
+package audio
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func buildWAV(sampleRate uint32, channels uint16, samples []int16) []byte {
+	data := make([]byte, len(samples)*2)
+	for i, sample := range samples {
+		binary.LittleEndian.PutUint16(data[i*2:], uint16(sample))
+	}
+
+	fmtChunk := make([]byte, 16)
+	binary.LittleEndian.PutUint16(fmtChunk[0:], 1)
+	binary.LittleEndian.PutUint16(fmtChunk[2:], channels)
+	binary.LittleEndian.PutUint32(fmtChunk[4:], sampleRate)
+	binary.LittleEndian.PutUint32(fmtChunk[8:], sampleRate*uint32(channels)*2)
+	binary.LittleEndian.PutUint16(fmtChunk[12:], channels*2)
+	binary.LittleEndian.PutUint16(fmtChunk[14:], 16)
+
+	var buf []byte
+	buf = append(buf, []byte("RIFF")...)
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(4+8+len(fmtChunk)+8+len(data)))
+	buf = append(buf, []byte("WAVE")...)
+	buf = append(buf, []byte("fmt ")...)
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(fmtChunk)))
+	buf = append(buf, fmtChunk...)
+	buf = append(buf, []byte("data")...)
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(data)))
+	return append(buf, data...)
+}
+
+func TestDecodeWAV(t *testing.T) {
+	samples := make([]int16, 16000)
+	pcm, err := DecodeWAV(buildWAV(8000, 2, samples))
+	require.NoError(t, err)
+	require.Equal(t, uint32(8000), pcm.SampleRate)
+	require.Equal(t, uint16(2), pcm.Channels)
+	require.Len(t, pcm.Samples, 16000)
+	require.Equal(t, uint64(1000), pcm.DurationMs())
+
+	_, err = DecodeWAV([]byte("not a wav file"))
+	require.ErrorIs(t, err, ErrInvalidWAV)
+}
+
+func TestWaveform(t *testing.T) {
+	samples := make([]int16, 400)
+	for i := range samples {
+		switch {
+		case i < 100:
+			samples[i] = 0
+		case i < 200:
+			samples[i] = -1000
+		case i < 300:
+			samples[i] = 500
+		default:
+			samples[i] = 250
+		}
+	}
+
+	pcm := &PCM{SampleRate: 8000, Channels: 1, Samples: samples}
+	require.Equal(t, []byte{0, 255, 127, 63}, pcm.Waveform(4))
+
+	// Never more peaks than samples
+	require.Len(t, pcm.Waveform(1000), 400)
+
+	silent := &PCM{SampleRate: 8000, Channels: 1, Samples: make([]int16, 10)}
+	require.Equal(t, make([]byte, 2), silent.Waveform(2))
+}
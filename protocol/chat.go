@@ -261,6 +261,12 @@ func (c *Chat) PrivateGroupChat() bool {
 	return c.ChatType == ChatTypePrivateGroupChat
 }
 
+// IsMuted tells whether the chat is muted at the given time, a mute which
+// has expired doesn't apply even before it's cleared
+func (c *Chat) IsMuted(now time.Time) bool {
+	return c.Muted && (c.MuteTill.IsZero() || now.Before(c.MuteTill))
+}
+
 func (c *Chat) IsActivePersonalChat() bool {
 	return c.Active && (c.OneToOne() || c.PrivateGroupChat() || c.Public()) && c.CommunityID == ""
 }
@@ -0,0 +1,61 @@
+package protocol
+
+import (
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ChatDraftAttachment describes a file attached to a draft. Only the metadata
+// is synced, the file stays on the device it was attached on
+type ChatDraftAttachment struct {
+	Name        string `json:"name"`
+	ContentType string `json:"contentType"`
+	Size        uint64 `json:"size"`
+}
+
+// ChatDraft is the message being written in a chat. A draft without text,
+// reply target and attachments is cleared
+type ChatDraft struct {
+	ChatID      string                 `json:"chatId"`
+	Text        string                 `json:"text"`
+	ResponseTo  string                 `json:"responseTo,omitempty"`
+	Attachments []*ChatDraftAttachment `json:"attachments,omitempty"`
+	Clock       uint64                 `json:"clock"`
+}
+
+func (d *ChatDraft) IsEmpty() bool {
+	return d.Text == "" && d.ResponseTo == "" && len(d.Attachments) == 0
+}
+
+func (d *ChatDraft) ToSyncProtobuf() *protobuf.SyncChatDraft {
+	message := &protobuf.SyncChatDraft{
+		Clock:      d.Clock,
+		ChatId:     d.ChatID,
+		Text:       d.Text,
+		ResponseTo: d.ResponseTo,
+	}
+	for _, a := range d.Attachments {
+		message.Attachments = append(message.Attachments, &protobuf.ChatDraftAttachment{
+			Name:        a.Name,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+		})
+	}
+	return message
+}
+
+func chatDraftFromSyncProtobuf(message *protobuf.SyncChatDraft) *ChatDraft {
+	draft := &ChatDraft{
+		ChatID:     message.ChatId,
+		Text:       message.Text,
+		ResponseTo: message.ResponseTo,
+		Clock:      message.Clock,
+	}
+	for _, a := range message.Attachments {
+		draft.Attachments = append(draft.Attachments, &ChatDraftAttachment{
+			Name:        a.Name,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+		})
+	}
+	return draft
+}
@@ -3,6 +3,7 @@ package protocol
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -162,3 +163,16 @@ func (s *ChatTestSuite) TestUpdateFirstMessageTimestamp() {
 	setAndCheck(FirstMessageTimestampNoMessage, false, 200)
 	setAndCheck(100, true, 100)
 }
+
+func (s *ChatTestSuite) TestIsMuted() {
+	now := time.Now()
+	chat := &Chat{}
+	s.Require().False(chat.IsMuted(now))
+
+	chat.Muted = true
+	s.Require().True(chat.IsMuted(now))
+
+	chat.MuteTill = now.Add(time.Hour)
+	s.Require().True(chat.IsMuted(now))
+	s.Require().False(chat.IsMuted(now.Add(2 * time.Hour)))
+}
@@ -0,0 +1,156 @@
+package common
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	// AttachmentChunkSize is the size of the chunks the attachments are split
+	// into, well below the size limit of the waku envelopes
+	AttachmentChunkSize = 256 * 1024
+	// AttachmentChunkingThreshold is the size of the attachments from which
+	// they are sent in chunks instead of within the message. Images are
+	// never larger, so that clients unaware of the chunks keep receiving them
+	AttachmentChunkingThreshold = images.MaxChatMessageImageSize
+	// MaxAttachmentSize bounds the size of the attachments we reassemble
+	MaxAttachmentSize = 64 * 1024 * 1024
+)
+
+var (
+	ErrAttachmentTooLarge          = errors.New("attachment too large")
+	ErrInvalidAttachmentDescriptor = errors.New("invalid attachment descriptor")
+	ErrInvalidAttachmentChunk      = errors.New("invalid attachment chunk")
+	ErrAttachmentChunkHashMismatch = errors.New("attachment chunk hash mismatch")
+	ErrAttachmentIncomplete        = errors.New("attachment incomplete")
+	ErrAttachmentChecksumMismatch  = errors.New("attachment checksum mismatch")
+)
+
+// AttachmentID returns the content address of an attachment
+func AttachmentID(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// SplitAttachment splits the data in chunks of chunkSize, and returns the
+// descriptor of the attachment along with the chunks
+func SplitAttachment(data []byte, chunkSize int) (*protobuf.AttachmentDescriptor, []*protobuf.AttachmentChunk, error) {
+	if len(data) == 0 || chunkSize <= 0 {
+		return nil, nil, ErrInvalidAttachmentDescriptor
+	}
+	if len(data) > MaxAttachmentSize {
+		return nil, nil, ErrAttachmentTooLarge
+	}
+
+	descriptor := &protobuf.AttachmentDescriptor{
+		Id:   AttachmentID(data),
+		Size: uint64(len(data)),
+	}
+
+	total := (len(data) + chunkSize - 1) / chunkSize
+	chunks := make([]*protobuf.AttachmentChunk, 0, total)
+	for i := 0; i < total; i++ {
+		end := (i + 1) * chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		chunkData := data[i*chunkSize : end]
+		hash := sha256.Sum256(chunkData)
+		descriptor.ChunkHashes = append(descriptor.ChunkHashes, hash[:])
+		chunks = append(chunks, &protobuf.AttachmentChunk{
+			AttachmentId:   descriptor.Id,
+			Index:          uint32(i),
+			Total:          uint32(total),
+			AttachmentSize: descriptor.Size,
+			Data:           chunkData,
+		})
+	}
+
+	return descriptor, chunks, nil
+}
+
+// ValidateAttachmentDescriptor checks that a received descriptor is
+// consistent and within our limits
+func ValidateAttachmentDescriptor(descriptor *protobuf.AttachmentDescriptor) error {
+	if descriptor == nil || len(descriptor.Id) != sha256.Size*2 || descriptor.Size == 0 || len(descriptor.ChunkHashes) == 0 {
+		return ErrInvalidAttachmentDescriptor
+	}
+	if descriptor.Size > MaxAttachmentSize {
+		return ErrAttachmentTooLarge
+	}
+	if uint64(len(descriptor.ChunkHashes)) > descriptor.Size {
+		return ErrInvalidAttachmentDescriptor
+	}
+	for _, hash := range descriptor.ChunkHashes {
+		if len(hash) != sha256.Size {
+			return ErrInvalidAttachmentDescriptor
+		}
+	}
+	return nil
+}
+
+// ValidateAttachmentChunk checks that a received chunk is consistent, it can
+// only be verified against the hashes once the descriptor is known
+func ValidateAttachmentChunk(chunk *protobuf.AttachmentChunk) error {
+	if chunk == nil || len(chunk.AttachmentId) != sha256.Size*2 || len(chunk.Data) == 0 || chunk.Index >= chunk.Total {
+		return ErrInvalidAttachmentChunk
+	}
+	if chunk.AttachmentSize > MaxAttachmentSize {
+		return ErrAttachmentTooLarge
+	}
+	if uint64(len(chunk.Data)) > chunk.AttachmentSize {
+		return ErrInvalidAttachmentChunk
+	}
+	return nil
+}
+
+// VerifyAttachmentChunk checks the chunk against the hash in the descriptor
+func VerifyAttachmentChunk(descriptor *protobuf.AttachmentDescriptor, index uint32, data []byte) error {
+	if int(index) >= len(descriptor.ChunkHashes) {
+		return ErrInvalidAttachmentChunk
+	}
+	hash := sha256.Sum256(data)
+	if !bytes.Equal(hash[:], descriptor.ChunkHashes[index]) {
+		return ErrAttachmentChunkHashMismatch
+	}
+	return nil
+}
+
+// MissingAttachmentChunks returns the indexes of the chunks which haven't
+// been received yet
+func MissingAttachmentChunks(descriptor *protobuf.AttachmentDescriptor, chunks map[uint32][]byte) []uint32 {
+	var missing []uint32
+	for i := range descriptor.ChunkHashes {
+		if _, ok := chunks[uint32(i)]; !ok {
+			missing = append(missing, uint32(i))
+		}
+	}
+	return missing
+}
+
+// AssembleAttachment reassembles the chunks, verifying each of them and the
+// whole attachment against the descriptor
+func AssembleAttachment(descriptor *protobuf.AttachmentDescriptor, chunks map[uint32][]byte) ([]byte, error) {
+	if len(MissingAttachmentChunks(descriptor, chunks)) != 0 {
+		return nil, ErrAttachmentIncomplete
+	}
+
+	data := make([]byte, 0, descriptor.Size)
+	for i := range descriptor.ChunkHashes {
+		chunk := chunks[uint32(i)]
+		if err := VerifyAttachmentChunk(descriptor, uint32(i), chunk); err != nil {
+			return nil, err
+		}
+		data = append(data, chunk...)
+	}
+
+	if uint64(len(data)) != descriptor.Size || AttachmentID(data) != descriptor.Id {
+		return nil, ErrAttachmentChecksumMismatch
+	}
+	return data, nil
+}
@@ -0,0 +1,69 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitAndAssembleAttachment(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), 105)
+
+	descriptor, chunks, err := SplitAttachment(data, 100)
+	require.NoError(t, err)
+	require.NoError(t, ValidateAttachmentDescriptor(descriptor))
+	require.Equal(t, AttachmentID(data), descriptor.Id)
+	require.Equal(t, uint64(len(data)), descriptor.Size)
+	require.Len(t, chunks, 11)
+	require.Len(t, descriptor.ChunkHashes, 11)
+
+	received := make(map[uint32][]byte)
+	for _, chunk := range chunks {
+		require.NoError(t, ValidateAttachmentChunk(chunk))
+		require.Equal(t, uint32(11), chunk.Total)
+		require.NoError(t, VerifyAttachmentChunk(descriptor, chunk.Index, chunk.Data))
+		// Chunks can arrive in any order
+		if chunk.Index != 3 {
+			received[chunk.Index] = chunk.Data
+		}
+	}
+
+	require.Equal(t, []uint32{3}, MissingAttachmentChunks(descriptor, received))
+	_, err = AssembleAttachment(descriptor, received)
+	require.Equal(t, ErrAttachmentIncomplete, err)
+
+	received[3] = chunks[3].Data
+	assembled, err := AssembleAttachment(descriptor, received)
+	require.NoError(t, err)
+	require.Equal(t, data, assembled)
+}
+
+func TestAssembleAttachmentTamperedChunk(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 250)
+
+	descriptor, chunks, err := SplitAttachment(data, 100)
+	require.NoError(t, err)
+
+	received := make(map[uint32][]byte)
+	for _, chunk := range chunks {
+		received[chunk.Index] = chunk.Data
+	}
+	received[1] = bytes.Repeat([]byte("b"), 100)
+
+	require.Equal(t, ErrAttachmentChunkHashMismatch, VerifyAttachmentChunk(descriptor, 1, received[1]))
+	_, err = AssembleAttachment(descriptor, received)
+	require.Equal(t, ErrAttachmentChunkHashMismatch, err)
+}
+
+func TestValidateAttachmentDescriptor(t *testing.T) {
+	descriptor, _, err := SplitAttachment([]byte("data"), 2)
+	require.NoError(t, err)
+
+	descriptor.Size = MaxAttachmentSize + 1
+	require.Equal(t, ErrAttachmentTooLarge, ValidateAttachmentDescriptor(descriptor))
+
+	descriptor.Size = 4
+	descriptor.ChunkHashes[0] = []byte("short")
+	require.Equal(t, ErrInvalidAttachmentDescriptor, ValidateAttachmentDescriptor(descriptor))
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/status-im/status-go/images"
 	"github.com/status-im/status-go/protocol/audio"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/video"
 )
 
 // QuotedMessage contains the original text of the message replied to
@@ -101,6 +102,52 @@ type LinkPreview struct {
 	Thumbnail   LinkPreviewThumbnail `json:"thumbnail,omitempty"`
 }
 
+// StatusLinkPreview is the preview of a status.app link, only one of
+// Contact, Community, Channel and Message is set
+type StatusLinkPreview struct {
+	URL       string                             `json:"url"`
+	Contact   *StatusContactLinkPreview          `json:"contact,omitempty"`
+	Community *StatusCommunityLinkPreview        `json:"community,omitempty"`
+	Channel   *StatusCommunityChannelLinkPreview `json:"channel,omitempty"`
+	Message   *StatusMessageLinkPreview          `json:"message,omitempty"`
+	// Unverified is set when the preview is the one provided by the sender,
+	// as it couldn't be built from the local data
+	Unverified bool `json:"unverified,omitempty"`
+}
+
+type StatusContactLinkPreview struct {
+	PublicKey   string               `json:"publicKey"`
+	DisplayName string               `json:"displayName"`
+	Description string               `json:"description"`
+	Icon        LinkPreviewThumbnail `json:"icon,omitempty"`
+}
+
+type StatusCommunityLinkPreview struct {
+	CommunityID  string               `json:"communityId"`
+	DisplayName  string               `json:"displayName"`
+	Description  string               `json:"description"`
+	MembersCount uint32               `json:"membersCount"`
+	Color        string               `json:"color"`
+	Icon         LinkPreviewThumbnail `json:"icon,omitempty"`
+}
+
+type StatusCommunityChannelLinkPreview struct {
+	ChannelUUID string                      `json:"channelUuid"`
+	Emoji       string                      `json:"emoji"`
+	DisplayName string                      `json:"displayName"`
+	Description string                      `json:"description"`
+	Color       string                      `json:"color"`
+	Community   *StatusCommunityLinkPreview `json:"community"`
+}
+
+type StatusMessageLinkPreview struct {
+	MessageID         string `json:"messageId"`
+	ChatID            string `json:"chatId"`
+	Author            string `json:"author"`
+	AuthorDisplayName string `json:"authorDisplayName"`
+	Text              string `json:"text"`
+}
+
 const EveryoneMentionTag = "0x00001"
 
 type CommandParameters struct {
@@ -191,10 +238,25 @@ type Message struct {
 	Base64Audio string `json:"audio,omitempty"`
 	// AudioPath is the path of the audio to be sent
 	AudioPath string `json:"audioPath,omitempty"`
+	// AudioPCMPath is the path of the uncompressed recording of the audio to be sent,
+	// as a 16-bit PCM wav, used to compute the waveform of the audio
+	AudioPCMPath string `json:"audioPcmPath,omitempty"`
+	// VideoPath is the path of the video to be sent
+	VideoPath string `json:"videoPath,omitempty"`
+	// VideoThumbnailPath is the path of the image used as the thumbnail of the
+	// video to be sent, when it's not extracted from the video
+	VideoThumbnailPath string `json:"videoThumbnailPath,omitempty"`
+	// ConfirmedFilterWarnings is set when sending a message once the user
+	// confirmed the warnings of the outgoing message filters
+	ConfirmedFilterWarnings bool `json:"confirmedFilterWarnings,omitempty"`
 	// ImageLocalURL is the local url of the image
 	ImageLocalURL string `json:"imageLocalUrl,omitempty"`
 	// AudioLocalURL is the local url of the audio
 	AudioLocalURL string `json:"audioLocalUrl,omitempty"`
+	// VideoLocalURL is the local url of the video
+	VideoLocalURL string `json:"videoLocalUrl,omitempty"`
+	// VideoThumbnailLocalURL is the local url of the thumbnail of the video
+	VideoThumbnailLocalURL string `json:"videoThumbnailLocalUrl,omitempty"`
 	// StickerLocalURL is the local url of the sticker
 	StickerLocalURL string `json:"stickerLocalUrl,omitempty"`
 
@@ -218,8 +280,9 @@ type Message struct {
 	Replied bool `json:"replied"`
 
 	// Links is an array of links within given message
-	Links        []string
-	LinkPreviews []LinkPreview `json:"linkPreviews"`
+	Links              []string
+	LinkPreviews       []LinkPreview       `json:"linkPreviews"`
+	StatusLinkPreviews []StatusLinkPreview `json:"statusLinkPreviews"`
 
 	// EditedAt indicates the clock value it was edited
 	EditedAt uint64 `json:"editedAt"`
@@ -248,52 +311,60 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 	}
 
 	type MessageStructType struct {
-		ID                       string                           `json:"id"`
-		WhisperTimestamp         uint64                           `json:"whisperTimestamp"`
-		From                     string                           `json:"from"`
-		Alias                    string                           `json:"alias"`
-		Identicon                string                           `json:"identicon"`
-		Seen                     bool                             `json:"seen"`
-		OutgoingStatus           string                           `json:"outgoingStatus,omitempty"`
-		QuotedMessage            *QuotedMessage                   `json:"quotedMessage"`
-		RTL                      bool                             `json:"rtl"`
-		ParsedText               json.RawMessage                  `json:"parsedText,omitempty"`
-		LineCount                int                              `json:"lineCount"`
-		Text                     string                           `json:"text"`
-		ChatID                   string                           `json:"chatId"`
-		LocalChatID              string                           `json:"localChatId"`
-		Clock                    uint64                           `json:"clock"`
-		Replace                  string                           `json:"replace"`
-		ResponseTo               string                           `json:"responseTo"`
-		New                      bool                             `json:"new,omitempty"`
-		EnsName                  string                           `json:"ensName"`
-		DisplayName              string                           `json:"displayName"`
-		Image                    string                           `json:"image,omitempty"`
-		AlbumID                  string                           `json:"albumId,omitempty"`
-		ImageWidth               uint32                           `json:"imageWidth,omitempty"`
-		ImageHeight              uint32                           `json:"imageHeight,omitempty"`
-		AlbumImagesCount         uint32                           `json:"albumImagesCount,omitempty"`
-		Audio                    string                           `json:"audio,omitempty"`
-		AudioDurationMs          uint64                           `json:"audioDurationMs,omitempty"`
-		CommunityID              string                           `json:"communityId,omitempty"`
-		Sticker                  *StickerAlias                    `json:"sticker,omitempty"`
-		CommandParameters        *CommandParameters               `json:"commandParameters,omitempty"`
-		GapParameters            *GapParameters                   `json:"gapParameters,omitempty"`
-		Timestamp                uint64                           `json:"timestamp"`
-		ContentType              protobuf.ChatMessage_ContentType `json:"contentType"`
-		MessageType              protobuf.MessageType             `json:"messageType"`
-		Mentions                 []string                         `json:"mentions,omitempty"`
-		Mentioned                bool                             `json:"mentioned,omitempty"`
-		Replied                  bool                             `json:"replied,omitempty"`
-		Links                    []string                         `json:"links,omitempty"`
-		LinkPreviews             []LinkPreview                    `json:"linkPreviews,omitempty"`
-		EditedAt                 uint64                           `json:"editedAt,omitempty"`
-		Deleted                  bool                             `json:"deleted,omitempty"`
-		DeletedBy                string                           `json:"deletedBy,omitempty"`
-		DeletedForMe             bool                             `json:"deletedForMe,omitempty"`
-		ContactRequestState      ContactRequestState              `json:"contactRequestState,omitempty"`
-		ContactVerificationState ContactVerificationState         `json:"contactVerificationState,omitempty"`
-		DiscordMessage           *protobuf.DiscordMessage         `json:"discordMessage,omitempty"`
+		ID                       string                            `json:"id"`
+		WhisperTimestamp         uint64                            `json:"whisperTimestamp"`
+		From                     string                            `json:"from"`
+		Alias                    string                            `json:"alias"`
+		Identicon                string                            `json:"identicon"`
+		Seen                     bool                              `json:"seen"`
+		OutgoingStatus           string                            `json:"outgoingStatus,omitempty"`
+		QuotedMessage            *QuotedMessage                    `json:"quotedMessage"`
+		RTL                      bool                              `json:"rtl"`
+		ParsedText               json.RawMessage                   `json:"parsedText,omitempty"`
+		LineCount                int                               `json:"lineCount"`
+		Text                     string                            `json:"text"`
+		ChatID                   string                            `json:"chatId"`
+		LocalChatID              string                            `json:"localChatId"`
+		Clock                    uint64                            `json:"clock"`
+		Replace                  string                            `json:"replace"`
+		ResponseTo               string                            `json:"responseTo"`
+		New                      bool                              `json:"new,omitempty"`
+		EnsName                  string                            `json:"ensName"`
+		DisplayName              string                            `json:"displayName"`
+		Image                    string                            `json:"image,omitempty"`
+		AlbumID                  string                            `json:"albumId,omitempty"`
+		ImageWidth               uint32                            `json:"imageWidth,omitempty"`
+		ImageHeight              uint32                            `json:"imageHeight,omitempty"`
+		AlbumImagesCount         uint32                            `json:"albumImagesCount,omitempty"`
+		Audio                    string                            `json:"audio,omitempty"`
+		AudioDurationMs          uint64                            `json:"audioDurationMs,omitempty"`
+		AudioWaveform            []int                             `json:"audioWaveform,omitempty"`
+		Video                    string                            `json:"video,omitempty"`
+		VideoThumbnail           string                            `json:"videoThumbnail,omitempty"`
+		VideoDurationMs          uint64                            `json:"videoDurationMs,omitempty"`
+		VideoWidth               uint32                            `json:"videoWidth,omitempty"`
+		VideoHeight              uint32                            `json:"videoHeight,omitempty"`
+		SafeTransaction          *protobuf.SafeTransactionProposal `json:"safeTransaction,omitempty"`
+		CommunityID              string                            `json:"communityId,omitempty"`
+		Sticker                  *StickerAlias                     `json:"sticker,omitempty"`
+		CommandParameters        *CommandParameters                `json:"commandParameters,omitempty"`
+		GapParameters            *GapParameters                    `json:"gapParameters,omitempty"`
+		Timestamp                uint64                            `json:"timestamp"`
+		ContentType              protobuf.ChatMessage_ContentType  `json:"contentType"`
+		MessageType              protobuf.MessageType              `json:"messageType"`
+		Mentions                 []string                          `json:"mentions,omitempty"`
+		Mentioned                bool                              `json:"mentioned,omitempty"`
+		Replied                  bool                              `json:"replied,omitempty"`
+		Links                    []string                          `json:"links,omitempty"`
+		LinkPreviews             []LinkPreview                     `json:"linkPreviews,omitempty"`
+		StatusLinkPreviews       []StatusLinkPreview               `json:"statusLinkPreviews,omitempty"`
+		EditedAt                 uint64                            `json:"editedAt,omitempty"`
+		Deleted                  bool                              `json:"deleted,omitempty"`
+		DeletedBy                string                            `json:"deletedBy,omitempty"`
+		DeletedForMe             bool                              `json:"deletedForMe,omitempty"`
+		ContactRequestState      ContactRequestState               `json:"contactRequestState,omitempty"`
+		ContactVerificationState ContactVerificationState          `json:"contactVerificationState,omitempty"`
+		DiscordMessage           *protobuf.DiscordMessage          `json:"discordMessage,omitempty"`
 	}
 	item := MessageStructType{
 		ID:                       m.ID,
@@ -318,6 +389,8 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		DisplayName:              m.DisplayName,
 		Image:                    m.ImageLocalURL,
 		Audio:                    m.AudioLocalURL,
+		Video:                    m.VideoLocalURL,
+		VideoThumbnail:           m.VideoThumbnailLocalURL,
 		CommunityID:              m.CommunityID,
 		Timestamp:                m.Timestamp,
 		ContentType:              m.ContentType,
@@ -326,6 +399,7 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		Replied:                  m.Replied,
 		Links:                    m.Links,
 		LinkPreviews:             m.LinkPreviews,
+		StatusLinkPreviews:       m.StatusLinkPreviews,
 		MessageType:              m.MessageType,
 		CommandParameters:        m.CommandParameters,
 		GapParameters:            m.GapParameters,
@@ -347,8 +421,22 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 
 	if audio := m.GetAudio(); audio != nil {
 		item.AudioDurationMs = audio.DurationMs
+		if len(audio.Waveform) != 0 {
+			item.AudioWaveform = make([]int, len(audio.Waveform))
+			for i, peak := range audio.Waveform {
+				item.AudioWaveform[i] = int(peak)
+			}
+		}
+	}
+
+	if video := m.GetVideo(); video != nil {
+		item.VideoDurationMs = video.DurationMs
+		item.VideoWidth = video.Width
+		item.VideoHeight = video.Height
 	}
 
+	item.SafeTransaction = m.GetSafeTransaction()
+
 	if image := m.GetImage(); image != nil {
 		item.AlbumID = image.AlbumId
 		item.ImageWidth = image.Width
@@ -375,21 +463,26 @@ func (m *Message) UnmarshalJSON(data []byte) error {
 	type Alias Message
 	aux := struct {
 		*Alias
-		ResponseTo       string                           `json:"responseTo"`
-		EnsName          string                           `json:"ensName"`
-		DisplayName      string                           `json:"displayName"`
-		ChatID           string                           `json:"chatId"`
-		Sticker          *protobuf.StickerMessage         `json:"sticker"`
-		AudioDurationMs  uint64                           `json:"audioDurationMs"`
-		ParsedText       json.RawMessage                  `json:"parsedText"`
-		ContentType      protobuf.ChatMessage_ContentType `json:"contentType"`
-		AlbumID          string                           `json:"albumId"`
-		ImageWidth       uint32                           `json:"imageWidth"`
-		ImageHeight      uint32                           `json:"imageHeight"`
-		AlbumImagesCount uint32                           `json:"albumImagesCount"`
-		From             string                           `json:"from"`
-		Deleted          bool                             `json:"deleted,omitempty"`
-		DeletedForMe     bool                             `json:"deletedForMe,omitempty"`
+		ResponseTo       string                            `json:"responseTo"`
+		EnsName          string                            `json:"ensName"`
+		DisplayName      string                            `json:"displayName"`
+		ChatID           string                            `json:"chatId"`
+		Sticker          *protobuf.StickerMessage          `json:"sticker"`
+		AudioDurationMs  uint64                            `json:"audioDurationMs"`
+		AudioWaveform    []int                             `json:"audioWaveform"`
+		VideoDurationMs  uint64                            `json:"videoDurationMs"`
+		VideoWidth       uint32                            `json:"videoWidth"`
+		VideoHeight      uint32                            `json:"videoHeight"`
+		SafeTransaction  *protobuf.SafeTransactionProposal `json:"safeTransaction"`
+		ParsedText       json.RawMessage                   `json:"parsedText"`
+		ContentType      protobuf.ChatMessage_ContentType  `json:"contentType"`
+		AlbumID          string                            `json:"albumId"`
+		ImageWidth       uint32                            `json:"imageWidth"`
+		ImageHeight      uint32                            `json:"imageHeight"`
+		AlbumImagesCount uint32                            `json:"albumImagesCount"`
+		From             string                            `json:"from"`
+		Deleted          bool                              `json:"deleted,omitempty"`
+		DeletedForMe     bool                              `json:"deletedForMe,omitempty"`
 	}{
 		Alias: (*Alias)(m),
 	}
@@ -400,11 +493,25 @@ func (m *Message) UnmarshalJSON(data []byte) error {
 		m.Payload = &protobuf.ChatMessage_Sticker{Sticker: aux.Sticker}
 	}
 	if aux.ContentType == protobuf.ChatMessage_AUDIO {
+		var waveform []byte
+		for _, peak := range aux.AudioWaveform {
+			waveform = append(waveform, byte(peak))
+		}
 		m.Payload = &protobuf.ChatMessage_Audio{
-			Audio: &protobuf.AudioMessage{DurationMs: aux.AudioDurationMs},
+			Audio: &protobuf.AudioMessage{DurationMs: aux.AudioDurationMs, Waveform: waveform},
+		}
+	}
+
+	if aux.ContentType == protobuf.ChatMessage_VIDEO {
+		m.Payload = &protobuf.ChatMessage_Video{
+			Video: &protobuf.VideoMessage{DurationMs: aux.VideoDurationMs, Width: aux.VideoWidth, Height: aux.VideoHeight},
 		}
 	}
 
+	if aux.ContentType == protobuf.ChatMessage_SAFE_TRANSACTION && aux.SafeTransaction != nil {
+		m.Payload = &protobuf.ChatMessage_SafeTransaction{SafeTransaction: aux.SafeTransaction}
+	}
+
 	if aux.ContentType == protobuf.ChatMessage_IMAGE {
 		m.Payload = &protobuf.ChatMessage_Image{
 			Image: &protobuf.ImageMessage{
@@ -447,6 +554,11 @@ func (m *Message) parseImage() error {
 		return errors.New("image empty")
 	}
 
+	// The chunks of the attachment haven't all been received yet
+	if len(image.Payload) == 0 && image.Attachment != nil {
+		return nil
+	}
+
 	payload := image.Payload
 
 	e64 := base64.StdEncoding
@@ -640,6 +752,12 @@ func (m *Message) GetSimplifiedText(identity string, canonicalNames map[string]s
 	if m.ContentType == protobuf.ChatMessage_IMAGE {
 		return "Image", nil
 	}
+	if m.ContentType == protobuf.ChatMessage_VIDEO {
+		return "Video", nil
+	}
+	if m.ContentType == protobuf.ChatMessage_SAFE_TRANSACTION {
+		return "Safe transaction", nil
+	}
 	if m.ContentType == protobuf.ChatMessage_COMMUNITY {
 		return "Community", nil
 	}
@@ -741,9 +859,38 @@ func (m *Message) LoadAudio() error {
 	audioMessage.Payload = payload
 	audioMessage.Type = audio.Type(payload)
 	m.Payload = &protobuf.ChatMessage_Audio{Audio: audioMessage}
+
+	if m.AudioPCMPath != "" {
+		err = m.loadAudioWaveform(audioMessage)
+		if err != nil {
+			return err
+		}
+	}
+
 	return os.Remove(m.AudioPath)
 }
 
+// loadAudioWaveform computes the waveform and the duration of the audio
+// from its uncompressed recording
+func (m *Message) loadAudioWaveform(audioMessage *protobuf.AudioMessage) error {
+	payload, err := ioutil.ReadFile(m.AudioPCMPath)
+	if err != nil {
+		return err
+	}
+
+	pcm, err := audio.DecodeWAV(payload)
+	if err != nil {
+		return err
+	}
+
+	audioMessage.Waveform = pcm.Waveform(audio.WaveformPeaks)
+	if audioMessage.DurationMs == 0 {
+		audioMessage.DurationMs = pcm.DurationMs()
+	}
+
+	return os.Remove(m.AudioPCMPath)
+}
+
 func (m *Message) LoadImage() error {
 	payload, err := images.OpenAndAdjustImage(images.CroppedImage{ImagePath: m.ImagePath}, false)
 
@@ -758,6 +905,94 @@ func (m *Message) LoadImage() error {
 	return nil
 }
 
+// LoadVideo reads the video to be sent, its thumbnail, duration and
+// dimensions are extracted unless they were set already
+func (m *Message) LoadVideo(extractor video.Extractor) error {
+	payload, err := ioutil.ReadFile(m.VideoPath)
+	if err != nil {
+		return err
+	}
+
+	videoMessage := m.GetVideo()
+	if videoMessage == nil {
+		return errors.New("no video has been passed")
+	}
+	videoMessage.Payload = payload
+	videoMessage.Type = video.Type(payload)
+	if videoMessage.Type == protobuf.VideoMessage_UNKNOWN_VIDEO_TYPE {
+		return video.ErrUnsupportedVideo
+	}
+
+	info, err := extractor.Extract(m.VideoPath)
+	if err != nil {
+		return err
+	}
+	if videoMessage.DurationMs == 0 {
+		videoMessage.DurationMs = info.DurationMs
+	}
+	if videoMessage.Width == 0 || videoMessage.Height == 0 {
+		videoMessage.Width = info.Width
+		videoMessage.Height = info.Height
+	}
+
+	thumbnail := info.Thumbnail
+	if m.VideoThumbnailPath != "" {
+		thumbnail, err = images.Decode(m.VideoThumbnailPath)
+		if err != nil {
+			return err
+		}
+	}
+	if thumbnail != nil {
+		videoMessage.Thumbnail, err = images.EncodeThumbnail(thumbnail)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.Payload = &protobuf.ChatMessage_Video{Video: videoMessage}
+	return nil
+}
+
+// AttachmentPayload returns the payload of the image or the video of the
+// message, the media sent in chunks when they're large
+func (m *Message) AttachmentPayload() []byte {
+	if image := m.GetImage(); image != nil {
+		return image.Payload
+	}
+	if video := m.GetVideo(); video != nil {
+		return video.Payload
+	}
+	return nil
+}
+
+// AttachmentDescriptor returns the descriptor of the image or the video of
+// the message when it's sent in chunks
+func (m *Message) AttachmentDescriptor() *protobuf.AttachmentDescriptor {
+	if image := m.GetImage(); image != nil {
+		return image.Attachment
+	}
+	if video := m.GetVideo(); video != nil {
+		return video.Attachment
+	}
+	return nil
+}
+
+// SetAttachment sets the payload and the descriptor of the image or the video
+// of the message, and tells whether it has one
+func (m *Message) SetAttachment(payload []byte, descriptor *protobuf.AttachmentDescriptor) bool {
+	if image := m.GetImage(); image != nil {
+		image.Payload = payload
+		image.Attachment = descriptor
+		return true
+	}
+	if video := m.GetVideo(); video != nil {
+		video.Payload = payload
+		video.Attachment = descriptor
+		return true
+	}
+	return false
+}
+
 func isValidLinkPreviewForProto(preview LinkPreview) bool {
 	return preview.Title != "" && preview.URL != "" &&
 		((preview.Thumbnail.DataURI == "" && preview.Thumbnail.Width == 0 && preview.Thumbnail.Height == 0) ||
@@ -840,6 +1075,161 @@ func (m *Message) ConvertFromProtoToLinkPreviews(makeMediaServerURL func(msgID s
 	return previews
 }
 
+// ConvertStatusLinkPreviewsToProto converts the previews of status.app links,
+// resolved by the sender, their icons are expected as data URIs
+func (m *Message) ConvertStatusLinkPreviewsToProto() ([]*protobuf.UnfurledStatusLink, error) {
+	if len(m.StatusLinkPreviews) == 0 {
+		return nil, nil
+	}
+
+	iconPayload := func(preview StatusLinkPreview, icon LinkPreviewThumbnail) ([]byte, error) {
+		if icon.DataURI == "" {
+			return nil, nil
+		}
+		payload, err := images.GetPayloadFromURI(icon.DataURI)
+		if err != nil {
+			return nil, fmt.Errorf("could not get data URI payload, url='%s': %w", preview.URL, err)
+		}
+		return payload, nil
+	}
+
+	communityToProto := func(preview StatusLinkPreview, community *StatusCommunityLinkPreview) (*protobuf.StatusCommunityLinkPreview, error) {
+		icon, err := iconPayload(preview, community.Icon)
+		if err != nil {
+			return nil, err
+		}
+		return &protobuf.StatusCommunityLinkPreview{
+			CommunityId:  community.CommunityID,
+			DisplayName:  community.DisplayName,
+			Description:  community.Description,
+			MembersCount: community.MembersCount,
+			Color:        community.Color,
+			Icon:         icon,
+		}, nil
+	}
+
+	unfurledLinks := make([]*protobuf.UnfurledStatusLink, 0, len(m.StatusLinkPreviews))
+	for _, preview := range m.StatusLinkPreviews {
+		if preview.URL == "" {
+			return nil, errors.New("invalid status link preview, empty url")
+		}
+
+		ul := &protobuf.UnfurledStatusLink{Url: preview.URL}
+		var err error
+		switch {
+		case preview.Contact != nil:
+			var icon []byte
+			icon, err = iconPayload(preview, preview.Contact.Icon)
+			ul.Contact = &protobuf.StatusContactLinkPreview{
+				PublicKey:   preview.Contact.PublicKey,
+				DisplayName: preview.Contact.DisplayName,
+				Description: preview.Contact.Description,
+				Icon:        icon,
+			}
+		case preview.Community != nil:
+			ul.Community, err = communityToProto(preview, preview.Community)
+		case preview.Channel != nil && preview.Channel.Community != nil:
+			ul.Channel = &protobuf.StatusCommunityChannelLinkPreview{
+				ChannelUuid: preview.Channel.ChannelUUID,
+				Emoji:       preview.Channel.Emoji,
+				DisplayName: preview.Channel.DisplayName,
+				Description: preview.Channel.Description,
+				Color:       preview.Channel.Color,
+			}
+			ul.Channel.Community, err = communityToProto(preview, preview.Channel.Community)
+		case preview.Message != nil:
+			ul.Message = &protobuf.StatusMessageLinkPreview{
+				MessageId:         preview.Message.MessageID,
+				ChatId:            preview.Message.ChatID,
+				Author:            preview.Message.Author,
+				AuthorDisplayName: preview.Message.AuthorDisplayName,
+				Text:              preview.Message.Text,
+			}
+		default:
+			return nil, fmt.Errorf("invalid status link preview, url='%s'", preview.URL)
+		}
+		if err != nil {
+			return nil, err
+		}
+		unfurledLinks = append(unfurledLinks, ul)
+	}
+
+	return unfurledLinks, nil
+}
+
+// ConvertFromProtoToStatusLinkPreviews converts the previews of status.app
+// links of the message, their icons are served by the media server
+func (m *Message) ConvertFromProtoToStatusLinkPreviews(makeMediaServerURL func(msgID string, previewURL string) string) []StatusLinkPreview {
+	links := m.GetUnfurledStatusLinks()
+	if links == nil {
+		return nil
+	}
+
+	icon := func(link *protobuf.UnfurledStatusLink, payload []byte) LinkPreviewThumbnail {
+		if len(payload) == 0 {
+			return LinkPreviewThumbnail{}
+		}
+		width, height, err := images.GetImageDimensions(payload)
+		if err != nil {
+			return LinkPreviewThumbnail{}
+		}
+		return LinkPreviewThumbnail{
+			Width:  width,
+			Height: height,
+			URL:    makeMediaServerURL(m.ID, link.Url),
+		}
+	}
+
+	communityFromProto := func(link *protobuf.UnfurledStatusLink, community *protobuf.StatusCommunityLinkPreview) *StatusCommunityLinkPreview {
+		return &StatusCommunityLinkPreview{
+			CommunityID:  community.CommunityId,
+			DisplayName:  community.DisplayName,
+			Description:  community.Description,
+			MembersCount: community.MembersCount,
+			Color:        community.Color,
+			Icon:         icon(link, community.Icon),
+		}
+	}
+
+	previews := make([]StatusLinkPreview, 0, len(links))
+	for _, link := range links {
+		preview := StatusLinkPreview{URL: link.Url, Unverified: link.Unverified}
+		switch {
+		case link.Contact != nil:
+			preview.Contact = &StatusContactLinkPreview{
+				PublicKey:   link.Contact.PublicKey,
+				DisplayName: link.Contact.DisplayName,
+				Description: link.Contact.Description,
+				Icon:        icon(link, link.Contact.Icon),
+			}
+		case link.Community != nil:
+			preview.Community = communityFromProto(link, link.Community)
+		case link.Channel != nil && link.Channel.Community != nil:
+			preview.Channel = &StatusCommunityChannelLinkPreview{
+				ChannelUUID: link.Channel.ChannelUuid,
+				Emoji:       link.Channel.Emoji,
+				DisplayName: link.Channel.DisplayName,
+				Description: link.Channel.Description,
+				Color:       link.Channel.Color,
+				Community:   communityFromProto(link, link.Channel.Community),
+			}
+		case link.Message != nil:
+			preview.Message = &StatusMessageLinkPreview{
+				MessageID:         link.Message.MessageId,
+				ChatID:            link.Message.ChatId,
+				Author:            link.Message.Author,
+				AuthorDisplayName: link.Message.AuthorDisplayName,
+				Text:              link.Message.Text,
+			}
+		default:
+			continue
+		}
+		previews = append(previews, preview)
+	}
+
+	return previews
+}
+
 func (m *Message) SetAlbumIDAndImagesCount(albumID string, imagesCount uint32) error {
 	imageMessage := m.GetImage()
 	if imageMessage == nil {
@@ -80,6 +80,21 @@ type MessageSender struct {
 
 	// handleSharedSecrets is a callback that is called every time a new shared secret is negotiated
 	handleSharedSecrets func([]*sharedsecret.Secret) error
+
+	// compressionSupport caches whether the devices of the recipients are
+	// able to decompress payloads, by their public key
+	compressionSupport      map[string]compressionSupport
+	compressionSupportMutex sync.Mutex
+}
+
+// compressionSupportTTL is how long whether the devices of a recipient are
+// able to decompress payloads is cached, new devices are taken into account
+// once it expires
+const compressionSupportTTL = 10 * time.Minute
+
+type compressionSupport struct {
+	supported bool
+	checkedAt time.Time
 }
 
 func NewMessageSender(
@@ -115,6 +130,8 @@ func NewMessageSender(
 		logger:          logger,
 		ephemeralKeys:   make(map[string]*ecdsa.PrivateKey),
 		featureFlags:    features,
+
+		compressionSupport: make(map[string]compressionSupport),
 	}
 
 	// Initializing DataSync is required to encrypt and send messages.
@@ -246,7 +263,10 @@ func ShouldCommunityMessageBeEncrypted(msgType protobuf.ApplicationMetadataMessa
 		msgType == protobuf.ApplicationMetadataMessage_EDIT_MESSAGE ||
 		msgType == protobuf.ApplicationMetadataMessage_DELETE_MESSAGE ||
 		msgType == protobuf.ApplicationMetadataMessage_PIN_MESSAGE ||
-		msgType == protobuf.ApplicationMetadataMessage_EMOJI_REACTION
+		msgType == protobuf.ApplicationMetadataMessage_EMOJI_REACTION ||
+		msgType == protobuf.ApplicationMetadataMessage_ATTACHMENT_CHUNK ||
+		msgType == protobuf.ApplicationMetadataMessage_SAFE_TRANSACTION_SIGNATURE ||
+		msgType == protobuf.ApplicationMetadataMessage_SAFE_TRANSACTION_EXECUTION
 }
 
 // sendCommunity sends a message that's to be sent in a community
@@ -305,6 +325,11 @@ func (s *MessageSender) sendCommunity(
 		return nil, err
 	}
 
+	wrappedMessage, err = s.compressForAll(rawMessage, wrappedMessage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compress message")
+	}
+
 	// If it's a chat message, we send it on the community chat topic
 	if ShouldCommunityMessageBeEncrypted(rawMessage.MessageType) {
 		messageSpec, err := s.protocol.BuildHashRatchetMessage(rawMessage.CommunityID, wrappedMessage)
@@ -375,6 +400,13 @@ func (s *MessageSender) sendPrivate(
 	// earlier than the scheduled
 	s.notifyOnScheduledMessage(recipient, rawMessage)
 
+	// The ID is of the uncompressed message, so that it's the same for all
+	// the recipients
+	wrappedMessage, err = s.compressFor(recipient, wrappedMessage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compress message")
+	}
+
 	if s.featureFlags.Datasync && rawMessage.ResendAutomatically {
 		// No need to call transport tracking.
 		// It is done in a data sync dispatch step.
@@ -563,6 +595,15 @@ func (s *MessageSender) SendPublic(
 		return nil, errors.Wrap(err, "failed to wrap message")
 	}
 
+	// The ID is of the uncompressed message
+	messageID := v1protocol.MessageID(&rawMessage.Sender.PublicKey, wrappedMessage)
+	rawMessage.ID = types.EncodeHex(messageID)
+
+	wrappedMessage, err = s.compressForAll(&rawMessage, wrappedMessage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compress message")
+	}
+
 	var newMessage *types.NewMessage
 
 	messageSpec, err := s.protocol.BuildPublicMessage(s.identity, wrappedMessage)
@@ -587,9 +628,6 @@ func (s *MessageSender) SendPublic(
 
 	newMessage.Ephemeral = rawMessage.Ephemeral
 
-	messageID := v1protocol.MessageID(&rawMessage.Sender.PublicKey, wrappedMessage)
-	rawMessage.ID = types.EncodeHex(messageID)
-
 	if rawMessage.BeforeDispatch != nil {
 		if err := rawMessage.BeforeDispatch(&rawMessage); err != nil {
 			return nil, err
@@ -648,19 +686,43 @@ func unwrapDatasyncMessage(m *v1protocol.StatusMessage, datasync *datasync.DataS
 // before.
 // It returns an error only if the processing of required steps failed.
 func (s *MessageSender) HandleMessages(shhMessage *types.Message) ([]*v1protocol.StatusMessage, [][]byte, error) {
-	logger := s.logger.With(zap.String("site", "handleMessages"))
-	hlogger := logger.With(zap.ByteString("hash", shhMessage.Hash))
-	var statusMessage v1protocol.StatusMessage
-	var statusMessages []*v1protocol.StatusMessage
-	var acks [][]byte
+	statusMessage, err := s.HandleTransportLayer(shhMessage)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	err := statusMessage.HandleTransport(shhMessage)
+	statusMessages, acks, err := s.HandleEncryptionLayers(shhMessage, statusMessage)
 	if err != nil {
-		hlogger.Error("failed to handle transport layer message", zap.Error(err))
 		return nil, nil, err
 	}
 
-	err = s.handleEncryptionLayer(context.Background(), &statusMessage)
+	s.HandleApplicationLayers(shhMessage, statusMessages)
+	return statusMessages, acks, nil
+}
+
+// HandleTransportLayer unwraps the whisper message and recovers its signer.
+// It doesn't depend on any state, so messages can be unwrapped concurrently
+func (s *MessageSender) HandleTransportLayer(shhMessage *types.Message) (*v1protocol.StatusMessage, error) {
+	var statusMessage v1protocol.StatusMessage
+	err := statusMessage.HandleTransport(shhMessage)
+	if err != nil {
+		s.logger.Error("failed to handle transport layer message", zap.String("site", "handleMessages"), zap.ByteString("hash", shhMessage.Hash), zap.Error(err))
+		return nil, err
+	}
+	return &statusMessage, nil
+}
+
+// HandleEncryptionLayers decrypts the message and unwraps its datasync
+// messages. The messages of hash ratchet keys which aren't known yet are
+// stored until a message with the key is handled, so the messages have to
+// be handled one at a time, in the order they were received
+func (s *MessageSender) HandleEncryptionLayers(shhMessage *types.Message, statusMessage *v1protocol.StatusMessage) ([]*v1protocol.StatusMessage, [][]byte, error) {
+	logger := s.logger.With(zap.String("site", "handleMessages"))
+	hlogger := logger.With(zap.ByteString("hash", shhMessage.Hash))
+	var statusMessages []*v1protocol.StatusMessage
+	var acks [][]byte
+
+	err := s.handleEncryptionLayer(context.Background(), statusMessage)
 	if err != nil {
 		hlogger.Debug("failed to handle an encryption message", zap.Error(err))
 	}
@@ -699,16 +761,23 @@ func (s *MessageSender) HandleMessages(shhMessage *types.Message) ([]*v1protocol
 		}
 	}
 
-	stms, as, err := unwrapDatasyncMessage(&statusMessage, s.datasync)
+	stms, as, err := unwrapDatasyncMessage(statusMessage, s.datasync)
 	if err != nil {
 		hlogger.Debug("failed to handle datasync message", zap.Error(err))
 		//that wasn't a datasync message, so use the original payload
-		statusMessages = append(stms, &statusMessage)
+		statusMessages = append(stms, statusMessage)
 	} else {
 		statusMessages = append(statusMessages, stms...)
 		acks = append(acks, as...)
 	}
 
+	return statusMessages, acks, nil
+}
+
+// HandleApplicationLayers unmarshals the application messages. It doesn't
+// depend on any state, so messages can be unmarshalled concurrently
+func (s *MessageSender) HandleApplicationLayers(shhMessage *types.Message, statusMessages []*v1protocol.StatusMessage) {
+	hlogger := s.logger.With(zap.String("site", "handleMessages"), zap.ByteString("hash", shhMessage.Hash))
 	for _, statusMessage := range statusMessages {
 		err := statusMessage.HandleApplicationMetadata()
 		if err != nil {
@@ -720,8 +789,6 @@ func (s *MessageSender) HandleMessages(shhMessage *types.Message) ([]*v1protocol
 			hlogger.Error("failed to handle application layer message", zap.Error(err))
 		}
 	}
-
-	return statusMessages, acks, nil
 }
 
 // fetchDecryptionKey returns the private key associated with this public key, and returns true if it's an ephemeral key
@@ -798,6 +865,68 @@ func (s *MessageSender) wrapMessageV1(rawMessage *RawMessage) ([]byte, error) {
 	return wrappedMessage, nil
 }
 
+// compressFor compresses the payload of the wrapped message when all the
+// devices of the recipient are able to decompress it
+func (s *MessageSender) compressFor(recipient *ecdsa.PublicKey, wrappedMessage []byte) ([]byte, error) {
+	if len(wrappedMessage) < v1protocol.CompressionThreshold {
+		return wrappedMessage, nil
+	}
+
+	supported, err := s.supportsCompression(recipient)
+	if err != nil || !supported {
+		return wrappedMessage, err
+	}
+	return v1protocol.CompressMessageV1(wrappedMessage)
+}
+
+// compressForAll compresses the payload of a message sent on a shared topic
+// when the caller knows that everyone reading the topic can decompress it,
+// like the members of a community who all advertised the capability, or else
+// when all the devices of all its recipients are able to
+func (s *MessageSender) compressForAll(rawMessage *RawMessage, wrappedMessage []byte) ([]byte, error) {
+	if len(wrappedMessage) < v1protocol.CompressionThreshold {
+		return wrappedMessage, nil
+	}
+	if rawMessage.Compress {
+		return v1protocol.CompressMessageV1(wrappedMessage)
+	}
+	if len(rawMessage.Recipients) == 0 {
+		return wrappedMessage, nil
+	}
+
+	for _, recipient := range rawMessage.Recipients {
+		supported, err := s.supportsCompression(recipient)
+		if err != nil || !supported {
+			return wrappedMessage, err
+		}
+	}
+	return v1protocol.CompressMessageV1(wrappedMessage)
+}
+
+// supportsCompression returns whether all the devices of the recipient are
+// able to decompress payloads, the answer is cached for a while so that
+// their installations aren't looked up for every message
+func (s *MessageSender) supportsCompression(recipient *ecdsa.PublicKey) (bool, error) {
+	key := types.EncodeHex(crypto.CompressPubkey(recipient))
+
+	s.compressionSupportMutex.Lock()
+	cached, ok := s.compressionSupport[key]
+	s.compressionSupportMutex.Unlock()
+	if ok && time.Since(cached.checkedAt) < compressionSupportTTL {
+		return cached.supported, nil
+	}
+
+	supported, err := s.protocol.SupportsCompression(recipient)
+	if err != nil {
+		return false, err
+	}
+
+	s.compressionSupportMutex.Lock()
+	s.compressionSupport[key] = compressionSupport{supported: supported, checkedAt: time.Now()}
+	s.compressionSupportMutex.Unlock()
+	return supported, nil
+}
+
 func (s *MessageSender) addToDataSync(publicKey *ecdsa.PublicKey, message []byte) ([]byte, error) {
 	groupID := datasync.ToOneToOneGroupID(&s.identity.PublicKey, publicKey)
 	peerID := datasyncpeer.PublicKeyToPeerID(*publicKey)
@@ -163,6 +163,45 @@ func (s *MessageSenderSuite) TestHandleDecodedMessagesDatasync() {
 	s.Require().Equal(protobuf.ApplicationMetadataMessage_CHAT_MESSAGE, decodedMessages[0].Type)
 }
 
+func (s *MessageSenderSuite) TestHandleDecodedMessagesByLayer() {
+	relayerKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	authorKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	encodedPayload, err := proto.Marshal(&s.testMessage)
+	s.Require().NoError(err)
+
+	wrappedPayload, err := v1protocol.WrapMessageV1(encodedPayload, protobuf.ApplicationMetadataMessage_CHAT_MESSAGE, authorKey)
+	s.Require().NoError(err)
+
+	dataSyncMessage := datasyncproto.Payload{
+		Messages: []*datasyncproto.Message{
+			{Body: wrappedPayload},
+		},
+	}
+	marshalledDataSyncMessage, err := proto.Marshal(&dataSyncMessage)
+	s.Require().NoError(err)
+	message := &types.Message{}
+	message.Sig = crypto.FromECDSAPub(&relayerKey.PublicKey)
+	message.Payload = marshalledDataSyncMessage
+
+	statusMessage, err := s.sender.HandleTransportLayer(message)
+	s.Require().NoError(err)
+	decodedMessages, _, err := s.sender.HandleEncryptionLayers(message, statusMessage)
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(decodedMessages))
+	s.Require().Nil(decodedMessages[0].ParsedMessage)
+
+	s.sender.HandleApplicationLayers(message, decodedMessages)
+	s.Require().Equal(&authorKey.PublicKey, decodedMessages[0].SigPubKey())
+	s.Require().Equal(v1protocol.MessageID(&authorKey.PublicKey, wrappedPayload), decodedMessages[0].ID)
+	parsedMessage := decodedMessages[0].ParsedMessage.Interface().(protobuf.ChatMessage)
+	s.Require().True(proto.Equal(&s.testMessage, &parsedMessage))
+	s.Require().Equal(protobuf.ApplicationMetadataMessage_CHAT_MESSAGE, decodedMessages[0].Type)
+}
+
 func (s *MessageSenderSuite) CalculatePoWTest() {
 	largeSizePayload := make([]byte, largeSizeInBytes)
 	s.Require().Equal(whisperLargeSizePoW, calculatePoW(largeSizePayload))
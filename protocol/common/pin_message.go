@@ -39,6 +39,7 @@ type PinnedMessage struct {
 	Message  *Message `json:"message"`
 	PinnedAt uint64   `json:"pinnedAt"`
 	PinnedBy string   `json:"pinnedBy"`
+	PinIndex uint64   `json:"pinIndex"`
 }
 
 // WrapGroupMessage indicates whether we should wrap this in membership information
@@ -34,5 +34,6 @@ type RawMessage struct {
 	CommunityID           []byte
 	CommunityKeyExMsgType CommKeyExMsgType
 	Ephemeral             bool
+	Compress              bool // everyone reading the message can decompress it
 	BeforeDispatch        func(*RawMessage) error
 }
@@ -221,6 +221,13 @@ func (db RawMessagesPersistence) RawMessagesIDsByType(t protobuf.ApplicationMeta
 	return ids, nil
 }
 
+// RemoveRawMessagesByTypeSentBefore deletes the raw messages of the given type
+// that have been last sent before the given timestamp (in milliseconds)
+func (db RawMessagesPersistence) RemoveRawMessagesByTypeSentBefore(t protobuf.ApplicationMetadataMessage_Type, timestamp uint64) error {
+	_, err := db.db.Exec(`DELETE FROM raw_messages WHERE message_type = ? AND last_sent < ?`, t, timestamp)
+	return err
+}
+
 // MarkAsConfirmed marks all the messages with dataSyncID as confirmed and returns
 // the messageIDs that can be considered confirmed.
 // If atLeastOne is set it will return messageid if at least once of the messages
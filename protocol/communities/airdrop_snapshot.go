@@ -0,0 +1,100 @@
+package communities
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// AirdropSnapshotCriteria filters the members recorded in an airdrop snapshot,
+// the zero value records all the members who revealed an address
+type AirdropSnapshotCriteria struct {
+	// Roles keeps the members holding one of the roles
+	Roles []protobuf.CommunityMember_Roles `json:"roles,omitempty"`
+	// JoinedBefore keeps the members who joined before the unix timestamp, in
+	// seconds. Members whose join time isn't known, like the owner, are left
+	// out
+	JoinedBefore uint64 `json:"joinedBefore,omitempty"`
+	// ActiveSince keeps the members who posted in the community since the
+	// unix timestamp, in seconds
+	ActiveSince uint64 `json:"activeSince,omitempty"`
+}
+
+func (c *AirdropSnapshotCriteria) hasRole(member *protobuf.CommunityMember) bool {
+	if len(c.Roles) == 0 {
+		return true
+	}
+	for _, role := range c.Roles {
+		for _, memberRole := range member.Roles {
+			if role == memberRole {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AirdropRecipient is a member recorded in an airdrop snapshot, with the
+// address the member receives airdrops to
+type AirdropRecipient struct {
+	PublicKey string `json:"publicKey"`
+	Address   string `json:"address"`
+}
+
+// AirdropSnapshot is the list of recipients of an airdrop, taken once so that
+// the airdrop doesn't change when the membership changes meanwhile
+type AirdropSnapshot struct {
+	ID          string                  `json:"id"`
+	CommunityID string                  `json:"communityId"`
+	CreatedAt   uint64                  `json:"createdAt"`
+	Criteria    AirdropSnapshotCriteria `json:"criteria"`
+	Recipients  []*AirdropRecipient     `json:"recipients"`
+}
+
+// Addresses returns the addresses of all the recipients, sorted and without
+// duplicates
+func (s *AirdropSnapshot) Addresses() []string {
+	seen := make(map[string]bool)
+	var addresses []string
+	for _, recipient := range s.Recipients {
+		if !seen[recipient.Address] {
+			seen[recipient.Address] = true
+			addresses = append(addresses, recipient.Address)
+		}
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+// airdropAddress returns the normalized address the member chose to receive
+// airdrops to, the lowest revealed address for members who joined before the
+// choice existed, and an empty string if the member didn't reveal any
+func airdropAddress(member *protobuf.CommunityMember) string {
+	for _, account := range member.RevealedAccounts {
+		if account.IsAirdropAddress && account.Address != "" {
+			return strings.ToLower(account.Address)
+		}
+	}
+	addresses := revealedAddresses(member)
+	if len(addresses) == 0 {
+		return ""
+	}
+	return addresses[0]
+}
+
+// revealedAddresses returns the revealed addresses of the member, normalized
+// and sorted
+func revealedAddresses(member *protobuf.CommunityMember) []string {
+	seen := make(map[string]bool)
+	var addresses []string
+	for _, account := range member.RevealedAccounts {
+		address := strings.ToLower(account.Address)
+		if address != "" && !seen[address] {
+			seen[address] = true
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+	return addresses
+}
@@ -0,0 +1,24 @@
+package communities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestAirdropAddress(t *testing.T) {
+	member := &protobuf.CommunityMember{RevealedAccounts: []*protobuf.RevealedAccount{
+		{Address: "0x02"},
+		{Address: "0x03", IsAirdropAddress: true},
+		{Address: "0x01"},
+	}}
+	require.Equal(t, "0x03", airdropAddress(member))
+
+	// Members who joined before choosing an airdrop address get the lowest one
+	member.RevealedAccounts[1].IsAirdropAddress = false
+	require.Equal(t, "0x01", airdropAddress(member))
+
+	require.Empty(t, airdropAddress(&protobuf.CommunityMember{}))
+}
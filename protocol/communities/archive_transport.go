@@ -0,0 +1,367 @@
+package communities
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/status-im/status-go/params"
+)
+
+const (
+	// The files of an archive, the torrent file describes the other ones so
+	// that they're verified against the magnet link whichever transport
+	// they're fetched with
+	archiveTorrentFileName = "torrent"
+	archiveIndexFileName   = "index"
+	archiveDataFileName    = "data"
+
+	maxArchiveTorrentFileSize = 1024 * 1024
+	archiveTransportTimeout   = 30 * time.Minute
+	ipfsURIPrefix             = "ipfs://"
+)
+
+var (
+	ErrNoArchiveTransport     = errors.New("no transport for the history archive")
+	ErrArchiveIntegrity       = errors.New("history archive doesn't match its magnet link")
+	ErrArchivePublishDisabled = errors.New("history archive publishing not configured")
+	ErrArchiveURLNotAllowed   = errors.New("history archives are only fetched over https from public addresses")
+)
+
+// ArchiveTransport distributes the history archives of the communities besides
+// BitTorrent
+type ArchiveTransport interface {
+	// Publish uploads the files of the archive of a community, by name, and
+	// returns the URI they can be fetched from
+	Publish(ctx context.Context, communityID string, files map[string]string) (string, error)
+	// Fetch downloads a file of the archive published at uri
+	Fetch(ctx context.Context, uri string, name string) (io.ReadCloser, error)
+	// CanPublish tells whether the transport is configured for publishing
+	CanPublish() bool
+	// CanFetch tells whether uri can be fetched with the transport
+	CanFetch(uri string) bool
+}
+
+func archiveTransportsFromConfig(config *params.TorrentConfig) []ArchiveTransport {
+	if config == nil {
+		return nil
+	}
+
+	gatewayURL := config.ArchiveIPFSGatewayURL
+	if gatewayURL == "" {
+		gatewayURL = params.IpfsGatewayURL
+	}
+
+	return []ArchiveTransport{
+		NewHTTPArchiveTransport(config.ArchiveHTTPURL),
+		NewIPFSArchiveTransport(config.ArchiveIPFSAPIURL, gatewayURL),
+	}
+}
+
+// HTTPArchiveTransport uploads the archives to an HTTP endpoint with PUT
+// requests, under the ID of the community, and fetches them with GET requests
+type HTTPArchiveTransport struct {
+	url         string
+	client      *http.Client
+	fetchClient *http.Client
+}
+
+func NewHTTPArchiveTransport(url string) *HTTPArchiveTransport {
+	return &HTTPArchiveTransport{
+		url:         strings.TrimSuffix(url, "/"),
+		client:      &http.Client{Timeout: archiveTransportTimeout},
+		fetchClient: newArchiveFetchClient(),
+	}
+}
+
+func (t *HTTPArchiveTransport) CanPublish() bool {
+	return t.url != ""
+}
+
+func (t *HTTPArchiveTransport) CanFetch(uri string) bool {
+	return strings.HasPrefix(uri, "https://")
+}
+
+func (t *HTTPArchiveTransport) Publish(ctx context.Context, communityID string, files map[string]string) (string, error) {
+	if !t.CanPublish() {
+		return "", ErrArchivePublishDisabled
+	}
+
+	uri := t.url + "/" + communityID
+	for name, path := range files {
+		err := t.upload(ctx, uri+"/"+name, path)
+		if err != nil {
+			return "", err
+		}
+	}
+	return uri, nil
+}
+
+func (t *HTTPArchiveTransport) upload(ctx context.Context, url string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, file)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = stat.Size()
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("failed to upload history archive: %s", resp.Status)
+	}
+	return nil
+}
+
+func (t *HTTPArchiveTransport) Fetch(ctx context.Context, uri string, name string) (io.ReadCloser, error) {
+	return fetchArchiveFile(ctx, t.fetchClient, strings.TrimSuffix(uri, "/")+"/"+name)
+}
+
+// IPFSArchiveTransport adds the archives to an IPFS node, wrapped in a
+// directory, and fetches them from a gateway. Only the CID comes from the
+// community description, the gateway is configured and may be a local node
+type IPFSArchiveTransport struct {
+	apiURL      string
+	gatewayURL  string
+	client      *http.Client
+	fetchClient *http.Client
+}
+
+func NewIPFSArchiveTransport(apiURL string, gatewayURL string) *IPFSArchiveTransport {
+	if gatewayURL != "" && !strings.HasSuffix(gatewayURL, "/") {
+		gatewayURL += "/"
+	}
+	return &IPFSArchiveTransport{
+		apiURL:      strings.TrimSuffix(apiURL, "/"),
+		gatewayURL:  gatewayURL,
+		client:      &http.Client{Timeout: archiveTransportTimeout},
+		fetchClient: &http.Client{Timeout: archiveTransportTimeout},
+	}
+}
+
+func (t *IPFSArchiveTransport) CanPublish() bool {
+	return t.apiURL != ""
+}
+
+func (t *IPFSArchiveTransport) CanFetch(uri string) bool {
+	return t.gatewayURL != "" && strings.HasPrefix(uri, ipfsURIPrefix)
+}
+
+type ipfsAddResponse struct {
+	Name string
+	Hash string
+}
+
+func (t *IPFSArchiveTransport) Publish(ctx context.Context, communityID string, files map[string]string) (string, error) {
+	if !t.CanPublish() {
+		return "", ErrArchivePublishDisabled
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// The files are streamed to the node as they can be large
+	body, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
+	go func() {
+		err := writeIPFSFiles(form, names, files)
+		if err == nil {
+			err = form.Close()
+		}
+		writer.CloseWithError(err)
+	}()
+
+	url := t.apiURL + "/api/v0/add?wrap-with-directory=true&pin=true&cid-version=1"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		body.Close()
+		return "", err
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		body.Close()
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", fmt.Errorf("failed to add history archive to ipfs: %s", resp.Status)
+	}
+
+	// The node returns an object for each file added, the one of the
+	// wrapping directory has no name
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var added ipfsAddResponse
+		err = json.Unmarshal(scanner.Bytes(), &added)
+		if err != nil {
+			return "", err
+		}
+		if added.Name == "" && added.Hash != "" {
+			return ipfsURIPrefix + added.Hash, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", errors.New("no directory added to ipfs")
+}
+
+func writeIPFSFiles(form *multipart.Writer, names []string, files map[string]string) error {
+	for _, name := range names {
+		part, err := form.CreateFormFile("file", name)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(files[name])
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(part, file)
+		file.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *IPFSArchiveTransport) Fetch(ctx context.Context, uri string, name string) (io.ReadCloser, error) {
+	cid := strings.TrimSuffix(strings.TrimPrefix(uri, ipfsURIPrefix), "/")
+	return getArchiveFile(ctx, t.fetchClient, t.gatewayURL+url.PathEscape(cid)+"/"+name)
+}
+
+// newArchiveFetchClient returns a client which only connects to public
+// addresses, also when redirected, for the archive URIs which come from the
+// community descriptions
+func newArchiveFetchClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: rejectNonPublicAddress,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   archiveTransportTimeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if req.URL.Scheme != "https" || len(via) >= 10 {
+				return ErrArchiveURLNotAllowed
+			}
+			return nil
+		},
+	}
+}
+
+// rejectNonPublicAddress is run before connecting, once the host is resolved,
+// so that a name resolving to a private address is rejected as well
+func rejectNonPublicAddress(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return ErrArchiveURLNotAllowed
+	}
+	return nil
+}
+
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified()
+}
+
+// fetchArchiveFile downloads a file of an archive from a URL of a community
+// description, which must be https
+func fetchArchiveFile(ctx context.Context, client *http.Client, url string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(url, "https://") {
+		return nil, ErrArchiveURLNotAllowed
+	}
+	return getArchiveFile(ctx, client, url)
+}
+
+func getArchiveFile(ctx context.Context, client *http.Client, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to fetch history archive: %s", resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// fetchArchiveFileTo downloads a file of an archive to path, and fails if it
+// isn't of the expected size
+func fetchArchiveFileTo(ctx context.Context, transport ArchiveTransport, uri string, name string, path string, size int64) error {
+	body, err := transport.Fetch(ctx, uri, name)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	written, err := io.Copy(file, io.LimitReader(body, size+1))
+	if err != nil {
+		return err
+	}
+	if written != size {
+		return ErrArchiveIntegrity
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package communities
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// archiveServer stores the files uploaded with PUT requests and serves them
+type archiveServer struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+func newArchiveServer() *archiveServer {
+	return &archiveServer{files: make(map[string][]byte)}
+}
+
+func (s *archiveServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.files[r.URL.Path] = data
+	case http.MethodGet:
+		data, ok := s.files[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func writeArchiveFiles(t *testing.T) map[string]string {
+	dir := t.TempDir()
+	files := map[string]string{
+		archiveIndexFileName: filepath.Join(dir, archiveIndexFileName),
+		archiveDataFileName:  filepath.Join(dir, archiveDataFileName),
+	}
+	for name, path := range files {
+		require.NoError(t, os.WriteFile(path, []byte(name+" content"), 0600))
+	}
+	return files
+}
+
+func TestHTTPArchiveTransport(t *testing.T) {
+	server := newArchiveServer()
+	httpServer := httptest.NewTLSServer(server)
+	defer httpServer.Close()
+
+	transport := NewHTTPArchiveTransport(httpServer.URL + "/archives/")
+	transport.client = httpServer.Client()
+	transport.fetchClient = httpServer.Client()
+	require.True(t, transport.CanPublish())
+
+	uri, err := transport.Publish(context.Background(), "0x01", writeArchiveFiles(t))
+	require.NoError(t, err)
+	require.Equal(t, httpServer.URL+"/archives/0x01", uri)
+	require.True(t, transport.CanFetch(uri))
+	require.False(t, transport.CanFetch("ipfs://cid"))
+	require.False(t, transport.CanFetch("http://example.com"))
+
+	body, err := transport.Fetch(context.Background(), uri, archiveIndexFileName)
+	require.NoError(t, err)
+	defer body.Close()
+	data, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+	require.Equal(t, "index content", string(data))
+
+	_, err = transport.Fetch(context.Background(), uri, "missing")
+	require.Error(t, err)
+
+	_, err = NewHTTPArchiveTransport("").Publish(context.Background(), "0x01", nil)
+	require.Equal(t, ErrArchivePublishDisabled, err)
+}
+
+func TestIPFSArchiveTransport(t *testing.T) {
+	var added []string
+	httpServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v0/add":
+			require.Equal(t, "true", r.URL.Query().Get("wrap-with-directory"))
+			reader, err := r.MultipartReader()
+			require.NoError(t, err)
+			for {
+				part, err := reader.NextPart()
+				if err != nil {
+					break
+				}
+				added = append(added, part.FileName())
+			}
+			_, _ = w.Write([]byte(`{"Name":"data","Hash":"cid-data"}` + "\n" + `{"Name":"index","Hash":"cid-index"}` + "\n" + `{"Name":"","Hash":"cid-dir"}` + "\n"))
+		case r.URL.Path == "/cid-dir/index":
+			_, _ = w.Write([]byte("index content"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer httpServer.Close()
+
+	transport := NewIPFSArchiveTransport(httpServer.URL, httpServer.URL)
+	transport.client = httpServer.Client()
+	transport.fetchClient = httpServer.Client()
+
+	uri, err := transport.Publish(context.Background(), "0x01", writeArchiveFiles(t))
+	require.NoError(t, err)
+	require.Equal(t, "ipfs://cid-dir", uri)
+	require.Equal(t, []string{archiveDataFileName, archiveIndexFileName}, added)
+	require.True(t, transport.CanFetch(uri))
+	require.False(t, transport.CanFetch("https://example.com"))
+
+	body, err := transport.Fetch(context.Background(), uri, archiveIndexFileName)
+	require.NoError(t, err)
+	defer body.Close()
+	data, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(data), "index"))
+}
+
+func TestArchiveFetchRestrictions(t *testing.T) {
+	httpServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("content"))
+	}))
+	defer httpServer.Close()
+
+	_, err := fetchArchiveFile(context.Background(), httpServer.Client(), "http://example.com/index")
+	require.ErrorIs(t, err, ErrArchiveURLNotAllowed)
+
+	// The server listens on a loopback address
+	_, err = fetchArchiveFile(context.Background(), newArchiveFetchClient(), httpServer.URL+"/index")
+	require.ErrorIs(t, err, ErrArchiveURLNotAllowed)
+
+	// The configured ipfs gateway may be a local node
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/cid-dir/index", r.URL.Path)
+		_, _ = w.Write([]byte("content"))
+	}))
+	defer gateway.Close()
+
+	body, err := NewIPFSArchiveTransport("", gateway.URL).Fetch(context.Background(), "ipfs://cid-dir", archiveIndexFileName)
+	require.NoError(t, err)
+	body.Close()
+
+	for _, ip := range []string{"127.0.0.1", "10.0.0.1", "192.168.1.1", "169.254.169.254", "::1", "fe80::1", "fd00::1", "0.0.0.0"} {
+		require.False(t, isPublicIP(net.ParseIP(ip)), ip)
+	}
+	require.True(t, isPublicIP(net.ParseIP("1.1.1.1")))
+	require.True(t, isPublicIP(net.ParseIP("2606:4700::1111")))
+}
@@ -0,0 +1,23 @@
+package communities
+
+import (
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	DefaultAuditLogPageSize = 50
+	MaxAuditLogPageSize     = 500
+)
+
+// AuditLogEntry is an administrative change applied to a community by one
+// of its privileged members
+type AuditLogEntry struct {
+	ID          string                                 `json:"id"`
+	CommunityID types.HexBytes                         `json:"communityId"`
+	Author      string                                 `json:"author"`
+	Type        protobuf.CommunityAdminEvent_EventType `json:"type"`
+	Clock       uint64                                 `json:"clock"`
+	Event       *protobuf.CommunityAdminEvent          `json:"event"`
+	Timestamp   uint64                                 `json:"timestamp"`
+}
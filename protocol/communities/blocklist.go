@@ -0,0 +1,106 @@
+package communities
+
+import (
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	maxBlocklistEntries      = 1000
+	maxBlocklistReasonLength = 200
+)
+
+// ValidateBlocklistEntry checks that an entry has a valid public key, which
+// is normalized, and a short reason
+func ValidateBlocklistEntry(entry *protobuf.CommunityBlocklistEntry) error {
+	pk, err := common.HexToPubkey(entry.PublicKey)
+	if err != nil {
+		return ErrInvalidBlocklistEntry
+	}
+	if len(entry.Reason) > maxBlocklistReasonLength {
+		return ErrInvalidBlocklistEntry
+	}
+	entry.PublicKey = common.PubkeyToHex(pk)
+	return nil
+}
+
+// ValidateBlocklist checks the blocklist of a received description
+func ValidateBlocklist(blocklist []*protobuf.CommunityBlocklistEntry) error {
+	if len(blocklist) > maxBlocklistEntries {
+		return ErrTooManyBlocklistEntries
+	}
+	for _, entry := range blocklist {
+		if err := ValidateBlocklistEntry(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *Community) Blocklist() []*protobuf.CommunityBlocklistEntry {
+	return o.config.CommunityDescription.Blocklist
+}
+
+// IsBlocklisted tells whether the owner of the community published the public
+// key in its blocklist
+func (o *Community) IsBlocklisted(publicKey string) bool {
+	for _, entry := range o.config.CommunityDescription.Blocklist {
+		if entry.PublicKey == publicKey {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateBlocklist adds entries to the blocklist of the community, replacing
+// the entries of the same public keys, and removes the given public keys,
+// which wins when a key is in both. The owner can't be blocklisted
+func (o *Community) UpdateBlocklist(add []*protobuf.CommunityBlocklistEntry, remove []string) (*protobuf.CommunityDescription, error) {
+	for _, entry := range add {
+		if err := ValidateBlocklistEntry(entry); err != nil {
+			return nil, err
+		}
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsOwner() {
+		return nil, ErrNotOwner
+	}
+
+	removed := make(map[string]bool, len(remove)+len(add))
+	for _, publicKey := range remove {
+		pk, err := common.HexToPubkey(publicKey)
+		if err != nil {
+			return nil, ErrInvalidBlocklistEntry
+		}
+		removed[common.PubkeyToHex(pk)] = true
+	}
+	var added []*protobuf.CommunityBlocklistEntry
+	for _, entry := range add {
+		if entry.PublicKey == common.PubkeyToHex(o.MemberIdentity()) {
+			return nil, ErrInvalidBlocklistEntry
+		}
+		if !removed[entry.PublicKey] {
+			added = append(added, entry)
+		}
+		removed[entry.PublicKey] = true
+	}
+
+	var blocklist []*protobuf.CommunityBlocklistEntry
+	for _, entry := range o.config.CommunityDescription.Blocklist {
+		if !removed[entry.PublicKey] {
+			blocklist = append(blocklist, entry)
+		}
+	}
+	blocklist = append(blocklist, added...)
+	if len(blocklist) > maxBlocklistEntries {
+		return nil, ErrTooManyBlocklistEntries
+	}
+
+	o.config.CommunityDescription.Blocklist = blocklist
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
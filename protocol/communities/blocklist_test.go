@@ -0,0 +1,58 @@
+package communities
+
+import (
+	"strings"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func (s *CommunitySuite) TestUpdateBlocklist() {
+	org, err := New(s.configOnRequest())
+	s.Require().NoError(err)
+
+	member1 := common.PubkeyToHex(&s.member1.PublicKey)
+	member2 := common.PubkeyToHex(&s.member2.PublicKey)
+
+	_, err = org.UpdateBlocklist([]*protobuf.CommunityBlocklistEntry{
+		{PublicKey: member1, Reason: "spam"},
+		{PublicKey: member2},
+	}, nil)
+	s.Require().NoError(err)
+	s.Require().True(org.IsBlocklisted(member1))
+	s.Require().True(org.IsBlocklisted(member2))
+
+	// An entry of the same public key is replaced
+	_, err = org.UpdateBlocklist([]*protobuf.CommunityBlocklistEntry{{PublicKey: member1, Reason: "scam"}}, []string{member2})
+	s.Require().NoError(err)
+	s.Require().Len(org.Blocklist(), 1)
+	s.Require().Equal("scam", org.Blocklist()[0].Reason)
+	s.Require().False(org.IsBlocklisted(member2))
+
+	_, err = org.UpdateBlocklist([]*protobuf.CommunityBlocklistEntry{{PublicKey: "not a key"}}, nil)
+	s.Require().Equal(ErrInvalidBlocklistEntry, err)
+
+	// The owner can't blocklist themselves
+	_, err = org.UpdateBlocklist([]*protobuf.CommunityBlocklistEntry{{PublicKey: common.PubkeyToHex(org.MemberIdentity())}}, nil)
+	s.Require().Equal(ErrInvalidBlocklistEntry, err)
+
+	// The removed keys are normalized
+	_, err = org.UpdateBlocklist(nil, []string{"0x" + strings.ToUpper(member1[2:])})
+	s.Require().NoError(err)
+	s.Require().Empty(org.Blocklist())
+
+	_, err = org.UpdateBlocklist(nil, []string{"not a key"})
+	s.Require().Equal(ErrInvalidBlocklistEntry, err)
+}
+
+func (s *CommunitySuite) TestValidateBlocklist() {
+	member1 := common.PubkeyToHex(&s.member1.PublicKey)
+	s.Require().NoError(ValidateBlocklist([]*protobuf.CommunityBlocklistEntry{{PublicKey: member1}}))
+	s.Require().Equal(ErrInvalidBlocklistEntry, ValidateBlocklist([]*protobuf.CommunityBlocklistEntry{{PublicKey: "not a key"}}))
+
+	blocklist := make([]*protobuf.CommunityBlocklistEntry, maxBlocklistEntries+1)
+	for i := range blocklist {
+		blocklist[i] = &protobuf.CommunityBlocklistEntry{PublicKey: member1}
+	}
+	s.Require().Equal(ErrTooManyBlocklistEntries, ValidateBlocklist(blocklist))
+}
@@ -0,0 +1,82 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// LocalCapabilities are the capabilities of this client, advertised to the
+// control node of the communities we're a member of so that they're recorded
+// in our member entry of the description
+var LocalCapabilities = []protobuf.CommunityMember_Capabilities{
+	protobuf.CommunityMember_CAPABILITY_COMPRESSION,
+	protobuf.CommunityMember_CAPABILITY_DESCRIPTION_DELTAS,
+}
+
+func hasCapabilities(member *protobuf.CommunityMember, capabilities []protobuf.CommunityMember_Capabilities) bool {
+	for _, capability := range capabilities {
+		found := false
+		for _, c := range member.Capabilities {
+			if c == capability {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// MemberHasCapabilities returns whether the member advertised all the
+// capabilities
+func (o *Community) MemberHasCapabilities(pk *ecdsa.PublicKey, capabilities []protobuf.CommunityMember_Capabilities) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	member := o.getMember(pk)
+	return member != nil && hasCapabilities(member, capabilities)
+}
+
+// MembersSupport returns whether all the members of the community advertised
+// the capability, it's computed from the description alone so that it's
+// cheap enough to be checked for every message sent
+func (o *Community) MembersSupport(capability protobuf.CommunityMember_Capabilities) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	capabilities := []protobuf.CommunityMember_Capabilities{capability}
+	for _, member := range o.config.CommunityDescription.Members {
+		if !hasCapabilities(member, capabilities) {
+			return false
+		}
+	}
+	return true
+}
+
+// SetMemberCapabilities records the capabilities advertised by a member, it
+// returns whether they changed
+func (o *Community) SetMemberCapabilities(pk *ecdsa.PublicKey, capabilities []protobuf.CommunityMember_Capabilities) (bool, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsOwner() {
+		return false, ErrNotOwner
+	}
+
+	member := o.getMember(pk)
+	if member == nil {
+		return false, ErrMemberNotFound
+	}
+
+	if len(member.Capabilities) == len(capabilities) && hasCapabilities(member, capabilities) {
+		return false, nil
+	}
+
+	o.config.CommunityDescription.Members[common.PubkeyToHex(pk)].Capabilities = capabilities
+	o.increaseClock()
+	return true, nil
+}
@@ -23,6 +23,8 @@ import (
 
 const signatureLength = 65
 
+const maxSnapshotSpaces = 5
+
 type Config struct {
 	PrivateKey                    *ecdsa.PrivateKey
 	CommunityDescription          *protobuf.CommunityDescription
@@ -69,16 +71,17 @@ type CommunityAdminSettings struct {
 }
 
 type CommunityChat struct {
-	ID          string                               `json:"id"`
-	Name        string                               `json:"name"`
-	Color       string                               `json:"color"`
-	Emoji       string                               `json:"emoji"`
-	Description string                               `json:"description"`
-	Members     map[string]*protobuf.CommunityMember `json:"members"`
-	Permissions *protobuf.CommunityPermissions       `json:"permissions"`
-	CanPost     bool                                 `json:"canPost"`
-	Position    int                                  `json:"position"`
-	CategoryID  string                               `json:"categoryID"`
+	ID           string                               `json:"id"`
+	Name         string                               `json:"name"`
+	Color        string                               `json:"color"`
+	Emoji        string                               `json:"emoji"`
+	Description  string                               `json:"description"`
+	Members      map[string]*protobuf.CommunityMember `json:"members"`
+	MembersCount int                                  `json:"membersCount"`
+	Permissions  *protobuf.CommunityPermissions       `json:"permissions"`
+	CanPost      bool                                 `json:"canPost"`
+	Position     int                                  `json:"position"`
+	CategoryID   string                               `json:"categoryID"`
 }
 
 type CommunityCategory struct {
@@ -117,6 +120,10 @@ func (o *Community) MarshalPublicAPIJSON() ([]byte, error) {
 		TokenPermissions        map[string]*protobuf.CommunityTokenPermission `json:"tokenPermissions"`
 		CommunityTokensMetadata []*protobuf.CommunityTokenMetadata            `json:"communityTokensMetadata"`
 		ActiveMembersCount      uint64                                        `json:"activeMembersCount"`
+		MembershipPayment       *protobuf.CommunityMembershipPayment          `json:"membershipPayment,omitempty"`
+		EmojiPacks              []*protobuf.CommunityEmojiPack                `json:"emojiPacks,omitempty"`
+		Blocklist               []*protobuf.CommunityBlocklistEntry           `json:"blocklist,omitempty"`
+		SnapshotSpaces          []string                                      `json:"snapshotSpaces,omitempty"`
 	}{
 		ID:         o.ID(),
 		Verified:   o.config.Verified,
@@ -140,16 +147,17 @@ func (o *Community) MarshalPublicAPIJSON() ([]byte, error) {
 				return nil, err
 			}
 			chat := CommunityChat{
-				ID:          id,
-				Name:        c.Identity.DisplayName,
-				Color:       c.Identity.Color,
-				Emoji:       c.Identity.Emoji,
-				Description: c.Identity.Description,
-				Permissions: c.Permissions,
-				Members:     c.Members,
-				CanPost:     canPost,
-				CategoryID:  c.CategoryId,
-				Position:    int(c.Position),
+				ID:           id,
+				Name:         c.Identity.DisplayName,
+				Color:        c.Identity.Color,
+				Emoji:        c.Identity.Emoji,
+				Description:  c.Identity.Description,
+				Permissions:  c.Permissions,
+				Members:      c.Members,
+				MembersCount: len(c.Members),
+				CanPost:      canPost,
+				CategoryID:   c.CategoryId,
+				Position:     int(c.Position),
 			}
 			communityItem.Chats[id] = chat
 		}
@@ -162,6 +170,10 @@ func (o *Community) MarshalPublicAPIJSON() ([]byte, error) {
 		communityItem.BanList = o.config.CommunityDescription.BanList
 		communityItem.CommunityTokensMetadata = o.config.CommunityDescription.CommunityTokensMetadata
 		communityItem.ActiveMembersCount = o.config.CommunityDescription.ActiveMembersCount
+		communityItem.MembershipPayment = o.config.CommunityDescription.MembershipPayment
+		communityItem.EmojiPacks = o.config.CommunityDescription.EmojiPacks
+		communityItem.Blocklist = o.config.CommunityDescription.Blocklist
+		communityItem.SnapshotSpaces = o.config.CommunityDescription.SnapshotSpaces
 
 		if o.config.CommunityDescription.Identity != nil {
 			communityItem.Name = o.Name()
@@ -208,6 +220,7 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 		Images                      map[string]images.IdentityImage               `json:"images"`
 		Permissions                 *protobuf.CommunityPermissions                `json:"permissions"`
 		Members                     map[string]*protobuf.CommunityMember          `json:"members"`
+		MembersCount                int                                           `json:"membersCount"`
 		CanRequestAccess            bool                                          `json:"canRequestAccess"`
 		CanManageUsers              bool                                          `json:"canManageUsers"`              //TODO: we can remove this
 		CanDeleteMessageForEveryone bool                                          `json:"canDeleteMessageForEveryone"` //TODO: we can remove this
@@ -223,6 +236,10 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 		TokenPermissions            map[string]*protobuf.CommunityTokenPermission `json:"tokenPermissions"`
 		CommunityTokensMetadata     []*protobuf.CommunityTokenMetadata            `json:"communityTokensMetadata"`
 		ActiveMembersCount          uint64                                        `json:"activeMembersCount"`
+		MembershipPayment           *protobuf.CommunityMembershipPayment          `json:"membershipPayment,omitempty"`
+		EmojiPacks                  []*protobuf.CommunityEmojiPack                `json:"emojiPacks,omitempty"`
+		Blocklist                   []*protobuf.CommunityBlocklistEntry           `json:"blocklist,omitempty"`
+		SnapshotSpaces              []string                                      `json:"snapshotSpaces,omitempty"`
 	}{
 		ID:                          o.ID(),
 		MemberRole:                  o.MemberRole(o.MemberIdentity()),
@@ -258,27 +275,33 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 				return nil, err
 			}
 			chat := CommunityChat{
-				ID:          id,
-				Name:        c.Identity.DisplayName,
-				Emoji:       c.Identity.Emoji,
-				Color:       c.Identity.Color,
-				Description: c.Identity.Description,
-				Permissions: c.Permissions,
-				Members:     c.Members,
-				CanPost:     canPost,
-				CategoryID:  c.CategoryId,
-				Position:    int(c.Position),
+				ID:           id,
+				Name:         c.Identity.DisplayName,
+				Emoji:        c.Identity.Emoji,
+				Color:        c.Identity.Color,
+				Description:  c.Identity.Description,
+				Permissions:  c.Permissions,
+				Members:      c.Members,
+				MembersCount: len(c.Members),
+				CanPost:      canPost,
+				CategoryID:   c.CategoryId,
+				Position:     int(c.Position),
 			}
 			communityItem.Chats[id] = chat
 		}
 		communityItem.TokenPermissions = o.config.CommunityDescription.TokenPermissions
 		communityItem.Members = o.config.CommunityDescription.Members
+		communityItem.MembersCount = len(o.config.CommunityDescription.Members)
 		communityItem.Permissions = o.config.CommunityDescription.Permissions
 		communityItem.IntroMessage = o.config.CommunityDescription.IntroMessage
 		communityItem.OutroMessage = o.config.CommunityDescription.OutroMessage
 		communityItem.BanList = o.config.CommunityDescription.BanList
 		communityItem.CommunityTokensMetadata = o.config.CommunityDescription.CommunityTokensMetadata
 		communityItem.ActiveMembersCount = o.config.CommunityDescription.ActiveMembersCount
+		communityItem.MembershipPayment = o.config.CommunityDescription.MembershipPayment
+		communityItem.EmojiPacks = o.config.CommunityDescription.EmojiPacks
+		communityItem.Blocklist = o.config.CommunityDescription.Blocklist
+		communityItem.SnapshotSpaces = o.config.CommunityDescription.SnapshotSpaces
 
 		if o.config.CommunityDescription.Identity != nil {
 			communityItem.Name = o.Name()
@@ -312,6 +335,50 @@ func (o *Community) Permissions() *protobuf.CommunityPermissions {
 	return o.config.CommunityDescription.Permissions
 }
 
+func (o *Community) MembershipPayment() *protobuf.CommunityMembershipPayment {
+	return o.config.CommunityDescription.MembershipPayment
+}
+
+// SetMembershipPayment sets the payment required to join the community,
+// a nil payment makes joining free
+func (o *Community) SetMembershipPayment(payment *protobuf.CommunityMembershipPayment) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsOwner() {
+		return nil, ErrNotOwner
+	}
+
+	o.config.CommunityDescription.MembershipPayment = payment
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
+
+func (o *Community) SnapshotSpaces() []string {
+	return o.config.CommunityDescription.SnapshotSpaces
+}
+
+// SetSnapshotSpaces links the Snapshot spaces where the proposals of the
+// community are voted on
+func (o *Community) SetSnapshotSpaces(spaces []string) (*protobuf.CommunityDescription, error) {
+	if len(spaces) > maxSnapshotSpaces {
+		return nil, ErrTooManySnapshotSpaces
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsOwner() {
+		return nil, ErrNotOwner
+	}
+
+	o.config.CommunityDescription.SnapshotSpaces = spaces
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
+
 func (o *Community) AdminSettings() *protobuf.CommunityAdminSettings {
 	return o.config.CommunityDescription.AdminSettings
 }
@@ -470,6 +537,7 @@ type CommunityToken struct {
 	DeployState        DeployState                 `json:"deployState"`
 	Base64Image        string                      `json:"image"`
 	Decimals           int                         `json:"decimals"`
+	Deployer           string                      `json:"deployer"`
 }
 
 type CommunitySettings struct {
@@ -1269,6 +1337,12 @@ func adminRolePermissions() map[protobuf.CommunityMember_Roles]bool {
 	return roles
 }
 
+func tokenMasterRolePermissions() map[protobuf.CommunityMember_Roles]bool {
+	roles := make(map[protobuf.CommunityMember_Roles]bool)
+	roles[protobuf.CommunityMember_ROLE_TOKEN_MASTER] = true
+	return roles
+}
+
 func ownerOrAdminRolePermissions() map[protobuf.CommunityMember_Roles]bool {
 	roles := make(map[protobuf.CommunityMember_Roles]bool)
 	roles[protobuf.CommunityMember_ROLE_OWNER] = true
@@ -1285,17 +1359,13 @@ func (o *Community) MemberRole(pubKey *ecdsa.PublicKey) protobuf.CommunityMember
 		return protobuf.CommunityMember_ROLE_MANAGE_USERS
 	} else if o.CanDeleteMessageForEveryone(pubKey) {
 		return protobuf.CommunityMember_ROLE_MODERATE_CONTENT
+	} else if o.hasPermission(pubKey, tokenMasterRolePermissions()) {
+		return protobuf.CommunityMember_ROLE_TOKEN_MASTER
 	}
 
 	return protobuf.CommunityMember_ROLE_NONE
 }
 
-func canDeleteMessageForEveryonePermissions() map[protobuf.CommunityMember_Roles]bool {
-	roles := ownerOrAdminRolePermissions()
-	roles[protobuf.CommunityMember_ROLE_MODERATE_CONTENT] = true
-	return roles
-}
-
 func (o *Community) GetMemberAdmins() []*ecdsa.PublicKey {
 	admins := make([]*ecdsa.PublicKey, 0)
 	members := o.GetMemberPubkeys()
@@ -1399,7 +1469,7 @@ func (o *Community) Description() *protobuf.CommunityDescription {
 }
 
 func (o *Community) marshaledDescription() ([]byte, error) {
-	return proto.Marshal(o.config.CommunityDescription)
+	return marshalDescription(o.config.CommunityDescription)
 }
 
 func (o *Community) MarshaledDescription() ([]byte, error) {
@@ -1827,7 +1897,7 @@ func (o *Community) CanDeleteMessageForEveryone(pk *ecdsa.PublicKey) bool {
 		return false
 	}
 
-	roles := canDeleteMessageForEveryonePermissions()
+	roles := o.rolesWithPermission(protobuf.CommunityMember_PERMISSION_DELETE_MESSAGES)
 	return o.hasPermission(pk, roles)
 }
 
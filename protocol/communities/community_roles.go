@@ -0,0 +1,136 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+type MemberRoles struct {
+	Roles       []protobuf.CommunityMember_Roles       `json:"roles"`
+	Permissions []protobuf.CommunityMember_Permissions `json:"permissions"`
+}
+
+// DefaultRolePermissions returns the permissions granted to moderators and
+// token masters of communities not defining their own
+func DefaultRolePermissions() []*protobuf.CommunityRolePermissions {
+	return []*protobuf.CommunityRolePermissions{
+		{
+			Role: protobuf.CommunityMember_ROLE_MODERATE_CONTENT,
+			Permissions: []protobuf.CommunityMember_Permissions{
+				protobuf.CommunityMember_PERMISSION_PIN_MESSAGES,
+				protobuf.CommunityMember_PERMISSION_DELETE_MESSAGES,
+			},
+		},
+		{
+			Role: protobuf.CommunityMember_ROLE_TOKEN_MASTER,
+			Permissions: []protobuf.CommunityMember_Permissions{
+				protobuf.CommunityMember_PERMISSION_MINT_TOKENS,
+				protobuf.CommunityMember_PERMISSION_AIRDROP_TOKENS,
+			},
+		},
+	}
+}
+
+func allMemberPermissions() []protobuf.CommunityMember_Permissions {
+	return []protobuf.CommunityMember_Permissions{
+		protobuf.CommunityMember_PERMISSION_PIN_MESSAGES,
+		protobuf.CommunityMember_PERMISSION_DELETE_MESSAGES,
+		protobuf.CommunityMember_PERMISSION_MINT_TOKENS,
+		protobuf.CommunityMember_PERMISSION_AIRDROP_TOKENS,
+	}
+}
+
+func (o *Community) rolePermissions() []*protobuf.CommunityRolePermissions {
+	if len(o.config.CommunityDescription.RolePermissions) == 0 {
+		return DefaultRolePermissions()
+	}
+	return o.config.CommunityDescription.RolePermissions
+}
+
+// rolesWithPermission returns the roles granted a permission, owners and
+// admins are granted all of them
+func (o *Community) rolesWithPermission(permission protobuf.CommunityMember_Permissions) map[protobuf.CommunityMember_Roles]bool {
+	roles := ownerOrAdminRolePermissions()
+	for _, rolePermissions := range o.rolePermissions() {
+		for _, p := range rolePermissions.Permissions {
+			if p == permission {
+				roles[rolePermissions.Role] = true
+			}
+		}
+	}
+	return roles
+}
+
+func (o *Community) HasMemberPermission(pk *ecdsa.PublicKey, permission protobuf.CommunityMember_Permissions) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	return o.hasPermission(pk, o.rolesWithPermission(permission))
+}
+
+func (o *Community) MemberRoles(pk *ecdsa.PublicKey) []protobuf.CommunityMember_Roles {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if common.IsPubKeyEqual(pk, o.config.ID) {
+		return []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_OWNER}
+	}
+
+	member := o.getMember(pk)
+	if member == nil {
+		return nil
+	}
+	return member.Roles
+}
+
+// MemberPermissions returns the permissions granted to a member by its roles
+func (o *Community) MemberPermissions(pk *ecdsa.PublicKey) []protobuf.CommunityMember_Permissions {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	var permissions []protobuf.CommunityMember_Permissions
+	for _, permission := range allMemberPermissions() {
+		if o.hasPermission(pk, o.rolesWithPermission(permission)) {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions
+}
+
+// SetMemberRoles replaces the roles of a member, the owner role can't be
+// granted nor revoked
+func (o *Community) SetMemberRoles(pk *ecdsa.PublicKey, roles []protobuf.CommunityMember_Roles) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.PrivateKey == nil {
+		return nil, ErrNotAdmin
+	}
+
+	member := o.getMember(pk)
+	if member == nil {
+		return nil, ErrMemberNotFound
+	}
+
+	newRoles := []protobuf.CommunityMember_Roles{}
+	if o.hasMemberPermission(member, ownerRolePermission()) {
+		newRoles = append(newRoles, protobuf.CommunityMember_ROLE_OWNER)
+	}
+
+	added := make(map[protobuf.CommunityMember_Roles]bool)
+	for _, role := range roles {
+		if role == protobuf.CommunityMember_ROLE_NONE || role == protobuf.CommunityMember_ROLE_OWNER || added[role] {
+			continue
+		}
+		added[role] = true
+		newRoles = append(newRoles, role)
+	}
+
+	member.Roles = newRoles
+	o.config.CommunityDescription.Members[common.PubkeyToHex(pk)] = member
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
@@ -846,6 +846,82 @@ func (s *CommunitySuite) TestChannelTokenPermissionsByType() {
 	s.Require().Equal(result[0].ChatIds, viewAndPostPermissions[0].ChatIds)
 }
 
+func (s *CommunitySuite) TestMemberRoles() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+
+	s.Require().Empty(org.MemberPermissions(&s.member1.PublicKey))
+	s.Require().Len(org.MemberPermissions(&s.identity.PublicKey), 4)
+
+	_, err := org.SetMemberRoles(&s.member1.PublicKey, []protobuf.CommunityMember_Roles{
+		protobuf.CommunityMember_ROLE_MODERATE_CONTENT,
+		protobuf.CommunityMember_ROLE_OWNER,
+		protobuf.CommunityMember_ROLE_MODERATE_CONTENT,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_MODERATE_CONTENT}, org.MemberRoles(&s.member1.PublicKey))
+	s.Require().True(org.HasMemberPermission(&s.member1.PublicKey, protobuf.CommunityMember_PERMISSION_PIN_MESSAGES))
+	s.Require().True(org.HasMemberPermission(&s.member1.PublicKey, protobuf.CommunityMember_PERMISSION_DELETE_MESSAGES))
+	s.Require().False(org.HasMemberPermission(&s.member1.PublicKey, protobuf.CommunityMember_PERMISSION_MINT_TOKENS))
+
+	_, err = org.SetMemberRoles(&s.member2.PublicKey, []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_TOKEN_MASTER})
+	s.Require().NoError(err)
+	s.Require().Equal([]protobuf.CommunityMember_Permissions{
+		protobuf.CommunityMember_PERMISSION_MINT_TOKENS,
+		protobuf.CommunityMember_PERMISSION_AIRDROP_TOKENS,
+	}, org.MemberPermissions(&s.member2.PublicKey))
+
+	// Permissions defined in the description take precedence
+	org.config.CommunityDescription.RolePermissions = []*protobuf.CommunityRolePermissions{
+		{
+			Role:        protobuf.CommunityMember_ROLE_TOKEN_MASTER,
+			Permissions: []protobuf.CommunityMember_Permissions{protobuf.CommunityMember_PERMISSION_PIN_MESSAGES},
+		},
+	}
+	s.Require().False(org.HasMemberPermission(&s.member1.PublicKey, protobuf.CommunityMember_PERMISSION_DELETE_MESSAGES))
+	s.Require().Equal([]protobuf.CommunityMember_Permissions{protobuf.CommunityMember_PERMISSION_PIN_MESSAGES}, org.MemberPermissions(&s.member2.PublicKey))
+
+	_, err = org.SetMemberRoles(&s.member3.PublicKey, []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ADMIN})
+	s.Require().ErrorIs(err, ErrMemberNotFound)
+
+	org.config.PrivateKey = nil
+	_, err = org.SetMemberRoles(&s.member1.PublicKey, nil)
+	s.Require().ErrorIs(err, ErrNotAdmin)
+}
+
+func (s *CommunitySuite) TestMemberCapabilities() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	clock := org.Clock()
+
+	s.Require().False(org.MembersSupport(protobuf.CommunityMember_CAPABILITY_COMPRESSION))
+
+	changed, err := org.SetMemberCapabilities(&s.member1.PublicKey, LocalCapabilities)
+	s.Require().NoError(err)
+	s.Require().True(changed)
+	s.Require().Greater(org.Clock(), clock)
+	s.Require().True(org.MemberHasCapabilities(&s.member1.PublicKey, LocalCapabilities))
+	s.Require().False(org.MembersSupport(protobuf.CommunityMember_CAPABILITY_COMPRESSION))
+
+	changed, err = org.SetMemberCapabilities(&s.member2.PublicKey, LocalCapabilities)
+	s.Require().NoError(err)
+	s.Require().True(changed)
+	s.Require().True(org.MembersSupport(protobuf.CommunityMember_CAPABILITY_COMPRESSION))
+
+	// Advertising the same capabilities again doesn't change the description
+	clock = org.Clock()
+	changed, err = org.SetMemberCapabilities(&s.member2.PublicKey, LocalCapabilities)
+	s.Require().NoError(err)
+	s.Require().False(changed)
+	s.Require().Equal(clock, org.Clock())
+
+	_, err = org.SetMemberCapabilities(&s.member3.PublicKey, LocalCapabilities)
+	s.Require().ErrorIs(err, ErrMemberNotFound)
+
+	org.config.PrivateKey = nil
+	org.config.MemberIdentity = &s.member1.PublicKey
+	_, err = org.SetMemberCapabilities(&s.member1.PublicKey, nil)
+	s.Require().ErrorIs(err, ErrNotOwner)
+}
+
 func (s *CommunitySuite) emptyCommunityDescription() *protobuf.CommunityDescription {
 	return &protobuf.CommunityDescription{
 		Permissions: &protobuf.CommunityPermissions{},
@@ -0,0 +1,244 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// marshalDescription serializes the description with the map entries sorted,
+// so that a description rebuilt from a delta serializes to the same bytes as
+// the one the owner signed
+func marshalDescription(description *protobuf.CommunityDescription) ([]byte, error) {
+	buffer := proto.NewBuffer(nil)
+	buffer.SetDeterministic(true)
+	err := buffer.Marshal(description)
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// descriptionWithoutMaps returns the fields of the description other than the
+// maps and the clock, the ones sent in a DESCRIPTION change
+func descriptionWithoutMaps(description *protobuf.CommunityDescription) *protobuf.CommunityDescription {
+	rest := proto.Clone(description).(*protobuf.CommunityDescription)
+	rest.Clock = 0
+	rest.Members = nil
+	rest.Chats = nil
+	rest.Categories = nil
+	rest.TokenPermissions = nil
+	return rest
+}
+
+func appendMapChanges[T proto.Message](changes []*protobuf.CommunityDescriptionChange, changeType protobuf.CommunityDescriptionChange_Type, base map[string]T, current map[string]T) ([]*protobuf.CommunityDescriptionChange, error) {
+	for key, value := range current {
+		if baseValue, ok := base[key]; ok && proto.Equal(baseValue, value) {
+			continue
+		}
+		marshaled, err := proto.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, &protobuf.CommunityDescriptionChange{Type: changeType, Key: key, Value: marshaled})
+	}
+	for key := range base {
+		if _, ok := current[key]; !ok {
+			changes = append(changes, &protobuf.CommunityDescriptionChange{Type: changeType, Key: key, Removed: true})
+		}
+	}
+	return changes, nil
+}
+
+func applyMapChange[T proto.Message](m map[string]T, change *protobuf.CommunityDescriptionChange, newValue func() T) error {
+	if change.Removed {
+		delete(m, change.Key)
+		return nil
+	}
+	value := newValue()
+	err := proto.Unmarshal(change.Value, value)
+	if err != nil {
+		return err
+	}
+	m[change.Key] = value
+	return nil
+}
+
+// NewDescriptionDelta returns the changes turning base into description
+func NewDescriptionDelta(base *protobuf.CommunityDescription, description *protobuf.CommunityDescription) (*protobuf.CommunityDescriptionDelta, error) {
+	var changes []*protobuf.CommunityDescriptionChange
+	var err error
+
+	changes, err = appendMapChanges(changes, protobuf.CommunityDescriptionChange_MEMBER, base.Members, description.Members)
+	if err != nil {
+		return nil, err
+	}
+	changes, err = appendMapChanges(changes, protobuf.CommunityDescriptionChange_CHAT, base.Chats, description.Chats)
+	if err != nil {
+		return nil, err
+	}
+	changes, err = appendMapChanges(changes, protobuf.CommunityDescriptionChange_CATEGORY, base.Categories, description.Categories)
+	if err != nil {
+		return nil, err
+	}
+	changes, err = appendMapChanges(changes, protobuf.CommunityDescriptionChange_TOKEN_PERMISSION, base.TokenPermissions, description.TokenPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	rest := descriptionWithoutMaps(description)
+	if !proto.Equal(descriptionWithoutMaps(base), rest) {
+		marshaled, err := proto.Marshal(rest)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, &protobuf.CommunityDescriptionChange{Type: protobuf.CommunityDescriptionChange_DESCRIPTION, Value: marshaled})
+	}
+
+	return &protobuf.CommunityDescriptionDelta{
+		BaseClock: base.Clock,
+		Clock:     description.Clock,
+		Changes:   changes,
+	}, nil
+}
+
+// ApplyDescriptionDelta returns the description the delta turns base into,
+// base is left untouched
+func ApplyDescriptionDelta(base *protobuf.CommunityDescription, delta *protobuf.CommunityDescriptionDelta) (*protobuf.CommunityDescription, error) {
+	if delta.BaseClock != base.Clock {
+		return nil, ErrDescriptionDeltaBaseMismatch
+	}
+	if delta.Clock <= delta.BaseClock {
+		return nil, ErrInvalidDescriptionDelta
+	}
+
+	description := proto.Clone(base).(*protobuf.CommunityDescription)
+	if description.Members == nil {
+		description.Members = make(map[string]*protobuf.CommunityMember)
+	}
+	if description.Chats == nil {
+		description.Chats = make(map[string]*protobuf.CommunityChat)
+	}
+	if description.Categories == nil {
+		description.Categories = make(map[string]*protobuf.CommunityCategory)
+	}
+	if description.TokenPermissions == nil {
+		description.TokenPermissions = make(map[string]*protobuf.CommunityTokenPermission)
+	}
+
+	var rest *protobuf.CommunityDescription
+	for _, change := range delta.Changes {
+		var err error
+		switch change.Type {
+		case protobuf.CommunityDescriptionChange_MEMBER:
+			err = applyMapChange(description.Members, change, func() *protobuf.CommunityMember { return &protobuf.CommunityMember{} })
+		case protobuf.CommunityDescriptionChange_CHAT:
+			err = applyMapChange(description.Chats, change, func() *protobuf.CommunityChat { return &protobuf.CommunityChat{} })
+		case protobuf.CommunityDescriptionChange_CATEGORY:
+			err = applyMapChange(description.Categories, change, func() *protobuf.CommunityCategory { return &protobuf.CommunityCategory{} })
+		case protobuf.CommunityDescriptionChange_TOKEN_PERMISSION:
+			err = applyMapChange(description.TokenPermissions, change, func() *protobuf.CommunityTokenPermission { return &protobuf.CommunityTokenPermission{} })
+		case protobuf.CommunityDescriptionChange_DESCRIPTION:
+			rest = &protobuf.CommunityDescription{}
+			err = proto.Unmarshal(change.Value, rest)
+		default:
+			return nil, ErrInvalidDescriptionDelta
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rest != nil {
+		rest.Members = description.Members
+		rest.Chats = description.Chats
+		rest.Categories = description.Categories
+		rest.TokenPermissions = description.TokenPermissions
+		description = rest
+	}
+	description.Clock = delta.Clock
+
+	return description, nil
+}
+
+// MarshaledDescriptionDelta returns the delta turning base into description,
+// signed by the community. payload is the serialized description, as
+// returned by DescriptionSnapshot
+func MarshaledDescriptionDelta(base *protobuf.CommunityDescription, description *protobuf.CommunityDescription, payload []byte, key *ecdsa.PrivateKey) ([]byte, error) {
+	if key == nil {
+		return nil, ErrNotOwner
+	}
+
+	delta, err := NewDescriptionDelta(base, description)
+	if err != nil {
+		return nil, err
+	}
+
+	delta.CommunityId = crypto.CompressPubkey(&key.PublicKey)
+	delta.Signature, err = crypto.Sign(crypto.Keccak256(payload), key)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(delta)
+}
+
+// DescriptionSnapshot returns a copy of the description and its serialized
+// form, read at once so that they match
+func (o *Community) DescriptionSnapshot() (*protobuf.CommunityDescription, []byte, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	payload, err := o.marshaledDescription()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return proto.Clone(o.config.CommunityDescription).(*protobuf.CommunityDescription), payload, nil
+}
+
+// applyDescriptionDelta rebuilds the description the delta results in and
+// checks that the community signed it. It returns the description and the
+// signed message wrapping it, which is stored like a received full
+// description, or nil when the delta is outdated
+func (o *Community) applyDescriptionDelta(delta *protobuf.CommunityDescriptionDelta) (*protobuf.CommunityDescription, []byte, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if delta.Clock <= o.config.CommunityDescription.Clock {
+		return nil, nil, nil
+	}
+
+	description, err := ApplyDescriptionDelta(o.config.CommunityDescription, delta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payload, err := marshalDescription(description)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signer, err := crypto.SigToPub(crypto.Keccak256(payload), delta.Signature)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !common.IsPubKeyEqual(signer, o.config.ID) {
+		return nil, nil, ErrNotAuthorized
+	}
+
+	wrapped, err := proto.Marshal(&protobuf.ApplicationMetadataMessage{
+		Signature: delta.Signature,
+		Payload:   payload,
+		Type:      protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return description, wrapped, nil
+}
@@ -0,0 +1,67 @@
+package communities
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func (s *CommunitySuite) TestDescriptionDelta() {
+	owner := s.buildCommunity(&s.identity.PublicKey)
+	owner.config.PrivateKey = s.identity
+	member := s.buildCommunity(&s.identity.PublicKey)
+
+	base, _, err := owner.DescriptionSnapshot()
+	s.Require().NoError(err)
+
+	owner.config.CommunityDescription.Clock++
+	owner.config.CommunityDescription.Members[s.member3Key] = &protobuf.CommunityMember{}
+	delete(owner.config.CommunityDescription.Members, s.member2Key)
+	owner.config.CommunityDescription.IntroMessage = "welcome"
+
+	description, payload, err := owner.DescriptionSnapshot()
+	s.Require().NoError(err)
+
+	marshaled, err := MarshaledDescriptionDelta(base, description, payload, s.identity)
+	s.Require().NoError(err)
+	s.Require().Less(len(marshaled), len(payload))
+
+	delta := &protobuf.CommunityDescriptionDelta{}
+	s.Require().NoError(proto.Unmarshal(marshaled, delta))
+	// member3 added, member2 removed and the intro message changed
+	s.Require().Len(delta.Changes, 3)
+
+	applied, wrapped, err := member.applyDescriptionDelta(delta)
+	s.Require().NoError(err)
+	s.Require().True(proto.Equal(description, applied))
+
+	message := &protobuf.ApplicationMetadataMessage{}
+	s.Require().NoError(proto.Unmarshal(wrapped, message))
+	s.Require().Equal(payload, message.Payload)
+	s.Require().Equal(protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION, message.Type)
+
+	// The delta is outdated once the member has the description
+	_, err = member.UpdateCommunityDescription(applied, wrapped)
+	s.Require().NoError(err)
+	applied, _, err = member.applyDescriptionDelta(delta)
+	s.Require().NoError(err)
+	s.Require().Nil(applied)
+
+	// A delta based on another description doesn't apply
+	member = s.buildCommunity(&s.identity.PublicKey)
+	member.config.CommunityDescription.Clock = 0
+	_, _, err = member.applyDescriptionDelta(delta)
+	s.Require().Equal(ErrDescriptionDeltaBaseMismatch, err)
+
+	// A delta not signed by the community is rejected
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	marshaled, err = MarshaledDescriptionDelta(base, description, payload, key)
+	s.Require().NoError(err)
+	s.Require().NoError(proto.Unmarshal(marshaled, delta))
+
+	member = s.buildCommunity(&s.identity.PublicKey)
+	_, _, err = member.applyDescriptionDelta(delta)
+	s.Require().Equal(ErrNotAuthorized, err)
+}
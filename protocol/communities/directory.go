@@ -0,0 +1,160 @@
+package communities
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/language"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+const (
+	directoryFeaturedScore          = 2.0
+	directoryLanguageScore          = 3.0
+	directoryTokenRequirementsScore = -1.0
+)
+
+// DirectoryEntry is a community listed in the curated communities directory
+type DirectoryEntry struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	Featured    bool           `json:"featured"`
+	FetchedAt   uint64         `json:"fetchedAt"`
+}
+
+type DirectoryFilter struct {
+	// Query is matched against the name, description and tags of the community
+	Query string
+	// Tags the community must be tagged with
+	Tags []string
+	// Language is preferred when ranking communities, it's a BCP 47 tag
+	// matched against the words of the name, description, introduction
+	// message and tags of the community
+	Language string
+	// WithoutTokenRequirements excludes communities gated by token permissions
+	WithoutTokenRequirements bool
+	FeaturedOnly             bool
+}
+
+type RankedCommunity struct {
+	Community *Community `json:"community"`
+	Featured  bool       `json:"featured"`
+	Score     float64    `json:"score"`
+}
+
+func containsFold(s string, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+func communityMatchesText(community *Community, text string) bool {
+	if containsFold(community.Name(), text) || containsFold(community.DescriptionText(), text) {
+		return true
+	}
+	for _, tag := range community.TagsRaw() {
+		if containsFold(tag, text) {
+			return true
+		}
+	}
+	return false
+}
+
+// languageBase returns the language of a BCP 47 tag, such as "es" or "pt-BR"
+func languageBase(s string) (language.Base, bool) {
+	tag, err := language.Parse(s)
+	if err != nil {
+		return language.Base{}, false
+	}
+	base, confidence := tag.Base()
+	return base, confidence == language.Exact
+}
+
+func isLanguageTagSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_'
+}
+
+// communityUsesLanguage tells whether one of the words of the community is a
+// tag of the language
+func communityUsesLanguage(community *Community, base language.Base) bool {
+	texts := append([]string{community.Name(), community.DescriptionText(), community.IntroMessage()}, community.TagsRaw()...)
+	for _, text := range texts {
+		for _, word := range strings.FieldsFunc(text, isLanguageTagSeparator) {
+			if wordBase, ok := languageBase(word); ok && wordBase == base {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func communityHasTags(community *Community, tags []string) bool {
+	communityTags := make(map[string]bool)
+	for _, tag := range community.TagsRaw() {
+		communityTags[strings.ToLower(tag)] = true
+	}
+	for _, tag := range tags {
+		if !communityTags[strings.ToLower(tag)] {
+			return false
+		}
+	}
+	return true
+}
+
+// rankDirectoryEntry returns the score of a community, or false if it
+// doesn't match the filter. Bigger communities rank higher, featured ones
+// and the ones using the preferred language get a boost, while the ones
+// requiring tokens to join are slightly penalized
+func rankDirectoryEntry(community *Community, featured bool, filter *DirectoryFilter) (float64, bool) {
+	if filter.FeaturedOnly && !featured {
+		return 0, false
+	}
+	if filter.WithoutTokenRequirements && community.HasTokenPermissions() {
+		return 0, false
+	}
+	if filter.Query != "" && !communityMatchesText(community, filter.Query) {
+		return 0, false
+	}
+	if !communityHasTags(community, filter.Tags) {
+		return 0, false
+	}
+
+	score := math.Log2(1 + float64(community.MembersCount()))
+	if featured {
+		score += directoryFeaturedScore
+	}
+	if base, ok := languageBase(filter.Language); ok && communityUsesLanguage(community, base) {
+		score += directoryLanguageScore
+	}
+	if community.HasTokenPermissions() {
+		score += directoryTokenRequirementsScore
+	}
+	return score, true
+}
+
+// RankDirectory filters the communities of the directory for which a
+// description is known and sorts them by descending score
+func RankDirectory(entries []*DirectoryEntry, descriptions map[string]*Community, filter *DirectoryFilter) []*RankedCommunity {
+	var result []*RankedCommunity
+	for _, entry := range entries {
+		community, ok := descriptions[entry.CommunityID.String()]
+		if !ok || community == nil {
+			continue
+		}
+
+		score, ok := rankDirectoryEntry(community, entry.Featured, filter)
+		if !ok {
+			continue
+		}
+		result = append(result, &RankedCommunity{
+			Community: community,
+			Featured:  entry.Featured,
+			Score:     score,
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Score > result[j].Score
+	})
+	return result
+}
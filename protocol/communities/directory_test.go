@@ -0,0 +1,94 @@
+package communities
+
+import (
+	"fmt"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func (s *CommunitySuite) buildDirectoryCommunity(name string, members int, tokenGated bool) *Community {
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	description := &protobuf.CommunityDescription{
+		Identity:    &protobuf.ChatIdentity{DisplayName: name, Description: fmt.Sprintf("%s community", name)},
+		Members:     make(map[string]*protobuf.CommunityMember),
+		Permissions: &protobuf.CommunityPermissions{},
+		Tags:        []string{"Web3"},
+	}
+	for i := 0; i < members; i++ {
+		description.Members[fmt.Sprintf("0x%d", i)] = &protobuf.CommunityMember{}
+	}
+	if tokenGated {
+		description.TokenPermissions = map[string]*protobuf.CommunityTokenPermission{
+			"permission": {Id: "permission", Type: protobuf.CommunityTokenPermission_BECOME_MEMBER},
+		}
+	}
+
+	community, err := New(Config{
+		ID:                   &key.PublicKey,
+		MemberIdentity:       &key.PublicKey,
+		CommunityDescription: description,
+	})
+	s.Require().NoError(err)
+	return community
+}
+
+func (s *CommunitySuite) TestRankDirectory() {
+	small := s.buildDirectoryCommunity("small", 1, false)
+	big := s.buildDirectoryCommunity("big", 10, false)
+	gated := s.buildDirectoryCommunity("gated", 10, true)
+	spanish := s.buildDirectoryCommunity("comunidad (es)", 2, false)
+
+	entries := []*DirectoryEntry{
+		{CommunityID: small.ID()},
+		{CommunityID: big.ID()},
+		{CommunityID: gated.ID()},
+		{CommunityID: spanish.ID(), Featured: true},
+		// Unknown communities are skipped
+		{CommunityID: types.HexBytes{1}},
+	}
+	descriptions := map[string]*Community{
+		small.IDString():   small,
+		big.IDString():     big,
+		gated.IDString():   gated,
+		spanish.IDString(): spanish,
+	}
+
+	names := func(ranked []*RankedCommunity) []string {
+		var result []string
+		for _, r := range ranked {
+			result = append(result, r.Community.Name())
+		}
+		return result
+	}
+
+	// Featured communities get a boost, token gated ones are penalized
+	ranked := RankDirectory(entries, descriptions, &DirectoryFilter{})
+	s.Require().Equal([]string{"comunidad (es)", "big", "gated", "small"}, names(ranked))
+
+	ranked = RankDirectory(entries, descriptions, &DirectoryFilter{Language: "es-ES"})
+	s.Require().Equal([]string{"comunidad (es)", "big", "gated", "small"}, names(ranked))
+	s.Require().Greater(ranked[0].Score-ranked[1].Score, directoryLanguageScore)
+
+	// Languages are matched against whole words
+	tokens := s.buildDirectoryCommunity("tokens", 1, false)
+	score, ok := rankDirectoryEntry(tokens, false, &DirectoryFilter{Language: "en"})
+	s.Require().True(ok)
+	scoreWithoutLanguage, _ := rankDirectoryEntry(tokens, false, &DirectoryFilter{})
+	s.Require().Equal(scoreWithoutLanguage, score)
+
+	ranked = RankDirectory(entries, descriptions, &DirectoryFilter{WithoutTokenRequirements: true})
+	s.Require().Equal([]string{"comunidad (es)", "big", "small"}, names(ranked))
+
+	ranked = RankDirectory(entries, descriptions, &DirectoryFilter{FeaturedOnly: true})
+	s.Require().Equal([]string{"comunidad (es)"}, names(ranked))
+
+	ranked = RankDirectory(entries, descriptions, &DirectoryFilter{Query: "SMALL", Tags: []string{"Web3"}})
+	s.Require().Equal([]string{"small"}, names(ranked))
+
+	ranked = RankDirectory(entries, descriptions, &DirectoryFilter{Tags: []string{"Gaming"}})
+	s.Require().Len(ranked, 0)
+}
@@ -0,0 +1,158 @@
+package communities
+
+import (
+	"regexp"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/ipfs"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	maxEmojiPacks       = 10
+	maxEmojisPerPack    = 100
+	maxEmojiPackNameLen = 30
+	// maxCommunityEmojis and maxEmojiPacksSize bound what the packs add to
+	// the community description, the images themselves aren't part of it
+	maxCommunityEmojis = 300
+	maxEmojiPacksSize  = 32 * 1024
+)
+
+var emojiShortcodeRegexp = regexp.MustCompile(`^[a-z0-9_]{2,32}$`)
+
+// ValidateEmojiPack checks that a pack is named, and that its emojis have an
+// ID, a shortcode and the IPFS hash of their image
+func ValidateEmojiPack(pack *protobuf.CommunityEmojiPack) error {
+	if pack.Id == "" || pack.Name == "" || len(pack.Name) > maxEmojiPackNameLen {
+		return ErrInvalidEmojiPack
+	}
+	if len(pack.Emojis) == 0 || len(pack.Emojis) > maxEmojisPerPack {
+		return ErrInvalidEmojiPack
+	}
+
+	ids := make(map[string]bool)
+	shortcodes := make(map[string]bool)
+	for _, emoji := range pack.Emojis {
+		if emoji.Id == "" || ids[emoji.Id] {
+			return ErrInvalidEmojiPack
+		}
+		if !emojiShortcodeRegexp.MatchString(emoji.Shortcode) || shortcodes[emoji.Shortcode] {
+			return ErrInvalidEmojiPack
+		}
+		if ipfs.ValidateHash(emoji.Hash) != nil {
+			return ErrInvalidEmojiPack
+		}
+		ids[emoji.Id] = true
+		shortcodes[emoji.Shortcode] = true
+	}
+	return nil
+}
+
+// ValidateEmojiPacks checks the packs of a community description: each pack
+// has to be valid, pack IDs, emoji IDs and shortcodes have to be unique
+// across the packs, and the packs have to fit in the bounds of a description
+func ValidateEmojiPacks(packs []*protobuf.CommunityEmojiPack) error {
+	if len(packs) > maxEmojiPacks {
+		return ErrTooManyEmojiPacks
+	}
+
+	packIDs := make(map[string]bool)
+	ids := make(map[string]bool)
+	shortcodes := make(map[string]bool)
+	emojis := 0
+	size := 0
+	for _, pack := range packs {
+		if err := ValidateEmojiPack(pack); err != nil {
+			return err
+		}
+		if packIDs[pack.Id] {
+			return ErrInvalidEmojiPack
+		}
+		packIDs[pack.Id] = true
+
+		for _, emoji := range pack.Emojis {
+			if ids[emoji.Id] || shortcodes[emoji.Shortcode] {
+				return ErrInvalidEmojiPack
+			}
+			ids[emoji.Id] = true
+			shortcodes[emoji.Shortcode] = true
+		}
+
+		emojis += len(pack.Emojis)
+		size += proto.Size(pack)
+	}
+
+	if emojis > maxCommunityEmojis || size > maxEmojiPacksSize {
+		return ErrEmojiPacksTooLarge
+	}
+	return nil
+}
+
+func (o *Community) EmojiPacks() []*protobuf.CommunityEmojiPack {
+	return o.config.CommunityDescription.EmojiPacks
+}
+
+// Emoji returns the emoji with the given ID from any of the packs of the
+// community, and nil when there's none
+func (o *Community) Emoji(id string) *protobuf.CommunityEmoji {
+	for _, pack := range o.config.CommunityDescription.EmojiPacks {
+		for _, emoji := range pack.Emojis {
+			if emoji.Id == id {
+				return emoji
+			}
+		}
+	}
+	return nil
+}
+
+// SetEmojiPack adds a pack to the community, or replaces the pack with the
+// same ID. Emoji IDs and shortcodes have to be unique across the packs
+func (o *Community) SetEmojiPack(pack *protobuf.CommunityEmojiPack) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsOwner() {
+		return nil, ErrNotOwner
+	}
+
+	var packs []*protobuf.CommunityEmojiPack
+	for _, p := range o.config.CommunityDescription.EmojiPacks {
+		if p.Id != pack.Id {
+			packs = append(packs, p)
+		}
+	}
+	packs = append(packs, pack)
+	if err := ValidateEmojiPacks(packs); err != nil {
+		return nil, err
+	}
+
+	o.config.CommunityDescription.EmojiPacks = packs
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
+
+func (o *Community) DeleteEmojiPack(id string) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsOwner() {
+		return nil, ErrNotOwner
+	}
+
+	var packs []*protobuf.CommunityEmojiPack
+	for _, p := range o.config.CommunityDescription.EmojiPacks {
+		if p.Id != id {
+			packs = append(packs, p)
+		}
+	}
+	if len(packs) == len(o.config.CommunityDescription.EmojiPacks) {
+		return nil, ErrEmojiPackNotFound
+	}
+
+	o.config.CommunityDescription.EmojiPacks = packs
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
@@ -0,0 +1,100 @@
+package communities
+
+import (
+	"fmt"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const testEmojiHash = "e30101701220eab9a8ef4eac6c3e5836a3768d8e04935c10c67d9a700436a0e53199e9b64d29"
+
+func testEmoji(id, shortcode string) *protobuf.CommunityEmoji {
+	return &protobuf.CommunityEmoji{
+		Id:        id,
+		Shortcode: shortcode,
+		Hash:      testEmojiHash,
+	}
+}
+
+func testEmojiPack(id string, emojis int) *protobuf.CommunityEmojiPack {
+	pack := &protobuf.CommunityEmojiPack{Id: id, Name: id}
+	for i := 0; i < emojis; i++ {
+		pack.Emojis = append(pack.Emojis, testEmoji(fmt.Sprintf("%s-%d", id, i), fmt.Sprintf("%s_%d", id, i)))
+	}
+	return pack
+}
+
+func (s *CommunitySuite) TestValidateEmojiPack() {
+	s.Require().NoError(ValidateEmojiPack(&protobuf.CommunityEmojiPack{
+		Id:     "pack-1",
+		Name:   "status",
+		Emojis: []*protobuf.CommunityEmoji{testEmoji("emoji-1", "party_parrot")},
+	}))
+
+	testCases := []struct {
+		name string
+		pack *protobuf.CommunityEmojiPack
+	}{
+		{"no name", &protobuf.CommunityEmojiPack{Id: "pack-1", Emojis: []*protobuf.CommunityEmoji{testEmoji("emoji-1", "parrot")}}},
+		{"no emojis", &protobuf.CommunityEmojiPack{Id: "pack-1", Name: "status"}},
+		{"invalid shortcode", &protobuf.CommunityEmojiPack{Id: "pack-1", Name: "status", Emojis: []*protobuf.CommunityEmoji{testEmoji("emoji-1", "Party Parrot")}}},
+		{"duplicate shortcode", &protobuf.CommunityEmojiPack{Id: "pack-1", Name: "status", Emojis: []*protobuf.CommunityEmoji{testEmoji("emoji-1", "parrot"), testEmoji("emoji-2", "parrot")}}},
+		{"no image", &protobuf.CommunityEmojiPack{Id: "pack-1", Name: "status", Emojis: []*protobuf.CommunityEmoji{{Id: "emoji-1", Shortcode: "parrot"}}}},
+		{"invalid hash", &protobuf.CommunityEmojiPack{Id: "pack-1", Name: "status", Emojis: []*protobuf.CommunityEmoji{{Id: "emoji-1", Shortcode: "parrot", Hash: "0x1234"}}}},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(ErrInvalidEmojiPack, ValidateEmojiPack(tc.pack))
+		})
+	}
+}
+
+func (s *CommunitySuite) TestValidateEmojiPacks() {
+	s.Require().NoError(ValidateEmojiPacks([]*protobuf.CommunityEmojiPack{testEmojiPack("a", 100), testEmojiPack("b", 100)}))
+
+	// Shortcodes are unique across packs
+	s.Require().Equal(ErrInvalidEmojiPack, ValidateEmojiPacks([]*protobuf.CommunityEmojiPack{testEmojiPack("a", 1), testEmojiPack("a", 1)}))
+
+	// The packs of a description are bounded
+	var packs []*protobuf.CommunityEmojiPack
+	for i := 0; i < 4; i++ {
+		packs = append(packs, testEmojiPack(fmt.Sprintf("p%d", i), 100))
+	}
+	s.Require().Equal(ErrEmojiPacksTooLarge, ValidateEmojiPacks(packs))
+}
+
+func (s *CommunitySuite) TestSetEmojiPack() {
+	org, err := New(s.configOnRequest())
+	s.Require().NoError(err)
+
+	pack := &protobuf.CommunityEmojiPack{
+		Id:     "pack-1",
+		Name:   "status",
+		Emojis: []*protobuf.CommunityEmoji{testEmoji("emoji-1", "parrot")},
+	}
+	_, err = org.SetEmojiPack(pack)
+	s.Require().NoError(err)
+	s.Require().Equal(pack.Emojis[0], org.Emoji("emoji-1"))
+
+	// Shortcodes are unique across packs
+	_, err = org.SetEmojiPack(&protobuf.CommunityEmojiPack{
+		Id:     "pack-2",
+		Name:   "other",
+		Emojis: []*protobuf.CommunityEmoji{testEmoji("emoji-2", "parrot")},
+	})
+	s.Require().Equal(ErrInvalidEmojiPack, err)
+
+	// A pack with the same ID is replaced
+	pack.Emojis = []*protobuf.CommunityEmoji{testEmoji("emoji-3", "cat")}
+	_, err = org.SetEmojiPack(pack)
+	s.Require().NoError(err)
+	s.Require().Len(org.EmojiPacks(), 1)
+	s.Require().Nil(org.Emoji("emoji-1"))
+
+	_, err = org.DeleteEmojiPack("pack-1")
+	s.Require().NoError(err)
+	s.Require().Empty(org.EmojiPacks())
+
+	_, err = org.DeleteEmojiPack("pack-1")
+	s.Require().Equal(ErrEmojiPackNotFound, err)
+}
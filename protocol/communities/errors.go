@@ -39,3 +39,16 @@ var ErrMemberWalletNotFound = errors.New("member wallet not found")
 var ErrNotEnoughPermissions = errors.New("not enough permissions for this community")
 var ErrCannotRemoveOwnerOrAdmin = errors.New("not allowed to remove admin or owner")
 var ErrCannotBanOwnerOrAdmin = errors.New("not allowed to ban admin or owner")
+var ErrRequestToJoinNotPending = errors.New("request to join is not pending for this community")
+var ErrInvalidMembershipPayment = errors.New("invalid membership payment")
+var ErrNoMembershipPayment = errors.New("community doesn't require a membership payment")
+var ErrMembershipPaymentAlreadyUsed = errors.New("membership payment already used")
+var ErrInvalidEmojiPack = errors.New("invalid emoji pack")
+var ErrTooManyEmojiPacks = errors.New("too many emoji packs")
+var ErrEmojiPacksTooLarge = errors.New("emoji packs too large")
+var ErrEmojiPackNotFound = errors.New("emoji pack not found")
+var ErrInvalidBlocklistEntry = errors.New("invalid blocklist entry")
+var ErrTooManyBlocklistEntries = errors.New("too many blocklist entries")
+var ErrInvalidDescriptionDelta = errors.New("invalid community description delta")
+var ErrDescriptionDeltaBaseMismatch = errors.New("community description delta doesn't apply to the current description")
+var ErrTooManySnapshotSpaces = errors.New("too many snapshot spaces")
@@ -1,10 +1,12 @@
 package communities
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
 	"database/sql"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math"
 	"math/big"
@@ -40,6 +42,7 @@ import (
 	"github.com/status-im/status-go/protocol/requests"
 	"github.com/status-im/status-go/protocol/transport"
 	"github.com/status-im/status-go/protocol/v1"
+	"github.com/status-im/status-go/scheduler"
 	walletcommon "github.com/status-im/status-go/services/wallet/common"
 	"github.com/status-im/status-go/services/wallet/thirdparty/opensea"
 	"github.com/status-im/status-go/services/wallet/token"
@@ -71,6 +74,8 @@ type Manager struct {
 	identity                       *ecdsa.PrivateKey
 	accountsManager                account.Manager
 	tokenManager                   TokenManager
+	paymentVerifier                PaymentVerifier
+	soulboundTokenVerifier         SoulboundTokenVerifier
 	logger                         *zap.Logger
 	stdoutLogger                   *zap.Logger
 	transport                      *transport.Transport
@@ -84,6 +89,8 @@ type Manager struct {
 	periodicMemberPermissionsTasks sync.Map // stores `chan struct{}`
 	torrentTasks                   map[string]metainfo.Hash
 	historyArchiveDownloadTasks    map[string]*HistoryArchiveDownloadTask
+	archiveTransports              []ArchiveTransport
+	historyArchiveURIs             sync.Map // stores `[]string`
 	stopped                        bool
 }
 
@@ -123,10 +130,13 @@ func (t *HistoryArchiveDownloadTask) Cancel() {
 }
 
 type managerOptions struct {
-	accountsManager      account.Manager
-	tokenManager         TokenManager
-	walletConfig         *params.WalletConfig
-	openseaClientBuilder openseaClientBuilder
+	accountsManager        account.Manager
+	tokenManager           TokenManager
+	paymentVerifier        PaymentVerifier
+	soulboundTokenVerifier SoulboundTokenVerifier
+	walletConfig           *params.WalletConfig
+	openseaClientBuilder   openseaClientBuilder
+	archiveTransports      []ArchiveTransport
 }
 
 type TokenManager interface {
@@ -187,12 +197,32 @@ func WithTokenManager(tokenManager TokenManager) ManagerOption {
 	}
 }
 
+func WithPaymentVerifier(paymentVerifier PaymentVerifier) ManagerOption {
+	return func(opts *managerOptions) {
+		opts.paymentVerifier = paymentVerifier
+	}
+}
+
+func WithSoulboundTokenVerifier(soulboundTokenVerifier SoulboundTokenVerifier) ManagerOption {
+	return func(opts *managerOptions) {
+		opts.soulboundTokenVerifier = soulboundTokenVerifier
+	}
+}
+
 func WithWalletConfig(walletConfig *params.WalletConfig) ManagerOption {
 	return func(opts *managerOptions) {
 		opts.walletConfig = walletConfig
 	}
 }
 
+// WithArchiveTransports replaces the transports of the history archives
+// configured in the torrent config
+func WithArchiveTransports(transports ...ArchiveTransport) ManagerOption {
+	return func(opts *managerOptions) {
+		opts.archiveTransports = transports
+	}
+}
+
 func NewManager(identity *ecdsa.PrivateKey, db *sql.DB, encryptor *encryption.Protocol, logger *zap.Logger, verifier *ens.Verifier, transport *transport.Transport, torrentConfig *params.TorrentConfig, opts ...ManagerOption) (*Manager, error) {
 	if identity == nil {
 		return nil, errors.New("empty identity")
@@ -239,6 +269,14 @@ func NewManager(identity *ecdsa.PrivateKey, db *sql.DB, encryptor *encryption.Pr
 		manager.tokenManager = managerConfig.tokenManager
 	}
 
+	if managerConfig.paymentVerifier != nil {
+		manager.paymentVerifier = managerConfig.paymentVerifier
+	}
+
+	if managerConfig.soulboundTokenVerifier != nil {
+		manager.soulboundTokenVerifier = managerConfig.soulboundTokenVerifier
+	}
+
 	if managerConfig.walletConfig != nil {
 		manager.walletConfig = managerConfig.walletConfig
 	}
@@ -249,6 +287,12 @@ func NewManager(identity *ecdsa.PrivateKey, db *sql.DB, encryptor *encryption.Pr
 		manager.openseaClientBuilder = &defaultOpenseaBuilder{}
 	}
 
+	if managerConfig.archiveTransports != nil {
+		manager.archiveTransports = managerConfig.archiveTransports
+	} else {
+		manager.archiveTransports = archiveTransportsFromConfig(torrentConfig)
+	}
+
 	if verifier != nil {
 
 		sub := verifier.Subscribe()
@@ -296,7 +340,9 @@ type Subscription struct {
 	DownloadingHistoryArchivesFinishedSignal *signal.DownloadingHistoryArchivesFinishedSignal
 	ImportingHistoryArchiveMessagesSignal    *signal.ImportingHistoryArchiveMessagesSignal
 	CommunityAdminEvent                      *protobuf.CommunityAdminEvent
+	CommunityBan                             *protobuf.CommunityBan
 	MemberPermissionsCheckedSignal           *MemberPermissionsCheckedSignal
+	ChannelMembersRemovedSignal              *signal.CommunityChannelMembersRemovedSignal
 }
 
 type MemberPermissionsCheckedSignal struct {
@@ -354,12 +400,54 @@ func (m *Manager) Stop() error {
 	for _, c := range m.subscriptions {
 		close(c)
 	}
+	if !m.TorrentClientStarted() {
+		// The archives might be distributed with the archive transports only
+		m.StopHistoryArchiveTasksIntervals()
+	}
 	m.StopTorrentClient()
 	return nil
 }
 
 func (m *Manager) SetTorrentConfig(config *params.TorrentConfig) {
 	m.torrentConfig = config
+	m.archiveTransports = archiveTransportsFromConfig(config)
+}
+
+// ArchivePublishingEnabled tells whether the history archives are published
+// with at least one of the archive transports
+func (m *Manager) ArchivePublishingEnabled() bool {
+	if m.torrentConfig == nil || m.torrentConfig.DataDir == "" {
+		return false
+	}
+	for _, t := range m.archiveTransports {
+		if t.CanPublish() {
+			return true
+		}
+	}
+	return false
+}
+
+// CanFetchHistoryArchives tells whether the history archives published at
+// one of the uris can be fetched with the archive transports
+func (m *Manager) CanFetchHistoryArchives(uris []string) bool {
+	if m.torrentConfig == nil || m.torrentConfig.DataDir == "" {
+		return false
+	}
+	for _, uri := range uris {
+		if m.archiveTransportFor(uri) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Manager) archiveTransportFor(uri string) ArchiveTransport {
+	for _, t := range m.archiveTransports {
+		if t.CanFetch(uri) {
+			return t
+		}
+	}
+	return nil
 }
 
 // getTCPandUDPport will return the same port number given if != 0,
@@ -513,6 +601,51 @@ func (m *Manager) GetStoredDescriptionForCommunities(communityIDs []types.HexByt
 	return
 }
 
+// SaveDirectory caches the curated communities directory, so that it can be
+// searched and used when the contract is not reachable
+func (m *Manager) SaveDirectory(communityIDs []types.HexBytes, featuredIDs []types.HexBytes) error {
+	featured := make(map[string]bool)
+	for _, id := range featuredIDs {
+		featured[id.String()] = true
+	}
+
+	fetchedAt := uint64(time.Now().UnixMilli())
+	var entries []*DirectoryEntry
+	for _, id := range communityIDs {
+		entries = append(entries, &DirectoryEntry{
+			CommunityID: id,
+			Featured:    featured[id.String()],
+			FetchedAt:   fetchedAt,
+		})
+	}
+	return m.persistence.SaveDirectory(entries)
+}
+
+func (m *Manager) Directory() ([]*DirectoryEntry, error) {
+	return m.persistence.GetDirectory()
+}
+
+// SearchDirectory returns the communities of the cached directory matching
+// the filter, ranked locally
+func (m *Manager) SearchDirectory(filter *DirectoryFilter) ([]*RankedCommunity, error) {
+	entries, err := m.persistence.GetDirectory()
+	if err != nil {
+		return nil, err
+	}
+
+	var communityIDs []types.HexBytes
+	for _, entry := range entries {
+		communityIDs = append(communityIDs, entry.CommunityID)
+	}
+
+	response, err := m.GetStoredDescriptionForCommunities(communityIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return RankDirectory(entries, response.Descriptions, filter), nil
+}
+
 func (m *Manager) Joined() ([]*Community, error) {
 	return m.persistence.JoinedCommunities(&m.identity.PublicKey)
 }
@@ -542,7 +675,11 @@ func (m *Manager) CreateCommunity(request *requests.CreateCommunity, publish boo
 	}
 
 	description.Members = make(map[string]*protobuf.CommunityMember)
-	description.Members[common.PubkeyToHex(&m.identity.PublicKey)] = &protobuf.CommunityMember{Roles: []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_OWNER}}
+	description.Members[common.PubkeyToHex(&m.identity.PublicKey)] = &protobuf.CommunityMember{
+		Roles:        []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_OWNER},
+		Capabilities: LocalCapabilities,
+	}
+	description.RolePermissions = DefaultRolePermissions()
 
 	err = ValidateCommunityDescription(description)
 	if err != nil {
@@ -670,6 +807,47 @@ func (m *Manager) EditCommunityTokenPermission(request *requests.EditCommunityTo
 }
 
 func (m *Manager) checkMemberPermissions(community *Community, removeAdmins bool) error {
+	checked, err := m.checkCommunityMemberPermissions(community, removeAdmins)
+	if err != nil {
+		return err
+	}
+
+	var removedChannelMembers map[string][]string
+	if community.IsOwner() {
+		removedChannelMembers, err = m.checkChannelMemberPermissions(community)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !checked && len(removedChannelMembers) == 0 {
+		return nil
+	}
+
+	subscription := &Subscription{
+		Community:                      community,
+		MemberPermissionsCheckedSignal: &MemberPermissionsCheckedSignal{},
+	}
+
+	if len(removedChannelMembers) > 0 {
+		err = m.persistence.SaveCommunity(community)
+		if err != nil {
+			return err
+		}
+		subscription.ChannelMembersRemovedSignal = &signal.CommunityChannelMembersRemovedSignal{
+			CommunityID: community.IDString(),
+			Members:     removedChannelMembers,
+		}
+	}
+
+	m.publish(subscription)
+	return nil
+}
+
+// checkCommunityMemberPermissions updates the roles of the members and
+// removes the ones that don't satisfy the community permissions anymore.
+// It returns false when there were no permissions to check.
+func (m *Manager) checkCommunityMemberPermissions(community *Community, removeAdmins bool) (bool, error) {
 	becomeMemberPermissions := community.TokenPermissionsByType(protobuf.CommunityTokenPermission_BECOME_MEMBER)
 	becomeAdminPermissions := community.TokenPermissionsByType(protobuf.CommunityTokenPermission_BECOME_ADMIN)
 
@@ -677,13 +855,13 @@ func (m *Manager) checkMemberPermissions(community *Community, removeAdmins bool
 	memberPermissions := len(becomeMemberPermissions) > 0
 
 	if !adminPermissions && !memberPermissions && !removeAdmins {
-		return nil
+		return false, nil
 	}
 
 	for memberKey, member := range community.Members() {
 		memberPubKey, err := common.HexToPubkey(memberKey)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		if memberKey == common.PubkeyToHex(&m.identity.PublicKey) || community.IsMemberOwner(memberPubKey) {
@@ -698,7 +876,7 @@ func (m *Manager) checkMemberPermissions(community *Community, removeAdmins bool
 		if (!memberHasWallet && isAdmin) || (memberPermissions && !memberHasWallet) {
 			_, err = community.RemoveUserFromOrg(memberPubKey)
 			if err != nil {
-				return err
+				return false, err
 			}
 			continue
 		}
@@ -710,11 +888,11 @@ func (m *Manager) checkMemberPermissions(community *Community, removeAdmins bool
 		if adminPermissions {
 			permissionResponse, err := m.checkPermissionToJoin(becomeAdminPermissions, accountsAndChainIDs, true)
 			if err != nil {
-				return err
+				return false, err
 			} else if permissionResponse.Satisfied && !isAdmin {
 				_, err = community.AddRoleToMember(memberPubKey, protobuf.CommunityMember_ROLE_ADMIN)
 				if err != nil {
-					return err
+					return false, err
 				}
 				isAdmin = true
 			} else if !permissionResponse.Satisfied && isAdmin {
@@ -727,7 +905,7 @@ func (m *Manager) checkMemberPermissions(community *Community, removeAdmins bool
 		if removeAdminRole || isAdmin && removeAdmins {
 			_, err = community.RemoveRoleFromMember(memberPubKey, protobuf.CommunityMember_ROLE_ADMIN)
 			if err != nil {
-				return err
+				return false, err
 			}
 			isAdmin = false
 		}
@@ -739,22 +917,251 @@ func (m *Manager) checkMemberPermissions(community *Community, removeAdmins bool
 
 		permissionResponse, err := m.checkPermissionToJoin(becomeMemberPermissions, accountsAndChainIDs, true)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		if !permissionResponse.Satisfied {
 			_, err = community.RemoveUserFromOrg(memberPubKey)
 			if err != nil {
-				return err
+				return false, err
 			}
 		}
 	}
 
-	m.publish(&Subscription{
-		Community:                      community,
-		MemberPermissionsCheckedSignal: &MemberPermissionsCheckedSignal{},
-	})
-	return nil
+	return true, nil
+}
+
+// checkChannelMemberPermissions re-verifies the holdings of the members of
+// token gated channels and removes from the channel the ones that don't
+// satisfy its view permissions anymore. It returns the public keys of the
+// removed members by channel id.
+func (m *Manager) checkChannelMemberPermissions(community *Community) (map[string][]string, error) {
+	removed := make(map[string][]string)
+
+	for channelID, channel := range community.Chats() {
+		chatID := community.IDString() + channelID
+		viewOnlyPermissions := community.ChannelTokenPermissionsByType(chatID, protobuf.CommunityTokenPermission_CAN_VIEW_CHANNEL)
+		viewAndPostPermissions := community.ChannelTokenPermissionsByType(chatID, protobuf.CommunityTokenPermission_CAN_VIEW_AND_POST_CHANNEL)
+
+		if len(viewOnlyPermissions) == 0 && len(viewAndPostPermissions) == 0 {
+			continue
+		}
+
+		for memberKey := range channel.Members {
+			memberPubKey, err := common.HexToPubkey(memberKey)
+			if err != nil {
+				return nil, err
+			}
+
+			if memberKey == common.PubkeyToHex(&m.identity.PublicKey) || community.IsMemberOwnerOrAdmin(memberPubKey) {
+				continue
+			}
+
+			satisfied := false
+			member := community.getMember(memberPubKey)
+			if member != nil && len(member.RevealedAccounts) > 0 {
+				accountsAndChainIDs := revealedAccountsToAccountsAndChainIDsCombination(member.RevealedAccounts)
+				permissionResponse, err := m.checkChannelPermissions(viewOnlyPermissions, viewAndPostPermissions, accountsAndChainIDs, true)
+				if err != nil {
+					return nil, err
+				}
+				satisfied = permissionResponse.ViewOnlyPermissions.Satisfied
+			}
+
+			if satisfied {
+				continue
+			}
+
+			_, err = community.RemoveUserFromChat(memberPubKey, channelID)
+			if err != nil {
+				return nil, err
+			}
+			removed[chatID] = append(removed[chatID], memberKey)
+		}
+	}
+
+	if len(removed) > 0 {
+		community.increaseClock()
+	}
+
+	return removed, nil
+}
+
+// ReevaluateMembers re-verifies the permissions of the members of a community
+// and of its token gated channels, revoking the access of the ones that don't
+// satisfy them anymore.
+func (m *Manager) ReevaluateMembers(communityID types.HexBytes) (*Community, error) {
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+	if !community.IsOwner() {
+		return nil, ErrNotOwner
+	}
+
+	err = m.checkMemberPermissions(community, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return community, nil
+}
+
+// VerifySoulboundTokens checks on chain that the members still hold the
+// non-transferable tokens of the community, and flags the members whose
+// tokens were burned since the last check. The permissions of the members
+// are re-evaluated when a token was burned
+func (m *Manager) VerifySoulboundTokens(communityID types.HexBytes) ([]*SoulboundMemberVerification, error) {
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+	if !community.IsOwner() {
+		return nil, ErrNotOwner
+	}
+	if m.soulboundTokenVerifier == nil {
+		return nil, errors.New("no soulbound token verifier")
+	}
+
+	communityTokens, err := m.persistence.GetCommunityTokens(community.IDString())
+	if err != nil {
+		return nil, err
+	}
+
+	var soulboundTokens []*CommunityToken
+	for _, token := range communityTokens {
+		if !token.Transferable && token.DeployState == Deployed && token.TokenType == protobuf.CommunityTokenType_ERC721 {
+			soulboundTokens = append(soulboundTokens, token)
+		}
+	}
+
+	previousHoldings, err := m.persistence.GetSoulboundHoldings(community.IDString())
+	if err != nil {
+		return nil, err
+	}
+
+	members := community.Members()
+	publicKeys := make([]string, 0, len(members))
+	for publicKey := range members {
+		publicKeys = append(publicKeys, publicKey)
+	}
+	sort.Strings(publicKeys)
+
+	ctx, cancel := context.WithTimeout(context.Background(), soulboundVerificationTimeout)
+	defer cancel()
+
+	verifications := make([]*SoulboundMemberVerification, len(publicKeys))
+	memberHoldings := make([][]*SoulboundTokenHolding, len(publicKeys))
+	semaphore := make(chan struct{}, maxConcurrentSoulboundVerifications)
+	var wg sync.WaitGroup
+	for i, publicKey := range publicKeys {
+		i, publicKey := i, publicKey
+		addresses := revealedAddresses(members[publicKey])
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			verifications[i], memberHoldings[i] = m.verifyMemberSoulboundTokens(ctx, publicKey, addresses, soulboundTokens, previousHoldings[publicKey])
+		}()
+	}
+	wg.Wait()
+
+	holdings := make(map[string][]*SoulboundTokenHolding)
+	burned := false
+	for i, verification := range verifications {
+		if verification.Burned {
+			burned = true
+		}
+		if len(memberHoldings[i]) > 0 {
+			holdings[verification.PublicKey] = memberHoldings[i]
+		}
+	}
+
+	err = m.persistence.SaveSoulboundHoldings(community.IDString(), holdings)
+	if err != nil {
+		return nil, err
+	}
+
+	if burned {
+		err = m.checkMemberPermissions(community, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return verifications, nil
+}
+
+// verifyMemberSoulboundTokens reads from chain the non-transferable tokens
+// held by the revealed addresses of a member, and returns them with the ones
+// to keep until the next check. The holdings of the last check are kept when
+// the tokens can't be read, so that they aren't reported as burned next time
+func (m *Manager) verifyMemberSoulboundTokens(ctx context.Context, publicKey string, addresses []string, soulboundTokens []*CommunityToken, previous []*SoulboundTokenHolding) (*SoulboundMemberVerification, []*SoulboundTokenHolding) {
+	verification := &SoulboundMemberVerification{PublicKey: publicKey}
+	var holdings []*SoulboundTokenHolding
+
+	for _, token := range soulboundTokens {
+		holding := &SoulboundTokenHolding{ChainID: uint64(token.ChainID), ContractAddress: token.Address}
+		contractAddress := gethcommon.HexToAddress(token.Address)
+		for _, address := range addresses {
+			tokenIDs, err := m.soulboundTokenVerifier.OwnedTokenIDs(ctx, holding.ChainID, contractAddress, gethcommon.HexToAddress(address))
+			if err != nil {
+				m.logger.Warn("failed to read the soulbound tokens of a member", zap.String("publicKey", publicKey), zap.Error(err))
+				return &SoulboundMemberVerification{PublicKey: publicKey, Error: err.Error()}, previous
+			}
+			for _, tokenID := range tokenIDs {
+				holding.TokenIDs = append(holding.TokenIDs, tokenID.String())
+			}
+		}
+
+		for _, previousHolding := range previous {
+			if previousHolding.ChainID != holding.ChainID || previousHolding.ContractAddress != holding.ContractAddress {
+				continue
+			}
+			for _, tokenID := range missingTokenIDs(previousHolding.TokenIDs, holding.TokenIDs) {
+				id, ok := new(big.Int).SetString(tokenID, 10)
+				if !ok {
+					continue
+				}
+				burned, err := m.soulboundTokenVerifier.TokenBurned(ctx, holding.ChainID, contractAddress, id)
+				if err != nil {
+					m.logger.Warn("failed to check whether a soulbound token was burned", zap.String("publicKey", publicKey), zap.Error(err))
+					return &SoulboundMemberVerification{PublicKey: publicKey, Error: err.Error()}, previous
+				}
+				if burned {
+					holding.BurnedTokenIDs = append(holding.BurnedTokenIDs, tokenID)
+				} else {
+					holding.HiddenTokenIDs = append(holding.HiddenTokenIDs, tokenID)
+				}
+			}
+		}
+
+		if len(holding.BurnedTokenIDs) > 0 {
+			verification.Burned = true
+		}
+		if len(holding.TokenIDs) > 0 || len(holding.HiddenTokenIDs) > 0 {
+			held := *holding
+			held.TokenIDs = append(append([]string{}, holding.TokenIDs...), holding.HiddenTokenIDs...)
+			held.BurnedTokenIDs = nil
+			held.HiddenTokenIDs = nil
+			holdings = append(holdings, &held)
+		}
+		if len(holding.TokenIDs) > 0 || len(holding.BurnedTokenIDs) > 0 || len(holding.HiddenTokenIDs) > 0 {
+			verification.Tokens = append(verification.Tokens, holding)
+		}
+	}
+
+	return verification, holdings
 }
 
 func (m *Manager) CheckMemberPermissionsPeriodically(communityID types.HexBytes) {
@@ -766,27 +1173,27 @@ func (m *Manager) CheckMemberPermissionsPeriodically(communityID types.HexBytes)
 	cancel := make(chan struct{})
 	m.periodicMemberPermissionsTasks.Store(communityID.String(), cancel)
 
-	ticker := time.NewTicker(memberPermissionsCheckInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
+	unschedule := scheduler.Default().Schedule(scheduler.Job{
+		Name:        "communities.member-permissions",
+		Interval:    memberPermissionsCheckInterval,
+		Constraints: scheduler.Constraints{Online: true},
+		Run: func(context.Context) {
 			community, err := m.GetByID(communityID)
 			if err != nil {
 				m.logger.Debug("can't validate member permissions, community was not found", zap.Error(err))
-				m.periodicMemberPermissionsTasks.Delete(communityID.String())
+				return
 			}
 
 			err = m.checkMemberPermissions(community, true)
 			if err != nil {
 				m.logger.Debug("failed to check member permissions", zap.Error(err))
 			}
-		case <-cancel:
-			m.periodicMemberPermissionsTasks.Delete(communityID.String())
-			return
-		}
-	}
+		},
+	})
+	defer unschedule()
+
+	<-cancel
+	m.periodicMemberPermissionsTasks.Delete(communityID.String())
 }
 
 func (m *Manager) DeleteCommunityTokenPermission(request *requests.DeleteCommunityTokenPermission) (*Community, *CommunityChanges, error) {
@@ -1290,6 +1697,30 @@ func (m *Manager) HandleCommunityDescriptionMessage(signer *ecdsa.PublicKey, des
 	return m.handleCommunityDescriptionMessageCommon(community, description, payload)
 }
 
+// HandleCommunityDescriptionDeltaMessage applies a delta to the description of
+// a community we know of. It returns ErrDescriptionDeltaBaseMismatch when the
+// delta isn't based on the description we have, and nil when the delta is
+// outdated
+func (m *Manager) HandleCommunityDescriptionDeltaMessage(delta *protobuf.CommunityDescriptionDelta) (*CommunityResponse, error) {
+	community, err := m.persistence.GetByID(&m.identity.PublicKey, delta.CommunityId)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	description, payload, err := community.applyDescriptionDelta(delta)
+	if err != nil {
+		return nil, err
+	}
+	if description == nil {
+		return nil, nil
+	}
+
+	return m.handleCommunityDescriptionMessageCommon(community, description, payload)
+}
+
 func (m *Manager) handleCommunityDescriptionMessageCommon(community *Community, description *protobuf.CommunityDescription, payload []byte) (*CommunityResponse, error) {
 	changes, err := community.UpdateCommunityDescription(description, payload)
 	if err != nil {
@@ -1394,15 +1825,61 @@ func (m *Manager) HandleCommunityAdminEvent(signer *ecdsa.PublicKey, adminEvent
 		return nil, err
 	}
 
-	return m.handleCommunityDescriptionMessageCommon(community, patchedCommDescr, rawMessage)
-}
+	response, err := m.handleCommunityDescriptionMessageCommon(community, patchedCommDescr, rawMessage)
+	if err != nil {
+		return nil, err
+	}
 
-func (m *Manager) handleAdditionalAdminChanges(community *Community, adminEvent *protobuf.CommunityAdminEvent) error {
+	err = m.saveAuditLogEntry(common.PubkeyToHex(signer), adminEvent)
+	if err != nil {
+		m.logger.Warn("failed to save audit log entry", zap.Error(err))
+	}
 
-	saveOrUpdateRequestToJoin := func(signer string, request *protobuf.CommunityRequestToJoin, state RequestToJoinState) error {
-		requestToJoin := &RequestToJoin{
-			PublicKey:        signer,
-			Clock:            request.Clock,
+	return response, nil
+}
+
+// saveAuditLogEntry records an administrative change in the audit log of
+// the community
+func (m *Manager) saveAuditLogEntry(author string, event *protobuf.CommunityAdminEvent) error {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return m.persistence.SaveAuditLogEntry(&AuditLogEntry{
+		ID:          types.EncodeHex(crypto.Keccak256([]byte(author), payload)),
+		CommunityID: event.CommunityId,
+		Author:      author,
+		Type:        event.Type,
+		Clock:       event.Clock,
+		Event:       event,
+		Timestamp:   uint64(time.Now().UnixMilli()),
+	})
+}
+
+// AuditLog returns a page of the audit log of a community, most recent
+// changes first. It's only available to the owner of the community
+func (m *Manager) AuditLog(id types.HexBytes, cursor string, limit int) ([]*AuditLogEntry, string, error) {
+	community, err := m.GetByID(id)
+	if err != nil {
+		return nil, "", err
+	}
+	if community == nil {
+		return nil, "", ErrOrgNotFound
+	}
+	if !community.IsOwner() {
+		return nil, "", ErrNotOwner
+	}
+
+	return m.persistence.GetAuditLog(id, cursor, limit)
+}
+
+func (m *Manager) handleAdditionalAdminChanges(community *Community, adminEvent *protobuf.CommunityAdminEvent) error {
+
+	saveOrUpdateRequestToJoin := func(signer string, request *protobuf.CommunityRequestToJoin, state RequestToJoinState) error {
+		requestToJoin := &RequestToJoin{
+			PublicKey:        signer,
+			Clock:            request.Clock,
 			ENSName:          request.EnsName,
 			CommunityID:      request.CommunityId,
 			State:            state,
@@ -1587,84 +2064,347 @@ func (m *Manager) AcceptRequestToJoin(request *requests.AcceptRequestToJoinCommu
 		return nil, err
 	}
 
-	becomeAdminPermissions := community.TokenPermissionsByType(protobuf.CommunityTokenPermission_BECOME_ADMIN)
-	becomeMemberPermissions := community.TokenPermissionsByType(protobuf.CommunityTokenPermission_BECOME_MEMBER)
+	changes, err := m.acceptRequestToJoin(community, dbRequest)
+	if err == ErrNoPermissionToJoin {
+		return community, err
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	revealedAccounts, err := m.persistence.GetRequestToJoinRevealedAddresses(dbRequest.ID)
+	err = m.persistence.SaveCommunity(community)
 	if err != nil {
 		return nil, err
 	}
 
-	memberRole := protobuf.CommunityMember_ROLE_NONE
+	if community.IsOwner() {
+		m.publish(&Subscription{Community: community})
+	} else if community.IsAdmin() {
+		acceptedRequestsToJoin := make(map[string]*protobuf.CommunityRequestToJoin)
+		acceptedRequestsToJoin[dbRequest.PublicKey] = dbRequest.ToCommunityRequestToJoinProtobuf()
 
-	if len(becomeMemberPermissions) > 0 || len(becomeAdminPermissions) > 0 {
+		adminChanges := &CommunityAdminEventChanges{
+			CommunityChanges:       changes,
+			AcceptedRequestsToJoin: acceptedRequestsToJoin,
+		}
 
-		accountsAndChainIDs := revealedAccountsToAccountsAndChainIDsCombination(revealedAccounts)
+		m.publish(&Subscription{CommunityAdminEvent: community.ToCommunityRequestToJoinAcceptAdminEvent(adminChanges)})
+	}
 
-		// admin token permissions required to became an admin must not cancel request to join
-		// if requirements were not met
-		hasPermission := m.accountsHasAdminPermission(becomeAdminPermissions, accountsAndChainIDs)
+	return community, nil
+}
 
-		if hasPermission {
-			memberRole = protobuf.CommunityMember_ROLE_ADMIN
-		} else if len(becomeMemberPermissions) > 0 {
-			permissionResponse, err := m.checkPermissionToJoin(becomeMemberPermissions, accountsAndChainIDs, true)
-			if err != nil {
-				return nil, err
-			}
+// requestToJoinRole returns the role granted to a requester sharing the
+// given accounts, or ErrNoPermissionToJoin if they don't satisfy the
+// permissions to become a member
+func (m *Manager) requestToJoinRole(community *Community, revealedAccounts []*protobuf.RevealedAccount) (protobuf.CommunityMember_Roles, error) {
+	becomeAdminPermissions := community.TokenPermissionsByType(protobuf.CommunityTokenPermission_BECOME_ADMIN)
+	becomeMemberPermissions := community.TokenPermissionsByType(protobuf.CommunityTokenPermission_BECOME_MEMBER)
 
-			hasPermission := permissionResponse.Satisfied
+	if len(becomeMemberPermissions) == 0 && len(becomeAdminPermissions) == 0 {
+		return protobuf.CommunityMember_ROLE_NONE, nil
+	}
 
-			if !hasPermission {
-				return community, ErrNoPermissionToJoin
-			}
+	accountsAndChainIDs := revealedAccountsToAccountsAndChainIDsCombination(revealedAccounts)
+
+	// admin token permissions required to became an admin must not cancel request to join
+	// if requirements were not met
+	if m.accountsHasAdminPermission(becomeAdminPermissions, accountsAndChainIDs) {
+		return protobuf.CommunityMember_ROLE_ADMIN, nil
+	}
+
+	if len(becomeMemberPermissions) > 0 {
+		permissionResponse, err := m.checkPermissionToJoin(becomeMemberPermissions, accountsAndChainIDs, true)
+		if err != nil {
+			return protobuf.CommunityMember_ROLE_NONE, err
+		}
+
+		if !permissionResponse.Satisfied {
+			return protobuf.CommunityMember_ROLE_NONE, ErrNoPermissionToJoin
 		}
 	}
 
+	return protobuf.CommunityMember_ROLE_NONE, nil
+}
+
+// requestToJoinAcceptance is a request to join which can be accepted, with the
+// role and the accounts of the new member
+type requestToJoinAcceptance struct {
+	request          *RequestToJoin
+	publicKey        *ecdsa.PublicKey
+	role             protobuf.CommunityMember_Roles
+	revealedAccounts []*protobuf.RevealedAccount
+}
+
+// validateRequestToJoinAcceptance checks that the requester can be added to
+// the community, without changing it
+func (m *Manager) validateRequestToJoinAcceptance(community *Community, dbRequest *RequestToJoin) (*requestToJoinAcceptance, error) {
+	revealedAccounts, err := m.persistence.GetRequestToJoinRevealedAddresses(dbRequest.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberRole, err := m.requestToJoinRole(community, revealedAccounts)
+	if err != nil {
+		return nil, err
+	}
+
 	pk, err := common.HexToPubkey(dbRequest.PublicKey)
 	if err != nil {
 		return nil, err
 	}
 
+	return &requestToJoinAcceptance{
+		request:          dbRequest,
+		publicKey:        pk,
+		role:             memberRole,
+		revealedAccounts: revealedAccounts,
+	}, nil
+}
+
+// addAcceptedMember adds the requester of the accepted request to the
+// community
+func (m *Manager) addAcceptedMember(community *Community, acceptance *requestToJoinAcceptance) (*CommunityChanges, error) {
 	role := []protobuf.CommunityMember_Roles{}
-	if memberRole != protobuf.CommunityMember_ROLE_NONE {
-		role = []protobuf.CommunityMember_Roles{memberRole}
+	if acceptance.role != protobuf.CommunityMember_ROLE_NONE {
+		role = []protobuf.CommunityMember_Roles{acceptance.role}
 	}
 
-	changes, err := community.AddMember(pk, role)
+	changes, err := community.AddMember(acceptance.publicKey, role)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = community.AddMemberRevealedAccounts(dbRequest.PublicKey, revealedAccounts)
+	_, err = community.AddMemberRevealedAccounts(acceptance.request.PublicKey, acceptance.revealedAccounts)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := m.markRequestToJoin(pk, community); err != nil {
+	return changes, nil
+}
+
+// acceptRequestToJoin adds the requester to the community and marks the
+// request as accepted, without saving nor publishing the community
+func (m *Manager) acceptRequestToJoin(community *Community, dbRequest *RequestToJoin) (*CommunityChanges, error) {
+	acceptance, err := m.validateRequestToJoinAcceptance(community, dbRequest)
+	if err != nil {
 		return nil, err
 	}
 
-	err = m.persistence.SaveCommunity(community)
+	changes, err := m.addAcceptedMember(community, acceptance)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := m.markRequestToJoin(acceptance.publicKey, community); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// ModerateRequestsToJoin accepts and declines pending requests to join a
+// community, publishing a single update of the community for all of them.
+// Accepted requests of users who don't satisfy the permissions to join are
+// left pending. The whole batch is validated before it's applied, and the
+// community is saved with the new state of the requests in one transaction.
+// It returns the moderated requests with their new state.
+func (m *Manager) ModerateRequestsToJoin(request *requests.ModerateRequestsToJoinCommunity) (*Community, []*RequestToJoin, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if community == nil {
+		return nil, nil, ErrOrgNotFound
+	}
+	if !community.IsOwnerOrAdmin() {
+		return nil, nil, ErrNotAdmin
+	}
+
+	var moderated []*RequestToJoin
+	var acceptances []*requestToJoinAcceptance
+	var declined []*RequestToJoin
+
+	for _, id := range request.Accept {
+		dbRequest, err := m.pendingRequestToJoin(community, id)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		acceptance, err := m.validateRequestToJoinAcceptance(community, dbRequest)
+		if err == ErrNoPermissionToJoin {
+			moderated = append(moderated, dbRequest)
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		acceptances = append(acceptances, acceptance)
+	}
+
+	for _, id := range request.Decline {
+		dbRequest, err := m.pendingRequestToJoin(community, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		declined = append(declined, dbRequest)
+	}
+
+	if len(acceptances) == 0 && len(declined) == 0 {
+		return community, moderated, nil
+	}
+
+	changes := community.emptyCommunityChanges()
+	acceptedRequestsToJoin := make(map[string]*protobuf.CommunityRequestToJoin)
+	rejectedRequestsToJoin := make(map[string]*protobuf.CommunityRequestToJoin)
+	var stateChanges []*RequestToJoin
+
+	for _, acceptance := range acceptances {
+		requestChanges, err := m.addAcceptedMember(community, acceptance)
+		if err != nil {
+			return nil, nil, err
+		}
+		for memberKey, member := range requestChanges.MembersAdded {
+			changes.MembersAdded[memberKey] = member
+		}
+
+		dbRequest := acceptance.request
+		dbRequest.State = RequestToJoinStateAccepted
+		acceptedRequestsToJoin[dbRequest.PublicKey] = dbRequest.ToCommunityRequestToJoinProtobuf()
+		moderated = append(moderated, dbRequest)
+		stateChanges = append(stateChanges, dbRequest)
+	}
+
+	for _, dbRequest := range declined {
+		dbRequest.State = RequestToJoinStateDeclined
+		rejectedRequestsToJoin[dbRequest.PublicKey] = dbRequest.ToCommunityRequestToJoinProtobuf()
+		moderated = append(moderated, dbRequest)
+		stateChanges = append(stateChanges, dbRequest)
+	}
+
+	// declining requests doesn't change the community, yet we need to
+	// increase the clock to ensure the update is processed by other nodes
+	if len(acceptedRequestsToJoin) == 0 {
+		community.increaseClock()
+	}
+
+	err = m.persistence.SaveCommunityWithRequestsToJoinStates(community, stateChanges)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if community.IsOwner() {
 		m.publish(&Subscription{Community: community})
-	} else if community.IsAdmin() {
-		acceptedRequestsToJoin := make(map[string]*protobuf.CommunityRequestToJoin)
-		acceptedRequestsToJoin[dbRequest.PublicKey] = dbRequest.ToCommunityRequestToJoinProtobuf()
+		return community, moderated, nil
+	}
 
+	if len(acceptedRequestsToJoin) > 0 {
 		adminChanges := &CommunityAdminEventChanges{
 			CommunityChanges:       changes,
 			AcceptedRequestsToJoin: acceptedRequestsToJoin,
 		}
-
 		m.publish(&Subscription{CommunityAdminEvent: community.ToCommunityRequestToJoinAcceptAdminEvent(adminChanges)})
 	}
 
-	return community, nil
+	if len(rejectedRequestsToJoin) > 0 {
+		// each admin event needs its own clock
+		if len(acceptedRequestsToJoin) > 0 {
+			community.increaseClock()
+		}
+		adminChanges := &CommunityAdminEventChanges{
+			CommunityChanges:       community.emptyCommunityChanges(),
+			RejectedRequestsToJoin: rejectedRequestsToJoin,
+		}
+		m.publish(&Subscription{CommunityAdminEvent: community.ToCommunityRequestToJoinRejectAdminEvent(adminChanges)})
+	}
+
+	return community, moderated, nil
+}
+
+func (m *Manager) pendingRequestToJoin(community *Community, id types.HexBytes) (*RequestToJoin, error) {
+	dbRequest, err := m.persistence.GetRequestToJoin(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(dbRequest.CommunityID, community.ID()) || dbRequest.State != RequestToJoinStatePending {
+		return nil, ErrRequestToJoinNotPending
+	}
+
+	return dbRequest, nil
+}
+
+// FilterPendingRequestsToJoin returns the pending requests to join a
+// community matching all the given filters, along with the revealed accounts
+func (m *Manager) FilterPendingRequestsToJoin(request *requests.PendingRequestsToJoinCommunity) ([]*RequestToJoin, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	pending, err := m.persistence.PendingRequestsToJoinForCommunity(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string]bool, len(request.Addresses))
+	for _, address := range request.Addresses {
+		addresses[strings.ToLower(address)] = true
+	}
+
+	roles := make(map[protobuf.CommunityMember_Roles]bool, len(request.Roles))
+	for _, role := range request.Roles {
+		roles[role] = true
+	}
+
+	var filtered []*RequestToJoin
+	for _, requestToJoin := range pending {
+		if request.WithENSName && requestToJoin.ENSName == "" {
+			continue
+		}
+
+		requestToJoin.RevealedAccounts, err = m.persistence.GetRequestToJoinRevealedAddresses(requestToJoin.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if request.WithSharedAddresses && len(requestToJoin.RevealedAccounts) == 0 {
+			continue
+		}
+
+		if len(addresses) > 0 {
+			shared := false
+			for _, account := range requestToJoin.RevealedAccounts {
+				if addresses[strings.ToLower(account.Address)] {
+					shared = true
+					break
+				}
+			}
+			if !shared {
+				continue
+			}
+		}
+
+		if len(roles) > 0 {
+			role, err := m.requestToJoinRole(community, requestToJoin.RevealedAccounts)
+			if err == ErrNoPermissionToJoin {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if !roles[role] {
+				continue
+			}
+		}
+
+		filtered = append(filtered, requestToJoin)
+	}
+
+	return filtered, nil
 }
 
 func (m *Manager) GetRequestToJoin(ID types.HexBytes) (*RequestToJoin, error) {
@@ -1833,13 +2573,30 @@ func (m *Manager) HandleCommunityRequestToJoin(signer *ecdsa.PublicKey, request
 		}
 	}
 
+	// Communities requiring a payment only accept members who paid for it.
+	// The payment is verified on chain away from the handling of the
+	// request, which is kept pending until then
+	paymentRequired := community.MembershipPayment() != nil && !community.HasMember(signer)
+	if paymentRequired {
+		proof := request.MembershipPayment
+		if proof == nil || proof.TransactionHash == "" || len(request.RevealedAccounts) == 0 {
+			err = m.markRequestToJoinAsCanceled(signer, community)
+			if err != nil {
+				return nil, err
+			}
+			requestToJoin.State = RequestToJoinStateDeclined
+			return requestToJoin, nil
+		}
+		requestToJoin.MembershipPayment = proof
+	}
+
 	becomeAdminPermissions := community.TokenPermissionsByType(protobuf.CommunityTokenPermission_BECOME_ADMIN)
 	becomeMemberPermissions := community.TokenPermissionsByType(protobuf.CommunityTokenPermission_BECOME_MEMBER)
 
 	// If user is already a member, then accept request automatically
 	// It may happen when member removes itself from community and then tries to rejoin
 	// More specifically, CommunityRequestToLeave may be delivered later than CommunityRequestToJoin, or not delivered at all
-	acceptAutomatically := community.AcceptRequestToJoinAutomatically() || community.HasMember(signer)
+	acceptAutomatically := (community.AcceptRequestToJoinAutomatically() || community.HasMember(signer)) && !paymentRequired
 	hasPermission := false
 
 	// if we have admin or member permission and user revealed the address - process verification
@@ -1897,7 +2654,324 @@ func (m *Manager) HandleCommunityRequestToJoin(signer *ecdsa.PublicKey, request
 		requestToJoin.State = RequestToJoinStateAccepted
 	}
 
-	return requestToJoin, nil
+	return requestToJoin, nil
+}
+
+// VerifyMembershipPayment checks that the transaction of a pending request
+// to join paid for the membership from one of the revealed addresses, and
+// that it hasn't been used by someone else
+func (m *Manager) VerifyMembershipPayment(requestToJoin *RequestToJoin) (bool, error) {
+	proof := requestToJoin.MembershipPayment
+	if proof == nil || len(requestToJoin.RevealedAccounts) == 0 {
+		return false, nil
+	}
+
+	if m.paymentVerifier == nil {
+		return false, errors.New("no payment verifier")
+	}
+
+	community, err := m.GetByID(requestToJoin.CommunityID)
+	if err != nil {
+		return false, err
+	}
+	if community == nil {
+		return false, ErrOrgNotFound
+	}
+	if community.MembershipPayment() == nil {
+		return true, nil
+	}
+
+	usedBy, err := m.persistence.GetMembershipPaymentPublicKey(proof)
+	if err != nil {
+		return false, err
+	}
+	if usedBy != "" && usedBy != requestToJoin.PublicKey {
+		return false, nil
+	}
+
+	var payers []gethcommon.Address
+	for _, revealedAccount := range requestToJoin.RevealedAccounts {
+		payers = append(payers, gethcommon.HexToAddress(revealedAccount.Address))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), membershipPaymentVerifyTimeout)
+	defer cancel()
+	paid, err := m.paymentVerifier.VerifyMembershipPayment(ctx, community.ID(), community.MembershipPayment(), proof, payers)
+	if err != nil || !paid {
+		return false, err
+	}
+
+	return true, m.persistence.SaveMembershipPayment(proof, community.ID(), requestToJoin.PublicKey)
+}
+
+// SetSnapshotSpaces links Snapshot spaces to a community, replacing the ones
+// linked before
+func (m *Manager) SetSnapshotSpaces(request *requests.SetCommunitySnapshotSpaces) (*Community, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	_, err = community.SetSnapshotSpaces(request.Spaces)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+
+	m.publish(&Subscription{Community: community})
+
+	return community, nil
+}
+
+// SetMembershipPayment sets the ERC20 payment required to join a community
+func (m *Manager) SetMembershipPayment(request *requests.SetCommunityMembershipPayment) (*Community, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	var payment *protobuf.CommunityMembershipPayment
+	if request.Amount != "" {
+		payment = &protobuf.CommunityMembershipPayment{
+			ChainId:         request.ChainID,
+			ContractAddress: request.ContractAddress,
+			Amount:          request.Amount,
+			Recipient:       request.Recipient,
+		}
+		if err := ValidateMembershipPayment(payment); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = community.SetMembershipPayment(payment)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+
+	m.publish(&Subscription{Community: community})
+
+	return community, nil
+}
+
+// SetMemberCapabilities records the capabilities advertised by a member of a
+// community we own and publishes the description when they changed
+func (m *Manager) SetMemberCapabilities(communityID types.HexBytes, pk *ecdsa.PublicKey, capabilities []protobuf.CommunityMember_Capabilities) (*Community, error) {
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	changed, err := community.SetMemberCapabilities(pk, capabilities)
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return community, nil
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+
+	m.publish(&Subscription{Community: community})
+
+	return community, nil
+}
+
+// UpdateBlocklist adds users to the blocklist of a community and removes
+// others from it
+func (m *Manager) UpdateBlocklist(request *requests.UpdateCommunityBlocklist) (*Community, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	_, err = community.UpdateBlocklist(request.ToBlocklistEntries(), request.Remove)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+
+	m.publish(&Subscription{Community: community})
+
+	return community, nil
+}
+
+// CreateAirdropSnapshot records the members of a community eligible to an
+// airdrop, with their airdrop address. lastActivity returns when the members
+// last posted in the community, in milliseconds, it's only used when the
+// criteria filter on activity
+func (m *Manager) CreateAirdropSnapshot(communityID types.HexBytes, criteria AirdropSnapshotCriteria, lastActivity func() (map[string]uint64, error)) (*AirdropSnapshot, error) {
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+	if !community.HasMemberPermission(&m.identity.PublicKey, protobuf.CommunityMember_PERMISSION_AIRDROP_TOKENS) {
+		return nil, ErrNotAuthorized
+	}
+
+	var lastActive map[string]uint64
+	if criteria.ActiveSince != 0 {
+		lastActive, err = lastActivity()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	snapshot := &AirdropSnapshot{
+		ID:          uuid.New().String(),
+		CommunityID: community.IDString(),
+		CreatedAt:   uint64(time.Now().UnixMilli()),
+		Criteria:    criteria,
+	}
+
+	members := community.Members()
+	publicKeys := make([]string, 0, len(members))
+	for publicKey := range members {
+		publicKeys = append(publicKeys, publicKey)
+	}
+	sort.Strings(publicKeys)
+
+	for _, publicKey := range publicKeys {
+		member := members[publicKey]
+		address := airdropAddress(member)
+		if address == "" || !criteria.hasRole(member) {
+			continue
+		}
+
+		if criteria.JoinedBefore != 0 {
+			request, err := m.persistence.GetRequestToJoinByPkAndCommunityID(publicKey, community.ID())
+			if err == sql.ErrNoRows {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if request.Clock >= criteria.JoinedBefore {
+				continue
+			}
+		}
+
+		if criteria.ActiveSince != 0 && lastActive[publicKey] < criteria.ActiveSince*1000 {
+			continue
+		}
+
+		snapshot.Recipients = append(snapshot.Recipients, &AirdropRecipient{
+			PublicKey: publicKey,
+			Address:   address,
+		})
+	}
+
+	err = m.persistence.SaveAirdropSnapshot(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// GetAirdropSnapshot returns the snapshot, or nil
+func (m *Manager) GetAirdropSnapshot(id string) (*AirdropSnapshot, error) {
+	return m.persistence.GetAirdropSnapshot(id)
+}
+
+func (m *Manager) GetAirdropSnapshots(communityID types.HexBytes) ([]*AirdropSnapshot, error) {
+	return m.persistence.GetAirdropSnapshots(communityID.String())
+}
+
+func (m *Manager) DeleteAirdropSnapshot(id string) error {
+	return m.persistence.DeleteAirdropSnapshot(id)
+}
+
+// SetEmojiPack creates an emoji pack, or replaces the pack with the same ID.
+// Emojis without a hash keep the image they had in the pack
+func (m *Manager) SetEmojiPack(request *requests.SetCommunityEmojiPack) (*Community, *protobuf.CommunityEmojiPack, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if community == nil {
+		return nil, nil, ErrOrgNotFound
+	}
+
+	pack := request.ToEmojiPack()
+	if pack.Id == "" {
+		pack.Id = uuid.New().String()
+	} else {
+		for _, emoji := range pack.Emojis {
+			if emoji.Hash == "" {
+				if existing := community.Emoji(emoji.Id); existing != nil {
+					emoji.Hash = existing.Hash
+				}
+			}
+		}
+	}
+
+	_, err = community.SetEmojiPack(pack)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m.publish(&Subscription{Community: community})
+
+	return community, pack, nil
+}
+
+func (m *Manager) DeleteEmojiPack(communityID types.HexBytes, packID string) (*Community, error) {
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	_, err = community.DeleteEmojiPack(packID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+
+	m.publish(&Subscription{Community: community})
+
+	return community, nil
 }
 
 type CheckPermissionsResponse struct {
@@ -2758,6 +3832,35 @@ func (m *Manager) AddRoleToMember(request *requests.AddRoleToMember) (*Community
 	return community, nil
 }
 
+func (m *Manager) SetMemberRoles(request *requests.SetMemberRoles) (*Community, error) {
+	publicKey, err := common.HexToPubkey(request.User.String())
+	if err != nil {
+		return nil, err
+	}
+
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	_, err = community.SetMemberRoles(publicKey, request.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+
+	m.publish(&Subscription{Community: community})
+
+	return community, nil
+}
+
 func (m *Manager) RemoveRoleFromMember(request *requests.RemoveRoleFromMember) (*Community, error) {
 	id := request.CommunityID
 	publicKey, err := common.HexToPubkey(request.User.String())
@@ -2818,15 +3921,53 @@ func (m *Manager) BanUserFromCommunity(request *requests.BanUserFromCommunity) (
 		return nil, err
 	}
 
+	// The reason and the moderator are kept out of the description, they're
+	// only recorded in the moderation log of the owner and admins
+	entry := &ModerationLogEntry{
+		CommunityID:     community.ID(),
+		MemberID:        request.User.String(),
+		ModeratorID:     common.PubkeyToHex(&m.identity.PublicKey),
+		Reason:          request.Reason,
+		MessagesDeleted: request.DeleteAllMessages,
+		Clock:           community.Clock(),
+	}
+	err = m.persistence.SaveModerationLogEntry(entry)
+	if err != nil {
+		return nil, err
+	}
+
 	if community.IsOwner() {
-		m.publish(&Subscription{Community: community})
+		m.publish(&Subscription{Community: community, CommunityBan: entry.ToProtobuf()})
 	} else if community.IsAdmin() {
-		m.publish(&Subscription{CommunityAdminEvent: community.ToBanCommunityMemberAdminEvent(request.User.String())})
+		m.publish(&Subscription{CommunityAdminEvent: community.ToBanCommunityMemberAdminEvent(request.User.String()), CommunityBan: entry.ToProtobuf()})
 	}
 
 	return community, nil
 }
 
+// HandleCommunityBan records in the moderation log a ban sent by another
+// owner or admin of the community
+func (m *Manager) HandleCommunityBan(signer *ecdsa.PublicKey, ban *protobuf.CommunityBan) error {
+	community, err := m.GetByID(ban.CommunityId)
+	if err != nil {
+		return err
+	}
+	if community == nil {
+		return ErrOrgNotFound
+	}
+	if !community.IsOwnerOrAdmin() {
+		return ErrNotAdmin
+	}
+	if !common.IsPubKeyEqual(community.PublicKey(), signer) && !community.IsMemberOwnerOrAdmin(signer) {
+		return ErrNotAuthorized
+	}
+	if ban.ModeratorId != common.PubkeyToHex(signer) {
+		return ErrNotAuthorized
+	}
+
+	return m.persistence.SaveModerationLogEntry(moderationLogEntryFromProtobuf(ban))
+}
+
 func (m *Manager) GetByID(id []byte) (*Community, error) {
 	return m.persistence.GetByID(&m.identity.PublicKey, id)
 }
@@ -2893,6 +4034,23 @@ func (m *Manager) PendingRequestsToJoinForCommunity(id types.HexBytes) ([]*Reque
 	return m.persistence.PendingRequestsToJoinForCommunity(id)
 }
 
+// ModerationLog returns the bans recorded for a community, most recent first.
+// It's only available to the owner and admins of the community
+func (m *Manager) ModerationLog(id types.HexBytes) ([]*ModerationLogEntry, error) {
+	community, err := m.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+	if !community.IsOwnerOrAdmin() {
+		return nil, ErrNotAdmin
+	}
+
+	return m.persistence.GetModerationLog(id)
+}
+
 func (m *Manager) DeclinedRequestsToJoinForCommunity(id types.HexBytes) ([]*RequestToJoin, error) {
 	m.logger.Info("fetching declined invitations", zap.String("community-id", id.String()))
 	return m.persistence.DeclinedRequestsToJoinForCommunity(id)
@@ -3166,48 +4324,56 @@ func (m *Manager) StartHistoryArchiveTasksInterval(community *Community, interva
 	m.historyArchiveTasks.Store(id, cancel)
 	m.historyArchiveTasksWaitGroup.Add(1)
 
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
+	// Creating and seeding the archives uploads data, so it waits for an
+	// unmetered connection
+	unschedule := scheduler.Default().Schedule(scheduler.Job{
+		Name:        "communities.history-archive",
+		Interval:    interval,
+		Constraints: scheduler.Constraints{Unmetered: true, Online: true},
+		Run: func(context.Context) {
+			m.createAndSeedHistoryArchive(community, interval)
+		},
+	})
 
 	m.LogStdout("starting history archive tasks interval", zap.String("id", id))
-	for {
-		select {
-		case <-ticker.C:
-			m.LogStdout("starting archive task...", zap.String("id", id))
-			lastArchiveEndDateTimestamp, err := m.GetHistoryArchivePartitionStartTimestamp(community.ID())
-			if err != nil {
-				m.LogStdout("failed to get last archive end date", zap.Error(err))
-				continue
-			}
+	<-cancel
+	unschedule()
+	m.UnseedHistoryArchiveTorrent(community.ID())
+	m.historyArchiveTasks.Delete(id)
+	m.historyArchiveTasksWaitGroup.Done()
+}
 
-			if lastArchiveEndDateTimestamp == 0 {
-				// This means there are no waku messages for this community,
-				// so nothing to do here
-				m.LogStdout("couldn't determine archive start date - skipping")
-				continue
-			}
+// createAndSeedHistoryArchive archives the messages of the community since
+// the last archive
+func (m *Manager) createAndSeedHistoryArchive(community *Community, interval time.Duration) {
+	id := community.IDString()
+	m.LogStdout("starting archive task...", zap.String("id", id))
+	lastArchiveEndDateTimestamp, err := m.GetHistoryArchivePartitionStartTimestamp(community.ID())
+	if err != nil {
+		m.LogStdout("failed to get last archive end date", zap.Error(err))
+		return
+	}
 
-			topics, err := m.GetCommunityChatsTopics(community.ID())
-			if err != nil {
-				m.LogStdout("failed to get community chat topics ", zap.Error(err))
-				continue
-			}
+	if lastArchiveEndDateTimestamp == 0 {
+		// This means there are no waku messages for this community,
+		// so nothing to do here
+		m.LogStdout("couldn't determine archive start date - skipping")
+		return
+	}
+
+	topics, err := m.GetCommunityChatsTopics(community.ID())
+	if err != nil {
+		m.LogStdout("failed to get community chat topics ", zap.Error(err))
+		return
+	}
 
-			ts := time.Now().Unix()
-			to := time.Unix(ts, 0)
-			lastArchiveEndDate := time.Unix(int64(lastArchiveEndDateTimestamp), 0)
+	ts := time.Now().Unix()
+	to := time.Unix(ts, 0)
+	lastArchiveEndDate := time.Unix(int64(lastArchiveEndDateTimestamp), 0)
 
-			err = m.CreateAndSeedHistoryArchive(community.ID(), topics, lastArchiveEndDate, to, interval, community.Encrypted())
-			if err != nil {
-				m.LogStdout("failed to create and seed history archive", zap.Error(err))
-				continue
-			}
-		case <-cancel:
-			m.UnseedHistoryArchiveTorrent(community.ID())
-			m.historyArchiveTasks.Delete(id)
-			m.historyArchiveTasksWaitGroup.Done()
-			return
-		}
+	err = m.CreateAndSeedHistoryArchive(community.ID(), topics, lastArchiveEndDate, to, interval, community.Encrypted())
+	if err != nil {
+		m.LogStdout("failed to create and seed history archive", zap.Error(err))
 	}
 }
 
@@ -3542,18 +4708,18 @@ func (m *Manager) SeedHistoryArchiveTorrent(communityID types.HexBytes) error {
 		return err
 	}
 
-	hash := metaInfo.HashInfoBytes()
-	m.torrentTasks[id] = hash
+	if m.TorrentClientStarted() {
+		hash := metaInfo.HashInfoBytes()
+		m.torrentTasks[id] = hash
 
-	if err != nil {
-		return err
+		torrent, err := m.torrentClient.AddTorrent(metaInfo)
+		if err != nil {
+			return err
+		}
+		torrent.DownloadAll()
 	}
 
-	torrent, err := m.torrentClient.AddTorrent(metaInfo)
-	if err != nil {
-		return err
-	}
-	torrent.DownloadAll()
+	m.publishHistoryArchive(communityID)
 
 	m.publish(&Subscription{
 		HistoryArchivesSeedingSignal: &signal.HistoryArchivesSeedingSignal{
@@ -3569,6 +4735,11 @@ func (m *Manager) SeedHistoryArchiveTorrent(communityID types.HexBytes) error {
 
 func (m *Manager) UnseedHistoryArchiveTorrent(communityID types.HexBytes) {
 	id := communityID.String()
+	m.historyArchiveURIs.Delete(id)
+
+	if !m.TorrentClientStarted() {
+		return
+	}
 
 	hash, exists := m.torrentTasks[id]
 
@@ -3590,6 +4761,10 @@ func (m *Manager) UnseedHistoryArchiveTorrent(communityID types.HexBytes) {
 
 func (m *Manager) IsSeedingHistoryArchiveTorrent(communityID types.HexBytes) bool {
 	id := communityID.String()
+	if !m.TorrentClientStarted() {
+		_, published := m.historyArchiveURIs.Load(id)
+		return published
+	}
 	hash := m.torrentTasks[id]
 	torrent, ok := m.torrentClient.Torrent(hash)
 	return ok && torrent.Seeding()
@@ -3871,6 +5046,245 @@ func (m *Manager) GetHistoryArchiveMagnetlink(communityID types.HexBytes) (strin
 	return metaInfo.Magnet(nil, &info).String(), nil
 }
 
+// publishHistoryArchive uploads the archive of the community with the
+// archive transports, so that it can be fetched without BitTorrent
+func (m *Manager) publishHistoryArchive(communityID types.HexBytes) {
+	if !m.ArchivePublishingEnabled() {
+		return
+	}
+
+	id := communityID.String()
+	files := map[string]string{
+		archiveTorrentFileName: m.torrentFile(id),
+		archiveIndexFileName:   m.archiveIndexFile(id),
+		archiveDataFileName:    m.archiveDataFile(id),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), archiveTransportTimeout)
+	defer cancel()
+
+	var uris []string
+	for _, t := range m.archiveTransports {
+		if !t.CanPublish() {
+			continue
+		}
+		uri, err := t.Publish(ctx, id, files)
+		if err != nil {
+			m.LogStdout("failed to publish history archive", zap.String("id", id), zap.Error(err))
+			continue
+		}
+		m.LogStdout("published history archive", zap.String("id", id), zap.String("uri", uri))
+		uris = append(uris, uri)
+	}
+
+	if len(uris) > 0 {
+		m.historyArchiveURIs.Store(id, uris)
+	}
+}
+
+// GetHistoryArchiveURIs returns the URIs the archive of the community has
+// been published at with the archive transports
+func (m *Manager) GetHistoryArchiveURIs(communityID types.HexBytes) []string {
+	uris, ok := m.historyArchiveURIs.Load(communityID.String())
+	if !ok {
+		return nil
+	}
+	return uris.([]string)
+}
+
+// DownloadHistoryArchivesByURIs downloads the archives of the community with
+// the archive transports, from the first of the uris which succeeds. The
+// files are verified against the info hash of the magnet link and the piece
+// hashes of the torrent, as they would be with BitTorrent
+func (m *Manager) DownloadHistoryArchivesByURIs(communityID types.HexBytes, magnetlink string, uris []string, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error) {
+	ml, err := metainfo.ParseMagnetUri(magnetlink)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), archiveTransportTimeout)
+	defer cancel()
+	go func() {
+		select {
+		case <-cancelTask:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	err = ErrNoArchiveTransport
+	for _, uri := range uris {
+		transport := m.archiveTransportFor(uri)
+		if transport == nil {
+			continue
+		}
+
+		m.LogStdout("downloading history archives", zap.String("uri", uri))
+		var downloadTaskInfo *HistoryArchiveDownloadTaskInfo
+		downloadTaskInfo, err = m.downloadHistoryArchivesFrom(ctx, transport, uri, communityID, ml.InfoHash)
+		if err == nil {
+			return downloadTaskInfo, nil
+		}
+
+		select {
+		case <-cancelTask:
+			m.LogStdout("cancelled downloading history archives")
+			return &HistoryArchiveDownloadTaskInfo{Cancelled: true}, nil
+		default:
+		}
+		m.LogStdout("failed to download history archives", zap.String("uri", uri), zap.Error(err))
+	}
+	return nil, err
+}
+
+func (m *Manager) downloadHistoryArchivesFrom(ctx context.Context, transport ArchiveTransport, uri string, communityID types.HexBytes, infoHash metainfo.Hash) (*HistoryArchiveDownloadTaskInfo, error) {
+	id := communityID.String()
+
+	body, err := transport.Fetch(ctx, uri, archiveTorrentFileName)
+	if err != nil {
+		return nil, err
+	}
+	metaInfoBytes, err := ioutil.ReadAll(io.LimitReader(body, maxArchiveTorrentFileSize))
+	body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	metaInfo, err := metainfo.Load(bytes.NewReader(metaInfoBytes))
+	if err != nil {
+		return nil, err
+	}
+	if metaInfo.HashInfoBytes() != infoHash {
+		return nil, ErrArchiveIntegrity
+	}
+	info, err := metaInfo.UnmarshalInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	// The files are downloaded next to the archive, which is replaced once
+	// they're verified
+	downloadDir := m.torrentConfig.DataDir + "/" + id + ".download"
+	err = os.RemoveAll(downloadDir)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(downloadDir)
+
+	for _, file := range info.UpvertedFiles() {
+		name := file.DisplayPath(&info)
+		if name != archiveIndexFileName && name != archiveDataFileName {
+			return nil, ErrArchiveIntegrity
+		}
+		err = fetchArchiveFileTo(ctx, transport, uri, name, downloadDir+"/"+name, file.Length)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	verified := metainfo.Info{
+		Name:        info.Name,
+		PieceLength: info.PieceLength,
+		Length:      info.Length,
+		Files:       info.Files,
+	}
+	err = verified.GeneratePieces(func(file metainfo.FileInfo) (io.ReadCloser, error) {
+		return os.Open(downloadDir + "/" + file.DisplayPath(&info))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(verified.Pieces, info.Pieces) {
+		return nil, ErrArchiveIntegrity
+	}
+
+	err = os.MkdirAll(m.torrentConfig.DataDir+"/"+id, 0700)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range info.UpvertedFiles() {
+		name := file.DisplayPath(&info)
+		err = os.Rename(downloadDir+"/"+name, m.torrentConfig.DataDir+"/"+id+"/"+name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if m.torrentConfig.TorrentDir != "" {
+		err = os.MkdirAll(m.torrentConfig.TorrentDir, 0700)
+		if err != nil {
+			return nil, err
+		}
+		err = os.WriteFile(m.torrentFile(id), metaInfoBytes, 0644) // nolint: gosec
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m.saveDownloadedHistoryArchives(communityID)
+}
+
+// saveDownloadedHistoryArchives marks the archives of the index which haven't
+// been downloaded before as downloaded, so that they're imported
+func (m *Manager) saveDownloadedHistoryArchives(communityID types.HexBytes) (*HistoryArchiveDownloadTaskInfo, error) {
+	index, err := m.LoadHistoryArchiveIndexFromFile(m.identity, communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	existingArchiveIDs, err := m.persistence.GetDownloadedMessageArchiveIDs(communityID)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]bool)
+	for _, hash := range existingArchiveIDs {
+		existing[hash] = true
+	}
+
+	downloadTaskInfo := &HistoryArchiveDownloadTaskInfo{
+		TotalDownloadedArchivesCount: len(existingArchiveIDs),
+		TotalArchivesCount:           len(index.Archives),
+	}
+
+	archiveHashes := make(archiveMDSlice, 0, len(index.Archives))
+	for hash, metadata := range index.Archives {
+		if !existing[hash] {
+			archiveHashes = append(archiveHashes, &archiveMetadata{hash: hash, from: metadata.Metadata.From})
+		}
+	}
+	if len(archiveHashes) == 0 {
+		m.LogStdout("no new archives")
+		return downloadTaskInfo, nil
+	}
+	sort.Sort(sort.Reverse(archiveHashes))
+
+	m.publish(&Subscription{
+		DownloadingHistoryArchivesStartedSignal: &signal.DownloadingHistoryArchivesStartedSignal{
+			CommunityID: communityID.String(),
+		},
+	})
+
+	for _, hd := range archiveHashes {
+		err = m.persistence.SaveMessageArchiveID(communityID, hd.hash)
+		if err != nil {
+			m.LogStdout("couldn't save message archive ID", zap.Error(err))
+			continue
+		}
+		downloadTaskInfo.TotalDownloadedArchivesCount++
+
+		metadata := index.Archives[hd.hash]
+		m.publish(&Subscription{
+			HistoryArchiveDownloadedSignal: &signal.HistoryArchiveDownloadedSignal{
+				CommunityID: communityID.String(),
+				From:        int(metadata.Metadata.From),
+				To:          int(metadata.Metadata.To),
+			},
+		})
+	}
+	m.LogStdout("finished downloading archives")
+	return downloadTaskInfo, nil
+}
+
 func (m *Manager) createWakuMessageArchive(from time.Time, to time.Time, messages []types.Message, topics [][]byte) *protobuf.WakuMessageArchive {
 	var wakuMessages []*protobuf.WakuMessage
 
@@ -4003,9 +5417,10 @@ func (m *Manager) ImageToBase64(uri string) string {
 	return base64img
 }
 
-func (m *Manager) AddCommunityToken(token *CommunityToken, croppedImage *images.CroppedImage) (*CommunityToken, error) {
-
-	community, err := m.GetByIDString(token.CommunityID)
+// CheckMintPermission returns an error when the user can't add tokens to the
+// community, so that a token isn't deployed for nothing
+func (m *Manager) CheckMintPermission(communityID string) (*Community, error) {
+	community, err := m.GetByIDString(communityID)
 	if err != nil {
 		return nil, err
 	}
@@ -4013,6 +5428,19 @@ func (m *Manager) AddCommunityToken(token *CommunityToken, croppedImage *images.
 		return nil, ErrOrgNotFound
 	}
 
+	if !community.HasMemberPermission(&m.identity.PublicKey, protobuf.CommunityMember_PERMISSION_MINT_TOKENS) {
+		return nil, ErrNotEnoughPermissions
+	}
+	return community, nil
+}
+
+func (m *Manager) AddCommunityToken(token *CommunityToken, croppedImage *images.CroppedImage) (*CommunityToken, error) {
+
+	community, err := m.CheckMintPermission(token.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
 	if croppedImage != nil && croppedImage.ImagePath != "" {
 		bytes, err := images.OpenAndAdjustImage(*croppedImage, true)
 		if err != nil {
@@ -4047,6 +5475,16 @@ func (m *Manager) AddCommunityToken(token *CommunityToken, croppedImage *images.
 		return nil, err
 	}
 
+	err = m.saveAuditLogEntry(common.PubkeyToHex(&m.identity.PublicKey), &protobuf.CommunityAdminEvent{
+		Clock:         community.Clock(),
+		CommunityId:   community.ID(),
+		Type:          protobuf.CommunityAdminEvent_COMMUNITY_TOKEN_ADD,
+		TokenMetadata: tokenMetadata,
+	})
+	if err != nil {
+		m.logger.Warn("failed to save audit log entry", zap.Error(err))
+	}
+
 	m.publish(&Subscription{Community: community})
 
 	return token, m.persistence.AddCommunityToken(token)
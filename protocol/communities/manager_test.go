@@ -3,11 +3,13 @@ package communities
 import (
 	"bytes"
 	"context"
+	"errors"
 	"image"
 	"image/png"
 	"io/ioutil"
 	"math"
 	"math/big"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -29,6 +31,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/sqlite"
 )
@@ -1392,6 +1395,73 @@ func (s *ManagerSuite) TestCheckAllChannelsPermissions() {
 	s.Require().Len(response.Channels[chatID2].ViewOnlyPermissions.Permissions, 0)
 }
 
+func (s *ManagerSuite) TestDownloadHistoryArchivesByURIs() {
+	server := newArchiveServer()
+	httpServer := httptest.NewTLSServer(server)
+	defer httpServer.Close()
+
+	torrentConfig := buildTorrentConfig()
+	torrentConfig.Enabled = false
+	torrentConfig.ArchiveHTTPURL = httpServer.URL
+	s.manager.SetTorrentConfig(&torrentConfig)
+	s.Require().True(s.manager.ArchivePublishingEnabled())
+
+	// The test server listens on a loopback address, which archives aren't
+	// fetched from otherwise
+	archiveTransport := NewHTTPArchiveTransport(httpServer.URL)
+	archiveTransport.client = httpServer.Client()
+	archiveTransport.fetchClient = httpServer.Client()
+	s.manager.archiveTransports = []ArchiveTransport{archiveTransport}
+
+	community, chatID, err := s.buildCommunityWithChat()
+	s.Require().NoError(err)
+
+	topic := types.BytesToTopic(transport.ToTopic(chatID))
+	topics := []types.TopicType{topic}
+
+	startDate := time.Date(2020, 1, 1, 00, 00, 00, 0, time.UTC)
+	endDate := time.Date(2020, 1, 7, 00, 00, 00, 0, time.UTC)
+	partition := 7 * 24 * time.Hour
+
+	message := buildMessage(startDate.Add(1*time.Hour), topic, []byte{1})
+	err = s.manager.StoreWakuMessage(&message)
+	s.Require().NoError(err)
+
+	_, err = s.manager.CreateHistoryArchiveTorrentFromDB(community.ID(), topics, startDate, endDate, partition, false)
+	s.Require().NoError(err)
+
+	// Without a torrent client the archive is only published over HTTP
+	err = s.manager.SeedHistoryArchiveTorrent(community.ID())
+	s.Require().NoError(err)
+	s.Require().True(s.manager.IsSeedingHistoryArchiveTorrent(community.ID()))
+
+	uris := s.manager.GetHistoryArchiveURIs(community.ID())
+	s.Require().Equal([]string{httpServer.URL + "/" + community.IDString()}, uris)
+	s.Require().True(s.manager.CanFetchHistoryArchives(uris))
+
+	magnetlink, err := s.manager.GetHistoryArchiveMagnetlink(community.ID())
+	s.Require().NoError(err)
+
+	downloadTaskInfo, err := s.manager.DownloadHistoryArchivesByURIs(community.ID(), magnetlink, uris, make(chan struct{}))
+	s.Require().NoError(err)
+	s.Require().Equal(1, downloadTaskInfo.TotalArchivesCount)
+	s.Require().Equal(1, downloadTaskInfo.TotalDownloadedArchivesCount)
+
+	archiveIDs, err := s.manager.GetMessageArchiveIDsToImport(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(archiveIDs, 1)
+
+	// A tampered archive doesn't match the pieces of the torrent
+	dataPath := "/" + community.IDString() + "/" + archiveDataFileName
+	server.files[dataPath][0] ^= 0xff
+
+	_, err = s.manager.DownloadHistoryArchivesByURIs(community.ID(), magnetlink, uris, make(chan struct{}))
+	s.Require().Equal(ErrArchiveIntegrity, err)
+
+	s.manager.UnseedHistoryArchiveTorrent(community.ID())
+	s.Require().False(s.manager.IsSeedingHistoryArchiveTorrent(community.ID()))
+}
+
 func buildTorrentConfig() params.TorrentConfig {
 	torrentConfig := params.TorrentConfig{
 		Enabled:    true,
@@ -1446,3 +1516,115 @@ func (s *ManagerSuite) buildCommunityWithChat() (*Community, string, error) {
 	}
 	return community, chatID, nil
 }
+
+type testSoulboundTokenVerifier struct {
+	tokenIDs map[gethcommon.Address][]*big.Int
+	burned   map[string]bool
+	errs     map[gethcommon.Address]error
+}
+
+func (v *testSoulboundTokenVerifier) OwnedTokenIDs(ctx context.Context, chainID uint64, contractAddress gethcommon.Address, owner gethcommon.Address) ([]*big.Int, error) {
+	return v.tokenIDs[owner], v.errs[owner]
+}
+
+func (v *testSoulboundTokenVerifier) TokenBurned(ctx context.Context, chainID uint64, contractAddress gethcommon.Address, tokenID *big.Int) (bool, error) {
+	return v.burned[tokenID.String()], nil
+}
+
+func findSoulboundVerification(verifications []*SoulboundMemberVerification, publicKey string) *SoulboundMemberVerification {
+	for _, v := range verifications {
+		if v.PublicKey == publicKey {
+			return v
+		}
+	}
+	return nil
+}
+
+func (s *ManagerSuite) TestVerifySoulboundTokens() {
+	verifier := &testSoulboundTokenVerifier{
+		tokenIDs: make(map[gethcommon.Address][]*big.Int),
+		burned:   make(map[string]bool),
+		errs:     make(map[gethcommon.Address]error),
+	}
+	s.manager.soulboundTokenVerifier = verifier
+
+	community, _, err := s.buildCommunityWithChat()
+	s.Require().NoError(err)
+
+	memberKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	memberID := common.PubkeyToHex(&memberKey.PublicKey)
+	address := "0x0100000000000000000000000000000000000000"
+	otherAddress := "0x0200000000000000000000000000000000000000"
+
+	failingKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	failingID := common.PubkeyToHex(&failingKey.PublicKey)
+	failingAddress := "0x0300000000000000000000000000000000000000"
+
+	_, err = community.AddMember(&memberKey.PublicKey, []protobuf.CommunityMember_Roles{})
+	s.Require().NoError(err)
+	_, err = community.AddMemberRevealedAccounts(memberID, []*protobuf.RevealedAccount{{Address: address, ChainIds: []uint64{5}}})
+	s.Require().NoError(err)
+	_, err = community.AddMember(&failingKey.PublicKey, []protobuf.CommunityMember_Roles{})
+	s.Require().NoError(err)
+	_, err = community.AddMemberRevealedAccounts(failingID, []*protobuf.RevealedAccount{{Address: failingAddress, ChainIds: []uint64{5}}})
+	s.Require().NoError(err)
+	s.Require().NoError(s.manager.persistence.SaveCommunity(community))
+
+	token := &CommunityToken{
+		TokenType:   protobuf.CommunityTokenType_ERC721,
+		CommunityID: community.IDString(),
+		Address:     "0x3d6afaa395c31fcd391fe3d562e75fe9e8ec7e6a",
+		ChainID:     5,
+		DeployState: Deployed,
+	}
+	s.Require().NoError(s.manager.persistence.AddCommunityToken(token))
+
+	verifier.tokenIDs[gethcommon.HexToAddress(address)] = []*big.Int{big.NewInt(1)}
+	verifier.errs[gethcommon.HexToAddress(failingAddress)] = errors.New("rpc error")
+
+	// The members whose tokens can't be read don't fail the verification
+	verifications, err := s.manager.VerifySoulboundTokens(community.ID())
+	s.Require().NoError(err)
+
+	failing := findSoulboundVerification(verifications, failingID)
+	s.Require().NotNil(failing)
+	s.Require().NotEmpty(failing.Error)
+
+	verification := findSoulboundVerification(verifications, memberID)
+	s.Require().NotNil(verification)
+	s.Require().Empty(verification.Error)
+	s.Require().False(verification.Burned)
+	s.Require().Len(verification.Tokens, 1)
+	s.Require().Equal([]string{"1"}, verification.Tokens[0].TokenIDs)
+
+	// The member stops revealing the address holding the token
+	_, err = community.AddMemberRevealedAccounts(memberID, []*protobuf.RevealedAccount{{Address: otherAddress, ChainIds: []uint64{5}}})
+	s.Require().NoError(err)
+	s.Require().NoError(s.manager.persistence.SaveCommunity(community))
+
+	verifications, err = s.manager.VerifySoulboundTokens(community.ID())
+	s.Require().NoError(err)
+	verification = findSoulboundVerification(verifications, memberID)
+	s.Require().False(verification.Burned)
+	s.Require().Len(verification.Tokens, 1)
+	s.Require().Empty(verification.Tokens[0].BurnedTokenIDs)
+	s.Require().Equal([]string{"1"}, verification.Tokens[0].HiddenTokenIDs)
+
+	// The token is burned
+	verifier.burned["1"] = true
+
+	verifications, err = s.manager.VerifySoulboundTokens(community.ID())
+	s.Require().NoError(err)
+	verification = findSoulboundVerification(verifications, memberID)
+	s.Require().True(verification.Burned)
+	s.Require().Equal([]string{"1"}, verification.Tokens[0].BurnedTokenIDs)
+
+	// A burned token is only reported once
+	verifications, err = s.manager.VerifySoulboundTokens(community.ID())
+	s.Require().NoError(err)
+	for _, v := range verifications {
+		s.Require().False(v.Burned)
+	}
+}
@@ -0,0 +1,90 @@
+package communities
+
+import (
+	"sort"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	DefaultMembersPageSize = 100
+	MaxMembersPageSize     = 1000
+)
+
+// CommunityMemberItem is a member in a page of members, with its public key
+type CommunityMemberItem struct {
+	PublicKey string `json:"publicKey"`
+	*protobuf.CommunityMember
+}
+
+// CommunityMembersPage is a page of the members of a community, or of one of
+// its chats, sorted by public key
+type CommunityMembersPage struct {
+	Members []*CommunityMemberItem `json:"members"`
+	// NextCursor is passed to get the next page, it's empty on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
+	Total      int    `json:"total"`
+}
+
+// MembersPage returns the members of the community, or of the chat when
+// chatID isn't empty, whose public key comes after the cursor
+func (o *Community) MembersPage(chatID string, cursor string, limit int) (*CommunityMembersPage, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if limit <= 0 {
+		limit = DefaultMembersPageSize
+	}
+	if limit > MaxMembersPageSize {
+		limit = MaxMembersPageSize
+	}
+
+	members := o.config.CommunityDescription.Members
+	if chatID != "" {
+		chat, ok := o.config.CommunityDescription.Chats[chatID]
+		if !ok {
+			return nil, ErrChatNotFound
+		}
+		members = chat.Members
+	}
+
+	keys := make([]string, 0, len(members))
+	for key := range members {
+		if key > cursor {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var nextCursor string
+	if len(keys) > limit {
+		keys = keys[:limit]
+		nextCursor = keys[limit-1]
+	}
+
+	page := &CommunityMembersPage{
+		Members:    make([]*CommunityMemberItem, 0, len(keys)),
+		NextCursor: nextCursor,
+		Total:      len(members),
+	}
+	for _, key := range keys {
+		page.Members = append(page.Members, &CommunityMemberItem{PublicKey: key, CommunityMember: members[key]})
+	}
+
+	return page, nil
+}
+
+// GetMembersPage returns a page of the members of the community, or of the
+// chat when chatID isn't empty
+func (m *Manager) GetMembersPage(communityID types.HexBytes, chatID string, cursor string, limit int) (*CommunityMembersPage, error) {
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	return community.MembersPage(chatID, cursor, limit)
+}
@@ -0,0 +1,57 @@
+package communities
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func (s *CommunitySuite) TestMembersPage() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	org.config.MemberIdentity = &s.member1.PublicKey
+
+	for i := 0; i < 500; i++ {
+		org.config.CommunityDescription.Members[fmt.Sprintf("0x%04d", i)] = &protobuf.CommunityMember{}
+	}
+	total := len(org.config.CommunityDescription.Members)
+
+	var keys []string
+	cursor := ""
+	for {
+		page, err := org.MembersPage("", cursor, 150)
+		s.Require().NoError(err)
+		s.Require().Equal(total, page.Total)
+		for _, member := range page.Members {
+			keys = append(keys, member.PublicKey)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		s.Require().Len(page.Members, 150)
+		cursor = page.NextCursor
+	}
+	s.Require().Len(keys, total)
+	for i := 1; i < len(keys); i++ {
+		s.Require().Less(keys[i-1], keys[i])
+	}
+
+	page, err := org.MembersPage(testChatID1, "", 0)
+	s.Require().NoError(err)
+	s.Require().Len(page.Members, 1)
+	s.Require().Equal(s.member1Key, page.Members[0].PublicKey)
+
+	_, err = org.MembersPage("unknown-chat", "", 0)
+	s.Require().Equal(ErrChatNotFound, err)
+
+	// The members are still all in the JSON, with their count
+	payload, err := org.MarshalJSON()
+	s.Require().NoError(err)
+	var item struct {
+		Members      map[string]*protobuf.CommunityMember `json:"members"`
+		MembersCount int                                  `json:"membersCount"`
+	}
+	s.Require().NoError(json.Unmarshal(payload, &item))
+	s.Require().Len(item.Members, total)
+	s.Require().Equal(total, item.MembersCount)
+}
@@ -0,0 +1,174 @@
+package communities
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/status-im/status-go/contracts/ierc20"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/transactions"
+)
+
+// membershipPaymentVerifyTimeout bounds the checks of a payment on chain
+const membershipPaymentVerifyTimeout = 30 * time.Second
+
+// transferCallDataLength is the length of the call data of an ERC20 transfer,
+// its selector followed by the recipient and the amount
+const transferCallDataLength = 4 + 32 + 32
+
+// PaymentVerifier checks that a transaction transferred the tokens required
+// to join a community, and that it was made to join that community
+type PaymentVerifier interface {
+	VerifyMembershipPayment(ctx context.Context, communityID types.HexBytes, payment *protobuf.CommunityMembershipPayment, proof *protobuf.CommunityMembershipPaymentProof, payers []gethcommon.Address) (bool, error)
+}
+
+type DefaultPaymentVerifier struct {
+	rpcClient *rpc.Client
+}
+
+func NewDefaultPaymentVerifier(rpcClient *rpc.Client) *DefaultPaymentVerifier {
+	return &DefaultPaymentVerifier{rpcClient: rpcClient}
+}
+
+func (v *DefaultPaymentVerifier) VerifyMembershipPayment(ctx context.Context, communityID types.HexBytes, payment *protobuf.CommunityMembershipPayment, proof *protobuf.CommunityMembershipPaymentProof, payers []gethcommon.Address) (bool, error) {
+	if proof.ChainId != payment.ChainId {
+		return false, nil
+	}
+
+	client, err := v.rpcClient.EthClient(payment.ChainId)
+	if err != nil {
+		return false, err
+	}
+
+	hash := gethcommon.HexToHash(proof.TransactionHash)
+	tx, _, err := client.TransactionByHash(ctx, hash)
+	if err != nil {
+		return false, err
+	}
+	if !membershipPaymentTransactionValid(communityID, payment, tx) {
+		return false, nil
+	}
+
+	receipt, err := client.TransactionReceipt(ctx, hash)
+	if err != nil {
+		return false, err
+	}
+
+	return membershipPaymentReceiptValid(payment, receipt, payers)
+}
+
+// membershipPaymentTransactionValid checks that the transaction was sent to
+// the token with the ID of the community appended to the transfer, so that
+// a payment made for a community can't be used to join another one
+func membershipPaymentTransactionValid(communityID types.HexBytes, payment *protobuf.CommunityMembershipPayment, tx *gethtypes.Transaction) bool {
+	if tx.To() == nil || *tx.To() != gethcommon.HexToAddress(payment.ContractAddress) {
+		return false
+	}
+
+	data := tx.Data()
+	return len(data) == transferCallDataLength+len(communityID) && bytes.Equal(data[transferCallDataLength:], communityID)
+}
+
+// membershipPaymentReceiptValid checks that a successful transaction receipt
+// contains a transfer of at least the required amount of tokens, from one
+// of the payers to the recipient of the payment
+func membershipPaymentReceiptValid(payment *protobuf.CommunityMembershipPayment, receipt *gethtypes.Receipt, payers []gethcommon.Address) (bool, error) {
+	if receipt.Status != gethtypes.ReceiptStatusSuccessful {
+		return false, nil
+	}
+
+	amount, ok := new(big.Int).SetString(payment.Amount, 10)
+	if !ok {
+		return false, ErrInvalidMembershipPayment
+	}
+
+	filterer, err := ierc20.NewIERC20Filterer(gethcommon.HexToAddress(payment.ContractAddress), nil)
+	if err != nil {
+		return false, err
+	}
+
+	isPayer := make(map[gethcommon.Address]bool)
+	for _, payer := range payers {
+		isPayer[payer] = true
+	}
+
+	contractAddress := gethcommon.HexToAddress(payment.ContractAddress)
+	recipient := gethcommon.HexToAddress(payment.Recipient)
+	for _, log := range receipt.Logs {
+		if log.Address != contractAddress {
+			continue
+		}
+
+		transfer, err := filterer.ParseTransfer(*log)
+		if err != nil {
+			// not a transfer event
+			continue
+		}
+
+		if transfer.To == recipient && isPayer[transfer.From] && transfer.Value.Cmp(amount) >= 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ValidateMembershipPayment checks a membership payment, a nil payment means
+// joining the community is free
+func ValidateMembershipPayment(payment *protobuf.CommunityMembershipPayment) error {
+	if payment == nil {
+		return nil
+	}
+
+	if payment.ChainId == 0 || !gethcommon.IsHexAddress(payment.ContractAddress) || !gethcommon.IsHexAddress(payment.Recipient) {
+		return ErrInvalidMembershipPayment
+	}
+
+	amount, ok := new(big.Int).SetString(payment.Amount, 10)
+	if !ok || amount.Sign() <= 0 {
+		return ErrInvalidMembershipPayment
+	}
+
+	return nil
+}
+
+// MembershipPaymentTransaction returns the ERC20 transfer that has to be sent
+// by the wallet to pay for joining a community. The ID of the community is
+// appended to the call data, which the token ignores, to tie the payment to
+// the community
+func MembershipPaymentTransaction(communityID types.HexBytes, payment *protobuf.CommunityMembershipPayment, from types.Address) (*transactions.SendTxArgs, error) {
+	if err := ValidateMembershipPayment(payment); err != nil {
+		return nil, err
+	}
+	amount, _ := new(big.Int).SetString(payment.Amount, 10)
+
+	parsed, err := abi.JSON(strings.NewReader(ierc20.IERC20ABI))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := parsed.Pack("transfer", gethcommon.HexToAddress(payment.Recipient), amount)
+	if err != nil {
+		return nil, err
+	}
+
+	data = append(data, communityID...)
+
+	to := types.HexToAddress(payment.ContractAddress)
+	return &transactions.SendTxArgs{
+		From:  from,
+		To:    &to,
+		Value: (*hexutil.Big)(big.NewInt(0)),
+		Data:  data,
+	}, nil
+}
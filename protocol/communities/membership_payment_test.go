@@ -0,0 +1,81 @@
+package communities
+
+import (
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func transferLog(contract, from, to gethcommon.Address, value int64) *gethtypes.Log {
+	return &gethtypes.Log{
+		Address: contract,
+		Topics: []gethcommon.Hash{
+			crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+			gethcommon.BytesToHash(from.Bytes()),
+			gethcommon.BytesToHash(to.Bytes()),
+		},
+		Data: gethcommon.LeftPadBytes(big.NewInt(value).Bytes(), 32),
+	}
+}
+
+func (s *CommunitySuite) TestMembershipPaymentReceiptValid() {
+	contract := gethcommon.HexToAddress("0x01")
+	recipient := gethcommon.HexToAddress("0x02")
+	payer := gethcommon.HexToAddress("0x03")
+	other := gethcommon.HexToAddress("0x04")
+
+	payment := &protobuf.CommunityMembershipPayment{
+		ChainId:         1,
+		ContractAddress: contract.Hex(),
+		Amount:          "100",
+		Recipient:       recipient.Hex(),
+	}
+	s.Require().NoError(ValidateMembershipPayment(payment))
+
+	testCases := []struct {
+		name  string
+		logs  []*gethtypes.Log
+		valid bool
+	}{
+		{"exact amount", []*gethtypes.Log{transferLog(contract, payer, recipient, 100)}, true},
+		{"bigger amount", []*gethtypes.Log{transferLog(contract, payer, recipient, 200)}, true},
+		{"smaller amount", []*gethtypes.Log{transferLog(contract, payer, recipient, 99)}, false},
+		{"wrong recipient", []*gethtypes.Log{transferLog(contract, payer, other, 100)}, false},
+		{"wrong payer", []*gethtypes.Log{transferLog(contract, other, recipient, 100)}, false},
+		{"wrong token", []*gethtypes.Log{transferLog(other, payer, recipient, 100)}, false},
+		{"no transfer", nil, false},
+	}
+
+	for _, tc := range testCases {
+		receipt := &gethtypes.Receipt{Status: gethtypes.ReceiptStatusSuccessful, Logs: tc.logs}
+		valid, err := membershipPaymentReceiptValid(payment, receipt, []gethcommon.Address{payer})
+		s.Require().NoError(err, tc.name)
+		s.Require().Equal(tc.valid, valid, tc.name)
+	}
+
+	failed := &gethtypes.Receipt{Status: gethtypes.ReceiptStatusFailed, Logs: []*gethtypes.Log{transferLog(contract, payer, recipient, 100)}}
+	valid, err := membershipPaymentReceiptValid(payment, failed, []gethcommon.Address{payer})
+	s.Require().NoError(err)
+	s.Require().False(valid)
+
+	communityID := types.HexBytes{0x01, 0x02, 0x03}
+	tx, err := MembershipPaymentTransaction(communityID, payment, types.HexToAddress(payer.Hex()))
+	s.Require().NoError(err)
+	s.Require().Equal(types.HexToAddress(contract.Hex()), *tx.To)
+	// transfer(address,uint256) selector
+	s.Require().Equal(types.HexBytes{0xa9, 0x05, 0x9c, 0xbb}, tx.Data[:4])
+
+	// The payment is only valid for the community it was made for
+	sent := gethtypes.NewTransaction(0, contract, big.NewInt(0), 0, big.NewInt(0), tx.Data)
+	s.Require().True(membershipPaymentTransactionValid(communityID, payment, sent))
+	s.Require().False(membershipPaymentTransactionValid(types.HexBytes{0x04}, payment, sent))
+	s.Require().False(membershipPaymentTransactionValid(communityID, payment, gethtypes.NewTransaction(0, other, big.NewInt(0), 0, big.NewInt(0), tx.Data)))
+	s.Require().False(membershipPaymentTransactionValid(communityID, payment, gethtypes.NewTransaction(0, contract, big.NewInt(0), 0, big.NewInt(0), tx.Data[:transferCallDataLength])))
+
+	s.Require().ErrorIs(ValidateMembershipPayment(&protobuf.CommunityMembershipPayment{ChainId: 1, ContractAddress: contract.Hex(), Amount: "0", Recipient: recipient.Hex()}), ErrInvalidMembershipPayment)
+}
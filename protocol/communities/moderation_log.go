@@ -0,0 +1,39 @@
+package communities
+
+import (
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ModerationLogEntry records a member banned by a moderator, only visible
+// to the owner and admins of the community
+type ModerationLogEntry struct {
+	CommunityID     types.HexBytes `json:"communityId"`
+	MemberID        string         `json:"memberId"`
+	ModeratorID     string         `json:"moderatorId"`
+	Reason          string         `json:"reason"`
+	MessagesDeleted bool           `json:"messagesDeleted"`
+	Clock           uint64         `json:"clock"`
+}
+
+func (e *ModerationLogEntry) ToProtobuf() *protobuf.CommunityBan {
+	return &protobuf.CommunityBan{
+		CommunityId:     e.CommunityID,
+		MemberId:        e.MemberID,
+		ModeratorId:     e.ModeratorID,
+		Reason:          e.Reason,
+		MessagesDeleted: e.MessagesDeleted,
+		Clock:           e.Clock,
+	}
+}
+
+func moderationLogEntryFromProtobuf(ban *protobuf.CommunityBan) *ModerationLogEntry {
+	return &ModerationLogEntry{
+		CommunityID:     ban.CommunityId,
+		MemberID:        ban.MemberId,
+		ModeratorID:     ban.ModeratorId,
+		Reason:          ban.Reason,
+		MessagesDeleted: ban.MessagesDeleted,
+		Clock:           ban.Clock,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -42,6 +43,39 @@ func (p *Persistence) SaveCommunity(community *Community) error {
 	return err
 }
 
+// SaveCommunityWithRequestsToJoinStates saves the community with the state of
+// the requests to join in one transaction
+func (p *Persistence) SaveCommunityWithRequestsToJoinStates(community *Community, requests []*RequestToJoin) (err error) {
+	description, err := community.ToBytes()
+	if err != nil {
+		return err
+	}
+
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, request := range requests {
+		_, err = tx.Exec(`UPDATE communities_requests_to_join SET state = ? WHERE community_id = ? AND public_key = ?`, request.State, request.CommunityID, request.PublicKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(`INSERT INTO communities_communities (id, private_key, description, joined, spectated, verified) VALUES (?, ?, ?, ?, ?, ?)`, community.ID(), crypto.FromECDSA(community.PrivateKey()), description, community.config.Joined, community.config.Spectated, community.config.Verified)
+	return err
+}
+
 func (p *Persistence) DeleteCommunity(id types.HexBytes) error {
 	_, err := p.db.Exec("DELETE FROM communities_communities WHERE id = ?", id)
 	return err
@@ -343,7 +377,7 @@ func (p *Persistence) SaveRequestToJoinRevealedAddresses(request *RequestToJoin)
 		_ = tx.Rollback()
 	}()
 
-	query := `INSERT OR REPLACE INTO communities_requests_to_join_revealed_addresses (request_id, address, chain_ids) VALUES (?, ?, ?)`
+	query := `INSERT OR REPLACE INTO communities_requests_to_join_revealed_addresses (request_id, address, chain_ids, is_airdrop_address) VALUES (?, ?, ?, ?)`
 	stmt, err := tx.Prepare(query)
 	if err != nil {
 		return
@@ -360,6 +394,7 @@ func (p *Persistence) SaveRequestToJoinRevealedAddresses(request *RequestToJoin)
 			request.ID,
 			account.Address,
 			strings.Join(chainIDs, ","),
+			account.IsAirdropAddress,
 		)
 		if err != nil {
 			return
@@ -529,7 +564,7 @@ func (p *Persistence) GetPermissionTokenCriteriaResult(permissionID string, comm
 
 func (p *Persistence) GetRequestToJoinRevealedAddresses(requestID []byte) ([]*protobuf.RevealedAccount, error) {
 	revealedAccounts := make([]*protobuf.RevealedAccount, 0)
-	rows, err := p.db.Query(`SELECT address, chain_ids FROM communities_requests_to_join_revealed_addresses WHERE request_id = ?`, requestID)
+	rows, err := p.db.Query(`SELECT address, chain_ids, is_airdrop_address FROM communities_requests_to_join_revealed_addresses WHERE request_id = ?`, requestID)
 	if err != nil {
 		return nil, err
 	}
@@ -538,7 +573,8 @@ func (p *Persistence) GetRequestToJoinRevealedAddresses(requestID []byte) ([]*pr
 	for rows.Next() {
 		address := ""
 		chainIDsStr := ""
-		err := rows.Scan(&address, &chainIDsStr)
+		isAirdropAddress := false
+		err := rows.Scan(&address, &chainIDsStr, &isAirdropAddress)
 		if err != nil {
 			return nil, err
 		}
@@ -555,8 +591,9 @@ func (p *Persistence) GetRequestToJoinRevealedAddresses(requestID []byte) ([]*pr
 		}
 
 		revealedAccount := &protobuf.RevealedAccount{
-			Address:  address,
-			ChainIds: chainIDs,
+			Address:          address,
+			ChainIds:         chainIDs,
+			IsAirdropAddress: isAirdropAddress,
 		}
 		revealedAccounts = append(revealedAccounts, revealedAccount)
 	}
@@ -1106,7 +1143,7 @@ func (p *Persistence) GetCommunityChatIDs(communityID types.HexBytes) ([]string,
 
 func (p *Persistence) GetAllCommunityTokens() ([]*CommunityToken, error) {
 	rows, err := p.db.Query(`SELECT community_id, address, type, name, symbol, description, supply,
-	infinite_supply, transferable, remote_self_destruct, chain_id, deploy_state, image_base64, decimals
+	infinite_supply, transferable, remote_self_destruct, chain_id, deploy_state, image_base64, decimals, deployer
 	FROM community_tokens`)
 	if err != nil {
 		return nil, err
@@ -1118,7 +1155,7 @@ func (p *Persistence) GetAllCommunityTokens() ([]*CommunityToken, error) {
 
 func (p *Persistence) GetCommunityTokens(communityID string) ([]*CommunityToken, error) {
 	rows, err := p.db.Query(`SELECT community_id, address, type, name, symbol, description, supply,
-	infinite_supply, transferable, remote_self_destruct, chain_id, deploy_state, image_base64, decimals
+	infinite_supply, transferable, remote_self_destruct, chain_id, deploy_state, image_base64, decimals, deployer
 	FROM community_tokens WHERE community_id = ?`, communityID)
 	if err != nil {
 		return nil, err
@@ -1135,7 +1172,7 @@ func (p *Persistence) getCommunityTokensInternal(rows *sql.Rows) ([]*CommunityTo
 		token := CommunityToken{}
 		err := rows.Scan(&token.CommunityID, &token.Address, &token.TokenType, &token.Name,
 			&token.Symbol, &token.Description, &token.Supply, &token.InfiniteSupply, &token.Transferable,
-			&token.RemoteSelfDestruct, &token.ChainID, &token.DeployState, &token.Base64Image, &token.Decimals)
+			&token.RemoteSelfDestruct, &token.ChainID, &token.DeployState, &token.Base64Image, &token.Decimals, &token.Deployer)
 		if err != nil {
 			return nil, err
 		}
@@ -1146,10 +1183,10 @@ func (p *Persistence) getCommunityTokensInternal(rows *sql.Rows) ([]*CommunityTo
 
 func (p *Persistence) AddCommunityToken(token *CommunityToken) error {
 	_, err := p.db.Exec(`INSERT INTO community_tokens (community_id, address, type, name, symbol, description, supply,
-		infinite_supply, transferable, remote_self_destruct, chain_id, deploy_state, image_base64, decimals) 
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, token.CommunityID, token.Address, token.TokenType, token.Name,
+		infinite_supply, transferable, remote_self_destruct, chain_id, deploy_state, image_base64, decimals, deployer)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, token.CommunityID, token.Address, token.TokenType, token.Name,
 		token.Symbol, token.Description, token.Supply, token.InfiniteSupply, token.Transferable, token.RemoteSelfDestruct,
-		token.ChainID, token.DeployState, token.Base64Image, token.Decimals)
+		token.ChainID, token.DeployState, token.Base64Image, token.Decimals, token.Deployer)
 	return err
 }
 
@@ -1162,3 +1199,375 @@ func (p *Persistence) UpdateCommunityTokenSupply(chainID int, contractAddress st
 	_, err := p.db.Exec(`UPDATE community_tokens SET supply = ? WHERE address = ? AND chain_id = ?`, supply, contractAddress, chainID)
 	return err
 }
+
+func (p *Persistence) SaveAuditLogEntry(entry *AuditLogEntry) error {
+	payload, err := proto.Marshal(entry.Event)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.db.Exec(`INSERT INTO communities_events_log (id, community_id, author, type, clock, payload, timestamp) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		entry.ID,
+		entry.CommunityID,
+		entry.Author,
+		entry.Type,
+		entry.Clock,
+		payload,
+		entry.Timestamp,
+	)
+	return err
+}
+
+// GetAuditLog returns the most recent entries of the audit log of a
+// community, starting from the given cursor, along with the cursor of the
+// next page
+func (p *Persistence) GetAuditLog(communityID types.HexBytes, currCursor string, limit int) ([]*AuditLogEntry, string, error) {
+	if limit <= 0 {
+		limit = DefaultAuditLogPageSize
+	}
+	if limit > MaxAuditLogPageSize {
+		limit = MaxAuditLogPageSize
+	}
+
+	cursorWhere := ""
+	args := []interface{}{communityID}
+	if currCursor != "" {
+		cursorWhere = "AND cursor <= ?"
+		args = append(args, currCursor)
+	}
+	args = append(args, limit+1) // take one more to figure out whether a cursor should be returned
+
+	// cursor is built from the fixed-size clock concatenated with the entry ID
+	rows, err := p.db.Query(fmt.Sprintf(`
+		SELECT id, community_id, author, type, clock, payload, timestamp, substr('0000000000000000000000000000000000000000000000000000000000000000' || clock, -64, 64) || id AS cursor
+		FROM communities_events_log
+		WHERE community_id = ? %s
+		ORDER BY cursor DESC
+		LIMIT ?`, cursorWhere), args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var entries []*AuditLogEntry
+	var cursors []string
+	for rows.Next() {
+		var payload []byte
+		var cursor string
+		entry := &AuditLogEntry{Event: &protobuf.CommunityAdminEvent{}}
+		err := rows.Scan(&entry.ID, &entry.CommunityID, &entry.Author, &entry.Type, &entry.Clock, &payload, &entry.Timestamp, &cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		err = proto.Unmarshal(payload, entry.Event)
+		if err != nil {
+			return nil, "", err
+		}
+		entries = append(entries, entry)
+		cursors = append(cursors, cursor)
+	}
+
+	var newCursor string
+	if len(entries) > limit {
+		newCursor = cursors[limit]
+		entries = entries[:limit]
+	}
+	return entries, newCursor, nil
+}
+
+// SaveDirectory replaces the cached curated communities directory
+func (p *Persistence) SaveDirectory(entries []*DirectoryEntry) (err error) {
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`DELETE FROM communities_directory`)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		_, err = tx.Exec(`INSERT INTO communities_directory (community_id, featured, fetched_at) VALUES (?, ?, ?)`, entry.CommunityID, entry.Featured, entry.FetchedAt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Persistence) GetDirectory() ([]*DirectoryEntry, error) {
+	rows, err := p.db.Query(`SELECT community_id, featured, fetched_at FROM communities_directory`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*DirectoryEntry
+	for rows.Next() {
+		entry := &DirectoryEntry{}
+		err := rows.Scan(&entry.CommunityID, &entry.Featured, &entry.FetchedAt)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+func (p *Persistence) SaveMembershipPayment(proof *protobuf.CommunityMembershipPaymentProof, communityID types.HexBytes, publicKey string) error {
+	_, err := p.db.Exec(`INSERT INTO communities_membership_payments (chain_id, transaction_hash, community_id, public_key) VALUES (?, ?, ?, ?)`,
+		proof.ChainId,
+		strings.ToLower(proof.TransactionHash),
+		communityID,
+		publicKey,
+	)
+	return err
+}
+
+// GetMembershipPaymentPublicKey returns the public key of the member who
+// joined with a payment, or an empty string if it hasn't been used yet
+func (p *Persistence) GetMembershipPaymentPublicKey(proof *protobuf.CommunityMembershipPaymentProof) (string, error) {
+	var publicKey string
+	err := p.db.QueryRow(`SELECT public_key FROM communities_membership_payments WHERE chain_id = ? AND transaction_hash = ?`, proof.ChainId, strings.ToLower(proof.TransactionHash)).Scan(&publicKey)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return publicKey, err
+}
+
+func (p *Persistence) SaveAirdropSnapshot(snapshot *AirdropSnapshot) (err error) {
+	criteria, err := json.Marshal(snapshot.Criteria)
+	if err != nil {
+		return err
+	}
+
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`INSERT INTO communities_airdrop_snapshots (id, community_id, created_at, criteria) VALUES (?, ?, ?, ?)`,
+		snapshot.ID, snapshot.CommunityID, snapshot.CreatedAt, criteria)
+	if err != nil {
+		return err
+	}
+
+	for _, recipient := range snapshot.Recipients {
+		_, err = tx.Exec(`INSERT INTO communities_airdrop_snapshot_recipients (snapshot_id, public_key, address) VALUES (?, ?, ?)`,
+			snapshot.ID, recipient.PublicKey, recipient.Address)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Persistence) GetAirdropSnapshot(id string) (*AirdropSnapshot, error) {
+	snapshots, err := p.queryAirdropSnapshots(`WHERE id = ?`, id)
+	if err != nil || len(snapshots) == 0 {
+		return nil, err
+	}
+	return snapshots[0], nil
+}
+
+// GetAirdropSnapshots returns the snapshots of the community, the latest first
+func (p *Persistence) GetAirdropSnapshots(communityID string) ([]*AirdropSnapshot, error) {
+	return p.queryAirdropSnapshots(`WHERE community_id = ? ORDER BY created_at DESC`, communityID)
+}
+
+func (p *Persistence) queryAirdropSnapshots(where string, args ...interface{}) ([]*AirdropSnapshot, error) {
+	rows, err := p.db.Query(`SELECT id, community_id, created_at, criteria FROM communities_airdrop_snapshots `+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var snapshots []*AirdropSnapshot
+	for rows.Next() {
+		snapshot := &AirdropSnapshot{}
+		var criteria []byte
+		err := rows.Scan(&snapshot.ID, &snapshot.CommunityID, &snapshot.CreatedAt, &criteria)
+		if err != nil {
+			return nil, err
+		}
+		if len(criteria) != 0 {
+			err = json.Unmarshal(criteria, &snapshot.Criteria)
+			if err != nil {
+				return nil, err
+			}
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, snapshot := range snapshots {
+		snapshot.Recipients, err = p.getAirdropSnapshotRecipients(snapshot.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return snapshots, nil
+}
+
+// getAirdropSnapshotRecipients returns the recipients of the snapshot, with
+// the lowest of their addresses for snapshots recorded with all of them
+func (p *Persistence) getAirdropSnapshotRecipients(snapshotID string) ([]*AirdropRecipient, error) {
+	rows, err := p.db.Query(`SELECT public_key, MIN(address) FROM communities_airdrop_snapshot_recipients WHERE snapshot_id = ? GROUP BY public_key ORDER BY public_key`, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var recipients []*AirdropRecipient
+	for rows.Next() {
+		recipient := &AirdropRecipient{}
+		err := rows.Scan(&recipient.PublicKey, &recipient.Address)
+		if err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients, rows.Err()
+}
+
+func (p *Persistence) DeleteAirdropSnapshot(id string) (err error) {
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`DELETE FROM communities_airdrop_snapshot_recipients WHERE snapshot_id = ?`, id)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`DELETE FROM communities_airdrop_snapshots WHERE id = ?`, id)
+	return err
+}
+
+// GetSoulboundHoldings returns the non-transferable tokens held by the
+// members of the community at the last check, by public key
+func (p *Persistence) GetSoulboundHoldings(communityID string) (map[string][]*SoulboundTokenHolding, error) {
+	rows, err := p.db.Query(`SELECT public_key, chain_id, contract_address, token_id FROM communities_soulbound_holdings WHERE community_id = ? ORDER BY public_key, chain_id, contract_address, token_id`, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	holdings := make(map[string][]*SoulboundTokenHolding)
+	for rows.Next() {
+		var publicKey, contractAddress, tokenID string
+		var chainID uint64
+		err := rows.Scan(&publicKey, &chainID, &contractAddress, &tokenID)
+		if err != nil {
+			return nil, err
+		}
+
+		memberHoldings := holdings[publicKey]
+		if len(memberHoldings) == 0 || memberHoldings[len(memberHoldings)-1].ChainID != chainID || memberHoldings[len(memberHoldings)-1].ContractAddress != contractAddress {
+			memberHoldings = append(memberHoldings, &SoulboundTokenHolding{ChainID: chainID, ContractAddress: contractAddress})
+		}
+		holding := memberHoldings[len(memberHoldings)-1]
+		holding.TokenIDs = append(holding.TokenIDs, tokenID)
+		holdings[publicKey] = memberHoldings
+	}
+	return holdings, rows.Err()
+}
+
+// SaveSoulboundHoldings replaces the non-transferable tokens held by the
+// members of the community
+func (p *Persistence) SaveSoulboundHoldings(communityID string, holdings map[string][]*SoulboundTokenHolding) (err error) {
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`DELETE FROM communities_soulbound_holdings WHERE community_id = ?`, communityID)
+	if err != nil {
+		return err
+	}
+
+	for publicKey, memberHoldings := range holdings {
+		for _, holding := range memberHoldings {
+			for _, tokenID := range holding.TokenIDs {
+				_, err = tx.Exec(`INSERT INTO communities_soulbound_holdings (community_id, chain_id, contract_address, token_id, public_key) VALUES (?, ?, ?, ?, ?)`,
+					communityID, holding.ChainID, holding.ContractAddress, tokenID, publicKey)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// SaveModerationLogEntry records a ban in the moderation log, the same ban
+// received more than once is only recorded once
+func (p *Persistence) SaveModerationLogEntry(entry *ModerationLogEntry) error {
+	_, err := p.db.Exec(`INSERT INTO communities_moderation_log (community_id, member_id, moderator_id, reason, messages_deleted, clock) VALUES (?, ?, ?, ?, ?, ?)`,
+		entry.CommunityID,
+		entry.MemberID,
+		entry.ModeratorID,
+		entry.Reason,
+		entry.MessagesDeleted,
+		entry.Clock,
+	)
+	return err
+}
+
+func (p *Persistence) GetModerationLog(communityID types.HexBytes) ([]*ModerationLogEntry, error) {
+	rows, err := p.db.Query(`SELECT community_id, member_id, moderator_id, reason, messages_deleted, clock FROM communities_moderation_log WHERE community_id = ? ORDER BY clock DESC`, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*ModerationLogEntry
+	for rows.Next() {
+		entry := &ModerationLogEntry{}
+		err := rows.Scan(&entry.CommunityID, &entry.MemberID, &entry.ModeratorID, &entry.Reason, &entry.MessagesDeleted, &entry.Clock)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
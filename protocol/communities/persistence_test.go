@@ -3,6 +3,7 @@ package communities
 import (
 	"crypto/ecdsa"
 	"database/sql"
+	"fmt"
 	"io/ioutil"
 	"testing"
 	"time"
@@ -468,3 +469,154 @@ func (s *PersistenceSuite) TestSaveCheckChannelPermissionResponse() {
 	s.Require().Equal(responses[chatID].ViewAndPostPermissions.Permissions["one"].Criteria, []bool{true, true, true, true})
 	s.Require().Equal(responses[chatID].ViewAndPostPermissions.Permissions["two"].Criteria, []bool{false})
 }
+
+func (s *PersistenceSuite) TestModerationLog() {
+	communityID := types.HexBytes{1, 2, 3}
+
+	entries, err := s.db.GetModerationLog(communityID)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 0)
+
+	first := &ModerationLogEntry{
+		CommunityID: communityID,
+		MemberID:    "0x01",
+		ModeratorID: "0x02",
+		Reason:      "spam",
+		Clock:       1,
+	}
+	second := &ModerationLogEntry{
+		CommunityID:     communityID,
+		MemberID:        "0x03",
+		ModeratorID:     "0x02",
+		MessagesDeleted: true,
+		Clock:           2,
+	}
+	s.Require().NoError(s.db.SaveModerationLogEntry(first))
+	s.Require().NoError(s.db.SaveModerationLogEntry(second))
+	s.Require().NoError(s.db.SaveModerationLogEntry(&ModerationLogEntry{CommunityID: types.HexBytes{4}, MemberID: "0x01", ModeratorID: "0x02", Clock: 3}))
+
+	// The same ban received again is only recorded once
+	s.Require().NoError(s.db.SaveModerationLogEntry(first))
+
+	entries, err = s.db.GetModerationLog(communityID)
+	s.Require().NoError(err)
+	s.Require().Equal([]*ModerationLogEntry{second, first}, entries)
+}
+
+func (s *PersistenceSuite) TestAuditLog() {
+	communityID := types.HexBytes{1, 2, 3}
+
+	for i := uint64(1); i <= 3; i++ {
+		err := s.db.SaveAuditLogEntry(&AuditLogEntry{
+			ID:          fmt.Sprintf("0x%d", i),
+			CommunityID: communityID,
+			Author:      "0x02",
+			Type:        protobuf.CommunityAdminEvent_COMMUNITY_EDIT,
+			Clock:       i,
+			Event:       &protobuf.CommunityAdminEvent{Clock: i, CommunityId: communityID, Type: protobuf.CommunityAdminEvent_COMMUNITY_EDIT},
+			Timestamp:   i,
+		})
+		s.Require().NoError(err)
+	}
+
+	entries, cursor, err := s.db.GetAuditLog(communityID, "", 2)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 2)
+	s.Require().NotEmpty(cursor)
+	s.Require().Equal(uint64(3), entries[0].Clock)
+	s.Require().Equal(uint64(2), entries[1].Event.Clock)
+
+	entries, cursor, err = s.db.GetAuditLog(communityID, cursor, 2)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Require().Empty(cursor)
+	s.Require().Equal(uint64(1), entries[0].Clock)
+
+	entries, _, err = s.db.GetAuditLog(types.HexBytes{4}, "", 2)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 0)
+
+	// Invalid limits fall back to the default page size
+	entries, cursor, err = s.db.GetAuditLog(communityID, "", -1)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 3)
+	s.Require().Empty(cursor)
+}
+
+func (s *PersistenceSuite) TestDirectory() {
+	entries, err := s.db.GetDirectory()
+	s.Require().NoError(err)
+	s.Require().Len(entries, 0)
+
+	first := &DirectoryEntry{CommunityID: types.HexBytes{1}, Featured: true, FetchedAt: 1}
+	second := &DirectoryEntry{CommunityID: types.HexBytes{2}, FetchedAt: 1}
+	s.Require().NoError(s.db.SaveDirectory([]*DirectoryEntry{first, second}))
+
+	entries, err = s.db.GetDirectory()
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]*DirectoryEntry{first, second}, entries)
+
+	// Saving a directory replaces the previous one
+	third := &DirectoryEntry{CommunityID: types.HexBytes{3}, FetchedAt: 2}
+	s.Require().NoError(s.db.SaveDirectory([]*DirectoryEntry{third}))
+
+	entries, err = s.db.GetDirectory()
+	s.Require().NoError(err)
+	s.Require().Equal([]*DirectoryEntry{third}, entries)
+}
+
+func (s *PersistenceSuite) TestAirdropSnapshots() {
+	snapshot := &AirdropSnapshot{
+		ID:          "snapshot-1",
+		CommunityID: "0x01",
+		CreatedAt:   1,
+		Criteria:    AirdropSnapshotCriteria{Roles: []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ADMIN}, JoinedBefore: 10},
+		Recipients: []*AirdropRecipient{
+			{PublicKey: "0xa", Address: "0x1"},
+			{PublicKey: "0xb", Address: "0x2"},
+			{PublicKey: "0xc", Address: "0x2"},
+		},
+	}
+	s.Require().NoError(s.db.SaveAirdropSnapshot(snapshot))
+
+	other := &AirdropSnapshot{ID: "snapshot-2", CommunityID: "0x01", CreatedAt: 2}
+	s.Require().NoError(s.db.SaveAirdropSnapshot(other))
+
+	saved, err := s.db.GetAirdropSnapshot(snapshot.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(snapshot, saved)
+	s.Require().Equal([]string{"0x1", "0x2"}, saved.Addresses())
+
+	snapshots, err := s.db.GetAirdropSnapshots("0x01")
+	s.Require().NoError(err)
+	s.Require().Len(snapshots, 2)
+	s.Require().Equal(other.ID, snapshots[0].ID)
+
+	s.Require().NoError(s.db.DeleteAirdropSnapshot(snapshot.ID))
+	saved, err = s.db.GetAirdropSnapshot(snapshot.ID)
+	s.Require().NoError(err)
+	s.Require().Nil(saved)
+}
+
+func (s *PersistenceSuite) TestSoulboundHoldings() {
+	holdings := map[string][]*SoulboundTokenHolding{
+		"0xa": {
+			{ChainID: 1, ContractAddress: "0x1", TokenIDs: []string{"1", "2"}},
+			{ChainID: 5, ContractAddress: "0x1", TokenIDs: []string{"3"}},
+		},
+		"0xb": {{ChainID: 1, ContractAddress: "0x1", TokenIDs: []string{"4"}}},
+	}
+	s.Require().NoError(s.db.SaveSoulboundHoldings("0x01", holdings))
+
+	saved, err := s.db.GetSoulboundHoldings("0x01")
+	s.Require().NoError(err)
+	s.Require().Equal(holdings, saved)
+
+	// Saving the holdings replaces the previous ones
+	holdings = map[string][]*SoulboundTokenHolding{"0xb": {{ChainID: 1, ContractAddress: "0x1", TokenIDs: []string{"4"}}}}
+	s.Require().NoError(s.db.SaveSoulboundHoldings("0x01", holdings))
+
+	saved, err = s.db.GetSoulboundHoldings("0x01")
+	s.Require().NoError(err)
+	s.Require().Equal(holdings, saved)
+}
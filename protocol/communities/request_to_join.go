@@ -29,6 +29,9 @@ type RequestToJoin struct {
 	Our              bool                        `json:"our"`
 	Deleted          bool                        `json:"deleted"`
 	RevealedAccounts []*protobuf.RevealedAccount `json:"revealedAccounts,omitempty"`
+	// MembershipPayment is the payment of a request to join received, which
+	// has to be verified before the request is accepted
+	MembershipPayment *protobuf.CommunityMembershipPaymentProof `json:"-"`
 }
 
 func (r *RequestToJoin) CalculateID() {
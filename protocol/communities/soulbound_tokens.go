@@ -0,0 +1,160 @@
+package communities
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/contracts/collectibles"
+	"github.com/status-im/status-go/rpc"
+)
+
+const (
+	// revertErrorCode is the JSON-RPC error code of reverted calls
+	revertErrorCode = 3
+	// soulboundVerificationTimeout bounds the verification of the tokens of
+	// all the members of a community
+	soulboundVerificationTimeout = 2 * time.Minute
+	// maxConcurrentSoulboundVerifications is the number of members whose
+	// tokens are read from chain at the same time
+	maxConcurrentSoulboundVerifications = 5
+)
+
+// SoulboundTokenVerifier reads from chain the tokens of a non-transferable
+// community collectible held by an address, and whether a token was burned
+type SoulboundTokenVerifier interface {
+	OwnedTokenIDs(ctx context.Context, chainID uint64, contractAddress gethcommon.Address, owner gethcommon.Address) ([]*big.Int, error)
+	TokenBurned(ctx context.Context, chainID uint64, contractAddress gethcommon.Address, tokenID *big.Int) (bool, error)
+}
+
+type DefaultSoulboundTokenVerifier struct {
+	rpcClient *rpc.Client
+}
+
+func NewDefaultSoulboundTokenVerifier(rpcClient *rpc.Client) *DefaultSoulboundTokenVerifier {
+	return &DefaultSoulboundTokenVerifier{rpcClient: rpcClient}
+}
+
+// OwnedTokenIDs enumerates the tokens of the owner with tokenOfOwnerByIndex
+// and confirms each one with ownerOf
+func (v *DefaultSoulboundTokenVerifier) OwnedTokenIDs(ctx context.Context, chainID uint64, contractAddress gethcommon.Address, owner gethcommon.Address) ([]*big.Int, error) {
+	client, err := v.rpcClient.EthClient(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	caller, err := collectibles.NewCollectiblesCaller(contractAddress, client)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	balance, err := caller.BalanceOf(opts, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenIDs []*big.Int
+	for i := uint64(0); i < balance.Uint64(); i++ {
+		tokenID, err := caller.TokenOfOwnerByIndex(opts, owner, new(big.Int).SetUint64(i))
+		if err != nil {
+			return nil, err
+		}
+		tokenOwner, err := caller.OwnerOf(opts, tokenID)
+		if err != nil {
+			return nil, err
+		}
+		if tokenOwner == owner {
+			tokenIDs = append(tokenIDs, tokenID)
+		}
+	}
+	return tokenIDs, nil
+}
+
+// TokenBurned tells whether the token doesn't exist anymore, ownerOf reverts
+// or returns the zero address for burned tokens
+func (v *DefaultSoulboundTokenVerifier) TokenBurned(ctx context.Context, chainID uint64, contractAddress gethcommon.Address, tokenID *big.Int) (bool, error) {
+	client, err := v.rpcClient.EthClient(chainID)
+	if err != nil {
+		return false, err
+	}
+
+	caller, err := collectibles.NewCollectiblesCaller(contractAddress, client)
+	if err != nil {
+		return false, err
+	}
+
+	owner, err := caller.OwnerOf(&bind.CallOpts{Context: ctx}, tokenID)
+	if err != nil {
+		if isRevert(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return owner == gethcommon.Address{}, nil
+}
+
+// isRevert tells whether a call error means the call reverted, from the code
+// of the error or its revert data, rather than the node or the request failing
+func isRevert(err error) bool {
+	var rpcErr gethrpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == revertErrorCode {
+		return true
+	}
+
+	var dataErr gethrpc.DataError
+	if errors.As(err, &dataErr) {
+		if data, ok := dataErr.ErrorData().(string); ok {
+			_, decodeErr := hexutil.Decode(data)
+			return decodeErr == nil
+		}
+	}
+	return false
+}
+
+// SoulboundTokenHolding is the tokens of a non-transferable community
+// collectible held by a member, and the ones burned since the last check.
+// HiddenTokenIDs are the tokens held before which still exist, the member
+// stopped revealing the address holding them
+type SoulboundTokenHolding struct {
+	ChainID         uint64   `json:"chainId"`
+	ContractAddress string   `json:"contractAddress"`
+	TokenIDs        []string `json:"tokenIds"`
+	BurnedTokenIDs  []string `json:"burnedTokenIds,omitempty"`
+	HiddenTokenIDs  []string `json:"hiddenTokenIds,omitempty"`
+}
+
+// SoulboundMemberVerification is the result of the verification of the
+// non-transferable tokens of a member, Burned is set when one of the tokens
+// the member held was burned since the last check. Error is set when the
+// tokens of the member couldn't be read from chain
+type SoulboundMemberVerification struct {
+	PublicKey string                   `json:"publicKey"`
+	Tokens    []*SoulboundTokenHolding `json:"tokens"`
+	Burned    bool                     `json:"burned"`
+	Error     string                   `json:"error,omitempty"`
+}
+
+// missingTokenIDs returns the token IDs held before which aren't held by the
+// revealed addresses anymore, as the tokens can't be transferred they were
+// either burned or are held by an address which isn't revealed
+func missingTokenIDs(before []string, now []string) []string {
+	held := make(map[string]bool)
+	for _, tokenID := range now {
+		held[tokenID] = true
+	}
+
+	var missing []string
+	for _, tokenID := range before {
+		if !held[tokenID] {
+			missing = append(missing, tokenID)
+		}
+	}
+	return missing
+}
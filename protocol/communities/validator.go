@@ -79,5 +79,9 @@ func ValidateCommunityDescription(desc *protobuf.CommunityDescription) error {
 		}
 	}
 
-	return nil
+	if err := ValidateBlocklist(desc.Blocklist); err != nil {
+		return err
+	}
+
+	return ValidateEmojiPacks(desc.EmojiPacks)
 }
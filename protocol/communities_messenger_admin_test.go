@@ -171,6 +171,41 @@ func (s *AdminMessengerCommunitiesSuite) TestAdminCreateEditDeleteChannels() {
 	s.adminDeleteCommunityChannel(community, newChatID)
 }
 
+func (s *AdminMessengerCommunitiesSuite) TestOwnerReviewsAdminEventsAuditLog() {
+	community := s.setUpCommunityAndRoles()
+
+	s.adminEditsCommunityDescription(community)
+	s.adminCreateCommunityChannel(community, &protobuf.CommunityChat{
+		Permissions: &protobuf.CommunityPermissions{
+			Access: protobuf.CommunityPermissions_NO_MEMBERSHIP,
+		},
+		Identity: &protobuf.ChatIdentity{
+			DisplayName: "chat from admin",
+			Description: "chat created by an admin",
+		},
+	})
+
+	_, _, err := s.owner.GetCommunityAuditLog(&requests.GetCommunityAuditLog{CommunityID: community.ID(), Limit: -1})
+	s.Require().ErrorIs(err, requests.ErrGetCommunityAuditLogInvalidLimit)
+
+	entries, cursor, err := s.owner.GetCommunityAuditLog(&requests.GetCommunityAuditLog{CommunityID: community.ID(), Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Require().NotEmpty(cursor)
+	s.Require().Equal(protobuf.CommunityAdminEvent_COMMUNITY_CHANNEL_CREATE, entries[0].Type)
+	s.Require().Equal(common.PubkeyToHex(&s.admin.identity.PublicKey), entries[0].Author)
+	s.Require().Equal("chat from admin", entries[0].Event.ChannelData.Channel.Identity.DisplayName)
+
+	entries, cursor, err = s.owner.GetCommunityAuditLog(&requests.GetCommunityAuditLog{CommunityID: community.ID(), Cursor: cursor, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Require().Empty(cursor)
+	s.Require().Equal(protobuf.CommunityAdminEvent_COMMUNITY_EDIT, entries[0].Type)
+
+	_, _, err = s.admin.GetCommunityAuditLog(&requests.GetCommunityAuditLog{CommunityID: community.ID(), Limit: 10})
+	s.Require().ErrorIs(err, communities.ErrNotOwner)
+}
+
 func (s *AdminMessengerCommunitiesSuite) TestAdminCreateBecomeMemberPermission() {
 	community := s.setUpCommunityAndRoles()
 	s.adminCreateTestTokenPermission(community)
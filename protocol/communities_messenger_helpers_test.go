@@ -25,7 +25,7 @@ import (
 	"github.com/status-im/status-go/protocol/tt"
 )
 
-func newCommunitiesTestMessenger(shh types.Waku, privateKey *ecdsa.PrivateKey, logger *zap.Logger, accountsManager account.Manager, tokenManager communities.TokenManager) (*Messenger, error) {
+func newCommunitiesTestMessenger(shh types.Waku, privateKey *ecdsa.PrivateKey, logger *zap.Logger, accountsManager account.Manager, tokenManager communities.TokenManager, extraOptions ...Option) (*Messenger, error) {
 	tmpfile, err := ioutil.TempFile("", "accounts-tests-")
 	if err != nil {
 		return nil, err
@@ -46,6 +46,7 @@ func newCommunitiesTestMessenger(shh types.Waku, privateKey *ecdsa.PrivateKey, l
 		WithDatasync(),
 		WithTokenManager(tokenManager),
 	}
+	options = append(options, extraOptions...)
 
 	m, err := NewMessenger(
 		"Test",
@@ -2250,6 +2250,164 @@ func (s *MessengerCommunitiesSuite) TestBanUser() {
 	s.Require().False(community.IsBanned(&s.alice.identity.PublicKey))
 }
 
+func (s *MessengerCommunitiesSuite) TestBanUserAndDeleteAllMessages() {
+	community, chat := createCommunity(&s.Suite, s.admin)
+
+	s.advertiseCommunityTo(community, s.alice)
+	s.advertiseCommunityTo(community, s.bob)
+	s.joinCommunity(community, s.alice)
+	s.joinCommunity(community, s.bob)
+
+	message := sendChatMessage(&s.Suite, s.alice, chat.ID, "spam")
+
+	for _, user := range []*Messenger{s.admin, s.bob} {
+		err := tt.RetryWithBackOff(func() error {
+			response, err := user.RetrieveAll()
+			if err != nil {
+				return err
+			}
+			if len(response.Messages()) == 0 {
+				return errors.New("message not received")
+			}
+			return nil
+		})
+		s.Require().NoError(err)
+	}
+
+	response, err := s.admin.BanUserFromCommunity(
+		&requests.BanUserFromCommunity{
+			CommunityID:       community.ID(),
+			User:              common.PubkeyToHexBytes(&s.alice.identity.PublicKey),
+			DeleteAllMessages: true,
+			Reason:            "spamming",
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().Len(response.Communities(), 1)
+	s.Require().True(response.Communities()[0].IsBanned(&s.alice.identity.PublicKey))
+	s.Require().Len(response.RemovedMessages(), 1)
+	s.Require().Equal(message.ID, response.RemovedMessages()[0].MessageID)
+
+	deletedMessage, err := s.admin.MessageByID(message.ID)
+	s.Require().NoError(err)
+	s.Require().True(deletedMessage.Deleted)
+	s.Require().Equal(common.PubkeyToHex(&s.admin.identity.PublicKey), deletedMessage.DeletedBy)
+
+	// Members delete the messages as well
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.bob.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if len(response.RemovedMessages()) == 0 {
+			return errors.New("messages not deleted")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	deletedMessage, err = s.bob.MessageByID(message.ID)
+	s.Require().NoError(err)
+	s.Require().True(deletedMessage.Deleted)
+
+	log, err := s.admin.CommunityModerationLog(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(log, 1)
+	s.Require().Equal(common.PubkeyToHex(&s.alice.identity.PublicKey), log[0].MemberID)
+	s.Require().Equal(common.PubkeyToHex(&s.admin.identity.PublicKey), log[0].ModeratorID)
+	s.Require().Equal("spamming", log[0].Reason)
+	s.Require().True(log[0].MessagesDeleted)
+
+	_, err = s.bob.CommunityModerationLog(community.ID())
+	s.Require().ErrorIs(err, communities.ErrNotAdmin)
+
+	// Unbanning keeps the entry in the log
+	_, err = s.admin.UnbanUserFromCommunity(
+		&requests.UnbanUserFromCommunity{
+			CommunityID: community.ID(),
+			User:        common.PubkeyToHexBytes(&s.alice.identity.PublicKey),
+		},
+	)
+	s.Require().NoError(err)
+
+	log, err = s.admin.CommunityModerationLog(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(log, 1)
+}
+
+func (s *MessengerCommunitiesSuite) TestModeratorCanPinMessages() {
+	community, chat := createCommunity(&s.Suite, s.admin)
+
+	s.advertiseCommunityTo(community, s.alice)
+	s.advertiseCommunityTo(community, s.bob)
+	s.joinCommunity(community, s.alice)
+	s.joinCommunity(community, s.bob)
+
+	response, err := s.admin.SetMemberRoles(&requests.SetMemberRoles{
+		CommunityID: community.ID(),
+		User:        common.PubkeyToHexBytes(&s.alice.identity.PublicKey),
+		Roles:       []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_MODERATE_CONTENT},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Communities(), 1)
+
+	for _, user := range []*Messenger{s.alice, s.bob} {
+		err = tt.RetryWithBackOff(func() error {
+			_, err := user.RetrieveAll()
+			if err != nil {
+				return err
+			}
+			roles, err := user.GetMemberRoles(community.ID(), common.PubkeyToHexBytes(&s.alice.identity.PublicKey))
+			if err != nil {
+				return err
+			}
+			if len(roles.Roles) == 0 {
+				return errors.New("member roles not received")
+			}
+			return nil
+		})
+		s.Require().NoError(err)
+	}
+
+	roles, err := s.bob.GetMemberRoles(community.ID(), common.PubkeyToHexBytes(&s.alice.identity.PublicKey))
+	s.Require().NoError(err)
+	s.Require().Equal([]protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_MODERATE_CONTENT}, roles.Roles)
+	s.Require().Equal([]protobuf.CommunityMember_Permissions{
+		protobuf.CommunityMember_PERMISSION_PIN_MESSAGES,
+		protobuf.CommunityMember_PERMISSION_DELETE_MESSAGES,
+	}, roles.Permissions)
+
+	message := sendChatMessage(&s.Suite, s.admin, chat.ID, "hello")
+	for _, user := range []*Messenger{s.alice, s.bob} {
+		_, err = WaitOnMessengerResponse(
+			user,
+			func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+			"message not received",
+		)
+		s.Require().NoError(err)
+	}
+
+	pinMessage := &common.PinMessage{}
+	pinMessage.MessageId = message.ID
+	pinMessage.Pinned = true
+	pinMessage.ChatId = chat.ID
+
+	// Members without the permission can't pin messages
+	_, err = s.bob.SendPinMessage(context.Background(), pinMessage)
+	s.Require().ErrorIs(err, ErrInvalidPinPermission)
+
+	response, err = s.alice.SendPinMessage(context.Background(), pinMessage)
+	s.Require().NoError(err)
+	s.Require().Len(response.PinMessages(), 1)
+
+	_, err = WaitOnMessengerResponse(
+		s.bob,
+		func(r *MessengerResponse) bool { return len(r.PinMessages()) > 0 },
+		"pin message not received",
+	)
+	s.Require().NoError(err)
+}
+
 func (s *MessengerCommunitiesSuite) TestSyncCommunitySettings() {
 	// Create new device
 	alicesOtherDevice := s.newMessengerWithKey(s.alice.identity)
@@ -3286,3 +3444,162 @@ func (s *MessengerCommunitiesSuite) TestGetCommunityIdFromKey() {
 	communityID = s.bob.GetCommunityIDFromKey(privateKey)
 	s.Require().Equal(communityID, publicKey)
 }
+
+func (s *MessengerCommunitiesSuite) TestModerateRequestsToJoinCommunity() {
+	description := &requests.CreateCommunity{
+		Membership:  protobuf.CommunityPermissions_ON_REQUEST,
+		Name:        "status",
+		Color:       "#ffffff",
+		Description: "status community description",
+	}
+
+	response, err := s.bob.CreateCommunity(description, true)
+	s.Require().NoError(err)
+	s.Require().NotNil(response)
+	s.Require().Len(response.Communities(), 1)
+
+	community := response.Communities()[0]
+
+	requestIDs := make(map[*Messenger]types.HexBytes)
+	for _, user := range []*Messenger{s.alice, s.admin} {
+		advertiseCommunityTo(&s.Suite, community, s.bob, user)
+
+		response, err = user.RequestToJoinCommunity(&requests.RequestToJoinCommunity{CommunityID: community.ID()})
+		s.Require().NoError(err)
+		s.Require().Len(response.RequestsToJoinCommunity, 1)
+		requestIDs[user] = response.RequestsToJoinCommunity[0].ID
+	}
+
+	// Retrieve both requests to join
+	err = tt.RetryWithBackOff(func() error {
+		_, err := s.bob.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		pending, err := s.bob.PendingRequestsToJoinForCommunity(community.ID())
+		if err != nil {
+			return err
+		}
+		if len(pending) != 2 {
+			return errors.New("requests to join not received")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	pending, err := s.bob.FilterPendingRequestsToJoinCommunity(&requests.PendingRequestsToJoinCommunity{CommunityID: community.ID()})
+	s.Require().NoError(err)
+	s.Require().Len(pending, 2)
+
+	pending, err = s.bob.FilterPendingRequestsToJoinCommunity(&requests.PendingRequestsToJoinCommunity{
+		CommunityID: community.ID(),
+		WithENSName: true,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(pending, 0)
+
+	// An invalid request in the batch leaves every request pending
+	_, err = s.bob.ModerateRequestsToJoinCommunity(&requests.ModerateRequestsToJoinCommunity{
+		CommunityID: community.ID(),
+		Accept:      []types.HexBytes{requestIDs[s.alice]},
+		Decline:     []types.HexBytes{requestIDs[s.admin], types.HexBytes("unknown")},
+	})
+	s.Require().Error(err)
+	pending, err = s.bob.PendingRequestsToJoinForCommunity(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(pending, 2)
+	bobCommunity, err := s.bob.communitiesManager.GetByID(community.ID())
+	s.Require().NoError(err)
+	s.Require().False(bobCommunity.HasMember(&s.alice.identity.PublicKey))
+
+	response, err = s.bob.ModerateRequestsToJoinCommunity(&requests.ModerateRequestsToJoinCommunity{
+		CommunityID: community.ID(),
+		Accept:      []types.HexBytes{requestIDs[s.alice]},
+		Decline:     []types.HexBytes{requestIDs[s.admin]},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Communities(), 1)
+	s.Require().True(response.Communities()[0].HasMember(&s.alice.identity.PublicKey))
+	s.Require().False(response.Communities()[0].HasMember(&s.admin.identity.PublicKey))
+
+	s.Require().Len(response.RequestsToJoinCommunity, 2)
+	for _, requestToJoin := range response.RequestsToJoinCommunity {
+		if requestToJoin.ID.String() == requestIDs[s.alice].String() {
+			s.Require().Equal(communities.RequestToJoinStateAccepted, requestToJoin.State)
+		} else {
+			s.Require().Equal(communities.RequestToJoinStateDeclined, requestToJoin.State)
+		}
+	}
+
+	pending, err = s.bob.PendingRequestsToJoinForCommunity(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(pending, 0)
+
+	// Moderating an already moderated request fails
+	_, err = s.bob.ModerateRequestsToJoinCommunity(&requests.ModerateRequestsToJoinCommunity{
+		CommunityID: community.ID(),
+		Accept:      []types.HexBytes{requestIDs[s.admin]},
+	})
+	s.Require().ErrorIs(err, communities.ErrRequestToJoinNotPending)
+
+	// Alice receives the acceptance
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.alice.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if len(response.Communities()) == 0 || !response.Communities()[0].Joined() {
+			return errors.New("community not joined")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+}
+
+func (s *MessengerCommunitiesSuite) TestBroadcastCommunityAnnouncement() {
+	firstCommunity, firstChat := createCommunity(&s.Suite, s.admin)
+	_, secondChat := createCommunity(&s.Suite, s.admin)
+
+	s.advertiseCommunityTo(firstCommunity, s.alice)
+	s.joinCommunity(firstCommunity, s.alice)
+
+	// Only owners can broadcast announcements
+	_, _, err := s.alice.BroadcastCommunityAnnouncement(context.Background(), &requests.BroadcastCommunityAnnouncement{
+		ChatIDs: []string{firstChat.ID},
+		Text:    "announcement",
+	})
+	s.Require().ErrorIs(err, communities.ErrNotOwner)
+
+	// Nothing is sent when one of the chats can't be found
+	_, _, err = s.admin.BroadcastCommunityAnnouncement(context.Background(), &requests.BroadcastCommunityAnnouncement{
+		ChatIDs: []string{firstChat.ID, "unknown"},
+		Text:    "announcement",
+	})
+	s.Require().ErrorIs(err, ErrChatNotFound)
+
+	response, results, err := s.admin.BroadcastCommunityAnnouncement(context.Background(), &requests.BroadcastCommunityAnnouncement{
+		ChatIDs: []string{firstChat.ID, secondChat.ID},
+		Text:    "announcement",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages(), 2)
+	s.Require().Len(results, 2)
+	for _, result := range results {
+		s.Require().Empty(result.Error)
+		s.Require().NotEmpty(result.MessageID)
+	}
+
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.alice.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		for _, message := range response.Messages() {
+			if message.ID == results[0].MessageID {
+				return nil
+			}
+		}
+		return errors.New("announcement not received")
+	})
+	s.Require().NoError(err)
+}
@@ -76,6 +76,24 @@ func (m *TokenManagerMock) GetBalancesByChain(ctx context.Context, accounts, tok
 	return *m.Balances, nil
 }
 
+type PaymentVerifierMock struct {
+	// Payers of the mocked transactions, by transaction hash
+	Payments *map[string]gethcommon.Address
+}
+
+func (m *PaymentVerifierMock) VerifyMembershipPayment(ctx context.Context, communityID types.HexBytes, payment *protobuf.CommunityMembershipPayment, proof *protobuf.CommunityMembershipPaymentProof, payers []gethcommon.Address) (bool, error) {
+	payer, ok := (*m.Payments)[proof.TransactionHash]
+	if !ok {
+		return false, nil
+	}
+	for _, p := range payers {
+		if p == payer {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func TestMessengerCommunitiesTokenPermissionsSuite(t *testing.T) {
 	suite.Run(t, new(MessengerCommunitiesTokenPermissionsSuite))
 }
@@ -91,6 +109,7 @@ type MessengerCommunitiesTokenPermissionsSuite struct {
 	logger *zap.Logger
 
 	mockedBalances map[uint64]map[gethcommon.Address]map[gethcommon.Address]*hexutil.Big // chainID, account, token, balance
+	mockedPayments map[string]gethcommon.Address                                         // transaction hash, payer
 }
 
 func (s *MessengerCommunitiesTokenPermissionsSuite) SetupTest() {
@@ -102,6 +121,8 @@ func (s *MessengerCommunitiesTokenPermissionsSuite) SetupTest() {
 	s.shh = gethbridge.NewGethWakuWrapper(shh)
 	s.Require().NoError(shh.Start())
 
+	s.mockedPayments = make(map[string]gethcommon.Address)
+
 	s.owner = s.newMessenger(ownerPassword, []string{ownerAddress})
 	s.bob = s.newMessenger(bobPassword, []string{bobAddress})
 	s.alice = s.newMessenger(alicePassword, []string{aliceAddress1, aliceAddress2})
@@ -140,7 +161,11 @@ func (s *MessengerCommunitiesTokenPermissionsSuite) newMessenger(password string
 	privateKey, err := crypto.GenerateKey()
 	s.Require().NoError(err)
 
-	messenger, err := newCommunitiesTestMessenger(s.shh, privateKey, s.logger, accountsManagerMock, tokenManagerMock)
+	paymentVerifierMock := &PaymentVerifierMock{
+		Payments: &s.mockedPayments,
+	}
+
+	messenger, err := newCommunitiesTestMessenger(s.shh, privateKey, s.logger, accountsManagerMock, tokenManagerMock, WithPaymentVerifier(paymentVerifierMock))
 	s.Require().NoError(err)
 
 	// add wallet account with keypair
@@ -547,3 +572,167 @@ func (s *MessengerCommunitiesTokenPermissionsSuite) TestBecomeMemberPermissions(
 	s.Require().Len(response.Messages(), 1)
 	s.Require().Equal(msg.Text, response.Messages()[0].Text)
 }
+
+func (s *MessengerCommunitiesTokenPermissionsSuite) TestReevaluateChannelMembersPermissions() {
+	community, chat := s.createCommunity()
+
+	s.advertiseCommunityTo(community, s.bob)
+	s.joinCommunity(community, s.bob, bobPassword, []string{})
+
+	permissionRequest := requests.CreateCommunityTokenPermission{
+		CommunityID: community.ID(),
+		Type:        protobuf.CommunityTokenPermission_CAN_VIEW_CHANNEL,
+		TokenCriteria: []*protobuf.TokenCriteria{
+			&protobuf.TokenCriteria{
+				Type:              protobuf.CommunityTokenType_ERC20,
+				ContractAddresses: map[uint64]string{testChainID1: "0x123"},
+				Symbol:            "TEST",
+				Amount:            "100",
+				Decimals:          uint64(18),
+			},
+		},
+		ChatIds: []string{chat.ID},
+	}
+
+	// bob holds enough tokens to view the channel
+	s.makeAddressSatisfyTheCriteria(testChainID1, bobAddress, permissionRequest.TokenCriteria[0])
+
+	_, err := s.owner.CreateCommunityTokenPermission(&permissionRequest)
+	s.Require().NoError(err)
+
+	community, err = s.owner.communitiesManager.GetByID(community.ID())
+	s.Require().NoError(err)
+	_, err = community.InviteUserToChat(&s.bob.identity.PublicKey, chat.CommunityChatID())
+	s.Require().NoError(err)
+	err = s.owner.communitiesManager.UpdateCommunity(community)
+	s.Require().NoError(err)
+
+	reevaluateRequest := &requests.ReevaluateCommunityMembersPermissions{CommunityID: community.ID()}
+
+	response, err := s.owner.ReevaluateCommunityMembersPermissions(reevaluateRequest)
+	s.Require().NoError(err)
+	s.Require().Len(response.Communities(), 1)
+	s.Require().True(response.Communities()[0].IsMemberInChat(&s.bob.identity.PublicKey, chat.CommunityChatID()))
+
+	// bob's balance drops below the threshold
+	delete(s.mockedBalances[testChainID1][gethcommon.HexToAddress(bobAddress)], gethcommon.HexToAddress("0x123"))
+
+	waitOnChannelMembersRemovedErrCh := s.waitOnCommunitiesEvent(s.owner, func(sub *communities.Subscription) bool {
+		return sub.ChannelMembersRemovedSignal != nil &&
+			len(sub.ChannelMembersRemovedSignal.Members[chat.ID]) == 1 &&
+			sub.ChannelMembersRemovedSignal.Members[chat.ID][0] == common.PubkeyToHex(&s.bob.identity.PublicKey)
+	})
+
+	response, err = s.owner.ReevaluateCommunityMembersPermissions(reevaluateRequest)
+	s.Require().NoError(err)
+
+	err = <-waitOnChannelMembersRemovedErrCh
+	s.Require().NoError(err)
+
+	// bob is still a member of the community, but can't access the channel anymore
+	s.Require().Len(response.Communities(), 1)
+	s.Require().True(response.Communities()[0].HasMember(&s.bob.identity.PublicKey))
+	s.Require().False(response.Communities()[0].IsMemberInChat(&s.bob.identity.PublicKey, chat.CommunityChatID()))
+
+	community, err = s.owner.communitiesManager.GetByID(community.ID())
+	s.Require().NoError(err)
+	s.Require().False(community.IsMemberInChat(&s.bob.identity.PublicKey, chat.CommunityChatID()))
+}
+
+func (s *MessengerCommunitiesTokenPermissionsSuite) TestJoinCommunityWithMembershipPayment() {
+	community, _ := s.createCommunity()
+
+	recipient := "0x0000000000000000000000000000000000000001"
+	contractAddress := "0x0000000000000000000000000000000000000002"
+	response, err := s.owner.SetCommunityMembershipPayment(&requests.SetCommunityMembershipPayment{
+		CommunityID:     community.ID(),
+		ChainID:         testChainID1,
+		ContractAddress: contractAddress,
+		Amount:          "1000",
+		Recipient:       recipient,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Communities(), 1)
+	s.Require().NotNil(response.Communities()[0].MembershipPayment())
+	community = response.Communities()[0]
+
+	s.advertiseCommunityTo(community, s.alice)
+	s.advertiseCommunityTo(community, s.bob)
+
+	tx, err := s.alice.GenerateCommunityMembershipPayment(community.ID(), types.HexToAddress(aliceAddress1))
+	s.Require().NoError(err)
+	s.Require().Equal(types.HexToAddress(contractAddress), *tx.To)
+	s.Require().NotEmpty(tx.Data)
+
+	// Bob didn't pay, his request is declined
+	passwdHash := types.EncodeHex(crypto.Keccak256([]byte(bobPassword)))
+	response, err = s.bob.RequestToJoinCommunity(&requests.RequestToJoinCommunity{
+		CommunityID:              community.ID(),
+		Password:                 passwdHash,
+		MembershipPaymentChainID: testChainID1,
+		MembershipPaymentTxHash:  "0x01",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.RequestsToJoinCommunity, 1)
+
+	// The payment is verified in the background, the request is declined
+	// once it's done
+	err = tt.RetryWithBackOff(func() error {
+		_, err := s.owner.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		declined, err := s.owner.DeclinedRequestsToJoinForCommunity(community.ID())
+		if err != nil {
+			return err
+		}
+		if len(declined) == 0 {
+			return errors.New("request to join not declined")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+	community, err = s.owner.communitiesManager.GetByID(community.ID())
+	s.Require().NoError(err)
+	s.Require().False(community.HasMember(&s.bob.identity.PublicKey))
+
+	// Alice paid, her request is accepted automatically
+	s.mockedPayments["0x02"] = gethcommon.HexToAddress(aliceAddress1)
+	passwdHash = types.EncodeHex(crypto.Keccak256([]byte(alicePassword)))
+	response, err = s.alice.RequestToJoinCommunity(&requests.RequestToJoinCommunity{
+		CommunityID:              community.ID(),
+		Password:                 passwdHash,
+		MembershipPaymentChainID: testChainID1,
+		MembershipPaymentTxHash:  "0x02",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.RequestsToJoinCommunity, 1)
+
+	err = tt.RetryWithBackOff(func() error {
+		_, err := s.owner.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		community, err := s.owner.communitiesManager.GetByID(community.ID())
+		if err != nil {
+			return err
+		}
+		if !community.HasMember(&s.alice.identity.PublicKey) {
+			return errors.New("alice not accepted")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	err = tt.RetryWithBackOff(func() error {
+		response, err := s.alice.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if len(response.Communities()) == 0 || !response.Communities()[0].HasMember(&s.alice.identity.PublicKey) {
+			return errors.New("alice not a member")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+}
@@ -0,0 +1,88 @@
+package protocol
+
+import (
+	"encoding/json"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// maxCommunityMemberProfileImageSize bounds the size of the received avatars,
+// which are thumbnails
+const maxCommunityMemberProfileImageSize = 100 * 1024
+
+// CommunityMemberProfile is the display name and the avatar a member uses in a
+// community instead of their own, the empty ones aren't overridden
+type CommunityMemberProfile struct {
+	CommunityID string `json:"communityId"`
+	MemberID    string `json:"memberId"`
+	DisplayName string `json:"displayName,omitempty"`
+	Image       []byte `json:"-"`
+	Clock       uint64 `json:"clock"`
+}
+
+func (p *CommunityMemberProfile) MarshalJSON() ([]byte, error) {
+	type Alias CommunityMemberProfile
+	item := struct {
+		*Alias
+		Image string `json:"image,omitempty"`
+	}{
+		Alias: (*Alias)(p),
+	}
+
+	if len(p.Image) != 0 {
+		uri, err := images.GetPayloadDataURI(p.Image)
+		if err != nil {
+			return nil, err
+		}
+		item.Image = uri
+	}
+
+	return json.Marshal(item)
+}
+
+// Empty tells whether the profile doesn't override anything
+func (p *CommunityMemberProfile) Empty() bool {
+	return p.DisplayName == "" && len(p.Image) == 0
+}
+
+func (p *CommunityMemberProfile) toProtobuf(communityID []byte) *protobuf.CommunityMemberProfile {
+	pb := &protobuf.CommunityMemberProfile{
+		Clock:       p.Clock,
+		CommunityId: communityID,
+		DisplayName: p.DisplayName,
+	}
+	if len(p.Image) != 0 {
+		pb.Image = &protobuf.IdentityImage{
+			Payload:    p.Image,
+			SourceType: protobuf.IdentityImage_RAW_PAYLOAD,
+			ImageType:  images.GetProtobufImageType(p.Image),
+		}
+	}
+	return pb
+}
+
+// communityMemberProfileFromProtobuf validates a received profile
+func communityMemberProfileFromProtobuf(memberID string, pb *protobuf.CommunityMemberProfile) (*CommunityMemberProfile, error) {
+	profile := &CommunityMemberProfile{
+		CommunityID: types.EncodeHex(pb.CommunityId),
+		MemberID:    memberID,
+		DisplayName: pb.DisplayName,
+		Clock:       pb.Clock,
+	}
+
+	err := ValidateDisplayName(&profile.DisplayName)
+	if err != nil {
+		return nil, err
+	}
+
+	if image := pb.GetImage(); image != nil && len(image.Payload) != 0 {
+		if len(image.Payload) > maxCommunityMemberProfileImageSize || images.GetType(image.Payload) == images.UNKNOWN {
+			return nil, ErrInvalidCommunityMemberProfile
+		}
+		profile.Image = image.Payload
+	}
+
+	return profile, nil
+}
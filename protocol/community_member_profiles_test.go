@@ -0,0 +1,34 @@
+package protocol
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestCommunityMemberProfileFromProtobuf(t *testing.T) {
+	pb := &protobuf.CommunityMemberProfile{
+		Clock:       1,
+		CommunityId: []byte{0x01},
+		DisplayName: " work-name ",
+	}
+
+	profile, err := communityMemberProfileFromProtobuf("0x02", pb)
+	require.NoError(t, err)
+	require.Equal(t, "0x01", profile.CommunityID)
+	require.Equal(t, "work-name", profile.DisplayName)
+	require.Nil(t, profile.Image)
+	require.Equal(t, "work-name", profile.toProtobuf(pb.CommunityId).DisplayName)
+
+	pb.DisplayName = "a"
+	_, err = communityMemberProfileFromProtobuf("0x02", pb)
+	require.Equal(t, ErrInvalidDisplayNameRegExp, err)
+
+	pb.DisplayName = ""
+	pb.Image = &protobuf.IdentityImage{Payload: bytes.Repeat([]byte{0xff}, maxCommunityMemberProfileImageSize+1)}
+	_, err = communityMemberProfileFromProtobuf("0x02", pb)
+	require.Equal(t, ErrInvalidCommunityMemberProfile, err)
+}
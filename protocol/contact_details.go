@@ -0,0 +1,95 @@
+package protocol
+
+import (
+	"sync"
+
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/verification"
+)
+
+// maxCachedContactDetails bounds the details kept in memory, the cache is
+// emptied when it's reached as the users of large communities aren't all
+// contacts
+const maxCachedContactDetails = 10000
+
+// ContactDetails is what's needed to render a user in a list, whether the
+// user is a contact or not
+type ContactDetails struct {
+	ID                 string                   `json:"id"`
+	PrimaryName        string                   `json:"primaryName"`
+	SecondaryName      string                   `json:"secondaryName,omitempty"`
+	Alias              string                   `json:"alias"`
+	DisplayName        string                   `json:"displayName,omitempty"`
+	EnsName            string                   `json:"ensName,omitempty"`
+	ENSVerified        bool                     `json:"ensVerified"`
+	Image              string                   `json:"image"`
+	IsContact          bool                     `json:"isContact"`
+	Blocked            bool                     `json:"blocked"`
+	VerificationStatus VerificationStatus       `json:"verificationStatus"`
+	TrustStatus        verification.TrustStatus `json:"trustStatus"`
+}
+
+func newContactDetails(contact *Contact, profilePicturesVisibility settings.ProfilePicturesVisibilityType) *ContactDetails {
+	return &ContactDetails{
+		ID:                 contact.ID,
+		PrimaryName:        contact.PrimaryName(),
+		SecondaryName:      contact.SecondaryName(),
+		Alias:              contact.Alias,
+		DisplayName:        contact.DisplayName,
+		EnsName:            contact.EnsName,
+		ENSVerified:        contact.ENSVerified,
+		Image:              contact.CanonicalImage(profilePicturesVisibility),
+		IsContact:          contact.mutual(),
+		Blocked:            contact.Blocked,
+		VerificationStatus: contact.VerificationStatus,
+		TrustStatus:        contact.TrustStatus,
+	}
+}
+
+// contactDetailsCache keeps the details of the users rendered in lists, so
+// that their names and images aren't resolved again. The details of a user
+// are dropped when the contact changes, and all of them when the profile
+// pictures visibility does
+type contactDetailsCache struct {
+	mutex      sync.Mutex
+	details    map[string]*ContactDetails
+	visibility settings.ProfilePicturesVisibilityType
+	// generation is bumped on every invalidation, details resolved meanwhile
+	// aren't cached as they may be outdated
+	generation uint64
+}
+
+func (c *contactDetailsCache) get(id string, visibility settings.ProfilePicturesVisibilityType) (*ContactDetails, uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.visibility != visibility {
+		c.details = nil
+		c.visibility = visibility
+		c.generation++
+	}
+
+	return c.details[id], c.generation
+}
+
+func (c *contactDetailsCache) put(details *ContactDetails, visibility settings.ProfilePicturesVisibilityType, generation uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.generation != generation || c.visibility != visibility {
+		return
+	}
+
+	if c.details == nil || len(c.details) >= maxCachedContactDetails {
+		c.details = make(map[string]*ContactDetails)
+	}
+	c.details[details.ID] = details
+}
+
+func (c *contactDetailsCache) invalidate(id string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.details, id)
+	c.generation++
+}
@@ -0,0 +1,48 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/multiaccounts/settings"
+)
+
+func TestContactDetailsCache(t *testing.T) {
+	contacts := new(contactMap)
+	contact := &Contact{ID: "0x04aa", Alias: "alias", DisplayName: "display-name", Identicon: "identicon"}
+	contacts.Store(contact.ID, contact)
+
+	visibility := settings.ProfilePicturesVisibilityEveryone
+
+	details, generation := contacts.details.get(contact.ID, visibility)
+	require.Nil(t, details)
+	contacts.details.put(newContactDetails(contact, visibility), visibility, generation)
+
+	details, _ = contacts.details.get(contact.ID, visibility)
+	require.NotNil(t, details)
+	require.Equal(t, "display-name", details.PrimaryName)
+	require.Equal(t, "identicon", details.Image)
+
+	// Storing the contact drops its details
+	contact.LocalNickname = "nickname"
+	contacts.Store(contact.ID, contact)
+	details, generation = contacts.details.get(contact.ID, visibility)
+	require.Nil(t, details)
+
+	// Details resolved while the contact changes aren't cached
+	stale := newContactDetails(contact, visibility)
+	contacts.Store(contact.ID, contact)
+	contacts.details.put(stale, visibility, generation)
+	details, generation = contacts.details.get(contact.ID, visibility)
+	require.Nil(t, details)
+
+	contacts.details.put(newContactDetails(contact, visibility), visibility, generation)
+	details, _ = contacts.details.get(contact.ID, visibility)
+	require.Equal(t, "nickname", details.PrimaryName)
+	require.Equal(t, "display-name", details.SecondaryName)
+
+	// Changing the profile pictures visibility drops all the details
+	details, _ = contacts.details.get(contact.ID, settings.ProfilePicturesVisibilityNone)
+	require.Nil(t, details)
+}
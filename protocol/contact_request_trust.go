@@ -0,0 +1,109 @@
+package protocol
+
+import (
+	"sync"
+
+	"github.com/status-im/status-go/protocol/common"
+)
+
+const (
+	// minContactTrustScore is the score below which the contact requests and
+	// the messages of non-contacts are kept in the requests bucket
+	minContactTrustScore = 3
+
+	contactTrustScoreMutualCommunity     = 1
+	contactTrustScoreSharedGroupChat     = 2
+	contactTrustScoreENSVerified         = 3
+	contactTrustScorePreviousInteraction = 3
+
+	// maxCountedMutualCommunities keeps the score of members of many large
+	// communities from growing without bounds
+	maxCountedMutualCommunities = 3
+
+	// contactRequestsThrottleWindow and maxContactRequestsPerThrottleWindow
+	// limit how many requests reach the activity center, the following ones
+	// are kept in the bucket whatever their score
+	contactRequestsThrottleWindow       = 60 * 60 * 1000
+	maxContactRequestsPerThrottleWindow = 10
+)
+
+// ContactTrust is what the trust score of someone who isn't a contact is
+// computed from
+type ContactTrust struct {
+	MutualCommunities    int  `json:"mutualCommunities"`
+	SharedGroupChats     int  `json:"sharedGroupChats"`
+	ENSVerified          bool `json:"ensVerified"`
+	PreviousInteractions bool `json:"previousInteractions"`
+	Trusted              bool `json:"trusted"`
+	Untrustworthy        bool `json:"untrustworthy"`
+	Score                int  `json:"score"`
+}
+
+func (t *ContactTrust) computeScore() {
+	if t.Untrustworthy {
+		t.Score = 0
+		return
+	}
+	if t.Trusted {
+		t.Score = minContactTrustScore
+		return
+	}
+
+	mutualCommunities := t.MutualCommunities
+	if mutualCommunities > maxCountedMutualCommunities {
+		mutualCommunities = maxCountedMutualCommunities
+	}
+	t.Score = mutualCommunities*contactTrustScoreMutualCommunity + t.SharedGroupChats*contactTrustScoreSharedGroupChat
+	if t.ENSVerified {
+		t.Score += contactTrustScoreENSVerified
+	}
+	if t.PreviousInteractions {
+		t.Score += contactTrustScorePreviousInteraction
+	}
+}
+
+func (t *ContactTrust) low() bool {
+	return t.Score < minContactTrustScore
+}
+
+// BucketedContactRequest is a contact request, or the latest message of
+// someone who isn't a contact, kept out of the activity center until it's
+// reviewed
+type BucketedContactRequest struct {
+	ContactID string `json:"contactId"`
+	MessageID string `json:"messageId"`
+	// ContactRequest is set when the message is a contact request, a contact
+	// request is never replaced by a later message
+	ContactRequest bool            `json:"contactRequest"`
+	TrustScore     int             `json:"trustScore"`
+	Timestamp      uint64          `json:"timestamp"`
+	Message        *common.Message `json:"message,omitempty"`
+}
+
+// contactRequestsThrottle counts the requests which reached the activity
+// center over the last throttle window
+type contactRequestsThrottle struct {
+	mutex      sync.Mutex
+	timestamps []uint64
+}
+
+// allow records a request received at now and tells whether it can reach the
+// activity center
+func (t *contactRequestsThrottle) allow(now uint64) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	recent := t.timestamps[:0]
+	for _, timestamp := range t.timestamps {
+		if timestamp+contactRequestsThrottleWindow > now {
+			recent = append(recent, timestamp)
+		}
+	}
+	t.timestamps = recent
+
+	if len(t.timestamps) >= maxContactRequestsPerThrottleWindow {
+		return false
+	}
+	t.timestamps = append(t.timestamps, now)
+	return true
+}
@@ -0,0 +1,52 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContactTrustScore(t *testing.T) {
+	trust := &ContactTrust{MutualCommunities: 2}
+	trust.computeScore()
+	require.Equal(t, 2, trust.Score)
+	require.True(t, trust.low())
+
+	// Being in many communities together doesn't make up for anything else
+	trust = &ContactTrust{MutualCommunities: 20}
+	trust.computeScore()
+	require.Equal(t, maxCountedMutualCommunities*contactTrustScoreMutualCommunity, trust.Score)
+	require.False(t, trust.low())
+
+	trust = &ContactTrust{ENSVerified: true}
+	trust.computeScore()
+	require.False(t, trust.low())
+
+	trust = &ContactTrust{PreviousInteractions: true, SharedGroupChats: 1}
+	trust.computeScore()
+	require.Equal(t, contactTrustScorePreviousInteraction+contactTrustScoreSharedGroupChat, trust.Score)
+
+	trust = &ContactTrust{Trusted: true}
+	trust.computeScore()
+	require.False(t, trust.low())
+
+	trust = &ContactTrust{Untrustworthy: true, ENSVerified: true, PreviousInteractions: true}
+	trust.computeScore()
+	require.Equal(t, 0, trust.Score)
+	require.True(t, trust.low())
+}
+
+func TestContactRequestsThrottle(t *testing.T) {
+	throttle := &contactRequestsThrottle{}
+	now := uint64(1000000000)
+
+	for i := 0; i < maxContactRequestsPerThrottleWindow; i++ {
+		require.True(t, throttle.allow(now+uint64(i)))
+	}
+	require.False(t, throttle.allow(now+maxContactRequestsPerThrottleWindow))
+
+	// The requests leave the window one at a time
+	require.True(t, throttle.allow(now+contactRequestsThrottleWindow))
+	require.False(t, throttle.allow(now+contactRequestsThrottleWindow))
+	require.True(t, throttle.allow(now+contactRequestsThrottleWindow+1))
+}
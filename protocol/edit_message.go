@@ -26,6 +26,17 @@ type EditMessage struct {
 	LocalChatID string `json:"localChatId"`
 }
 
+// MessageEditRevision is a previous version of an edited message
+type MessageEditRevision struct {
+	MessageID string `json:"messageId"`
+	// Clock is the clock of the message or of the edit that produced this version
+	Clock uint64 `json:"clock"`
+	// ReplacedAt is the clock of the edit that replaced this version
+	ReplacedAt  uint64                           `json:"replacedAt"`
+	Text        string                           `json:"text"`
+	ContentType protobuf.ChatMessage_ContentType `json:"contentType"`
+}
+
 // GetSigPubKey returns an ecdsa encoded public key
 // this function is required to implement the ChatEntity interface
 func (e EditMessage) GetSigPubKey() *ecdsa.PublicKey {
@@ -28,9 +28,29 @@ type EmojiReaction struct {
 	LocalChatID string `json:"localChatId"`
 }
 
-// ID is the Keccak256() contatenation of From-MessageID-EmojiType
+// EmojiReactionCount is the number of reactions to a message with a given emoji
+type EmojiReactionCount struct {
+	EmojiID protobuf.EmojiReaction_Type `json:"emojiId"`
+	// CommunityEmojiID is set for the emojis of community emoji packs
+	CommunityEmojiID string `json:"communityEmojiId,omitempty"`
+	Count            int    `json:"count"`
+	// ReactedByMe is set when one of the reactions is ours
+	ReactedByMe bool `json:"reactedByMe"`
+}
+
+// MessageReactions aggregates the reactions to a message, along with a page
+// of the reactions themselves
+type MessageReactions struct {
+	MessageID string                `json:"messageId"`
+	Counts    []*EmojiReactionCount `json:"counts"`
+	Reactions []*EmojiReaction      `json:"reactions"`
+	Cursor    string                `json:"cursor"`
+}
+
+// ID is the Keccak256() contatenation of From-MessageID-EmojiType, followed
+// by the ID of the community emoji for custom emojis
 func (e EmojiReaction) ID() string {
-	return types.EncodeHex(crypto.Keccak256([]byte(fmt.Sprintf("%s%s%d", e.From, e.MessageId, e.Type))))
+	return types.EncodeHex(crypto.Keccak256([]byte(fmt.Sprintf("%s%s%d%s", e.From, e.MessageId, e.Type, e.CommunityEmojiId))))
 }
 
 // GetSigPubKey returns an ecdsa encoded public key
@@ -62,6 +82,8 @@ func (e EmojiReaction) MarshalJSON() ([]byte, error) {
 		MessageType protobuf.MessageType        `json:"messageType,omitempty"`
 		Retracted   bool                        `json:"retracted,omitempty"`
 		EmojiID     protobuf.EmojiReaction_Type `json:"emojiId,omitempty"`
+		// CommunityEmojiID is set for the emojis of community emoji packs
+		CommunityEmojiID string `json:"communityEmojiId,omitempty"`
 	}{
 
 		ID:          e.ID(),
@@ -73,6 +95,8 @@ func (e EmojiReaction) MarshalJSON() ([]byte, error) {
 		MessageType: e.MessageType,
 		Retracted:   e.Retracted,
 		EmojiID:     e.Type,
+
+		CommunityEmojiID: e.CommunityEmojiId,
 	}
 
 	ext, err := accountJson.ExtendStructWithPubKeyData(item.From, item)
@@ -43,7 +43,7 @@ func (s *SQLLitePersistenceTestSuite) TestPrivateBundle() {
 	s.Require().NoError(err, "Error was not returned even though bundle is not there")
 	s.Nil(actualKey)
 
-	anyPrivateBundle, err := s.service.GetAnyPrivateBundle([]byte("non-existing-id"), []*multidevice.Installation{{ID: installationID, Version: 1}})
+	anyPrivateBundle, err := s.service.GetAnyPrivateBundle([]byte("non-existing-id"), []*multidevice.Installation{{ID: installationID, Version: protocolVersion}})
 	s.Require().NoError(err)
 	s.Nil(anyPrivateBundle)
 
@@ -60,7 +60,7 @@ func (s *SQLLitePersistenceTestSuite) TestPrivateBundle() {
 	s.Equal(bundle.GetPrivateSignedPreKey(), actualKey, "It returns the same key")
 
 	identity := crypto.CompressPubkey(&key.PublicKey)
-	anyPrivateBundle, err = s.service.GetAnyPrivateBundle(identity, []*multidevice.Installation{{ID: installationID, Version: 1}})
+	anyPrivateBundle, err = s.service.GetAnyPrivateBundle(identity, []*multidevice.Installation{{ID: installationID, Version: protocolVersion}})
 	s.Require().NoError(err)
 	s.NotNil(anyPrivateBundle)
 	s.Equal(bundle.GetBundle().GetSignedPreKeys()[installationID].SignedPreKey, anyPrivateBundle.GetBundle().GetSignedPreKeys()[installationID].SignedPreKey, "It returns the same bundle")
@@ -70,7 +70,7 @@ func (s *SQLLitePersistenceTestSuite) TestPublicBundle() {
 	key, err := crypto.GenerateKey()
 	s.Require().NoError(err)
 
-	actualBundle, err := s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: 1}})
+	actualBundle, err := s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: protocolVersion}})
 	s.Require().NoError(err, "Error was not returned even though bundle is not there")
 	s.Nil(actualBundle)
 
@@ -81,7 +81,7 @@ func (s *SQLLitePersistenceTestSuite) TestPublicBundle() {
 	err = s.service.AddPublicBundle(bundle)
 	s.Require().NoError(err)
 
-	actualBundle, err = s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: 1}})
+	actualBundle, err = s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: protocolVersion}})
 	s.Require().NoError(err)
 	s.Equal(bundle.GetIdentity(), actualBundle.GetIdentity(), "It sets the right identity")
 	s.Equal(bundle.GetSignedPreKeys(), actualBundle.GetSignedPreKeys(), "It sets the right prekeys")
@@ -91,7 +91,7 @@ func (s *SQLLitePersistenceTestSuite) TestUpdatedBundle() {
 	key, err := crypto.GenerateKey()
 	s.Require().NoError(err)
 
-	actualBundle, err := s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: 1}})
+	actualBundle, err := s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: protocolVersion}})
 	s.Require().NoError(err, "Error was not returned even though bundle is not there")
 	s.Nil(actualBundle)
 
@@ -113,7 +113,7 @@ func (s *SQLLitePersistenceTestSuite) TestUpdatedBundle() {
 	err = s.service.AddPublicBundle(bundle)
 	s.Require().NoError(err)
 
-	actualBundle, err = s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: 1}})
+	actualBundle, err = s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: protocolVersion}})
 	s.Require().NoError(err)
 	s.Equal(bundle.GetIdentity(), actualBundle.GetIdentity(), "It sets the right identity")
 	s.Equal(bundle.GetSignedPreKeys(), actualBundle.GetSignedPreKeys(), "It sets the right prekeys")
@@ -123,7 +123,7 @@ func (s *SQLLitePersistenceTestSuite) TestOutOfOrderBundles() {
 	key, err := crypto.GenerateKey()
 	s.Require().NoError(err)
 
-	actualBundle, err := s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: 1}})
+	actualBundle, err := s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: protocolVersion}})
 	s.Require().NoError(err, "Error was not returned even though bundle is not there")
 	s.Nil(actualBundle)
 
@@ -150,7 +150,7 @@ func (s *SQLLitePersistenceTestSuite) TestOutOfOrderBundles() {
 	err = s.service.AddPublicBundle(bundle1)
 	s.Require().NoError(err)
 
-	actualBundle, err = s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: 1}})
+	actualBundle, err = s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: protocolVersion}})
 	s.Require().NoError(err)
 	s.Equal(bundle2.GetIdentity(), actualBundle.GetIdentity(), "It sets the right identity")
 	s.Equal(bundle2.GetSignedPreKeys()["1"].GetVersion(), uint32(1))
@@ -161,7 +161,7 @@ func (s *SQLLitePersistenceTestSuite) TestMultiplePublicBundle() {
 	key, err := crypto.GenerateKey()
 	s.Require().NoError(err)
 
-	actualBundle, err := s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: 1}})
+	actualBundle, err := s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: protocolVersion}})
 	s.Require().NoError(err, "Error was not returned even though bundle is not there")
 	s.Nil(actualBundle)
 
@@ -187,7 +187,7 @@ func (s *SQLLitePersistenceTestSuite) TestMultiplePublicBundle() {
 	s.Require().NoError(err)
 
 	// Returns the most recent bundle
-	actualBundle, err = s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: 1}})
+	actualBundle, err = s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: protocolVersion}})
 	s.Require().NoError(err)
 
 	s.Equal(bundle.GetIdentity(), actualBundle.GetIdentity(), "It sets the identity")
@@ -199,7 +199,7 @@ func (s *SQLLitePersistenceTestSuite) TestMultiDevicePublicBundle() {
 	key, err := crypto.GenerateKey()
 	s.Require().NoError(err)
 
-	actualBundle, err := s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: 1}})
+	actualBundle, err := s.service.GetPublicBundle(&key.PublicKey, []*multidevice.Installation{{ID: "1", Version: protocolVersion}})
 	s.Require().NoError(err, "Error was not returned even though bundle is not there")
 	s.Nil(actualBundle)
 
@@ -225,8 +225,8 @@ func (s *SQLLitePersistenceTestSuite) TestMultiDevicePublicBundle() {
 	// Returns the most recent bundle
 	actualBundle, err = s.service.GetPublicBundle(&key.PublicKey,
 		[]*multidevice.Installation{
-			{ID: "1", Version: 1},
-			{ID: "2", Version: 1},
+			{ID: "1", Version: protocolVersion},
+			{ID: "2", Version: protocolVersion},
 		})
 	s.Require().NoError(err)
 
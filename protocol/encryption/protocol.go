@@ -22,9 +22,10 @@ import (
 //go:generate protoc --go_out=. ./protocol_message.proto
 
 const (
-	protocolVersion                = 1
+	protocolVersion                = 2
 	sharedSecretNegotiationVersion = 1
 	partitionedTopicMinVersion     = 1
+	compressionMinVersion          = 2
 	defaultMinVersion              = 0
 )
 
@@ -476,6 +477,25 @@ func (p *Protocol) SetInstallationName(myIdentityKey *ecdsa.PublicKey, installat
 	return p.multidevice.SetInstallationName(myIdentityKey, installationID, name)
 }
 
+// SupportsCompression returns whether all the active installations of the
+// identity can decompress the application payloads
+func (p *Protocol) SupportsCompression(theirIdentityKey *ecdsa.PublicKey) (bool, error) {
+	installations, err := p.multidevice.GetActiveInstallations(theirIdentityKey)
+	if err != nil {
+		return false, err
+	}
+	if len(installations) == 0 {
+		return false, nil
+	}
+
+	for _, installation := range installations {
+		if installation.Version < compressionMinVersion {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // GetPublicBundle retrieves a public bundle given an identity
 func (p *Protocol) GetPublicBundle(theirIdentityKey *ecdsa.PublicKey) (*Bundle, error) {
 	installations, err := p.multidevice.GetActiveInstallations(theirIdentityKey)
@@ -10,4 +10,32 @@ var (
 	ErrNotImplemented   = errors.New("not implemented")
 	ErrContactNotFound  = errors.New("contact not found")
 	ErrCommunityIDEmpty = errors.New("community ID is empty")
+
+	ErrBackupIntervalTooShort = errors.New("backup interval is too short")
+	ErrPinnedMessageNotFound  = errors.New("pinned message not found")
+
+	ErrOutboxMessageNotFound    = errors.New("outbox message not found")
+	ErrOutboxMessageAlreadySent = errors.New("outbox message already sent")
+
+	ErrInvalidMessageRetentionPolicy = errors.New("invalid message retention policy")
+	ErrMessengerStopped              = errors.New("messenger stopped")
+
+	ErrInvalidNotificationRule  = errors.New("invalid notification rule")
+	ErrNotificationRuleNotFound = errors.New("notification rule not found")
+
+	ErrInvalidEmojiReaction = errors.New("invalid emoji reaction")
+	ErrEmojiNotFound        = errors.New("community emoji not found")
+
+	ErrWalletNotEnabled = errors.New("wallet service not enabled")
+
+	ErrAttachmentNotFound = errors.New("attachment not found")
+
+	ErrInvalidOutgoingMessageFilter     = errors.New("invalid outgoing message filter")
+	ErrOutgoingMessageFilterNotFound    = errors.New("outgoing message filter not found")
+	ErrOutgoingMessageBlocked           = errors.New("message blocked by an outgoing message filter")
+	ErrOutgoingMessageNeedsConfirmation = errors.New("message matches an outgoing message filter and needs to be confirmed")
+
+	ErrInvalidCommunityMemberProfile = errors.New("invalid community member profile")
+
+	ErrBucketedContactRequestNotFound = errors.New("bucketed contact request not found")
 )
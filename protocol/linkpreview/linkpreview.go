@@ -370,3 +370,49 @@ func UnfurlURLs(logger *zap.Logger, httpClient http.Client, urls []string) ([]co
 
 	return previews, nil
 }
+
+// HostnameFilter restricts the hosts link previews are generated and shown
+// for. A hostname matches an entry when it is the same domain or one of its
+// subdomains. The denylist has priority, an empty allowlist allows any host.
+type HostnameFilter struct {
+	Allowlist []string
+	Denylist  []string
+}
+
+func hostnameMatches(hostname string, domains []string) bool {
+	for _, domain := range domains {
+		domain = normalizeHostname(strings.TrimSpace(domain))
+		if domain == "" {
+			continue
+		}
+		if hostname == domain || strings.HasSuffix(hostname, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allowed returns whether previews can be generated and shown for hostname
+func (f HostnameFilter) Allowed(hostname string) bool {
+	hostname = normalizeHostname(hostname)
+	if hostnameMatches(hostname, f.Denylist) {
+		return false
+	}
+	return len(f.Allowlist) == 0 || hostnameMatches(hostname, f.Allowlist)
+}
+
+// FilterURLs returns the URLs whose hostname is allowed by the filter.
+// URLs that can't be parsed are discarded.
+func FilterURLs(urls []string, filter HostnameFilter) []string {
+	filtered := make([]string, 0, len(urls))
+	for _, rawURL := range urls {
+		parsedURL, err := neturl.Parse(rawURL)
+		if err != nil {
+			continue
+		}
+		if filter.Allowed(parsedURL.Hostname()) {
+			filtered = append(filtered, rawURL)
+		}
+	}
+	return filtered
+}
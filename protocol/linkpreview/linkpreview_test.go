@@ -155,6 +155,40 @@ func Test_GetLinks(t *testing.T) {
 	}
 }
 
+func Test_FilterURLs(t *testing.T) {
+	urls := []string{
+		"https://status.im/test",
+		"https://www.youtube.com/watch?v=mzOyYtfXkb0",
+		"https://m.youtube.com/watch?v=mzOyYtfXkb0",
+		"https://notyoutube.com",
+		"https://github.com",
+	}
+
+	examples := []struct {
+		filter   HostnameFilter
+		expected []string
+	}{
+		{filter: HostnameFilter{}, expected: urls},
+		{
+			filter:   HostnameFilter{Denylist: []string{"youtube.com"}},
+			expected: []string{"https://status.im/test", "https://notyoutube.com", "https://github.com"},
+		},
+		{
+			filter:   HostnameFilter{Allowlist: []string{"YouTube.com", "status.im"}},
+			expected: []string{"https://status.im/test", "https://www.youtube.com/watch?v=mzOyYtfXkb0", "https://m.youtube.com/watch?v=mzOyYtfXkb0"},
+		},
+		// The denylist has priority over the allowlist.
+		{
+			filter:   HostnameFilter{Allowlist: []string{"youtube.com"}, Denylist: []string{"m.youtube.com"}},
+			expected: []string{"https://www.youtube.com/watch?v=mzOyYtfXkb0"},
+		},
+	}
+
+	for _, ex := range examples {
+		require.Equal(t, ex.expected, FilterURLs(urls, ex.filter), "Failed for filter: '%v'", ex.filter)
+	}
+}
+
 func readAsset(t *testing.T, filename string) []byte {
 	b, err := ioutil.ReadFile("../../_assets/tests/" + filename)
 	require.NoError(t, err)
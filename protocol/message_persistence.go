@@ -8,6 +8,8 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
 )
@@ -36,6 +38,10 @@ var basicInsertDiscordMessageAuthorQuery = `INSERT OR REPLACE INTO discord_messa
 var cursor = "substr('0000000000000000000000000000000000000000000000000000000000000000' || m1.clock_value, -64, 64) || m1.id"
 var cursorField = cursor + " as cursor"
 
+// pinnedCursor orders pinned messages by their pin index first, then by message clock
+var pinnedCursor = "substr('0000000000000000000000000000000000000000000000000000000000000000' || pm.pin_index, -64, 64) || " + cursor
+var pinnedCursorField = pinnedCursor + " as cursor"
+
 func (db sqlitePersistence) buildMessagesQueryWithAdditionalFields(additionalSelectFields, whereAndTheRest string) string {
 	allFields := db.tableUserMessagesAllFieldsJoin()
 	if additionalSelectFields != "" {
@@ -76,11 +82,19 @@ func (db sqlitePersistence) tableUserMessagesAllFields() string {
 		audio_payload,
 		audio_type,
 		audio_duration_ms,
+		audio_waveform,
 		audio_base64,
+		video_type,
+		video_duration_ms,
+		video_width,
+		video_height,
+		video_thumbnail,
+		safe_transaction,
 		community_id,
 		mentions,
 		links,
 		unfurled_links,
+		unfurled_status_links,
 		command_id,
 		command_value,
 		command_from,
@@ -130,10 +144,18 @@ func (db sqlitePersistence) tableUserMessagesAllFieldsJoin() string {
 		COALESCE(m1.image_width, 0),
 		COALESCE(m1.image_height, 0),
 		COALESCE(m1.audio_duration_ms,0),
+		m1.audio_waveform,
+		COALESCE(m1.video_type, 0),
+		COALESCE(m1.video_duration_ms, 0),
+		COALESCE(m1.video_width, 0),
+		COALESCE(m1.video_height, 0),
+		m1.video_thumbnail,
+		m1.safe_transaction,
 		m1.community_id,
 		m1.mentions,
 		m1.links,
 		m1.unfurled_links,
+		m1.unfurled_status_links,
 		m1.command_id,
 		m1.command_value,
 		m1.command_from,
@@ -213,6 +235,7 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 	var serializedMentions []byte
 	var serializedLinks []byte
 	var serializedUnfurledLinks []byte
+	var serializedUnfurledStatusLinks []byte
 	var alias sql.NullString
 	var identicon sql.NullString
 	var communityID sql.NullString
@@ -224,11 +247,13 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 	var deletedForMe sql.NullBool
 	var contactRequestState sql.NullInt64
 	var contactVerificationState sql.NullInt64
+	var serializedSafeTransaction []byte
 
 	sticker := &protobuf.StickerMessage{}
 	command := &common.CommandParameters{}
 	audio := &protobuf.AudioMessage{}
 	image := &protobuf.ImageMessage{}
+	video := &protobuf.VideoMessage{}
 	discordMessage := &protobuf.DiscordMessage{
 		Author:      &protobuf.DiscordMessageAuthor{},
 		Reference:   &protobuf.DiscordMessageReference{},
@@ -265,10 +290,18 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 		&image.Width,
 		&image.Height,
 		&audio.DurationMs,
+		&audio.Waveform,
+		&video.Type,
+		&video.DurationMs,
+		&video.Width,
+		&video.Height,
+		&video.Thumbnail,
+		&serializedSafeTransaction,
 		&communityID,
 		&serializedMentions,
 		&serializedLinks,
 		&serializedUnfurledLinks,
+		&serializedUnfurledStatusLinks,
 		&command.ID,
 		&command.Value,
 		&command.From,
@@ -412,6 +445,13 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 		}
 	}
 
+	if serializedUnfurledStatusLinks != nil {
+		err = json.Unmarshal(serializedUnfurledStatusLinks, &message.UnfurledStatusLinks)
+		if err != nil {
+			return err
+		}
+	}
+
 	if attachment.Id != "" {
 		discordMessage.Attachments = append(discordMessage.Attachments, attachment)
 	}
@@ -429,6 +469,17 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 	case protobuf.ChatMessage_IMAGE:
 		message.Payload = &protobuf.ChatMessage_Image{Image: image}
 
+	case protobuf.ChatMessage_VIDEO:
+		message.Payload = &protobuf.ChatMessage_Video{Video: video}
+
+	case protobuf.ChatMessage_SAFE_TRANSACTION:
+		safeTransaction := &protobuf.SafeTransactionProposal{}
+		err = proto.Unmarshal(serializedSafeTransaction, safeTransaction)
+		if err != nil {
+			return err
+		}
+		message.Payload = &protobuf.ChatMessage_SafeTransaction{SafeTransaction: safeTransaction}
+
 	case protobuf.ChatMessage_DISCORD_MESSAGE:
 		message.Payload = &protobuf.ChatMessage_DiscordMessage{
 			DiscordMessage: discordMessage,
@@ -456,6 +507,11 @@ func (db sqlitePersistence) tableUserMessagesAllValues(message *common.Message)
 		audio = &protobuf.AudioMessage{}
 	}
 
+	video := message.GetVideo()
+	if video == nil {
+		video = &protobuf.VideoMessage{}
+	}
+
 	command := message.CommandParameters
 	if command == nil {
 		command = &common.CommandParameters{}
@@ -500,6 +556,22 @@ func (db sqlitePersistence) tableUserMessagesAllValues(message *common.Message)
 		}
 	}
 
+	var serializedUnfurledStatusLinks []byte
+	if links := message.GetUnfurledStatusLinks(); links != nil {
+		serializedUnfurledStatusLinks, err = json.Marshal(links)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var serializedSafeTransaction []byte
+	if safeTransaction := message.GetSafeTransaction(); safeTransaction != nil {
+		serializedSafeTransaction, err = proto.Marshal(safeTransaction)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return []interface{}{
 		message.ID,
 		message.WhisperTimestamp,
@@ -527,11 +599,19 @@ func (db sqlitePersistence) tableUserMessagesAllValues(message *common.Message)
 		audio.Payload,
 		audio.Type,
 		audio.DurationMs,
+		audio.Waveform,
 		message.Base64Audio,
+		video.Type,
+		video.DurationMs,
+		video.Width,
+		video.Height,
+		video.Thumbnail,
+		serializedSafeTransaction,
 		message.CommunityID,
 		serializedMentions,
 		serializedLinks,
 		serializedUnfurledLinks,
+		serializedUnfurledStatusLinks,
 		command.ID,
 		command.Value,
 		command.From,
@@ -575,8 +655,11 @@ func (db sqlitePersistence) messageByID(tx *sql.Tx, id string) (*common.Message,
 		}()
 	}
 
-	query := db.buildMessagesQuery("WHERE m1.id = ?")
-	rows, err := tx.Query(query, id)
+	stmt, err := db.cachedTxStmt(tx, db.buildMessagesQuery("WHERE m1.id = ?"))
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(id)
 	if err != nil {
 		return nil, err
 	}
@@ -603,7 +686,7 @@ func (db sqlitePersistence) MessageByCommandID(chatID, id string) (*common.Messa
 			ORDER BY m1.clock_value DESC
 			LIMIT 1`
 	query := db.buildMessagesQuery(where)
-	rows, err := db.db.Query(query, id, chatID)
+	rows, err := db.cachedQuery(query, id, chatID)
 	if err != nil {
 		return nil, err
 	}
@@ -729,6 +812,61 @@ func (db sqlitePersistence) MessageByChatID(chatID string, currCursor string, li
 	return result, newCursor, nil
 }
 
+// MessagesByChatIDInTimeRange returns the messages for a given chatID with a timestamp
+// in the [from, to] range, in ascending order. A zero `to` leaves the range unbounded.
+// The cursor is composed like in MessageByChatID, but it's used to page forward.
+func (db sqlitePersistence) MessagesByChatIDInTimeRange(chatID string, from uint64, to uint64, currCursor string, limit int) ([]*common.Message, string, error) {
+	args := []interface{}{chatID, from}
+	conditions := ""
+	if to != 0 {
+		conditions += " AND m1.timestamp <= ?"
+		args = append(args, to)
+	}
+	if currCursor != "" {
+		conditions += " AND cursor >= ?"
+		args = append(args, currCursor)
+	}
+
+	where := fmt.Sprintf(`
+            WHERE
+                NOT(m1.hide) AND NOT(m1.deleted) AND NOT(m1.deleted_for_me) AND m1.local_chat_id = ? AND m1.timestamp >= ? %s
+            ORDER BY cursor ASC
+            LIMIT ?`, conditions)
+
+	query := db.buildMessagesQueryWithAdditionalFields(cursorField, where)
+
+	rows, err := db.db.Query(
+		query,
+		append(args, limit+1)..., // take one more to figure our whether a cursor should be returned
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	result, _, err := getMessagesAndCursorsFromScanRows(db, rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Results are sorted by clock in descending order, restore the cursor order
+	sort.Slice(result, func(i, j int) bool {
+		return messageCursor(result[i]) < messageCursor(result[j])
+	})
+
+	var newCursor string
+	if len(result) > limit {
+		newCursor = messageCursor(result[limit])
+		result = result[:limit]
+	}
+	return result, newCursor, nil
+}
+
+// messageCursor builds the same value as the `cursor` column for a given message
+func messageCursor(message *common.Message) string {
+	return fmt.Sprintf("%064d", message.Clock) + message.ID
+}
+
 func (db sqlitePersistence) FirstUnseenMessageID(chatID string) (string, error) {
 	var id string
 	err := db.db.QueryRow(`
@@ -938,6 +1076,66 @@ func (db sqlitePersistence) AllMessageByChatIDWhichMatchTerm(chatID string, sear
 	return getMessagesFromScanRows(db, rows, true)
 }
 
+// messageSearchMatch is a message matching a full-text query, along with
+// the offsets and the matchinfo blob returned by the FTS index for the match
+type messageSearchMatch struct {
+	ID        string
+	Clock     uint64
+	Text      string
+	Offsets   string
+	MatchInfo []byte
+}
+
+// MessagesMatchingFullTextQuery returns at most limit visible messages whose text matches
+// the given FTS query, optionally restricted to a set of chats. The most recent matches
+// are returned first.
+func (db sqlitePersistence) MessagesMatchingFullTextQuery(query string, chatIDs []string, limit int) ([]*messageSearchMatch, error) {
+	args := []interface{}{query}
+	chatCond := ""
+	if len(chatIDs) > 0 {
+		chatCond = "AND m1.local_chat_id IN (?" + strings.Repeat(",?", len(chatIDs)-1) + ")"
+		for _, chatID := range chatIDs {
+			args = append(args, chatID)
+		}
+	}
+
+	rows, err := db.db.Query(fmt.Sprintf(`
+			SELECT
+				m1.id,
+				m1.clock_value,
+				m1.text,
+				offsets(user_messages_fts),
+				matchinfo(user_messages_fts, 'pcnalx')
+			FROM
+				user_messages_fts
+			JOIN
+				user_messages m1
+			ON
+				m1.rowid = user_messages_fts.docid
+			WHERE
+				user_messages_fts MATCH ?
+				AND NOT(m1.hide) AND NOT(m1.deleted) AND NOT(m1.deleted_for_me) %s
+			ORDER BY
+				m1.clock_value DESC
+			LIMIT ?`, chatCond), append(args, limit)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var matches []*messageSearchMatch
+	for rows.Next() {
+		match := &messageSearchMatch{}
+		err := rows.Scan(&match.ID, &match.Clock, &match.Text, &match.Offsets, &match.MatchInfo)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+
+	return matches, rows.Err()
+}
+
 // AllMessagesFromChatsAndCommunitiesWhichMatchTerm returns all messages which match the search
 // term, if they belong to either any chat from the chatIds array or any channel of any community
 // from communityIds array.
@@ -1006,6 +1204,23 @@ func (db sqlitePersistence) AllMessagesFromChatsAndCommunitiesWhichMatchTerm(com
 	return getMessagesFromScanRows(db, rows, true)
 }
 
+// CommunityMemberMessages returns the messages sent by a member in any
+// channel of a community which haven't been deleted yet
+func (db sqlitePersistence) CommunityMemberMessages(communityID string, memberID string) ([]*common.Message, error) {
+	where := `
+            WHERE
+                m1.source = ? AND NOT(m1.deleted) AND m1.local_chat_id IN (SELECT id FROM chats WHERE community_id = ?)
+            ORDER BY cursor DESC`
+
+	query := db.buildMessagesQueryWithAdditionalFields(cursorField, where)
+	rows, err := db.db.Query(query, memberID, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return getMessagesFromScanRows(db, rows, true)
+}
+
 func (db sqlitePersistence) AllChatIDsByCommunity(communityID string) ([]string, error) {
 	rows, err := db.db.Query("SELECT id FROM chats WHERE community_id = ?", communityID)
 
@@ -1048,8 +1263,36 @@ func (db sqlitePersistence) CountActiveChattersInCommunity(communityID string, a
 	return activeChattersCount, nil
 }
 
+// LatestMessageTimestampsByAuthor returns the whisper timestamp of the last
+// message of each author in a chat
+func (db sqlitePersistence) LatestMessageTimestampsByAuthor(chatID string) (map[string]uint64, error) {
+	rows, err := db.db.Query(`
+			SELECT source, MAX(whisper_timestamp)
+			FROM user_messages
+			WHERE local_chat_id = ?
+			AND NOT(hide) AND NOT(deleted) AND NOT(deleted_for_me)
+			GROUP BY source
+		`, chatID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	timestamps := make(map[string]uint64)
+	for rows.Next() {
+		var author string
+		var timestamp uint64
+		err = rows.Scan(&author, &timestamp)
+		if err != nil {
+			return nil, err
+		}
+		timestamps[author] = timestamp
+	}
+	return timestamps, rows.Err()
+}
+
 // PinnedMessageByChatID returns all pinned messages for a given chatID in descending order.
-// Ordering is accomplished using two concatenated values: ClockValue and ID.
+// Ordering is accomplished using three concatenated values: PinIndex, ClockValue and ID.
 // These two values are also used to compose a cursor which is returned to the result.
 func (db sqlitePersistence) PinnedMessageByChatIDs(chatIDs []string, currCursor string, limit int) ([]*common.PinnedMessage, string, error) {
 	cursorWhere := ""
@@ -1068,8 +1311,8 @@ func (db sqlitePersistence) PinnedMessageByChatIDs(chatIDs []string, currCursor
 	if limit > -1 {
 		args = append(args, limit+1) // take one more to figure our whether a cursor should be returned
 	}
-	// Build a new column `cursor` at the query time by having a fixed-sized clock value at the beginning
-	// concatenated with message ID. Results are sorted using this new column.
+	// Build a new column `cursor` at the query time by having a fixed-sized pin index and clock value
+	// at the beginning concatenated with message ID. Results are sorted using this new column.
 	// This new column values can also be returned as a cursor for subsequent requests.
 	allFields := db.tableUserMessagesAllFieldsJoin()
 	rows, err := db.db.Query(
@@ -1078,6 +1321,7 @@ func (db sqlitePersistence) PinnedMessageByChatIDs(chatIDs []string, currCursor
  				%s,
  				pm.clock_value as pinnedAt,
  				pm.pinned_by as pinnedBy,
+ 				pm.pin_index as pinIndex,
                                 %s
  			FROM
  				pin_messages pm
@@ -1124,7 +1368,7 @@ func (db sqlitePersistence) PinnedMessageByChatIDs(chatIDs []string, currCursor
  				AND NOT(m1.hide) AND m1.local_chat_id IN %s %s
  			ORDER BY cursor DESC
  			%s
- 		`, allFields, cursorField, "(?"+strings.Repeat(",?", len(chatIDs)-1)+")", cursorWhere, limitStr),
+ 		`, allFields, pinnedCursorField, "(?"+strings.Repeat(",?", len(chatIDs)-1)+")", cursorWhere, limitStr),
 		args..., // take one more to figure our whether a cursor should be returned
 	)
 	if err != nil {
@@ -1150,6 +1394,25 @@ func (db sqlitePersistence) PinnedMessageByChatID(chatID string, currCursor stri
 	return db.PinnedMessageByChatIDs([]string{chatID}, currCursor, limit)
 }
 
+// PinnedMessageIDsByChatID returns the IDs of the messages currently pinned in a chat
+func (db sqlitePersistence) PinnedMessageIDsByChatID(chatID string) ([]string, error) {
+	rows, err := db.db.Query(`SELECT message_id FROM pin_messages WHERE local_chat_id = ? AND pinned = 1 ORDER BY pin_index DESC`, chatID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
 // MessageByChatIDs returns all messages for a given chatIDs in descending order.
 // Ordering is accomplished using two concatenated values: ClockValue and ID.
 // These two values are also used to compose a cursor which is returned to the result.
@@ -1250,7 +1513,8 @@ func (db sqlitePersistence) EmojiReactionsByChatID(chatID string, currCursor str
 			    e.message_id,
 			    e.chat_id,
 			    e.local_chat_id,
-			    e.retracted
+			    e.retracted,
+			    e.community_emoji_id
 			FROM
 				emoji_reactions e
 			WHERE NOT(e.retracted)
@@ -1281,7 +1545,8 @@ func (db sqlitePersistence) EmojiReactionsByChatID(chatID string, currCursor str
 			&emojiReaction.MessageId,
 			&emojiReaction.ChatId,
 			&emojiReaction.LocalChatID,
-			&emojiReaction.Retracted)
+			&emojiReaction.Retracted,
+			&emojiReaction.CommunityEmojiId)
 		if err != nil {
 			return nil, err
 		}
@@ -1303,7 +1568,8 @@ func (db sqlitePersistence) EmojiReactionsByChatIDMessageID(chatID string, messa
 			    e.message_id,
 			    e.chat_id,
 			    e.local_chat_id,
-			    e.retracted
+			    e.retracted,
+			    e.community_emoji_id
 			FROM
 				emoji_reactions e
 			WHERE NOT(e.retracted)
@@ -1331,7 +1597,8 @@ func (db sqlitePersistence) EmojiReactionsByChatIDMessageID(chatID string, messa
 			&emojiReaction.MessageId,
 			&emojiReaction.ChatId,
 			&emojiReaction.LocalChatID,
-			&emojiReaction.Retracted)
+			&emojiReaction.Retracted,
+			&emojiReaction.CommunityEmojiId)
 		if err != nil {
 			return nil, err
 		}
@@ -1380,7 +1647,8 @@ func (db sqlitePersistence) EmojiReactionsByChatIDs(chatIDs []string, currCursor
 			    e.message_id,
 			    e.chat_id,
 			    e.local_chat_id,
-			    e.retracted
+			    e.retracted,
+			    e.community_emoji_id
 			FROM
 				emoji_reactions e
 			WHERE NOT(e.retracted)
@@ -1411,7 +1679,8 @@ func (db sqlitePersistence) EmojiReactionsByChatIDs(chatIDs []string, currCursor
 			&emojiReaction.MessageId,
 			&emojiReaction.ChatId,
 			&emojiReaction.LocalChatID,
-			&emojiReaction.Retracted)
+			&emojiReaction.Retracted,
+			&emojiReaction.CommunityEmojiId)
 		if err != nil {
 			return nil, err
 		}
@@ -1435,14 +1704,60 @@ func (db sqlitePersistence) SaveMessages(messages []*common.Message) (err error)
 		_ = tx.Rollback()
 	}()
 
+	return db.saveMessages(tx, messages)
+}
+
+// SaveReceivedData saves the chats, the messages and the contacts modified by
+// a batch of received messages in a single transaction
+func (db sqlitePersistence) SaveReceivedData(chats []*Chat, messages []*common.Message, contacts []*Contact) (err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, chat := range chats {
+		err = db.saveChat(tx, *chat)
+		if err != nil {
+			return
+		}
+	}
+
+	err = db.saveMessages(tx, messages)
+	if err != nil {
+		return
+	}
+
+	for _, contact := range contacts {
+		err = db.SaveContact(contact, tx)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (db sqlitePersistence) saveMessages(tx *sql.Tx, messages []*common.Message) (err error) {
+	if len(messages) == 0 {
+		return nil
+	}
+
 	allFields := db.tableUserMessagesAllFields()
 	valuesVector := strings.Repeat("?, ", db.tableUserMessagesAllFieldsCount()-1) + "?"
 	query := "INSERT INTO user_messages(" + allFields + ") VALUES (" + valuesVector + ")" // nolint: gosec
-	stmt, err := tx.Prepare(query)
+	stmt, err := db.cachedTxStmt(tx, query)
 	if err != nil {
 		return
 	}
 
+	var videoStmt *sql.Stmt
 	for _, msg := range messages {
 		var allValues []interface{}
 		allValues, err = db.tableUserMessagesAllValues(msg)
@@ -1454,6 +1769,21 @@ func (db sqlitePersistence) SaveMessages(messages []*common.Message) (err error)
 		if err != nil {
 			return
 		}
+
+		// Videos are stored apart, the payload isn't loaded with the messages
+		// and the stored one is kept when they're saved again
+		if video := msg.GetVideo(); video != nil && len(video.Payload) != 0 {
+			if videoStmt == nil {
+				videoStmt, err = db.cachedTxStmt(tx, `INSERT INTO message_videos(message_id, payload) VALUES (?, ?)`)
+				if err != nil {
+					return
+				}
+			}
+			_, err = videoStmt.Exec(msg.ID, video.Payload)
+			if err != nil {
+				return
+			}
+		}
 	}
 	return
 }
@@ -1476,7 +1806,7 @@ func (db sqlitePersistence) buildPinMessageQueries() (*insertPinMessagesQueries,
 	queries.selectStmt = "SELECT clock_value FROM pin_messages WHERE id = ?"
 
 	// insert
-	allInsertFields := `id, message_id, whisper_timestamp, chat_id, local_chat_id, clock_value, pinned, pinned_by`
+	allInsertFields := `id, message_id, whisper_timestamp, chat_id, local_chat_id, clock_value, pinned, pinned_by, pin_index`
 	insertValues := strings.Repeat("?, ", strings.Count(allInsertFields, ",")) + "?"
 	insertQuery := "INSERT INTO pin_messages(" + allInsertFields + ") VALUES (" + insertValues + ")" // nolint: gosec
 	insertStmt, err := tx.Prepare(insertQuery)
@@ -1486,7 +1816,7 @@ func (db sqlitePersistence) buildPinMessageQueries() (*insertPinMessagesQueries,
 	queries.insertStmt = insertStmt
 
 	// update
-	updateQuery := "UPDATE pin_messages SET pinned = ?, clock_value = ?, pinned_by = ? WHERE id = ?"
+	updateQuery := "UPDATE pin_messages SET pinned = ?, clock_value = ?, pinned_by = ?, pin_index = ? WHERE id = ?"
 	updateStmt, err := tx.Prepare(updateQuery)
 	if err != nil {
 		return nil, err
@@ -1539,6 +1869,7 @@ func (db sqlitePersistence) savePinMessage(message *common.PinMessage, queries *
 			message.Clock,
 			message.Pinned,
 			message.From,
+			message.PinIndex,
 		}
 		_, err = insertStmt.Exec(allValues...)
 		if err != nil {
@@ -1549,7 +1880,7 @@ func (db sqlitePersistence) savePinMessage(message *common.PinMessage, queries *
 		// found, update if current message is more recent, otherwise skip
 		if existingClock < message.Clock {
 			// update
-			_, err = updateStmt.Exec(message.Pinned, message.Clock, message.From, message.ID)
+			_, err = updateStmt.Exec(message.Pinned, message.Clock, message.From, message.PinIndex, message.ID)
 			if err != nil {
 				return
 			}
@@ -1601,6 +1932,45 @@ func (db sqlitePersistence) HideMessage(id string) error {
 	return err
 }
 
+// HideBlocklistedMessage hides a message whose author is blocklisted by the
+// community, it's shown again by UnhideBlocklistedMessages
+func (db sqlitePersistence) HideBlocklistedMessage(id string) error {
+	_, err := db.db.Exec(`UPDATE user_messages SET hide = 1, seen = 1, blocklisted = 1 WHERE id = ?`, id)
+	return err
+}
+
+// HideBlocklistedMessages hides the messages already stored in the chats of
+// the community whose authors are blocklisted. Messages hidden for other
+// reasons are left untouched, so that unhiding doesn't show them
+func (db sqlitePersistence) HideBlocklistedMessages(communityID string, blocklisted []string) error {
+	if len(blocklisted) == 0 {
+		return nil
+	}
+
+	args := []interface{}{communityID + "%"}
+	for _, publicKey := range blocklisted {
+		args = append(args, publicKey)
+	}
+	query := `UPDATE user_messages SET hide = 1, blocklisted = 1 WHERE NOT hide AND local_chat_id LIKE ? AND source IN (` + strings.Repeat("?, ", len(blocklisted)-1) + `?)`
+	_, err := db.db.Exec(query, args...) // nolint: gosec
+	return err
+}
+
+// UnhideBlocklistedMessages shows again the messages of the community hidden
+// because of the blocklist, except those of the authors still blocklisted
+func (db sqlitePersistence) UnhideBlocklistedMessages(communityID string, blocklisted []string) error {
+	args := []interface{}{communityID + "%"}
+	query := `UPDATE user_messages SET hide = 0, blocklisted = 0 WHERE blocklisted AND local_chat_id LIKE ?`
+	if len(blocklisted) > 0 {
+		query += ` AND source NOT IN (` + strings.Repeat("?, ", len(blocklisted)-1) + `?)`
+		for _, publicKey := range blocklisted {
+			args = append(args, publicKey)
+		}
+	}
+	_, err := db.db.Exec(query, args...) // nolint: gosec
+	return err
+}
+
 // SetHideOnMessage set the hide flag, but not the seen flag, as it's needed by the client to understand whether the count should be updated
 func (db sqlitePersistence) SetHideOnMessage(id string) error {
 	_, err := db.db.Exec(`UPDATE user_messages SET hide = 1 WHERE id = ?`, id)
@@ -2161,14 +2531,36 @@ func (db sqlitePersistence) SaveDiscordMessageAttachments(attachments []*protobu
 	return
 }
 
+// SaveEmojiReaction stores an emoji reaction and keeps the aggregated
+// counts of reactions for the message up to date
 func (db sqlitePersistence) SaveEmojiReaction(emojiReaction *EmojiReaction) (err error) {
-	query := "INSERT INTO emoji_reactions(id,clock_value,source,emoji_id,message_id,chat_id,local_chat_id,retracted) VALUES (?,?,?,?,?,?,?,?)"
-	stmt, err := db.db.Prepare(query)
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
 	if err != nil {
 		return
 	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
 
-	_, err = stmt.Exec(
+	var (
+		existingMessageID   string
+		existingLocalChatID string
+		existingRetracted   bool
+	)
+	err = tx.QueryRow(`SELECT message_id, local_chat_id, retracted FROM emoji_reactions WHERE id = ?`, emojiReaction.ID()).Scan(&existingMessageID, &existingLocalChatID, &existingRetracted)
+	exists := err == nil
+	if err != nil && err != sql.ErrNoRows {
+		return
+	}
+
+	query := "INSERT INTO emoji_reactions(id,clock_value,source,emoji_id,message_id,chat_id,local_chat_id,retracted,community_emoji_id) VALUES (?,?,?,?,?,?,?,?,?)"
+	_, err = tx.Exec(
+		query,
 		emojiReaction.ID(),
 		emojiReaction.Clock,
 		emojiReaction.From,
@@ -2177,11 +2569,128 @@ func (db sqlitePersistence) SaveEmojiReaction(emojiReaction *EmojiReaction) (err
 		emojiReaction.ChatId,
 		emojiReaction.LocalChatID,
 		emojiReaction.Retracted,
+		emojiReaction.CommunityEmojiId,
 	)
+	if err != nil {
+		return
+	}
+
+	if exists && !existingRetracted {
+		_, err = tx.Exec(`UPDATE emoji_reactions_counts SET count = count - 1 WHERE message_id = ? AND local_chat_id = ? AND emoji_id = ? AND community_emoji_id = ? AND count > 0`, existingMessageID, existingLocalChatID, emojiReaction.Type, emojiReaction.CommunityEmojiId)
+		if err != nil {
+			return
+		}
+	}
+
+	if !emojiReaction.Retracted {
+		_, err = tx.Exec(`INSERT INTO emoji_reactions_counts(message_id, local_chat_id, emoji_id, community_emoji_id, count) VALUES (?, ?, ?, ?, 1)
+			ON CONFLICT(message_id, local_chat_id, emoji_id, community_emoji_id) DO UPDATE SET count = count + 1`, emojiReaction.MessageId, emojiReaction.LocalChatID, emojiReaction.Type, emojiReaction.CommunityEmojiId)
+	}
 
 	return
 }
 
+// EmojiReactionsCountsByMessageID returns the number of reactions to a message
+// for each emoji, ordered by emoji
+func (db sqlitePersistence) EmojiReactionsCountsByMessageID(chatID string, messageID string) ([]*EmojiReactionCount, error) {
+	rows, err := db.db.Query(`SELECT emoji_id, community_emoji_id, count FROM emoji_reactions_counts WHERE local_chat_id = ? AND message_id = ? AND count > 0 ORDER BY emoji_id, community_emoji_id`, chatID, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*EmojiReactionCount
+	for rows.Next() {
+		count := &EmojiReactionCount{}
+		if err := rows.Scan(&count.EmojiID, &count.CommunityEmojiID, &count.Count); err != nil {
+			return nil, err
+		}
+		result = append(result, count)
+	}
+
+	return result, rows.Err()
+}
+
+// EmojiReactionsByMessageID returns the emoji reactions for the queried message in descending order.
+// Ordering and cursor are composed of the clock value and the ID of the reaction.
+func (db sqlitePersistence) EmojiReactionsByMessageID(chatID string, messageID string, currCursor string, limit int) ([]*EmojiReaction, string, error) {
+	reactionCursor := "substr('0000000000000000000000000000000000000000000000000000000000000000' || e.clock_value, -64, 64) || e.id"
+
+	cursorWhere := ""
+	args := []interface{}{chatID, messageID}
+	if currCursor != "" {
+		cursorWhere = fmt.Sprintf("AND %s <= ?", reactionCursor)
+		args = append(args, currCursor)
+	}
+	args = append(args, limit+1) // take one more to figure our whether a cursor should be returned
+
+	// nolint: gosec
+	query := fmt.Sprintf(`
+			SELECT
+			    e.clock_value,
+			    e.source,
+			    e.emoji_id,
+			    e.message_id,
+			    e.chat_id,
+			    e.local_chat_id,
+			    e.retracted,
+			    e.community_emoji_id,
+			    %s as cursor
+			FROM
+				emoji_reactions e
+			WHERE NOT(e.retracted)
+			AND
+			e.local_chat_id = ?
+			AND
+			e.message_id = ?
+			%s
+			ORDER BY cursor DESC
+			LIMIT ?`, reactionCursor, cursorWhere)
+
+	rows, err := db.db.Query(query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var (
+		result  []*EmojiReaction
+		cursors []string
+	)
+	for rows.Next() {
+		var (
+			emojiReaction EmojiReaction
+			cursor        string
+		)
+		err := rows.Scan(&emojiReaction.Clock,
+			&emojiReaction.From,
+			&emojiReaction.Type,
+			&emojiReaction.MessageId,
+			&emojiReaction.ChatId,
+			&emojiReaction.LocalChatID,
+			&emojiReaction.Retracted,
+			&emojiReaction.CommunityEmojiId,
+			&cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		result = append(result, &emojiReaction)
+		cursors = append(cursors, cursor)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var newCursor string
+	if len(result) > limit {
+		newCursor = cursors[limit]
+		result = result[:limit]
+	}
+
+	return result, newCursor, nil
+}
+
 func (db sqlitePersistence) EmojiReactionByID(id string) (*EmojiReaction, error) {
 	row := db.db.QueryRow(
 		`SELECT
@@ -2191,7 +2700,8 @@ func (db sqlitePersistence) EmojiReactionByID(id string) (*EmojiReaction, error)
 			    message_id,
 			    chat_id,
 			    local_chat_id,
-			    retracted
+			    retracted,
+			    community_emoji_id
 			FROM
 				emoji_reactions
 			WHERE
@@ -2206,6 +2716,7 @@ func (db sqlitePersistence) EmojiReactionByID(id string) (*EmojiReaction, error)
 		&emojiReaction.ChatId,
 		&emojiReaction.LocalChatID,
 		&emojiReaction.Retracted,
+		&emojiReaction.CommunityEmojiId,
 	)
 
 	switch err {
@@ -2483,6 +2994,63 @@ func (db sqlitePersistence) GetEdits(messageID string, from string) ([]*EditMess
 	return messages, nil
 }
 
+// SaveMessageEditRevision stores a previous version of a message, keeping
+// at most limit revisions for the message
+func (db sqlitePersistence) SaveMessageEditRevision(revision *MessageEditRevision, limit int) (err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`INSERT OR IGNORE INTO message_edits (message_id, clock, replaced_at, text, content_type) VALUES (?, ?, ?, ?, ?)`, revision.MessageID, revision.Clock, revision.ReplacedAt, revision.Text, revision.ContentType)
+	if err != nil {
+		return
+	}
+
+	_, err = tx.Exec(`DELETE FROM message_edits WHERE message_id = ? AND clock NOT IN (SELECT clock FROM message_edits WHERE message_id = ? ORDER BY clock DESC LIMIT ?)`, revision.MessageID, revision.MessageID, limit)
+	return
+}
+
+// MessageEditHistory returns the previous versions of a message, most recent first
+func (db sqlitePersistence) MessageEditHistory(messageID string) ([]*MessageEditRevision, error) {
+	rows, err := db.db.Query(`SELECT message_id, clock, replaced_at, text, content_type FROM message_edits WHERE message_id = ? ORDER BY clock DESC`, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*MessageEditRevision
+	for rows.Next() {
+		r := &MessageEditRevision{}
+		err := rows.Scan(&r.MessageID, &r.Clock, &r.ReplacedAt, &r.Text, &r.ContentType)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, r)
+	}
+	return revisions, rows.Err()
+}
+
+// TrimMessageEditHistory removes the oldest revisions of every message
+// so that at most limit revisions are kept for each of them
+func (db sqlitePersistence) TrimMessageEditHistory(limit int) error {
+	_, err := db.db.Exec(`
+		DELETE FROM message_edits WHERE rowid IN (
+			SELECT rowid FROM (
+				SELECT rowid, ROW_NUMBER() OVER (PARTITION BY message_id ORDER BY clock DESC) AS n FROM message_edits
+			) WHERE n > ?
+		)`, limit)
+	return err
+}
+
 func (db sqlitePersistence) clearHistory(chat *Chat, currentClockValue uint64, tx *sql.Tx, deactivate bool) error {
 	// Set deleted at clock value if it's not a public chat so that
 	// old messages will be discarded, or if it's a straight clear history
@@ -2667,9 +3235,10 @@ func getPinnedMessagesAndCursorsFromScanRows(db sqlitePersistence, rows *sql.Row
 			message  common.Message
 			pinnedAt uint64
 			pinnedBy string
+			pinIndex uint64
 			cursor   string
 		)
-		if err := db.tableUserMessagesScanAllFields(rows, &message, &pinnedAt, &pinnedBy, &cursor); err != nil {
+		if err := db.tableUserMessagesScanAllFields(rows, &message, &pinnedAt, &pinnedBy, &pinIndex, &cursor); err != nil {
 			return nil, nil, err
 		}
 		if msg, ok := messageIdx[message.ID]; !ok {
@@ -2677,6 +3246,7 @@ func getPinnedMessagesAndCursorsFromScanRows(db sqlitePersistence, rows *sql.Row
 				Message:  &message,
 				PinnedAt: pinnedAt,
 				PinnedBy: pinnedBy,
+				PinIndex: pinIndex,
 			}
 			messageIdx[message.ID] = pinnedMessage
 			messages = append(messages, pinnedMessage)
@@ -2686,7 +3256,27 @@ func getPinnedMessagesAndCursorsFromScanRows(db sqlitePersistence, rows *sql.Row
 		}
 	}
 
-	SortByClock(messages)
-
 	return messages, cursors, nil
 }
+
+// LastCommunityMessageTimestamps returns when each author last posted in the
+// chats of the community, in milliseconds
+func (db sqlitePersistence) LastCommunityMessageTimestamps(communityID string) (map[string]uint64, error) {
+	rows, err := db.db.Query(`SELECT source, MAX(whisper_timestamp) FROM user_messages WHERE local_chat_id LIKE ? AND NOT(hide) GROUP BY source`, communityID+"%")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	timestamps := make(map[string]uint64)
+	for rows.Next() {
+		var source string
+		var timestamp sql.NullInt64
+		err := rows.Scan(&source, &timestamp)
+		if err != nil {
+			return nil, err
+		}
+		timestamps[source] = uint64(timestamp.Int64)
+	}
+	return timestamps, rows.Err()
+}
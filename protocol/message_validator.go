@@ -6,12 +6,16 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/v1"
+	"github.com/status-im/status-go/protocol/video"
 )
 
 const maxChatMessageTextLength = 4096
 const maxStatusMessageText = 128
+const maxAudioWaveformLength = 256
+const maxVideoThumbnailSize = 64 * 1024
 
 // maxWhisperDrift is how many milliseconds we allow the clock value to differ
 // from whisperTimestamp
@@ -98,6 +102,37 @@ func ValidateDeleteForMeMessage(message protobuf.DeleteForMeMessage) error {
 	return nil
 }
 
+func ValidateDeleteCommunityMemberMessages(message protobuf.DeleteCommunityMemberMessages) error {
+	if message.Clock == 0 {
+		return errors.New("clock can't be 0")
+	}
+	if len(message.CommunityId) == 0 {
+		return errors.New("community-id can't be empty")
+	}
+	if len(message.MemberId) == 0 {
+		return errors.New("member-id can't be empty")
+	}
+
+	return nil
+}
+
+func ValidateCommunityBan(message protobuf.CommunityBan) error {
+	if message.Clock == 0 {
+		return errors.New("clock can't be 0")
+	}
+	if len(message.CommunityId) == 0 {
+		return errors.New("community-id can't be empty")
+	}
+	if len(message.MemberId) == 0 {
+		return errors.New("member-id can't be empty")
+	}
+	if len(message.ModeratorId) == 0 {
+		return errors.New("moderator-id can't be empty")
+	}
+
+	return nil
+}
+
 func ValidateReceivedPairInstallation(message *protobuf.PairInstallation, whisperTimestamp uint64) error {
 	if err := validateClockValue(message.Clock, whisperTimestamp); err != nil {
 		return err
@@ -233,7 +268,7 @@ func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp
 		return errors.New("timestamp can't be 0")
 	}
 
-	if message.ContentType != protobuf.ChatMessage_DISCORD_MESSAGE && (message.ContentType != protobuf.ChatMessage_IMAGE || message.Text != "") {
+	if message.ContentType != protobuf.ChatMessage_DISCORD_MESSAGE && ((message.ContentType != protobuf.ChatMessage_IMAGE && message.ContentType != protobuf.ChatMessage_VIDEO) || message.Text != "") {
 		if err := ValidateText(message.Text); err != nil {
 			return err
 		}
@@ -274,12 +309,60 @@ func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp
 		if image == nil {
 			return errors.New("no image content")
 		}
-		if len(image.Payload) == 0 {
+		// Large images are sent in chunks after the message
+		if image.Attachment != nil {
+			if len(image.Payload) != 0 {
+				return errors.New("image with both payload and attachment")
+			}
+			if err := common.ValidateAttachmentDescriptor(image.Attachment); err != nil {
+				return err
+			}
+		} else if len(image.Payload) == 0 {
 			return errors.New("image payload empty")
 		}
 		if image.Type == protobuf.ImageType_UNKNOWN_IMAGE_TYPE {
 			return errors.New("image type unknown")
 		}
+
+	case protobuf.ChatMessage_VIDEO:
+		videoMessage := message.GetVideo()
+		if videoMessage == nil {
+			return errors.New("no video content")
+		}
+		if videoMessage.Type == protobuf.VideoMessage_UNKNOWN_VIDEO_TYPE {
+			return errors.New("video type unknown")
+		}
+		// Large videos are sent in chunks after the message, their type is
+		// checked once they're assembled
+		if videoMessage.Attachment != nil {
+			if len(videoMessage.Payload) != 0 {
+				return errors.New("video with both payload and attachment")
+			}
+			if err := common.ValidateAttachmentDescriptor(videoMessage.Attachment); err != nil {
+				return err
+			}
+		} else if len(videoMessage.Payload) == 0 {
+			return errors.New("video payload empty")
+		} else if video.Type(videoMessage.Payload) != videoMessage.Type {
+			return errors.New("video type mismatch")
+		}
+		if len(videoMessage.Thumbnail) > maxVideoThumbnailSize {
+			return errors.New("video thumbnail too large")
+		}
+		if len(videoMessage.Thumbnail) != 0 {
+			if err := video.ValidateThumbnail(videoMessage.Thumbnail); err != nil {
+				return err
+			}
+		}
+
+	case protobuf.ChatMessage_SAFE_TRANSACTION:
+		proposal := message.GetSafeTransaction()
+		if proposal == nil {
+			return errors.New("no safe transaction content")
+		}
+		if _, err := safeTransactionFromProposal(proposal); err != nil {
+			return err
+		}
 	}
 
 	if message.ContentType == protobuf.ChatMessage_AUDIO {
@@ -297,6 +380,10 @@ func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp
 		if audio.Type == protobuf.AudioMessage_UNKNOWN_AUDIO_TYPE {
 			return errors.New("audio type unknown")
 		}
+
+		if len(audio.Waveform) > maxAudioWaveformLength {
+			return errors.New("audio waveform too long")
+		}
 	}
 
 	if message.ContentType == protobuf.ChatMessage_SYSTEM_MESSAGE_CONTENT_PRIVATE_GROUP {
@@ -331,6 +418,10 @@ func ValidateReceivedEmojiReaction(emoji *protobuf.EmojiReaction, whisperTimesta
 		return errors.New("unknown emoji reaction type")
 	}
 
+	if (emoji.Type == protobuf.EmojiReaction_CUSTOM) != (len(emoji.CommunityEmojiId) != 0) {
+		return errors.New("community-emoji-id has to be set for custom emoji reactions only")
+	}
+
 	if emoji.MessageType == protobuf.MessageType_UNKNOWN_MESSAGE_TYPE {
 		return errors.New("unknown message type")
 	}
@@ -338,6 +429,54 @@ func ValidateReceivedEmojiReaction(emoji *protobuf.EmojiReaction, whisperTimesta
 	return nil
 }
 
+func ValidateReceivedSafeTransactionSignature(signature *protobuf.SafeTransactionSignature, whisperTimestamp uint64) error {
+	if err := validateClockValue(signature.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(signature.MessageId) == 0 {
+		return errors.New("message-id can't be empty")
+	}
+
+	if len(signature.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if len(signature.SafeTxHash) != 32 {
+		return errors.New("invalid safe-tx-hash")
+	}
+
+	if signature.MessageType == protobuf.MessageType_UNKNOWN_MESSAGE_TYPE {
+		return errors.New("unknown message type")
+	}
+
+	return nil
+}
+
+func ValidateReceivedSafeTransactionExecution(execution *protobuf.SafeTransactionExecution, whisperTimestamp uint64) error {
+	if err := validateClockValue(execution.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+
+	if len(execution.MessageId) == 0 {
+		return errors.New("message-id can't be empty")
+	}
+
+	if len(execution.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+
+	if len(execution.TransactionHash) == 0 {
+		return errors.New("transaction-hash can't be empty")
+	}
+
+	if execution.MessageType == protobuf.MessageType_UNKNOWN_MESSAGE_TYPE {
+		return errors.New("unknown message type")
+	}
+
+	return nil
+}
+
 func ValidateReceivedGroupChatInvitation(invitation *protobuf.GroupChatInvitation) error {
 
 	if len(invitation.ChatId) == 0 {
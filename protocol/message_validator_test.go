@@ -1,6 +1,9 @@
 package protocol
 
 import (
+	"bytes"
+	"image"
+	"image/jpeg"
 	"strings"
 	"testing"
 
@@ -86,6 +89,15 @@ func (s *MessageValidatorSuite) TestValidateRequestAddressForTransaction() {
 
 }
 
+// testVideoPayload is the start of an MP4 file
+var testVideoPayload = []byte("\x00\x00\x00\x14ftypisom\x00\x00\x00\x00")
+
+func testVideoThumbnail(width, height int) []byte {
+	bb := bytes.NewBuffer(nil)
+	_ = jpeg.Encode(bb, image.NewRGBA(image.Rect(0, 0, width, height)), nil)
+	return bb.Bytes()
+}
+
 func (s *MessageValidatorSuite) TestValidatePlainTextMessage() {
 	testCases := []struct {
 		Name             string
@@ -480,6 +492,118 @@ func (s *MessageValidatorSuite) TestValidatePlainTextMessage() {
 				ContentType: protobuf.ChatMessage_AUDIO,
 			},
 		},
+		{
+			Name:             "Valid video message",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Video{
+					Video: &protobuf.VideoMessage{
+						Type:      protobuf.VideoMessage_MP4,
+						Payload:   testVideoPayload,
+						Thumbnail: testVideoThumbnail(320, 180),
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_VIDEO,
+			},
+		},
+		{
+			Name:             "Invalid video message, type unknown",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Video{
+					Video: &protobuf.VideoMessage{
+						Type:    protobuf.VideoMessage_UNKNOWN_VIDEO_TYPE,
+						Payload: testVideoPayload,
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_VIDEO,
+			},
+		},
+		{
+			Name:             "Invalid video message, thumbnail too large",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Video{
+					Video: &protobuf.VideoMessage{
+						Type:      protobuf.VideoMessage_MP4,
+						Payload:   testVideoPayload,
+						Thumbnail: make([]byte, maxVideoThumbnailSize+1),
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_VIDEO,
+			},
+		},
+		{
+			Name:             "Invalid video message, type mismatch",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Video{
+					Video: &protobuf.VideoMessage{
+						Type:    protobuf.VideoMessage_WEBM,
+						Payload: testVideoPayload,
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_VIDEO,
+			},
+		},
+		{
+			Name:             "Invalid video message, thumbnail isn't a JPEG",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Video{
+					Video: &protobuf.VideoMessage{
+						Type:      protobuf.VideoMessage_MP4,
+						Payload:   testVideoPayload,
+						Thumbnail: []byte("some-thumbnail"),
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_VIDEO,
+			},
+		},
+		{
+			Name:             "Invalid video message, thumbnail too wide",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: protobuf.ChatMessage{
+				ChatId:    "a",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Video{
+					Video: &protobuf.VideoMessage{
+						Type:      protobuf.VideoMessage_MP4,
+						Payload:   testVideoPayload,
+						Thumbnail: testVideoThumbnail(1920, 1080),
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_VIDEO,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
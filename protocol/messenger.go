@@ -8,12 +8,12 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"math"
 	"math/rand"
 	"os"
 	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
@@ -49,11 +49,11 @@ import (
 	"github.com/status-im/status-go/protocol/identity"
 	"github.com/status-im/status-go/protocol/identity/alias"
 	"github.com/status-im/status-go/protocol/identity/identicon"
-	"github.com/status-im/status-go/protocol/linkpreview"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/pushnotificationclient"
 	"github.com/status-im/status-go/protocol/pushnotificationserver"
 	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/socialrecovery"
 	"github.com/status-im/status-go/protocol/sqlite"
 	"github.com/status-im/status-go/protocol/transport"
 	"github.com/status-im/status-go/protocol/verification"
@@ -66,6 +66,7 @@ import (
 	"github.com/status-im/status-go/services/wallet/token"
 	"github.com/status-im/status-go/signal"
 	"github.com/status-im/status-go/telemetry"
+	"github.com/status-im/status-go/transactions"
 )
 
 // todo: kozieiev: get rid of wakutransp word
@@ -83,6 +84,9 @@ const (
 const messageResendMinDelay = 30
 const messageResendMaxCount = 3
 
+// messageReactionsPageSize is the number of reactions returned by GetMessageReactions
+const messageReactionsPageSize = 100
+
 var communityAdvertiseIntervalSecond int64 = 60 * 60
 
 // messageCacheIntervalMs is how long we should keep processed messages in the cache, in ms
@@ -129,6 +133,7 @@ type Messenger struct {
 	modifiedInstallations      *stringBoolMap
 	installationID             string
 	mailserverCycle            mailserverCycle
+	mailserverQueryStats       *mailserverQueryStats
 	database                   *sql.DB
 	multiAccounts              *multiaccounts.Database
 	mailservers                *mailserversDB.Database
@@ -155,13 +160,45 @@ type Messenger struct {
 	requestedContactsLock sync.RWMutex
 	requestedContacts     map[string]*transport.Filter
 
+	// IDs of the communities we own whose full description was requested by
+	// members who missed a delta
+	communityDescriptionRequests chan string
+
+	// When we last requested the full description of each community after
+	// missing a delta
+	requestedCommunityDescriptionsLock sync.Mutex
+	requestedCommunityDescriptions     map[string]time.Time
+
+	// pendingMemberMessagesDeletions are the deletions of the messages of
+	// members not banned yet in the description we have, by the moderator
+	// that requested them
+	pendingMemberMessagesDeletionsLock sync.Mutex
+	pendingMemberMessagesDeletions     map[string]string
+
+	// Wakes up the checks of the Safe transaction executions received
+	unverifiedSafeTransactionExecutions chan struct{}
+
+	notificationKeywords notificationKeywordMatcher
+
+	contactRequestsThrottle contactRequestsThrottle
+
 	connectionState                      connection.State
 	telemetryClient                      *telemetry.Client
+	latencyTracker                       *telemetry.LatencyTracker
+	latencyTelemetryEnabled              atomic.Bool
+	lastHistoryQuery                     atomic.Int64
+	lastSyncMessage                      atomic.Int64
+	retentionMutex                       sync.Mutex
 	contractMaker                        *contracts.ContractMaker
 	downloadHistoryArchiveTasksWaitGroup sync.WaitGroup
 	verificationDatabase                 *verification.Persistence
+	socialRecoveryDatabase               *socialrecovery.Persistence
 	savedAddressesManager                *wallet.SavedAddressesManager
+	tokenManager                         *token.Manager
 	walletAPI                            *wallet.API
+	walletFeed                           *event.Feed
+	transactor                           *transactions.Transactor
+	primaryNames                         *ensservice.PrimaryNames
 
 	// TODO(samyoul) Determine if/how the remaining usage of this mutex can be removed
 	mutex                     sync.Mutex
@@ -169,6 +206,9 @@ type Messenger struct {
 	handleMessagesMutex       sync.Mutex
 	handleImportMessagesMutex sync.Mutex
 
+	// read states waiting to be synced to the paired devices
+	readStateQueue readStateQueue
+
 	// flag to disable checking #hasPairedDevices
 	localPairing bool
 }
@@ -426,13 +466,33 @@ func NewMessenger(
 		communities.WithAccountManager(accountsManager),
 	}
 
+	// The wallet's token manager is shared, so that both see the same custom
+	// tokens. One is only built when neither the wallet nor the tests provide it
+	var tokenManager *token.Manager
+	if c.walletService != nil {
+		tokenManager = c.walletService.GetTokenManager()
+	} else if c.tokenManager == nil && c.rpcClient != nil {
+		tokenManager = token.NewTokenManager(database, c.rpcClient, c.rpcClient.NetworkManager)
+	}
+
 	if c.tokenManager != nil {
 		managerOptions = append(managerOptions, communities.WithTokenManager(c.tokenManager))
-	} else if c.rpcClient != nil {
-		tokenManager := token.NewTokenManager(database, c.rpcClient, c.rpcClient.NetworkManager)
+	} else if tokenManager != nil {
 		managerOptions = append(managerOptions, communities.WithTokenManager(communities.NewDefaultTokenManager(tokenManager)))
 	}
 
+	if c.paymentVerifier != nil {
+		managerOptions = append(managerOptions, communities.WithPaymentVerifier(c.paymentVerifier))
+	} else if c.rpcClient != nil {
+		managerOptions = append(managerOptions, communities.WithPaymentVerifier(communities.NewDefaultPaymentVerifier(c.rpcClient)))
+	}
+
+	if c.soulboundVerifier != nil {
+		managerOptions = append(managerOptions, communities.WithSoulboundTokenVerifier(c.soulboundVerifier))
+	} else if c.rpcClient != nil {
+		managerOptions = append(managerOptions, communities.WithSoulboundTokenVerifier(communities.NewDefaultSoulboundTokenVerifier(c.rpcClient)))
+	}
+
 	if c.walletConfig != nil {
 		managerOptions = append(managerOptions, communities.WithWalletConfig(c.walletConfig))
 	}
@@ -464,6 +524,7 @@ func NewMessenger(
 		anonMetricsClient:          anonMetricsClient,
 		anonMetricsServer:          anonMetricsServer,
 		telemetryClient:            telemetryClient,
+		latencyTracker:             telemetry.NewLatencyTracker(),
 		pushNotificationClient:     pushNotificationClient,
 		pushNotificationServer:     pushNotificationServer,
 		communitiesManager:         communitiesManager,
@@ -482,11 +543,13 @@ func NewMessenger(
 		settings:                   settings,
 		peerStore:                  peerStore,
 		verificationDatabase:       verification.NewPersistence(database),
+		socialRecoveryDatabase:     socialrecovery.NewPersistence(database),
 		mailservers:                mailservers,
 		mailserverCycle: mailserverCycle{
 			peers:                     make(map[string]peerStatus),
 			availabilitySubscriptions: make([]chan struct{}, 0),
 		},
+		mailserverQueryStats:     newMailserverQueryStats(),
 		mailserversDatabase:      c.mailserversDatabase,
 		account:                  c.account,
 		quit:                     make(chan struct{}),
@@ -502,8 +565,12 @@ func NewMessenger(
 			wait chan struct{}
 			once sync.Once
 		}{wait: make(chan struct{})},
-		browserDatabase: c.browserDatabase,
-		httpServer:      c.httpServer,
+		communityDescriptionRequests:        make(chan string, 100),
+		requestedCommunityDescriptions:      make(map[string]time.Time),
+		pendingMemberMessagesDeletions:      make(map[string]string),
+		unverifiedSafeTransactionExecutions: make(chan struct{}, 1),
+		browserDatabase:                     c.browserDatabase,
+		httpServer:                          c.httpServer,
 		contractMaker: &contracts.ContractMaker{
 			RPCClient: c.rpcClient,
 		},
@@ -518,15 +585,24 @@ func NewMessenger(
 			// Currently this often fails, seems like it's safe to ignore them
 			// https://github.com/uber-go/zap/issues/328
 			func() error { _ = logger.Sync; return nil },
+			sqlitePersistence.stmts.close,
 			database.Close,
 		},
 		logger:                logger,
 		savedAddressesManager: savedAddressesManager,
+		tokenManager:          tokenManager,
 	}
 	messenger.mentionsManager = NewMentionManager(messenger)
+	messenger.verificationDatabase.SetTransitionHandler(messenger.verificationRequestTransitioned)
+
+	if c.rpcClient != nil {
+		messenger.primaryNames = ensservice.NewPrimaryNames(c.rpcClient, ensservice.NewEnsDatabase(database), time.Now)
+	}
 
 	if c.walletService != nil {
 		messenger.walletAPI = wallet.NewAPI(c.walletService)
+		messenger.walletFeed = c.walletService.GetFeed()
+		messenger.transactor = c.walletService.GetTransactor()
 	}
 
 	if c.outputMessagesCSV {
@@ -596,73 +672,17 @@ func (m *Messenger) processSentMessages(ids []string) error {
 		if err != nil {
 			return err
 		}
-	}
-
-	return nil
-}
-
-func shouldResendMessage(message *common.RawMessage, t common.TimeSource) (bool, error) {
-	if !(message.MessageType == protobuf.ApplicationMetadataMessage_EMOJI_REACTION ||
-		message.MessageType == protobuf.ApplicationMetadataMessage_CHAT_MESSAGE) {
-		return false, errors.Errorf("Should resend only specific types of messages, can't resend %v", message.MessageType)
-	}
-
-	if message.Sent {
-		return false, errors.New("Should resend only non-sent messages")
-	}
-
-	if message.SendCount > messageResendMaxCount {
-		return false, nil
-	}
-
-	//exponential backoff depends on how many attempts to send message already made
-	backoff := uint64(math.Pow(2, float64(message.SendCount-1))) * messageResendMinDelay * uint64(time.Second.Milliseconds())
-	backoffElapsed := t.GetCurrentTime() > (message.LastSent + backoff)
-	return backoffElapsed, nil
-}
-
-func (m *Messenger) resendExpiredMessages() error {
-	if m.connectionState.Offline {
-		return errors.New("offline")
-	}
-
-	ids, err := m.persistence.ExpiredMessagesIDs(messageResendMaxCount)
-	if err != nil {
-		return errors.Wrapf(err, "Can't get expired reactions from db")
-	}
-
-	for _, id := range ids {
-		rawMessage, err := m.persistence.RawMessageByID(id)
-		if err != nil {
-			return errors.Wrapf(err, "Can't get raw message with id %v", id)
-		}
-
-		chat, ok := m.allChats.Load(rawMessage.LocalChatID)
-		if !ok {
-			return ErrChatNotFound
-		}
 
-		if !(chat.Public() || chat.CommunityChat()) {
-			return errors.New("Only public chats and community chats messages are resent")
-		}
-
-		ok, err = shouldResendMessage(rawMessage, m.getTimesource())
+		err = m.persistence.MarkOutboxMessageSent(id, m.getTimesource().GetCurrentTime())
 		if err != nil {
 			return err
 		}
 
-		if ok {
-			err = m.persistence.SaveRawMessage(rawMessage)
-			if err != nil {
-				return errors.Wrapf(err, "Can't save raw message marked as non-expired")
-			}
-
-			err = m.reSendRawMessage(context.Background(), rawMessage.ID)
-			if err != nil {
-				return errors.Wrapf(err, "Can't resend expired message with id %v", rawMessage.ID)
-			}
+		if m.latencyTelemetryEnabled.Load() {
+			m.latencyTracker.MessageConfirmed(id, time.Now())
 		}
 	}
+
 	return nil
 }
 
@@ -739,7 +759,18 @@ func (m *Messenger) Start() (*MessengerResponse, error) {
 	m.watchExpiredMessages()
 	m.watchIdentityImageChanges()
 	m.watchWalletBalances()
+	m.watchWatchOnlyActivity()
+	m.watchCustomTokenChanges()
+	m.watchSafeTransactionExecutions()
+	m.watchUnverifiedSafeTransactionExecutions()
+	m.watchPrimaryNames()
 	m.watchPendingCommunityRequestToJoin()
+	m.watchBandwidthStats()
+	m.watchMessageRetention()
+	m.deleteOrphanedAttachmentChunks()
+	m.watchExpiredVerificationRequests()
+	m.watchReadStateQueue()
+	m.startLatencyTelemetryLoop()
 	m.broadcastLatestUserStatus()
 	m.timeoutAutomaticStatusUpdates()
 	m.startBackupLoop()
@@ -765,7 +796,7 @@ func (m *Messenger) Start() (*MessengerResponse, error) {
 		return nil, err
 	}
 
-	if m.torrentClientReady() {
+	if m.historyArchivesReady() {
 		adminCommunities, err := m.communitiesManager.Created()
 		if err == nil && len(adminCommunities) > 0 {
 			available := m.SubscribeMailserverAvailable()
@@ -1312,6 +1343,9 @@ func (m *Messenger) handleENSVerified(records []*ens.VerificationRecord) {
 	var contacts []*Contact
 	for _, record := range records {
 		m.logger.Info("handling record", zap.Any("record", record))
+		// The details of users who aren't contacts carry their ENS name too
+		m.allContacts.details.invalidate(record.PublicKey)
+
 		contact, ok := m.allContacts.Load(record.PublicKey)
 		if !ok {
 			m.logger.Info("contact not found")
@@ -1389,6 +1423,7 @@ func (m *Messenger) watchChatsAndCommunitiesToUnmute() {
 			select {
 			case <-time.After(3 * time.Second): // Poll every 3 seconds
 				response := &MessengerResponse{}
+				unmuted := false
 				m.allChats.Range(func(chatID string, c *Chat) bool {
 					chatMuteTill, _ := time.Parse(time.RFC3339, c.MuteTill.Format(time.RFC3339))
 					currTime, _ := time.Parse(time.RFC3339, time.Now().Format(time.RFC3339))
@@ -1402,9 +1437,18 @@ func (m *Messenger) watchChatsAndCommunitiesToUnmute() {
 						c.Muted = false
 						c.MuteTill = time.Time{}
 						response.AddChat(c)
+						unmuted = true
 					}
 					return true
 				})
+				if unmuted {
+					// The expired mutes are no longer sent to the push
+					// notification servers
+					err := m.reregisterForPushNotifications()
+					if err != nil {
+						m.logger.Warn("failed to re-register for push notifications", zap.Error(err))
+					}
+				}
 				err := m.CheckCommunitiesToUnmute(response)
 				if err != nil {
 					m.logger.Info("err", zap.Any("", err))
@@ -1878,6 +1922,12 @@ func (m *Messenger) reSendRawMessage(ctx context.Context, messageID string) erro
 
 // ReSendChatMessage pulls a message from the database and sends it again
 func (m *Messenger) ReSendChatMessage(ctx context.Context, messageID string) error {
+	// Sending again manually restarts the retry schedule
+	err := m.persistence.DeleteOutboxMessage(messageID)
+	if err != nil {
+		return err
+	}
+
 	return m.reSendRawMessage(ctx, messageID)
 }
 
@@ -1994,11 +2044,17 @@ func (m *Messenger) dispatchMessage(ctx context.Context, rawMessage common.RawMe
 		}
 
 		logger.Debug("sending community chat message", zap.String("chatName", chat.Name))
-		isEncrypted, err := m.communitiesManager.IsEncrypted(chat.CommunityID)
+		community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
 		if err != nil {
 			return rawMessage, err
 		}
-		if !isEncrypted {
+		if community == nil {
+			return rawMessage, communities.ErrOrgNotFound
+		}
+		// Large messages are compressed when all the members advertised
+		// that they can decompress them
+		rawMessage.Compress = community.MembersSupport(protobuf.CommunityMember_CAPABILITY_COMPRESSION)
+		if !community.Encrypted() {
 			id, err = m.sender.SendPublic(ctx, chat.ID, rawMessage)
 		} else {
 			rawMessage.CommunityID, err = types.DecodeHex(chat.CommunityID)
@@ -2060,6 +2116,17 @@ func (m *Messenger) dispatchMessage(ctx context.Context, rawMessage common.RawMe
 		return rawMessage, err
 	}
 
+	if m.latencyTelemetryEnabled.Load() {
+		m.latencyTracker.MessageSent(rawMessage.ID, time.Now())
+	}
+
+	if shouldQueueInOutbox(chat, &rawMessage) {
+		err = m.queueInOutbox(&rawMessage)
+		if err != nil {
+			return rawMessage, err
+		}
+	}
+
 	return rawMessage, nil
 }
 
@@ -2142,6 +2209,16 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 		if err != nil {
 			return nil, err
 		}
+	} else if len(message.VideoPath) != 0 {
+		err := message.LoadVideo(m.videoExtractor())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = m.unfurlMessageLinks(message)
+	if err != nil {
+		m.logger.Error("failed to unfurl message links", zap.Error(err))
 	}
 
 	unfurledLinks, err := message.ConvertLinkPreviewsToProto()
@@ -2153,6 +2230,15 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 		message.UnfurledLinks = unfurledLinks
 	}
 
+	m.unfurlMessageStatusLinks(message)
+
+	unfurledStatusLinks, err := message.ConvertStatusLinkPreviewsToProto()
+	if err != nil {
+		m.logger.Error("failed to convert status link previews", zap.Error(err))
+	} else {
+		message.UnfurledStatusLinks = unfurledStatusLinks
+	}
+
 	var response MessengerResponse
 
 	// A valid added chat is required.
@@ -2161,6 +2247,15 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 		return nil, errors.New("Chat not found")
 	}
 
+	err = m.checkOutgoingMessage(chat, message.Text, message.ConfirmedFilterWarnings)
+	if err != nil {
+		return nil, err
+	}
+
+	if displayName := m.communityDisplayName(chat); displayName != "" {
+		message.DisplayName = displayName
+	}
+
 	err = m.handleStandaloneChatIdentity(chat)
 	if err != nil {
 		return nil, err
@@ -2176,7 +2271,14 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 		return nil, err
 	}
 
+	// Large images and videos are sent in chunks after the message
+	attachmentChunks, restoreAttachment, err := m.splitAttachment(message)
+	if err != nil {
+		return nil, err
+	}
+
 	encodedMessage, err := m.encodeChatEntity(chat, message)
+	restoreAttachment()
 	if err != nil {
 		return nil, err
 	}
@@ -2209,6 +2311,11 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 			return err
 		}
 
+		err = m.saveSentAttachment(message)
+		if err != nil {
+			return err
+		}
+
 		return m.persistence.SaveMessages([]*common.Message{message})
 	}
 
@@ -2217,6 +2324,8 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 		return nil, err
 	}
 
+	m.sendAttachmentChunks(ctx, chat, attachmentChunks)
+
 	msg, err := m.pullMessagesAndResponsesFromDB([]*common.Message{message})
 	if err != nil {
 		return nil, err
@@ -2527,6 +2636,11 @@ func (m *Messenger) SyncDevices(ctx context.Context, ensName, photoPath string,
 		}
 	}
 
+	err = m.syncAccountsPositions(rawMessageHandler)
+	if err != nil {
+		return err
+	}
+
 	savedAddresses, err := m.savedAddressesManager.GetRawSavedAddresses()
 	if err != nil {
 		return err
@@ -2541,6 +2655,10 @@ func (m *Messenger) SyncDevices(ctx context.Context, ensName, photoPath string,
 		}
 	}
 
+	if err = m.syncCustomTokens(ctx, rawMessageHandler); err != nil {
+		return err
+	}
+
 	if err = m.syncEnsUsernameDetails(ctx, rawMessageHandler); err != nil {
 		return err
 	}
@@ -2549,7 +2667,19 @@ func (m *Messenger) SyncDevices(ctx context.Context, ensName, photoPath string,
 		return err
 	}
 
-	return m.syncSocialLinks(context.Background(), rawMessageHandler)
+	if err = m.syncSocialLinks(context.Background(), rawMessageHandler); err != nil {
+		return err
+	}
+
+	if err = m.syncNotificationRules(ctx, rawMessageHandler); err != nil {
+		return err
+	}
+
+	if err = m.syncChatDrafts(ctx, rawMessageHandler); err != nil {
+		return err
+	}
+
+	return m.syncMutes(ctx, rawMessageHandler)
 }
 
 func (m *Messenger) syncContactRequestDecision(ctx context.Context, requestID string, accepted bool, rawMessageHandler RawMessageHandler) error {
@@ -2992,6 +3122,7 @@ func (m *Messenger) SyncVerificationRequest(ctx context.Context, vr *verificatio
 		RequestedAt:        vr.RequestedAt,
 		RepliedAt:          vr.RepliedAt,
 		VerificationStatus: protobuf.SyncVerificationRequest_VerificationStatus(vr.RequestStatus),
+		ExpiresAt:          vr.ExpiresAt,
 	}
 	encodedMessage, err := proto.Marshal(syncMessage)
 	if err != nil {
@@ -3069,6 +3200,8 @@ type ReceivedMessageState struct {
 	AllBookmarks            map[string]*browsers.Bookmark
 	AllVerificationRequests []*verification.Request
 	AllTrustStatus          map[string]verification.TrustStatus
+	// NotificationRules decide which notifications are created for new messages
+	NotificationRules []*NotificationRule
 }
 
 func (m *Messenger) markDeliveredMessages(acks [][]byte) {
@@ -3089,6 +3222,10 @@ func (m *Messenger) markDeliveredMessages(acks [][]byte) {
 			m.logger.Debug("Can't set message status as delivered", zap.Error(err))
 		}
 
+		if m.latencyTelemetryEnabled.Load() {
+			m.latencyTracker.MessageDelivered(messageID, time.Now())
+		}
+
 		//send signal to client that message status updated
 		if m.config.messengerSignalsHandler != nil {
 			message, err := m.persistence.MessageByID(messageID)
@@ -3124,14 +3261,18 @@ func (r *ReceivedMessageState) addNewMessageNotification(publicKey ecdsa.PublicK
 		return fmt.Errorf("contact ID '%s' not present", contactID)
 	}
 
-	if !chat.Muted {
-		if showMessageNotification(publicKey, m, chat, responseTo) {
-			notification, err := NewMessageNotification(m.ID, m, chat, contact, r.AllContacts, profilePicturesVisibility)
-			if err != nil {
-				return err
-			}
-			r.Response.AddNotification(notification)
+	action, matched := r.notificationAction(publicKey, m, chat, responseTo)
+	show := action == NotificationRuleActionNotify && showMessageNotification(publicKey, m, chat, responseTo)
+	if matched {
+		// A rule notifies of every message it matches
+		show = action == NotificationRuleActionNotify && chat.Active
+	}
+	if show {
+		notification, err := NewMessageNotification(m.ID, m, chat, contact, r.AllContacts, profilePicturesVisibility)
+		if err != nil {
+			return err
 		}
+		r.Response.AddNotification(notification)
 	}
 
 	return nil
@@ -3183,6 +3324,17 @@ func (r *ReceivedMessageState) addNewActivityCenterNotification(publicKey ecdsa.
 	}
 
 	isNotification, notificationType := showMentionOrReplyActivityCenterNotification(publicKey, message, chat, responseTo)
+
+	action, _ := r.notificationAction(publicKey, message, chat, responseTo)
+	if action == NotificationRuleActionMute {
+		isNotification = false
+	}
+
+	// The keywords the user subscribed to are notified even in muted chats
+	if !isNotification && m.matchNotificationKeywords(message, chat) {
+		isNotification, notificationType = true, ActivityCenterNotificationTypeKeyword
+	}
+
 	if isNotification {
 		notification := &ActivityCenterNotification{
 			ID:           types.FromHex(idToUse),
@@ -3245,14 +3397,17 @@ func (m *Messenger) handleImportedMessages(messagesToHandle map[transport.Filter
 
 	logger := m.logger.With(zap.String("site", "handleImportedMessages"))
 
+	decodedEnvelopes := m.decodeEnvelopes(messagesToHandle)
+
 	for filter, messages := range messagesToHandle {
-		for _, shhMessage := range messages {
+		for i := range messages {
 
-			statusMessages, _, err := m.sender.HandleMessages(shhMessage)
-			if err != nil {
-				logger.Info("failed to decode messages", zap.Error(err))
+			decoded := decodedEnvelopes[filter][i]
+			if decoded.err != nil {
+				logger.Info("failed to decode messages", zap.Error(decoded.err))
 				continue
 			}
+			statusMessages := decoded.statusMessages
 
 			for _, msg := range statusMessages {
 				logger := logger.With(zap.String("message-id", msg.TransportMessage.ThirdPartyID))
@@ -3382,9 +3537,13 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 		logger.Info("failed to retrieve admin communities", zap.Error(err))
 	}
 
+	// The envelopes are decoded in parallel, their messages are then handled
+	// in order
+	decodedEnvelopes := m.decodeEnvelopes(chatWithMessages)
+
 	for filter, messages := range chatWithMessages {
 		var processedMessages []string
-		for _, shhMessage := range messages {
+		for i, shhMessage := range messages {
 			logger := logger.With(zap.String("hash", types.EncodeHex(shhMessage.Hash)))
 			// Indicates tha all messages in the batch have been processed correctly
 			allMessagesProcessed := true
@@ -3397,15 +3556,19 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 				}
 			}
 
-			statusMessages, acks, err := m.sender.HandleMessages(shhMessage)
-			if err != nil {
-				logger.Info("failed to decode messages", zap.Error(err))
+			decoded := decodedEnvelopes[filter][i]
+			if decoded.err != nil {
+				logger.Info("failed to decode messages", zap.Error(decoded.err))
 				continue
 			}
+			statusMessages, acks := decoded.statusMessages, decoded.acks
 
 			if m.telemetryClient != nil {
 				go m.telemetryClient.PushReceivedMessages(filter, shhMessage, statusMessages)
 			}
+			if m.latencyTelemetryEnabled.Load() {
+				m.latencyTracker.MessageReceived(time.Unix(int64(shhMessage.Timestamp), 0), time.Now())
+			}
 			m.markDeliveredMessages(acks)
 
 			logger.Debug("processing messages further", zap.Int("count", len(statusMessages)))
@@ -3462,6 +3625,10 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 					Contact:          contact,
 					PublicKey:        publicKey,
 				}
+				// Messages from our own key are sent by our other devices
+				if common.IsPubKeyEqual(publicKey, &m.identity.PublicKey) {
+					m.recordSyncMessage()
+				}
 
 				if msg.ParsedMessage != nil {
 
@@ -3866,6 +4033,74 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							continue
 						}
 
+					case protobuf.SyncNotificationRule:
+						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+							logger.Warn("not coming from us, ignoring")
+							continue
+						}
+
+						a := msg.ParsedMessage.Interface().(protobuf.SyncNotificationRule)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, a)
+						logger.Debug("Handling SyncNotificationRule", zap.Any("message", a))
+
+						err = m.handleSyncNotificationRule(messageState, &a)
+						if err != nil {
+							logger.Warn("failed to handle SyncNotificationRule", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.SyncChatDraft:
+						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+							logger.Warn("not coming from us, ignoring")
+							continue
+						}
+
+						p := msg.ParsedMessage.Interface().(protobuf.SyncChatDraft)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, p)
+						logger.Debug("Handling SyncChatDraft", zap.Any("message", p))
+
+						err = m.handleSyncChatDraft(messageState, &p)
+						if err != nil {
+							logger.Warn("failed to handle SyncChatDraft", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.SyncMute:
+						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+							logger.Warn("not coming from us, ignoring")
+							continue
+						}
+
+						p := msg.ParsedMessage.Interface().(protobuf.SyncMute)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, p)
+						logger.Debug("Handling SyncMute", zap.Any("message", p))
+
+						err = m.handleSyncMute(messageState, &p)
+						if err != nil {
+							logger.Warn("failed to handle SyncMute", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.SyncChatReadState:
+						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+							logger.Warn("not coming from us, ignoring")
+							continue
+						}
+
+						p := msg.ParsedMessage.Interface().(protobuf.SyncChatReadState)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, p)
+						logger.Debug("Handling SyncChatReadState", zap.Any("message", p))
+
+						err = m.handleSyncChatReadState(messageState, &p)
+						if err != nil {
+							logger.Warn("failed to handle SyncChatReadState", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
 					case protobuf.SyncSetting:
 						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
 							logger.Warn("not coming from us, ignoring")
@@ -4087,6 +4322,36 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 						}
 						// We continue in any case, no changes to messenger
 						continue
+					case protobuf.AttachmentChunk:
+						logger.Debug("Handling AttachmentChunk")
+						message := msg.ParsedMessage.Interface().(protobuf.AttachmentChunk)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.handleAttachmentChunk(messageState, &message)
+						if err != nil {
+							logger.Warn("failed to handle AttachmentChunk", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+					case protobuf.AttachmentChunkRequest:
+						logger.Debug("Handling AttachmentChunkRequest")
+						message := msg.ParsedMessage.Interface().(protobuf.AttachmentChunkRequest)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.handleAttachmentChunkRequest(messageState, &message)
+						if err != nil {
+							logger.Warn("failed to handle AttachmentChunkRequest", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+					case protobuf.CommunityMemberProfile:
+						logger.Debug("Handling CommunityMemberProfile")
+						message := msg.ParsedMessage.Interface().(protobuf.CommunityMemberProfile)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.handleCommunityMemberProfile(messageState, &message)
+						if err != nil {
+							logger.Warn("failed to handle CommunityMemberProfile", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
 					case protobuf.EmojiReaction:
 						logger.Debug("Handling EmojiReaction")
 						message := msg.ParsedMessage.Interface().(protobuf.EmojiReaction)
@@ -4097,6 +4362,66 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							allMessagesProcessed = false
 							continue
 						}
+					case protobuf.SafeTransactionSignature:
+						logger.Debug("Handling SafeTransactionSignature")
+						message := msg.ParsedMessage.Interface().(protobuf.SafeTransactionSignature)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.HandleSafeTransactionSignature(messageState, message)
+						if err != nil {
+							logger.Warn("failed to handle SafeTransactionSignature", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+					case protobuf.SafeTransactionExecution:
+						logger.Debug("Handling SafeTransactionExecution")
+						message := msg.ParsedMessage.Interface().(protobuf.SafeTransactionExecution)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.HandleSafeTransactionExecution(messageState, message)
+						if err != nil {
+							logger.Warn("failed to handle SafeTransactionExecution", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+					case protobuf.SocialRecoveryShare:
+						logger.Debug("Handling SocialRecoveryShare")
+						message := msg.ParsedMessage.Interface().(protobuf.SocialRecoveryShare)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.HandleSocialRecoveryShare(messageState, message)
+						if err != nil {
+							logger.Warn("failed to handle SocialRecoveryShare", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+					case protobuf.SocialRecoveryShareResponse:
+						logger.Debug("Handling SocialRecoveryShareResponse")
+						message := msg.ParsedMessage.Interface().(protobuf.SocialRecoveryShareResponse)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.HandleSocialRecoveryShareResponse(messageState, message)
+						if err != nil {
+							logger.Warn("failed to handle SocialRecoveryShareResponse", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+					case protobuf.SocialRecoveryRequest:
+						logger.Debug("Handling SocialRecoveryRequest")
+						message := msg.ParsedMessage.Interface().(protobuf.SocialRecoveryRequest)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.HandleSocialRecoveryRequest(messageState, message)
+						if err != nil {
+							logger.Warn("failed to handle SocialRecoveryRequest", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+					case protobuf.SocialRecoveryShareRelease:
+						logger.Debug("Handling SocialRecoveryShareRelease")
+						message := msg.ParsedMessage.Interface().(protobuf.SocialRecoveryShareRelease)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.HandleSocialRecoveryShareRelease(messageState, message)
+						if err != nil {
+							logger.Warn("failed to handle SocialRecoveryShareRelease", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
 					case protobuf.GroupChatInvitation:
 						logger.Debug("Handling GroupChatInvitation")
 						message := msg.ParsedMessage.Interface().(protobuf.GroupChatInvitation)
@@ -4135,6 +4460,26 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							}
 						}
 
+					case protobuf.CommunityDescriptionDelta:
+						logger.Debug("Handling CommunityDescriptionDelta")
+						message := msg.ParsedMessage.Interface().(protobuf.CommunityDescriptionDelta)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.handleCommunityDescriptionDelta(messageState, &message)
+						if err != nil {
+							logger.Debug("failed to handle CommunityDescriptionDelta", zap.Error(err))
+							continue
+						}
+
+					case protobuf.CommunityDescriptionRequest:
+						logger.Debug("Handling CommunityDescriptionRequest")
+						message := msg.ParsedMessage.Interface().(protobuf.CommunityDescriptionRequest)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.handleCommunityDescriptionRequest(messageState, &message)
+						if err != nil {
+							logger.Debug("failed to handle CommunityDescriptionRequest", zap.Error(err))
+							continue
+						}
+
 					case protobuf.RequestContactVerification:
 						logger.Debug("Handling RequestContactVerification")
 						err = m.HandleRequestContactVerification(messageState, msg.ParsedMessage.Interface().(protobuf.RequestContactVerification))
@@ -4221,7 +4566,7 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 						logger.Debug("Handling CommunityMessageArchiveMagnetlink")
 						magnetlinkMessage := msg.ParsedMessage.Interface().(protobuf.CommunityMessageArchiveMagnetlink)
 						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, magnetlinkMessage)
-						err = m.HandleHistoryArchiveMagnetlinkMessage(messageState, publicKey, magnetlinkMessage.MagnetUri, magnetlinkMessage.Clock)
+						err = m.HandleHistoryArchiveMagnetlinkMessage(messageState, publicKey, magnetlinkMessage.MagnetUri, magnetlinkMessage.ArchiveUris, magnetlinkMessage.Clock)
 						if err != nil {
 							logger.Warn("failed to handle CommunityMessageArchiveMagnetlink", zap.Error(err))
 							allMessagesProcessed = false
@@ -4239,6 +4584,28 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							continue
 						}
 
+					case protobuf.DeleteCommunityMemberMessages:
+						logger.Debug("Handling DeleteCommunityMemberMessages")
+						message := msg.ParsedMessage.Interface().(protobuf.DeleteCommunityMemberMessages)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.HandleDeleteCommunityMemberMessages(messageState, publicKey, message)
+						if err != nil {
+							logger.Warn("failed to handle DeleteCommunityMemberMessages", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.CommunityBan:
+						logger.Debug("Handling CommunityBan")
+						message := msg.ParsedMessage.Interface().(protobuf.CommunityBan)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, message)
+						err = m.HandleCommunityBan(messageState, publicKey, message)
+						if err != nil {
+							logger.Warn("failed to handle CommunityBan", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
 					case protobuf.AnonymousMetricBatch:
 						logger.Debug("Handling AnonymousMetricBatch")
 						if m.anonMetricsServer == nil {
@@ -4284,6 +4651,21 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							allMessagesProcessed = false
 							continue
 						}
+					case protobuf.SyncAccountsPositions:
+						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+							logger.Warn("not coming from us, ignoring")
+							continue
+						}
+
+						p := msg.ParsedMessage.Interface().(protobuf.SyncAccountsPositions)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, p)
+						logger.Debug("Handling SyncAccountsPositions", zap.Any("message", p))
+						err = m.HandleSyncAccountsPositions(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle SyncAccountsPositions", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
 					case protobuf.SyncContactRequestDecision:
 						logger.Info("SyncContactRequestDecision")
 						p := msg.ParsedMessage.Interface().(protobuf.SyncContactRequestDecision)
@@ -4306,6 +4688,20 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							allMessagesProcessed = false
 							continue
 						}
+					case protobuf.SyncCustomToken:
+						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+							logger.Warn("not coming from us, ignoring")
+							continue
+						}
+
+						p := msg.ParsedMessage.Interface().(protobuf.SyncCustomToken)
+						m.outputToCSV(msg.TransportMessage.Timestamp, msg.ID, senderID, filter.Topic, filter.ChatID, msg.Type, p)
+						err = m.handleSyncCustomToken(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle SyncCustomToken", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
 					case protobuf.SyncKeycardAction:
 						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
 							logger.Warn("not coming from us, ignoring")
@@ -4494,19 +4890,23 @@ func (m *Messenger) saveDataAndPrepareResponse(messageState *ReceivedMessageStat
 		return nil, err
 	}
 
-	if len(messageState.Response.chats) > 0 {
-		err = m.saveChats(messageState.Response.Chats())
+	// The chats, messages and contacts of the batch are saved in a single
+	// transaction, which is much faster when catching up on a community
+	chatsToSave := messageState.Response.Chats()
+	messagesToSave := messageState.Response.Messages()
+	if len(chatsToSave) > 0 || len(messagesToSave) > 0 || len(contactsToSave) > 0 {
+		err = m.persistence.SaveReceivedData(chatsToSave, messagesToSave, contactsToSave)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	messagesToSave := messageState.Response.Messages()
+	for _, chat := range chatsToSave {
+		m.allChats.Store(chat.ID, chat)
+	}
+
 	if len(messagesToSave) > 0 {
-		err = m.SaveMessages(messagesToSave)
-		if err != nil {
-			return nil, err
-		}
+		m.mentionsManager.activity.record(messagesToSave)
 	}
 
 	for _, emojiReaction := range messageState.EmojiReactions {
@@ -4517,13 +4917,6 @@ func (m *Messenger) saveDataAndPrepareResponse(messageState *ReceivedMessageStat
 		messageState.Response.Invitations = append(messageState.Response.Invitations, groupChatInvitation)
 	}
 
-	if len(contactsToSave) > 0 {
-		err = m.persistence.SaveContacts(contactsToSave)
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	newMessagesIds := map[string]struct{}{}
 	for _, message := range messagesToSave {
 		if message.New {
@@ -4551,6 +4944,11 @@ func (m *Messenger) saveDataAndPrepareResponse(messageState *ReceivedMessageStat
 		return nil, err
 	}
 
+	messageState.NotificationRules, err = m.persistence.NotificationRules()
+	if err != nil {
+		return nil, err
+	}
+
 	m.prepareMessages(messageState.Response.messages)
 
 	for _, message := range messageState.Response.messages {
@@ -4737,11 +5135,18 @@ func (m *Messenger) prepareMessage(msg *common.Message, s *server.MediaServer) {
 	if msg.ContentType == protobuf.ChatMessage_AUDIO {
 		msg.AudioLocalURL = s.MakeAudioURL(msg.ID)
 	}
+	if msg.ContentType == protobuf.ChatMessage_VIDEO {
+		msg.VideoLocalURL = s.MakeVideoURL(msg.ID)
+		if video := msg.GetVideo(); video != nil && len(video.Thumbnail) != 0 {
+			msg.VideoThumbnailLocalURL = s.MakeVideoThumbnailURL(msg.ID)
+		}
+	}
 	if msg.ContentType == protobuf.ChatMessage_STICKER {
 		msg.StickerLocalURL = s.MakeStickerURL(msg.GetSticker().Hash)
 	}
 
 	msg.LinkPreviews = msg.ConvertFromProtoToLinkPreviews(s.MakeLinkPreviewThumbnailURL)
+	msg.StatusLinkPreviews = msg.ConvertFromProtoToStatusLinkPreviews(s.MakeStatusLinkPreviewIconURL)
 }
 
 func (m *Messenger) AllMessageByChatIDWhichMatchTerm(chatID string, searchTerm string, caseSensitive bool) ([]*common.Message, error) {
@@ -4782,6 +5187,11 @@ func (m *Messenger) MarkMessagesSeen(chatID string, ids []string) (uint64, uint6
 		return 0, 0, err
 	}
 	m.allChats.Store(chatID, chat)
+
+	if m.hasPairedDevices() {
+		clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+		m.readStateQueue.add(chatID, clock, ids)
+	}
 	return count, countWithMentions, nil
 }
 
@@ -4961,7 +5371,11 @@ func (m *Messenger) MuteChat(request *requests.MuteChat) (time.Time, error) {
 		return time.Time{}, err
 	}
 
-	return m.muteChat(chat, contact, muteTillTimeRemoveMs)
+	mutedTill, err := m.muteChat(chat, contact, muteTillTimeRemoveMs)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return mutedTill, m.recordMute(context.Background(), chat.ID, "", true, mutedTill)
 }
 
 func (m *Messenger) MuteChatV2(muteParams *requests.MuteChat) (time.Time, error) {
@@ -5010,7 +5424,11 @@ func (m *Messenger) UnmuteChat(chatID string) error {
 		contact, _ = m.allContacts.Load(chatID)
 	}
 
-	return m.unmuteChat(chat, contact)
+	err := m.unmuteChat(chat, contact)
+	if err != nil {
+		return err
+	}
+	return m.recordMute(context.Background(), chat.ID, "", false, time.Time{})
 }
 
 func (m *Messenger) unmuteChat(chat *Chat, contact *Contact) error {
@@ -5817,6 +6235,12 @@ func (m *Messenger) pushNotificationOptions() *pushnotificationclient.Registrati
 	var mutedChatIDs []string
 	var publicChatIDs []string
 	var blockedChatIDs []string
+	chatPreferences := make(map[string]protobuf.PushNotificationChatPreference_Level)
+
+	notificationRules, err := m.persistence.NotificationRules()
+	if err != nil {
+		m.logger.Warn("failed to get notification rules", zap.Error(err))
+	}
 
 	m.allContacts.Range(func(contactID string, contact *Contact) (shouldContinue bool) {
 		if contact.added() && !contact.Blocked {
@@ -5833,21 +6257,25 @@ func (m *Messenger) pushNotificationOptions() *pushnotificationclient.Registrati
 	})
 
 	m.allChats.Range(func(chatID string, chat *Chat) (shouldContinue bool) {
-		if chat.Muted {
+		if chat.IsMuted(time.Now()) {
 			mutedChatIDs = append(mutedChatIDs, chat.ID)
 			return true
 		}
 		if chat.Active && (chat.Public() || chat.CommunityChat()) {
 			publicChatIDs = append(publicChatIDs, chat.ID)
 		}
+		if level, ok := pushNotificationLevel(notificationRules, chat); chat.Active && ok {
+			chatPreferences[chat.ID] = level
+		}
 		return true
 	})
 
 	return &pushnotificationclient.RegistrationOptions{
-		ContactIDs:     contactIDs,
-		MutedChatIDs:   mutedChatIDs,
-		PublicChatIDs:  publicChatIDs,
-		BlockedChatIDs: blockedChatIDs,
+		ContactIDs:      contactIDs,
+		MutedChatIDs:    mutedChatIDs,
+		PublicChatIDs:   publicChatIDs,
+		BlockedChatIDs:  blockedChatIDs,
+		ChatPreferences: chatPreferences,
 	}
 }
 
@@ -5919,6 +6347,28 @@ func (m *Messenger) DisablePushNotificationsBlockMentions() error {
 	return m.pushNotificationClient.DisablePushNotificationsBlockMentions(m.pushNotificationOptions())
 }
 
+// EnablePushNotificationsCollapse is used to indicate that we want the notifications of a chat grouped in a single summary
+func (m *Messenger) EnablePushNotificationsCollapse() error {
+	if m.pushNotificationClient == nil {
+		return errors.New("no push notification client")
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.pushNotificationClient.EnablePushNotificationsCollapse(m.pushNotificationOptions())
+}
+
+// DisablePushNotificationsCollapse is used to indicate that we want a notification for each message
+func (m *Messenger) DisablePushNotificationsCollapse() error {
+	if m.pushNotificationClient == nil {
+		return errors.New("no push notification client")
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.pushNotificationClient.DisablePushNotificationsCollapse(m.pushNotificationOptions())
+}
+
 // GetPushNotificationsServers returns the servers used for push notifications
 func (m *Messenger) GetPushNotificationsServers() ([]*pushnotificationclient.PushNotificationServer, error) {
 	if m.pushNotificationClient == nil {
@@ -5962,11 +6412,14 @@ func generateAliasAndIdenticon(pk string) (string, string, error) {
 
 }
 
-func (m *Messenger) UnfurlURLs(urls []string) ([]common.LinkPreview, error) {
-	return linkpreview.UnfurlURLs(m.logger, linkpreview.NewDefaultHTTPClient(), urls)
+func (m *Messenger) SendEmojiReaction(ctx context.Context, chatID, messageID string, emojiID protobuf.EmojiReaction_Type) (*MessengerResponse, error) {
+	if emojiID == protobuf.EmojiReaction_CUSTOM {
+		return nil, ErrInvalidEmojiReaction
+	}
+	return m.sendEmojiReaction(ctx, chatID, messageID, emojiID, "")
 }
 
-func (m *Messenger) SendEmojiReaction(ctx context.Context, chatID, messageID string, emojiID protobuf.EmojiReaction_Type) (*MessengerResponse, error) {
+func (m *Messenger) sendEmojiReaction(ctx context.Context, chatID, messageID string, emojiID protobuf.EmojiReaction_Type, communityEmojiID string) (*MessengerResponse, error) {
 	var response MessengerResponse
 
 	chat, ok := m.allChats.Load(chatID)
@@ -5977,10 +6430,11 @@ func (m *Messenger) SendEmojiReaction(ctx context.Context, chatID, messageID str
 
 	emojiR := &EmojiReaction{
 		EmojiReaction: protobuf.EmojiReaction{
-			Clock:     clock,
-			MessageId: messageID,
-			ChatId:    chatID,
-			Type:      emojiID,
+			Clock:            clock,
+			MessageId:        messageID,
+			ChatId:           chatID,
+			Type:             emojiID,
+			CommunityEmojiId: communityEmojiID,
 		},
 		LocalChatID: chatID,
 		From:        types.EncodeHex(crypto.FromECDSAPub(&m.identity.PublicKey)),
@@ -6042,6 +6496,45 @@ func (m *Messenger) EmojiReactionsByChatIDMessageID(chatID string, messageID str
 	return m.persistence.EmojiReactionsByChatIDMessageID(chatID, messageID)
 }
 
+// GetMessageReactions returns the number of reactions to a message for each
+// emoji, along with a page of the reactions, most recent first
+func (m *Messenger) GetMessageReactions(messageID string, cursor string) (*MessageReactions, error) {
+	message, err := m.persistence.MessageByID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := m.persistence.EmojiReactionsCountsByMessageID(message.LocalChatID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	myID := m.myHexIdentity()
+	for _, count := range counts {
+		ownReaction := EmojiReaction{
+			EmojiReaction: protobuf.EmojiReaction{MessageId: messageID, Type: count.EmojiID},
+			From:          myID,
+		}
+		reaction, err := m.persistence.EmojiReactionByID(ownReaction.ID())
+		if err != nil && err != common.ErrRecordNotFound {
+			return nil, err
+		}
+		count.ReactedByMe = reaction != nil && !reaction.Retracted && reaction.LocalChatID == message.LocalChatID
+	}
+
+	reactions, cursor, err := m.persistence.EmojiReactionsByMessageID(message.LocalChatID, messageID, cursor, messageReactionsPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessageReactions{
+		MessageID: messageID,
+		Counts:    counts,
+		Reactions: reactions,
+		Cursor:    cursor,
+	}, nil
+}
+
 func (m *Messenger) SendEmojiReactionRetraction(ctx context.Context, emojiReactionID string) (*MessengerResponse, error) {
 	emojiR, err := m.persistence.EmojiReactionByID(emojiReactionID)
 	if err != nil {
@@ -6271,6 +6764,7 @@ func (m *Messenger) handleSyncTrustedUser(state *ReceivedMessageState, message p
 
 func ToVerificationRequest(message protobuf.SyncVerificationRequest) *verification.Request {
 	return &verification.Request{
+		ID:            message.Id,
 		From:          message.From,
 		To:            message.To,
 		Challenge:     message.Challenge,
@@ -6278,6 +6772,7 @@ func ToVerificationRequest(message protobuf.SyncVerificationRequest) *verificati
 		RequestedAt:   message.RequestedAt,
 		RepliedAt:     message.RepliedAt,
 		RequestStatus: verification.RequestStatus(message.VerificationStatus),
+		ExpiresAt:     message.ExpiresAt,
 	}
 }
 
@@ -0,0 +1,470 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/video"
+)
+
+// attachmentChunksGracePeriod is how long the chunks of an attachment are
+// waited for once its message is received, the missing ones are then requested
+// to its author
+const attachmentChunksGracePeriod = 30 * time.Second
+
+// SetAttachmentAutoDownloadLimits sets the size up to which the attachments
+// are downloaded automatically on Wi-Fi and on cellular connections, larger
+// ones are downloaded when requested with RequestAttachmentChunks
+func (m *Messenger) SetAttachmentAutoDownloadLimits(wifi uint64, cellular uint64) error {
+	return m.settings.SetAttachmentAutoDownloadLimits(wifi, cellular)
+}
+
+// SetVideoAutoDownload sets whether the videos are downloaded automatically
+// on Wi-Fi and on cellular connections, within the attachments limits
+func (m *Messenger) SetVideoAutoDownload(wifi bool, cellular bool) error {
+	return m.settings.SetVideoAutoDownload(wifi, cellular)
+}
+
+func (m *Messenger) videoExtractor() video.Extractor {
+	if m.config.videoExtractor != nil {
+		return m.config.videoExtractor
+	}
+	return video.MetadataExtractor{}
+}
+
+func (m *Messenger) attachmentAutoDownloadLimit() uint64 {
+	wifi, cellular, err := m.settings.AttachmentAutoDownloadLimits()
+	if err != nil {
+		m.logger.Error("failed to get attachment auto download limits", zap.Error(err))
+		return 0
+	}
+	if m.connectionState.IsExpensive() {
+		return cellular
+	}
+	return wifi
+}
+
+// autoDownloadAttachment tells whether an attachment is downloaded without the
+// user asking for it, depending on its size, on whether it's a video and on
+// the type of the current connection
+func (m *Messenger) autoDownloadAttachment(contentType protobuf.ChatMessage_ContentType, size uint64) bool {
+	if size > m.attachmentAutoDownloadLimit() {
+		return false
+	}
+	if contentType != protobuf.ChatMessage_VIDEO {
+		return true
+	}
+
+	wifi, cellular, err := m.settings.VideoAutoDownload()
+	if err != nil {
+		m.logger.Error("failed to get video auto download settings", zap.Error(err))
+		return false
+	}
+	if m.connectionState.IsExpensive() {
+		return cellular
+	}
+	return wifi
+}
+
+// splitAttachment removes the image or the video of the message when it's
+// too large to be sent within it, and returns its chunks. The payload must be
+// restored with the returned function once the message is encoded
+func (m *Messenger) splitAttachment(message *common.Message) ([]*protobuf.AttachmentChunk, func(), error) {
+	payload := message.AttachmentPayload()
+	if len(payload) <= common.AttachmentChunkingThreshold {
+		return nil, func() {}, nil
+	}
+
+	descriptor, chunks, err := common.SplitAttachment(payload, common.AttachmentChunkSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	message.SetAttachment(nil, descriptor)
+	return chunks, func() { message.SetAttachment(payload, descriptor) }, nil
+}
+
+func (m *Messenger) saveSentAttachment(message *common.Message) error {
+	descriptor := message.AttachmentDescriptor()
+	if descriptor == nil {
+		return nil
+	}
+
+	return m.persistence.SaveAttachment(&Attachment{
+		ID:          descriptor.Id,
+		MessageID:   message.ID,
+		ChatID:      message.LocalChatID,
+		From:        message.From,
+		Size:        descriptor.Size,
+		ContentType: message.ContentType,
+		Descriptor:  descriptor,
+		Complete:    true,
+	})
+}
+
+// sendAttachmentChunks sends the chunks after the message they belong to,
+// they aren't resent automatically as the missing ones can be requested
+func (m *Messenger) sendAttachmentChunks(ctx context.Context, chat *Chat, chunks []*protobuf.AttachmentChunk) {
+	for _, chunk := range chunks {
+		encodedChunk, err := proto.Marshal(chunk)
+		if err != nil {
+			m.logger.Error("failed to marshal attachment chunk", zap.Error(err))
+			return
+		}
+
+		_, err = m.dispatchMessage(ctx, common.RawMessage{
+			LocalChatID:          chat.ID,
+			Payload:              encodedChunk,
+			MessageType:          protobuf.ApplicationMetadataMessage_ATTACHMENT_CHUNK,
+			SkipGroupMessageWrap: true,
+		})
+		if err != nil {
+			m.logger.Warn("failed to send attachment chunk", zap.String("attachmentID", chunk.AttachmentId), zap.Uint32("index", chunk.Index), zap.Error(err))
+		}
+	}
+}
+
+// RequestAttachmentChunks downloads the attachment of a message which wasn't
+// downloaded automatically, or resumes its download. The missing chunks are
+// requested to the author of the message
+func (m *Messenger) RequestAttachmentChunks(ctx context.Context, messageID string) error {
+	attachment, err := m.persistence.AttachmentByMessageID(messageID)
+	if err != nil {
+		return err
+	}
+	if attachment == nil {
+		return ErrAttachmentNotFound
+	}
+	if attachment.Complete {
+		return nil
+	}
+
+	err = m.persistence.SetAttachmentRequested(messageID)
+	if err != nil {
+		return err
+	}
+
+	chunks, err := m.persistence.AttachmentChunks(attachment.ID)
+	if err != nil {
+		return err
+	}
+
+	encodedRequest, err := proto.Marshal(&protobuf.AttachmentChunkRequest{
+		AttachmentId: attachment.ID,
+		Indexes:      common.MissingAttachmentChunks(attachment.Descriptor, chunks),
+	})
+	if err != nil {
+		return err
+	}
+
+	author, err := common.HexToPubkey(attachment.From)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.sender.SendPrivate(ctx, author, &common.RawMessage{
+		LocalChatID: attachment.ChatID,
+		Payload:     encodedRequest,
+		MessageType: protobuf.ApplicationMetadataMessage_ATTACHMENT_CHUNK_REQUEST,
+	})
+	return err
+}
+
+// handleReceivedAttachment stores the descriptor of the attachment of a
+// received message, its chunks might have been received already. The message
+// must be in the response so that it's completed along with the others
+func (m *Messenger) handleReceivedAttachment(response *MessengerResponse, message *common.Message) error {
+	descriptor := message.AttachmentDescriptor()
+
+	attachments, err := m.persistence.AttachmentsByID(descriptor.Id)
+	if err != nil {
+		return err
+	}
+
+	attachment := &Attachment{
+		ID:          descriptor.Id,
+		MessageID:   message.ID,
+		ChatID:      message.LocalChatID,
+		From:        message.From,
+		Size:        descriptor.Size,
+		ContentType: message.ContentType,
+		Descriptor:  descriptor,
+	}
+
+	for _, a := range attachments {
+		if a.MessageID == message.ID {
+			attachment.Requested = a.Requested
+		}
+	}
+
+	// The same attachment has been received already, possibly with another
+	// message
+	for _, a := range attachments {
+		if !a.Complete {
+			continue
+		}
+		payload, err := m.persistence.AttachmentPayload(a.MessageID)
+		if err != nil || len(payload) == 0 {
+			continue
+		}
+		message.SetAttachment(payload, descriptor)
+		attachment.Complete = true
+		err = m.persistence.SaveAttachment(attachment)
+		if err != nil {
+			return err
+		}
+		return message.PrepareContent(m.myHexIdentity())
+	}
+
+	err = m.persistence.SaveAttachment(attachment)
+	if err != nil {
+		return err
+	}
+
+	err = m.assembleAttachment(response, descriptor.Id)
+	if err != nil {
+		return err
+	}
+
+	if attachment.Requested || m.autoDownloadAttachment(message.ContentType, descriptor.Size) {
+		m.requestMissingAttachmentChunks(message.ID)
+	}
+	return nil
+}
+
+// requestMissingAttachmentChunks requests the chunks of the attachment of a
+// received message which are still missing after a grace period, like the
+// ones received before the message, which are dropped
+func (m *Messenger) requestMissingAttachmentChunks(messageID string) {
+	go func() {
+		select {
+		case <-time.After(attachmentChunksGracePeriod):
+		case <-m.quit:
+			return
+		}
+
+		err := m.RequestAttachmentChunks(context.Background(), messageID)
+		if err != nil && err != ErrAttachmentNotFound {
+			m.logger.Warn("failed to request missing attachment chunks", zap.String("messageID", messageID), zap.Error(err))
+		}
+	}()
+}
+
+// handleAttachmentChunk stores a chunk of an attachment of a message of the
+// same author. Chunks received before their message are dropped, the missing
+// ones are requested once the message is received
+func (m *Messenger) handleAttachmentChunk(state *ReceivedMessageState, chunk *protobuf.AttachmentChunk) error {
+	err := common.ValidateAttachmentChunk(chunk)
+	if err != nil {
+		return err
+	}
+
+	attachments, err := m.persistence.AttachmentsByID(chunk.AttachmentId)
+	if err != nil {
+		return err
+	}
+
+	author := state.CurrentMessageState.Contact.ID
+	var attachment *Attachment
+	for _, a := range attachments {
+		if a.Complete {
+			return nil
+		}
+		if a.From != author {
+			continue
+		}
+		if attachment == nil {
+			attachment = a
+		}
+		attachment.Requested = attachment.Requested || a.Requested
+	}
+	if attachment == nil {
+		m.logger.Debug("dropping attachment chunk without a message from its author", zap.String("attachmentID", chunk.AttachmentId))
+		return nil
+	}
+
+	if !attachment.Requested && !m.autoDownloadAttachment(attachment.ContentType, attachment.Size) {
+		m.logger.Debug("skipping attachment chunk not downloaded automatically", zap.String("attachmentID", chunk.AttachmentId), zap.Uint64("size", attachment.Size))
+		return nil
+	}
+
+	err = common.VerifyAttachmentChunk(attachment.Descriptor, chunk.Index, chunk.Data)
+	if err != nil {
+		return err
+	}
+
+	err = m.persistence.SaveAttachmentChunk(chunk.AttachmentId, chunk.Index, chunk.Data)
+	if err != nil {
+		return err
+	}
+
+	return m.assembleAttachment(state.Response, chunk.AttachmentId)
+}
+
+// deleteOrphanedAttachmentChunks removes the chunks of the attachments whose
+// messages were deleted before the attachments were complete
+func (m *Messenger) deleteOrphanedAttachmentChunks() {
+	err := m.persistence.DeleteOrphanedAttachmentChunks()
+	if err != nil {
+		m.logger.Error("failed to delete orphaned attachment chunks", zap.Error(err))
+	}
+}
+
+// assembleAttachment completes the messages waiting for the attachment once
+// all its chunks have been received
+func (m *Messenger) assembleAttachment(response *MessengerResponse, attachmentID string) error {
+	attachments, err := m.persistence.AttachmentsByID(attachmentID)
+	if err != nil || len(attachments) == 0 {
+		return err
+	}
+	descriptor := attachments[0].Descriptor
+
+	chunks, err := m.persistence.AttachmentChunks(attachmentID)
+	if err != nil {
+		return err
+	}
+	if len(common.MissingAttachmentChunks(descriptor, chunks)) != 0 {
+		return nil
+	}
+
+	data, err := common.AssembleAttachment(descriptor, chunks)
+	if err != nil {
+		return err
+	}
+
+	for _, attachment := range attachments {
+		if attachment.Complete {
+			continue
+		}
+
+		message := response.GetMessage(attachment.MessageID)
+		if message == nil {
+			message, err = m.persistence.MessageByID(attachment.MessageID)
+			if err == common.ErrRecordNotFound {
+				continue
+			} else if err != nil {
+				return err
+			}
+		}
+
+		// The type of a video is the one declared in its message, which
+		// doesn't complete when the data doesn't match it
+		if v := message.GetVideo(); v != nil && video.Type(data) != v.Type {
+			m.logger.Warn("video attachment doesn't match its type", zap.String("messageID", message.ID))
+			continue
+		}
+		if !message.SetAttachment(data, descriptor) {
+			continue
+		}
+		err = message.PrepareContent(m.myHexIdentity())
+		if err != nil {
+			return err
+		}
+
+		err = m.persistence.SaveMessages([]*common.Message{message})
+		if err != nil {
+			return err
+		}
+		response.AddMessage(message)
+	}
+
+	return m.persistence.CompleteAttachment(attachmentID)
+}
+
+// handleAttachmentChunkRequest sends again the chunks of an attachment we
+// sent, to the members of the chat it was sent in
+func (m *Messenger) handleAttachmentChunkRequest(state *ReceivedMessageState, request *protobuf.AttachmentChunkRequest) error {
+	requester := state.CurrentMessageState.PublicKey
+
+	attachments, err := m.persistence.AttachmentsByID(request.AttachmentId)
+	if err != nil {
+		return err
+	}
+
+	for _, attachment := range attachments {
+		if attachment.From != m.myHexIdentity() || !attachment.Complete {
+			continue
+		}
+
+		chat, ok := m.allChats.Load(attachment.ChatID)
+		if !ok || !m.isAttachmentRecipient(chat, requester) {
+			continue
+		}
+
+		payload, err := m.persistence.AttachmentPayload(attachment.MessageID)
+		if err != nil {
+			return err
+		}
+		if len(payload) == 0 {
+			continue
+		}
+
+		descriptor, chunks, err := common.SplitAttachment(payload, common.AttachmentChunkSize)
+		if err != nil {
+			return err
+		}
+		if descriptor.Id != request.AttachmentId {
+			continue
+		}
+
+		return m.resendAttachmentChunks(requester, chunks, request.Indexes)
+	}
+
+	return ErrAttachmentNotFound
+}
+
+func (m *Messenger) isAttachmentRecipient(chat *Chat, publicKey *ecdsa.PublicKey) bool {
+	if common.IsPubKeyEqual(publicKey, &m.identity.PublicKey) {
+		return true
+	}
+
+	memberID := types.EncodeHex(crypto.FromECDSAPub(publicKey))
+	switch {
+	case chat.OneToOne():
+		return chat.ID == memberID
+	case chat.PrivateGroupChat():
+		return chat.HasMember(memberID)
+	case chat.CommunityChat():
+		community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
+		if err != nil || community == nil {
+			return false
+		}
+		return community.HasMember(publicKey)
+	}
+	return false
+}
+
+func (m *Messenger) resendAttachmentChunks(recipient *ecdsa.PublicKey, chunks []*protobuf.AttachmentChunk, indexes []uint32) error {
+	requested := make(map[uint32]bool)
+	for _, index := range indexes {
+		requested[index] = true
+	}
+
+	for _, chunk := range chunks {
+		if len(requested) != 0 && !requested[chunk.Index] {
+			continue
+		}
+
+		encodedChunk, err := proto.Marshal(chunk)
+		if err != nil {
+			return err
+		}
+
+		_, err = m.sender.SendPrivate(context.Background(), recipient, &common.RawMessage{
+			Payload:     encodedChunk,
+			MessageType: protobuf.ApplicationMetadataMessage_ATTACHMENT_CHUNK,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
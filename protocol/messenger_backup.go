@@ -20,10 +20,24 @@ const (
 // backupTickerInterval is how often we should check for backups
 var backupTickerInterval = 120 * time.Second
 
-// backupIntervalSeconds is the amount of seconds we should allow between
-// backups
+// backupIntervalSeconds is the default amount of seconds we should allow
+// between backups, used when no interval has been set by the user
 var backupIntervalSeconds uint64 = 28800
 
+// minBackupIntervalSeconds is the minimum interval that can be set between
+// scheduled backups
+var minBackupIntervalSeconds uint64 = 3600
+
+type BackupStatus struct {
+	Enabled bool `json:"enabled"`
+	// Interval is the amount of seconds between scheduled backups
+	Interval uint64 `json:"interval"`
+	// LastBackup is the unix timestamp in seconds of the last backup performed
+	LastBackup uint64 `json:"lastBackup"`
+	// NextBackup is the unix timestamp in seconds of the next scheduled backup
+	NextBackup uint64 `json:"nextBackup"`
+}
+
 func (m *Messenger) backupEnabled() (bool, error) {
 	return m.settings.BackupEnabled()
 }
@@ -32,6 +46,54 @@ func (m *Messenger) lastBackup() (uint64, error) {
 	return m.settings.LastBackup()
 }
 
+func (m *Messenger) backupInterval() (uint64, error) {
+	interval, err := m.settings.BackupInterval()
+	if err != nil {
+		return 0, err
+	}
+	if interval == 0 {
+		return backupIntervalSeconds, nil
+	}
+	return interval, nil
+}
+
+// SetBackupInterval sets the amount of seconds between scheduled backups
+func (m *Messenger) SetBackupInterval(seconds uint64) error {
+	if seconds < minBackupIntervalSeconds {
+		return ErrBackupIntervalTooShort
+	}
+	return m.settings.SetBackupInterval(seconds)
+}
+
+// GetBackupStatus returns the state of the scheduled backups
+func (m *Messenger) GetBackupStatus() (*BackupStatus, error) {
+	enabled, err := m.backupEnabled()
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := m.backupInterval()
+	if err != nil {
+		return nil, err
+	}
+
+	lastBackup, err := m.lastBackup()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &BackupStatus{
+		Enabled:    enabled,
+		Interval:   interval,
+		LastBackup: lastBackup,
+	}
+	if enabled {
+		status.NextBackup = lastBackup + interval
+	}
+
+	return status, nil
+}
+
 func (m *Messenger) startBackupLoop() {
 	ticker := time.NewTicker(backupTickerInterval)
 	go func() {
@@ -58,19 +120,20 @@ func (m *Messenger) startBackupLoop() {
 					continue
 				}
 
+				interval, err := m.backupInterval()
+				if err != nil {
+					m.logger.Error("failed to fetch backup interval")
+					continue
+				}
+
 				now := time.Now().Unix()
-				if uint64(now) <= backupIntervalSeconds+lastBackup {
+				if uint64(now) <= interval+lastBackup {
 					m.logger.Debug("not backing up")
 					continue
 				}
 				m.logger.Debug("backing up data")
 
-				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
-				defer cancel()
-				_, err = m.BackupData(ctx)
-				if err != nil {
-					m.logger.Error("failed to backup data", zap.Error(err))
-				}
+				m.performScheduledBackup()
 			case <-m.quit:
 				ticker.Stop()
 				return
@@ -79,6 +142,28 @@ func (m *Messenger) startBackupLoop() {
 	}()
 }
 
+func (m *Messenger) performScheduledBackup() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	_, err := m.BackupData(ctx)
+	if err != nil {
+		m.logger.Error("failed to backup data", zap.Error(err))
+		if m.config.messengerSignalsHandler != nil {
+			m.config.messengerSignalsHandler.BackupFailed(err)
+		}
+	}
+}
+
+// rotateBackups removes the raw messages of the backups preceding the
+// previous one, so that only the last two backups are kept locally
+func (m *Messenger) rotateBackups(previousBackup uint64) error {
+	if previousBackup == 0 {
+		return nil
+	}
+	return m.persistence.RemoveRawMessagesByTypeSentBefore(protobuf.ApplicationMetadataMessage_BACKUP, previousBackup*1000)
+}
+
 func (m *Messenger) BackupData(ctx context.Context) (uint64, error) {
 	clock, chat := m.getLastClockWithRelatedChat()
 	contactsToBackup := m.backupContacts(ctx)
@@ -208,11 +293,21 @@ func (m *Messenger) BackupData(ctx context.Context) (uint64, error) {
 		return 0, err
 	}
 
+	previousBackup, err := m.lastBackup()
+	if err != nil {
+		return 0, err
+	}
+
 	clockInSeconds := clock / 1000
 	err = m.settings.SetLastBackup(clockInSeconds)
 	if err != nil {
 		return 0, err
 	}
+
+	err = m.rotateBackups(previousBackup)
+	if err != nil {
+		m.logger.Warn("failed to rotate backups", zap.Error(err))
+	}
 	if m.config.messengerSignalsHandler != nil {
 		m.config.messengerSignalsHandler.BackupPerformed(clockInSeconds)
 	}
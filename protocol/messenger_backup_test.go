@@ -18,6 +18,7 @@ import (
 	"github.com/status-im/status-go/images"
 	"github.com/status-im/status-go/multiaccounts/accounts"
 	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/requests"
 	"github.com/status-im/status-go/protocol/tt"
@@ -879,3 +880,49 @@ func (s *MessengerBackupSuite) TestBackupWatchOnlyAccounts() {
 	s.Require().Equal(len(woAccounts), len(dbWoAccounts2))
 	s.Require().True(haveSameElements(woAccounts, dbWoAccounts2, accounts.SameAccounts))
 }
+
+func (s *MessengerBackupSuite) TestBackupStatus() {
+	bob := s.m
+
+	status, err := bob.GetBackupStatus()
+	s.Require().NoError(err)
+	s.Require().True(status.Enabled)
+	s.Require().Equal(backupIntervalSeconds, status.Interval)
+
+	err = bob.SetBackupInterval(minBackupIntervalSeconds - 1)
+	s.Require().ErrorIs(err, ErrBackupIntervalTooShort)
+
+	err = bob.SetBackupInterval(2 * minBackupIntervalSeconds)
+	s.Require().NoError(err)
+
+	lastBackup, err := bob.BackupData(context.Background())
+	s.Require().NoError(err)
+
+	status, err = bob.GetBackupStatus()
+	s.Require().NoError(err)
+	s.Require().Equal(2*minBackupIntervalSeconds, status.Interval)
+	s.Require().Equal(lastBackup, status.LastBackup)
+	s.Require().Equal(lastBackup+2*minBackupIntervalSeconds, status.NextBackup)
+}
+
+func (s *MessengerBackupSuite) TestBackupRotation() {
+	bob := s.m
+
+	_, chat := bob.getLastClockWithRelatedChat()
+	oldBackup := &common.RawMessage{
+		ID:          "0x01",
+		LocalChatID: chat.ID,
+		LastSent:    1000,
+		MessageType: protobuf.ApplicationMetadataMessage_BACKUP,
+	}
+	s.Require().NoError(bob.persistence.SaveRawMessage(oldBackup))
+	s.Require().NoError(bob.settings.SetLastBackup(2))
+
+	_, err := bob.BackupData(context.Background())
+	s.Require().NoError(err)
+
+	ids, err := bob.persistence.RawMessagesIDsByType(protobuf.ApplicationMetadataMessage_BACKUP)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(ids)
+	s.Require().NotContains(ids, oldBackup.ID)
+}
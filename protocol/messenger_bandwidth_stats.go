@@ -0,0 +1,76 @@
+package protocol
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+const (
+	bandwidthStatsInterval  = time.Minute
+	bandwidthStatsRetention = 30 * 24 * time.Hour
+)
+
+// bandwidthDelta returns the bytes exchanged since the previous totals. The
+// waku counters can be reset, in which case the current totals are the delta
+func bandwidthDelta(previous, current map[string]types.ProtocolBandwidth) map[string]types.ProtocolBandwidth {
+	delta := make(map[string]types.ProtocolBandwidth)
+	for protocol, c := range current {
+		p := previous[protocol]
+		if c.BytesIn < p.BytesIn || c.BytesOut < p.BytesOut {
+			delta[protocol] = c
+			continue
+		}
+		delta[protocol] = types.ProtocolBandwidth{
+			BytesIn:  c.BytesIn - p.BytesIn,
+			BytesOut: c.BytesOut - p.BytesOut,
+		}
+	}
+	return delta
+}
+
+func (m *Messenger) saveBandwidthStats(previous map[string]types.ProtocolBandwidth, now time.Time) map[string]types.ProtocolBandwidth {
+	current := m.transport.BandwidthByProtocol()
+
+	hour := now.Truncate(time.Hour).Unix()
+	err := m.persistence.AddBandwidthStats(hour, bandwidthDelta(previous, current))
+	if err != nil {
+		m.logger.Error("failed to save bandwidth stats", zap.Error(err))
+		return previous
+	}
+
+	err = m.persistence.DeleteBandwidthStatsBefore(now.Add(-bandwidthStatsRetention).Truncate(time.Hour).Unix())
+	if err != nil {
+		m.logger.Error("failed to delete old bandwidth stats", zap.Error(err))
+	}
+
+	return current
+}
+
+// watchBandwidthStats periodically saves the bytes exchanged by each waku
+// protocol, aggregated by hour
+func (m *Messenger) watchBandwidthStats() {
+	m.logger.Debug("watching bandwidth stats")
+	go func() {
+		ticker := time.NewTicker(bandwidthStatsInterval)
+		defer ticker.Stop()
+
+		var previous map[string]types.ProtocolBandwidth
+		for {
+			select {
+			case now := <-ticker.C:
+				previous = m.saveBandwidthStats(previous, now)
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// GetBandwidthStats returns the hourly bytes exchanged by each waku protocol
+// since the given unix timestamp
+func (m *Messenger) GetBandwidthStats(since int64) ([]BandwidthStats, error) {
+	return m.persistence.BandwidthStats(time.Unix(since, 0).Truncate(time.Hour).Unix())
+}
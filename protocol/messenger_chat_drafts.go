@@ -0,0 +1,109 @@
+package protocol
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// SaveChatDraft stores the draft of a chat and syncs it to the paired devices,
+// an empty draft clears it
+func (m *Messenger) SaveChatDraft(ctx context.Context, draft *ChatDraft) (*ChatDraft, error) {
+	if _, ok := m.allChats.Load(draft.ChatID); !ok {
+		return nil, ErrChatNotFound
+	}
+
+	clock, _ := m.getLastClockWithRelatedChat()
+	draft.Clock = clock
+
+	err := m.persistence.SaveChatDraft(draft)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.syncChatDraft(ctx, draft, m.dispatchMessage)
+	if err != nil {
+		return nil, err
+	}
+	return draft, nil
+}
+
+// ClearChatDraft clears the draft of a chat, e.g. once it has been sent
+func (m *Messenger) ClearChatDraft(ctx context.Context, chatID string) error {
+	_, err := m.SaveChatDraft(ctx, &ChatDraft{ChatID: chatID})
+	return err
+}
+
+// ChatDraft returns the draft of a chat, and nil when there's none
+func (m *Messenger) ChatDraft(chatID string) (*ChatDraft, error) {
+	draft, err := m.persistence.ChatDraft(chatID)
+	if err != nil || draft == nil || draft.IsEmpty() {
+		return nil, err
+	}
+	return draft, nil
+}
+
+func (m *Messenger) ChatDrafts() ([]*ChatDraft, error) {
+	return m.persistence.ChatDrafts()
+}
+
+func (m *Messenger) syncChatDraft(ctx context.Context, draft *ChatDraft, rawMessageHandler RawMessageHandler) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+
+	_, chat := m.getLastClockWithRelatedChat()
+	encodedMessage, err := proto.Marshal(draft.ToSyncProtobuf())
+	if err != nil {
+		return err
+	}
+
+	_, err = rawMessageHandler(ctx, common.RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_CHAT_DRAFT,
+		ResendAutomatically: true,
+	})
+	return err
+}
+
+func (m *Messenger) syncChatDrafts(ctx context.Context, rawMessageHandler RawMessageHandler) error {
+	drafts, err := m.persistence.ChatDrafts()
+	if err != nil {
+		return err
+	}
+
+	for _, draft := range drafts {
+		err = m.syncChatDraft(ctx, draft, rawMessageHandler)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Messenger) handleSyncChatDraft(state *ReceivedMessageState, message *protobuf.SyncChatDraft) error {
+	if _, ok := m.allChats.Load(message.ChatId); !ok {
+		return ErrChatNotFound
+	}
+
+	existing, err := m.persistence.ChatDraft(message.ChatId)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.Clock >= message.Clock {
+		return nil
+	}
+
+	draft := chatDraftFromSyncProtobuf(message)
+	err = m.persistence.SaveChatDraft(draft)
+	if err != nil {
+		return err
+	}
+
+	state.Response.ChatDrafts = append(state.Response.ChatDrafts, draft)
+	return nil
+}
@@ -0,0 +1,275 @@
+package protocol
+
+import (
+	"bufio"
+	"encoding/json"
+	"html/template"
+	"io"
+	"os"
+	"time"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+const exportChatHistoryBatchSize = 500
+
+type ExportedChatHistory struct {
+	ChatID        string `json:"chatId"`
+	ChatName      string `json:"chatName"`
+	Path          string `json:"path"`
+	MessagesCount int    `json:"messagesCount"`
+}
+
+type exportedChat struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	ExportedAt uint64 `json:"exportedAt"`
+	From       uint64 `json:"from,omitempty"`
+	To         uint64 `json:"to,omitempty"`
+}
+
+// exportedMedia references the media attached to a message, the payload
+// itself is not embedded in the export and can be looked up by message ID
+type exportedMedia struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	Hash string `json:"hash,omitempty"`
+}
+
+type exportedMessage struct {
+	ID          string         `json:"id"`
+	From        string         `json:"from"`
+	Author      string         `json:"author"`
+	Clock       uint64         `json:"clock"`
+	Timestamp   uint64         `json:"timestamp"`
+	ContentType string         `json:"contentType"`
+	Text        string         `json:"text,omitempty"`
+	ResponseTo  string         `json:"responseTo,omitempty"`
+	EditedAt    uint64         `json:"editedAt,omitempty"`
+	Media       *exportedMedia `json:"media,omitempty"`
+}
+
+func (e *exportedMessage) Time() string {
+	return time.UnixMilli(int64(e.Timestamp)).UTC().Format(time.RFC3339)
+}
+
+type chatHistoryWriter interface {
+	WriteHeader(chat *exportedChat) error
+	WriteMessage(message *exportedMessage) error
+	WriteFooter() error
+}
+
+type jsonChatHistoryWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (j *jsonChatHistoryWriter) WriteHeader(chat *exportedChat) error {
+	encodedChat, err := json.Marshal(chat)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(j.w, `{"chat":`+string(encodedChat)+`,"messages":[`)
+	return err
+}
+
+func (j *jsonChatHistoryWriter) WriteMessage(message *exportedMessage) error {
+	encodedMessage, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	if j.count > 0 {
+		if _, err = io.WriteString(j.w, ","); err != nil {
+			return err
+		}
+	}
+	j.count++
+	_, err = j.w.Write(encodedMessage)
+	return err
+}
+
+func (j *jsonChatHistoryWriter) WriteFooter() error {
+	_, err := io.WriteString(j.w, "]}\n")
+	return err
+}
+
+var chatHistoryHTMLHeader = template.Must(template.New("header").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Name}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+.message { margin-bottom: 1em; }
+.author { font-weight: bold; }
+.time, .meta { color: #939ba1; font-size: 0.85em; }
+.text { white-space: pre-wrap; }
+</style>
+</head>
+<body>
+<h1>{{.Name}}</h1>
+<div class="meta">{{.ID}}</div>
+`))
+
+var chatHistoryHTMLMessage = template.Must(template.New("message").Parse(`<div class="message" id="{{.ID}}">
+<span class="author" title="{{.From}}">{{.Author}}</span> <span class="time">{{.Time}}</span>{{if .EditedAt}} <span class="meta">(edited)</span>{{end}}
+{{if .ResponseTo}}<div class="meta">In reply to <a href="#{{.ResponseTo}}">{{.ResponseTo}}</a></div>
+{{end}}{{if .Text}}<div class="text">{{.Text}}</div>
+{{end}}{{with .Media}}<div class="meta">[{{.Type}}: {{.ID}}{{if .Hash}} {{.Hash}}{{end}}]</div>
+{{end}}</div>
+`))
+
+type htmlChatHistoryWriter struct {
+	w io.Writer
+}
+
+func (h *htmlChatHistoryWriter) WriteHeader(chat *exportedChat) error {
+	return chatHistoryHTMLHeader.Execute(h.w, chat)
+}
+
+func (h *htmlChatHistoryWriter) WriteMessage(message *exportedMessage) error {
+	return chatHistoryHTMLMessage.Execute(h.w, message)
+}
+
+func (h *htmlChatHistoryWriter) WriteFooter() error {
+	_, err := io.WriteString(h.w, "</body>\n</html>\n")
+	return err
+}
+
+// ExportChatHistory writes the messages of a chat in the requested time range
+// to a file, either as JSON or as a standalone HTML document.
+// Messages are fetched and written in batches, so that large chats are
+// not loaded in memory at once.
+func (m *Messenger) ExportChatHistory(request *requests.ExportChatHistory) (*ExportedChatHistory, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	chat, ok := m.allChats.Load(request.ChatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+
+	file, err := os.Create(request.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := m.exportChatHistory(file, chat, request)
+	closeErr := file.Close()
+	if err != nil {
+		_ = os.Remove(request.Path)
+		return nil, err
+	}
+	if closeErr != nil {
+		return nil, closeErr
+	}
+
+	return &ExportedChatHistory{
+		ChatID:        chat.ID,
+		ChatName:      chat.Name,
+		Path:          request.Path,
+		MessagesCount: count,
+	}, nil
+}
+
+func (m *Messenger) exportChatHistory(w io.Writer, chat *Chat, request *requests.ExportChatHistory) (int, error) {
+	buffered := bufio.NewWriter(w)
+
+	var writer chatHistoryWriter
+	switch request.Format {
+	case requests.ExportChatHistoryFormatHTML:
+		writer = &htmlChatHistoryWriter{w: buffered}
+	default:
+		writer = &jsonChatHistoryWriter{w: buffered}
+	}
+
+	err := writer.WriteHeader(&exportedChat{
+		ID:         chat.ID,
+		Name:       chat.Name,
+		ExportedAt: m.getTimesource().GetCurrentTime(),
+		From:       request.From,
+		To:         request.To,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	authors := make(map[string]string)
+	count := 0
+	cursor := ""
+	for {
+		var messages []*common.Message
+		messages, cursor, err = m.persistence.MessagesByChatIDInTimeRange(chat.ID, request.From, request.To, cursor, exportChatHistoryBatchSize)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, message := range messages {
+			err = writer.WriteMessage(m.toExportedMessage(message, authors))
+			if err != nil {
+				return 0, err
+			}
+			count++
+		}
+
+		if cursor == "" {
+			break
+		}
+	}
+
+	err = writer.WriteFooter()
+	if err != nil {
+		return 0, err
+	}
+
+	return count, buffered.Flush()
+}
+
+func (m *Messenger) exportedAuthorName(message *common.Message, authors map[string]string) string {
+	if name, ok := authors[message.From]; ok {
+		return name
+	}
+
+	name := message.Alias
+	if message.From == m.myHexIdentity() {
+		displayName, err := m.settings.DisplayName()
+		if err == nil && displayName != "" {
+			name = displayName
+		}
+	} else if contact := m.GetContactByID(message.From); contact != nil {
+		name = contact.PrimaryName()
+	}
+
+	authors[message.From] = name
+	return name
+}
+
+func (m *Messenger) toExportedMessage(message *common.Message, authors map[string]string) *exportedMessage {
+	exported := &exportedMessage{
+		ID:          message.ID,
+		From:        message.From,
+		Author:      m.exportedAuthorName(message, authors),
+		Clock:       message.Clock,
+		Timestamp:   message.Timestamp,
+		ContentType: message.ContentType.String(),
+		Text:        message.Text,
+		ResponseTo:  message.ResponseTo,
+		EditedAt:    message.EditedAt,
+	}
+
+	switch message.ContentType {
+	case protobuf.ChatMessage_IMAGE:
+		exported.Media = &exportedMedia{Type: "image", ID: message.ID}
+	case protobuf.ChatMessage_AUDIO:
+		exported.Media = &exportedMedia{Type: "audio", ID: message.ID}
+	case protobuf.ChatMessage_STICKER:
+		if sticker := message.GetSticker(); sticker != nil {
+			exported.Media = &exportedMedia{Type: "sticker", ID: message.ID, Hash: sticker.Hash}
+		}
+	}
+
+	return exported
+}
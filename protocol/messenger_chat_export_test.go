@@ -0,0 +1,139 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerChatExportSuite(t *testing.T) {
+	suite.Run(t, new(MessengerChatExportSuite))
+}
+
+type MessengerChatExportSuite struct {
+	suite.Suite
+	m          *Messenger        // main instance of Messenger
+	privateKey *ecdsa.PrivateKey // private key for the main instance of Messenger
+	// If one wants to send messages between different instances of Messenger,
+	// a single waku service should be shared.
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerChatExportSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	s.m, err = newMessengerWithKey(s.shh, privateKey, s.logger, nil)
+	s.Require().NoError(err)
+	s.privateKey = s.m.identity
+	_, err = s.m.Start()
+	s.Require().NoError(err)
+}
+
+func (s *MessengerChatExportSuite) TearDownTest() {
+	s.Require().NoError(s.m.Shutdown())
+}
+
+func (s *MessengerChatExportSuite) saveTestMessages(chat *Chat) []*common.Message {
+	var messages []*common.Message
+	for i, text := range []string{"first", "<b>second</b>", "third"} {
+		message := buildTestMessage(*chat)
+		message.ID = types.EncodeHex([]byte{byte(i + 1)})
+		message.Text = text
+		message.Timestamp = uint64(1000 * (i + 1))
+		message.From = s.m.myHexIdentity()
+		messages = append(messages, message)
+	}
+	messages[2].ContentType = protobuf.ChatMessage_IMAGE
+
+	s.Require().NoError(s.m.SaveMessages(messages))
+	return messages
+}
+
+func (s *MessengerChatExportSuite) TestExportChatHistoryJSON() {
+	chat := CreatePublicChat("test-chat", s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+	messages := s.saveTestMessages(chat)
+
+	path := filepath.Join(s.T().TempDir(), "history.json")
+	exported, err := s.m.ExportChatHistory(&requests.ExportChatHistory{
+		ChatID: chat.ID,
+		Format: requests.ExportChatHistoryFormatJSON,
+		Path:   path,
+		From:   2000,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(2, exported.MessagesCount)
+
+	data, err := ioutil.ReadFile(path)
+	s.Require().NoError(err)
+
+	var result struct {
+		Chat     exportedChat       `json:"chat"`
+		Messages []*exportedMessage `json:"messages"`
+	}
+	s.Require().NoError(json.Unmarshal(data, &result))
+	s.Require().Equal(chat.ID, result.Chat.ID)
+	s.Require().Len(result.Messages, 2)
+	s.Require().Equal(messages[1].ID, result.Messages[0].ID)
+	s.Require().Equal(messages[2].ID, result.Messages[1].ID)
+	s.Require().NotNil(result.Messages[1].Media)
+	s.Require().Equal("image", result.Messages[1].Media.Type)
+}
+
+func (s *MessengerChatExportSuite) TestExportChatHistoryHTML() {
+	chat := CreatePublicChat("test-chat", s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+	s.saveTestMessages(chat)
+
+	path := filepath.Join(s.T().TempDir(), "history.html")
+	exported, err := s.m.ExportChatHistory(&requests.ExportChatHistory{
+		ChatID: chat.ID,
+		Format: requests.ExportChatHistoryFormatHTML,
+		Path:   path,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(3, exported.MessagesCount)
+
+	data, err := ioutil.ReadFile(path)
+	s.Require().NoError(err)
+
+	html := string(data)
+	s.Require().True(strings.HasPrefix(html, "<!DOCTYPE html>"))
+	s.Require().Contains(html, "first")
+	s.Require().Contains(html, "&lt;b&gt;second&lt;/b&gt;")
+	s.Require().NotContains(html, "<b>second</b>")
+}
+
+func (s *MessengerChatExportSuite) TestExportChatHistoryUnknownChat() {
+	_, err := s.m.ExportChatHistory(&requests.ExportChatHistory{
+		ChatID: "unknown",
+		Format: requests.ExportChatHistoryFormatJSON,
+		Path:   filepath.Join(s.T().TempDir(), "history.json"),
+	})
+	s.Require().ErrorIs(err, ErrChatNotFound)
+}
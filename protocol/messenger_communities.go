@@ -39,6 +39,7 @@ import (
 	v1protocol "github.com/status-im/status-go/protocol/v1"
 	localnotifications "github.com/status-im/status-go/services/local-notifications"
 	"github.com/status-im/status-go/signal"
+	"github.com/status-im/status-go/transactions"
 )
 
 // 7 days interval
@@ -56,6 +57,15 @@ const (
 	importInitialDelay      = time.Minute * 5
 )
 
+// A delta is published instead of the full community description when it's at
+// least this many times smaller
+const communityDescriptionDeltaMaxRatio = 4
+
+// The full description of a community is published at most once per interval
+// on the request of members who missed a delta, who request it at most once
+// per interval
+const communityDescriptionRequestInterval = time.Minute
+
 const (
 	maxChunkSizeMessages = 1000
 	maxChunkSizeBytes    = 1500000
@@ -66,23 +76,128 @@ func (m *Messenger) publishOrg(org *communities.Community) error {
 		return nil
 	}
 
-	m.logger.Debug("publishing org", zap.String("org-id", org.IDString()), zap.Any("org", org))
 	payload, err := org.MarshaledDescription()
 	if err != nil {
 		return err
 	}
 
+	return m.sendOrgDescription(org, payload)
+}
+
+func (m *Messenger) sendOrgDescription(org *communities.Community, payload []byte) error {
+	m.logger.Debug("publishing org", zap.String("org-id", org.IDString()), zap.Any("org", org))
+
 	rawMessage := common.RawMessage{
 		Payload: payload,
 		Sender:  org.PrivateKey(),
 		// we don't want to wrap in an encryption layer message
 		SkipProtocolLayer: true,
 		MessageType:       protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION,
+		Compress:          org.MembersSupport(protobuf.CommunityMember_CAPABILITY_COMPRESSION),
 	}
-	_, err = m.sender.SendPublic(context.Background(), org.IDString(), rawMessage)
+	_, err := m.sender.SendPublic(context.Background(), org.IDString(), rawMessage)
 	return err
 }
 
+// descriptionDeltaState is the state of the publication of the descriptions
+// of the communities we own as deltas, only used by the communities
+// subscription loop
+type descriptionDeltaState struct {
+	// The last description published of each community, the following
+	// changes are published as a delta against it
+	published map[string]*protobuf.CommunityDescription
+	// When the full description of each community was last published on
+	// the request of a member
+	lastRequested map[string]time.Time
+}
+
+func newDescriptionDeltaState() *descriptionDeltaState {
+	return &descriptionDeltaState{
+		published:     make(map[string]*protobuf.CommunityDescription),
+		lastRequested: make(map[string]time.Time),
+	}
+}
+
+// publishOrgUpdate publishes the changes to the description of the community
+// since the last one published as a delta, or the full description when
+// there's no previous one, the delta isn't much smaller or some members
+// haven't advertised that they're able to apply deltas
+func (m *Messenger) publishOrgUpdate(org *communities.Community, state *descriptionDeltaState) error {
+	if org == nil || org.PrivateKey() == nil {
+		return m.publishOrg(org)
+	}
+
+	description, payload, err := org.DescriptionSnapshot()
+	if err != nil {
+		return err
+	}
+
+	base, ok := state.published[org.IDString()]
+	if ok && base.Clock < description.Clock && org.MembersSupport(protobuf.CommunityMember_CAPABILITY_DESCRIPTION_DELTAS) {
+		delta, err := communities.MarshaledDescriptionDelta(base, description, payload, org.PrivateKey())
+		if err != nil {
+			return err
+		}
+
+		if len(delta)*communityDescriptionDeltaMaxRatio <= len(payload) {
+			rawMessage := common.RawMessage{
+				Payload:           delta,
+				Sender:            org.PrivateKey(),
+				SkipProtocolLayer: true,
+				MessageType:       protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION_DELTA,
+				Compress:          org.MembersSupport(protobuf.CommunityMember_CAPABILITY_COMPRESSION),
+			}
+			_, err = m.sender.SendPublic(context.Background(), org.IDString(), rawMessage)
+			if err != nil {
+				return err
+			}
+			state.published[org.IDString()] = description
+			return nil
+		}
+	}
+
+	err = m.sendOrgDescription(org, payload)
+	if err != nil {
+		return err
+	}
+	state.published[org.IDString()] = description
+	return nil
+}
+
+// publishRequestedOrg publishes the full description of a community we own
+// on the request of a member who missed a delta
+func (m *Messenger) publishRequestedOrg(communityID string, state *descriptionDeltaState) error {
+	if time.Since(state.lastRequested[communityID]) < communityDescriptionRequestInterval {
+		return nil
+	}
+
+	org, err := m.communitiesManager.GetByIDString(communityID)
+	if err != nil {
+		return err
+	}
+	if org == nil || org.PrivateKey() == nil {
+		return nil
+	}
+
+	description, err := m.publishFullOrg(org)
+	if err != nil {
+		return err
+	}
+	state.published[communityID] = description
+	state.lastRequested[communityID] = time.Now()
+	return nil
+}
+
+// publishFullOrg publishes the full description of a community we own and
+// returns the description published
+func (m *Messenger) publishFullOrg(org *communities.Community) (*protobuf.CommunityDescription, error) {
+	description, payload, err := org.DescriptionSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return description, m.sendOrgDescription(org, payload)
+}
+
 func (m *Messenger) publishOrgInvitation(org *communities.Community, invitation *protobuf.CommunityInvitation) error {
 	m.logger.Debug("publishing org invitation", zap.String("org-id", org.IDString()), zap.Any("org", org))
 	pk, err := crypto.DecompressPubkey(invitation.PublicKey)
@@ -131,6 +246,59 @@ func (m *Messenger) publishCommunityAdminEvent(adminEvent *protobuf.CommunityAdm
 	return err
 }
 
+// sendCommunityBan sends a ban, with its reason, to the control node and
+// the admins of the community so that it's recorded in their moderation log.
+// It's never published on the community topic
+func (m *Messenger) sendCommunityBan(ban *protobuf.CommunityBan) error {
+	community, err := m.communitiesManager.GetByID(ban.CommunityId)
+	if err != nil {
+		return err
+	}
+	if community == nil {
+		return communities.ErrOrgNotFound
+	}
+
+	payload, err := proto.Marshal(ban)
+	if err != nil {
+		return err
+	}
+
+	rawMessage := common.RawMessage{
+		Payload:           payload,
+		CommunityID:       community.ID(),
+		SkipProtocolLayer: true,
+		MessageType:       protobuf.ApplicationMetadataMessage_COMMUNITY_BAN,
+	}
+
+	if !community.IsOwner() {
+		_, err = m.sender.SendCommunityMessage(context.Background(), rawMessage)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, admin := range community.GetMemberAdmins() {
+		if common.IsPubKeyEqual(admin, &m.identity.PublicKey) {
+			continue
+		}
+		_, err := m.sender.SendPrivate(context.Background(), admin, &rawMessage)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleCommunityBan records in our moderation log a ban sent by another
+// owner or admin of the community
+func (m *Messenger) HandleCommunityBan(state *ReceivedMessageState, signer *ecdsa.PublicKey, message protobuf.CommunityBan) error {
+	if err := ValidateCommunityBan(message); err != nil {
+		return err
+	}
+
+	return m.communitiesManager.HandleCommunityBan(signer, &message)
+}
+
 func (m *Messenger) handleCommunitiesHistoryArchivesSubscription(c chan *communities.Subscription) {
 
 	go func() {
@@ -215,6 +383,8 @@ func (m *Messenger) handleCommunitiesSubscription(c chan *communities.Subscripti
 	// We check every 5 minutes if we need to publish
 	ticker := time.NewTicker(5 * time.Minute)
 
+	deltaState := newDescriptionDeltaState()
+
 	go func() {
 		for {
 			select {
@@ -223,7 +393,7 @@ func (m *Messenger) handleCommunitiesSubscription(c chan *communities.Subscripti
 					return
 				}
 				if sub.Community != nil {
-					err := m.publishOrg(sub.Community)
+					err := m.publishOrgUpdate(sub.Community, deltaState)
 					if err != nil {
 						m.logger.Warn("failed to publish org", zap.Error(err))
 					}
@@ -242,6 +412,13 @@ func (m *Messenger) handleCommunitiesSubscription(c chan *communities.Subscripti
 						}
 					}
 
+					if sub.ChannelMembersRemovedSignal != nil && m.config.messengerSignalsHandler != nil {
+						m.config.messengerSignalsHandler.CommunityChannelMembersRemoved(
+							sub.ChannelMembersRemovedSignal.CommunityID,
+							sub.ChannelMembersRemovedSignal.Members,
+						)
+					}
+
 					m.logger.Debug("published org")
 				}
 
@@ -252,6 +429,13 @@ func (m *Messenger) handleCommunitiesSubscription(c chan *communities.Subscripti
 					}
 				}
 
+				if sub.CommunityBan != nil {
+					err := m.sendCommunityBan(sub.CommunityBan)
+					if err != nil {
+						m.logger.Warn("failed to send community ban", zap.Error(err))
+					}
+				}
+
 			case <-ticker.C:
 				// If we are not online, we don't even try
 				if !m.online() {
@@ -272,16 +456,28 @@ func (m *Messenger) handleCommunitiesSubscription(c chan *communities.Subscripti
 					org := orgs[idx]
 					_, beingImported := m.importingCommunities[org.IDString()]
 					if !beingImported {
-						err := m.publishOrg(org)
+						m.advertiseCommunityCapabilities(org)
+
+						// The full description is published periodically, so
+						// that the members who missed a delta catch up
+						description, err := m.publishFullOrg(org)
 						if err != nil {
 							m.logger.Warn("failed to publish org", zap.Error(err))
+							continue
 						}
+						deltaState.published[org.IDString()] = description
 					}
 				}
 
 				// set lastPublished
 				lastPublished = time.Now().Unix()
 
+			case communityID := <-m.communityDescriptionRequests:
+				err := m.publishRequestedOrg(communityID, deltaState)
+				if err != nil {
+					m.logger.Warn("failed to publish requested org", zap.Error(err))
+				}
+
 			case <-m.quit:
 				return
 
@@ -382,31 +578,33 @@ func (m *Messenger) SpectatedCommunities() ([]*communities.Community, error) {
 	return m.communitiesManager.Spectated()
 }
 
-func (m *Messenger) CuratedCommunities() (*communities.KnownCommunitiesResponse, error) {
+// fetchCuratedCommunities returns the communities and featured communities
+// listed in the curated communities contract
+func (m *Messenger) fetchCuratedCommunities() ([]types.HexBytes, []types.HexBytes, error) {
 	// Revert code to https://github.com/status-im/status-go/blob/e6a3f63ec7f2fa691878ed35f921413dc8acfc66/protocol/messenger_communities.go#L211-L226 once the curated communities contract is deployed to mainnet
 
 	chainID := uint64(420) // Optimism Goerli
 	sDB, err := accounts.NewDB(m.database)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	nodeConfig, err := sDB.GetNodeConfig()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	var backend *ethclient.Client
 	for _, n := range nodeConfig.Networks {
 		if n.ChainID == chainID {
 			b, err := ethclient.Dial(n.RPCURL)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			backend = b
 		}
 	}
 	directory, err := m.contractMaker.NewDirectoryWithBackend(chainID, backend)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	// --- end delete
 
@@ -414,24 +612,61 @@ func (m *Messenger) CuratedCommunities() (*communities.KnownCommunitiesResponse,
 
 	communities, err := directory.GetCommunities(callOpts)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	var communityIDs []types.HexBytes
 	for _, c := range communities {
 		communityIDs = append(communityIDs, c)
 	}
 
-	response, err := m.communitiesManager.GetStoredDescriptionForCommunities(communityIDs)
+	featuredCommunities, err := directory.GetFeaturedCommunities(callOpts)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	var featuredIDs []types.HexBytes
+	for _, c := range featuredCommunities {
+		featuredIDs = append(featuredIDs, c)
 	}
 
-	featuredCommunities, err := directory.GetFeaturedCommunities(callOpts)
+	return communityIDs, featuredIDs, nil
+}
+
+// CuratedCommunities returns the curated communities directory, the directory
+// is cached so that the last fetched one is returned if the contract is not
+// reachable
+func (m *Messenger) CuratedCommunities() (*communities.KnownCommunitiesResponse, error) {
+	communityIDs, featuredIDs, err := m.fetchCuratedCommunities()
+	if err == nil {
+		err = m.communitiesManager.SaveDirectory(communityIDs, featuredIDs)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		m.logger.Warn("failed to fetch curated communities, using cached directory", zap.Error(err))
+
+		entries, dirErr := m.communitiesManager.Directory()
+		if dirErr != nil {
+			return nil, dirErr
+		}
+		if len(entries) == 0 {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			communityIDs = append(communityIDs, entry.CommunityID)
+			if entry.Featured {
+				featuredIDs = append(featuredIDs, entry.CommunityID)
+			}
+		}
+	}
+
+	response, err := m.communitiesManager.GetStoredDescriptionForCommunities(communityIDs)
 	if err != nil {
 		return nil, err
 	}
-	for _, c := range featuredCommunities {
-		response.ContractFeaturedCommunities = append(response.ContractFeaturedCommunities, types.HexBytes(c).String())
+
+	for _, c := range featuredIDs {
+		response.ContractFeaturedCommunities = append(response.ContractFeaturedCommunities, c.String())
 	}
 
 	go m.requestCommunitiesFromMailserver(response.UnknownCommunities)
@@ -439,6 +674,30 @@ func (m *Messenger) CuratedCommunities() (*communities.KnownCommunitiesResponse,
 	return response, nil
 }
 
+// SearchCuratedCommunities searches the cached curated communities directory,
+// ranking the results locally
+func (m *Messenger) SearchCuratedCommunities(request *requests.SearchCommunityDirectory) ([]*communities.RankedCommunity, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	result, err := m.communitiesManager.SearchDirectory(&communities.DirectoryFilter{
+		Query:                    request.Query,
+		Tags:                     request.Tags,
+		Language:                 request.Language,
+		WithoutTokenRequirements: request.WithoutTokenRequirements,
+		FeaturedOnly:             request.FeaturedOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Limit > 0 && len(result) > request.Limit {
+		result = result[:request.Limit]
+	}
+	return result, nil
+}
+
 func (m *Messenger) initCommunityChats(community *communities.Community) ([]*Chat, error) {
 	logger := m.logger.Named("initCommunityChats")
 
@@ -612,7 +871,11 @@ func (m *Messenger) SetMuted(request *requests.MuteCommunity) error {
 		return err
 	}
 	if request.MutedType == Unmuted {
-		return m.communitiesManager.SetMuted(request.CommunityID, false, time.Time{})
+		community, err := m.setCommunityMuted(request.CommunityID, false, time.Time{})
+		if err != nil {
+			return err
+		}
+		return m.recordMute(context.Background(), "", community.IDString(), false, time.Time{})
 	}
 	var MuteTill time.Time
 
@@ -635,7 +898,11 @@ func (m *Messenger) SetMuted(request *requests.MuteCommunity) error {
 	if err != nil {
 		return err
 	}
-	return m.communitiesManager.SetMuted(request.CommunityID, true, muteTillTimeRemoveMs)
+	community, err := m.setCommunityMuted(request.CommunityID, true, muteTillTimeRemoveMs)
+	if err != nil {
+		return err
+	}
+	return m.recordMute(context.Background(), "", community.IDString(), true, muteTillTimeRemoveMs)
 }
 
 func (m *Messenger) SetMutePropertyOnChatsByCategory(request *requests.MuteCategory, muted bool) error {
@@ -704,6 +971,21 @@ func (m *Messenger) RequestToJoinCommunity(request *requests.RequestToJoinCommun
 		RevealedAccounts: make([]*protobuf.RevealedAccount, 0),
 	}
 
+	profile, err := m.myCommunityProfile(community.IDString())
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		requestToJoinProto.Profile = profile.toProtobuf(community.ID())
+	}
+
+	if request.MembershipPaymentTxHash != "" {
+		requestToJoinProto.MembershipPayment = &protobuf.CommunityMembershipPaymentProof{
+			ChainId:         request.MembershipPaymentChainID,
+			TransactionHash: request.MembershipPaymentTxHash,
+		}
+	}
+
 	// find wallet accounts and attach wallet addresses and
 	// signatures to request
 	if request.Password != "" {
@@ -751,13 +1033,18 @@ func (m *Messenger) RequestToJoinCommunity(request *requests.RequestToJoinCommun
 				revealedAddress := gethcommon.HexToAddress(verifiedAccount.Address.Hex())
 				revealedAddresses = append(revealedAddresses, revealedAddress)
 				revealedAccounts[revealedAddress] = &protobuf.RevealedAccount{
-					Address:   verifiedAccount.Address.Hex(),
-					Signature: signatureBytes,
-					ChainIds:  make([]uint64, 0),
+					Address:          verifiedAccount.Address.Hex(),
+					Signature:        signatureBytes,
+					ChainIds:         make([]uint64, 0),
+					IsAirdropAddress: strings.EqualFold(request.AirdropAddress, verifiedAccount.Address.Hex()),
 				}
 			}
 		}
 
+		if request.AirdropAddress == "" && len(revealedAddresses) > 0 {
+			revealedAccounts[revealedAddresses[0]].IsAirdropAddress = true
+		}
+
 		response, err := m.communitiesManager.CheckPermissionToJoin(community.ID(), revealedAddresses)
 		if err != nil {
 			return nil, err
@@ -1011,11 +1298,33 @@ func (m *Messenger) AcceptRequestToJoinCommunity(request *requests.AcceptRequest
 		return nil, err
 	}
 
-	grant, err := community.BuildGrant(pk, "")
+	err = m.SendKeyExchangeMessage(community.ID(), []*ecdsa.PublicKey{pk}, common.KeyExMsgReuse)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.sendRequestToJoinAcceptedResponse(community, pk)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+
+	err = m.updateRequestToJoinNotification(response, request.ID, true)
 	if err != nil {
 		return nil, err
 	}
 
+	return response, nil
+}
+
+func (m *Messenger) sendRequestToJoinAcceptedResponse(community *communities.Community, pk *ecdsa.PublicKey) error {
+	grant, err := community.BuildGrant(pk, "")
+	if err != nil {
+		return err
+	}
+
 	requestToJoinResponseProto := &protobuf.CommunityRequestToJoinResponse{
 		Clock:       community.Clock(),
 		Accepted:    true,
@@ -1024,23 +1333,19 @@ func (m *Messenger) AcceptRequestToJoinCommunity(request *requests.AcceptRequest
 		Grant:       grant,
 	}
 
-	if m.torrentClientReady() && m.communitiesManager.TorrentFileExists(community.IDString()) {
+	if m.historyArchivesReady() && m.communitiesManager.TorrentFileExists(community.IDString()) {
 		magnetlink, err := m.communitiesManager.GetHistoryArchiveMagnetlink(community.ID())
 		if err != nil {
 			m.logger.Warn("couldn't get magnet link for community", zap.Error(err))
-			return nil, err
+			return err
 		}
 		requestToJoinResponseProto.MagnetUri = magnetlink
+		requestToJoinResponseProto.ArchiveUris = m.communitiesManager.GetHistoryArchiveURIs(community.ID())
 	}
 
 	payload, err := proto.Marshal(requestToJoinResponseProto)
 	if err != nil {
-		return nil, err
-	}
-
-	err = m.SendKeyExchangeMessage(community.ID(), []*ecdsa.PublicKey{pk}, common.KeyExMsgReuse)
-	if err != nil {
-		return nil, err
+		return err
 	}
 
 	rawMessage := &common.RawMessage{
@@ -1051,33 +1356,38 @@ func (m *Messenger) AcceptRequestToJoinCommunity(request *requests.AcceptRequest
 	}
 
 	_, err = m.sender.SendPrivate(context.Background(), pk, rawMessage)
+	return err
+}
+
+// updateRequestToJoinNotification updates the activity center notification
+// of a request to join once it has been accepted or declined
+func (m *Messenger) updateRequestToJoinNotification(response *MessengerResponse, requestID types.HexBytes, accepted bool) error {
+	notification, err := m.persistence.GetActivityCenterNotificationByID(requestID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	response := &MessengerResponse{}
-	response.AddCommunity(community)
-
-	// Activity Center notification
-	notification, err := m.persistence.GetActivityCenterNotificationByID(request.ID)
-	if err != nil {
-		return nil, err
+	if notification == nil {
+		return nil
 	}
 
-	if notification != nil {
+	if accepted {
 		notification.MembershipStatus = ActivityCenterMembershipStatusAccepted
-		notification.Read = true
 		notification.Accepted = true
-		notification.UpdatedAt = m.getCurrentTimeInMillis()
+	} else {
+		notification.MembershipStatus = ActivityCenterMembershipStatusDeclined
+		notification.Dismissed = true
+	}
+	notification.Read = true
+	notification.UpdatedAt = m.getCurrentTimeInMillis()
 
-		err = m.addActivityCenterNotification(response, notification)
-		if err != nil {
-			m.logger.Error("failed to save notification", zap.Error(err))
-			return nil, err
-		}
+	err = m.addActivityCenterNotification(response, notification)
+	if err != nil {
+		m.logger.Error("failed to save notification", zap.Error(err))
+		return err
 	}
 
-	return response, nil
+	return nil
 }
 
 func (m *Messenger) DeclineRequestToJoinCommunity(request *requests.DeclineRequestToJoinCommunity) (*MessengerResponse, error) {
@@ -1090,23 +1400,66 @@ func (m *Messenger) DeclineRequestToJoinCommunity(request *requests.DeclineReque
 		return nil, err
 	}
 
-	// Activity Center notification
-	notification, err := m.persistence.GetActivityCenterNotificationByID(request.ID)
+	response := &MessengerResponse{}
+
+	err = m.updateRequestToJoinNotification(response, request.ID, false)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MessengerResponse{}
+	return response, nil
+}
 
-	if notification != nil {
-		notification.MembershipStatus = ActivityCenterMembershipStatusDeclined
-		notification.Read = true
-		notification.Dismissed = true
-		notification.UpdatedAt = m.getCurrentTimeInMillis()
+// ModerateRequestsToJoinCommunity accepts and declines pending requests to
+// join a community with a single update of the community.
+// Requests that can't be accepted because the user doesn't satisfy the
+// permissions to join are left pending.
+func (m *Messenger) ModerateRequestsToJoinCommunity(request *requests.ModerateRequestsToJoinCommunity) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, moderated, err := m.communitiesManager.ModerateRequestsToJoin(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var acceptedKeys []*ecdsa.PublicKey
+	for _, requestToJoin := range moderated {
+		if requestToJoin.State != communities.RequestToJoinStateAccepted {
+			continue
+		}
+		pk, err := common.HexToPubkey(requestToJoin.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		acceptedKeys = append(acceptedKeys, pk)
+	}
+
+	if len(acceptedKeys) > 0 {
+		err = m.SendKeyExchangeMessage(community.ID(), acceptedKeys, common.KeyExMsgReuse)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-		err = m.addActivityCenterNotification(response, notification)
+	for _, pk := range acceptedKeys {
+		err = m.sendRequestToJoinAcceptedResponse(community, pk)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	response.AddRequestsToJoinCommunity(moderated)
+
+	for _, requestToJoin := range moderated {
+		if requestToJoin.State == communities.RequestToJoinStatePending {
+			continue
+		}
+		err = m.updateRequestToJoinNotification(response, requestToJoin.ID, requestToJoin.State == communities.RequestToJoinStateAccepted)
 		if err != nil {
-			m.logger.Error("failed to save notification", zap.Error(err))
 			return nil, err
 		}
 	}
@@ -1492,6 +1845,31 @@ func (m *Messenger) EditCommunityTokenPermission(request *requests.EditCommunity
 	return response, nil
 }
 
+// ReevaluateCommunityMembersPermissions checks right away the holdings of
+// the members of a community instead of waiting for the periodic check
+func (m *Messenger) ReevaluateCommunityMembersPermissions(request *requests.ReevaluateCommunityMembersPermissions) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.ReevaluateMembers(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+
+	return response, nil
+}
+
+// VerifyCommunitySoulboundTokens checks on chain that the members of a
+// community still hold its non-transferable tokens, the members whose tokens
+// were burned are re-evaluated against the community permissions
+func (m *Messenger) VerifyCommunitySoulboundTokens(communityID types.HexBytes) ([]*communities.SoulboundMemberVerification, error) {
+	return m.communitiesManager.VerifySoulboundTokens(communityID)
+}
+
 func (m *Messenger) DeleteCommunityTokenPermission(request *requests.DeleteCommunityTokenPermission) (*MessengerResponse, error) {
 	if err := request.Validate(); err != nil {
 		return nil, err
@@ -1530,7 +1908,7 @@ func (m *Messenger) EditCommunity(request *requests.EditCommunity) (*MessengerRe
 
 	id := community.ID()
 
-	if m.torrentClientReady() {
+	if m.historyArchivesReady() {
 		if !communitySettings.HistoryArchiveSupportEnabled {
 			m.communitiesManager.StopHistoryArchiveTasksInterval(id)
 		} else if !m.communitiesManager.IsSeedingHistoryArchiveTorrent(id) {
@@ -1593,7 +1971,7 @@ func (m *Messenger) ImportCommunity(ctx context.Context, key *ecdsa.PrivateKey)
 		return nil, err
 	}
 
-	if m.torrentClientReady() {
+	if m.historyArchivesReady() {
 		var communities []*communities.Community
 		communities = append(communities, community)
 		go m.InitHistoryArchiveTasks(communities)
@@ -1690,21 +2068,89 @@ func (m *Messenger) ShareCommunity(request *requests.ShareCommunity) (*Messenger
 			return nil, err
 		}
 
-		if err := response.Merge(r); err != nil {
-			return nil, err
+		if err := response.Merge(r); err != nil {
+			return nil, err
+		}
+	}
+
+	sendMessagesResponse, err := m.SendChatMessages(context.Background(), messages)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := response.Merge(sendMessagesResponse); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+type CommunityAnnouncementResult struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	ChatID      string         `json:"chatId"`
+	MessageID   string         `json:"messageId,omitempty"`
+	Error       string         `json:"error,omitempty"`
+}
+
+// BroadcastCommunityAnnouncement posts an announcement to a channel of each of
+// the given communities. All the channels are validated before anything is
+// sent, so that the announcement is either posted everywhere or nowhere,
+// unless sending itself fails, which is reported for each community
+func (m *Messenger) BroadcastCommunityAnnouncement(ctx context.Context, request *requests.BroadcastCommunityAnnouncement) (*MessengerResponse, []*CommunityAnnouncementResult, error) {
+	if err := request.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	communityIDs := make(map[string]bool)
+	var results []*CommunityAnnouncementResult
+	for _, chatID := range request.ChatIDs {
+		chat, ok := m.allChats.Load(chatID)
+		if !ok || !chat.CommunityChat() {
+			return nil, nil, ErrChatNotFound
+		}
+
+		community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if community == nil {
+			return nil, nil, communities.ErrOrgNotFound
+		}
+		if !community.IsOwner() {
+			return nil, nil, communities.ErrNotOwner
+		}
+		if communityIDs[chat.CommunityID] {
+			return nil, nil, errors.New("only one channel per community can be selected")
+		}
+		communityIDs[chat.CommunityID] = true
+
+		results = append(results, &CommunityAnnouncementResult{
+			CommunityID: community.ID(),
+			ChatID:      chatID,
+		})
+	}
+
+	response := &MessengerResponse{}
+	for _, result := range results {
+		message := &common.Message{}
+		message.ChatId = result.ChatID
+		message.Text = request.Text
+		message.ContentType = protobuf.ChatMessage_TEXT_PLAIN
+
+		messageResponse, err := m.sendChatMessage(ctx, message)
+		if err != nil {
+			m.logger.Error("failed to broadcast community announcement", zap.String("chatID", result.ChatID), zap.Error(err))
+			result.Error = err.Error()
+			continue
+		}
+		result.MessageID = message.ID
+
+		if err := response.Merge(messageResponse); err != nil {
+			return nil, nil, err
 		}
 	}
 
-	sendMessagesResponse, err := m.SendChatMessages(context.Background(), messages)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := response.Merge(sendMessagesResponse); err != nil {
-		return nil, err
-	}
-
-	return response, nil
+	return response, results, nil
 }
 
 func (m *Messenger) MyCanceledRequestsToJoin() ([]*communities.RequestToJoin, error) {
@@ -1719,6 +2165,25 @@ func (m *Messenger) PendingRequestsToJoinForCommunity(id types.HexBytes) ([]*com
 	return m.communitiesManager.PendingRequestsToJoinForCommunity(id)
 }
 
+func (m *Messenger) FilterPendingRequestsToJoinCommunity(request *requests.PendingRequestsToJoinCommunity) ([]*communities.RequestToJoin, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	return m.communitiesManager.FilterPendingRequestsToJoin(request)
+}
+
+// GetCommunityMembersPage returns a page of the members of the community, or
+// of one of its chats, so that clients can list the members of large
+// communities page by page
+func (m *Messenger) GetCommunityMembersPage(request *requests.GetCommunityMembersPage) (*communities.CommunityMembersPage, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	return m.communitiesManager.GetMembersPage(request.CommunityID, request.ChatID, request.Cursor, request.Limit)
+}
+
 func (m *Messenger) DeclinedRequestsToJoinForCommunity(id types.HexBytes) ([]*communities.RequestToJoin, error) {
 	return m.communitiesManager.DeclinedRequestsToJoinForCommunity(id)
 }
@@ -1793,10 +2258,173 @@ func (m *Messenger) BanUserFromCommunity(request *requests.BanUserFromCommunity)
 		return nil, err
 	}
 
+	if request.DeleteAllMessages {
+		err = m.deleteCommunityMemberMessages(response, community.IDString(), request.User.String(), common.PubkeyToHex(&m.identity.PublicKey))
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.publishDeleteCommunityMemberMessages(community, request.User.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	response.AddCommunity(community)
 	return response, nil
 }
 
+func (m *Messenger) publishDeleteCommunityMemberMessages(community *communities.Community, memberID string) error {
+	deleteMessages := &protobuf.DeleteCommunityMemberMessages{
+		Clock:       m.getTimesource().GetCurrentTime(),
+		CommunityId: community.ID(),
+		MemberId:    memberID,
+	}
+
+	payload, err := proto.Marshal(deleteMessages)
+	if err != nil {
+		return err
+	}
+
+	rawMessage := common.RawMessage{
+		Payload: payload,
+		Sender:  m.identity,
+		// we don't want to wrap in an encryption layer message
+		SkipProtocolLayer: true,
+		MessageType:       protobuf.ApplicationMetadataMessage_DELETE_COMMUNITY_MEMBER_MESSAGES,
+	}
+
+	_, err = m.sender.SendPublic(context.Background(), community.IDString(), rawMessage)
+	return err
+}
+
+// HandleDeleteCommunityMemberMessages deletes the messages of a banned member
+// when requested by the owner or an admin of the community. When the member
+// isn't banned yet in the description we have, the deletion waits for it
+func (m *Messenger) HandleDeleteCommunityMemberMessages(state *ReceivedMessageState, signer *ecdsa.PublicKey, message protobuf.DeleteCommunityMemberMessages) error {
+	if err := ValidateDeleteCommunityMemberMessages(message); err != nil {
+		return err
+	}
+
+	community, err := m.communitiesManager.GetByID(message.CommunityId)
+	if err != nil {
+		return err
+	}
+	if community == nil {
+		return communities.ErrOrgNotFound
+	}
+
+	if !common.IsPubKeyEqual(community.PublicKey(), signer) && !community.IsMemberOwnerOrAdmin(signer) {
+		return ErrInvalidDeletePermission
+	}
+
+	member, err := common.HexToPubkey(message.MemberId)
+	if err != nil {
+		return err
+	}
+	if common.IsPubKeyEqual(community.PublicKey(), member) || community.IsMemberOwnerOrAdmin(member) {
+		return ErrInvalidDeletePermission
+	}
+
+	if !community.IsBanned(member) {
+		m.pendingMemberMessagesDeletionsLock.Lock()
+		m.pendingMemberMessagesDeletions[community.IDString()+message.MemberId] = common.PubkeyToHex(signer)
+		m.pendingMemberMessagesDeletionsLock.Unlock()
+		return nil
+	}
+
+	return m.deleteCommunityMemberMessages(state.Response, community.IDString(), message.MemberId, common.PubkeyToHex(signer))
+}
+
+// deleteBannedMembersMessages applies the deletions of the messages of the
+// members removed from the community that arrived before the description
+// banning them
+func (m *Messenger) deleteBannedMembersMessages(response *MessengerResponse, community *communities.Community, removed map[string]*protobuf.CommunityMember) {
+	for memberID := range removed {
+		member, err := common.HexToPubkey(memberID)
+		if err != nil || !community.IsBanned(member) {
+			continue
+		}
+
+		key := community.IDString() + memberID
+		m.pendingMemberMessagesDeletionsLock.Lock()
+		deletedBy, ok := m.pendingMemberMessagesDeletions[key]
+		delete(m.pendingMemberMessagesDeletions, key)
+		m.pendingMemberMessagesDeletionsLock.Unlock()
+		if !ok {
+			continue
+		}
+
+		err = m.deleteCommunityMemberMessages(response, community.IDString(), memberID, deletedBy)
+		if err != nil {
+			m.logger.Warn("failed to delete the messages of a banned member", zap.Error(err))
+		}
+	}
+}
+
+// deleteCommunityMemberMessages marks as deleted all the messages sent by a
+// member in the channels of a community
+func (m *Messenger) deleteCommunityMemberMessages(response *MessengerResponse, communityID string, memberID string, deletedBy string) error {
+	messages, err := m.persistence.CommunityMemberMessages(communityID, memberID)
+	if err != nil {
+		return err
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+
+	for _, message := range messages {
+		message.Deleted = true
+		message.DeletedBy = deletedBy
+	}
+	err = m.persistence.SaveMessages(messages)
+	if err != nil {
+		return err
+	}
+
+	var deletedNotifications []*ActivityCenterNotification
+	for _, message := range messages {
+		notifications, err := m.persistence.DeleteActivityCenterNotificationForMessage(message.LocalChatID, message.ID, m.getCurrentTimeInMillis())
+		if err != nil {
+			return err
+		}
+		deletedNotifications = append(deletedNotifications, notifications...)
+
+		response.AddRemovedMessage(&RemovedMessage{MessageID: message.ID, ChatID: message.LocalChatID, DeletedBy: deletedBy})
+		response.AddActivityCenterNotification(&ActivityCenterNotification{
+			ID:      types.FromHex(message.ID),
+			Deleted: true,
+		})
+
+		chat, ok := m.allChats.Load(message.LocalChatID)
+		if ok && chat.LastMessage != nil && chat.LastMessage.ID == message.ID {
+			chat.LastMessage = message
+			err = m.saveChat(chat)
+			if err != nil {
+				return err
+			}
+			response.AddChat(chat)
+		}
+	}
+
+	return m.syncActivityCenterNotifications(deletedNotifications)
+}
+
+// CommunityModerationLog returns the bans recorded for a community
+func (m *Messenger) CommunityModerationLog(communityID types.HexBytes) ([]*communities.ModerationLogEntry, error) {
+	return m.communitiesManager.ModerationLog(communityID)
+}
+
+// GetCommunityAuditLog returns a page of the changes applied to a community
+// by its privileged members, along with the cursor of the next page
+func (m *Messenger) GetCommunityAuditLog(request *requests.GetCommunityAuditLog) ([]*communities.AuditLogEntry, string, error) {
+	if err := request.Validate(); err != nil {
+		return nil, "", err
+	}
+
+	return m.communitiesManager.AuditLog(request.CommunityID, request.Cursor, request.Limit)
+}
+
 func (m *Messenger) AddRoleToMember(request *requests.AddRoleToMember) (*MessengerResponse, error) {
 	if err := request.Validate(); err != nil {
 		return nil, err
@@ -1825,6 +2453,119 @@ func (m *Messenger) RemoveRoleFromMember(request *requests.RemoveRoleFromMember)
 	return response, nil
 }
 
+func (m *Messenger) SetMemberRoles(request *requests.SetMemberRoles) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	community, err := m.communitiesManager.SetMemberRoles(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+// SetCommunitySnapshotSpaces links the Snapshot spaces where the members of
+// a community owned by the user vote on its proposals
+func (m *Messenger) SetCommunitySnapshotSpaces(request *requests.SetCommunitySnapshotSpaces) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	community, err := m.communitiesManager.SetSnapshotSpaces(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+func (m *Messenger) SetCommunityMembershipPayment(request *requests.SetCommunityMembershipPayment) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	community, err := m.communitiesManager.SetMembershipPayment(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+// GenerateCommunityMembershipPayment returns the transaction to be sent by
+// the wallet to pay for joining a community, its hash has then to be
+// attached to the request to join
+func (m *Messenger) GenerateCommunityMembershipPayment(communityID types.HexBytes, from types.Address) (*transactions.SendTxArgs, error) {
+	community, err := m.communitiesManager.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, communities.ErrOrgNotFound
+	}
+	if community.MembershipPayment() == nil {
+		return nil, communities.ErrNoMembershipPayment
+	}
+
+	return communities.MembershipPaymentTransaction(community.ID(), community.MembershipPayment(), from)
+}
+
+// verifyMembershipPayment verifies the payment of a pending request to join
+// on chain, away from the handling of the messages, and then accepts or
+// declines the request. When the payment can't be verified the request is
+// kept pending, so that it can be accepted manually
+func (m *Messenger) verifyMembershipPayment(requestToJoin *communities.RequestToJoin) {
+	go func() {
+		paid, err := m.communitiesManager.VerifyMembershipPayment(requestToJoin)
+		if err != nil {
+			m.logger.Error("failed to verify membership payment", zap.Error(err))
+			return
+		}
+
+		var response *MessengerResponse
+		if paid {
+			response, err = m.AcceptRequestToJoinCommunity(&requests.AcceptRequestToJoinCommunity{ID: requestToJoin.ID})
+		} else {
+			response, err = m.DeclineRequestToJoinCommunity(&requests.DeclineRequestToJoinCommunity{ID: requestToJoin.ID})
+		}
+		if err != nil {
+			m.logger.Error("failed to handle verified membership payment", zap.Error(err))
+			return
+		}
+
+		if m.config.messengerSignalsHandler != nil {
+			m.config.messengerSignalsHandler.MessengerResponse(response)
+		}
+	}()
+}
+
+// GetMemberRoles returns the roles of a member of a community along with
+// the permissions they grant
+func (m *Messenger) GetMemberRoles(communityID types.HexBytes, user types.HexBytes) (*communities.MemberRoles, error) {
+	publicKey, err := common.HexToPubkey(user.String())
+	if err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, communities.ErrOrgNotFound
+	}
+
+	return &communities.MemberRoles{
+		Roles:       community.MemberRoles(publicKey),
+		Permissions: community.MemberPermissions(publicKey),
+	}, nil
+}
+
 func (m *Messenger) findCommunityInfoFromDB(communityID string) (*communities.Community, error) {
 	id, err := hexutil.Decode(communityID)
 	if err != nil {
@@ -2112,6 +2853,120 @@ func (m *Messenger) handleCommunityDescription(state *ReceivedMessageState, sign
 	return m.handleCommunityResponse(state, communityResponse)
 }
 
+// handleCommunityDescriptionDelta applies a delta to the description of the
+// community. When the delta doesn't apply to the description we have, the
+// full description is requested from the control node
+func (m *Messenger) handleCommunityDescriptionDelta(state *ReceivedMessageState, delta *protobuf.CommunityDescriptionDelta) error {
+	communityResponse, err := m.communitiesManager.HandleCommunityDescriptionDeltaMessage(delta)
+	if err == communities.ErrDescriptionDeltaBaseMismatch {
+		if err := m.requestCommunityDescription(delta.CommunityId); err != nil {
+			m.logger.Warn("failed to request community description", zap.Error(err))
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if communityResponse == nil {
+		return nil
+	}
+
+	return m.handleCommunityResponse(state, communityResponse)
+}
+
+// advertiseCommunityCapabilities makes sure that the capabilities of this
+// client are recorded in our member entry of the description of the
+// community, so that the other members know what we're able to handle. The
+// control node records them itself, the other members ask it to
+func (m *Messenger) advertiseCommunityCapabilities(community *communities.Community) {
+	if !community.HasMember(&m.identity.PublicKey) || community.MemberHasCapabilities(&m.identity.PublicKey, communities.LocalCapabilities) {
+		return
+	}
+
+	var err error
+	if community.PrivateKey() != nil {
+		_, err = m.communitiesManager.SetMemberCapabilities(community.ID(), &m.identity.PublicKey, communities.LocalCapabilities)
+	} else {
+		err = m.requestCommunityDescription(community.ID())
+	}
+	if err != nil {
+		m.logger.Warn("failed to advertise community capabilities", zap.String("communityID", community.IDString()), zap.Error(err))
+	}
+}
+
+// requestCommunityDescription asks the control node of the community to
+// publish its full description, advertising our capabilities on the way
+func (m *Messenger) requestCommunityDescription(communityID types.HexBytes) error {
+	community, err := m.communitiesManager.GetByID(communityID)
+	if err != nil {
+		return err
+	}
+	if community == nil {
+		return communities.ErrOrgNotFound
+	}
+
+	m.requestedCommunityDescriptionsLock.Lock()
+	lastRequested := m.requestedCommunityDescriptions[community.IDString()]
+	if time.Since(lastRequested) < communityDescriptionRequestInterval {
+		m.requestedCommunityDescriptionsLock.Unlock()
+		return nil
+	}
+	m.requestedCommunityDescriptions[community.IDString()] = time.Now()
+	m.requestedCommunityDescriptionsLock.Unlock()
+
+	payload, err := proto.Marshal(&protobuf.CommunityDescriptionRequest{
+		CommunityId:  community.ID(),
+		Clock:        community.Clock(),
+		Capabilities: communities.LocalCapabilities,
+	})
+	if err != nil {
+		return err
+	}
+
+	rawMessage := common.RawMessage{
+		Payload:     payload,
+		MessageType: protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION_REQUEST,
+	}
+	_, err = m.sender.SendPublic(context.Background(), community.IDString(), rawMessage)
+	return err
+}
+
+// handleCommunityDescriptionRequest records the capabilities advertised by
+// the member requesting the description of a community we own, and publishes
+// the full description when they're behind
+func (m *Messenger) handleCommunityDescriptionRequest(state *ReceivedMessageState, request *protobuf.CommunityDescriptionRequest) error {
+	community, err := m.communitiesManager.GetByID(request.CommunityId)
+	if err != nil {
+		return err
+	}
+	if community == nil || community.PrivateKey() == nil {
+		return nil
+	}
+
+	signer := state.CurrentMessageState.PublicKey
+	if community.HasMember(signer) && !community.MemberHasCapabilities(signer, request.Capabilities) {
+		// The description is published with the member's capabilities
+		// by the communities subscription loop
+		_, err = m.communitiesManager.SetMemberCapabilities(community.ID(), signer, request.Capabilities)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if request.Clock >= community.Clock() {
+		return nil
+	}
+
+	// The description is published by the communities subscription loop,
+	// which rate limits the requests
+	select {
+	case m.communityDescriptionRequests <- community.IDString():
+	default:
+	}
+	return nil
+}
+
 func (m *Messenger) handleCommunityResponse(state *ReceivedMessageState, communityResponse *communities.CommunityResponse) error {
 	community := communityResponse.Community
 
@@ -2123,6 +2978,11 @@ func (m *Messenger) handleCommunityResponse(state *ReceivedMessageState, communi
 		return nil
 	}
 
+	m.advertiseCommunityCapabilities(community)
+
+	m.deleteBannedMembersMessages(state.Response, community, communityResponse.Changes.MembersRemoved)
+	m.syncBlocklistedMessages(community)
+
 	removedChatIDs := make([]string, 0)
 	for id := range communityResponse.Changes.ChatsRemoved {
 		chatID := community.IDString() + id
@@ -2612,8 +3472,9 @@ func (m *Messenger) dispatchMagnetlinkMessage(communityID string) error {
 	}
 
 	magnetLinkMessage := &protobuf.CommunityMessageArchiveMagnetlink{
-		Clock:     m.getTimesource().GetCurrentTime(),
-		MagnetUri: magnetlink,
+		Clock:       m.getTimesource().GetCurrentTime(),
+		MagnetUri:   magnetlink,
+		ArchiveUris: m.communitiesManager.GetHistoryArchiveURIs(community.ID()),
 	}
 
 	encodedMessage, err := proto.Marshal(magnetLinkMessage)
@@ -3509,7 +4370,7 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 				continue
 			}
 
-			if m.torrentClientReady() && communitySettings.HistoryArchiveSupportEnabled {
+			if m.historyArchivesReady() && communitySettings.HistoryArchiveSupportEnabled {
 
 				err = m.communitiesManager.SeedHistoryArchiveTorrent(discordCommunity.ID())
 				if err != nil {
@@ -3747,6 +4608,12 @@ func (m *Messenger) pinMessagesToWakuMessages(pinMessages []*common.PinMessage,
 	return wakuMessages, nil
 }
 
+// historyArchivesReady tells whether the history archives of the communities
+// we control can be distributed, with BitTorrent or the archive transports
+func (m *Messenger) historyArchivesReady() bool {
+	return m.torrentClientReady() || m.communitiesManager.ArchivePublishingEnabled()
+}
+
 func (m *Messenger) torrentClientReady() bool {
 	// Simply checking for `torrentConfig.Enabled` isn't enough
 	// as there's a possiblity that the torrent client couldn't
@@ -3795,6 +4662,13 @@ func (m *Messenger) GetAllCommunityTokens() ([]*communities.CommunityToken, erro
 	return m.communitiesManager.GetAllCommunityTokens()
 }
 
+// CheckCommunityMintPermission returns an error when the user can't add tokens
+// to the community
+func (m *Messenger) CheckCommunityMintPermission(communityID string) error {
+	_, err := m.communitiesManager.CheckMintPermission(communityID)
+	return err
+}
+
 func (m *Messenger) AddCommunityToken(token *communities.CommunityToken, croppedImage *images.CroppedImage) (*communities.CommunityToken, error) {
 	return m.communitiesManager.AddCommunityToken(token, croppedImage)
 }
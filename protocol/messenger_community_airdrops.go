@@ -0,0 +1,40 @@
+package protocol
+
+import (
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+// CreateCommunityAirdropSnapshot records the members of a community eligible
+// to an airdrop, filtered by role, join time and activity. Minting to the
+// snapshot always targets the same addresses, even if the membership changed
+// meanwhile
+func (m *Messenger) CreateCommunityAirdropSnapshot(request *requests.CreateCommunityAirdropSnapshot) (*communities.AirdropSnapshot, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	criteria := communities.AirdropSnapshotCriteria{
+		Roles:        request.Roles,
+		JoinedBefore: request.JoinedBefore,
+		ActiveSince:  request.ActiveSince,
+	}
+	communityID := request.CommunityID.String()
+
+	return m.communitiesManager.CreateAirdropSnapshot(request.CommunityID, criteria, func() (map[string]uint64, error) {
+		return m.persistence.LastCommunityMessageTimestamps(communityID)
+	})
+}
+
+func (m *Messenger) CommunityAirdropSnapshot(id string) (*communities.AirdropSnapshot, error) {
+	return m.communitiesManager.GetAirdropSnapshot(id)
+}
+
+func (m *Messenger) CommunityAirdropSnapshots(communityID types.HexBytes) ([]*communities.AirdropSnapshot, error) {
+	return m.communitiesManager.GetAirdropSnapshots(communityID)
+}
+
+func (m *Messenger) DeleteCommunityAirdropSnapshot(id string) error {
+	return m.communitiesManager.DeleteAirdropSnapshot(id)
+}
@@ -0,0 +1,124 @@
+package protocol
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+// UpdateCommunityBlocklist adds users to the blocklist of a community owned by
+// the user and removes others from it. The clients of the members hide the
+// messages of the blocklisted users in the chats of the community, unless
+// they opted out
+func (m *Messenger) UpdateCommunityBlocklist(request *requests.UpdateCommunityBlocklist) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.UpdateBlocklist(request)
+	if err != nil {
+		return nil, err
+	}
+
+	m.syncBlocklistedMessages(community)
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+// SetCommunityBlocklistsEnabled opts in or out of applying the blocklists of
+// the joined communities, the setting is synced to the paired devices
+func (m *Messenger) SetCommunityBlocklistsEnabled(enabled bool) error {
+	err := m.settings.SetCommunityBlocklistsEnabled(enabled)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return m.persistence.UnhideBlocklistedMessages("", nil)
+	}
+
+	joined, err := m.communitiesManager.Joined()
+	if err != nil {
+		return err
+	}
+	for _, community := range joined {
+		err = m.persistence.HideBlocklistedMessages(community.IDString(), m.blocklistedPublicKeys(community))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveBlocklistedMessage stores the message of a blocklisted user hidden and
+// seen, so that it doesn't notify
+func (m *Messenger) saveBlocklistedMessage(message *common.Message) error {
+	message.Seen = true
+	err := m.persistence.SaveMessages([]*common.Message{message})
+	if err != nil {
+		return err
+	}
+	return m.persistence.HideBlocklistedMessage(message.ID)
+}
+
+// blocklistedPublicKeys returns the users blocklisted by the community, except
+// ourselves as our own messages are never hidden
+func (m *Messenger) blocklistedPublicKeys(community *communities.Community) []string {
+	var blocklisted []string
+	for _, entry := range community.Blocklist() {
+		if entry.PublicKey != m.myHexIdentity() {
+			blocklisted = append(blocklisted, entry.PublicKey)
+		}
+	}
+	return blocklisted
+}
+
+// syncBlocklistedMessages shows again the messages of the users removed from
+// the blocklist of the community, and hides the stored messages of the users
+// added to it unless we opted out of the blocklists
+func (m *Messenger) syncBlocklistedMessages(community *communities.Community) {
+	blocklisted := m.blocklistedPublicKeys(community)
+	err := m.persistence.UnhideBlocklistedMessages(community.IDString(), blocklisted)
+	if err != nil {
+		m.logger.Warn("failed to unhide messages of users removed from the blocklist", zap.Error(err))
+	}
+
+	if !community.Joined() {
+		return
+	}
+	enabled, err := m.settings.CommunityBlocklistsEnabled()
+	if err != nil {
+		m.logger.Warn("failed to get community blocklists setting", zap.Error(err))
+		return
+	}
+	if !enabled {
+		return
+	}
+	err = m.persistence.HideBlocklistedMessages(community.IDString(), blocklisted)
+	if err != nil {
+		m.logger.Warn("failed to hide messages of blocklisted users", zap.Error(err))
+	}
+}
+
+// blocklistedInChat tells whether the messages of the author are hidden in a
+// chat because the community of the chat blocklisted them
+func (m *Messenger) blocklistedInChat(chat *Chat, author string) bool {
+	if !chat.CommunityChat() || author == m.myHexIdentity() {
+		return false
+	}
+
+	community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
+	if err != nil || community == nil || !community.Joined() || !community.IsBlocklisted(author) {
+		return false
+	}
+
+	enabled, err := m.settings.CommunityBlocklistsEnabled()
+	if err != nil {
+		m.logger.Warn("failed to get community blocklists setting", zap.Error(err))
+		return false
+	}
+	return enabled
+}
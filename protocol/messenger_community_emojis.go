@@ -0,0 +1,79 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+// SetCommunityEmojiPack creates an emoji pack of a community, or replaces it
+// when its ID is set. The pack is published with the community description
+func (m *Messenger) SetCommunityEmojiPack(request *requests.SetCommunityEmojiPack) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	community, _, err := m.communitiesManager.SetEmojiPack(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+func (m *Messenger) DeleteCommunityEmojiPack(communityID types.HexBytes, packID string) (*MessengerResponse, error) {
+	community, err := m.communitiesManager.DeleteEmojiPack(communityID, packID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+// SendCommunityEmojiReaction reacts to a message of a community chat with an
+// emoji of the packs of the community
+func (m *Messenger) SendCommunityEmojiReaction(ctx context.Context, chatID, messageID string, emojiID string) (*MessengerResponse, error) {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+
+	err := m.validateCommunityEmojiReaction(chat, &m.identity.PublicKey, emojiID)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.sendEmojiReaction(ctx, chatID, messageID, protobuf.EmojiReaction_CUSTOM, emojiID)
+}
+
+// validateCommunityEmojiReaction checks that the emoji belongs to a pack of
+// the community of the chat, and that the author of the reaction is a member
+func (m *Messenger) validateCommunityEmojiReaction(chat *Chat, author *ecdsa.PublicKey, emojiID string) error {
+	if !chat.CommunityChat() {
+		return ErrInvalidEmojiReaction
+	}
+
+	community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
+	if err != nil {
+		return err
+	}
+	if community == nil {
+		return communities.ErrOrgNotFound
+	}
+
+	if !community.HasMember(author) {
+		return communities.ErrNotAuthorized
+	}
+
+	if community.Emoji(emojiID) == nil {
+		return ErrEmojiNotFound
+	}
+	return nil
+}
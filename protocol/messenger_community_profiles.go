@@ -0,0 +1,163 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+// SetCommunityProfile sets the display name and the avatar the user has in a
+// joined community, so that they can be distinct from their own. The profile
+// is published to the members of the community
+func (m *Messenger) SetCommunityProfile(request *requests.SetCommunityProfile) (*CommunityMemberProfile, error) {
+	err := request.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil || !community.Joined() {
+		return nil, communities.ErrOrgNotFound
+	}
+
+	profile := &CommunityMemberProfile{
+		CommunityID: community.IDString(),
+		MemberID:    m.myHexIdentity(),
+		DisplayName: request.DisplayName,
+		Clock:       m.getTimesource().GetCurrentTime(),
+	}
+
+	err = ValidateDisplayName(&profile.DisplayName)
+	if err != nil {
+		return nil, err
+	}
+
+	profile.Image, err = request.ToImage()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.persistence.SaveCommunityMemberProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.publishCommunityProfile(community, profile)
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// CommunityProfile returns the profile the user has in a community, nil when
+// they use their own
+func (m *Messenger) CommunityProfile(communityID types.HexBytes) (*CommunityMemberProfile, error) {
+	return m.myCommunityProfile(communityID.String())
+}
+
+// CommunityMemberProfiles returns the profiles the members of a community
+// use in it instead of their own
+func (m *Messenger) CommunityMemberProfiles(communityID types.HexBytes) ([]*CommunityMemberProfile, error) {
+	return m.persistence.CommunityMemberProfiles(communityID.String())
+}
+
+func (m *Messenger) myCommunityProfile(communityID string) (*CommunityMemberProfile, error) {
+	profile, err := m.persistence.CommunityMemberProfile(communityID, m.myHexIdentity())
+	if err != nil || profile == nil || profile.Empty() {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// communityDisplayName returns the display name the user has in the
+// community of a chat, empty when they use their own
+func (m *Messenger) communityDisplayName(chat *Chat) string {
+	if !chat.CommunityChat() {
+		return ""
+	}
+
+	profile, err := m.myCommunityProfile(chat.CommunityID)
+	if err != nil {
+		m.logger.Error("failed to get community profile", zap.Error(err))
+		return ""
+	}
+	if profile == nil {
+		return ""
+	}
+	return profile.DisplayName
+}
+
+// publishCommunityProfile sends the profile on the community topic, which
+// every member listens to
+func (m *Messenger) publishCommunityProfile(community *communities.Community, profile *CommunityMemberProfile) error {
+	payload, err := proto.Marshal(profile.toProtobuf(community.ID()))
+	if err != nil {
+		return err
+	}
+
+	rawMessage := common.RawMessage{
+		Payload: payload,
+		Sender:  m.identity,
+		// we don't want to wrap in an encryption layer message
+		SkipProtocolLayer: true,
+		MessageType:       protobuf.ApplicationMetadataMessage_COMMUNITY_MEMBER_PROFILE,
+	}
+
+	_, err = m.sender.SendPublic(context.Background(), community.IDString(), rawMessage)
+	return err
+}
+
+// publishMyCommunityProfile publishes the profile of the user in a community
+// once they've joined it, when they have one
+func (m *Messenger) publishMyCommunityProfile(community *communities.Community) error {
+	profile, err := m.myCommunityProfile(community.IDString())
+	if err != nil || profile == nil {
+		return err
+	}
+	return m.publishCommunityProfile(community, profile)
+}
+
+func (m *Messenger) handleCommunityMemberProfile(state *ReceivedMessageState, message *protobuf.CommunityMemberProfile) error {
+	community, err := m.communitiesManager.GetByID(message.CommunityId)
+	if err != nil {
+		return err
+	}
+	if community == nil || !community.Joined() || !community.HasMember(state.CurrentMessageState.PublicKey) {
+		return nil
+	}
+
+	return m.saveCommunityMemberProfile(state.Response, state.CurrentMessageState.PublicKey, message)
+}
+
+// saveCommunityMemberProfile stores the profile a member uses in a community,
+// the membership must have been checked
+func (m *Messenger) saveCommunityMemberProfile(response *MessengerResponse, member *ecdsa.PublicKey, message *protobuf.CommunityMemberProfile) error {
+	memberID := common.PubkeyToHex(member)
+	if memberID == m.myHexIdentity() {
+		return nil
+	}
+
+	profile, err := communityMemberProfileFromProtobuf(memberID, message)
+	if err != nil {
+		return err
+	}
+
+	saved, err := m.persistence.SaveCommunityMemberProfile(profile)
+	if err != nil || !saved {
+		return err
+	}
+
+	response.CommunityMemberProfiles = append(response.CommunityMemberProfiles, profile)
+	return nil
+}
@@ -0,0 +1,69 @@
+package protocol
+
+import (
+	"context"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/services/wallet"
+)
+
+// GetCommunityTreasury aggregates the native and ERC20 balances of the
+// addresses which deployed the community tokens and of the token contracts,
+// on every chain a token was deployed to
+func (m *Messenger) GetCommunityTreasury(ctx context.Context, communityID types.HexBytes) (*wallet.Treasury, error) {
+	if m.walletAPI == nil {
+		return nil, ErrWalletNotEnabled
+	}
+
+	community, err := m.communitiesManager.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, communities.ErrOrgNotFound
+	}
+
+	tokens, err := m.communitiesManager.GetCommunityTokens(community.IDString())
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []ethcommon.Address
+	var chainIDs []uint64
+	seenAddresses := make(map[ethcommon.Address]bool)
+	seenChainIDs := make(map[uint64]bool)
+
+	addAddress := func(address string) {
+		if !ethcommon.IsHexAddress(address) {
+			return
+		}
+		a := ethcommon.HexToAddress(address)
+		if !seenAddresses[a] {
+			seenAddresses[a] = true
+			addresses = append(addresses, a)
+		}
+	}
+
+	for _, token := range tokens {
+		if token.DeployState != communities.Deployed {
+			continue
+		}
+		addAddress(token.Deployer)
+		addAddress(token.Address)
+
+		chainID := uint64(token.ChainID)
+		if !seenChainIDs[chainID] {
+			seenChainIDs[chainID] = true
+			chainIDs = append(chainIDs, chainID)
+		}
+	}
+
+	if len(addresses) == 0 {
+		return &wallet.Treasury{}, nil
+	}
+
+	return m.walletAPI.GetTreasury(ctx, addresses, chainIDs)
+}
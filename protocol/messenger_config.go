@@ -23,6 +23,8 @@ import (
 	"github.com/status-im/status-go/protocol/pushnotificationclient"
 	"github.com/status-im/status-go/protocol/pushnotificationserver"
 	"github.com/status-im/status-go/protocol/transport"
+	"github.com/status-im/status-go/protocol/verification"
+	"github.com/status-im/status-go/protocol/video"
 	"github.com/status-im/status-go/protocol/wakusync"
 	"github.com/status-im/status-go/services/mailservers"
 	"github.com/status-im/status-go/services/wallet"
@@ -38,6 +40,7 @@ type MessengerSignalsHandler interface {
 	HistoryRequestCompleted()
 
 	BackupPerformed(uint64)
+	BackupFailed(error)
 	HistoryArchivesProtocolEnabled()
 	HistoryArchivesProtocolDisabled()
 	CreatingHistoryArchives(communityID string)
@@ -49,6 +52,7 @@ type MessengerSignalsHandler interface {
 	DownloadingHistoryArchivesStarted(communityID string)
 	DownloadingHistoryArchivesFinished(communityID string)
 	ImportingHistoryArchiveMessages(communityID string)
+	CommunityChannelMembersRemoved(communityID string, members map[string][]string)
 	StatusUpdatesTimedOut(statusUpdates *[]UserStatus)
 	DiscordCategoriesAndChannelsExtracted(categories []*discord.Category, channels []*discord.Channel, oldestMessageTimestamp int64, errors map[string]*discord.ImportError)
 	DiscordCommunityImportProgress(importProgress *discord.ImportProgress)
@@ -59,6 +63,8 @@ type MessengerSignalsHandler interface {
 	SendWakuBackedUpSettings(response *wakusync.WakuBackedUpDataResponse)
 	SendWakuBackedUpKeypair(response *wakusync.WakuBackedUpDataResponse)
 	SendWakuBackedUpWatchOnlyAccount(response *wakusync.WakuBackedUpDataResponse)
+	MessagesPruned(result *MessageRetentionResult)
+	ContactVerificationStateChanged(transition *verification.Transition)
 }
 
 type config struct {
@@ -90,6 +96,11 @@ type config struct {
 	httpServer          *server.MediaServer
 	rpcClient           *rpc.Client
 	tokenManager        communities.TokenManager
+	paymentVerifier     communities.PaymentVerifier
+	soulboundVerifier   communities.SoulboundTokenVerifier
+	outboxRetryPolicy   *OutboxRetryPolicy
+	videoExtractor      video.Extractor
+	envelopeWorkers     int
 
 	verifyTransactionClient  EthClient
 	verifyENSURL             string
@@ -341,3 +352,43 @@ func WithTokenManager(tokenManager communities.TokenManager) Option {
 		return nil
 	}
 }
+
+func WithOutboxRetryPolicy(policy OutboxRetryPolicy) Option {
+	return func(c *config) error {
+		c.outboxRetryPolicy = &policy
+		return nil
+	}
+}
+
+func WithPaymentVerifier(paymentVerifier communities.PaymentVerifier) Option {
+	return func(c *config) error {
+		c.paymentVerifier = paymentVerifier
+		return nil
+	}
+}
+
+func WithSoulboundTokenVerifier(soulboundVerifier communities.SoulboundTokenVerifier) Option {
+	return func(c *config) error {
+		c.soulboundVerifier = soulboundVerifier
+		return nil
+	}
+}
+
+// WithEnvelopeWorkers sets the number of envelopes decrypted and unmarshalled
+// in parallel, it defaults to the number of CPUs
+func WithEnvelopeWorkers(workers int) Option {
+	return func(c *config) error {
+		c.envelopeWorkers = workers
+		return nil
+	}
+}
+
+// WithVideoExtractor sets the extractor of the thumbnail, the duration and the
+// dimensions of the videos sent, by default only the metadata of MP4 and
+// QuickTime files is read
+func WithVideoExtractor(extractor video.Extractor) Option {
+	return func(c *config) error {
+		c.videoExtractor = extractor
+		return nil
+	}
+}
@@ -0,0 +1,245 @@
+package protocol
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+// SetContactRequestsThrottlingEnabled enables the requests bucket, where the
+// contact requests and the messages of non-contacts with a low trust score, or
+// received once too many requests reached the activity center, are kept until
+// they're reviewed
+func (m *Messenger) SetContactRequestsThrottlingEnabled(enabled bool) error {
+	return m.settings.SetContactRequestsThrottlingEnabled(enabled)
+}
+
+// ContactTrust returns the trust score of someone, computed locally from the
+// communities, the group chats and the previous messages shared with them
+func (m *Messenger) ContactTrust(contactID string) (*ContactTrust, error) {
+	contact, err := m.BuildContact(&requests.BuildContact{PublicKey: contactID})
+	if err != nil {
+		return nil, err
+	}
+	return m.contactTrust(contact)
+}
+
+func (m *Messenger) contactTrust(contact *Contact) (*ContactTrust, error) {
+	trust := &ContactTrust{
+		ENSVerified:   contact.ENSVerified,
+		Trusted:       contact.IsTrusted(),
+		Untrustworthy: contact.IsUntrustworthy(),
+	}
+
+	publicKey, err := contact.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	joined, err := m.communitiesManager.Joined()
+	if err != nil {
+		return nil, err
+	}
+	for _, community := range joined {
+		if community.HasMember(publicKey) {
+			trust.MutualCommunities++
+		}
+	}
+
+	m.allChats.Range(func(chatID string, chat *Chat) bool {
+		if chat.Active && chat.PrivateGroupChat() && chat.HasMember(contact.ID) && chat.HasMember(m.myHexIdentity()) {
+			trust.SharedGroupChats++
+		}
+		return true
+	})
+
+	trust.PreviousInteractions, err = m.persistence.HasMessagesFrom(contact.ID, m.myHexIdentity())
+	if err != nil {
+		return nil, err
+	}
+
+	trust.computeScore()
+	return trust, nil
+}
+
+// bucketContactRequest keeps the contact request, or the message, of a
+// non-contact in the requests bucket instead of notifying the user about it,
+// when the throttling is enabled and the sender has a low trust score or too
+// many requests were notified recently. It tells whether it was bucketed
+func (m *Messenger) bucketContactRequest(response *MessengerResponse, contact *Contact, message *common.Message, contactRequest bool) (bool, error) {
+	if message == nil || contact.added() || contact.ID == m.myHexIdentity() {
+		return false, nil
+	}
+
+	enabled, err := m.settings.ContactRequestsThrottlingEnabled()
+	if err != nil || !enabled {
+		return false, err
+	}
+
+	trust, err := m.contactTrust(contact)
+	if err != nil {
+		return false, err
+	}
+
+	if !trust.low() && m.contactRequestsThrottle.allow(m.getCurrentTimeInMillis()) {
+		return false, nil
+	}
+
+	err = m.persistence.BucketContactRequest(&BucketedContactRequest{
+		ContactID:      contact.ID,
+		MessageID:      message.ID,
+		ContactRequest: contactRequest,
+		TrustScore:     trust.Score,
+		Timestamp:      message.WhisperTimestamp,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	bucketed, err := m.persistence.BucketedContactRequest(contact.ID)
+	if err != nil {
+		return false, err
+	}
+	if bucketed.MessageID == message.ID {
+		bucketed.Message = message
+		response.BucketedContactRequests = append(response.BucketedContactRequests, bucketed)
+	}
+
+	m.logger.Debug("contact request bucketed", zap.String("contactID", contact.ID), zap.Int("trustScore", trust.Score))
+	return true, nil
+}
+
+// ContactRequestsBucket returns the requests waiting to be reviewed, the
+// latest first. The ones of the users added or blocked meanwhile are dropped
+func (m *Messenger) ContactRequestsBucket() ([]*BucketedContactRequest, error) {
+	bucketed, err := m.persistence.BucketedContactRequests()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*BucketedContactRequest
+	var stale []string
+	for _, request := range bucketed {
+		contact, ok := m.allContacts.Load(request.ContactID)
+		if ok && (contact.added() || contact.Blocked) {
+			stale = append(stale, request.ContactID)
+			continue
+		}
+
+		request.Message, err = m.persistence.MessageByID(request.MessageID)
+		if err == common.ErrRecordNotFound {
+			stale = append(stale, request.ContactID)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, request)
+	}
+
+	err = m.persistence.DeleteBucketedContactRequests(stale)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ReviewBucketedContactRequests accepts and declines requests of the bucket
+// at once. Accepting a contact request adds the contact, accepting a message
+// moves the chat to the activity center. Declining a contact request dismisses
+// it, declining a message only drops it from the bucket
+func (m *Messenger) ReviewBucketedContactRequests(ctx context.Context, request *requests.ReviewBucketedContactRequests) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	reviewed := make([]string, 0, len(request.Accept)+len(request.Decline))
+
+	review := func(contactID string, accept bool) error {
+		bucketed, err := m.persistence.BucketedContactRequest(contactID)
+		if err != nil {
+			return err
+		}
+		if bucketed == nil {
+			return ErrBucketedContactRequestNotFound
+		}
+		reviewed = append(reviewed, contactID)
+
+		if !bucketed.ContactRequest {
+			if accept {
+				return m.notifyBucketedMessage(response, bucketed)
+			}
+			return nil
+		}
+
+		contactRequest, err := m.persistence.MessageByID(bucketed.MessageID)
+		if err == common.ErrRecordNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if contactRequest.ContactRequestState != common.ContactRequestStatePending {
+			return nil
+		}
+
+		var decisionResponse *MessengerResponse
+		if accept {
+			decisionResponse, err = m.acceptContactRequest(ctx, contactRequest.ID, false)
+		} else {
+			decisionResponse, err = m.declineContactRequest(contactRequest.ID, false)
+		}
+		if err != nil {
+			return err
+		}
+
+		err = m.syncContactRequestDecision(ctx, contactRequest.ID, accept, m.dispatchMessage)
+		if err != nil {
+			return err
+		}
+		return response.Merge(decisionResponse)
+	}
+
+	for _, contactID := range request.Accept {
+		if err := review(contactID, true); err != nil {
+			return nil, err
+		}
+	}
+	for _, contactID := range request.Decline {
+		if err := review(contactID, false); err != nil {
+			return nil, err
+		}
+	}
+
+	err := m.persistence.DeleteBucketedContactRequests(reviewed)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// notifyBucketedMessage creates the activity center notification a message
+// of a non-contact would have had if it hadn't been bucketed
+func (m *Messenger) notifyBucketedMessage(response *MessengerResponse, bucketed *BucketedContactRequest) error {
+	chat, ok := m.allChats.Load(bucketed.ContactID)
+	if !ok || chat.Active {
+		return nil
+	}
+
+	notification := &ActivityCenterNotification{
+		ID:          types.FromHex(chat.ID),
+		Name:        chat.Name,
+		LastMessage: chat.LastMessage,
+		Type:        ActivityCenterNotificationTypeNewOneToOne,
+		Author:      bucketed.ContactID,
+		Timestamp:   bucketed.Timestamp,
+		ChatID:      chat.ID,
+		UpdatedAt:   m.getCurrentTimeInMillis(),
+	}
+	return m.addActivityCenterNotification(response, notification)
+}
@@ -3,6 +3,7 @@ package protocol
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -23,6 +24,14 @@ import (
 const minContactVerificationMessageLen = 1
 const maxContactVerificationMessageLen = 280
 
+// contactVerificationExpiry is how long a verification request can be accepted after it's sent
+const contactVerificationExpiry = 7 * 24 * time.Hour
+
+// expiredVerificationRequestsInterval is how often pending verification requests are checked for expiry
+const expiredVerificationRequestsInterval = 5 * time.Minute
+
+var ErrContactVerificationExpired = errors.New("verification request expired")
+
 func (m *Messenger) SendContactVerificationRequest(ctx context.Context, contactID string, challenge string) (*MessengerResponse, error) {
 	if len(challenge) < minContactVerificationMessageLen || len(challenge) > maxContactVerificationMessageLen {
 		return nil, errors.New("invalid verification request challenge length")
@@ -53,11 +62,12 @@ func (m *Messenger) SendContactVerificationRequest(ctx context.Context, contactI
 	}
 
 	m.allChats.Store(chat.ID, chat)
-	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	clock, timestamp := chat.NextClockAndTimestamp(m.getTimesource())
 
 	request := &protobuf.RequestContactVerification{
 		Clock:     clock,
 		Challenge: challenge,
+		ExpiresAt: timestamp + uint64(contactVerificationExpiry.Milliseconds()),
 	}
 
 	encodedMessage, err := proto.Marshal(request)
@@ -93,6 +103,7 @@ func (m *Messenger) SendContactVerificationRequest(ctx context.Context, contactI
 	m.allContacts.Store(contact.ID, contact)
 
 	verifRequest.RequestedAt = clock
+	verifRequest.ExpiresAt = request.ExpiresAt
 	verifRequest.ID = rawMessage.ID
 
 	err = m.verificationDatabase.SaveVerificationRequest(verifRequest)
@@ -269,6 +280,10 @@ func (m *Messenger) AcceptContactVerificationRequest(ctx context.Context, id str
 		return nil, verification.ErrVerificationRequestNotFound
 	}
 
+	if verifRequest.Expired(m.getTimesource().GetCurrentTime()) {
+		return nil, ErrContactVerificationExpired
+	}
+
 	contactID := verifRequest.From
 
 	contact, ok := m.allContacts.Load(contactID)
@@ -772,6 +787,7 @@ func (m *Messenger) HandleRequestContactVerification(state *ReceivedMessageState
 
 	persistedVR.Challenge = request.Challenge
 	persistedVR.RequestedAt = request.Clock
+	persistedVR.ExpiresAt = request.ExpiresAt
 
 	err = m.verificationDatabase.SaveVerificationRequest(persistedVR)
 	if err != nil {
@@ -848,8 +864,8 @@ func (m *Messenger) HandleAcceptContactVerification(state *ReceivedMessageState,
 		return nil // older message, ignore it
 	}
 
-	if persistedVR.RequestStatus == verification.RequestStatusCANCELED {
-		return nil // Do nothing, We have already cancelled the verification request
+	if persistedVR != nil && (persistedVR.RequestStatus == verification.RequestStatusCANCELED || persistedVR.RequestStatus == verification.RequestStatusEXPIRED) {
+		return nil // Do nothing, We have already cancelled the verification request or it expired
 	}
 
 	if persistedVR == nil {
@@ -937,8 +953,8 @@ func (m *Messenger) HandleDeclineContactVerification(state *ReceivedMessageState
 		return nil // older message, ignore it
 	}
 
-	if persistedVR.RequestStatus == verification.RequestStatusCANCELED {
-		return nil // Do nothing, We have already cancelled the verification request
+	if persistedVR != nil && (persistedVR.RequestStatus == verification.RequestStatusCANCELED || persistedVR.RequestStatus == verification.RequestStatusEXPIRED) {
+		return nil // Do nothing, We have already cancelled the verification request or it expired
 	}
 
 	if persistedVR == nil {
@@ -1119,3 +1135,180 @@ func (m *Messenger) createLocalContactVerificationMessage(challenge string, chat
 	}
 	return chatMessage, nil
 }
+
+// verificationRequestTransitioned surfaces the status changes of verification requests to the client
+func (m *Messenger) verificationRequestTransitioned(transition *verification.Transition) {
+	if m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.ContactVerificationStateChanged(transition)
+	}
+}
+
+// GetVerificationAuditTrail returns the status changes of the verification requests exchanged with the contact
+func (m *Messenger) GetVerificationAuditTrail(contactID string) ([]*verification.Transition, error) {
+	return m.verificationDatabase.GetVerificationAuditTrail(contactID)
+}
+
+func (m *Messenger) watchExpiredVerificationRequests() {
+	m.logger.Debug("watching expired verification requests")
+	go func() {
+		ticker := time.NewTicker(expiredVerificationRequestsInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				response, err := m.expireVerificationRequests()
+				if err != nil {
+					m.logger.Error("failed to expire verification requests", zap.Error(err))
+					continue
+				}
+				if !response.IsEmpty() && m.config.messengerSignalsHandler != nil {
+					m.config.messengerSignalsHandler.MessengerResponse(response)
+				}
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// expireVerificationRequests marks the pending verification requests past their expiration as expired. The
+// contacts of expired outgoing requests are unverified again
+func (m *Messenger) expireVerificationRequests() (*MessengerResponse, error) {
+	response := &MessengerResponse{}
+
+	expired, err := m.verificationDatabase.GetExpiredVerificationRequests(m.getTimesource().GetCurrentTime())
+	if err != nil {
+		return nil, err
+	}
+
+	myPubKey := common.PubkeyToHex(&m.identity.PublicKey)
+	for _, vr := range expired {
+		vr.RequestStatus = verification.RequestStatusEXPIRED
+		err = m.verificationDatabase.SaveVerificationRequest(vr)
+		if err != nil {
+			return nil, err
+		}
+		response.AddVerificationRequest(vr)
+
+		err = m.SyncVerificationRequest(context.Background(), vr, m.dispatchMessage)
+		if err != nil {
+			return nil, err
+		}
+
+		if vr.From == myPubKey {
+			contact, ok := m.allContacts.Load(vr.To)
+			if ok && contact.VerificationStatus == VerificationStatusVERIFYING {
+				contact.VerificationStatus = VerificationStatusUNVERIFIED
+				contact.LastUpdatedLocally = m.getTimesource().GetCurrentTime()
+				err = m.persistence.SaveContact(contact, nil)
+				if err != nil {
+					return nil, err
+				}
+				err = m.syncContact(context.Background(), contact, m.dispatchMessage)
+				if err != nil {
+					return nil, err
+				}
+				m.allContacts.Store(contact.ID, contact)
+				response.AddContact(contact)
+			}
+		}
+
+		notification, err := m.persistence.GetActivityCenterNotificationByID(types.FromHex(vr.ID))
+		if err != nil {
+			return nil, err
+		}
+		if notification != nil {
+			notification.ContactVerificationStatus = verification.RequestStatusEXPIRED
+			notification.Read = true
+			notification.UpdatedAt = m.getCurrentTimeInMillis()
+			err = m.addActivityCenterNotification(response, notification)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return response, nil
+}
+
+// RevokeContactVerification revokes the verification of a contact, whose answer to the latest verification
+// request was accepted. The contact is unverified and its trust status removed on all paired devices.
+// Pending requests are canceled with CancelVerificationRequest
+func (m *Messenger) RevokeContactVerification(ctx context.Context, contactID string) (*MessengerResponse, error) {
+	contact, ok := m.allContacts.Load(contactID)
+	if !ok {
+		return nil, errors.New("contact not found")
+	}
+
+	verifRequest, err := m.verificationDatabase.GetLatestVerificationRequestSentTo(contactID)
+	if err != nil {
+		return nil, err
+	}
+	if verifRequest == nil {
+		return nil, verification.ErrVerificationRequestNotFound
+	}
+
+	switch verifRequest.RequestStatus {
+	case verification.RequestStatusACCEPTED, verification.RequestStatusTRUSTED, verification.RequestStatusUNTRUSTWORTHY:
+	default:
+		return nil, errors.New("can revoke only answered verification request")
+	}
+
+	verifRequest.RequestStatus = verification.RequestStatusREVOKED
+	err = m.verificationDatabase.SaveVerificationRequest(verifRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.SyncVerificationRequest(ctx, verifRequest, m.dispatchMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.RemoveTrustStatus(ctx, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	contact.VerificationStatus = VerificationStatusUNVERIFIED
+	contact.LastUpdatedLocally = m.getTimesource().GetCurrentTime()
+	err = m.persistence.SaveContact(contact, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.syncContact(ctx, contact, m.dispatchMessage)
+	if err != nil {
+		return nil, err
+	}
+	m.allContacts.Store(contact.ID, contact)
+
+	response := &MessengerResponse{}
+	response.AddVerificationRequest(verifRequest)
+	response.AddContact(contact)
+	return response, nil
+}
+
+// ReRequestContactVerification sends a new verification request to a contact whose latest request expired,
+// was declined, canceled or revoked. The challenge of the latest request is used if challenge is empty
+func (m *Messenger) ReRequestContactVerification(ctx context.Context, contactID string, challenge string) (*MessengerResponse, error) {
+	verifRequest, err := m.verificationDatabase.GetLatestVerificationRequestSentTo(contactID)
+	if err != nil {
+		return nil, err
+	}
+	if verifRequest == nil {
+		return nil, verification.ErrVerificationRequestNotFound
+	}
+
+	switch verifRequest.RequestStatus {
+	case verification.RequestStatusEXPIRED, verification.RequestStatusDECLINED, verification.RequestStatusCANCELED, verification.RequestStatusREVOKED:
+	default:
+		return nil, errors.New("can re-request only expired, declined, canceled or revoked verification request")
+	}
+
+	if len(strings.TrimSpace(challenge)) == 0 {
+		challenge = verifRequest.Challenge
+	}
+	return m.SendContactVerificationRequest(ctx, contactID, challenge)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/settings"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/requests"
@@ -776,6 +777,44 @@ func (m *Messenger) GetContactByID(pubKey string) *Contact {
 	return contact
 }
 
+// GetContactsDetails returns the names and images of the users, contacts or
+// not, in the order of the public keys
+func (m *Messenger) GetContactsDetails(publicKeys []string) ([]*ContactDetails, error) {
+	visibility, err := m.settings.GetProfilePicturesVisibility()
+	if err != nil {
+		return nil, err
+	}
+	profilePicturesVisibility := settings.ProfilePicturesVisibilityType(visibility)
+
+	result := make([]*ContactDetails, 0, len(publicKeys))
+	for _, publicKey := range publicKeys {
+		details, generation := m.allContacts.details.get(publicKey, profilePicturesVisibility)
+		if details != nil {
+			result = append(result, details)
+			continue
+		}
+
+		contact, ok := m.allContacts.Load(publicKey)
+		if !ok {
+			contact, err = buildContactFromPkString(publicKey)
+			if err != nil {
+				return nil, err
+			}
+
+			err = m.addENSNameToContact(contact)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		details = newContactDetails(contact, profilePicturesVisibility)
+		m.allContacts.details.put(details, profilePicturesVisibility, generation)
+		result = append(result, details)
+	}
+
+	return result, nil
+}
+
 func (m *Messenger) SetContactLocalNickname(request *requests.SetContactLocalNickname) (*MessengerResponse, error) {
 
 	if err := request.Validate(); err != nil {
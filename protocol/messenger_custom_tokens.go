@@ -0,0 +1,201 @@
+package protocol
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+// AddCustomTokenByAddress adds the ERC20 at the address as a custom token of
+// the chain, and syncs it with the paired devices. Its name, symbol and
+// decimals are fetched on chain, the token is flagged when its symbol is used
+// by a token of the lists
+func (m *Messenger) AddCustomTokenByAddress(ctx context.Context, chainID uint64, address gethcommon.Address) (*token.DiscoveredToken, error) {
+	if m.tokenManager == nil {
+		return nil, ErrWalletNotEnabled
+	}
+
+	t, err := m.tokenManager.DiscoverCustomToken(ctx, chainID, address)
+	if err != nil {
+		return nil, err
+	}
+
+	clock, _ := m.getLastClockWithRelatedChat()
+	customToken := &token.CustomToken{
+		Token: *t.Token,
+		Clock: clock,
+	}
+
+	_, err = m.tokenManager.SaveCustomIfNewer(customToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, m.syncCustomToken(ctx, customToken, m.dispatchMessage)
+}
+
+// RemoveCustomToken removes the custom token on this device and the paired
+// ones
+func (m *Messenger) RemoveCustomToken(ctx context.Context, chainID uint64, address gethcommon.Address) error {
+	if m.tokenManager == nil {
+		return ErrWalletNotEnabled
+	}
+
+	customTokens, err := m.tokenManager.GetCustomsByChainID(chainID)
+	if err != nil {
+		return err
+	}
+
+	var customToken *token.CustomToken
+	for _, t := range customTokens {
+		if t.Address == address {
+			customToken = &token.CustomToken{Token: *t}
+			break
+		}
+	}
+	if customToken == nil {
+		return token.ErrCustomTokenNotFound
+	}
+
+	customToken.Clock, _ = m.getLastClockWithRelatedChat()
+	customToken.Removed = true
+
+	_, err = m.tokenManager.SaveCustomIfNewer(customToken)
+	if err != nil {
+		return err
+	}
+
+	return m.syncCustomToken(ctx, customToken, m.dispatchMessage)
+}
+
+func (m *Messenger) syncCustomToken(ctx context.Context, customToken *token.CustomToken, rawMessageHandler RawMessageHandler) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+
+	clock, chat := m.getLastClockWithRelatedChat()
+
+	encodedMessage, err := proto.Marshal(&protobuf.SyncCustomToken{
+		Clock:    customToken.Clock,
+		ChainId:  customToken.ChainID,
+		Address:  customToken.Address.Bytes(),
+		Name:     customToken.Name,
+		Symbol:   customToken.Symbol,
+		Decimals: uint32(customToken.Decimals),
+		Color:    customToken.Color,
+		Removed:  customToken.Removed,
+	})
+	if err != nil {
+		return err
+	}
+
+	rawMessage := common.RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_CUSTOM_TOKEN,
+		ResendAutomatically: true,
+	}
+
+	_, err = rawMessageHandler(ctx, rawMessage)
+	if err != nil {
+		return err
+	}
+
+	chat.LastClockValue = clock
+	return m.saveChat(chat)
+}
+
+// watchCustomTokenChanges syncs the custom tokens changed through the wallet
+// API with the paired devices
+func (m *Messenger) watchCustomTokenChanges() {
+	if m.walletFeed == nil {
+		return
+	}
+
+	events := make(chan walletevent.Event, 10)
+	sub := m.walletFeed.Subscribe(events)
+
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case event := <-events:
+				if event.Type != token.EventCustomTokenChanged {
+					continue
+				}
+
+				customToken, err := token.ParseCustomTokenChanged(event)
+				if err != nil {
+					m.logger.Error("failed to parse custom token change", zap.Error(err))
+					continue
+				}
+
+				err = m.syncCustomToken(context.Background(), customToken, m.dispatchMessage)
+				if err != nil {
+					m.logger.Error("failed to sync custom token", zap.Error(err))
+				}
+			case <-sub.Err():
+				return
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+func (m *Messenger) syncCustomTokens(ctx context.Context, rawMessageHandler RawMessageHandler) error {
+	if m.tokenManager == nil {
+		return nil
+	}
+
+	customTokens, err := m.tokenManager.GetRawCustoms()
+	if err != nil {
+		return err
+	}
+
+	for _, customToken := range customTokens {
+		err = m.syncCustomToken(ctx, customToken, rawMessageHandler)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Messenger) handleSyncCustomToken(state *ReceivedMessageState, message protobuf.SyncCustomToken) error {
+	if m.tokenManager == nil {
+		return nil
+	}
+
+	customToken := &token.CustomToken{
+		Token: token.Token{
+			Address:  gethcommon.BytesToAddress(message.Address),
+			Name:     message.Name,
+			Symbol:   message.Symbol,
+			Color:    message.Color,
+			Decimals: uint(message.Decimals),
+			ChainID:  message.ChainId,
+		},
+		Clock:   message.Clock,
+		Removed: message.Removed,
+	}
+
+	saved, err := m.tokenManager.SaveCustomIfNewer(customToken)
+	if err != nil {
+		return err
+	}
+
+	if saved {
+		state.Response.CustomTokens = append(state.Response.CustomTokens, customToken)
+	}
+
+	return nil
+}
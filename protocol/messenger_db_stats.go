@@ -0,0 +1,11 @@
+package protocol
+
+import (
+	"github.com/status-im/status-go/sqlite"
+)
+
+// DBStats returns the duration of the queries run on the database and the
+// stats of its connections
+func (m *Messenger) DBStats() *sqlite.DBStats {
+	return sqlite.GetDBStats(m.database)
+}
@@ -485,3 +485,88 @@ func (s *MessengerEditMessageSuite) TestEditMessageWithMention() {
 	s.Require().Equal(int(response.Chats()[0].UnviewedMentionsCount), 0)
 	s.Require().False(response.Messages()[0].Mentioned)
 }
+
+func (s *MessengerEditMessageSuite) TestEditMessageHistory() {
+	theirMessenger := s.newMessenger()
+	_, err := theirMessenger.Start()
+	s.Require().NoError(err)
+	defer theirMessenger.Shutdown() // nolint: errcheck
+
+	theirChat := CreateOneToOneChat("Their 1TO1", &s.privateKey.PublicKey, s.m.transport)
+	err = theirMessenger.SaveChat(theirChat)
+	s.Require().NoError(err)
+
+	ourChat := CreateOneToOneChat("Our 1TO1", &theirMessenger.identity.PublicKey, s.m.transport)
+	err = s.m.SaveChat(ourChat)
+	s.Require().NoError(err)
+
+	inputMessage := buildTestMessage(*theirChat)
+	sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+	ogMessage := sendResponse.Messages()[0]
+
+	_, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.messages) > 0 },
+		"no messages",
+	)
+	s.Require().NoError(err)
+
+	messageID, err := types.DecodeHex(ogMessage.ID)
+	s.Require().NoError(err)
+
+	texts := []string{"first edit", "second edit", "third edit"}
+	for _, text := range texts {
+		_, err = theirMessenger.EditMessage(context.Background(), &requests.EditMessage{
+			ID:   messageID,
+			Text: text,
+		})
+		s.Require().NoError(err)
+	}
+
+	history, err := theirMessenger.GetMessageEditHistory(ogMessage.ID)
+	s.Require().NoError(err)
+	s.Require().Len(history, 3)
+	s.Require().Equal(texts[1], history[0].Text)
+	s.Require().Equal(texts[0], history[1].Text)
+	s.Require().Equal(ogMessage.Text, history[2].Text)
+	s.Require().Equal(ogMessage.Clock, history[2].Clock)
+	s.Require().Equal(history[1].Clock, history[2].ReplacedAt)
+
+	// Receivers keep the history as well
+	_, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool {
+			history, err := s.m.GetMessageEditHistory(ogMessage.ID)
+			return err == nil && len(history) == 3
+		},
+		"no edits",
+	)
+	s.Require().NoError(err)
+
+	history, err = s.m.GetMessageEditHistory(ogMessage.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(ogMessage.Text, history[2].Text)
+
+	// Lowering the limit drops the oldest revisions
+	err = theirMessenger.SetEditHistoryLimit(2)
+	s.Require().NoError(err)
+
+	history, err = theirMessenger.GetMessageEditHistory(ogMessage.ID)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Require().Equal(texts[1], history[0].Text)
+	s.Require().Equal(texts[0], history[1].Text)
+
+	_, err = theirMessenger.EditMessage(context.Background(), &requests.EditMessage{
+		ID:   messageID,
+		Text: "fourth edit",
+	})
+	s.Require().NoError(err)
+
+	history, err = theirMessenger.GetMessageEditHistory(ogMessage.ID)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Require().Equal(texts[2], history[0].Text)
+	s.Require().Equal(texts[1], history[1].Text)
+}
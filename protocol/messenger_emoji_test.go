@@ -222,3 +222,80 @@ func (s *MessengerEmojiSuite) TestCompressedKeyReturnedWithEmoji() {
 	s.Require().True(strings.Contains(string(encodedReaction), "compressedKey\":\"zQ"))
 	s.Require().True(strings.Contains(string(encodedReaction), "emojiHash"))
 }
+
+func (s *MessengerEmojiSuite) TestGetMessageReactions() {
+	alice := s.m
+
+	chat := CreatePublicChat(statusChatID, alice.transport)
+	err := alice.SaveChat(chat)
+	s.Require().NoError(err)
+
+	message := buildTestMessage(*chat)
+	_, err = alice.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+
+	response, err := alice.SendEmojiReaction(context.Background(), chat.ID, message.ID, protobuf.EmojiReaction_LOVE)
+	s.Require().NoError(err)
+	ownReactionID := response.EmojiReactions()[0].ID()
+
+	// Reactions from other members of the chat
+	for i := 0; i < 5; i++ {
+		emojiType := protobuf.EmojiReaction_LOVE
+		if i%2 == 0 {
+			emojiType = protobuf.EmojiReaction_SAD
+		}
+		err = alice.persistence.SaveEmojiReaction(&EmojiReaction{
+			EmojiReaction: protobuf.EmojiReaction{
+				Clock:     uint64(i + 1),
+				MessageId: message.ID,
+				ChatId:    chat.ID,
+				Type:      emojiType,
+			},
+			LocalChatID: chat.ID,
+			From:        strings.Repeat("a", i+1),
+		})
+		s.Require().NoError(err)
+	}
+
+	reactions, err := alice.GetMessageReactions(message.ID, "")
+	s.Require().NoError(err)
+	s.Require().Equal(message.ID, reactions.MessageID)
+	s.Require().Len(reactions.Reactions, 6)
+	s.Require().Empty(reactions.Cursor)
+	s.Require().Len(reactions.Counts, 2)
+	s.Require().Equal(protobuf.EmojiReaction_LOVE, reactions.Counts[0].EmojiID)
+	s.Require().Equal(3, reactions.Counts[0].Count)
+	s.Require().True(reactions.Counts[0].ReactedByMe)
+	s.Require().Equal(protobuf.EmojiReaction_SAD, reactions.Counts[1].EmojiID)
+	s.Require().Equal(3, reactions.Counts[1].Count)
+	s.Require().False(reactions.Counts[1].ReactedByMe)
+
+	// Retracting updates the counts
+	_, err = alice.SendEmojiReactionRetraction(context.Background(), ownReactionID)
+	s.Require().NoError(err)
+
+	reactions, err = alice.GetMessageReactions(message.ID, "")
+	s.Require().NoError(err)
+	s.Require().Len(reactions.Reactions, 5)
+	s.Require().Equal(2, reactions.Counts[0].Count)
+	s.Require().False(reactions.Counts[0].ReactedByMe)
+
+	// Reactions are paginated, most recent first
+	items, cursor, err := alice.persistence.EmojiReactionsByMessageID(chat.ID, message.ID, "", 2)
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+	s.Require().NotEmpty(cursor)
+	s.Require().Equal(uint64(5), items[0].Clock)
+	s.Require().Equal(uint64(4), items[1].Clock)
+
+	items, cursor, err = alice.persistence.EmojiReactionsByMessageID(chat.ID, message.ID, cursor, 2)
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+	s.Require().NotEmpty(cursor)
+	s.Require().Equal(uint64(3), items[0].Clock)
+
+	items, cursor, err = alice.persistence.EmojiReactionsByMessageID(chat.ID, message.ID, cursor, 2)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Require().Empty(cursor)
+}
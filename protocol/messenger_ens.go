@@ -1,6 +1,86 @@
 package protocol
 
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/status-im/status-go/scheduler"
+	walletcommon "github.com/status-im/status-go/services/wallet/common"
+)
+
+// primaryNamesRefreshInterval is how often the primary ENS names of contacts
+// and saved addresses are resolved, names are cached for longer
+const primaryNamesRefreshInterval = time.Hour
+
 func (m *Messenger) ENSVerified(pubkey, ensName string) error {
 	clock := m.getTimesource().GetCurrentTime()
 	return m.ensVerifier.ENSVerified(pubkey, ensName, clock)
 }
+
+// primaryNameAddresses returns the addresses displayed in chat whose primary
+// names are resolved: the ones of added contacts and mainnet saved addresses
+func (m *Messenger) primaryNameAddresses() ([]gethcommon.Address, error) {
+	var addresses []gethcommon.Address
+	for _, contact := range m.AddedContacts() {
+		if gethcommon.IsHexAddress(contact.Address) {
+			addresses = append(addresses, gethcommon.HexToAddress(contact.Address))
+		}
+	}
+
+	if m.savedAddressesManager != nil {
+		savedAddresses, err := m.savedAddressesManager.GetSavedAddresses()
+		if err != nil {
+			return nil, err
+		}
+		for _, savedAddress := range savedAddresses {
+			if !savedAddress.IsTest {
+				addresses = append(addresses, savedAddress.Address)
+			}
+		}
+	}
+	return addresses, nil
+}
+
+func (m *Messenger) refreshPrimaryNames() error {
+	addresses, err := m.primaryNameAddresses()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	_, err = m.primaryNames.Get(ctx, walletcommon.EthereumMainnet, addresses)
+	return err
+}
+
+// watchPrimaryNames keeps the cached primary ENS names of contacts and saved
+// addresses up to date, so that they are displayed without waiting for the
+// resolution
+func (m *Messenger) watchPrimaryNames() {
+	if m.primaryNames == nil {
+		return
+	}
+
+	unschedule := scheduler.Default().Schedule(scheduler.Job{
+		Name:        "messenger.primary-names",
+		Interval:    primaryNamesRefreshInterval,
+		Constraints: scheduler.Constraints{Online: true, NotOnBatterySaver: true},
+		Run: func(context.Context) {
+			if !m.online() {
+				return
+			}
+			err := m.refreshPrimaryNames()
+			if err != nil {
+				m.logger.Debug("failed to refresh primary ENS names", zap.Error(err))
+			}
+		},
+	})
+
+	go func() {
+		<-m.quit
+		unschedule()
+	}()
+}
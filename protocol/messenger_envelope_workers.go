@@ -0,0 +1,93 @@
+package protocol
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/transport"
+	v1protocol "github.com/status-im/status-go/protocol/v1"
+)
+
+// decodedEnvelope is an envelope decrypted and unmarshalled ahead of the
+// handling of its messages
+type decodedEnvelope struct {
+	envelope       *types.Message
+	statusMessage  *v1protocol.StatusMessage
+	statusMessages []*v1protocol.StatusMessage
+	acks           [][]byte
+	err            error
+}
+
+func (m *Messenger) envelopeWorkers() int {
+	if m.config.envelopeWorkers > 0 {
+		return m.config.envelopeWorkers
+	}
+	return runtime.NumCPU()
+}
+
+// forEachEnvelope calls fn for each envelope with a pool of workers
+func (m *Messenger) forEachEnvelope(envelopes []*decodedEnvelope, fn func(*decodedEnvelope)) {
+	workers := m.envelopeWorkers()
+	if workers > len(envelopes) {
+		workers = len(envelopes)
+	}
+
+	jobs := make(chan *decodedEnvelope)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for envelope := range jobs {
+				fn(envelope)
+			}
+		}()
+	}
+
+	for _, envelope := range envelopes {
+		jobs <- envelope
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// decodeEnvelopes decrypts and unmarshals the envelopes. The transport and
+// application layers are handled by a pool of workers, the encryption and
+// datasync layers one envelope at a time in the order they were received,
+// as the messages waiting for a hash ratchet key are only decrypted once the
+// envelope with the key is handled. The envelopes are returned in the order
+// they were received for each filter, so that their messages are still
+// handled in the order of each chat
+func (m *Messenger) decodeEnvelopes(chatWithMessages map[transport.Filter][]*types.Message) map[transport.Filter][]*decodedEnvelope {
+	decoded := make(map[transport.Filter][]*decodedEnvelope, len(chatWithMessages))
+	var all []*decodedEnvelope
+
+	for filter, envelopes := range chatWithMessages {
+		decoded[filter] = make([]*decodedEnvelope, len(envelopes))
+		for i, envelope := range envelopes {
+			result := &decodedEnvelope{envelope: envelope}
+			decoded[filter][i] = result
+			all = append(all, result)
+		}
+	}
+
+	m.forEachEnvelope(all, func(envelope *decodedEnvelope) {
+		envelope.statusMessage, envelope.err = m.sender.HandleTransportLayer(envelope.envelope)
+	})
+
+	for _, envelope := range all {
+		if envelope.err != nil {
+			continue
+		}
+		envelope.statusMessages, envelope.acks, envelope.err = m.sender.HandleEncryptionLayers(envelope.envelope, envelope.statusMessage)
+	}
+
+	m.forEachEnvelope(all, func(envelope *decodedEnvelope) {
+		if envelope.err == nil {
+			m.sender.HandleApplicationLayers(envelope.envelope, envelope.statusMessages)
+		}
+	})
+
+	return decoded
+}
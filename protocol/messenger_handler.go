@@ -250,6 +250,13 @@ func (m *Messenger) createMessageNotification(chat *Chat, messageState *Received
 
 	var notificationType ActivityCenterType
 	if chat.OneToOne() {
+		bucketed, err := m.bucketContactRequest(messageState.Response, messageState.CurrentMessageState.Contact, message, false)
+		if err != nil {
+			m.logger.Warn("failed to bucket message", zap.Error(err))
+		}
+		if bucketed {
+			return
+		}
 		notificationType = ActivityCenterNotificationTypeNewOneToOne
 	} else {
 		notificationType = ActivityCenterNotificationTypeNewPrivateGroupChat
@@ -336,6 +343,11 @@ func (m *Messenger) createIncomingContactRequestNotification(contact *Contact, m
 		return nil
 	}
 
+	bucketed, err := m.bucketContactRequest(messageState.Response, contact, contactRequest, true)
+	if err != nil || bucketed {
+		return err
+	}
+
 	notification := &ActivityCenterNotification{
 		ID:        types.FromHex(contactRequest.ID),
 		Name:      contact.PrimaryName(),
@@ -644,6 +656,13 @@ func (m *Messenger) HandleSyncInstallationContact(state *ReceivedMessageState, m
 				}
 			} else {
 				contact.Unblock(message.LastUpdatedLocally)
+				state.AllContacts.Store(contact.ID, contact)
+
+				// The blocked chats are part of the push notification registration
+				err = m.reregisterForPushNotifications()
+				if err != nil {
+					return err
+				}
 			}
 		}
 		if chat != nil && message.Muted != chat.Muted {
@@ -827,6 +846,23 @@ func (m *Messenger) handlePinMessage(pinner *Contact, whisperTimestamp uint64, r
 	// Set the LocalChatID for the message
 	pinMessage.LocalChatID = chat.ID
 
+	// Messages sent by clients not supporting ordering are displayed by pin time
+	if pinMessage.PinIndex == 0 {
+		pinMessage.PinIndex = pinMessage.Clock
+	}
+
+	pinnedIDs, err := m.persistence.PinnedMessageIDsByChatID(chat.ID)
+	if err != nil {
+		return err
+	}
+	alreadyPinned := false
+	for _, id := range pinnedIDs {
+		if id == message.MessageId {
+			alreadyPinned = true
+			break
+		}
+	}
+
 	inserted, err := m.persistence.SavePinMessage(pinMessage)
 	if err != nil {
 		return err
@@ -838,7 +874,8 @@ func (m *Messenger) handlePinMessage(pinner *Contact, whisperTimestamp uint64, r
 		return nil
 	}
 
-	if message.Pinned {
+	// A message that is already pinned is only being reordered, no need to notify
+	if message.Pinned && !alreadyPinned {
 		id, err := generatePinMessageNotificationID(&m.identity.PublicKey, pinMessage, chat)
 		if err != nil {
 			return err
@@ -1196,7 +1233,7 @@ func (m *Messenger) HandleCommunityInvitation(state *ReceivedMessageState, signe
 	return nil
 }
 
-func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessageState, communityPubKey *ecdsa.PublicKey, magnetlink string, clock uint64) error {
+func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessageState, communityPubKey *ecdsa.PublicKey, magnetlink string, archiveURIs []string, clock uint64) error {
 
 	id := types.HexBytes(crypto.CompressPubkey(communityPubKey))
 	settings, err := m.communitiesManager.GetCommunitySettingsByID(id)
@@ -1205,7 +1242,7 @@ func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessage
 		return err
 	}
 
-	if m.torrentClientReady() && settings != nil && settings.HistoryArchiveSupportEnabled {
+	if m.canDownloadHistoryArchives(archiveURIs) && settings != nil && settings.HistoryArchiveSupportEnabled {
 		signedByOwnedCommunity, err := m.communitiesManager.IsAdminCommunity(communityPubKey)
 		if err != nil {
 			return err
@@ -1258,7 +1295,7 @@ func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessage
 				// this wait groups tracks all ongoing tasks across communities
 				m.downloadHistoryArchiveTasksWaitGroup.Add(1)
 				defer m.downloadHistoryArchiveTasksWaitGroup.Done()
-				m.downloadAndImportHistoryArchives(communityID, magnetlink, task.CancelChan)
+				m.downloadAndImportHistoryArchives(communityID, magnetlink, archiveURIs, task.CancelChan)
 			}(currentTask, id)
 
 			return m.communitiesManager.UpdateMagnetlinkMessageClock(id, clock)
@@ -1267,21 +1304,32 @@ func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessage
 	return nil
 }
 
-func (m *Messenger) downloadAndImportHistoryArchives(id types.HexBytes, magnetlink string, cancel chan struct{}) {
-	downloadTaskInfo, err := m.communitiesManager.DownloadHistoryArchivesByMagnetlink(id, magnetlink, cancel)
-	if err != nil {
-		logMsg := "failed to download history archive data"
+// canDownloadHistoryArchives tells whether the history archives can be
+// downloaded, with BitTorrent or from one of the archive URIs
+func (m *Messenger) canDownloadHistoryArchives(archiveURIs []string) bool {
+	return m.torrentClientReady() || m.communitiesManager.CanFetchHistoryArchives(archiveURIs)
+}
+
+func (m *Messenger) downloadHistoryArchives(id types.HexBytes, magnetlink string, archiveURIs []string, cancel chan struct{}) (*communities.HistoryArchiveDownloadTaskInfo, error) {
+	if m.torrentClientReady() {
+		downloadTaskInfo, err := m.communitiesManager.DownloadHistoryArchivesByMagnetlink(id, magnetlink, cancel)
 		if err == communities.ErrTorrentTimedout {
 			m.communitiesManager.LogStdout("torrent has timed out, trying once more...")
 			downloadTaskInfo, err = m.communitiesManager.DownloadHistoryArchivesByMagnetlink(id, magnetlink, cancel)
-			if err != nil {
-				m.communitiesManager.LogStdout(logMsg, zap.Error(err))
-				return
-			}
-		} else {
-			m.communitiesManager.LogStdout(logMsg, zap.Error(err))
-			return
 		}
+		if err == nil || !m.communitiesManager.CanFetchHistoryArchives(archiveURIs) {
+			return downloadTaskInfo, err
+		}
+		m.communitiesManager.LogStdout("failed to download history archive torrent, trying the archive URIs", zap.Error(err))
+	}
+	return m.communitiesManager.DownloadHistoryArchivesByURIs(id, magnetlink, archiveURIs, cancel)
+}
+
+func (m *Messenger) downloadAndImportHistoryArchives(id types.HexBytes, magnetlink string, archiveURIs []string, cancel chan struct{}) {
+	downloadTaskInfo, err := m.downloadHistoryArchives(id, magnetlink, archiveURIs, cancel)
+	if err != nil {
+		m.communitiesManager.LogStdout("failed to download history archive data", zap.Error(err))
+		return
 	}
 
 	if downloadTaskInfo.Cancelled {
@@ -1418,6 +1466,18 @@ func (m *Messenger) HandleCommunityRequestToJoin(state *ReceivedMessageState, si
 		return err
 	}
 
+	if profile := requestToJoinProto.Profile; profile != nil {
+		profile.CommunityId = requestToJoinProto.CommunityId
+		err = m.saveCommunityMemberProfile(state.Response, signer, profile)
+		if err != nil {
+			m.logger.Warn("failed to save community member profile", zap.Error(err))
+		}
+	}
+
+	if requestToJoin.State == communities.RequestToJoinStatePending && requestToJoin.MembershipPayment != nil {
+		m.verifyMembershipPayment(requestToJoin)
+	}
+
 	if requestToJoin.State == communities.RequestToJoinStateAccepted {
 		accept := &requests.AcceptRequestToJoinCommunity{
 			ID: requestToJoin.ID,
@@ -1530,8 +1590,14 @@ func (m *Messenger) HandleCommunityRequestToJoinResponse(state *ReceivedMessageS
 			state.Response.AddCommunity(community)
 			state.Response.AddCommunitySettings(communitySettings)
 
+			err = m.publishMyCommunityProfile(community)
+			if err != nil {
+				m.logger.Warn("failed to publish community profile", zap.Error(err))
+			}
+
 			magnetlink := requestToJoinResponseProto.MagnetUri
-			if m.torrentClientReady() && communitySettings != nil && communitySettings.HistoryArchiveSupportEnabled && magnetlink != "" {
+			archiveURIs := requestToJoinResponseProto.ArchiveUris
+			if m.canDownloadHistoryArchives(archiveURIs) && communitySettings != nil && communitySettings.HistoryArchiveSupportEnabled && magnetlink != "" {
 
 				currentTask := m.communitiesManager.GetHistoryArchiveDownloadTask(community.IDString())
 				go func(currentTask *communities.HistoryArchiveDownloadTask) {
@@ -1555,7 +1621,7 @@ func (m *Messenger) HandleCommunityRequestToJoinResponse(state *ReceivedMessageS
 					m.downloadHistoryArchiveTasksWaitGroup.Add(1)
 					defer m.downloadHistoryArchiveTasksWaitGroup.Done()
 
-					m.downloadAndImportHistoryArchives(community.ID(), magnetlink, task.CancelChan)
+					m.downloadAndImportHistoryArchives(community.ID(), magnetlink, archiveURIs, task.CancelChan)
 				}(currentTask)
 
 				clock := requestToJoinResponseProto.Community.ArchiveMagnetlinkClock
@@ -1938,6 +2004,8 @@ func (m *Messenger) handleChatMessage(state *ReceivedMessageState, forceSeen boo
 		logger.Warn("failed to validate message", zap.Error(err))
 		return err
 	}
+	m.filterReceivedLinkPreviews(&state.CurrentMessageState.Message)
+
 	receivedMessage := &common.Message{
 		ID:               state.CurrentMessageState.MessageID,
 		ChatMessage:      state.CurrentMessageState.Message,
@@ -1977,6 +2045,8 @@ func (m *Messenger) handleChatMessage(state *ReceivedMessageState, forceSeen boo
 		return err // matchChatEntity returns a descriptive error message
 	}
 
+	m.resolveReceivedStatusLinkPreviews(&receivedMessage.ChatMessage, chat.ID)
+
 	if chat.ReadMessagesAtClockValue >= receivedMessage.Clock {
 		receivedMessage.Seen = true
 	}
@@ -2014,6 +2084,12 @@ func (m *Messenger) handleChatMessage(state *ReceivedMessageState, forceSeen boo
 	// Set the LocalChatID for the message
 	receivedMessage.LocalChatID = chat.ID
 
+	// The messages of the users blocklisted by the community are stored hidden
+	if m.blocklistedInChat(chat, receivedMessage.From) {
+		m.logger.Debug("hiding message of a blocklisted user", zap.String("chatID", chat.ID), zap.String("from", receivedMessage.From))
+		return m.saveBlocklistedMessage(receivedMessage)
+	}
+
 	if err := m.updateChatFirstMessageTimestamp(chat, whisperToUnixTimestamp(receivedMessage.WhisperTimestamp), state.Response); err != nil {
 		return err
 	}
@@ -2184,6 +2260,18 @@ func (m *Messenger) handleChatMessage(state *ReceivedMessageState, forceSeen boo
 	receivedMessage.New = true
 	state.Response.AddMessage(receivedMessage)
 
+	if receivedMessage.ContentType == protobuf.ChatMessage_SAFE_TRANSACTION {
+		// Executions received before the proposal can be checked now
+		m.verifySafeTransactionExecutionsSoon()
+	}
+
+	if receivedMessage.AttachmentDescriptor() != nil && len(receivedMessage.AttachmentPayload()) == 0 {
+		err = m.handleReceivedAttachment(state.Response, receivedMessage)
+		if err != nil {
+			logger.Warn("failed to handle attachment", zap.Error(err))
+		}
+	}
+
 	return nil
 }
 
@@ -2265,6 +2353,10 @@ func (m *Messenger) handleSyncSetting(messageState *ReceivedMessageState, messag
 				return err
 			}
 		}
+	case protobuf.SyncSetting_NOTIFICATION_KEYWORDS:
+		m.notificationKeywords.reset()
+	case protobuf.SyncSetting_TEST_NETWORKS_ENABLED:
+		m.reloadWallet()
 	case protobuf.SyncSetting_MNEMONIC_REMOVED:
 		if message.GetValueBool() {
 			if err := m.settings.DeleteMnemonic(); err != nil {
@@ -2548,10 +2640,10 @@ func (m *Messenger) matchChatEntity(chatEntity common.ChatEntity) (*Chat, error)
 			return nil, err
 		}
 
-		isMemberOwnerOrAdmin := community.IsMemberOwnerOrAdmin(chatEntity.GetSigPubKey())
+		canPinMessages := community.HasMemberPermission(chatEntity.GetSigPubKey(), protobuf.CommunityMember_PERMISSION_PIN_MESSAGES)
 		pinMessageAllowed := community.AllowsAllMembersToPinMessage()
 
-		if (pinMessage && !isMemberOwnerOrAdmin && !pinMessageAllowed) || (!emojiReaction && !canPost) {
+		if (pinMessage && !canPinMessages && !pinMessageAllowed) || (!emojiReaction && !canPost) {
 			return nil, errors.New("user can't post")
 		}
 
@@ -2637,6 +2729,14 @@ func (m *Messenger) HandleEmojiReaction(state *ReceivedMessageState, pbEmojiR pr
 		return err // matchChatEntity returns a descriptive error message
 	}
 
+	if pbEmojiR.Type == protobuf.EmojiReaction_CUSTOM {
+		err = m.validateCommunityEmojiReaction(chat, state.CurrentMessageState.PublicKey, pbEmojiR.CommunityEmojiId)
+		if err != nil {
+			logger.Warn("invalid community emoji reaction", zap.Error(err))
+			return err
+		}
+	}
+
 	// Set local chat id
 	emojiReaction.LocalChatID = chat.ID
 
@@ -3210,6 +3310,34 @@ func (m *Messenger) HandleSyncKeypair(state *ReceivedMessageState, message proto
 	return nil
 }
 
+func (m *Messenger) HandleSyncAccountsPositions(state *ReceivedMessageState, message protobuf.SyncAccountsPositions) error {
+	clock, err := m.settings.GetClockOfLastAccountsPositionChange()
+	if err != nil {
+		return err
+	}
+	if message.Clock <= clock {
+		return nil
+	}
+
+	var accs []*accounts.Account
+	for _, sAcc := range message.Accounts {
+		accs = append(accs, &accounts.Account{
+			Address:  types.BytesToAddress(sAcc.Address),
+			KeyUID:   sAcc.KeyUid,
+			Position: sAcc.Position,
+		})
+	}
+
+	err = m.settings.SetWalletAccountsPositions(accs, message.Clock)
+	if err != nil {
+		return err
+	}
+
+	state.Response.AccountsPositions = append(state.Response.AccountsPositions, accs...)
+
+	return nil
+}
+
 func (m *Messenger) HandleSyncContactRequestDecision(state *ReceivedMessageState, message protobuf.SyncContactRequestDecision) error {
 	var err error
 	var response *MessengerResponse
@@ -0,0 +1,113 @@
+package protocol
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/status-im/status-go/appdatabase"
+)
+
+// Prefixes of the ids of the waku protocols, the versions vary
+const (
+	wakuRelayProtocol     = "/vac/waku/relay/"
+	wakuStoreProtocol     = "/vac/waku/store/"
+	wakuFilterProtocol    = "/vac/waku/filter"
+	wakuLightPushProtocol = "/vac/waku/lightpush/"
+)
+
+// WakuPeerCounts are the numbers of peers connected, by protocol they support
+type WakuPeerCounts struct {
+	Total     int `json:"total"`
+	Relay     int `json:"relay"`
+	Store     int `json:"store"`
+	Filter    int `json:"filter"`
+	LightPush int `json:"lightPush"`
+}
+
+// MessengerHealth is the state of the messaging subsystem. The timestamps are
+// in seconds, 0 when it didn't happen since the messenger started
+type MessengerHealth struct {
+	Online               bool           `json:"online"`
+	Peers                WakuPeerCounts `json:"peers"`
+	ActiveMailserverID   string         `json:"activeMailserverId,omitempty"`
+	ActiveMailserverName string         `json:"activeMailserverName,omitempty"`
+	LastHistoryQuery     int64          `json:"lastHistoryQuery"`
+	LastSyncMessage      int64          `json:"lastSyncMessage"`
+	// DatabaseSizes are the sizes in bytes of the files of the database
+	DatabaseSizes map[string]int64 `json:"databaseSizes"`
+}
+
+func (m *Messenger) wakuPeerCounts() WakuPeerCounts {
+	counts := WakuPeerCounts{}
+	for _, peer := range m.Peers() {
+		counts.Total++
+		for _, protocol := range peer.Protocols {
+			switch id := string(protocol); {
+			case strings.HasPrefix(id, wakuRelayProtocol):
+				counts.Relay++
+			case strings.HasPrefix(id, wakuStoreProtocol):
+				counts.Store++
+			case strings.HasPrefix(id, wakuFilterProtocol):
+				counts.Filter++
+			case strings.HasPrefix(id, wakuLightPushProtocol):
+				counts.LightPush++
+			}
+		}
+	}
+	return counts
+}
+
+func (m *Messenger) databaseSizes() (map[string]int64, error) {
+	sizes := make(map[string]int64)
+	path, err := appdatabase.GetDBFilename(m.database)
+	if err != nil || path == "" {
+		return sizes, err
+	}
+
+	for _, file := range []string{path, path + "-wal", path + "-shm"} {
+		info, err := os.Stat(file)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sizes[filepath.Base(file)] = info.Size()
+	}
+	return sizes, nil
+}
+
+// Health returns the state of the messaging subsystem
+func (m *Messenger) Health() (*MessengerHealth, error) {
+	databaseSizes, err := m.databaseSizes()
+	if err != nil {
+		return nil, err
+	}
+
+	health := &MessengerHealth{
+		Online:           m.online(),
+		Peers:            m.wakuPeerCounts(),
+		LastHistoryQuery: m.lastHistoryQuery.Load(),
+		LastSyncMessage:  m.lastSyncMessage.Load(),
+		DatabaseSizes:    databaseSizes,
+	}
+
+	m.mailserverCycle.RLock()
+	if ms := m.mailserverCycle.activeMailserver; ms != nil {
+		health.ActiveMailserverID = ms.ID
+		health.ActiveMailserverName = ms.Name
+	}
+	m.mailserverCycle.RUnlock()
+
+	return health, nil
+}
+
+func (m *Messenger) recordHistoryQuery() {
+	m.lastHistoryQuery.Store(time.Now().Unix())
+}
+
+func (m *Messenger) recordSyncMessage() {
+	m.lastSyncMessage.Store(time.Now().Unix())
+}
@@ -0,0 +1,61 @@
+package protocol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/protocol/encryption/multidevice"
+)
+
+func TestMessengerHealthSuite(t *testing.T) {
+	suite.Run(t, new(MessengerHealthSuite))
+}
+
+type MessengerHealthSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerHealthSuite) TestHealth() {
+	health, err := s.m.Health()
+	s.Require().NoError(err)
+	s.Require().Zero(health.LastHistoryQuery)
+	s.Require().Zero(health.LastSyncMessage)
+	s.Require().NotNil(health.DatabaseSizes)
+
+	s.m.recordHistoryQuery()
+	s.m.recordSyncMessage()
+
+	health, err = s.m.Health()
+	s.Require().NoError(err)
+	s.Require().NotZero(health.LastHistoryQuery)
+	s.Require().NotZero(health.LastSyncMessage)
+	s.Require().GreaterOrEqual(health.Peers.Total, health.Peers.Relay)
+}
+
+func (s *MessengerHealthSuite) TestLastSyncMessage() {
+	theirMessenger, err := newMessengerWithKey(s.shh, s.privateKey, s.logger, nil)
+	s.Require().NoError(err)
+	defer theirMessenger.Shutdown() // nolint: errcheck
+
+	err = theirMessenger.SetInstallationMetadata(theirMessenger.installationID, &multidevice.InstallationMetadata{
+		Name:       "their-name",
+		DeviceType: "their-device-type",
+	})
+	s.Require().NoError(err)
+	_, err = theirMessenger.SendPairInstallation(context.Background(), nil)
+	s.Require().NoError(err)
+
+	// The pairing message is sent by our other device
+	_, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.Installations) > 0 },
+		"installation not received",
+	)
+	s.Require().NoError(err)
+
+	health, err := s.m.Health()
+	s.Require().NoError(err)
+	s.Require().NotZero(health.LastSyncMessage)
+}
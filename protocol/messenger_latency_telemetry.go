@@ -0,0 +1,61 @@
+package protocol
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/telemetry"
+)
+
+const latencyTelemetryPushInterval = 10 * time.Minute
+
+// startLatencyTelemetryLoop loads whether latency telemetry is enabled and
+// periodically pushes the latencies to the telemetry server, if any
+func (m *Messenger) startLatencyTelemetryLoop() {
+	enabled, err := m.settings.LatencyTelemetryEnabled()
+	if err != nil {
+		m.logger.Error("failed to get latency telemetry setting", zap.Error(err))
+	}
+	m.latencyTelemetryEnabled.Store(enabled)
+
+	if m.telemetryClient == nil {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(latencyTelemetryPushInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if m.latencyTelemetryEnabled.Load() {
+					m.telemetryClient.PushLatencyHistograms(m.latencyTracker.TakeUnreported())
+				}
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// ToggleLatencyTelemetry enables recording the latencies of the messages sent
+// and received, disabling it drops the latencies recorded
+func (m *Messenger) ToggleLatencyTelemetry(enabled bool) error {
+	err := m.settings.SetLatencyTelemetryEnabled(enabled)
+	if err != nil {
+		return err
+	}
+
+	m.latencyTelemetryEnabled.Store(enabled)
+	if !enabled {
+		m.latencyTracker.Reset()
+	}
+	return nil
+}
+
+// LatencyTelemetry returns the histograms of the latencies recorded
+func (m *Messenger) LatencyTelemetry() map[string]telemetry.LatencyHistogram {
+	return m.latencyTracker.Histograms()
+}
@@ -0,0 +1,326 @@
+package protocol
+
+import (
+	"errors"
+	"net/url"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/linkpreview"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// maxStatusLinkPreviewIconSize is the largest icon embedded in the preview of
+// a status.app link, larger ones are left out
+const maxStatusLinkPreviewIconSize = 64 * 1024
+
+var ErrLinkPreviewsPrivacyMode = errors.New("link previews can't be fetched in privacy mode")
+var ErrStatusLinkPreviewAudience = errors.New("messages can only be previewed in their own chat")
+
+// UnfurlURLs uses a best-effort approach to unfurl each URL allowed by the
+// link previews filter. No URL is fetched when the privacy mode of link
+// previews, or the global privacy mode, is enabled.
+func (m *Messenger) UnfurlURLs(urls []string) ([]common.LinkPreview, error) {
+	privacy, err := m.PrivacySettings()
+	if err != nil {
+		return nil, err
+	}
+	if !privacy.LinkPreviews {
+		return nil, ErrLinkPreviewsPrivacyMode
+	}
+
+	filter, err := m.linkPreviewsFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	return linkpreview.UnfurlURLs(m.logger, linkpreview.NewDefaultHTTPClient(), linkpreview.FilterURLs(urls, filter))
+}
+
+func (m *Messenger) SetLinkPreviewsMode(mode settings.LinkPreviewsModeType) error {
+	return m.settings.SetLinkPreviewsMode(mode)
+}
+
+func (m *Messenger) SetLinkPreviewsFilter(allowlist []string, denylist []string) error {
+	err := m.settings.SetLinkPreviewsAllowlist(allowlist)
+	if err != nil {
+		return err
+	}
+	return m.settings.SetLinkPreviewsDenylist(denylist)
+}
+
+func (m *Messenger) linkPreviewsFilter() (linkpreview.HostnameFilter, error) {
+	var filter linkpreview.HostnameFilter
+	var err error
+
+	filter.Allowlist, err = m.settings.LinkPreviewsAllowlist()
+	if err != nil {
+		return filter, err
+	}
+	filter.Denylist, err = m.settings.LinkPreviewsDenylist()
+	return filter, err
+}
+
+// unfurlMessageLinks generates the link previews of an outgoing message
+// when the sender unfurls links and none were provided by the client, so
+// that receivers don't have to fetch the URLs themselves
+func (m *Messenger) unfurlMessageLinks(message *common.Message) error {
+	if len(message.LinkPreviews) != 0 {
+		return nil
+	}
+
+	mode, err := m.settings.LinkPreviewsMode()
+	if err != nil {
+		return err
+	}
+	if mode != settings.LinkPreviewsModeSender {
+		return nil
+	}
+	privacyMode, err := m.settings.PrivacyMode()
+	if err != nil {
+		return err
+	}
+	if privacyMode {
+		return nil
+	}
+
+	// status.app links are unfurled from the local data instead
+	var urls []string
+	for _, u := range linkpreview.GetURLs(message.Text) {
+		if !isStatusLink(u) {
+			urls = append(urls, u)
+		}
+	}
+	if len(urls) == 0 {
+		return nil
+	}
+
+	previews, err := m.UnfurlURLs(urls)
+	if err != nil {
+		return err
+	}
+
+	for _, preview := range previews {
+		// Previews without a title can't be embedded in the message
+		if preview.Title != "" {
+			message.LinkPreviews = append(message.LinkPreviews, preview)
+		}
+	}
+
+	return nil
+}
+
+// filterReceivedLinkPreviews drops the previews embedded in a received
+// message whose hostname is not allowed by the link previews filter
+func (m *Messenger) filterReceivedLinkPreviews(message *protobuf.ChatMessage) {
+	if len(message.UnfurledLinks) == 0 {
+		return
+	}
+
+	filter, err := m.linkPreviewsFilter()
+	if err != nil {
+		m.logger.Error("failed to get link previews filter", zap.Error(err))
+		return
+	}
+
+	unfurledLinks := make([]*protobuf.UnfurledLink, 0, len(message.UnfurledLinks))
+	for _, link := range message.UnfurledLinks {
+		parsedURL, err := url.Parse(link.Url)
+		if err != nil || !filter.Allowed(parsedURL.Hostname()) {
+			continue
+		}
+		unfurledLinks = append(unfurledLinks, link)
+	}
+	message.UnfurledLinks = unfurledLinks
+}
+
+// UnfurlStatusLinks resolves the status.app links of a message to be sent in
+// the chat to the users, communities, channels and messages they point to.
+// Nothing is fetched from the links, the previews are built from the local
+// data and the data embedded in the links, unknown communities are requested
+// from the store nodes. Links which can't be resolved are skipped, as are
+// the messages of other chats, whose audience may differ
+func (m *Messenger) UnfurlStatusLinks(chatID string, urls []string) []common.StatusLinkPreview {
+	return m.unfurlStatusLinks(urls, chatID, true)
+}
+
+func (m *Messenger) unfurlStatusLinks(urls []string, chatID string, fetch bool) []common.StatusLinkPreview {
+	previews := make([]common.StatusLinkPreview, 0, len(urls))
+	for _, u := range urls {
+		if !isStatusLink(u) {
+			continue
+		}
+		preview, err := m.unfurlStatusLink(u, chatID, fetch)
+		if err != nil {
+			m.logger.Debug("failed to unfurl status link", zap.String("url", u), zap.Error(err))
+			continue
+		}
+		previews = append(previews, *preview)
+	}
+	return previews
+}
+
+func (m *Messenger) unfurlStatusLink(u string, chatID string, fetch bool) (*common.StatusLinkPreview, error) {
+	data, err := m.parseSharedURL(u, fetch)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &common.StatusLinkPreview{URL: u}
+	switch {
+	case data.Message.MessageID != "":
+		// Messages must not leak to the members of other chats
+		if _, ok := m.allChats.Load(data.Message.ChatID); !ok || data.Message.ChatID != chatID {
+			return nil, ErrStatusLinkPreviewAudience
+		}
+		preview.Message = &common.StatusMessageLinkPreview{
+			MessageID:         data.Message.MessageID,
+			ChatID:            data.Message.ChatID,
+			Author:            data.Message.Author,
+			AuthorDisplayName: data.Message.AuthorDisplayName,
+			Text:              data.Message.Text,
+		}
+
+	case data.Community.CommunityID != "" && data.Community.DisplayName == "":
+		// Invites to communities which aren't known yet only carry their ID
+		return nil, errors.New("community of the status link not found")
+
+	case data.Channel.ChannelUUID != "":
+		preview.Channel = &common.StatusCommunityChannelLinkPreview{
+			ChannelUUID: data.Channel.ChannelUUID,
+			Emoji:       data.Channel.Emoji,
+			DisplayName: data.Channel.DisplayName,
+			Description: data.Channel.Description,
+			Color:       data.Channel.Color,
+			Community:   m.statusLinkCommunityPreview(data.Community),
+		}
+
+	case data.Community.CommunityID != "":
+		preview.Community = m.statusLinkCommunityPreview(data.Community)
+
+	case data.Contact.PublicKey != "":
+		preview.Contact = &common.StatusContactLinkPreview{
+			PublicKey:   data.Contact.PublicKey,
+			DisplayName: data.Contact.DisplayName,
+			Description: data.Contact.Description,
+		}
+		if contact, ok := m.allContacts.Load(data.Contact.PublicKey); ok {
+			if image, ok := contact.Images[images.SmallDimName]; ok {
+				preview.Contact.Icon = statusLinkPreviewIcon(image.Payload)
+			}
+		}
+
+	default:
+		return nil, errors.New("unhandled status link")
+	}
+
+	return preview, nil
+}
+
+// statusLinkCommunityPreview adds the icon of the community to its preview
+// when the community is known
+func (m *Messenger) statusLinkCommunityPreview(data CommunityURLData) *common.StatusCommunityLinkPreview {
+	preview := &common.StatusCommunityLinkPreview{
+		CommunityID:  data.CommunityID,
+		DisplayName:  data.DisplayName,
+		Description:  data.Description,
+		MembersCount: data.MembersCount,
+		Color:        data.Color,
+	}
+
+	communityID, err := types.DecodeHex(data.CommunityID)
+	if err != nil {
+		return preview
+	}
+	community, err := m.GetCommunityByID(communityID)
+	if err != nil || community == nil {
+		return preview
+	}
+	if image, ok := community.Images()[images.SmallDimName]; ok {
+		preview.Icon = statusLinkPreviewIcon(image.Payload)
+	}
+	return preview
+}
+
+func statusLinkPreviewIcon(payload []byte) common.LinkPreviewThumbnail {
+	if len(payload) == 0 || len(payload) > maxStatusLinkPreviewIconSize {
+		return common.LinkPreviewThumbnail{}
+	}
+	width, height, err := images.GetImageDimensions(payload)
+	if err != nil {
+		return common.LinkPreviewThumbnail{}
+	}
+	dataURI, err := images.GetPayloadDataURI(payload)
+	if err != nil {
+		return common.LinkPreviewThumbnail{}
+	}
+	return common.LinkPreviewThumbnail{
+		Width:   width,
+		Height:  height,
+		DataURI: dataURI,
+	}
+}
+
+// unfurlMessageStatusLinks generates the previews of the status.app links of
+// an outgoing message. Unlike the other links they're always unfurled, as
+// nothing is fetched from them. The previews provided by the client are
+// resolved again for the chat of the message, unknown communities aren't
+// requested, not to hold the message back
+func (m *Messenger) unfurlMessageStatusLinks(message *common.Message) {
+	var urls []string
+	if len(message.StatusLinkPreviews) != 0 {
+		for _, preview := range message.StatusLinkPreviews {
+			urls = append(urls, preview.URL)
+		}
+	} else {
+		urls = linkpreview.GetURLs(message.Text)
+	}
+	if len(urls) == 0 {
+		return
+	}
+	message.StatusLinkPreviews = m.unfurlStatusLinks(urls, message.ChatId, false)
+}
+
+// resolveReceivedStatusLinkPreviews replaces the previews of status.app links
+// embedded in a message received in the chat by the ones built from the local
+// data, so that the sender can't make a link look like something else. The
+// community cards which can't be resolved locally are kept as sent, marked
+// unverified, the other links which can't be resolved, as the messages of
+// other chats, aren't previewed
+func (m *Messenger) resolveReceivedStatusLinkPreviews(message *protobuf.ChatMessage, chatID string) {
+	if len(message.UnfurledStatusLinks) == 0 {
+		return
+	}
+
+	unfurledLinks := make([]*protobuf.UnfurledStatusLink, 0, len(message.UnfurledStatusLinks))
+	for _, link := range message.UnfurledStatusLinks {
+		preview, err := m.unfurlStatusLink(link.Url, chatID, false)
+		if err != nil {
+			if unverifiedCommunityLinkAllowed(link) {
+				link.Unverified = true
+				unfurledLinks = append(unfurledLinks, link)
+			}
+			continue
+		}
+
+		resolved := &common.Message{StatusLinkPreviews: []common.StatusLinkPreview{*preview}}
+		links, err := resolved.ConvertStatusLinkPreviewsToProto()
+		if err != nil {
+			m.logger.Warn("failed to convert received status link preview", zap.String("url", link.Url), zap.Error(err))
+			continue
+		}
+		unfurledLinks = append(unfurledLinks, links...)
+	}
+	message.UnfurledStatusLinks = unfurledLinks
+}
+
+// unverifiedCommunityLinkAllowed tells whether the community card provided by
+// the sender can be shown when it can't be built from the local data, that is
+// when it points to status.app and its icon isn't too large
+func unverifiedCommunityLinkAllowed(link *protobuf.UnfurledStatusLink) bool {
+	return isStatusLink(link.Url) && link.Community != nil && len(link.Community.Icon) <= maxStatusLinkPreviewIconSize
+}
@@ -330,6 +330,7 @@ func (m *Messenger) RequestAllHistoricMessages(forceFetchingBackup bool) (*Messe
 	if err != nil {
 		return nil, err
 	}
+	m.recordHistoryQuery()
 	return response, nil
 }
 
@@ -728,12 +729,13 @@ loop:
 }
 
 func (m *Messenger) processMailserverBatch(batch MailserverBatch) error {
-	mailserverID, err := m.activeMailserverID()
+	mailserverIDs, err := m.mailserverQueryPeers()
 	if err != nil {
 		return err
 	}
 
-	return processMailserverBatch(m.ctx, m.transport, batch, mailserverID, m.logger)
+	requester := &statsMessageRequester{messageRequester: m.transport, stats: m.mailserverQueryStats}
+	return processMailserverBatchFromPeers(m.ctx, requester, batch, mailserverIDs, m.logger)
 }
 
 type MailserverBatch struct {
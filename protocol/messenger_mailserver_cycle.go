@@ -32,21 +32,21 @@ func (m *Messenger) mailserversByFleet(fleet string) []mailservers.Mailserver {
 	return items
 }
 
-type byRTTMsAndCanConnectBefore []SortedMailserver
+type byScoreAndCanConnectBefore []SortedMailserver
 
-func (s byRTTMsAndCanConnectBefore) Len() int {
+func (s byScoreAndCanConnectBefore) Len() int {
 	return len(s)
 }
 
-func (s byRTTMsAndCanConnectBefore) Swap(i, j int) {
+func (s byScoreAndCanConnectBefore) Swap(i, j int) {
 	s[i], s[j] = s[j], s[i]
 }
 
-func (s byRTTMsAndCanConnectBefore) Less(i, j int) bool {
+func (s byScoreAndCanConnectBefore) Less(i, j int) bool {
 	// Slightly inaccurate as time sensitive sorting, but it does not matter so much
 	now := time.Now()
 	if s[i].CanConnectAfter.Before(now) && s[j].CanConnectAfter.Before(now) {
-		return s[i].RTTMs < s[j].RTTMs
+		return s[i].Score < s[j].Score
 	}
 	return s[i].CanConnectAfter.Before(s[j].CanConnectAfter)
 }
@@ -195,6 +195,7 @@ func (m *Messenger) allMailservers() ([]mailservers.Mailserver, error) {
 type SortedMailserver struct {
 	Address         string
 	RTTMs           int
+	Score           float64
 	CanConnectAfter time.Time
 }
 
@@ -270,6 +271,7 @@ func (m *Messenger) findNewMailserver() error {
 	for idx := range allMailservers {
 		mailserversByAddress[allMailservers[idx].Address] = allMailservers[idx]
 	}
+	stats := m.mailserverQueryStats.all()
 	var sortedMailservers []SortedMailserver
 	for _, ping := range availableMailservers {
 		address := ping.Address
@@ -277,6 +279,7 @@ func (m *Messenger) findNewMailserver() error {
 		sortedMailserver := SortedMailserver{
 			Address: address,
 			RTTMs:   *ping.RTTMs,
+			Score:   mailserverScore(*ping.RTTMs, mailserverStats(stats, ms)),
 		}
 		m.mailPeersMutex.Lock()
 		pInfo, ok := m.mailserverCycle.peers[ms.ID]
@@ -288,9 +291,9 @@ func (m *Messenger) findNewMailserver() error {
 		sortedMailservers = append(sortedMailservers, sortedMailserver)
 
 	}
-	sort.Sort(byRTTMsAndCanConnectBefore(sortedMailservers))
+	sort.Sort(byScoreAndCanConnectBefore(sortedMailservers))
 
-	// Picks a random mailserver amongs the ones with the lowest latency
+	// Picks a random mailserver amongs the ones with the best score
 	// The pool size is 1/4 of the mailservers were pinged successfully
 	pSize := poolSize(len(sortedMailservers) - 1)
 	if pSize <= 0 {
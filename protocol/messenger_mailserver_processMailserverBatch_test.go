@@ -153,3 +153,79 @@ func TestProcessMailserverBatchFailure(t *testing.T) {
 	err = processMailserverBatch(context.TODO(), testTransport, testBatch, mailserverID, logger)
 	require.Error(t, err)
 }
+
+type failingPeersTransport struct {
+	*mockTransport
+	failingPeers map[string]bool
+}
+
+func (t *failingPeersTransport) SendMessagesRequestForTopics(
+	ctx context.Context,
+	peerID []byte,
+	from, to uint32,
+	previousCursor []byte,
+	previousStoreCursor *types.StoreRequestCursor,
+	topics []types.TopicType,
+	waitForResponse bool,
+) (cursor []byte, storeCursor *types.StoreRequestCursor, err error) {
+	if t.failingPeers[types.EncodeHex(peerID)] {
+		return nil, nil, errors.New("peer down")
+	}
+	return t.mockTransport.SendMessagesRequestForTopics(ctx, peerID, from, to, previousCursor, previousStoreCursor, topics, waitForResponse)
+}
+
+func TestProcessMailserverBatchFromPeers(t *testing.T) {
+	logger := tt.MustCreateTestLogger()
+
+	mailserverIDs := [][]byte{{1}, {2}, {3}}
+	topics := []types.TopicType{}
+	for i := 0; i < 12; i++ {
+		topics = append(topics, types.BytesToTopic([]byte{0, 0, 0, byte(i)}))
+	}
+
+	testTransport := &failingPeersTransport{
+		mockTransport: newMockTransport(),
+		failingPeers:  map[string]bool{types.EncodeHex(mailserverIDs[1]): true},
+	}
+	err := testTransport.Populate(topics, 3, false)
+	require.NoError(t, err)
+
+	stats := newMailserverQueryStats()
+	requester := &statsMessageRequester{messageRequester: testTransport, stats: stats}
+
+	testBatch := MailserverBatch{
+		Topics: topics,
+	}
+
+	// Succeeds as long as one of the mailservers succeeds
+	err = processMailserverBatchFromPeers(context.TODO(), requester, testBatch, mailserverIDs, logger)
+	require.NoError(t, err)
+
+	allStats := stats.all()
+	require.Len(t, allStats, 3)
+	// 2 bunches of topics with 3 pages each
+	require.Equal(t, uint64(6), allStats[types.EncodeHex(mailserverIDs[0])].Queries)
+	require.Equal(t, uint64(0), allStats[types.EncodeHex(mailserverIDs[0])].Failures)
+	require.NotZero(t, allStats[types.EncodeHex(mailserverIDs[1])].Failures)
+
+	for _, mailserverID := range mailserverIDs {
+		testTransport.failingPeers[types.EncodeHex(mailserverID)] = true
+	}
+	err = processMailserverBatchFromPeers(context.TODO(), requester, testBatch, mailserverIDs, logger)
+	require.Error(t, err)
+}
+
+func TestMailserverScore(t *testing.T) {
+	// New mailservers are ranked by RTT
+	require.Less(t, mailserverScore(10, MailserverQueryStats{}), mailserverScore(100, MailserverQueryStats{}))
+
+	// Failing mailservers are ranked after slower reliable ones
+	reliable := MailserverQueryStats{Queries: 10, AverageLatencyMs: 200}
+	failing := MailserverQueryStats{Queries: 10, Failures: 8, AverageLatencyMs: 50}
+	require.Less(t, mailserverScore(50, reliable), mailserverScore(50, failing))
+
+	// Slow store queries lower the score of mailservers with a low RTT
+	slow := MailserverQueryStats{Queries: 10, AverageLatencyMs: 2000}
+	fast := MailserverQueryStats{Queries: 10, AverageLatencyMs: 100}
+	require.Less(t, mailserverScore(100, fast), mailserverScore(10, slow))
+}
@@ -0,0 +1,246 @@
+package protocol
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/services/mailservers"
+)
+
+// maxParallelMailserverQueries limits the number of mailservers a batch is
+// requested from at the same time
+var maxParallelMailserverQueries = 3
+
+// MailserverQueryStats are the stats of the store queries sent to a
+// mailserver, used to select the mailservers to query
+type MailserverQueryStats struct {
+	Queries          uint64 `json:"queries"`
+	Failures         uint64 `json:"failures"`
+	LastLatencyMs    int64  `json:"lastLatencyMs"`
+	AverageLatencyMs int64  `json:"averageLatencyMs"`
+
+	totalLatency time.Duration
+}
+
+type mailserverQueryStats struct {
+	sync.RWMutex
+	stats map[string]*MailserverQueryStats // by mailserver ID
+}
+
+func newMailserverQueryStats() *mailserverQueryStats {
+	return &mailserverQueryStats{
+		stats: make(map[string]*MailserverQueryStats),
+	}
+}
+
+func (s *mailserverQueryStats) record(mailserverID []byte, latency time.Duration, err error) {
+	s.Lock()
+	defer s.Unlock()
+
+	id := types.EncodeHex(mailserverID)
+	stats, ok := s.stats[id]
+	if !ok {
+		stats = &MailserverQueryStats{}
+		s.stats[id] = stats
+	}
+
+	stats.Queries++
+	if err != nil {
+		stats.Failures++
+	}
+	stats.totalLatency += latency
+	stats.LastLatencyMs = latency.Milliseconds()
+	stats.AverageLatencyMs = (stats.totalLatency / time.Duration(stats.Queries)).Milliseconds()
+}
+
+func (s *mailserverQueryStats) all() map[string]MailserverQueryStats {
+	s.RLock()
+	defer s.RUnlock()
+
+	result := make(map[string]MailserverQueryStats)
+	for id, stats := range s.stats {
+		result[id] = *stats
+	}
+	return result
+}
+
+// mailserverStats returns the stats of a mailserver from the stats by ID
+func mailserverStats(stats map[string]MailserverQueryStats, ms mailservers.Mailserver) MailserverQueryStats {
+	mailserverID, err := ms.IDBytes()
+	if err != nil {
+		return MailserverQueryStats{}
+	}
+	return stats[types.EncodeHex(mailserverID)]
+}
+
+// statsMessageRequester records the latency of the store queries
+type statsMessageRequester struct {
+	messageRequester
+	stats *mailserverQueryStats
+}
+
+func (r *statsMessageRequester) SendMessagesRequestForTopics(
+	ctx context.Context,
+	peerID []byte,
+	from, to uint32,
+	previousCursor []byte,
+	previousStoreCursor *types.StoreRequestCursor,
+	topics []types.TopicType,
+	waitForResponse bool,
+) (cursor []byte, storeCursor *types.StoreRequestCursor, err error) {
+	start := time.Now()
+	cursor, storeCursor, err = r.messageRequester.SendMessagesRequestForTopics(ctx, peerID, from, to, previousCursor, previousStoreCursor, topics, waitForResponse)
+	r.stats.record(peerID, time.Since(start), err)
+	return cursor, storeCursor, err
+}
+
+// mailserverScore ranks a mailserver, the lower the better. The latency is the
+// average of the ping RTT and of the store queries latency, and it's increased
+// by the failure rate of the queries. Mailservers without queries get a
+// neutral success rate, so that new ones are tried too
+func mailserverScore(rttMs int, stats MailserverQueryStats) float64 {
+	latencyMs := float64(rttMs)
+	if stats.Queries > 0 {
+		latencyMs = (latencyMs + float64(stats.AverageLatencyMs)) / 2
+	}
+
+	successRate := float64(stats.Queries-stats.Failures+1) / float64(stats.Queries+2)
+	return (latencyMs + 1) / successRate
+}
+
+// MailserverScore is the score of a mailserver used to select it, the lower
+// the better
+type MailserverScore struct {
+	ID      string               `json:"id"`
+	Address string               `json:"address"`
+	Pinned  bool                 `json:"pinned"`
+	Active  bool                 `json:"active"`
+	Score   float64              `json:"score"`
+	Stats   MailserverQueryStats `json:"stats"`
+}
+
+// processMailserverBatchFromPeers requests a batch from several mailservers in
+// parallel. Envelopes received from more than one mailserver are deduplicated
+// by hash by waku, so the result is the union of the history of all of them.
+// It only fails when all the mailservers fail
+func processMailserverBatchFromPeers(ctx context.Context, messageRequester messageRequester, batch MailserverBatch, mailserverIDs [][]byte, logger *zap.Logger) error {
+	if len(mailserverIDs) <= 1 {
+		var mailserverID []byte
+		if len(mailserverIDs) == 1 {
+			mailserverID = mailserverIDs[0]
+		}
+		return processMailserverBatch(ctx, messageRequester, batch, mailserverID, logger)
+	}
+
+	errCh := make(chan error, len(mailserverIDs))
+	for _, mailserverID := range mailserverIDs {
+		go func(mailserverID []byte) {
+			errCh <- processMailserverBatch(ctx, messageRequester, batch, mailserverID, logger.With(zap.String("mailserverID", types.EncodeHex(mailserverID))))
+		}(mailserverID)
+	}
+
+	var errs []error
+	for range mailserverIDs {
+		if err := <-errCh; err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == len(mailserverIDs) {
+		return errs[0]
+	}
+	if len(errs) > 0 {
+		logger.Warn("some mailservers failed to process batch", zap.Int("failed", len(errs)), zap.Int("mailservers", len(mailserverIDs)))
+	}
+	return nil
+}
+
+// mailserverQueryPeers returns the active mailserver, followed by other
+// connected mailservers to query in parallel
+func (m *Messenger) mailserverQueryPeers() ([][]byte, error) {
+	activeMailserverID, err := m.activeMailserverID()
+	if err != nil {
+		return nil, err
+	}
+
+	mailserverIDs := [][]byte{activeMailserverID}
+	if m.mailserverCycle.activeMailserver == nil {
+		return mailserverIDs, nil
+	}
+
+	m.mailPeersMutex.Lock()
+	var candidates []peerStatus
+	for id, peer := range m.mailserverCycle.peers {
+		if peer.status != connected || id == m.mailserverCycle.activeMailserver.ID {
+			continue
+		}
+		candidates = append(candidates, peer)
+	}
+	m.mailPeersMutex.Unlock()
+
+	// Prefer the mailservers answering faster and more reliably
+	stats := m.mailserverQueryStats.all()
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return mailserverScore(0, mailserverStats(stats, candidates[i].mailserver)) < mailserverScore(0, mailserverStats(stats, candidates[j].mailserver))
+	})
+
+	for _, peer := range candidates {
+		if len(mailserverIDs) >= maxParallelMailserverQueries {
+			break
+		}
+
+		mailserverID, err := peer.mailserver.IDBytes()
+		if err != nil {
+			continue
+		}
+		mailserverIDs = append(mailserverIDs, mailserverID)
+	}
+
+	return mailserverIDs, nil
+}
+
+// MailserverScores returns the mailservers of the current fleet, sorted by
+// score, best first
+func (m *Messenger) MailserverScores() ([]MailserverScore, error) {
+	allMailservers, err := m.allMailservers()
+	if err != nil {
+		return nil, err
+	}
+
+	pinnedMailserver, err := m.getPinnedMailserver()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := m.mailserverQueryStats.all()
+	activeMailserver := m.getActiveMailserver()
+
+	var scores []MailserverScore
+	for _, ms := range allMailservers {
+		msStats := mailserverStats(stats, ms)
+		scores = append(scores, MailserverScore{
+			ID:      ms.ID,
+			Address: ms.Address,
+			Pinned:  pinnedMailserver != nil && pinnedMailserver.ID == ms.ID,
+			Active:  activeMailserver != nil && activeMailserver.ID == ms.ID,
+			Score:   mailserverScore(0, msStats),
+			Stats:   msStats,
+		})
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Score < scores[j].Score
+	})
+
+	return scores, nil
+}
+
+// MailserverQueryStats returns the stats of the store queries by mailserver ID
+func (m *Messenger) MailserverQueryStats() map[string]MailserverQueryStats {
+	return m.mailserverQueryStats.all()
+}
@@ -54,6 +54,9 @@ func (cm *chatMap) Delete(chatID string) {
 
 type contactMap struct {
 	sm sync.Map
+	// details caches the details of the users rendered in lists, they're
+	// dropped when the contact is stored or deleted
+	details contactDetailsCache
 }
 
 func (cm *contactMap) Load(contactID string) (*Contact, bool) {
@@ -66,6 +69,7 @@ func (cm *contactMap) Load(contactID string) (*Contact, bool) {
 
 func (cm *contactMap) Store(contactID string, contact *Contact) {
 	cm.sm.Store(contactID, contact)
+	cm.details.invalidate(contactID)
 }
 
 func (cm *contactMap) Range(f func(contactID string, contact *Contact) (shouldContinue bool)) {
@@ -77,6 +81,7 @@ func (cm *contactMap) Range(f func(contactID string, contact *Contact) (shouldCo
 
 func (cm *contactMap) Delete(contactID string) {
 	cm.sm.Delete(contactID)
+	cm.details.invalidate(contactID)
 }
 
 func (cm *contactMap) Len() int {
@@ -184,7 +184,8 @@ type MentionManager struct {
 	mentionContexts map[string]*ChatMentionContext
 	*Messenger
 	mentionableUserGetter
-	logger *zap.Logger
+	logger   *zap.Logger
+	activity mentionActivityIndex
 }
 
 func NewMentionManager(m *Messenger) *MentionManager {
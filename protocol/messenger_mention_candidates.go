@@ -0,0 +1,172 @@
+package protocol
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// MentionCandidateRole orders the candidates with the same activity, the
+// higher the role the earlier the candidate
+type MentionCandidateRole int
+
+const (
+	MentionCandidateRoleMember MentionCandidateRole = iota
+	MentionCandidateRoleModerator
+	MentionCandidateRoleAdmin
+	MentionCandidateRoleOwner
+)
+
+// MentionCandidate is a user who can be mentioned in a chat, along with what
+// it's ranked by
+type MentionCandidate struct {
+	User *MentionableUser     `json:"user"`
+	Role MentionCandidateRole `json:"role"`
+	// LastActivity is the whisper timestamp of the last message of the user
+	// in the chat, 0 when there's none
+	LastActivity uint64 `json:"lastActivity"`
+}
+
+// mentionActivityIndex keeps the timestamp of the last message of each
+// author in the chats mentions were looked up in, so that the candidates are
+// ranked without querying the messages again. It's updated as the messages
+// are received
+type mentionActivityIndex struct {
+	mutex sync.Mutex
+	chats map[string]map[string]uint64
+}
+
+// get returns the activity of a chat, loading it the first time
+func (i *mentionActivityIndex) get(chatID string, load func(chatID string) (map[string]uint64, error)) (map[string]uint64, error) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	activity, ok := i.chats[chatID]
+	if !ok {
+		var err error
+		activity, err = load(chatID)
+		if err != nil {
+			return nil, err
+		}
+		if i.chats == nil {
+			i.chats = make(map[string]map[string]uint64)
+		}
+		i.chats[chatID] = activity
+	}
+
+	copied := make(map[string]uint64, len(activity))
+	for author, timestamp := range activity {
+		copied[author] = timestamp
+	}
+	return copied, nil
+}
+
+// record updates the activity of the chats already loaded, the others are
+// loaded with the message once needed
+func (i *mentionActivityIndex) record(messages []*common.Message) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for _, message := range messages {
+		activity, ok := i.chats[message.LocalChatID]
+		if !ok || message.Deleted || message.DeletedForMe {
+			continue
+		}
+		if message.WhisperTimestamp > activity[message.From] {
+			activity[message.From] = message.WhisperTimestamp
+		}
+	}
+}
+
+// MentionCandidates returns the users who can be mentioned in a chat and
+// match the searched text, the ones who wrote in the chat most recently first,
+// then by role and name. A negative limit returns all of them
+func (m *MentionManager) MentionCandidates(chatID string, searchedText string, limit int) ([]*MentionCandidate, error) {
+	mentionableUsers, err := m.mentionableUserGetter.getMentionableUsers(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	activity, err := m.activity.get(chatID, m.persistence.LatestMessageTimestampsByAuthor)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := m.mentionCandidateRoles(chatID)
+
+	var candidates []*MentionCandidate
+	for pk, user := range getUserSuggestions(mentionableUsers, strings.ToLower(searchedText), -1) {
+		candidates = append(candidates, &MentionCandidate{
+			User:         user,
+			Role:         roles[pk],
+			LastActivity: activity[pk],
+		})
+	}
+
+	sortMentionCandidates(candidates)
+	if limit >= 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates, nil
+}
+
+// mentionCandidateRoles returns the roles of the members of a community or a
+// group chat, the members missing have no role
+func (m *MentionManager) mentionCandidateRoles(chatID string) map[string]MentionCandidateRole {
+	roles := make(map[string]MentionCandidateRole)
+
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return roles
+	}
+
+	switch {
+	case chat.PrivateGroupChat():
+		for _, member := range chat.Members {
+			if member.Admin {
+				roles[member.ID] = MentionCandidateRoleAdmin
+			}
+		}
+	case chat.CommunityChat():
+		community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
+		if err != nil || community == nil {
+			return roles
+		}
+		for _, pk := range community.GetMemberPubkeys() {
+			roles[common.PubkeyToHex(pk)] = mentionCandidateRole(community.MemberRole(pk))
+		}
+	}
+	return roles
+}
+
+func mentionCandidateRole(role protobuf.CommunityMember_Roles) MentionCandidateRole {
+	switch role {
+	case protobuf.CommunityMember_ROLE_OWNER:
+		return MentionCandidateRoleOwner
+	case protobuf.CommunityMember_ROLE_ADMIN:
+		return MentionCandidateRoleAdmin
+	case protobuf.CommunityMember_ROLE_MANAGE_USERS, protobuf.CommunityMember_ROLE_MODERATE_CONTENT, protobuf.CommunityMember_ROLE_TOKEN_MASTER:
+		return MentionCandidateRoleModerator
+	}
+	return MentionCandidateRoleMember
+}
+
+func sortMentionCandidates(candidates []*MentionCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.LastActivity != b.LastActivity {
+			return a.LastActivity > b.LastActivity
+		}
+		if a.Role != b.Role {
+			return a.Role > b.Role
+		}
+		aName, bName := strings.ToLower(a.User.GetDisplayName()), strings.ToLower(b.User.GetDisplayName())
+		if aName != bName {
+			return aName < bName
+		}
+		return a.User.ID < b.User.ID
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/logutils"
+	"github.com/status-im/status-go/protocol/common"
 )
 
 func TestRePosRegex(t *testing.T) {
@@ -1048,3 +1049,54 @@ func getDefaultMentionableUserMap() map[string]*MentionableUser {
 		},
 	}
 }
+
+func TestMentionCandidates(t *testing.T) {
+	_, chatID, mentionManager := setupMentionSuggestionTest(t, nil)
+	mentionManager.activity.chats = map[string]map[string]uint64{
+		chatID: {"0xpk1": 10, "0xpk3": 20},
+	}
+
+	mentionManager.activity.record([]*common.Message{
+		{ID: "0x01", LocalChatID: chatID, From: "0xpk2", WhisperTimestamp: 30},
+		{ID: "0x02", LocalChatID: "0xotherChatID", From: "0xpk4", WhisperTimestamp: 40},
+	})
+
+	candidates, err := mentionManager.MentionCandidates(chatID, "", -1)
+	require.NoError(t, err)
+	var keys []string
+	for _, c := range candidates {
+		keys = append(keys, c.User.Key)
+	}
+	require.Equal(t, []string{"0xpk2", "0xpk3", "0xpk1", "0xpk4"}, keys)
+	require.Equal(t, uint64(30), candidates[0].LastActivity)
+
+	candidates, err = mentionManager.MentionCandidates(chatID, "", 2)
+	require.NoError(t, err)
+	require.Len(t, candidates, 2)
+
+	candidates, err = mentionManager.MentionCandidates(chatID, "U3", -1)
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	require.Equal(t, "0xpk3", candidates[0].User.Key)
+}
+
+func TestSortMentionCandidates(t *testing.T) {
+	user := func(id string, name string) *MentionableUser {
+		return &MentionableUser{Contact: &Contact{ID: id, DisplayName: name}}
+	}
+	candidates := []*MentionCandidate{
+		{User: user("0xpk1", "bob")},
+		{User: user("0xpk2", "Alice")},
+		{User: user("0xpk3", "carol"), Role: MentionCandidateRoleAdmin},
+		{User: user("0xpk4", "dave"), Role: MentionCandidateRoleOwner},
+		{User: user("0xpk5", "erin"), LastActivity: 1},
+	}
+
+	sortMentionCandidates(candidates)
+
+	var ids []string
+	for _, c := range candidates {
+		ids = append(ids, c.User.ID)
+	}
+	require.Equal(t, []string{"0xpk5", "0xpk4", "0xpk3", "0xpk2", "0xpk1"}, ids)
+}
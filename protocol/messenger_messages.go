@@ -19,6 +19,10 @@ var ErrInvalidDeleteTypeAuthor = errors.New("message type cannot be deleted")
 var ErrInvalidEditContentType = errors.New("only text or emoji messages can be replaced")
 var ErrInvalidDeletePermission = errors.New("don't have enough permission to delete")
 
+// defaultEditHistoryLimit is the number of revisions kept for each edited
+// message when no limit has been set
+const defaultEditHistoryLimit = 20
+
 func (m *Messenger) EditMessage(ctx context.Context, request *requests.EditMessage) (*MessengerResponse, error) {
 	err := request.Validate()
 	if err != nil {
@@ -43,6 +47,11 @@ func (m *Messenger) EditMessage(ctx context.Context, request *requests.EditMessa
 		return nil, ErrChatNotFound
 	}
 
+	err = m.checkOutgoingMessage(chat, request.Text, request.ConfirmedFilterWarnings)
+	if err != nil {
+		return nil, err
+	}
+
 	messages, err := m.getConnectedMessages(message, message.LocalChatID)
 	if err != nil {
 		return nil, err
@@ -329,10 +338,64 @@ func (m *Messenger) DeleteMessageForMeAndSync(ctx context.Context, chatID string
 	return response, nil
 }
 
+// GetMessageEditHistory returns the previous versions of an edited message,
+// most recent first
+func (m *Messenger) GetMessageEditHistory(messageID string) ([]*MessageEditRevision, error) {
+	_, err := m.persistence.MessageByID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.persistence.MessageEditHistory(messageID)
+}
+
+// SetEditHistoryLimit sets the number of previous versions kept for each edited
+// message, older versions are removed right away. Zero resets it to the default.
+func (m *Messenger) SetEditHistoryLimit(limit uint64) error {
+	err := m.settings.SetEditHistoryLimit(limit)
+	if err != nil {
+		return err
+	}
+
+	return m.persistence.TrimMessageEditHistory(m.editHistoryLimit())
+}
+
+func (m *Messenger) editHistoryLimit() int {
+	limit, err := m.settings.EditHistoryLimit()
+	if err != nil {
+		m.logger.Error("failed to get edit history limit", zap.Error(err))
+	}
+	if limit == 0 {
+		return defaultEditHistoryLimit
+	}
+	return int(limit)
+}
+
+func (m *Messenger) saveMessageEditRevision(message *common.Message, replacedAt uint64) error {
+	clock := message.EditedAt
+	if clock == 0 {
+		clock = message.Clock
+	}
+
+	return m.persistence.SaveMessageEditRevision(&MessageEditRevision{
+		MessageID:   message.ID,
+		Clock:       clock,
+		ReplacedAt:  replacedAt,
+		Text:        message.Text,
+		ContentType: message.ContentType,
+	}, m.editHistoryLimit())
+}
+
 func (m *Messenger) applyEditMessage(editMessage *protobuf.EditMessage, message *common.Message) error {
 	if err := ValidateText(editMessage.Text); err != nil {
 		return err
 	}
+
+	// Keep the current version of the message in the edit history
+	if err := m.saveMessageEditRevision(message, editMessage.Clock); err != nil {
+		return err
+	}
+
 	message.Text = editMessage.Text
 	message.EditedAt = editMessage.Clock
 	if editMessage.ContentType != protobuf.ChatMessage_UNKNOWN_CONTENT_TYPE {
@@ -0,0 +1,219 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+// BM25 parameters used to rank full-text search results
+const (
+	searchRankK1 = 1.2
+	searchRankB  = 0.75
+)
+
+// maxSearchMatches is the number of matches ranked for a search, only the
+// most recent matches are ranked when there are more
+const maxSearchMatches = 1000
+
+var ErrInvalidSearchCursor = errors.New("invalid search cursor")
+
+// MessageSearchHighlight is the position of a matched term within the
+// text of a message, expressed in characters
+type MessageSearchHighlight struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+type MessageSearchResult struct {
+	Message    *common.Message          `json:"message"`
+	Rank       float64                  `json:"rank"`
+	Highlights []MessageSearchHighlight `json:"highlights"`
+}
+
+type MessagesSearchResponse struct {
+	Results []*MessageSearchResult `json:"results"`
+	Cursor  string                 `json:"cursor"`
+}
+
+type rankedSearchMatch struct {
+	match *messageSearchMatch
+	rank  float64
+}
+
+// SearchMessages looks up messages matching a query using the full-text index.
+// Results are ordered by relevance, most recent messages first on equal rank,
+// out of the maxSearchMatches most recent matches.
+// The returned cursor can be passed to fetch the next page of results.
+func (m *Messenger) SearchMessages(request *requests.SearchMessages) (*MessagesSearchResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	offset := 0
+	if request.Cursor != "" {
+		var err error
+		offset, err = strconv.Atoi(request.Cursor)
+		if err != nil || offset < 0 {
+			return nil, ErrInvalidSearchCursor
+		}
+	}
+
+	response := &MessagesSearchResponse{}
+
+	query := buildFullTextQuery(request.Query)
+	if query == "" {
+		return response, nil
+	}
+
+	matches, err := m.persistence.MessagesMatchingFullTextQuery(query, request.ChatIDs, maxSearchMatches)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := make([]*rankedSearchMatch, len(matches))
+	for i, match := range matches {
+		ranked[i] = &rankedSearchMatch{match: match, rank: searchMatchRank(match.MatchInfo)}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].rank != ranked[j].rank {
+			return ranked[i].rank > ranked[j].rank
+		}
+		return ranked[i].match.Clock > ranked[j].match.Clock
+	})
+
+	if offset >= len(ranked) {
+		return response, nil
+	}
+	end := offset + request.Limit
+	if end < len(ranked) {
+		response.Cursor = strconv.Itoa(end)
+	} else {
+		end = len(ranked)
+	}
+	ranked = ranked[offset:end]
+
+	ids := make([]string, len(ranked))
+	for i, r := range ranked {
+		ids[i] = r.match.ID
+	}
+	messages, err := m.persistence.MessagesByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	messagesByID := make(map[string]*common.Message, len(messages))
+	for _, message := range messages {
+		messagesByID[message.ID] = message
+	}
+
+	for _, r := range ranked {
+		message, ok := messagesByID[r.match.ID]
+		if !ok {
+			continue
+		}
+		if m.httpServer != nil {
+			m.prepareMessage(message, m.httpServer)
+		}
+		response.Results = append(response.Results, &MessageSearchResult{
+			Message:    message,
+			Rank:       r.rank,
+			Highlights: searchMatchHighlights(r.match.Text, r.match.Offsets),
+		})
+	}
+
+	return response, nil
+}
+
+// buildFullTextQuery turns user input into an FTS query where every
+// term has to be present, the last one being matched as a prefix
+func buildFullTextQuery(input string) string {
+	var terms []string
+	for _, term := range strings.Fields(input) {
+		term = strings.ReplaceAll(term, `"`, "")
+		if term != "" {
+			terms = append(terms, term)
+		}
+	}
+	if len(terms) == 0 {
+		return ""
+	}
+	terms[len(terms)-1] += "*"
+	for i, term := range terms {
+		terms[i] = `"` + term + `"`
+	}
+	return strings.Join(terms, " ")
+}
+
+// searchMatchRank computes the BM25 score of a match from the
+// `pcnalx` matchinfo blob returned by the FTS index
+func searchMatchRank(matchInfo []byte) float64 {
+	values := make([]uint32, len(matchInfo)/4)
+	for i := range values {
+		values[i] = binary.LittleEndian.Uint32(matchInfo[i*4:])
+	}
+	if len(values) < 3 {
+		return 0
+	}
+
+	phrases, columns, docs := int(values[0]), int(values[1]), float64(values[2])
+	if len(values) < 3+2*columns {
+		return 0
+	}
+	averages := values[3 : 3+columns]
+	lengths := values[3+columns : 3+2*columns]
+	hits := values[3+2*columns:]
+	if len(hits) < 3*phrases*columns {
+		return 0
+	}
+
+	var rank float64
+	for p := 0; p < phrases; p++ {
+		for c := 0; c < columns; c++ {
+			x := hits[3*(p*columns+c):]
+			tf, docsWithHits := float64(x[0]), float64(x[2])
+			if tf == 0 {
+				continue
+			}
+			idf := math.Log(1 + (docs-docsWithHits+0.5)/(docsWithHits+0.5))
+			norm := 1 - searchRankB
+			if averages[c] > 0 {
+				norm += searchRankB * float64(lengths[c]) / float64(averages[c])
+			}
+			rank += idf * tf * (searchRankK1 + 1) / (tf + searchRankK1*norm)
+		}
+	}
+	return rank
+}
+
+// searchMatchHighlights converts the byte offsets returned by the FTS
+// index into character offsets within the text
+func searchMatchHighlights(text string, offsets string) []MessageSearchHighlight {
+	fields := strings.Fields(offsets)
+	var highlights []MessageSearchHighlight
+	for i := 0; i+3 < len(fields); i += 4 {
+		start, err := strconv.Atoi(fields[i+2])
+		if err != nil {
+			continue
+		}
+		size, err := strconv.Atoi(fields[i+3])
+		if err != nil {
+			continue
+		}
+		if start < 0 || size < 0 || start+size > len(text) {
+			continue
+		}
+		highlights = append(highlights, MessageSearchHighlight{
+			Start:  utf8.RuneCountInString(text[:start]),
+			Length: utf8.RuneCountInString(text[start : start+size]),
+		})
+	}
+	return highlights
+}
@@ -0,0 +1,22 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildFullTextQuery(t *testing.T) {
+	require.Equal(t, `"hello" "wor*"`, buildFullTextQuery("  hello wor "))
+	require.Equal(t, `"quoted*"`, buildFullTextQuery(`"quoted"`))
+	require.Equal(t, "", buildFullTextQuery(`" "`))
+}
+
+func TestSearchMatchHighlights(t *testing.T) {
+	text := "héllo wörld"
+	// "wörld" starts at byte 7 and is 6 bytes long
+	highlights := searchMatchHighlights(text, "0 0 7 6")
+	require.Equal(t, []MessageSearchHighlight{{Start: 6, Length: 5}}, highlights)
+
+	require.Empty(t, searchMatchHighlights(text, "0 0 70 6"))
+}
@@ -11,6 +11,7 @@ import (
 	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/requests"
 	"github.com/status-im/status-go/protocol/tt"
 	"github.com/status-im/status-go/waku"
@@ -175,3 +176,93 @@ func (s *MessengerMuteSuite) TestSetMuteForDuration() {
 
 	s.Require().NoError(theirMessenger.Shutdown())
 }
+
+func (s *MessengerMuteSuite) TestSyncMute() {
+	alice2, err := newMessengerWithKey(s.shh, s.m.identity, s.logger, nil)
+	s.Require().NoError(err)
+	_, err = alice2.Start()
+	s.Require().NoError(err)
+	defer alice2.Shutdown() // nolint: errcheck
+
+	prepAliceMessengersForPairing(&s.Suite, s.m, alice2)
+	pairTwoDevices(&s.Suite, alice2, s.m)
+	pairTwoDevices(&s.Suite, s.m, alice2)
+
+	chat := CreatePublicChat(publicChatName, s.m.transport)
+	_, err = s.m.Join(chat)
+	s.Require().NoError(err)
+	_, err = alice2.Join(CreatePublicChat(publicChatName, alice2.transport))
+	s.Require().NoError(err)
+
+	mutedTill, err := s.m.MuteChat(&requests.MuteChat{ChatID: chat.ID, MutedType: MuteFor1Hr})
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		alice2,
+		func(r *MessengerResponse) bool {
+			synced, ok := alice2.allChats.Load(chat.ID)
+			return ok && synced.Muted
+		},
+		"mute not synced",
+	)
+	s.Require().NoError(err)
+
+	synced, _ := alice2.allChats.Load(chat.ID)
+	s.Require().Equal(mutedTill.Unix(), synced.MuteTill.Unix())
+
+	s.Require().NoError(s.m.UnmuteChat(chat.ID))
+
+	_, err = WaitOnMessengerResponse(
+		alice2,
+		func(r *MessengerResponse) bool {
+			synced, ok := alice2.allChats.Load(chat.ID)
+			return ok && !synced.Muted
+		},
+		"unmute not synced",
+	)
+	s.Require().NoError(err)
+}
+
+func (s *MessengerMuteSuite) TestCommunityMuteKeepsChatMutes() {
+	community, chat := createCommunity(&s.Suite, s.m)
+
+	community, err := s.m.communitiesManager.GetByID(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(community.ChatIDs(), 2)
+
+	var otherChatID string
+	for _, chatID := range community.ChatIDs() {
+		if chatID != chat.ID {
+			otherChatID = chatID
+		}
+	}
+
+	_, err = s.m.MuteChat(&requests.MuteChat{ChatID: chat.ID, MutedType: MuteTillUnmuted})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.m.SetMuted(&requests.MuteCommunity{CommunityID: community.ID(), MutedType: MuteFor1Hr}))
+	muted, _ := s.m.allChats.Load(chat.ID)
+	s.Require().True(muted.Muted)
+	s.Require().True(muted.MuteTill.IsZero())
+	other, _ := s.m.allChats.Load(otherChatID)
+	s.Require().True(other.Muted)
+
+	// Unmuting the community keeps the mute of the chat muted on its own
+	s.Require().NoError(s.m.SetMuted(&requests.MuteCommunity{CommunityID: community.ID(), MutedType: Unmuted}))
+	muted, _ = s.m.allChats.Load(chat.ID)
+	s.Require().True(muted.Muted)
+	other, _ = s.m.allChats.Load(otherChatID)
+	s.Require().False(other.Muted)
+}
+
+func (s *MessengerMuteSuite) TestSyncMuteOfUnknownChat() {
+	state := &ReceivedMessageState{Response: &MessengerResponse{}}
+
+	err := s.m.handleSyncMute(state, &protobuf.SyncMute{Clock: 1, ChatId: "unknown", Muted: true})
+	s.Require().NoError(err)
+
+	err = s.m.handleSyncMute(state, &protobuf.SyncMute{Clock: 1, CommunityId: "0x0102", Muted: true})
+	s.Require().NoError(err)
+	s.Require().Empty(state.Response.Communities())
+	s.Require().Empty(state.Response.Chats())
+}
@@ -0,0 +1,220 @@
+package protocol
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// recordMute stores the clock of the mute or unmute of a chat or a community
+// and syncs it to the paired devices
+func (m *Messenger) recordMute(ctx context.Context, chatID string, communityID string, muted bool, mutedTill time.Time) error {
+	clock, _ := m.getLastClockWithRelatedChat()
+	message := &protobuf.SyncMute{
+		Clock:       clock,
+		ChatId:      chatID,
+		CommunityId: communityID,
+		Muted:       muted,
+		MuteTill:    muteTillToProtobuf(mutedTill),
+	}
+
+	err := m.persistence.SaveMuteClock(muteID(message), clock, muted, mutedTill)
+	if err != nil {
+		return err
+	}
+	return m.syncMute(ctx, message, m.dispatchMessage)
+}
+
+func (m *Messenger) syncMute(ctx context.Context, message *protobuf.SyncMute, rawMessageHandler RawMessageHandler) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+
+	_, chat := m.getLastClockWithRelatedChat()
+	encodedMessage, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	_, err = rawMessageHandler(ctx, common.RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_MUTE,
+		ResendAutomatically: true,
+	})
+	return err
+}
+
+// syncMutes syncs the chats and communities currently muted, the ones muted
+// before their clock was recorded are synced with the current clock
+func (m *Messenger) syncMutes(ctx context.Context, rawMessageHandler RawMessageHandler) error {
+	var messages []*protobuf.SyncMute
+	m.allChats.Range(func(chatID string, chat *Chat) bool {
+		if chat.Muted {
+			messages = append(messages, &protobuf.SyncMute{ChatId: chat.ID, Muted: true, MuteTill: muteTillToProtobuf(chat.MuteTill)})
+		}
+		return true
+	})
+
+	joined, err := m.communitiesManager.Joined()
+	if err != nil {
+		return err
+	}
+	for _, community := range joined {
+		if community.Muted() {
+			messages = append(messages, &protobuf.SyncMute{CommunityId: community.IDString(), Muted: true, MuteTill: muteTillToProtobuf(community.MuteTill())})
+		}
+	}
+
+	for _, message := range messages {
+		clock, err := m.persistence.MuteClock(muteID(message))
+		if err != nil {
+			return err
+		}
+		if clock == 0 {
+			clock, _ = m.getLastClockWithRelatedChat()
+		}
+		message.Clock = clock
+
+		err = m.syncMute(ctx, message, rawMessageHandler)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Messenger) handleSyncMute(state *ReceivedMessageState, message *protobuf.SyncMute) error {
+	id := muteID(message)
+	clock, err := m.persistence.MuteClock(id)
+	if err != nil {
+		return err
+	}
+	if clock >= message.Clock {
+		return nil
+	}
+
+	muted := message.Muted
+	mutedTill := muteTillFromProtobuf(message.MuteTill)
+	// The mute might have expired while the message was on its way
+	if muted && !mutedTill.IsZero() && !time.Now().Before(mutedTill) {
+		muted = false
+		mutedTill = time.Time{}
+	}
+
+	if message.CommunityId != "" {
+		communityID := types.FromHex(message.CommunityId)
+		community, err := m.communitiesManager.GetByID(communityID)
+		if err != nil {
+			return err
+		}
+		if community == nil {
+			m.logger.Warn("skipping the mute of an unknown community", zap.String("communityID", message.CommunityId))
+			return nil
+		}
+
+		community, err = m.setCommunityMuted(communityID, muted, mutedTill)
+		if err != nil {
+			return err
+		}
+		state.Response.AddCommunity(community)
+	} else {
+		chat, ok := m.allChats.Load(message.ChatId)
+		if !ok {
+			m.logger.Warn("skipping the mute of an unknown chat", zap.String("chatID", message.ChatId))
+			return nil
+		}
+
+		// The contact has been synced by the device it was muted on
+		if muted {
+			_, err = m.muteChat(chat, nil, mutedTill)
+		} else {
+			err = m.unmuteChat(chat, nil)
+		}
+		if err != nil {
+			return err
+		}
+		state.Response.AddChat(chat)
+	}
+
+	return m.persistence.SaveMuteClock(id, message.Clock, muted, mutedTill)
+}
+
+// chatMutedIndependently tells whether the chat was muted on its own, rather
+// than along with its community, and its mute hasn't expired
+func (m *Messenger) chatMutedIndependently(chatID string) (bool, error) {
+	muted, mutedTill, err := m.persistence.MuteState(chatID)
+	if err != nil {
+		return false, err
+	}
+	return muted && (mutedTill.IsZero() || time.Now().Before(mutedTill)), nil
+}
+
+// setCommunityMuted mutes or unmutes a community along with its chats, so that
+// the notifications of its messages follow the mute of the community. The
+// chats muted on their own keep their mute
+func (m *Messenger) setCommunityMuted(communityID types.HexBytes, muted bool, mutedTill time.Time) (*communities.Community, error) {
+	err := m.communitiesManager.SetMuted(communityID, muted, mutedTill)
+	if err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, communities.ErrOrgNotFound
+	}
+
+	for _, chatID := range community.ChatIDs() {
+		chat, ok := m.allChats.Load(chatID)
+		if !ok {
+			continue
+		}
+		independent, err := m.chatMutedIndependently(chatID)
+		if err != nil {
+			return nil, err
+		}
+		if independent {
+			continue
+		}
+		if muted {
+			_, err = m.muteChat(chat, nil, mutedTill)
+		} else {
+			err = m.unmuteChat(chat, nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return community, nil
+}
+
+func muteID(message *protobuf.SyncMute) string {
+	if message.CommunityId != "" {
+		return message.CommunityId
+	}
+	return message.ChatId
+}
+
+func muteTillToProtobuf(mutedTill time.Time) int64 {
+	if mutedTill.IsZero() {
+		return 0
+	}
+	return mutedTill.Unix()
+}
+
+func muteTillFromProtobuf(muteTill int64) time.Time {
+	if muteTill == 0 {
+		return time.Time{}
+	}
+	return time.Unix(muteTill, 0)
+}
@@ -0,0 +1,39 @@
+package protocol
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/common"
+)
+
+// SetNotificationKeywords replaces the keywords producing activity center
+// notifications for the messages of the joined communities, even in muted
+// chats. They're synced to the paired devices
+func (m *Messenger) SetNotificationKeywords(keywords []string) ([]string, error) {
+	keywords = normalizeNotificationKeywords(keywords)
+	err := m.settings.SetNotificationKeywords(keywords)
+	if err != nil {
+		return nil, err
+	}
+	m.notificationKeywords.reset()
+	return keywords, nil
+}
+
+func (m *Messenger) NotificationKeywords() ([]string, error) {
+	return m.settings.NotificationKeywords()
+}
+
+// matchNotificationKeywords tells whether a message received in a community
+// chat contains a keyword the user subscribed to
+func (m *Messenger) matchNotificationKeywords(message *common.Message, chat *Chat) bool {
+	if !chat.Active || !chat.CommunityChat() || message.From == m.myHexIdentity() || message.Text == "" {
+		return false
+	}
+
+	matched, err := m.notificationKeywords.match(message.Text, m.settings.NotificationKeywords)
+	if err != nil {
+		m.logger.Error("failed to match notification keywords", zap.Error(err))
+		return false
+	}
+	return matched
+}
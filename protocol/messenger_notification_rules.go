@@ -0,0 +1,132 @@
+package protocol
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// SaveNotificationRule creates a rule, or updates it when its ID is set, and
+// syncs it to the paired devices
+func (m *Messenger) SaveNotificationRule(ctx context.Context, rule *NotificationRule) (*NotificationRule, error) {
+	err := rule.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if rule.ID == "" {
+		rule.ID = uuid.New().String()
+	} else {
+		existing, err := m.persistence.NotificationRule(rule.ID)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil || existing.Deleted {
+			return nil, ErrNotificationRuleNotFound
+		}
+	}
+
+	clock, _ := m.getLastClockWithRelatedChat()
+	rule.Clock = clock
+	rule.Deleted = false
+
+	err = m.persistence.SaveNotificationRule(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.syncNotificationRule(ctx, rule, m.dispatchMessage)
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (m *Messenger) NotificationRules() ([]*NotificationRule, error) {
+	return m.persistence.NotificationRules()
+}
+
+// DeleteNotificationRule deletes a rule and syncs the deletion to the paired
+// devices
+func (m *Messenger) DeleteNotificationRule(ctx context.Context, id string) error {
+	rule, err := m.persistence.NotificationRule(id)
+	if err != nil {
+		return err
+	}
+	if rule == nil || rule.Deleted {
+		return ErrNotificationRuleNotFound
+	}
+
+	clock, _ := m.getLastClockWithRelatedChat()
+	rule.Clock = clock
+	rule.Deleted = true
+
+	err = m.persistence.SaveNotificationRule(rule)
+	if err != nil {
+		return err
+	}
+	return m.syncNotificationRule(ctx, rule, m.dispatchMessage)
+}
+
+func (m *Messenger) syncNotificationRule(ctx context.Context, rule *NotificationRule, rawMessageHandler RawMessageHandler) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+
+	_, chat := m.getLastClockWithRelatedChat()
+	encodedMessage, err := proto.Marshal(rule.ToSyncProtobuf())
+	if err != nil {
+		return err
+	}
+
+	_, err = rawMessageHandler(ctx, common.RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_NOTIFICATION_RULE,
+		ResendAutomatically: true,
+	})
+	return err
+}
+
+func (m *Messenger) syncNotificationRules(ctx context.Context, rawMessageHandler RawMessageHandler) error {
+	rules, err := m.persistence.NotificationRules()
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		err = m.syncNotificationRule(ctx, rule, rawMessageHandler)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Messenger) handleSyncNotificationRule(state *ReceivedMessageState, message *protobuf.SyncNotificationRule) error {
+	rule := notificationRuleFromSyncProtobuf(message)
+	err := rule.Validate()
+	if err != nil {
+		return err
+	}
+
+	existing, err := m.persistence.NotificationRule(rule.ID)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.Clock >= rule.Clock {
+		return nil
+	}
+
+	err = m.persistence.SaveNotificationRule(rule)
+	if err != nil {
+		return err
+	}
+
+	state.Response.NotificationRules = append(state.Response.NotificationRules, rule)
+	return nil
+}
@@ -0,0 +1,207 @@
+package protocol
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+type OutboxMessageStatus string
+
+const (
+	// OutboxMessageQueued messages are waiting to be sent, because the
+	// previous attempt failed or the messenger was offline
+	OutboxMessageQueued OutboxMessageStatus = "queued"
+	// OutboxMessageSending messages were sent and are waiting for the
+	// envelope to be confirmed, they are resent if it isn't
+	OutboxMessageSending OutboxMessageStatus = "sending"
+	OutboxMessageSent    OutboxMessageStatus = "sent"
+	// OutboxMessageFailed messages couldn't be sent in the maximum number of
+	// attempts and aren't resent anymore
+	OutboxMessageFailed OutboxMessageStatus = "failed"
+)
+
+// outboxSentRetention is how long sent messages are kept in the outbox
+const outboxSentRetention = 24 * time.Hour
+
+// OutboxMessage tracks the delivery of a message which is resent automatically
+type OutboxMessage struct {
+	ID          string              `json:"id"`
+	LocalChatID string              `json:"localChatId"`
+	Status      OutboxMessageStatus `json:"status"`
+	Attempts    int                 `json:"attempts"`
+	// NextAttempt is the timestamp in ms of the next attempt to send the message
+	NextAttempt uint64 `json:"nextAttempt"`
+	LastError   string `json:"lastError,omitempty"`
+	UpdatedAt   uint64 `json:"updatedAt"`
+}
+
+// OutboxRetryPolicy is the schedule of the attempts to send a message, the
+// delay between attempts doubles after each of them
+type OutboxRetryPolicy struct {
+	MinDelay    time.Duration
+	MaxDelay    time.Duration
+	MaxAttempts int
+}
+
+var defaultOutboxRetryPolicy = OutboxRetryPolicy{
+	MinDelay:    messageResendMinDelay * time.Second,
+	MaxDelay:    10 * time.Minute,
+	MaxAttempts: messageResendMaxCount + 1,
+}
+
+// Delay returns how long to wait after the given number of attempts before
+// sending the message again
+func (p OutboxRetryPolicy) Delay(attempts int) time.Duration {
+	if attempts < 1 {
+		return 0
+	}
+
+	delay := p.MaxDelay
+	if attempts < 16 && p.MinDelay<<(attempts-1) < p.MaxDelay {
+		delay = p.MinDelay << (attempts - 1)
+	}
+	return delay
+}
+
+func (m *Messenger) outboxRetryPolicy() OutboxRetryPolicy {
+	if m.config.outboxRetryPolicy != nil {
+		return *m.config.outboxRetryPolicy
+	}
+	return defaultOutboxRetryPolicy
+}
+
+// shouldQueueInOutbox returns whether a message is resent until it's
+// confirmed, only chat messages and emoji reactions of public and community
+// chats are
+func shouldQueueInOutbox(chat *Chat, message *common.RawMessage) bool {
+	if message.Sent {
+		return false
+	}
+
+	if message.MessageType != protobuf.ApplicationMetadataMessage_CHAT_MESSAGE &&
+		message.MessageType != protobuf.ApplicationMetadataMessage_EMOJI_REACTION {
+		return false
+	}
+
+	return chat.Public() || chat.CommunityChat()
+}
+
+// queueInOutbox adds a message which was just dispatched to the outbox, unless
+// it's already there
+func (m *Messenger) queueInOutbox(message *common.RawMessage) error {
+	_, err := m.persistence.OutboxMessageByID(message.ID)
+	if err == nil {
+		return nil
+	}
+	if err != sql.ErrNoRows {
+		return err
+	}
+
+	now := m.getTimesource().GetCurrentTime()
+	outboxMessage := &OutboxMessage{
+		ID:          message.ID,
+		LocalChatID: message.LocalChatID,
+		Status:      OutboxMessageSending,
+		Attempts:    message.SendCount,
+		NextAttempt: message.LastSent + uint64(m.outboxRetryPolicy().Delay(message.SendCount).Milliseconds()),
+		UpdatedAt:   now,
+	}
+
+	// The envelope won't make it while offline, send it again as soon as
+	// we are back online
+	if m.connectionState.Offline {
+		outboxMessage.Status = OutboxMessageQueued
+		outboxMessage.NextAttempt = now
+	}
+
+	return m.persistence.SaveOutboxMessage(outboxMessage)
+}
+
+func (m *Messenger) processOutboxMessage(message *OutboxMessage, now uint64) error {
+	rawMessage, err := m.persistence.RawMessageByID(message.ID)
+	if err != nil {
+		return errors.Wrapf(err, "Can't get raw message with id %v", message.ID)
+	}
+
+	if rawMessage.Sent {
+		return m.persistence.MarkOutboxMessageSent(message.ID, now)
+	}
+
+	policy := m.outboxRetryPolicy()
+	message.UpdatedAt = now
+	if message.Attempts >= policy.MaxAttempts {
+		message.Status = OutboxMessageFailed
+		return m.persistence.SaveOutboxMessage(message)
+	}
+
+	message.Attempts++
+	message.Status = OutboxMessageSending
+	message.NextAttempt = now + uint64(policy.Delay(message.Attempts).Milliseconds())
+	message.LastError = ""
+
+	err = m.reSendRawMessage(context.Background(), message.ID)
+	if err != nil {
+		message.Status = OutboxMessageQueued
+		message.LastError = err.Error()
+	}
+
+	return m.persistence.SaveOutboxMessage(message)
+}
+
+// resendExpiredMessages sends again the messages of the outbox which weren't
+// confirmed in time
+func (m *Messenger) resendExpiredMessages() error {
+	if m.connectionState.Offline {
+		return errors.New("offline")
+	}
+
+	now := m.getTimesource().GetCurrentTime()
+	err := m.persistence.DeleteSentOutboxMessagesBefore(now - uint64(outboxSentRetention.Milliseconds()))
+	if err != nil {
+		return err
+	}
+
+	messages, err := m.persistence.DueOutboxMessages(now)
+	if err != nil {
+		return errors.Wrapf(err, "Can't get outbox messages from db")
+	}
+
+	for _, message := range messages {
+		err = m.processOutboxMessage(message, now)
+		if err != nil {
+			m.logger.Warn("failed to process outbox message", zap.String("id", message.ID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// OutboxMessages returns the messages which are resent automatically, and the
+// ones sent recently
+func (m *Messenger) OutboxMessages() ([]*OutboxMessage, error) {
+	return m.persistence.OutboxMessages()
+}
+
+// CancelOutboxMessage stops sending a message again
+func (m *Messenger) CancelOutboxMessage(id string) error {
+	message, err := m.persistence.OutboxMessageByID(id)
+	if err == sql.ErrNoRows {
+		return ErrOutboxMessageNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if message.Status == OutboxMessageSent {
+		return ErrOutboxMessageAlreadySent
+	}
+
+	return m.persistence.DeleteOutboxMessage(id)
+}
@@ -0,0 +1,93 @@
+package protocol
+
+import (
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SaveOutgoingMessageFilter creates a filter, or updates it when its ID is set
+func (m *Messenger) SaveOutgoingMessageFilter(filter *OutgoingMessageFilter) (*OutgoingMessageFilter, error) {
+	err := filter.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.ID == "" {
+		filter.ID = uuid.New().String()
+	} else {
+		existing, err := m.persistence.OutgoingMessageFilter(filter.ID)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			return nil, ErrOutgoingMessageFilterNotFound
+		}
+	}
+
+	err = m.persistence.SaveOutgoingMessageFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func (m *Messenger) DeleteOutgoingMessageFilter(id string) error {
+	filter, err := m.persistence.OutgoingMessageFilter(id)
+	if err != nil {
+		return err
+	}
+	if filter == nil {
+		return ErrOutgoingMessageFilterNotFound
+	}
+	return m.persistence.DeleteOutgoingMessageFilter(id)
+}
+
+func (m *Messenger) OutgoingMessageFilters() ([]*OutgoingMessageFilter, error) {
+	return m.persistence.OutgoingMessageFilters()
+}
+
+// SetOutgoingSecretsFilterEnabled enables the built-in filters warning about
+// the messages looking like they contain a seed phrase or a private key, it's
+// disabled by default
+func (m *Messenger) SetOutgoingSecretsFilterEnabled(enabled bool) error {
+	return m.settings.SetOutgoingSecretsFilterEnabled(enabled)
+}
+
+// CheckOutgoingMessage returns the filters the text matches when sent in the
+// chat, so that the user can be warned before sending it
+func (m *Messenger) CheckOutgoingMessage(chatID string, text string) ([]*OutgoingMessageFilterMatch, error) {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+	return m.outgoingMessageFilterMatches(chat, text)
+}
+
+func (m *Messenger) outgoingMessageFilterMatches(chat *Chat, text string) ([]*OutgoingMessageFilterMatch, error) {
+	filters, err := m.persistence.OutgoingMessageFilters()
+	if err != nil {
+		return nil, err
+	}
+
+	secretsFilterEnabled, err := m.settings.OutgoingSecretsFilterEnabled()
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluateOutgoingMessageFilters(filters, secretsFilterEnabled, chat.CommunityID, text), nil
+}
+
+// checkOutgoingMessage fails when the text can't be sent in the chat, either
+// because a filter blocks it or because the user hasn't confirmed a warning
+func (m *Messenger) checkOutgoingMessage(chat *Chat, text string, confirmed bool) error {
+	matches, err := m.outgoingMessageFilterMatches(chat, text)
+	if err != nil {
+		return err
+	}
+
+	err = outgoingMessageFiltersError(matches, confirmed)
+	if err != nil {
+		m.logger.Debug("outgoing message filtered", zap.String("chatID", chat.ID), zap.Error(err))
+	}
+	return err
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
 )
 
 func TestMessengerPinMessageSuite(t *testing.T) {
@@ -186,3 +187,106 @@ func (s *MessengerPinMessageSuite) TestPinMessageOutOfOrder() {
 
 	s.Require().Len(handlePinMessageResponse.PinMessages(), 0)
 }
+
+func (s *MessengerPinMessageSuite) TestReorderPinnedMessages() {
+	theirMessenger := s.newMessenger()
+	_, err := theirMessenger.Start()
+	s.Require().NoError(err)
+	defer theirMessenger.Shutdown() // nolint: errcheck
+
+	theirChat := CreateOneToOneChat("Their 1TO1", &s.privateKey.PublicKey, s.m.transport)
+	err = theirMessenger.SaveChat(theirChat)
+	s.Require().NoError(err)
+
+	ourChat := CreateOneToOneChat("Our 1TO1", &theirMessenger.identity.PublicKey, s.m.transport)
+	err = s.m.SaveChat(ourChat)
+	s.Require().NoError(err)
+
+	var messageIDs []string
+	for i := 0; i < 3; i++ {
+		inputMessage := buildTestMessage(*theirChat)
+		sendResponse, err := theirMessenger.SendChatMessage(context.Background(), inputMessage)
+		s.Require().NoError(err)
+		s.Require().Len(sendResponse.Messages(), 1)
+		messageIDs = append(messageIDs, inputMessage.ID)
+
+		pinMessage := &common.PinMessage{LocalChatID: theirChat.ID}
+		pinMessage.MessageId = inputMessage.ID
+		pinMessage.Pinned = true
+		pinMessage.ChatId = theirChat.ID
+		_, err = theirMessenger.SendPinMessage(context.Background(), pinMessage)
+		s.Require().NoError(err)
+	}
+
+	_, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool {
+			pinned, _, err := s.m.PinnedMessageByChatID(ourChat.ID, "", -1)
+			return err == nil && len(pinned) == 3
+		},
+		"pin messages not received",
+	)
+	s.Require().NoError(err)
+
+	// Most recently pinned messages come first
+	pinned, _, err := s.m.PinnedMessageByChatID(ourChat.ID, "", -1)
+	s.Require().NoError(err)
+	s.Require().Len(pinned, 3)
+	s.Require().Equal(messageIDs[2], pinned[0].Message.ID)
+	s.Require().Equal(messageIDs[1], pinned[1].Message.ID)
+	s.Require().Equal(messageIDs[0], pinned[2].Message.ID)
+
+	order := []string{messageIDs[1], messageIDs[0], messageIDs[2]}
+	response, err := theirMessenger.ReorderPinnedMessages(context.Background(), &requests.ReorderPinnedMessages{
+		ChatID:     theirChat.ID,
+		MessageIDs: order,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.PinMessages(), 3)
+
+	pinned, _, err = theirMessenger.PinnedMessageByChatID(theirChat.ID, "", -1)
+	s.Require().NoError(err)
+	s.Require().Len(pinned, 3)
+	for i, id := range order {
+		s.Require().Equal(id, pinned[i].Message.ID)
+	}
+
+	response, err = WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.PinMessages()) == 3 },
+		"reordered pin messages not received",
+	)
+	s.Require().NoError(err)
+	// Reordering doesn't notify about pinned messages again
+	s.Require().Len(response.Messages(), 0)
+
+	pinned, _, err = s.m.PinnedMessageByChatID(ourChat.ID, "", -1)
+	s.Require().NoError(err)
+	s.Require().Len(pinned, 3)
+	for i, id := range order {
+		s.Require().Equal(id, pinned[i].Message.ID)
+	}
+
+	// A message pinned after reordering is displayed first
+	inputMessage := buildTestMessage(*theirChat)
+	_, err = theirMessenger.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+	pinMessage := &common.PinMessage{LocalChatID: theirChat.ID}
+	pinMessage.MessageId = inputMessage.ID
+	pinMessage.Pinned = true
+	pinMessage.ChatId = theirChat.ID
+	_, err = theirMessenger.SendPinMessage(context.Background(), pinMessage)
+	s.Require().NoError(err)
+
+	pinned, _, err = theirMessenger.PinnedMessageByChatID(theirChat.ID, "", -1)
+	s.Require().NoError(err)
+	s.Require().Len(pinned, 4)
+	s.Require().Equal(inputMessage.ID, pinned[0].Message.ID)
+
+	// Only pinned messages can be reordered
+	_, err = theirMessenger.ReorderPinnedMessages(context.Background(), &requests.ReorderPinnedMessages{
+		ChatID:     theirChat.ID,
+		MessageIDs: []string{"not-pinned"},
+	})
+	s.Require().ErrorIs(err, ErrPinnedMessageNotFound)
+}
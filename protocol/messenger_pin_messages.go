@@ -13,8 +13,11 @@ import (
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
 )
 
+var ErrInvalidPinPermission = errors.New("member can't pin message")
+
 // SendPinMessage sends the PinMessage to the corresponding chat
 func (m *Messenger) SendPinMessage(ctx context.Context, message *common.PinMessage) (*MessengerResponse, error) {
 	m.mutex.Lock()
@@ -31,20 +34,12 @@ func (m *Messenger) sendPinMessage(ctx context.Context, message *common.PinMessa
 		return nil, errors.New("chat not found")
 	}
 
-	if chat.CommunityChat() {
-		community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
-		if err != nil {
-			return nil, err
-		}
-		isMemberOwnerOrAdmin := community.IsMemberOwnerOrAdmin(&m.identity.PublicKey)
-		pinMessageAllowed := community.AllowsAllMembersToPinMessage()
-
-		if !pinMessageAllowed && !isMemberOwnerOrAdmin {
-			return nil, errors.New("member can't pin message")
-		}
+	err := m.checkCanPinMessages(chat)
+	if err != nil {
+		return nil, err
 	}
 
-	err := m.handleStandaloneChatIdentity(chat)
+	err = m.handleStandaloneChatIdentity(chat)
 	if err != nil {
 		return nil, err
 	}
@@ -54,6 +49,10 @@ func (m *Messenger) sendPinMessage(ctx context.Context, message *common.PinMessa
 		return nil, err
 	}
 
+	if message.PinIndex == 0 {
+		message.PinIndex = message.Clock
+	}
+
 	message.ID, err = generatePinMessageID(&m.identity.PublicKey, message, chat)
 	if err != nil {
 		return nil, err
@@ -121,6 +120,121 @@ func (m *Messenger) sendPinMessage(ctx context.Context, message *common.PinMessa
 	return &response, m.saveChat(chat)
 }
 
+// ReorderPinnedMessages changes the order in which the pinned messages of a chat
+// are displayed. Each message is pinned again with a new pin index, so that
+// the order is synced to our devices and to the other members of the chat.
+func (m *Messenger) ReorderPinnedMessages(ctx context.Context, request *requests.ReorderPinnedMessages) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	chat, ok := m.allChats.Load(request.ChatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+
+	err := m.checkCanPinMessages(chat)
+	if err != nil {
+		return nil, err
+	}
+
+	pinnedIDs, err := m.persistence.PinnedMessageIDsByChatID(chat.ID)
+	if err != nil {
+		return nil, err
+	}
+	pinned := make(map[string]bool, len(pinnedIDs))
+	for _, id := range pinnedIDs {
+		pinned[id] = true
+	}
+	for _, id := range request.MessageIDs {
+		if !pinned[id] {
+			return nil, ErrPinnedMessageNotFound
+		}
+	}
+
+	err = m.handleStandaloneChatIdentity(chat)
+	if err != nil {
+		return nil, err
+	}
+
+	var pinMessages []*common.PinMessage
+	var pinIndex uint64
+	for i, messageID := range request.MessageIDs {
+		message := &common.PinMessage{}
+		message.MessageId = messageID
+		message.ChatId = chat.ID
+		message.Pinned = true
+
+		err = extendPinMessageFromChat(message, chat, &m.identity.PublicKey, m.getTimesource())
+		if err != nil {
+			return nil, err
+		}
+
+		// Indexes are based on the clock of the first message, so that
+		// messages pinned later are still displayed first
+		if i == 0 {
+			pinIndex = message.Clock + uint64(len(request.MessageIDs)-1)
+		}
+		message.PinIndex = pinIndex - uint64(i)
+
+		message.ID, err = generatePinMessageID(&m.identity.PublicKey, message, chat)
+		if err != nil {
+			return nil, err
+		}
+
+		encodedMessage, err := m.encodeChatEntity(chat, message)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = m.dispatchMessage(ctx, common.RawMessage{
+			LocalChatID:          chat.ID,
+			Payload:              encodedMessage,
+			MessageType:          protobuf.ApplicationMetadataMessage_PIN_MESSAGE,
+			SkipGroupMessageWrap: true,
+			ResendAutomatically:  true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		pinMessages = append(pinMessages, message)
+	}
+
+	err = m.persistence.SavePinMessages(pinMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	for _, message := range pinMessages {
+		response.AddPinMessage(message)
+	}
+	response.AddChat(chat)
+	return response, m.saveChat(chat)
+}
+
+func (m *Messenger) checkCanPinMessages(chat *Chat) error {
+	if !chat.CommunityChat() {
+		return nil
+	}
+
+	community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
+	if err != nil {
+		return err
+	}
+	canPinMessages := community.HasMemberPermission(&m.identity.PublicKey, protobuf.CommunityMember_PERMISSION_PIN_MESSAGES)
+	pinMessageAllowed := community.AllowsAllMembersToPinMessage()
+
+	if !pinMessageAllowed && !canPinMessages {
+		return ErrInvalidPinPermission
+	}
+	return nil
+}
+
 func (m *Messenger) PinnedMessageByChatID(chatID, cursor string, limit int) ([]*common.PinnedMessage, string, error) {
 	pinnedMsgs, cursor, err := m.persistence.PinnedMessageByChatID(chatID, cursor, limit)
 
@@ -0,0 +1,50 @@
+package protocol
+
+import (
+	"github.com/status-im/status-go/multiaccounts/settings"
+)
+
+// PrivacySettings is the effective state of the features which leak activity
+// to third parties or to the other participants of a chat
+type PrivacySettings struct {
+	// PrivacyMode disables all of the features below at once
+	PrivacyMode bool `json:"privacyMode"`
+	// LinkPreviews is whether URLs can be fetched to unfurl links
+	LinkPreviews bool `json:"linkPreviews"`
+	// TypingIndicators is whether typing notifications can be sent
+	TypingIndicators bool `json:"typingIndicators"`
+	// ReadReceipts is whether read receipts can be sent
+	ReadReceipts bool `json:"readReceipts"`
+}
+
+// PrivacySettings returns which features are enabled, taking the global
+// privacy mode into account. It must be consulted before sending typing
+// notifications or read receipts and before unfurling links, so that every
+// client of the account behaves the same
+func (m *Messenger) PrivacySettings() (*PrivacySettings, error) {
+	privacyMode, err := m.settings.PrivacyMode()
+	if err != nil {
+		return nil, err
+	}
+	linkPreviewsMode, err := m.settings.LinkPreviewsMode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivacySettings{
+		PrivacyMode:      privacyMode,
+		LinkPreviews:     !privacyMode && linkPreviewsMode != settings.LinkPreviewsModePrivacy,
+		TypingIndicators: !privacyMode,
+		ReadReceipts:     !privacyMode,
+	}, nil
+}
+
+// SetPrivacyMode toggles the global privacy mode, it's synced to the paired
+// devices
+func (m *Messenger) SetPrivacyMode(enabled bool) (*PrivacySettings, error) {
+	err := m.settings.SetPrivacyMode(enabled)
+	if err != nil {
+		return nil, err
+	}
+	return m.PrivacySettings()
+}
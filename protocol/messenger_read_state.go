@@ -0,0 +1,183 @@
+package protocol
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// readStateSyncInterval is how long the messages seen are batched before
+// being synced, so that scrolling through a chat sends a single message
+const readStateSyncInterval = 2 * time.Second
+
+// readStateQueue collects the messages seen on this device until they're
+// synced to the paired devices
+type readStateQueue struct {
+	sync.Mutex
+	chats map[string]*protobuf.ChatReadState
+}
+
+func (q *readStateQueue) add(chatID string, clock uint64, messageIDs []string) {
+	q.Lock()
+	defer q.Unlock()
+
+	if q.chats == nil {
+		q.chats = make(map[string]*protobuf.ChatReadState)
+	}
+
+	state, ok := q.chats[chatID]
+	if !ok {
+		state = &protobuf.ChatReadState{ChatId: chatID}
+		q.chats[chatID] = state
+	}
+	if clock > state.Clock {
+		state.Clock = clock
+	}
+	state.MessageIds = append(state.MessageIds, messageIDs...)
+}
+
+// flush empties the queue and returns its read states ordered by chat
+func (q *readStateQueue) flush() []*protobuf.ChatReadState {
+	q.Lock()
+	defer q.Unlock()
+
+	states := make([]*protobuf.ChatReadState, 0, len(q.chats))
+	for _, state := range q.chats {
+		states = append(states, state)
+	}
+	q.chats = nil
+
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].ChatId < states[j].ChatId
+	})
+	return states
+}
+
+// pending returns a copy of the queued read states ordered by chat, they stay
+// queued until they're removed once synced
+func (q *readStateQueue) pending() []*protobuf.ChatReadState {
+	q.Lock()
+	defer q.Unlock()
+
+	states := make([]*protobuf.ChatReadState, 0, len(q.chats))
+	for _, state := range q.chats {
+		states = append(states, &protobuf.ChatReadState{
+			ChatId:     state.ChatId,
+			Clock:      state.Clock,
+			MessageIds: append([]string(nil), state.MessageIds...),
+		})
+	}
+
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].ChatId < states[j].ChatId
+	})
+	return states
+}
+
+// remove drops the read states which were synced, the messages seen in the
+// meantime stay queued
+func (q *readStateQueue) remove(synced []*protobuf.ChatReadState) {
+	q.Lock()
+	defer q.Unlock()
+
+	for _, syncedState := range synced {
+		state, ok := q.chats[syncedState.ChatId]
+		if !ok {
+			continue
+		}
+		// The message IDs are only appended, the synced ones come first
+		state.MessageIds = state.MessageIds[len(syncedState.MessageIds):]
+		if len(state.MessageIds) == 0 && state.Clock <= syncedState.Clock {
+			delete(q.chats, syncedState.ChatId)
+		}
+	}
+}
+
+func (m *Messenger) watchReadStateQueue() {
+	m.logger.Debug("watching read state queue")
+	go func() {
+		ticker := time.NewTicker(readStateSyncInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				err := m.syncChatReadStates(m.ctx)
+				if err != nil {
+					m.logger.Error("failed to sync chat read states", zap.Error(err))
+				}
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// syncChatReadStates sends the queued read states in a single message, they
+// stay queued until it's dispatched so that they're sent on the next tick
+// otherwise
+func (m *Messenger) syncChatReadStates(ctx context.Context) error {
+	if !m.hasPairedDevices() {
+		m.readStateQueue.flush()
+		return nil
+	}
+
+	states := m.readStateQueue.pending()
+	if len(states) == 0 {
+		return nil
+	}
+
+	encodedMessage, err := proto.Marshal(&protobuf.SyncChatReadState{Chats: states})
+	if err != nil {
+		return err
+	}
+
+	_, chat := m.getLastClockWithRelatedChat()
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_CHAT_READ_STATE,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	m.readStateQueue.remove(states)
+	return nil
+}
+
+func (m *Messenger) handleSyncChatReadState(state *ReceivedMessageState, message *protobuf.SyncChatReadState) error {
+	for _, readState := range message.Chats {
+		chat, ok := m.allChats.Load(readState.ChatId)
+		if !ok {
+			m.logger.Debug("read state of unknown chat", zap.String("chatID", readState.ChatId))
+			continue
+		}
+
+		// The messages were already marked read with the whole chat
+		if chat.ReadMessagesAtClockValue >= readState.Clock || len(readState.MessageIds) == 0 {
+			continue
+		}
+
+		_, _, err := m.persistence.MarkMessagesSeen(readState.ChatId, readState.MessageIds)
+		if err != nil {
+			return err
+		}
+
+		chat, err = m.persistence.Chat(readState.ChatId)
+		if err != nil {
+			return err
+		}
+		m.allChats.Store(chat.ID, chat)
+		state.Response.AddChat(chat)
+	}
+	return nil
+}
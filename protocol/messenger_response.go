@@ -7,6 +7,7 @@ import (
 
 	"github.com/status-im/status-go/services/browsers"
 	"github.com/status-im/status-go/services/wallet"
+	"github.com/status-im/status-go/services/wallet/token"
 
 	"github.com/status-im/status-go/appmetrics"
 	"github.com/status-im/status-go/images"
@@ -18,6 +19,7 @@ import (
 	"github.com/status-im/status-go/protocol/encryption/multidevice"
 	"github.com/status-im/status-go/protocol/identity"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/socialrecovery"
 	"github.com/status-im/status-go/protocol/verification"
 	localnotifications "github.com/status-im/status-go/services/local-notifications"
 	"github.com/status-im/status-go/services/mailservers"
@@ -47,6 +49,17 @@ type MessengerResponse struct {
 	IdentityImages                []images.IdentityImage
 	WatchOnlyAccounts             []*accounts.Account
 	Keypairs                      []*accounts.Keypair
+	AccountsPositions             []*accounts.Account
+	CustomTokens                  []*token.CustomToken
+	SafeTransactions              []*SafeTransactionStatus
+	SocialRecoveryTrustees        []*socialrecovery.Trustee
+	SocialRecoveryShares          []*socialrecovery.Share
+	SocialRecoveryRequests        []*socialrecovery.Request
+	SocialRecoveryProgress        []*socialrecovery.Progress
+	NotificationRules             []*NotificationRule
+	ChatDrafts                    []*ChatDraft
+	CommunityMemberProfiles       []*CommunityMemberProfile
+	BucketedContactRequests       []*BucketedContactRequest
 	DiscordCategories             []*discord.Category
 	DiscordChannels               []*discord.Channel
 	DiscordOldestMessageTimestamp int
@@ -110,6 +123,17 @@ func (r *MessengerResponse) MarshalJSON() ([]byte, error) {
 		IdentityImages                []images.IdentityImage               `json:"identityImages,omitempty"`
 		WatchOnlyAccounts             []*accounts.Account                  `json:"watchOnlyAccounts,omitempty"`
 		Keypairs                      []*accounts.Keypair                  `json:"keypairs,omitempty"`
+		AccountsPositions             []*accounts.Account                  `json:"accountsPositions,omitempty"`
+		CustomTokens                  []*token.CustomToken                 `json:"customTokens,omitempty"`
+		SafeTransactions              []*SafeTransactionStatus             `json:"safeTransactions,omitempty"`
+		SocialRecoveryTrustees        []*socialrecovery.Trustee            `json:"socialRecoveryTrustees,omitempty"`
+		SocialRecoveryShares          []*socialrecovery.Share              `json:"socialRecoveryShares,omitempty"`
+		SocialRecoveryRequests        []*socialrecovery.Request            `json:"socialRecoveryRequests,omitempty"`
+		SocialRecoveryProgress        []*socialrecovery.Progress           `json:"socialRecoveryProgress,omitempty"`
+		NotificationRules             []*NotificationRule                  `json:"notificationRules,omitempty"`
+		ChatDrafts                    []*ChatDraft                         `json:"chatDrafts,omitempty"`
+		CommunityMemberProfiles       []*CommunityMemberProfile            `json:"communityMemberProfiles,omitempty"`
+		BucketedContactRequests       []*BucketedContactRequest            `json:"bucketedContactRequests,omitempty"`
 		DiscordCategories             []*discord.Category                  `json:"discordCategories,omitempty"`
 		DiscordChannels               []*discord.Channel                   `json:"discordChannels,omitempty"`
 		DiscordOldestMessageTimestamp int                                  `json:"discordOldestMessageTimestamp"`
@@ -132,6 +156,17 @@ func (r *MessengerResponse) MarshalJSON() ([]byte, error) {
 		IdentityImages:          r.IdentityImages,
 		WatchOnlyAccounts:       r.WatchOnlyAccounts,
 		Keypairs:                r.Keypairs,
+		AccountsPositions:       r.AccountsPositions,
+		CustomTokens:            r.CustomTokens,
+		SafeTransactions:        r.SafeTransactions,
+		SocialRecoveryTrustees:  r.SocialRecoveryTrustees,
+		SocialRecoveryShares:    r.SocialRecoveryShares,
+		SocialRecoveryRequests:  r.SocialRecoveryRequests,
+		SocialRecoveryProgress:  r.SocialRecoveryProgress,
+		NotificationRules:       r.NotificationRules,
+		ChatDrafts:              r.ChatDrafts,
+		CommunityMemberProfiles: r.CommunityMemberProfiles,
+		BucketedContactRequests: r.BucketedContactRequests,
 
 		Messages:                      r.Messages(),
 		VerificationRequests:          r.VerificationRequests(),
@@ -272,6 +307,17 @@ func (r *MessengerResponse) IsEmpty() bool {
 		len(r.IdentityImages)+
 		len(r.WatchOnlyAccounts)+
 		len(r.Keypairs)+
+		len(r.AccountsPositions)+
+		len(r.CustomTokens)+
+		len(r.SafeTransactions)+
+		len(r.SocialRecoveryTrustees)+
+		len(r.SocialRecoveryShares)+
+		len(r.SocialRecoveryRequests)+
+		len(r.SocialRecoveryProgress)+
+		len(r.NotificationRules)+
+		len(r.ChatDrafts)+
+		len(r.CommunityMemberProfiles)+
+		len(r.BucketedContactRequests)+
 		len(r.notifications)+
 		len(r.statusUpdates)+
 		len(r.activityCenterNotifications)+
@@ -320,6 +366,17 @@ func (r *MessengerResponse) Merge(response *MessengerResponse) error {
 	r.BackupHandled = response.BackupHandled
 	r.WatchOnlyAccounts = append(r.WatchOnlyAccounts, response.WatchOnlyAccounts...)
 	r.Keypairs = append(r.Keypairs, response.Keypairs...)
+	r.AccountsPositions = append(r.AccountsPositions, response.AccountsPositions...)
+	r.CustomTokens = append(r.CustomTokens, response.CustomTokens...)
+	r.SafeTransactions = append(r.SafeTransactions, response.SafeTransactions...)
+	r.SocialRecoveryTrustees = append(r.SocialRecoveryTrustees, response.SocialRecoveryTrustees...)
+	r.SocialRecoveryShares = append(r.SocialRecoveryShares, response.SocialRecoveryShares...)
+	r.SocialRecoveryRequests = append(r.SocialRecoveryRequests, response.SocialRecoveryRequests...)
+	r.SocialRecoveryProgress = append(r.SocialRecoveryProgress, response.SocialRecoveryProgress...)
+	r.NotificationRules = append(r.NotificationRules, response.NotificationRules...)
+	r.ChatDrafts = append(r.ChatDrafts, response.ChatDrafts...)
+	r.CommunityMemberProfiles = append(r.CommunityMemberProfiles, response.CommunityMemberProfiles...)
+	r.BucketedContactRequests = append(r.BucketedContactRequests, response.BucketedContactRequests...)
 	r.SocialLinksInfo = response.SocialLinksInfo
 
 	return nil
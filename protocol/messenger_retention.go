@@ -0,0 +1,187 @@
+package protocol
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	messageRetentionInterval = time.Hour
+	// messageRetentionBatchSize is the number of rows deleted at once, so that
+	// the database isn't locked for long
+	messageRetentionBatchSize  = 500
+	messageRetentionBatchPause = 50 * time.Millisecond
+	// messageRetentionVacuumPages is the number of pages released to the file
+	// system at once
+	messageRetentionVacuumPages = 1000
+)
+
+// MessageRetentionPolicy is how long the messages of a type of chat are kept
+type MessageRetentionPolicy struct {
+	ChatType ChatType `json:"chatType"`
+	// MaxAge is in seconds
+	MaxAge uint64 `json:"maxAge"`
+}
+
+// MessageRetentionResult is the number of rows deleted by a pruning run, and
+// the size in bytes the database shrank
+type MessageRetentionResult struct {
+	Messages                    int64 `json:"messages"`
+	RawMessages                 int64 `json:"rawMessages"`
+	ActivityCenterNotifications int64 `json:"activityCenterNotifications"`
+	ReclaimedBytes              int64 `json:"reclaimedBytes"`
+}
+
+func (r *MessageRetentionResult) empty() bool {
+	return r.Messages == 0 && r.RawMessages == 0 && r.ActivityCenterNotifications == 0 && r.ReclaimedBytes == 0
+}
+
+// SetMessageRetentionPolicy sets how long the messages of a type of chat are
+// kept, a max age of 0 keeps them forever
+func (m *Messenger) SetMessageRetentionPolicy(policy MessageRetentionPolicy) error {
+	if policy.ChatType < ChatTypeOneToOne || policy.ChatType > ChatTypeCommunityChat {
+		return ErrInvalidMessageRetentionPolicy
+	}
+
+	if policy.MaxAge == 0 {
+		return m.persistence.DeleteMessageRetentionPolicy(policy.ChatType)
+	}
+	return m.persistence.SaveMessageRetentionPolicy(&policy)
+}
+
+func (m *Messenger) MessageRetentionPolicies() ([]*MessageRetentionPolicy, error) {
+	return m.persistence.MessageRetentionPolicies()
+}
+
+// pruneInBatches runs prune until there are no rows left to delete, pausing
+// between batches
+func (m *Messenger) pruneInBatches(prune func(ChatType, uint64, int) (int64, error), chatType ChatType, before uint64) (int64, error) {
+	var total int64
+	for {
+		deleted, err := prune(chatType, before, messageRetentionBatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+		if deleted < messageRetentionBatchSize {
+			return total, nil
+		}
+
+		select {
+		case <-m.quit:
+			return total, ErrMessengerStopped
+		case <-time.After(messageRetentionBatchPause):
+		}
+	}
+}
+
+func (m *Messenger) pruneChatType(policy *MessageRetentionPolicy, now time.Time, result *MessageRetentionResult) error {
+	before := uint64(now.Add(-time.Duration(policy.MaxAge) * time.Second).UnixMilli())
+
+	deleted, err := m.pruneInBatches(m.persistence.PruneMessagesBatch, policy.ChatType, before)
+	result.Messages += deleted
+	if err != nil {
+		return err
+	}
+
+	deleted, err = m.pruneInBatches(m.persistence.PruneRawMessagesBatch, policy.ChatType, before)
+	result.RawMessages += deleted
+	if err != nil {
+		return err
+	}
+
+	deleted, err = m.pruneInBatches(m.persistence.PruneActivityCenterNotificationsBatch, policy.ChatType, before)
+	result.ActivityCenterNotifications += deleted
+	return err
+}
+
+// PruneMessages deletes the messages, raw messages and activity center
+// notifications older than the retention policy of their chat type, and
+// releases the free space of the database
+func (m *Messenger) PruneMessages() (*MessageRetentionResult, error) {
+	m.retentionMutex.Lock()
+	defer m.retentionMutex.Unlock()
+
+	result := &MessageRetentionResult{}
+	policies, err := m.persistence.MessageRetentionPolicies()
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return result, nil
+	}
+
+	sizeBefore, err := m.persistence.DatabaseSize()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, policy := range policies {
+		err = m.pruneChatType(policy, now, result)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	for {
+		freePagesLeft, err := m.persistence.IncrementalVacuum(messageRetentionVacuumPages)
+		if err != nil {
+			return result, err
+		}
+		if !freePagesLeft {
+			break
+		}
+
+		select {
+		case <-m.quit:
+			return result, nil
+		case <-time.After(messageRetentionBatchPause):
+		}
+	}
+
+	sizeAfter, err := m.persistence.DatabaseSize()
+	if err != nil {
+		return result, err
+	}
+	if sizeBefore > sizeAfter {
+		result.ReclaimedBytes = sizeBefore - sizeAfter
+	}
+
+	if m.config.messengerSignalsHandler != nil && !result.empty() {
+		m.config.messengerSignalsHandler.MessagesPruned(result)
+	}
+
+	return result, nil
+}
+
+// watchMessageRetention periodically prunes the messages older than the
+// retention policies
+func (m *Messenger) watchMessageRetention() {
+	m.logger.Debug("watching message retention")
+	go func() {
+		ticker := time.NewTicker(messageRetentionInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				result, err := m.PruneMessages()
+				if err != nil {
+					m.logger.Error("failed to prune messages", zap.Error(err))
+					continue
+				}
+				if !result.empty() {
+					m.logger.Info("pruned messages",
+						zap.Int64("messages", result.Messages),
+						zap.Int64("rawMessages", result.RawMessages),
+						zap.Int64("activityCenterNotifications", result.ActivityCenterNotifications),
+						zap.Int64("reclaimedBytes", result.ReclaimedBytes))
+				}
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,717 @@
+package protocol
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/rpc/chain"
+	"github.com/status-im/status-go/services/wallet/pendingtx"
+	"github.com/status-im/status-go/services/wallet/safe"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+	"github.com/status-im/status-go/transactions"
+)
+
+const (
+	defaultSafeTransactionText = "Safe transaction"
+	// safeTransactionVerifyTimeout bounds the checks of an execution on chain
+	safeTransactionVerifyTimeout = 30 * time.Second
+	// unverifiedSafeTransactionExecutionsInterval is how often the received
+	// executions are checked again, when their proposal or the chain wasn't
+	// available
+	unverifiedSafeTransactionExecutionsInterval = time.Minute
+	// unverifiedSafeTransactionExecutionTTL is how long a received execution
+	// waits for its proposal
+	unverifiedSafeTransactionExecutionTTL = 7 * 24 * time.Hour
+)
+
+func (m *Messenger) safeClient(chainID uint64) (*chain.ClientWithFallback, error) {
+	if m.config.rpcClient == nil {
+		return nil, ErrSafeTransactionNoWallet
+	}
+	return m.config.rpcClient.EthClient(chainID)
+}
+
+// safeAccount returns the wallet account, once the password is verified
+func (m *Messenger) safeAccount(address gethcommon.Address, password string) (*account.SelectedExtKey, error) {
+	if m.accountsManager == nil {
+		return nil, ErrSafeTransactionNoWallet
+	}
+	verifiedAccount, err := m.accountsManager.GetVerifiedWalletAccount(m.settings, address.Hex(), password)
+	if err != nil {
+		return nil, err
+	}
+	if verifiedAccount.AccountKey == nil || verifiedAccount.AccountKey.PrivateKey == nil {
+		return nil, ErrSafeTransactionNoWallet
+	}
+	return verifiedAccount, nil
+}
+
+// verifySafeTransactionExecution checks on chain that the transaction
+// executed the Safe transaction of the proposal
+func (m *Messenger) verifySafeTransactionExecution(ctx context.Context, proposal *protobuf.SafeTransactionProposal, transactionHash string) error {
+	tx, err := safeTransactionFromProposal(proposal)
+	if err != nil {
+		return err
+	}
+	safeAddress := gethcommon.HexToAddress(proposal.SafeAddress)
+	hash, err := tx.Hash(proposal.ChainId, safeAddress)
+	if err != nil {
+		return err
+	}
+
+	client, err := m.safeClient(proposal.ChainId)
+	if err != nil {
+		return err
+	}
+	return safe.VerifyExecution(ctx, client, safeAddress, hash, tx.Nonce, gethcommon.HexToHash(transactionHash))
+}
+
+// safeTransactionMessage returns the message a Safe transaction was shared
+// with, along with its chat
+func (m *Messenger) safeTransactionMessage(messageID string) (*common.Message, *Chat, error) {
+	message, err := m.persistence.MessageByID(messageID)
+	if err == common.ErrRecordNotFound {
+		return nil, nil, ErrSafeTransactionNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if message.GetSafeTransaction() == nil {
+		return nil, nil, ErrSafeTransactionNotFound
+	}
+
+	chat, ok := m.allChats.Load(message.LocalChatID)
+	if !ok {
+		return nil, nil, ErrChatNotFound
+	}
+	return message, chat, nil
+}
+
+// validSafeTransactionSignatures returns the signatures of the transaction
+// which were made by their signer, when owners is set only theirs are kept
+func (m *Messenger) validSafeTransactionSignatures(messageID string, hash gethcommon.Hash, owners []gethcommon.Address) (map[gethcommon.Address][]byte, error) {
+	signatures, err := m.persistence.SafeTransactionSignatures(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	isOwner := make(map[gethcommon.Address]bool)
+	for _, owner := range owners {
+		isOwner[owner] = true
+	}
+
+	valid := make(map[gethcommon.Address][]byte)
+	for signer, signature := range signatures {
+		recovered, err := safe.RecoverSigner(hash, signature)
+		if err != nil || recovered != signer {
+			continue
+		}
+		if owners != nil && !isOwner[signer] {
+			continue
+		}
+		valid[signer] = signature
+	}
+	return valid, nil
+}
+
+func (m *Messenger) safeTransactionStatus(message *common.Message) (*SafeTransactionStatus, error) {
+	proposal := message.GetSafeTransaction()
+	hash, err := safeTxHash(proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	signatures, err := m.validSafeTransactionSignatures(message.ID, hash, nil)
+	if err != nil {
+		return nil, err
+	}
+	transactionHash, err := m.persistence.SafeTransactionExecutionHash(message.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &SafeTransactionStatus{
+		MessageID:       message.ID,
+		ChatID:          message.LocalChatID,
+		SafeTxHash:      hash.Bytes(),
+		Threshold:       proposal.Threshold,
+		Signers:         make([]string, 0, len(signatures)),
+		TransactionHash: transactionHash,
+	}
+	for signer := range signatures {
+		status.Signers = append(status.Signers, signer.Hex())
+	}
+	sort.Strings(status.Signers)
+	return status, nil
+}
+
+// ProposeSafeTransaction shares a transaction of a Safe in a community or
+// group chat, the owners of the Safe then sign it from the chat
+func (m *Messenger) ProposeSafeTransaction(ctx context.Context, request *requests.ProposeSafeTransaction) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	chat, ok := m.allChats.Load(request.ChatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+	if chat.ChatType != ChatTypeCommunityChat && chat.ChatType != ChatTypePrivateGroupChat {
+		return nil, ErrSafeTransactionChatType
+	}
+
+	var data []byte
+	if request.Data != "" {
+		var err error
+		data, err = hexutil.Decode(request.Data)
+		if err != nil {
+			return nil, ErrInvalidSafeTransaction
+		}
+	}
+
+	proposal := &protobuf.SafeTransactionProposal{
+		ChainId:        request.ChainID,
+		SafeAddress:    request.SafeAddress,
+		To:             request.To,
+		Value:          request.Value,
+		Data:           data,
+		Operation:      request.Operation,
+		SafeTxGas:      request.SafeTxGas,
+		BaseGas:        request.BaseGas,
+		GasPrice:       request.GasPrice,
+		GasToken:       request.GasToken,
+		RefundReceiver: request.RefundReceiver,
+		Nonce:          request.Nonce,
+	}
+	if _, err := safeTransactionFromProposal(proposal); err != nil {
+		return nil, err
+	}
+
+	client, err := m.safeClient(request.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	safeAddress := gethcommon.HexToAddress(request.SafeAddress)
+	if proposal.Nonce == "" {
+		nonce, err := safe.Nonce(ctx, client, safeAddress)
+		if err != nil {
+			return nil, err
+		}
+		proposal.Nonce = nonce.String()
+	}
+	threshold, err := safe.Threshold(ctx, client, safeAddress)
+	if err != nil {
+		return nil, err
+	}
+	proposal.Threshold = uint32(threshold)
+
+	text := request.Text
+	if text == "" {
+		text = defaultSafeTransactionText
+	}
+	message := &common.Message{}
+	message.ChatId = chat.ID
+	message.Text = text
+	message.ContentType = protobuf.ChatMessage_SAFE_TRANSACTION
+	message.Payload = &protobuf.ChatMessage_SafeTransaction{SafeTransaction: proposal}
+
+	return m.sendChatMessage(ctx, message)
+}
+
+// SignSafeTransaction signs the Safe transaction shared in the message with
+// the key of one of the owners of the Safe, and shares the signature in the
+// chat
+func (m *Messenger) SignSafeTransaction(ctx context.Context, messageID string, address gethcommon.Address, password string) (*MessengerResponse, error) {
+	message, chat, err := m.safeTransactionMessage(messageID)
+	if err != nil {
+		return nil, err
+	}
+	proposal := message.GetSafeTransaction()
+	hash, err := safeTxHash(proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := m.safeClient(proposal.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	owners, err := safe.Owners(ctx, client, gethcommon.HexToAddress(proposal.SafeAddress))
+	if err != nil {
+		return nil, err
+	}
+	isOwner := false
+	for _, owner := range owners {
+		isOwner = isOwner || owner == address
+	}
+	if !isOwner {
+		return nil, ErrSafeTransactionNotOwner
+	}
+
+	verifiedAccount, err := m.safeAccount(address, password)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := safe.Sign(hash, verifiedAccount.AccountKey.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	safeSignature := &SafeTransactionSignature{
+		SafeTransactionSignature: protobuf.SafeTransactionSignature{
+			Clock:      clock,
+			ChatId:     chat.ID,
+			MessageId:  messageID,
+			SafeTxHash: hash.Bytes(),
+			Signer:     address.Hex(),
+			Signature:  signature,
+		},
+		From:      common.PubkeyToHex(&m.identity.PublicKey),
+		SigPubKey: &m.identity.PublicKey,
+	}
+	encodedMessage, err := m.encodeChatEntity(chat, safeSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:          chat.ID,
+		Payload:              encodedMessage,
+		MessageType:          protobuf.ApplicationMetadataMessage_SAFE_TRANSACTION_SIGNATURE,
+		SkipGroupMessageWrap: true,
+		ResendAutomatically:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveSafeTransactionSignature(safeSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.safeTransactionResponse(message, chat)
+}
+
+// ExecuteSafeTransaction submits the Safe transaction shared in the message
+// once enough owners signed it, the gas is paid by the from account. The
+// others are told about the execution once it's mined
+func (m *Messenger) ExecuteSafeTransaction(ctx context.Context, messageID string, from gethcommon.Address, password string) (*MessengerResponse, error) {
+	if m.transactor == nil {
+		return nil, ErrSafeTransactionNoWallet
+	}
+
+	message, chat, err := m.safeTransactionMessage(messageID)
+	if err != nil {
+		return nil, err
+	}
+	// Only an execution submitted from this device blocks another one, the
+	// ones received are checked against the Safe below
+	execution, err := m.persistence.SafeTransactionExecution(messageID)
+	if err != nil {
+		return nil, err
+	}
+	if execution != nil && execution.From == common.PubkeyToHex(&m.identity.PublicKey) {
+		return nil, ErrSafeTransactionAlreadyExecuted
+	}
+
+	proposal := message.GetSafeTransaction()
+	tx, err := safeTransactionFromProposal(proposal)
+	if err != nil {
+		return nil, err
+	}
+	safeAddress := gethcommon.HexToAddress(proposal.SafeAddress)
+	hash, err := tx.Hash(proposal.ChainId, safeAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := m.safeClient(proposal.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := safe.Nonce(ctx, client, safeAddress)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Nonce != nil && nonce.Cmp(tx.Nonce) > 0 {
+		return nil, ErrSafeTransactionAlreadyExecuted
+	}
+	owners, err := safe.Owners(ctx, client, safeAddress)
+	if err != nil {
+		return nil, err
+	}
+	threshold, err := safe.Threshold(ctx, client, safeAddress)
+	if err != nil {
+		return nil, err
+	}
+	signatures, err := m.validSafeTransactionSignatures(messageID, hash, owners)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(signatures)) < threshold {
+		return nil, ErrSafeTransactionThreshold
+	}
+
+	data, err := tx.PackExecTransaction(safe.EncodeSignatures(signatures))
+	if err != nil {
+		return nil, err
+	}
+
+	verifiedAccount, err := m.safeAccount(from, password)
+	if err != nil {
+		return nil, err
+	}
+	to := types.Address(safeAddress)
+	transactionHash, err := m.transactor.SendTransactionWithChainID(proposal.ChainId, transactions.SendTxArgs{
+		From:  types.Address(from),
+		To:    &to,
+		Input: data,
+	}, verifiedAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	execution = &SafeTransactionExecution{
+		SafeTransactionExecution: protobuf.SafeTransactionExecution{
+			Clock:           clock,
+			ChatId:          chat.ID,
+			MessageId:       messageID,
+			TransactionHash: gethcommon.Hash(transactionHash).Hex(),
+		},
+		From:      common.PubkeyToHex(&m.identity.PublicKey),
+		SigPubKey: &m.identity.PublicKey,
+	}
+	// The transaction is tracked by the wallet, the execution is shared once
+	// it's mined
+	err = m.persistence.SaveSafeTransactionExecution(execution)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.safeTransactionResponse(message, chat)
+}
+
+// shareSafeTransactionExecution tells the chat about the execution submitted
+// by the transaction once it's mined. When the transaction didn't execute
+// the Safe transaction, as when it was dropped, the execution is removed so
+// that it can be submitted again
+func (m *Messenger) shareSafeTransactionExecution(transactionHash string) (*MessengerResponse, error) {
+	execution, err := m.persistence.SafeTransactionExecutionByHash(transactionHash)
+	if err != nil || execution == nil || execution.From != common.PubkeyToHex(&m.identity.PublicKey) {
+		return nil, err
+	}
+	message, chat, err := m.safeTransactionMessage(execution.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), safeTransactionVerifyTimeout)
+	defer cancel()
+	err = m.verifySafeTransactionExecution(ctx, message.GetSafeTransaction(), transactionHash)
+	if err == safe.ErrExecutionNotVerified {
+		err = m.persistence.DeleteSafeTransactionExecution(execution.MessageId)
+		if err != nil {
+			return nil, err
+		}
+		return m.safeTransactionResponse(message, chat)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	execution.Clock, _ = chat.NextClockAndTimestamp(m.getTimesource())
+	execution.SigPubKey = &m.identity.PublicKey
+	encodedMessage, err := m.encodeChatEntity(chat, execution)
+	if err != nil {
+		return nil, err
+	}
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:          chat.ID,
+		Payload:              encodedMessage,
+		MessageType:          protobuf.ApplicationMetadataMessage_SAFE_TRANSACTION_EXECUTION,
+		SkipGroupMessageWrap: true,
+		ResendAutomatically:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return m.safeTransactionResponse(message, chat)
+}
+
+// watchSafeTransactionExecutions shares the executions submitted from this
+// device once the wallet sees their transactions mined
+func (m *Messenger) watchSafeTransactionExecutions() {
+	if m.walletFeed == nil {
+		return
+	}
+
+	events := make(chan walletevent.Event, 10)
+	sub := m.walletFeed.Subscribe(events)
+
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case event := <-events:
+				if event.Type != pendingtx.EventPendingTransactionStatusChanged {
+					continue
+				}
+
+				response, err := m.shareSafeTransactionExecution(event.Message)
+				if err != nil {
+					m.logger.Error("failed to share safe transaction execution", zap.Error(err))
+					continue
+				}
+
+				if response != nil && !response.IsEmpty() && m.config.messengerSignalsHandler != nil {
+					m.config.messengerSignalsHandler.MessengerResponse(response)
+				}
+			case <-sub.Err():
+				return
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// GetSafeTransactionStatus returns the signers of the Safe transaction shared
+// in the message, and its hash once it was submitted
+func (m *Messenger) GetSafeTransactionStatus(messageID string) (*SafeTransactionStatus, error) {
+	message, _, err := m.safeTransactionMessage(messageID)
+	if err != nil {
+		return nil, err
+	}
+	return m.safeTransactionStatus(message)
+}
+
+func (m *Messenger) safeTransactionResponse(message *common.Message, chat *Chat) (*MessengerResponse, error) {
+	status, err := m.safeTransactionStatus(message)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.SafeTransactions = append(response.SafeTransactions, status)
+	response.AddChat(chat)
+	return response, nil
+}
+
+// addSafeTransactionStatus adds the status of the Safe transaction to the
+// response, when its message was received already
+func (m *Messenger) addSafeTransactionStatus(state *ReceivedMessageState, messageID string) error {
+	message, _, err := m.safeTransactionMessage(messageID)
+	if err == ErrSafeTransactionNotFound || err == ErrChatNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	status, err := m.safeTransactionStatus(message)
+	if err != nil {
+		return err
+	}
+	state.Response.SafeTransactions = append(state.Response.SafeTransactions, status)
+	return nil
+}
+
+func (m *Messenger) HandleSafeTransactionSignature(state *ReceivedMessageState, message protobuf.SafeTransactionSignature) error {
+	if err := ValidateReceivedSafeTransactionSignature(&message, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	signer, err := safe.RecoverSigner(gethcommon.BytesToHash(message.SafeTxHash), message.Signature)
+	if err != nil {
+		return err
+	}
+	if !gethcommon.IsHexAddress(message.Signer) || signer != gethcommon.HexToAddress(message.Signer) {
+		return safe.ErrInvalidSignature
+	}
+
+	safeSignature := &SafeTransactionSignature{
+		SafeTransactionSignature: message,
+		From:                     state.CurrentMessageState.Contact.ID,
+		SigPubKey:                state.CurrentMessageState.PublicKey,
+	}
+	chat, err := m.matchChatEntity(safeSignature)
+	if err != nil {
+		return err
+	}
+
+	// The signature may arrive before the proposal, it's checked against the
+	// proposal whenever it's used
+	err = m.persistence.SaveSafeTransactionSignature(safeSignature)
+	if err != nil {
+		return err
+	}
+
+	if chat.LastClockValue < message.Clock {
+		chat.LastClockValue = message.Clock
+	}
+	state.Response.AddChat(chat)
+	state.AllChats.Store(chat.ID, chat)
+
+	return m.addSafeTransactionStatus(state, message.MessageId)
+}
+
+// HandleSafeTransactionExecution stores the execution as unverified, it's
+// only a claim of the sender until it's checked on chain by
+// verifySafeTransactionExecutions, which waits for the proposal when it
+// arrives after the execution
+func (m *Messenger) HandleSafeTransactionExecution(state *ReceivedMessageState, message protobuf.SafeTransactionExecution) error {
+	if err := ValidateReceivedSafeTransactionExecution(&message, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	execution := &SafeTransactionExecution{
+		SafeTransactionExecution: message,
+		From:                     state.CurrentMessageState.Contact.ID,
+		SigPubKey:                state.CurrentMessageState.PublicKey,
+	}
+	chat, err := m.matchChatEntity(execution)
+	if err != nil {
+		return err
+	}
+
+	transactionHash, err := m.persistence.SafeTransactionExecutionHash(message.MessageId)
+	if err != nil {
+		return err
+	}
+	if transactionHash == message.TransactionHash {
+		return nil
+	}
+
+	err = m.persistence.SaveUnverifiedSafeTransactionExecution(execution, m.getTimesource().GetCurrentTime())
+	if err != nil {
+		return err
+	}
+	m.verifySafeTransactionExecutionsSoon()
+
+	if chat.LastClockValue < message.Clock {
+		chat.LastClockValue = message.Clock
+	}
+	state.Response.AddChat(chat)
+	state.AllChats.Store(chat.ID, chat)
+
+	return nil
+}
+
+// verifySafeTransactionExecutionsSoon wakes up the checks of the received
+// executions, without waiting for them
+func (m *Messenger) verifySafeTransactionExecutionsSoon() {
+	select {
+	case m.unverifiedSafeTransactionExecutions <- struct{}{}:
+	default:
+	}
+}
+
+// verifyUnverifiedSafeTransactionExecution checks on chain a received execution, it
+// returns whether it's done with it
+func (m *Messenger) verifyUnverifiedSafeTransactionExecution(response *MessengerResponse, execution *SafeTransactionExecution) (bool, error) {
+	message, chat, err := m.safeTransactionMessage(execution.MessageId)
+	if err == ErrSafeTransactionNotFound || err == ErrChatNotFound {
+		// Queued until the proposal arrives
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), safeTransactionVerifyTimeout)
+	defer cancel()
+	err = m.verifySafeTransactionExecution(ctx, message.GetSafeTransaction(), execution.TransactionHash)
+	if err == safe.ErrExecutionNotVerified {
+		m.logger.Warn("dropping safe transaction execution not verified", zap.String("messageID", execution.MessageId), zap.String("from", execution.From))
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	err = m.persistence.SaveSafeTransactionExecution(execution)
+	if err != nil {
+		return false, err
+	}
+
+	status, err := m.safeTransactionStatus(message)
+	if err != nil {
+		return false, err
+	}
+	response.SafeTransactions = append(response.SafeTransactions, status)
+	response.AddChat(chat)
+	return true, nil
+}
+
+// verifySafeTransactionExecutions checks on chain the executions received in
+// chats, away from the handling of the messages
+func (m *Messenger) verifySafeTransactionExecutions() (*MessengerResponse, error) {
+	expiredAt := m.getTimesource().GetCurrentTime() - uint64(unverifiedSafeTransactionExecutionTTL.Milliseconds())
+	err := m.persistence.DeleteUnverifiedSafeTransactionExecutionsBefore(expiredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	executions, err := m.persistence.UnverifiedSafeTransactionExecutions()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	for _, execution := range executions {
+		done, err := m.verifyUnverifiedSafeTransactionExecution(response, execution)
+		if err != nil {
+			m.logger.Warn("failed to verify safe transaction execution", zap.String("messageID", execution.MessageId), zap.Error(err))
+			continue
+		}
+		if !done {
+			continue
+		}
+		err = m.persistence.DeleteUnverifiedSafeTransactionExecution(execution.MessageId, execution.TransactionHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (m *Messenger) watchUnverifiedSafeTransactionExecutions() {
+	ticker := time.NewTicker(unverifiedSafeTransactionExecutionsInterval)
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-m.unverifiedSafeTransactionExecutions:
+			case <-ticker.C:
+			case <-m.quit:
+				return
+			}
+
+			response, err := m.verifySafeTransactionExecutions()
+			if err != nil {
+				m.logger.Error("failed to verify safe transaction executions", zap.Error(err))
+				continue
+			}
+
+			if !response.IsEmpty() && m.config.messengerSignalsHandler != nil {
+				m.config.messengerSignalsHandler.MessengerResponse(response)
+			}
+		}
+	}()
+}
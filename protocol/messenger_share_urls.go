@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
 
 	"github.com/status-im/status-go/api/multiformat"
 	"github.com/status-im/status-go/eth-node/crypto"
@@ -19,6 +20,7 @@ import (
 )
 
 type CommunityURLData struct {
+	CommunityID  string   `json:"communityId"`
 	DisplayName  string   `json:"displayName"`
 	Description  string   `json:"description"`
 	MembersCount uint32   `json:"membersCount"`
@@ -27,6 +29,7 @@ type CommunityURLData struct {
 }
 
 type CommunityChannelURLData struct {
+	ChannelUUID string `json:"channelUuid"`
 	Emoji       string `json:"emoji"`
 	DisplayName string `json:"displayName"`
 	Description string `json:"description"`
@@ -34,19 +37,44 @@ type CommunityChannelURLData struct {
 }
 
 type ContactURLData struct {
+	PublicKey   string `json:"publicKey"`
 	DisplayName string `json:"displayName"`
 	Description string `json:"description"`
 }
 
+type MessageURLData struct {
+	MessageID         string `json:"messageId"`
+	ChatID            string `json:"chatId"`
+	Author            string `json:"author"`
+	AuthorDisplayName string `json:"authorDisplayName"`
+	Text              string `json:"text"`
+}
+
+// CommunityInviteURLData is set when the url is a community invite
+type CommunityInviteURLData struct {
+	// Inviter is empty when the invite is signed by the community
+	Inviter string `json:"inviter,omitempty"`
+	// Verified is whether the inviter was checked to be a member, which can
+	// only be done once the community is known
+	Verified bool `json:"verified"`
+}
+
 type URLDataResponse struct {
 	Community CommunityURLData        `json:"community"`
 	Channel   CommunityChannelURLData `json:"channel"`
 	Contact   ContactURLData          `json:"contact"`
+	Message   MessageURLData          `json:"message"`
+	Invite    *CommunityInviteURLData `json:"invite,omitempty"`
 }
 
 const baseShareURL = "https://status.app"
+const maxMessageURLTextLength = 200
 const channelUUIDRegExp = "^[0-9a-f]{8}-[0-9a-f]{4}-[0-5][0-9a-f]{3}-[089ab][0-9a-f]{3}-[0-9a-f]{12}$"
 
+func isStatusLink(url string) bool {
+	return strings.HasPrefix(url, baseShareURL+"/")
+}
+
 func (m *Messenger) SerializePublicKey(compressedKey types.HexBytes) (string, error) {
 	rawKey, err := crypto.DecompressPubkey(compressedKey)
 	if err != nil {
@@ -91,6 +119,7 @@ func (m *Messenger) ShareCommunityURLWithChatKey(communityID types.HexBytes) (st
 
 func (m *Messenger) prepareCommunityData(community *communities.Community) CommunityURLData {
 	return CommunityURLData{
+		CommunityID:  community.IDString(),
 		DisplayName:  community.Identity().DisplayName,
 		Description:  community.DescriptionText(),
 		MembersCount: uint32(community.MembersCount()),
@@ -99,21 +128,17 @@ func (m *Messenger) prepareCommunityData(community *communities.Community) Commu
 	}
 }
 
-func (m *Messenger) parseCommunityURLWithChatKey(urlData string) (*URLDataResponse, error) {
+func (m *Messenger) parseCommunityURLWithChatKey(urlData string, fetch bool) (*URLDataResponse, error) {
 	communityID, err := m.DeserializePublicKey(urlData)
 	if err != nil {
 		return nil, err
 	}
 
-	community, err := m.GetCommunityByID(communityID)
+	community, err := m.findOrFetchCommunity(communityID, fetch)
 	if err != nil {
 		return nil, err
 	}
 
-	if community == nil {
-		return nil, fmt.Errorf("community with communityID %s not found", communityID)
-	}
-
 	return &URLDataResponse{
 		Community: m.prepareCommunityData(community),
 	}, nil
@@ -183,7 +208,7 @@ func (m *Messenger) verifySignature(data string, rawSignature string) (*ecdsa.Pu
 }
 
 func (m *Messenger) parseCommunityURLWithData(data string, signature string) (*URLDataResponse, error) {
-	_, err := m.verifySignature(data, signature)
+	communityKey, err := m.verifySignature(data, signature)
 	if err != nil {
 		return nil, err
 	}
@@ -201,6 +226,7 @@ func (m *Messenger) parseCommunityURLWithData(data string, signature string) (*U
 
 	return &URLDataResponse{
 		Community: CommunityURLData{
+			CommunityID:  types.HexBytes(crypto.CompressPubkey(communityKey)).String(),
 			DisplayName:  communityProto.DisplayName,
 			Description:  communityProto.Description,
 			MembersCount: communityProto.MembersCount,
@@ -241,7 +267,7 @@ func (m *Messenger) prepareCommunityChannelData(channel *protobuf.CommunityChat)
 	}
 }
 
-func (m *Messenger) parseCommunityChannelURLWithChatKey(channelID string, publickKey string) (*URLDataResponse, error) {
+func (m *Messenger) parseCommunityChannelURLWithChatKey(channelID string, publickKey string, fetch bool) (*URLDataResponse, error) {
 	valid, err := regexp.MatchString(channelUUIDRegExp, channelID)
 	if err != nil {
 		return nil, err
@@ -256,23 +282,22 @@ func (m *Messenger) parseCommunityChannelURLWithChatKey(channelID string, public
 		return nil, err
 	}
 
-	community, err := m.GetCommunityByID(communityID)
+	community, err := m.findOrFetchCommunity(communityID, fetch)
 	if err != nil {
 		return nil, err
 	}
 
-	if community == nil {
-		return nil, fmt.Errorf("community with communityID %s not found", communityID)
-	}
-
 	channel, ok := community.Chats()[channelID]
 	if !ok {
 		return nil, fmt.Errorf("channel with channelID %s not found", channelID)
 	}
 
+	channelData := m.prepareCommunityChannelData(channel)
+	channelData.ChannelUUID = channelID
+
 	return &URLDataResponse{
 		Community: m.prepareCommunityData(community),
-		Channel:   m.prepareCommunityChannelData(channel),
+		Channel:   channelData,
 	}, nil
 }
 
@@ -335,7 +360,7 @@ func (m *Messenger) ShareCommunityChannelURLWithData(request *requests.Community
 }
 
 func (m *Messenger) parseCommunityChannelURLWithData(data string, signature string) (*URLDataResponse, error) {
-	_, err := m.verifySignature(data, signature)
+	communityKey, err := m.verifySignature(data, signature)
 	if err != nil {
 		return nil, err
 	}
@@ -353,6 +378,7 @@ func (m *Messenger) parseCommunityChannelURLWithData(data string, signature stri
 
 	return &URLDataResponse{
 		Community: CommunityURLData{
+			CommunityID:  types.HexBytes(crypto.CompressPubkey(communityKey)).String(),
 			DisplayName:  channelProto.Community.DisplayName,
 			Description:  channelProto.Community.Description,
 			MembersCount: channelProto.Community.MembersCount,
@@ -360,6 +386,7 @@ func (m *Messenger) parseCommunityChannelURLWithData(data string, signature stri
 			TagIndices:   channelProto.Community.TagIndices,
 		},
 		Channel: CommunityChannelURLData{
+			ChannelUUID: channelProto.Uuid,
 			Emoji:       channelProto.Emoji,
 			DisplayName: channelProto.DisplayName,
 			Description: channelProto.Description,
@@ -384,6 +411,7 @@ func (m *Messenger) ShareUserURLWithChatKey(contactID string) (string, error) {
 
 func (m *Messenger) prepareContactData(contact *Contact) ContactURLData {
 	return ContactURLData{
+		PublicKey:   contact.ID,
 		DisplayName: contact.DisplayName,
 	}
 }
@@ -452,7 +480,7 @@ func (m *Messenger) ShareUserURLWithData(contactID string) (string, error) {
 }
 
 func (m *Messenger) parseUserURLWithData(data string, signature string) (*URLDataResponse, error) {
-	_, err := m.verifySignature(data, signature)
+	userKey, err := m.verifySignature(data, signature)
 	if err != nil {
 		return nil, err
 	}
@@ -470,13 +498,181 @@ func (m *Messenger) parseUserURLWithData(data string, signature string) (*URLDat
 
 	return &URLDataResponse{
 		Contact: ContactURLData{
+			PublicKey:   common.PubkeyToHex(userKey),
 			DisplayName: userProto.DisplayName,
 			Description: userProto.Description,
 		},
 	}, nil
 }
 
+// ShareCommunityInviteURL creates a short invite link to the community, or
+// to one of its channels. The link is signed by the community when we
+// control it, and by us otherwise, in which case we must be a member
+func (m *Messenger) ShareCommunityInviteURL(request *requests.CommunityInviteURL) (string, error) {
+	if err := request.Validate(); err != nil {
+		return "", err
+	}
+
+	community, err := m.GetCommunityByID(request.CommunityID)
+	if err != nil {
+		return "", err
+	}
+	if community == nil {
+		return "", fmt.Errorf("community with communityID %s not found", request.CommunityID)
+	}
+
+	if request.ChannelID != "" {
+		if _, ok := community.Chats()[request.ChannelID]; !ok {
+			return "", fmt.Errorf("channel with channelID %s not found", request.ChannelID)
+		}
+	}
+
+	invite := &protobuf.CommunityInvite{
+		CommunityId: community.ID(),
+		ChannelUuid: request.ChannelID,
+	}
+
+	key := community.PrivateKey()
+	if key == nil {
+		if !community.HasMember(&m.identity.PublicKey) {
+			return "", communities.ErrNotAuthorized
+		}
+		key = m.identity
+		invite.Inviter = crypto.CompressPubkey(&m.identity.PublicKey)
+	}
+
+	data, signature, err := urls.EncodeCommunityInvite(invite, key)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/i/%s#%s", baseShareURL, data, signature), nil
+}
+
+// parseCommunityInviteURL checks the signature of the invite offline, and
+// that the inviter is a member once the community is known. An invite to
+// an unknown community is resolved to its ID only
+func (m *Messenger) parseCommunityInviteURL(data string, signature string, fetch bool) (*URLDataResponse, error) {
+	invite, err := urls.DecodeCommunityInvite(data, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	communityID := types.HexBytes(invite.CommunityId)
+	response := &URLDataResponse{
+		Community: CommunityURLData{CommunityID: communityID.String()},
+		Channel:   CommunityChannelURLData{ChannelUUID: invite.ChannelUuid},
+		Invite:    &CommunityInviteURLData{Verified: true},
+	}
+
+	var inviter *ecdsa.PublicKey
+	if len(invite.Inviter) != 0 {
+		inviter, err = crypto.DecompressPubkey(invite.Inviter)
+		if err != nil {
+			return nil, err
+		}
+		response.Invite.Inviter = common.PubkeyToHex(inviter)
+		response.Invite.Verified = false
+	}
+
+	community, err := m.findOrFetchCommunity(communityID, fetch)
+	if err != nil {
+		// The invite is valid, the community is just not known yet
+		m.logger.Debug("community of the invite not found", zap.String("communityID", communityID.String()), zap.Error(err))
+		return response, nil
+	}
+
+	if inviter != nil {
+		if !community.HasMember(inviter) {
+			return nil, urls.ErrSpoofedCommunityInvite
+		}
+		response.Invite.Verified = true
+	}
+
+	response.Community = m.prepareCommunityData(community)
+	if invite.ChannelUuid != "" {
+		channel, ok := community.Chats()[invite.ChannelUuid]
+		if !ok {
+			return nil, fmt.Errorf("channel with channelID %s not found", invite.ChannelUuid)
+		}
+		response.Channel = m.prepareCommunityChannelData(channel)
+		response.Channel.ChannelUUID = invite.ChannelUuid
+	}
+
+	return response, nil
+}
+
+func (m *Messenger) ShareMessageURL(messageID string) (string, error) {
+	message, err := m.persistence.MessageByID(messageID)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/m#%s", baseShareURL, message.ID), nil
+}
+
+func (m *Messenger) prepareMessageData(message *common.Message) MessageURLData {
+	text := message.Text
+	if len([]rune(text)) > maxMessageURLTextLength {
+		text = string([]rune(text)[:maxMessageURLTextLength])
+	}
+
+	return MessageURLData{
+		MessageID:         message.ID,
+		ChatID:            message.LocalChatID,
+		Author:            message.From,
+		AuthorDisplayName: message.DisplayName,
+		Text:              text,
+	}
+}
+
+// parseMessageURL only resolves the messages we have, the link doesn't
+// carry the message itself
+func (m *Messenger) parseMessageURL(messageID string) (*URLDataResponse, error) {
+	message, err := m.persistence.MessageByID(messageID)
+	if err == common.ErrRecordNotFound {
+		return nil, fmt.Errorf("message with messageID %s not found", messageID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if message.Deleted || message.DeletedForMe {
+		return nil, fmt.Errorf("message with messageID %s was deleted", messageID)
+	}
+
+	return &URLDataResponse{
+		Message: m.prepareMessageData(message),
+	}, nil
+}
+
+// findOrFetchCommunity returns the community, requesting it from the store
+// nodes when it's not known yet and fetch is set
+func (m *Messenger) findOrFetchCommunity(communityID types.HexBytes, fetch bool) (*communities.Community, error) {
+	community, err := m.GetCommunityByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if community == nil && fetch {
+		community, err = m.requestCommunityInfoFromMailserver(communityID.String(), true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if community == nil {
+		return nil, fmt.Errorf("community with communityID %s not found", communityID)
+	}
+	return community, nil
+}
+
 func (m *Messenger) ParseSharedURL(url string) (*URLDataResponse, error) {
+	return m.parseSharedURL(url, true)
+}
+
+// parseSharedURL resolves the shared url, the unknown communities are
+// requested from the store nodes when fetch is set
+func (m *Messenger) parseSharedURL(url string, fetch bool) (*URLDataResponse, error) {
 	if !strings.HasPrefix(url, baseShareURL) {
 		return nil, fmt.Errorf("url should start with '%s'", baseShareURL)
 	}
@@ -487,7 +683,7 @@ func (m *Messenger) ParseSharedURL(url string) (*URLDataResponse, error) {
 	}
 
 	if urlContents[0] == "c" {
-		return m.parseCommunityURLWithChatKey(urlContents[1])
+		return m.parseCommunityURLWithChatKey(urlContents[1], fetch)
 	}
 
 	if strings.HasPrefix(urlContents[0], "c/") {
@@ -502,7 +698,7 @@ func (m *Messenger) ParseSharedURL(url string) (*URLDataResponse, error) {
 			return nil, err
 		}
 		if isChannel {
-			return m.parseCommunityChannelURLWithChatKey(first, urlContents[1])
+			return m.parseCommunityChannelURLWithChatKey(first, urlContents[1], fetch)
 		}
 		return m.parseCommunityChannelURLWithData(first, urlContents[1])
 	}
@@ -518,5 +714,13 @@ func (m *Messenger) ParseSharedURL(url string) (*URLDataResponse, error) {
 		return m.parseUserURLWithData(strings.TrimPrefix(urlContents[0], "u/"), urlContents[1])
 	}
 
+	if urlContents[0] == "m" {
+		return m.parseMessageURL(urlContents[1])
+	}
+
+	if strings.HasPrefix(urlContents[0], "i/") {
+		return m.parseCommunityInviteURL(strings.TrimPrefix(urlContents[0], "i/"), urlContents[1], fetch)
+	}
+
 	return nil, fmt.Errorf("unhandled shared url: %s", url)
 }
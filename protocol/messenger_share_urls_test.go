@@ -216,6 +216,7 @@ func (s *MessengerShareUrlsSuite) TestParseCommunityURLWithChatKey() {
 	s.Require().NotNil(urlData)
 
 	s.Require().NotNil(urlData.Community)
+	s.Require().Equal(community.IDString(), urlData.Community.CommunityID)
 	s.Require().Equal(community.Identity().DisplayName, urlData.Community.DisplayName)
 	s.Require().Equal(community.DescriptionText(), urlData.Community.Description)
 	s.Require().Equal(uint32(community.MembersCount()), urlData.Community.MembersCount)
@@ -249,6 +250,7 @@ func (s *MessengerShareUrlsSuite) TestParseCommunityURLWithData() {
 	s.Require().NotNil(urlData)
 
 	s.Require().NotNil(urlData.Community)
+	s.Require().Equal(community.IDString(), urlData.Community.CommunityID)
 	s.Require().Equal(community.Identity().DisplayName, urlData.Community.DisplayName)
 	s.Require().Equal(community.DescriptionText(), urlData.Community.Description)
 	s.Require().Equal(uint32(community.MembersCount()), urlData.Community.MembersCount)
@@ -287,6 +289,7 @@ func (s *MessengerShareUrlsSuite) TestParseCommunityChannelURLWithChatKey() {
 	s.Require().NotNil(urlData)
 
 	s.Require().NotNil(urlData.Community)
+	s.Require().Equal(community.IDString(), urlData.Community.CommunityID)
 	s.Require().Equal(community.Identity().DisplayName, urlData.Community.DisplayName)
 	s.Require().Equal(community.DescriptionText(), urlData.Community.Description)
 	s.Require().Equal(uint32(community.MembersCount()), urlData.Community.MembersCount)
@@ -329,6 +332,7 @@ func (s *MessengerShareUrlsSuite) TestParseCommunityChannelURLWithData() {
 	s.Require().NotNil(urlData)
 
 	s.Require().NotNil(urlData.Community)
+	s.Require().Equal(community.IDString(), urlData.Community.CommunityID)
 	s.Require().Equal(community.Identity().DisplayName, urlData.Community.DisplayName)
 	s.Require().Equal(community.DescriptionText(), urlData.Community.Description)
 	s.Require().Equal(uint32(community.MembersCount()), urlData.Community.MembersCount)
@@ -431,3 +435,152 @@ func (s *MessengerShareUrlsSuite) TestParseUserURLWithData() {
 	s.Require().Equal(contact.DisplayName, urlData.Contact.DisplayName)
 	s.Require().Equal(contact.Bio, urlData.Contact.Description)
 }
+
+func (s *MessengerShareUrlsSuite) saveMessage(chatID string, text string) *common.Message {
+	message := &common.Message{
+		ID:          types.EncodeHex(crypto.Keccak256([]byte(chatID + text))),
+		LocalChatID: chatID,
+		From:        s.m.myHexIdentity(),
+		ChatMessage: protobuf.ChatMessage{
+			ChatId:      chatID,
+			Text:        text,
+			Clock:       1,
+			Timestamp:   1,
+			ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+		},
+	}
+	s.Require().NoError(s.m.persistence.SaveMessages([]*common.Message{message}))
+	return message
+}
+
+func (s *MessengerShareUrlsSuite) TestParseMessageURL() {
+	community, _, channelID := s.createCommunityWithChannel()
+	message := s.saveMessage(community.IDString()+channelID, "status link previews")
+
+	url, err := s.m.ShareMessageURL(message.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(fmt.Sprintf("%s/m#%s", baseShareURL, message.ID), url)
+
+	urlData, err := s.m.ParseSharedURL(url)
+	s.Require().NoError(err)
+	s.Require().NotNil(urlData)
+
+	s.Require().Equal(message.ID, urlData.Message.MessageID)
+	s.Require().Equal(message.ChatId, urlData.Message.ChatID)
+	s.Require().Equal(message.From, urlData.Message.Author)
+	s.Require().Equal(message.Text, urlData.Message.Text)
+}
+
+func (s *MessengerShareUrlsSuite) TestUnfurlStatusLinks() {
+	community, channel, channelID := s.createCommunityWithChannel()
+	communityMessage := s.saveMessage(community.IDString()+channelID, "community message")
+	privateMessage := s.saveMessage(s.m.myHexIdentity(), "private message")
+
+	communityURL, err := s.m.ShareCommunityURLWithChatKey(community.ID())
+	s.Require().NoError(err)
+	channelURL, err := s.m.ShareCommunityChannelURLWithData(&requests.CommunityChannelShareURL{
+		CommunityID: community.ID(),
+		ChannelID:   channelID,
+	})
+	s.Require().NoError(err)
+	communityMessageURL := fmt.Sprintf("%s/m#%s", baseShareURL, communityMessage.ID)
+	privateMessageURL := fmt.Sprintf("%s/m#%s", baseShareURL, privateMessage.ID)
+
+	// The messages of other chats aren't previewed, their audience may differ
+	previews := s.m.UnfurlStatusLinks(community.IDString()+channelID, []string{communityURL, channelURL, communityMessageURL, privateMessageURL, "https://status.im"})
+	s.Require().Len(previews, 3)
+
+	s.Require().Equal(communityURL, previews[0].URL)
+	s.Require().NotNil(previews[0].Community)
+	s.Require().Equal(community.IDString(), previews[0].Community.CommunityID)
+	s.Require().Equal(community.Identity().DisplayName, previews[0].Community.DisplayName)
+
+	s.Require().Equal(channelURL, previews[1].URL)
+	s.Require().NotNil(previews[1].Channel)
+	s.Require().Equal(channelID, previews[1].Channel.ChannelUUID)
+	s.Require().Equal(channel.Identity.DisplayName, previews[1].Channel.DisplayName)
+	s.Require().Equal(community.IDString(), previews[1].Channel.Community.CommunityID)
+
+	s.Require().Equal(communityMessageURL, previews[2].URL)
+	s.Require().NotNil(previews[2].Message)
+	s.Require().Equal(communityMessage.Text, previews[2].Message.Text)
+
+	message := &common.Message{StatusLinkPreviews: previews}
+	unfurledLinks, err := message.ConvertStatusLinkPreviewsToProto()
+	s.Require().NoError(err)
+	s.Require().Len(unfurledLinks, 3)
+	s.Require().Equal(community.IDString(), unfurledLinks[0].Community.CommunityId)
+	s.Require().Equal(channelID, unfurledLinks[1].Channel.ChannelUuid)
+	s.Require().Equal(communityMessage.ID, unfurledLinks[2].Message.MessageId)
+
+	previews = s.m.UnfurlStatusLinks(s.m.myHexIdentity(), []string{communityMessageURL})
+	s.Require().Empty(previews)
+}
+
+func (s *MessengerShareUrlsSuite) TestResolveReceivedStatusLinkPreviews() {
+	community, _, channelID := s.createCommunityWithChannel()
+	privateMessage := s.saveMessage(s.m.myHexIdentity(), "private message")
+
+	communityURL, err := s.m.ShareCommunityURLWithChatKey(community.ID())
+	s.Require().NoError(err)
+	unknownCommunityURL := fmt.Sprintf("%s/c#unknown", baseShareURL)
+
+	message := &protobuf.ChatMessage{
+		UnfurledStatusLinks: []*protobuf.UnfurledStatusLink{
+			{Url: communityURL, Community: &protobuf.StatusCommunityLinkPreview{DisplayName: "forged"}},
+			{Url: unknownCommunityURL, Community: &protobuf.StatusCommunityLinkPreview{DisplayName: "unknown"}},
+			{Url: unknownCommunityURL, Community: &protobuf.StatusCommunityLinkPreview{Icon: make([]byte, maxStatusLinkPreviewIconSize+1)}},
+			{Url: "https://status.im", Community: &protobuf.StatusCommunityLinkPreview{DisplayName: "not a status link"}},
+			{Url: fmt.Sprintf("%s/m#%s", baseShareURL, privateMessage.ID), Message: &protobuf.StatusMessageLinkPreview{Text: "private message"}},
+		},
+	}
+	s.m.resolveReceivedStatusLinkPreviews(message, community.IDString()+channelID)
+	s.Require().Len(message.UnfurledStatusLinks, 2)
+
+	// The known community is built from the local data
+	s.Require().Equal(communityURL, message.UnfurledStatusLinks[0].Url)
+	s.Require().Equal(community.Identity().DisplayName, message.UnfurledStatusLinks[0].Community.DisplayName)
+	s.Require().False(message.UnfurledStatusLinks[0].Unverified)
+
+	// The card of the unknown community is kept as sent
+	s.Require().Equal(unknownCommunityURL, message.UnfurledStatusLinks[1].Url)
+	s.Require().Equal("unknown", message.UnfurledStatusLinks[1].Community.DisplayName)
+	s.Require().True(message.UnfurledStatusLinks[1].Unverified)
+}
+
+func (s *MessengerShareUrlsSuite) TestParseCommunityInviteURL() {
+	community, channel, channelID := s.createCommunityWithChannel()
+
+	url, err := s.m.ShareCommunityInviteURL(&requests.CommunityInviteURL{
+		CommunityID: community.ID(),
+		ChannelID:   channelID,
+	})
+	s.Require().NoError(err)
+	s.Require().True(strings.HasPrefix(url, baseShareURL+"/i/"))
+
+	urlData, err := s.m.ParseSharedURL(url)
+	s.Require().NoError(err)
+	s.Require().NotNil(urlData.Invite)
+	s.Require().True(urlData.Invite.Verified)
+	s.Require().Empty(urlData.Invite.Inviter)
+	s.Require().Equal(community.IDString(), urlData.Community.CommunityID)
+	s.Require().Equal(community.Identity().DisplayName, urlData.Community.DisplayName)
+	s.Require().Equal(channelID, urlData.Channel.ChannelUUID)
+	s.Require().Equal(channel.Identity.DisplayName, urlData.Channel.DisplayName)
+
+	// An invite signed by someone who isn't a member of the community
+	theirMessenger := s.newMessenger()
+	invite := &protobuf.CommunityInvite{
+		CommunityId: community.ID(),
+		Inviter:     crypto.CompressPubkey(&theirMessenger.identity.PublicKey),
+	}
+	data, signature, err := urls.EncodeCommunityInvite(invite, theirMessenger.identity)
+	s.Require().NoError(err)
+
+	_, err = s.m.ParseSharedURL(fmt.Sprintf("%s/i/%s#%s", baseShareURL, data, signature))
+	s.Require().Equal(urls.ErrSpoofedCommunityInvite, err)
+
+	// Someone who isn't a member can't create invites
+	_, err = theirMessenger.ShareCommunityInviteURL(&requests.CommunityInviteURL{CommunityID: community.ID()})
+	s.Require().Error(err)
+}
@@ -0,0 +1,572 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/socialrecovery"
+)
+
+var (
+	ErrSocialRecoveryNotMutualContact  = errors.New("trusted contacts must be mutual contacts")
+	ErrSocialRecoveryShareNotFound     = errors.New("social recovery share not found")
+	ErrSocialRecoveryRequestNotFound   = errors.New("social recovery request not found")
+	ErrSocialRecoveryUnexpectedRelease = errors.New("unexpected social recovery share release")
+	ErrSocialRecoveryMismatchedRelease = errors.New("social recovery share release doesn't match the ones of its set")
+)
+
+// socialRecoveryChat returns the one to one chat with the public key, it's
+// created hidden when it doesn't exist
+func (m *Messenger) socialRecoveryChat(publicKey string) (*Chat, error) {
+	chat, ok := m.allChats.Load(publicKey)
+	if !ok {
+		pk, err := common.HexToPubkey(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		chat = OneToOneFromPublicKey(pk, m.getTimesource())
+		// We don't want to show the chat to the user
+		chat.Active = false
+		m.allChats.Store(chat.ID, chat)
+	}
+	return chat, nil
+}
+
+func (m *Messenger) sendSocialRecoveryMessage(ctx context.Context, publicKey string, message func(clock uint64) proto.Message, messageType protobuf.ApplicationMetadataMessage_Type) (uint64, error) {
+	chat, err := m.socialRecoveryChat(publicKey)
+	if err != nil {
+		return 0, err
+	}
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+
+	encodedMessage, err := proto.Marshal(message(clock))
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         messageType,
+		ResendAutomatically: true,
+	})
+	return clock, err
+}
+
+func socialRecoveryNotificationID(kind string, ids ...string) types.HexBytes {
+	data := []byte(kind)
+	for _, id := range ids {
+		data = append(data, []byte(id)...)
+	}
+	return types.HexBytes(crypto.Keccak256(data))
+}
+
+// SetupSocialRecovery encrypts the recovery payload and splits its key among
+// trusted contacts, each of them has to accept their share. The contacts of
+// a previous setup which aren't trusted anymore are told to drop theirs
+func (m *Messenger) SetupSocialRecovery(ctx context.Context, request *requests.SetupSocialRecovery) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	for _, contactID := range request.ContactIDs {
+		contact, ok := m.allContacts.Load(contactID)
+		if !ok || !contact.mutual() {
+			return nil, ErrSocialRecoveryNotMutualContact
+		}
+	}
+
+	sealed, key, err := socialrecovery.Seal([]byte(request.Payload))
+	if err != nil {
+		return nil, err
+	}
+	shares, err := socialrecovery.Split(key, len(request.ContactIDs), int(request.Threshold))
+	if err != nil {
+		return nil, err
+	}
+	setIDBytes := make([]byte, 16)
+	if _, err := rand.Read(setIDBytes); err != nil {
+		return nil, err
+	}
+	setID := types.EncodeHex(setIDBytes)
+
+	previousTrustees, err := m.socialRecoveryDatabase.Trustees()
+	if err != nil {
+		return nil, err
+	}
+
+	total := uint32(len(request.ContactIDs))
+	trustees := make([]*socialrecovery.Trustee, 0, len(request.ContactIDs))
+	for i, contactID := range request.ContactIDs {
+		share := shares[i]
+		// The device recovering the account checks the signature, so that
+		// nobody but the owner can hand out shares it would combine
+		signature, err := socialrecovery.SignShare(m.identity, setID, request.Threshold, total, sealed, share)
+		if err != nil {
+			return nil, err
+		}
+		clock, err := m.sendSocialRecoveryMessage(ctx, contactID, func(clock uint64) proto.Message {
+			return &protobuf.SocialRecoveryShare{
+				Clock:     clock,
+				SetId:     setID,
+				Share:     share,
+				Payload:   sealed,
+				Threshold: request.Threshold,
+				Total:     total,
+				Signature: signature,
+			}
+		}, protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE)
+		if err != nil {
+			return nil, err
+		}
+		trustees = append(trustees, &socialrecovery.Trustee{
+			SetID:     setID,
+			ContactID: contactID,
+			State:     socialrecovery.StatePending,
+			Clock:     clock,
+		})
+	}
+
+	trusted := make(map[string]bool, len(request.ContactIDs))
+	for _, contactID := range request.ContactIDs {
+		trusted[contactID] = true
+	}
+	for _, trustee := range previousTrustees {
+		if trusted[trustee.ContactID] {
+			continue
+		}
+		_, err := m.sendSocialRecoveryMessage(ctx, trustee.ContactID, func(clock uint64) proto.Message {
+			return &protobuf.SocialRecoveryShare{Clock: clock, SetId: trustee.SetID}
+		}, protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE)
+		if err != nil {
+			m.logger.Warn("failed to revoke social recovery share", zap.String("contactID", trustee.ContactID), zap.Error(err))
+		}
+	}
+
+	err = m.socialRecoveryDatabase.SaveTrustees(trustees)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.SocialRecoveryTrustees = trustees
+	return response, nil
+}
+
+func (m *Messenger) SocialRecoveryTrustees() ([]*socialrecovery.Trustee, error) {
+	return m.socialRecoveryDatabase.Trustees()
+}
+
+// SocialRecoveryShares returns the shares held for contacts
+func (m *Messenger) SocialRecoveryShares() ([]*socialrecovery.Share, error) {
+	return m.socialRecoveryDatabase.Shares()
+}
+
+// SocialRecoveryRequests returns the requests to release shares which
+// weren't answered yet
+func (m *Messenger) SocialRecoveryRequests() ([]*socialrecovery.Request, error) {
+	return m.socialRecoveryDatabase.PendingRequests()
+}
+
+func (m *Messenger) answerSocialRecoveryShare(ctx context.Context, ownerID string, accepted bool) (*MessengerResponse, error) {
+	share, err := m.socialRecoveryDatabase.Share(ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if share == nil || share.State != socialrecovery.StatePending {
+		return nil, ErrSocialRecoveryShareNotFound
+	}
+
+	_, err = m.sendSocialRecoveryMessage(ctx, ownerID, func(clock uint64) proto.Message {
+		return &protobuf.SocialRecoveryShareResponse{Clock: clock, SetId: share.SetID, Accepted: accepted}
+	}, protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE_RESPONSE)
+	if err != nil {
+		return nil, err
+	}
+
+	if accepted {
+		share.State = socialrecovery.StateAccepted
+		err = m.socialRecoveryDatabase.SaveShare(share)
+	} else {
+		share.State = socialrecovery.StateDeclined
+		err = m.socialRecoveryDatabase.DeleteShare(ownerID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.SocialRecoveryShares = append(response.SocialRecoveryShares, share)
+	err = m.answerSocialRecoveryNotification(response, socialRecoveryNotificationID("share", ownerID, share.SetID), accepted)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (m *Messenger) answerSocialRecoveryNotification(response *MessengerResponse, id types.HexBytes, accepted bool) error {
+	ids := []types.HexBytes{id}
+	updatedAt := m.getCurrentTimeInMillis()
+	if !accepted {
+		return m.persistence.DismissActivityCenterNotifications(ids, updatedAt)
+	}
+	notifications, err := m.persistence.AcceptActivityCenterNotifications(ids, updatedAt)
+	if err != nil {
+		return err
+	}
+	response.AddActivityCenterNotifications(notifications)
+	return nil
+}
+
+// AcceptSocialRecoveryShare keeps the share of the recovery key of a contact
+// until they ask for it from a new device
+func (m *Messenger) AcceptSocialRecoveryShare(ctx context.Context, ownerID string) (*MessengerResponse, error) {
+	return m.answerSocialRecoveryShare(ctx, ownerID, true)
+}
+
+// DeclineSocialRecoveryShare drops the share of the recovery key of a contact
+func (m *Messenger) DeclineSocialRecoveryShare(ctx context.Context, ownerID string) (*MessengerResponse, error) {
+	return m.answerSocialRecoveryShare(ctx, ownerID, false)
+}
+
+// RequestSocialRecovery asks the trusted contacts of an account to release
+// their shares to this device, they have to approve the request
+func (m *Messenger) RequestSocialRecovery(ctx context.Context, request *requests.RequestSocialRecovery) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := common.HexToPubkey(request.OwnerPublicKey); err != nil {
+		return nil, requests.ErrRequestSocialRecoveryInvalidOwner
+	}
+
+	// The contacts are added to the ones of the previous requests, the
+	// recovery is started over with ResetSocialRecovery
+	err := m.socialRecoveryDatabase.SaveRecovery(request.OwnerPublicKey, request.SetID, request.ContactIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, contactID := range request.ContactIDs {
+		_, err := m.sendSocialRecoveryMessage(ctx, contactID, func(clock uint64) proto.Message {
+			return &protobuf.SocialRecoveryRequest{Clock: clock, OwnerPublicKey: request.OwnerPublicKey}
+		}, protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_REQUEST)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	progress, err := m.SocialRecoveryProgress(request.OwnerPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	response := &MessengerResponse{}
+	response.SocialRecoveryProgress = append(response.SocialRecoveryProgress, progress)
+	return response, nil
+}
+
+func (m *Messenger) answerSocialRecoveryRequest(ctx context.Context, requesterID string, ownerID string, approved bool) (*MessengerResponse, error) {
+	request, err := m.socialRecoveryDatabase.Request(requesterID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil || request.State != socialrecovery.StatePending {
+		return nil, ErrSocialRecoveryRequestNotFound
+	}
+
+	if approved {
+		share, err := m.socialRecoveryDatabase.Share(ownerID)
+		if err != nil {
+			return nil, err
+		}
+		if share == nil || share.State != socialrecovery.StateAccepted {
+			return nil, ErrSocialRecoveryShareNotFound
+		}
+
+		_, err = m.sendSocialRecoveryMessage(ctx, requesterID, func(clock uint64) proto.Message {
+			return &protobuf.SocialRecoveryShareRelease{
+				Clock:          clock,
+				OwnerPublicKey: ownerID,
+				SetId:          share.SetID,
+				Share:          share.Share,
+				Payload:        share.Payload,
+				Threshold:      share.Threshold,
+				Total:          share.Total,
+				Signature:      share.Signature,
+			}
+		}, protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE_RELEASE)
+		if err != nil {
+			return nil, err
+		}
+		request.State = socialrecovery.StateAccepted
+	} else {
+		request.State = socialrecovery.StateDeclined
+	}
+
+	err = m.socialRecoveryDatabase.SaveRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.SocialRecoveryRequests = append(response.SocialRecoveryRequests, request)
+	err = m.answerSocialRecoveryNotification(response, socialRecoveryNotificationID("request", requesterID, ownerID), approved)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ApproveSocialRecoveryRequest releases the share of the owner to the new
+// device of the requester. It should only be approved once the user made sure
+// the requester is the owner
+func (m *Messenger) ApproveSocialRecoveryRequest(ctx context.Context, requesterID string, ownerID string) (*MessengerResponse, error) {
+	return m.answerSocialRecoveryRequest(ctx, requesterID, ownerID, true)
+}
+
+func (m *Messenger) DeclineSocialRecoveryRequest(ctx context.Context, requesterID string, ownerID string) (*MessengerResponse, error) {
+	return m.answerSocialRecoveryRequest(ctx, requesterID, ownerID, false)
+}
+
+// ResetSocialRecovery drops the recovery of the owner started from this device
+// and the shares released to it, so that a contact releasing the share of a
+// stale set can't block the recovery. It's then requested again
+func (m *Messenger) ResetSocialRecovery(ownerID string) error {
+	return m.socialRecoveryDatabase.ResetRecovery(ownerID)
+}
+
+// SocialRecoveryProgress returns how many shares of the owner were released
+// to this device, out of the ones needed
+func (m *Messenger) SocialRecoveryProgress(ownerID string) (*socialrecovery.Progress, error) {
+	shares, err := m.socialRecoveryDatabase.ReleasedShares(ownerID)
+	if err != nil {
+		return nil, err
+	}
+	return socialrecovery.RecoveryProgress(ownerID, shares), nil
+}
+
+// RecoverSocialRecoveryPayload returns the recovery payload of the owner once
+// enough of their trusted contacts released their shares to this device
+func (m *Messenger) RecoverSocialRecoveryPayload(ownerID string) (string, error) {
+	shares, err := m.socialRecoveryDatabase.ReleasedShares(ownerID)
+	if err != nil {
+		return "", err
+	}
+	payload, err := socialrecovery.Recover(shares)
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+func (m *Messenger) HandleSocialRecoveryShare(state *ReceivedMessageState, message protobuf.SocialRecoveryShare) error {
+	contact := state.CurrentMessageState.Contact
+	if contact.ID == m.myHexIdentity() {
+		return nil
+	}
+
+	existing, err := m.socialRecoveryDatabase.Share(contact.ID)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.Clock >= message.Clock {
+		return nil
+	}
+
+	// An empty share revokes the one held for the contact
+	if len(message.Share) == 0 {
+		if existing == nil || existing.SetID != message.SetId {
+			return nil
+		}
+		return m.socialRecoveryDatabase.DeleteShare(contact.ID)
+	}
+
+	if !contact.mutual() {
+		return ErrSocialRecoveryNotMutualContact
+	}
+	if message.SetId == "" || len(message.Payload) == 0 || message.Threshold < 2 || message.Total < message.Threshold {
+		return errors.New("invalid social recovery share")
+	}
+	// A share the owner didn't sign could never be released
+	owner, err := contact.PublicKey()
+	if err != nil {
+		return err
+	}
+	err = socialrecovery.VerifyShare(owner, message.Signature, message.SetId, message.Threshold, message.Total, message.Payload, message.Share)
+	if err != nil {
+		return err
+	}
+
+	share := &socialrecovery.Share{
+		OwnerID:   contact.ID,
+		SetID:     message.SetId,
+		Share:     message.Share,
+		Payload:   message.Payload,
+		Threshold: message.Threshold,
+		Total:     message.Total,
+		Signature: message.Signature,
+		State:     socialrecovery.StatePending,
+		Clock:     message.Clock,
+	}
+	err = m.socialRecoveryDatabase.SaveShare(share)
+	if err != nil {
+		return err
+	}
+	state.Response.SocialRecoveryShares = append(state.Response.SocialRecoveryShares, share)
+
+	notification := &ActivityCenterNotification{
+		ID:        socialRecoveryNotificationID("share", contact.ID, share.SetID),
+		Type:      ActivityCenterNotificationTypeSocialRecoveryShare,
+		Name:      contact.PrimaryName(),
+		Author:    contact.ID,
+		Timestamp: m.getTimesource().GetCurrentTime(),
+		ChatID:    contact.ID,
+		UpdatedAt: m.getCurrentTimeInMillis(),
+	}
+	return m.addActivityCenterNotification(state.Response, notification)
+}
+
+func (m *Messenger) HandleSocialRecoveryShareResponse(state *ReceivedMessageState, message protobuf.SocialRecoveryShareResponse) error {
+	contactID := state.CurrentMessageState.Contact.ID
+	answer := socialrecovery.StateDeclined
+	if message.Accepted {
+		answer = socialrecovery.StateAccepted
+	}
+
+	updated, err := m.socialRecoveryDatabase.UpdateTrusteeState(message.SetId, contactID, answer, message.Clock)
+	if err != nil || !updated {
+		return err
+	}
+
+	state.Response.SocialRecoveryTrustees = append(state.Response.SocialRecoveryTrustees, &socialrecovery.Trustee{
+		SetID:     message.SetId,
+		ContactID: contactID,
+		State:     answer,
+		Clock:     message.Clock,
+	})
+	return nil
+}
+
+func (m *Messenger) HandleSocialRecoveryRequest(state *ReceivedMessageState, message protobuf.SocialRecoveryRequest) error {
+	requesterID := state.CurrentMessageState.Contact.ID
+	if requesterID == m.myHexIdentity() {
+		return nil
+	}
+
+	// Only the requests for accepted shares are shown
+	share, err := m.socialRecoveryDatabase.Share(message.OwnerPublicKey)
+	if err != nil || share == nil || share.State != socialrecovery.StateAccepted {
+		return err
+	}
+
+	existing, err := m.socialRecoveryDatabase.Request(requesterID, message.OwnerPublicKey)
+	if err != nil {
+		return err
+	}
+	if existing != nil && (existing.State != socialrecovery.StatePending || existing.Clock >= message.Clock) {
+		return nil
+	}
+
+	request := &socialrecovery.Request{
+		RequesterID: requesterID,
+		OwnerID:     message.OwnerPublicKey,
+		State:       socialrecovery.StatePending,
+		Clock:       message.Clock,
+	}
+	err = m.socialRecoveryDatabase.SaveRequest(request)
+	if err != nil {
+		return err
+	}
+	state.Response.SocialRecoveryRequests = append(state.Response.SocialRecoveryRequests, request)
+
+	name := message.OwnerPublicKey
+	if owner, ok := m.allContacts.Load(message.OwnerPublicKey); ok {
+		name = owner.PrimaryName()
+	}
+	notification := &ActivityCenterNotification{
+		ID:        socialRecoveryNotificationID("request", requesterID, message.OwnerPublicKey),
+		Type:      ActivityCenterNotificationTypeSocialRecoveryRequest,
+		Name:      name,
+		Author:    requesterID,
+		Timestamp: m.getTimesource().GetCurrentTime(),
+		ChatID:    message.OwnerPublicKey,
+		UpdatedAt: m.getCurrentTimeInMillis(),
+	}
+	return m.addActivityCenterNotification(state.Response, notification)
+}
+
+func (m *Messenger) HandleSocialRecoveryShareRelease(state *ReceivedMessageState, message protobuf.SocialRecoveryShareRelease) error {
+	if len(message.Share) == 0 || len(message.Payload) == 0 || message.SetId == "" || message.Threshold < 2 || message.Total < message.Threshold {
+		return errors.New("invalid social recovery share release")
+	}
+	owner, err := common.HexToPubkey(message.OwnerPublicKey)
+	if err != nil {
+		return err
+	}
+	err = socialrecovery.VerifyShare(owner, message.Signature, message.SetId, message.Threshold, message.Total, message.Payload, message.Share)
+	if err != nil {
+		return err
+	}
+
+	// Only the contacts asked by this device can release a share, of a single
+	// set, so that nobody else can prevent the recovery
+	contactID := state.CurrentMessageState.Contact.ID
+	recovery, err := m.socialRecoveryDatabase.Recovery(message.OwnerPublicKey)
+	if err != nil {
+		return err
+	}
+	if recovery == nil || !stringSliceContains(recovery.ContactIDs, contactID) {
+		return ErrSocialRecoveryUnexpectedRelease
+	}
+	released, err := m.socialRecoveryDatabase.ReleasedShares(message.OwnerPublicKey)
+	if err != nil {
+		return err
+	}
+	for _, share := range released {
+		if share.SetID == message.SetId && (share.Threshold != message.Threshold || share.Total != message.Total || !bytes.Equal(share.Payload, message.Payload)) {
+			return ErrSocialRecoveryMismatchedRelease
+		}
+	}
+	if recovery.SetID != message.SetId {
+		if recovery.SetID != "" {
+			return ErrSocialRecoveryUnexpectedRelease
+		}
+		set, err := m.socialRecoveryDatabase.SetRecoverySet(message.OwnerPublicKey, message.SetId)
+		if err != nil {
+			return err
+		}
+		if !set {
+			return ErrSocialRecoveryUnexpectedRelease
+		}
+	}
+
+	err = m.socialRecoveryDatabase.SaveReleasedShare(&socialrecovery.ReleasedShare{
+		OwnerID:   message.OwnerPublicKey,
+		ContactID: contactID,
+		SetID:     message.SetId,
+		Share:     message.Share,
+		Payload:   message.Payload,
+		Threshold: message.Threshold,
+		Total:     message.Total,
+		Signature: message.Signature,
+		Clock:     message.Clock,
+	})
+	if err != nil {
+		return err
+	}
+
+	progress, err := m.SocialRecoveryProgress(message.OwnerPublicKey)
+	if err != nil {
+		return err
+	}
+	state.Response.SocialRecoveryProgress = append(state.Response.SocialRecoveryProgress, progress)
+	return nil
+}
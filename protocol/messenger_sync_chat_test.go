@@ -218,3 +218,69 @@ func (s *MessengerSyncChatSuite) TestMarkChatMessagesRead() {
 	s.Require().Equal(receivedChat.ID, chatID)
 	s.Require().Equal(receivedChat.UnviewedMessagesCount, uint(0))
 }
+
+func (s *MessengerSyncChatSuite) TestSyncChatReadState() {
+	s.Pair()
+	chatID := "foobarsyncreadstate"
+	_, err := s.alice1.createPublicChat(chatID, &MessengerResponse{})
+	s.Require().NoError(err)
+
+	_, err = s.alice2.createPublicChat(chatID, &MessengerResponse{})
+	s.Require().NoError(err)
+
+	otherMessenger := s.otherNewMessenger()
+	_, err = otherMessenger.createPublicChat(chatID, &MessengerResponse{})
+	s.Require().NoError(err)
+
+	chat := otherMessenger.Chat(chatID)
+	message := buildTestMessage(*chat)
+
+	sendResponse, err := otherMessenger.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+	messageID := sendResponse.Messages()[0].ID
+
+	for _, messenger := range []*Messenger{s.alice1, s.alice2} {
+		_, err = WaitOnMessengerResponse(
+			messenger,
+			func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+			"message not received",
+		)
+		s.Require().NoError(err)
+	}
+
+	count, _, err := s.alice1.MarkMessagesSeen(chatID, []string{messageID})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), count)
+
+	// The queued read state is sent at once and the queue emptied
+	s.Require().NoError(s.alice1.syncChatReadStates(context.Background()))
+	s.Require().Empty(s.alice1.readStateQueue.flush())
+
+	response, err := WaitOnMessengerResponse(
+		s.alice2,
+		func(r *MessengerResponse) bool { return len(r.Chats()) > 0 },
+		"read state not received",
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(chatID, response.Chats()[0].ID)
+	s.Require().Equal(uint(0), s.alice2.Chat(chatID).UnviewedMessagesCount)
+}
+
+func (s *MessengerSyncChatSuite) TestReadStateQueueRemove() {
+	queue := &readStateQueue{}
+	queue.add("chat-1", 1, []string{"message-1"})
+	queue.add("chat-2", 1, []string{"message-2"})
+
+	pending := queue.pending()
+	s.Require().Len(pending, 2)
+
+	// Messages seen while the read states are being synced stay queued
+	queue.add("chat-1", 2, []string{"message-3"})
+	queue.remove(pending)
+
+	remaining := queue.flush()
+	s.Require().Len(remaining, 1)
+	s.Require().Equal("chat-1", remaining[0].ChatId)
+	s.Require().Equal(uint64(2), remaining[0].Clock)
+	s.Require().Equal([]string{"message-3"}, remaining[0].MessageIds)
+}
@@ -128,6 +128,10 @@ func (m *Messenger) startSyncSettingsLoop() {
 		for {
 			select {
 			case s := <-m.settings.SyncQueue:
+				if s.GetReactName() == settings.NotificationKeywords.GetReactName() {
+					m.notificationKeywords.reset()
+				}
+
 				if s.CanSync(settings.FromInterface) {
 					logger.Debug("setting for sync received from settings.SyncQueue")
 
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"math/big"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -21,12 +22,14 @@ import (
 	"github.com/status-im/status-go/eth-node/types"
 	enstypes "github.com/status-im/status-go/eth-node/types/ens"
 	"github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/multiaccounts/settings"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/requests"
 	"github.com/status-im/status-go/protocol/tt"
 	v1protocol "github.com/status-im/status-go/protocol/v1"
 	"github.com/status-im/status-go/server"
+	"github.com/status-im/status-go/telemetry"
 )
 
 const (
@@ -286,6 +289,39 @@ func (s *MessengerSuite) TestMarkMessagesSeen() {
 	}
 }
 
+func (s *MessengerSuite) TestSearchMessages() {
+	chat := CreatePublicChat("test-chat", s.m.transport)
+	err := s.m.SaveChat(chat)
+	s.Require().NoError(err)
+
+	var messages []*common.Message
+	for i, text := range []string{"status rocks", "status status status", "unrelated", "statuses everywhere"} {
+		message := buildTestMessage(*chat)
+		message.ID = strconv.Itoa(i)
+		message.Text = text
+		messages = append(messages, message)
+	}
+	err = s.m.SaveMessages(messages)
+	s.Require().NoError(err)
+
+	response, err := s.m.SearchMessages(&requests.SearchMessages{Query: "status", Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Len(response.Results, 2)
+	s.Require().NotEmpty(response.Cursor)
+	s.Require().Equal("1", response.Results[0].Message.ID)
+	s.Require().Len(response.Results[0].Highlights, 3)
+	s.Require().GreaterOrEqual(response.Results[0].Rank, response.Results[1].Rank)
+
+	response, err = s.m.SearchMessages(&requests.SearchMessages{Query: "status", Limit: 2, Cursor: response.Cursor})
+	s.Require().NoError(err)
+	s.Require().Len(response.Results, 1)
+	s.Require().Empty(response.Cursor)
+
+	response, err = s.m.SearchMessages(&requests.SearchMessages{Query: "status", ChatIDs: []string{"other-chat"}, Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Empty(response.Results)
+}
+
 func (s *MessengerSuite) TestMarkAllRead() {
 	chat := CreatePublicChat("test-chat", s.m.transport)
 	chat.UnviewedMessagesCount = 2
@@ -2211,72 +2247,306 @@ func (s *MessengerSuite) TestLastSentField() {
 }
 
 func (s *MessengerSuite) TestShouldResendEmoji() {
+	chat := CreatePublicChat("test-chat", s.m.transport)
+	err := s.m.SaveChat(chat)
+	s.Require().NoError(err)
+
+	// shouldResendMessage queues the message in the outbox as after sending
+	// it, and tells whether it's due to be sent again
+	shouldResendMessage := func(message *common.RawMessage) bool {
+		message.ID = "emoji-" + strconv.Itoa(message.SendCount) + "-" + strconv.FormatUint(message.LastSent, 10)
+		message.LocalChatID = chat.ID
+		err := s.m.persistence.SaveRawMessage(message)
+		s.Require().NoError(err)
+		err = s.m.queueInOutbox(message)
+		s.Require().NoError(err)
+
+		now := s.m.getTimesource().GetCurrentTime()
+		outboxMessage, err := s.m.persistence.OutboxMessageByID(message.ID)
+		s.Require().NoError(err)
+		if outboxMessage.NextAttempt > now {
+			return false
+		}
+		err = s.m.processOutboxMessage(outboxMessage, now)
+		s.Require().NoError(err)
+		return outboxMessage.Status != OutboxMessageFailed
+	}
+
 	// shouldn't try to resend non-emoji messages.
-	ok, err := shouldResendMessage(&common.RawMessage{
+	ok := shouldQueueInOutbox(chat, &common.RawMessage{
 		MessageType: protobuf.ApplicationMetadataMessage_CONTACT_UPDATE,
 		Sent:        false,
 		SendCount:   2,
-	}, s.m.getTimesource())
-	s.Error(err)
+	})
 	s.False(ok)
 
 	// shouldn't try to resend already sent message
-	ok, err = shouldResendMessage(&common.RawMessage{
+	ok = shouldQueueInOutbox(chat, &common.RawMessage{
 		MessageType: protobuf.ApplicationMetadataMessage_EMOJI_REACTION,
 		Sent:        true,
 		SendCount:   1,
-	}, s.m.getTimesource())
-	s.Error(err)
+	})
 	s.False(ok)
 
 	// messages that already sent to many times shouldn't be resend
-	ok, err = shouldResendMessage(&common.RawMessage{
+	ok = shouldResendMessage(&common.RawMessage{
 		MessageType: protobuf.ApplicationMetadataMessage_EMOJI_REACTION,
 		Sent:        false,
 		SendCount:   messageResendMaxCount + 1,
-	}, s.m.getTimesource())
-	s.NoError(err)
+	})
 	s.False(ok)
 
 	// message sent one time CAN'T be resend in 15 seconds (only after 30)
-	ok, err = shouldResendMessage(&common.RawMessage{
+	ok = shouldResendMessage(&common.RawMessage{
 		MessageType: protobuf.ApplicationMetadataMessage_EMOJI_REACTION,
 		Sent:        false,
 		SendCount:   1,
 		LastSent:    s.m.getTimesource().GetCurrentTime() - 15*uint64(time.Second.Milliseconds()),
-	}, s.m.getTimesource())
-	s.NoError(err)
+	})
 	s.False(ok)
 
 	// message sent one time CAN be resend in 35 seconds
-	ok, err = shouldResendMessage(&common.RawMessage{
+	ok = shouldResendMessage(&common.RawMessage{
 		MessageType: protobuf.ApplicationMetadataMessage_EMOJI_REACTION,
 		Sent:        false,
 		SendCount:   1,
 		LastSent:    s.m.getTimesource().GetCurrentTime() - 35*uint64(time.Second.Milliseconds()),
-	}, s.m.getTimesource())
-	s.NoError(err)
+	})
 	s.True(ok)
 
 	// message sent three times CAN'T be resend in 100 seconds (only after 120)
-	ok, err = shouldResendMessage(&common.RawMessage{
+	ok = shouldResendMessage(&common.RawMessage{
 		MessageType: protobuf.ApplicationMetadataMessage_EMOJI_REACTION,
 		Sent:        false,
 		SendCount:   3,
 		LastSent:    s.m.getTimesource().GetCurrentTime() - 100*uint64(time.Second.Milliseconds()),
-	}, s.m.getTimesource())
-	s.NoError(err)
+	})
 	s.False(ok)
 
 	// message sent tow times CAN be resend in 65 seconds
-	ok, err = shouldResendMessage(&common.RawMessage{
+	ok = shouldResendMessage(&common.RawMessage{
 		MessageType: protobuf.ApplicationMetadataMessage_EMOJI_REACTION,
 		Sent:        false,
 		SendCount:   3,
 		LastSent:    s.m.getTimesource().GetCurrentTime() - 125*uint64(time.Second.Milliseconds()),
-	}, s.m.getTimesource())
-	s.NoError(err)
+	})
 	s.True(ok)
+
+	// delay is capped
+	s.Equal(defaultOutboxRetryPolicy.MaxDelay, defaultOutboxRetryPolicy.Delay(20))
+}
+
+func (s *MessengerSuite) TestOutbox() {
+	chat := CreatePublicChat("test-chat", s.m.transport)
+	err := s.m.SaveChat(chat)
+	s.Require().NoError(err)
+
+	inputMessage := buildTestMessage(*chat)
+	response, err := s.m.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+	messageID := response.Messages()[0].ID
+
+	outboxMessages, err := s.m.OutboxMessages()
+	s.Require().NoError(err)
+	s.Require().Len(outboxMessages, 1)
+	s.Require().Equal(messageID, outboxMessages[0].ID)
+	s.Require().Equal(OutboxMessageSending, outboxMessages[0].Status)
+	s.Require().Equal(1, outboxMessages[0].Attempts)
+
+	// Not due yet
+	err = s.m.resendExpiredMessages()
+	s.Require().NoError(err)
+	outboxMessage, err := s.m.persistence.OutboxMessageByID(messageID)
+	s.Require().NoError(err)
+	s.Require().Equal(1, outboxMessage.Attempts)
+
+	// Resent once due, then failed after the last attempt
+	policy := OutboxRetryPolicy{MaxAttempts: 2}
+	s.m.config.outboxRetryPolicy = &policy
+	outboxMessage.NextAttempt = 0
+	err = s.m.persistence.SaveOutboxMessage(outboxMessage)
+	s.Require().NoError(err)
+
+	err = s.m.resendExpiredMessages()
+	s.Require().NoError(err)
+	outboxMessage, err = s.m.persistence.OutboxMessageByID(messageID)
+	s.Require().NoError(err)
+	s.Require().Equal(OutboxMessageSending, outboxMessage.Status)
+	s.Require().Equal(2, outboxMessage.Attempts)
+
+	rawMessage, err := s.m.persistence.RawMessageByID(messageID)
+	s.Require().NoError(err)
+	s.Require().Equal(2, rawMessage.SendCount)
+
+	err = s.m.resendExpiredMessages()
+	s.Require().NoError(err)
+	outboxMessage, err = s.m.persistence.OutboxMessageByID(messageID)
+	s.Require().NoError(err)
+	s.Require().Equal(OutboxMessageFailed, outboxMessage.Status)
+
+	err = s.m.CancelOutboxMessage(messageID)
+	s.Require().NoError(err)
+	err = s.m.CancelOutboxMessage(messageID)
+	s.Require().ErrorIs(err, ErrOutboxMessageNotFound)
+
+	// Sent messages can't be cancelled
+	inputMessage = buildTestMessage(*chat)
+	inputMessage.Text = "another message"
+	response, err = s.m.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+	messageID = response.Messages()[0].ID
+
+	err = s.m.processSentMessages([]string{messageID})
+	s.Require().NoError(err)
+
+	outboxMessage, err = s.m.persistence.OutboxMessageByID(messageID)
+	s.Require().NoError(err)
+	s.Require().Equal(OutboxMessageSent, outboxMessage.Status)
+
+	err = s.m.CancelOutboxMessage(messageID)
+	s.Require().ErrorIs(err, ErrOutboxMessageAlreadySent)
+}
+
+func (s *MessengerSuite) TestLatencyTelemetry() {
+	chat := CreatePublicChat("test-chat", s.m.transport)
+	err := s.m.SaveChat(chat)
+	s.Require().NoError(err)
+
+	// Disabled by default
+	response, err := s.m.SendChatMessage(context.Background(), buildTestMessage(*chat))
+	s.Require().NoError(err)
+	err = s.m.processSentMessages([]string{response.Messages()[0].ID})
+	s.Require().NoError(err)
+	s.Require().Empty(s.m.LatencyTelemetry())
+
+	err = s.m.ToggleLatencyTelemetry(true)
+	s.Require().NoError(err)
+	enabled, err := s.m.settings.LatencyTelemetryEnabled()
+	s.Require().NoError(err)
+	s.Require().True(enabled)
+
+	inputMessage := buildTestMessage(*chat)
+	inputMessage.Text = "another message"
+	response, err = s.m.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+	err = s.m.processSentMessages([]string{response.Messages()[0].ID})
+	s.Require().NoError(err)
+
+	histograms := s.m.LatencyTelemetry()
+	s.Require().Len(histograms, 1)
+	s.Require().Equal(uint64(1), histograms[telemetry.LatencySendToSent].Count)
+
+	err = s.m.ToggleLatencyTelemetry(false)
+	s.Require().NoError(err)
+	s.Require().Empty(s.m.LatencyTelemetry())
+}
+
+func (s *MessengerSuite) TestMessageRetention() {
+	chat := CreatePublicChat("test-chat", s.m.transport)
+	err := s.m.SaveChat(chat)
+	s.Require().NoError(err)
+
+	now := time.Now()
+	oldMessage := buildTestMessage(*chat)
+	oldMessage.ID = "old-message"
+	oldMessage.WhisperTimestamp = uint64(now.Add(-48 * time.Hour).UnixMilli())
+	newMessage := buildTestMessage(*chat)
+	newMessage.ID = "new-message"
+	newMessage.WhisperTimestamp = uint64(now.UnixMilli())
+	pinnedMessage := buildTestMessage(*chat)
+	pinnedMessage.ID = "pinned-message"
+	pinnedMessage.WhisperTimestamp = oldMessage.WhisperTimestamp
+	oldMessage.Seen = false
+	newMessage.Seen = true
+	pinnedMessage.Seen = true
+	err = s.m.persistence.SaveMessages([]*common.Message{oldMessage, newMessage, pinnedMessage})
+	s.Require().NoError(err)
+
+	chat.UnviewedMessagesCount = 1
+	err = s.m.SaveChat(chat)
+	s.Require().NoError(err)
+
+	err = s.m.persistence.SaveMessageEditRevision(&MessageEditRevision{
+		MessageID:   oldMessage.ID,
+		Clock:       1,
+		ReplacedAt:  oldMessage.WhisperTimestamp,
+		Text:        "old text",
+		ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+	}, 10)
+	s.Require().NoError(err)
+
+	pinMessage := &common.PinMessage{ID: "pin", LocalChatID: chat.ID}
+	pinMessage.MessageId = pinnedMessage.ID
+	pinMessage.ChatId = chat.ID
+	pinMessage.Clock = 1
+	pinMessage.Pinned = true
+	err = s.m.persistence.SavePinMessages([]*common.PinMessage{pinMessage})
+	s.Require().NoError(err)
+
+	err = s.m.persistence.SaveEmojiReaction(&EmojiReaction{
+		EmojiReaction: protobuf.EmojiReaction{
+			Clock:     1,
+			MessageId: oldMessage.ID,
+			ChatId:    chat.ID,
+			Type:      protobuf.EmojiReaction_LOVE,
+		},
+		LocalChatID: chat.ID,
+		From:        "reaction-author",
+	})
+	s.Require().NoError(err)
+
+	_, err = s.m.persistence.SaveActivityCenterNotification(&ActivityCenterNotification{
+		ID:        types.HexBytes("old-notification"),
+		Type:      ActivityCenterNotificationTypeMention,
+		ChatID:    chat.ID,
+		Timestamp: oldMessage.WhisperTimestamp,
+	}, true)
+	s.Require().NoError(err)
+
+	// Messages are kept forever by default
+	result, err := s.m.PruneMessages()
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), result.Messages)
+
+	err = s.m.SetMessageRetentionPolicy(MessageRetentionPolicy{ChatType: ChatType(0), MaxAge: 1})
+	s.Require().ErrorIs(err, ErrInvalidMessageRetentionPolicy)
+
+	err = s.m.SetMessageRetentionPolicy(MessageRetentionPolicy{ChatType: ChatTypePublic, MaxAge: 24 * 60 * 60})
+	s.Require().NoError(err)
+	policies, err := s.m.MessageRetentionPolicies()
+	s.Require().NoError(err)
+	s.Require().Len(policies, 1)
+
+	result, err = s.m.PruneMessages()
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), result.Messages)
+	s.Require().Equal(int64(1), result.ActivityCenterNotifications)
+
+	_, err = s.m.persistence.MessageByID(oldMessage.ID)
+	s.Require().Error(err)
+	_, err = s.m.persistence.MessageByID(newMessage.ID)
+	s.Require().NoError(err)
+	_, err = s.m.persistence.MessageByID(pinnedMessage.ID)
+	s.Require().NoError(err)
+
+	reactions, err := s.m.persistence.EmojiReactionsByChatIDMessageID(chat.ID, oldMessage.ID)
+	s.Require().NoError(err)
+	s.Require().Empty(reactions)
+
+	revisions, err := s.m.persistence.MessageEditHistory(oldMessage.ID)
+	s.Require().NoError(err)
+	s.Require().Empty(revisions)
+
+	prunedChat, err := s.m.persistence.Chat(chat.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint(0), prunedChat.UnviewedMessagesCount)
+	s.Require().NotNil(prunedChat.LastMessage)
+
+	err = s.m.SetMessageRetentionPolicy(MessageRetentionPolicy{ChatType: ChatTypePublic})
+	s.Require().NoError(err)
+	policies, err = s.m.MessageRetentionPolicies()
+	s.Require().NoError(err)
+	s.Require().Empty(policies)
 }
 
 func (s *MessengerSuite) TestSendMessageWithPreviews() {
@@ -2329,6 +2599,90 @@ func (s *MessengerSuite) TestSendMessageWithPreviews() {
 	)
 }
 
+func (s *MessengerSuite) TestLinkPreviewsPrivacyMode() {
+	err := s.m.SetLinkPreviewsMode(settings.LinkPreviewsModePrivacy)
+	s.Require().NoError(err)
+
+	_, err = s.m.UnfurlURLs([]string{"https://github.com"})
+	s.Require().Equal(ErrLinkPreviewsPrivacyMode, err)
+
+	// Outgoing messages are not unfurled
+	chat := CreatePublicChat("test-chat", s.m.transport)
+	err = s.m.SaveChat(chat)
+	s.Require().NoError(err)
+	inputMsg := buildTestMessage(*chat)
+	inputMsg.Text = "https://github.com"
+
+	response, err := s.m.SendChatMessage(context.Background(), inputMsg)
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages(), 1)
+	s.Require().Empty(response.Messages()[0].UnfurledLinks)
+}
+
+func (s *MessengerSuite) TestGlobalPrivacyMode() {
+	privacy, err := s.m.PrivacySettings()
+	s.Require().NoError(err)
+	s.Require().False(privacy.PrivacyMode)
+	s.Require().True(privacy.LinkPreviews)
+	s.Require().True(privacy.TypingIndicators)
+	s.Require().True(privacy.ReadReceipts)
+
+	privacy, err = s.m.SetPrivacyMode(true)
+	s.Require().NoError(err)
+	s.Require().True(privacy.PrivacyMode)
+	s.Require().False(privacy.LinkPreviews)
+	s.Require().False(privacy.TypingIndicators)
+	s.Require().False(privacy.ReadReceipts)
+
+	_, err = s.m.UnfurlURLs([]string{"https://github.com"})
+	s.Require().Equal(ErrLinkPreviewsPrivacyMode, err)
+
+	// The link previews mode is kept once the privacy mode is disabled
+	err = s.m.SetLinkPreviewsMode(settings.LinkPreviewsModePrivacy)
+	s.Require().NoError(err)
+	privacy, err = s.m.SetPrivacyMode(false)
+	s.Require().NoError(err)
+	s.Require().False(privacy.LinkPreviews)
+	s.Require().True(privacy.TypingIndicators)
+	s.Require().True(privacy.ReadReceipts)
+}
+
+func (s *MessengerSuite) TestReceiveMessageWithDeniedPreviews() {
+	theirMessenger := s.newMessenger()
+	_, err := theirMessenger.Start()
+	s.Require().NoError(err)
+	defer theirMessenger.Shutdown() // nolint: errcheck
+
+	err = s.m.SetLinkPreviewsFilter(nil, []string{"github.com"})
+	s.Require().NoError(err)
+
+	chat := CreateOneToOneChat("XXX", &s.privateKey.PublicKey, s.m.transport)
+	err = theirMessenger.SaveChat(chat)
+	s.Require().NoError(err)
+
+	inputMsg := buildTestMessage(*chat)
+	inputMsg.Text = "https://gist.github.com https://status.im"
+	inputMsg.LinkPreviews = []common.LinkPreview{
+		{URL: "https://gist.github.com", Title: "Gists"},
+		{URL: "https://status.im", Title: "Status"},
+	}
+
+	_, err = theirMessenger.SendChatMessage(context.Background(), inputMsg)
+	s.Require().NoError(err)
+
+	response, err := WaitOnMessengerResponse(
+		s.m,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"no messages",
+	)
+	s.Require().NoError(err)
+
+	// Only the previews of allowed hosts are kept
+	unfurledLinks := response.Messages()[0].UnfurledLinks
+	s.Require().Len(unfurledLinks, 1)
+	s.Require().Equal("https://status.im", unfurledLinks[0].Url)
+}
+
 func (s *MessengerSuite) TestMessageSent() {
 	//send message
 	chat := CreatePublicChat("test-chat", s.m.transport)
@@ -2385,8 +2739,10 @@ func (s *MessengerSuite) TestResendExpiredEmojis() {
 	s.Equal(1, rawMessage.SendCount)
 
 	//imitate that more than 30 seconds passed since message was sent
-	rawMessage.LastSent = rawMessage.LastSent - 35*uint64(time.Second.Milliseconds())
-	err = s.m.persistence.SaveRawMessage(rawMessage)
+	outboxMessage, err := s.m.persistence.OutboxMessageByID(emojiID)
+	s.Require().NoError(err)
+	outboxMessage.NextAttempt = outboxMessage.NextAttempt - 35*uint64(time.Second.Milliseconds())
+	err = s.m.persistence.SaveOutboxMessage(outboxMessage)
 	s.NoError(err)
 	time.Sleep(2 * time.Second)
 
@@ -15,6 +15,9 @@ import (
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/encryption/multidevice"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/services/wallet"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+	"github.com/status-im/status-go/services/wallet/watchonly"
 )
 
 var (
@@ -78,6 +81,97 @@ func (m *Messenger) watchWalletBalances() {
 	}()
 }
 
+// addWatchOnlyActivityNotifications adds an activity center notification for
+// each transfer of a watch-only account. They aren't synced, every device
+// watches the accounts on its own
+func (m *Messenger) addWatchOnlyActivityNotifications(activities []*watchonly.Activity) (*MessengerResponse, error) {
+	response := &MessengerResponse{}
+	now := m.getTimesource().GetCurrentTime()
+	for _, activity := range activities {
+		notification := &ActivityCenterNotification{
+			ID:             types.HexBytes(activity.ID().Bytes()),
+			Type:           ActivityCenterNotificationTypeWatchOnlyActivity,
+			Timestamp:      now,
+			UpdatedAt:      now,
+			WalletActivity: activity,
+		}
+
+		n, err := m.persistence.SaveActivityCenterNotification(notification, true)
+		if err != nil {
+			return nil, err
+		}
+		if n > 0 {
+			response.AddActivityCenterNotification(notification)
+		}
+	}
+
+	if len(response.ActivityCenterNotifications()) == 0 {
+		return response, nil
+	}
+
+	state, err := m.persistence.GetActivityCenterState()
+	if err != nil {
+		return nil, err
+	}
+	response.SetActivityCenterState(state)
+	return response, nil
+}
+
+// reloadWallet asks the clients to fetch the wallet data again, as after the
+// testnet mode was switched on a paired device
+func (m *Messenger) reloadWallet() {
+	if m.walletFeed == nil {
+		return
+	}
+
+	m.walletFeed.Send(walletevent.Event{
+		Type: wallet.EventWalletTickReload,
+	})
+}
+
+// watchWatchOnlyActivity turns the transfers of watch-only accounts found by
+// the wallet into activity center notifications
+func (m *Messenger) watchWatchOnlyActivity() {
+	if m.walletFeed == nil {
+		return
+	}
+
+	events := make(chan walletevent.Event, 10)
+	sub := m.walletFeed.Subscribe(events)
+
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case event := <-events:
+				if event.Type != watchonly.EventWatchOnlyActivity {
+					continue
+				}
+
+				activities, err := watchonly.ParseActivities(event)
+				if err != nil {
+					m.logger.Error("failed to parse watch-only activity", zap.Error(err))
+					continue
+				}
+
+				response, err := m.addWatchOnlyActivityNotifications(activities)
+				if err != nil {
+					m.logger.Error("failed to add watch-only activity notifications", zap.Error(err))
+					continue
+				}
+
+				if !response.IsEmpty() && m.config.messengerSignalsHandler != nil {
+					m.config.messengerSignalsHandler.MessengerResponse(response)
+				}
+			case <-sub.Err():
+				return
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
 func (m *Messenger) UpdateKeypairName(keyUID string, name string) error {
 	if keyUID == m.account.KeyUID && name != m.account.Name {
 		// profile keypair name must always follow profile display name
@@ -106,7 +200,32 @@ func (m *Messenger) UpdateAccountPosition(address types.Address, position int64)
 		return err
 	}
 
-	return m.resolveAndSyncKeypairOrJustWalletAccount(acc.KeyUID, acc.Address, acc.Clock, m.dispatchMessage)
+	err = m.resolveAndSyncKeypairOrJustWalletAccount(acc.KeyUID, acc.Address, acc.Clock, m.dispatchMessage)
+	if err != nil {
+		return err
+	}
+
+	// Moving an account shifts the accounts in between, which aren't synced
+	// with it
+	return m.syncAccountsPositions(m.dispatchMessage)
+}
+
+// SetAccountHidden hides the account from the wallet, or shows it again, on
+// all paired devices
+func (m *Messenger) SetAccountHidden(address types.Address, hidden bool) error {
+	acc, err := m.settings.GetAccountByAddress(address)
+	if err != nil {
+		return err
+	}
+
+	clock, _ := m.getLastClockWithRelatedChat()
+
+	err = m.settings.UpdateAccountHidden(address, hidden, clock)
+	if err != nil {
+		return err
+	}
+
+	return m.resolveAndSyncKeypairOrJustWalletAccount(acc.KeyUID, acc.Address, clock, m.dispatchMessage)
 }
 
 func (m *Messenger) resolveAndSetAccountPropsMaintainedByBackend(acc *accounts.Account) error {
@@ -389,6 +508,56 @@ func (m *Messenger) syncKeypair(keypair *accounts.Keypair, rawMessageHandler Raw
 	return err
 }
 
+func (m *Messenger) syncAccountsPositions(rawMessageHandler RawMessageHandler) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, chat := m.getLastClockWithRelatedChat()
+
+	clock, err := m.settings.GetClockOfLastAccountsPositionChange()
+	if err != nil {
+		return err
+	}
+
+	allDbAccounts, err := m.settings.GetAccounts()
+	if err != nil {
+		return err
+	}
+
+	message := &protobuf.SyncAccountsPositions{
+		Clock: clock,
+	}
+	for _, acc := range allDbAccounts {
+		if acc.Chat {
+			continue
+		}
+		message.Accounts = append(message.Accounts, &protobuf.SyncAccount{
+			Address:  acc.Address.Bytes(),
+			KeyUid:   acc.KeyUID,
+			Position: acc.Position,
+		})
+	}
+
+	encodedMessage, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	rawMessage := common.RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_ACCOUNTS_POSITIONS,
+		ResendAutomatically: true,
+	}
+
+	_, err = rawMessageHandler(ctx, rawMessage)
+	return err
+}
+
 // This function resolves which protobuf message needs to be sent.
 //
 // If `KeyUID` is empty (means it's a watch only account) we send `protobuf.SyncAccount` message
@@ -96,6 +96,45 @@
 // 1687370421_add_communities_muted_till_new.up.sql (635B)
 // 1687416607_add_communities_check_channel_permission_responses_table.up.sql (739B)
 // 1687856939_add_community_tokens_decimals.up.sql (65B)
+// 1688125132_add_user_messages_fts.up.sql (681B)
+// 1688140000_add_pin_index_to_pin_messages.up.sql (119B)
+// 1688150000_add_emoji_reactions_counts.up.sql (553B)
+// 1688160001_add_message_edits.up.sql (214B)
+// 1688170000_add_audio_waveform_to_user_messages.up.sql (58B)
+// 1688190000_add_communities_moderation_log.up.sql (327B)
+// 1688190001_add_communities_events_log.up.sql (372B)
+// 1688200000_add_communities_directory.up.sql (176B)
+// 1688200001_add_communities_membership_payments.up.sql (250B)
+// 1688210000_add_waku_bandwidth_stats.up.sql (205B)
+// 1688210001_add_outbox_messages.up.sql (399B)
+// 1688210002_add_message_retention_policies.up.sql (131B)
+// 1688210003_add_activity_center_wallet_activity.up.sql (75B)
+// 1688210004_add_verification_expiry_and_audit.up.sql (537B)
+// 1688210005_add_notification_rules.up.sql (402B)
+// 1688210006_add_chat_drafts.up.sql (216B)
+// 1688210007_add_community_emoji_reactions.up.sql (664B)
+// 1688210008_add_deployer_to_community_tokens.up.sql (78B)
+// 1688210009_add_attachments.up.sql (575B)
+// 1688210010_add_mute_clocks.up.sql (111B)
+// 1688210011_add_outgoing_message_filters.up.sql (268B)
+// 1688210012_add_community_member_profiles.up.sql (271B)
+// 1688210013_add_contact_requests_bucket.up.sql (228B)
+// 1688210014_add_video_messages.up.sql (462B)
+// 1688210015_add_unfurled_status_links.up.sql (65B)
+// 1688210016_add_communities_airdrop_snapshots.up.sql (479B)
+// 1688210017_add_communities_soulbound_holdings.up.sql (288B)
+// 1688210018_add_safe_transactions.up.sql (516B)
+// 1688210019_add_social_recovery.up.sql (1196B)
+// 1688210020_add_social_recovery_recoveries.up.sql (502B)
+// 1688210021_delete_replaced_user_messages_fts.up.sql (419B)
+// 1688210022_add_social_recovery_signatures.up.sql (488B)
+// 1688210024_add_deploy_tx_to_community_tokens.up.sql (304B)
+// 1688210025_add_message_videos.up.sql (642B)
+// 1688210026_add_airdrop_address_to_revealed_addresses.up.sql (122B)
+// 1688210027_add_user_messages_blocklisted.up.sql (318B)
+// 1688210028_add_safe_transaction_unverified_executions.up.sql (436B)
+// 1688210029_backfill_outbox_messages.up.sql (666B)
+// 1688210030_add_mute_state_to_mute_clocks.up.sql (283B)
 // README.md (554B)
 // doc.go (850B)
 
@@ -2085,6 +2124,786 @@ func _1687856939_add_community_tokens_decimalsUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1688125132_add_user_messages_ftsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x91\x41\x4b\xc3\x40\x10\x85\xef\xfb\x2b\xc6\x53\x53\x10\x41\x10\x2f\x21\x87\x6d\x32\x89\x0b\xeb\xa6\x6c\x36\xf5\x18\x4a\x77\x94\x60\x4d\xa4\xd9\x50\xf1\xd7\xbb\x49\x63\x41\xa2\xd0\xa3\xb7\xe1\xbd\xe1\xbd\x6f\x76\x63\x8d\xdc\x20\x6c\x84\x36\x25\x97\x60\xf8\x4a\x22\xf4\x1d\x1d\xaa\x37\xea\xba\xed\x0b\x75\xd5\xb3\xeb\xa0\x2c\x84\xca\xc0\x4f\x77\x81\xa3\x0f\x77\x0d\xae\x7d\xa5\xa6\xfe\xa4\xa8\x6f\xea\x5d\x6b\xe9\xfe\x76\x19\x32\x16\x9f\xd2\x8c\x16\x59\x86\x7a\x9e\x53\xd5\x8d\x97\x1c\xf0\xd4\x78\x5b\xa8\x02\xb5\x81\x5c\xfd\x5c\x64\x2b\xcc\x84\x62\x70\xf6\x35\x68\x5c\x4b\x1e\xa3\x57\x4c\x3e\x4f\x0d\x6c\xbb\xab\xad\x67\xf2\x64\x4b\xd8\x70\x59\x62\x01\x41\x43\xc7\x9b\x43\x7b\x1c\x8c\x61\x1c\xcd\x90\xa1\x4a\x2e\xe1\xec\xdf\xed\xd6\xd1\xc4\x59\xae\x93\x61\x3b\x4f\xc7\x86\x7f\xc9\x6b\x69\x4f\x67\xde\x04\x25\x0e\xbc\x7f\x71\x4e\x7e\xaa\xf3\xc7\x5f\xbe\xfa\xe9\x01\x35\xc2\x88\x08\x11\xb4\x7b\x7b\xa2\xfa\x46\x99\x8e\xbc\xe4\xb2\xc2\xf7\xc4\x06\xa6\xa3\xc6\xb7\x9b\x77\x4e\x7d\xa3\x2b\x0a\x50\xb9\x01\x55\x4a\x09\x5c\x25\x27\xf1\x2a\x82\xc5\x22\x64\x5f\xab\xbc\x80\x2a\xa9\x02\x00\x00")
+
+func _1688125132_add_user_messages_ftsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688125132_add_user_messages_ftsUpSql,
+		"1688125132_add_user_messages_fts.up.sql",
+	)
+}
+
+func _1688125132_add_user_messages_ftsUpSql() (*asset, error) {
+	bytes, err := _1688125132_add_user_messages_ftsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688125132_add_user_messages_fts.up.sql", size: 681, mode: os.FileMode(0644), modTime: time.Unix(1792108471, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x61, 0xf3, 0x82, 0x6a, 0xf5, 0x53, 0x28, 0xc9, 0xc7, 0x50, 0xd2, 0xc6, 0xb6, 0x5a, 0x64, 0x6e, 0xd3, 0x7d, 0xa1, 0xdf, 0xcd, 0xe5, 0xe2, 0xca, 0xe5, 0xae, 0x5d, 0x56, 0xd6, 0x20, 0xd4, 0xcd}}
+	return a, nil
+}
+
+var __1688140000_add_pin_index_to_pin_messagesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\xc8\xcc\x8b\xcf\x4d\x2d\x2e\x4e\x4c\x4f\x2d\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x03\x8b\x67\xe6\xa5\xa4\x56\x28\x78\xfa\x85\x28\xf8\xf9\x03\x71\xa8\x8f\x8f\x82\x8b\xab\x9b\x63\xa8\x4f\x88\x82\x81\x35\x57\x68\x80\x8b\x63\x08\x9a\x01\xc1\xae\x21\x48\x3a\x6d\x15\x92\x73\xf2\x93\xb3\xe3\xcb\x12\x73\x4a\x53\xad\xb9\x00\x6a\xfd\xa1\xcc\x77\x00\x00\x00")
+
+func _1688140000_add_pin_index_to_pin_messagesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688140000_add_pin_index_to_pin_messagesUpSql,
+		"1688140000_add_pin_index_to_pin_messages.up.sql",
+	)
+}
+
+func _1688140000_add_pin_index_to_pin_messagesUpSql() (*asset, error) {
+	bytes, err := _1688140000_add_pin_index_to_pin_messagesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688140000_add_pin_index_to_pin_messages.up.sql", size: 119, mode: os.FileMode(0644), modTime: time.Unix(1792110454, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x11, 0x9d, 0x4b, 0xd9, 0x8e, 0x78, 0x5f, 0x53, 0xe7, 0xd4, 0xf5, 0xed, 0x92, 0xf0, 0xb3, 0x64, 0xb6, 0xb8, 0xda, 0x65, 0xb5, 0xd8, 0xc1, 0x66, 0x8a, 0x42, 0x62, 0x97, 0x8a, 0x7a, 0x85, 0x96}}
+	return a, nil
+}
+
+var __1688150000_add_emoji_reactions_countsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x51\xc1\x6e\x83\x30\x0c\xbd\xe7\x2b\x7c\x24\x13\x87\xdd\x7b\x4a\x53\xb3\x46\x4b\x93\x2a\x84\xad\x9c\x22\x44\xa3\x95\x8e\x16\x09\xe8\xb4\xcf\x5f\x28\xd2\x3a\x3a\x55\xe2\x68\xbf\xe7\xf7\xfc\x6c\x6e\x90\x59\x04\xcb\x96\x12\x41\x24\xa0\xb4\x05\xdc\x89\xd4\xa6\xe0\x4f\xcd\xb1\x72\xad\x2f\xca\xbe\x6a\xce\x9d\x2b\x9b\xcb\xb9\xef\x20\x22\x00\x27\xdf\x75\xc5\x87\x77\xd5\x1e\xde\x98\xe1\x6b\x66\xae\x83\x2a\x93\x32\x0e\x70\xdd\x94\x45\xed\xca\x43\xd1\x3f\x62\x8c\xda\x01\x14\xca\x4e\x80\xab\xc9\xa4\x0b\x2b\x4c\x58\x26\x2d\x3c\x0f\xf8\xd6\x88\x0d\x33\x39\xbc\x62\x0e\xd1\x6d\x8d\x78\xea\x19\xff\x1a\x50\x42\x17\x84\x08\x95\xa2\xb1\x83\xac\x7e\x18\x6b\x86\x58\x3c\xae\x47\x49\x8a\x12\xb9\x85\x59\x23\x5c\x67\xca\x46\x4f\x14\x12\xa3\x37\xf7\xee\xf0\xbe\x46\x83\x43\xd6\xa8\xf5\x7d\x1b\xba\x7e\x4f\xe1\xc5\xe8\x6c\x0b\xcb\x7c\x8e\x41\x48\xc7\xc7\x1f\x0a\xb5\xc2\xdd\xbf\x78\x37\x09\x57\x06\x89\x4f\xf7\x55\xd4\x97\xa1\xfc\x06\xad\xee\xd9\x93\x23\xfc\xa1\x87\x1b\xfe\x00\x64\xf4\xee\x46\x29\x02\x00\x00")
+
+func _1688150000_add_emoji_reactions_countsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688150000_add_emoji_reactions_countsUpSql,
+		"1688150000_add_emoji_reactions_counts.up.sql",
+	)
+}
+
+func _1688150000_add_emoji_reactions_countsUpSql() (*asset, error) {
+	bytes, err := _1688150000_add_emoji_reactions_countsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688150000_add_emoji_reactions_counts.up.sql", size: 553, mode: os.FileMode(0644), modTime: time.Unix(1792112023, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x15, 0xb9, 0x80, 0xd0, 0xb5, 0x41, 0x7c, 0xcc, 0xf1, 0x88, 0x1, 0x43, 0xc3, 0x83, 0x7a, 0x29, 0x8d, 0xfc, 0x3c, 0x32, 0xa9, 0x92, 0xd8, 0x5a, 0xfa, 0xdf, 0xd8, 0x39, 0x53, 0x1e, 0x11, 0x46}}
+	return a, nil
+}
+
+var __1688160001_add_message_editsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\x0e\x72\x75\x0c\x71\x55\x08\x71\x74\xf2\x71\x55\xf0\x74\x53\xf0\xf3\x0f\x51\x70\x8d\xf0\x0c\x0e\x09\x56\xc8\x4d\x2d\x2e\x4e\x4c\x4f\x8d\x4f\x4d\xc9\x2c\x29\x56\xd0\xe0\x52\x80\x8b\x64\xa6\x28\x84\x39\x06\x39\x7b\x38\x06\x81\xd5\xfb\x85\xfa\xf8\xe8\x00\xa5\x93\x73\xf2\x93\xb3\x15\x3c\xfd\x42\x50\x44\x8b\x52\x0b\x72\x12\x93\x53\x53\xe2\x13\x4b\x30\xe4\x4a\x52\x2b\x4a\xb0\x1b\x95\x9f\x57\x92\x9a\x57\x12\x5f\x52\x59\x90\x8a\xa1\x2b\x20\xc8\xd3\xd7\x31\x28\x52\xc1\xdb\x35\x52\x03\xe1\x24\x1d\x88\xfd\x9a\x5c\x9a\xd6\x5c\x00\x11\x6d\x3a\xd7\xd6\x00\x00\x00")
+
+func _1688160001_add_message_editsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688160001_add_message_editsUpSql,
+		"1688160001_add_message_edits.up.sql",
+	)
+}
+
+func _1688160001_add_message_editsUpSql() (*asset, error) {
+	bytes, err := _1688160001_add_message_editsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688160001_add_message_edits.up.sql", size: 214, mode: os.FileMode(0644), modTime: time.Unix(1792112175, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x89, 0x68, 0xac, 0xdd, 0x59, 0xb9, 0xb, 0xa9, 0xeb, 0xaf, 0xcc, 0x26, 0x1f, 0xa5, 0xeb, 0xa3, 0x5c, 0xe3, 0x93, 0x4b, 0xe4, 0xfd, 0x26, 0xd8, 0x62, 0x3a, 0x11, 0xac, 0xaf, 0x2b, 0xe8, 0x3a}}
+	return a, nil
+}
+
+var __1688170000_add_audio_waveform_to_user_messagesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x2d\x4e\x2d\x8a\xcf\x4d\x2d\x2e\x4e\x4c\x4f\x2d\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\x2c\x4d\xc9\xcc\x8f\x2f\x4f\x2c\x4b\x4d\xcb\x2f\xca\x55\x70\xf2\xf1\x77\xb2\xe6\x02\x00\x61\xa0\x40\xf3\x3a\x00\x00\x00")
+
+func _1688170000_add_audio_waveform_to_user_messagesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688170000_add_audio_waveform_to_user_messagesUpSql,
+		"1688170000_add_audio_waveform_to_user_messages.up.sql",
+	)
+}
+
+func _1688170000_add_audio_waveform_to_user_messagesUpSql() (*asset, error) {
+	bytes, err := _1688170000_add_audio_waveform_to_user_messagesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688170000_add_audio_waveform_to_user_messages.up.sql", size: 58, mode: os.FileMode(0644), modTime: time.Unix(1792112327, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xeb, 0xad, 0xfc, 0xa0, 0x3a, 0xda, 0xf6, 0x4f, 0x25, 0x2a, 0xd, 0x44, 0xb7, 0x20, 0x4d, 0x2a, 0x20, 0x13, 0xa, 0x5f, 0xeb, 0x59, 0xf8, 0xb9, 0xfc, 0x1a, 0x51, 0xc3, 0x3b, 0x50, 0xad, 0x65}}
+	return a, nil
+}
+
+var __1688190000_add_communities_moderation_logUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x8f\xc1\x6e\x83\x30\x10\x44\xef\x7c\xc5\x2a\xa7\x44\xe2\x0f\x7a\x5a\xdc\x45\xb1\xba\xb5\x23\xb3\xa9\x92\x13\xa2\x60\x45\xa8\x18\x4b\x40\x0f\xf9\xfb\x90\x22\xa5\x89\xa2\x5c\xf7\xcd\xec\xcc\x28\x47\x28\x04\x82\x19\x13\xe8\x1c\x8c\x15\xa0\x83\x2e\xa4\x80\x3a\x86\xf0\xdb\xb7\x53\xeb\xc7\x32\xc4\xc6\x0f\xd5\xd4\xc6\xbe\xec\xe2\x09\xd6\x09\xdc\xf0\xb9\x6c\x1b\xc8\xd8\x66\x7f\x5e\xb3\x67\x4e\x67\x1a\x7c\xf8\xf6\xc3\x15\x7d\xa1\x53\x5b\x74\x8f\x74\x79\x17\x5f\x0a\x06\x5f\x8d\xb1\x07\xa1\x83\xdc\xee\xf0\x4e\x39\xee\x59\x60\xb5\x5a\x12\xc6\xb1\x3a\xcd\xdd\x1a\xdf\xf9\xc9\xcf\x1d\xac\x65\x42\xf3\xac\xcf\x91\x0b\xba\x5a\xea\x2e\xd6\x3f\xa0\x8d\x3c\x64\xed\x9c\xfe\x44\x77\x84\x0f\x3a\xc2\xfa\x7e\x55\xfa\xbf\x22\x5d\xbc\x1b\xb0\x06\x94\x35\x39\x6b\x25\xe0\x68\xc7\xa8\x28\xd9\xbc\x25\x17\x3f\xd8\x6a\x39\x47\x01\x00\x00")
+
+func _1688190000_add_communities_moderation_logUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688190000_add_communities_moderation_logUpSql,
+		"1688190000_add_communities_moderation_log.up.sql",
+	)
+}
+
+func _1688190000_add_communities_moderation_logUpSql() (*asset, error) {
+	bytes, err := _1688190000_add_communities_moderation_logUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688190000_add_communities_moderation_log.up.sql", size: 327, mode: os.FileMode(0644), modTime: time.Unix(1792165304, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x16, 0xe0, 0xe6, 0xb6, 0x52, 0x41, 0xfe, 0x69, 0xec, 0x4, 0xb, 0x8c, 0xe8, 0x65, 0x44, 0x4e, 0x9b, 0x97, 0xe2, 0xfb, 0xb7, 0x3e, 0xd, 0x96, 0x44, 0x51, 0x7a, 0x36, 0xbd, 0xdd, 0xd9, 0x7c}}
+	return a, nil
+}
+
+var __1688190001_add_communities_events_logUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x90\xc1\x0e\x82\x30\x10\x44\xef\x7c\xc5\x1e\x21\xe1\x0f\x3c\x95\x5a\x62\x63\x2d\xa4\x54\x23\xa7\x86\x68\xa3\x8d\xd4\x12\x29\x26\xfc\xbd\x44\x62\x02\x01\xaf\xb3\xf3\x66\x77\x16\x0b\x82\x24\x01\x89\x12\x46\x80\xa6\xc0\x33\x09\xe4\x4c\x0b\x59\xc0\xc5\x59\xdb\x3d\x8d\x37\xba\x55\xfa\xad\x9f\xbe\x55\xb5\xbb\x41\x18\x00\x98\x2b\x9c\x90\xc0\x3b\x24\xbe\x00\x3f\x32\x16\x0f\xf2\x8f\xe8\xd5\x60\x48\x58\x96\xcc\xa6\x55\xe7\xef\xee\xb5\x0a\xfa\xbe\xd1\x40\xb9\x9c\xa7\xd5\xee\xf2\x58\xa8\x4d\xd5\xd7\xae\x5a\x89\xf7\xc6\xea\xd6\x57\xb6\x59\x20\xb9\xa0\x07\x24\x4a\xd8\x93\x12\x42\x73\x8d\x20\xe3\x80\x33\x9e\x32\x8a\x25\x08\x92\x33\x84\x49\x10\x6d\x82\x00\x8f\xcf\xa0\x7c\x4b\xce\x7f\xea\xab\x69\x47\x35\x9e\x38\xc4\xad\x9b\xc3\xa9\x39\x1e\x0b\x0d\x7b\x3e\xbe\x69\x77\xfd\x74\x01\x00\x00")
+
+func _1688190001_add_communities_events_logUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688190001_add_communities_events_logUpSql,
+		"1688190001_add_communities_events_log.up.sql",
+	)
+}
+
+func _1688190001_add_communities_events_logUpSql() (*asset, error) {
+	bytes, err := _1688190001_add_communities_events_logUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688190001_add_communities_events_log.up.sql", size: 372, mode: os.FileMode(0644), modTime: time.Unix(1792115918, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd9, 0xdc, 0xf8, 0xb1, 0xf7, 0xe3, 0xe5, 0xa0, 0xe1, 0x73, 0x8c, 0xb5, 0x3d, 0xc4, 0xef, 0x9, 0xa9, 0x7d, 0x46, 0x18, 0xb9, 0x7e, 0x62, 0x7d, 0xc, 0xba, 0x13, 0x79, 0x48, 0x65, 0x20, 0x96}}
+	return a, nil
+}
+
+var __1688200000_add_communities_directoryUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3d\x8d\x41\x0a\x83\x30\x14\x05\xf7\x9e\xe2\x2d\x5b\xe8\x0d\xba\xfa\xa6\x3f\x10\xfa\x9b\x48\x8c\x50\x57\x22\x9a\xd2\x2c\xac\x60\xe3\xc2\xdb\x57\x4a\xe9\x7a\x66\x18\xe5\x99\x02\x23\x50\x29\x0c\xa3\x61\x5d\x00\xdf\x4d\x1d\x6a\x0c\xf3\x34\xad\xaf\x94\x53\x7c\x77\x63\x5a\xe2\x90\xe7\x65\xc3\xa1\xc0\x9f\x6c\x5d\x1a\x51\x8a\x2b\x51\x79\x73\x23\xdf\xe2\xca\x2d\x9c\x85\x72\x56\x8b\x51\x01\x9e\x2b\x21\xc5\xa7\x3d\x7a\xc4\x3e\xaf\x4b\xdc\x03\xe7\x84\xc9\x7e\x57\xb6\x11\xc1\x85\x35\x35\x12\xa0\x49\xea\x9f\x9a\x87\x67\x1c\xbb\x3e\xc3\xd8\xf0\x17\x8b\xe3\xb9\xf8\x00\x1b\x56\xf0\xb6\xb0\x00\x00\x00")
+
+func _1688200000_add_communities_directoryUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688200000_add_communities_directoryUpSql,
+		"1688200000_add_communities_directory.up.sql",
+	)
+}
+
+func _1688200000_add_communities_directoryUpSql() (*asset, error) {
+	bytes, err := _1688200000_add_communities_directoryUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688200000_add_communities_directory.up.sql", size: 176, mode: os.FileMode(0644), modTime: time.Unix(1792116407, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xdf, 0x70, 0xf7, 0x58, 0x24, 0x83, 0x2b, 0xdf, 0xe5, 0x69, 0x60, 0xbd, 0x4a, 0x26, 0xf2, 0x52, 0xc8, 0x81, 0xd, 0x6d, 0x4a, 0x94, 0xa4, 0x15, 0xcc, 0x66, 0x5e, 0xcc, 0x17, 0xb9, 0xb2, 0xd7}}
+	return a, nil
+}
+
+var __1688200001_add_communities_membership_paymentsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6d\x8e\x4d\x0e\x83\x20\x18\x44\xf7\x9e\xe2\x5b\x6a\xe2\x0d\xba\x42\x82\x29\x29\x45\x83\xb4\xa9\x2b\x82\x96\x04\xd2\x82\x46\x74\xe1\xed\xfb\x93\xb8\x30\xed\x7a\xe6\xcd\x1b\x2c\x08\x92\x04\x24\x2a\x18\x01\x5a\x02\xaf\x24\x90\x1b\x6d\x64\x03\xfd\xe0\xfd\x12\xdc\xec\x4c\x54\xde\xf8\xce\x4c\xd1\xba\x51\x8d\x7a\xf5\x26\xcc\x11\xd2\x04\xa0\xb7\xda\x05\xe5\xee\x40\xb9\xfc\xb2\xfc\xc2\x58\xfe\x0e\xe6\x49\x87\xa8\xfb\xd9\x0d\x41\x59\x1d\x2d\x5c\x91\xc0\x47\x24\x76\xa5\xcd\xb0\x7e\x16\x0a\x56\x15\xbb\x74\x5c\xba\xa7\xeb\xd5\xc3\xac\x7f\xe1\x5a\xd0\x33\x12\x2d\x9c\x48\x0b\xe9\xf6\x23\xff\x11\x67\x50\x71\xc0\x15\x2f\x19\xc5\x12\x04\xa9\x19\xc2\x24\xc9\x0e\xc9\x0b\x68\xb8\xac\x8c\xfa\x00\x00\x00")
+
+func _1688200001_add_communities_membership_paymentsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688200001_add_communities_membership_paymentsUpSql,
+		"1688200001_add_communities_membership_payments.up.sql",
+	)
+}
+
+func _1688200001_add_communities_membership_paymentsUpSql() (*asset, error) {
+	bytes, err := _1688200001_add_communities_membership_paymentsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688200001_add_communities_membership_payments.up.sql", size: 250, mode: os.FileMode(0644), modTime: time.Unix(1792116844, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xff, 0xf0, 0xb1, 0x6a, 0x5d, 0x13, 0x53, 0xca, 0x8d, 0xa2, 0xdf, 0xdd, 0x62, 0xe9, 0xd1, 0xb5, 0xfe, 0xfc, 0xf9, 0xa1, 0x74, 0x94, 0xe4, 0xf9, 0xe7, 0x54, 0x72, 0x12, 0xb8, 0x1f, 0x9d, 0xc5}}
+	return a, nil
+}
+
+var __1688210000_add_waku_bandwidth_statsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\x0e\x72\x75\x0c\x71\x55\x08\x71\x74\xf2\x71\x55\xf0\x74\x53\xf0\xf3\x0f\x51\x70\x8d\xf0\x0c\x0e\x09\x56\x28\x4f\xcc\x2e\x8d\x4f\x4a\xcc\x4b\x29\xcf\x4c\x29\xc9\x88\x2f\x2e\x49\x2c\x29\x56\xd0\xe0\x52\x50\xc8\xc8\x2f\x2d\x52\xf0\xf4\x0b\x01\x2b\xf6\x0b\xf5\xf1\xd1\x01\x0a\x16\x14\xe5\x97\xe4\x27\xe7\xe7\x28\x84\x39\x06\x39\x7b\x38\x06\xa1\x48\x26\x55\x96\xa4\x16\xc7\x67\xe6\xa1\xe8\x52\x70\x71\x75\x73\x0c\xf5\x09\x51\x30\x40\x28\xc9\x2f\x2d\xc1\xa3\x26\x20\xc8\xd3\xd7\x31\x28\x52\xc1\xdb\x35\x52\x41\x03\xe4\x0a\x1d\xb8\xb5\x9a\x5c\x9a\xd6\x5c\x00\x6b\x66\xa3\xe0\xcd\x00\x00\x00")
+
+func _1688210000_add_waku_bandwidth_statsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210000_add_waku_bandwidth_statsUpSql,
+		"1688210000_add_waku_bandwidth_stats.up.sql",
+	)
+}
+
+func _1688210000_add_waku_bandwidth_statsUpSql() (*asset, error) {
+	bytes, err := _1688210000_add_waku_bandwidth_statsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210000_add_waku_bandwidth_stats.up.sql", size: 205, mode: os.FileMode(0644), modTime: time.Unix(1792121887, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6, 0x18, 0x84, 0x95, 0x6d, 0xc8, 0xa0, 0xdf, 0x76, 0xa7, 0xe8, 0x26, 0xca, 0x3b, 0xf6, 0x6b, 0x8b, 0x9b, 0x9b, 0xbb, 0x30, 0x3f, 0x44, 0x5a, 0xc0, 0x8, 0x4f, 0x6a, 0x94, 0xd1, 0x9e, 0x6f}}
+	return a, nil
+}
+
+var __1688210001_add_outbox_messagesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\xd0\x31\x6f\xc2\x30\x10\x05\xe0\x3d\xbf\xe2\x6d\x80\xc4\xc0\xde\xc9\x98\x8b\xb0\x6a\x1c\x64\x4c\x05\x93\xe5\x12\xab\xad\x14\x08\x8a\x2f\x12\x3f\xbf\x89\x52\xa1\x42\x0b\xf3\x7d\x7e\xe7\x7b\xd2\x92\x70\x04\x27\xe6\x9a\xa0\x72\x98\xc2\x81\x76\x6a\xe3\x36\xa8\x5b\x7e\xaf\x2f\xfe\x18\x53\x0a\x1f\x31\x61\x9c\x01\x5f\x25\xde\x84\x95\x4b\x61\xb1\xb6\x6a\x25\xec\x1e\xaf\xb4\x47\x61\x20\x0b\x93\x6b\x25\x1d\x2c\xad\xb5\x90\x34\xed\x74\x55\x1f\x42\xe5\x0f\x9f\x81\xfd\xaf\x87\xfd\x0a\xb3\xd5\xba\x17\x89\x03\xb7\xe9\xdf\x51\x60\x8e\xc7\x33\x27\x28\xe3\xae\x03\x2c\x28\x17\x5b\xed\x30\xeb\xc9\x29\x5e\xd8\xff\xb8\x27\xac\x0a\x89\x7d\x6c\x9a\xba\xf9\xb3\xe8\x0a\x47\xa3\x5e\xb6\xe7\x32\x70\x2c\xbb\xcc\x07\x71\xd9\xe4\x25\xcb\xe4\xd0\x99\x32\x0b\xda\xdd\xb7\xe4\x87\x8b\xfc\xcd\xd7\xba\x7a\xee\xd8\x78\x60\xd3\x9b\x13\xba\xec\x6f\x97\x4c\xd5\x4f\x8f\x01\x00\x00")
+
+func _1688210001_add_outbox_messagesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210001_add_outbox_messagesUpSql,
+		"1688210001_add_outbox_messages.up.sql",
+	)
+}
+
+func _1688210001_add_outbox_messagesUpSql() (*asset, error) {
+	bytes, err := _1688210001_add_outbox_messagesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210001_add_outbox_messages.up.sql", size: 399, mode: os.FileMode(0644), modTime: time.Unix(1792122189, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x40, 0x5a, 0x28, 0x72, 0x9b, 0xc7, 0xee, 0xcb, 0x8, 0x6b, 0x41, 0xd5, 0x64, 0x8d, 0x86, 0x74, 0xb0, 0x39, 0xcd, 0x6c, 0x8, 0x3f, 0xe7, 0x8e, 0x4f, 0x46, 0xf9, 0x69, 0x5b, 0x3f, 0x6b, 0x94}}
+	return a, nil
+}
+
+var __1688210002_add_message_retention_policiesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x1d\x8d\x4b\x0a\xc2\x30\x10\x86\xf7\x3d\xc5\xbf\x54\xf0\x06\xae\x62\x98\x42\x30\x26\x25\x1d\xc1\xae\x42\x28\x83\x06\xec\x03\x93\x85\xde\xde\xea\xfe\x7b\xe8\x40\x8a\x09\xac\x4e\x96\x60\x5a\x38\xcf\xa0\x9b\xe9\xb9\xc7\x24\xa5\xa4\xbb\xc4\x97\x54\x99\x6b\x5e\xe6\xb8\x2e\xcf\x3c\x66\x29\xd8\x35\xc0\xf8\x48\x35\xd6\xcf\x2a\x30\x8e\xd1\x05\x73\x51\x61\xc0\x99\x06\x78\x07\xed\x5d\x6b\x8d\x66\x04\xea\xac\xd2\x74\xd8\x84\x29\xbd\xe3\xd6\xfb\xe3\xbf\x8d\xbb\x5a\xdb\xec\x8f\xcd\x17\xe6\xa7\xe5\x46\x83\x00\x00\x00")
+
+func _1688210002_add_message_retention_policiesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210002_add_message_retention_policiesUpSql,
+		"1688210002_add_message_retention_policies.up.sql",
+	)
+}
+
+func _1688210002_add_message_retention_policiesUpSql() (*asset, error) {
+	bytes, err := _1688210002_add_message_retention_policiesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210002_add_message_retention_policies.up.sql", size: 131, mode: os.FileMode(0644), modTime: time.Unix(1792131030, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x84, 0x5e, 0xa0, 0x29, 0xef, 0x7b, 0x2, 0xc0, 0x74, 0x9c, 0x2e, 0x84, 0x61, 0xb7, 0x51, 0xf3, 0x99, 0xc0, 0xc2, 0xaf, 0x6d, 0x4b, 0x9e, 0xc1, 0x88, 0xa0, 0x49, 0xf8, 0xbc, 0xb6, 0x41, 0x2b}}
+	return a, nil
+}
+
+var __1688210003_add_activity_center_wallet_activityUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x48\x4c\x2e\xc9\x2c\xcb\x2c\xa9\x8c\x4f\x4e\xcd\x2b\x49\x2d\x8a\xcf\xcb\x2f\xc9\x4c\xcb\x4c\x4e\x2c\xc9\xcc\xcf\x2b\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x28\x4f\xcc\xc9\x49\x2d\x89\x87\xa9\x57\x70\xf2\xf1\x77\xb2\xe6\x02\x00\x28\x9f\x3d\x6b\x4b\x00\x00\x00")
+
+func _1688210003_add_activity_center_wallet_activityUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210003_add_activity_center_wallet_activityUpSql,
+		"1688210003_add_activity_center_wallet_activity.up.sql",
+	)
+}
+
+func _1688210003_add_activity_center_wallet_activityUpSql() (*asset, error) {
+	bytes, err := _1688210003_add_activity_center_wallet_activityUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210003_add_activity_center_wallet_activity.up.sql", size: 75, mode: os.FileMode(0644), modTime: time.Unix(1792137505, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x48, 0xeb, 0xca, 0x84, 0xe4, 0x6, 0x4f, 0x36, 0x74, 0xfb, 0x37, 0xa9, 0x5a, 0x6a, 0xff, 0xe9, 0xc5, 0x3f, 0xc3, 0x4b, 0x5e, 0x59, 0x25, 0xa3, 0xd7, 0x88, 0x1f, 0x5c, 0x50, 0x63, 0xef, 0xd6}}
+	return a, nil
+}
+
+var __1688210004_add_verification_expiry_and_auditUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x90\x3d\x6b\xc3\x30\x10\x86\x77\xff\x8a\x1b\x13\xe8\xd0\xbd\x93\x1a\x5f\x8a\x88\x2c\x17\x47\x06\x67\x12\xa2\x56\xe0\xa0\x8e\x1d\x7d\x98\xfe\xfc\xca\xc5\xad\x09\xc5\x69\x07\x2d\x7a\x9f\x7b\xf4\x9e\x98\x50\x58\x81\x62\xcf\x02\x61\xb4\x8e\xce\xf4\x66\x02\xf5\x17\xed\xec\x35\x5a\x1f\xbc\xa6\x4b\x4b\x23\xb5\xd1\xbc\x03\xcb\x73\xd8\x95\xa2\x2e\x24\xd8\x8f\x81\x9c\xf5\xda\x04\xe0\x52\x81\x2c\xd3\xa9\x85\x80\x1c\xf7\xac\x16\x0a\x1e\x9f\xb2\x6c\x57\x21\x53\x38\xcb\xf9\xfe\x0b\xc2\x86\x1f\xd5\x71\xe5\x29\x13\x5b\x0a\xb0\xc9\x00\xa8\x9d\xb4\xf8\x92\xba\xbd\x56\xbc\x60\xd5\x09\x0e\x78\x02\x56\xab\x92\xcb\xe4\x2d\x50\xaa\x87\xc4\xcd\xa3\x3a\xf1\x0a\x9b\xa5\xc7\x94\x9d\x5d\xdf\xe9\xe8\xad\xfb\x1d\x85\x7e\x25\x18\x9c\x1d\xa9\x8f\x5e\xfb\x60\x42\xf4\x37\xbb\x4d\xf9\xca\x75\xa0\x2e\xb5\x30\xdd\x70\x93\x64\xdb\xe5\x13\xb8\xcc\xb1\xb9\xb7\xb6\x5e\xea\x96\xf2\x1e\xb8\xf9\x01\x93\xfe\xdf\xf6\xef\x8d\xff\x70\xcf\x58\x32\x7f\x02\xe1\xb8\xe3\xde\x19\x02\x00\x00")
+
+func _1688210004_add_verification_expiry_and_auditUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210004_add_verification_expiry_and_auditUpSql,
+		"1688210004_add_verification_expiry_and_audit.up.sql",
+	)
+}
+
+func _1688210004_add_verification_expiry_and_auditUpSql() (*asset, error) {
+	bytes, err := _1688210004_add_verification_expiry_and_auditUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210004_add_verification_expiry_and_audit.up.sql", size: 537, mode: os.FileMode(0644), modTime: time.Unix(1792139936, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x51, 0x4d, 0x49, 0x3c, 0xef, 0x1, 0x45, 0x1e, 0x1a, 0x1, 0x5f, 0xe9, 0x8c, 0xc6, 0x7b, 0x93, 0xb2, 0x15, 0xd7, 0xcb, 0xeb, 0xac, 0xdd, 0x72, 0x60, 0x40, 0x7d, 0xc4, 0x51, 0x26, 0xef, 0x11}}
+	return a, nil
+}
+
+var __1688210005_add_notification_rulesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\xce\x31\x4f\xc3\x30\x14\x04\xe0\x3d\xbf\xe2\xb6\x52\x89\x81\x9d\xc9\x0d\x2f\x92\x85\xb1\xab\xc4\x95\xda\xc9\x8a\x1c\xd3\x5a\xa4\x31\x38\x2f\x43\xff\x7d\x49\xa5\x4e\xa0\xc0\x7c\xdf\x9d\xae\xac\x49\x58\x82\x15\x1b\x45\x90\x15\xb4\xb1\xa0\xbd\x6c\x6c\x83\x21\x71\x7c\x8f\xbe\xe5\x98\x06\x97\xa7\x3e\x8c\x78\x28\x80\xd8\xc1\xd2\xde\x62\x5b\xcb\x37\x51\x1f\xf0\x4a\x07\x18\x8d\xd2\xe8\x4a\xc9\xd2\xa2\xa6\xad\x12\x25\x3d\x7e\x53\x9f\xce\xe7\x69\x88\x7c\x71\xf7\xd2\x3c\xaf\x77\x4a\xe1\x85\x2a\xb1\x53\x16\xab\xd5\x0d\x9e\x5a\xfe\xcb\x70\x8e\xc7\x63\xc8\x8e\x2f\x9f\x01\x52\xff\xe2\x9e\x66\xd6\xfa\xf9\xef\x02\xf8\x9a\x62\x60\x77\x4a\x53\x1e\xdd\xc8\x6d\xe6\x7f\xda\x30\x74\x0b\xd2\xf7\xc9\x7f\x2c\xe4\x5d\xe8\x03\x87\x0e\x1b\x63\x14\x09\xfd\x53\x55\x42\x35\x54\xac\x9f\x8b\x2b\xd9\xff\x91\x2c\x92\x01\x00\x00")
+
+func _1688210005_add_notification_rulesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210005_add_notification_rulesUpSql,
+		"1688210005_add_notification_rules.up.sql",
+	)
+}
+
+func _1688210005_add_notification_rulesUpSql() (*asset, error) {
+	bytes, err := _1688210005_add_notification_rulesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210005_add_notification_rules.up.sql", size: 402, mode: os.FileMode(0644), modTime: time.Unix(1792140563, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xad, 0x3a, 0x7e, 0xfd, 0xb8, 0x15, 0x35, 0xaa, 0x5d, 0xd3, 0x48, 0xad, 0xef, 0x60, 0x16, 0x86, 0x73, 0xbf, 0x25, 0x67, 0x52, 0x2e, 0x5b, 0x6f, 0x2c, 0x5d, 0xd7, 0xa, 0x53, 0x8e, 0x1e, 0xc}}
+	return a, nil
+}
+
+var __1688210006_add_chat_draftsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\xce\x31\x0f\x82\x30\x14\x04\xe0\x9d\x5f\x71\x1b\x9a\x38\xb8\x3b\x95\xfa\x48\x1a\x6b\x4b\x4a\x49\x60\x22\x4d\xa9\xc1\xa8\x60\xa0\x83\x3f\x5f\x08\xab\x71\x7c\x97\xef\x2e\x8f\x1b\x62\x96\x60\x59\x26\x09\x22\x87\xd2\x16\x54\x8b\xd2\x96\xf0\xbd\x8b\x6d\x37\xb9\x5b\x9c\xb1\x4b\xb0\xdd\xf7\x0e\x96\x6a\x8b\xc2\x88\x2b\x33\x0d\x2e\xd4\x40\x2b\x70\xad\x72\x29\xb8\x85\xa1\x42\x32\x4e\x87\xc5\xc7\xf0\x89\x1b\x5e\x47\x55\x25\x25\xce\x94\xb3\x4a\x5a\xa4\xe9\x0a\xa6\x30\xbf\xc7\x61\x0e\x6d\x1c\xff\x3a\x17\xa3\xf3\xfd\x2b\x0c\xcb\x23\x99\xd4\xd9\x9a\xf9\xe7\xe8\x1f\x10\xea\x47\xe9\x98\xec\x4f\xc9\x17\x28\x76\xfb\xfe\xd8\x00\x00\x00")
+
+func _1688210006_add_chat_draftsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210006_add_chat_draftsUpSql,
+		"1688210006_add_chat_drafts.up.sql",
+	)
+}
+
+func _1688210006_add_chat_draftsUpSql() (*asset, error) {
+	bytes, err := _1688210006_add_chat_draftsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210006_add_chat_drafts.up.sql", size: 216, mode: os.FileMode(0644), modTime: time.Unix(1792141254, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x80, 0x69, 0xe1, 0x65, 0x7a, 0xfc, 0x8b, 0xbc, 0x86, 0xd4, 0x70, 0xdc, 0x6e, 0x33, 0xe4, 0x74, 0x3b, 0xd9, 0x6f, 0xdb, 0xf4, 0xbb, 0x13, 0xe3, 0x42, 0x3c, 0x51, 0xbf, 0x3d, 0x59, 0x8f, 0xae}}
+	return a, nil
+}
+
+var __1688210007_add_community_emoji_reactionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x92\x41\x4f\x84\x30\x10\x85\xef\xfd\x15\x73\xdb\x25\xe1\xe0\xdd\x53\x85\x21\x36\x96\x76\x33\x14\xe3\x9e\x1a\x52\x1b\xc5\x2c\x34\x11\x36\xc6\x7f\x6f\xd7\x35\x28\x06\xcc\x7a\x7e\xef\xcd\xfb\x66\x32\x5c\x1a\x24\x30\xfc\x46\x22\xf8\x2e\xbc\xb4\xf6\xd5\x37\x6e\x6c\x43\x3f\x00\xcf\x73\xc8\xb4\xac\x4b\x05\x2e\x74\xdd\xb1\x6f\xc7\x77\x7b\x36\xb5\x8f\x70\xcf\x29\xbb\xe5\x04\x4a\x1b\x50\xb5\x94\x90\x63\xc1\x6b\x69\x60\xb3\xb9\x66\x2c\x23\xe4\x06\xbf\x06\x8b\xe2\xd3\x85\x0f\xa2\x32\xd5\xef\x1a\xeb\xc2\xb1\x1f\x07\xdb\xfb\x37\xd8\x32\x80\xce\x0f\x43\xf3\xe4\x97\x2a\xd2\x28\x1f\x82\x6b\x0e\xd6\x3d\x37\xe3\x9a\x63\x22\x14\xca\xcc\x84\xff\x2d\x71\x4e\x44\xb4\xd9\x9c\xc9\x70\x75\xd2\x77\x24\x4a\x4e\x7b\xb8\xc3\x3d\x6c\xbf\xc1\xd3\x39\x65\x3a\x21\xa5\x0b\x0c\x09\x4b\xe2\xbd\x84\xaa\x90\xcc\xa9\x4a\xff\x79\xa0\x0b\x4b\x62\x20\x61\x15\x4a\xcc\x0c\x5c\x1e\x81\x82\x74\xb9\x52\x1f\x19\x73\xd2\xbb\xe5\x57\x99\x3c\x7c\xfd\x9d\x7e\xae\x41\xa8\x78\x19\xbf\x43\xaf\x0e\xfa\x00\x29\x00\xf2\xa7\x98\x02\x00\x00")
+
+func _1688210007_add_community_emoji_reactionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210007_add_community_emoji_reactionsUpSql,
+		"1688210007_add_community_emoji_reactions.up.sql",
+	)
+}
+
+func _1688210007_add_community_emoji_reactionsUpSql() (*asset, error) {
+	bytes, err := _1688210007_add_community_emoji_reactionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210007_add_community_emoji_reactions.up.sql", size: 664, mode: os.FileMode(0644), modTime: time.Unix(1792141545, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x77, 0xd6, 0x31, 0x12, 0x2a, 0x71, 0x5, 0x30, 0xcb, 0x74, 0x55, 0xf6, 0xfa, 0xea, 0x33, 0x46, 0x25, 0x62, 0x29, 0xd2, 0xf8, 0x5b, 0x60, 0x7e, 0xa5, 0xe2, 0xbd, 0x8d, 0xb, 0xe0, 0x74, 0xdf}}
+	return a, nil
+}
+
+var __1688210008_add_deployer_to_community_tokensUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x48\xce\xcf\xcd\x2d\xcd\xcb\x2c\xa9\x8c\x2f\xc9\xcf\x4e\xcd\x2b\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\x49\x2d\xc8\xc9\xaf\x4c\x2d\x52\x08\x73\x0c\x72\xf6\x70\x0c\x52\xf0\xf3\x0f\x51\xf0\x0b\xf5\xf1\x51\x70\x71\x75\x73\x0c\xf5\x09\x51\x50\x57\xb7\xe6\x02\x00\x92\x30\x72\x66\x4e\x00\x00\x00")
+
+func _1688210008_add_deployer_to_community_tokensUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210008_add_deployer_to_community_tokensUpSql,
+		"1688210008_add_deployer_to_community_tokens.up.sql",
+	)
+}
+
+func _1688210008_add_deployer_to_community_tokensUpSql() (*asset, error) {
+	bytes, err := _1688210008_add_deployer_to_community_tokensUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210008_add_deployer_to_community_tokens.up.sql", size: 78, mode: os.FileMode(0644), modTime: time.Unix(1792141772, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5c, 0x8, 0x4a, 0xa1, 0x27, 0x94, 0xfc, 0xa0, 0x75, 0x56, 0x76, 0xdf, 0xcf, 0x3, 0x88, 0x13, 0x78, 0x4b, 0x4, 0x4f, 0x8c, 0xe6, 0x4f, 0x35, 0xfb, 0x36, 0x94, 0xc2, 0x4f, 0xe1, 0x2d, 0xc3}}
+	return a, nil
+}
+
+var __1688210009_add_attachmentsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x91\x4d\x6e\x83\x30\x10\x85\xf7\x9c\x62\x96\x44\xca\x0d\xba\x32\xc6\xb4\x56\x5c\x3b\x32\xa6\x4a\x56\xc8\xc2\xa3\x82\x5a\x48\x8a\x8d\x54\xf5\xf4\x85\x44\xaa\x8c\xda\xa4\xdb\xf7\xe6\xcd\xcf\x37\x54\x33\x62\x18\x18\x92\x09\x06\xbc\x00\xa9\x0c\xb0\x03\x2f\x4d\x09\x36\x04\xdb\xb4\x3d\x0e\xc1\x43\x9a\x00\xf4\xe8\xbd\x7d\xc5\xba\x73\xf0\x42\x34\x7d\x22\x1a\xf6\x9a\x3f\x13\x7d\x84\x1d\x3b\x82\x92\x40\x95\x2c\x04\xa7\x06\x34\xdb\x0b\x42\xd9\x76\x4e\x45\xd5\x4b\x6f\x59\x09\xb1\xc8\x4d\x6b\x43\x7d\xc3\xb3\x53\x68\x4f\xe3\x9f\x96\xef\xbe\x10\x2a\x59\xf2\x47\xc9\x72\xe0\xd2\xac\x5c\x87\xbe\x19\xbb\x73\x98\xc3\x99\x50\xd9\xca\x1b\xf1\x63\x42\x1f\xd0\x41\xa6\x94\x60\x44\xfe\xb8\x90\xb3\x82\x54\xc2\x40\x41\x44\x79\xd9\xb9\x39\xf5\xe7\x77\x0c\xf8\x4f\x69\xb2\x79\x48\x12\x7a\x05\xc8\x65\xce\x0e\x31\xb2\xe5\xb8\x19\x49\xa4\xa4\x9d\x8b\x02\xf7\x89\xd7\x4d\x3b\x0d\x6f\x57\xee\x91\x7a\x13\xe6\x5c\x5c\x77\x83\xc3\xcf\x3b\x70\x6c\xb0\xbf\xb1\xc4\x1f\x4c\x57\x93\xb6\x71\xdb\xcd\xea\xbd\xf3\x00\xa5\x2f\xe7\x7f\x03\x28\xfe\x84\x70\x3f\x02\x00\x00")
+
+func _1688210009_add_attachmentsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210009_add_attachmentsUpSql,
+		"1688210009_add_attachments.up.sql",
+	)
+}
+
+func _1688210009_add_attachmentsUpSql() (*asset, error) {
+	bytes, err := _1688210009_add_attachmentsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210009_add_attachments.up.sql", size: 575, mode: os.FileMode(0644), modTime: time.Unix(1792145997, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2b, 0xb7, 0x74, 0x97, 0xae, 0x13, 0xc7, 0xc1, 0x86, 0x5f, 0x22, 0x35, 0x3a, 0xe3, 0x95, 0x46, 0xce, 0x8c, 0xba, 0xb3, 0x68, 0xea, 0xfc, 0x39, 0xa3, 0x85, 0xa5, 0xb3, 0x22, 0x7c, 0x7c, 0xe0}}
+	return a, nil
+}
+
+var __1688210010_add_mute_clocksUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x1d\xcc\xb1\x0a\xc2\x50\x0c\x46\xe1\xfd\x3e\xc5\x3f\x2a\xf8\x06\x4e\x69\x48\x31\x18\x73\x4b\x1a\x4b\x3b\x39\x54\x07\x51\x71\xb0\xbe\xbf\xd2\xfd\x7c\x87\x43\x28\x05\x49\x8d\x09\xb4\x85\xd7\x84\x8c\xda\x67\x8f\xd7\x77\xb9\x5d\xe6\xe7\x7b\x7e\x7c\xb0\x29\xc0\xfd\x8a\x81\x82\x0f\x14\xe8\x42\x4f\x14\x13\x8e\x32\xa1\x3a\xb8\x7a\x6b\xca\x89\x90\xce\x88\x65\xf7\xaf\x57\x08\xf5\x5c\x97\x7e\x36\x2b\xdb\x7d\xf9\x01\x52\x9b\xff\xc2\x6f\x00\x00\x00")
+
+func _1688210010_add_mute_clocksUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210010_add_mute_clocksUpSql,
+		"1688210010_add_mute_clocks.up.sql",
+	)
+}
+
+func _1688210010_add_mute_clocksUpSql() (*asset, error) {
+	bytes, err := _1688210010_add_mute_clocksUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210010_add_mute_clocks.up.sql", size: 111, mode: os.FileMode(0644), modTime: time.Unix(1792146694, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x99, 0x9, 0xc7, 0x97, 0x89, 0xd3, 0xa7, 0x52, 0x6f, 0xd, 0x9f, 0x7e, 0xe2, 0x45, 0x14, 0xa, 0xb8, 0xd8, 0xe6, 0xd1, 0xfb, 0xb2, 0x59, 0x7e, 0xf9, 0xb0, 0x7b, 0x24, 0x52, 0x71, 0x6c, 0x0}}
+	return a, nil
+}
+
+var __1688210011_add_outgoing_message_filtersUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x8d\xdd\x0a\x82\x30\x00\x46\xef\x7d\x8a\xef\xae\x82\xde\xa0\xab\xb5\x26\x8d\xd6\x94\x39\x23\xaf\x44\x74\xc9\x20\x7f\xd0\x79\xe1\xdb\xe7\x0a\x22\x10\xba\x3e\xe7\xfb\x0e\x55\x8c\x68\x06\x4d\x8e\x82\x81\x87\x90\x91\x06\xbb\xf3\x44\x27\xe8\x26\x57\x77\xb6\xad\xf3\xc6\x8c\x63\x51\x9b\xfc\x61\x9f\xce\x0c\x23\xb6\x01\x60\x2b\xdc\x88\xa2\x67\xa2\x10\x2b\x7e\x25\x2a\xc3\x85\x65\x88\x24\x68\x24\x43\xc1\xa9\x86\x62\xb1\x20\x94\xed\x17\xbb\xec\x9a\x66\x6a\xad\x9b\xf3\x9f\x9d\x4f\xc9\x54\x08\x9c\x58\x48\x52\xa1\xb1\xd9\x78\xf7\x53\xc9\xdd\xdc\x1b\x70\xa9\xbf\x9a\x67\x7d\xe1\x16\xd6\xae\x2e\x3c\x2b\x4a\x67\xbb\x76\x35\xa9\xcc\x58\x0e\xb6\x7f\xb3\x3f\xe5\x60\x77\x08\x5e\xb6\x86\x9d\x7f\x0c\x01\x00\x00")
+
+func _1688210011_add_outgoing_message_filtersUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210011_add_outgoing_message_filtersUpSql,
+		"1688210011_add_outgoing_message_filters.up.sql",
+	)
+}
+
+func _1688210011_add_outgoing_message_filtersUpSql() (*asset, error) {
+	bytes, err := _1688210011_add_outgoing_message_filtersUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210011_add_outgoing_message_filters.up.sql", size: 268, mode: os.FileMode(0644), modTime: time.Unix(1792146942, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x42, 0x8, 0x37, 0x91, 0x1d, 0x7d, 0x14, 0x98, 0x71, 0xae, 0xc1, 0xa5, 0xbf, 0x24, 0x2, 0xeb, 0x3f, 0x50, 0xea, 0xd8, 0xac, 0xfc, 0x97, 0x2d, 0x26, 0x40, 0x3e, 0x40, 0xc0, 0x12, 0xf, 0x45}}
+	return a, nil
+}
+
+var __1688210012_add_community_member_profilesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x8e\x41\x0b\x82\x30\x18\x86\xef\xfe\x8a\xf7\x56\x42\x87\xee\x9d\xe6\xfa\xa4\xd1\x9a\x32\x57\xd4\x49\xcc\x56\x8c\x9c\x8a\xd6\xc1\x7f\x5f\x46\x50\x10\x1d\x5f\x9e\xe7\x81\x97\x6b\x62\x86\x60\x58\x24\x09\x22\x86\x4a\x0c\x68\x2f\x32\x93\xa1\x6c\xbc\xbf\xd7\xee\x36\xe4\xde\xfa\xa3\xed\xf2\xb6\x6b\xce\xae\xb2\x3d\xa6\x01\xbe\xa8\x3b\x61\xc7\x34\x5f\x31\xfd\xaa\xd5\x56\xca\xd9\x53\x78\x47\x7f\xe8\xc9\xf5\x6d\x55\x0c\x79\x5d\x78\xfb\x23\x60\x49\x31\xdb\x4a\x83\xc9\x64\x74\x9d\x2f\x2e\x16\x91\x4c\xa2\x71\x95\x55\x53\x5e\x21\x94\xf9\xd5\xe7\x23\x4f\xb5\xd8\x30\x7d\xc0\x9a\x0e\x98\x7e\xbf\x9c\x7d\x2e\x85\x48\x14\x78\xa2\x62\x29\xb8\x81\xa6\x54\x32\x4e\x41\xb8\x08\x1e\x11\xd9\xfe\x87\x0f\x01\x00\x00")
+
+func _1688210012_add_community_member_profilesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210012_add_community_member_profilesUpSql,
+		"1688210012_add_community_member_profiles.up.sql",
+	)
+}
+
+func _1688210012_add_community_member_profilesUpSql() (*asset, error) {
+	bytes, err := _1688210012_add_community_member_profilesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210012_add_community_member_profiles.up.sql", size: 271, mode: os.FileMode(0644), modTime: time.Unix(1792147461, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd, 0xe6, 0x33, 0x5c, 0x53, 0x8f, 0x10, 0x8a, 0x35, 0x99, 0xf, 0xe9, 0x57, 0xb0, 0x9c, 0xdd, 0x85, 0xb, 0x57, 0x4, 0x49, 0x2c, 0xe2, 0x54, 0x33, 0xd4, 0xea, 0x6, 0x2d, 0xd2, 0x49, 0xdd}}
+	return a, nil
+}
+
+var __1688210013_add_contact_requests_bucketUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x65\x8d\xc1\x0a\x82\x40\x14\x45\xf7\x7e\xc5\x5d\x16\xb8\x68\xdf\x6a\xac\x27\x0c\x4d\x33\xa1\x4f\xd0\x95\xd8\x34\x84\x84\x59\xce\xf8\xff\x69\x84\x14\xad\xcf\xb9\xe7\xee\x32\x12\x4c\x60\x91\x28\x82\x4c\xa1\x0d\x83\x4a\x99\x73\x0e\xdb\xdf\x43\x63\x43\x3d\xb8\xe7\xe8\x7c\xf0\xf5\x79\xb4\x37\x17\xb0\x8a\xb0\xb0\xf6\x02\xa6\x92\x71\xca\xe4\x51\x64\x15\x0e\x54\xc5\x13\xee\x9c\xf7\xcd\xd5\x2d\x78\xae\xea\x42\xa9\xf8\x6b\xfa\xc9\x22\x31\x46\x91\xd0\x8b\x83\x3d\xa5\xa2\x50\x8c\x54\xa8\x9c\xe6\x45\x18\x46\x1f\x6a\x6f\xfb\xc1\x41\x6a\xfe\x37\x37\x6f\xab\x9d\x5e\x43\xd3\x3d\x7e\x9c\x68\xbd\x8d\x5e\x86\x2d\x4f\x0c\xe4\x00\x00\x00")
+
+func _1688210013_add_contact_requests_bucketUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210013_add_contact_requests_bucketUpSql,
+		"1688210013_add_contact_requests_bucket.up.sql",
+	)
+}
+
+func _1688210013_add_contact_requests_bucketUpSql() (*asset, error) {
+	bytes, err := _1688210013_add_contact_requests_bucketUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210013_add_contact_requests_bucket.up.sql", size: 228, mode: os.FileMode(0644), modTime: time.Unix(1792147740, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x57, 0xec, 0xf8, 0x95, 0xd2, 0xb4, 0xa6, 0xcc, 0x66, 0xc6, 0xfb, 0x5f, 0x7b, 0x41, 0xdf, 0x56, 0x53, 0xba, 0x88, 0xff, 0x37, 0xd8, 0x26, 0x47, 0x17, 0x22, 0x99, 0xf, 0x61, 0xed, 0x17, 0x67}}
+	return a, nil
+}
+
+var __1688210014_add_video_messagesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x90\xb1\x0a\x83\x30\x18\x84\x77\x9f\xe2\x5e\xc0\xb9\x43\xa7\x58\x2d\x14\x52\x85\xa2\xb3\xa4\xfa\x63\x42\x4d\x22\xe6\x4f\x8b\x6f\x5f\x29\xa5\xb4\x5b\x1d\xee\x86\xe3\x3e\x0e\x4e\xc8\xba\xb8\xa0\x16\x99\x2c\x10\x03\xcd\xad\xa5\x10\xd4\x40\x01\x22\xcf\x71\xa8\x64\x73\x2e\x71\x37\x3d\xf9\x76\x52\xcb\xe8\x55\x8f\x4c\x56\xd9\x3e\x11\xff\x83\xbc\x4c\x84\x53\x59\x6f\x82\xfa\x38\x2b\x36\xde\xb5\x36\x6c\x66\x1f\xa6\x67\xbd\x99\xd2\x64\x06\xcd\x9b\x31\xd6\xd1\x5e\x9d\x32\xe3\xfb\x98\x24\x4d\x71\xb2\xaf\xee\x83\x66\x02\x6b\x82\x77\xe3\x02\xc5\xac\x3a\x6d\xc9\x71\x40\x58\x1d\xc6\xa1\xd3\xd1\xdd\xc2\xcf\xdc\x77\xed\x6b\xac\xf3\x8e\xd7\xec\x73\x26\xca\x6a\x55\x23\x25\xf2\xe2\x28\x1a\x59\x63\xb7\x4f\x9e\xa5\xb3\x63\x61\xce\x01\x00\x00")
+
+func _1688210014_add_video_messagesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210014_add_video_messagesUpSql,
+		"1688210014_add_video_messages.up.sql",
+	)
+}
+
+func _1688210014_add_video_messagesUpSql() (*asset, error) {
+	bytes, err := _1688210014_add_video_messagesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210014_add_video_messages.up.sql", size: 462, mode: os.FileMode(0644), modTime: time.Unix(1792148670, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa5, 0x7d, 0x78, 0xac, 0xc8, 0x9f, 0xb8, 0xe3, 0x3f, 0x65, 0xb3, 0x62, 0x1e, 0xdf, 0xed, 0x7a, 0xc1, 0x3d, 0x3, 0x8, 0x38, 0xde, 0x1a, 0xbf, 0x68, 0x52, 0xdc, 0x71, 0xfa, 0xbc, 0xed, 0x6}}
+	return a, nil
+}
+
+var __1688210015_add_unfurled_status_linksUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x2d\x4e\x2d\x8a\xcf\x4d\x2d\x2e\x4e\x4c\x4f\x2d\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x28\xcd\x4b\x2b\x2d\xca\x49\x4d\x89\x2f\x2e\x49\x2c\x29\x2d\x8e\xcf\xc9\xcc\xcb\x2e\x56\x70\xf2\xf1\x77\xb2\xe6\x02\x00\x48\x71\x81\x1f\x41\x00\x00\x00")
+
+func _1688210015_add_unfurled_status_linksUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210015_add_unfurled_status_linksUpSql,
+		"1688210015_add_unfurled_status_links.up.sql",
+	)
+}
+
+func _1688210015_add_unfurled_status_linksUpSql() (*asset, error) {
+	bytes, err := _1688210015_add_unfurled_status_linksUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210015_add_unfurled_status_links.up.sql", size: 65, mode: os.FileMode(0644), modTime: time.Unix(1792149009, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc7, 0x79, 0x8a, 0xf2, 0x90, 0x17, 0x73, 0x36, 0xb1, 0x4, 0xc6, 0x55, 0x7a, 0x2e, 0xd0, 0x5b, 0xc1, 0xa5, 0xca, 0x36, 0x64, 0x16, 0x5, 0x50, 0x5c, 0x7c, 0x7c, 0x28, 0x45, 0xfd, 0xa, 0xd5}}
+	return a, nil
+}
+
+var __1688210016_add_communities_airdrop_snapshotsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x51\xcb\x0e\x82\x30\x10\xbc\xf3\x15\x7b\x84\x84\x3f\xf0\x04\xb5\x26\xc4\x5a\x0c\xa9\x89\x9e\x9a\x4a\x9b\xb8\x51\x81\xb4\xe5\xc0\xdf\x8b\x0f\x4c\x7d\x44\x0f\x7b\xd9\x99\x9d\x9d\x9d\x25\x15\xcd\x04\x05\x91\xe5\x8c\x42\xdd\x9e\xcf\x7d\x83\x1e\x8d\x93\x0a\xad\xb6\x6d\x27\x5d\xa3\x3a\x77\x68\xbd\x83\x38\x02\x40\x0d\x82\x6e\x05\xac\xab\x62\x95\x55\x3b\x58\xd2\x1d\x94\x1c\x48\xc9\x17\xac\x20\x02\x2a\xba\x66\x19\xa1\xe9\x48\x9d\xc4\x06\x39\x0d\xf1\x72\xac\x0d\x63\x37\xd4\x1a\xe5\x8d\x96\xca\x43\xc1\xdf\x21\xf4\xc6\xa2\x82\x9c\x95\x79\x94\xcc\xa2\x88\xdc\x4d\x16\x7c\x4e\xb7\xbf\x4d\xca\x97\xad\xa3\xb3\x9f\xec\x38\x64\x07\x8b\xfe\xa7\x21\xad\xa9\xb1\x43\xd3\x3c\x72\x79\xf6\xbf\xdd\xda\xf5\xfb\x13\xd6\xf2\x68\x86\x4f\x4c\x69\x6d\x8d\x73\x9f\x40\x98\x70\x1c\xc8\xa7\x81\x5c\x3a\x8d\x27\xdf\x9e\x70\x8d\xee\x02\x3d\x66\xbc\x5d\xdf\x01\x00\x00")
+
+func _1688210016_add_communities_airdrop_snapshotsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210016_add_communities_airdrop_snapshotsUpSql,
+		"1688210016_add_communities_airdrop_snapshots.up.sql",
+	)
+}
+
+func _1688210016_add_communities_airdrop_snapshotsUpSql() (*asset, error) {
+	bytes, err := _1688210016_add_communities_airdrop_snapshotsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210016_add_communities_airdrop_snapshots.up.sql", size: 479, mode: os.FileMode(0644), modTime: time.Unix(1792149355, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x19, 0x30, 0x13, 0x96, 0x73, 0xe3, 0xf0, 0x6a, 0x7a, 0xa1, 0xcf, 0xcf, 0xd9, 0x19, 0xc1, 0xf5, 0x13, 0x74, 0x75, 0x4, 0xbc, 0xb8, 0x13, 0xb6, 0xec, 0x46, 0xd6, 0xf5, 0x4a, 0xc7, 0xe1, 0x4a}}
+	return a, nil
+}
+
+var __1688210017_add_communities_soulbound_holdingsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6d\x8e\xb1\x0e\x82\x30\x18\x84\x77\x9e\xe2\x1f\x21\xe9\x1b\x38\x21\x56\xd3\x58\x0b\xc1\x92\xc8\xd4\x00\x6d\xa4\x01\x5a\x43\xdb\x81\xb7\x57\x16\x62\xc4\xe1\xa6\xef\xf2\xdd\x65\x25\x4e\x39\x06\x9e\x1e\x29\x86\xce\x4e\x53\x30\xda\x6b\xe5\x84\xb3\x61\x6c\x6d\x30\x52\xf4\x76\x94\xda\x3c\x1d\xc4\x11\x6c\x95\x45\x68\x09\x1c\x3f\x38\xb0\xfc\x93\x8a\x52\xb4\xd2\xbe\xd1\x66\x25\x15\xbb\x93\x0b\xc3\x27\x20\xec\xa7\x61\x8d\x9f\x9b\xce\x8b\x46\xca\x59\x39\xb7\x77\x78\x3b\x28\xf3\xd7\xfe\x0a\xed\xa8\x3b\x31\xa8\x65\xcf\x8a\x92\xdc\xd2\xb2\x86\x2b\xae\x21\xfe\x3e\x89\xb6\x53\x68\x37\x8e\xb6\xb1\x04\x72\x06\x59\xce\xce\x94\x64\x1c\x4a\x5c\xd0\x34\xc3\x51\x72\x88\xde\x97\x37\x14\xfa\x20\x01\x00\x00")
+
+func _1688210017_add_communities_soulbound_holdingsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210017_add_communities_soulbound_holdingsUpSql,
+		"1688210017_add_communities_soulbound_holdings.up.sql",
+	)
+}
+
+func _1688210017_add_communities_soulbound_holdingsUpSql() (*asset, error) {
+	bytes, err := _1688210017_add_communities_soulbound_holdingsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210017_add_communities_soulbound_holdings.up.sql", size: 288, mode: os.FileMode(0644), modTime: time.Unix(1792149587, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd9, 0xd0, 0x89, 0x5d, 0xe2, 0xc9, 0x91, 0xc8, 0x35, 0xbf, 0x88, 0x9b, 0xee, 0x36, 0xf3, 0xf3, 0x35, 0xe, 0x38, 0x88, 0x86, 0x72, 0x66, 0xae, 0x52, 0x77, 0xcc, 0xa9, 0x12, 0xbd, 0xd5, 0xd4}}
+	return a, nil
+}
+
+var __1688210018_add_safe_transactionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x50\xc1\xaa\xc2\x30\x10\xbc\xf7\x2b\xf6\xa8\xe0\x1f\x78\x4a\xe3\x8a\xe1\xc5\x54\x62\x7c\xe8\x29\x84\xbc\x55\xcb\xf3\xb5\x90\x34\xe2\xe7\xbf\xb6\x14\xb4\xa8\x07\x6f\xcb\xce\xce\xec\xcc\x30\x69\x50\x83\x61\xb9\x44\x48\x91\x82\xfd\xa3\x18\xdd\x89\x22\xb0\xc5\x02\x78\x21\x77\x6b\x05\xd1\x1d\xc9\x36\xc1\x55\xd1\xf9\xa6\xac\x2b\xc8\x65\x91\xcf\xb3\x8c\x6b\x64\x06\x07\xb6\x58\x82\x2a\x0c\xe0\x5e\x6c\xcd\xf6\x89\x62\x63\x79\xaa\x5c\x93\x42\xab\x3c\xc9\x00\x86\x37\xb6\xfc\x81\x6f\xa6\xf9\x8a\xe9\x9e\xad\x76\x52\xce\x5a\xb8\xbb\xa6\xf0\x16\xea\x85\x7a\x17\x63\xa8\x4e\xc1\x13\x18\xdc\x9b\xd1\xde\x5f\x6a\xff\x6b\xaf\xee\x92\x08\x84\x1a\x63\x1b\x2d\xd6\x4c\x1f\xe0\x0b\x0f\x30\xb9\xbb\x9a\x0d\x16\xa6\x50\xa8\xb6\x06\xb5\x94\x82\x1b\xd0\xb8\x91\x8c\x63\x36\xfd\x30\x3c\xdd\xc8\xa7\x6e\x7a\x1b\xfe\xd1\xc6\x8b\x8f\x9d\xd3\x47\xc1\xb3\x8b\xe7\xd7\xed\x7c\x58\x41\x17\xe5\x1f\x21\xf3\x89\xa4\x04\x02\x00\x00")
+
+func _1688210018_add_safe_transactionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210018_add_safe_transactionsUpSql,
+		"1688210018_add_safe_transactions.up.sql",
+	)
+}
+
+func _1688210018_add_safe_transactionsUpSql() (*asset, error) {
+	bytes, err := _1688210018_add_safe_transactionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210018_add_safe_transactions.up.sql", size: 516, mode: os.FileMode(0644), modTime: time.Unix(1792151863, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x40, 0xd1, 0x67, 0x42, 0x41, 0x2f, 0xf2, 0x5b, 0xdf, 0x5e, 0x6d, 0x93, 0xa2, 0x59, 0x22, 0x4f, 0xf1, 0xbd, 0x50, 0x8c, 0xe3, 0x87, 0x2e, 0x1d, 0x9, 0xd4, 0xfc, 0xb5, 0xe2, 0xb6, 0xc4, 0xc}}
+	return a, nil
+}
+
+var __1688210019_add_social_recoveryUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbd\x93\xcb\x4e\xc3\x30\x10\x45\xf7\xf9\x8a\x59\xb6\x52\x22\xb1\x67\xe5\x06\x57\x54\x98\xa4\x0a\x2e\x52\x57\x95\x71\x06\x12\xd5\x8a\xc1\x76\x8a\xf2\xf7\xe4\xd1\x94\x56\x4d\x4b\x90\x10\x4b\xdf\x49\xe6\x71\xe6\x4e\x10\x00\x37\xa5\x75\x98\x82\xd4\x85\x13\xd2\x59\xc8\xb4\x4a\xf3\xe2\x0d\x04\xd8\x4c\x18\x04\xfd\x0a\x2e\x43\x30\x28\xf5\x0e\x4d\x05\x5b\xac\x7a\xad\xb4\x68\xbc\x30\xa1\x84\x53\xe0\x64\xc6\x28\x58\x2d\x73\xa1\x36\xfd\xc7\x1b\xd7\x66\x47\x0b\x13\x0f\xc0\xa2\xdb\xe4\x29\x3c\x93\x24\xbc\x27\x09\x44\x31\x87\x68\xc5\x98\x5f\x87\xf6\xd5\x2f\x85\xad\x13\x0e\x61\x11\xf1\x83\x0a\x77\x74\x4e\x56\x8c\xc3\x4d\xfb\xbb\xd2\x72\x7b\x12\x6f\xd4\x65\xb2\x78\x24\xc9\x1a\x1e\xe8\x1a\x26\x5d\x71\xff\xa8\xd2\x14\xe2\x08\xc2\x38\x9a\xb3\x45\xc8\x21\xa1\x4b\x46\x42\xea\x4d\x6f\x3d\x2f\x08\xe0\xa9\x99\xdd\x0e\x0d\xdf\x8a\xdf\xb4\x50\xa5\xf0\x52\x8d\xc4\x61\xbb\xac\x0d\x0c\xfd\x59\xa0\x19\x9a\xf7\xa4\xed\x81\x0e\xfd\xeb\x24\xbb\xa5\xcd\x58\x3c\x3b\x91\xdf\x45\xa5\xb4\x48\xcf\x03\x2e\xab\x3b\x6a\x76\x7e\x86\xcf\x69\x27\xd4\x99\xfa\xfb\x55\xb4\x48\xaf\x62\x31\xf8\x51\xa2\x75\x1d\x98\xfd\x63\x18\x8e\x7f\x8d\xdc\x1f\x39\xe5\xb8\x01\xff\x50\x6d\x8c\x5b\x0c\x2a\x14\xb6\x3e\x26\xa7\x6b\xae\xb9\x85\x14\x77\xb9\xc4\xe6\xb9\x9f\xb5\xf5\x89\x90\x52\x97\x85\x6b\x7c\xd4\x67\xff\x89\x4f\x97\x77\x94\x7f\xc6\x9c\xd3\x7f\xd8\x67\x04\xe9\x7e\x86\x51\x57\xf9\x05\x53\xc5\xe8\xdc\xac\x04\x00\x00")
+
+func _1688210019_add_social_recoveryUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210019_add_social_recoveryUpSql,
+		"1688210019_add_social_recovery.up.sql",
+	)
+}
+
+func _1688210019_add_social_recoveryUpSql() (*asset, error) {
+	bytes, err := _1688210019_add_social_recoveryUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210019_add_social_recovery.up.sql", size: 1196, mode: os.FileMode(0644), modTime: time.Unix(1792152696, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xcc, 0x95, 0x7a, 0x82, 0xc6, 0x64, 0x9f, 0x2e, 0xea, 0x38, 0x4d, 0x5c, 0xa7, 0x4e, 0x5a, 0xe7, 0xf, 0x2d, 0xb8, 0x52, 0x32, 0xb2, 0xe4, 0xb8, 0x8d, 0x57, 0xa2, 0x72, 0xa6, 0x42, 0x99, 0x4}}
+	return a, nil
+}
+
+var __1688210020_add_social_recovery_recoveriesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x90\xc1\x4e\x84\x30\x10\x86\xef\x3c\xc5\x7f\x63\x37\x81\x27\xf0\x84\xc8\x2a\x11\xc1\x34\xac\xc9\x9e\x48\x2d\x43\x68\x5c\xa9\xb6\xdd\xdd\xf0\xf6\xb6\x0b\x18\x12\xa3\xf1\x36\x33\xfd\xa6\xfd\xfe\xc6\x31\x18\x09\x75\x26\x2d\xc9\xc0\x58\xae\x2d\xb5\xe8\xb4\x7a\x87\xed\xa5\x41\x4b\x67\x29\x28\x82\x21\xdb\xc8\x16\x6e\x62\x7b\xf2\x1d\x54\x37\x95\x3d\xd7\x64\x82\x38\x06\x17\x82\x3e\xdc\x76\x04\x69\x43\x73\x65\x5e\xc7\x2b\xd3\x49\x6d\xec\x44\x42\xd3\x91\xb8\x71\x6f\x5c\x7a\x1a\x26\x72\x50\xd6\x8d\x3f\x4f\x64\xdc\x76\x90\xb2\x2c\xa9\x33\xd4\xc9\x6d\x91\xc1\x28\x21\xf9\xb1\xd1\x93\xe2\xb8\x14\xde\x75\x13\x00\xea\x32\x90\xf6\x5e\x2f\x09\x4b\x1f\x12\x86\xb2\xaa\x51\xee\x8b\x02\xcf\x2c\x7f\x4a\xd8\x01\x8f\xd9\x21\x72\xe0\xac\xff\x03\xbb\xcb\x76\xc9\xbe\xa8\x11\x86\xc1\xf6\x26\xf0\x29\x6a\x7d\xf2\x1a\x10\x6a\xb0\x5c\x58\x03\x6e\xde\x5c\x6b\xd5\x62\xee\x13\x49\x3d\xa7\x71\xe3\xd5\x37\xfd\xcb\x7d\x6c\xbe\xaf\xfe\x33\x82\xd7\x9e\xc9\xdf\x8e\x57\x21\xb1\x59\x2e\x8a\x56\x5b\x5b\x54\x25\xd2\xaa\xdc\x15\x79\x5a\x23\xbf\x2f\x2b\x96\xf9\xa0\x5f\xa8\x33\x18\x43\xf6\x01\x00\x00")
+
+func _1688210020_add_social_recovery_recoveriesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210020_add_social_recovery_recoveriesUpSql,
+		"1688210020_add_social_recovery_recoveries.up.sql",
+	)
+}
+
+func _1688210020_add_social_recovery_recoveriesUpSql() (*asset, error) {
+	bytes, err := _1688210020_add_social_recovery_recoveriesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210020_add_social_recovery_recoveries.up.sql", size: 502, mode: os.FileMode(0644), modTime: time.Unix(1792153640, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8c, 0xd, 0xb6, 0xd2, 0x9, 0x48, 0xdb, 0x9a, 0x81, 0xb2, 0xec, 0x59, 0xc0, 0xff, 0x45, 0x97, 0x6e, 0x1b, 0xdd, 0x78, 0x93, 0xae, 0x10, 0x60, 0x2f, 0x89, 0x35, 0x64, 0xa1, 0x27, 0x46, 0x58}}
+	return a, nil
+}
+
+var __1688210021_delete_replaced_user_messages_ftsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x8e\xc1\x6a\xc3\x30\x10\x44\xef\xfa\x8a\x39\x36\x50\xf7\x07\x42\x0f\x4d\xb2\x71\x0d\xad\x0c\x8a\xa1\x47\xe3\x46\x6b\x47\x90\x5a\x41\xda\xe0\xf6\xef\x2b\x1b\xf5\x50\x72\xc9\x69\x61\x76\xe6\xcd\x14\x05\x0c\x5f\xce\xdd\xd1\x8d\x03\x3a\x7c\x71\x8c\xdd\xc0\xb0\x7c\x66\xe1\x08\x27\x11\xc1\x4f\x98\x9c\x9c\xfc\x55\xd0\xbb\x30\x1b\xe5\xf4\x67\x81\x04\x37\x0c\x1c\xe2\xa3\x2a\x8a\x45\x77\xa3\xe5\x6f\xf0\x28\xe1\x07\xbe\x5f\xa4\xb0\x34\xb0\x5d\x50\x2e\xe6\xa8\xc5\x27\xf7\x3e\x70\x4e\x45\x0e\x32\x1f\xe1\xce\xaa\xad\xa1\x97\x86\xd0\x98\xaa\x2c\xc9\xe0\x9a\x9e\x6d\xde\x16\xdb\x5e\x62\x9b\x91\xd8\xd0\xbe\x36\x84\x4a\x1f\xc8\x34\xa8\xf5\x7f\xab\xda\x50\x59\x69\x05\xec\xe8\x8d\x12\x6f\x6f\xea\xf7\x5b\x18\x3e\x5e\x29\x31\xac\x3f\x3a\x9b\x48\x78\x38\x24\xf7\xb6\x99\xd7\x26\xe1\x36\x93\xfd\xe9\xf7\x8c\x91\xa7\x27\x67\x57\x6b\x45\x7a\xb7\x56\xea\xfe\x1e\x5d\x37\xf7\x74\x25\xf2\x2f\x36\xb5\xa8\x48\xa3\x01\x00\x00")
+
+func _1688210021_delete_replaced_user_messages_ftsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210021_delete_replaced_user_messages_ftsUpSql,
+		"1688210021_delete_replaced_user_messages_fts.up.sql",
+	)
+}
+
+func _1688210021_delete_replaced_user_messages_ftsUpSql() (*asset, error) {
+	bytes, err := _1688210021_delete_replaced_user_messages_ftsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210021_delete_replaced_user_messages_fts.up.sql", size: 419, mode: os.FileMode(0644), modTime: time.Unix(1792154720, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x0, 0x92, 0xf1, 0xe2, 0xbe, 0x28, 0x84, 0x56, 0xf1, 0xbd, 0x7d, 0x10, 0x87, 0x9d, 0x9b, 0x79, 0xaf, 0x6c, 0xc8, 0xc3, 0x1, 0xf9, 0xdd, 0x14, 0xcf, 0x26, 0x9b, 0xbe, 0x52, 0xde, 0x55, 0x99}}
+	return a, nil
+}
+
+var __1688210022_add_social_recovery_signaturesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x8e\xc1\x0a\xc2\x30\x10\x44\xef\x7e\xc5\x7c\x80\x05\xef\x9e\x52\x5b\x41\x88\x2d\x68\x7a\x2e\x4b\xdd\xb6\x81\x90\x4a\x12\x2d\xfd\x7b\x53\x4a\x11\x44\x3c\x78\xd8\xc3\xec\xec\xbc\x9d\x24\xc1\x55\x77\x96\xc2\xc3\xb1\xc7\xd0\x22\xf4\x8c\x61\xb4\xec\xa2\x7a\xb2\x9b\xb5\x76\xf0\x3d\x45\x7f\x0b\x82\x63\xc3\xe4\xf9\xb6\xac\xa0\xe3\x99\x35\xd3\x26\x49\x40\x4d\xc3\xf7\x10\x9d\xb1\x67\x0b\x1d\x10\xe3\xba\xd5\x11\x4b\x1d\x69\xeb\xc3\x9b\xbd\x11\x52\xe5\x17\x28\x91\xca\x1c\x7e\x68\x34\x99\xda\x71\x33\x3f\x9c\xea\xe5\x17\x44\x96\xe1\x50\xca\xea\x5c\xc0\xaf\x0d\x91\xca\x32\xdd\xff\x4c\xaf\xfd\xbe\x60\xc2\x10\xc8\xe0\x54\x28\x14\x65\x9c\x4a\x4a\x64\xf9\x51\x54\x52\x61\xf7\x37\xf4\xb3\xdb\x0b\x5e\xd6\x6b\x6c\x51\x01\x00\x00")
+
+func _1688210022_add_social_recovery_signaturesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210022_add_social_recovery_signaturesUpSql,
+		"1688210022_add_social_recovery_signatures.up.sql",
+	)
+}
+
+func _1688210022_add_social_recovery_signaturesUpSql() (*asset, error) {
+	bytes, err := _1688210022_add_social_recovery_signaturesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210022_add_social_recovery_signatures.up.sql", size: 337, mode: os.FileMode(0644), modTime: time.Unix(1792156496, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7, 0xa1, 0xbc, 0x18, 0x93, 0x56, 0xbd, 0x43, 0xb0, 0x7e, 0xed, 0x82, 0xc6, 0xf2, 0x5a, 0xb0, 0xb9, 0x57, 0x2b, 0xca, 0xcb, 0x3, 0x66, 0x1c, 0x3f, 0x45, 0x75, 0x9d, 0xc5, 0xdd, 0x59, 0x3}}
+	return a, nil
+}
+
+var __1688210024_add_deploy_tx_to_community_tokensUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x8e\x3f\x0b\xc2\x30\x14\xc4\xf7\x7e\x8a\xdb\xba\x58\x70\x77\x8a\xb6\xa2\x10\x2b\x94\xd4\xb5\x94\x34\xd2\xa0\x79\x91\xe6\xd5\x3f\xdf\xde\x14\x3b\x08\x6e\x8e\xef\xee\xf7\xee\x2e\xcb\xa0\x7a\x83\xce\xdc\xae\xfe\xe5\x0c\x31\x78\x68\x29\xb4\x9a\xad\x27\xf8\x33\x38\xba\xec\x2f\x86\xc2\x0c\x99\x0e\xe7\xc1\xbb\x68\xd8\x49\xba\x5b\x6d\x16\x13\x65\x87\x24\xcb\xbe\x83\xa2\xfd\x68\x59\xf7\xf1\x61\x24\xb6\x57\x58\x4e\x03\x9c\xa5\x28\xf8\x21\x5e\x01\xe4\x49\x9b\x09\x1c\x83\xe9\x12\x21\x55\x51\x41\x89\xb5\x2c\xa0\xbd\x73\x23\x59\x7e\x35\x73\xb9\xc8\x73\x6c\x8e\xb2\x3e\x94\x73\x47\xc3\xcf\xa6\x6f\x43\x8f\x93\xa8\x36\x3b\x51\xa1\x3c\x2a\x94\xb5\x94\xc8\x8b\xad\xa8\xa5\x42\x9a\xae\xfe\xc8\xfc\x6c\xda\x97\xea\x37\x70\xb9\x4a\xde\x27\x3f\xd6\x33\x30\x01\x00\x00")
+
+func _1688210024_add_deploy_tx_to_community_tokensUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210024_add_deploy_tx_to_community_tokensUpSql,
+		"1688210024_add_deploy_tx_to_community_tokens.up.sql",
+	)
+}
+
+func _1688210024_add_deploy_tx_to_community_tokensUpSql() (*asset, error) {
+	bytes, err := _1688210024_add_deploy_tx_to_community_tokensUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210024_add_deploy_tx_to_community_tokens.up.sql", size: 304, mode: os.FileMode(0644), modTime: time.Unix(1792156778, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x67, 0xee, 0xfc, 0x7e, 0xa3, 0x9a, 0x23, 0xf7, 0xc5, 0x70, 0x23, 0x43, 0x50, 0x1d, 0xee, 0xde, 0x60, 0x55, 0x39, 0xd3, 0x94, 0xc1, 0xe6, 0x74, 0x13, 0x4b, 0x49, 0x3f, 0x3e, 0x79, 0x18, 0x72}}
+	return a, nil
+}
+
+var __1688210025_add_message_videosUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x85\x91\xd1\x6e\x82\x30\x14\x86\xef\xfb\x14\xe7\x6e\x9a\xc8\x5e\x80\x78\x01\x58\xb4\x19\x16\x53\xaa\x9b\x57\xa4\xb1\x95\x35\x43\x4a\x28\x9a\xf8\xf6\x2b\x38\xd8\xe4\x66\x17\x4d\x9a\xf6\x3f\xff\x39\xe7\xfb\x3d\x0f\x0e\x5a\x2a\x63\x41\x34\x0a\xbe\x54\xdd\x82\xb9\xba\x73\x86\xab\x55\x4d\x7e\x51\xd6\x8a\x42\x59\xb0\x06\xda\x4f\xd1\x42\x69\x84\xd4\x55\x01\xa2\x92\x60\xc5\xad\xbb\x0e\x1a\xe4\x79\x20\x0a\xa1\x2b\x90\x46\xd9\xea\xa5\x85\x93\xa9\xef\xae\x4c\x5d\x9c\xb9\xb9\x56\x12\x45\x0c\x07\x1c\x03\x0f\xc2\x04\x03\x89\x81\xa6\x1c\xf0\x07\xc9\x78\x36\xb8\xe4\xb7\xc7\x34\x33\x04\xe3\x93\x96\x70\x08\x58\xb4\x09\x18\xec\x18\xd9\x06\xec\x08\x6f\xf8\x08\x29\x85\x28\xa5\x71\x42\x22\x0e\x0c\xef\x92\x20\xc2\x0b\x57\x55\x8b\x7b\x37\x24\x84\x49\x1a\xf6\x0d\xe8\x3e\x49\xd0\xdc\x47\x88\xd0\x0c\x33\x0e\x84\xf2\x74\xd2\x6e\xf6\xdb\x6a\x31\x18\xcc\x21\xc3\x09\x76\xde\xdd\x5b\x2f\xcb\x07\xeb\x98\xa5\xdb\x09\x9f\xf7\x0d\x66\x78\x22\x23\xd9\xd8\xdf\x47\xfb\xdd\xaa\x5b\xfd\xb9\x2a\xc3\x7c\x52\xb3\xec\xe5\xff\xdb\x75\xb0\x99\xaa\x4b\x71\xea\xd3\x18\xf6\x19\xd1\x9f\xb5\x8b\xd3\xa1\x87\xb6\xd1\x45\xa1\x9a\x05\xe8\xd6\x3e\x0c\x41\xdb\x3e\xe8\x31\x0d\x46\xd6\x6b\xcc\x26\x48\x72\xa9\x4a\xd5\x2a\x08\x62\xee\xfe\x56\x0e\x85\x93\x3a\xe4\x4f\x0b\xa0\x10\xaf\x09\x75\xd0\x7f\xfe\x7b\x30\x93\x24\x1f\xab\xfc\xc9\x72\x09\xa6\x94\xaf\x5a\xfa\x08\xd3\x95\x8f\xbe\x01\x7d\x72\x8a\xee\x82\x02\x00\x00")
+
+func _1688210025_add_message_videosUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210025_add_message_videosUpSql,
+		"1688210025_add_message_videos.up.sql",
+	)
+}
+
+func _1688210025_add_message_videosUpSql() (*asset, error) {
+	bytes, err := _1688210025_add_message_videosUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210025_add_message_videos.up.sql", size: 642, mode: os.FileMode(0644), modTime: time.Unix(1792157613, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x76, 0x7c, 0xba, 0x20, 0xb3, 0xe3, 0xa9, 0x4d, 0xc7, 0xd5, 0xc7, 0x2a, 0xe9, 0x8f, 0xb0, 0xf0, 0x9f, 0x25, 0x9d, 0x31, 0xa1, 0x6f, 0xd5, 0x93, 0xd4, 0xed, 0x46, 0xd8, 0x6e, 0x6d, 0x6e, 0x5d}}
+	return a, nil
+}
+
+var __1688210026_add_airdrop_address_to_revealed_addressesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x35\xc9\xb1\x12\xc2\x20\x0c\x00\xd0\xdd\xaf\xc8\x7f\x38\xa5\x42\xa7\x08\x77\x0a\x73\x8e\x2b\x19\xe2\xd9\xa2\x84\xf6\xfb\x75\xe9\xfa\x1e\x52\xf2\x0f\x48\x38\x91\x87\xa5\xad\xeb\xbe\xe9\x50\x31\xee\xf2\xdd\xc5\x86\xf1\x68\xfc\x6a\xba\xfd\xe1\x90\xf2\x96\xca\xa5\xd6\x2e\x66\x62\x80\xce\xc1\x2d\x52\xbe\x07\x50\xe3\xa2\xbd\xf6\xf6\x39\x1f\xa6\x18\xc9\x63\x80\x10\x13\x84\x4c\x04\xce\xcf\x98\x29\xc1\x8c\xf4\xf4\xd7\xcb\x0f\x99\xc7\x94\x23\x7a\x00\x00\x00")
+
+func _1688210026_add_airdrop_address_to_revealed_addressesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210026_add_airdrop_address_to_revealed_addressesUpSql,
+		"1688210026_add_airdrop_address_to_revealed_addresses.up.sql",
+	)
+}
+
+func _1688210026_add_airdrop_address_to_revealed_addressesUpSql() (*asset, error) {
+	bytes, err := _1688210026_add_airdrop_address_to_revealed_addressesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210026_add_airdrop_address_to_revealed_addresses.up.sql", size: 122, mode: os.FileMode(0644), modTime: time.Unix(1792157991, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8f, 0x73, 0xb0, 0x6f, 0x6, 0x40, 0x84, 0xfb, 0x95, 0xad, 0xcc, 0x2a, 0xf4, 0x85, 0x73, 0x89, 0x62, 0x6f, 0x70, 0x63, 0x1, 0xff, 0xe8, 0xf6, 0x8e, 0x45, 0xc5, 0x98, 0xda, 0x14, 0x5e, 0xa9}}
+	return a, nil
+}
+
+var __1688210027_add_user_messages_blocklistedUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x55\x8f\x41\x6a\xc3\x30\x10\x45\xf7\x3e\xc5\x5f\xb6\x50\x9f\x20\x2b\x25\x56\x68\x41\x91\xc0\x95\x69\x77\x46\xb1\x27\xb1\x88\x65\x05\x49\x6e\xc8\xed\xab\x18\x5a\xe2\xcd\x30\xf0\x86\x37\xff\x97\x25\x0e\x14\xa3\x39\x53\x84\x3f\x61\x8e\x14\x22\x8e\xa3\xef\x2e\xa3\x8d\x89\x7a\x1c\xef\x30\xe8\xbc\x73\xf3\x64\x53\xde\x03\xe1\x42\xd7\x84\xc1\xf6\x3d\x4d\x6f\x88\x1e\x69\x30\x29\x0f\xba\x17\x65\x89\x38\xf8\x1b\xe6\x2b\xcc\xd9\xd8\x09\xb7\x81\xa6\x07\x5a\xc4\xb0\x11\x81\x9c\xff\xc9\xda\x53\xf0\x6e\x01\xff\xbf\x0a\x26\x34\xaf\xa1\xd9\x56\xf0\xe5\xbc\x75\x7f\xc1\x58\x55\x61\xa7\x44\x73\x90\xab\x68\x5b\xa5\x04\x67\x12\x52\x69\xc8\x46\x08\x54\x7c\xcf\x1a\xa1\xb1\x67\xe2\x93\x6f\x8a\x5d\xcd\x99\xe6\xf8\x90\x15\xff\x5e\x1b\xdb\x67\x8d\x92\x6b\xf8\x92\x99\x19\xdb\x2e\xb7\x6a\x6d\xff\x68\x38\x87\x8e\x5e\xf1\xf5\xce\x6b\xfe\x1c\x60\x53\xfc\x02\x6c\xb7\x86\x5f\x3e\x01\x00\x00")
+
+func _1688210027_add_user_messages_blocklistedUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210027_add_user_messages_blocklistedUpSql,
+		"1688210027_add_user_messages_blocklisted.up.sql",
+	)
+}
+
+func _1688210027_add_user_messages_blocklistedUpSql() (*asset, error) {
+	bytes, err := _1688210027_add_user_messages_blocklistedUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210027_add_user_messages_blocklisted.up.sql", size: 318, mode: os.FileMode(0644), modTime: time.Unix(1792158246, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3a, 0xa9, 0xe1, 0x24, 0x36, 0x96, 0xd2, 0x33, 0x7d, 0xf8, 0x91, 0xe1, 0x54, 0xc7, 0xd4, 0x3c, 0x2b, 0xdd, 0x69, 0x79, 0xb9, 0xde, 0xb5, 0xf9, 0xdc, 0x59, 0xf0, 0x83, 0xdd, 0x1b, 0x3d, 0xc5}}
+	return a, nil
+}
+
+var __1688210028_add_safe_transaction_unverified_executionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6d\x90\xc1\x4e\x83\x40\x10\x86\xef\x3c\xc5\x7f\xb3\x4d\xe0\x09\x3c\x21\xd9\x46\x52\xa4\x0d\x5d\x4d\x7b\x22\x93\x65\xea\x6e\x4a\x77\xc9\x2e\x54\x79\x7b\x41\x63\x6a\xd5\xdb\x64\xfe\x99\x2f\xdf\x4c\x92\x40\xbc\xb3\x1a\x7a\xe3\x6c\x80\x67\xc5\xe6\xc2\x0d\x8c\x05\x41\x69\xea\x41\x9e\xe1\x6c\x3b\x42\xb5\x64\xce\x01\xee\x88\x5e\xb3\xf1\x08\x6c\x1b\xf6\x18\x6c\x6f\xda\xb9\x35\xde\x79\x8e\x92\x64\xda\x62\x75\x9a\x10\xce\xce\x00\x63\x63\xbc\x69\xa3\x34\xce\x34\x7e\x81\x34\x75\x1d\xdb\xa9\x56\x3c\xef\xa1\xf3\xae\x73\x81\x5a\x98\xab\x40\x94\x55\x22\x95\x02\x32\x7d\x28\x04\xf2\x15\xca\x8d\x84\xd8\xe7\x3b\xb9\x43\xa0\x23\xd7\xbd\x27\x1b\x48\xcd\xda\xf5\x60\x2f\xec\xcd\xd1\x70\x53\xf3\xf5\x96\x45\x04\x9c\x39\x04\x7a\xe5\xda\x34\x78\x49\xab\xec\x31\xad\x3e\x41\xe5\x73\x51\xc4\x53\xfc\x13\xa2\x29\xe8\x7f\x87\x82\x1b\xfc\x64\x2a\xc5\x5e\xde\xf4\x55\xeb\xd4\xa9\xbe\x50\x3b\x30\xf2\xf2\x36\xfb\x3e\xa3\x9e\x1e\xf8\x3b\xdb\x56\xf9\x53\x5a\x1d\xb0\x16\x07\x2c\xae\x82\xf1\x1f\x9b\x25\x36\x25\xb2\x4d\xb9\x2a\xf2\x4c\xa2\x12\xdb\x22\xcd\x44\xb4\xbc\x8f\x3e\x00\xc0\xfe\xac\xe5\xb4\x01\x00\x00")
+
+func _1688210028_add_safe_transaction_unverified_executionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210028_add_safe_transaction_unverified_executionsUpSql,
+		"1688210028_add_safe_transaction_unverified_executions.up.sql",
+	)
+}
+
+func _1688210028_add_safe_transaction_unverified_executionsUpSql() (*asset, error) {
+	bytes, err := _1688210028_add_safe_transaction_unverified_executionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210028_add_safe_transaction_unverified_executions.up.sql", size: 436, mode: os.FileMode(0644), modTime: time.Unix(1792165480, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe3, 0x35, 0x47, 0x71, 0x26, 0x69, 0x2e, 0xb9, 0x15, 0xb7, 0x87, 0x69, 0x66, 0xe9, 0x84, 0xcd, 0xec, 0xa6, 0xb9, 0xb5, 0xfc, 0xa6, 0x17, 0xb, 0x7b, 0x99, 0x88, 0x8, 0x78, 0xcb, 0x48, 0x21}}
+	return a, nil
+}
+
+var __1688210029_backfill_outbox_messagesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5d\x52\x4d\x4f\x83\x40\x10\xbd\xf3\x2b\xde\xad\x10\xa1\x69\x6b\xe2\xc5\x7a\x30\x8a\xb1\x46\x21\x69\x31\x1e\xc9\x76\x59\x2d\xa6\xec\x36\xbb\x43\x6a\xff\xbd\xb3\x2b\x35\xa1\x1c\x36\x33\x6f\xde\xbc\xf9\x22\xcb\xf0\xae\x9d\xd2\x04\xb9\x13\x84\x4e\x39\x27\xbe\x94\x43\x3c\x4f\x20\x74\x03\xd5\x99\xef\x16\x56\x09\x49\xad\xd1\x8c\x2f\x16\x09\xcc\x27\x0e\xfd\x76\xdf\x4a\x76\x03\x2d\xca\x32\x48\xd3\x75\xbd\x6e\xe9\x84\xf8\x26\x09\x6a\x0e\xbd\x53\x0d\xc8\x60\xab\x58\x22\x54\xf9\xb4\xa6\x83\x15\xc7\xfa\x5c\x29\x05\xed\xd4\x09\xc2\x9e\x29\x5e\x2b\xb0\x18\x87\xe9\x69\x6b\x7e\xa0\xcd\x31\xc5\xb1\xa5\x5d\x00\x9d\xe8\xf8\x91\x3b\xd5\xf4\x7b\x15\xad\x8a\x4d\xbe\xae\xb0\x2a\xaa\x72\xa0\xff\x6b\xc7\x6d\x93\x62\x6f\xa4\xd8\xd7\xbe\x9f\xda\xbb\x8e\x04\xf5\x5c\x55\x10\xa9\xee\x40\x6c\x69\xf5\x43\xf5\xe0\x32\x5d\x38\xaa\x95\xb5\xc6\xa6\xe8\x0f\x8d\x20\xd5\x70\x30\x89\x36\xf9\x6b\xfe\x50\xc1\x4e\xbd\x88\x9d\x5e\xa8\x4e\xb8\xf3\xa6\xd5\x5f\x13\x1f\xf3\x76\x2d\x4d\xaf\x29\x30\xbd\x60\x98\xfd\x0a\x0f\xf7\x9b\x1c\x1f\xcf\x79\x31\x62\x61\x89\x39\x2a\x8f\xce\x90\xbf\x32\xe3\x6d\x55\xc4\xd7\x33\xfe\xb0\x5c\x22\x1e\x51\x33\xcc\x93\x14\x37\x3e\x38\x4b\x90\x17\x8f\x5c\x7b\x32\x2a\x13\x3d\xad\xcb\xb7\xd1\x8a\x61\xf1\x52\xae\x8a\xe1\x26\x12\x25\x9b\x3c\x06\xee\x2e\xe7\x88\xb8\xb5\x75\xce\xe8\x90\x59\xd3\xe9\xa0\x78\xb3\xfc\x37\xa4\xf0\x87\xbf\x2f\x1e\x51\x94\xd5\x5f\xf7\x14\xdc\xf1\x20\x77\xb8\x0e\xa8\x9c\xfe\xa7\x2e\xb8\xdd\x24\xfa\xa3\x72\x51\x9f\xee\xe1\x61\x9f\x8c\x84\x7e\x2f\x2e\x97\xdc\x46\xbf\x14\x8b\xe9\x50\x9a\x02\x00\x00")
+
+func _1688210029_backfill_outbox_messagesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210029_backfill_outbox_messagesUpSql,
+		"1688210029_backfill_outbox_messages.up.sql",
+	)
+}
+
+func _1688210029_backfill_outbox_messagesUpSql() (*asset, error) {
+	bytes, err := _1688210029_backfill_outbox_messagesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210029_backfill_outbox_messages.up.sql", size: 666, mode: os.FileMode(0644), modTime: time.Unix(1792166611, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xcd, 0x40, 0x20, 0x50, 0x77, 0xde, 0x4a, 0x2b, 0xf6, 0xf0, 0x22, 0x84, 0xef, 0xc8, 0x8e, 0x4e, 0x42, 0xd7, 0x2c, 0x7a, 0xb4, 0x95, 0x78, 0xee, 0x8c, 0x51, 0x34, 0x4, 0x2f, 0x55, 0xfd, 0x98}}
+	return a, nil
+}
+
+var __1688210030_add_mute_state_to_mute_clocksUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\xcc\xbd\x0e\x82\x30\x14\x86\xe1\x9d\xab\xf8\x6e\x80\xc4\x9d\xa9\x48\x49\x4c\x6a\x49\xb4\xcc\xa4\xa9\x20\xc4\xfe\x18\x7a\x08\xf1\xee\x95\xca\xe6\xe2\x76\xce\x97\x27\x6f\x9e\x43\x8d\x3d\x22\x69\xea\x11\x06\xd0\xe7\xb1\x3a\x12\xdc\xb2\x0d\x33\x16\xff\xbd\x06\x68\x98\x51\x13\xa8\xb7\x36\x42\x3f\xf5\x4c\x49\x6f\x63\x4c\xfc\x96\xe5\x39\x82\xdf\xd6\x69\x46\x58\x3d\x86\x39\xb8\x84\x82\xef\x77\x03\x6d\x83\xbf\x63\x9d\x68\xdc\xa1\x09\xce\x2d\x7e\xa2\x57\xc6\x84\xe2\x17\x28\x56\x0a\x9e\x70\x67\x6c\x30\x8f\x08\x56\x55\x38\x36\xa2\x3d\xcb\xbd\x51\x36\x8d\xe0\x4c\x42\x36\x0a\xb2\x15\x02\x15\xaf\x59\x2b\x14\x6a\x26\xae\xbc\xf8\x37\xd4\xd1\x64\x2d\x4e\x52\xfd\x86\x0e\x45\xf6\x06\x1c\x4d\x35\xd6\x1b\x01\x00\x00")
+
+func _1688210030_add_mute_state_to_mute_clocksUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1688210030_add_mute_state_to_mute_clocksUpSql,
+		"1688210030_add_mute_state_to_mute_clocks.up.sql",
+	)
+}
+
+func _1688210030_add_mute_state_to_mute_clocksUpSql() (*asset, error) {
+	bytes, err := _1688210030_add_mute_state_to_mute_clocksUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1688210030_add_mute_state_to_mute_clocks.up.sql", size: 283, mode: os.FileMode(0644), modTime: time.Unix(1792168924, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x55, 0x62, 0xb2, 0xfd, 0x87, 0xa6, 0x44, 0xb0, 0xd1, 0x5d, 0xe5, 0x64, 0xf5, 0xd1, 0x15, 0xd3, 0xae, 0x95, 0xb4, 0x51, 0x13, 0x94, 0x2a, 0xa4, 0x87, 0x4d, 0x5e, 0x38, 0x9b, 0x26, 0x43, 0x1}}
+	return a, nil
+}
+
 var _readmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\xc1\xce\xd3\x30\x10\x84\xef\x7e\x8a\x91\x7a\x01\xa9\x2a\x8f\xc0\x0d\x71\x82\x03\x48\x1c\xc9\x36\x9e\x36\x96\x1c\x6f\xf0\xae\x93\xe6\xed\x91\xa3\xc2\xdf\xff\x66\xed\xd8\x33\xdf\x78\x4f\xa7\x13\xbe\xea\x06\x57\x6c\x35\x39\x31\xa7\x7b\x15\x4f\x5a\xec\x73\x08\xbf\x08\x2d\x79\x7f\x4a\x43\x5b\x86\x17\xfd\x8c\x21\xea\x56\x5e\x47\x90\x4a\x14\x75\x48\xde\x64\x37\x2c\x6a\x96\xae\x99\x48\x05\xf6\x27\x77\x13\xad\x08\xae\x8a\x51\xe7\x25\xf3\xf1\xa9\x9f\xf9\x58\x58\x2c\xad\xbc\xe0\x8b\x56\xf0\x21\x5d\xeb\x4c\x95\xb3\xae\x84\x60\xd4\xdc\xe6\x82\x5d\x1b\x36\x6d\x39\x62\x92\xf5\xb8\x11\xdb\x92\xd3\x28\xce\xe0\x13\xe1\x72\xcd\x3c\x63\xd4\x65\x87\xae\xac\xe8\xc3\x28\x2e\x67\x44\x66\x3a\x21\x25\xa2\x72\xac\x14\x67\xbc\x84\x9f\x53\x32\x8c\x52\x70\x25\x56\xd6\xfd\x8d\x05\x37\xad\x30\x9d\x9f\xa6\x86\x0f\xcd\x58\x7f\xcf\x34\x93\x3b\xed\x90\x9f\xa4\x1f\xcf\x30\x85\x4d\x07\x58\xaf\x7f\x25\xc4\x9d\xf3\x72\x64\x84\xd0\x7f\xf9\x9b\x3a\x2d\x84\xef\x85\x48\x66\x8d\xd8\x88\x9b\x8c\x8c\x98\x5b\xf6\x74\x14\x4e\x33\x0d\xc9\xe0\x93\x38\xda\x12\xc5\x69\xbd\xe4\xf0\x2e\x7a\x78\x07\x1c\xfe\x13\x9f\x91\x29\x31\x95\x7b\x7f\x62\x59\x37\xb4\xe5\x5e\x25\xfe\x33\xee\xd5\x53\x71\xd6\xda\x3a\xd8\xcb\xde\x2e\xf8\xa1\x90\x55\x53\x0c\xc7\xaa\x0d\xe9\x76\x14\x29\x1c\x7b\x68\xdd\x2f\xe1\x6f\x00\x00\x00\xff\xff\x3c\x0a\xc2\xfe\x2a\x02\x00\x00")
 
 func readmeMdBytes() ([]byte, error) {
@@ -2312,6 +3131,45 @@ var _bindata = map[string]func() (*asset, error){
 	"1687370421_add_communities_muted_till_new.up.sql":                            _1687370421_add_communities_muted_till_newUpSql,
 	"1687416607_add_communities_check_channel_permission_responses_table.up.sql":  _1687416607_add_communities_check_channel_permission_responses_tableUpSql,
 	"1687856939_add_community_tokens_decimals.up.sql":                             _1687856939_add_community_tokens_decimalsUpSql,
+	"1688125132_add_user_messages_fts.up.sql":                                     _1688125132_add_user_messages_ftsUpSql,
+	"1688140000_add_pin_index_to_pin_messages.up.sql":                             _1688140000_add_pin_index_to_pin_messagesUpSql,
+	"1688150000_add_emoji_reactions_counts.up.sql":                                _1688150000_add_emoji_reactions_countsUpSql,
+	"1688160001_add_message_edits.up.sql":                                         _1688160001_add_message_editsUpSql,
+	"1688170000_add_audio_waveform_to_user_messages.up.sql":                       _1688170000_add_audio_waveform_to_user_messagesUpSql,
+	"1688190000_add_communities_moderation_log.up.sql":                            _1688190000_add_communities_moderation_logUpSql,
+	"1688190001_add_communities_events_log.up.sql":                                _1688190001_add_communities_events_logUpSql,
+	"1688200000_add_communities_directory.up.sql":                                 _1688200000_add_communities_directoryUpSql,
+	"1688200001_add_communities_membership_payments.up.sql":                       _1688200001_add_communities_membership_paymentsUpSql,
+	"1688210000_add_waku_bandwidth_stats.up.sql":                                  _1688210000_add_waku_bandwidth_statsUpSql,
+	"1688210001_add_outbox_messages.up.sql":                                       _1688210001_add_outbox_messagesUpSql,
+	"1688210002_add_message_retention_policies.up.sql":                            _1688210002_add_message_retention_policiesUpSql,
+	"1688210003_add_activity_center_wallet_activity.up.sql":                       _1688210003_add_activity_center_wallet_activityUpSql,
+	"1688210004_add_verification_expiry_and_audit.up.sql":                         _1688210004_add_verification_expiry_and_auditUpSql,
+	"1688210005_add_notification_rules.up.sql":                                    _1688210005_add_notification_rulesUpSql,
+	"1688210006_add_chat_drafts.up.sql":                                           _1688210006_add_chat_draftsUpSql,
+	"1688210007_add_community_emoji_reactions.up.sql":                             _1688210007_add_community_emoji_reactionsUpSql,
+	"1688210008_add_deployer_to_community_tokens.up.sql":                          _1688210008_add_deployer_to_community_tokensUpSql,
+	"1688210009_add_attachments.up.sql":                                           _1688210009_add_attachmentsUpSql,
+	"1688210010_add_mute_clocks.up.sql":                                           _1688210010_add_mute_clocksUpSql,
+	"1688210011_add_outgoing_message_filters.up.sql":                              _1688210011_add_outgoing_message_filtersUpSql,
+	"1688210012_add_community_member_profiles.up.sql":                             _1688210012_add_community_member_profilesUpSql,
+	"1688210013_add_contact_requests_bucket.up.sql":                               _1688210013_add_contact_requests_bucketUpSql,
+	"1688210014_add_video_messages.up.sql":                                        _1688210014_add_video_messagesUpSql,
+	"1688210015_add_unfurled_status_links.up.sql":                                 _1688210015_add_unfurled_status_linksUpSql,
+	"1688210016_add_communities_airdrop_snapshots.up.sql":                         _1688210016_add_communities_airdrop_snapshotsUpSql,
+	"1688210017_add_communities_soulbound_holdings.up.sql":                        _1688210017_add_communities_soulbound_holdingsUpSql,
+	"1688210018_add_safe_transactions.up.sql":                                     _1688210018_add_safe_transactionsUpSql,
+	"1688210019_add_social_recovery.up.sql":                                       _1688210019_add_social_recoveryUpSql,
+	"1688210020_add_social_recovery_recoveries.up.sql":                            _1688210020_add_social_recovery_recoveriesUpSql,
+	"1688210021_delete_replaced_user_messages_fts.up.sql":                         _1688210021_delete_replaced_user_messages_ftsUpSql,
+	"1688210022_add_social_recovery_signatures.up.sql":                            _1688210022_add_social_recovery_signaturesUpSql,
+	"1688210024_add_deploy_tx_to_community_tokens.up.sql":                         _1688210024_add_deploy_tx_to_community_tokensUpSql,
+	"1688210025_add_message_videos.up.sql":                                        _1688210025_add_message_videosUpSql,
+	"1688210026_add_airdrop_address_to_revealed_addresses.up.sql":                 _1688210026_add_airdrop_address_to_revealed_addressesUpSql,
+	"1688210027_add_user_messages_blocklisted.up.sql":                             _1688210027_add_user_messages_blocklistedUpSql,
+	"1688210028_add_safe_transaction_unverified_executions.up.sql":                _1688210028_add_safe_transaction_unverified_executionsUpSql,
+	"1688210029_backfill_outbox_messages.up.sql":                                  _1688210029_backfill_outbox_messagesUpSql,
+	"1688210030_add_mute_state_to_mute_clocks.up.sql":                             _1688210030_add_mute_state_to_mute_clocksUpSql,
 	"README.md": readmeMd,
 	"doc.go":    docGo,
 }
@@ -2458,6 +3316,45 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1687370421_add_communities_muted_till_new.up.sql":                            {_1687370421_add_communities_muted_till_newUpSql, map[string]*bintree{}},
 	"1687416607_add_communities_check_channel_permission_responses_table.up.sql":  {_1687416607_add_communities_check_channel_permission_responses_tableUpSql, map[string]*bintree{}},
 	"1687856939_add_community_tokens_decimals.up.sql":                             {_1687856939_add_community_tokens_decimalsUpSql, map[string]*bintree{}},
+	"1688125132_add_user_messages_fts.up.sql":                                     {_1688125132_add_user_messages_ftsUpSql, map[string]*bintree{}},
+	"1688140000_add_pin_index_to_pin_messages.up.sql":                             {_1688140000_add_pin_index_to_pin_messagesUpSql, map[string]*bintree{}},
+	"1688150000_add_emoji_reactions_counts.up.sql":                                {_1688150000_add_emoji_reactions_countsUpSql, map[string]*bintree{}},
+	"1688160001_add_message_edits.up.sql":                                         {_1688160001_add_message_editsUpSql, map[string]*bintree{}},
+	"1688170000_add_audio_waveform_to_user_messages.up.sql":                       {_1688170000_add_audio_waveform_to_user_messagesUpSql, map[string]*bintree{}},
+	"1688190000_add_communities_moderation_log.up.sql":                            {_1688190000_add_communities_moderation_logUpSql, map[string]*bintree{}},
+	"1688190001_add_communities_events_log.up.sql":                                {_1688190001_add_communities_events_logUpSql, map[string]*bintree{}},
+	"1688200000_add_communities_directory.up.sql":                                 {_1688200000_add_communities_directoryUpSql, map[string]*bintree{}},
+	"1688200001_add_communities_membership_payments.up.sql":                       {_1688200001_add_communities_membership_paymentsUpSql, map[string]*bintree{}},
+	"1688210000_add_waku_bandwidth_stats.up.sql":                                  {_1688210000_add_waku_bandwidth_statsUpSql, map[string]*bintree{}},
+	"1688210001_add_outbox_messages.up.sql":                                       {_1688210001_add_outbox_messagesUpSql, map[string]*bintree{}},
+	"1688210002_add_message_retention_policies.up.sql":                            {_1688210002_add_message_retention_policiesUpSql, map[string]*bintree{}},
+	"1688210003_add_activity_center_wallet_activity.up.sql":                       {_1688210003_add_activity_center_wallet_activityUpSql, map[string]*bintree{}},
+	"1688210004_add_verification_expiry_and_audit.up.sql":                         {_1688210004_add_verification_expiry_and_auditUpSql, map[string]*bintree{}},
+	"1688210005_add_notification_rules.up.sql":                                    {_1688210005_add_notification_rulesUpSql, map[string]*bintree{}},
+	"1688210006_add_chat_drafts.up.sql":                                           {_1688210006_add_chat_draftsUpSql, map[string]*bintree{}},
+	"1688210007_add_community_emoji_reactions.up.sql":                             {_1688210007_add_community_emoji_reactionsUpSql, map[string]*bintree{}},
+	"1688210008_add_deployer_to_community_tokens.up.sql":                          {_1688210008_add_deployer_to_community_tokensUpSql, map[string]*bintree{}},
+	"1688210009_add_attachments.up.sql":                                           {_1688210009_add_attachmentsUpSql, map[string]*bintree{}},
+	"1688210010_add_mute_clocks.up.sql":                                           {_1688210010_add_mute_clocksUpSql, map[string]*bintree{}},
+	"1688210011_add_outgoing_message_filters.up.sql":                              {_1688210011_add_outgoing_message_filtersUpSql, map[string]*bintree{}},
+	"1688210012_add_community_member_profiles.up.sql":                             {_1688210012_add_community_member_profilesUpSql, map[string]*bintree{}},
+	"1688210013_add_contact_requests_bucket.up.sql":                               {_1688210013_add_contact_requests_bucketUpSql, map[string]*bintree{}},
+	"1688210014_add_video_messages.up.sql":                                        {_1688210014_add_video_messagesUpSql, map[string]*bintree{}},
+	"1688210015_add_unfurled_status_links.up.sql":                                 {_1688210015_add_unfurled_status_linksUpSql, map[string]*bintree{}},
+	"1688210016_add_communities_airdrop_snapshots.up.sql":                         {_1688210016_add_communities_airdrop_snapshotsUpSql, map[string]*bintree{}},
+	"1688210017_add_communities_soulbound_holdings.up.sql":                        {_1688210017_add_communities_soulbound_holdingsUpSql, map[string]*bintree{}},
+	"1688210018_add_safe_transactions.up.sql":                                     {_1688210018_add_safe_transactionsUpSql, map[string]*bintree{}},
+	"1688210019_add_social_recovery.up.sql":                                       {_1688210019_add_social_recoveryUpSql, map[string]*bintree{}},
+	"1688210020_add_social_recovery_recoveries.up.sql":                            {_1688210020_add_social_recovery_recoveriesUpSql, map[string]*bintree{}},
+	"1688210021_delete_replaced_user_messages_fts.up.sql":                         {_1688210021_delete_replaced_user_messages_ftsUpSql, map[string]*bintree{}},
+	"1688210022_add_social_recovery_signatures.up.sql":                            {_1688210022_add_social_recovery_signaturesUpSql, map[string]*bintree{}},
+	"1688210024_add_deploy_tx_to_community_tokens.up.sql":                         {_1688210024_add_deploy_tx_to_community_tokensUpSql, map[string]*bintree{}},
+	"1688210025_add_message_videos.up.sql":                                        {_1688210025_add_message_videosUpSql, map[string]*bintree{}},
+	"1688210026_add_airdrop_address_to_revealed_addresses.up.sql":                 {_1688210026_add_airdrop_address_to_revealed_addressesUpSql, map[string]*bintree{}},
+	"1688210027_add_user_messages_blocklisted.up.sql":                             {_1688210027_add_user_messages_blocklistedUpSql, map[string]*bintree{}},
+	"1688210028_add_safe_transaction_unverified_executions.up.sql":                {_1688210028_add_safe_transaction_unverified_executionsUpSql, map[string]*bintree{}},
+	"1688210029_backfill_outbox_messages.up.sql":                                  {_1688210029_backfill_outbox_messagesUpSql, map[string]*bintree{}},
+	"1688210030_add_mute_state_to_mute_clocks.up.sql":                             {_1688210030_add_mute_state_to_mute_clocksUpSql, map[string]*bintree{}},
 	"README.md": {readmeMd, map[string]*bintree{}},
 	"doc.go":    {docGo, map[string]*bintree{}},
 }}
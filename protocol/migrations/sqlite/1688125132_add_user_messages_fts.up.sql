@@ -0,0 +1,18 @@
+CREATE VIRTUAL TABLE user_messages_fts USING fts4(text, tokenize=unicode61);
+
+CREATE TRIGGER user_messages_fts_insert AFTER INSERT ON user_messages
+BEGIN
+  INSERT OR REPLACE INTO user_messages_fts(docid, text) VALUES (new.rowid, new.text);
+END;
+
+CREATE TRIGGER user_messages_fts_update AFTER UPDATE OF text ON user_messages
+BEGIN
+  INSERT OR REPLACE INTO user_messages_fts(docid, text) VALUES (new.rowid, new.text);
+END;
+
+CREATE TRIGGER user_messages_fts_delete AFTER DELETE ON user_messages
+BEGIN
+  DELETE FROM user_messages_fts WHERE docid = old.rowid;
+END;
+
+INSERT INTO user_messages_fts(docid, text) SELECT rowid, text FROM user_messages WHERE text IS NOT NULL AND text != '';
@@ -0,0 +1,2 @@
+ALTER TABLE pin_messages ADD COLUMN pin_index INT NOT NULL DEFAULT 0;
+UPDATE pin_messages SET pin_index = clock_value;
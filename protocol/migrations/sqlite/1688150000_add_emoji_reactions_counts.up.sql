@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS emoji_reactions_counts (
+  message_id VARCHAR NOT NULL,
+  local_chat_id VARCHAR NOT NULL,
+  emoji_id INT NOT NULL,
+  count INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (message_id, local_chat_id, emoji_id)
+);
+
+INSERT INTO emoji_reactions_counts (message_id, local_chat_id, emoji_id, count)
+SELECT message_id, local_chat_id, emoji_id, COUNT(*) FROM emoji_reactions WHERE NOT(retracted) GROUP BY message_id, local_chat_id, emoji_id;
+
+CREATE INDEX emoji_reactions_message_id_clock_value_idx ON emoji_reactions(message_id, clock_value);
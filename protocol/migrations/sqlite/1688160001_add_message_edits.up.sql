@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS message_edits (
+  message_id VARCHAR NOT NULL,
+  clock INT NOT NULL,
+  replaced_at INT NOT NULL,
+  text VARCHAR NOT NULL,
+  content_type INT NOT NULL,
+  PRIMARY KEY(message_id, clock)
+);
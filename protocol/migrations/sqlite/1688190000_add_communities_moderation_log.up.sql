@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS communities_moderation_log (
+  community_id BLOB NOT NULL,
+  member_id VARCHAR NOT NULL,
+  moderator_id VARCHAR NOT NULL,
+  reason TEXT NOT NULL DEFAULT "",
+  messages_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+  clock INT NOT NULL,
+  PRIMARY KEY (community_id, member_id, clock) ON CONFLICT REPLACE
+);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS communities_events_log (
+  id VARCHAR NOT NULL,
+  community_id BLOB NOT NULL,
+  author VARCHAR NOT NULL,
+  type INT NOT NULL,
+  clock INT NOT NULL,
+  payload BLOB NOT NULL,
+  timestamp INT NOT NULL,
+  PRIMARY KEY (id) ON CONFLICT REPLACE
+);
+
+CREATE INDEX communities_events_log_community_id_clock ON communities_events_log(community_id, clock);
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS communities_directory (
+  community_id BLOB PRIMARY KEY ON CONFLICT REPLACE,
+  featured BOOLEAN NOT NULL DEFAULT FALSE,
+  fetched_at INT NOT NULL
+);
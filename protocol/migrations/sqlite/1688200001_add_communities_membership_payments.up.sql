@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS communities_membership_payments (
+  chain_id INT NOT NULL,
+  transaction_hash VARCHAR NOT NULL,
+  community_id BLOB NOT NULL,
+  public_key VARCHAR NOT NULL,
+  PRIMARY KEY (chain_id, transaction_hash) ON CONFLICT REPLACE
+);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS waku_bandwidth_stats (
+  hour INT NOT NULL,
+  protocol VARCHAR NOT NULL,
+  bytes_in INT NOT NULL DEFAULT 0,
+  bytes_out INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (hour, protocol)
+);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS outbox_messages (
+  id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  local_chat_id VARCHAR NOT NULL,
+  status VARCHAR NOT NULL,
+  attempts INT NOT NULL DEFAULT 0,
+  next_attempt INT NOT NULL DEFAULT 0,
+  last_error VARCHAR NOT NULL DEFAULT '',
+  updated_at INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX outbox_messages_status_next_attempt ON outbox_messages(status, next_attempt);
@@ -0,0 +1,4 @@
+CREATE TABLE IF NOT EXISTS message_retention_policies (
+  chat_type INT PRIMARY KEY ON CONFLICT REPLACE,
+  max_age INT NOT NULL
+);
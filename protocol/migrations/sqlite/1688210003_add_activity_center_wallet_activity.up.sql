@@ -0,0 +1 @@
+ALTER TABLE activity_center_notifications ADD COLUMN wallet_activity BLOB;
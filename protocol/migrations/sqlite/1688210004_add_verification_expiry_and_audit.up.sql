@@ -0,0 +1,14 @@
+ALTER TABLE verification_requests_individual ADD COLUMN expires_at INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS verification_requests_audit (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  request_id TEXT NOT NULL,
+  from_user TEXT NOT NULL,
+  to_user TEXT NOT NULL,
+  previous_status INT NOT NULL,
+  status INT NOT NULL,
+  timestamp INT NOT NULL
+);
+
+CREATE INDEX verification_requests_audit_from_user ON verification_requests_audit(from_user);
+CREATE INDEX verification_requests_audit_to_user ON verification_requests_audit(to_user);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS notification_rules (
+  id TEXT PRIMARY KEY ON CONFLICT REPLACE,
+  community_id TEXT NOT NULL DEFAULT '',
+  chat_id TEXT NOT NULL DEFAULT '',
+  trigger_type INT NOT NULL DEFAULT 0,
+  action INT NOT NULL DEFAULT 0,
+  quiet_hours_start INT NOT NULL DEFAULT 0,
+  quiet_hours_end INT NOT NULL DEFAULT 0,
+  clock INT NOT NULL DEFAULT 0,
+  deleted BOOLEAN NOT NULL DEFAULT FALSE
+);
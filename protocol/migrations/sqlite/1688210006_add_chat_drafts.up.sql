@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS chat_drafts (
+  chat_id TEXT PRIMARY KEY ON CONFLICT REPLACE,
+  text TEXT NOT NULL DEFAULT '',
+  response_to TEXT NOT NULL DEFAULT '',
+  attachments BLOB,
+  clock INT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,16 @@
+ALTER TABLE emoji_reactions ADD COLUMN community_emoji_id VARCHAR NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS emoji_reactions_counts_new (
+  message_id VARCHAR NOT NULL,
+  local_chat_id VARCHAR NOT NULL,
+  emoji_id INT NOT NULL,
+  community_emoji_id VARCHAR NOT NULL DEFAULT '',
+  count INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (message_id, local_chat_id, emoji_id, community_emoji_id)
+);
+
+INSERT INTO emoji_reactions_counts_new (message_id, local_chat_id, emoji_id, count)
+SELECT message_id, local_chat_id, emoji_id, count FROM emoji_reactions_counts;
+
+DROP TABLE emoji_reactions_counts;
+ALTER TABLE emoji_reactions_counts_new RENAME TO emoji_reactions_counts;
@@ -0,0 +1 @@
+ALTER TABLE community_tokens ADD COLUMN deployer VARCHAR NOT NULL DEFAULT '';
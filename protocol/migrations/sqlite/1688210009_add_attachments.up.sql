@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS attachments (
+  message_id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  id VARCHAR NOT NULL,
+  chat_id VARCHAR NOT NULL,
+  author VARCHAR NOT NULL,
+  size UNSIGNED INT NOT NULL,
+  descriptor BLOB NOT NULL,
+  requested BOOLEAN NOT NULL DEFAULT FALSE,
+  complete BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX attachments_id ON attachments(id);
+
+CREATE TABLE IF NOT EXISTS attachment_chunks (
+  attachment_id VARCHAR NOT NULL,
+  chunk_index UNSIGNED INT NOT NULL,
+  data BLOB NOT NULL,
+  PRIMARY KEY (attachment_id, chunk_index) ON CONFLICT IGNORE
+);
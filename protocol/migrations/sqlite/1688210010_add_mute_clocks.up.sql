@@ -0,0 +1,4 @@
+CREATE TABLE IF NOT EXISTS mute_clocks (
+  id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  clock INT NOT NULL
+);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS outgoing_message_filters (
+  id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  community_id VARCHAR NOT NULL DEFAULT '',
+  filter_type INT NOT NULL,
+  pattern VARCHAR NOT NULL,
+  action INT NOT NULL,
+  description VARCHAR NOT NULL DEFAULT ''
+);
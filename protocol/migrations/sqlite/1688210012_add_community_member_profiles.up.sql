@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS community_member_profiles (
+  community_id VARCHAR NOT NULL,
+  member_id VARCHAR NOT NULL,
+  display_name VARCHAR NOT NULL DEFAULT '',
+  image BLOB,
+  clock INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (community_id, member_id) ON CONFLICT REPLACE
+);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS contact_requests_bucket (
+  contact_id TEXT PRIMARY KEY,
+  message_id TEXT NOT NULL,
+  contact_request BOOLEAN NOT NULL DEFAULT FALSE,
+  trust_score INT NOT NULL DEFAULT 0,
+  timestamp INT NOT NULL
+);
@@ -0,0 +1,9 @@
+ALTER TABLE user_messages ADD COLUMN video_payload BLOB;
+ALTER TABLE user_messages ADD COLUMN video_type INT;
+ALTER TABLE user_messages ADD COLUMN video_duration_ms INT;
+ALTER TABLE user_messages ADD COLUMN video_width INT;
+ALTER TABLE user_messages ADD COLUMN video_height INT;
+ALTER TABLE user_messages ADD COLUMN video_thumbnail BLOB;
+
+-- Images were the only attachments sent in chunks
+ALTER TABLE attachments ADD COLUMN content_type INT NOT NULL DEFAULT 7;
@@ -0,0 +1 @@
+ALTER TABLE user_messages ADD COLUMN unfurled_status_links BLOB;
@@ -0,0 +1,15 @@
+CREATE TABLE communities_airdrop_snapshots (
+  id TEXT PRIMARY KEY ON CONFLICT REPLACE,
+  community_id TEXT NOT NULL,
+  created_at INT NOT NULL,
+  criteria BLOB
+);
+
+CREATE INDEX communities_airdrop_snapshots_community_id ON communities_airdrop_snapshots(community_id);
+
+CREATE TABLE communities_airdrop_snapshot_recipients (
+  snapshot_id TEXT NOT NULL,
+  public_key TEXT NOT NULL,
+  address TEXT NOT NULL,
+  PRIMARY KEY (snapshot_id, public_key, address) ON CONFLICT REPLACE
+);
@@ -0,0 +1,8 @@
+CREATE TABLE communities_soulbound_holdings (
+  community_id TEXT NOT NULL,
+  chain_id UNSIGNED INT NOT NULL,
+  contract_address TEXT NOT NULL,
+  token_id TEXT NOT NULL,
+  public_key TEXT NOT NULL,
+  PRIMARY KEY (community_id, chain_id, contract_address, token_id) ON CONFLICT REPLACE
+);
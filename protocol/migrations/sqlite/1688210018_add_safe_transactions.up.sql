@@ -0,0 +1,17 @@
+ALTER TABLE user_messages ADD COLUMN safe_transaction BLOB;
+
+CREATE TABLE IF NOT EXISTS safe_transaction_signatures (
+  message_id VARCHAR NOT NULL,
+  signer VARCHAR NOT NULL,
+  signature BLOB NOT NULL,
+  source TEXT NOT NULL,
+  clock_value INT NOT NULL,
+  PRIMARY KEY (message_id, signer) ON CONFLICT REPLACE
+);
+
+CREATE TABLE IF NOT EXISTS safe_transaction_executions (
+  message_id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  transaction_hash VARCHAR NOT NULL,
+  source TEXT NOT NULL,
+  clock_value INT NOT NULL
+);
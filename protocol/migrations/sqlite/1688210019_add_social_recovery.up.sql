@@ -0,0 +1,40 @@
+-- Trusted contacts holding a share of the recovery key of the user
+CREATE TABLE social_recovery_trustees (
+  set_id VARCHAR NOT NULL,
+  contact_id VARCHAR NOT NULL,
+  state INT NOT NULL DEFAULT 0,
+  clock INT NOT NULL,
+  PRIMARY KEY (set_id, contact_id) ON CONFLICT REPLACE
+);
+
+-- Shares of the recovery keys of contacts held by the user
+CREATE TABLE social_recovery_shares (
+  owner_id VARCHAR NOT NULL PRIMARY KEY ON CONFLICT REPLACE,
+  set_id VARCHAR NOT NULL,
+  share BLOB NOT NULL,
+  payload BLOB NOT NULL,
+  threshold INT NOT NULL,
+  total INT NOT NULL,
+  state INT NOT NULL DEFAULT 0,
+  clock INT NOT NULL
+);
+
+CREATE TABLE social_recovery_requests (
+  requester_id VARCHAR NOT NULL,
+  owner_id VARCHAR NOT NULL,
+  state INT NOT NULL DEFAULT 0,
+  clock INT NOT NULL,
+  PRIMARY KEY (requester_id, owner_id) ON CONFLICT REPLACE
+);
+
+-- Shares released to this device to recover the account of owner_id
+CREATE TABLE social_recovery_released_shares (
+  owner_id VARCHAR NOT NULL,
+  contact_id VARCHAR NOT NULL,
+  set_id VARCHAR NOT NULL,
+  share BLOB NOT NULL,
+  payload BLOB NOT NULL,
+  threshold INT NOT NULL,
+  clock INT NOT NULL,
+  PRIMARY KEY (owner_id, contact_id) ON CONFLICT REPLACE
+);
@@ -0,0 +1,13 @@
+-- Recoveries started from this device, set_id is the set of the shares
+-- accepted, it's set by the first share released when it's not requested
+CREATE TABLE social_recovery_recoveries (
+  owner_id VARCHAR NOT NULL PRIMARY KEY,
+  set_id VARCHAR NOT NULL DEFAULT ''
+);
+
+-- Trusted contacts asked to release their share to this device
+CREATE TABLE social_recovery_recovery_contacts (
+  owner_id VARCHAR NOT NULL,
+  contact_id VARCHAR NOT NULL,
+  PRIMARY KEY (owner_id, contact_id) ON CONFLICT IGNORE
+);
@@ -0,0 +1,8 @@
+-- Replacing a message deletes its row without firing the delete triggers,
+-- the index entry of the replaced row is deleted before the insert instead
+CREATE TRIGGER user_messages_fts_replace BEFORE INSERT ON user_messages
+BEGIN
+  DELETE FROM user_messages_fts WHERE docid IN (SELECT rowid FROM user_messages WHERE id = new.id);
+END;
+
+DELETE FROM user_messages_fts WHERE docid NOT IN (SELECT rowid FROM user_messages);
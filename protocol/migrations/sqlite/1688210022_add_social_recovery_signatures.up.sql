@@ -0,0 +1,5 @@
+-- Signatures of the owners over their shares, a released share is only
+-- accepted when it verifies against the owner
+ALTER TABLE social_recovery_shares ADD COLUMN signature BLOB;
+ALTER TABLE social_recovery_released_shares ADD COLUMN total INT NOT NULL DEFAULT 0;
+ALTER TABLE social_recovery_released_shares ADD COLUMN signature BLOB;
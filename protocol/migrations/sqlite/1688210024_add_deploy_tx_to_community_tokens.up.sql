@@ -0,0 +1,4 @@
+-- The deployment transaction of the tokens deployed from this device, their
+-- deployment is watched until it's mined or its nonce is used
+ALTER TABLE community_tokens ADD COLUMN deploy_tx_hash VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE community_tokens ADD COLUMN deploy_tx_nonce INT NOT NULL DEFAULT 0;
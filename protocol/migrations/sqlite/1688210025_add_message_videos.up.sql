@@ -0,0 +1,15 @@
+-- Videos are kept out of user_messages so that loading and saving messages
+-- again doesn't copy them around
+CREATE TABLE IF NOT EXISTS message_videos (
+  message_id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  payload BLOB NOT NULL
+);
+
+INSERT INTO message_videos(message_id, payload) SELECT id, video_payload FROM user_messages WHERE video_payload IS NOT NULL;
+UPDATE user_messages SET video_payload = NULL WHERE video_payload IS NOT NULL;
+
+-- Replacing a message doesn't fire the trigger, its video is kept
+CREATE TRIGGER message_videos_delete AFTER DELETE ON user_messages
+BEGIN
+  DELETE FROM message_videos WHERE message_id = old.id;
+END;
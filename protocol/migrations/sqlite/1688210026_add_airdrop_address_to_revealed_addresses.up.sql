@@ -0,0 +1 @@
+ALTER TABLE communities_requests_to_join_revealed_addresses ADD COLUMN is_airdrop_address BOOLEAN NOT NULL DEFAULT FALSE;
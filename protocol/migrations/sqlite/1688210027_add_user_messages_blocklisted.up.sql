@@ -0,0 +1,4 @@
+-- Messages of users blocklisted by a community are kept hidden, so that they
+-- show up again when the user is removed from the blocklist
+ALTER TABLE user_messages ADD COLUMN blocklisted BOOLEAN NOT NULL DEFAULT FALSE;
+CREATE INDEX user_messages_blocklisted ON user_messages(local_chat_id, source) WHERE blocklisted;
@@ -0,0 +1,10 @@
+-- Executions received in a chat are only claims of their sender until they're
+-- checked on chain, which may only happen once the proposal is received
+CREATE TABLE IF NOT EXISTS safe_transaction_unverified_executions (
+  message_id VARCHAR NOT NULL,
+  transaction_hash VARCHAR NOT NULL,
+  source TEXT NOT NULL,
+  clock_value INT NOT NULL,
+  received_at INT NOT NULL,
+  PRIMARY KEY (message_id, transaction_hash) ON CONFLICT REPLACE
+);
@@ -0,0 +1,8 @@
+-- Unsent chat messages (1) and emoji reactions (22) of public (2) and
+-- community (6) chats used to be resent from raw_messages, they are resent
+-- from the outbox now, with the same schedule
+INSERT INTO outbox_messages(id, local_chat_id, status, attempts, next_attempt, last_error, updated_at)
+SELECT r.id, r.local_chat_id, 'sending', r.send_count, r.last_sent + CASE WHEN r.send_count < 1 THEN 0 ELSE MIN(30000 << (r.send_count - 1), 600000) END, '', r.last_sent
+FROM raw_messages r JOIN chats c ON c.id = r.local_chat_id
+WHERE r.message_type IN (1, 22) AND NOT r.sent AND r.send_count <= 3 AND c.type IN (2, 6)
+AND r.id NOT IN (SELECT id FROM outbox_messages);
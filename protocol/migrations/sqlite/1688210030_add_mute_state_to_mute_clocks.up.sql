@@ -0,0 +1,4 @@
+-- The state of the last mute or unmute of a chat tells apart the chats muted
+-- on their own from the ones muted along with their community
+ALTER TABLE mute_clocks ADD COLUMN muted BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE mute_clocks ADD COLUMN mute_till INT NOT NULL DEFAULT 0;
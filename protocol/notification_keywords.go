@@ -0,0 +1,73 @@
+package protocol
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// notificationKeywordMatcher matches the messages against the keywords the
+// user subscribed to. The keywords are compiled once, and again after they
+// change
+type notificationKeywordMatcher struct {
+	mutex   sync.Mutex
+	loaded  bool
+	matcher *regexp.Regexp
+}
+
+// normalizeNotificationKeywords trims the keywords and removes the empty
+// ones and the duplicates, ignoring the case
+func normalizeNotificationKeywords(keywords []string) []string {
+	seen := make(map[string]bool)
+	var normalized []string
+	for _, keyword := range keywords {
+		keyword = strings.TrimSpace(keyword)
+		if keyword == "" || seen[strings.ToLower(keyword)] {
+			continue
+		}
+		seen[strings.ToLower(keyword)] = true
+		normalized = append(normalized, keyword)
+	}
+	return normalized
+}
+
+// compileNotificationKeywords returns a case insensitive matcher of whole
+// keywords, nil when there's none
+func compileNotificationKeywords(keywords []string) *regexp.Regexp {
+	keywords = normalizeNotificationKeywords(keywords)
+	if len(keywords) == 0 {
+		return nil
+	}
+
+	quoted := make([]string, len(keywords))
+	for i, keyword := range keywords {
+		quoted[i] = regexp.QuoteMeta(keyword)
+	}
+	// Keywords can start or end with symbols, so word boundaries are matched
+	// explicitly
+	return regexp.MustCompile(`(?i)(?:^|[^\p{L}\p{N}_])(?:` + strings.Join(quoted, "|") + `)(?:$|[^\p{L}\p{N}_])`)
+}
+
+// reset makes the matcher load the keywords again on the next match
+func (k *notificationKeywordMatcher) reset() {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+	k.loaded = false
+	k.matcher = nil
+}
+
+func (k *notificationKeywordMatcher) match(text string, load func() ([]string, error)) (bool, error) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	if !k.loaded {
+		keywords, err := load()
+		if err != nil {
+			return false, err
+		}
+		k.matcher = compileNotificationKeywords(keywords)
+		k.loaded = true
+	}
+
+	return k.matcher != nil && k.matcher.MatchString(text), nil
+}
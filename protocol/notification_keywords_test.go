@@ -0,0 +1,44 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationKeywordMatcher(t *testing.T) {
+	keywords := []string{" Status ", "status", "#waku", ""}
+	loads := 0
+	load := func() ([]string, error) {
+		loads++
+		return keywords, nil
+	}
+
+	var matcher notificationKeywordMatcher
+	for text, expected := range map[string]bool{
+		"status is great":        true,
+		"have you seen STATUS?":  true,
+		"statuses are different": false,
+		"ask in #waku":           true,
+		"waku":                   false,
+		"nothing here":           false,
+	} {
+		matched, err := matcher.match(text, load)
+		require.NoError(t, err)
+		require.Equal(t, expected, matched, text)
+	}
+	// The keywords are compiled once
+	require.Equal(t, 1, loads)
+
+	keywords = nil
+	matcher.reset()
+	matched, err := matcher.match("status", load)
+	require.NoError(t, err)
+	require.False(t, matched)
+	require.Equal(t, 2, loads)
+}
+
+func TestNormalizeNotificationKeywords(t *testing.T) {
+	require.Equal(t, []string{"Status", "waku"}, normalizeNotificationKeywords([]string{" Status", "", "status ", "waku"}))
+	require.Nil(t, normalizeNotificationKeywords([]string{" "}))
+}
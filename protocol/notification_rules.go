@@ -0,0 +1,206 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"time"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const minutesPerDay = 24 * 60
+
+// NotificationRuleTrigger is the kind of message a notification rule applies to
+type NotificationRuleTrigger int
+
+const (
+	NotificationRuleTriggerAny NotificationRuleTrigger = iota
+	// NotificationRuleTriggerMessage is a message which neither mentions nor
+	// replies to the user
+	NotificationRuleTriggerMessage
+	NotificationRuleTriggerMention
+	NotificationRuleTriggerReply
+)
+
+// NotificationRuleAction is what happens to the notifications of the messages
+// matching a rule
+type NotificationRuleAction int
+
+const (
+	// NotificationRuleActionNotify creates activity center notifications for
+	// mentions and replies, and local push notifications for every message
+	NotificationRuleActionNotify NotificationRuleAction = iota
+	// NotificationRuleActionActivityCenterOnly creates activity center
+	// notifications without local push notifications
+	NotificationRuleActionActivityCenterOnly
+	// NotificationRuleActionMute creates no notification at all
+	NotificationRuleActionMute
+)
+
+// NotificationRule decides how the user is notified of the messages of a
+// community, a chat, or of every chat when neither is set. When the quiet
+// hours differ the rule only applies between them
+type NotificationRule struct {
+	ID          string                  `json:"id"`
+	CommunityID string                  `json:"communityId,omitempty"`
+	ChatID      string                  `json:"chatId,omitempty"`
+	Trigger     NotificationRuleTrigger `json:"trigger"`
+	Action      NotificationRuleAction  `json:"action"`
+	// QuietHoursStart and QuietHoursEnd are minutes since midnight UTC, the
+	// quiet hours can span midnight
+	QuietHoursStart uint32 `json:"quietHoursStart"`
+	QuietHoursEnd   uint32 `json:"quietHoursEnd"`
+	Clock           uint64 `json:"clock"`
+	Deleted         bool   `json:"deleted,omitempty"`
+}
+
+func (r *NotificationRule) Validate() error {
+	if r.Trigger < NotificationRuleTriggerAny || r.Trigger > NotificationRuleTriggerReply {
+		return ErrInvalidNotificationRule
+	}
+	if r.Action < NotificationRuleActionNotify || r.Action > NotificationRuleActionMute {
+		return ErrInvalidNotificationRule
+	}
+	if r.QuietHoursStart >= minutesPerDay || r.QuietHoursEnd >= minutesPerDay {
+		return ErrInvalidNotificationRule
+	}
+	return nil
+}
+
+func (r *NotificationRule) hasQuietHours() bool {
+	return r.QuietHoursStart != r.QuietHoursEnd
+}
+
+func (r *NotificationRule) inQuietHours(now time.Time) bool {
+	now = now.UTC()
+	minute := uint32(now.Hour()*60 + now.Minute())
+	if r.QuietHoursStart < r.QuietHoursEnd {
+		return minute >= r.QuietHoursStart && minute < r.QuietHoursEnd
+	}
+	return minute >= r.QuietHoursStart || minute < r.QuietHoursEnd
+}
+
+func (r *NotificationRule) matches(chat *Chat, trigger NotificationRuleTrigger, now time.Time) bool {
+	if r.ChatID != "" && r.ChatID != chat.ID {
+		return false
+	}
+	if r.CommunityID != "" && r.CommunityID != chat.CommunityID {
+		return false
+	}
+	if r.Trigger != NotificationRuleTriggerAny && r.Trigger != trigger {
+		return false
+	}
+	return !r.hasQuietHours() || r.inQuietHours(now)
+}
+
+// priority orders the rules matching a message. Rules in their quiet hours
+// override the others, then a chat rule wins over a community rule, which
+// wins over a global rule, and a rule for the trigger wins over a rule for
+// any message
+func (r *NotificationRule) priority() int {
+	p := 0
+	if r.hasQuietHours() {
+		p += 8
+	}
+	if r.ChatID != "" {
+		p += 4
+	} else if r.CommunityID != "" {
+		p += 2
+	}
+	if r.Trigger != NotificationRuleTriggerAny {
+		p++
+	}
+	return p
+}
+
+func (r *NotificationRule) ToSyncProtobuf() *protobuf.SyncNotificationRule {
+	return &protobuf.SyncNotificationRule{
+		Clock:           r.Clock,
+		Id:              r.ID,
+		CommunityId:     r.CommunityID,
+		ChatId:          r.ChatID,
+		Trigger:         uint32(r.Trigger),
+		Action:          uint32(r.Action),
+		QuietHoursStart: r.QuietHoursStart,
+		QuietHoursEnd:   r.QuietHoursEnd,
+		Deleted:         r.Deleted,
+	}
+}
+
+func notificationRuleFromSyncProtobuf(message *protobuf.SyncNotificationRule) *NotificationRule {
+	return &NotificationRule{
+		ID:              message.Id,
+		CommunityID:     message.CommunityId,
+		ChatID:          message.ChatId,
+		Trigger:         NotificationRuleTrigger(message.Trigger),
+		Action:          NotificationRuleAction(message.Action),
+		QuietHoursStart: message.QuietHoursStart,
+		QuietHoursEnd:   message.QuietHoursEnd,
+		Clock:           message.Clock,
+		Deleted:         message.Deleted,
+	}
+}
+
+// evaluateNotificationRules returns the action of the rule with the highest
+// priority matching the message, and false when no rule matches
+func evaluateNotificationRules(rules []*NotificationRule, chat *Chat, trigger NotificationRuleTrigger, now time.Time) (NotificationRuleAction, bool) {
+	var match *NotificationRule
+	for _, rule := range rules {
+		if rule.Deleted || !rule.matches(chat, trigger, now) {
+			continue
+		}
+		if match == nil || rule.priority() > match.priority() {
+			match = rule
+		}
+	}
+	if match == nil {
+		return NotificationRuleActionNotify, false
+	}
+	return match.Action, true
+}
+
+// pushNotificationLevel returns the level of the push notifications a server
+// should deliver for the chat, and false when it gets every notification.
+// Rules with quiet hours are left out, they're applied on the device
+func pushNotificationLevel(rules []*NotificationRule, chat *Chat) (protobuf.PushNotificationChatPreference_Level, bool) {
+	var permanentRules []*NotificationRule
+	for _, rule := range rules {
+		if !rule.hasQuietHours() {
+			permanentRules = append(permanentRules, rule)
+		}
+	}
+
+	messageAction, _ := evaluateNotificationRules(permanentRules, chat, NotificationRuleTriggerMessage, time.Time{})
+	if messageAction == NotificationRuleActionNotify {
+		return protobuf.PushNotificationChatPreference_ALL, false
+	}
+	mentionAction, _ := evaluateNotificationRules(permanentRules, chat, NotificationRuleTriggerMention, time.Time{})
+	if mentionAction == NotificationRuleActionNotify {
+		return protobuf.PushNotificationChatPreference_MENTIONS, true
+	}
+	return protobuf.PushNotificationChatPreference_NONE, true
+}
+
+func notificationRuleTrigger(publicKey ecdsa.PublicKey, message *common.Message, responseTo *common.Message) NotificationRuleTrigger {
+	if message.Mentioned {
+		return NotificationRuleTriggerMention
+	}
+	if responseTo != nil && responseTo.From == common.PubkeyToHex(&publicKey) {
+		return NotificationRuleTriggerReply
+	}
+	return NotificationRuleTriggerMessage
+}
+
+// notificationAction returns what to do with the notifications of a message.
+// The mute flag of the chat only applies when no rule matches the message
+func (r *ReceivedMessageState) notificationAction(publicKey ecdsa.PublicKey, message *common.Message, chat *Chat, responseTo *common.Message) (NotificationRuleAction, bool) {
+	now := time.UnixMilli(int64(r.Timesource.GetCurrentTime()))
+	action, ok := evaluateNotificationRules(r.NotificationRules, chat, notificationRuleTrigger(publicKey, message, responseTo), now)
+	if ok {
+		return action, true
+	}
+	if chat.IsMuted(now) {
+		return NotificationRuleActionMute, false
+	}
+	return NotificationRuleActionNotify, false
+}
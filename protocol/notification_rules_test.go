@@ -0,0 +1,82 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestEvaluateNotificationRules(t *testing.T) {
+	chat := &Chat{ID: "chat-1", CommunityID: "community-1", Active: true}
+	noon := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	night := time.Date(2023, 7, 1, 23, 30, 0, 0, time.UTC)
+
+	rules := []*NotificationRule{
+		{ID: "global", Action: NotificationRuleActionActivityCenterOnly},
+		{ID: "community", CommunityID: "community-1", Action: NotificationRuleActionMute},
+		{ID: "mentions", CommunityID: "community-1", Trigger: NotificationRuleTriggerMention, Action: NotificationRuleActionNotify},
+		{ID: "quiet", Action: NotificationRuleActionMute, QuietHoursStart: 22 * 60, QuietHoursEnd: 7 * 60},
+	}
+
+	action, ok := evaluateNotificationRules(nil, chat, NotificationRuleTriggerMessage, noon)
+	require.False(t, ok)
+	require.Equal(t, NotificationRuleActionNotify, action)
+
+	// The community rule wins over the global one
+	action, ok = evaluateNotificationRules(rules, chat, NotificationRuleTriggerMessage, noon)
+	require.True(t, ok)
+	require.Equal(t, NotificationRuleActionMute, action)
+
+	// The mention rule of the community wins over the community rule
+	action, ok = evaluateNotificationRules(rules, chat, NotificationRuleTriggerMention, noon)
+	require.True(t, ok)
+	require.Equal(t, NotificationRuleActionNotify, action)
+
+	// Quiet hours spanning midnight override every other rule
+	action, ok = evaluateNotificationRules(rules, chat, NotificationRuleTriggerMention, night)
+	require.True(t, ok)
+	require.Equal(t, NotificationRuleActionMute, action)
+
+	// Only the global rule matches chats of other communities
+	other := &Chat{ID: "chat-2", CommunityID: "community-2", Active: true}
+	action, ok = evaluateNotificationRules(rules, other, NotificationRuleTriggerReply, noon)
+	require.True(t, ok)
+	require.Equal(t, NotificationRuleActionActivityCenterOnly, action)
+
+	// Deleted rules are ignored
+	rules[0].Deleted = true
+	_, ok = evaluateNotificationRules(rules, other, NotificationRuleTriggerReply, noon)
+	require.False(t, ok)
+}
+
+func TestValidateNotificationRule(t *testing.T) {
+	require.NoError(t, (&NotificationRule{ChatID: "chat-1", Action: NotificationRuleActionMute}).Validate())
+	require.Equal(t, ErrInvalidNotificationRule, (&NotificationRule{Action: NotificationRuleActionMute + 1}).Validate())
+	require.Equal(t, ErrInvalidNotificationRule, (&NotificationRule{Trigger: NotificationRuleTriggerReply + 1}).Validate())
+	require.Equal(t, ErrInvalidNotificationRule, (&NotificationRule{QuietHoursStart: 24 * 60}).Validate())
+}
+
+func TestPushNotificationLevel(t *testing.T) {
+	chat := &Chat{ID: "chat-1", CommunityID: "community-1", Active: true}
+
+	_, ok := pushNotificationLevel(nil, chat)
+	require.False(t, ok)
+
+	rules := []*NotificationRule{
+		{ID: "community", CommunityID: "community-1", Action: NotificationRuleActionActivityCenterOnly},
+		{ID: "mentions", CommunityID: "community-1", Trigger: NotificationRuleTriggerMention, Action: NotificationRuleActionNotify},
+		// Quiet hours are applied on the device
+		{ID: "quiet", Action: NotificationRuleActionMute, QuietHoursStart: 22 * 60, QuietHoursEnd: 7 * 60},
+	}
+	level, ok := pushNotificationLevel(rules, chat)
+	require.True(t, ok)
+	require.Equal(t, protobuf.PushNotificationChatPreference_MENTIONS, level)
+
+	rules[1].Action = NotificationRuleActionMute
+	level, ok = pushNotificationLevel(rules, chat)
+	require.True(t, ok)
+	require.Equal(t, protobuf.PushNotificationChatPreference_NONE, level)
+}
@@ -0,0 +1,219 @@
+package protocol
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/status-im/status-go/extkeys"
+)
+
+// seedPhraseLengths are the numbers of words of BIP39 mnemonics
+var seedPhraseLengths = []int{12, 15, 18, 21, 24}
+
+// OutgoingMessageFilterType is how the pattern of a filter is matched
+type OutgoingMessageFilterType int
+
+const (
+	// OutgoingMessageFilterTypeKeyword matches the pattern anywhere in the
+	// text, ignoring the case
+	OutgoingMessageFilterTypeKeyword OutgoingMessageFilterType = iota + 1
+	OutgoingMessageFilterTypeRegex
+)
+
+// OutgoingMessageFilterAction is what happens to the messages matching a
+// filter before they're sent
+type OutgoingMessageFilterAction int
+
+const (
+	// OutgoingMessageFilterActionWarn sends the message only once the user
+	// confirms it
+	OutgoingMessageFilterActionWarn OutgoingMessageFilterAction = iota + 1
+	// OutgoingMessageFilterActionBlock never sends the message
+	OutgoingMessageFilterActionBlock
+)
+
+// OutgoingMessageFilter is checked against the messages the user sends, in the
+// chats of a community, or in every chat when CommunityID isn't set
+type OutgoingMessageFilter struct {
+	ID          string                      `json:"id"`
+	CommunityID string                      `json:"communityId,omitempty"`
+	Type        OutgoingMessageFilterType   `json:"type"`
+	Pattern     string                      `json:"pattern"`
+	Action      OutgoingMessageFilterAction `json:"action"`
+	Description string                      `json:"description,omitempty"`
+
+	regexp *regexp.Regexp
+}
+
+// OutgoingMessageFilterMatch is a filter matching a message. Built-in filters
+// have no ID
+type OutgoingMessageFilterMatch struct {
+	FilterID    string                      `json:"filterId,omitempty"`
+	Description string                      `json:"description"`
+	Action      OutgoingMessageFilterAction `json:"action"`
+}
+
+func (f *OutgoingMessageFilter) Validate() error {
+	if f.Action < OutgoingMessageFilterActionWarn || f.Action > OutgoingMessageFilterActionBlock {
+		return ErrInvalidOutgoingMessageFilter
+	}
+	switch f.Type {
+	case OutgoingMessageFilterTypeKeyword:
+		if strings.TrimSpace(f.Pattern) == "" {
+			return ErrInvalidOutgoingMessageFilter
+		}
+	case OutgoingMessageFilterTypeRegex:
+		if f.Pattern == "" {
+			return ErrInvalidOutgoingMessageFilter
+		}
+		if _, err := regexp.Compile(f.Pattern); err != nil {
+			return ErrInvalidOutgoingMessageFilter
+		}
+	default:
+		return ErrInvalidOutgoingMessageFilter
+	}
+	return nil
+}
+
+func (f *OutgoingMessageFilter) appliesTo(communityID string) bool {
+	return f.CommunityID == "" || f.CommunityID == communityID
+}
+
+func (f *OutgoingMessageFilter) matches(text string) bool {
+	switch f.Type {
+	case OutgoingMessageFilterTypeKeyword:
+		return strings.Contains(strings.ToLower(text), strings.ToLower(f.Pattern))
+	case OutgoingMessageFilterTypeRegex:
+		if f.regexp == nil {
+			compiled, err := regexp.Compile(f.Pattern)
+			if err != nil {
+				return false
+			}
+			f.regexp = compiled
+		}
+		return f.regexp.MatchString(text)
+	}
+	return false
+}
+
+var (
+	// privateKeyRegexp matches a raw private key, with or without the 0x
+	// prefix. Transaction hashes look the same, hence only a warning
+	privateKeyRegexp         = regexp.MustCompile(`\b(0x)?[0-9a-fA-F]{64}\b`)
+	extendedPrivateKeyRegexp = regexp.MustCompile(`\b[xtyz]prv[1-9A-HJ-NP-Za-km-z]{100,}\b`)
+	seedPhraseListIndex      = regexp.MustCompile(`^\d+[.):]?$`)
+
+	bip39WordsOnce sync.Once
+	bip39Words     map[string]bool
+)
+
+func englishBIP39Words() map[string]bool {
+	bip39WordsOnce.Do(func() {
+		bip39Words = make(map[string]bool)
+		wordList, err := extkeys.NewMnemonic().WordList(extkeys.EnglishLanguage)
+		if err != nil {
+			return
+		}
+		for _, word := range wordList {
+			bip39Words[word] = true
+		}
+	})
+	return bip39Words
+}
+
+// containsSeedPhrase tells whether the text contains consecutive words of the
+// BIP39 list forming a mnemonic with a valid checksum, the words can be
+// numbered
+func containsSeedPhrase(text string) bool {
+	words := englishBIP39Words()
+	var run []string
+	for _, field := range strings.Fields(strings.ToLower(text)) {
+		if seedPhraseListIndex.MatchString(field) {
+			continue
+		}
+		word := strings.Trim(field, ".,;:")
+		if !words[word] {
+			if isSeedPhrase(run) {
+				return true
+			}
+			run = nil
+			continue
+		}
+		run = append(run, word)
+	}
+	return isSeedPhrase(run)
+}
+
+// isSeedPhrase tells whether a run of BIP39 words contains a mnemonic. The
+// checksum rules out most sentences which happen to only use BIP39 words
+func isSeedPhrase(run []string) bool {
+	mnemonic := extkeys.NewMnemonic()
+	for _, length := range seedPhraseLengths {
+		for start := 0; start+length <= len(run); start++ {
+			if mnemonic.ValidMnemonic(strings.Join(run[start:start+length], " "), extkeys.EnglishLanguage) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// secretsFilterMatches returns the matches of the built-in filters warning
+// about secrets which shouldn't be shared
+func secretsFilterMatches(text string) []*OutgoingMessageFilterMatch {
+	var matches []*OutgoingMessageFilterMatch
+	if containsSeedPhrase(text) {
+		matches = append(matches, &OutgoingMessageFilterMatch{
+			Description: "The message looks like it contains a seed phrase",
+			Action:      OutgoingMessageFilterActionWarn,
+		})
+	}
+	if privateKeyRegexp.MatchString(text) || extendedPrivateKeyRegexp.MatchString(text) {
+		matches = append(matches, &OutgoingMessageFilterMatch{
+			Description: "The message looks like it contains a private key",
+			Action:      OutgoingMessageFilterActionWarn,
+		})
+	}
+	return matches
+}
+
+// evaluateOutgoingMessageFilters returns the matches of the filters applying
+// to a message sent in the community, which is empty out of communities
+func evaluateOutgoingMessageFilters(filters []*OutgoingMessageFilter, secretsFilterEnabled bool, communityID string, text string) []*OutgoingMessageFilterMatch {
+	if text == "" {
+		return nil
+	}
+
+	var matches []*OutgoingMessageFilterMatch
+	if secretsFilterEnabled {
+		matches = secretsFilterMatches(text)
+	}
+	for _, filter := range filters {
+		if !filter.appliesTo(communityID) || !filter.matches(text) {
+			continue
+		}
+		matches = append(matches, &OutgoingMessageFilterMatch{
+			FilterID:    filter.ID,
+			Description: filter.Description,
+			Action:      filter.Action,
+		})
+	}
+	return matches
+}
+
+// outgoingMessageFiltersError tells whether a message with these matches can
+// be sent, a warning needs to be confirmed and a block can't be bypassed
+func outgoingMessageFiltersError(matches []*OutgoingMessageFilterMatch, confirmed bool) error {
+	warned := false
+	for _, match := range matches {
+		if match.Action == OutgoingMessageFilterActionBlock {
+			return ErrOutgoingMessageBlocked
+		}
+		warned = true
+	}
+	if warned && !confirmed {
+		return ErrOutgoingMessageNeedsConfirmation
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testSeedPhrase = "legal winner thank year wave sausage worth useful legal winner thank yellow"
+
+func TestContainsSeedPhrase(t *testing.T) {
+	require.True(t, containsSeedPhrase("my words are "+testSeedPhrase+", keep them safe"))
+	require.True(t, containsSeedPhrase("1. legal 2. winner 3. thank 4. year 5. wave 6. sausage 7. worth 8. useful 9. legal 10. winner 11. thank 12. yellow"))
+	require.True(t, containsSeedPhrase("LEGAL Winner thank year wave sausage worth useful legal winner thank yellow"))
+	require.True(t, containsSeedPhrase("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art"))
+
+	// Too few words, not consecutive, or a wrong checksum
+	require.False(t, containsSeedPhrase("legal winner thank year wave sausage worth useful legal winner thank"))
+	require.False(t, containsSeedPhrase("legal winner thank year wave sausage status worth useful legal winner thank yellow"))
+	require.False(t, containsSeedPhrase("legal winner thank year wave sausage worth useful legal winner thank year"))
+	require.False(t, containsSeedPhrase("let's meet at the bar after work"))
+}
+
+func TestSecretsFilterFalsePositives(t *testing.T) {
+	// Messages only made of words of the BIP39 list, or with hex strings too
+	// short to be keys
+	messages := []string{
+		"Please review my pull request before lunch, the build is broken again and I need your help to fix it today",
+		"Order list: apple banana orange lemon bread butter cheese coffee sugar salt pepper olive garlic onion tomato potato",
+		"sent it to 0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204, should confirm soon",
+		"They also found a useful way to enjoy the summer: walk along the river and watch the boats",
+	}
+	for _, message := range messages {
+		require.Empty(t, secretsFilterMatches(message), message)
+	}
+}
+
+func TestEvaluateOutgoingMessageFilters(t *testing.T) {
+	filters := []*OutgoingMessageFilter{
+		{ID: "keyword", Type: OutgoingMessageFilterTypeKeyword, Pattern: "Password", Action: OutgoingMessageFilterActionWarn},
+		{ID: "regex", CommunityID: "community-1", Type: OutgoingMessageFilterTypeRegex, Pattern: `\bbuy now\b`, Action: OutgoingMessageFilterActionBlock},
+	}
+
+	require.Empty(t, evaluateOutgoingMessageFilters(filters, true, "", "hello"))
+
+	// Keywords are matched ignoring the case
+	matches := evaluateOutgoingMessageFilters(filters, true, "", "my password is hunter2")
+	require.Len(t, matches, 1)
+	require.Equal(t, "keyword", matches[0].FilterID)
+	require.Equal(t, ErrOutgoingMessageNeedsConfirmation, outgoingMessageFiltersError(matches, false))
+	require.NoError(t, outgoingMessageFiltersError(matches, true))
+
+	// Community filters only apply to the chats of the community
+	require.Empty(t, evaluateOutgoingMessageFilters(filters, true, "community-2", "buy now"))
+	matches = evaluateOutgoingMessageFilters(filters, true, "community-1", "buy now")
+	require.Len(t, matches, 1)
+	require.Equal(t, ErrOutgoingMessageBlocked, outgoingMessageFiltersError(matches, true))
+
+	// Built-in secrets filters, private keys are matched with or without
+	// being labelled as such and with or without the 0x prefix
+	privateKey := "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	matches = evaluateOutgoingMessageFilters(nil, true, "", "here's my private key: "+privateKey)
+	require.Len(t, matches, 1)
+	require.Equal(t, OutgoingMessageFilterActionWarn, matches[0].Action)
+	require.Equal(t, ErrOutgoingMessageNeedsConfirmation, outgoingMessageFiltersError(matches, false))
+	require.Len(t, evaluateOutgoingMessageFilters(nil, true, "", privateKey), 1)
+	require.Len(t, evaluateOutgoingMessageFilters(nil, true, "", "use "+privateKey[2:]+" to import it"), 1)
+	require.Len(t, evaluateOutgoingMessageFilters(nil, true, "", testSeedPhrase), 1)
+	require.Empty(t, evaluateOutgoingMessageFilters(nil, false, "", testSeedPhrase))
+}
+
+func TestValidateOutgoingMessageFilter(t *testing.T) {
+	require.NoError(t, (&OutgoingMessageFilter{Type: OutgoingMessageFilterTypeRegex, Pattern: `^\d+$`, Action: OutgoingMessageFilterActionBlock}).Validate())
+	require.Equal(t, ErrInvalidOutgoingMessageFilter, (&OutgoingMessageFilter{Type: OutgoingMessageFilterTypeRegex, Pattern: `(`, Action: OutgoingMessageFilterActionWarn}).Validate())
+	require.Equal(t, ErrInvalidOutgoingMessageFilter, (&OutgoingMessageFilter{Type: OutgoingMessageFilterTypeKeyword, Pattern: " ", Action: OutgoingMessageFilterActionWarn}).Validate())
+	require.Equal(t, ErrInvalidOutgoingMessageFilter, (&OutgoingMessageFilter{Type: OutgoingMessageFilterTypeKeyword, Pattern: "a"}).Validate())
+	require.Equal(t, ErrInvalidOutgoingMessageFilter, (&OutgoingMessageFilter{Pattern: "a", Action: OutgoingMessageFilterActionWarn}).Validate())
+}
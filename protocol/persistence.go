@@ -32,11 +32,12 @@ var (
 // sqlitePersistence wrapper around sql db with operations common for a client.
 type sqlitePersistence struct {
 	*common.RawMessagesPersistence
-	db *sql.DB
+	db    *sql.DB
+	stmts *statementCache
 }
 
 func newSQLitePersistence(db *sql.DB) *sqlitePersistence {
-	return &sqlitePersistence{common.NewRawMessagesPersistence(db), db}
+	return &sqlitePersistence{common.NewRawMessagesPersistence(db), db, newStatementCache(db)}
 }
 
 func (db sqlitePersistence) SaveChat(chat Chat) error {
@@ -138,7 +139,7 @@ func (db sqlitePersistence) saveChat(tx *sql.Tx, chat Chat) error {
 	}
 
 	// Insert record
-	stmt, err := tx.Prepare(`INSERT INTO chats(id, name, color, emoji, active, type, timestamp,  deleted_at_clock_value, unviewed_message_count, unviewed_mentions_count, last_clock_value, last_message, members, membership_updates, muted, muted_till, invitation_admin, profile, community_id, joined, synced_from, synced_to, first_message_timestamp, description, highlight, read_messages_at_clock_value, received_invitation_admin, image_payload)
+	stmt, err := db.cachedTxStmt(tx, `INSERT INTO chats(id, name, color, emoji, active, type, timestamp,  deleted_at_clock_value, unviewed_message_count, unviewed_mentions_count, last_clock_value, last_message, members, membership_updates, muted, muted_till, invitation_admin, profile, community_id, joined, synced_from, synced_to, first_message_timestamp, description, highlight, read_messages_at_clock_value, received_invitation_admin, image_payload)
 	    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?,?, ?,?,?,?,?,?,?,?,?,?,?,?,?,?)`)
 	if err != nil {
 		return err
@@ -847,36 +848,6 @@ func (db sqlitePersistence) SaveContactChatIdentity(contactID string, chatIdenti
 	return
 }
 
-func (db sqlitePersistence) ExpiredMessagesIDs(maxSendCount int) ([]string, error) {
-	ids := []string{}
-
-	rows, err := db.db.Query(`
-			SELECT
-			  id
-			FROM
-				raw_messages
-			WHERE
-			message_type IN (?, ?) AND sent = ? AND send_count <= ?`,
-		protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
-		protobuf.ApplicationMetadataMessage_EMOJI_REACTION,
-		false,
-		maxSendCount)
-	if err != nil {
-		return ids, err
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var id string
-		if err := rows.Scan(&id); err != nil {
-			return ids, err
-		}
-		ids = append(ids, id)
-	}
-
-	return ids, nil
-}
-
 func (db sqlitePersistence) SaveContact(contact *Contact, tx *sql.Tx) (err error) {
 	if tx == nil {
 		tx, err = db.db.BeginTx(context.Background(), &sql.TxOptions{})
@@ -897,7 +868,7 @@ func (db sqlitePersistence) SaveContact(contact *Contact, tx *sql.Tx) (err error
 	// NOTE: name, photo and tribute_to_talk are not used anymore, but it's not nullable
 	// Removing it requires copying over the table which might be expensive
 	// when there are many contacts, so best avoiding it
-	stmt, err := tx.Prepare(`
+	stmt, err := db.cachedTxStmt(tx, `
 		INSERT INTO contacts(
 			id,
 			address,
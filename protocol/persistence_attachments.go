@@ -0,0 +1,156 @@
+package protocol
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const attachmentColumns = `message_id, id, chat_id, author, size, content_type, descriptor, requested, complete`
+
+func scanAttachment(row interface{ Scan(...interface{}) error }) (*Attachment, error) {
+	attachment := &Attachment{Descriptor: &protobuf.AttachmentDescriptor{}}
+	var descriptor []byte
+	err := row.Scan(&attachment.MessageID, &attachment.ID, &attachment.ChatID, &attachment.From, &attachment.Size, &attachment.ContentType, &descriptor, &attachment.Requested, &attachment.Complete)
+	if err != nil {
+		return nil, err
+	}
+	return attachment, proto.Unmarshal(descriptor, attachment.Descriptor)
+}
+
+// SaveAttachment inserts or replaces the attachment of a message
+func (db *sqlitePersistence) SaveAttachment(attachment *Attachment) error {
+	descriptor, err := proto.Marshal(attachment.Descriptor)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.db.Exec(`INSERT INTO attachments(`+attachmentColumns+`) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		attachment.MessageID, attachment.ID, attachment.ChatID, attachment.From, attachment.Size, attachment.ContentType, descriptor, attachment.Requested, attachment.Complete)
+	return err
+}
+
+// AttachmentByMessageID returns the attachment of a message, and nil when
+// there's none
+func (db *sqlitePersistence) AttachmentByMessageID(messageID string) (*Attachment, error) {
+	attachment, err := scanAttachment(db.db.QueryRow(`SELECT `+attachmentColumns+` FROM attachments WHERE message_id = ?`, messageID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return attachment, err
+}
+
+// AttachmentsByID returns the attachments with the given id, one for each
+// message they were received with
+func (db *sqlitePersistence) AttachmentsByID(id string) ([]*Attachment, error) {
+	rows, err := db.db.Query(`SELECT `+attachmentColumns+` FROM attachments WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attachments []*Attachment
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+	return attachments, rows.Err()
+}
+
+// SetAttachmentRequested marks the attachment of a message as requested by
+// the user
+func (db *sqlitePersistence) SetAttachmentRequested(messageID string) error {
+	_, err := db.db.Exec(`UPDATE attachments SET requested = 1 WHERE message_id = ?`, messageID)
+	return err
+}
+
+// CompleteAttachment marks all the attachments with the given id as complete
+// and removes their chunks, the data is stored with the messages
+func (db *sqlitePersistence) CompleteAttachment(id string) (err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`UPDATE attachments SET complete = 1 WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`DELETE FROM attachment_chunks WHERE attachment_id = ?`, id)
+	return err
+}
+
+// AttachmentPayload returns the image or the video of a message, videos are
+// stored apart from the messages
+func (db *sqlitePersistence) AttachmentPayload(messageID string) ([]byte, error) {
+	var payload []byte
+	err := db.db.QueryRow(`SELECT CASE m.content_type WHEN ? THEN v.payload ELSE m.image_payload END FROM user_messages m LEFT JOIN message_videos v ON v.message_id = m.id WHERE m.id = ?`, protobuf.ChatMessage_VIDEO, messageID).Scan(&payload)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return payload, err
+}
+
+// SaveAttachmentChunk stores a chunk, chunks already received are ignored
+func (db *sqlitePersistence) SaveAttachmentChunk(attachmentID string, index uint32, data []byte) error {
+	_, err := db.db.Exec(`INSERT INTO attachment_chunks(attachment_id, chunk_index, data) VALUES(?, ?, ?)`, attachmentID, index, data)
+	return err
+}
+
+// DeleteOrphanedAttachmentChunks removes the incomplete attachments whose
+// message doesn't exist anymore, along with their chunks, and the chunks
+// without an attachment
+func (db *sqlitePersistence) DeleteOrphanedAttachmentChunks() (err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`DELETE FROM attachments WHERE NOT complete AND message_id NOT IN (SELECT id FROM user_messages)`)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`DELETE FROM attachment_chunks WHERE attachment_id NOT IN (SELECT id FROM attachments WHERE NOT complete)`)
+	return err
+}
+
+// AttachmentChunks returns the chunks received for an attachment by index
+func (db *sqlitePersistence) AttachmentChunks(attachmentID string) (map[uint32][]byte, error) {
+	rows, err := db.db.Query(`SELECT chunk_index, data FROM attachment_chunks WHERE attachment_id = ?`, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	chunks := make(map[uint32][]byte)
+	for rows.Next() {
+		var index uint32
+		var data []byte
+		err = rows.Scan(&index, &data)
+		if err != nil {
+			return nil, err
+		}
+		chunks[index] = data
+	}
+	return chunks, rows.Err()
+}
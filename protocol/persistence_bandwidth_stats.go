@@ -0,0 +1,71 @@
+package protocol
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+// BandwidthStats are the bytes exchanged through a waku protocol during an hour
+type BandwidthStats struct {
+	// Hour is the unix timestamp of the start of the hour
+	Hour     int64  `json:"hour"`
+	Protocol string `json:"protocol"`
+	BytesIn  uint64 `json:"bytesIn"`
+	BytesOut uint64 `json:"bytesOut"`
+}
+
+func (db *sqlitePersistence) AddBandwidthStats(hour int64, bandwidth map[string]types.ProtocolBandwidth) (err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for protocol, b := range bandwidth {
+		if b.BytesIn == 0 && b.BytesOut == 0 {
+			continue
+		}
+		_, err = tx.Exec(`INSERT INTO waku_bandwidth_stats(hour, protocol, bytes_in, bytes_out) VALUES(?, ?, ?, ?)
+			ON CONFLICT(hour, protocol) DO UPDATE SET bytes_in = bytes_in + excluded.bytes_in, bytes_out = bytes_out + excluded.bytes_out`,
+			hour, protocol, b.BytesIn, b.BytesOut)
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+func (db *sqlitePersistence) BandwidthStats(since int64) ([]BandwidthStats, error) {
+	rows, err := db.db.Query(`SELECT hour, protocol, bytes_in, bytes_out FROM waku_bandwidth_stats WHERE hour >= ? ORDER BY hour, protocol`, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []BandwidthStats
+	for rows.Next() {
+		var stats BandwidthStats
+		err = rows.Scan(&stats.Hour, &stats.Protocol, &stats.BytesIn, &stats.BytesOut)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, stats)
+	}
+
+	return result, nil
+}
+
+func (db *sqlitePersistence) DeleteBandwidthStatsBefore(hour int64) error {
+	_, err := db.db.Exec(`DELETE FROM waku_bandwidth_stats WHERE hour < ?`, hour)
+	return err
+}
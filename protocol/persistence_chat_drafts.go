@@ -0,0 +1,67 @@
+package protocol
+
+import (
+	"database/sql"
+	"encoding/json"
+)
+
+const chatDraftColumns = `chat_id, text, response_to, attachments, clock`
+
+func scanChatDraft(row interface{ Scan(...interface{}) error }) (*ChatDraft, error) {
+	draft := &ChatDraft{}
+	var attachments []byte
+	err := row.Scan(&draft.ChatID, &draft.Text, &draft.ResponseTo, &attachments, &draft.Clock)
+	if err != nil {
+		return nil, err
+	}
+	if len(attachments) > 0 {
+		err = json.Unmarshal(attachments, &draft.Attachments)
+	}
+	return draft, err
+}
+
+// SaveChatDraft inserts or replaces the draft of a chat. Cleared drafts are
+// kept so that older sync messages don't restore them
+func (db *sqlitePersistence) SaveChatDraft(draft *ChatDraft) error {
+	var attachments []byte
+	if len(draft.Attachments) > 0 {
+		var err error
+		attachments, err = json.Marshal(draft.Attachments)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := db.db.Exec(`INSERT INTO chat_drafts(`+chatDraftColumns+`) VALUES(?, ?, ?, ?, ?)`,
+		draft.ChatID, draft.Text, draft.ResponseTo, attachments, draft.Clock)
+	return err
+}
+
+// ChatDraft returns the draft of a chat, cleared or not, and nil when there's
+// none
+func (db *sqlitePersistence) ChatDraft(chatID string) (*ChatDraft, error) {
+	draft, err := scanChatDraft(db.db.QueryRow(`SELECT `+chatDraftColumns+` FROM chat_drafts WHERE chat_id = ?`, chatID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return draft, err
+}
+
+// ChatDrafts returns the drafts which aren't cleared
+func (db *sqlitePersistence) ChatDrafts() ([]*ChatDraft, error) {
+	rows, err := db.db.Query(`SELECT ` + chatDraftColumns + ` FROM chat_drafts WHERE text != '' OR response_to != '' OR attachments IS NOT NULL ORDER BY clock`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var drafts []*ChatDraft
+	for rows.Next() {
+		draft, err := scanChatDraft(rows)
+		if err != nil {
+			return nil, err
+		}
+		drafts = append(drafts, draft)
+	}
+	return drafts, rows.Err()
+}
@@ -0,0 +1,59 @@
+package protocol
+
+import (
+	"database/sql"
+)
+
+const communityMemberProfileColumns = `community_id, member_id, display_name, image, clock`
+
+func scanCommunityMemberProfile(row interface{ Scan(...interface{}) error }) (*CommunityMemberProfile, error) {
+	profile := &CommunityMemberProfile{}
+	err := row.Scan(&profile.CommunityID, &profile.MemberID, &profile.DisplayName, &profile.Image, &profile.Clock)
+	return profile, err
+}
+
+// SaveCommunityMemberProfile stores a profile unless a more recent one is
+// stored already, and tells whether it was stored
+func (db *sqlitePersistence) SaveCommunityMemberProfile(profile *CommunityMemberProfile) (bool, error) {
+	result, err := db.db.Exec(`INSERT INTO community_member_profiles(`+communityMemberProfileColumns+`)
+		SELECT ?, ?, ?, ?, ?
+		WHERE NOT EXISTS (SELECT 1 FROM community_member_profiles WHERE community_id = ? AND member_id = ? AND clock >= ?)`,
+		profile.CommunityID, profile.MemberID, profile.DisplayName, profile.Image, profile.Clock,
+		profile.CommunityID, profile.MemberID, profile.Clock)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	return rows != 0, err
+}
+
+// CommunityMemberProfile returns the profile of a member in a community, and
+// nil when there's none
+func (db *sqlitePersistence) CommunityMemberProfile(communityID string, memberID string) (*CommunityMemberProfile, error) {
+	profile, err := scanCommunityMemberProfile(db.db.QueryRow(`SELECT `+communityMemberProfileColumns+` FROM community_member_profiles WHERE community_id = ? AND member_id = ?`, communityID, memberID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return profile, err
+}
+
+// CommunityMemberProfiles returns the profiles of the members of a community
+// which override something
+func (db *sqlitePersistence) CommunityMemberProfiles(communityID string) ([]*CommunityMemberProfile, error) {
+	rows, err := db.db.Query(`SELECT `+communityMemberProfileColumns+` FROM community_member_profiles WHERE community_id = ? AND (display_name != '' OR image IS NOT NULL)`, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var profiles []*CommunityMemberProfile
+	for rows.Next() {
+		profile, err := scanCommunityMemberProfile(rows)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, rows.Err()
+}
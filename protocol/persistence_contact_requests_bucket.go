@@ -0,0 +1,74 @@
+package protocol
+
+import (
+	"database/sql"
+	"strings"
+)
+
+const bucketedContactRequestColumns = `contact_id, message_id, contact_request, trust_score, timestamp`
+
+func scanBucketedContactRequest(row interface{ Scan(...interface{}) error }) (*BucketedContactRequest, error) {
+	request := &BucketedContactRequest{}
+	err := row.Scan(&request.ContactID, &request.MessageID, &request.ContactRequest, &request.TrustScore, &request.Timestamp)
+	return request, err
+}
+
+// BucketContactRequest keeps the latest message of a contact in the bucket,
+// unless a contact request of theirs is already there
+func (db *sqlitePersistence) BucketContactRequest(request *BucketedContactRequest) error {
+	_, err := db.db.Exec(`INSERT INTO contact_requests_bucket(`+bucketedContactRequestColumns+`) VALUES(?, ?, ?, ?, ?)
+			ON CONFLICT(contact_id) DO UPDATE SET message_id = excluded.message_id, contact_request = excluded.contact_request, trust_score = excluded.trust_score, timestamp = excluded.timestamp
+			WHERE excluded.contact_request OR NOT contact_requests_bucket.contact_request`,
+		request.ContactID, request.MessageID, request.ContactRequest, request.TrustScore, request.Timestamp)
+	return err
+}
+
+// BucketedContactRequest returns the request of the contact in the bucket,
+// and nil when there's none
+func (db *sqlitePersistence) BucketedContactRequest(contactID string) (*BucketedContactRequest, error) {
+	request, err := scanBucketedContactRequest(db.db.QueryRow(`SELECT `+bucketedContactRequestColumns+` FROM contact_requests_bucket WHERE contact_id = ?`, contactID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return request, err
+}
+
+// BucketedContactRequests returns the requests in the bucket, the latest first
+func (db *sqlitePersistence) BucketedContactRequests() ([]*BucketedContactRequest, error) {
+	rows, err := db.db.Query(`SELECT ` + bucketedContactRequestColumns + ` FROM contact_requests_bucket ORDER BY timestamp DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*BucketedContactRequest
+	for rows.Next() {
+		request, err := scanBucketedContactRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, request)
+	}
+	return result, rows.Err()
+}
+
+func (db *sqlitePersistence) DeleteBucketedContactRequests(contactIDs []string) error {
+	if len(contactIDs) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, len(contactIDs))
+	for _, contactID := range contactIDs {
+		args = append(args, contactID)
+	}
+	inVector := strings.Repeat("?, ", len(contactIDs)-1) + "?"
+	_, err := db.db.Exec(`DELETE FROM contact_requests_bucket WHERE contact_id IN (`+inVector+`)`, args...) // nolint: gosec
+	return err
+}
+
+// HasMessagesFrom tells whether the author sent any message in the chat
+func (db *sqlitePersistence) HasMessagesFrom(chatID string, from string) (bool, error) {
+	var result bool
+	err := db.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM user_messages WHERE local_chat_id = ? AND source = ?)`, chatID, from).Scan(&result)
+	return result, err
+}
@@ -0,0 +1,37 @@
+package protocol
+
+import (
+	"database/sql"
+	"time"
+)
+
+// MuteClock returns the clock of the last mute or unmute of a chat or a
+// community, and 0 when it has never been muted
+func (db *sqlitePersistence) MuteClock(id string) (uint64, error) {
+	var clock uint64
+	err := db.db.QueryRow(`SELECT clock FROM mute_clocks WHERE id = ?`, id).Scan(&clock)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return clock, err
+}
+
+// MuteState returns whether the last mute or unmute of a chat or a community
+// was a mute, and until when
+func (db *sqlitePersistence) MuteState(id string) (bool, time.Time, error) {
+	var muted bool
+	var muteTill int64
+	err := db.db.QueryRow(`SELECT muted, mute_till FROM mute_clocks WHERE id = ?`, id).Scan(&muted, &muteTill)
+	if err == sql.ErrNoRows {
+		return false, time.Time{}, nil
+	}
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	return muted, muteTillFromProtobuf(muteTill), nil
+}
+
+func (db *sqlitePersistence) SaveMuteClock(id string, clock uint64, muted bool, mutedTill time.Time) error {
+	_, err := db.db.Exec(`INSERT INTO mute_clocks(id, clock, muted, mute_till) VALUES(?, ?, ?, ?)`, id, clock, muted, muteTillToProtobuf(mutedTill))
+	return err
+}
@@ -0,0 +1,50 @@
+package protocol
+
+import (
+	"database/sql"
+)
+
+const notificationRuleColumns = `id, community_id, chat_id, trigger_type, action, quiet_hours_start, quiet_hours_end, clock, deleted`
+
+func scanNotificationRule(row interface{ Scan(...interface{}) error }) (*NotificationRule, error) {
+	rule := &NotificationRule{}
+	err := row.Scan(&rule.ID, &rule.CommunityID, &rule.ChatID, &rule.Trigger, &rule.Action, &rule.QuietHoursStart, &rule.QuietHoursEnd, &rule.Clock, &rule.Deleted)
+	return rule, err
+}
+
+// SaveNotificationRule inserts or replaces a rule. Deleted rules are kept so
+// that older sync messages don't restore them
+func (db *sqlitePersistence) SaveNotificationRule(rule *NotificationRule) error {
+	_, err := db.db.Exec(`INSERT INTO notification_rules(`+notificationRuleColumns+`) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		rule.ID, rule.CommunityID, rule.ChatID, rule.Trigger, rule.Action, rule.QuietHoursStart, rule.QuietHoursEnd, rule.Clock, rule.Deleted)
+	return err
+}
+
+// NotificationRule returns the rule with the given ID, deleted or not, and
+// nil when there's none
+func (db *sqlitePersistence) NotificationRule(id string) (*NotificationRule, error) {
+	rule, err := scanNotificationRule(db.db.QueryRow(`SELECT `+notificationRuleColumns+` FROM notification_rules WHERE id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return rule, err
+}
+
+// NotificationRules returns the rules which aren't deleted
+func (db *sqlitePersistence) NotificationRules() ([]*NotificationRule, error) {
+	rows, err := db.db.Query(`SELECT ` + notificationRuleColumns + ` FROM notification_rules WHERE NOT deleted ORDER BY clock`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*NotificationRule
+	for rows.Next() {
+		rule, err := scanNotificationRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
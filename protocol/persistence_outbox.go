@@ -0,0 +1,71 @@
+package protocol
+
+import (
+	"database/sql"
+)
+
+const selectOutboxMessagesQuery = `SELECT id, local_chat_id, status, attempts, next_attempt, last_error, updated_at FROM outbox_messages`
+
+func (db *sqlitePersistence) SaveOutboxMessage(message *OutboxMessage) error {
+	_, err := db.db.Exec(`INSERT INTO outbox_messages(id, local_chat_id, status, attempts, next_attempt, last_error, updated_at) VALUES(?, ?, ?, ?, ?, ?, ?)`,
+		message.ID, message.LocalChatID, message.Status, message.Attempts, message.NextAttempt, message.LastError, message.UpdatedAt)
+	return err
+}
+
+func (db *sqlitePersistence) OutboxMessageByID(id string) (*OutboxMessage, error) {
+	messages, err := db.queryOutboxMessages(selectOutboxMessagesQuery+` WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return messages[0], nil
+}
+
+func (db *sqlitePersistence) OutboxMessages() ([]*OutboxMessage, error) {
+	return db.queryOutboxMessages(selectOutboxMessagesQuery + ` ORDER BY updated_at DESC`)
+}
+
+// DueOutboxMessages returns the messages waiting to be sent, whose next
+// attempt is due
+func (db *sqlitePersistence) DueOutboxMessages(now uint64) ([]*OutboxMessage, error) {
+	return db.queryOutboxMessages(selectOutboxMessagesQuery+` WHERE status IN (?, ?) AND next_attempt <= ? ORDER BY next_attempt`,
+		OutboxMessageQueued, OutboxMessageSending, now)
+}
+
+// MarkOutboxMessageSent is a no-op for messages which aren't in the outbox
+func (db *sqlitePersistence) MarkOutboxMessageSent(id string, now uint64) error {
+	_, err := db.db.Exec(`UPDATE outbox_messages SET status = ?, updated_at = ? WHERE id = ?`, OutboxMessageSent, now, id)
+	return err
+}
+
+func (db *sqlitePersistence) DeleteOutboxMessage(id string) error {
+	_, err := db.db.Exec(`DELETE FROM outbox_messages WHERE id = ?`, id)
+	return err
+}
+
+func (db *sqlitePersistence) DeleteSentOutboxMessagesBefore(timestamp uint64) error {
+	_, err := db.db.Exec(`DELETE FROM outbox_messages WHERE status = ? AND updated_at < ?`, OutboxMessageSent, timestamp)
+	return err
+}
+
+func (db *sqlitePersistence) queryOutboxMessages(query string, args ...interface{}) ([]*OutboxMessage, error) {
+	rows, err := db.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []*OutboxMessage
+	for rows.Next() {
+		message := &OutboxMessage{}
+		err = rows.Scan(&message.ID, &message.LocalChatID, &message.Status, &message.Attempts, &message.NextAttempt, &message.LastError, &message.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
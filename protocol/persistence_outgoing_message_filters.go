@@ -0,0 +1,52 @@
+package protocol
+
+import (
+	"database/sql"
+)
+
+const outgoingMessageFilterColumns = `id, community_id, filter_type, pattern, action, description`
+
+func scanOutgoingMessageFilter(row interface{ Scan(...interface{}) error }) (*OutgoingMessageFilter, error) {
+	filter := &OutgoingMessageFilter{}
+	err := row.Scan(&filter.ID, &filter.CommunityID, &filter.Type, &filter.Pattern, &filter.Action, &filter.Description)
+	return filter, err
+}
+
+func (db *sqlitePersistence) SaveOutgoingMessageFilter(filter *OutgoingMessageFilter) error {
+	_, err := db.db.Exec(`INSERT INTO outgoing_message_filters(`+outgoingMessageFilterColumns+`) VALUES(?, ?, ?, ?, ?, ?)`,
+		filter.ID, filter.CommunityID, filter.Type, filter.Pattern, filter.Action, filter.Description)
+	return err
+}
+
+// OutgoingMessageFilter returns the filter with the given ID, and nil when
+// there's none
+func (db *sqlitePersistence) OutgoingMessageFilter(id string) (*OutgoingMessageFilter, error) {
+	filter, err := scanOutgoingMessageFilter(db.db.QueryRow(`SELECT `+outgoingMessageFilterColumns+` FROM outgoing_message_filters WHERE id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return filter, err
+}
+
+func (db *sqlitePersistence) DeleteOutgoingMessageFilter(id string) error {
+	_, err := db.db.Exec(`DELETE FROM outgoing_message_filters WHERE id = ?`, id)
+	return err
+}
+
+func (db *sqlitePersistence) OutgoingMessageFilters() ([]*OutgoingMessageFilter, error) {
+	rows, err := db.db.Query(`SELECT ` + outgoingMessageFilterColumns + ` FROM outgoing_message_filters ORDER BY rowid`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var filters []*OutgoingMessageFilter
+	for rows.Next() {
+		filter, err := scanOutgoingMessageFilter(rows)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, rows.Err()
+}
@@ -0,0 +1,227 @@
+package protocol
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+func (db *sqlitePersistence) SaveMessageRetentionPolicy(policy *MessageRetentionPolicy) error {
+	_, err := db.db.Exec(`INSERT INTO message_retention_policies(chat_type, max_age) VALUES(?, ?)`, policy.ChatType, policy.MaxAge)
+	return err
+}
+
+func (db *sqlitePersistence) DeleteMessageRetentionPolicy(chatType ChatType) error {
+	_, err := db.db.Exec(`DELETE FROM message_retention_policies WHERE chat_type = ?`, chatType)
+	return err
+}
+
+func (db *sqlitePersistence) MessageRetentionPolicies() ([]*MessageRetentionPolicy, error) {
+	rows, err := db.db.Query(`SELECT chat_type, max_age FROM message_retention_policies ORDER BY chat_type`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []*MessageRetentionPolicy
+	for rows.Next() {
+		policy := &MessageRetentionPolicy{}
+		err = rows.Scan(&policy.ChatType, &policy.MaxAge)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+// deleteInBatch runs a delete statement whose query selects at most limit
+// rows, and returns the number of deleted rows
+func (db *sqlitePersistence) deleteInBatch(query string, args ...interface{}) (int64, error) {
+	result, err := db.db.Exec(query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// updateChatsAfterPruning recalculates the last message and the unviewed
+// counts of the chats which had messages deleted
+func (db *sqlitePersistence) updateChatsAfterPruning(tx *sql.Tx, chatIDs map[string]struct{}) error {
+	for chatID := range chatIDs {
+		_, err := tx.Exec(`
+			UPDATE chats
+			SET
+				unviewed_message_count = (SELECT COUNT(1) FROM user_messages WHERE seen = 0 AND local_chat_id = chats.id),
+				unviewed_mentions_count = (SELECT COUNT(1) FROM user_messages WHERE seen = 0 AND local_chat_id = chats.id AND (mentioned OR replied))
+			WHERE id = ?`, chatID)
+		if err != nil {
+			return err
+		}
+
+		var lastMessageID string
+		err = tx.QueryRow(`SELECT id FROM user_messages WHERE local_chat_id = ? ORDER BY clock_value DESC LIMIT 1`, chatID).Scan(&lastMessageID)
+		switch err {
+		case nil:
+			message, err := db.messageByID(tx, lastMessageID)
+			if err != nil {
+				return err
+			}
+			encodedMessage, err := json.Marshal(message)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Exec(`UPDATE chats SET last_message = ? WHERE id = ?`, encodedMessage, chatID)
+			if err != nil {
+				return err
+			}
+		case sql.ErrNoRows:
+			_, err = tx.Exec(`UPDATE chats SET last_message = NULL WHERE id = ?`, chatID)
+			if err != nil {
+				return err
+			}
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// PruneMessagesBatch deletes at most limit messages of the chats of the given
+// type received before the timestamp in ms, with their reactions and edits,
+// and updates the last message and the unviewed counts of their chats. Pinned
+// messages are kept
+func (db *sqlitePersistence) PruneMessagesBatch(chatType ChatType, before uint64, limit int) (deleted int64, err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	rows, err := tx.Query(`SELECT m.id, m.local_chat_id FROM user_messages m JOIN chats c ON c.id = m.local_chat_id
+		WHERE c.type = ? AND m.whisper_timestamp < ?
+		AND m.id NOT IN (SELECT message_id FROM pin_messages WHERE pinned = 1) LIMIT ?`, chatType, before, limit)
+	if err != nil {
+		return 0, err
+	}
+	var ids []interface{}
+	chatIDs := make(map[string]struct{})
+	for rows.Next() {
+		var id, chatID string
+		err = rows.Scan(&id, &chatID)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+		chatIDs[chatID] = struct{}{}
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+
+	inVector := strings.Repeat("?, ", len(ids)-1) + "?"
+	// pin_messages only has the unpinned messages left
+	for _, table := range []string{"emoji_reactions", "emoji_reactions_counts", "message_edits", "pin_messages"} {
+		_, err = tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE message_id IN (%s)", table, inVector), ids...) // nolint: gosec
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	result, err := tx.Exec(fmt.Sprintf("DELETE FROM user_messages WHERE id IN (%s)", inVector), ids...) // nolint: gosec
+	if err != nil {
+		return 0, err
+	}
+	deleted, err = result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	err = db.updateChatsAfterPruning(tx, chatIDs)
+	return deleted, err
+}
+
+// PruneRawMessagesBatch deletes at most limit raw messages of the chats of
+// the given type last sent before the timestamp in ms. Messages which are
+// still waiting in the outbox are kept
+func (db *sqlitePersistence) PruneRawMessagesBatch(chatType ChatType, before uint64, limit int) (int64, error) {
+	return db.deleteInBatch(`DELETE FROM raw_messages WHERE id IN (
+		SELECT r.id FROM raw_messages r JOIN chats c ON c.id = r.local_chat_id
+		WHERE c.type = ? AND r.last_sent < ?
+		AND r.id NOT IN (SELECT id FROM outbox_messages WHERE status IN (?, ?)) LIMIT ?)`,
+		chatType, before, OutboxMessageQueued, OutboxMessageSending, limit)
+}
+
+// PruneActivityCenterNotificationsBatch deletes at most limit notifications
+// of the chats of the given type created before the timestamp in ms
+func (db *sqlitePersistence) PruneActivityCenterNotificationsBatch(chatType ChatType, before uint64, limit int) (int64, error) {
+	return db.deleteInBatch(`DELETE FROM activity_center_notifications WHERE id IN (
+		SELECT a.id FROM activity_center_notifications a JOIN chats c ON c.id = a.chat_id
+		WHERE c.type = ? AND a.timestamp < ? LIMIT ?)`, chatType, before, limit)
+}
+
+func (db *sqlitePersistence) pragmaInt(name string) (int64, error) {
+	var value int64
+	err := db.db.QueryRow(fmt.Sprintf("PRAGMA %s", name)).Scan(&value)
+	return value, err
+}
+
+// DatabaseSize returns the size of the database in bytes
+func (db *sqlitePersistence) DatabaseSize() (int64, error) {
+	pageCount, err := db.pragmaInt("page_count")
+	if err != nil {
+		return 0, err
+	}
+	pageSize, err := db.pragmaInt("page_size")
+	if err != nil {
+		return 0, err
+	}
+	return pageCount * pageSize, nil
+}
+
+// IncrementalVacuum releases at most pages free pages of the database to the
+// file system and returns whether there are free pages left. It does nothing
+// unless the database was created with incremental auto vacuum, otherwise the
+// free pages are reused by the next writes
+func (db *sqlitePersistence) IncrementalVacuum(pages int) (bool, error) {
+	autoVacuum, err := db.pragmaInt("auto_vacuum")
+	if err != nil {
+		return false, err
+	}
+	// 2 is INCREMENTAL
+	if autoVacuum != 2 {
+		return false, nil
+	}
+
+	// incremental_vacuum returns no rows, but only runs when they are read
+	rows, err := db.db.Query(fmt.Sprintf("PRAGMA incremental_vacuum(%d)", pages))
+	if err != nil {
+		return false, err
+	}
+	for rows.Next() {
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return false, err
+	}
+
+	freePages, err := db.pragmaInt("freelist_count")
+	if err != nil {
+		return false, err
+	}
+	return freePages > 0, nil
+}
@@ -0,0 +1,130 @@
+package protocol
+
+import (
+	"database/sql"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// SaveSafeTransactionSignature inserts or replaces the signature of an owner
+// of the Safe for the transaction shared in the message
+func (db *sqlitePersistence) SaveSafeTransactionSignature(signature *SafeTransactionSignature) error {
+	_, err := db.db.Exec(`INSERT INTO safe_transaction_signatures(message_id, signer, signature, source, clock_value) VALUES(?, ?, ?, ?, ?)`,
+		signature.MessageId, gethcommon.HexToAddress(signature.Signer).Hex(), signature.Signature, signature.From, signature.Clock)
+	return err
+}
+
+// SafeTransactionSignatures returns the signatures of the transaction shared
+// in the message, by owner
+func (db *sqlitePersistence) SafeTransactionSignatures(messageID string) (map[gethcommon.Address][]byte, error) {
+	rows, err := db.db.Query(`SELECT signer, signature FROM safe_transaction_signatures WHERE message_id = ?`, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	signatures := make(map[gethcommon.Address][]byte)
+	for rows.Next() {
+		var signer string
+		var signature []byte
+		err = rows.Scan(&signer, &signature)
+		if err != nil {
+			return nil, err
+		}
+		signatures[gethcommon.HexToAddress(signer)] = signature
+	}
+	return signatures, rows.Err()
+}
+
+// SaveSafeTransactionExecution stores the hash of the submitted transaction
+func (db *sqlitePersistence) SaveSafeTransactionExecution(execution *SafeTransactionExecution) error {
+	_, err := db.db.Exec(`INSERT INTO safe_transaction_executions(message_id, transaction_hash, source, clock_value) VALUES(?, ?, ?, ?)`,
+		execution.MessageId, execution.TransactionHash, execution.From, execution.Clock)
+	return err
+}
+
+// SafeTransactionExecutionHash returns the hash of the submitted transaction,
+// and an empty string when it wasn't submitted
+func (db *sqlitePersistence) SafeTransactionExecutionHash(messageID string) (string, error) {
+	var transactionHash string
+	err := db.db.QueryRow(`SELECT transaction_hash FROM safe_transaction_executions WHERE message_id = ?`, messageID).Scan(&transactionHash)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return transactionHash, err
+}
+
+func (db *sqlitePersistence) safeTransactionExecution(query string, arg string) (*SafeTransactionExecution, error) {
+	execution := &SafeTransactionExecution{}
+	err := db.db.QueryRow(`SELECT message_id, transaction_hash, source, clock_value FROM safe_transaction_executions WHERE `+query, arg).
+		Scan(&execution.MessageId, &execution.TransactionHash, &execution.From, &execution.Clock)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return execution, nil
+}
+
+// SafeTransactionExecution returns the execution of the transaction shared
+// in the message, and nil when it wasn't submitted
+func (db *sqlitePersistence) SafeTransactionExecution(messageID string) (*SafeTransactionExecution, error) {
+	return db.safeTransactionExecution(`message_id = ?`, messageID)
+}
+
+// SafeTransactionExecutionByHash returns the execution submitted by the
+// transaction, and nil when there's none
+func (db *sqlitePersistence) SafeTransactionExecutionByHash(transactionHash string) (*SafeTransactionExecution, error) {
+	return db.safeTransactionExecution(`transaction_hash = ?`, transactionHash)
+}
+
+// DeleteSafeTransactionExecution removes the execution of the transaction
+// shared in the message, once its transaction didn't execute it
+func (db *sqlitePersistence) DeleteSafeTransactionExecution(messageID string) error {
+	_, err := db.db.Exec(`DELETE FROM safe_transaction_executions WHERE message_id = ?`, messageID)
+	return err
+}
+
+// SaveUnverifiedSafeTransactionExecution stores an execution received in a
+// chat until it's checked on chain
+func (db *sqlitePersistence) SaveUnverifiedSafeTransactionExecution(execution *SafeTransactionExecution, receivedAt uint64) error {
+	_, err := db.db.Exec(`INSERT INTO safe_transaction_unverified_executions(message_id, transaction_hash, source, clock_value, received_at) VALUES(?, ?, ?, ?, ?)`,
+		execution.MessageId, execution.TransactionHash, execution.From, execution.Clock, receivedAt)
+	return err
+}
+
+// UnverifiedSafeTransactionExecutions returns the executions received in a
+// chat which weren't checked on chain yet
+func (db *sqlitePersistence) UnverifiedSafeTransactionExecutions() ([]*SafeTransactionExecution, error) {
+	rows, err := db.db.Query(`SELECT message_id, transaction_hash, source, clock_value FROM safe_transaction_unverified_executions ORDER BY received_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var executions []*SafeTransactionExecution
+	for rows.Next() {
+		execution := &SafeTransactionExecution{}
+		err = rows.Scan(&execution.MessageId, &execution.TransactionHash, &execution.From, &execution.Clock)
+		if err != nil {
+			return nil, err
+		}
+		executions = append(executions, execution)
+	}
+	return executions, rows.Err()
+}
+
+// DeleteUnverifiedSafeTransactionExecution removes an execution received in a
+// chat once it was checked on chain
+func (db *sqlitePersistence) DeleteUnverifiedSafeTransactionExecution(messageID string, transactionHash string) error {
+	_, err := db.db.Exec(`DELETE FROM safe_transaction_unverified_executions WHERE message_id = ? AND transaction_hash = ?`, messageID, transactionHash)
+	return err
+}
+
+// DeleteUnverifiedSafeTransactionExecutionsBefore removes the executions
+// received before the timestamp, whose proposal never arrived
+func (db *sqlitePersistence) DeleteUnverifiedSafeTransactionExecutionsBefore(receivedAt uint64) error {
+	_, err := db.db.Exec(`DELETE FROM safe_transaction_unverified_executions WHERE received_at < ?`, receivedAt)
+	return err
+}
@@ -12,8 +12,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/require"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/common"
@@ -43,6 +46,41 @@ func TestSaveMessages(t *testing.T) {
 	}
 }
 
+func TestSaveReceivedData(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	chat := CreatePublicChat("test-chat", &testTimeSource{})
+	messages := []*common.Message{
+		{ID: "1", LocalChatID: chat.ID, ChatMessage: protobuf.ChatMessage{Text: "some-text"}, From: testPK},
+		{ID: "2", LocalChatID: chat.ID, ChatMessage: protobuf.ChatMessage{Text: "some-text"}, From: testPK},
+	}
+	contact := &Contact{ID: testPK}
+
+	require.NoError(t, p.SaveReceivedData([]*Chat{chat}, messages, []*Contact{contact}))
+
+	savedChat, err := p.Chat(chat.ID)
+	require.NoError(t, err)
+	require.NotNil(t, savedChat)
+
+	savedMessages, err := p.MessagesByIDs([]string{"1", "2"})
+	require.NoError(t, err)
+	require.Len(t, savedMessages, 2)
+
+	contacts, err := p.Contacts()
+	require.NoError(t, err)
+	require.Len(t, contacts, 1)
+
+	// The cached statements are reused by the following batches
+	messages[0].Text = "edited-text"
+	require.NoError(t, p.SaveReceivedData(nil, messages[:1], nil))
+
+	message, err := p.MessageByID("1")
+	require.NoError(t, err)
+	require.Equal(t, "edited-text", message.Text)
+}
+
 func TestMessagesByIDs(t *testing.T) {
 	db, err := openTestDB()
 	require.NoError(t, err)
@@ -248,6 +286,71 @@ func TestMessageByChatID(t *testing.T) {
 	)
 }
 
+func TestMessagesByChatIDInTimeRange(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+	chatID := testPublicChatID
+	count := 100
+	pageSize := 7
+
+	var messages []*common.Message
+	for i := 0; i < count; i++ {
+		messages = append(messages, &common.Message{
+			ID:          strconv.Itoa(i),
+			LocalChatID: chatID,
+			ChatMessage: protobuf.ChatMessage{
+				Clock:     uint64(i),
+				Timestamp: uint64(i * 1000),
+			},
+			From: testPK,
+		})
+	}
+	messages = append(messages, &common.Message{
+		ID:          strconv.Itoa(count),
+		LocalChatID: "other-chat",
+		ChatMessage: protobuf.ChatMessage{
+			Clock:     uint64(50),
+			Timestamp: uint64(50000),
+		},
+		From: testPK,
+	})
+
+	err = p.SaveMessages(messages)
+	require.NoError(t, err)
+
+	var (
+		result []*common.Message
+		cursor string
+	)
+	for {
+		var items []*common.Message
+		items, cursor, err = p.MessagesByChatIDInTimeRange(chatID, 20000, 59000, cursor, pageSize)
+		require.NoError(t, err)
+		result = append(result, items...)
+
+		if len(cursor) == 0 {
+			break
+		}
+	}
+
+	require.Len(t, result, 40)
+	require.Equal(t, uint64(20000), result[0].Timestamp)
+	require.Equal(t, uint64(59000), result[len(result)-1].Timestamp)
+	require.True(
+		t,
+		// Verify ascending order.
+		sort.SliceIsSorted(result, func(i, j int) bool {
+			return result[i].Clock < result[j].Clock
+		}),
+	)
+
+	result, cursor, err = p.MessagesByChatIDInTimeRange(chatID, 90000, 0, "", pageSize*2)
+	require.NoError(t, err)
+	require.Equal(t, "", cursor)
+	require.Len(t, result, 10)
+}
+
 func TestFirstUnseenMessageIDByChatID(t *testing.T) {
 	db, err := openTestDB()
 	require.NoError(t, err)
@@ -734,38 +837,6 @@ func TestMessagesIDsByType(t *testing.T) {
 	require.Equal(t, "emoji-message-id", ids[0])
 }
 
-func TestExpiredMessagesIDs(t *testing.T) {
-	db, err := openTestDB()
-	require.NoError(t, err)
-	p := newSQLitePersistence(db)
-
-	ids, err := p.ExpiredMessagesIDs(messageResendMaxCount)
-	require.NoError(t, err)
-	require.Empty(t, ids)
-
-	//save expired emoji message
-	rawEmojiReaction := minimalRawMessage("emoji-message-id", protobuf.ApplicationMetadataMessage_EMOJI_REACTION)
-	rawEmojiReaction.Sent = false
-	err = p.SaveRawMessage(rawEmojiReaction)
-	require.NoError(t, err)
-
-	//make sure it appered in expired emoji reactions list
-	ids, err = p.ExpiredMessagesIDs(messageResendMaxCount)
-	require.NoError(t, err)
-	require.Equal(t, 1, len(ids))
-
-	//save non-expired emoji reaction
-	rawEmojiReaction2 := minimalRawMessage("emoji-message-id2", protobuf.ApplicationMetadataMessage_EMOJI_REACTION)
-	rawEmojiReaction2.Sent = true
-	err = p.SaveRawMessage(rawEmojiReaction2)
-	require.NoError(t, err)
-
-	//make sure it didn't appear in expired emoji reactions list
-	ids, err = p.ExpiredMessagesIDs(messageResendMaxCount)
-	require.NoError(t, err)
-	require.Equal(t, 1, len(ids))
-}
-
 func TestDontOverwriteSentStatus(t *testing.T) {
 	db, err := openTestDB()
 	require.NoError(t, err)
@@ -1015,6 +1086,38 @@ func TestMessagesAudioDurationMsNull(t *testing.T) {
 	require.Len(t, m, 1)
 }
 
+func TestMessagesAudioWaveform(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	waveform := []byte{0, 64, 128, 255}
+	message := &common.Message{
+		ID:          "message-id-1",
+		LocalChatID: testPublicChatID,
+		ChatMessage: protobuf.ChatMessage{
+			Clock:       1,
+			ContentType: protobuf.ChatMessage_AUDIO,
+			Payload: &protobuf.ChatMessage_Audio{
+				Audio: &protobuf.AudioMessage{
+					Type:       protobuf.AudioMessage_AAC,
+					DurationMs: 1500,
+					Waveform:   waveform,
+				},
+			},
+		},
+		From: testPK,
+	}
+	err = p.SaveMessages([]*common.Message{message})
+	require.NoError(t, err)
+
+	m, err := p.MessageByID(message.ID)
+	require.NoError(t, err)
+	require.NotNil(t, m.GetAudio())
+	require.Equal(t, uint64(1500), m.GetAudio().DurationMs)
+	require.Equal(t, waveform, m.GetAudio().Waveform)
+}
+
 func TestSaveChat(t *testing.T) {
 	db, err := openTestDB()
 	require.NoError(t, err)
@@ -1265,6 +1368,52 @@ func TestHideMessage(t *testing.T) {
 	require.True(t, actualSeen)
 }
 
+func TestHideBlocklistedMessages(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	communityID := "0x02"
+	var messages []*common.Message
+	for i, from := range []string{"a", "b", "a"} {
+		messages = append(messages, &common.Message{
+			ID:          fmt.Sprintf("id-%d", i),
+			LocalChatID: communityID + "chat",
+			ChatMessage: protobuf.ChatMessage{Text: "content", Clock: uint64(i)},
+			From:        from,
+		})
+	}
+	require.NoError(t, p.SaveMessages(messages))
+	require.NoError(t, p.HideMessage("id-2"))
+	require.NoError(t, p.HideBlocklistedMessage("id-0"))
+	require.NoError(t, p.HideBlocklistedMessage("id-1"))
+
+	hidden := func(id string) bool {
+		var hide bool
+		require.NoError(t, p.db.QueryRow("SELECT hide FROM user_messages WHERE id = ?", id).Scan(&hide))
+		return hide
+	}
+
+	// Only the messages of the authors removed from the blocklist show up
+	require.NoError(t, p.UnhideBlocklistedMessages(communityID, []string{"a"}))
+	require.True(t, hidden("id-0"))
+	require.False(t, hidden("id-1"))
+
+	// Messages hidden for other reasons stay hidden
+	require.NoError(t, p.UnhideBlocklistedMessages("", nil))
+	require.False(t, hidden("id-0"))
+	require.True(t, hidden("id-2"))
+
+	// The stored messages of newly blocklisted authors are hidden
+	require.NoError(t, p.HideBlocklistedMessages(communityID, []string{"a"}))
+	require.True(t, hidden("id-0"))
+	require.False(t, hidden("id-1"))
+
+	require.NoError(t, p.UnhideBlocklistedMessages(communityID, nil))
+	require.False(t, hidden("id-0"))
+	require.True(t, hidden("id-2"))
+}
+
 func TestDeactivatePublicChat(t *testing.T) {
 	db, err := openTestDB()
 	require.NoError(t, err)
@@ -1744,3 +1893,493 @@ func TestCountActiveChattersInCommunity(t *testing.T) {
 	checker(7, 1)
 	checker(8, 0)
 }
+
+func TestMessagesMatchingFullTextQuery(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	messages := []*common.Message{
+		{ID: "1", LocalChatID: testPublicChatID, From: testPK, ChatMessage: protobuf.ChatMessage{Text: "hello world", Clock: 1}},
+		{ID: "2", LocalChatID: testPublicChatID, From: testPK, ChatMessage: protobuf.ChatMessage{Text: "world peace", Clock: 2}},
+		{ID: "3", LocalChatID: "other-chat", From: testPK, ChatMessage: protobuf.ChatMessage{Text: "hello there", Clock: 3}},
+		{ID: "4", LocalChatID: testPublicChatID, From: testPK, Deleted: true, ChatMessage: protobuf.ChatMessage{Text: "hello deleted", Clock: 4}},
+	}
+	require.NoError(t, p.SaveMessages(messages))
+
+	matches, err := p.MessagesMatchingFullTextQuery(`"hello"`, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+
+	matches, err = p.MessagesMatchingFullTextQuery(`"hello"`, []string{testPublicChatID}, 10)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, "1", matches[0].ID)
+
+	// Replacing a message updates the index
+	messages[0].Text = "goodbye world"
+	require.NoError(t, p.SaveMessages(messages[:1]))
+	matches, err = p.MessagesMatchingFullTextQuery(`"hello"`, []string{testPublicChatID}, 10)
+	require.NoError(t, err)
+	require.Len(t, matches, 0)
+
+	// The index entry of the replaced message is gone
+	var indexed int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM user_messages_fts`).Scan(&indexed))
+	require.Equal(t, len(messages), indexed)
+
+	// Only the most recent matches are returned past the limit
+	matches, err = p.MessagesMatchingFullTextQuery(`"world"`, nil, 1)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, "2", matches[0].ID)
+
+	require.NoError(t, p.DeleteMessage("2"))
+	matches, err = p.MessagesMatchingFullTextQuery(`"world"`, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, "1", matches[0].ID)
+}
+
+func TestBandwidthStats(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	err = p.AddBandwidthStats(3600, map[string]types.ProtocolBandwidth{
+		types.RelayProtocol:  {BytesIn: 10, BytesOut: 5},
+		types.StoreProtocol:  {BytesIn: 100},
+		types.FilterProtocol: {},
+	})
+	require.NoError(t, err)
+
+	// Stats of the same hour are added up
+	err = p.AddBandwidthStats(3600, map[string]types.ProtocolBandwidth{
+		types.RelayProtocol: {BytesIn: 1, BytesOut: 2},
+	})
+	require.NoError(t, err)
+
+	err = p.AddBandwidthStats(7200, map[string]types.ProtocolBandwidth{
+		types.LightpushProtocol: {BytesOut: 50},
+	})
+	require.NoError(t, err)
+
+	stats, err := p.BandwidthStats(0)
+	require.NoError(t, err)
+	require.Equal(t, []BandwidthStats{
+		{Hour: 3600, Protocol: types.RelayProtocol, BytesIn: 11, BytesOut: 7},
+		{Hour: 3600, Protocol: types.StoreProtocol, BytesIn: 100},
+		{Hour: 7200, Protocol: types.LightpushProtocol, BytesOut: 50},
+	}, stats)
+
+	err = p.DeleteBandwidthStatsBefore(7200)
+	require.NoError(t, err)
+
+	stats, err = p.BandwidthStats(0)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, int64(7200), stats[0].Hour)
+}
+
+func TestBandwidthDelta(t *testing.T) {
+	previous := map[string]types.ProtocolBandwidth{
+		types.RelayProtocol: {BytesIn: 10, BytesOut: 10},
+		types.StoreProtocol: {BytesIn: 100, BytesOut: 100},
+	}
+	current := map[string]types.ProtocolBandwidth{
+		types.RelayProtocol:  {BytesIn: 15, BytesOut: 12},
+		types.StoreProtocol:  {BytesIn: 20, BytesOut: 30}, // counters were reset
+		types.FilterProtocol: {BytesIn: 5},
+	}
+
+	require.Equal(t, map[string]types.ProtocolBandwidth{
+		types.RelayProtocol:  {BytesIn: 5, BytesOut: 2},
+		types.StoreProtocol:  {BytesIn: 20, BytesOut: 30},
+		types.FilterProtocol: {BytesIn: 5},
+	}, bandwidthDelta(previous, current))
+}
+
+func TestNotificationRules(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	rule := &NotificationRule{
+		ID:              "rule-1",
+		CommunityID:     "community-1",
+		Trigger:         NotificationRuleTriggerMention,
+		Action:          NotificationRuleActionActivityCenterOnly,
+		QuietHoursStart: 22 * 60,
+		QuietHoursEnd:   7 * 60,
+		Clock:           1,
+	}
+	require.NoError(t, p.SaveNotificationRule(rule))
+
+	rules, err := p.NotificationRules()
+	require.NoError(t, err)
+	require.Equal(t, []*NotificationRule{rule}, rules)
+
+	rule.Deleted = true
+	rule.Clock = 2
+	require.NoError(t, p.SaveNotificationRule(rule))
+
+	rules, err = p.NotificationRules()
+	require.NoError(t, err)
+	require.Empty(t, rules)
+
+	// Deleted rules are kept for their clock
+	retrieved, err := p.NotificationRule(rule.ID)
+	require.NoError(t, err)
+	require.Equal(t, rule, retrieved)
+
+	retrieved, err = p.NotificationRule("missing")
+	require.NoError(t, err)
+	require.Nil(t, retrieved)
+}
+
+func TestChatDrafts(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	draft := &ChatDraft{
+		ChatID:     "chat-1",
+		Text:       "hello",
+		ResponseTo: "message-1",
+		Attachments: []*ChatDraftAttachment{
+			{Name: "photo.jpg", ContentType: "image/jpeg", Size: 1024},
+		},
+		Clock: 1,
+	}
+	require.NoError(t, p.SaveChatDraft(draft))
+
+	drafts, err := p.ChatDrafts()
+	require.NoError(t, err)
+	require.Equal(t, []*ChatDraft{draft}, drafts)
+
+	cleared := &ChatDraft{ChatID: "chat-1", Clock: 2}
+	require.NoError(t, p.SaveChatDraft(cleared))
+
+	drafts, err = p.ChatDrafts()
+	require.NoError(t, err)
+	require.Empty(t, drafts)
+
+	// Cleared drafts are kept for their clock
+	retrieved, err := p.ChatDraft("chat-1")
+	require.NoError(t, err)
+	require.Equal(t, cleared, retrieved)
+
+	retrieved, err = p.ChatDraft("missing")
+	require.NoError(t, err)
+	require.Nil(t, retrieved)
+}
+
+func TestAttachments(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	descriptor, chunks, err := common.SplitAttachment([]byte("attachment data"), 4)
+	require.NoError(t, err)
+
+	attachment := &Attachment{
+		ID:         descriptor.Id,
+		MessageID:  "message-1",
+		ChatID:     "chat-1",
+		From:       "author",
+		Size:       descriptor.Size,
+		Descriptor: descriptor,
+	}
+	require.NoError(t, p.SaveAttachment(attachment))
+	require.NoError(t, p.SetAttachmentRequested("message-1"))
+
+	retrieved, err := p.AttachmentByMessageID("message-1")
+	require.NoError(t, err)
+	require.True(t, retrieved.Requested)
+	require.True(t, proto.Equal(descriptor, retrieved.Descriptor))
+
+	for _, chunk := range chunks {
+		require.NoError(t, p.SaveAttachmentChunk(chunk.AttachmentId, chunk.Index, chunk.Data))
+	}
+	// Chunks received again are ignored
+	require.NoError(t, p.SaveAttachmentChunk(descriptor.Id, 0, []byte("other")))
+
+	received, err := p.AttachmentChunks(descriptor.Id)
+	require.NoError(t, err)
+	require.Len(t, received, len(chunks))
+	require.Equal(t, chunks[0].Data, received[0])
+
+	require.NoError(t, p.CompleteAttachment(descriptor.Id))
+
+	attachments, err := p.AttachmentsByID(descriptor.Id)
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	require.True(t, attachments[0].Complete)
+
+	received, err = p.AttachmentChunks(descriptor.Id)
+	require.NoError(t, err)
+	require.Empty(t, received)
+
+	retrieved, err = p.AttachmentByMessageID("missing")
+	require.NoError(t, err)
+	require.Nil(t, retrieved)
+}
+
+func TestDeleteOrphanedAttachmentChunks(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	kept, keptChunks, err := common.SplitAttachment([]byte("kept attachment"), 4)
+	require.NoError(t, err)
+	orphaned, orphanedChunks, err := common.SplitAttachment([]byte("orphaned attachment"), 4)
+	require.NoError(t, err)
+
+	require.NoError(t, insertMinimalMessage(p, "message-1"))
+	require.NoError(t, p.SaveAttachment(&Attachment{ID: kept.Id, MessageID: "message-1", Size: kept.Size, Descriptor: kept}))
+	// The message of this attachment was deleted
+	require.NoError(t, p.SaveAttachment(&Attachment{ID: orphaned.Id, MessageID: "deleted-message", Size: orphaned.Size, Descriptor: orphaned}))
+	for _, chunk := range append(keptChunks, orphanedChunks...) {
+		require.NoError(t, p.SaveAttachmentChunk(chunk.AttachmentId, chunk.Index, chunk.Data))
+	}
+	// A chunk without an attachment
+	require.NoError(t, p.SaveAttachmentChunk(common.AttachmentID([]byte("unknown")), 0, []byte("unknown")))
+
+	require.NoError(t, p.DeleteOrphanedAttachmentChunks())
+
+	received, err := p.AttachmentChunks(kept.Id)
+	require.NoError(t, err)
+	require.Len(t, received, len(keptChunks))
+	received, err = p.AttachmentChunks(orphaned.Id)
+	require.NoError(t, err)
+	require.Empty(t, received)
+	received, err = p.AttachmentChunks(common.AttachmentID([]byte("unknown")))
+	require.NoError(t, err)
+	require.Empty(t, received)
+	attachments, err := p.AttachmentsByID(orphaned.Id)
+	require.NoError(t, err)
+	require.Empty(t, attachments)
+}
+
+func TestMuteClocks(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	clock, err := p.MuteClock("chat-1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), clock)
+
+	muted, mutedTill, err := p.MuteState("chat-1")
+	require.NoError(t, err)
+	require.False(t, muted)
+	require.True(t, mutedTill.IsZero())
+
+	till := time.Unix(1000, 0)
+	require.NoError(t, p.SaveMuteClock("chat-1", 1, false, time.Time{}))
+	require.NoError(t, p.SaveMuteClock("chat-1", 2, true, till))
+
+	clock, err = p.MuteClock("chat-1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), clock)
+
+	muted, mutedTill, err = p.MuteState("chat-1")
+	require.NoError(t, err)
+	require.True(t, muted)
+	require.Equal(t, till, mutedTill)
+}
+
+func TestOutgoingMessageFilters(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	filter := &OutgoingMessageFilter{
+		ID:          "filter-1",
+		CommunityID: "community-1",
+		Type:        OutgoingMessageFilterTypeKeyword,
+		Pattern:     "password",
+		Action:      OutgoingMessageFilterActionBlock,
+		Description: "Don't share passwords",
+	}
+	require.NoError(t, p.SaveOutgoingMessageFilter(filter))
+
+	filters, err := p.OutgoingMessageFilters()
+	require.NoError(t, err)
+	require.Equal(t, []*OutgoingMessageFilter{filter}, filters)
+
+	filter.Action = OutgoingMessageFilterActionWarn
+	require.NoError(t, p.SaveOutgoingMessageFilter(filter))
+	retrieved, err := p.OutgoingMessageFilter(filter.ID)
+	require.NoError(t, err)
+	require.Equal(t, OutgoingMessageFilterActionWarn, retrieved.Action)
+
+	require.NoError(t, p.DeleteOutgoingMessageFilter(filter.ID))
+	retrieved, err = p.OutgoingMessageFilter(filter.ID)
+	require.NoError(t, err)
+	require.Nil(t, retrieved)
+}
+
+func TestContactRequestsBucket(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	message := &BucketedContactRequest{ContactID: "contact-1", MessageID: "message-1", TrustScore: 1, Timestamp: 1}
+	require.NoError(t, p.BucketContactRequest(message))
+
+	contactRequest := &BucketedContactRequest{ContactID: "contact-1", MessageID: "message-2", ContactRequest: true, TrustScore: 1, Timestamp: 2}
+	require.NoError(t, p.BucketContactRequest(contactRequest))
+
+	// A later message doesn't replace the contact request
+	require.NoError(t, p.BucketContactRequest(&BucketedContactRequest{ContactID: "contact-1", MessageID: "message-3", TrustScore: 1, Timestamp: 3}))
+	retrieved, err := p.BucketedContactRequest("contact-1")
+	require.NoError(t, err)
+	require.Equal(t, contactRequest, retrieved)
+
+	other := &BucketedContactRequest{ContactID: "contact-2", MessageID: "message-4", Timestamp: 4}
+	require.NoError(t, p.BucketContactRequest(other))
+
+	bucketed, err := p.BucketedContactRequests()
+	require.NoError(t, err)
+	require.Equal(t, []*BucketedContactRequest{other, contactRequest}, bucketed)
+
+	require.NoError(t, p.DeleteBucketedContactRequests([]string{"contact-1", "contact-2"}))
+	retrieved, err = p.BucketedContactRequest("contact-1")
+	require.NoError(t, err)
+	require.Nil(t, retrieved)
+}
+
+func TestCommunityMemberProfiles(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	profile := &CommunityMemberProfile{
+		CommunityID: "0x01",
+		MemberID:    "0x02",
+		DisplayName: "work-name",
+		Image:       []byte("image"),
+		Clock:       2,
+	}
+	saved, err := p.SaveCommunityMemberProfile(profile)
+	require.NoError(t, err)
+	require.True(t, saved)
+
+	// Older profiles are ignored
+	saved, err = p.SaveCommunityMemberProfile(&CommunityMemberProfile{CommunityID: "0x01", MemberID: "0x02", DisplayName: "old-name", Clock: 1})
+	require.NoError(t, err)
+	require.False(t, saved)
+
+	retrieved, err := p.CommunityMemberProfile("0x01", "0x02")
+	require.NoError(t, err)
+	require.Equal(t, profile, retrieved)
+
+	// Empty profiles are stored but not listed
+	saved, err = p.SaveCommunityMemberProfile(&CommunityMemberProfile{CommunityID: "0x01", MemberID: "0x03", Clock: 1})
+	require.NoError(t, err)
+	require.True(t, saved)
+
+	profiles, err := p.CommunityMemberProfiles("0x01")
+	require.NoError(t, err)
+	require.Equal(t, []*CommunityMemberProfile{profile}, profiles)
+
+	retrieved, err = p.CommunityMemberProfile("0x02", "0x02")
+	require.NoError(t, err)
+	require.Nil(t, retrieved)
+}
+
+func TestSafeTransactions(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	message := &common.Message{
+		ID:          "message-id",
+		LocalChatID: "chat-id",
+		ChatMessage: protobuf.ChatMessage{
+			Text:        "Safe transaction",
+			ContentType: protobuf.ChatMessage_SAFE_TRANSACTION,
+			Payload: &protobuf.ChatMessage_SafeTransaction{SafeTransaction: &protobuf.SafeTransactionProposal{
+				ChainId:     1,
+				SafeAddress: "0x0000000000000000000000000000000000000001",
+				To:          "0x0000000000000000000000000000000000000002",
+				Value:       "1000",
+				Nonce:       "3",
+				Threshold:   2,
+			}},
+		},
+		From: "me",
+	}
+	require.NoError(t, p.SaveMessages([]*common.Message{message}))
+
+	retrieved, err := p.MessageByID(message.ID)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(message.GetSafeTransaction(), retrieved.GetSafeTransaction()))
+
+	signer := "0x0000000000000000000000000000000000000003"
+	for _, signature := range [][]byte{[]byte("first"), []byte("second")} {
+		err = p.SaveSafeTransactionSignature(&SafeTransactionSignature{
+			SafeTransactionSignature: protobuf.SafeTransactionSignature{Clock: 1, MessageId: message.ID, Signer: signer, Signature: signature},
+			From:                     "owner",
+		})
+		require.NoError(t, err)
+	}
+
+	// Signing again replaces the signature
+	signatures, err := p.SafeTransactionSignatures(message.ID)
+	require.NoError(t, err)
+	require.Len(t, signatures, 1)
+	require.Equal(t, []byte("second"), signatures[gethcommon.HexToAddress(signer)])
+
+	transactionHash, err := p.SafeTransactionExecutionHash(message.ID)
+	require.NoError(t, err)
+	require.Empty(t, transactionHash)
+
+	err = p.SaveSafeTransactionExecution(&SafeTransactionExecution{
+		SafeTransactionExecution: protobuf.SafeTransactionExecution{Clock: 2, MessageId: message.ID, TransactionHash: "0x01"},
+		From:                     "owner",
+	})
+	require.NoError(t, err)
+
+	transactionHash, err = p.SafeTransactionExecutionHash(message.ID)
+	require.NoError(t, err)
+	require.Equal(t, "0x01", transactionHash)
+
+	execution, err := p.SafeTransactionExecutionByHash("0x01")
+	require.NoError(t, err)
+	require.NotNil(t, execution)
+	require.Equal(t, message.ID, execution.MessageId)
+	require.Equal(t, "owner", execution.From)
+
+	require.NoError(t, p.DeleteSafeTransactionExecution(message.ID))
+	execution, err = p.SafeTransactionExecution(message.ID)
+	require.NoError(t, err)
+	require.Nil(t, execution)
+
+	// Received executions are kept apart until they're verified
+	claim := &SafeTransactionExecution{
+		SafeTransactionExecution: protobuf.SafeTransactionExecution{Clock: 3, MessageId: message.ID, TransactionHash: "0x02"},
+		From:                     "owner",
+	}
+	require.NoError(t, p.SaveUnverifiedSafeTransactionExecution(claim, 10))
+	transactionHash, err = p.SafeTransactionExecutionHash(message.ID)
+	require.NoError(t, err)
+	require.Empty(t, transactionHash)
+
+	claims, err := p.UnverifiedSafeTransactionExecutions()
+	require.NoError(t, err)
+	require.Len(t, claims, 1)
+	require.Equal(t, "0x02", claims[0].TransactionHash)
+	require.Equal(t, "owner", claims[0].From)
+
+	require.NoError(t, p.DeleteUnverifiedSafeTransactionExecutionsBefore(10))
+	claims, err = p.UnverifiedSafeTransactionExecutions()
+	require.NoError(t, err)
+	require.Len(t, claims, 1)
+
+	require.NoError(t, p.DeleteUnverifiedSafeTransactionExecution(message.ID, "0x02"))
+	claims, err = p.UnverifiedSafeTransactionExecutions()
+	require.NoError(t, err)
+	require.Len(t, claims, 0)
+}
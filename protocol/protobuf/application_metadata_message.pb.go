@@ -91,6 +91,25 @@ const (
 	ApplicationMetadataMessage_SYNC_ACTIVITY_CENTER_NOTIFICATION       ApplicationMetadataMessage_Type = 66
 	ApplicationMetadataMessage_SYNC_ACTIVITY_CENTER_NOTIFICATION_STATE ApplicationMetadataMessage_Type = 67
 	ApplicationMetadataMessage_COMMUNITY_ADMIN_MESSAGE                 ApplicationMetadataMessage_Type = 68
+	ApplicationMetadataMessage_DELETE_COMMUNITY_MEMBER_MESSAGES        ApplicationMetadataMessage_Type = 69
+	ApplicationMetadataMessage_SYNC_NOTIFICATION_RULE                  ApplicationMetadataMessage_Type = 70
+	ApplicationMetadataMessage_SYNC_CHAT_READ_STATE                    ApplicationMetadataMessage_Type = 71
+	ApplicationMetadataMessage_SYNC_CHAT_DRAFT                         ApplicationMetadataMessage_Type = 72
+	ApplicationMetadataMessage_ATTACHMENT_CHUNK                        ApplicationMetadataMessage_Type = 73
+	ApplicationMetadataMessage_ATTACHMENT_CHUNK_REQUEST                ApplicationMetadataMessage_Type = 74
+	ApplicationMetadataMessage_SYNC_MUTE                               ApplicationMetadataMessage_Type = 75
+	ApplicationMetadataMessage_COMMUNITY_MEMBER_PROFILE                ApplicationMetadataMessage_Type = 76
+	ApplicationMetadataMessage_COMMUNITY_DESCRIPTION_DELTA             ApplicationMetadataMessage_Type = 77
+	ApplicationMetadataMessage_SYNC_ACCOUNTS_POSITIONS                 ApplicationMetadataMessage_Type = 78
+	ApplicationMetadataMessage_SYNC_CUSTOM_TOKEN                       ApplicationMetadataMessage_Type = 79
+	ApplicationMetadataMessage_SAFE_TRANSACTION_SIGNATURE              ApplicationMetadataMessage_Type = 80
+	ApplicationMetadataMessage_SAFE_TRANSACTION_EXECUTION              ApplicationMetadataMessage_Type = 81
+	ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE                   ApplicationMetadataMessage_Type = 82
+	ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE_RESPONSE          ApplicationMetadataMessage_Type = 83
+	ApplicationMetadataMessage_SOCIAL_RECOVERY_REQUEST                 ApplicationMetadataMessage_Type = 84
+	ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE_RELEASE           ApplicationMetadataMessage_Type = 85
+	ApplicationMetadataMessage_COMMUNITY_DESCRIPTION_REQUEST           ApplicationMetadataMessage_Type = 86
+	ApplicationMetadataMessage_COMMUNITY_BAN                           ApplicationMetadataMessage_Type = 87
 )
 
 var ApplicationMetadataMessage_Type_name = map[int32]string{
@@ -162,6 +181,25 @@ var ApplicationMetadataMessage_Type_name = map[int32]string{
 	66: "SYNC_ACTIVITY_CENTER_NOTIFICATION",
 	67: "SYNC_ACTIVITY_CENTER_NOTIFICATION_STATE",
 	68: "COMMUNITY_ADMIN_MESSAGE",
+	69: "DELETE_COMMUNITY_MEMBER_MESSAGES",
+	70: "SYNC_NOTIFICATION_RULE",
+	71: "SYNC_CHAT_READ_STATE",
+	72: "SYNC_CHAT_DRAFT",
+	73: "ATTACHMENT_CHUNK",
+	74: "ATTACHMENT_CHUNK_REQUEST",
+	75: "SYNC_MUTE",
+	76: "COMMUNITY_MEMBER_PROFILE",
+	77: "COMMUNITY_DESCRIPTION_DELTA",
+	78: "SYNC_ACCOUNTS_POSITIONS",
+	79: "SYNC_CUSTOM_TOKEN",
+	80: "SAFE_TRANSACTION_SIGNATURE",
+	81: "SAFE_TRANSACTION_EXECUTION",
+	82: "SOCIAL_RECOVERY_SHARE",
+	83: "SOCIAL_RECOVERY_SHARE_RESPONSE",
+	84: "SOCIAL_RECOVERY_REQUEST",
+	85: "SOCIAL_RECOVERY_SHARE_RELEASE",
+	86: "COMMUNITY_DESCRIPTION_REQUEST",
+	87: "COMMUNITY_BAN",
 }
 
 var ApplicationMetadataMessage_Type_value = map[string]int32{
@@ -233,6 +271,25 @@ var ApplicationMetadataMessage_Type_value = map[string]int32{
 	"SYNC_ACTIVITY_CENTER_NOTIFICATION":       66,
 	"SYNC_ACTIVITY_CENTER_NOTIFICATION_STATE": 67,
 	"COMMUNITY_ADMIN_MESSAGE":                 68,
+	"DELETE_COMMUNITY_MEMBER_MESSAGES":        69,
+	"SYNC_NOTIFICATION_RULE":                  70,
+	"SYNC_CHAT_READ_STATE":                    71,
+	"SYNC_CHAT_DRAFT":                         72,
+	"ATTACHMENT_CHUNK":                        73,
+	"ATTACHMENT_CHUNK_REQUEST":                74,
+	"SYNC_MUTE":                               75,
+	"COMMUNITY_MEMBER_PROFILE":                76,
+	"COMMUNITY_DESCRIPTION_DELTA":             77,
+	"SYNC_ACCOUNTS_POSITIONS":                 78,
+	"SYNC_CUSTOM_TOKEN":                       79,
+	"SAFE_TRANSACTION_SIGNATURE":              80,
+	"SAFE_TRANSACTION_EXECUTION":              81,
+	"SOCIAL_RECOVERY_SHARE":                   82,
+	"SOCIAL_RECOVERY_SHARE_RESPONSE":          83,
+	"SOCIAL_RECOVERY_REQUEST":                 84,
+	"SOCIAL_RECOVERY_SHARE_RELEASE":           85,
+	"COMMUNITY_DESCRIPTION_REQUEST":           86,
+	"COMMUNITY_BAN":                           87,
 }
 
 func (x ApplicationMetadataMessage_Type) String() string {
@@ -243,16 +300,43 @@ func (ApplicationMetadataMessage_Type) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ad09a6406fcf24c7, []int{0, 0}
 }
 
+type ApplicationMetadataMessage_Compression int32
+
+const (
+	ApplicationMetadataMessage_UNCOMPRESSED ApplicationMetadataMessage_Compression = 0
+	ApplicationMetadataMessage_ZSTD         ApplicationMetadataMessage_Compression = 1
+)
+
+var ApplicationMetadataMessage_Compression_name = map[int32]string{
+	0: "UNCOMPRESSED",
+	1: "ZSTD",
+}
+
+var ApplicationMetadataMessage_Compression_value = map[string]int32{
+	"UNCOMPRESSED": 0,
+	"ZSTD":         1,
+}
+
+func (x ApplicationMetadataMessage_Compression) String() string {
+	return proto.EnumName(ApplicationMetadataMessage_Compression_name, int32(x))
+}
+
+func (ApplicationMetadataMessage_Compression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ad09a6406fcf24c7, []int{0, 1}
+}
+
 type ApplicationMetadataMessage struct {
 	// Signature of the payload field
 	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
 	// This is the encoded protobuf of the application level message, i.e ChatMessage
 	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 	// The type of protobuf message sent
-	Type                 ApplicationMetadataMessage_Type `protobuf:"varint,3,opt,name=type,proto3,enum=protobuf.ApplicationMetadataMessage_Type" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+	Type ApplicationMetadataMessage_Type `protobuf:"varint,3,opt,name=type,proto3,enum=protobuf.ApplicationMetadataMessage_Type" json:"type,omitempty"`
+	// The compression of the payload, the signature is of the uncompressed one
+	Compression          ApplicationMetadataMessage_Compression `protobuf:"varint,4,opt,name=compression,proto3,enum=protobuf.ApplicationMetadataMessage_Compression" json:"compression,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
 }
 
 func (m *ApplicationMetadataMessage) Reset()         { *m = ApplicationMetadataMessage{} }
@@ -301,8 +385,16 @@ func (m *ApplicationMetadataMessage) GetType() ApplicationMetadataMessage_Type {
 	return ApplicationMetadataMessage_UNKNOWN
 }
 
+func (m *ApplicationMetadataMessage) GetCompression() ApplicationMetadataMessage_Compression {
+	if m != nil {
+		return m.Compression
+	}
+	return ApplicationMetadataMessage_UNCOMPRESSED
+}
+
 func init() {
 	proto.RegisterEnum("protobuf.ApplicationMetadataMessage_Type", ApplicationMetadataMessage_Type_name, ApplicationMetadataMessage_Type_value)
+	proto.RegisterEnum("protobuf.ApplicationMetadataMessage_Compression", ApplicationMetadataMessage_Compression_name, ApplicationMetadataMessage_Compression_value)
 	proto.RegisterType((*ApplicationMetadataMessage)(nil), "protobuf.ApplicationMetadataMessage")
 }
 
@@ -311,67 +403,81 @@ func init() {
 }
 
 var fileDescriptor_ad09a6406fcf24c7 = []byte{
-	// 977 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x6b, 0x73, 0x13, 0x37,
-	0x14, 0x6d, 0x20, 0x4d, 0x40, 0x79, 0x29, 0x22, 0x0f, 0xe7, 0xed, 0x18, 0x08, 0x01, 0x5a, 0xd3,
-	0x42, 0xdb, 0x69, 0x4b, 0x69, 0x2b, 0x4b, 0x37, 0xb6, 0xf0, 0xae, 0xb4, 0x48, 0x5a, 0x77, 0xdc,
-	0x2f, 0x1a, 0x53, 0x5c, 0x26, 0x33, 0x40, 0x3c, 0xc4, 0xf9, 0x90, 0xbf, 0xd3, 0x5f, 0xd1, 0x9f,
-	0xd7, 0xd1, 0x3e, 0x9d, 0x64, 0xd3, 0x7c, 0x4a, 0x7c, 0xef, 0xd1, 0x95, 0xee, 0xb9, 0xe7, 0x9e,
-	0x45, 0x8d, 0xc1, 0x68, 0xf4, 0xe1, 0xf8, 0xaf, 0xc1, 0xf8, 0xf8, 0xe4, 0x93, 0xfb, 0x38, 0x1c,
-	0x0f, 0xde, 0x0d, 0xc6, 0x03, 0xf7, 0x71, 0x78, 0x7a, 0x3a, 0x78, 0x3f, 0x6c, 0x8e, 0x3e, 0x9f,
-	0x8c, 0x4f, 0xc8, 0x9d, 0xe4, 0xcf, 0xdb, 0xb3, 0xbf, 0x1b, 0xff, 0x62, 0xb4, 0x49, 0xcb, 0x03,
-	0x61, 0x86, 0x0f, 0x53, 0x38, 0xd9, 0x46, 0x77, 0x4f, 0x8f, 0xdf, 0x7f, 0x1a, 0x8c, 0xcf, 0x3e,
-	0x0f, 0x6b, 0x53, 0xf5, 0xa9, 0xc3, 0x79, 0x5d, 0x06, 0x48, 0x0d, 0xcd, 0x8e, 0x06, 0xe7, 0x1f,
-	0x4e, 0x06, 0xef, 0x6a, 0xb7, 0x92, 0x5c, 0xfe, 0x93, 0xbc, 0x42, 0xd3, 0xe3, 0xf3, 0xd1, 0xb0,
-	0x76, 0xbb, 0x3e, 0x75, 0xb8, 0xf8, 0xfc, 0x71, 0x33, 0xbf, 0xaf, 0x79, 0xfd, 0x5d, 0x4d, 0x7b,
-	0x3e, 0x1a, 0xea, 0xe4, 0x58, 0xe3, 0x9f, 0x25, 0x34, 0xed, 0x7f, 0x92, 0x39, 0x34, 0x1b, 0xcb,
-	0xae, 0x54, 0x7f, 0x48, 0xfc, 0x05, 0xc1, 0x68, 0x9e, 0x75, 0xa8, 0x75, 0x21, 0x18, 0x43, 0xdb,
-	0x80, 0xa7, 0x08, 0x41, 0x8b, 0x4c, 0x49, 0x4b, 0x99, 0x75, 0x71, 0xc4, 0xa9, 0x05, 0x7c, 0x8b,
-	0xec, 0xa0, 0x8d, 0x10, 0xc2, 0x16, 0x68, 0xd3, 0x11, 0x51, 0x16, 0x2e, 0x8e, 0xdc, 0x26, 0xab,
-	0x68, 0x39, 0xa2, 0x42, 0x3b, 0x21, 0x8d, 0xa5, 0x41, 0x40, 0xad, 0x50, 0x12, 0x4f, 0xfb, 0xb0,
-	0xe9, 0x4b, 0x76, 0x31, 0xfc, 0x25, 0xb9, 0x8f, 0xf6, 0x34, 0xbc, 0x89, 0xc1, 0x58, 0x47, 0x39,
-	0xd7, 0x60, 0x8c, 0x3b, 0x52, 0xda, 0x59, 0x4d, 0xa5, 0xa1, 0x2c, 0x01, 0xcd, 0x90, 0x27, 0xe8,
-	0x80, 0x32, 0x06, 0x91, 0x75, 0x37, 0x61, 0x67, 0xc9, 0x53, 0xf4, 0x88, 0x03, 0x0b, 0x84, 0x84,
-	0x1b, 0xc1, 0x77, 0xc8, 0x3a, 0xba, 0x97, 0x83, 0x26, 0x13, 0x77, 0xc9, 0x0a, 0xc2, 0x06, 0x24,
-	0xbf, 0x10, 0x45, 0x64, 0x0f, 0x6d, 0x5d, 0xae, 0x3d, 0x09, 0x98, 0xf3, 0xd4, 0x5c, 0x69, 0xd2,
-	0x65, 0x04, 0xe2, 0xf9, 0xea, 0x34, 0x65, 0x4c, 0xc5, 0xd2, 0xe2, 0x05, 0xb2, 0x8f, 0x76, 0xae,
-	0xa6, 0xa3, 0xb8, 0x15, 0x08, 0xe6, 0xfc, 0x5c, 0xf0, 0x22, 0xd9, 0x45, 0x9b, 0xf9, 0x3c, 0x98,
-	0xe2, 0xe0, 0x28, 0xef, 0x81, 0xb6, 0xc2, 0x40, 0x08, 0xd2, 0xe2, 0x25, 0xd2, 0x40, 0xbb, 0x51,
-	0x6c, 0x3a, 0x4e, 0x2a, 0x2b, 0x8e, 0x04, 0x4b, 0x4b, 0x68, 0x68, 0x0b, 0x63, 0x75, 0x4a, 0x39,
-	0xf6, 0x0c, 0xfd, 0x3f, 0xc6, 0x69, 0x30, 0x91, 0x92, 0x06, 0xf0, 0x32, 0xd9, 0x42, 0xeb, 0x57,
-	0xc1, 0x6f, 0x62, 0xd0, 0x7d, 0x4c, 0xc8, 0x03, 0x54, 0xbf, 0x26, 0x59, 0x96, 0xb8, 0xe7, 0xbb,
-	0xae, 0xba, 0x2f, 0xe1, 0x0f, 0xaf, 0xf8, 0x96, 0xaa, 0xd2, 0xd9, 0xf1, 0x55, 0x2f, 0x41, 0x08,
-	0xd5, 0x6b, 0xe1, 0x34, 0x64, 0x3c, 0xaf, 0x91, 0x0d, 0xb4, 0xda, 0xd6, 0x2a, 0x8e, 0x12, 0x5a,
-	0x9c, 0x90, 0x3d, 0x61, 0xd3, 0xee, 0xd6, 0xc9, 0x32, 0x5a, 0x48, 0x83, 0x1c, 0xa4, 0x15, 0xb6,
-	0x8f, 0x6b, 0x1e, 0xcd, 0x54, 0x18, 0xc6, 0x52, 0xd8, 0xbe, 0xe3, 0x60, 0x98, 0x16, 0x51, 0x82,
-	0xde, 0x20, 0x35, 0xb4, 0x52, 0xa6, 0x26, 0xea, 0x6c, 0xfa, 0x57, 0x97, 0x99, 0x62, 0xda, 0xca,
-	0xbd, 0x56, 0x42, 0xe2, 0x2d, 0xb2, 0x84, 0xe6, 0x22, 0x21, 0x0b, 0xd9, 0x6f, 0xfb, 0xdd, 0x01,
-	0x2e, 0xca, 0xdd, 0xd9, 0xf1, 0x2f, 0x31, 0x96, 0xda, 0xd8, 0xe4, 0xab, 0xb3, 0xeb, 0x7b, 0xe1,
-	0x10, 0xc0, 0xc4, 0xbe, 0xec, 0x79, 0x51, 0x55, 0x69, 0x26, 0xbb, 0x1a, 0xd7, 0xc9, 0x26, 0x5a,
-	0xa3, 0x52, 0xc9, 0x7e, 0xa8, 0x62, 0xe3, 0x42, 0xb0, 0x5a, 0x30, 0xd7, 0xa2, 0x96, 0x75, 0xf0,
-	0x7e, 0xb1, 0x55, 0x49, 0xcb, 0x1a, 0x42, 0xd5, 0x03, 0x8e, 0x1b, 0x7e, 0x6a, 0x65, 0x38, 0xbb,
-	0xca, 0x78, 0x02, 0x39, 0xbe, 0x4f, 0x10, 0x9a, 0x69, 0x51, 0xd6, 0x8d, 0x23, 0xfc, 0xa0, 0x50,
-	0xa4, 0x67, 0xb6, 0xe7, 0x3b, 0x65, 0x20, 0x2d, 0xe8, 0x14, 0xfa, 0xb0, 0x50, 0xe4, 0xe5, 0x74,
-	0xba, 0x8d, 0xc0, 0xf1, 0x81, 0x57, 0x5c, 0x25, 0x84, 0x0b, 0x13, 0x0a, 0x63, 0x80, 0xe3, 0x47,
-	0x09, 0x13, 0x1e, 0xd3, 0x52, 0xaa, 0x1b, 0x52, 0xdd, 0xc5, 0x87, 0x64, 0x0d, 0x91, 0xf4, 0x85,
-	0x01, 0x50, 0xed, 0x3a, 0xc2, 0x58, 0xa5, 0xfb, 0xf8, 0xb1, 0xa7, 0x31, 0x89, 0x1b, 0xb0, 0x56,
-	0xc8, 0x36, 0x7e, 0x42, 0xea, 0x68, 0xbb, 0x1c, 0x04, 0xd5, 0xac, 0x23, 0x7a, 0xe0, 0x42, 0xda,
-	0x96, 0x60, 0x03, 0x21, 0xbb, 0xf8, 0xa9, 0x1f, 0x62, 0x72, 0x26, 0xd2, 0xea, 0x48, 0x04, 0xe0,
-	0x22, 0xc1, 0x6c, 0xac, 0x01, 0x7f, 0x55, 0x54, 0xcb, 0x77, 0xec, 0xeb, 0x84, 0xcc, 0xd4, 0x4a,
-	0xf2, 0x3d, 0xca, 0x95, 0xd8, 0xf4, 0xac, 0x69, 0xb0, 0x3a, 0x5d, 0xae, 0x8b, 0xc9, 0x67, 0xe4,
-	0x00, 0x35, 0xae, 0xd5, 0x43, 0x29, 0xd7, 0x6f, 0x4a, 0xea, 0x0b, 0x70, 0xd6, 0x8a, 0xc1, 0xdf,
-	0xfa, 0x5e, 0xf2, 0xa3, 0xf9, 0x0d, 0x3d, 0xd0, 0x85, 0xec, 0xf1, 0x73, 0xaf, 0x86, 0x4b, 0xef,
-	0xbb, 0x00, 0x78, 0xe1, 0x4b, 0xe4, 0x1e, 0x54, 0x89, 0xf8, 0xae, 0xd0, 0x84, 0xd5, 0xb1, 0xb1,
-	0xc0, 0x5d, 0x6c, 0x40, 0xe3, 0xef, 0x8b, 0x51, 0x4f, 0xa2, 0x8b, 0xfe, 0x7e, 0x28, 0x46, 0x7d,
-	0xa9, 0x73, 0xc7, 0x81, 0x09, 0xe3, 0x0b, 0xff, 0x98, 0x9a, 0x4f, 0x05, 0x05, 0x01, 0xd0, 0x1e,
-	0xe0, 0x9f, 0x7c, 0x3e, 0x29, 0x91, 0x49, 0xdc, 0xdb, 0x6d, 0x58, 0x2a, 0xfd, 0xe7, 0x62, 0xe6,
-	0x86, 0xf6, 0x80, 0xe7, 0xae, 0x8c, 0x5f, 0x7a, 0x1b, 0x29, 0xeb, 0x32, 0x2a, 0x19, 0x04, 0x57,
-	0x36, 0xee, 0x17, 0xcf, 0x4c, 0x96, 0xab, 0xec, 0xfb, 0x55, 0x31, 0xec, 0x2e, 0xf4, 0xfd, 0x07,
-	0x08, 0xff, 0xea, 0xed, 0x3d, 0x8f, 0x30, 0xaa, 0xb9, 0xcb, 0xfc, 0xe3, 0xb7, 0x82, 0x22, 0xa3,
-	0x98, 0xa0, 0x81, 0xf3, 0x3a, 0x32, 0xf8, 0x77, 0xb2, 0x8d, 0x6a, 0x49, 0x18, 0xa4, 0x49, 0x58,
-	0x93, 0x34, 0x04, 0xc7, 0xc1, 0x52, 0x11, 0x60, 0x4a, 0x1e, 0xa2, 0xfd, 0x4a, 0xa5, 0x4f, 0x1a,
-	0x17, 0x6e, 0x79, 0x7b, 0xbd, 0x11, 0xe6, 0xbc, 0x31, 0x00, 0x66, 0x5e, 0x2d, 0x13, 0xe2, 0xe6,
-	0xe1, 0x84, 0xa5, 0xf0, 0xd6, 0xc2, 0x9f, 0x73, 0xcd, 0x67, 0x2f, 0xf3, 0x2f, 0xfb, 0xdb, 0x99,
-	0xe4, 0xbf, 0x17, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xf3, 0x96, 0x25, 0x5f, 0x80, 0x08, 0x00,
-	0x00,
+	// 1214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x5b, 0x73, 0x53, 0x37,
+	0x10, 0x26, 0x90, 0x72, 0x51, 0x08, 0x2c, 0x0a, 0x01, 0x13, 0x02, 0x04, 0x73, 0x87, 0x36, 0x50,
+	0x68, 0x3b, 0x6d, 0x29, 0x6d, 0x65, 0x69, 0x63, 0x0b, 0x9f, 0x23, 0x1d, 0x24, 0x1d, 0x53, 0xf3,
+	0xa2, 0x31, 0xe0, 0x32, 0x99, 0x01, 0xe2, 0x21, 0xe1, 0x81, 0x9f, 0xdb, 0x3f, 0xd1, 0xe7, 0x8e,
+	0xe4, 0x73, 0x71, 0x92, 0x93, 0x86, 0x27, 0xdb, 0xbb, 0x9f, 0x76, 0xb5, 0xdf, 0x7e, 0xbb, 0x32,
+	0x69, 0x8f, 0x26, 0x93, 0xf7, 0x9b, 0x6f, 0x46, 0x3b, 0x9b, 0x5b, 0x1f, 0xfd, 0x87, 0xf1, 0xce,
+	0xe8, 0xed, 0x68, 0x67, 0xe4, 0x3f, 0x8c, 0xb7, 0xb7, 0x47, 0xef, 0xc6, 0xeb, 0x93, 0x4f, 0x5b,
+	0x3b, 0x5b, 0xf4, 0x64, 0xfc, 0x78, 0xfd, 0xf9, 0xef, 0xf6, 0xbf, 0xcb, 0x64, 0x85, 0xd5, 0x07,
+	0xd2, 0x02, 0x9f, 0x4e, 0xe1, 0x74, 0x95, 0x9c, 0xda, 0xde, 0x7c, 0xf7, 0x71, 0xb4, 0xf3, 0xf9,
+	0xd3, 0xb8, 0x35, 0xb7, 0x36, 0x77, 0xf7, 0xb4, 0xa9, 0x0d, 0xb4, 0x45, 0x4e, 0x4c, 0x46, 0x5f,
+	0xde, 0x6f, 0x8d, 0xde, 0xb6, 0x8e, 0x46, 0x5f, 0xf9, 0x93, 0x3e, 0x23, 0xf3, 0x3b, 0x5f, 0x26,
+	0xe3, 0xd6, 0xb1, 0xb5, 0xb9, 0xbb, 0x67, 0x1e, 0xdf, 0x5b, 0x2f, 0xf3, 0xad, 0x1f, 0x9c, 0x6b,
+	0xdd, 0x7d, 0x99, 0x8c, 0x4d, 0x3c, 0x46, 0x0d, 0x59, 0x78, 0xb3, 0xf5, 0x61, 0xf2, 0x69, 0xbc,
+	0xbd, 0xbd, 0xb9, 0xf5, 0xb1, 0x35, 0x1f, 0xa3, 0x3c, 0xfa, 0xaa, 0x28, 0xbc, 0x3e, 0x67, 0x66,
+	0x83, 0xb4, 0xff, 0x59, 0x22, 0xf3, 0x21, 0x05, 0x5d, 0x20, 0x27, 0x72, 0xd5, 0x57, 0xfa, 0xa5,
+	0x82, 0x23, 0x14, 0xc8, 0x69, 0xde, 0x63, 0xce, 0xa7, 0x68, 0x2d, 0xeb, 0x22, 0xcc, 0x51, 0x4a,
+	0xce, 0x70, 0xad, 0x1c, 0xe3, 0xce, 0xe7, 0x99, 0x60, 0x0e, 0xe1, 0x28, 0xbd, 0x42, 0x2e, 0xa5,
+	0x98, 0x76, 0xd0, 0xd8, 0x9e, 0xcc, 0x0a, 0x73, 0x75, 0xe4, 0x18, 0x5d, 0x26, 0xe7, 0x32, 0x26,
+	0x8d, 0x97, 0xca, 0x3a, 0x96, 0x24, 0xcc, 0x49, 0xad, 0x60, 0x3e, 0x98, 0xed, 0x50, 0xf1, 0xdd,
+	0xe6, 0x6f, 0xe8, 0x0d, 0x72, 0xcd, 0xe0, 0x8b, 0x1c, 0xad, 0xf3, 0x4c, 0x08, 0x83, 0xd6, 0xfa,
+	0x0d, 0x6d, 0xbc, 0x33, 0x4c, 0x59, 0xc6, 0x23, 0xe8, 0x38, 0xbd, 0x4f, 0x6e, 0x33, 0xce, 0x31,
+	0x73, 0xfe, 0x30, 0xec, 0x09, 0xfa, 0x80, 0xdc, 0x11, 0xc8, 0x13, 0xa9, 0xf0, 0x50, 0xf0, 0x49,
+	0x7a, 0x91, 0x2c, 0x95, 0xa0, 0x59, 0xc7, 0x29, 0x7a, 0x9e, 0x80, 0x45, 0x25, 0x76, 0x59, 0x09,
+	0xbd, 0x46, 0x2e, 0xef, 0x8d, 0x3d, 0x0b, 0x58, 0x08, 0xd4, 0xec, 0x2b, 0xd2, 0x17, 0x04, 0xc2,
+	0xe9, 0x66, 0x37, 0xe3, 0x5c, 0xe7, 0xca, 0xc1, 0x22, 0xbd, 0x4e, 0xae, 0xec, 0x77, 0x67, 0x79,
+	0x27, 0x91, 0xdc, 0x87, 0xbe, 0xc0, 0x19, 0x7a, 0x95, 0xac, 0x94, 0xfd, 0xe0, 0x5a, 0xa0, 0x67,
+	0x62, 0x80, 0xc6, 0x49, 0x8b, 0x29, 0x2a, 0x07, 0x67, 0x69, 0x9b, 0x5c, 0xcd, 0x72, 0xdb, 0xf3,
+	0x4a, 0x3b, 0xb9, 0x21, 0xf9, 0x34, 0x84, 0xc1, 0xae, 0xb4, 0xce, 0xc4, 0x1f, 0x00, 0x81, 0xa1,
+	0xff, 0xc7, 0x78, 0x83, 0x36, 0xd3, 0xca, 0x22, 0x9c, 0xa3, 0x97, 0xc9, 0xc5, 0xfd, 0xe0, 0x17,
+	0x39, 0x9a, 0x21, 0x50, 0x7a, 0x93, 0xac, 0x1d, 0xe0, 0xac, 0x43, 0x2c, 0x85, 0xaa, 0x9b, 0xf2,
+	0x45, 0xfe, 0xe0, 0x7c, 0x28, 0xa9, 0xc9, 0x5d, 0x1c, 0x5f, 0x0e, 0x12, 0xc4, 0x54, 0x3f, 0x97,
+	0xde, 0x60, 0xc1, 0xf3, 0x05, 0x7a, 0x89, 0x2c, 0x77, 0x8d, 0xce, 0xb3, 0x48, 0x8b, 0x97, 0x6a,
+	0x20, 0xdd, 0xb4, 0xba, 0x8b, 0xf4, 0x1c, 0x59, 0x9c, 0x1a, 0x05, 0x2a, 0x27, 0xdd, 0x10, 0x5a,
+	0x01, 0xcd, 0x75, 0x9a, 0xe6, 0x4a, 0xba, 0xa1, 0x17, 0x68, 0xb9, 0x91, 0x59, 0x44, 0x5f, 0xa2,
+	0x2d, 0x72, 0xbe, 0x76, 0xcd, 0xc4, 0x59, 0x09, 0xb7, 0xae, 0x3d, 0x55, 0xb7, 0xb5, 0x7f, 0xae,
+	0xa5, 0x82, 0xcb, 0xf4, 0x2c, 0x59, 0xc8, 0xa4, 0xaa, 0x64, 0xbf, 0x1a, 0x66, 0x07, 0x85, 0xac,
+	0x67, 0xe7, 0x4a, 0xb8, 0x89, 0x75, 0xcc, 0xe5, 0xb6, 0x1c, 0x9d, 0xab, 0xa1, 0x16, 0x81, 0x09,
+	0xce, 0xcc, 0xcb, 0xb5, 0x20, 0xaa, 0x26, 0xcd, 0x14, 0xa9, 0x61, 0x8d, 0xae, 0x90, 0x0b, 0x4c,
+	0x69, 0x35, 0x4c, 0x75, 0x6e, 0x7d, 0x8a, 0xce, 0x48, 0xee, 0x3b, 0xcc, 0xf1, 0x1e, 0x5c, 0xaf,
+	0xa6, 0x2a, 0x96, 0x6c, 0x30, 0xd5, 0x03, 0x14, 0xd0, 0x0e, 0x5d, 0xab, 0xcd, 0x45, 0x2a, 0x1b,
+	0x08, 0x14, 0x70, 0x83, 0x12, 0x72, 0xbc, 0xc3, 0x78, 0x3f, 0xcf, 0xe0, 0x66, 0xa5, 0xc8, 0xc0,
+	0xec, 0x20, 0x54, 0xca, 0x51, 0x39, 0x34, 0x53, 0xe8, 0xad, 0x4a, 0x91, 0x7b, 0xdd, 0xd3, 0x69,
+	0x44, 0x01, 0xb7, 0x83, 0xe2, 0x1a, 0x21, 0x42, 0xda, 0x54, 0x5a, 0x8b, 0x02, 0xee, 0x44, 0x26,
+	0x02, 0xa6, 0xa3, 0x75, 0x3f, 0x65, 0xa6, 0x0f, 0x77, 0xe9, 0x05, 0x42, 0xa7, 0x37, 0x4c, 0x90,
+	0x19, 0xdf, 0x93, 0xd6, 0x69, 0x33, 0x84, 0x7b, 0x81, 0xc6, 0x68, 0xb7, 0xe8, 0x9c, 0x54, 0x5d,
+	0xb8, 0x4f, 0xd7, 0xc8, 0x6a, 0xdd, 0x08, 0x66, 0x78, 0x4f, 0x0e, 0xd0, 0xa7, 0xac, 0xab, 0xd0,
+	0x25, 0x52, 0xf5, 0xe1, 0x41, 0x68, 0x62, 0x3c, 0x93, 0x19, 0xbd, 0x21, 0x13, 0xf4, 0x99, 0xe4,
+	0x2e, 0x37, 0x08, 0xdf, 0x56, 0xd1, 0xca, 0x19, 0xfb, 0x2e, 0x92, 0x39, 0x5d, 0x25, 0xe5, 0x1c,
+	0x95, 0x4a, 0x5c, 0x0f, 0xac, 0x19, 0x74, 0x86, 0xf1, 0xfd, 0xce, 0x87, 0xf4, 0x36, 0x69, 0x1f,
+	0xa8, 0x87, 0x5a, 0xae, 0x8f, 0x6a, 0xea, 0x2b, 0x70, 0x51, 0x8a, 0x85, 0xef, 0x43, 0x2d, 0xe5,
+	0xd1, 0x32, 0xc3, 0x00, 0x4d, 0x25, 0x7b, 0x78, 0x1c, 0xd4, 0xb0, 0xe7, 0x7e, 0xbb, 0x00, 0x4f,
+	0x42, 0x88, 0x72, 0x07, 0x35, 0x22, 0x7e, 0xa8, 0x34, 0xe1, 0x4c, 0x6e, 0x1d, 0x0a, 0x9f, 0x5b,
+	0x34, 0xf0, 0x63, 0xd5, 0xea, 0x59, 0x74, 0x55, 0xdf, 0x4f, 0x55, 0xab, 0xf7, 0x54, 0xee, 0x05,
+	0x72, 0x69, 0x43, 0xe0, 0x9f, 0xa7, 0xcb, 0xa7, 0x81, 0x82, 0x04, 0xd9, 0x00, 0xe1, 0x97, 0xe0,
+	0x8f, 0x21, 0x0a, 0x89, 0x87, 0x75, 0x9b, 0xd6, 0x4a, 0xff, 0xb5, 0xea, 0xb9, 0x65, 0x03, 0x14,
+	0xe5, 0x56, 0x86, 0xa7, 0x61, 0x8d, 0xd4, 0x71, 0x39, 0x53, 0x1c, 0x93, 0x7d, 0x13, 0xf7, 0x5b,
+	0x60, 0xa6, 0xf0, 0x35, 0xd6, 0xfd, 0xac, 0x6a, 0x76, 0x1f, 0x87, 0xe1, 0x01, 0x82, 0xdf, 0xc3,
+	0x7a, 0x2f, 0x2d, 0x9c, 0x19, 0xe1, 0x8b, 0xfd, 0xf1, 0x47, 0x45, 0x91, 0xd5, 0x5c, 0xb2, 0xc4,
+	0x07, 0x1d, 0x59, 0xf8, 0x93, 0xae, 0x92, 0x56, 0x34, 0xa3, 0xb2, 0x91, 0x35, 0xc5, 0x52, 0xf4,
+	0x02, 0x1d, 0x93, 0x09, 0x30, 0x7a, 0x8b, 0x5c, 0x6f, 0x54, 0xfa, 0xec, 0xe2, 0x82, 0x4e, 0x58,
+	0xaf, 0x87, 0xc2, 0xbc, 0x75, 0x61, 0x21, 0xf0, 0xa0, 0x96, 0x19, 0x71, 0x8b, 0x74, 0x66, 0xa5,
+	0x88, 0xc0, 0x4b, 0x41, 0x65, 0x8d, 0x99, 0xbe, 0xbc, 0x25, 0xc8, 0x02, 0x06, 0x45, 0xc7, 0x7c,
+	0xbb, 0xf7, 0x67, 0x9e, 0x20, 0x6c, 0x54, 0x93, 0x51, 0xac, 0x07, 0x26, 0x8a, 0xc4, 0x5d, 0xba,
+	0x44, 0xce, 0xd6, 0x1e, 0x61, 0xd8, 0x86, 0x83, 0x5e, 0x78, 0xf5, 0x98, 0x73, 0x8c, 0xf7, 0xc2,
+	0x6b, 0xe2, 0x79, 0x2f, 0x57, 0x7d, 0x90, 0x81, 0x95, 0xbd, 0xd6, 0x4a, 0x37, 0xcf, 0xe9, 0x22,
+	0x39, 0x15, 0x03, 0xa5, 0xb9, 0x43, 0xe8, 0x07, 0xf0, 0xbe, 0xcb, 0x16, 0x73, 0x09, 0x49, 0xec,
+	0x61, 0xd3, 0x26, 0x0e, 0x92, 0x71, 0x0c, 0xd2, 0x6a, 0x7a, 0x8a, 0x81, 0xb5, 0x3e, 0xd3, 0x56,
+	0x06, 0x84, 0x05, 0x55, 0x2f, 0xbb, 0xdc, 0x3a, 0x9d, 0x7a, 0xa7, 0xfb, 0xa8, 0x40, 0x47, 0xd9,
+	0xb1, 0x0d, 0x9c, 0x7d, 0x8a, 0xbd, 0x95, 0x5d, 0xc5, 0xe2, 0x12, 0xc8, 0x1a, 0xfd, 0xf8, 0x17,
+	0xf2, 0x3c, 0x7c, 0x83, 0x17, 0xe1, 0x79, 0x28, 0x74, 0x60, 0x90, 0xeb, 0x41, 0x78, 0xbd, 0x6c,
+	0x8f, 0x19, 0x04, 0x13, 0x97, 0x5b, 0x93, 0xab, 0x1e, 0x78, 0x1b, 0xaf, 0xbc, 0x07, 0x53, 0xb2,
+	0xe3, 0xe2, 0x54, 0x1d, 0x10, 0x20, 0x41, 0x66, 0x11, 0xf2, 0x00, 0x69, 0xe6, 0xa4, 0x8c, 0x32,
+	0xf8, 0x1a, 0x48, 0x78, 0xf6, 0x2a, 0x48, 0x87, 0x29, 0x78, 0xd9, 0xbe, 0x47, 0x16, 0x66, 0xfe,
+	0xff, 0x85, 0xf1, 0xc8, 0x15, 0xd7, 0x69, 0x16, 0xa6, 0x0e, 0x05, 0x1c, 0xa1, 0x27, 0xc9, 0xfc,
+	0x2b, 0xeb, 0x04, 0xcc, 0x75, 0x16, 0x5f, 0x2d, 0xac, 0x3f, 0x7c, 0x5a, 0xfe, 0xa3, 0x7c, 0x7d,
+	0x3c, 0x7e, 0x7b, 0xf2, 0xdf, 0x00, 0x36, 0x2d, 0x19, 0x07, 0x3e, 0x0b, 0x00, 0x00,
 }
@@ -70,6 +70,8 @@ const (
 	// Only local
 	ChatMessage_SYSTEM_MESSAGE_PINNED_MESSAGE      ChatMessage_ContentType = 14
 	ChatMessage_SYSTEM_MESSAGE_MUTUAL_STATE_UPDATE ChatMessage_ContentType = 15
+	ChatMessage_VIDEO                              ChatMessage_ContentType = 16
+	ChatMessage_SAFE_TRANSACTION                   ChatMessage_ContentType = 17
 )
 
 var ChatMessage_ContentType_name = map[int32]string{
@@ -89,6 +91,8 @@ var ChatMessage_ContentType_name = map[int32]string{
 	13: "IDENTITY_VERIFICATION",
 	14: "SYSTEM_MESSAGE_PINNED_MESSAGE",
 	15: "SYSTEM_MESSAGE_MUTUAL_STATE_UPDATE",
+	16: "VIDEO",
+	17: "SAFE_TRANSACTION",
 }
 
 var ChatMessage_ContentType_value = map[string]int32{
@@ -108,6 +112,8 @@ var ChatMessage_ContentType_value = map[string]int32{
 	"IDENTITY_VERIFICATION":                13,
 	"SYSTEM_MESSAGE_PINNED_MESSAGE":        14,
 	"SYSTEM_MESSAGE_MUTUAL_STATE_UPDATE":   15,
+	"VIDEO":                                16,
+	"SAFE_TRANSACTION":                     17,
 }
 
 func (x ChatMessage_ContentType) String() string {
@@ -118,6 +124,37 @@ func (ChatMessage_ContentType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_263952f55fd35689, []int{11, 0}
 }
 
+type VideoMessage_VideoType int32
+
+const (
+	VideoMessage_UNKNOWN_VIDEO_TYPE VideoMessage_VideoType = 0
+	VideoMessage_MP4                VideoMessage_VideoType = 1
+	VideoMessage_QUICKTIME          VideoMessage_VideoType = 2
+	VideoMessage_WEBM               VideoMessage_VideoType = 3
+)
+
+var VideoMessage_VideoType_name = map[int32]string{
+	0: "UNKNOWN_VIDEO_TYPE",
+	1: "MP4",
+	2: "QUICKTIME",
+	3: "WEBM",
+}
+
+var VideoMessage_VideoType_value = map[string]int32{
+	"UNKNOWN_VIDEO_TYPE": 0,
+	"MP4":                1,
+	"QUICKTIME":          2,
+	"WEBM":               3,
+}
+
+func (x VideoMessage_VideoType) String() string {
+	return proto.EnumName(VideoMessage_VideoType_name, int32(x))
+}
+
+func (VideoMessage_VideoType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{15, 0}
+}
+
 type StickerMessage struct {
 	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Pack                 int32    `protobuf:"varint,2,opt,name=pack,proto3" json:"pack,omitempty"`
@@ -166,15 +203,17 @@ func (m *StickerMessage) GetPack() int32 {
 }
 
 type ImageMessage struct {
-	Payload              []byte    `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Type                 ImageType `protobuf:"varint,2,opt,name=type,proto3,enum=protobuf.ImageType" json:"type,omitempty"`
-	AlbumId              string    `protobuf:"bytes,3,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
-	Width                uint32    `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
-	Height               uint32    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
-	AlbumImagesCount     uint32    `protobuf:"varint,6,opt,name=album_images_count,json=albumImagesCount,proto3" json:"album_images_count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Payload          []byte    `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Type             ImageType `protobuf:"varint,2,opt,name=type,proto3,enum=protobuf.ImageType" json:"type,omitempty"`
+	AlbumId          string    `protobuf:"bytes,3,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	Width            uint32    `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height           uint32    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	AlbumImagesCount uint32    `protobuf:"varint,6,opt,name=album_images_count,json=albumImagesCount,proto3" json:"album_images_count,omitempty"`
+	// Attachment is set when the payload is sent in chunks
+	Attachment           *AttachmentDescriptor `protobuf:"bytes,7,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *ImageMessage) Reset()         { *m = ImageMessage{} }
@@ -244,13 +283,23 @@ func (m *ImageMessage) GetAlbumImagesCount() uint32 {
 	return 0
 }
 
+func (m *ImageMessage) GetAttachment() *AttachmentDescriptor {
+	if m != nil {
+		return m.Attachment
+	}
+	return nil
+}
+
 type AudioMessage struct {
-	Payload              []byte                 `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Type                 AudioMessage_AudioType `protobuf:"varint,2,opt,name=type,proto3,enum=protobuf.AudioMessage_AudioType" json:"type,omitempty"`
-	DurationMs           uint64                 `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	Payload    []byte                 `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Type       AudioMessage_AudioType `protobuf:"varint,2,opt,name=type,proto3,enum=protobuf.AudioMessage_AudioType" json:"type,omitempty"`
+	DurationMs uint64                 `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// Peaks of the audio normalized to 0-255, used to render a scrubber
+	// without loading the payload
+	Waveform             []byte   `protobuf:"bytes,4,opt,name=waveform,proto3" json:"waveform,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AudioMessage) Reset()         { *m = AudioMessage{} }
@@ -299,6 +348,13 @@ func (m *AudioMessage) GetDurationMs() uint64 {
 	return 0
 }
 
+func (m *AudioMessage) GetWaveform() []byte {
+	if m != nil {
+		return m.Waveform
+	}
+	return nil
+}
+
 type EditMessage struct {
 	Clock uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	// Text of the message
@@ -961,6 +1017,8 @@ type ChatMessage struct {
 	//	*ChatMessage_Audio
 	//	*ChatMessage_Community
 	//	*ChatMessage_DiscordMessage
+	//	*ChatMessage_Video
+	//	*ChatMessage_SafeTransaction
 	Payload isChatMessage_Payload `protobuf_oneof:"payload"`
 	// Grant for community chat messages
 	Grant []byte `protobuf:"bytes,13,opt,name=grant,proto3" json:"grant,omitempty"`
@@ -968,6 +1026,7 @@ type ChatMessage struct {
 	DisplayName                   string                         `protobuf:"bytes,14,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
 	ContactRequestPropagatedState *ContactRequestPropagatedState `protobuf:"bytes,15,opt,name=contact_request_propagated_state,json=contactRequestPropagatedState,proto3" json:"contact_request_propagated_state,omitempty"`
 	UnfurledLinks                 []*UnfurledLink                `protobuf:"bytes,16,rep,name=unfurled_links,json=unfurledLinks,proto3" json:"unfurled_links,omitempty"`
+	UnfurledStatusLinks           []*UnfurledStatusLink          `protobuf:"bytes,18,rep,name=unfurled_status_links,json=unfurledStatusLinks,proto3" json:"unfurled_status_links,omitempty"`
 	XXX_NoUnkeyedLiteral          struct{}                       `json:"-"`
 	XXX_unrecognized              []byte                         `json:"-"`
 	XXX_sizecache                 int32                          `json:"-"`
@@ -1078,6 +1137,14 @@ type ChatMessage_DiscordMessage struct {
 	DiscordMessage *DiscordMessage `protobuf:"bytes,99,opt,name=discord_message,json=discordMessage,proto3,oneof"`
 }
 
+type ChatMessage_Video struct {
+	Video *VideoMessage `protobuf:"bytes,17,opt,name=video,proto3,oneof"`
+}
+
+type ChatMessage_SafeTransaction struct {
+	SafeTransaction *SafeTransactionProposal `protobuf:"bytes,19,opt,name=safe_transaction,json=safeTransaction,proto3,oneof"`
+}
+
 func (*ChatMessage_Sticker) isChatMessage_Payload() {}
 
 func (*ChatMessage_Image) isChatMessage_Payload() {}
@@ -1088,6 +1155,10 @@ func (*ChatMessage_Community) isChatMessage_Payload() {}
 
 func (*ChatMessage_DiscordMessage) isChatMessage_Payload() {}
 
+func (*ChatMessage_Video) isChatMessage_Payload() {}
+
+func (*ChatMessage_SafeTransaction) isChatMessage_Payload() {}
+
 func (m *ChatMessage) GetPayload() isChatMessage_Payload {
 	if m != nil {
 		return m.Payload
@@ -1130,6 +1201,20 @@ func (m *ChatMessage) GetDiscordMessage() *DiscordMessage {
 	return nil
 }
 
+func (m *ChatMessage) GetVideo() *VideoMessage {
+	if x, ok := m.GetPayload().(*ChatMessage_Video); ok {
+		return x.Video
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetSafeTransaction() *SafeTransactionProposal {
+	if x, ok := m.GetPayload().(*ChatMessage_SafeTransaction); ok {
+		return x.SafeTransaction
+	}
+	return nil
+}
+
 func (m *ChatMessage) GetGrant() []byte {
 	if m != nil {
 		return m.Grant
@@ -1158,6 +1243,13 @@ func (m *ChatMessage) GetUnfurledLinks() []*UnfurledLink {
 	return nil
 }
 
+func (m *ChatMessage) GetUnfurledStatusLinks() []*UnfurledStatusLink {
+	if m != nil {
+		return m.UnfurledStatusLinks
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ChatMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1166,12 +1258,965 @@ func (*ChatMessage) XXX_OneofWrappers() []interface{} {
 		(*ChatMessage_Audio)(nil),
 		(*ChatMessage_Community)(nil),
 		(*ChatMessage_DiscordMessage)(nil),
+		(*ChatMessage_Video)(nil),
+		(*ChatMessage_SafeTransaction)(nil),
+	}
+}
+
+// AttachmentDescriptor describes an attachment sent in chunks, separately
+// from its message
+type AttachmentDescriptor struct {
+	// Hex encoded sha256 of the attachment
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// sha256 of each of the chunks, in order
+	ChunkHashes          [][]byte `protobuf:"bytes,3,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttachmentDescriptor) Reset()         { *m = AttachmentDescriptor{} }
+func (m *AttachmentDescriptor) String() string { return proto.CompactTextString(m) }
+func (*AttachmentDescriptor) ProtoMessage()    {}
+func (*AttachmentDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{12}
+}
+
+func (m *AttachmentDescriptor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttachmentDescriptor.Unmarshal(m, b)
+}
+func (m *AttachmentDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttachmentDescriptor.Marshal(b, m, deterministic)
+}
+func (m *AttachmentDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachmentDescriptor.Merge(m, src)
+}
+func (m *AttachmentDescriptor) XXX_Size() int {
+	return xxx_messageInfo_AttachmentDescriptor.Size(m)
+}
+func (m *AttachmentDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachmentDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttachmentDescriptor proto.InternalMessageInfo
+
+func (m *AttachmentDescriptor) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AttachmentDescriptor) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *AttachmentDescriptor) GetChunkHashes() [][]byte {
+	if m != nil {
+		return m.ChunkHashes
+	}
+	return nil
+}
+
+type AttachmentChunk struct {
+	AttachmentId string `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	Index        uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Total        uint32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// Size of the attachment, to decide whether to keep the chunk before
+	// receiving its message
+	AttachmentSize       uint64   `protobuf:"varint,4,opt,name=attachment_size,json=attachmentSize,proto3" json:"attachment_size,omitempty"`
+	Data                 []byte   `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttachmentChunk) Reset()         { *m = AttachmentChunk{} }
+func (m *AttachmentChunk) String() string { return proto.CompactTextString(m) }
+func (*AttachmentChunk) ProtoMessage()    {}
+func (*AttachmentChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{13}
+}
+
+func (m *AttachmentChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttachmentChunk.Unmarshal(m, b)
+}
+func (m *AttachmentChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttachmentChunk.Marshal(b, m, deterministic)
+}
+func (m *AttachmentChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachmentChunk.Merge(m, src)
+}
+func (m *AttachmentChunk) XXX_Size() int {
+	return xxx_messageInfo_AttachmentChunk.Size(m)
+}
+func (m *AttachmentChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachmentChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttachmentChunk proto.InternalMessageInfo
+
+func (m *AttachmentChunk) GetAttachmentId() string {
+	if m != nil {
+		return m.AttachmentId
+	}
+	return ""
+}
+
+func (m *AttachmentChunk) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *AttachmentChunk) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *AttachmentChunk) GetAttachmentSize() uint64 {
+	if m != nil {
+		return m.AttachmentSize
+	}
+	return 0
+}
+
+func (m *AttachmentChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// AttachmentChunkRequest asks the author of an attachment to send again the
+// chunks missing
+type AttachmentChunkRequest struct {
+	AttachmentId         string   `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	Indexes              []uint32 `protobuf:"varint,2,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttachmentChunkRequest) Reset()         { *m = AttachmentChunkRequest{} }
+func (m *AttachmentChunkRequest) String() string { return proto.CompactTextString(m) }
+func (*AttachmentChunkRequest) ProtoMessage()    {}
+func (*AttachmentChunkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{14}
+}
+
+func (m *AttachmentChunkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttachmentChunkRequest.Unmarshal(m, b)
+}
+func (m *AttachmentChunkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttachmentChunkRequest.Marshal(b, m, deterministic)
+}
+func (m *AttachmentChunkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachmentChunkRequest.Merge(m, src)
+}
+func (m *AttachmentChunkRequest) XXX_Size() int {
+	return xxx_messageInfo_AttachmentChunkRequest.Size(m)
+}
+func (m *AttachmentChunkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachmentChunkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttachmentChunkRequest proto.InternalMessageInfo
+
+func (m *AttachmentChunkRequest) GetAttachmentId() string {
+	if m != nil {
+		return m.AttachmentId
+	}
+	return ""
+}
+
+func (m *AttachmentChunkRequest) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+type VideoMessage struct {
+	Payload    []byte                 `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Type       VideoMessage_VideoType `protobuf:"varint,2,opt,name=type,proto3,enum=protobuf.VideoMessage_VideoType" json:"type,omitempty"`
+	DurationMs uint64                 `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Width      uint32                 `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height     uint32                 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// JPEG thumbnail, displayed until the video is downloaded
+	Thumbnail []byte `protobuf:"bytes,6,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	// Set when the video is sent in chunks after the message
+	Attachment           *AttachmentDescriptor `protobuf:"bytes,7,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *VideoMessage) Reset()         { *m = VideoMessage{} }
+func (m *VideoMessage) String() string { return proto.CompactTextString(m) }
+func (*VideoMessage) ProtoMessage()    {}
+func (*VideoMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{15}
+}
+
+func (m *VideoMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VideoMessage.Unmarshal(m, b)
+}
+func (m *VideoMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VideoMessage.Marshal(b, m, deterministic)
+}
+func (m *VideoMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoMessage.Merge(m, src)
+}
+func (m *VideoMessage) XXX_Size() int {
+	return xxx_messageInfo_VideoMessage.Size(m)
+}
+func (m *VideoMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoMessage proto.InternalMessageInfo
+
+func (m *VideoMessage) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *VideoMessage) GetType() VideoMessage_VideoType {
+	if m != nil {
+		return m.Type
+	}
+	return VideoMessage_UNKNOWN_VIDEO_TYPE
+}
+
+func (m *VideoMessage) GetDurationMs() uint64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
+func (m *VideoMessage) GetWidth() uint32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *VideoMessage) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *VideoMessage) GetThumbnail() []byte {
+	if m != nil {
+		return m.Thumbnail
+	}
+	return nil
+}
+
+func (m *VideoMessage) GetAttachment() *AttachmentDescriptor {
+	if m != nil {
+		return m.Attachment
+	}
+	return nil
+}
+
+type UnfurledStatusLink struct {
+	Url                  string                             `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Contact              *StatusContactLinkPreview          `protobuf:"bytes,2,opt,name=contact,proto3" json:"contact,omitempty"`
+	Community            *StatusCommunityLinkPreview        `protobuf:"bytes,3,opt,name=community,proto3" json:"community,omitempty"`
+	Channel              *StatusCommunityChannelLinkPreview `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Message              *StatusMessageLinkPreview          `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Unverified           bool                               `protobuf:"varint,6,opt,name=unverified,proto3" json:"unverified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *UnfurledStatusLink) Reset()         { *m = UnfurledStatusLink{} }
+func (m *UnfurledStatusLink) String() string { return proto.CompactTextString(m) }
+func (*UnfurledStatusLink) ProtoMessage()    {}
+func (*UnfurledStatusLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{16}
+}
+
+func (m *UnfurledStatusLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfurledStatusLink.Unmarshal(m, b)
+}
+func (m *UnfurledStatusLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfurledStatusLink.Marshal(b, m, deterministic)
+}
+func (m *UnfurledStatusLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfurledStatusLink.Merge(m, src)
+}
+func (m *UnfurledStatusLink) XXX_Size() int {
+	return xxx_messageInfo_UnfurledStatusLink.Size(m)
+}
+func (m *UnfurledStatusLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfurledStatusLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfurledStatusLink proto.InternalMessageInfo
+
+func (m *UnfurledStatusLink) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *UnfurledStatusLink) GetContact() *StatusContactLinkPreview {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (m *UnfurledStatusLink) GetCommunity() *StatusCommunityLinkPreview {
+	if m != nil {
+		return m.Community
+	}
+	return nil
+}
+
+func (m *UnfurledStatusLink) GetChannel() *StatusCommunityChannelLinkPreview {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *UnfurledStatusLink) GetMessage() *StatusMessageLinkPreview {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *UnfurledStatusLink) GetUnverified() bool {
+	if m != nil {
+		return m.Unverified
+	}
+	return false
+}
+
+type StatusContactLinkPreview struct {
+	PublicKey            string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	DisplayName          string   `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Icon                 []byte   `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusContactLinkPreview) Reset()         { *m = StatusContactLinkPreview{} }
+func (m *StatusContactLinkPreview) String() string { return proto.CompactTextString(m) }
+func (*StatusContactLinkPreview) ProtoMessage()    {}
+func (*StatusContactLinkPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{17}
+}
+
+func (m *StatusContactLinkPreview) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusContactLinkPreview.Unmarshal(m, b)
+}
+func (m *StatusContactLinkPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusContactLinkPreview.Marshal(b, m, deterministic)
+}
+func (m *StatusContactLinkPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusContactLinkPreview.Merge(m, src)
+}
+func (m *StatusContactLinkPreview) XXX_Size() int {
+	return xxx_messageInfo_StatusContactLinkPreview.Size(m)
+}
+func (m *StatusContactLinkPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusContactLinkPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusContactLinkPreview proto.InternalMessageInfo
+
+func (m *StatusContactLinkPreview) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *StatusContactLinkPreview) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
 	}
+	return ""
+}
+
+func (m *StatusContactLinkPreview) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *StatusContactLinkPreview) GetIcon() []byte {
+	if m != nil {
+		return m.Icon
+	}
+	return nil
+}
+
+type StatusCommunityLinkPreview struct {
+	CommunityId          string   `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	DisplayName          string   `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	MembersCount         uint32   `protobuf:"varint,4,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	Color                string   `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	Icon                 []byte   `protobuf:"bytes,6,opt,name=icon,proto3" json:"icon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusCommunityLinkPreview) Reset()         { *m = StatusCommunityLinkPreview{} }
+func (m *StatusCommunityLinkPreview) String() string { return proto.CompactTextString(m) }
+func (*StatusCommunityLinkPreview) ProtoMessage()    {}
+func (*StatusCommunityLinkPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{18}
+}
+
+func (m *StatusCommunityLinkPreview) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusCommunityLinkPreview.Unmarshal(m, b)
+}
+func (m *StatusCommunityLinkPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusCommunityLinkPreview.Marshal(b, m, deterministic)
+}
+func (m *StatusCommunityLinkPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusCommunityLinkPreview.Merge(m, src)
+}
+func (m *StatusCommunityLinkPreview) XXX_Size() int {
+	return xxx_messageInfo_StatusCommunityLinkPreview.Size(m)
+}
+func (m *StatusCommunityLinkPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusCommunityLinkPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusCommunityLinkPreview proto.InternalMessageInfo
+
+func (m *StatusCommunityLinkPreview) GetCommunityId() string {
+	if m != nil {
+		return m.CommunityId
+	}
+	return ""
+}
+
+func (m *StatusCommunityLinkPreview) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *StatusCommunityLinkPreview) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *StatusCommunityLinkPreview) GetMembersCount() uint32 {
+	if m != nil {
+		return m.MembersCount
+	}
+	return 0
+}
+
+func (m *StatusCommunityLinkPreview) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+func (m *StatusCommunityLinkPreview) GetIcon() []byte {
+	if m != nil {
+		return m.Icon
+	}
+	return nil
+}
+
+type StatusCommunityChannelLinkPreview struct {
+	ChannelUuid          string                      `protobuf:"bytes,1,opt,name=channel_uuid,json=channelUuid,proto3" json:"channel_uuid,omitempty"`
+	Emoji                string                      `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	DisplayName          string                      `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description          string                      `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Color                string                      `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	Community            *StatusCommunityLinkPreview `protobuf:"bytes,6,opt,name=community,proto3" json:"community,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *StatusCommunityChannelLinkPreview) Reset()         { *m = StatusCommunityChannelLinkPreview{} }
+func (m *StatusCommunityChannelLinkPreview) String() string { return proto.CompactTextString(m) }
+func (*StatusCommunityChannelLinkPreview) ProtoMessage()    {}
+func (*StatusCommunityChannelLinkPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{19}
+}
+
+func (m *StatusCommunityChannelLinkPreview) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusCommunityChannelLinkPreview.Unmarshal(m, b)
+}
+func (m *StatusCommunityChannelLinkPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusCommunityChannelLinkPreview.Marshal(b, m, deterministic)
+}
+func (m *StatusCommunityChannelLinkPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusCommunityChannelLinkPreview.Merge(m, src)
+}
+func (m *StatusCommunityChannelLinkPreview) XXX_Size() int {
+	return xxx_messageInfo_StatusCommunityChannelLinkPreview.Size(m)
+}
+func (m *StatusCommunityChannelLinkPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusCommunityChannelLinkPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusCommunityChannelLinkPreview proto.InternalMessageInfo
+
+func (m *StatusCommunityChannelLinkPreview) GetChannelUuid() string {
+	if m != nil {
+		return m.ChannelUuid
+	}
+	return ""
+}
+
+func (m *StatusCommunityChannelLinkPreview) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+func (m *StatusCommunityChannelLinkPreview) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *StatusCommunityChannelLinkPreview) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *StatusCommunityChannelLinkPreview) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+func (m *StatusCommunityChannelLinkPreview) GetCommunity() *StatusCommunityLinkPreview {
+	if m != nil {
+		return m.Community
+	}
+	return nil
+}
+
+type StatusMessageLinkPreview struct {
+	MessageId            string   `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ChatId               string   `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Author               string   `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	AuthorDisplayName    string   `protobuf:"bytes,4,opt,name=author_display_name,json=authorDisplayName,proto3" json:"author_display_name,omitempty"`
+	Text                 string   `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusMessageLinkPreview) Reset()         { *m = StatusMessageLinkPreview{} }
+func (m *StatusMessageLinkPreview) String() string { return proto.CompactTextString(m) }
+func (*StatusMessageLinkPreview) ProtoMessage()    {}
+func (*StatusMessageLinkPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{20}
+}
+
+func (m *StatusMessageLinkPreview) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusMessageLinkPreview.Unmarshal(m, b)
+}
+func (m *StatusMessageLinkPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusMessageLinkPreview.Marshal(b, m, deterministic)
+}
+func (m *StatusMessageLinkPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusMessageLinkPreview.Merge(m, src)
+}
+func (m *StatusMessageLinkPreview) XXX_Size() int {
+	return xxx_messageInfo_StatusMessageLinkPreview.Size(m)
+}
+func (m *StatusMessageLinkPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusMessageLinkPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusMessageLinkPreview proto.InternalMessageInfo
+
+func (m *StatusMessageLinkPreview) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *StatusMessageLinkPreview) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *StatusMessageLinkPreview) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *StatusMessageLinkPreview) GetAuthorDisplayName() string {
+	if m != nil {
+		return m.AuthorDisplayName
+	}
+	return ""
+}
+
+func (m *StatusMessageLinkPreview) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type SafeTransactionProposal struct {
+	ChainId              uint64   `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	SafeAddress          string   `protobuf:"bytes,2,opt,name=safe_address,json=safeAddress,proto3" json:"safe_address,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Data                 []byte   `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Operation            uint32   `protobuf:"varint,6,opt,name=operation,proto3" json:"operation,omitempty"`
+	SafeTxGas            string   `protobuf:"bytes,7,opt,name=safe_tx_gas,json=safeTxGas,proto3" json:"safe_tx_gas,omitempty"`
+	BaseGas              string   `protobuf:"bytes,8,opt,name=base_gas,json=baseGas,proto3" json:"base_gas,omitempty"`
+	GasPrice             string   `protobuf:"bytes,9,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	GasToken             string   `protobuf:"bytes,10,opt,name=gas_token,json=gasToken,proto3" json:"gas_token,omitempty"`
+	RefundReceiver       string   `protobuf:"bytes,11,opt,name=refund_receiver,json=refundReceiver,proto3" json:"refund_receiver,omitempty"`
+	Nonce                string   `protobuf:"bytes,12,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Threshold            uint32   `protobuf:"varint,13,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SafeTransactionProposal) Reset()         { *m = SafeTransactionProposal{} }
+func (m *SafeTransactionProposal) String() string { return proto.CompactTextString(m) }
+func (*SafeTransactionProposal) ProtoMessage()    {}
+func (*SafeTransactionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{21}
+}
+
+func (m *SafeTransactionProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SafeTransactionProposal.Unmarshal(m, b)
+}
+func (m *SafeTransactionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SafeTransactionProposal.Marshal(b, m, deterministic)
+}
+func (m *SafeTransactionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SafeTransactionProposal.Merge(m, src)
+}
+func (m *SafeTransactionProposal) XXX_Size() int {
+	return xxx_messageInfo_SafeTransactionProposal.Size(m)
+}
+func (m *SafeTransactionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SafeTransactionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SafeTransactionProposal proto.InternalMessageInfo
+
+func (m *SafeTransactionProposal) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *SafeTransactionProposal) GetSafeAddress() string {
+	if m != nil {
+		return m.SafeAddress
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *SafeTransactionProposal) GetOperation() uint32 {
+	if m != nil {
+		return m.Operation
+	}
+	return 0
+}
+
+func (m *SafeTransactionProposal) GetSafeTxGas() string {
+	if m != nil {
+		return m.SafeTxGas
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetBaseGas() string {
+	if m != nil {
+		return m.BaseGas
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetGasToken() string {
+	if m != nil {
+		return m.GasToken
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetRefundReceiver() string {
+	if m != nil {
+		return m.RefundReceiver
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+type SafeTransactionSignature struct {
+	Clock                uint64      `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId               string      `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	MessageId            string      `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	MessageType          MessageType `protobuf:"varint,4,opt,name=message_type,json=messageType,proto3,enum=protobuf.MessageType" json:"message_type,omitempty"`
+	SafeTxHash           []byte      `protobuf:"bytes,5,opt,name=safe_tx_hash,json=safeTxHash,proto3" json:"safe_tx_hash,omitempty"`
+	Signer               string      `protobuf:"bytes,6,opt,name=signer,proto3" json:"signer,omitempty"`
+	Signature            []byte      `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	Grant                []byte      `protobuf:"bytes,8,opt,name=grant,proto3" json:"grant,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SafeTransactionSignature) Reset()         { *m = SafeTransactionSignature{} }
+func (m *SafeTransactionSignature) String() string { return proto.CompactTextString(m) }
+func (*SafeTransactionSignature) ProtoMessage()    {}
+func (*SafeTransactionSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{22}
+}
+
+func (m *SafeTransactionSignature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SafeTransactionSignature.Unmarshal(m, b)
+}
+func (m *SafeTransactionSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SafeTransactionSignature.Marshal(b, m, deterministic)
+}
+func (m *SafeTransactionSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SafeTransactionSignature.Merge(m, src)
+}
+func (m *SafeTransactionSignature) XXX_Size() int {
+	return xxx_messageInfo_SafeTransactionSignature.Size(m)
+}
+func (m *SafeTransactionSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_SafeTransactionSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SafeTransactionSignature proto.InternalMessageInfo
+
+func (m *SafeTransactionSignature) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SafeTransactionSignature) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *SafeTransactionSignature) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *SafeTransactionSignature) GetMessageType() MessageType {
+	if m != nil {
+		return m.MessageType
+	}
+	return MessageType_UNKNOWN_MESSAGE_TYPE
+}
+
+func (m *SafeTransactionSignature) GetSafeTxHash() []byte {
+	if m != nil {
+		return m.SafeTxHash
+	}
+	return nil
+}
+
+func (m *SafeTransactionSignature) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *SafeTransactionSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *SafeTransactionSignature) GetGrant() []byte {
+	if m != nil {
+		return m.Grant
+	}
+	return nil
+}
+
+type SafeTransactionExecution struct {
+	Clock                uint64      `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId               string      `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	MessageId            string      `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	MessageType          MessageType `protobuf:"varint,4,opt,name=message_type,json=messageType,proto3,enum=protobuf.MessageType" json:"message_type,omitempty"`
+	TransactionHash      string      `protobuf:"bytes,5,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Grant                []byte      `protobuf:"bytes,6,opt,name=grant,proto3" json:"grant,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SafeTransactionExecution) Reset()         { *m = SafeTransactionExecution{} }
+func (m *SafeTransactionExecution) String() string { return proto.CompactTextString(m) }
+func (*SafeTransactionExecution) ProtoMessage()    {}
+func (*SafeTransactionExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{23}
+}
+
+func (m *SafeTransactionExecution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SafeTransactionExecution.Unmarshal(m, b)
+}
+func (m *SafeTransactionExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SafeTransactionExecution.Marshal(b, m, deterministic)
+}
+func (m *SafeTransactionExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SafeTransactionExecution.Merge(m, src)
+}
+func (m *SafeTransactionExecution) XXX_Size() int {
+	return xxx_messageInfo_SafeTransactionExecution.Size(m)
+}
+func (m *SafeTransactionExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_SafeTransactionExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SafeTransactionExecution proto.InternalMessageInfo
+
+func (m *SafeTransactionExecution) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SafeTransactionExecution) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *SafeTransactionExecution) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *SafeTransactionExecution) GetMessageType() MessageType {
+	if m != nil {
+		return m.MessageType
+	}
+	return MessageType_UNKNOWN_MESSAGE_TYPE
+}
+
+func (m *SafeTransactionExecution) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
+func (m *SafeTransactionExecution) GetGrant() []byte {
+	if m != nil {
+		return m.Grant
+	}
+	return nil
 }
 
 func init() {
 	proto.RegisterEnum("protobuf.AudioMessage_AudioType", AudioMessage_AudioType_name, AudioMessage_AudioType_value)
 	proto.RegisterEnum("protobuf.ChatMessage_ContentType", ChatMessage_ContentType_name, ChatMessage_ContentType_value)
+	proto.RegisterEnum("protobuf.VideoMessage_VideoType", VideoMessage_VideoType_name, VideoMessage_VideoType_value)
 	proto.RegisterType((*StickerMessage)(nil), "protobuf.StickerMessage")
 	proto.RegisterType((*ImageMessage)(nil), "protobuf.ImageMessage")
 	proto.RegisterType((*AudioMessage)(nil), "protobuf.AudioMessage")
@@ -1184,6 +2229,18 @@ func init() {
 	proto.RegisterType((*DiscordMessageAttachment)(nil), "protobuf.DiscordMessageAttachment")
 	proto.RegisterType((*UnfurledLink)(nil), "protobuf.UnfurledLink")
 	proto.RegisterType((*ChatMessage)(nil), "protobuf.ChatMessage")
+	proto.RegisterType((*AttachmentDescriptor)(nil), "protobuf.AttachmentDescriptor")
+	proto.RegisterType((*AttachmentChunk)(nil), "protobuf.AttachmentChunk")
+	proto.RegisterType((*AttachmentChunkRequest)(nil), "protobuf.AttachmentChunkRequest")
+	proto.RegisterType((*VideoMessage)(nil), "protobuf.VideoMessage")
+	proto.RegisterType((*UnfurledStatusLink)(nil), "protobuf.UnfurledStatusLink")
+	proto.RegisterType((*StatusContactLinkPreview)(nil), "protobuf.StatusContactLinkPreview")
+	proto.RegisterType((*StatusCommunityLinkPreview)(nil), "protobuf.StatusCommunityLinkPreview")
+	proto.RegisterType((*StatusCommunityChannelLinkPreview)(nil), "protobuf.StatusCommunityChannelLinkPreview")
+	proto.RegisterType((*StatusMessageLinkPreview)(nil), "protobuf.StatusMessageLinkPreview")
+	proto.RegisterType((*SafeTransactionProposal)(nil), "protobuf.SafeTransactionProposal")
+	proto.RegisterType((*SafeTransactionSignature)(nil), "protobuf.SafeTransactionSignature")
+	proto.RegisterType((*SafeTransactionExecution)(nil), "protobuf.SafeTransactionExecution")
 }
 
 func init() {
@@ -1191,95 +2248,151 @@ func init() {
 }
 
 var fileDescriptor_263952f55fd35689 = []byte{
-	// 1430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x5f, 0x73, 0xdb, 0x44,
-	0x10, 0x8f, 0x1d, 0xff, 0x89, 0x56, 0xb6, 0x23, 0xae, 0x69, 0xab, 0x76, 0x9a, 0x36, 0xd5, 0x74,
-	0x68, 0x18, 0x98, 0x30, 0x53, 0x0a, 0xd3, 0x19, 0x86, 0x61, 0x14, 0x5b, 0x4d, 0x44, 0x6b, 0xc7,
-	0x9c, 0xe5, 0x96, 0xf0, 0xa2, 0x51, 0xa4, 0x4b, 0xac, 0x89, 0x2c, 0x19, 0xe9, 0x04, 0x98, 0x77,
-	0x66, 0xf8, 0x44, 0x7c, 0x06, 0x1e, 0x78, 0xe5, 0x81, 0x2f, 0xc0, 0x0b, 0xaf, 0x7c, 0x00, 0xe6,
-	0xee, 0xf4, 0xcf, 0xa6, 0x49, 0x99, 0x3e, 0xe9, 0x76, 0xb5, 0xbb, 0xb7, 0xfb, 0xdb, 0xbd, 0xdd,
-	0x05, 0xe4, 0xce, 0x1c, 0x6a, 0xcf, 0x49, 0x92, 0x38, 0x17, 0xe4, 0x60, 0x11, 0x47, 0x34, 0x42,
-	0x5b, 0xfc, 0x73, 0x96, 0x9e, 0xdf, 0x95, 0x49, 0x98, 0xce, 0x13, 0xc1, 0xbe, 0xdb, 0x75, 0xa3,
-	0x90, 0x3a, 0x2e, 0x15, 0xa4, 0xf6, 0x0c, 0x7a, 0x13, 0xea, 0xbb, 0x97, 0x24, 0x1e, 0x0a, 0x6d,
-	0x84, 0xa0, 0x31, 0x73, 0x92, 0x99, 0x5a, 0xdb, 0xab, 0xed, 0x4b, 0x98, 0x9f, 0x19, 0x6f, 0xe1,
-	0xb8, 0x97, 0x6a, 0x7d, 0xaf, 0xb6, 0xdf, 0xc4, 0xfc, 0xac, 0xfd, 0x56, 0x83, 0x8e, 0x39, 0x77,
-	0x2e, 0x48, 0xae, 0xa8, 0x42, 0x7b, 0xe1, 0x2c, 0x83, 0xc8, 0xf1, 0xb8, 0x6e, 0x07, 0xe7, 0x24,
-	0x7a, 0x0c, 0x0d, 0xba, 0x5c, 0x10, 0xae, 0xde, 0x7b, 0x72, 0xe3, 0x20, 0xf7, 0xec, 0x80, 0xeb,
-	0x5b, 0xcb, 0x05, 0xc1, 0x5c, 0x00, 0xdd, 0x81, 0x2d, 0x27, 0x38, 0x4b, 0xe7, 0xb6, 0xef, 0xa9,
-	0x9b, 0xfc, 0xfe, 0x36, 0xa7, 0x4d, 0x0f, 0xed, 0x40, 0xf3, 0x07, 0xdf, 0xa3, 0x33, 0xb5, 0xb1,
-	0x57, 0xdb, 0xef, 0x62, 0x41, 0xa0, 0x5b, 0xd0, 0x9a, 0x11, 0xff, 0x62, 0x46, 0xd5, 0x26, 0x67,
-	0x67, 0x14, 0xfa, 0x08, 0x50, 0x66, 0x88, 0xdd, 0x90, 0xd8, 0x6e, 0x94, 0x86, 0x54, 0x6d, 0x71,
-	0x19, 0x45, 0x98, 0xe4, 0x3f, 0xfa, 0x8c, 0xaf, 0xfd, 0x5a, 0x83, 0x8e, 0x9e, 0x7a, 0x7e, 0xf4,
-	0xf6, 0x50, 0x9e, 0xae, 0x84, 0xb2, 0x57, 0x86, 0x52, 0xd5, 0x17, 0x44, 0x25, 0xae, 0x07, 0x20,
-	0x7b, 0x69, 0xec, 0x50, 0x3f, 0x0a, 0xed, 0x79, 0xc2, 0x43, 0x6b, 0x60, 0xc8, 0x59, 0xc3, 0x44,
-	0xfb, 0x14, 0xa4, 0x42, 0x07, 0xdd, 0x02, 0x34, 0x1d, 0xbd, 0x18, 0x9d, 0xbc, 0x1e, 0xd9, 0xfa,
-	0x74, 0x60, 0x9e, 0xd8, 0xd6, 0xe9, 0xd8, 0x50, 0x36, 0x50, 0x1b, 0x36, 0x75, 0xbd, 0xaf, 0xd4,
-	0xf8, 0x61, 0x88, 0x95, 0xba, 0xf6, 0x73, 0x1d, 0x64, 0xc3, 0xf3, 0x69, 0xee, 0xf7, 0x0e, 0x34,
-	0xdd, 0x20, 0x72, 0x2f, 0xb9, 0xd7, 0x0d, 0x2c, 0x08, 0x96, 0x3d, 0x4a, 0x7e, 0xa4, 0xdc, 0x67,
-	0x09, 0xf3, 0x33, 0xba, 0x0d, 0x6d, 0x5e, 0x33, 0x05, 0xd0, 0x2d, 0x46, 0x9a, 0x1e, 0xda, 0x05,
-	0xc8, 0xea, 0x88, 0xfd, 0x6b, 0xf0, 0x7f, 0x52, 0xc6, 0x11, 0x69, 0xb8, 0x88, 0x9d, 0x50, 0xe0,
-	0xdd, 0xc1, 0x82, 0x40, 0xcf, 0xa0, 0x93, 0x2b, 0x71, 0x74, 0x5a, 0x1c, 0x9d, 0x9b, 0x25, 0x3a,
-	0x99, 0x83, 0x1c, 0x12, 0x79, 0x5e, 0x12, 0x68, 0x00, 0x1d, 0x56, 0x90, 0x24, 0xa4, 0x42, 0xb3,
-	0xcd, 0x35, 0x1f, 0x96, 0x9a, 0xfd, 0x99, 0x93, 0x87, 0x77, 0xd0, 0x17, 0x92, 0xc2, 0x8a, 0x5b,
-	0x12, 0xda, 0xef, 0x35, 0xe8, 0x0e, 0x48, 0x40, 0x28, 0xb9, 0x1e, 0x89, 0x4a, 0xd4, 0xf5, 0x6b,
-	0xa2, 0xde, 0xbc, 0x32, 0xea, 0xc6, 0x75, 0x51, 0x37, 0xff, 0x77, 0xd4, 0xbb, 0x00, 0x1e, 0x77,
-	0xd7, 0xb3, 0xcf, 0x96, 0x1c, 0x2d, 0x09, 0x4b, 0x19, 0xe7, 0x70, 0xa9, 0x99, 0x80, 0x44, 0x34,
-	0xcf, 0xa3, 0x78, 0xf8, 0x96, 0x90, 0x56, 0x3d, 0xaf, 0xaf, 0x79, 0xae, 0xfd, 0x51, 0x87, 0xde,
-	0xc0, 0x4f, 0xdc, 0x28, 0xf6, 0x72, 0x3b, 0x3d, 0xa8, 0xfb, 0x5e, 0xf6, 0xbc, 0xeb, 0xbe, 0xc7,
-	0xcb, 0x23, 0x2f, 0x69, 0x29, 0x2b, 0xd8, 0x7b, 0x20, 0x51, 0x7f, 0x4e, 0x12, 0xea, 0xcc, 0x17,
-	0x39, 0x1c, 0x05, 0x03, 0xed, 0xc3, 0x76, 0x41, 0xb0, 0xf2, 0x23, 0x79, 0xa1, 0xac, 0xb3, 0xd9,
-	0x43, 0xca, 0xf2, 0xc4, 0xd1, 0x91, 0x70, 0x4e, 0xa2, 0xcf, 0xa0, 0xe5, 0xa4, 0x74, 0x16, 0xc5,
-	0x3c, 0x7c, 0xf9, 0xc9, 0xfd, 0x12, 0xb6, 0x55, 0x7f, 0x75, 0x2e, 0x85, 0x33, 0x69, 0xf4, 0x25,
-	0x48, 0x31, 0x39, 0x27, 0x31, 0x09, 0x5d, 0x51, 0x2d, 0x72, 0xb5, 0x5a, 0x56, 0x55, 0x71, 0x2e,
-	0x88, 0x4b, 0x1d, 0x34, 0x00, 0xd9, 0xa1, 0xd4, 0x71, 0x67, 0x73, 0x12, 0xd2, 0x44, 0xdd, 0xda,
-	0xdb, 0xdc, 0x97, 0x9f, 0x68, 0x57, 0xde, 0x5e, 0x88, 0xe2, 0xaa, 0x9a, 0xf6, 0x57, 0x0d, 0x76,
-	0xde, 0xe4, 0xe7, 0x9b, 0xd0, 0x0d, 0x9d, 0x79, 0x81, 0x2e, 0x3b, 0xa3, 0x47, 0xd0, 0xf5, 0xfc,
-	0xc4, 0x8d, 0xfd, 0xb9, 0x1f, 0x3a, 0x34, 0x8a, 0x33, 0x84, 0x57, 0x99, 0xe8, 0x2e, 0x6c, 0x85,
-	0xbe, 0x7b, 0xc9, 0xb5, 0x05, 0xbc, 0x05, 0xcd, 0xf2, 0xe3, 0x7c, 0xef, 0x50, 0x27, 0x9e, 0xc6,
-	0x41, 0x86, 0x6c, 0xc9, 0x40, 0x07, 0x80, 0x04, 0xc1, 0x9b, 0xdc, 0x38, 0xeb, 0x64, 0x2d, 0x5e,
-	0xbb, 0x6f, 0xf8, 0xc3, 0x6e, 0x0a, 0x22, 0xd7, 0x09, 0x98, 0xb1, 0xb6, 0xb8, 0x29, 0xa7, 0xb5,
-	0x08, 0x6e, 0x5f, 0x01, 0x2a, 0x73, 0xa2, 0x28, 0xb4, 0x2c, 0xe2, 0xca, 0x9b, 0xb9, 0x07, 0x92,
-	0x3b, 0x73, 0xc2, 0x90, 0x04, 0x66, 0x51, 0x97, 0x05, 0x83, 0x15, 0xc6, 0x45, 0xea, 0x07, 0x9e,
-	0x59, 0x34, 0xfa, 0x8c, 0xd4, 0xfe, 0xa9, 0x81, 0x7a, 0x55, 0x0e, 0xfe, 0x83, 0xee, 0x8a, 0x0b,
-	0xeb, 0xc5, 0x8f, 0x14, 0xd8, 0x4c, 0xe3, 0x20, 0xbb, 0x80, 0x1d, 0x59, 0xa4, 0xe7, 0x7e, 0x40,
-	0x46, 0x15, 0x4c, 0x73, 0x9a, 0x65, 0x85, 0x9d, 0x27, 0xfe, 0x4f, 0xe4, 0x70, 0x49, 0x49, 0xc2,
-	0x71, 0x6d, 0xe0, 0x55, 0x26, 0xda, 0x83, 0x6a, 0xe7, 0xc9, 0xde, 0x6e, 0x95, 0x55, 0x1d, 0x1e,
-	0xed, 0xd5, 0xe1, 0x51, 0xc5, 0x79, 0x6b, 0x0d, 0xe7, 0x3f, 0x6b, 0xd0, 0x99, 0x86, 0xe7, 0x69,
-	0x1c, 0x10, 0xef, 0xa5, 0x1f, 0x5e, 0xe6, 0xce, 0xd7, 0x4a, 0xe7, 0x77, 0xa0, 0x49, 0x7d, 0x1a,
-	0xe4, 0xb5, 0x24, 0x08, 0xe6, 0x90, 0x47, 0x58, 0xdd, 0x2c, 0xd8, 0x2c, 0xc9, 0x82, 0xad, 0xb2,
-	0xd0, 0x87, 0xf0, 0x1e, 0x9d, 0xa5, 0xf3, 0xb3, 0xd0, 0xf1, 0x03, 0x3b, 0x77, 0x4d, 0x74, 0x32,
-	0xa5, 0xf8, 0x31, 0x2e, 0x66, 0xf5, 0x76, 0x29, 0x2c, 0x26, 0xae, 0x18, 0xad, 0xbd, 0x82, 0xfd,
-	0x9a, 0x8f, 0xde, 0x0f, 0xa0, 0x54, 0xb6, 0xb3, 0x21, 0x2c, 0x06, 0x6c, 0x69, 0xe0, 0x98, 0xb3,
-	0xb5, 0x5f, 0x24, 0x90, 0x2b, 0x7d, 0xfc, 0x8a, 0x4e, 0xb6, 0xd2, 0x73, 0xea, 0xfc, 0x4f, 0xa5,
-	0xe7, 0xe4, 0x43, 0x6c, 0xb3, 0x32, 0xc4, 0x1e, 0x80, 0x1c, 0x93, 0x64, 0x11, 0x85, 0x09, 0xb1,
-	0x69, 0x94, 0x25, 0x14, 0x72, 0x96, 0x15, 0xb1, 0x7d, 0x82, 0x84, 0x89, 0xcd, 0x9f, 0x50, 0xd6,
-	0x7f, 0x48, 0x98, 0xf0, 0x6c, 0x57, 0x46, 0x41, 0x6b, 0x65, 0x14, 0xac, 0x77, 0xf5, 0xf6, 0x3b,
-	0xcf, 0xb2, 0xad, 0x77, 0x99, 0x65, 0xe8, 0x29, 0xb4, 0x13, 0xb1, 0x91, 0xa9, 0x12, 0x6f, 0x6f,
-	0x6a, 0x69, 0x60, 0x75, 0x55, 0x3b, 0xde, 0xc0, 0xb9, 0x28, 0x3a, 0x80, 0x26, 0x5f, 0x75, 0x54,
-	0xe0, 0x3a, 0xb7, 0xd6, 0x76, 0xac, 0x52, 0x43, 0x88, 0x31, 0x79, 0x87, 0x2d, 0x1c, 0xaa, 0xbc,
-	0x2e, 0x5f, 0x5d, 0x64, 0x98, 0x3c, 0x17, 0x43, 0xf7, 0x41, 0x72, 0xa3, 0xf9, 0x3c, 0x0d, 0x7d,
-	0xba, 0x54, 0x3b, 0xac, 0x76, 0x8e, 0x37, 0x70, 0xc9, 0x42, 0x7d, 0xd8, 0xf6, 0xc4, 0xa3, 0xcd,
-	0xd7, 0x50, 0xd5, 0x5d, 0xf7, 0x7e, 0xf5, 0x55, 0x1f, 0x6f, 0xe0, 0x9e, 0xb7, 0x3a, 0x99, 0x8a,
-	0x31, 0xdb, 0xad, 0x8e, 0xd9, 0x87, 0xd0, 0xf1, 0xfc, 0x64, 0x11, 0x38, 0x4b, 0x91, 0xc8, 0x5e,
-	0x56, 0xe1, 0x82, 0xc7, 0x93, 0xb9, 0x80, 0xbd, 0x6c, 0xad, 0xb5, 0x63, 0xf2, 0x5d, 0x4a, 0x12,
-	0x6a, 0x2f, 0xe2, 0x68, 0xe1, 0x5c, 0x38, 0x6c, 0xc4, 0x26, 0xd4, 0xa1, 0x44, 0xdd, 0xe6, 0xee,
-	0x3c, 0xae, 0x64, 0x43, 0x68, 0x60, 0xa1, 0x30, 0x2e, 0xe4, 0x27, 0x4c, 0x1c, 0xef, 0xba, 0xd7,
-	0xfd, 0x46, 0x5f, 0x40, 0x2f, 0xcd, 0x5e, 0xab, 0x1d, 0xf8, 0xe1, 0x65, 0xa2, 0x2a, 0x7c, 0x90,
-	0x54, 0x80, 0xac, 0xbe, 0x66, 0xdc, 0x4d, 0x2b, 0x54, 0xa2, 0xfd, 0x5d, 0x07, 0xb9, 0xbf, 0xd2,
-	0x33, 0x76, 0xf2, 0x95, 0xaf, 0x7f, 0x32, 0xb2, 0x8c, 0x91, 0x95, 0x2f, 0x7d, 0x3d, 0x00, 0xcb,
-	0xf8, 0xc6, 0xb2, 0xc7, 0x2f, 0x75, 0x73, 0xa4, 0xd4, 0x90, 0x0c, 0xed, 0x89, 0x65, 0xf6, 0x5f,
-	0x18, 0x58, 0xa9, 0x23, 0x80, 0xd6, 0xc4, 0xd2, 0xad, 0xe9, 0x44, 0xd9, 0x44, 0x12, 0x34, 0x8d,
-	0xe1, 0xc9, 0x57, 0xa6, 0xd2, 0x40, 0xb7, 0xe1, 0x86, 0x85, 0xf5, 0xd1, 0x44, 0xef, 0x5b, 0xe6,
-	0x09, 0xb3, 0x38, 0x1c, 0xea, 0xa3, 0x81, 0xd2, 0x44, 0xfb, 0xf0, 0x68, 0x72, 0x3a, 0xb1, 0x8c,
-	0xa1, 0x3d, 0x34, 0x26, 0x13, 0xfd, 0xc8, 0x28, 0x6e, 0x1b, 0x63, 0xf3, 0x95, 0x6e, 0x19, 0xf6,
-	0x11, 0x3e, 0x99, 0x8e, 0x95, 0x16, 0xb3, 0x66, 0x0e, 0xf5, 0x23, 0x43, 0x69, 0xb3, 0x23, 0x5f,
-	0x43, 0x95, 0x2d, 0xd4, 0x05, 0x89, 0x19, 0x9b, 0x8e, 0x4c, 0xeb, 0x54, 0x91, 0xd8, 0xa2, 0xba,
-	0x66, 0xee, 0x48, 0x1f, 0x2b, 0x80, 0x6e, 0xc0, 0x36, 0xb3, 0xab, 0xf7, 0x2d, 0x1b, 0x1b, 0x5f,
-	0x4f, 0x8d, 0x89, 0xa5, 0xc8, 0x8c, 0x39, 0x30, 0x27, 0xfd, 0x13, 0x3c, 0xc8, 0xa5, 0x95, 0x0e,
-	0xba, 0x03, 0x37, 0xcd, 0x81, 0x31, 0xb2, 0x4c, 0xeb, 0xd4, 0x7e, 0x65, 0x60, 0xf3, 0xb9, 0xd9,
-	0xd7, 0x99, 0xcf, 0x4a, 0x17, 0x3d, 0x84, 0xdd, 0x35, 0xe3, 0x63, 0x73, 0x34, 0x32, 0x4a, 0xed,
-	0x1e, 0x7a, 0x1f, 0xb4, 0x35, 0x91, 0xe1, 0xd4, 0x9a, 0xea, 0x2f, 0x6d, 0x06, 0x8a, 0x61, 0x4f,
-	0xc7, 0x03, 0xdd, 0x32, 0x94, 0xed, 0x43, 0xa9, 0xe8, 0xc8, 0x87, 0xdd, 0x6f, 0xe5, 0x83, 0x8f,
-	0x3f, 0xcf, 0x73, 0x74, 0xd6, 0xe2, 0xa7, 0x4f, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x18, 0x57,
-	0x25, 0x82, 0x41, 0x0d, 0x00, 0x00,
+	// 2325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x8f, 0xdb, 0xc8,
+	0x11, 0xb6, 0x1e, 0x23, 0x0d, 0x4b, 0x8f, 0xa1, 0x7b, 0xc6, 0x36, 0xd7, 0x59, 0x7b, 0xc7, 0x5c,
+	0x23, 0x3b, 0xc1, 0x06, 0x13, 0xc0, 0x71, 0x82, 0x05, 0xf2, 0x82, 0x46, 0xa2, 0x3d, 0x8c, 0x2d,
+	0x8d, 0x96, 0xa2, 0xec, 0x38, 0x40, 0x40, 0xf4, 0x90, 0x3d, 0x12, 0x33, 0x12, 0xa9, 0x90, 0xcd,
+	0xf1, 0xcc, 0xde, 0x72, 0x08, 0x90, 0x43, 0x0e, 0x41, 0xfe, 0x40, 0xfe, 0x41, 0x7e, 0x49, 0x72,
+	0xd9, 0x20, 0x87, 0x9c, 0x03, 0xe4, 0x17, 0xe4, 0xb6, 0x97, 0xa0, 0x1f, 0x7c, 0x48, 0x1a, 0xd9,
+	0x5e, 0x67, 0x0f, 0x39, 0xb1, 0xeb, 0xeb, 0xae, 0xee, 0xaa, 0xea, 0xea, 0x7a, 0x10, 0x90, 0x3b,
+	0xc5, 0xd4, 0x99, 0x93, 0x38, 0xc6, 0x13, 0x72, 0xb8, 0x88, 0x42, 0x1a, 0xa2, 0x6d, 0xfe, 0x39,
+	0x4d, 0xce, 0xee, 0x36, 0x48, 0x90, 0xcc, 0x63, 0x01, 0xdf, 0x6d, 0xb9, 0x61, 0x40, 0xb1, 0x4b,
+	0x05, 0xa9, 0x7f, 0x06, 0xed, 0x11, 0xf5, 0xdd, 0x73, 0x12, 0xf5, 0x05, 0x37, 0x42, 0x50, 0x9d,
+	0xe2, 0x78, 0xaa, 0x95, 0xf6, 0x4b, 0x07, 0x8a, 0xc5, 0xc7, 0x0c, 0x5b, 0x60, 0xf7, 0x5c, 0x2b,
+	0xef, 0x97, 0x0e, 0xb6, 0x2c, 0x3e, 0xd6, 0x7f, 0x5f, 0x86, 0xa6, 0x39, 0xc7, 0x13, 0x92, 0x32,
+	0x6a, 0x50, 0x5f, 0xe0, 0xab, 0x59, 0x88, 0x3d, 0xce, 0xdb, 0xb4, 0x52, 0x12, 0x7d, 0x02, 0x55,
+	0x7a, 0xb5, 0x20, 0x9c, 0xbd, 0xfd, 0x68, 0xf7, 0x30, 0x95, 0xec, 0x90, 0xf3, 0xdb, 0x57, 0x0b,
+	0x62, 0xf1, 0x05, 0xe8, 0x03, 0xd8, 0xc6, 0xb3, 0xd3, 0x64, 0xee, 0xf8, 0x9e, 0x56, 0xe1, 0xe7,
+	0xd7, 0x39, 0x6d, 0x7a, 0x68, 0x0f, 0xb6, 0x5e, 0xfb, 0x1e, 0x9d, 0x6a, 0xd5, 0xfd, 0xd2, 0x41,
+	0xcb, 0x12, 0x04, 0xba, 0x0d, 0xb5, 0x29, 0xf1, 0x27, 0x53, 0xaa, 0x6d, 0x71, 0x58, 0x52, 0xe8,
+	0xbb, 0x80, 0xe4, 0x46, 0xec, 0x84, 0xd8, 0x71, 0xc3, 0x24, 0xa0, 0x5a, 0x8d, 0xaf, 0x51, 0xc5,
+	0x96, 0x7c, 0xa2, 0xcb, 0x70, 0xf4, 0x53, 0x00, 0x4c, 0x29, 0x76, 0xa7, 0x73, 0x12, 0x50, 0xad,
+	0xbe, 0x5f, 0x3a, 0x68, 0x3c, 0xba, 0x9f, 0x4b, 0xd9, 0xc9, 0xe6, 0x7a, 0x24, 0x76, 0x23, 0x7f,
+	0x41, 0xc3, 0xc8, 0x2a, 0x70, 0xe8, 0x5f, 0x96, 0xa0, 0xd9, 0x49, 0x3c, 0x3f, 0x7c, 0xbb, 0x29,
+	0x1e, 0x2f, 0x99, 0x62, 0xbf, 0x70, 0x48, 0x81, 0x5f, 0x10, 0x05, 0xbb, 0x7c, 0x04, 0x0d, 0x2f,
+	0x89, 0x30, 0xf5, 0xc3, 0xc0, 0x99, 0xc7, 0xdc, 0x34, 0x55, 0x0b, 0x52, 0xa8, 0x1f, 0xa3, 0xbb,
+	0xb0, 0xfd, 0x1a, 0x5f, 0x90, 0xb3, 0x30, 0x9a, 0x73, 0x03, 0x35, 0xad, 0x8c, 0xd6, 0x7f, 0x00,
+	0x4a, 0xb6, 0x1f, 0xba, 0x0d, 0x68, 0x3c, 0x78, 0x36, 0x38, 0x79, 0x39, 0x70, 0x3a, 0xe3, 0x9e,
+	0x79, 0xe2, 0xd8, 0xaf, 0x86, 0x86, 0x7a, 0x03, 0xd5, 0xa1, 0xd2, 0xe9, 0x74, 0xd5, 0x12, 0x1f,
+	0xf4, 0x2d, 0xb5, 0xac, 0xff, 0xae, 0x0c, 0x0d, 0xc3, 0xf3, 0x69, 0xaa, 0xd3, 0x1e, 0x6c, 0xb9,
+	0xb3, 0xd0, 0x3d, 0xe7, 0x1a, 0x55, 0x2d, 0x41, 0x30, 0xcf, 0xa0, 0xe4, 0x92, 0x72, 0x7d, 0x14,
+	0x8b, 0x8f, 0xd1, 0x1d, 0xa8, 0x73, 0x7f, 0xcc, 0x2e, 0xb1, 0xc6, 0x48, 0xd3, 0x43, 0xf7, 0x00,
+	0xa4, 0x8f, 0xb2, 0xb9, 0x2a, 0x9f, 0x53, 0x24, 0x22, 0xae, 0x78, 0x12, 0xe1, 0x40, 0xdc, 0x65,
+	0xd3, 0x12, 0x04, 0xfa, 0x0c, 0x9a, 0x29, 0x13, 0xb7, 0x5c, 0x8d, 0x5b, 0xee, 0x56, 0x6e, 0x39,
+	0x29, 0x20, 0x37, 0x57, 0x63, 0x9e, 0x13, 0xa8, 0x07, 0x4d, 0xe6, 0xec, 0x24, 0xa0, 0x82, 0xb3,
+	0xce, 0x39, 0x1f, 0xe4, 0x9c, 0xdd, 0x29, 0x4e, 0xd5, 0x3b, 0xec, 0x8a, 0x95, 0x62, 0x17, 0x37,
+	0x27, 0xf4, 0xbf, 0x96, 0xa0, 0xd5, 0x23, 0x33, 0x42, 0xc9, 0x9b, 0x2d, 0x51, 0xd0, 0xba, 0xfc,
+	0x06, 0xad, 0x2b, 0x1b, 0xb5, 0xae, 0xbe, 0x49, 0xeb, 0xad, 0x77, 0xd6, 0xfa, 0x1e, 0x80, 0xc7,
+	0xc5, 0xf5, 0x9c, 0xd3, 0x2b, 0x6e, 0x2d, 0xc5, 0x52, 0x24, 0x72, 0x74, 0xa5, 0x9b, 0x80, 0x84,
+	0x36, 0x4f, 0xc2, 0xa8, 0xff, 0x16, 0x95, 0x96, 0x25, 0x2f, 0xaf, 0x48, 0xae, 0xff, 0xa3, 0x0c,
+	0xed, 0x9e, 0x1f, 0xbb, 0x61, 0xe4, 0xa5, 0xfb, 0xb4, 0xa1, 0xec, 0x7b, 0x32, 0x74, 0x94, 0x7d,
+	0x8f, 0xbb, 0x47, 0xea, 0xee, 0x8a, 0x74, 0xe6, 0x0f, 0x41, 0xa1, 0xfe, 0x9c, 0xc4, 0x14, 0xcf,
+	0x17, 0xa9, 0x39, 0x32, 0x00, 0x1d, 0xc0, 0x4e, 0x46, 0x30, 0xf7, 0x23, 0xa9, 0xa3, 0xac, 0xc2,
+	0xec, 0x91, 0xc9, 0x7b, 0xe2, 0xd6, 0x51, 0xac, 0x94, 0x44, 0x3f, 0x84, 0x1a, 0x4e, 0xe8, 0x34,
+	0x8c, 0xb4, 0xda, 0xea, 0x5b, 0x5e, 0x96, 0xb7, 0xc3, 0x57, 0x59, 0x72, 0x35, 0xfa, 0x19, 0x28,
+	0x11, 0x39, 0x23, 0x11, 0x09, 0x5c, 0x22, 0xc3, 0xc0, 0x83, 0x4d, 0xac, 0x56, 0xba, 0xd0, 0xca,
+	0x79, 0x50, 0x0f, 0x1a, 0x79, 0x58, 0x88, 0xb5, 0xed, 0xfd, 0xca, 0x41, 0xe3, 0x91, 0xbe, 0xf1,
+	0xf4, 0x6c, 0xa9, 0x55, 0x64, 0xd3, 0xff, 0x5d, 0x82, 0xbd, 0xeb, 0xe4, 0xbc, 0xce, 0xba, 0x01,
+	0x9e, 0x67, 0xd6, 0x65, 0x63, 0xf4, 0x10, 0x5a, 0x9e, 0xcf, 0xa2, 0xd4, 0xdc, 0x0f, 0x30, 0x0d,
+	0x23, 0x69, 0xe1, 0x65, 0x90, 0xc5, 0x8b, 0xc0, 0x77, 0xcf, 0x39, 0xb7, 0x30, 0x6f, 0x46, 0xb3,
+	0xfb, 0xc1, 0x17, 0x98, 0xe2, 0x68, 0x1c, 0xcd, 0xa4, 0x65, 0x73, 0x00, 0x1d, 0x02, 0x12, 0x04,
+	0x0f, 0xa0, 0x43, 0x19, 0xe5, 0x6a, 0xdc, 0x77, 0xaf, 0x99, 0x61, 0x27, 0xcd, 0x42, 0x17, 0xcf,
+	0xd8, 0x66, 0x75, 0x71, 0x52, 0x4a, 0xeb, 0x21, 0xdc, 0xd9, 0x60, 0x54, 0x26, 0x44, 0xe6, 0x68,
+	0x52, 0xe3, 0x1c, 0x60, 0xb3, 0xee, 0x14, 0x07, 0x01, 0x99, 0x99, 0x99, 0x5f, 0x66, 0x00, 0x73,
+	0x8c, 0x49, 0xe2, 0xcf, 0x3c, 0x33, 0x4b, 0x22, 0x92, 0xd4, 0xff, 0x53, 0x02, 0x6d, 0xd3, 0x1d,
+	0xac, 0x59, 0x77, 0x49, 0x84, 0x55, 0xe7, 0x47, 0x2a, 0x54, 0x92, 0x68, 0x26, 0x0f, 0x60, 0x43,
+	0xa6, 0xe9, 0x99, 0x3f, 0x23, 0x83, 0x82, 0x4d, 0x53, 0x9a, 0xdd, 0x0a, 0x1b, 0x8f, 0xfc, 0x2f,
+	0xc8, 0xd1, 0x15, 0x25, 0x31, 0xb7, 0x6b, 0xd5, 0x5a, 0x06, 0xd1, 0x3e, 0x14, 0x23, 0x8f, 0x7c,
+	0xbb, 0x45, 0xa8, 0x98, 0x58, 0xea, 0xcb, 0x89, 0xa5, 0x68, 0xe7, 0xed, 0x15, 0x3b, 0xff, 0xb3,
+	0x04, 0xcd, 0x71, 0x70, 0x96, 0x44, 0x33, 0xe2, 0x3d, 0xf7, 0x83, 0xf3, 0x54, 0xf8, 0x52, 0x2e,
+	0xfc, 0x1e, 0x6c, 0x51, 0x9f, 0xce, 0x52, 0x5f, 0x12, 0x04, 0x13, 0xc8, 0x93, 0x29, 0xcf, 0x0f,
+	0x03, 0xa9, 0x6c, 0x11, 0x42, 0x9f, 0xc2, 0x4d, 0x3a, 0x4d, 0xe6, 0xa7, 0x01, 0xf6, 0x67, 0x4e,
+	0x2a, 0x9a, 0x88, 0x64, 0x6a, 0x36, 0x31, 0xcc, 0xea, 0x80, 0x9d, 0x7c, 0xb1, 0xc8, 0xe6, 0x22,
+	0x6d, 0xb7, 0x33, 0xf8, 0x25, 0x43, 0xd1, 0x77, 0x20, 0x67, 0x76, 0x64, 0x82, 0x17, 0xc9, 0x3b,
+	0xdf, 0xe0, 0x98, 0xc3, 0xfa, 0x57, 0x00, 0x8d, 0x42, 0x1c, 0xdf, 0x10, 0xc9, 0x96, 0x62, 0x4e,
+	0x99, 0xcf, 0xe4, 0x40, 0x96, 0xc4, 0x2a, 0x85, 0x24, 0xf6, 0x11, 0x34, 0x22, 0x12, 0x2f, 0xc2,
+	0x20, 0x26, 0x0e, 0x0d, 0xe5, 0x85, 0x42, 0x0a, 0xd9, 0x21, 0xab, 0x55, 0x48, 0x10, 0x3b, 0xfc,
+	0x09, 0xc9, 0xf8, 0x43, 0x82, 0x98, 0xdf, 0x76, 0x21, 0x15, 0xd4, 0x96, 0x52, 0xc1, 0x6a, 0x54,
+	0xaf, 0xbf, 0x77, 0x2e, 0xdb, 0x7e, 0x9f, 0x5c, 0x86, 0x1e, 0x43, 0x3d, 0x16, 0xd5, 0x9e, 0xa6,
+	0xf0, 0xf0, 0xa6, 0xe5, 0x1b, 0x2c, 0x97, 0x81, 0xc7, 0x37, 0xac, 0x74, 0x29, 0x3a, 0x84, 0x2d,
+	0x5e, 0x46, 0x69, 0xc0, 0x79, 0x6e, 0xaf, 0xd4, 0x6f, 0x39, 0x87, 0x58, 0xc6, 0xd6, 0x63, 0x56,
+	0x70, 0x68, 0x8d, 0xd5, 0xf5, 0xc5, 0x22, 0x87, 0xad, 0xe7, 0xcb, 0xd0, 0x7d, 0x50, 0xdc, 0x70,
+	0x3e, 0x4f, 0x02, 0x9f, 0x5e, 0x69, 0x4d, 0xe6, 0x3b, 0xc7, 0x37, 0xac, 0x1c, 0x42, 0x5d, 0xd8,
+	0xf1, 0xc4, 0xa3, 0x4d, 0x4b, 0x5c, 0xcd, 0x5d, 0x95, 0x7e, 0xf9, 0x55, 0x1f, 0xdf, 0xb0, 0xda,
+	0xde, 0x12, 0x92, 0xa7, 0xd9, 0x56, 0x31, 0xcd, 0x3e, 0x80, 0xa6, 0xe7, 0xc7, 0x8b, 0x19, 0xbe,
+	0x12, 0x17, 0xd9, 0x96, 0x1e, 0x2e, 0x30, 0x7e, 0x99, 0x0b, 0xd8, 0x97, 0x25, 0xb3, 0x13, 0x91,
+	0xdf, 0x24, 0x24, 0xa6, 0xce, 0x22, 0x0a, 0x17, 0x78, 0x82, 0x59, 0x8a, 0x8d, 0x29, 0xa6, 0x44,
+	0xdb, 0xe1, 0xe2, 0x7c, 0x52, 0xb8, 0x0d, 0xc1, 0x61, 0x09, 0x86, 0x61, 0xb6, 0x7e, 0xc4, 0x96,
+	0x5b, 0xf7, 0xdc, 0x37, 0x4d, 0xa3, 0x9f, 0x40, 0x3b, 0x91, 0xaf, 0xd5, 0x99, 0xf9, 0xc1, 0x79,
+	0xac, 0xa9, 0xfb, 0x95, 0x65, 0x43, 0x16, 0x5f, 0xb3, 0xd5, 0x4a, 0x0a, 0x54, 0xcc, 0xcc, 0x7f,
+	0xe1, 0x7b, 0x24, 0xd4, 0x6e, 0xae, 0x9a, 0xff, 0x05, 0x83, 0x0b, 0xe6, 0xe7, 0xcb, 0xd0, 0x10,
+	0x6e, 0x65, 0xc7, 0x31, 0x75, 0x92, 0x58, 0x9e, 0x8a, 0xf8, 0xa9, 0x1f, 0xae, 0x9f, 0x3a, 0xe2,
+	0xab, 0xf8, 0xd9, 0xbb, 0xc9, 0x1a, 0x16, 0xa3, 0x01, 0xa8, 0x31, 0x3e, 0x23, 0x0e, 0x8d, 0x70,
+	0x10, 0x63, 0x97, 0xc7, 0x8e, 0xdd, 0xd5, 0x74, 0x3a, 0xc2, 0x67, 0xc4, 0xce, 0x17, 0x30, 0x23,
+	0x84, 0x31, 0x9e, 0x1d, 0xdf, 0xb0, 0x76, 0xe2, 0xe5, 0x29, 0xfd, 0x0f, 0x15, 0x68, 0x74, 0x97,
+	0xa2, 0xe0, 0x5e, 0x5a, 0xc4, 0x76, 0x4f, 0x06, 0xb6, 0x31, 0xb0, 0xd3, 0x32, 0xb6, 0x0d, 0x60,
+	0x1b, 0xbf, 0xb0, 0x9d, 0xe1, 0xf3, 0x8e, 0x39, 0x50, 0x4b, 0xa8, 0x01, 0xf5, 0x91, 0x6d, 0x76,
+	0x9f, 0x19, 0x96, 0x5a, 0x46, 0x00, 0xb5, 0x91, 0xdd, 0xb1, 0xc7, 0x23, 0xb5, 0x82, 0x14, 0xd8,
+	0x32, 0xfa, 0x27, 0x3f, 0x37, 0xd5, 0x2a, 0xba, 0x03, 0xbb, 0xb6, 0xd5, 0x19, 0x8c, 0x3a, 0x5d,
+	0xdb, 0x3c, 0x61, 0x3b, 0xf6, 0xfb, 0x9d, 0x41, 0x4f, 0xdd, 0x42, 0x07, 0xf0, 0x70, 0xf4, 0x6a,
+	0x64, 0x1b, 0x7d, 0xa7, 0x6f, 0x8c, 0x46, 0x9d, 0xa7, 0x46, 0x76, 0xda, 0xd0, 0x32, 0x5f, 0x74,
+	0x6c, 0xc3, 0x79, 0x6a, 0x9d, 0x8c, 0x87, 0x6a, 0x8d, 0xed, 0x66, 0xf6, 0x3b, 0x4f, 0x0d, 0xb5,
+	0xce, 0x86, 0xbc, 0xb0, 0x56, 0xb7, 0x51, 0x0b, 0x14, 0xb6, 0xd9, 0x78, 0x60, 0xda, 0xaf, 0x54,
+	0x85, 0x95, 0xde, 0x2b, 0xdb, 0x3d, 0xed, 0x0c, 0x55, 0x40, 0xbb, 0xb0, 0xc3, 0xf6, 0xed, 0x74,
+	0x6d, 0xc7, 0x32, 0x3e, 0x1f, 0x1b, 0x23, 0x5b, 0x6d, 0x30, 0xb0, 0x67, 0x8e, 0xba, 0x27, 0x56,
+	0x2f, 0x5d, 0xad, 0x36, 0xd1, 0x07, 0x70, 0xcb, 0xec, 0x19, 0x03, 0xdb, 0xb4, 0x5f, 0x39, 0x2f,
+	0x0c, 0xcb, 0x7c, 0x62, 0x76, 0x3b, 0x4c, 0x66, 0xb5, 0x85, 0x1e, 0xc0, 0xbd, 0x95, 0xcd, 0x87,
+	0xe6, 0x60, 0x60, 0xe4, 0xdc, 0x6d, 0xf4, 0x6d, 0xd0, 0x57, 0x96, 0xf4, 0xc7, 0xf6, 0xb8, 0xf3,
+	0xdc, 0x61, 0x46, 0x31, 0x9c, 0xf1, 0xb0, 0xd7, 0xb1, 0x0d, 0x75, 0x87, 0x69, 0xf0, 0xc2, 0xec,
+	0x19, 0x27, 0xaa, 0x8a, 0xf6, 0x40, 0x1d, 0x75, 0x9e, 0x18, 0x4e, 0xc1, 0x3e, 0xea, 0xcd, 0x23,
+	0x25, 0x4b, 0x42, 0xfa, 0xaf, 0x60, 0xef, 0xba, 0xee, 0xe8, 0xba, 0x4a, 0x25, 0xf6, 0xbf, 0x20,
+	0x32, 0xf4, 0xf2, 0x31, 0x7b, 0x7b, 0xee, 0x34, 0x09, 0xce, 0x1d, 0xd6, 0x62, 0x12, 0xd6, 0xd5,
+	0x54, 0x0e, 0x9a, 0x56, 0x83, 0x63, 0xc7, 0x1c, 0xd2, 0xff, 0x5c, 0x82, 0x9d, 0x7c, 0xff, 0x2e,
+	0x9b, 0x41, 0x1f, 0x43, 0x2b, 0x2f, 0x96, 0x9c, 0xec, 0x94, 0x66, 0x0e, 0x8a, 0xa2, 0xda, 0x0f,
+	0x3c, 0x72, 0xc9, 0x0f, 0x6c, 0x59, 0x82, 0x60, 0x28, 0x0d, 0x29, 0x16, 0x59, 0xbb, 0x65, 0x09,
+	0x82, 0x65, 0xa5, 0xc2, 0x86, 0x5c, 0xcc, 0x2a, 0x17, 0xb3, 0x9d, 0xc3, 0x2c, 0x47, 0x33, 0x25,
+	0x3c, 0x4c, 0xb1, 0x6c, 0x4f, 0xf8, 0x58, 0x7f, 0x09, 0xb7, 0x57, 0x04, 0x94, 0x8f, 0xfa, 0xdd,
+	0xe4, 0xd4, 0xa0, 0xce, 0x45, 0x23, 0xb1, 0x56, 0xde, 0xaf, 0x1c, 0xb4, 0xac, 0x94, 0xd4, 0xbf,
+	0x2c, 0x43, 0xb3, 0xf8, 0x5e, 0xdf, 0xa7, 0xa7, 0x2c, 0xf2, 0x0b, 0xe2, 0xeb, 0xf4, 0x94, 0x5f,
+	0xaf, 0xe3, 0x66, 0x19, 0x36, 0x4d, 0xcd, 0xb2, 0x1c, 0xcc, 0x81, 0xff, 0xb9, 0xc3, 0x36, 0x40,
+	0xc9, 0xe4, 0x2f, 0xf6, 0xb0, 0xdc, 0x51, 0x0b, 0x3d, 0x6c, 0x7f, 0xf8, 0x58, 0x2d, 0xb1, 0x87,
+	0xf7, 0xf9, 0xd8, 0xec, 0x3e, 0xb3, 0xcd, 0xbe, 0xa1, 0x96, 0xd1, 0x36, 0x54, 0x5f, 0x1a, 0x47,
+	0x7d, 0xb5, 0xa2, 0xff, 0xad, 0x0c, 0x68, 0x3d, 0x88, 0x5d, 0x53, 0x0e, 0xfd, 0x58, 0xf4, 0x16,
+	0xd8, 0x15, 0x9d, 0xed, 0x52, 0x11, 0x2f, 0x18, 0x65, 0x84, 0x67, 0xfc, 0xc3, 0x88, 0x5c, 0xf8,
+	0xe4, 0xb5, 0x95, 0xb2, 0xa0, 0xa3, 0x62, 0x42, 0xab, 0x70, 0xfe, 0x87, 0xeb, 0xfc, 0x72, 0x41,
+	0x71, 0x87, 0x9c, 0x0d, 0x19, 0x50, 0x97, 0x15, 0x2d, 0xb7, 0x7f, 0xe3, 0xd1, 0xa7, 0x1b, 0x77,
+	0xe8, 0x8a, 0x75, 0xcb, 0xa2, 0x08, 0x8c, 0x29, 0x92, 0xe6, 0xcc, 0xad, 0xeb, 0x15, 0x91, 0xfe,
+	0xb1, 0xc4, 0x2d, 0x59, 0xd0, 0x7d, 0x80, 0x24, 0xb8, 0x20, 0x91, 0x7f, 0xe6, 0x13, 0x51, 0xcb,
+	0x6c, 0x5b, 0x05, 0x44, 0xff, 0x53, 0x09, 0xb4, 0x4d, 0xe6, 0x60, 0xdd, 0xe3, 0x22, 0x39, 0x9d,
+	0xf9, 0xae, 0x73, 0x4e, 0xae, 0xd2, 0x1a, 0x5e, 0x20, 0xcf, 0xc8, 0xd5, 0x5a, 0xea, 0x2d, 0xaf,
+	0xa7, 0xde, 0xb7, 0x97, 0x9f, 0x08, 0xaa, 0xbe, 0x1b, 0x06, 0xb2, 0xe2, 0xe4, 0x63, 0xfd, 0xef,
+	0x25, 0xb8, 0xbb, 0xd9, 0xc6, 0x3c, 0xec, 0xa4, 0x78, 0xfe, 0x2c, 0x1b, 0x19, 0x66, 0x7a, 0xdf,
+	0x8c, 0x68, 0x1f, 0x43, 0x6b, 0x4e, 0xe6, 0xa7, 0x24, 0x4a, 0xff, 0x3e, 0x89, 0x67, 0xd4, 0x94,
+	0xa0, 0xf8, 0xf3, 0xc4, 0xaa, 0xd5, 0x70, 0x16, 0x46, 0xb2, 0x82, 0x14, 0x44, 0xa6, 0x55, 0xad,
+	0xa0, 0xd5, 0x57, 0x25, 0x78, 0xf0, 0xd6, 0x7b, 0x17, 0x31, 0x95, 0xa3, 0x4e, 0x92, 0x14, 0x94,
+	0x13, 0xd8, 0x38, 0xf1, 0x79, 0x68, 0x24, 0xf3, 0xf0, 0xd7, 0x7e, 0x5a, 0xe9, 0x73, 0x62, 0x4d,
+	0xe5, 0xca, 0x5b, 0x55, 0xae, 0xae, 0xab, 0x7c, 0xbd, 0x36, 0x4b, 0xaf, 0xa1, 0xf6, 0x5e, 0xaf,
+	0x41, 0xff, 0x4b, 0xe6, 0x68, 0xeb, 0xee, 0xba, 0xf2, 0x9b, 0x62, 0xad, 0x59, 0xdc, 0xf8, 0x63,
+	0xe6, 0x76, 0xf6, 0x9b, 0x40, 0xfe, 0xa6, 0x12, 0x14, 0x3a, 0x84, 0x5d, 0x31, 0x72, 0x96, 0x4c,
+	0x22, 0x14, 0xbe, 0x29, 0xa6, 0x7a, 0x05, 0xc3, 0xa4, 0xed, 0xc3, 0x56, 0xde, 0x3e, 0xe8, 0xbf,
+	0xad, 0xc0, 0x9d, 0x0d, 0x15, 0x0e, 0xeb, 0x1c, 0xdc, 0x29, 0xf6, 0x83, 0x54, 0xda, 0x2a, 0x7f,
+	0xae, 0x7e, 0x20, 0x3c, 0x8f, 0x57, 0x4e, 0xd8, 0xf3, 0x22, 0x12, 0xc7, 0xa9, 0xe7, 0x31, 0xac,
+	0x23, 0x20, 0x96, 0x5a, 0x69, 0x28, 0x25, 0x2e, 0xd3, 0x90, 0x19, 0xfd, 0x02, 0xcf, 0x92, 0x54,
+	0x3e, 0x41, 0x5c, 0x97, 0xab, 0x58, 0x88, 0x0e, 0x17, 0x44, 0xc4, 0x77, 0xd9, 0x4e, 0xe5, 0x00,
+	0xba, 0x0f, 0xfc, 0x18, 0x87, 0x5e, 0x3a, 0x13, 0x1c, 0xcb, 0x5e, 0x5d, 0x61, 0x90, 0x7d, 0xf9,
+	0x14, 0xc7, 0x4c, 0xea, 0x53, 0x1c, 0x13, 0x3e, 0x29, 0x1a, 0xcc, 0x3a, 0xa3, 0xd9, 0xd4, 0xb7,
+	0x40, 0x99, 0xe0, 0xd8, 0x59, 0x44, 0xbe, 0x4b, 0x78, 0x63, 0xa1, 0x58, 0xdb, 0x13, 0x1c, 0x0f,
+	0x19, 0x9d, 0x4e, 0xd2, 0xf0, 0x9c, 0x04, 0x1a, 0x64, 0x93, 0x36, 0xa3, 0x59, 0xee, 0x8d, 0xc8,
+	0x59, 0x12, 0x78, 0x4e, 0x44, 0x5c, 0xe2, 0x5f, 0x90, 0x88, 0x37, 0x0d, 0x8a, 0xd5, 0x16, 0xb0,
+	0x25, 0x51, 0xa6, 0x65, 0x10, 0xb2, 0xdf, 0x32, 0x4d, 0xa1, 0x25, 0x27, 0x44, 0xd2, 0x89, 0x48,
+	0x3c, 0x0d, 0x67, 0x1e, 0x2f, 0xec, 0x5b, 0x56, 0x0e, 0xe8, 0x7f, 0x2c, 0x83, 0xb6, 0x72, 0x07,
+	0x23, 0x7f, 0x12, 0x60, 0x9a, 0x44, 0xdf, 0xf4, 0x4f, 0xbc, 0xd5, 0xc6, 0xae, 0xfa, 0xce, 0x8d,
+	0xdd, 0xbe, 0xbc, 0x71, 0x7a, 0xc9, 0xeb, 0x20, 0x79, 0x61, 0x20, 0xec, 0xce, 0xca, 0x20, 0xe6,
+	0xa6, 0xb1, 0x3f, 0x09, 0x48, 0x94, 0x36, 0x93, 0x82, 0x62, 0xca, 0xc7, 0xa9, 0x3a, 0xf2, 0x6f,
+	0x80, 0x12, 0x17, 0xf5, 0x13, 0xfd, 0xce, 0x76, 0xa1, 0xdf, 0xd1, 0xff, 0x55, 0x5a, 0x33, 0x89,
+	0x71, 0x49, 0xdc, 0x24, 0x7b, 0xbe, 0xff, 0x0f, 0x26, 0x61, 0xdd, 0x7f, 0x2e, 0x5f, 0x6e, 0x16,
+	0xf6, 0x0f, 0x30, 0xc7, 0xb9, 0x6d, 0x32, 0x2d, 0x6b, 0x05, 0x2d, 0x8f, 0x5a, 0xbf, 0x6c, 0x1c,
+	0x7e, 0xef, 0x47, 0xe9, 0x41, 0xa7, 0x35, 0x3e, 0xfa, 0xfe, 0x7f, 0x07, 0x00, 0xe3, 0xaa, 0x4a,
+	0x25, 0x26, 0x19, 0x00, 0x00,
 }
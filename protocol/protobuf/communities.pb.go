@@ -28,6 +28,7 @@ const (
 	CommunityMember_ROLE_MANAGE_USERS     CommunityMember_Roles = 2
 	CommunityMember_ROLE_MODERATE_CONTENT CommunityMember_Roles = 3
 	CommunityMember_ROLE_ADMIN            CommunityMember_Roles = 4
+	CommunityMember_ROLE_TOKEN_MASTER     CommunityMember_Roles = 5
 )
 
 var CommunityMember_Roles_name = map[int32]string{
@@ -36,6 +37,7 @@ var CommunityMember_Roles_name = map[int32]string{
 	2: "ROLE_MANAGE_USERS",
 	3: "ROLE_MODERATE_CONTENT",
 	4: "ROLE_ADMIN",
+	5: "ROLE_TOKEN_MASTER",
 }
 
 var CommunityMember_Roles_value = map[string]int32{
@@ -44,6 +46,7 @@ var CommunityMember_Roles_value = map[string]int32{
 	"ROLE_MANAGE_USERS":     2,
 	"ROLE_MODERATE_CONTENT": 3,
 	"ROLE_ADMIN":            4,
+	"ROLE_TOKEN_MASTER":     5,
 }
 
 func (x CommunityMember_Roles) String() string {
@@ -54,6 +57,68 @@ func (CommunityMember_Roles) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f937943d74c1cd8b, []int{1, 0}
 }
 
+type CommunityMember_Permissions int32
+
+const (
+	CommunityMember_PERMISSION_NONE            CommunityMember_Permissions = 0
+	CommunityMember_PERMISSION_PIN_MESSAGES    CommunityMember_Permissions = 1
+	CommunityMember_PERMISSION_DELETE_MESSAGES CommunityMember_Permissions = 2
+	CommunityMember_PERMISSION_MINT_TOKENS     CommunityMember_Permissions = 3
+	CommunityMember_PERMISSION_AIRDROP_TOKENS  CommunityMember_Permissions = 4
+)
+
+var CommunityMember_Permissions_name = map[int32]string{
+	0: "PERMISSION_NONE",
+	1: "PERMISSION_PIN_MESSAGES",
+	2: "PERMISSION_DELETE_MESSAGES",
+	3: "PERMISSION_MINT_TOKENS",
+	4: "PERMISSION_AIRDROP_TOKENS",
+}
+
+var CommunityMember_Permissions_value = map[string]int32{
+	"PERMISSION_NONE":            0,
+	"PERMISSION_PIN_MESSAGES":    1,
+	"PERMISSION_DELETE_MESSAGES": 2,
+	"PERMISSION_MINT_TOKENS":     3,
+	"PERMISSION_AIRDROP_TOKENS":  4,
+}
+
+func (x CommunityMember_Permissions) String() string {
+	return proto.EnumName(CommunityMember_Permissions_name, int32(x))
+}
+
+func (CommunityMember_Permissions) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{1, 1}
+}
+
+type CommunityMember_Capabilities int32
+
+const (
+	CommunityMember_CAPABILITY_NONE               CommunityMember_Capabilities = 0
+	CommunityMember_CAPABILITY_COMPRESSION        CommunityMember_Capabilities = 1
+	CommunityMember_CAPABILITY_DESCRIPTION_DELTAS CommunityMember_Capabilities = 2
+)
+
+var CommunityMember_Capabilities_name = map[int32]string{
+	0: "CAPABILITY_NONE",
+	1: "CAPABILITY_COMPRESSION",
+	2: "CAPABILITY_DESCRIPTION_DELTAS",
+}
+
+var CommunityMember_Capabilities_value = map[string]int32{
+	"CAPABILITY_NONE":               0,
+	"CAPABILITY_COMPRESSION":        1,
+	"CAPABILITY_DESCRIPTION_DELTAS": 2,
+}
+
+func (x CommunityMember_Capabilities) String() string {
+	return proto.EnumName(CommunityMember_Capabilities_name, int32(x))
+}
+
+func (CommunityMember_Capabilities) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{1, 2}
+}
+
 type CommunityPermissions_Access int32
 
 const (
@@ -119,6 +184,43 @@ func (CommunityTokenPermission_Type) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f937943d74c1cd8b, []int{5, 0}
 }
 
+type CommunityDescriptionChange_Type int32
+
+const (
+	CommunityDescriptionChange_UNKNOWN_CHANGE   CommunityDescriptionChange_Type = 0
+	CommunityDescriptionChange_MEMBER           CommunityDescriptionChange_Type = 1
+	CommunityDescriptionChange_CHAT             CommunityDescriptionChange_Type = 2
+	CommunityDescriptionChange_CATEGORY         CommunityDescriptionChange_Type = 3
+	CommunityDescriptionChange_TOKEN_PERMISSION CommunityDescriptionChange_Type = 4
+	CommunityDescriptionChange_DESCRIPTION      CommunityDescriptionChange_Type = 5
+)
+
+var CommunityDescriptionChange_Type_name = map[int32]string{
+	0: "UNKNOWN_CHANGE",
+	1: "MEMBER",
+	2: "CHAT",
+	3: "CATEGORY",
+	4: "TOKEN_PERMISSION",
+	5: "DESCRIPTION",
+}
+
+var CommunityDescriptionChange_Type_value = map[string]int32{
+	"UNKNOWN_CHANGE":   0,
+	"MEMBER":           1,
+	"CHAT":             2,
+	"CATEGORY":         3,
+	"TOKEN_PERMISSION": 4,
+	"DESCRIPTION":      5,
+}
+
+func (x CommunityDescriptionChange_Type) String() string {
+	return proto.EnumName(CommunityDescriptionChange_Type_name, int32(x))
+}
+
+func (CommunityDescriptionChange_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{31, 0}
+}
+
 type Grant struct {
 	CommunityId          []byte   `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
 	MemberId             []byte   `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
@@ -183,11 +285,12 @@ func (m *Grant) GetClock() uint64 {
 }
 
 type CommunityMember struct {
-	Roles                []CommunityMember_Roles `protobuf:"varint,1,rep,packed,name=roles,proto3,enum=protobuf.CommunityMember_Roles" json:"roles,omitempty"`
-	RevealedAccounts     []*RevealedAccount      `protobuf:"bytes,2,rep,name=revealed_accounts,json=revealedAccounts,proto3" json:"revealed_accounts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Roles                []CommunityMember_Roles        `protobuf:"varint,1,rep,packed,name=roles,proto3,enum=protobuf.CommunityMember_Roles" json:"roles,omitempty"`
+	RevealedAccounts     []*RevealedAccount             `protobuf:"bytes,2,rep,name=revealed_accounts,json=revealedAccounts,proto3" json:"revealed_accounts,omitempty"`
+	Capabilities         []CommunityMember_Capabilities `protobuf:"varint,3,rep,packed,name=capabilities,proto3,enum=protobuf.CommunityMember_Capabilities" json:"capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *CommunityMember) Reset()         { *m = CommunityMember{} }
@@ -229,6 +332,13 @@ func (m *CommunityMember) GetRevealedAccounts() []*RevealedAccount {
 	return nil
 }
 
+func (m *CommunityMember) GetCapabilities() []CommunityMember_Capabilities {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
 type CommunityTokenMetadata struct {
 	ContractAddresses    map[uint64]string  `protobuf:"bytes,1,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Description          string             `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -555,6 +665,11 @@ type CommunityDescription struct {
 	TokenPermissions        map[string]*CommunityTokenPermission `protobuf:"bytes,15,rep,name=token_permissions,json=tokenPermissions,proto3" json:"token_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	CommunityTokensMetadata []*CommunityTokenMetadata            `protobuf:"bytes,16,rep,name=community_tokens_metadata,json=communityTokensMetadata,proto3" json:"community_tokens_metadata,omitempty"`
 	ActiveMembersCount      uint64                               `protobuf:"varint,17,opt,name=active_members_count,json=activeMembersCount,proto3" json:"active_members_count,omitempty"`
+	RolePermissions         []*CommunityRolePermissions          `protobuf:"bytes,18,rep,name=role_permissions,json=rolePermissions,proto3" json:"role_permissions,omitempty"`
+	MembershipPayment       *CommunityMembershipPayment          `protobuf:"bytes,19,opt,name=membership_payment,json=membershipPayment,proto3" json:"membership_payment,omitempty"`
+	EmojiPacks              []*CommunityEmojiPack                `protobuf:"bytes,20,rep,name=emoji_packs,json=emojiPacks,proto3" json:"emoji_packs,omitempty"`
+	Blocklist               []*CommunityBlocklistEntry           `protobuf:"bytes,21,rep,name=blocklist,proto3" json:"blocklist,omitempty"`
+	SnapshotSpaces          []string                             `protobuf:"bytes,22,rep,name=snapshot_spaces,json=snapshotSpaces,proto3" json:"snapshot_spaces,omitempty"`
 	XXX_NoUnkeyedLiteral    struct{}                             `json:"-"`
 	XXX_unrecognized        []byte                               `json:"-"`
 	XXX_sizecache           int32                                `json:"-"`
@@ -697,6 +812,202 @@ func (m *CommunityDescription) GetActiveMembersCount() uint64 {
 	return 0
 }
 
+func (m *CommunityDescription) GetRolePermissions() []*CommunityRolePermissions {
+	if m != nil {
+		return m.RolePermissions
+	}
+	return nil
+}
+
+func (m *CommunityDescription) GetMembershipPayment() *CommunityMembershipPayment {
+	if m != nil {
+		return m.MembershipPayment
+	}
+	return nil
+}
+
+func (m *CommunityDescription) GetEmojiPacks() []*CommunityEmojiPack {
+	if m != nil {
+		return m.EmojiPacks
+	}
+	return nil
+}
+
+func (m *CommunityDescription) GetBlocklist() []*CommunityBlocklistEntry {
+	if m != nil {
+		return m.Blocklist
+	}
+	return nil
+}
+
+func (m *CommunityDescription) GetSnapshotSpaces() []string {
+	if m != nil {
+		return m.SnapshotSpaces
+	}
+	return nil
+}
+
+// ERC20 payment required to join a community, the amount is in the smallest
+// unit of the token
+type CommunityMembershipPayment struct {
+	ChainId              uint64   `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress      string   `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Amount               string   `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Recipient            string   `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityMembershipPayment) Reset()         { *m = CommunityMembershipPayment{} }
+func (m *CommunityMembershipPayment) String() string { return proto.CompactTextString(m) }
+func (*CommunityMembershipPayment) ProtoMessage()    {}
+func (*CommunityMembershipPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{7}
+}
+
+func (m *CommunityMembershipPayment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityMembershipPayment.Unmarshal(m, b)
+}
+func (m *CommunityMembershipPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityMembershipPayment.Marshal(b, m, deterministic)
+}
+func (m *CommunityMembershipPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityMembershipPayment.Merge(m, src)
+}
+func (m *CommunityMembershipPayment) XXX_Size() int {
+	return xxx_messageInfo_CommunityMembershipPayment.Size(m)
+}
+func (m *CommunityMembershipPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityMembershipPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityMembershipPayment proto.InternalMessageInfo
+
+func (m *CommunityMembershipPayment) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *CommunityMembershipPayment) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *CommunityMembershipPayment) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *CommunityMembershipPayment) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type CommunityMembershipPaymentProof struct {
+	ChainId              uint64   `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TransactionHash      string   `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityMembershipPaymentProof) Reset()         { *m = CommunityMembershipPaymentProof{} }
+func (m *CommunityMembershipPaymentProof) String() string { return proto.CompactTextString(m) }
+func (*CommunityMembershipPaymentProof) ProtoMessage()    {}
+func (*CommunityMembershipPaymentProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{8}
+}
+
+func (m *CommunityMembershipPaymentProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityMembershipPaymentProof.Unmarshal(m, b)
+}
+func (m *CommunityMembershipPaymentProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityMembershipPaymentProof.Marshal(b, m, deterministic)
+}
+func (m *CommunityMembershipPaymentProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityMembershipPaymentProof.Merge(m, src)
+}
+func (m *CommunityMembershipPaymentProof) XXX_Size() int {
+	return xxx_messageInfo_CommunityMembershipPaymentProof.Size(m)
+}
+func (m *CommunityMembershipPaymentProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityMembershipPaymentProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityMembershipPaymentProof proto.InternalMessageInfo
+
+func (m *CommunityMembershipPaymentProof) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *CommunityMembershipPaymentProof) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
+// Permissions granted to the members holding a role, owners and admins
+// are granted all of them
+type CommunityRolePermissions struct {
+	Role                 CommunityMember_Roles         `protobuf:"varint,1,opt,name=role,proto3,enum=protobuf.CommunityMember_Roles" json:"role,omitempty"`
+	Permissions          []CommunityMember_Permissions `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=protobuf.CommunityMember_Permissions" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *CommunityRolePermissions) Reset()         { *m = CommunityRolePermissions{} }
+func (m *CommunityRolePermissions) String() string { return proto.CompactTextString(m) }
+func (*CommunityRolePermissions) ProtoMessage()    {}
+func (*CommunityRolePermissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{9}
+}
+
+func (m *CommunityRolePermissions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityRolePermissions.Unmarshal(m, b)
+}
+func (m *CommunityRolePermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityRolePermissions.Marshal(b, m, deterministic)
+}
+func (m *CommunityRolePermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityRolePermissions.Merge(m, src)
+}
+func (m *CommunityRolePermissions) XXX_Size() int {
+	return xxx_messageInfo_CommunityRolePermissions.Size(m)
+}
+func (m *CommunityRolePermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityRolePermissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityRolePermissions proto.InternalMessageInfo
+
+func (m *CommunityRolePermissions) GetRole() CommunityMember_Roles {
+	if m != nil {
+		return m.Role
+	}
+	return CommunityMember_ROLE_NONE
+}
+
+func (m *CommunityRolePermissions) GetPermissions() []CommunityMember_Permissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
 type CommunityAdminSettings struct {
 	PinMessageAllMembersEnabled bool     `protobuf:"varint,1,opt,name=pin_message_all_members_enabled,json=pinMessageAllMembersEnabled,proto3" json:"pin_message_all_members_enabled,omitempty"`
 	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
@@ -708,7 +1019,7 @@ func (m *CommunityAdminSettings) Reset()         { *m = CommunityAdminSettings{}
 func (m *CommunityAdminSettings) String() string { return proto.CompactTextString(m) }
 func (*CommunityAdminSettings) ProtoMessage()    {}
 func (*CommunityAdminSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{7}
+	return fileDescriptor_f937943d74c1cd8b, []int{10}
 }
 
 func (m *CommunityAdminSettings) XXX_Unmarshal(b []byte) error {
@@ -751,7 +1062,7 @@ func (m *CommunityChat) Reset()         { *m = CommunityChat{} }
 func (m *CommunityChat) String() string { return proto.CompactTextString(m) }
 func (*CommunityChat) ProtoMessage()    {}
 func (*CommunityChat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{8}
+	return fileDescriptor_f937943d74c1cd8b, []int{11}
 }
 
 func (m *CommunityChat) XXX_Unmarshal(b []byte) error {
@@ -820,7 +1131,7 @@ func (m *CommunityCategory) Reset()         { *m = CommunityCategory{} }
 func (m *CommunityCategory) String() string { return proto.CompactTextString(m) }
 func (*CommunityCategory) ProtoMessage()    {}
 func (*CommunityCategory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{9}
+	return fileDescriptor_f937943d74c1cd8b, []int{12}
 }
 
 func (m *CommunityCategory) XXX_Unmarshal(b []byte) error {
@@ -876,7 +1187,7 @@ func (m *CommunityInvitation) Reset()         { *m = CommunityInvitation{} }
 func (m *CommunityInvitation) String() string { return proto.CompactTextString(m) }
 func (*CommunityInvitation) ProtoMessage()    {}
 func (*CommunityInvitation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{10}
+	return fileDescriptor_f937943d74c1cd8b, []int{13}
 }
 
 func (m *CommunityInvitation) XXX_Unmarshal(b []byte) error {
@@ -929,6 +1240,7 @@ type RevealedAccount struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 	ChainIds             []uint64 `protobuf:"varint,3,rep,packed,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
+	IsAirdropAddress     bool     `protobuf:"varint,4,opt,name=is_airdrop_address,json=isAirdropAddress,proto3" json:"is_airdrop_address,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -938,7 +1250,7 @@ func (m *RevealedAccount) Reset()         { *m = RevealedAccount{} }
 func (m *RevealedAccount) String() string { return proto.CompactTextString(m) }
 func (*RevealedAccount) ProtoMessage()    {}
 func (*RevealedAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{11}
+	return fileDescriptor_f937943d74c1cd8b, []int{14}
 }
 
 func (m *RevealedAccount) XXX_Unmarshal(b []byte) error {
@@ -980,23 +1292,32 @@ func (m *RevealedAccount) GetChainIds() []uint64 {
 	return nil
 }
 
+func (m *RevealedAccount) GetIsAirdropAddress() bool {
+	if m != nil {
+		return m.IsAirdropAddress
+	}
+	return false
+}
+
 type CommunityRequestToJoin struct {
-	Clock                uint64             `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
-	EnsName              string             `protobuf:"bytes,2,opt,name=ens_name,json=ensName,proto3" json:"ens_name,omitempty"`
-	ChatId               string             `protobuf:"bytes,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
-	CommunityId          []byte             `protobuf:"bytes,4,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
-	DisplayName          string             `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	RevealedAccounts     []*RevealedAccount `protobuf:"bytes,6,rep,name=revealed_accounts,json=revealedAccounts,proto3" json:"revealed_accounts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Clock                uint64                           `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	EnsName              string                           `protobuf:"bytes,2,opt,name=ens_name,json=ensName,proto3" json:"ens_name,omitempty"`
+	ChatId               string                           `protobuf:"bytes,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	CommunityId          []byte                           `protobuf:"bytes,4,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	DisplayName          string                           `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	RevealedAccounts     []*RevealedAccount               `protobuf:"bytes,6,rep,name=revealed_accounts,json=revealedAccounts,proto3" json:"revealed_accounts,omitempty"`
+	MembershipPayment    *CommunityMembershipPaymentProof `protobuf:"bytes,7,opt,name=membership_payment,json=membershipPayment,proto3" json:"membership_payment,omitempty"`
+	Profile              *CommunityMemberProfile          `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
 func (m *CommunityRequestToJoin) Reset()         { *m = CommunityRequestToJoin{} }
 func (m *CommunityRequestToJoin) String() string { return proto.CompactTextString(m) }
 func (*CommunityRequestToJoin) ProtoMessage()    {}
 func (*CommunityRequestToJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{12}
+	return fileDescriptor_f937943d74c1cd8b, []int{15}
 }
 
 func (m *CommunityRequestToJoin) XXX_Unmarshal(b []byte) error {
@@ -1059,6 +1380,20 @@ func (m *CommunityRequestToJoin) GetRevealedAccounts() []*RevealedAccount {
 	return nil
 }
 
+func (m *CommunityRequestToJoin) GetMembershipPayment() *CommunityMembershipPaymentProof {
+	if m != nil {
+		return m.MembershipPayment
+	}
+	return nil
+}
+
+func (m *CommunityRequestToJoin) GetProfile() *CommunityMemberProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
 type CommunityCancelRequestToJoin struct {
 	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	EnsName              string   `protobuf:"bytes,2,opt,name=ens_name,json=ensName,proto3" json:"ens_name,omitempty"`
@@ -1074,7 +1409,7 @@ func (m *CommunityCancelRequestToJoin) Reset()         { *m = CommunityCancelReq
 func (m *CommunityCancelRequestToJoin) String() string { return proto.CompactTextString(m) }
 func (*CommunityCancelRequestToJoin) ProtoMessage()    {}
 func (*CommunityCancelRequestToJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{13}
+	return fileDescriptor_f937943d74c1cd8b, []int{16}
 }
 
 func (m *CommunityCancelRequestToJoin) XXX_Unmarshal(b []byte) error {
@@ -1137,6 +1472,7 @@ type CommunityRequestToJoinResponse struct {
 	Grant                []byte                `protobuf:"bytes,4,opt,name=grant,proto3" json:"grant,omitempty"`
 	CommunityId          []byte                `protobuf:"bytes,5,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
 	MagnetUri            string                `protobuf:"bytes,6,opt,name=magnet_uri,json=magnetUri,proto3" json:"magnet_uri,omitempty"`
+	ArchiveUris          []string              `protobuf:"bytes,7,rep,name=archive_uris,json=archiveUris,proto3" json:"archive_uris,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1146,7 +1482,7 @@ func (m *CommunityRequestToJoinResponse) Reset()         { *m = CommunityRequest
 func (m *CommunityRequestToJoinResponse) String() string { return proto.CompactTextString(m) }
 func (*CommunityRequestToJoinResponse) ProtoMessage()    {}
 func (*CommunityRequestToJoinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{14}
+	return fileDescriptor_f937943d74c1cd8b, []int{17}
 }
 
 func (m *CommunityRequestToJoinResponse) XXX_Unmarshal(b []byte) error {
@@ -1209,6 +1545,13 @@ func (m *CommunityRequestToJoinResponse) GetMagnetUri() string {
 	return ""
 }
 
+func (m *CommunityRequestToJoinResponse) GetArchiveUris() []string {
+	if m != nil {
+		return m.ArchiveUris
+	}
+	return nil
+}
+
 type CommunityRequestToLeave struct {
 	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	CommunityId          []byte   `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
@@ -1221,7 +1564,7 @@ func (m *CommunityRequestToLeave) Reset()         { *m = CommunityRequestToLeave
 func (m *CommunityRequestToLeave) String() string { return proto.CompactTextString(m) }
 func (*CommunityRequestToLeave) ProtoMessage()    {}
 func (*CommunityRequestToLeave) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{15}
+	return fileDescriptor_f937943d74c1cd8b, []int{18}
 }
 
 func (m *CommunityRequestToLeave) XXX_Unmarshal(b []byte) error {
@@ -1259,6 +1602,7 @@ func (m *CommunityRequestToLeave) GetCommunityId() []byte {
 type CommunityMessageArchiveMagnetlink struct {
 	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	MagnetUri            string   `protobuf:"bytes,2,opt,name=magnet_uri,json=magnetUri,proto3" json:"magnet_uri,omitempty"`
+	ArchiveUris          []string `protobuf:"bytes,3,rep,name=archive_uris,json=archiveUris,proto3" json:"archive_uris,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1268,7 +1612,7 @@ func (m *CommunityMessageArchiveMagnetlink) Reset()         { *m = CommunityMess
 func (m *CommunityMessageArchiveMagnetlink) String() string { return proto.CompactTextString(m) }
 func (*CommunityMessageArchiveMagnetlink) ProtoMessage()    {}
 func (*CommunityMessageArchiveMagnetlink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{16}
+	return fileDescriptor_f937943d74c1cd8b, []int{19}
 }
 
 func (m *CommunityMessageArchiveMagnetlink) XXX_Unmarshal(b []byte) error {
@@ -1303,6 +1647,68 @@ func (m *CommunityMessageArchiveMagnetlink) GetMagnetUri() string {
 	return ""
 }
 
+func (m *CommunityMessageArchiveMagnetlink) GetArchiveUris() []string {
+	if m != nil {
+		return m.ArchiveUris
+	}
+	return nil
+}
+
+type DeleteCommunityMemberMessages struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	CommunityId          []byte   `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	MemberId             string   `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCommunityMemberMessages) Reset()         { *m = DeleteCommunityMemberMessages{} }
+func (m *DeleteCommunityMemberMessages) String() string { return proto.CompactTextString(m) }
+func (*DeleteCommunityMemberMessages) ProtoMessage()    {}
+func (*DeleteCommunityMemberMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{20}
+}
+
+func (m *DeleteCommunityMemberMessages) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCommunityMemberMessages.Unmarshal(m, b)
+}
+func (m *DeleteCommunityMemberMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCommunityMemberMessages.Marshal(b, m, deterministic)
+}
+func (m *DeleteCommunityMemberMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCommunityMemberMessages.Merge(m, src)
+}
+func (m *DeleteCommunityMemberMessages) XXX_Size() int {
+	return xxx_messageInfo_DeleteCommunityMemberMessages.Size(m)
+}
+func (m *DeleteCommunityMemberMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCommunityMemberMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCommunityMemberMessages proto.InternalMessageInfo
+
+func (m *DeleteCommunityMemberMessages) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *DeleteCommunityMemberMessages) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *DeleteCommunityMemberMessages) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
 type WakuMessage struct {
 	Sig                  []byte   `protobuf:"bytes,1,opt,name=sig,proto3" json:"sig,omitempty"`
 	Timestamp            uint64   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -1320,7 +1726,7 @@ func (m *WakuMessage) Reset()         { *m = WakuMessage{} }
 func (m *WakuMessage) String() string { return proto.CompactTextString(m) }
 func (*WakuMessage) ProtoMessage()    {}
 func (*WakuMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{17}
+	return fileDescriptor_f937943d74c1cd8b, []int{21}
 }
 
 func (m *WakuMessage) XXX_Unmarshal(b []byte) error {
@@ -1404,7 +1810,7 @@ func (m *WakuMessageArchiveMetadata) Reset()         { *m = WakuMessageArchiveMe
 func (m *WakuMessageArchiveMetadata) String() string { return proto.CompactTextString(m) }
 func (*WakuMessageArchiveMetadata) ProtoMessage()    {}
 func (*WakuMessageArchiveMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{18}
+	return fileDescriptor_f937943d74c1cd8b, []int{22}
 }
 
 func (m *WakuMessageArchiveMetadata) XXX_Unmarshal(b []byte) error {
@@ -1466,7 +1872,7 @@ func (m *WakuMessageArchive) Reset()         { *m = WakuMessageArchive{} }
 func (m *WakuMessageArchive) String() string { return proto.CompactTextString(m) }
 func (*WakuMessageArchive) ProtoMessage()    {}
 func (*WakuMessageArchive) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{19}
+	return fileDescriptor_f937943d74c1cd8b, []int{23}
 }
 
 func (m *WakuMessageArchive) XXX_Unmarshal(b []byte) error {
@@ -1523,7 +1929,7 @@ func (m *WakuMessageArchiveIndexMetadata) Reset()         { *m = WakuMessageArch
 func (m *WakuMessageArchiveIndexMetadata) String() string { return proto.CompactTextString(m) }
 func (*WakuMessageArchiveIndexMetadata) ProtoMessage()    {}
 func (*WakuMessageArchiveIndexMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{20}
+	return fileDescriptor_f937943d74c1cd8b, []int{24}
 }
 
 func (m *WakuMessageArchiveIndexMetadata) XXX_Unmarshal(b []byte) error {
@@ -1590,7 +1996,7 @@ func (m *WakuMessageArchiveIndex) Reset()         { *m = WakuMessageArchiveIndex
 func (m *WakuMessageArchiveIndex) String() string { return proto.CompactTextString(m) }
 func (*WakuMessageArchiveIndex) ProtoMessage()    {}
 func (*WakuMessageArchiveIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{21}
+	return fileDescriptor_f937943d74c1cd8b, []int{25}
 }
 
 func (m *WakuMessageArchiveIndex) XXX_Unmarshal(b []byte) error {
@@ -1618,10 +2024,504 @@ func (m *WakuMessageArchiveIndex) GetArchives() map[string]*WakuMessageArchiveIn
 	return nil
 }
 
+type CommunityEmojiPack struct {
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Emojis               []*CommunityEmoji `protobuf:"bytes,3,rep,name=emojis,proto3" json:"emojis,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CommunityEmojiPack) Reset()         { *m = CommunityEmojiPack{} }
+func (m *CommunityEmojiPack) String() string { return proto.CompactTextString(m) }
+func (*CommunityEmojiPack) ProtoMessage()    {}
+func (*CommunityEmojiPack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{26}
+}
+
+func (m *CommunityEmojiPack) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityEmojiPack.Unmarshal(m, b)
+}
+func (m *CommunityEmojiPack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityEmojiPack.Marshal(b, m, deterministic)
+}
+func (m *CommunityEmojiPack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityEmojiPack.Merge(m, src)
+}
+func (m *CommunityEmojiPack) XXX_Size() int {
+	return xxx_messageInfo_CommunityEmojiPack.Size(m)
+}
+func (m *CommunityEmojiPack) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityEmojiPack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityEmojiPack proto.InternalMessageInfo
+
+func (m *CommunityEmojiPack) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CommunityEmojiPack) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CommunityEmojiPack) GetEmojis() []*CommunityEmoji {
+	if m != nil {
+		return m.Emojis
+	}
+	return nil
+}
+
+type CommunityEmoji struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Shortcode            string   `protobuf:"bytes,2,opt,name=shortcode,proto3" json:"shortcode,omitempty"`
+	Hash                 string   `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityEmoji) Reset()         { *m = CommunityEmoji{} }
+func (m *CommunityEmoji) String() string { return proto.CompactTextString(m) }
+func (*CommunityEmoji) ProtoMessage()    {}
+func (*CommunityEmoji) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{27}
+}
+
+func (m *CommunityEmoji) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityEmoji.Unmarshal(m, b)
+}
+func (m *CommunityEmoji) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityEmoji.Marshal(b, m, deterministic)
+}
+func (m *CommunityEmoji) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityEmoji.Merge(m, src)
+}
+func (m *CommunityEmoji) XXX_Size() int {
+	return xxx_messageInfo_CommunityEmoji.Size(m)
+}
+func (m *CommunityEmoji) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityEmoji.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityEmoji proto.InternalMessageInfo
+
+func (m *CommunityEmoji) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CommunityEmoji) GetShortcode() string {
+	if m != nil {
+		return m.Shortcode
+	}
+	return ""
+}
+
+func (m *CommunityEmoji) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// A distinct display name and avatar a member uses in a community
+type CommunityMemberProfile struct {
+	Clock                uint64         `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	CommunityId          []byte         `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	DisplayName          string         `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Image                *IdentityImage `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CommunityMemberProfile) Reset()         { *m = CommunityMemberProfile{} }
+func (m *CommunityMemberProfile) String() string { return proto.CompactTextString(m) }
+func (*CommunityMemberProfile) ProtoMessage()    {}
+func (*CommunityMemberProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{28}
+}
+
+func (m *CommunityMemberProfile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityMemberProfile.Unmarshal(m, b)
+}
+func (m *CommunityMemberProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityMemberProfile.Marshal(b, m, deterministic)
+}
+func (m *CommunityMemberProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityMemberProfile.Merge(m, src)
+}
+func (m *CommunityMemberProfile) XXX_Size() int {
+	return xxx_messageInfo_CommunityMemberProfile.Size(m)
+}
+func (m *CommunityMemberProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityMemberProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityMemberProfile proto.InternalMessageInfo
+
+func (m *CommunityMemberProfile) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityMemberProfile) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *CommunityMemberProfile) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *CommunityMemberProfile) GetImage() *IdentityImage {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+// A user whose messages the clients of the members hide in the chats of the
+// community
+type CommunityBlocklistEntry struct {
+	PublicKey            string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityBlocklistEntry) Reset()         { *m = CommunityBlocklistEntry{} }
+func (m *CommunityBlocklistEntry) String() string { return proto.CompactTextString(m) }
+func (*CommunityBlocklistEntry) ProtoMessage()    {}
+func (*CommunityBlocklistEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{29}
+}
+
+func (m *CommunityBlocklistEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityBlocklistEntry.Unmarshal(m, b)
+}
+func (m *CommunityBlocklistEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityBlocklistEntry.Marshal(b, m, deterministic)
+}
+func (m *CommunityBlocklistEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityBlocklistEntry.Merge(m, src)
+}
+func (m *CommunityBlocklistEntry) XXX_Size() int {
+	return xxx_messageInfo_CommunityBlocklistEntry.Size(m)
+}
+func (m *CommunityBlocklistEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityBlocklistEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityBlocklistEntry proto.InternalMessageInfo
+
+func (m *CommunityBlocklistEntry) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *CommunityBlocklistEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type CommunityDescriptionDelta struct {
+	CommunityId          []byte                        `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	BaseClock            uint64                        `protobuf:"varint,2,opt,name=base_clock,json=baseClock,proto3" json:"base_clock,omitempty"`
+	Clock                uint64                        `protobuf:"varint,3,opt,name=clock,proto3" json:"clock,omitempty"`
+	Signature            []byte                        `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Changes              []*CommunityDescriptionChange `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *CommunityDescriptionDelta) Reset()         { *m = CommunityDescriptionDelta{} }
+func (m *CommunityDescriptionDelta) String() string { return proto.CompactTextString(m) }
+func (*CommunityDescriptionDelta) ProtoMessage()    {}
+func (*CommunityDescriptionDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{30}
+}
+
+func (m *CommunityDescriptionDelta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityDescriptionDelta.Unmarshal(m, b)
+}
+func (m *CommunityDescriptionDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityDescriptionDelta.Marshal(b, m, deterministic)
+}
+func (m *CommunityDescriptionDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityDescriptionDelta.Merge(m, src)
+}
+func (m *CommunityDescriptionDelta) XXX_Size() int {
+	return xxx_messageInfo_CommunityDescriptionDelta.Size(m)
+}
+func (m *CommunityDescriptionDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityDescriptionDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityDescriptionDelta proto.InternalMessageInfo
+
+func (m *CommunityDescriptionDelta) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *CommunityDescriptionDelta) GetBaseClock() uint64 {
+	if m != nil {
+		return m.BaseClock
+	}
+	return 0
+}
+
+func (m *CommunityDescriptionDelta) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityDescriptionDelta) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *CommunityDescriptionDelta) GetChanges() []*CommunityDescriptionChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type CommunityDescriptionChange struct {
+	Type                 CommunityDescriptionChange_Type `protobuf:"varint,1,opt,name=type,proto3,enum=protobuf.CommunityDescriptionChange_Type" json:"type,omitempty"`
+	Key                  string                          `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Removed              bool                            `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`
+	Value                []byte                          `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *CommunityDescriptionChange) Reset()         { *m = CommunityDescriptionChange{} }
+func (m *CommunityDescriptionChange) String() string { return proto.CompactTextString(m) }
+func (*CommunityDescriptionChange) ProtoMessage()    {}
+func (*CommunityDescriptionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{31}
+}
+
+func (m *CommunityDescriptionChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityDescriptionChange.Unmarshal(m, b)
+}
+func (m *CommunityDescriptionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityDescriptionChange.Marshal(b, m, deterministic)
+}
+func (m *CommunityDescriptionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityDescriptionChange.Merge(m, src)
+}
+func (m *CommunityDescriptionChange) XXX_Size() int {
+	return xxx_messageInfo_CommunityDescriptionChange.Size(m)
+}
+func (m *CommunityDescriptionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityDescriptionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityDescriptionChange proto.InternalMessageInfo
+
+func (m *CommunityDescriptionChange) GetType() CommunityDescriptionChange_Type {
+	if m != nil {
+		return m.Type
+	}
+	return CommunityDescriptionChange_UNKNOWN_CHANGE
+}
+
+func (m *CommunityDescriptionChange) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *CommunityDescriptionChange) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
+func (m *CommunityDescriptionChange) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type CommunityDescriptionRequest struct {
+	CommunityId          []byte                         `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	Clock                uint64                         `protobuf:"varint,2,opt,name=clock,proto3" json:"clock,omitempty"`
+	Capabilities         []CommunityMember_Capabilities `protobuf:"varint,3,rep,packed,name=capabilities,proto3,enum=protobuf.CommunityMember_Capabilities" json:"capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *CommunityDescriptionRequest) Reset()         { *m = CommunityDescriptionRequest{} }
+func (m *CommunityDescriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*CommunityDescriptionRequest) ProtoMessage()    {}
+func (*CommunityDescriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{32}
+}
+
+func (m *CommunityDescriptionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityDescriptionRequest.Unmarshal(m, b)
+}
+func (m *CommunityDescriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityDescriptionRequest.Marshal(b, m, deterministic)
+}
+func (m *CommunityDescriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityDescriptionRequest.Merge(m, src)
+}
+func (m *CommunityDescriptionRequest) XXX_Size() int {
+	return xxx_messageInfo_CommunityDescriptionRequest.Size(m)
+}
+func (m *CommunityDescriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityDescriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityDescriptionRequest proto.InternalMessageInfo
+
+func (m *CommunityDescriptionRequest) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *CommunityDescriptionRequest) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityDescriptionRequest) GetCapabilities() []CommunityMember_Capabilities {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+type CommunityBan struct {
+	MemberId             string   `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	ModeratorId          string   `protobuf:"bytes,2,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	MessagesDeleted      bool     `protobuf:"varint,4,opt,name=messages_deleted,json=messagesDeleted,proto3" json:"messages_deleted,omitempty"`
+	Clock                uint64   `protobuf:"varint,5,opt,name=clock,proto3" json:"clock,omitempty"`
+	CommunityId          []byte   `protobuf:"bytes,6,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityBan) Reset()         { *m = CommunityBan{} }
+func (m *CommunityBan) String() string { return proto.CompactTextString(m) }
+func (*CommunityBan) ProtoMessage()    {}
+func (*CommunityBan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{33}
+}
+
+func (m *CommunityBan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityBan.Unmarshal(m, b)
+}
+func (m *CommunityBan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityBan.Marshal(b, m, deterministic)
+}
+func (m *CommunityBan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityBan.Merge(m, src)
+}
+func (m *CommunityBan) XXX_Size() int {
+	return xxx_messageInfo_CommunityBan.Size(m)
+}
+func (m *CommunityBan) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityBan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityBan proto.InternalMessageInfo
+
+func (m *CommunityBan) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *CommunityBan) GetModeratorId() string {
+	if m != nil {
+		return m.ModeratorId
+	}
+	return ""
+}
+
+func (m *CommunityBan) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CommunityBan) GetMessagesDeleted() bool {
+	if m != nil {
+		return m.MessagesDeleted
+	}
+	return false
+}
+
+func (m *CommunityBan) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityBan) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("protobuf.CommunityMember_Roles", CommunityMember_Roles_name, CommunityMember_Roles_value)
+	proto.RegisterEnum("protobuf.CommunityMember_Permissions", CommunityMember_Permissions_name, CommunityMember_Permissions_value)
+	proto.RegisterEnum("protobuf.CommunityMember_Capabilities", CommunityMember_Capabilities_name, CommunityMember_Capabilities_value)
 	proto.RegisterEnum("protobuf.CommunityPermissions_Access", CommunityPermissions_Access_name, CommunityPermissions_Access_value)
 	proto.RegisterEnum("protobuf.CommunityTokenPermission_Type", CommunityTokenPermission_Type_name, CommunityTokenPermission_Type_value)
+	proto.RegisterEnum("protobuf.CommunityDescriptionChange_Type", CommunityDescriptionChange_Type_name, CommunityDescriptionChange_Type_value)
 	proto.RegisterType((*Grant)(nil), "protobuf.Grant")
 	proto.RegisterType((*CommunityMember)(nil), "protobuf.CommunityMember")
 	proto.RegisterType((*CommunityTokenMetadata)(nil), "protobuf.CommunityTokenMetadata")
@@ -1635,6 +2535,9 @@ func init() {
 	proto.RegisterMapType((map[string]*CommunityChat)(nil), "protobuf.CommunityDescription.ChatsEntry")
 	proto.RegisterMapType((map[string]*CommunityMember)(nil), "protobuf.CommunityDescription.MembersEntry")
 	proto.RegisterMapType((map[string]*CommunityTokenPermission)(nil), "protobuf.CommunityDescription.TokenPermissionsEntry")
+	proto.RegisterType((*CommunityMembershipPayment)(nil), "protobuf.CommunityMembershipPayment")
+	proto.RegisterType((*CommunityMembershipPaymentProof)(nil), "protobuf.CommunityMembershipPaymentProof")
+	proto.RegisterType((*CommunityRolePermissions)(nil), "protobuf.CommunityRolePermissions")
 	proto.RegisterType((*CommunityAdminSettings)(nil), "protobuf.CommunityAdminSettings")
 	proto.RegisterType((*CommunityChat)(nil), "protobuf.CommunityChat")
 	proto.RegisterMapType((map[string]*CommunityMember)(nil), "protobuf.CommunityChat.MembersEntry")
@@ -1646,11 +2549,20 @@ func init() {
 	proto.RegisterType((*CommunityRequestToJoinResponse)(nil), "protobuf.CommunityRequestToJoinResponse")
 	proto.RegisterType((*CommunityRequestToLeave)(nil), "protobuf.CommunityRequestToLeave")
 	proto.RegisterType((*CommunityMessageArchiveMagnetlink)(nil), "protobuf.CommunityMessageArchiveMagnetlink")
+	proto.RegisterType((*DeleteCommunityMemberMessages)(nil), "protobuf.DeleteCommunityMemberMessages")
 	proto.RegisterType((*WakuMessage)(nil), "protobuf.WakuMessage")
 	proto.RegisterType((*WakuMessageArchiveMetadata)(nil), "protobuf.WakuMessageArchiveMetadata")
 	proto.RegisterType((*WakuMessageArchive)(nil), "protobuf.WakuMessageArchive")
 	proto.RegisterType((*WakuMessageArchiveIndexMetadata)(nil), "protobuf.WakuMessageArchiveIndexMetadata")
 	proto.RegisterType((*WakuMessageArchiveIndex)(nil), "protobuf.WakuMessageArchiveIndex")
+	proto.RegisterType((*CommunityEmojiPack)(nil), "protobuf.CommunityEmojiPack")
+	proto.RegisterType((*CommunityEmoji)(nil), "protobuf.CommunityEmoji")
+	proto.RegisterType((*CommunityMemberProfile)(nil), "protobuf.CommunityMemberProfile")
+	proto.RegisterType((*CommunityBlocklistEntry)(nil), "protobuf.CommunityBlocklistEntry")
+	proto.RegisterType((*CommunityDescriptionDelta)(nil), "protobuf.CommunityDescriptionDelta")
+	proto.RegisterType((*CommunityDescriptionChange)(nil), "protobuf.CommunityDescriptionChange")
+	proto.RegisterType((*CommunityDescriptionRequest)(nil), "protobuf.CommunityDescriptionRequest")
+	proto.RegisterType((*CommunityBan)(nil), "protobuf.CommunityBan")
 	proto.RegisterMapType((map[string]*WakuMessageArchiveIndexMetadata)(nil), "protobuf.WakuMessageArchiveIndex.ArchivesEntry")
 }
 
@@ -1659,130 +2571,183 @@ func init() {
 }
 
 var fileDescriptor_f937943d74c1cd8b = []byte{
-	// 1999 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x73, 0x1b, 0x49,
-	0x15, 0xdf, 0x91, 0x64, 0x5b, 0x7a, 0x92, 0x1c, 0xb9, 0x93, 0xd8, 0x63, 0x27, 0xd9, 0x28, 0x03,
-	0x14, 0xde, 0xa2, 0x50, 0x76, 0xbd, 0x50, 0xa4, 0x76, 0x61, 0x77, 0x15, 0x79, 0xc8, 0x8a, 0xc4,
-	0x23, 0x6f, 0x5b, 0xd9, 0xc0, 0x16, 0x30, 0xd5, 0x9e, 0x69, 0xcb, 0x5d, 0x91, 0x66, 0xc4, 0x74,
-	0xcb, 0x85, 0x38, 0xec, 0x81, 0xe2, 0x43, 0x70, 0xe7, 0xce, 0x57, 0xe0, 0xc0, 0x9d, 0x3b, 0x37,
-	0xb8, 0x71, 0xe4, 0xc4, 0x81, 0x13, 0xd5, 0x7f, 0x66, 0x34, 0x23, 0x4b, 0x49, 0xb6, 0x16, 0xaa,
-	0x38, 0x69, 0xde, 0xeb, 0xd7, 0xef, 0xf5, 0x7b, 0xef, 0xd7, 0xaf, 0xdf, 0x13, 0xec, 0x04, 0xf1,
-	0x64, 0x32, 0x8b, 0x98, 0x60, 0x94, 0x77, 0xa6, 0x49, 0x2c, 0x62, 0x54, 0x55, 0x3f, 0xe7, 0xb3,
-	0x8b, 0x83, 0x9b, 0xc1, 0x25, 0x11, 0x3e, 0x0b, 0x69, 0x24, 0x98, 0x98, 0xeb, 0xe5, 0x83, 0x3a,
-	0x8d, 0x66, 0x13, 0x23, 0xeb, 0x5c, 0xc1, 0xc6, 0x93, 0x84, 0x44, 0x02, 0x3d, 0x80, 0x46, 0xaa,
-	0x69, 0xee, 0xb3, 0xd0, 0xb6, 0xda, 0xd6, 0x61, 0x03, 0xd7, 0x33, 0x5e, 0x3f, 0x44, 0x77, 0xa0,
-	0x36, 0xa1, 0x93, 0x73, 0x9a, 0xc8, 0xf5, 0x92, 0x5a, 0xaf, 0x6a, 0x46, 0x3f, 0x44, 0x7b, 0xb0,
-	0x65, 0x8c, 0xd9, 0xe5, 0xb6, 0x75, 0x58, 0xc3, 0x9b, 0x92, 0xec, 0x87, 0xe8, 0x16, 0x6c, 0x04,
-	0xe3, 0x38, 0x78, 0x69, 0x57, 0xda, 0xd6, 0x61, 0x05, 0x6b, 0xc2, 0xf9, 0xb7, 0x05, 0x37, 0x7a,
-	0xa9, 0xee, 0x13, 0xa5, 0x04, 0x7d, 0x1f, 0x36, 0x92, 0x78, 0x4c, 0xb9, 0x6d, 0xb5, 0xcb, 0x87,
-	0xdb, 0x47, 0xf7, 0x3b, 0xa9, 0x1f, 0x9d, 0x25, 0xc9, 0x0e, 0x96, 0x62, 0x58, 0x4b, 0xa3, 0x1f,
-	0xc3, 0x4e, 0x42, 0xaf, 0x28, 0x19, 0xd3, 0xd0, 0x27, 0x41, 0x10, 0xcf, 0x22, 0xc1, 0xed, 0x52,
-	0xbb, 0x7c, 0x58, 0x3f, 0xda, 0x5f, 0xa8, 0xc0, 0x46, 0xa4, 0xab, 0x25, 0x70, 0x2b, 0x29, 0x32,
-	0xb8, 0x73, 0x09, 0x1b, 0x4a, 0x2f, 0x6a, 0x42, 0x0d, 0x0f, 0x9e, 0xb9, 0xbe, 0x37, 0xf0, 0xdc,
-	0xd6, 0x5b, 0x68, 0x1b, 0x40, 0x91, 0x83, 0x17, 0x9e, 0x8b, 0x5b, 0x16, 0xba, 0x0d, 0x3b, 0x8a,
-	0x3e, 0xe9, 0x7a, 0xdd, 0x27, 0xae, 0xff, 0xfc, 0xcc, 0xc5, 0x67, 0xad, 0x12, 0xda, 0x87, 0xdb,
-	0x9a, 0x3d, 0x38, 0x76, 0x71, 0x77, 0xe8, 0xfa, 0xbd, 0x81, 0x37, 0x74, 0xbd, 0x61, 0xab, 0x9c,
-	0x69, 0xe8, 0x1e, 0x9f, 0xf4, 0xbd, 0x56, 0xc5, 0xf9, 0x6d, 0x19, 0x76, 0x33, 0x97, 0x86, 0xf1,
-	0x4b, 0x1a, 0x9d, 0x50, 0x41, 0x42, 0x22, 0x08, 0xba, 0x00, 0x14, 0xc4, 0x91, 0x48, 0x48, 0x20,
-	0x7c, 0x12, 0x86, 0x09, 0xe5, 0xdc, 0x04, 0xa4, 0x7e, 0xf4, 0x83, 0x15, 0x01, 0x29, 0xec, 0xee,
-	0xf4, 0xcc, 0xd6, 0x6e, 0xba, 0xd3, 0x8d, 0x44, 0x32, 0xc7, 0x3b, 0xc1, 0x32, 0x1f, 0xb5, 0xa1,
-	0x1e, 0x52, 0x1e, 0x24, 0x6c, 0x2a, 0x58, 0x1c, 0xa9, 0x6c, 0xd6, 0x70, 0x9e, 0x25, 0xf3, 0xc6,
-	0x26, 0x64, 0x44, 0x4d, 0x3a, 0x35, 0x81, 0x3e, 0x80, 0x9a, 0x90, 0x26, 0x87, 0xf3, 0x29, 0x55,
-	0x19, 0xdd, 0x3e, 0xba, 0xbb, 0xee, 0x58, 0x52, 0x06, 0x2f, 0xc4, 0xd1, 0x2e, 0x6c, 0xf2, 0xf9,
-	0xe4, 0x3c, 0x1e, 0xdb, 0x1b, 0x1a, 0x21, 0x9a, 0x42, 0x08, 0x2a, 0x11, 0x99, 0x50, 0x7b, 0x53,
-	0x71, 0xd5, 0x37, 0x3a, 0x80, 0x6a, 0x48, 0x03, 0x36, 0x21, 0x63, 0x6e, 0x6f, 0xb5, 0xad, 0xc3,
-	0x26, 0xce, 0xe8, 0x83, 0x63, 0x19, 0xbd, 0x55, 0x8e, 0xa2, 0x16, 0x94, 0x5f, 0xd2, 0xb9, 0xc2,
-	0x6e, 0x05, 0xcb, 0x4f, 0xe9, 0xc5, 0x15, 0x19, 0xcf, 0xa8, 0xf1, 0x50, 0x13, 0x1f, 0x94, 0x1e,
-	0x59, 0xce, 0xdf, 0x2c, 0xb8, 0x95, 0x9d, 0xf7, 0x94, 0x26, 0x13, 0xc6, 0x39, 0x8b, 0x23, 0x8e,
-	0xf6, 0xa1, 0x4a, 0x23, 0xee, 0xc7, 0xd1, 0x58, 0x6b, 0xaa, 0xe2, 0x2d, 0x1a, 0xf1, 0x41, 0x34,
-	0x9e, 0x23, 0x1b, 0xb6, 0xa6, 0x09, 0xbb, 0x22, 0x42, 0xeb, 0xab, 0xe2, 0x94, 0x44, 0x3f, 0x82,
-	0x4d, 0x12, 0x04, 0x94, 0x73, 0x15, 0xae, 0xed, 0xa3, 0x6f, 0xad, 0x08, 0x4a, 0xce, 0x48, 0xa7,
-	0xab, 0x84, 0xb1, 0xd9, 0xe4, 0x0c, 0x61, 0x53, 0x73, 0x10, 0x82, 0xed, 0xe7, 0xde, 0x53, 0x6f,
-	0xf0, 0xc2, 0xf3, 0xbb, 0xbd, 0x9e, 0x7b, 0x76, 0xd6, 0x7a, 0x0b, 0xed, 0x40, 0xd3, 0x1b, 0xf8,
-	0x27, 0xee, 0xc9, 0x63, 0x17, 0x9f, 0x7d, 0xda, 0x3f, 0x6d, 0x59, 0xe8, 0x26, 0xdc, 0xe8, 0x7b,
-	0x9f, 0xf7, 0x87, 0xdd, 0x61, 0x7f, 0xe0, 0xf9, 0x03, 0xef, 0xd9, 0xcf, 0x5a, 0x25, 0x89, 0xb3,
-	0x81, 0xe7, 0x63, 0xf7, 0xb3, 0xe7, 0xee, 0xd9, 0xb0, 0x55, 0x76, 0x7e, 0x57, 0x86, 0xa6, 0xca,
-	0x44, 0x2f, 0x61, 0x82, 0x26, 0x8c, 0xa0, 0x5f, 0xbc, 0x02, 0x5e, 0x9d, 0xc5, 0x91, 0x0b, 0x9b,
-	0xbe, 0x02, 0xaa, 0xde, 0x85, 0x8a, 0x90, 0xc0, 0x28, 0xbd, 0x01, 0x30, 0x94, 0x64, 0x0e, 0x13,
-	0xe5, 0x95, 0x98, 0xa8, 0xe4, 0x30, 0xb1, 0x0b, 0x9b, 0x64, 0x22, 0xef, 0x6a, 0x8a, 0x1f, 0x4d,
-	0xc9, 0xba, 0xa4, 0x40, 0xe6, 0xb3, 0x90, 0xdb, 0x9b, 0xed, 0xf2, 0x61, 0x05, 0x57, 0x15, 0xa3,
-	0x1f, 0x72, 0x74, 0x1f, 0xea, 0x32, 0x9b, 0x53, 0x22, 0x04, 0x4d, 0x22, 0x85, 0xa5, 0x1a, 0x06,
-	0x1a, 0xf1, 0x53, 0xcd, 0x29, 0x20, 0xad, 0xaa, 0x80, 0xf3, 0xdf, 0x46, 0xda, 0xdf, 0x4b, 0x60,
-	0x17, 0x03, 0xb0, 0x40, 0x02, 0xda, 0x86, 0x92, 0xa9, 0xb6, 0x35, 0x5c, 0x62, 0x21, 0xfa, 0xb0,
-	0x10, 0xc2, 0x6f, 0xaf, 0x0b, 0xe1, 0x42, 0x43, 0x27, 0x17, 0xcd, 0x8f, 0x60, 0x5b, 0x47, 0x22,
-	0x30, 0xb9, 0xb3, 0xcb, 0x2a, 0xb5, 0x7b, 0x6b, 0x52, 0x8b, 0x9b, 0xa2, 0x00, 0x8f, 0x7d, 0xa8,
-	0x9a, 0x22, 0xce, 0xed, 0x4a, 0xbb, 0x7c, 0x58, 0xc3, 0x5b, 0xba, 0x8a, 0x73, 0x74, 0x0f, 0x80,
-	0x71, 0x3f, 0x45, 0xff, 0x86, 0x42, 0x7f, 0x8d, 0xf1, 0x53, 0xcd, 0x70, 0xbe, 0x84, 0x8a, 0xba,
-	0xe3, 0x77, 0xc1, 0x4e, 0xe1, 0x3b, 0x1c, 0x3c, 0x75, 0x3d, 0xff, 0xd4, 0xc5, 0x27, 0xfd, 0xb3,
-	0xb3, 0xfe, 0xc0, 0x6b, 0xbd, 0x85, 0x5a, 0xd0, 0x78, 0xec, 0xf6, 0x06, 0x27, 0x69, 0x29, 0xb4,
-	0x24, 0xb4, 0x0d, 0x47, 0xc3, 0xbb, 0x55, 0x42, 0xb7, 0xa0, 0xd5, 0xeb, 0x7a, 0xfe, 0xe7, 0x7d,
-	0xf7, 0x85, 0xdf, 0xfb, 0xb4, 0xeb, 0x79, 0xee, 0xb3, 0x56, 0x19, 0xdd, 0x83, 0xfd, 0x8c, 0xdb,
-	0xf5, 0x8e, 0xfd, 0xd3, 0xc1, 0xd9, 0x30, 0x5b, 0xae, 0x38, 0xff, 0xaa, 0xe5, 0x6e, 0xf3, 0x71,
-	0xb1, 0x8c, 0xe9, 0xe7, 0xc7, 0xca, 0x3d, 0x3f, 0xc8, 0x85, 0x2d, 0xfd, 0x72, 0xa5, 0x2f, 0xc5,
-	0x77, 0x56, 0x04, 0x3a, 0xa7, 0xa6, 0xa3, 0x1f, 0x1e, 0x83, 0xfc, 0x74, 0x2f, 0xfa, 0x04, 0xea,
-	0xd3, 0xc5, 0xa5, 0x56, 0x10, 0xae, 0x1f, 0xbd, 0xfd, 0xea, 0xab, 0x8f, 0xf3, 0x5b, 0xd0, 0x11,
-	0x54, 0xd3, 0xe7, 0x59, 0x05, 0xb5, 0x7e, 0xb4, 0x9b, 0xdb, 0xae, 0x62, 0xaf, 0x57, 0x71, 0x26,
-	0x87, 0x3e, 0x86, 0x0d, 0x99, 0x15, 0x8d, 0xf5, 0xfa, 0xd1, 0x3b, 0xaf, 0x39, 0xba, 0xd4, 0x62,
-	0x0e, 0xae, 0xf7, 0xc9, 0x34, 0x9f, 0x93, 0xc8, 0x1f, 0x33, 0x2e, 0xec, 0x2d, 0x9d, 0xe6, 0x73,
-	0x12, 0x3d, 0x63, 0x5c, 0x20, 0x0f, 0x20, 0x20, 0x82, 0x8e, 0xe2, 0x84, 0x51, 0x79, 0x1f, 0x96,
-	0x0a, 0xc3, 0x6a, 0x03, 0xd9, 0x06, 0x6d, 0x25, 0xa7, 0x01, 0x3d, 0x02, 0x9b, 0x24, 0xc1, 0x25,
-	0xbb, 0xa2, 0xfe, 0x84, 0x8c, 0x22, 0x2a, 0xc6, 0x2c, 0x7a, 0xe9, 0xeb, 0x8c, 0xd4, 0x54, 0x46,
-	0x76, 0xcd, 0xfa, 0x49, 0xb6, 0xdc, 0x53, 0x29, 0x7a, 0x02, 0xdb, 0x24, 0x9c, 0xb0, 0xc8, 0xe7,
-	0x54, 0x08, 0x16, 0x8d, 0xb8, 0x0d, 0x2a, 0x3e, 0xed, 0x15, 0xa7, 0xe9, 0x4a, 0xc1, 0x33, 0x23,
-	0x87, 0x9b, 0x24, 0x4f, 0xa2, 0x6f, 0x40, 0x93, 0x45, 0x22, 0x89, 0xfd, 0x09, 0xe5, 0x5c, 0x3e,
-	0x68, 0x75, 0x75, 0xd9, 0x1a, 0x8a, 0x79, 0xa2, 0x79, 0x52, 0x28, 0x9e, 0xe5, 0x85, 0x1a, 0x5a,
-	0x48, 0x31, 0x53, 0xa1, 0xbb, 0x50, 0xa3, 0x51, 0x90, 0xcc, 0xa7, 0x82, 0x86, 0x76, 0x53, 0x5f,
-	0x81, 0x8c, 0x21, 0x4b, 0x96, 0x20, 0x23, 0x6e, 0x6f, 0xab, 0x88, 0xaa, 0x6f, 0x44, 0x60, 0x47,
-	0x5f, 0xc8, 0x3c, 0x4c, 0x6e, 0xa8, 0xa8, 0x7e, 0xef, 0x35, 0x51, 0x5d, 0xba, 0xe6, 0x26, 0xb6,
-	0x2d, 0xb1, 0xc4, 0x46, 0x3f, 0x87, 0xfd, 0x45, 0xe3, 0xa6, 0x56, 0xb9, 0x3f, 0x31, 0x0d, 0x81,
-	0xdd, 0x52, 0xa6, 0xda, 0xaf, 0x6b, 0x1c, 0xf0, 0x5e, 0x50, 0xe0, 0xf3, 0xac, 0x1f, 0x79, 0x17,
-	0x6e, 0x91, 0x40, 0xa8, 0xf4, 0x69, 0xcc, 0xfb, 0xaa, 0x5b, 0xb2, 0x77, 0x54, 0xee, 0x90, 0x5e,
-	0x33, 0x97, 0xa3, 0x27, 0x57, 0x0e, 0x9e, 0x43, 0x23, 0x7f, 0x59, 0xf2, 0x95, 0xb2, 0xa6, 0x2b,
-	0xe5, 0xc3, 0x7c, 0xa5, 0x2c, 0x34, 0x69, 0x4b, 0x7d, 0x5e, 0xae, 0x88, 0x1e, 0x7c, 0x06, 0xb0,
-	0x00, 0xf2, 0x0a, 0xa5, 0xdf, 0x2d, 0x2a, 0xdd, 0x5b, 0xa1, 0x54, 0xee, 0xcf, 0xab, 0xfc, 0x02,
-	0x6e, 0x2c, 0x41, 0x77, 0x85, 0xde, 0xf7, 0x8a, 0x7a, 0xef, 0xac, 0xd2, 0xab, 0x95, 0xcc, 0xf3,
-	0xba, 0x47, 0x70, 0x7b, 0x65, 0x02, 0x57, 0x58, 0x78, 0x54, 0xb4, 0xe0, 0xbc, 0xbe, 0xe4, 0xe7,
-	0x1f, 0x97, 0x5f, 0xe6, 0x5a, 0xc9, 0xc2, 0x35, 0x40, 0xc7, 0x70, 0x7f, 0xca, 0xa2, 0x14, 0xd0,
-	0x3e, 0x19, 0x8f, 0xb3, 0x1c, 0xd2, 0x88, 0x9c, 0x8f, 0x69, 0x68, 0xda, 0x9b, 0x3b, 0x53, 0x16,
-	0x19, 0x88, 0x77, 0xc7, 0xe3, 0x2c, 0x79, 0x4a, 0xc4, 0xf9, 0x6b, 0x09, 0x9a, 0x85, 0x08, 0xa2,
-	0x8f, 0x16, 0xb5, 0x53, 0x37, 0x0e, 0xdf, 0x5c, 0x13, 0xeb, 0x37, 0x2b, 0x9a, 0xa5, 0xaf, 0x57,
-	0x34, 0xcb, 0x6f, 0x58, 0x34, 0xef, 0x43, 0xdd, 0x94, 0x25, 0x35, 0xde, 0xe8, 0xbe, 0x22, 0xad,
-	0x54, 0x72, 0xba, 0x39, 0x80, 0xea, 0x34, 0xe6, 0x4c, 0xb5, 0xc3, 0xb2, 0x12, 0x6f, 0xe0, 0x8c,
-	0xfe, 0x1f, 0x61, 0xda, 0x09, 0x61, 0xe7, 0x1a, 0x88, 0x96, 0x0f, 0x6a, 0x5d, 0x3b, 0x68, 0xda,
-	0x1a, 0x95, 0x8a, 0xed, 0x72, 0x76, 0xf8, 0x72, 0xf1, 0xf0, 0xce, 0xef, 0x2d, 0xb8, 0x99, 0x99,
-	0xe9, 0x47, 0x57, 0x4c, 0x10, 0xf5, 0x32, 0xbe, 0x0f, 0xb7, 0x17, 0x85, 0x23, 0x3f, 0x0c, 0xe8,
-	0xd1, 0xef, 0x56, 0xb0, 0xe6, 0x39, 0x1d, 0xc9, 0x79, 0xd1, 0xcc, 0x7f, 0x9a, 0x58, 0x3f, 0xfc,
-	0xdd, 0x03, 0x98, 0xce, 0xce, 0xc7, 0x2c, 0xf0, 0x65, 0xbc, 0x2a, 0x6a, 0x4f, 0x4d, 0x73, 0x9e,
-	0xd2, 0xb9, 0x73, 0x01, 0x37, 0x96, 0xe6, 0x32, 0xd9, 0x62, 0x9b, 0xc6, 0xd4, 0xb8, 0x9e, 0x92,
-	0xb2, 0xfa, 0x72, 0x36, 0x8a, 0x88, 0x98, 0x25, 0xd4, 0x98, 0x5f, 0x30, 0x64, 0x13, 0x18, 0x5c,
-	0x12, 0xa6, 0x9b, 0xc0, 0xb2, 0x6e, 0x02, 0x15, 0xa3, 0x1f, 0x72, 0xe7, 0x9f, 0x56, 0xee, 0x96,
-	0x60, 0xfa, 0xab, 0x19, 0xe5, 0x62, 0x18, 0xff, 0x24, 0x66, 0xeb, 0xfa, 0x03, 0x33, 0x03, 0xe4,
-	0xe2, 0x2c, 0x67, 0x00, 0x4f, 0x86, 0x7a, 0xad, 0xaf, 0xcb, 0x13, 0x74, 0xe5, 0xfa, 0x04, 0xfd,
-	0x00, 0x1a, 0x21, 0xe3, 0xd3, 0x31, 0x99, 0x6b, 0xd5, 0x1b, 0x66, 0xec, 0xd2, 0x3c, 0xa5, 0x7e,
-	0xe5, 0x34, 0xbb, 0xf9, 0xd5, 0xa7, 0xd9, 0x3f, 0x5a, 0x70, 0x37, 0x07, 0xae, 0x28, 0xa0, 0xe3,
-	0xff, 0x6b, 0xc7, 0x9d, 0x7f, 0x58, 0xf0, 0xf6, 0xea, 0x1c, 0x61, 0xca, 0xa7, 0x71, 0xc4, 0xe9,
-	0x9a, 0x23, 0xff, 0x10, 0x6a, 0x99, 0xa9, 0x57, 0x54, 0x93, 0x1c, 0x8a, 0xf1, 0x62, 0x83, 0xbc,
-	0x39, 0x72, 0x06, 0x53, 0x4f, 0x7a, 0x59, 0x95, 0xc3, 0x8c, 0x5e, 0x80, 0xbd, 0x92, 0x07, 0xfb,
-	0xb2, 0xbb, 0x1b, 0xd7, 0xdd, 0xbd, 0x07, 0xa0, 0xbb, 0x1d, 0x7f, 0x96, 0x30, 0x33, 0xd7, 0xd6,
-	0x34, 0xe7, 0x79, 0xc2, 0x1c, 0x0c, 0x7b, 0xd7, 0x3d, 0x7d, 0x46, 0xc9, 0xd5, 0x3a, 0x17, 0x97,
-	0x4d, 0x96, 0xae, 0x99, 0x74, 0x7e, 0x0a, 0x0f, 0x72, 0x95, 0x46, 0x17, 0xf3, 0xe5, 0xc6, 0x6a,
-	0x8d, 0xf6, 0xe2, 0x69, 0x4b, 0xcb, 0xa7, 0xfd, 0x93, 0x05, 0xf5, 0x17, 0xe4, 0xe5, 0x2c, 0xed,
-	0x82, 0x5a, 0x50, 0xe6, 0x6c, 0x64, 0xaa, 0x84, 0xfc, 0x94, 0x37, 0x53, 0xb0, 0x09, 0xe5, 0x82,
-	0x4c, 0xa6, 0x6a, 0x7f, 0x05, 0x2f, 0x18, 0xd2, 0xa8, 0x88, 0xa7, 0x2c, 0x50, 0xe1, 0x6d, 0x60,
-	0x4d, 0xa8, 0x51, 0x9a, 0xcc, 0xc7, 0x31, 0x49, 0xf1, 0x92, 0x92, 0x7a, 0x25, 0x0c, 0x59, 0x34,
-	0x32, 0xa1, 0x4d, 0x49, 0x59, 0xf9, 0x2e, 0x09, 0xbf, 0x54, 0x01, 0x6d, 0x60, 0xf5, 0x8d, 0x1c,
-	0x68, 0x88, 0x4b, 0x96, 0x84, 0xa7, 0x24, 0x91, 0x71, 0x30, 0x03, 0x5e, 0x81, 0xe7, 0x7c, 0x09,
-	0x07, 0x39, 0x07, 0xd2, 0xb0, 0xa4, 0x2d, 0x8e, 0x0d, 0x5b, 0x57, 0x34, 0xe1, 0x69, 0xe5, 0x6b,
-	0xe2, 0x94, 0x94, 0xf6, 0x2e, 0x92, 0x78, 0x62, 0x5c, 0x52, 0xdf, 0x72, 0x5e, 0x13, 0xb1, 0x72,
-	0xa5, 0x82, 0x4b, 0x22, 0x96, 0xf6, 0xe5, 0x1c, 0x4c, 0x23, 0x31, 0x54, 0x4e, 0xca, 0xb1, 0xa9,
-	0x81, 0x0b, 0x3c, 0xe7, 0x0f, 0x16, 0xa0, 0xeb, 0x07, 0x78, 0x85, 0xe1, 0x4f, 0xa0, 0x9a, 0xb5,
-	0x70, 0x1a, 0xd1, 0xb9, 0x37, 0x76, 0xbd, 0x2b, 0x38, 0xdb, 0x85, 0xde, 0x93, 0x1a, 0x94, 0x0c,
-	0x37, 0x33, 0xe0, 0xed, 0x95, 0x1a, 0x70, 0x26, 0xe6, 0xfc, 0xd9, 0x82, 0xfb, 0xd7, 0x75, 0xf7,
-	0xa3, 0x90, 0xfe, 0xfa, 0x0d, 0x62, 0xf5, 0xf5, 0x8f, 0xbc, 0x0b, 0x9b, 0xf1, 0xc5, 0x05, 0xa7,
-	0xc2, 0x44, 0xd7, 0x50, 0x32, 0x0b, 0x9c, 0xfd, 0x86, 0x9a, 0xff, 0x0f, 0xd5, 0xf7, 0x32, 0x46,
-	0x2a, 0x19, 0x46, 0x9c, 0xbf, 0x58, 0xb0, 0xb7, 0xc6, 0x0b, 0xf4, 0x14, 0xaa, 0x66, 0xd8, 0x48,
-	0x5b, 0x97, 0x87, 0xaf, 0x3a, 0xa3, 0xda, 0xd4, 0x31, 0x84, 0xe9, 0x62, 0x32, 0x05, 0x07, 0x17,
-	0xd0, 0x2c, 0x2c, 0xad, 0x68, 0x0a, 0x3e, 0x2e, 0x36, 0x05, 0xef, 0xbc, 0xd6, 0x58, 0x16, 0x95,
-	0x45, 0x93, 0xf0, 0xb8, 0xf9, 0x45, 0xbd, 0xf3, 0xf0, 0xc3, 0x74, 0xe7, 0xf9, 0xa6, 0xfa, 0x7a,
-	0xff, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xca, 0xc3, 0x8d, 0xb3, 0xf8, 0x15, 0x00, 0x00,
+	// 2839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5b, 0x6f, 0xe3, 0xc6,
+	0xf5, 0x0f, 0x25, 0x4a, 0x96, 0x8e, 0x64, 0x9b, 0x9e, 0xdd, 0xb5, 0xb9, 0xda, 0xdd, 0xac, 0x97,
+	0xff, 0xfc, 0x1b, 0x2f, 0xda, 0x38, 0x89, 0xd3, 0xa2, 0x41, 0xd2, 0x5c, 0xb4, 0x32, 0xeb, 0x55,
+	0xd6, 0xba, 0x64, 0xa4, 0xcd, 0x36, 0x41, 0x5b, 0x82, 0x26, 0xc7, 0x36, 0x63, 0x89, 0x54, 0x39,
+	0x94, 0x51, 0xb5, 0x40, 0x0a, 0x14, 0x41, 0x5f, 0xfa, 0xda, 0x87, 0xa2, 0x8f, 0xed, 0x7b, 0xbf,
+	0x42, 0x1f, 0xfa, 0xd4, 0x3e, 0x14, 0xe8, 0x63, 0x5f, 0x8a, 0xf6, 0x9b, 0x14, 0x73, 0x21, 0x45,
+	0x4a, 0xd4, 0xda, 0xb9, 0x14, 0xe8, 0x93, 0x34, 0x67, 0xce, 0x9c, 0x39, 0x73, 0xe6, 0x5c, 0x7e,
+	0x73, 0x08, 0x5b, 0x4e, 0x30, 0x1e, 0x4f, 0x7d, 0x2f, 0xf2, 0x08, 0xdd, 0x9f, 0x84, 0x41, 0x14,
+	0xa0, 0x0a, 0xff, 0x39, 0x99, 0x9e, 0x36, 0x6e, 0x38, 0xe7, 0x76, 0x64, 0x79, 0x2e, 0xf1, 0x23,
+	0x2f, 0x9a, 0x89, 0xe9, 0x46, 0x8d, 0xf8, 0xd3, 0xb1, 0xe4, 0x35, 0x2e, 0xa1, 0x74, 0x14, 0xda,
+	0x7e, 0x84, 0x1e, 0x40, 0x3d, 0x96, 0x34, 0xb3, 0x3c, 0x57, 0x57, 0x76, 0x95, 0xbd, 0x3a, 0xae,
+	0x25, 0xb4, 0xb6, 0x8b, 0xee, 0x40, 0x75, 0x4c, 0xc6, 0x27, 0x24, 0x64, 0xf3, 0x05, 0x3e, 0x5f,
+	0x11, 0x84, 0xb6, 0x8b, 0x76, 0x60, 0x4d, 0x6e, 0xa6, 0x17, 0x77, 0x95, 0xbd, 0x2a, 0x2e, 0xb3,
+	0x61, 0xdb, 0x45, 0x37, 0xa1, 0xe4, 0x8c, 0x02, 0xe7, 0x42, 0x57, 0x77, 0x95, 0x3d, 0x15, 0x8b,
+	0x81, 0xf1, 0x4f, 0x15, 0x36, 0x5b, 0xb1, 0xec, 0x0e, 0x17, 0x82, 0xbe, 0x03, 0xa5, 0x30, 0x18,
+	0x11, 0xaa, 0x2b, 0xbb, 0xc5, 0xbd, 0x8d, 0x83, 0xfb, 0xfb, 0xf1, 0x39, 0xf6, 0x17, 0x38, 0xf7,
+	0x31, 0x63, 0xc3, 0x82, 0x1b, 0x7d, 0x1f, 0xb6, 0x42, 0x72, 0x49, 0xec, 0x11, 0x71, 0x2d, 0xdb,
+	0x71, 0x82, 0xa9, 0x1f, 0x51, 0xbd, 0xb0, 0x5b, 0xdc, 0xab, 0x1d, 0xdc, 0x9e, 0x8b, 0xc0, 0x92,
+	0xa5, 0x29, 0x38, 0xb0, 0x16, 0x66, 0x09, 0x14, 0x7d, 0x00, 0x75, 0xc7, 0x9e, 0xd8, 0x27, 0xde,
+	0x88, 0x1b, 0x53, 0x2f, 0x72, 0x2d, 0xbe, 0xb1, 0x5a, 0x8b, 0x56, 0x8a, 0x1b, 0x67, 0xd6, 0x1a,
+	0xbf, 0x80, 0x12, 0xd7, 0x11, 0xad, 0x43, 0x15, 0xf7, 0x8e, 0x4d, 0xab, 0xdb, 0xeb, 0x9a, 0xda,
+	0x0b, 0x68, 0x03, 0x80, 0x0f, 0x7b, 0xcf, 0xba, 0x26, 0xd6, 0x14, 0x74, 0x0b, 0xb6, 0xf8, 0xb8,
+	0xd3, 0xec, 0x36, 0x8f, 0x4c, 0xeb, 0xe9, 0xc0, 0xc4, 0x03, 0xad, 0x80, 0x6e, 0xc3, 0x2d, 0x41,
+	0xee, 0x1d, 0x9a, 0xb8, 0x39, 0x34, 0xad, 0x56, 0xaf, 0x3b, 0x34, 0xbb, 0x43, 0xad, 0x98, 0x48,
+	0x68, 0x1e, 0x76, 0xda, 0x5d, 0x4d, 0x4d, 0x24, 0x0c, 0x7b, 0x4f, 0xcc, 0xae, 0xd5, 0x69, 0x0e,
+	0x86, 0x26, 0xd6, 0x4a, 0xc6, 0xef, 0x14, 0xa8, 0xf5, 0x49, 0x38, 0xf6, 0x28, 0xf5, 0x02, 0x9f,
+	0xa2, 0x1b, 0xb0, 0xd9, 0x37, 0x71, 0xa7, 0x3d, 0x18, 0xb4, 0x7b, 0xdd, 0x58, 0x9b, 0x3b, 0xb0,
+	0x93, 0x22, 0xf6, 0xdb, 0x5d, 0xab, 0x63, 0x0e, 0x06, 0xcd, 0x23, 0x73, 0xa0, 0x29, 0xe8, 0x45,
+	0x68, 0xa4, 0x26, 0x0f, 0xcd, 0x63, 0x73, 0x68, 0xce, 0xe7, 0x0b, 0xa8, 0x01, 0xdb, 0xa9, 0xf9,
+	0x4e, 0xbb, 0x3b, 0x14, 0x3a, 0x0c, 0xb4, 0x22, 0xba, 0x07, 0xb7, 0x53, 0x73, 0xcd, 0x36, 0x3e,
+	0xc4, 0xbd, 0x7e, 0x3c, 0xad, 0x1a, 0x27, 0x50, 0x4f, 0xdb, 0x8e, 0x29, 0xd7, 0x6a, 0xf6, 0x9b,
+	0x8f, 0xda, 0xc7, 0xed, 0xe1, 0xc7, 0xb1, 0x72, 0x0d, 0xd8, 0x4e, 0x11, 0x5b, 0xbd, 0x4e, 0x1f,
+	0x9b, 0x5c, 0x9e, 0xa6, 0xa0, 0x07, 0x70, 0x2f, 0x35, 0x77, 0x68, 0x0e, 0x5a, 0xb8, 0xdd, 0x1f,
+	0x4a, 0x3d, 0x87, 0xcd, 0x81, 0x56, 0x30, 0x7e, 0x59, 0x84, 0xed, 0xe4, 0xc2, 0x86, 0xc1, 0x05,
+	0xf1, 0x3b, 0x24, 0xb2, 0x5d, 0x3b, 0xb2, 0xd1, 0x29, 0x20, 0x27, 0xf0, 0xa3, 0xd0, 0x76, 0x22,
+	0xcb, 0x76, 0xdd, 0x90, 0x50, 0x2a, 0x9d, 0xae, 0x76, 0xf0, 0xdd, 0x9c, 0xeb, 0xce, 0xac, 0xde,
+	0x6f, 0xc9, 0xa5, 0xcd, 0x78, 0xa5, 0xe9, 0x47, 0xe1, 0x0c, 0x6f, 0x39, 0x8b, 0x74, 0xb4, 0x0b,
+	0x35, 0x97, 0x50, 0x27, 0xf4, 0x26, 0x91, 0x17, 0xf8, 0x3c, 0x62, 0xaa, 0x38, 0x4d, 0x62, 0xb1,
+	0xe1, 0x8d, 0xed, 0x33, 0x22, 0x43, 0x46, 0x0c, 0xd0, 0x5b, 0x50, 0x8d, 0xd8, 0x96, 0xc3, 0xd9,
+	0x84, 0xf0, 0xa8, 0xd9, 0x38, 0xb8, 0xbb, 0x4a, 0x2d, 0xc6, 0x83, 0xe7, 0xec, 0x68, 0x1b, 0xca,
+	0x74, 0x36, 0x3e, 0x09, 0x46, 0x7a, 0x49, 0x44, 0xa1, 0x18, 0x21, 0x04, 0xaa, 0x6f, 0x8f, 0x89,
+	0x5e, 0xe6, 0x54, 0xfe, 0x1f, 0x35, 0xa0, 0xe2, 0x12, 0xc7, 0x1b, 0xdb, 0x23, 0xaa, 0xaf, 0xed,
+	0x2a, 0x7b, 0xeb, 0x38, 0x19, 0x37, 0x0e, 0x99, 0xf5, 0xf2, 0x0e, 0x8a, 0x34, 0x28, 0x5e, 0x90,
+	0x19, 0xcf, 0x0f, 0x2a, 0x66, 0x7f, 0xd9, 0x29, 0x2e, 0xed, 0xd1, 0x94, 0xc8, 0x13, 0x8a, 0xc1,
+	0x5b, 0x85, 0x37, 0x15, 0xe3, 0x5f, 0x0a, 0xdc, 0x4c, 0xf4, 0x4d, 0xbb, 0xe3, 0x6d, 0xa8, 0x10,
+	0x9f, 0x5a, 0x81, 0x3f, 0x12, 0x92, 0x2a, 0x78, 0x8d, 0xf8, 0xb4, 0xe7, 0x8f, 0x66, 0x48, 0x87,
+	0xb5, 0x49, 0xe8, 0x5d, 0xda, 0x91, 0x90, 0x57, 0xc1, 0xf1, 0x10, 0xbd, 0x03, 0x65, 0xdb, 0x71,
+	0x08, 0xa5, 0xdc, 0x5c, 0x1b, 0x07, 0xff, 0x9f, 0x63, 0x94, 0xd4, 0x26, 0xfb, 0x4d, 0xce, 0x8c,
+	0xe5, 0x22, 0x63, 0x08, 0x65, 0x41, 0x41, 0x08, 0x36, 0x9e, 0x76, 0x9f, 0x74, 0x7b, 0xcf, 0xba,
+	0x56, 0xb3, 0xd5, 0x32, 0x07, 0x03, 0xed, 0x05, 0xb4, 0x05, 0xeb, 0xdd, 0x9e, 0xd5, 0x31, 0x3b,
+	0x8f, 0x4c, 0x3c, 0x78, 0xdc, 0xee, 0x6b, 0x0a, 0x73, 0xcb, 0x76, 0xf7, 0xa3, 0xf6, 0xb0, 0xc9,
+	0x3d, 0xab, 0xd7, 0x3d, 0xfe, 0x58, 0x2b, 0xb0, 0xf8, 0xeb, 0x75, 0x2d, 0x6c, 0x7e, 0xf8, 0xd4,
+	0x1c, 0x0c, 0xb5, 0xa2, 0xf1, 0x79, 0x11, 0xd6, 0xf9, 0x4d, 0xb4, 0x42, 0x2f, 0x22, 0xa1, 0x67,
+	0xa3, 0x1f, 0x3d, 0xc7, 0xbd, 0xf6, 0xe7, 0x2a, 0x67, 0x16, 0x7d, 0x01, 0xaf, 0x7a, 0x0d, 0xd4,
+	0x68, 0x36, 0x11, 0xc6, 0xb9, 0xca, 0x31, 0xd4, 0x28, 0xeb, 0x13, 0xc5, 0x5c, 0x9f, 0x50, 0x53,
+	0x3e, 0xb1, 0x0d, 0x65, 0x7b, 0xcc, 0xf2, 0x61, 0xec, 0x3f, 0x62, 0xc4, 0x72, 0x3f, 0x77, 0x32,
+	0xcb, 0x73, 0xa9, 0x5e, 0xde, 0x2d, 0xee, 0xa9, 0xb8, 0xc2, 0x09, 0x6d, 0x97, 0xa2, 0xfb, 0x50,
+	0x63, 0xb7, 0x39, 0xb1, 0xa3, 0x88, 0x84, 0x3e, 0xf7, 0xa5, 0x2a, 0x06, 0xe2, 0xd3, 0xbe, 0xa0,
+	0x64, 0x3c, 0xad, 0xc2, 0x1d, 0xe7, 0xeb, 0xf6, 0xb4, 0x7f, 0x17, 0x40, 0xcf, 0x1a, 0x60, 0xee,
+	0x09, 0x68, 0x03, 0x0a, 0xb2, 0xa2, 0x55, 0x71, 0xc1, 0x73, 0xd1, 0xdb, 0x19, 0x13, 0xbe, 0xbc,
+	0xca, 0x84, 0x73, 0x09, 0xfb, 0x29, 0x6b, 0xbe, 0x0b, 0x1b, 0xc2, 0x12, 0x8e, 0xbc, 0x3b, 0x5e,
+	0x28, 0x6a, 0x07, 0x3b, 0x2b, 0xae, 0x16, 0xaf, 0x47, 0xe9, 0x21, 0x73, 0x7d, 0x59, 0x28, 0xa9,
+	0xae, 0xee, 0x16, 0xf7, 0xaa, 0x78, 0x4d, 0x54, 0x4a, 0x8a, 0xee, 0x01, 0x78, 0xd4, 0x8a, 0xbd,
+	0xbf, 0xc4, 0xbd, 0xbf, 0xea, 0xd1, 0xbe, 0x20, 0x18, 0x9f, 0x81, 0xca, 0x63, 0xfc, 0x2e, 0xe8,
+	0xb1, 0xfb, 0x8a, 0xac, 0x3f, 0xcf, 0xb5, 0xda, 0x0b, 0x48, 0x83, 0xfa, 0x23, 0xb3, 0xd5, 0xeb,
+	0xc4, 0x25, 0x42, 0x61, 0xae, 0x2d, 0x29, 0xc2, 0xbd, 0xb5, 0x02, 0xba, 0x09, 0x5a, 0xab, 0xd9,
+	0xb5, 0x3e, 0x6a, 0x9b, 0xcf, 0xac, 0xd6, 0xe3, 0x66, 0xb7, 0x6b, 0x1e, 0x8b, 0xb4, 0x9d, 0x50,
+	0x9b, 0xdd, 0x43, 0xab, 0xdf, 0x1b, 0x0c, 0x93, 0x69, 0xd5, 0xf8, 0x4b, 0x3d, 0x15, 0xcd, 0x87,
+	0xd9, 0x34, 0x26, 0x4a, 0xbc, 0x92, 0x2a, 0xf1, 0xc8, 0x84, 0x35, 0x81, 0x0e, 0xe2, 0x6a, 0xfc,
+	0xcd, 0x1c, 0x43, 0xa7, 0xc4, 0xec, 0x8b, 0xb2, 0x2a, 0x3d, 0x3f, 0x5e, 0x8b, 0xde, 0x87, 0xda,
+	0x64, 0x1e, 0xd4, 0xdc, 0x85, 0x6b, 0x07, 0x2f, 0x3e, 0x3f, 0xf4, 0x71, 0x7a, 0x09, 0x3a, 0x80,
+	0x4a, 0x0c, 0x81, 0xb8, 0x51, 0x6b, 0x07, 0xdb, 0xa9, 0xe5, 0xdc, 0xf6, 0x62, 0x16, 0x27, 0x7c,
+	0xe8, 0x3d, 0x28, 0xb1, 0x5b, 0x11, 0xbe, 0x5e, 0x3b, 0x78, 0x78, 0x85, 0xea, 0x4c, 0x8a, 0x54,
+	0x5c, 0xac, 0x63, 0xd7, 0x7c, 0x62, 0xfb, 0xd6, 0xc8, 0xa3, 0x91, 0xbe, 0x26, 0xae, 0xf9, 0xc4,
+	0xf6, 0x8f, 0x3d, 0x1a, 0xa1, 0x2e, 0x80, 0x63, 0x47, 0xe4, 0x2c, 0x08, 0x19, 0xcc, 0xa8, 0x2c,
+	0x26, 0x86, 0xfc, 0x0d, 0x92, 0x05, 0x62, 0x97, 0x94, 0x04, 0xf4, 0x26, 0xe8, 0x76, 0xe8, 0x9c,
+	0x7b, 0x97, 0xc4, 0x1a, 0xdb, 0x67, 0x3e, 0x89, 0x46, 0x9e, 0x7f, 0x61, 0x89, 0x1b, 0xa9, 0xf2,
+	0x1b, 0xd9, 0x96, 0xf3, 0x9d, 0x64, 0xba, 0xc5, 0xaf, 0xe8, 0x08, 0x36, 0x6c, 0x77, 0xec, 0xf9,
+	0x16, 0x25, 0x51, 0xe4, 0xf9, 0x67, 0x54, 0x07, 0x6e, 0x9f, 0xdd, 0x1c, 0x6d, 0x9a, 0x8c, 0x71,
+	0x20, 0xf9, 0xf0, 0xba, 0x9d, 0x1e, 0xa2, 0xff, 0x83, 0x75, 0xcf, 0x8f, 0xc2, 0xc0, 0x1a, 0x13,
+	0x4a, 0x59, 0x41, 0xab, 0xf1, 0x60, 0xab, 0x73, 0x62, 0x47, 0xd0, 0x18, 0x53, 0x30, 0x4d, 0x33,
+	0xd5, 0x05, 0x53, 0x30, 0x4d, 0x31, 0xdd, 0x85, 0x2a, 0xf1, 0x9d, 0x70, 0x36, 0x89, 0x88, 0xab,
+	0xaf, 0x8b, 0x10, 0x48, 0x08, 0x2c, 0x65, 0x45, 0xf6, 0x19, 0xd5, 0x37, 0xb8, 0x45, 0xf9, 0x7f,
+	0x64, 0xc3, 0x96, 0x08, 0xc8, 0xb4, 0x9b, 0x6c, 0x72, 0xab, 0x7e, 0xfb, 0x0a, 0xab, 0x2e, 0x84,
+	0xb9, 0xb4, 0xad, 0x16, 0x2d, 0x90, 0xd1, 0x0f, 0xe1, 0xf6, 0x1c, 0x1c, 0xf3, 0x59, 0x6a, 0x8d,
+	0x25, 0x20, 0xd0, 0xb5, 0xdd, 0xe2, 0x0a, 0x93, 0x65, 0x80, 0x03, 0xde, 0x71, 0x32, 0x74, 0x1a,
+	0x4f, 0xa0, 0xd7, 0xe0, 0xa6, 0xed, 0x44, 0xfc, 0xfa, 0x84, 0xcf, 0x5b, 0x1c, 0x91, 0xea, 0x5b,
+	0xfc, 0xee, 0x90, 0x98, 0x93, 0xc1, 0xd1, 0x62, 0x33, 0xa8, 0x03, 0x1a, 0xc3, 0xbe, 0x99, 0x13,
+	0x23, 0xae, 0x86, 0x91, 0xa3, 0x06, 0x43, 0xa2, 0xe9, 0xe0, 0xd8, 0x0c, 0xb3, 0x04, 0x34, 0x00,
+	0x24, 0x77, 0x3e, 0xf7, 0x26, 0xd6, 0xc4, 0x9e, 0x8d, 0x89, 0x1f, 0xe9, 0x37, 0xb8, 0x2b, 0xbc,
+	0xb4, 0x12, 0xff, 0x32, 0xe6, 0xbe, 0xe0, 0xc5, 0x5b, 0xe3, 0x45, 0x12, 0x7a, 0x07, 0x6a, 0x64,
+	0x1c, 0x7c, 0xea, 0x59, 0x13, 0xdb, 0xb9, 0xa0, 0xfa, 0x4d, 0xae, 0x5e, 0x5e, 0xb9, 0x32, 0x19,
+	0x57, 0xdf, 0x76, 0x2e, 0x30, 0x90, 0xf8, 0x2f, 0x45, 0xef, 0x41, 0xf5, 0x84, 0xf9, 0x28, 0x0f,
+	0xa0, 0x5b, 0x7c, 0xf1, 0x83, 0x9c, 0xc5, 0x8f, 0x62, 0x1e, 0x71, 0x75, 0xf3, 0x35, 0xe8, 0x65,
+	0xd8, 0xa4, 0xbe, 0x3d, 0xa1, 0xe7, 0x41, 0x64, 0xd1, 0x89, 0xed, 0x10, 0xaa, 0x6f, 0x73, 0xaf,
+	0xd9, 0x88, 0xc9, 0x03, 0x4e, 0x6d, 0x3c, 0x85, 0x7a, 0x3a, 0xf3, 0xa4, 0xcb, 0x4e, 0x55, 0x94,
+	0x9d, 0x57, 0xd3, 0x65, 0x27, 0xf3, 0xaa, 0x58, 0x30, 0x49, 0xaa, 0x22, 0x35, 0x3e, 0x04, 0x98,
+	0x67, 0x85, 0x1c, 0xa1, 0xaf, 0x64, 0x85, 0xee, 0xe4, 0x08, 0x65, 0xeb, 0xd3, 0x22, 0x3f, 0x81,
+	0xcd, 0x85, 0x3c, 0x90, 0x23, 0xf7, 0xf5, 0xac, 0xdc, 0x3b, 0x79, 0x72, 0x85, 0x90, 0x59, 0x5a,
+	0xf6, 0x19, 0xdc, 0xca, 0x8d, 0x86, 0x9c, 0x1d, 0xde, 0xcc, 0xee, 0x60, 0x5c, 0x5d, 0x3f, 0x53,
+	0x1b, 0x7d, 0xa0, 0x56, 0x76, 0x34, 0xdd, 0xf8, 0xad, 0x02, 0x8d, 0xd5, 0xfe, 0x24, 0x8b, 0xa4,
+	0xe7, 0xc7, 0x2f, 0x51, 0x95, 0x17, 0x49, 0xcf, 0x6f, 0xbb, 0xe8, 0x21, 0x68, 0x8b, 0xf0, 0x4a,
+	0xc2, 0x81, 0xcd, 0x05, 0xb0, 0x94, 0x02, 0x33, 0xc5, 0x0c, 0x98, 0xb9, 0x0b, 0xd5, 0x90, 0x38,
+	0xde, 0xc4, 0x63, 0x6e, 0x2e, 0xd0, 0xcf, 0x9c, 0x60, 0x9c, 0xc1, 0xfd, 0xd5, 0x9a, 0xf5, 0xc3,
+	0x20, 0x38, 0xbd, 0x42, 0xbd, 0x28, 0xb4, 0x7d, 0xca, 0xa2, 0x36, 0xf0, 0xad, 0x73, 0x9b, 0x9e,
+	0xc7, 0xea, 0xa5, 0xe8, 0x8f, 0x6d, 0x7a, 0xce, 0x6c, 0xa0, 0xaf, 0x0a, 0x52, 0xf4, 0x06, 0xa8,
+	0x2c, 0x4c, 0xb9, 0xf8, 0x6b, 0xbc, 0x85, 0x39, 0x33, 0x3a, 0xca, 0xd6, 0xca, 0xc2, 0x6e, 0x71,
+	0x05, 0x4c, 0x96, 0x6b, 0x57, 0x95, 0x4c, 0xe3, 0xc7, 0xb0, 0x9d, 0x9f, 0xf8, 0xd1, 0x21, 0xdc,
+	0x9f, 0x78, 0x7e, 0x9c, 0xc2, 0x2d, 0x7b, 0x34, 0x4a, 0xb2, 0x16, 0xf1, 0xed, 0x93, 0x11, 0x71,
+	0x25, 0xa0, 0xbf, 0x33, 0xf1, 0x7c, 0x99, 0xd4, 0x9b, 0xa3, 0x51, 0x12, 0x61, 0x9c, 0xc5, 0xf8,
+	0x47, 0x01, 0xd6, 0x33, 0x6e, 0x8e, 0xde, 0x9d, 0xa3, 0x05, 0x01, 0x95, 0x5f, 0x5a, 0x11, 0x10,
+	0xd7, 0x83, 0x09, 0x85, 0xaf, 0x06, 0x13, 0x8a, 0xd7, 0x84, 0x09, 0xf7, 0xa1, 0x26, 0x0b, 0x31,
+	0x6f, 0x9a, 0x08, 0x5f, 0x8a, 0x6b, 0x33, 0xeb, 0x99, 0x34, 0xa0, 0x32, 0x09, 0xa8, 0xc7, 0x1f,
+	0x80, 0x0c, 0x7b, 0x94, 0x70, 0x32, 0xfe, 0x2f, 0x25, 0x1e, 0xc3, 0x85, 0xad, 0xa5, 0x48, 0x5f,
+	0x54, 0x54, 0x59, 0x52, 0x34, 0x7e, 0x0c, 0x14, 0xb2, 0x0f, 0xc4, 0x44, 0xf9, 0x62, 0x56, 0x79,
+	0xe6, 0xbc, 0x37, 0x92, 0x6d, 0xda, 0xfe, 0xa5, 0x17, 0xd9, 0x8c, 0x8e, 0xde, 0x80, 0x5b, 0xf3,
+	0x52, 0x99, 0x7e, 0xfe, 0x8a, 0x86, 0xd2, 0x4d, 0x67, 0x05, 0x80, 0x3c, 0x63, 0x5d, 0x28, 0xd9,
+	0x55, 0x12, 0x83, 0xd5, 0x2d, 0xa5, 0x7b, 0x00, 0x93, 0xe9, 0xc9, 0xc8, 0x73, 0x2c, 0x66, 0x2f,
+	0x95, 0xaf, 0xa9, 0x0a, 0xca, 0x13, 0x32, 0x33, 0x7e, 0xa3, 0xc0, 0xe6, 0x42, 0xbb, 0x87, 0xbd,
+	0x2a, 0xe3, 0x64, 0x21, 0xce, 0x1e, 0x0f, 0x59, 0x32, 0xa0, 0xde, 0x99, 0x6f, 0x47, 0xd3, 0x90,
+	0xc8, 0xfd, 0xe7, 0x04, 0xf6, 0xee, 0x89, 0x23, 0x5d, 0x74, 0x84, 0x54, 0x5c, 0x91, 0xa1, 0x4e,
+	0xd1, 0xb7, 0x00, 0x79, 0xd4, 0xb2, 0xbd, 0xd0, 0x0d, 0x83, 0x49, 0x92, 0x8c, 0x54, 0xee, 0xfe,
+	0x9a, 0x47, 0x9b, 0x62, 0x42, 0x66, 0x23, 0xe3, 0x57, 0xe9, 0x8e, 0x04, 0x26, 0x3f, 0x99, 0x12,
+	0x1a, 0x0d, 0x83, 0x0f, 0x02, 0x6f, 0x15, 0x80, 0x96, 0x8f, 0xe4, 0xd4, 0xb5, 0xb0, 0x47, 0x72,
+	0x97, 0xdd, 0xcc, 0x4a, 0xd3, 0x2c, 0xb6, 0xf1, 0xd4, 0xe5, 0x36, 0xde, 0x03, 0xa8, 0xbb, 0x1e,
+	0x9d, 0x8c, 0xec, 0x99, 0x10, 0x5d, 0x92, 0x7d, 0x09, 0x41, 0xe3, 0xe2, 0x73, 0x5b, 0x6a, 0xe5,
+	0x2f, 0xde, 0x52, 0xfb, 0x41, 0x2e, 0xb0, 0x58, 0xdb, 0x55, 0x56, 0x40, 0xea, 0xfc, 0x74, 0x9b,
+	0x87, 0x2e, 0xde, 0x62, 0x5d, 0x82, 0xe0, 0xd4, 0x1b, 0x11, 0xfe, 0xa0, 0xcc, 0xc7, 0x5f, 0x42,
+	0x5c, 0x5f, 0xf0, 0xe1, 0x78, 0x81, 0xf1, 0x47, 0x05, 0xee, 0xa6, 0x22, 0xc4, 0x77, 0xc8, 0xe8,
+	0x7f, 0xfa, 0x3a, 0x8c, 0x5f, 0x17, 0xe0, 0xc5, 0x7c, 0xcf, 0xc1, 0x84, 0x4e, 0x02, 0x9f, 0x92,
+	0x15, 0x2a, 0x7f, 0x0f, 0xaa, 0xc9, 0x56, 0xcf, 0x49, 0x89, 0xa9, 0x50, 0xc4, 0xf3, 0x05, 0x2c,
+	0xfc, 0x59, 0xeb, 0x84, 0x23, 0xf1, 0x22, 0x77, 0xea, 0x64, 0x3c, 0x8f, 0x58, 0x35, 0x1d, 0xb1,
+	0x8b, 0xc7, 0x2d, 0x2d, 0x1f, 0xf7, 0x1e, 0x80, 0x78, 0xa4, 0x58, 0xd3, 0xd0, 0x93, 0xed, 0xa8,
+	0xaa, 0xa0, 0x3c, 0x0d, 0x3d, 0x26, 0x21, 0x7e, 0xcb, 0x4c, 0x43, 0x8f, 0xca, 0xa7, 0x53, 0x4d,
+	0xd2, 0x9e, 0x86, 0x1e, 0x35, 0x30, 0xec, 0x2c, 0x1b, 0xe3, 0x98, 0xd8, 0x97, 0xab, 0xac, 0xb0,
+	0xa8, 0x55, 0x61, 0x49, 0x2b, 0xe3, 0xe7, 0xf0, 0x20, 0xe5, 0x35, 0xa2, 0x68, 0x2d, 0x3e, 0x99,
+	0x56, 0x48, 0xcf, 0x1e, 0xa8, 0x70, 0xd5, 0x81, 0x8a, 0xcb, 0x07, 0x9a, 0xc2, 0xbd, 0x43, 0x32,
+	0x22, 0x11, 0x59, 0x70, 0x5c, 0xa9, 0x08, 0xfd, 0xd2, 0xc7, 0xca, 0x76, 0xec, 0x85, 0x67, 0x26,
+	0x1d, 0x7b, 0xe3, 0x4f, 0x0a, 0xd4, 0x9e, 0xd9, 0x17, 0x53, 0xb9, 0x0d, 0x2b, 0x3f, 0xd4, 0x3b,
+	0x93, 0x79, 0x9a, 0xfd, 0x65, 0xa9, 0x31, 0xf2, 0xc6, 0x84, 0x46, 0xf6, 0x78, 0xc2, 0xc5, 0xab,
+	0x78, 0x4e, 0x60, 0x5a, 0x45, 0xc1, 0xc4, 0x73, 0xb8, 0xe0, 0x3a, 0x16, 0x03, 0xde, 0xbe, 0xb3,
+	0x67, 0xa3, 0xc0, 0x8e, 0x9d, 0x3d, 0x1e, 0x8a, 0x19, 0xd7, 0xf5, 0xfc, 0x33, 0xe9, 0x17, 0xf1,
+	0x90, 0xd5, 0x1e, 0x8e, 0x93, 0xca, 0x9c, 0xcc, 0xff, 0x23, 0x03, 0xea, 0xd1, 0xb9, 0x17, 0xba,
+	0x7d, 0x3b, 0x64, 0x47, 0x91, 0x4d, 0xa5, 0x0c, 0xcd, 0xf8, 0x0c, 0x1a, 0xa9, 0x03, 0xc4, 0x17,
+	0x16, 0x3f, 0xab, 0x74, 0x58, 0xbb, 0x24, 0x21, 0x8d, 0x6b, 0xcf, 0x3a, 0x8e, 0x87, 0x6c, 0xbf,
+	0xd3, 0x30, 0x18, 0xcb, 0x23, 0xf1, 0xff, 0xac, 0x47, 0x14, 0x05, 0xfc, 0x28, 0x2a, 0x2e, 0x44,
+	0x01, 0xdb, 0x9f, 0xc1, 0x49, 0xe2, 0x47, 0x43, 0x7e, 0x48, 0xd6, 0xaa, 0xa9, 0xe3, 0x0c, 0xcd,
+	0xf8, 0x83, 0x02, 0x68, 0x59, 0x81, 0xe7, 0x6c, 0xfc, 0x3e, 0x54, 0x92, 0x67, 0x63, 0x61, 0xf1,
+	0x79, 0xb5, 0xfa, 0x28, 0x38, 0x59, 0x85, 0x5e, 0x67, 0x12, 0x84, 0x5b, 0xc8, 0xbe, 0xd3, 0xad,
+	0x5c, 0x09, 0x38, 0x61, 0x33, 0xfe, 0xac, 0xc0, 0xfd, 0x65, 0xd9, 0x6d, 0xdf, 0x25, 0x3f, 0xbd,
+	0x86, 0xad, 0xbe, 0xba, 0xca, 0xdb, 0x50, 0x0e, 0x4e, 0x4f, 0x29, 0x89, 0xa4, 0x75, 0xe5, 0x88,
+	0xdd, 0x02, 0xf5, 0x7e, 0x46, 0xe4, 0x77, 0x21, 0xfe, 0x7f, 0xd1, 0x47, 0xd4, 0xc4, 0x47, 0x8c,
+	0xbf, 0x29, 0xb0, 0xb3, 0xe2, 0x14, 0xe8, 0x09, 0x54, 0x64, 0x3c, 0xc5, 0xe0, 0xf1, 0xd5, 0xe7,
+	0xe9, 0xc8, 0x17, 0xed, 0xcb, 0x81, 0xc4, 0x91, 0x89, 0x80, 0xc6, 0x29, 0xac, 0x67, 0xa6, 0x72,
+	0x60, 0xd9, 0x7b, 0x59, 0x58, 0xf6, 0xf0, 0xca, 0xcd, 0x12, 0xab, 0xa4, 0x60, 0xda, 0xa7, 0x80,
+	0x96, 0x9f, 0xc0, 0x4b, 0xad, 0xca, 0x3c, 0x58, 0xf6, 0x1a, 0x94, 0xf9, 0x43, 0x39, 0xf6, 0x00,
+	0x7d, 0xd5, 0xa3, 0x1a, 0x4b, 0x3e, 0x03, 0xc3, 0x46, 0x76, 0x66, 0x69, 0x1f, 0x86, 0x82, 0xce,
+	0x83, 0x30, 0x72, 0x02, 0x37, 0xde, 0x6c, 0x4e, 0x48, 0x02, 0x54, 0x76, 0x8a, 0xd9, 0x7f, 0xe6,
+	0xfc, 0xdb, 0xf9, 0x95, 0xf6, 0xcb, 0xe7, 0xab, 0xc5, 0x5a, 0x58, 0x5c, 0x86, 0x26, 0xaf, 0xc4,
+	0x9f, 0x4c, 0xd4, 0xc5, 0x67, 0x73, 0x0c, 0xcf, 0xdb, 0x6c, 0x5a, 0x7e, 0x4b, 0x31, 0xfa, 0xb0,
+	0xb3, 0xa2, 0x57, 0xb0, 0x80, 0x22, 0x85, 0x29, 0xe6, 0x28, 0x92, 0xb9, 0x6d, 0x48, 0x6c, 0x9a,
+	0x7c, 0xb8, 0x91, 0x23, 0xe3, 0xef, 0x0a, 0xdc, 0xce, 0xab, 0x9c, 0x87, 0x64, 0x14, 0xd9, 0xd7,
+	0xf9, 0x8c, 0x7a, 0x0f, 0xe0, 0xc4, 0xa6, 0x44, 0x36, 0xe8, 0x64, 0x5a, 0x65, 0x14, 0xd1, 0x93,
+	0x4b, 0x8c, 0x57, 0x4c, 0x1b, 0x2f, 0x83, 0x52, 0xd5, 0x45, 0x94, 0xfa, 0x2e, 0xc7, 0x1f, 0x3e,
+	0x4b, 0x0a, 0xa5, 0x95, 0x8f, 0xa7, 0x94, 0xae, 0x2d, 0xce, 0x8c, 0xe3, 0x45, 0xc6, 0xe7, 0x05,
+	0x68, 0xac, 0xe6, 0x43, 0xef, 0xc8, 0x7e, 0xb9, 0x78, 0x8b, 0x3e, 0xbc, 0x8e, 0xec, 0x74, 0xc7,
+	0x5c, 0x06, 0x50, 0x61, 0x1e, 0x40, 0x3a, 0xac, 0x85, 0x64, 0x1c, 0x5c, 0x26, 0xc0, 0x22, 0x1e,
+	0xce, 0x3b, 0xfc, 0x12, 0x57, 0xf0, 0x81, 0x41, 0x64, 0xe7, 0x3b, 0xf5, 0xe1, 0x86, 0xb5, 0xa5,
+	0x8f, 0xd8, 0x77, 0x42, 0x80, 0xb2, 0x6c, 0x6b, 0x2b, 0xa8, 0x02, 0x6a, 0xeb, 0x71, 0x73, 0xa8,
+	0x15, 0x50, 0x1d, 0x2a, 0xad, 0xe6, 0xd0, 0x3c, 0xea, 0xe1, 0x8f, 0xb5, 0x22, 0x6b, 0x77, 0x2f,
+	0x75, 0xca, 0x55, 0xb4, 0x09, 0xb5, 0xd4, 0xa7, 0x43, 0xad, 0x64, 0xfc, 0x5e, 0x81, 0x3b, 0xb9,
+	0xa0, 0x48, 0xa0, 0x8c, 0xeb, 0x5c, 0x6e, 0x72, 0x7b, 0x85, 0xf4, 0xed, 0x7d, 0x9d, 0x9f, 0x96,
+	0xff, 0xaa, 0x40, 0x7d, 0xee, 0xd2, 0xb6, 0x9f, 0x2d, 0xf2, 0x4a, 0xb6, 0xc8, 0x33, 0x95, 0xc7,
+	0x81, 0x4b, 0x42, 0x3b, 0x0a, 0x92, 0xcf, 0xf6, 0x55, 0x5c, 0x4b, 0x68, 0x6d, 0x37, 0xe5, 0xe8,
+	0xc5, 0xb4, 0xa3, 0xb3, 0x4e, 0x46, 0x5c, 0x43, 0x2c, 0x97, 0xe3, 0x13, 0x57, 0xbe, 0x6d, 0x36,
+	0x63, 0xba, 0x80, 0x2d, 0xa9, 0x53, 0x97, 0x9e, 0x17, 0xf0, 0xe5, 0x25, 0x73, 0x3d, 0x5a, 0xff,
+	0xa4, 0xb6, 0xff, 0xea, 0xdb, 0xb1, 0x19, 0x4e, 0xca, 0xfc, 0xdf, 0x1b, 0xff, 0x19, 0x00, 0xc7,
+	0x40, 0x1b, 0xc4, 0xd5, 0x20, 0x00, 0x00,
 }
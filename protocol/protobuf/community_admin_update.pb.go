@@ -40,6 +40,7 @@ const (
 	CommunityAdminEvent_COMMUNITY_MEMBER_KICK                    CommunityAdminEvent_EventType = 14
 	CommunityAdminEvent_COMMUNITY_MEMBER_BAN                     CommunityAdminEvent_EventType = 15
 	CommunityAdminEvent_COMMUNITY_MEMBER_UNBAN                   CommunityAdminEvent_EventType = 16
+	CommunityAdminEvent_COMMUNITY_TOKEN_ADD                      CommunityAdminEvent_EventType = 17
 )
 
 var CommunityAdminEvent_EventType_name = map[int32]string{
@@ -60,6 +61,7 @@ var CommunityAdminEvent_EventType_name = map[int32]string{
 	14: "COMMUNITY_MEMBER_KICK",
 	15: "COMMUNITY_MEMBER_BAN",
 	16: "COMMUNITY_MEMBER_UNBAN",
+	17: "COMMUNITY_TOKEN_ADD",
 }
 
 var CommunityAdminEvent_EventType_value = map[string]int32{
@@ -80,6 +82,7 @@ var CommunityAdminEvent_EventType_value = map[string]int32{
 	"COMMUNITY_MEMBER_KICK":                    14,
 	"COMMUNITY_MEMBER_BAN":                     15,
 	"COMMUNITY_MEMBER_UNBAN":                   16,
+	"COMMUNITY_TOKEN_ADD":                      17,
 }
 
 func (x CommunityAdminEvent_EventType) String() string {
@@ -102,6 +105,7 @@ type CommunityAdminEvent struct {
 	MembersAdded           map[string]*CommunityMember          `protobuf:"bytes,9,rep,name=membersAdded,proto3" json:"membersAdded,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	RejectedRequestsToJoin map[string]*CommunityRequestToJoin   `protobuf:"bytes,10,rep,name=rejectedRequestsToJoin,proto3" json:"rejectedRequestsToJoin,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	AcceptedRequestsToJoin map[string]*CommunityRequestToJoin   `protobuf:"bytes,11,rep,name=acceptedRequestsToJoin,proto3" json:"acceptedRequestsToJoin,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TokenMetadata          *CommunityTokenMetadata              `protobuf:"bytes,12,opt,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}                             `json:"-"`
 	XXX_unrecognized       []byte                               `json:"-"`
 	XXX_sizecache          int32                                `json:"-"`
@@ -209,6 +213,13 @@ func (m *CommunityAdminEvent) GetAcceptedRequestsToJoin() map[string]*CommunityR
 	return nil
 }
 
+func (m *CommunityAdminEvent) GetTokenMetadata() *CommunityTokenMetadata {
+	if m != nil {
+		return m.TokenMetadata
+	}
+	return nil
+}
+
 type CommunityConfig struct {
 	Identity             *ChatIdentity           `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
 	Permissions          *CommunityPermissions   `protobuf:"bytes,2,opt,name=permissions,proto3" json:"permissions,omitempty"`
@@ -431,65 +442,67 @@ func init() {
 }
 
 var fileDescriptor_22a3f5c92e845a9d = []byte{
-	// 950 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0xe2, 0x46,
-	0x14, 0xae, 0x03, 0x24, 0xf8, 0x18, 0x88, 0x33, 0xbb, 0xc9, 0x3a, 0xec, 0x4f, 0x29, 0xad, 0x54,
-	0x5f, 0x54, 0x44, 0x65, 0xa5, 0x2a, 0x6d, 0x6e, 0xea, 0x98, 0x51, 0xd6, 0xc9, 0x62, 0xd2, 0xc1,
-	0x51, 0xb5, 0x7b, 0xe3, 0x3a, 0xf6, 0x2c, 0x71, 0x13, 0x6c, 0x16, 0x0f, 0x2b, 0x71, 0xdb, 0x97,
-	0xe8, 0x4d, 0x9f, 0xa1, 0xcf, 0xd1, 0xc7, 0xaa, 0x3c, 0xb6, 0xc1, 0x80, 0x97, 0xe6, 0xa2, 0x37,
-	0xe0, 0x39, 0xdf, 0xf9, 0xbe, 0xef, 0xcc, 0x68, 0x66, 0xce, 0xc0, 0x0b, 0x37, 0x1c, 0x8f, 0x67,
-	0x81, 0xcf, 0xe6, 0xb6, 0xe3, 0x8d, 0xfd, 0xc0, 0x9e, 0x4d, 0x3c, 0x87, 0xd1, 0xce, 0x64, 0x1a,
-	0xb2, 0x10, 0x55, 0xf9, 0xdf, 0xed, 0xec, 0x43, 0xf3, 0x89, 0x7b, 0xe7, 0x30, 0xdb, 0xf7, 0x68,
-	0xc0, 0x7c, 0x36, 0x4f, 0xe0, 0xe6, 0x41, 0x46, 0xf6, 0x69, 0x94, 0x84, 0xda, 0xff, 0xd4, 0xe1,
-	0x89, 0x9e, 0x49, 0x6a, 0xb1, 0x22, 0xfe, 0x44, 0x03, 0x86, 0x9e, 0x42, 0xc5, 0x7d, 0x08, 0xdd,
-	0x7b, 0x45, 0x68, 0x09, 0x6a, 0x99, 0x24, 0x03, 0xf4, 0x15, 0xd4, 0x96, 0xfe, 0xbe, 0xa7, 0xec,
-	0xb4, 0x04, 0xb5, 0x46, 0xa4, 0x45, 0xcc, 0xf0, 0xd0, 0x19, 0x94, 0xd9, 0x7c, 0x42, 0x95, 0x52,
-	0x4b, 0x50, 0x1b, 0xdd, 0x6f, 0x3b, 0x59, 0x45, 0x9d, 0x02, 0x97, 0x0e, 0xff, 0xb5, 0xe6, 0x13,
-	0x4a, 0x38, 0x09, 0xf5, 0x40, 0x5e, 0xea, 0xbb, 0x61, 0xf0, 0xc1, 0x1f, 0x29, 0xe5, 0x96, 0xa0,
-	0x4a, 0xdd, 0xe3, 0x02, 0x21, 0x9d, 0x27, 0x90, 0x7d, 0x77, 0x35, 0x80, 0x7e, 0x83, 0x03, 0x16,
-	0xde, 0xd3, 0xc0, 0x9e, 0xd0, 0xe9, 0xd8, 0x8f, 0x22, 0x3f, 0x0c, 0x22, 0xa5, 0xd2, 0x2a, 0xa9,
-	0x52, 0xf7, 0xf5, 0xf6, 0x7a, 0xac, 0x98, 0x76, 0xbd, 0x64, 0xe1, 0x80, 0x4d, 0xe7, 0x44, 0x66,
-	0x6b, 0x61, 0x74, 0x06, 0x75, 0xd7, 0x61, 0x74, 0x14, 0x4e, 0xe7, 0xb6, 0xe7, 0x30, 0x47, 0xd9,
-	0xe5, 0x45, 0x1e, 0xe5, 0xd4, 0x53, 0xb8, 0xe7, 0x30, 0x87, 0xd4, 0xdc, 0xdc, 0x08, 0x9d, 0x42,
-	0xcd, 0xbd, 0x73, 0x82, 0x80, 0x3e, 0x24, 0xdc, 0x3d, 0xce, 0x3d, 0xcc, 0x71, 0x13, 0x94, 0x53,
-	0x25, 0x77, 0x39, 0x40, 0x2a, 0xc8, 0x63, 0x3a, 0xbe, 0xa5, 0x53, 0x9b, 0x85, 0xb6, 0xe3, 0x32,
-	0x3f, 0x0c, 0x94, 0x6a, 0x4b, 0x50, 0x45, 0xd2, 0x48, 0xe2, 0x56, 0xa8, 0xf1, 0x28, 0x1a, 0x42,
-	0x2d, 0x89, 0x44, 0x9a, 0xe7, 0x51, 0x4f, 0x11, 0xf9, 0xec, 0x4f, 0xb6, 0xcf, 0xbe, 0x9f, 0x63,
-	0x24, 0x33, 0x5f, 0x11, 0x41, 0x1f, 0xe1, 0x68, 0x4a, 0x7f, 0xa7, 0x2e, 0xa3, 0x1e, 0xa1, 0x1f,
-	0x67, 0x34, 0x62, 0x91, 0x15, 0x5e, 0x86, 0x7e, 0xa0, 0x00, 0x97, 0xff, 0x71, 0xbb, 0x3c, 0x29,
-	0xe4, 0x26, 0x46, 0x9f, 0x11, 0x8e, 0x2d, 0x1d, 0xd7, 0xa5, 0x93, 0x4d, 0x4b, 0xe9, 0x31, 0x96,
-	0x5a, 0x21, 0x37, 0xb5, 0x2c, 0x16, 0x6e, 0x8e, 0xe0, 0xb0, 0x70, 0x1b, 0x20, 0x19, 0x4a, 0xf7,
-	0x74, 0xce, 0x0f, 0x84, 0x48, 0xe2, 0x4f, 0x74, 0x0a, 0x95, 0x4f, 0xce, 0xc3, 0x8c, 0xf2, 0x73,
-	0x20, 0x75, 0xdb, 0x05, 0xc5, 0xac, 0x49, 0x91, 0x84, 0xf0, 0xd3, 0xce, 0xa9, 0xd0, 0x7c, 0x0f,
-	0x07, 0x1b, 0x2b, 0x5e, 0x60, 0x72, 0xb2, 0x6a, 0x52, 0x74, 0x10, 0x12, 0x99, 0xbc, 0xf6, 0x3d,
-	0x3c, 0xdf, 0xb2, 0xdc, 0x05, 0x2e, 0x3f, 0xac, 0xba, 0xb4, 0x0a, 0x5c, 0x52, 0xa1, 0x44, 0x67,
-	0xcd, 0x6c, 0xcb, 0x42, 0xff, 0xbf, 0x66, 0xed, 0x3f, 0xcb, 0x20, 0x2e, 0xae, 0x0d, 0x24, 0xc1,
-	0xde, 0x8d, 0x79, 0x65, 0x0e, 0x7e, 0x35, 0xe5, 0x2f, 0x10, 0x82, 0x86, 0x3e, 0xe8, 0xf7, 0x6f,
-	0x4c, 0xc3, 0x7a, 0x67, 0xe3, 0x9e, 0x61, 0xc9, 0x02, 0xfa, 0x0e, 0xd4, 0x65, 0xac, 0x8f, 0xfb,
-	0xe7, 0x98, 0xd8, 0xd6, 0xe0, 0x0a, 0x9b, 0xf6, 0x35, 0x26, 0x7d, 0x63, 0x38, 0x34, 0x06, 0xa6,
-	0xad, 0xbf, 0xd1, 0xcc, 0x0b, 0x2c, 0xef, 0x3c, 0x2e, 0xbb, 0x87, 0xdf, 0x62, 0x0b, 0xcb, 0x25,
-	0xf4, 0x12, 0x8e, 0x97, 0xd9, 0xba, 0x66, 0xe1, 0x8b, 0x01, 0x79, 0x67, 0xeb, 0x04, 0x6b, 0x16,
-	0x96, 0xcb, 0x9f, 0x81, 0x53, 0x76, 0x05, 0x3d, 0x87, 0x67, 0x05, 0x30, 0x2f, 0x7b, 0x17, 0xbd,
-	0x00, 0x25, 0x07, 0xbe, 0xd1, 0x4c, 0x13, 0xbf, 0xcd, 0x94, 0xf7, 0x8a, 0xd1, 0x54, 0xb8, 0x8a,
-	0x9a, 0x70, 0xb4, 0x89, 0x72, 0x5d, 0x11, 0xbd, 0x82, 0x66, 0x81, 0x29, 0xc1, 0x03, 0xd2, 0xc3,
-	0x44, 0x86, 0xb5, 0x9a, 0x53, 0x6e, 0x06, 0x4b, 0xe8, 0x1b, 0x68, 0x2d, 0x61, 0x82, 0x7f, 0xb9,
-	0xc1, 0x43, 0xcb, 0xb6, 0x06, 0xf6, 0xe5, 0xc0, 0x30, 0x6d, 0x4d, 0xd7, 0xf1, 0xb5, 0x25, 0xd7,
-	0xb6, 0x67, 0x11, 0x7c, 0x89, 0x75, 0x4b, 0xae, 0xa3, 0x63, 0x38, 0xdc, 0x58, 0xeb, 0x2b, 0x43,
-	0xbf, 0x92, 0x1b, 0x48, 0x81, 0xa7, 0x1b, 0xd0, 0xb9, 0x66, 0xca, 0xfb, 0xab, 0x73, 0x4b, 0x91,
-	0x1b, 0x33, 0xc6, 0xe4, 0xf6, 0xdf, 0x3b, 0xb0, 0xbf, 0xd6, 0x1b, 0x50, 0x17, 0xaa, 0x59, 0x0f,
-	0xe4, 0x1b, 0x70, 0xf5, 0x8e, 0xbe, 0x73, 0x98, 0x91, 0xa2, 0x64, 0x91, 0x87, 0x7e, 0x06, 0x29,
-	0xdf, 0x38, 0x92, 0x3d, 0xfa, 0xaa, 0x60, 0x8f, 0xe6, 0x6e, 0x08, 0x92, 0xa7, 0xa0, 0x0b, 0x68,
-	0x24, 0xcd, 0x39, 0xa2, 0x8c, 0xf9, 0xc1, 0x28, 0xe2, 0xdd, 0xb0, 0x78, 0xa3, 0xf3, 0xdb, 0x6a,
-	0x98, 0xe6, 0x91, 0xba, 0x93, 0x1f, 0xa2, 0xaf, 0xa1, 0xee, 0x07, 0x6c, 0x1a, 0xda, 0x63, 0x1a,
-	0x45, 0xce, 0x88, 0xf2, 0x66, 0x28, 0x92, 0x1a, 0x0f, 0xf6, 0x93, 0x58, 0x9c, 0x14, 0xce, 0xf2,
-	0x49, 0x95, 0x24, 0x89, 0x07, 0xb3, 0x24, 0x04, 0x65, 0xe6, 0x8c, 0x22, 0x65, 0xb7, 0x55, 0x52,
-	0x45, 0xc2, 0xbf, 0xdb, 0x7f, 0x08, 0x50, 0xcb, 0xf7, 0x29, 0xf4, 0x25, 0x48, 0x8b, 0xb6, 0xe6,
-	0x7b, 0xe9, 0x89, 0x85, 0x2c, 0x64, 0x78, 0xb1, 0x4a, 0xe0, 0x8c, 0x93, 0x73, 0x2b, 0x12, 0xfe,
-	0xcd, 0xdf, 0x04, 0x49, 0x8f, 0x8a, 0x6c, 0xdf, 0x8b, 0xa7, 0x1a, 0x3b, 0x64, 0x7d, 0x2b, 0x32,
-	0xbc, 0x08, 0x35, 0xa1, 0x3a, 0x09, 0x23, 0x9f, 0xf7, 0xab, 0x78, 0x06, 0x15, 0xb2, 0x18, 0xb7,
-	0xff, 0x12, 0x40, 0xca, 0x35, 0xbc, 0xff, 0xae, 0xe1, 0x25, 0x40, 0xd6, 0x3e, 0xd3, 0x17, 0x88,
-	0x48, 0xc4, 0x34, 0x62, 0x78, 0x2b, 0x5e, 0xa5, 0x55, 0x2f, 0xf4, 0x3d, 0xec, 0xa5, 0x89, 0xe9,
-	0xab, 0xe2, 0x59, 0xd1, 0xab, 0xe2, 0xce, 0x61, 0x24, 0xcb, 0x3b, 0xaf, 0xbf, 0x97, 0x3a, 0x27,
-	0x67, 0x59, 0xd6, 0xed, 0x2e, 0xff, 0x7a, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xd0,
-	0x35, 0x14, 0x87, 0x09, 0x00, 0x00,
+	// 983 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x6f, 0xe2, 0x46,
+	0x14, 0xad, 0x03, 0x24, 0xf8, 0x1a, 0x88, 0x33, 0xd9, 0x24, 0x0e, 0xfb, 0x51, 0x4a, 0x2b, 0x95,
+	0x87, 0x2a, 0x51, 0xb3, 0x52, 0x95, 0x36, 0x2f, 0x75, 0xcc, 0x28, 0xeb, 0x64, 0x31, 0xe9, 0xe0,
+	0xa8, 0xda, 0x7d, 0x71, 0x1d, 0x7b, 0x96, 0xb8, 0x09, 0x36, 0x8b, 0x87, 0x95, 0x78, 0xed, 0xef,
+	0xe8, 0x6f, 0xa8, 0xf6, 0x27, 0x56, 0x9e, 0xb1, 0xc1, 0x04, 0x87, 0xee, 0x43, 0x5f, 0x60, 0xe6,
+	0x9e, 0x7b, 0xce, 0x99, 0xf1, 0x7c, 0xdc, 0x81, 0x17, 0x5e, 0x34, 0x1a, 0x4d, 0xc3, 0x80, 0xcd,
+	0x1c, 0xd7, 0x1f, 0x05, 0xa1, 0x33, 0x1d, 0xfb, 0x2e, 0xa3, 0x47, 0xe3, 0x49, 0xc4, 0x22, 0x54,
+	0xe5, 0x7f, 0xb7, 0xd3, 0x0f, 0xcd, 0x5d, 0xef, 0xce, 0x65, 0x4e, 0xe0, 0xd3, 0x90, 0x05, 0x6c,
+	0x26, 0xe0, 0xe6, 0x4e, 0x46, 0x0e, 0x68, 0x2c, 0x42, 0xed, 0xcf, 0x0d, 0xd8, 0x35, 0x32, 0x49,
+	0x3d, 0x51, 0xc4, 0x9f, 0x68, 0xc8, 0xd0, 0x33, 0xa8, 0x78, 0x0f, 0x91, 0x77, 0xaf, 0x49, 0x2d,
+	0xa9, 0x53, 0x26, 0xa2, 0x83, 0xbe, 0x81, 0xda, 0xc2, 0x3f, 0xf0, 0xb5, 0x8d, 0x96, 0xd4, 0xa9,
+	0x11, 0x65, 0x1e, 0x33, 0x7d, 0x74, 0x06, 0x65, 0x36, 0x1b, 0x53, 0xad, 0xd4, 0x92, 0x3a, 0x8d,
+	0x93, 0xef, 0x8f, 0xb2, 0x11, 0x1d, 0x15, 0xb8, 0x1c, 0xf1, 0x5f, 0x7b, 0x36, 0xa6, 0x84, 0x93,
+	0x50, 0x17, 0xd4, 0x85, 0xbe, 0x17, 0x85, 0x1f, 0x82, 0xa1, 0x56, 0x6e, 0x49, 0x1d, 0xe5, 0xe4,
+	0xb0, 0x40, 0xc8, 0xe0, 0x09, 0x64, 0xdb, 0x5b, 0x0e, 0xa0, 0x3f, 0x60, 0x87, 0x45, 0xf7, 0x34,
+	0x74, 0xc6, 0x74, 0x32, 0x0a, 0xe2, 0x38, 0x88, 0xc2, 0x58, 0xab, 0xb4, 0x4a, 0x1d, 0xe5, 0xe4,
+	0xf5, 0xfa, 0xf1, 0xd8, 0x09, 0xed, 0x7a, 0xc1, 0xc2, 0x21, 0x9b, 0xcc, 0x88, 0xca, 0x1e, 0x85,
+	0xd1, 0x19, 0xd4, 0x3d, 0x97, 0xd1, 0x61, 0x34, 0x99, 0x39, 0xbe, 0xcb, 0x5c, 0x6d, 0x93, 0x0f,
+	0x72, 0x3f, 0xa7, 0x9e, 0xc2, 0x5d, 0x97, 0xb9, 0xa4, 0xe6, 0xe5, 0x7a, 0xe8, 0x14, 0x6a, 0xde,
+	0x9d, 0x1b, 0x86, 0xf4, 0x41, 0x70, 0xb7, 0x38, 0x77, 0x2f, 0xc7, 0x15, 0x28, 0xa7, 0x2a, 0xde,
+	0xa2, 0x83, 0x3a, 0xa0, 0x8e, 0xe8, 0xe8, 0x96, 0x4e, 0x1c, 0x16, 0x39, 0xae, 0xc7, 0x82, 0x28,
+	0xd4, 0xaa, 0x2d, 0xa9, 0x23, 0x93, 0x86, 0x88, 0xdb, 0x91, 0xce, 0xa3, 0x68, 0x00, 0x35, 0x11,
+	0x89, 0x75, 0xdf, 0xa7, 0xbe, 0x26, 0xf3, 0xd9, 0x1f, 0xaf, 0x9f, 0x7d, 0x2f, 0xc7, 0x10, 0x33,
+	0x5f, 0x12, 0x41, 0x1f, 0x61, 0x7f, 0x42, 0xff, 0xa4, 0x1e, 0xa3, 0x3e, 0xa1, 0x1f, 0xa7, 0x34,
+	0x66, 0xb1, 0x1d, 0x5d, 0x46, 0x41, 0xa8, 0x01, 0x97, 0xff, 0x79, 0xbd, 0x3c, 0x29, 0xe4, 0x0a,
+	0xa3, 0x27, 0x84, 0x13, 0x4b, 0xd7, 0xf3, 0xe8, 0x78, 0xd5, 0x52, 0xf9, 0x12, 0x4b, 0xbd, 0x90,
+	0x9b, 0x5a, 0x16, 0x0b, 0xa3, 0x0b, 0x68, 0x88, 0xdd, 0x33, 0xa2, 0xcc, 0xe5, 0x0b, 0x54, 0xe3,
+	0x0b, 0xd4, 0x2a, 0xb0, 0xe2, 0xfb, 0xa5, 0x97, 0xe6, 0x91, 0x3a, 0xcb, 0x77, 0x9b, 0x43, 0xd8,
+	0x2b, 0xdc, 0x4f, 0x48, 0x85, 0xd2, 0x3d, 0x9d, 0xf1, 0x93, 0x25, 0x93, 0xa4, 0x89, 0x4e, 0xa1,
+	0xf2, 0xc9, 0x7d, 0x98, 0x52, 0x7e, 0xa0, 0x94, 0x93, 0xf6, 0x53, 0x56, 0x0b, 0x29, 0x22, 0x08,
+	0xbf, 0x6c, 0x9c, 0x4a, 0xcd, 0xf7, 0xb0, 0xb3, 0xb2, 0x74, 0x05, 0x26, 0xc7, 0xcb, 0x26, 0x45,
+	0x27, 0x4a, 0xc8, 0xe4, 0xb5, 0xef, 0xe1, 0xf9, 0x9a, 0x75, 0x2b, 0x70, 0xf9, 0x69, 0xd9, 0xa5,
+	0xe8, 0xab, 0xa5, 0x42, 0x42, 0xe7, 0x91, 0xd9, 0x9a, 0x15, 0xfb, 0x7f, 0xcd, 0xda, 0x9f, 0xcb,
+	0x20, 0xcf, 0xef, 0x1f, 0xa4, 0xc0, 0xd6, 0x8d, 0x75, 0x65, 0xf5, 0x7f, 0xb7, 0xd4, 0xaf, 0x10,
+	0x82, 0x86, 0xd1, 0xef, 0xf5, 0x6e, 0x2c, 0xd3, 0x7e, 0xe7, 0xe0, 0xae, 0x69, 0xab, 0x12, 0xfa,
+	0x01, 0x3a, 0x8b, 0x58, 0x0f, 0xf7, 0xce, 0x31, 0x71, 0xec, 0xfe, 0x15, 0xb6, 0x9c, 0x6b, 0x4c,
+	0x7a, 0xe6, 0x60, 0x60, 0xf6, 0x2d, 0xc7, 0x78, 0xa3, 0x5b, 0x17, 0x58, 0xdd, 0xf8, 0xb2, 0xec,
+	0x2e, 0x7e, 0x8b, 0x6d, 0xac, 0x96, 0xd0, 0x4b, 0x38, 0x5c, 0x64, 0x1b, 0xba, 0x8d, 0x2f, 0xfa,
+	0xe4, 0x9d, 0x63, 0x10, 0xac, 0xdb, 0x58, 0x2d, 0x3f, 0x01, 0xa7, 0xec, 0x0a, 0x7a, 0x0e, 0x07,
+	0x05, 0x30, 0x1f, 0xf6, 0x26, 0x7a, 0x01, 0x5a, 0x0e, 0x7c, 0xa3, 0x5b, 0x16, 0x7e, 0x9b, 0x29,
+	0x6f, 0x15, 0xa3, 0xa9, 0x70, 0x15, 0x35, 0x61, 0x7f, 0x15, 0xe5, 0xba, 0x32, 0x7a, 0x05, 0xcd,
+	0x02, 0x53, 0x82, 0xfb, 0xa4, 0x8b, 0x89, 0x0a, 0x8f, 0xc6, 0x9c, 0x72, 0x33, 0x58, 0x41, 0xdf,
+	0x41, 0x6b, 0x01, 0x13, 0xfc, 0xdb, 0x0d, 0x1e, 0xd8, 0x8e, 0xdd, 0x77, 0x2e, 0xfb, 0xa6, 0xe5,
+	0xe8, 0x86, 0x81, 0xaf, 0x6d, 0xb5, 0xb6, 0x3e, 0x8b, 0xe0, 0x4b, 0x6c, 0xd8, 0x6a, 0x1d, 0x1d,
+	0xc2, 0xde, 0xca, 0xb7, 0xbe, 0x32, 0x8d, 0x2b, 0xb5, 0x81, 0x34, 0x78, 0xb6, 0x02, 0x9d, 0xeb,
+	0x96, 0xba, 0xbd, 0x3c, 0xb7, 0x14, 0xb9, 0xb1, 0x12, 0x4c, 0x45, 0x07, 0xb0, 0xbb, 0xc0, 0xc4,
+	0xaa, 0xe9, 0xdd, 0xae, 0xba, 0x73, 0x59, 0xae, 0xd6, 0xd5, 0x46, 0xfb, 0x9f, 0x0d, 0xd8, 0x7e,
+	0x54, 0x83, 0xd0, 0x09, 0x54, 0xb3, 0x5a, 0xab, 0x49, 0x2b, 0xb5, 0xe0, 0xce, 0x65, 0x66, 0x8a,
+	0x92, 0x79, 0x1e, 0xfa, 0x15, 0x94, 0x7c, 0x81, 0x12, 0x5b, 0xf8, 0x55, 0xc1, 0x16, 0xce, 0x5d,
+	0x20, 0x24, 0x4f, 0x49, 0xae, 0x2a, 0xf1, 0x08, 0x88, 0x29, 0x63, 0x41, 0x38, 0x8c, 0xb5, 0xd2,
+	0x93, 0xe7, 0x80, 0xdf, 0x8a, 0x83, 0x34, 0x8f, 0xd4, 0xdd, 0x7c, 0x17, 0x7d, 0x0b, 0xf5, 0x20,
+	0x64, 0x93, 0xc8, 0x19, 0xd1, 0x38, 0x76, 0x87, 0x94, 0x17, 0x5d, 0x99, 0xd4, 0x78, 0xb0, 0x27,
+	0x62, 0x49, 0x52, 0x34, 0xcd, 0x27, 0x55, 0x44, 0x52, 0x34, 0xcd, 0x25, 0x21, 0x28, 0x33, 0x77,
+	0x18, 0x6b, 0x9b, 0xad, 0x52, 0x47, 0x26, 0xbc, 0xdd, 0xfe, 0x4b, 0x82, 0x5a, 0xbe, 0x1e, 0xa2,
+	0xaf, 0x41, 0x99, 0x97, 0xcf, 0xc0, 0x4f, 0x0f, 0x34, 0x64, 0x21, 0xd3, 0x4f, 0x54, 0x42, 0x77,
+	0x24, 0x8e, 0xb5, 0x4c, 0x78, 0x9b, 0xbf, 0x3d, 0x44, 0x2d, 0x8c, 0x9d, 0xc0, 0x4f, 0xa6, 0x9a,
+	0x38, 0x64, 0xf5, 0x31, 0x36, 0xfd, 0x18, 0x35, 0xa1, 0x3a, 0x8e, 0xe2, 0x80, 0xd7, 0xc5, 0x64,
+	0x06, 0x15, 0x32, 0xef, 0xb7, 0xff, 0x96, 0x40, 0xc9, 0x15, 0xd6, 0xff, 0x1e, 0xc3, 0x4b, 0x80,
+	0xac, 0x4c, 0xa7, 0x2f, 0x1d, 0x99, 0xc8, 0x69, 0xc4, 0xf4, 0x97, 0xbc, 0x4a, 0xcb, 0x5e, 0xe8,
+	0x47, 0xd8, 0x4a, 0x13, 0xd3, 0xd7, 0xcb, 0x41, 0xd1, 0xeb, 0xe5, 0xce, 0x65, 0x24, 0xcb, 0x3b,
+	0xaf, 0xbf, 0x57, 0x8e, 0x8e, 0xcf, 0xb2, 0xac, 0xdb, 0x4d, 0xde, 0x7a, 0xfd, 0xef, 0x00, 0x93,
+	0x7c, 0x6d, 0x79, 0xef, 0x09, 0x00, 0x00,
 }
@@ -21,8 +21,10 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type RequestContactVerification struct {
-	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
-	Challenge            string   `protobuf:"bytes,3,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Clock     uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Challenge string `protobuf:"bytes,3,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// Timestamp in milliseconds after which the request can't be accepted
+	ExpiresAt            uint64   `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -67,6 +69,13 @@ func (m *RequestContactVerification) GetChallenge() string {
 	return ""
 }
 
+func (m *RequestContactVerification) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 type AcceptContactVerification struct {
 	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -228,18 +237,19 @@ func init() {
 }
 
 var fileDescriptor_d6997df64de39454 = []byte{
-	// 194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0xcf, 0x2b,
-	0x49, 0x4c, 0x2e, 0x89, 0x2f, 0x4b, 0x2d, 0xca, 0x4c, 0xcb, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf,
-	0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x00, 0x53, 0x49, 0xa5, 0x69, 0x4a, 0x01, 0x5c,
-	0x52, 0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0xce, 0x10, 0xe5, 0x61, 0x48, 0xaa, 0x85, 0x44,
-	0xb8, 0x58, 0x93, 0x73, 0xf2, 0x93, 0xb3, 0x25, 0x18, 0x15, 0x18, 0x35, 0x58, 0x82, 0x20, 0x1c,
-	0x21, 0x19, 0x2e, 0xce, 0xe4, 0x8c, 0xc4, 0x9c, 0x9c, 0xd4, 0xbc, 0xf4, 0x54, 0x09, 0x66, 0x05,
-	0x46, 0x0d, 0xce, 0x20, 0x84, 0x80, 0x52, 0x2c, 0x97, 0xa4, 0x63, 0x72, 0x72, 0x6a, 0x01, 0x09,
-	0x06, 0xf2, 0x71, 0x31, 0x65, 0xa6, 0x48, 0x30, 0x81, 0x4d, 0x62, 0xca, 0x4c, 0x11, 0x92, 0xe2,
-	0xe2, 0x28, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x86, 0x99, 0x0f, 0xe7, 0x2b, 0x39, 0x71, 0x49,
-	0xb9, 0xa4, 0x26, 0xe7, 0x64, 0xe6, 0xa5, 0x92, 0x6d, 0xbe, 0x92, 0x23, 0x97, 0xa4, 0x73, 0x62,
-	0x5e, 0x72, 0x6a, 0x0e, 0xd9, 0x46, 0x38, 0xf1, 0x46, 0x71, 0xeb, 0xe9, 0x5b, 0xc3, 0x82, 0x31,
-	0x89, 0x0d, 0xcc, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x2b, 0x89, 0x8f, 0x75, 0x01,
-	0x00, 0x00,
+	// 213 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x90, 0x41, 0x4b, 0xc4, 0x30,
+	0x10, 0x85, 0x69, 0x5d, 0x65, 0x3b, 0xa2, 0x87, 0xe0, 0x21, 0x1b, 0x14, 0x96, 0x9c, 0xf6, 0xb4,
+	0x1e, 0x3c, 0x7a, 0xea, 0xae, 0xbf, 0xa0, 0x07, 0x0f, 0x82, 0x94, 0x74, 0x3a, 0xd5, 0x60, 0x48,
+	0x62, 0x92, 0x8a, 0x3f, 0x5f, 0x48, 0xad, 0x7a, 0xb5, 0xa7, 0x99, 0xf7, 0x78, 0xf3, 0x3e, 0x18,
+	0x10, 0xe8, 0x6c, 0x52, 0x98, 0xda, 0x0f, 0x0a, 0x7a, 0xd0, 0xa8, 0x92, 0x76, 0x76, 0xef, 0x83,
+	0x4b, 0x8e, 0xad, 0xf3, 0xe8, 0xc6, 0x41, 0x3a, 0x10, 0x0d, 0xbd, 0x8f, 0x14, 0xd3, 0x71, 0x8a,
+	0x3f, 0xfe, 0x49, 0xb3, 0x2b, 0x38, 0x45, 0xe3, 0xf0, 0x8d, 0x17, 0xdb, 0x62, 0xb7, 0x6a, 0x26,
+	0xc1, 0xae, 0xa1, 0xc2, 0x57, 0x65, 0x0c, 0xd9, 0x17, 0xe2, 0x27, 0xdb, 0x62, 0x57, 0x35, 0xbf,
+	0x06, 0xbb, 0x01, 0xa0, 0x4f, 0xaf, 0x03, 0xc5, 0x56, 0x25, 0xbe, 0xca, 0x87, 0xd5, 0xb7, 0x53,
+	0x27, 0xf9, 0x0c, 0x9b, 0x1a, 0x91, 0xfc, 0x3f, 0x78, 0x97, 0x50, 0xea, 0x9e, 0x97, 0x19, 0x54,
+	0xea, 0x9e, 0x09, 0x58, 0x07, 0x8a, 0xde, 0xd9, 0x38, 0xe3, 0x7f, 0xb4, 0x3c, 0x80, 0x78, 0x20,
+	0x34, 0xda, 0xd2, 0xe2, 0x7e, 0x59, 0xc3, 0xe6, 0xa8, 0x2c, 0x92, 0x59, 0x5c, 0x71, 0xb8, 0x78,
+	0x3a, 0xdf, 0xdf, 0xde, 0xcf, 0x5f, 0xee, 0xce, 0xf2, 0x76, 0xf7, 0x35, 0x00, 0xb1, 0x14, 0xc6,
+	0xf9, 0x94, 0x01, 0x00, 0x00,
 }
@@ -30,6 +30,7 @@ const (
 	EmojiReaction_LAUGH                       EmojiReaction_Type = 4
 	EmojiReaction_SAD                         EmojiReaction_Type = 5
 	EmojiReaction_ANGRY                       EmojiReaction_Type = 6
+	EmojiReaction_CUSTOM                      EmojiReaction_Type = 7
 )
 
 var EmojiReaction_Type_name = map[int32]string{
@@ -40,6 +41,7 @@ var EmojiReaction_Type_name = map[int32]string{
 	4: "LAUGH",
 	5: "SAD",
 	6: "ANGRY",
+	7: "CUSTOM",
 }
 
 var EmojiReaction_Type_value = map[string]int32{
@@ -50,6 +52,7 @@ var EmojiReaction_Type_value = map[string]int32{
 	"LAUGH":                       4,
 	"SAD":                         5,
 	"ANGRY":                       6,
+	"CUSTOM":                      7,
 }
 
 func (x EmojiReaction_Type) String() string {
@@ -75,7 +78,9 @@ type EmojiReaction struct {
 	// whether this is a rectraction of a previously sent emoji
 	Retracted bool `protobuf:"varint,6,opt,name=retracted,proto3" json:"retracted,omitempty"`
 	// Grant for organisation chat messages
-	Grant                []byte   `protobuf:"bytes,7,opt,name=grant,proto3" json:"grant,omitempty"`
+	Grant []byte `protobuf:"bytes,7,opt,name=grant,proto3" json:"grant,omitempty"`
+	// community_emoji_id the ID of the emoji of a community emoji pack, when the type is CUSTOM
+	CommunityEmojiId     string   `protobuf:"bytes,8,opt,name=community_emoji_id,json=communityEmojiId,proto3" json:"community_emoji_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -155,6 +160,13 @@ func (m *EmojiReaction) GetGrant() []byte {
 	return nil
 }
 
+func (m *EmojiReaction) GetCommunityEmojiId() string {
+	if m != nil {
+		return m.CommunityEmojiId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("protobuf.EmojiReaction_Type", EmojiReaction_Type_name, EmojiReaction_Type_value)
 	proto.RegisterType((*EmojiReaction)(nil), "protobuf.EmojiReaction")
@@ -165,26 +177,28 @@ func init() {
 }
 
 var fileDescriptor_0a088c907bbc7ed6 = []byte{
-	// 330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0xcd, 0x6e, 0xaa, 0x40,
-	0x14, 0xc7, 0x2f, 0x0a, 0x28, 0x07, 0xbd, 0x77, 0x32, 0xf1, 0xa6, 0xa4, 0xb5, 0x29, 0x71, 0xc5,
-	0x8a, 0x36, 0xed, 0xa6, 0x49, 0x57, 0x58, 0x89, 0xd2, 0x2a, 0x98, 0x11, 0x6a, 0xec, 0x86, 0x20,
-	0x4c, 0xad, 0x6d, 0x11, 0x82, 0xe3, 0xc2, 0xa7, 0xee, 0x2b, 0x34, 0x0c, 0x1a, 0xd3, 0xd5, 0xcc,
-	0xef, 0xff, 0x91, 0x73, 0x0e, 0x74, 0x68, 0x9a, 0x7d, 0xac, 0xc3, 0x82, 0x46, 0x31, 0x5b, 0x67,
-	0x1b, 0x33, 0x2f, 0x32, 0x96, 0xe1, 0x26, 0x7f, 0x96, 0xbb, 0xb7, 0x73, 0x95, 0x6e, 0x76, 0xe9,
-	0xb6, 0x92, 0x7b, 0xdf, 0x35, 0x68, 0xdb, 0x65, 0x9e, 0x1c, 0xe2, 0xb8, 0x03, 0x52, 0xfc, 0x95,
-	0xc5, 0x9f, 0x9a, 0xa0, 0x0b, 0x86, 0x48, 0x2a, 0xc0, 0x67, 0xd0, 0x88, 0xdf, 0x23, 0x16, 0xae,
-	0x13, 0xad, 0xa6, 0x0b, 0x86, 0x42, 0xe4, 0x12, 0x9d, 0x04, 0x5f, 0x02, 0xa4, 0x74, 0xbb, 0x8d,
-	0x56, 0xb4, 0xf4, 0xea, 0xdc, 0x53, 0x0e, 0x8a, 0x93, 0xe0, 0x7b, 0x68, 0x1d, 0x6d, 0xb6, 0xcf,
-	0xa9, 0x26, 0xea, 0x82, 0xf1, 0xf7, 0xf6, 0xbf, 0x79, 0xdc, 0xc6, 0x9c, 0x54, 0xae, 0xbf, 0xcf,
-	0x29, 0x51, 0xd3, 0x13, 0xe0, 0x1b, 0x10, 0x79, 0x43, 0xe2, 0x8d, 0xee, 0xa9, 0xf1, 0x6b, 0x5d,
-	0x93, 0x17, 0x79, 0x12, 0x77, 0x41, 0x29, 0x28, 0x2b, 0xa2, 0x98, 0xd1, 0x44, 0x93, 0x75, 0xc1,
-	0x68, 0x92, 0x93, 0x50, 0xde, 0xb5, 0x2a, 0xa2, 0x0d, 0xd3, 0x1a, 0xba, 0x60, 0xb4, 0x48, 0x05,
-	0xbd, 0x1c, 0x44, 0x3e, 0xed, 0x0a, 0x2e, 0x02, 0xf7, 0xd9, 0xf5, 0xe6, 0x6e, 0x68, 0x4f, 0xbc,
-	0x27, 0x27, 0x24, 0xb6, 0xf5, 0xe8, 0x3b, 0x9e, 0x1b, 0xfa, 0x8b, 0xa9, 0x8d, 0xfe, 0xe0, 0x26,
-	0x88, 0x63, 0xef, 0xc5, 0x46, 0x02, 0x6e, 0x83, 0xe2, 0x8f, 0x82, 0x49, 0x7f, 0x16, 0x06, 0x53,
-	0x54, 0xc3, 0xff, 0x40, 0x3d, 0xe0, 0xc0, 0x9b, 0xbb, 0xa8, 0x8e, 0x15, 0x90, 0xc6, 0x56, 0x30,
-	0x1c, 0x21, 0x11, 0x37, 0xa0, 0x3e, 0xb3, 0x06, 0x48, 0x2a, 0x35, 0xcb, 0x1d, 0x92, 0x05, 0x92,
-	0xfb, 0xed, 0x57, 0xd5, 0xbc, 0x7e, 0x38, 0x5e, 0xb3, 0x94, 0xf9, 0xef, 0xee, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0x7e, 0x57, 0x12, 0xd9, 0xb6, 0x01, 0x00, 0x00,
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x50, 0x4d, 0xcf, 0x93, 0x40,
+	0x10, 0x96, 0x97, 0xef, 0xa1, 0xd5, 0xcd, 0xa6, 0x46, 0xa2, 0x35, 0x92, 0x9e, 0x38, 0x18, 0x34,
+	0x7a, 0x31, 0xf1, 0x44, 0x5b, 0xd2, 0xa2, 0x05, 0x9a, 0x2d, 0xd8, 0xd4, 0x0b, 0xa1, 0xb0, 0x56,
+	0x54, 0xa0, 0xa1, 0xdb, 0x43, 0x13, 0xff, 0x90, 0xff, 0xd2, 0xb0, 0xb4, 0x36, 0x9e, 0x76, 0x9f,
+	0xaf, 0xcc, 0x33, 0x03, 0x23, 0x5a, 0x35, 0x3f, 0xca, 0xb4, 0xa5, 0x59, 0xce, 0xca, 0xa6, 0x76,
+	0x8e, 0x6d, 0xc3, 0x1a, 0xac, 0xf1, 0x67, 0x7f, 0xfe, 0xf6, 0xdc, 0xa0, 0xf5, 0xb9, 0x3a, 0xf5,
+	0xf4, 0xe4, 0x8f, 0x08, 0x43, 0xaf, 0xf3, 0x93, 0xab, 0x1d, 0x8f, 0x40, 0xce, 0x7f, 0x35, 0xf9,
+	0x4f, 0x53, 0xb0, 0x04, 0x5b, 0x22, 0x3d, 0xc0, 0xcf, 0x40, 0xcd, 0xbf, 0x67, 0x2c, 0x2d, 0x0b,
+	0xf3, 0xc1, 0x12, 0x6c, 0x9d, 0x28, 0x1d, 0xf4, 0x0b, 0xfc, 0x12, 0xa0, 0xa2, 0xa7, 0x53, 0x76,
+	0xa0, 0x9d, 0x26, 0x72, 0x4d, 0xbf, 0x32, 0x7e, 0x81, 0x3f, 0xc0, 0xe0, 0x26, 0xb3, 0xcb, 0x91,
+	0x9a, 0x92, 0x25, 0xd8, 0x8f, 0xdf, 0x3d, 0x75, 0x6e, 0x6d, 0x9c, 0xa0, 0x57, 0xe3, 0xcb, 0x91,
+	0x12, 0xa3, 0xba, 0x03, 0xfc, 0x16, 0x24, 0x9e, 0x90, 0x79, 0x62, 0x7c, 0x4f, 0xfc, 0x57, 0xd7,
+	0xe1, 0x41, 0xee, 0xc4, 0x63, 0xd0, 0x5b, 0xca, 0xda, 0x2c, 0x67, 0xb4, 0x30, 0x15, 0x4b, 0xb0,
+	0x35, 0x72, 0x27, 0xba, 0xbd, 0x0e, 0x6d, 0x56, 0x33, 0x53, 0xb5, 0x04, 0x7b, 0x40, 0x7a, 0x80,
+	0x5f, 0x03, 0xce, 0x9b, 0xaa, 0x3a, 0xd7, 0x25, 0xbb, 0xa4, 0xfd, 0xe1, 0xca, 0xc2, 0xd4, 0xf8,
+	0x1a, 0xe8, 0x9f, 0xc2, 0x47, 0xfa, 0xc5, 0xe4, 0x37, 0x48, 0xbc, 0xdb, 0x2b, 0x78, 0x91, 0x84,
+	0x9f, 0xc3, 0x68, 0x1b, 0xa6, 0x5e, 0x10, 0x7d, 0xf2, 0x53, 0xe2, 0xb9, 0xb3, 0xd8, 0x8f, 0xc2,
+	0x34, 0xde, 0xad, 0x3d, 0xf4, 0x08, 0x6b, 0x20, 0xad, 0xa2, 0x2f, 0x1e, 0x12, 0xf0, 0x10, 0xf4,
+	0x78, 0x99, 0x04, 0xd3, 0x4d, 0x9a, 0xac, 0xd1, 0x03, 0x7e, 0x02, 0xc6, 0x15, 0xce, 0xa3, 0x6d,
+	0x88, 0x44, 0xac, 0x83, 0xbc, 0x72, 0x93, 0xc5, 0x12, 0x49, 0x58, 0x05, 0x71, 0xe3, 0xce, 0x91,
+	0xdc, 0x71, 0x6e, 0xb8, 0x20, 0x3b, 0xa4, 0x60, 0x00, 0x65, 0x96, 0x6c, 0xe2, 0x28, 0x40, 0xea,
+	0x74, 0xf8, 0xd5, 0x70, 0xde, 0x7c, 0xbc, 0xdd, 0x61, 0xaf, 0xf0, 0xdf, 0xfb, 0xbf, 0x03, 0x00,
+	0x8a, 0xac, 0xc3, 0x89, 0xf0, 0x01, 0x00, 0x00,
 }
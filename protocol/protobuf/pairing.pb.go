@@ -2877,6 +2877,7 @@ type SyncVerificationRequest struct {
 	RepliedAt            uint64                                     `protobuf:"varint,7,opt,name=replied_at,json=repliedAt,proto3" json:"replied_at,omitempty"`
 	VerificationStatus   SyncVerificationRequest_VerificationStatus `protobuf:"varint,8,opt,name=verification_status,json=verificationStatus,proto3,enum=protobuf.SyncVerificationRequest_VerificationStatus" json:"verification_status,omitempty"`
 	Id                   string                                     `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	ExpiresAt            uint64                                     `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
 	XXX_unrecognized     []byte                                     `json:"-"`
 	XXX_sizecache        int32                                      `json:"-"`
@@ -2970,6 +2971,13 @@ func (m *SyncVerificationRequest) GetId() string {
 	return ""
 }
 
+func (m *SyncVerificationRequest) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 type SyncContactRequestDecision struct {
 	Clock                uint64                                    `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	RequestId            string                                    `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
@@ -3389,6 +3397,545 @@ func (m *SyncSocialLinks) GetClock() uint64 {
 	return 0
 }
 
+type SyncNotificationRule struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	CommunityId          string   `protobuf:"bytes,3,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	ChatId               string   `protobuf:"bytes,4,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Trigger              uint32   `protobuf:"varint,5,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Action               uint32   `protobuf:"varint,6,opt,name=action,proto3" json:"action,omitempty"`
+	QuietHoursStart      uint32   `protobuf:"varint,7,opt,name=quiet_hours_start,json=quietHoursStart,proto3" json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd        uint32   `protobuf:"varint,8,opt,name=quiet_hours_end,json=quietHoursEnd,proto3" json:"quiet_hours_end,omitempty"`
+	Deleted              bool     `protobuf:"varint,9,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncNotificationRule) Reset()         { *m = SyncNotificationRule{} }
+func (m *SyncNotificationRule) String() string { return proto.CompactTextString(m) }
+func (*SyncNotificationRule) ProtoMessage()    {}
+func (*SyncNotificationRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{39}
+}
+
+func (m *SyncNotificationRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncNotificationRule.Unmarshal(m, b)
+}
+func (m *SyncNotificationRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncNotificationRule.Marshal(b, m, deterministic)
+}
+func (m *SyncNotificationRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncNotificationRule.Merge(m, src)
+}
+func (m *SyncNotificationRule) XXX_Size() int {
+	return xxx_messageInfo_SyncNotificationRule.Size(m)
+}
+func (m *SyncNotificationRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncNotificationRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncNotificationRule proto.InternalMessageInfo
+
+func (m *SyncNotificationRule) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SyncNotificationRule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SyncNotificationRule) GetCommunityId() string {
+	if m != nil {
+		return m.CommunityId
+	}
+	return ""
+}
+
+func (m *SyncNotificationRule) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *SyncNotificationRule) GetTrigger() uint32 {
+	if m != nil {
+		return m.Trigger
+	}
+	return 0
+}
+
+func (m *SyncNotificationRule) GetAction() uint32 {
+	if m != nil {
+		return m.Action
+	}
+	return 0
+}
+
+func (m *SyncNotificationRule) GetQuietHoursStart() uint32 {
+	if m != nil {
+		return m.QuietHoursStart
+	}
+	return 0
+}
+
+func (m *SyncNotificationRule) GetQuietHoursEnd() uint32 {
+	if m != nil {
+		return m.QuietHoursEnd
+	}
+	return 0
+}
+
+func (m *SyncNotificationRule) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+type SyncChatReadState struct {
+	Chats                []*ChatReadState `protobuf:"bytes,1,rep,name=chats,proto3" json:"chats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SyncChatReadState) Reset()         { *m = SyncChatReadState{} }
+func (m *SyncChatReadState) String() string { return proto.CompactTextString(m) }
+func (*SyncChatReadState) ProtoMessage()    {}
+func (*SyncChatReadState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{40}
+}
+
+func (m *SyncChatReadState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncChatReadState.Unmarshal(m, b)
+}
+func (m *SyncChatReadState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncChatReadState.Marshal(b, m, deterministic)
+}
+func (m *SyncChatReadState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncChatReadState.Merge(m, src)
+}
+func (m *SyncChatReadState) XXX_Size() int {
+	return xxx_messageInfo_SyncChatReadState.Size(m)
+}
+func (m *SyncChatReadState) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncChatReadState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncChatReadState proto.InternalMessageInfo
+
+func (m *SyncChatReadState) GetChats() []*ChatReadState {
+	if m != nil {
+		return m.Chats
+	}
+	return nil
+}
+
+type ChatReadState struct {
+	ChatId               string   `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Clock                uint64   `protobuf:"varint,2,opt,name=clock,proto3" json:"clock,omitempty"`
+	MessageIds           []string `protobuf:"bytes,3,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChatReadState) Reset()         { *m = ChatReadState{} }
+func (m *ChatReadState) String() string { return proto.CompactTextString(m) }
+func (*ChatReadState) ProtoMessage()    {}
+func (*ChatReadState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{41}
+}
+
+func (m *ChatReadState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatReadState.Unmarshal(m, b)
+}
+func (m *ChatReadState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatReadState.Marshal(b, m, deterministic)
+}
+func (m *ChatReadState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatReadState.Merge(m, src)
+}
+func (m *ChatReadState) XXX_Size() int {
+	return xxx_messageInfo_ChatReadState.Size(m)
+}
+func (m *ChatReadState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatReadState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatReadState proto.InternalMessageInfo
+
+func (m *ChatReadState) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *ChatReadState) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *ChatReadState) GetMessageIds() []string {
+	if m != nil {
+		return m.MessageIds
+	}
+	return nil
+}
+
+type SyncChatDraft struct {
+	Clock                uint64                 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId               string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Text                 string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	ResponseTo           string                 `protobuf:"bytes,4,opt,name=response_to,json=responseTo,proto3" json:"response_to,omitempty"`
+	Attachments          []*ChatDraftAttachment `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SyncChatDraft) Reset()         { *m = SyncChatDraft{} }
+func (m *SyncChatDraft) String() string { return proto.CompactTextString(m) }
+func (*SyncChatDraft) ProtoMessage()    {}
+func (*SyncChatDraft) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{42}
+}
+
+func (m *SyncChatDraft) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncChatDraft.Unmarshal(m, b)
+}
+func (m *SyncChatDraft) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncChatDraft.Marshal(b, m, deterministic)
+}
+func (m *SyncChatDraft) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncChatDraft.Merge(m, src)
+}
+func (m *SyncChatDraft) XXX_Size() int {
+	return xxx_messageInfo_SyncChatDraft.Size(m)
+}
+func (m *SyncChatDraft) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncChatDraft.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncChatDraft proto.InternalMessageInfo
+
+func (m *SyncChatDraft) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SyncChatDraft) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *SyncChatDraft) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *SyncChatDraft) GetResponseTo() string {
+	if m != nil {
+		return m.ResponseTo
+	}
+	return ""
+}
+
+func (m *SyncChatDraft) GetAttachments() []*ChatDraftAttachment {
+	if m != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
+type ChatDraftAttachment struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType          string   `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size                 uint64   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChatDraftAttachment) Reset()         { *m = ChatDraftAttachment{} }
+func (m *ChatDraftAttachment) String() string { return proto.CompactTextString(m) }
+func (*ChatDraftAttachment) ProtoMessage()    {}
+func (*ChatDraftAttachment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{43}
+}
+
+func (m *ChatDraftAttachment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatDraftAttachment.Unmarshal(m, b)
+}
+func (m *ChatDraftAttachment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatDraftAttachment.Marshal(b, m, deterministic)
+}
+func (m *ChatDraftAttachment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatDraftAttachment.Merge(m, src)
+}
+func (m *ChatDraftAttachment) XXX_Size() int {
+	return xxx_messageInfo_ChatDraftAttachment.Size(m)
+}
+func (m *ChatDraftAttachment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatDraftAttachment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatDraftAttachment proto.InternalMessageInfo
+
+func (m *ChatDraftAttachment) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ChatDraftAttachment) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *ChatDraftAttachment) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+// SyncMute syncs the mute of a chat or a community, chat_id is set for
+// chats and community_id for communities
+type SyncMute struct {
+	Clock       uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId      string `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	CommunityId string `protobuf:"bytes,3,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	Muted       bool   `protobuf:"varint,4,opt,name=muted,proto3" json:"muted,omitempty"`
+	// Unix time in seconds the mute expires at, 0 when it lasts until unmuted
+	MuteTill             int64    `protobuf:"varint,5,opt,name=mute_till,json=muteTill,proto3" json:"mute_till,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncMute) Reset()         { *m = SyncMute{} }
+func (m *SyncMute) String() string { return proto.CompactTextString(m) }
+func (*SyncMute) ProtoMessage()    {}
+func (*SyncMute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{44}
+}
+
+func (m *SyncMute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncMute.Unmarshal(m, b)
+}
+func (m *SyncMute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncMute.Marshal(b, m, deterministic)
+}
+func (m *SyncMute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncMute.Merge(m, src)
+}
+func (m *SyncMute) XXX_Size() int {
+	return xxx_messageInfo_SyncMute.Size(m)
+}
+func (m *SyncMute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncMute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncMute proto.InternalMessageInfo
+
+func (m *SyncMute) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SyncMute) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *SyncMute) GetCommunityId() string {
+	if m != nil {
+		return m.CommunityId
+	}
+	return ""
+}
+
+func (m *SyncMute) GetMuted() bool {
+	if m != nil {
+		return m.Muted
+	}
+	return false
+}
+
+func (m *SyncMute) GetMuteTill() int64 {
+	if m != nil {
+		return m.MuteTill
+	}
+	return 0
+}
+
+type SyncAccountsPositions struct {
+	Clock                uint64         `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Accounts             []*SyncAccount `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SyncAccountsPositions) Reset()         { *m = SyncAccountsPositions{} }
+func (m *SyncAccountsPositions) String() string { return proto.CompactTextString(m) }
+func (*SyncAccountsPositions) ProtoMessage()    {}
+func (*SyncAccountsPositions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{45}
+}
+
+func (m *SyncAccountsPositions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncAccountsPositions.Unmarshal(m, b)
+}
+func (m *SyncAccountsPositions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncAccountsPositions.Marshal(b, m, deterministic)
+}
+func (m *SyncAccountsPositions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncAccountsPositions.Merge(m, src)
+}
+func (m *SyncAccountsPositions) XXX_Size() int {
+	return xxx_messageInfo_SyncAccountsPositions.Size(m)
+}
+func (m *SyncAccountsPositions) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncAccountsPositions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncAccountsPositions proto.InternalMessageInfo
+
+func (m *SyncAccountsPositions) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SyncAccountsPositions) GetAccounts() []*SyncAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type SyncCustomToken struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChainId              uint64   `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address              []byte   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol               string   `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals             uint32   `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Color                string   `protobuf:"bytes,7,opt,name=color,proto3" json:"color,omitempty"`
+	Removed              bool     `protobuf:"varint,8,opt,name=removed,proto3" json:"removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncCustomToken) Reset()         { *m = SyncCustomToken{} }
+func (m *SyncCustomToken) String() string { return proto.CompactTextString(m) }
+func (*SyncCustomToken) ProtoMessage()    {}
+func (*SyncCustomToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d61ab7221f0b5518, []int{46}
+}
+
+func (m *SyncCustomToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncCustomToken.Unmarshal(m, b)
+}
+func (m *SyncCustomToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncCustomToken.Marshal(b, m, deterministic)
+}
+func (m *SyncCustomToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncCustomToken.Merge(m, src)
+}
+func (m *SyncCustomToken) XXX_Size() int {
+	return xxx_messageInfo_SyncCustomToken.Size(m)
+}
+func (m *SyncCustomToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncCustomToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncCustomToken proto.InternalMessageInfo
+
+func (m *SyncCustomToken) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SyncCustomToken) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *SyncCustomToken) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *SyncCustomToken) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SyncCustomToken) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *SyncCustomToken) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *SyncCustomToken) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+func (m *SyncCustomToken) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("protobuf.SyncActivityCenterNotification_NotificationType", SyncActivityCenterNotification_NotificationType_name, SyncActivityCenterNotification_NotificationType_value)
 	proto.RegisterEnum("protobuf.SyncActivityCenterNotification_MembershipStatus", SyncActivityCenterNotification_MembershipStatus_name, SyncActivityCenterNotification_MembershipStatus_value)
@@ -3439,6 +3986,14 @@ func init() {
 	proto.RegisterType((*SyncKeycard)(nil), "protobuf.SyncKeycard")
 	proto.RegisterType((*SyncKeycardAction)(nil), "protobuf.SyncKeycardAction")
 	proto.RegisterType((*SyncSocialLinks)(nil), "protobuf.SyncSocialLinks")
+	proto.RegisterType((*SyncNotificationRule)(nil), "protobuf.SyncNotificationRule")
+	proto.RegisterType((*SyncChatReadState)(nil), "protobuf.SyncChatReadState")
+	proto.RegisterType((*ChatReadState)(nil), "protobuf.ChatReadState")
+	proto.RegisterType((*SyncChatDraft)(nil), "protobuf.SyncChatDraft")
+	proto.RegisterType((*ChatDraftAttachment)(nil), "protobuf.ChatDraftAttachment")
+	proto.RegisterType((*SyncMute)(nil), "protobuf.SyncMute")
+	proto.RegisterType((*SyncAccountsPositions)(nil), "protobuf.SyncAccountsPositions")
+	proto.RegisterType((*SyncCustomToken)(nil), "protobuf.SyncCustomToken")
 }
 
 func init() {
@@ -3446,225 +4001,248 @@ func init() {
 }
 
 var fileDescriptor_d61ab7221f0b5518 = []byte{
-	// 3517 bytes of a gzipped FileDescriptorProto
+	// 3883 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4d, 0x73, 0x23, 0x49,
-	0x56, 0xa3, 0x0f, 0xeb, 0xe3, 0x49, 0x96, 0xcb, 0x69, 0xcf, 0x58, 0xed, 0xf6, 0x6c, 0x77, 0x57,
-	0xef, 0xc4, 0x36, 0xc4, 0xe0, 0x01, 0x0f, 0xb0, 0xec, 0xf4, 0x4c, 0x0c, 0x6a, 0x49, 0xd3, 0xed,
-	0x76, 0x5b, 0x36, 0x69, 0x7b, 0x86, 0x25, 0x88, 0x28, 0xd2, 0x55, 0xd9, 0x56, 0xad, 0x4b, 0x55,
-	0xa2, 0x32, 0x65, 0xa3, 0x3d, 0x10, 0x40, 0x04, 0x67, 0x22, 0xb8, 0x2c, 0xc7, 0x39, 0x73, 0x24,
-	0x82, 0x03, 0x11, 0x1c, 0x38, 0x11, 0xfb, 0x1f, 0xe0, 0xca, 0x85, 0xe0, 0xc2, 0x8d, 0x03, 0x07,
-	0xe2, 0x65, 0x66, 0x95, 0xaa, 0xf4, 0x61, 0xec, 0xe0, 0xc4, 0xc9, 0xf5, 0x5e, 0xbe, 0x7c, 0xf9,
-	0x32, 0xdf, 0xf7, 0xb3, 0x60, 0x7d, 0xcc, 0xfc, 0xd8, 0x0f, 0xaf, 0xf6, 0xc7, 0x71, 0x24, 0x23,
-	0x52, 0x53, 0x7f, 0x2e, 0x27, 0xef, 0x77, 0xb7, 0xdc, 0x21, 0x93, 0x8e, 0xef, 0xf1, 0x50, 0xfa,
-	0x72, 0xaa, 0x97, 0x77, 0xb7, 0xc4, 0x34, 0x74, 0x1d, 0xc1, 0xa5, 0xf4, 0xc3, 0x2b, 0x61, 0x90,
-	0x36, 0x1b, 0x8f, 0x03, 0xdf, 0x65, 0xd2, 0x8f, 0x42, 0x67, 0xc4, 0x25, 0xf3, 0x98, 0x64, 0xce,
-	0x88, 0x0b, 0xc1, 0xae, 0xb8, 0xa1, 0xd9, 0x74, 0xa3, 0xd1, 0x68, 0x12, 0xfa, 0xd2, 0xe7, 0x66,
-	0x9b, 0xcd, 0xe0, 0xf1, 0x37, 0x5c, 0xba, 0x43, 0x3f, 0xbc, 0x7a, 0xc5, 0xdc, 0x6b, 0xee, 0x5d,
-	0x8c, 0x7b, 0x4c, 0xb2, 0x1e, 0x97, 0xcc, 0x0f, 0x04, 0x79, 0x02, 0x0d, 0xc5, 0x27, 0x9c, 0x8c,
-	0x2e, 0x79, 0xdc, 0x2e, 0x3c, 0x2d, 0xbc, 0x58, 0xa7, 0x80, 0xa8, 0x81, 0xc2, 0x90, 0x67, 0xd0,
-	0x94, 0x91, 0x64, 0x41, 0x42, 0x51, 0x54, 0x14, 0x0d, 0x85, 0xd3, 0x24, 0xf6, 0x7f, 0x57, 0xa0,
-	0x82, 0xbc, 0x27, 0x63, 0xb2, 0x0d, 0x6b, 0x6e, 0x10, 0xb9, 0xd7, 0x8a, 0x51, 0x99, 0x6a, 0x80,
-	0xb4, 0xa0, 0xe8, 0x7b, 0x6a, 0x67, 0x9d, 0x16, 0x7d, 0x8f, 0x7c, 0x0d, 0x35, 0x37, 0x0a, 0x25,
-	0x73, 0xa5, 0x68, 0x97, 0x9e, 0x96, 0x5e, 0x34, 0x0e, 0x9e, 0xef, 0x27, 0x2f, 0xb2, 0x7f, 0x36,
-	0x0d, 0xdd, 0xc3, 0x50, 0x48, 0x16, 0x04, 0xea, 0xae, 0x5d, 0x4d, 0xf9, 0xed, 0x01, 0x4d, 0x37,
-	0x91, 0x9f, 0x40, 0x23, 0x73, 0xd3, 0x76, 0x59, 0xf1, 0xd8, 0xc9, 0xf3, 0xe8, 0x1a, 0x82, 0x29,
-	0xcd, 0xd2, 0x92, 0x13, 0xd8, 0x48, 0xd8, 0x98, 0x37, 0x68, 0xaf, 0x3d, 0x2d, 0xbc, 0x68, 0x1c,
-	0x7c, 0x32, 0xdb, 0x7e, 0xc7, 0x83, 0xd1, 0xf9, 0xdd, 0xe4, 0x02, 0x48, 0x86, 0x7f, 0xc2, 0xb3,
-	0xf2, 0x10, 0x9e, 0x4b, 0x18, 0x90, 0xcf, 0xa1, 0x3a, 0x8e, 0xa3, 0xf7, 0x7e, 0xc0, 0xdb, 0x55,
-	0xc5, 0xeb, 0xd1, 0x8c, 0x57, 0xc2, 0xe3, 0x54, 0x13, 0xd0, 0x84, 0x92, 0x1c, 0x43, 0xcb, 0x7c,
-	0x26, 0x72, 0xd4, 0x1e, 0x22, 0xc7, 0xdc, 0x66, 0xf2, 0x19, 0x54, 0x8d, 0x11, 0xb6, 0xeb, 0x8a,
-	0xcf, 0x87, 0xf9, 0x27, 0x3e, 0xd3, 0x8b, 0x34, 0xa1, 0xc2, 0xc7, 0x4d, 0xac, 0x36, 0x11, 0x00,
-	0x1e, 0xf4, 0xb8, 0x73, 0xbb, 0x51, 0x82, 0x6b, 0x3e, 0x45, 0xe7, 0x69, 0x37, 0x96, 0x49, 0x70,
-	0xa4, 0x17, 0x69, 0x42, 0x85, 0x2f, 0x60, 0x3e, 0x13, 0x01, 0x9a, 0x0f, 0x7a, 0x81, 0xfc, 0x66,
-	0xd2, 0x01, 0xeb, 0x96, 0x49, 0x77, 0x78, 0x12, 0x06, 0xd3, 0x8e, 0xeb, 0x46, 0x93, 0x50, 0xb6,
-	0xd7, 0x97, 0x09, 0x62, 0x16, 0xe9, 0x02, 0x39, 0x71, 0x60, 0x67, 0x1e, 0x97, 0x88, 0xd6, 0x7a,
-	0x88, 0x68, 0xab, 0xb8, 0xd8, 0xff, 0x51, 0x86, 0xe6, 0xf1, 0x24, 0x90, 0x7e, 0x72, 0x22, 0x81,
-	0x72, 0xc8, 0x46, 0x5c, 0xf9, 0x60, 0x9d, 0xaa, 0x6f, 0xb2, 0x07, 0x75, 0xe9, 0x8f, 0xb8, 0x90,
-	0x6c, 0x34, 0x56, 0x9e, 0x58, 0xa2, 0x33, 0x04, 0xae, 0xea, 0x10, 0xe4, 0x46, 0x61, 0xbb, 0xa4,
-	0xb6, 0xcd, 0x10, 0xe4, 0x6b, 0x00, 0x37, 0x0a, 0xa2, 0xd8, 0x19, 0x32, 0x31, 0x34, 0xce, 0xf6,
-	0x74, 0x26, 0x74, 0xf6, 0xec, 0xfd, 0x2e, 0x12, 0xbe, 0x61, 0x62, 0x48, 0xeb, 0x6e, 0xf2, 0x49,
-	0x1e, 0xa1, 0xbf, 0x23, 0x03, 0xdf, 0x53, 0xce, 0x56, 0xa2, 0x55, 0x05, 0x1f, 0x7a, 0xe4, 0x47,
-	0xb0, 0x71, 0xcd, 0xa7, 0x2e, 0x8b, 0x3d, 0xc7, 0x84, 0x48, 0xe5, 0x3a, 0x75, 0xa5, 0x09, 0x44,
-	0x9f, 0x6a, 0x2c, 0xd9, 0x51, 0x96, 0xe0, 0x4c, 0x7c, 0x4f, 0xf9, 0x43, 0x9d, 0x56, 0xae, 0xf9,
-	0xf4, 0xc2, 0xf7, 0xc8, 0x97, 0x50, 0xf1, 0x47, 0xec, 0x8a, 0xa3, 0xad, 0xa3, 0x64, 0x3f, 0x5c,
-	0x21, 0xd9, 0xa1, 0x89, 0xb1, 0x87, 0x48, 0x4c, 0xcd, 0x1e, 0xf2, 0x19, 0x6c, 0xb9, 0x13, 0x21,
-	0xa3, 0x91, 0xff, 0x73, 0x1d, 0x59, 0x95, 0x60, 0xca, 0xdc, 0xeb, 0x94, 0xe4, 0x96, 0xd4, 0xd5,
-	0x76, 0x9f, 0x41, 0x3d, 0xbd, 0x23, 0x86, 0x3b, 0x3f, 0xf4, 0xf8, 0x9f, 0xb4, 0x0b, 0x4f, 0x4b,
-	0x2f, 0x4a, 0x54, 0x03, 0xbb, 0xff, 0x52, 0x80, 0xf5, 0xdc, 0x69, 0x59, 0xe1, 0x0b, 0x39, 0xe1,
-	0x13, 0x55, 0x15, 0x33, 0xaa, 0x6a, 0x43, 0x75, 0xcc, 0xa6, 0x41, 0xc4, 0x3c, 0xa5, 0x8a, 0x26,
-	0x4d, 0x40, 0x3c, 0xee, 0xd6, 0xf7, 0x24, 0xea, 0x00, 0x1f, 0x51, 0x03, 0xe4, 0x23, 0xa8, 0x0c,
-	0xb9, 0x7f, 0x35, 0x94, 0xe6, 0x6d, 0x0d, 0x44, 0x76, 0xa1, 0x86, 0xce, 0x2c, 0xfc, 0x9f, 0x73,
-	0xf5, 0xa6, 0x25, 0x9a, 0xc2, 0xe4, 0x39, 0xac, 0xc7, 0xea, 0xcb, 0x91, 0x2c, 0xbe, 0xe2, 0x52,
-	0xbd, 0x69, 0x89, 0x36, 0x35, 0xf2, 0x5c, 0xe1, 0x66, 0xc1, 0xbc, 0x96, 0x09, 0xe6, 0xf6, 0x2f,
-	0x8a, 0xb0, 0xf5, 0x2e, 0x72, 0x59, 0x60, 0x34, 0x73, 0x6a, 0x84, 0xfb, 0x2d, 0x28, 0x5f, 0xf3,
-	0xa9, 0x50, 0x4f, 0xd1, 0x38, 0x78, 0x36, 0xd3, 0xc2, 0x12, 0xe2, 0xfd, 0x23, 0x3e, 0xa5, 0x8a,
-	0x9c, 0x7c, 0x01, 0xcd, 0x11, 0xaa, 0x89, 0x19, 0xef, 0x2a, 0x2a, 0x9f, 0xf8, 0x68, 0xb9, 0x12,
-	0x69, 0x8e, 0x16, 0x6f, 0x38, 0x66, 0x42, 0xdc, 0x46, 0xb1, 0x67, 0xac, 0x36, 0x85, 0xf1, 0x15,
-	0x31, 0xb5, 0x1e, 0xf1, 0xa9, 0x7a, 0xad, 0x3a, 0x4d, 0x40, 0xf2, 0x22, 0x35, 0x39, 0x23, 0x94,
-	0xce, 0x00, 0x75, 0x3a, 0x8f, 0xde, 0xfd, 0x35, 0x28, 0xe1, 0x86, 0x65, 0xfe, 0x44, 0xa0, 0x8c,
-	0x49, 0x52, 0x89, 0xdb, 0xa4, 0xea, 0xdb, 0xfe, 0x87, 0x02, 0x7c, 0x98, 0xbb, 0x2c, 0xe7, 0xf1,
-	0x1b, 0x1e, 0x04, 0x11, 0x5a, 0xb9, 0xb1, 0x6e, 0xe7, 0x86, 0xc7, 0xc2, 0x8f, 0x42, 0xc5, 0x6c,
-	0x8d, 0xb6, 0x0c, 0xfa, 0x5b, 0x8d, 0x45, 0x43, 0x19, 0x73, 0xae, 0x1c, 0x45, 0x73, 0xae, 0x20,
-	0x78, 0xe8, 0xa9, 0x3c, 0xcd, 0x6f, 0x7c, 0x97, 0x3b, 0x4a, 0x14, 0x7d, 0x5b, 0xd0, 0xa8, 0x01,
-	0x0a, 0x34, 0x23, 0x90, 0xd3, 0x31, 0x37, 0x77, 0x36, 0x04, 0xe7, 0xd3, 0xb1, 0x8a, 0x00, 0xc2,
-	0xbf, 0x0a, 0x99, 0x9c, 0xc4, 0x5c, 0x5d, 0xb8, 0x49, 0x67, 0x08, 0xfb, 0xfb, 0x02, 0x58, 0x28,
-	0x76, 0x36, 0xf3, 0xae, 0xc8, 0xe6, 0x3f, 0x82, 0x0d, 0x3f, 0x43, 0xe5, 0xa4, 0xa9, 0xbd, 0x95,
-	0x45, 0xe7, 0x64, 0x56, 0x22, 0x95, 0x16, 0x44, 0x4a, 0x1e, 0xb6, 0x9c, 0xb7, 0xfe, 0xe4, 0x89,
-	0xd6, 0x54, 0xa9, 0x91, 0x80, 0xf6, 0xbf, 0x17, 0x60, 0x67, 0x45, 0x71, 0x70, 0xcf, 0xba, 0xe3,
-	0x39, 0xac, 0x9b, 0x0c, 0xe7, 0x28, 0xf7, 0x37, 0x22, 0x35, 0x0d, 0x52, 0xfb, 0xea, 0x23, 0xa8,
-	0xf1, 0x50, 0x38, 0x19, 0xc1, 0xaa, 0x3c, 0x14, 0xea, 0x8d, 0x9f, 0x41, 0x33, 0x60, 0x42, 0x3a,
-	0x93, 0xb1, 0xc7, 0x24, 0xd7, 0xb1, 0xac, 0x4c, 0x1b, 0x88, 0xbb, 0xd0, 0x28, 0xbc, 0xb3, 0x98,
-	0x0a, 0xc9, 0x47, 0x8e, 0x64, 0x57, 0x58, 0x06, 0x94, 0xf0, 0xce, 0x1a, 0x75, 0xce, 0xae, 0x04,
-	0xf9, 0x04, 0x5a, 0x01, 0xda, 0x88, 0x13, 0xfa, 0xee, 0xb5, 0x3a, 0x44, 0x87, 0xb3, 0x75, 0x85,
-	0x1d, 0x18, 0xa4, 0xfd, 0xe7, 0x15, 0x78, 0xb4, 0xb2, 0x12, 0x22, 0xbf, 0x0e, 0xdb, 0x59, 0x41,
-	0x1c, 0xb5, 0x37, 0x98, 0x9a, 0xdb, 0x93, 0x8c, 0x40, 0xef, 0xf4, 0xca, 0xff, 0xe3, 0xa7, 0x40,
-	0xdd, 0x32, 0xcf, 0xe3, 0x9e, 0x0a, 0xca, 0x35, 0xaa, 0x01, 0xb4, 0x93, 0x4b, 0x54, 0x32, 0xf7,
-	0x54, 0x89, 0x51, 0xa3, 0x09, 0x88, 0xf4, 0xa3, 0x09, 0xca, 0xd4, 0xd0, 0xf4, 0x0a, 0x40, 0xfa,
-	0x98, 0x8f, 0xa2, 0x1b, 0xee, 0xa9, 0x8a, 0xa0, 0x46, 0x13, 0x90, 0x3c, 0x85, 0xe6, 0x90, 0x09,
-	0x47, 0xb1, 0x75, 0x26, 0x42, 0xe5, 0xf7, 0x1a, 0x85, 0x21, 0x13, 0x1d, 0x44, 0x5d, 0xa8, 0x24,
-	0x71, 0xc3, 0x63, 0xff, 0x7d, 0x52, 0x7d, 0x0b, 0xc9, 0xe4, 0x44, 0xa7, 0xef, 0x12, 0x25, 0xd9,
-	0xa5, 0x33, 0xb5, 0xa2, 0x8a, 0xe6, 0x78, 0x22, 0x64, 0x42, 0xb9, 0xa1, 0x28, 0x1b, 0x0a, 0x67,
-	0x48, 0xbe, 0x82, 0xc7, 0xa6, 0x92, 0x74, 0x62, 0xfe, 0xc7, 0x13, 0x2e, 0xa4, 0xd6, 0xa2, 0xda,
-	0xc2, 0xdb, 0x96, 0xda, 0xd1, 0x36, 0x24, 0x54, 0x53, 0x28, 0x65, 0xe2, 0x7e, 0xbe, 0x7a, 0xbb,
-	0x76, 0x83, 0xcd, 0x95, 0xdb, 0xbb, 0xca, 0x33, 0xbe, 0x86, 0xbd, 0xf9, 0xed, 0xf8, 0x1c, 0x92,
-	0x9b, 0xe3, 0x89, 0xda, 0xff, 0x28, 0xbf, 0x9f, 0x2a, 0x0a, 0x7d, 0xfe, 0x6a, 0x06, 0x5a, 0x80,
-	0xad, 0xd5, 0x0c, 0xb4, 0x04, 0xcf, 0xa0, 0xe9, 0xf9, 0x62, 0x1c, 0xb0, 0xa9, 0xb6, 0xaf, 0x6d,
-	0xa5, 0xfa, 0x86, 0xc1, 0xa1, 0x8d, 0xd9, 0xb7, 0x8b, 0xfe, 0x9e, 0x94, 0x38, 0xcb, 0xfd, 0x7d,
-	0xc1, 0xa8, 0x8b, 0x4b, 0x8c, 0x7a, 0xde, 0x72, 0x4b, 0x0b, 0x96, 0x6b, 0xbf, 0x82, 0xdd, 0xf9,
-	0x83, 0x4f, 0x27, 0x97, 0x81, 0xef, 0x76, 0x87, 0xec, 0x9e, 0xb1, 0xc6, 0xfe, 0xfb, 0x12, 0xac,
-	0xe7, 0xda, 0x90, 0xff, 0x75, 0x5f, 0x53, 0x39, 0xe6, 0x13, 0x68, 0x8c, 0x63, 0xff, 0x86, 0x49,
-	0xee, 0x5c, 0xf3, 0xa9, 0xa9, 0x00, 0xc0, 0xa0, 0x30, 0x1b, 0x3d, 0xc5, 0xa8, 0x2a, 0xdc, 0xd8,
-	0x1f, 0xa3, 0x5c, 0xca, 0x2f, 0x9b, 0x34, 0x8b, 0xc2, 0x82, 0xe0, 0x67, 0x91, 0x1f, 0x1a, 0xaf,
-	0xac, 0x51, 0x03, 0x61, 0xba, 0xd4, 0xb6, 0xca, 0x3d, 0x55, 0x10, 0xd4, 0x68, 0x0a, 0xcf, 0x9c,
-	0xa6, 0x9a, 0x75, 0x9a, 0x13, 0xb0, 0x8c, 0x76, 0x85, 0x23, 0x23, 0x07, 0xf9, 0x98, 0x2a, 0xeb,
-	0x93, 0x55, 0xcd, 0x96, 0x21, 0x3f, 0x8f, 0xde, 0x46, 0x7e, 0x48, 0x5b, 0x71, 0x0e, 0x26, 0x2f,
-	0xa1, 0x96, 0x94, 0xf8, 0xa6, 0xa5, 0x78, 0xb2, 0x82, 0x91, 0xe9, 0x2d, 0x04, 0x4d, 0x37, 0x60,
-	0x06, 0xe3, 0xa1, 0x1b, 0x4f, 0xc7, 0x32, 0x75, 0xfa, 0x19, 0x42, 0xe5, 0xb7, 0x31, 0x77, 0x25,
-	0x9b, 0xb9, 0xfe, 0x0c, 0x81, 0x49, 0xcb, 0x90, 0xa2, 0x03, 0xab, 0x42, 0xa5, 0xa9, 0x5e, 0xae,
-	0x35, 0x43, 0x1f, 0xf1, 0xa9, 0xc0, 0xf2, 0xe6, 0xf1, 0x1d, 0x37, 0x32, 0xfa, 0x2a, 0xa4, 0xfa,
-	0xfa, 0x18, 0x60, 0xac, 0x6c, 0x43, 0xa9, 0x4b, 0xeb, 0xbf, 0xae, 0x31, 0xa8, 0xad, 0x54, 0xe9,
-	0xa5, 0xac, 0xd2, 0xef, 0x08, 0xac, 0x3b, 0xba, 0x6e, 0x49, 0x4a, 0xe5, 0x3a, 0xad, 0x20, 0x78,
-	0xe8, 0xa1, 0xdd, 0x26, 0x6d, 0xe2, 0x14, 0x57, 0x2b, 0x5a, 0xf1, 0x29, 0xee, 0x50, 0x29, 0x51,
-	0xbb, 0x6f, 0x55, 0x1f, 0xa6, 0x00, 0xf2, 0x0d, 0x6c, 0xc6, 0xfc, 0x86, 0xb3, 0x80, 0x7b, 0x8e,
-	0xa9, 0x9c, 0x92, 0x5a, 0x39, 0xd3, 0x53, 0x52, 0x43, 0x92, 0x36, 0x32, 0x71, 0x1e, 0x21, 0xec,
-	0xbf, 0x2e, 0x82, 0x35, 0xef, 0x16, 0xe4, 0xab, 0x4c, 0x2b, 0xbf, 0x50, 0xf9, 0xad, 0x48, 0x60,
-	0x99, 0x46, 0xfe, 0x35, 0x34, 0xcd, 0xeb, 0xe1, 0x2d, 0x45, 0xbb, 0x38, 0x5f, 0xc2, 0xaf, 0xf6,
-	0x43, 0xda, 0x18, 0xa7, 0xdf, 0x82, 0xbc, 0x84, 0x6a, 0x52, 0x41, 0x96, 0x94, 0x5d, 0xdd, 0x21,
-	0x46, 0x72, 0xc5, 0x64, 0xc7, 0xff, 0x61, 0x9c, 0x60, 0xff, 0x18, 0x36, 0xd4, 0x2a, 0x0a, 0x64,
-	0xf2, 0xc9, 0xfd, 0xe2, 0xc3, 0x97, 0xb0, 0x9d, 0x6c, 0x3c, 0xd6, 0x33, 0x1c, 0x41, 0x39, 0xbb,
-	0xef, 0xee, 0xdf, 0x85, 0x8f, 0x74, 0xd7, 0x29, 0xfd, 0x1b, 0x5f, 0x4e, 0xbb, 0x3c, 0x94, 0x3c,
-	0xbe, 0x63, 0xbf, 0x05, 0x25, 0xdf, 0xd3, 0xcf, 0xdb, 0xa4, 0xf8, 0x69, 0xf7, 0x74, 0x8c, 0xcb,
-	0x73, 0xe8, 0xb8, 0x2e, 0x57, 0xce, 0x74, 0x5f, 0x2e, 0x7d, 0xed, 0x2c, 0x79, 0x2e, 0x3d, 0x5f,
-	0x8c, 0x7c, 0x21, 0x1e, 0xc0, 0xc6, 0x81, 0xe7, 0x8b, 0x6c, 0x06, 0x91, 0xcc, 0xe5, 0x55, 0x8e,
-	0xbe, 0x96, 0x54, 0x3c, 0x4c, 0x1a, 0x9e, 0x75, 0x83, 0xe9, 0x48, 0xf4, 0x2a, 0x4c, 0xe4, 0x82,
-	0xf3, 0x50, 0x3d, 0x55, 0x8d, 0x56, 0x87, 0x4c, 0x9c, 0x71, 0x1e, 0xda, 0x7f, 0x55, 0x80, 0x27,
-	0x77, 0x9f, 0x20, 0x48, 0x00, 0x1f, 0x33, 0xb3, 0xec, 0xb8, 0x6a, 0xdd, 0x09, 0xb3, 0x04, 0xc6,
-	0xbe, 0x5f, 0xcc, 0x37, 0xfe, 0xab, 0x38, 0xd2, 0xc7, 0x6c, 0xf5, 0x69, 0xf6, 0x3f, 0xd6, 0xe1,
-	0x07, 0x77, 0xef, 0x5f, 0x08, 0x35, 0x0b, 0x3d, 0x7c, 0x39, 0xdb, 0xc3, 0xbf, 0x87, 0xcd, 0xac,
-	0xb8, 0xb3, 0x9a, 0xbb, 0x75, 0xf0, 0x93, 0xfb, 0x8a, 0xbc, 0x9f, 0x05, 0xb0, 0x44, 0xa7, 0x56,
-	0x38, 0x87, 0xc9, 0x06, 0xa8, 0x72, 0x2e, 0x40, 0x11, 0x28, 0xc7, 0x9c, 0x25, 0x49, 0x47, 0x7d,
-	0xa3, 0xc8, 0x5e, 0x62, 0x0d, 0x26, 0xe7, 0xcc, 0x10, 0x98, 0x90, 0x98, 0xb1, 0x38, 0x93, 0x77,
-	0x52, 0x18, 0xeb, 0x35, 0x33, 0xdb, 0x54, 0xed, 0x67, 0x93, 0x26, 0x20, 0xa6, 0x37, 0x36, 0x91,
-	0xc3, 0xb4, 0x4b, 0x37, 0x90, 0xee, 0x69, 0xc7, 0xc1, 0x34, 0x99, 0x89, 0xaa, 0x14, 0xd1, 0xc4,
-	0x9e, 0x76, 0x1c, 0x4c, 0x8d, 0x8f, 0x2d, 0x44, 0xd1, 0x86, 0x2e, 0x3b, 0xb2, 0x51, 0xf4, 0x3d,
-	0x6c, 0x8e, 0xf8, 0xe8, 0x92, 0xc7, 0x62, 0xe8, 0x8f, 0x93, 0x0a, 0xae, 0xf9, 0xc0, 0x87, 0x3c,
-	0x4e, 0x39, 0xe8, 0x7a, 0x8f, 0x5a, 0xa3, 0x39, 0x0c, 0xf9, 0x8b, 0xc2, 0xac, 0x86, 0x5b, 0x56,
-	0x5e, 0xae, 0xab, 0x23, 0x5f, 0xdd, 0xfb, 0xc8, 0xa4, 0x3d, 0x58, 0x28, 0x47, 0xd3, 0x32, 0x6c,
-	0x71, 0x09, 0x9f, 0xd9, 0xe3, 0x01, 0x47, 0x0d, 0xb4, 0xb4, 0xcb, 0x18, 0x70, 0xce, 0xd9, 0x36,
-	0xe6, 0x9c, 0xcd, 0xfe, 0xcf, 0x02, 0x58, 0xf3, 0xd6, 0x42, 0x00, 0x2a, 0x83, 0x08, 0xbf, 0xac,
-	0x0f, 0xc8, 0x06, 0x34, 0x06, 0xfc, 0xf6, 0x24, 0xe4, 0xe7, 0xd1, 0x49, 0xc8, 0xad, 0x02, 0xd9,
-	0x81, 0xad, 0x01, 0xbf, 0x3d, 0xd5, 0x95, 0xcc, 0xeb, 0x38, 0x9a, 0x8c, 0x31, 0xf8, 0x59, 0x45,
-	0xd2, 0x80, 0xea, 0x31, 0x0f, 0x91, 0x89, 0x55, 0x22, 0x75, 0x58, 0xa3, 0xa8, 0x30, 0xab, 0x4c,
-	0x08, 0xb4, 0xba, 0xb9, 0xfa, 0xd1, 0x5a, 0x43, 0x26, 0x69, 0x24, 0x3e, 0x0c, 0x6f, 0x7c, 0xa9,
-	0x0e, 0xb7, 0x2a, 0x64, 0x1b, 0xac, 0xf9, 0x94, 0x6d, 0x55, 0xc9, 0x0f, 0x60, 0x37, 0xc5, 0xce,
-	0x54, 0x92, 0xac, 0xd7, 0xc8, 0x16, 0x6c, 0xa4, 0xeb, 0x47, 0x3e, 0xb6, 0x0f, 0x56, 0x5d, 0x9f,
-	0xb1, 0xf0, 0x60, 0x16, 0xd8, 0x7f, 0x59, 0x00, 0x6b, 0x5e, 0xb1, 0xa4, 0x0d, 0xdb, 0xf3, 0xb8,
-	0x43, 0x2f, 0xc0, 0x17, 0x78, 0x0c, 0x3b, 0xf3, 0x2b, 0xa7, 0x3c, 0xf4, 0xfc, 0xf0, 0xca, 0x2a,
-	0x90, 0x3d, 0x68, 0xcf, 0x2f, 0x26, 0xd1, 0xd7, 0x2a, 0x2e, 0x5b, 0xed, 0x71, 0x37, 0xc0, 0x32,
-	0xce, 0x2a, 0xd9, 0x7f, 0x56, 0x80, 0x47, 0x2b, 0xb5, 0x8d, 0xcf, 0x79, 0x11, 0x5e, 0x87, 0xd1,
-	0x6d, 0x68, 0x7d, 0x80, 0xc0, 0xec, 0xcc, 0x26, 0xd4, 0x32, 0x67, 0x34, 0xa1, 0x36, 0xe3, 0x49,
-	0xd6, 0xa1, 0xde, 0x65, 0xa1, 0xcb, 0x83, 0x80, 0x7b, 0x56, 0x19, 0xf7, 0x9d, 0x63, 0xb7, 0xc2,
-	0x3d, 0x6b, 0x8d, 0x6c, 0xc2, 0xfa, 0x45, 0xa8, 0xc0, 0xef, 0xa2, 0x58, 0x0e, 0xa7, 0x56, 0xc5,
-	0xfe, 0xbe, 0x00, 0x4d, 0xb4, 0xc7, 0x57, 0x51, 0x74, 0x3d, 0x62, 0xf1, 0xf5, 0xea, 0x50, 0x3f,
-	0x89, 0x03, 0x93, 0xb8, 0xf0, 0x33, 0xed, 0xf9, 0x4b, 0x99, 0x9e, 0xff, 0x31, 0xd4, 0x55, 0xbd,
-	0xee, 0x20, 0xad, 0x0e, 0x2a, 0x35, 0x85, 0xb8, 0x88, 0x83, 0x6c, 0xe3, 0xb6, 0x96, 0x6f, 0xdc,
-	0x3e, 0x06, 0x30, 0xc6, 0x8a, 0x16, 0x5a, 0xd1, 0x16, 0x6a, 0x30, 0x1d, 0x69, 0xff, 0x29, 0x7c,
-	0x88, 0x12, 0xf6, 0x43, 0x71, 0x21, 0x78, 0x8c, 0x07, 0xe9, 0x89, 0xe9, 0x0a, 0x51, 0x77, 0xa1,
-	0x36, 0x31, 0x74, 0x46, 0xde, 0x14, 0x56, 0x03, 0xcc, 0x21, 0xf3, 0xd5, 0xac, 0x43, 0x17, 0x72,
-	0x55, 0x05, 0x1f, 0xe6, 0xfa, 0xca, 0x72, 0x4e, 0x3c, 0xfb, 0xad, 0x2e, 0x97, 0xba, 0x01, 0x67,
-	0xf1, 0x1b, 0x5f, 0xc8, 0x28, 0x9e, 0x66, 0x83, 0x67, 0x21, 0x17, 0x3c, 0x3f, 0x06, 0x70, 0x91,
-	0x50, 0xdf, 0xc5, 0x04, 0x77, 0x83, 0xe9, 0x48, 0xfb, 0x97, 0x05, 0x20, 0xc8, 0xcc, 0x4c, 0xfc,
-	0x4f, 0x7d, 0x57, 0x4e, 0x62, 0xbe, 0x74, 0x32, 0x95, 0x19, 0x1f, 0x16, 0x57, 0x8c, 0x0f, 0x4b,
-	0x6a, 0xb0, 0xb2, 0x30, 0x3e, 0x2c, 0x2b, 0x74, 0x32, 0x3e, 0x7c, 0x0c, 0x75, 0xd5, 0x49, 0xa9,
-	0xf9, 0xa1, 0x1e, 0xc5, 0xa8, 0xf9, 0xe1, 0xd9, 0xd2, 0xf9, 0x61, 0x45, 0x11, 0xac, 0x98, 0x1f,
-	0x56, 0xb3, 0xf3, 0xc3, 0x21, 0x6c, 0x2d, 0xde, 0x44, 0xac, 0x1e, 0x91, 0xfe, 0x0e, 0xd4, 0xc6,
-	0x86, 0xc8, 0x94, 0x87, 0x7b, 0xf9, 0x90, 0x98, 0xe7, 0x44, 0x53, 0x6a, 0xfb, 0x97, 0x45, 0x68,
-	0x64, 0x66, 0xf3, 0x2b, 0xf4, 0xde, 0x86, 0x2a, 0xf3, 0xbc, 0x98, 0x0b, 0x91, 0xbc, 0x97, 0x01,
-	0xb3, 0x22, 0x95, 0x72, 0x22, 0xe5, 0x6b, 0x7e, 0xdd, 0x81, 0x65, 0x6a, 0x7e, 0x02, 0xe5, 0x31,
-	0x93, 0x43, 0x53, 0xbf, 0xab, 0xef, 0x54, 0x53, 0x95, 0x8c, 0xa6, 0xb2, 0x63, 0xf1, 0xaa, 0x99,
-	0x51, 0x9a, 0xb1, 0xf8, 0x36, 0xac, 0xf1, 0x51, 0xf4, 0x33, 0x5f, 0xe5, 0xbe, 0x3a, 0xd5, 0x00,
-	0xaa, 0xea, 0x96, 0x05, 0x01, 0x97, 0x66, 0x14, 0x62, 0x20, 0x64, 0x8e, 0x66, 0x64, 0x7a, 0x22,
-	0xf5, 0xad, 0xd4, 0xea, 0x7b, 0x1e, 0x0f, 0x4d, 0x2f, 0x64, 0xa0, 0x3b, 0xe6, 0x20, 0xbb, 0x50,
-	0x1b, 0x47, 0xc2, 0x57, 0x5d, 0xe5, 0xba, 0x9e, 0x17, 0x27, 0xb0, 0xfd, 0x6f, 0xe6, 0x29, 0xcd,
-	0xff, 0x5b, 0x56, 0x3c, 0x65, 0xe6, 0xc1, 0x8a, 0x4b, 0xc7, 0xdc, 0xa5, 0xfc, 0x04, 0x35, 0x33,
-	0xa9, 0x54, 0xdf, 0x6a, 0x28, 0xc0, 0x63, 0xff, 0x86, 0x7b, 0xce, 0xfb, 0x38, 0x1a, 0x99, 0x17,
-	0x6c, 0x18, 0xdc, 0x37, 0x71, 0x34, 0x22, 0x2f, 0x61, 0x57, 0xb7, 0xef, 0x82, 0x7b, 0x8e, 0x5a,
-	0x30, 0x53, 0x48, 0x35, 0x87, 0xd7, 0x41, 0x60, 0x47, 0x35, 0xf3, 0x82, 0x7b, 0xbd, 0x74, 0xfd,
-	0x10, 0x97, 0xf5, 0x48, 0x2a, 0x74, 0x13, 0xf6, 0xfa, 0xd1, 0x41, 0xa3, 0x14, 0xf7, 0xdf, 0x50,
-	0x15, 0x49, 0xb6, 0x45, 0x5a, 0xf1, 0x7f, 0x9e, 0x94, 0x0c, 0xb7, 0x98, 0xb9, 0x31, 0xb6, 0xb4,
-	0xa5, 0xa5, 0xff, 0xa3, 0xc2, 0x55, 0x9a, 0x92, 0x65, 0x75, 0x00, 0xf9, 0x98, 0xf1, 0x5f, 0x05,
-	0x1d, 0x34, 0xce, 0xd8, 0x0d, 0xf7, 0x3a, 0xc6, 0x0e, 0x33, 0x16, 0x5a, 0xc8, 0x5b, 0xe8, 0xb2,
-	0x7f, 0x1f, 0xec, 0x41, 0xfd, 0x3d, 0xbb, 0x89, 0x26, 0xb1, 0x2f, 0xf5, 0x83, 0xd7, 0xe8, 0x0c,
-	0x71, 0x47, 0x34, 0x7d, 0x06, 0x4d, 0x9d, 0xdd, 0x9d, 0xac, 0xd3, 0x36, 0x34, 0x4e, 0xcf, 0x6c,
-	0x7e, 0x15, 0x36, 0x75, 0x18, 0x14, 0xc3, 0x28, 0x96, 0xaa, 0x7d, 0x15, 0xc6, 0x42, 0x37, 0xd4,
-	0xc2, 0x19, 0xe2, 0xb1, 0x8d, 0x15, 0x18, 0xf9, 0x79, 0x28, 0x4c, 0x89, 0x86, 0x9f, 0x68, 0x1d,
-	0xbe, 0x70, 0x24, 0x17, 0x89, 0xa1, 0x56, 0x7c, 0x71, 0xce, 0x85, 0x7c, 0x5b, 0xae, 0x95, 0xad,
-	0x35, 0xfb, 0x17, 0x05, 0x1d, 0xaf, 0x17, 0x26, 0x00, 0x2b, 0x8c, 0x6d, 0xbe, 0x92, 0x2b, 0x2e,
-	0x56, 0x72, 0x7d, 0x78, 0x32, 0xd4, 0x81, 0xd7, 0x61, 0xb1, 0x3b, 0xf4, 0x6f, 0xb8, 0x23, 0x26,
-	0xe3, 0x31, 0xca, 0xce, 0x43, 0x76, 0x19, 0x98, 0xe9, 0x4f, 0x8d, 0xee, 0x19, 0xb2, 0x8e, 0xa6,
-	0x3a, 0xd3, 0x44, 0x7d, 0x4d, 0x63, 0xff, 0x5d, 0x41, 0x37, 0x79, 0x26, 0x21, 0x62, 0x36, 0xb9,
-	0xe7, 0xc0, 0xf9, 0x2b, 0xa8, 0x98, 0x62, 0x4e, 0x17, 0xe2, 0x73, 0x53, 0x93, 0x0c, 0xc3, 0xfd,
-	0xf3, 0xd9, 0x6c, 0x90, 0x9a, 0x4d, 0xf6, 0x17, 0xd0, 0xc8, 0xa0, 0x55, 0x62, 0x1f, 0x1c, 0x0d,
-	0x4e, 0xbe, 0x1b, 0xe8, 0xc4, 0x7e, 0x4e, 0x2f, 0xce, 0xce, 0xfb, 0x3d, 0xab, 0xa0, 0x12, 0xf4,
-	0x40, 0x81, 0xdf, 0x9d, 0xd0, 0xf3, 0x37, 0x3f, 0xb5, 0x8a, 0xf6, 0xf7, 0x25, 0x3d, 0x3d, 0xcb,
-	0x16, 0x08, 0xa6, 0xee, 0x59, 0x21, 0x3c, 0x81, 0xb2, 0xf2, 0x0a, 0x63, 0x4c, 0xf8, 0x8d, 0x17,
-	0x92, 0x91, 0x71, 0xdb, 0xa2, 0x8c, 0xd0, 0xb8, 0xdc, 0x21, 0x06, 0x9d, 0xf0, 0x2a, 0xf1, 0xdc,
-	0x19, 0x02, 0x55, 0x62, 0xe6, 0x3d, 0x3a, 0x8d, 0x99, 0xa1, 0x70, 0x8a, 0xeb, 0xa8, 0x7f, 0xd9,
-	0xc4, 0x5c, 0x8c, 0xa3, 0x50, 0x24, 0xb1, 0x30, 0x85, 0x31, 0xac, 0x62, 0xad, 0xee, 0xeb, 0xcd,
-	0xda, 0xfe, 0xea, 0x06, 0xd3, 0x91, 0x84, 0x2f, 0x9f, 0xc2, 0xd6, 0xd4, 0xcb, 0xfe, 0x66, 0xfe,
-	0x65, 0x97, 0xdc, 0x7a, 0x7f, 0x49, 0x61, 0xbc, 0x6c, 0x76, 0xab, 0x75, 0x58, 0x4f, 0x5b, 0xed,
-	0xdf, 0x07, 0xb2, 0xa2, 0xc8, 0xca, 0xea, 0xe2, 0xb4, 0x3f, 0xe8, 0x1d, 0x0e, 0x5e, 0x9b, 0x22,
-	0xab, 0xdb, 0xed, 0x9f, 0xa2, 0x66, 0x74, 0x91, 0xd5, 0xef, 0xbe, 0x3b, 0x1c, 0xf4, 0x7b, 0x56,
-	0x09, 0xa1, 0x6e, 0x67, 0xd0, 0xed, 0xbf, 0xeb, 0xf7, 0xac, 0xb2, 0xfd, 0xaf, 0x05, 0xdd, 0x83,
-	0xe7, 0x8b, 0xdc, 0x1e, 0x77, 0x7d, 0xb1, 0xfa, 0xbf, 0x2f, 0x7b, 0x50, 0x37, 0xef, 0x79, 0x98,
-	0x58, 0xda, 0x0c, 0x41, 0xfe, 0x10, 0x36, 0x3c, 0xb3, 0xdf, 0xc9, 0x59, 0xde, 0xe7, 0xf3, 0xd3,
-	0x8c, 0x65, 0x47, 0xee, 0x27, 0x1f, 0xe6, 0x79, 0x5a, 0x5e, 0x0e, 0xb6, 0x3f, 0x85, 0x56, 0x9e,
-	0x22, 0x77, 0xd9, 0x0f, 0x72, 0x97, 0x2d, 0xd8, 0xff, 0x5c, 0x84, 0x8d, 0xb9, 0x5f, 0x2a, 0xac,
-	0xce, 0xf2, 0xf3, 0xe3, 0xe0, 0xe2, 0xc2, 0x38, 0x98, 0x7c, 0x0a, 0x24, 0x4b, 0xe2, 0x64, 0xe7,
-	0x6a, 0x56, 0x86, 0x50, 0xc7, 0xaa, 0x6c, 0xd9, 0x50, 0x7e, 0x48, 0xd9, 0x40, 0xbe, 0x84, 0xa6,
-	0x88, 0x5c, 0x9f, 0x05, 0x4e, 0xe0, 0x87, 0xd7, 0xc9, 0xcf, 0x43, 0x1e, 0xcd, 0xfd, 0xf4, 0x41,
-	0x51, 0xbc, 0x43, 0x02, 0xda, 0x10, 0x33, 0x80, 0xfc, 0x1e, 0x6c, 0xf3, 0x50, 0x38, 0x49, 0xe9,
-	0xe8, 0x78, 0xe9, 0x0f, 0x42, 0x4a, 0x8b, 0xd3, 0xce, 0x85, 0xda, 0x94, 0x12, 0x3e, 0x8f, 0x12,
-	0xb6, 0x00, 0xa0, 0xec, 0x36, 0xe9, 0x60, 0x33, 0xf5, 0x5d, 0x21, 0x5f, 0xdf, 0x1d, 0x41, 0xc3,
-	0xb4, 0xbe, 0xd8, 0x82, 0xa9, 0x27, 0x6c, 0x1d, 0xfc, 0xca, 0xec, 0xc4, 0xce, 0xec, 0x07, 0x44,
-	0xc7, 0xe6, 0xf7, 0x43, 0x86, 0xe9, 0xbe, 0xea, 0xf5, 0xb3, 0xbb, 0xed, 0xbf, 0x2d, 0x40, 0x0b,
-	0x45, 0xcc, 0x9c, 0xfc, 0xdb, 0xd0, 0x88, 0x53, 0x28, 0x19, 0x87, 0x6c, 0x67, 0x46, 0x88, 0xe9,
-	0x22, 0xcd, 0x12, 0x92, 0x03, 0xd8, 0x16, 0x93, 0xcb, 0x64, 0x8e, 0xf8, 0x56, 0x44, 0xe1, 0xab,
-	0xa9, 0xe4, 0x49, 0xb9, 0xb5, 0x74, 0x8d, 0x7c, 0x0a, 0x9b, 0xc9, 0xdc, 0x77, 0xb6, 0x41, 0x0f,
-	0xc3, 0x17, 0x17, 0xec, 0xbf, 0x29, 0xa4, 0xe5, 0x09, 0x66, 0x58, 0xd5, 0x76, 0xa4, 0x26, 0x86,
-	0x9f, 0x4b, 0x33, 0xe5, 0x47, 0x50, 0x31, 0xff, 0x41, 0xd2, 0x59, 0xc0, 0x40, 0x59, 0x23, 0x2d,
-	0xe7, 0x8c, 0x74, 0x0f, 0xea, 0x26, 0xf3, 0x72, 0x34, 0x8b, 0x12, 0x96, 0x7d, 0x29, 0x62, 0xe6,
-	0xaf, 0x95, 0x6c, 0xb9, 0xfb, 0x4f, 0x45, 0xd8, 0xcc, 0x88, 0x86, 0xfd, 0x7b, 0x14, 0x92, 0x2f,
-	0xa0, 0xc2, 0xd4, 0x97, 0x92, 0xb1, 0x75, 0x60, 0x2f, 0x2d, 0x19, 0x34, 0xf1, 0xbe, 0xfe, 0x43,
-	0xcd, 0x0e, 0xf2, 0x43, 0x58, 0x8f, 0x02, 0xcf, 0x90, 0x5c, 0xa4, 0xf9, 0x26, 0x8f, 0x34, 0xbf,
-	0x9c, 0x41, 0xc8, 0x0c, 0x44, 0x57, 0x54, 0x25, 0x09, 0x15, 0xe6, 0xdf, 0x8a, 0x91, 0x6e, 0x13,
-	0xd6, 0x8f, 0xfa, 0x3f, 0xed, 0x76, 0x68, 0xcf, 0xe9, 0xf4, 0x7a, 0xca, 0xb5, 0x09, 0xb4, 0x3a,
-	0xdd, 0xee, 0xc9, 0xc5, 0xe0, 0xfc, 0xcc, 0xe0, 0x0a, 0xd8, 0x3c, 0x27, 0x64, 0xbd, 0xfe, 0xbb,
-	0xbe, 0x0e, 0x78, 0xdb, 0x60, 0xa5, 0x84, 0xb4, 0x7f, 0x7c, 0xf2, 0xad, 0x0a, 0x7c, 0x00, 0x95,
-	0x77, 0x27, 0xdd, 0x23, 0x0c, 0x7b, 0x18, 0x25, 0x2e, 0x06, 0x06, 0x5a, 0x23, 0x1b, 0xd0, 0xb8,
-	0x38, 0xec, 0x39, 0x17, 0xa7, 0xbd, 0x0e, 0x32, 0xa8, 0x10, 0x0b, 0x9a, 0x83, 0xce, 0x71, 0xdf,
-	0xe9, 0xbe, 0xe9, 0x0c, 0x5e, 0xf7, 0x7b, 0x56, 0xd5, 0xfe, 0x23, 0x9d, 0x7e, 0x33, 0x2e, 0x47,
-	0x7e, 0x3c, 0xe7, 0xa3, 0x0b, 0xb6, 0x38, 0x23, 0xce, 0xbb, 0x67, 0xaa, 0xa4, 0x62, 0x46, 0x49,
-	0xaf, 0xd6, 0xff, 0xa0, 0xb1, 0xff, 0xd9, 0xcb, 0x64, 0xf3, 0x65, 0x45, 0x7d, 0x7d, 0xfe, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x9a, 0xb1, 0xaf, 0x0c, 0xb6, 0x27, 0x00, 0x00,
+	0x56, 0xa3, 0x0f, 0xeb, 0xe3, 0xe9, 0xc3, 0xe5, 0x6c, 0xcf, 0xb4, 0xda, 0xdd, 0xbd, 0xdd, 0x5d,
+	0xb3, 0xc3, 0x36, 0xc4, 0xac, 0x07, 0x7a, 0x80, 0x65, 0xe7, 0x23, 0x06, 0xb5, 0xa4, 0x99, 0xf6,
+	0xb8, 0x5b, 0x36, 0x69, 0x7b, 0x86, 0x25, 0x88, 0xa8, 0xc9, 0xae, 0xca, 0xb6, 0x6a, 0x5d, 0xaa,
+	0xd2, 0x56, 0xa6, 0xec, 0xd1, 0x1e, 0x08, 0x20, 0x82, 0x33, 0x11, 0x5c, 0x76, 0x8f, 0x9c, 0x39,
+	0x12, 0x70, 0x20, 0x82, 0x08, 0x38, 0x11, 0xf3, 0x03, 0xb8, 0xc1, 0x95, 0x0b, 0xc1, 0x85, 0x1b,
+	0x07, 0x0e, 0xc4, 0xcb, 0xcc, 0x2a, 0x55, 0x49, 0x2a, 0x63, 0x07, 0x27, 0x4e, 0xca, 0xf7, 0xf2,
+	0x65, 0xe6, 0xcb, 0x97, 0xef, 0x5b, 0x05, 0x9d, 0x19, 0xf3, 0x63, 0x3f, 0x3c, 0xdf, 0x9f, 0xc5,
+	0x91, 0x8c, 0x48, 0x43, 0xfd, 0xbc, 0x9e, 0xbf, 0xd9, 0xbb, 0xe3, 0x4e, 0x98, 0x74, 0x7c, 0x8f,
+	0x87, 0xd2, 0x97, 0x0b, 0x3d, 0xbd, 0x77, 0x47, 0x2c, 0x42, 0xd7, 0x11, 0x5c, 0x4a, 0x3f, 0x3c,
+	0x17, 0x06, 0x69, 0xb3, 0xd9, 0x2c, 0xf0, 0x5d, 0x26, 0xfd, 0x28, 0x74, 0xa6, 0x5c, 0x32, 0x8f,
+	0x49, 0xe6, 0x4c, 0xb9, 0x10, 0xec, 0x9c, 0x1b, 0x9a, 0x1d, 0x37, 0x9a, 0x4e, 0xe7, 0xa1, 0x2f,
+	0x7d, 0x6e, 0x96, 0xd9, 0x0c, 0xee, 0x7f, 0xce, 0xa5, 0x3b, 0xf1, 0xc3, 0xf3, 0xe7, 0xcc, 0xbd,
+	0xe0, 0xde, 0xd9, 0x6c, 0xc8, 0x24, 0x1b, 0x72, 0xc9, 0xfc, 0x40, 0x90, 0x47, 0xd0, 0x52, 0xfb,
+	0x84, 0xf3, 0xe9, 0x6b, 0x1e, 0xf7, 0x4a, 0x8f, 0x4b, 0x4f, 0x3b, 0x14, 0x10, 0x35, 0x56, 0x18,
+	0xf2, 0x04, 0xda, 0x32, 0x92, 0x2c, 0x48, 0x28, 0xca, 0x8a, 0xa2, 0xa5, 0x70, 0x9a, 0xc4, 0xfe,
+	0xef, 0x1a, 0xd4, 0x70, 0xef, 0xf9, 0x8c, 0xec, 0xc2, 0x96, 0x1b, 0x44, 0xee, 0x85, 0xda, 0xa8,
+	0x4a, 0x35, 0x40, 0xba, 0x50, 0xf6, 0x3d, 0xb5, 0xb2, 0x49, 0xcb, 0xbe, 0x47, 0x3e, 0x83, 0x86,
+	0x1b, 0x85, 0x92, 0xb9, 0x52, 0xf4, 0x2a, 0x8f, 0x2b, 0x4f, 0x5b, 0xcf, 0xde, 0xdd, 0x4f, 0x24,
+	0xb2, 0x7f, 0xb2, 0x08, 0xdd, 0x83, 0x50, 0x48, 0x16, 0x04, 0xea, 0xae, 0x03, 0x4d, 0xf9, 0xd5,
+	0x33, 0x9a, 0x2e, 0x22, 0x3f, 0x86, 0x56, 0xe6, 0xa6, 0xbd, 0xaa, 0xda, 0xe3, 0x6e, 0x7e, 0x8f,
+	0x81, 0x21, 0x58, 0xd0, 0x2c, 0x2d, 0x39, 0x82, 0xed, 0x64, 0x1b, 0x23, 0x83, 0xde, 0xd6, 0xe3,
+	0xd2, 0xd3, 0xd6, 0xb3, 0xf7, 0x96, 0xcb, 0xaf, 0x11, 0x18, 0x5d, 0x5d, 0x4d, 0xce, 0x80, 0x64,
+	0xf6, 0x4f, 0xf6, 0xac, 0xdd, 0x66, 0xcf, 0x0d, 0x1b, 0x90, 0x0f, 0xa1, 0x3e, 0x8b, 0xa3, 0x37,
+	0x7e, 0xc0, 0x7b, 0x75, 0xb5, 0xd7, 0xbd, 0xe5, 0x5e, 0xc9, 0x1e, 0xc7, 0x9a, 0x80, 0x26, 0x94,
+	0xe4, 0x15, 0x74, 0xcd, 0x30, 0xe1, 0xa3, 0x71, 0x1b, 0x3e, 0x56, 0x16, 0x93, 0x0f, 0xa0, 0x6e,
+	0x94, 0xb0, 0xd7, 0x54, 0xfb, 0xbc, 0x9d, 0x17, 0xf1, 0x89, 0x9e, 0xa4, 0x09, 0x15, 0x0a, 0xd7,
+	0x0c, 0x53, 0x41, 0xc0, 0xad, 0x84, 0xbb, 0xb2, 0x1a, 0x39, 0xb8, 0xe0, 0x0b, 0x34, 0x9e, 0x5e,
+	0x6b, 0x13, 0x07, 0x87, 0x7a, 0x92, 0x26, 0x54, 0x28, 0x01, 0x33, 0x4c, 0x18, 0x68, 0xdf, 0x4a,
+	0x02, 0xf9, 0xc5, 0xa4, 0x0f, 0xd6, 0x15, 0x93, 0xee, 0xe4, 0x28, 0x0c, 0x16, 0x7d, 0xd7, 0x8d,
+	0xe6, 0xa1, 0xec, 0x75, 0x36, 0x31, 0x62, 0x26, 0xe9, 0x1a, 0x39, 0x71, 0xe0, 0xee, 0x2a, 0x2e,
+	0x61, 0xad, 0x7b, 0x1b, 0xd6, 0x8a, 0x76, 0xb1, 0xff, 0xa3, 0x0a, 0xed, 0x57, 0xf3, 0x40, 0xfa,
+	0xc9, 0x89, 0x04, 0xaa, 0x21, 0x9b, 0x72, 0x65, 0x83, 0x4d, 0xaa, 0xc6, 0xe4, 0x01, 0x34, 0xa5,
+	0x3f, 0xe5, 0x42, 0xb2, 0xe9, 0x4c, 0x59, 0x62, 0x85, 0x2e, 0x11, 0x38, 0xab, 0x5d, 0x90, 0x1b,
+	0x85, 0xbd, 0x8a, 0x5a, 0xb6, 0x44, 0x90, 0xcf, 0x00, 0xdc, 0x28, 0x88, 0x62, 0x67, 0xc2, 0xc4,
+	0xc4, 0x18, 0xdb, 0xe3, 0x25, 0xd3, 0xd9, 0xb3, 0xf7, 0x07, 0x48, 0xf8, 0x82, 0x89, 0x09, 0x6d,
+	0xba, 0xc9, 0x90, 0xdc, 0x83, 0x86, 0xde, 0xc0, 0xf7, 0x94, 0xb1, 0x55, 0x68, 0x5d, 0xc1, 0x07,
+	0x1e, 0xf9, 0x01, 0x6c, 0x5f, 0xf0, 0x85, 0xcb, 0x62, 0xcf, 0x31, 0x2e, 0x52, 0x99, 0x4e, 0x93,
+	0x76, 0x0d, 0xfa, 0x58, 0x63, 0xc9, 0x5d, 0xa5, 0x09, 0xce, 0xdc, 0xf7, 0x94, 0x3d, 0x34, 0x69,
+	0xed, 0x82, 0x2f, 0xce, 0x7c, 0x8f, 0x7c, 0x02, 0x35, 0x7f, 0xca, 0xce, 0x39, 0xea, 0x3a, 0x72,
+	0xf6, 0xfd, 0x02, 0xce, 0x0e, 0x8c, 0x8f, 0x3d, 0x40, 0x62, 0x6a, 0xd6, 0x90, 0x0f, 0xe0, 0x8e,
+	0x3b, 0x17, 0x32, 0x9a, 0xfa, 0x3f, 0xd7, 0x9e, 0x55, 0x31, 0xa6, 0xd4, 0xbd, 0x49, 0x49, 0x6e,
+	0x4a, 0x5d, 0x6d, 0xef, 0x09, 0x34, 0xd3, 0x3b, 0xa2, 0xbb, 0xf3, 0x43, 0x8f, 0x7f, 0xdb, 0x2b,
+	0x3d, 0xae, 0x3c, 0xad, 0x50, 0x0d, 0xec, 0xfd, 0x4b, 0x09, 0x3a, 0xb9, 0xd3, 0xb2, 0xcc, 0x97,
+	0x72, 0xcc, 0x27, 0x4f, 0x55, 0xce, 0x3c, 0x55, 0x0f, 0xea, 0x33, 0xb6, 0x08, 0x22, 0xe6, 0xa9,
+	0xa7, 0x68, 0xd3, 0x04, 0xc4, 0xe3, 0xae, 0x7c, 0x4f, 0xe2, 0x1b, 0xa0, 0x10, 0x35, 0x40, 0xde,
+	0x81, 0xda, 0x84, 0xfb, 0xe7, 0x13, 0x69, 0x64, 0x6b, 0x20, 0xb2, 0x07, 0x0d, 0x34, 0x66, 0xe1,
+	0xff, 0x9c, 0x2b, 0x99, 0x56, 0x68, 0x0a, 0x93, 0x77, 0xa1, 0x13, 0xab, 0x91, 0x23, 0x59, 0x7c,
+	0xce, 0xa5, 0x92, 0x69, 0x85, 0xb6, 0x35, 0xf2, 0x54, 0xe1, 0x96, 0xce, 0xbc, 0x91, 0x71, 0xe6,
+	0xf6, 0x2f, 0xca, 0x70, 0xe7, 0x65, 0xe4, 0xb2, 0xc0, 0xbc, 0xcc, 0xb1, 0x61, 0xee, 0xb7, 0xa0,
+	0x7a, 0xc1, 0x17, 0x42, 0x89, 0xa2, 0xf5, 0xec, 0xc9, 0xf2, 0x15, 0x36, 0x10, 0xef, 0x1f, 0xf2,
+	0x05, 0x55, 0xe4, 0xe4, 0x23, 0x68, 0x4f, 0xf1, 0x99, 0x98, 0xb1, 0xae, 0xb2, 0xb2, 0x89, 0x77,
+	0x36, 0x3f, 0x22, 0xcd, 0xd1, 0xe2, 0x0d, 0x67, 0x4c, 0x88, 0xab, 0x28, 0xf6, 0x8c, 0xd6, 0xa6,
+	0x30, 0x4a, 0x11, 0x43, 0xeb, 0x21, 0x5f, 0x28, 0x69, 0x35, 0x69, 0x02, 0x92, 0xa7, 0xa9, 0xca,
+	0x19, 0xa6, 0x74, 0x04, 0x68, 0xd2, 0x55, 0xf4, 0xde, 0x0f, 0xa1, 0x82, 0x0b, 0x36, 0xd9, 0x13,
+	0x81, 0x2a, 0x06, 0x49, 0xc5, 0x6e, 0x9b, 0xaa, 0xb1, 0xfd, 0x77, 0x25, 0x78, 0x3b, 0x77, 0x59,
+	0xce, 0xe3, 0x17, 0x3c, 0x08, 0x22, 0xd4, 0x72, 0xa3, 0xdd, 0xce, 0x25, 0x8f, 0x85, 0x1f, 0x85,
+	0x6a, 0xb3, 0x2d, 0xda, 0x35, 0xe8, 0xaf, 0x34, 0x16, 0x15, 0x65, 0xc6, 0xb9, 0x32, 0x14, 0xbd,
+	0x73, 0x0d, 0xc1, 0x03, 0x4f, 0xc5, 0x69, 0x7e, 0xe9, 0xbb, 0xdc, 0x51, 0xac, 0xe8, 0xdb, 0x82,
+	0x46, 0x8d, 0x91, 0xa1, 0x25, 0x81, 0x5c, 0xcc, 0x78, 0xaf, 0x9a, 0x25, 0x38, 0x5d, 0xcc, 0x94,
+	0x07, 0x10, 0xfe, 0x79, 0xc8, 0xe4, 0x3c, 0xe6, 0xea, 0xc2, 0x6d, 0xba, 0x44, 0xd8, 0x7f, 0x59,
+	0x02, 0x0b, 0xd9, 0xce, 0x46, 0xde, 0x82, 0x68, 0xfe, 0x03, 0xd8, 0xf6, 0x33, 0x54, 0x4e, 0x1a,
+	0xda, 0xbb, 0x59, 0xf4, 0x81, 0xb7, 0xca, 0x52, 0x65, 0x8d, 0xa5, 0x44, 0xb0, 0xd5, 0xbc, 0xf6,
+	0x27, 0x22, 0xda, 0x52, 0xa9, 0x46, 0x02, 0xda, 0xff, 0x5e, 0x82, 0xbb, 0x05, 0xc9, 0xc1, 0x0d,
+	0xf3, 0x8e, 0x77, 0xa1, 0x63, 0x22, 0x9c, 0xa3, 0xcc, 0xdf, 0xb0, 0xd4, 0x36, 0x48, 0x6d, 0xab,
+	0xf7, 0xa0, 0xc1, 0x43, 0xe1, 0x64, 0x18, 0xab, 0xf3, 0x50, 0x28, 0x19, 0x3f, 0x81, 0x76, 0xc0,
+	0x84, 0x74, 0xe6, 0x33, 0x8f, 0x49, 0xae, 0x7d, 0x59, 0x95, 0xb6, 0x10, 0x77, 0xa6, 0x51, 0x78,
+	0x67, 0xb1, 0x10, 0x92, 0x4f, 0x1d, 0xc9, 0xce, 0x31, 0x0d, 0xa8, 0xe0, 0x9d, 0x35, 0xea, 0x94,
+	0x9d, 0x0b, 0xf2, 0x1e, 0x74, 0x03, 0xd4, 0x11, 0x27, 0xf4, 0xdd, 0x0b, 0x75, 0x88, 0x76, 0x67,
+	0x1d, 0x85, 0x1d, 0x1b, 0xa4, 0xfd, 0x27, 0x35, 0xb8, 0x57, 0x98, 0x09, 0x91, 0x5f, 0x87, 0xdd,
+	0x2c, 0x23, 0x8e, 0x5a, 0x1b, 0x2c, 0xcc, 0xed, 0x49, 0x86, 0xa1, 0x97, 0x7a, 0xe6, 0xff, 0xb1,
+	0x28, 0xf0, 0x6d, 0x99, 0xe7, 0x71, 0x4f, 0x39, 0xe5, 0x06, 0xd5, 0x00, 0xea, 0xc9, 0x6b, 0x7c,
+	0x64, 0xee, 0xa9, 0x14, 0xa3, 0x41, 0x13, 0x10, 0xe9, 0xa7, 0x73, 0xe4, 0xa9, 0xa5, 0xe9, 0x15,
+	0x80, 0xf4, 0x31, 0x9f, 0x46, 0x97, 0xdc, 0x53, 0x19, 0x41, 0x83, 0x26, 0x20, 0x79, 0x0c, 0xed,
+	0x09, 0x13, 0x8e, 0xda, 0xd6, 0x99, 0x0b, 0x15, 0xdf, 0x1b, 0x14, 0x26, 0x4c, 0xf4, 0x11, 0x75,
+	0xa6, 0x82, 0xc4, 0x25, 0x8f, 0xfd, 0x37, 0x49, 0xf6, 0x2d, 0x24, 0x93, 0x73, 0x1d, 0xbe, 0x2b,
+	0x94, 0x64, 0xa7, 0x4e, 0xd4, 0x8c, 0x4a, 0x9a, 0xe3, 0xb9, 0x90, 0x09, 0xe5, 0xb6, 0xa2, 0x6c,
+	0x29, 0x9c, 0x21, 0xf9, 0x14, 0xee, 0x9b, 0x4c, 0xd2, 0x89, 0xf9, 0xcf, 0xe6, 0x5c, 0x48, 0xfd,
+	0x8a, 0x6a, 0x09, 0xef, 0x59, 0x6a, 0x45, 0xcf, 0x90, 0x50, 0x4d, 0xa1, 0x1e, 0x13, 0xd7, 0xf3,
+	0xe2, 0xe5, 0xda, 0x0c, 0x76, 0x0a, 0x97, 0x0f, 0x94, 0x65, 0x7c, 0x06, 0x0f, 0x56, 0x97, 0xa3,
+	0x38, 0x24, 0x37, 0xc7, 0x13, 0xb5, 0xfe, 0x5e, 0x7e, 0x3d, 0x55, 0x14, 0xfa, 0xfc, 0xe2, 0x0d,
+	0x34, 0x03, 0x77, 0x8a, 0x37, 0xd0, 0x1c, 0x3c, 0x81, 0xb6, 0xe7, 0x8b, 0x59, 0xc0, 0x16, 0x5a,
+	0xbf, 0x76, 0xd5, 0xd3, 0xb7, 0x0c, 0x0e, 0x75, 0xcc, 0xbe, 0x5a, 0xb7, 0xf7, 0x24, 0xc5, 0xd9,
+	0x6c, 0xef, 0x6b, 0x4a, 0x5d, 0xde, 0xa0, 0xd4, 0xab, 0x9a, 0x5b, 0x59, 0xd3, 0x5c, 0xfb, 0x39,
+	0xec, 0xad, 0x1e, 0x7c, 0x3c, 0x7f, 0x1d, 0xf8, 0xee, 0x60, 0xc2, 0x6e, 0xe8, 0x6b, 0xec, 0xbf,
+	0xad, 0x40, 0x27, 0x57, 0x86, 0xfc, 0xaf, 0xeb, 0xda, 0xca, 0x30, 0x1f, 0x41, 0x6b, 0x16, 0xfb,
+	0x97, 0x4c, 0x72, 0xe7, 0x82, 0x2f, 0x4c, 0x06, 0x00, 0x06, 0x85, 0xd1, 0xe8, 0x31, 0x7a, 0x55,
+	0xe1, 0xc6, 0xfe, 0x0c, 0xf9, 0x52, 0x76, 0xd9, 0xa6, 0x59, 0x14, 0x26, 0x04, 0x3f, 0x8d, 0xfc,
+	0xd0, 0x58, 0x65, 0x83, 0x1a, 0x08, 0xc3, 0xa5, 0xd6, 0x55, 0xee, 0xa9, 0x84, 0xa0, 0x41, 0x53,
+	0x78, 0x69, 0x34, 0xf5, 0xac, 0xd1, 0x1c, 0x81, 0x65, 0x5e, 0x57, 0x38, 0x32, 0x72, 0x70, 0x1f,
+	0x93, 0x65, 0xbd, 0x57, 0x54, 0x6c, 0x19, 0xf2, 0xd3, 0xe8, 0xcb, 0xc8, 0x0f, 0x69, 0x37, 0xce,
+	0xc1, 0xe4, 0x63, 0x68, 0x24, 0x29, 0xbe, 0x29, 0x29, 0x1e, 0x15, 0x6c, 0x64, 0x6a, 0x0b, 0x41,
+	0xd3, 0x05, 0x18, 0xc1, 0x78, 0xe8, 0xc6, 0x8b, 0x99, 0x4c, 0x8d, 0x7e, 0x89, 0xc0, 0x59, 0x31,
+	0xe3, 0xae, 0x64, 0x4b, 0xd3, 0x5f, 0x22, 0x30, 0x68, 0x19, 0x52, 0x34, 0x60, 0x95, 0xa8, 0xb4,
+	0x95, 0xe4, 0xba, 0x4b, 0xf4, 0x21, 0x5f, 0x08, 0x4c, 0x6f, 0xee, 0x5f, 0x73, 0x23, 0xf3, 0x5e,
+	0xa5, 0xf4, 0xbd, 0x1e, 0x02, 0xcc, 0x94, 0x6e, 0xa8, 0xe7, 0xd2, 0xef, 0xdf, 0xd4, 0x98, 0x43,
+	0x9e, 0x79, 0xf4, 0x4a, 0xf6, 0xd1, 0xaf, 0x71, 0xac, 0x77, 0x75, 0xde, 0x92, 0xa4, 0xca, 0x4d,
+	0x5a, 0x43, 0xf0, 0xc0, 0x43, 0xbd, 0x4d, 0xca, 0xc4, 0x85, 0xe3, 0xeb, 0x17, 0x6c, 0x2f, 0x6b,
+	0xdb, 0xc5, 0x81, 0x7a, 0x44, 0x6d, 0xbe, 0x75, 0x7d, 0x98, 0x02, 0xc8, 0xe7, 0xb0, 0x13, 0xf3,
+	0x4b, 0xce, 0x02, 0xee, 0x39, 0x26, 0x73, 0x4a, 0x72, 0xe5, 0x4c, 0x4d, 0x49, 0x0d, 0x49, 0x5a,
+	0xc8, 0xc4, 0x79, 0x84, 0xb0, 0xff, 0xa2, 0x0c, 0xd6, 0xaa, 0x59, 0x90, 0x4f, 0x33, 0xa5, 0xfc,
+	0x5a, 0xe6, 0x57, 0x10, 0xc0, 0x32, 0x85, 0xfc, 0x17, 0xd0, 0x36, 0xd2, 0xc3, 0x5b, 0x8a, 0x5e,
+	0x79, 0x35, 0x85, 0x2f, 0xb6, 0x43, 0xda, 0x9a, 0xa5, 0x63, 0x41, 0x3e, 0x86, 0x7a, 0x92, 0x41,
+	0x56, 0x1e, 0x97, 0xae, 0x67, 0x23, 0xb9, 0x62, 0xb2, 0xe2, 0xff, 0xd0, 0x4e, 0xb0, 0x7f, 0x04,
+	0xdb, 0x6a, 0x16, 0x19, 0x32, 0xf1, 0xe4, 0x66, 0xfe, 0xe1, 0x13, 0xd8, 0x4d, 0x16, 0xbe, 0xd2,
+	0x3d, 0x1c, 0x41, 0x39, 0xbb, 0xe9, 0xea, 0xdf, 0x85, 0x77, 0x74, 0xd5, 0x29, 0xfd, 0x4b, 0x5f,
+	0x2e, 0x06, 0x3c, 0x94, 0x3c, 0xbe, 0x66, 0xbd, 0x05, 0x15, 0xdf, 0xd3, 0xe2, 0x6d, 0x53, 0x1c,
+	0xda, 0x43, 0xd8, 0x5b, 0xdf, 0xa1, 0xef, 0xba, 0x5c, 0x19, 0xd3, 0x4d, 0x77, 0x19, 0xc1, 0xfd,
+	0xf5, 0x5d, 0x86, 0xbe, 0x98, 0xfa, 0x42, 0xdc, 0x62, 0x1b, 0x07, 0xde, 0x5d, 0xdf, 0x66, 0x1c,
+	0xc9, 0x5c, 0x5c, 0xe5, 0x68, 0x6b, 0x49, 0xc6, 0xc3, 0xa4, 0xd9, 0xb3, 0x69, 0x30, 0x7d, 0x89,
+	0x56, 0x85, 0x81, 0x5c, 0x70, 0x1e, 0x2a, 0x51, 0x35, 0x68, 0x7d, 0xc2, 0xc4, 0x09, 0xe7, 0xa1,
+	0xfd, 0xe7, 0x25, 0x78, 0x74, 0xfd, 0x09, 0x82, 0x04, 0xf0, 0x90, 0x99, 0x69, 0xc7, 0x55, 0xf3,
+	0x4e, 0x98, 0x25, 0x30, 0xfa, 0xfd, 0x74, 0xb5, 0xf0, 0x2f, 0xda, 0x91, 0xde, 0x67, 0xc5, 0xa7,
+	0xd9, 0x7f, 0xdf, 0x84, 0xef, 0x5d, 0xbf, 0x7e, 0xcd, 0xd5, 0xac, 0xd5, 0xf0, 0xd5, 0x6c, 0x0d,
+	0xff, 0x06, 0x76, 0xb2, 0xec, 0x2e, 0x73, 0xee, 0xee, 0xb3, 0x1f, 0xdf, 0x94, 0xe5, 0xfd, 0x2c,
+	0x80, 0x29, 0x3a, 0xb5, 0xc2, 0x15, 0x4c, 0xd6, 0x41, 0x55, 0x73, 0x0e, 0x8a, 0x40, 0x35, 0xe6,
+	0x2c, 0x09, 0x3a, 0x6a, 0x8c, 0x2c, 0x7b, 0x89, 0x36, 0x98, 0x98, 0xb3, 0x44, 0x60, 0x40, 0x62,
+	0x46, 0xe3, 0x4c, 0xdc, 0x49, 0x61, 0xcc, 0xd7, 0x4c, 0x6f, 0x53, 0x95, 0x9f, 0x6d, 0x9a, 0x80,
+	0x18, 0xde, 0xd8, 0x5c, 0x4e, 0xd2, 0x2a, 0xdd, 0x40, 0xba, 0xa6, 0x9d, 0x05, 0x8b, 0xa4, 0x27,
+	0xaa, 0x42, 0x44, 0x1b, 0x6b, 0xda, 0x59, 0xb0, 0x30, 0x36, 0xb6, 0xe6, 0x45, 0x5b, 0x3a, 0xed,
+	0xc8, 0x7a, 0xd1, 0x37, 0xb0, 0x33, 0xe5, 0xd8, 0xd8, 0x14, 0x13, 0x7f, 0x96, 0x64, 0x70, 0xed,
+	0x5b, 0x0a, 0xf2, 0x55, 0xba, 0x83, 0xce, 0xf7, 0xa8, 0x35, 0x5d, 0xc1, 0x90, 0x3f, 0x2d, 0x2d,
+	0x73, 0xb8, 0x4d, 0xe9, 0x65, 0x47, 0x1d, 0xf9, 0xfc, 0xc6, 0x47, 0x26, 0xe5, 0xc1, 0x5a, 0x3a,
+	0x9a, 0xa6, 0x61, 0xeb, 0x53, 0x28, 0x66, 0x8f, 0x07, 0x1c, 0x5f, 0xa0, 0xab, 0x4d, 0xc6, 0x80,
+	0x2b, 0xc6, 0xb6, 0xbd, 0x62, 0x6c, 0xf6, 0x7f, 0x96, 0xc0, 0x5a, 0xd5, 0x16, 0x02, 0x50, 0x1b,
+	0x47, 0x38, 0xb2, 0xde, 0x22, 0xdb, 0xd0, 0x1a, 0xf3, 0xab, 0xa3, 0x90, 0x9f, 0x46, 0x47, 0x21,
+	0xb7, 0x4a, 0xe4, 0x2e, 0xdc, 0x19, 0xf3, 0xab, 0x63, 0x9d, 0xc9, 0x7c, 0x11, 0x47, 0xf3, 0x19,
+	0x3a, 0x3f, 0xab, 0x4c, 0x5a, 0x50, 0x7f, 0xc5, 0x43, 0xdc, 0xc4, 0xaa, 0x90, 0x26, 0x6c, 0x51,
+	0x7c, 0x30, 0xab, 0x4a, 0x08, 0x74, 0x07, 0xb9, 0xfc, 0xd1, 0xda, 0xc2, 0x4d, 0x52, 0x4f, 0x7c,
+	0x10, 0x5e, 0xfa, 0x52, 0x1d, 0x6e, 0xd5, 0xc8, 0x2e, 0x58, 0xab, 0x21, 0xdb, 0xaa, 0x93, 0xef,
+	0xc1, 0x5e, 0x8a, 0x5d, 0x3e, 0x49, 0x32, 0xdf, 0x20, 0x77, 0x60, 0x3b, 0x9d, 0x3f, 0xf4, 0xb1,
+	0x7c, 0xb0, 0x9a, 0xfa, 0x8c, 0x35, 0x81, 0x59, 0x60, 0xff, 0x59, 0x09, 0xac, 0xd5, 0x87, 0x25,
+	0x3d, 0xd8, 0x5d, 0xc5, 0x1d, 0x78, 0x01, 0x4a, 0xe0, 0x3e, 0xdc, 0x5d, 0x9d, 0x39, 0xe6, 0xa1,
+	0xe7, 0x87, 0xe7, 0x56, 0x89, 0x3c, 0x80, 0xde, 0xea, 0x64, 0xe2, 0x7d, 0xad, 0xf2, 0xa6, 0xd9,
+	0x21, 0x77, 0x03, 0x4c, 0xe3, 0xac, 0x8a, 0xfd, 0xc7, 0x25, 0xb8, 0x57, 0xf8, 0xda, 0x28, 0xce,
+	0xb3, 0xf0, 0x22, 0x8c, 0xae, 0x42, 0xeb, 0x2d, 0x04, 0x96, 0x67, 0xb6, 0xa1, 0x91, 0x39, 0xa3,
+	0x0d, 0x8d, 0xe5, 0x9e, 0xa4, 0x03, 0xcd, 0x01, 0x0b, 0x5d, 0x1e, 0x04, 0xdc, 0xb3, 0xaa, 0xb8,
+	0xee, 0x14, 0xab, 0x15, 0xee, 0x59, 0x5b, 0x64, 0x07, 0x3a, 0x67, 0xa1, 0x02, 0xbf, 0x8e, 0x62,
+	0x39, 0x59, 0x58, 0x35, 0xec, 0x17, 0xb4, 0x51, 0x1f, 0x9f, 0x47, 0xd1, 0xc5, 0x94, 0xc5, 0x17,
+	0xc5, 0xae, 0x7e, 0x1e, 0x07, 0x26, 0x70, 0xe1, 0x30, 0xad, 0xf9, 0x2b, 0x99, 0x9a, 0xff, 0x3e,
+	0x34, 0x55, 0xbe, 0xee, 0x20, 0xad, 0x76, 0x2a, 0x0d, 0x85, 0x38, 0x8b, 0x83, 0x6c, 0xe1, 0xb6,
+	0x95, 0x2f, 0xdc, 0x1e, 0x02, 0x18, 0x65, 0x45, 0x0d, 0xad, 0x69, 0x0d, 0x35, 0x98, 0xbe, 0xb4,
+	0xff, 0x08, 0xde, 0x46, 0x0e, 0x47, 0xa1, 0x38, 0x13, 0x3c, 0xc6, 0x83, 0x74, 0xc7, 0xb4, 0x80,
+	0xd5, 0x3d, 0x68, 0xcc, 0x0d, 0x9d, 0xe1, 0x37, 0x85, 0x55, 0x03, 0x73, 0xc2, 0x7c, 0xd5, 0xeb,
+	0xd0, 0x89, 0x5c, 0x5d, 0xc1, 0x07, 0xb9, 0xba, 0xb2, 0x9a, 0x63, 0xcf, 0xfe, 0x52, 0xa7, 0x4b,
+	0x83, 0x80, 0xb3, 0xf8, 0x85, 0x2f, 0x64, 0x14, 0x2f, 0xb2, 0xce, 0xb3, 0x94, 0x73, 0x9e, 0x0f,
+	0x01, 0x5c, 0x24, 0xd4, 0x77, 0x31, 0xce, 0xdd, 0x60, 0xfa, 0xd2, 0xfe, 0xae, 0x04, 0x04, 0x37,
+	0x33, 0x1d, 0xff, 0x63, 0xdf, 0xc5, 0xae, 0xcd, 0xc6, 0xce, 0x54, 0xa6, 0x7d, 0x58, 0x2e, 0x68,
+	0x1f, 0x56, 0x54, 0x63, 0x65, 0xad, 0x7d, 0x58, 0x55, 0x68, 0x03, 0xe1, 0xa3, 0xa8, 0x4a, 0x4a,
+	0xf5, 0x0f, 0x75, 0x2b, 0x46, 0xf5, 0x0f, 0x4f, 0x36, 0xf6, 0x0f, 0x6b, 0x8a, 0xa0, 0xa0, 0x7f,
+	0x58, 0xcf, 0xf6, 0x0f, 0x27, 0x70, 0x67, 0xfd, 0x26, 0xa2, 0xb8, 0x45, 0xfa, 0x3b, 0xd0, 0x98,
+	0x19, 0x22, 0x93, 0x1e, 0x3e, 0xc8, 0xbb, 0xc4, 0xfc, 0x4e, 0x34, 0xa5, 0xb6, 0xbf, 0x2b, 0x43,
+	0x2b, 0xd3, 0x9b, 0x2f, 0x78, 0xf7, 0x1e, 0xd4, 0x99, 0xe7, 0xc5, 0x5c, 0x88, 0x44, 0x5e, 0x06,
+	0xcc, 0xb2, 0x54, 0xc9, 0xb1, 0x94, 0xcf, 0xf9, 0x75, 0x05, 0x96, 0xc9, 0xf9, 0x09, 0x54, 0x67,
+	0x4c, 0x4e, 0x4c, 0xfe, 0xae, 0xc6, 0xe9, 0x4b, 0xd5, 0x32, 0x2f, 0x95, 0x6d, 0x8b, 0xd7, 0x4d,
+	0x8f, 0xd2, 0xb4, 0xc5, 0x77, 0x61, 0x8b, 0x4f, 0xa3, 0x9f, 0xfa, 0x2a, 0xf6, 0x35, 0xa9, 0x06,
+	0xf0, 0xa9, 0xae, 0x58, 0x10, 0x70, 0x69, 0x5a, 0x21, 0x06, 0xc2, 0xcd, 0x51, 0x8d, 0x4c, 0x4d,
+	0xa4, 0xc6, 0xea, 0x59, 0x7d, 0xcf, 0xe3, 0xa1, 0xa9, 0x85, 0x0c, 0x74, 0x4d, 0x1f, 0x04, 0xbb,
+	0xa9, 0x91, 0xf0, 0x55, 0x55, 0xd9, 0xd1, 0xfd, 0xe2, 0x04, 0xb6, 0xff, 0xcd, 0x88, 0xd2, 0xfc,
+	0xdf, 0x52, 0x20, 0xca, 0x8c, 0xc0, 0xca, 0x1b, 0xdb, 0xdc, 0x95, 0x7c, 0x07, 0x35, 0xd3, 0xa9,
+	0x54, 0x63, 0xd5, 0x14, 0xe0, 0xb1, 0x7f, 0xc9, 0x3d, 0xe7, 0x4d, 0x1c, 0x4d, 0x8d, 0x04, 0x5b,
+	0x06, 0xf7, 0x79, 0x1c, 0x4d, 0xc9, 0xc7, 0xb0, 0xa7, 0xcb, 0x77, 0xc1, 0x3d, 0x47, 0x4d, 0x98,
+	0x2e, 0xa4, 0xea, 0xc3, 0x6b, 0x27, 0x70, 0x57, 0x15, 0xf3, 0x82, 0x7b, 0xc3, 0x74, 0xfe, 0x00,
+	0xa7, 0x75, 0x4b, 0x2a, 0x74, 0x93, 0xed, 0xb5, 0xd0, 0x41, 0xa3, 0xd4, 0xee, 0xbf, 0xa1, 0x32,
+	0x92, 0x6c, 0x89, 0x54, 0xf0, 0x3f, 0x4f, 0x4a, 0x86, 0x4b, 0x4c, 0xdf, 0x18, 0x4b, 0xda, 0xca,
+	0xc6, 0xff, 0xa8, 0x70, 0x96, 0xa6, 0x64, 0xd9, 0x37, 0x80, 0xbc, 0xcf, 0xf8, 0xaf, 0x92, 0x76,
+	0x1a, 0x27, 0xec, 0x92, 0x7b, 0x7d, 0xa3, 0x87, 0x19, 0x0d, 0x2d, 0xe5, 0x35, 0x74, 0xd3, 0xdf,
+	0x07, 0x0f, 0xa0, 0xf9, 0x86, 0x5d, 0x46, 0xf3, 0xd8, 0x97, 0x5a, 0xe0, 0x0d, 0xba, 0x44, 0x5c,
+	0xe3, 0x4d, 0x9f, 0x40, 0x5b, 0x47, 0x77, 0x27, 0x6b, 0xb4, 0x2d, 0x8d, 0xd3, 0x3d, 0x9b, 0x5f,
+	0x83, 0x1d, 0xed, 0x06, 0xc5, 0x24, 0x8a, 0xa5, 0x2a, 0x5f, 0x85, 0xd1, 0xd0, 0x6d, 0x35, 0x71,
+	0x82, 0x78, 0x2c, 0x63, 0x05, 0x7a, 0x7e, 0x1e, 0x0a, 0x93, 0xa2, 0xe1, 0x10, 0xb5, 0xc3, 0x17,
+	0x8e, 0xe4, 0x22, 0x51, 0xd4, 0x9a, 0x2f, 0x4e, 0xb9, 0x90, 0x5f, 0x56, 0x1b, 0x55, 0x6b, 0xcb,
+	0xfe, 0x45, 0x49, 0xfb, 0xeb, 0xb5, 0x0e, 0x40, 0x81, 0xb2, 0xad, 0x66, 0x72, 0xe5, 0xf5, 0x4c,
+	0x6e, 0x04, 0x8f, 0x26, 0xda, 0xf1, 0x3a, 0x2c, 0x76, 0x27, 0xfe, 0x25, 0x77, 0xc4, 0x7c, 0x36,
+	0x43, 0xde, 0x79, 0xc8, 0x5e, 0x07, 0xa6, 0xfb, 0xd3, 0xa0, 0x0f, 0x0c, 0x59, 0x5f, 0x53, 0x9d,
+	0x68, 0xa2, 0x91, 0xa6, 0xb1, 0xff, 0xba, 0xa4, 0x8b, 0x3c, 0x13, 0x10, 0x31, 0x9a, 0xdc, 0xb0,
+	0xe1, 0xfc, 0x29, 0xd4, 0x4c, 0x32, 0xa7, 0x13, 0xf1, 0x95, 0xae, 0x49, 0x66, 0xc3, 0xfd, 0xd3,
+	0x65, 0x6f, 0x90, 0x9a, 0x45, 0xf6, 0x47, 0xd0, 0xca, 0xa0, 0x55, 0x60, 0x1f, 0x1f, 0x8e, 0x8f,
+	0xbe, 0x1e, 0xeb, 0xc0, 0x7e, 0x4a, 0xcf, 0x4e, 0x4e, 0x47, 0x43, 0xab, 0xa4, 0x02, 0xf4, 0x58,
+	0x81, 0x5f, 0x1f, 0xd1, 0xd3, 0x17, 0x3f, 0xb1, 0xca, 0xf6, 0x3f, 0x54, 0x74, 0xf7, 0x2c, 0x9b,
+	0x20, 0x98, 0xbc, 0xa7, 0x80, 0x79, 0x02, 0x55, 0x65, 0x15, 0x46, 0x99, 0x70, 0x8c, 0x17, 0x92,
+	0x91, 0x31, 0xdb, 0xb2, 0x8c, 0x50, 0xb9, 0xdc, 0x09, 0x3a, 0x9d, 0xf0, 0x3c, 0xb1, 0xdc, 0x25,
+	0x02, 0x9f, 0xc4, 0xf4, 0x7b, 0x74, 0x18, 0x33, 0x4d, 0xe1, 0x14, 0xd7, 0x57, 0x7f, 0xd9, 0xc4,
+	0x5c, 0xcc, 0xa2, 0x50, 0x24, 0xbe, 0x30, 0x85, 0xd1, 0xad, 0x62, 0xae, 0xee, 0xeb, 0xc5, 0x5a,
+	0xff, 0x9a, 0x06, 0xd3, 0x97, 0x84, 0x6f, 0xee, 0xc2, 0x36, 0x94, 0x64, 0x7f, 0x33, 0x2f, 0xd9,
+	0x0d, 0xb7, 0xde, 0xdf, 0x90, 0x18, 0x6f, 0xea, 0xdd, 0xea, 0x37, 0x6c, 0xa6, 0x6f, 0xf8, 0x10,
+	0x80, 0x7f, 0x3b, 0xf3, 0x63, 0x2e, 0x1c, 0xe3, 0x62, 0xab, 0xb4, 0x69, 0x30, 0x7d, 0x69, 0xff,
+	0x3e, 0x90, 0x82, 0x1c, 0x2c, 0xfb, 0x54, 0xc7, 0xa3, 0xf1, 0xf0, 0x60, 0xfc, 0x85, 0xc9, 0xc1,
+	0x06, 0x83, 0xd1, 0x31, 0x3e, 0x9c, 0xce, 0xc1, 0x46, 0x83, 0x97, 0x07, 0xe3, 0xd1, 0xd0, 0xaa,
+	0x20, 0x34, 0xe8, 0x8f, 0x07, 0xa3, 0x97, 0xa3, 0xa1, 0x55, 0xb5, 0xff, 0xb5, 0xa4, 0x4b, 0xf4,
+	0x7c, 0x0e, 0x3c, 0xe4, 0xae, 0x2f, 0x8a, 0xff, 0x9c, 0x79, 0x00, 0x4d, 0x23, 0xee, 0x83, 0x44,
+	0x11, 0x97, 0x08, 0xf2, 0x87, 0xb0, 0xed, 0x99, 0xf5, 0x4e, 0x4e, 0x31, 0x3f, 0x5c, 0x6d, 0x76,
+	0x6c, 0x3a, 0x72, 0x3f, 0x19, 0x18, 0xe9, 0x75, 0xbd, 0x1c, 0x6c, 0xbf, 0x0f, 0xdd, 0x3c, 0x45,
+	0xee, 0xb2, 0x6f, 0xe5, 0x2e, 0x5b, 0xb2, 0xff, 0xa9, 0x0c, 0xdb, 0x2b, 0x1f, 0x32, 0x14, 0x27,
+	0x01, 0xab, 0xdd, 0xe2, 0xf2, 0x5a, 0xb7, 0x98, 0xbc, 0x0f, 0x24, 0x4b, 0xe2, 0x64, 0xdb, 0x6e,
+	0x56, 0x86, 0x50, 0xbb, 0xb2, 0x6c, 0x56, 0x51, 0xbd, 0x4d, 0x56, 0x41, 0x3e, 0x81, 0xb6, 0x88,
+	0x5c, 0x9f, 0x05, 0x4e, 0xe0, 0x87, 0x17, 0xc9, 0xd7, 0x23, 0xf7, 0xf2, 0xab, 0x4f, 0x14, 0xc5,
+	0x4b, 0x24, 0xa0, 0x2d, 0xb1, 0x04, 0xc8, 0xef, 0xc1, 0x2e, 0x76, 0xfe, 0x92, 0xcc, 0xd2, 0xf1,
+	0xd2, 0xef, 0x45, 0x2a, 0xeb, 0xcd, 0xd0, 0xb5, 0xd4, 0x95, 0x12, 0xbe, 0x8a, 0x12, 0xb6, 0x00,
+	0xa0, 0xec, 0x2a, 0x29, 0x70, 0x33, 0xe9, 0x5f, 0x29, 0x9f, 0xfe, 0x1d, 0x42, 0xcb, 0x54, 0xc6,
+	0x58, 0xa1, 0x29, 0x11, 0x76, 0x9f, 0xfd, 0xea, 0xf2, 0xc4, 0xfe, 0xf2, 0xfb, 0xa2, 0x57, 0xe6,
+	0xf3, 0x22, 0xb3, 0xe9, 0x3e, 0x2e, 0xa0, 0xd9, 0xd5, 0xf6, 0x5f, 0x95, 0xa0, 0x8b, 0x2c, 0x66,
+	0x4e, 0xfe, 0x6d, 0x68, 0xc5, 0x29, 0x94, 0x74, 0x4b, 0x76, 0x97, 0xfb, 0x2f, 0x49, 0x69, 0x96,
+	0x90, 0x3c, 0x83, 0x5d, 0x31, 0x7f, 0x9d, 0xb4, 0x19, 0xbf, 0x14, 0x51, 0xf8, 0x7c, 0x21, 0x79,
+	0x92, 0x8d, 0x6d, 0x9c, 0x23, 0xef, 0xc3, 0x4e, 0xd2, 0x16, 0x5e, 0x2e, 0xd0, 0xbd, 0xf2, 0xf5,
+	0x09, 0xfb, 0x97, 0xa5, 0x34, 0x7b, 0xc1, 0x00, 0xac, 0xaa, 0x92, 0x54, 0xc5, 0x70, 0xb8, 0x31,
+	0x90, 0xbe, 0x03, 0x35, 0xf3, 0x07, 0x93, 0x0e, 0x12, 0x06, 0xca, 0x2a, 0x69, 0x35, 0xa7, 0xa4,
+	0x0f, 0xa0, 0x69, 0x02, 0x33, 0x47, 0xb5, 0xc0, 0xee, 0xd6, 0x12, 0xb1, 0xb4, 0xd7, 0x5a, 0x36,
+	0x1b, 0xfe, 0xc7, 0x32, 0xec, 0x64, 0x58, 0xc3, 0xf2, 0x3e, 0x0a, 0xc9, 0x47, 0x50, 0x63, 0x6a,
+	0xa4, 0x78, 0xec, 0x3e, 0xb3, 0x37, 0x66, 0x14, 0x9a, 0x78, 0x5f, 0xff, 0x50, 0xb3, 0x82, 0x7c,
+	0x1f, 0x3a, 0x51, 0xe0, 0x19, 0x92, 0xb3, 0x34, 0x1c, 0xe5, 0x91, 0xe6, 0xc3, 0x1a, 0x84, 0x4c,
+	0xbf, 0xb4, 0x20, 0x69, 0x49, 0xa8, 0x30, 0x3c, 0xd7, 0x0c, 0x77, 0x3b, 0xd0, 0x39, 0x1c, 0xfd,
+	0x64, 0xd0, 0xa7, 0x43, 0xa7, 0x3f, 0x1c, 0x2a, 0xd3, 0x26, 0xd0, 0xed, 0x0f, 0x06, 0x47, 0x67,
+	0xe3, 0xd3, 0x13, 0x83, 0x2b, 0x61, 0x6d, 0x9d, 0x90, 0x0d, 0x47, 0x2f, 0x47, 0xda, 0xe1, 0xed,
+	0x82, 0x95, 0x12, 0xd2, 0xd1, 0xab, 0xa3, 0xaf, 0x94, 0xe3, 0x03, 0xa8, 0xbd, 0x3c, 0x1a, 0x1c,
+	0xa2, 0xdb, 0x43, 0x2f, 0x71, 0x36, 0x36, 0xd0, 0x16, 0x76, 0x11, 0xce, 0x0e, 0x86, 0xce, 0xd9,
+	0xf1, 0xb0, 0x8f, 0x1b, 0xd4, 0x88, 0x05, 0xed, 0x71, 0xff, 0xd5, 0xc8, 0x19, 0xbc, 0xe8, 0x8f,
+	0xbf, 0x18, 0x0d, 0xad, 0xba, 0xfd, 0x0d, 0x6c, 0xaf, 0x98, 0x1c, 0xf9, 0xd1, 0x8a, 0x8d, 0xae,
+	0xe9, 0xe2, 0x92, 0x38, 0x6f, 0x9e, 0xe9, 0x23, 0x95, 0xb3, 0x8f, 0xf4, 0xcb, 0xb2, 0x6e, 0xd6,
+	0xe6, 0xba, 0x7b, 0xf3, 0x80, 0xdf, 0x30, 0x0b, 0x58, 0xcd, 0x54, 0x2a, 0xeb, 0x99, 0x4a, 0x61,
+	0x53, 0xad, 0x07, 0x75, 0x19, 0xfb, 0xe7, 0xe7, 0x3c, 0x4e, 0xfe, 0x0e, 0x37, 0xa0, 0x6a, 0x83,
+	0x69, 0x1d, 0xd1, 0xb5, 0x97, 0x81, 0x30, 0x49, 0xfb, 0xd9, 0xdc, 0xe7, 0xd2, 0x99, 0x44, 0xf3,
+	0x58, 0xa0, 0x9b, 0x8f, 0x75, 0x30, 0xed, 0xd0, 0x6d, 0x35, 0xf1, 0x02, 0xf1, 0x27, 0x88, 0x26,
+	0xbf, 0x02, 0xdb, 0x59, 0x5a, 0x1e, 0x7a, 0x2a, 0x9c, 0x76, 0x68, 0x67, 0x49, 0x39, 0x0a, 0xbd,
+	0x6c, 0x97, 0xa8, 0x99, 0xeb, 0x12, 0xd9, 0xcf, 0xb5, 0xfa, 0xea, 0xfe, 0x37, 0xf3, 0x74, 0x9f,
+	0xf6, 0x87, 0xb0, 0xa5, 0xdb, 0xf9, 0xa5, 0xd5, 0x4e, 0x7a, 0x8e, 0x8e, 0x6a, 0x2a, 0xdb, 0x81,
+	0x4e, 0x7e, 0x7d, 0x61, 0x95, 0xbc, 0xf1, 0x79, 0x30, 0xab, 0x37, 0xae, 0xc9, 0xf1, 0x3d, 0xfd,
+	0x45, 0x61, 0x93, 0x82, 0x41, 0x1d, 0x78, 0xc2, 0xfe, 0x9b, 0x92, 0xf9, 0x2f, 0x6e, 0xc2, 0xe4,
+	0x30, 0x66, 0x6f, 0x64, 0x71, 0xfd, 0x92, 0x9c, 0x5b, 0x5e, 0x6d, 0x6d, 0x4a, 0xfe, 0xad, 0x4c,
+	0xea, 0x17, 0x1c, 0xe3, 0xa9, 0x49, 0xe6, 0xe2, 0xc8, 0xc8, 0x3c, 0x1b, 0x24, 0xa8, 0xd3, 0x88,
+	0x7c, 0x06, 0x2d, 0x26, 0x25, 0x73, 0x27, 0x53, 0x1e, 0x4a, 0xed, 0x10, 0x5a, 0xcf, 0x1e, 0xe6,
+	0x65, 0xa1, 0xb8, 0xe9, 0xa7, 0x54, 0x34, 0xbb, 0xc2, 0xfe, 0x06, 0xee, 0x6c, 0xa0, 0xd9, 0x58,
+	0xf4, 0x2b, 0x15, 0x0b, 0x25, 0x0f, 0xa5, 0xee, 0xfb, 0xa6, 0xc9, 0xb0, 0xc2, 0x25, 0x1f, 0x5b,
+	0xa8, 0x52, 0x5e, 0x47, 0x44, 0x35, 0xc6, 0xb6, 0x78, 0x03, 0x05, 0xf3, 0x6a, 0x2e, 0xf9, 0x6d,
+	0x65, 0x72, 0x03, 0xad, 0x4e, 0xff, 0x54, 0xac, 0x66, 0xff, 0x54, 0xbc, 0x0f, 0x4d, 0x1c, 0x38,
+	0xd2, 0x0f, 0x02, 0xf3, 0xc9, 0x52, 0x03, 0x11, 0xa7, 0x7e, 0x10, 0xd8, 0xdf, 0xe8, 0x22, 0x20,
+	0xf1, 0xf8, 0xc7, 0xa6, 0x00, 0x2d, 0x2a, 0x02, 0xb2, 0xf5, 0x5a, 0xf9, 0x46, 0xf5, 0x9a, 0xfd,
+	0xcf, 0x26, 0x9b, 0x1f, 0xa8, 0x6f, 0xbb, 0x4e, 0xa3, 0x0b, 0x5e, 0x94, 0x4b, 0x65, 0xbb, 0x3e,
+	0xe5, 0xb5, 0xae, 0x4f, 0x52, 0x92, 0x55, 0x36, 0x97, 0x64, 0xd5, 0x7c, 0x24, 0x11, 0x8b, 0xe9,
+	0xeb, 0x28, 0x48, 0xfe, 0xd2, 0xd3, 0x10, 0x26, 0xc3, 0x98, 0x42, 0x4d, 0x99, 0xf9, 0x60, 0xb4,
+	0x43, 0x53, 0x58, 0xb1, 0xa4, 0x3e, 0x45, 0xd3, 0x45, 0xaa, 0x06, 0xb2, 0xe5, 0x5b, 0x23, 0x57,
+	0xbe, 0x3d, 0xef, 0xfc, 0x41, 0x6b, 0xff, 0x83, 0x8f, 0x93, 0xbb, 0xbf, 0xae, 0xa9, 0xd1, 0x87,
+	0xff, 0x33, 0x00, 0x26, 0xc2, 0x2e, 0xae, 0x79, 0x2c, 0x00, 0x00,
 }
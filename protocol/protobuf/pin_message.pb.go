@@ -26,10 +26,13 @@ type PinMessage struct {
 	ChatId    string `protobuf:"bytes,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
 	Pinned    bool   `protobuf:"varint,4,opt,name=pinned,proto3" json:"pinned,omitempty"`
 	// The type of message (public/one-to-one/private-group-chat)
-	MessageType          MessageType `protobuf:"varint,5,opt,name=message_type,json=messageType,proto3,enum=protobuf.MessageType" json:"message_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	MessageType MessageType `protobuf:"varint,5,opt,name=message_type,json=messageType,proto3,enum=protobuf.MessageType" json:"message_type,omitempty"`
+	// Position of the message in the list of pinned messages of the chat,
+	// higher values are displayed first
+	PinIndex             uint64   `protobuf:"varint,6,opt,name=pin_index,json=pinIndex,proto3" json:"pin_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PinMessage) Reset()         { *m = PinMessage{} }
@@ -92,6 +95,13 @@ func (m *PinMessage) GetMessageType() MessageType {
 	return MessageType_UNKNOWN_MESSAGE_TYPE
 }
 
+func (m *PinMessage) GetPinIndex() uint64 {
+	if m != nil {
+		return m.PinIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PinMessage)(nil), "protobuf.PinMessage")
 }
@@ -101,10 +111,10 @@ func init() {
 }
 
 var fileDescriptor_b3c2ad1be7128a0a = []byte{
-	// 192 bytes of a gzipped FileDescriptorProto
+	// 214 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2c, 0xc8, 0xcc, 0x8b,
 	0xcf, 0x4d, 0x2d, 0x2e, 0x4e, 0x4c, 0x4f, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x00,
-	0x53, 0x49, 0xa5, 0x69, 0x52, 0xdc, 0xa9, 0x79, 0xa5, 0xb9, 0xc5, 0x10, 0x61, 0xa5, 0x35, 0x8c,
+	0x53, 0x49, 0xa5, 0x69, 0x52, 0xdc, 0xa9, 0x79, 0xa5, 0xb9, 0xc5, 0x10, 0x61, 0xa5, 0x93, 0x8c,
 	0x5c, 0x5c, 0x01, 0x99, 0x79, 0xbe, 0x10, 0xb5, 0x42, 0x22, 0x5c, 0xac, 0xc9, 0x39, 0xf9, 0xc9,
 	0xd9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x10, 0x8e, 0x90, 0x2c, 0x17, 0x17, 0xd4, 0xb0,
 	0xf8, 0xcc, 0x14, 0x09, 0x26, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x4e, 0xa8, 0x88, 0x67, 0x8a, 0x90,
@@ -112,6 +122,8 @@ var fileDescriptor_b3c2ad1be7128a0a = []byte{
 	0x08, 0x89, 0x71, 0xb1, 0x15, 0x64, 0xe6, 0xe5, 0xa5, 0xa6, 0x48, 0xb0, 0x28, 0x30, 0x6a, 0x70,
 	0x04, 0x41, 0x79, 0x42, 0x16, 0x5c, 0x3c, 0x30, 0xf3, 0x4a, 0x2a, 0x0b, 0x52, 0x25, 0x58, 0x15,
 	0x18, 0x35, 0xf8, 0x8c, 0x44, 0xf5, 0x60, 0x4e, 0xd4, 0x83, 0x3a, 0x27, 0xa4, 0xb2, 0x20, 0x35,
-	0x88, 0x3b, 0x17, 0xc1, 0x71, 0xe2, 0x8d, 0xe2, 0xd6, 0xd3, 0xb7, 0x86, 0xa9, 0x4b, 0x62, 0x03,
-	0xb3, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x78, 0x7a, 0xb9, 0x5d, 0xf0, 0x00, 0x00, 0x00,
+	0x88, 0x3b, 0x17, 0xc1, 0x11, 0x92, 0xe6, 0xe2, 0x04, 0x79, 0x2d, 0x33, 0x2f, 0x25, 0xb5, 0x42,
+	0x82, 0x0d, 0xec, 0x46, 0x8e, 0x82, 0xcc, 0x3c, 0x4f, 0x10, 0xdf, 0x89, 0x37, 0x8a, 0x5b, 0x4f,
+	0xdf, 0x1a, 0x66, 0x48, 0x12, 0x1b, 0x98, 0x65, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x78,
+	0x7a, 0x32, 0x0d, 0x01, 0x00, 0x00,
 }
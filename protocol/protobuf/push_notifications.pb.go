@@ -144,6 +144,34 @@ func (PushNotificationReport_ErrorType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_200acd86044eaa5d, []int{8, 0}
 }
 
+type PushNotificationChatPreference_Level int32
+
+const (
+	PushNotificationChatPreference_ALL      PushNotificationChatPreference_Level = 0
+	PushNotificationChatPreference_MENTIONS PushNotificationChatPreference_Level = 1
+	PushNotificationChatPreference_NONE     PushNotificationChatPreference_Level = 2
+)
+
+var PushNotificationChatPreference_Level_name = map[int32]string{
+	0: "ALL",
+	1: "MENTIONS",
+	2: "NONE",
+}
+
+var PushNotificationChatPreference_Level_value = map[string]int32{
+	"ALL":      0,
+	"MENTIONS": 1,
+	"NONE":     2,
+}
+
+func (x PushNotificationChatPreference_Level) String() string {
+	return proto.EnumName(PushNotificationChatPreference_Level_name, int32(x))
+}
+
+func (PushNotificationChatPreference_Level) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_200acd86044eaa5d, []int{10, 0}
+}
+
 type PushNotificationRegistration struct {
 	TokenType               PushNotificationRegistration_TokenType `protobuf:"varint,1,opt,name=token_type,json=tokenType,proto3,enum=protobuf.PushNotificationRegistration_TokenType" json:"token_type,omitempty"`
 	DeviceToken             string                                 `protobuf:"bytes,2,opt,name=device_token,json=deviceToken,proto3" json:"device_token,omitempty"`
@@ -160,6 +188,8 @@ type PushNotificationRegistration struct {
 	BlockMentions           bool                                   `protobuf:"varint,13,opt,name=block_mentions,json=blockMentions,proto3" json:"block_mentions,omitempty"`
 	AllowedMentionsChatList [][]byte                               `protobuf:"bytes,14,rep,name=allowed_mentions_chat_list,json=allowedMentionsChatList,proto3" json:"allowed_mentions_chat_list,omitempty"`
 	MutedChatList           [][]byte                               `protobuf:"bytes,15,rep,name=muted_chat_list,json=mutedChatList,proto3" json:"muted_chat_list,omitempty"`
+	ChatPreferences         []*PushNotificationChatPreference      `protobuf:"bytes,16,rep,name=chat_preferences,json=chatPreferences,proto3" json:"chat_preferences,omitempty"`
+	CollapseNotifications   bool                                   `protobuf:"varint,17,opt,name=collapse_notifications,json=collapseNotifications,proto3" json:"collapse_notifications,omitempty"`
 	XXX_NoUnkeyedLiteral    struct{}                               `json:"-"`
 	XXX_unrecognized        []byte                                 `json:"-"`
 	XXX_sizecache           int32                                  `json:"-"`
@@ -295,6 +325,20 @@ func (m *PushNotificationRegistration) GetMutedChatList() [][]byte {
 	return nil
 }
 
+func (m *PushNotificationRegistration) GetChatPreferences() []*PushNotificationChatPreference {
+	if m != nil {
+		return m.ChatPreferences
+	}
+	return nil
+}
+
+func (m *PushNotificationRegistration) GetCollapseNotifications() bool {
+	if m != nil {
+		return m.CollapseNotifications
+	}
+	return false
+}
+
 type PushNotificationRegistrationResponse struct {
 	Success              bool                                           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Error                PushNotificationRegistrationResponse_ErrorType `protobuf:"varint,2,opt,name=error,proto3,enum=protobuf.PushNotificationRegistrationResponse_ErrorType" json:"error,omitempty"`
@@ -822,11 +866,59 @@ func (m *PushNotificationResponse) GetReports() []*PushNotificationReport {
 	return nil
 }
 
+type PushNotificationChatPreference struct {
+	ChatId               []byte                               `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Level                PushNotificationChatPreference_Level `protobuf:"varint,2,opt,name=level,proto3,enum=protobuf.PushNotificationChatPreference_Level" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *PushNotificationChatPreference) Reset()         { *m = PushNotificationChatPreference{} }
+func (m *PushNotificationChatPreference) String() string { return proto.CompactTextString(m) }
+func (*PushNotificationChatPreference) ProtoMessage()    {}
+func (*PushNotificationChatPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_200acd86044eaa5d, []int{10}
+}
+
+func (m *PushNotificationChatPreference) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushNotificationChatPreference.Unmarshal(m, b)
+}
+func (m *PushNotificationChatPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushNotificationChatPreference.Marshal(b, m, deterministic)
+}
+func (m *PushNotificationChatPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushNotificationChatPreference.Merge(m, src)
+}
+func (m *PushNotificationChatPreference) XXX_Size() int {
+	return xxx_messageInfo_PushNotificationChatPreference.Size(m)
+}
+func (m *PushNotificationChatPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushNotificationChatPreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushNotificationChatPreference proto.InternalMessageInfo
+
+func (m *PushNotificationChatPreference) GetChatId() []byte {
+	if m != nil {
+		return m.ChatId
+	}
+	return nil
+}
+
+func (m *PushNotificationChatPreference) GetLevel() PushNotificationChatPreference_Level {
+	if m != nil {
+		return m.Level
+	}
+	return PushNotificationChatPreference_ALL
+}
+
 func init() {
 	proto.RegisterEnum("protobuf.PushNotificationRegistration_TokenType", PushNotificationRegistration_TokenType_name, PushNotificationRegistration_TokenType_value)
 	proto.RegisterEnum("protobuf.PushNotificationRegistrationResponse_ErrorType", PushNotificationRegistrationResponse_ErrorType_name, PushNotificationRegistrationResponse_ErrorType_value)
 	proto.RegisterEnum("protobuf.PushNotification_PushNotificationType", PushNotification_PushNotificationType_name, PushNotification_PushNotificationType_value)
 	proto.RegisterEnum("protobuf.PushNotificationReport_ErrorType", PushNotificationReport_ErrorType_name, PushNotificationReport_ErrorType_value)
+	proto.RegisterEnum("protobuf.PushNotificationChatPreference_Level", PushNotificationChatPreference_Level_name, PushNotificationChatPreference_Level_value)
 	proto.RegisterType((*PushNotificationRegistration)(nil), "protobuf.PushNotificationRegistration")
 	proto.RegisterType((*PushNotificationRegistrationResponse)(nil), "protobuf.PushNotificationRegistrationResponse")
 	proto.RegisterType((*ContactCodeAdvertisement)(nil), "protobuf.ContactCodeAdvertisement")
@@ -837,6 +929,7 @@ func init() {
 	proto.RegisterType((*PushNotificationRequest)(nil), "protobuf.PushNotificationRequest")
 	proto.RegisterType((*PushNotificationReport)(nil), "protobuf.PushNotificationReport")
 	proto.RegisterType((*PushNotificationResponse)(nil), "protobuf.PushNotificationResponse")
+	proto.RegisterType((*PushNotificationChatPreference)(nil), "protobuf.PushNotificationChatPreference")
 }
 
 func init() {
@@ -844,73 +937,80 @@ func init() {
 }
 
 var fileDescriptor_200acd86044eaa5d = []byte{
-	// 1088 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x5b, 0x4f, 0xe3, 0x46,
-	0x14, 0xae, 0x93, 0x40, 0x92, 0x93, 0x2b, 0x53, 0x08, 0x5e, 0x5a, 0xb6, 0xa9, 0x7b, 0x8b, 0x78,
-	0xc8, 0x56, 0x54, 0xea, 0xae, 0xca, 0x4b, 0xb3, 0xc1, 0xb0, 0x2e, 0xc4, 0xce, 0x4e, 0x9c, 0xae,
-	0xa8, 0x2a, 0x8d, 0x4c, 0x32, 0x80, 0x45, 0xb0, 0x5d, 0xcf, 0x84, 0x2a, 0x6f, 0x55, 0x9f, 0xfb,
-	0xd2, 0xd7, 0xfe, 0x8c, 0xfd, 0x15, 0xfd, 0x59, 0x95, 0xc7, 0xe3, 0x60, 0x48, 0xb8, 0x54, 0xda,
-	0xa7, 0xf8, 0x7c, 0xe7, 0x32, 0x73, 0xce, 0xf9, 0xe6, 0x0b, 0xa8, 0xc1, 0x94, 0x5d, 0x10, 0xcf,
-	0xe7, 0xee, 0x99, 0x3b, 0x72, 0xb8, 0xeb, 0x7b, 0xac, 0x1d, 0x84, 0x3e, 0xf7, 0x51, 0x41, 0xfc,
-	0x9c, 0x4e, 0xcf, 0xb6, 0x3e, 0x1e, 0x5d, 0x38, 0x9c, 0xb8, 0x63, 0xea, 0x71, 0x97, 0xcf, 0x62,
-	0xb7, 0xf6, 0xef, 0x0a, 0x7c, 0xda, 0x9f, 0xb2, 0x0b, 0x33, 0x95, 0x8a, 0xe9, 0xb9, 0xcb, 0x78,
-	0x28, 0xbe, 0x91, 0x05, 0xc0, 0xfd, 0x4b, 0xea, 0x11, 0x3e, 0x0b, 0xa8, 0xaa, 0x34, 0x95, 0x56,
-	0x75, 0xf7, 0xdb, 0x76, 0x52, 0xb4, 0xfd, 0x50, 0x6e, 0xdb, 0x8e, 0x12, 0xed, 0x59, 0x40, 0x71,
-	0x91, 0x27, 0x9f, 0xe8, 0x73, 0x28, 0x8f, 0xe9, 0xb5, 0x3b, 0xa2, 0x44, 0x60, 0x6a, 0xa6, 0xa9,
-	0xb4, 0x8a, 0xb8, 0x14, 0x63, 0x22, 0x03, 0x7d, 0x03, 0x35, 0xd7, 0x63, 0xdc, 0x99, 0x4c, 0x44,
-	0x1d, 0xe2, 0x8e, 0xd5, 0xac, 0x88, 0xaa, 0xa6, 0x61, 0x63, 0x1c, 0xd5, 0x72, 0x46, 0x23, 0xca,
-	0x98, 0xac, 0x95, 0x8b, 0x6b, 0xc5, 0x58, 0x5c, 0x4b, 0x85, 0x3c, 0xf5, 0x9c, 0xd3, 0x09, 0x1d,
-	0xab, 0x2b, 0x4d, 0xa5, 0x55, 0xc0, 0x89, 0x19, 0x79, 0xae, 0x69, 0xc8, 0x5c, 0xdf, 0x53, 0x57,
-	0x9b, 0x4a, 0x2b, 0x87, 0x13, 0x13, 0xb5, 0xa0, 0xee, 0x4c, 0x26, 0xfe, 0xef, 0x74, 0x4c, 0x2e,
-	0xe9, 0x8c, 0x4c, 0x5c, 0xc6, 0xd5, 0x7c, 0x33, 0xdb, 0x2a, 0xe3, 0xaa, 0xc4, 0x8f, 0xe8, 0xec,
-	0xd8, 0x65, 0x1c, 0xed, 0xc0, 0xda, 0xe9, 0xc4, 0x1f, 0x5d, 0xd2, 0x31, 0x11, 0xd3, 0x15, 0xa1,
-	0x05, 0x11, 0x5a, 0x93, 0x8e, 0xee, 0x85, 0xc3, 0x45, 0xec, 0x73, 0x80, 0xa9, 0x17, 0x8a, 0xf9,
-	0xd0, 0x50, 0x2d, 0x8a, 0xcb, 0xa4, 0x10, 0xb4, 0x0e, 0x2b, 0xe7, 0xa1, 0xe3, 0x71, 0x15, 0x9a,
-	0x4a, 0xab, 0x8c, 0x63, 0x03, 0xbd, 0x04, 0x55, 0x9c, 0x49, 0xce, 0x42, 0xff, 0x8a, 0x8c, 0x7c,
-	0x8f, 0x3b, 0x23, 0xce, 0x88, 0xef, 0x4d, 0x66, 0x6a, 0x49, 0xd4, 0xd8, 0x10, 0xfe, 0x83, 0xd0,
-	0xbf, 0xea, 0x4a, 0xaf, 0xe5, 0x4d, 0x66, 0xe8, 0x13, 0x28, 0x3a, 0x81, 0x47, 0xb8, 0x1f, 0xb8,
-	0x23, 0xb5, 0x2c, 0x06, 0x53, 0x70, 0x02, 0xcf, 0x8e, 0x6c, 0xf4, 0x15, 0x54, 0xc5, 0xf5, 0xc8,
-	0x55, 0xc4, 0x06, 0xdf, 0x63, 0x6a, 0x45, 0xd4, 0xaa, 0x08, 0xb4, 0x27, 0x41, 0xb4, 0x07, 0x5b,
-	0xc9, 0x20, 0x92, 0xc0, 0x54, 0x9f, 0x55, 0xd1, 0xe7, 0xa6, 0x8c, 0x48, 0x92, 0xe6, 0xfd, 0x7e,
-	0x0d, 0xb5, 0xab, 0x29, 0xbf, 0x35, 0x99, 0x9a, 0xc8, 0xa8, 0x08, 0x38, 0x89, 0xd3, 0x0e, 0xa0,
-	0x38, 0x27, 0x0a, 0x6a, 0x00, 0x1a, 0x9a, 0x47, 0xa6, 0xf5, 0xce, 0x24, 0xb6, 0x75, 0xa4, 0x9b,
-	0xc4, 0x3e, 0xe9, 0xeb, 0xf5, 0x8f, 0x50, 0x05, 0x8a, 0x9d, 0xbe, 0xc4, 0xea, 0x0a, 0x42, 0x50,
-	0x3d, 0x30, 0xb0, 0xfe, 0xba, 0x33, 0xd0, 0x25, 0x96, 0xd1, 0xde, 0x67, 0xe0, 0xcb, 0x87, 0xe8,
-	0x88, 0x29, 0x0b, 0x7c, 0x8f, 0xd1, 0x68, 0xf1, 0x6c, 0x2a, 0x28, 0x22, 0xf8, 0x5c, 0xc0, 0x89,
-	0x89, 0x4c, 0x58, 0xa1, 0x61, 0xe8, 0x87, 0x82, 0x94, 0xd5, 0xdd, 0x57, 0x4f, 0xe3, 0x79, 0x52,
-	0xb8, 0xad, 0x47, 0xb9, 0x82, 0xef, 0x71, 0x19, 0xb4, 0x0d, 0x10, 0xd2, 0xdf, 0xa6, 0x94, 0xf1,
-	0x84, 0xc3, 0x65, 0x5c, 0x94, 0x88, 0x31, 0xd6, 0xfe, 0x50, 0xa0, 0x38, 0xcf, 0x49, 0xb7, 0xae,
-	0x63, 0x6c, 0xe1, 0xa4, 0xf5, 0x0d, 0x58, 0xeb, 0x75, 0x8e, 0x0f, 0x2c, 0xdc, 0xd3, 0xf7, 0x49,
-	0x4f, 0x1f, 0x0c, 0x3a, 0x87, 0x7a, 0x5d, 0x41, 0xeb, 0x50, 0xff, 0x59, 0xc7, 0x03, 0xc3, 0x32,
-	0x49, 0xcf, 0x18, 0xf4, 0x3a, 0x76, 0xf7, 0x4d, 0x3d, 0x83, 0xb6, 0xa0, 0x31, 0x34, 0x07, 0xc3,
-	0x7e, 0xdf, 0xc2, 0xb6, 0xbe, 0x9f, 0x9e, 0x61, 0x36, 0x1a, 0x9a, 0x61, 0xda, 0x3a, 0x36, 0x3b,
-	0xc7, 0xf1, 0x09, 0xf5, 0x9c, 0xf6, 0x5e, 0x01, 0x55, 0xd2, 0xa6, 0xeb, 0x8f, 0x69, 0x67, 0x7c,
-	0x4d, 0x43, 0xee, 0x32, 0x1a, 0xad, 0x1b, 0x9d, 0x40, 0x63, 0x41, 0x57, 0x88, 0xeb, 0x9d, 0xf9,
-	0xaa, 0xd2, 0xcc, 0xb6, 0x4a, 0xbb, 0x5f, 0xdc, 0x3f, 0x9f, 0xb7, 0x53, 0x1a, 0xce, 0x0c, 0xef,
-	0xcc, 0xc7, 0xeb, 0xc1, 0x1d, 0x57, 0x84, 0xa2, 0x3d, 0xa8, 0xdc, 0x92, 0x23, 0x31, 0xf1, 0xd2,
-	0x6e, 0xe3, 0xa6, 0x62, 0xc4, 0x0f, 0x43, 0x7a, 0x71, 0x79, 0x94, 0xb2, 0xb4, 0x57, 0xb0, 0xb1,
-	0xf4, 0x3c, 0xf4, 0x19, 0x94, 0x82, 0xe9, 0xe9, 0xc4, 0x1d, 0x45, 0xef, 0x96, 0x89, 0x5b, 0x96,
-	0x31, 0xc4, 0xd0, 0x11, 0x9d, 0x31, 0xed, 0xaf, 0x0c, 0x3c, 0xbb, 0xf7, 0xaa, 0x0b, 0x72, 0xa2,
-	0x2c, 0xca, 0xc9, 0x12, 0x69, 0xca, 0x2c, 0x95, 0xa6, 0x6d, 0x80, 0x9b, 0xab, 0x24, 0xab, 0x9f,
-	0xdf, 0x64, 0xa9, 0xc4, 0xe4, 0x96, 0x4a, 0xcc, 0x5c, 0x16, 0x56, 0xd2, 0xb2, 0x70, 0xbf, 0x78,
-	0xed, 0xc0, 0x1a, 0xa3, 0xe1, 0x35, 0x0d, 0x49, 0xea, 0xfc, 0xbc, 0xc8, 0xad, 0xc5, 0x8e, 0x7e,
-	0x72, 0x0b, 0xed, 0x6f, 0x05, 0xb6, 0x97, 0x8e, 0x63, 0xfe, 0x56, 0x5e, 0x42, 0xee, 0xff, 0x2e,
-	0x5c, 0x24, 0x44, 0xfd, 0x5f, 0x51, 0xc6, 0x9c, 0x73, 0x9a, 0xcc, 0xa8, 0x8c, 0x8b, 0x12, 0x31,
-	0xc6, 0xe9, 0x37, 0x98, 0xbd, 0xf5, 0x06, 0xb5, 0x3f, 0xb3, 0x50, 0xbf, 0x5b, 0xfc, 0x29, 0x9b,
-	0xd9, 0x84, 0xbc, 0x64, 0x94, 0x3c, 0x6d, 0x35, 0xe6, 0xcc, 0x63, 0x9b, 0x58, 0xb2, 0xd1, 0xdc,
-	0xd2, 0x8d, 0xaa, 0x90, 0x97, 0xf7, 0x97, 0xab, 0x48, 0x4c, 0xd4, 0x85, 0x9c, 0xf8, 0x77, 0x5c,
-	0x15, 0xaa, 0xf1, 0xe2, 0xfe, 0x21, 0x2d, 0x00, 0x42, 0x2c, 0x44, 0x32, 0x6a, 0xc0, 0xaa, 0x33,
-	0xe5, 0x17, 0x7e, 0x28, 0x97, 0x25, 0x2d, 0x8d, 0xc1, 0xfa, 0xb2, 0x2c, 0xa4, 0xc1, 0xf3, 0x44,
-	0x2e, 0xfa, 0xc3, 0xc1, 0x1b, 0x62, 0x5a, 0xb6, 0x71, 0x60, 0x74, 0x3b, 0x76, 0xa4, 0x08, 0x52,
-	0x3a, 0x4a, 0x90, 0xbf, 0x11, 0x0c, 0x61, 0x98, 0x91, 0xbb, 0x9e, 0x41, 0xdb, 0xf0, 0x0c, 0xeb,
-	0x6f, 0x87, 0xfa, 0xc0, 0x26, 0xb6, 0x45, 0x7e, 0xb2, 0x0c, 0x93, 0x74, 0xad, 0x5e, 0x6f, 0x68,
-	0x1a, 0xf6, 0x49, 0x3d, 0xab, 0x05, 0xb0, 0xb9, 0xa8, 0x78, 0x42, 0xb6, 0xd0, 0xf7, 0x50, 0x90,
-	0x0a, 0xc6, 0x24, 0x2b, 0xb6, 0x1e, 0x90, 0xc9, 0x79, 0xec, 0x23, 0x84, 0xd0, 0xfe, 0xc9, 0x40,
-	0x63, 0xf1, 0xc8, 0xc0, 0x0f, 0xf9, 0x03, 0x7a, 0xfd, 0xe3, 0x6d, 0xbd, 0xde, 0x79, 0x48, 0xaf,
-	0xa3, 0x52, 0x4b, 0x15, 0xfa, 0x43, 0x90, 0x43, 0xfb, 0xf5, 0x29, 0x4a, 0x5e, 0x83, 0xd2, 0x3b,
-	0x6c, 0x99, 0x87, 0xe9, 0xbf, 0xb1, 0x3b, 0x8a, 0x9c, 0x89, 0x30, 0xd3, 0xb2, 0x09, 0xd6, 0x0f,
-	0x8d, 0x81, 0xad, 0x63, 0x7d, 0xbf, 0x9e, 0xd5, 0xa6, 0xa0, 0x2e, 0x36, 0x24, 0x5f, 0xe8, 0xed,
-	0xb9, 0x2a, 0x77, 0x1f, 0xda, 0x0f, 0x90, 0x0f, 0x45, 0xef, 0x4c, 0xcd, 0x88, 0x6d, 0x35, 0x1f,
-	0x1b, 0x12, 0x4e, 0x12, 0x5e, 0x57, 0x7e, 0x29, 0xb5, 0x5f, 0xec, 0x25, 0xe1, 0xa7, 0xab, 0xe2,
-	0xeb, 0xbb, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x80, 0x64, 0xa2, 0x39, 0x6d, 0x0a, 0x00, 0x00,
+	// 1197 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x73, 0xda, 0x46,
+	0x14, 0x8f, 0x00, 0x1b, 0x78, 0x60, 0x90, 0xb7, 0x0e, 0x51, 0xdc, 0x3a, 0xa5, 0xea, 0x3f, 0x26,
+	0x07, 0xd2, 0x71, 0xa7, 0x4d, 0xa6, 0xb9, 0x94, 0x60, 0x39, 0x51, 0x6d, 0x24, 0xb2, 0x88, 0x66,
+	0xd2, 0xe9, 0xcc, 0x8e, 0x2c, 0x96, 0x58, 0x13, 0x59, 0x52, 0xb5, 0xc2, 0x1d, 0x6e, 0x9d, 0x9e,
+	0x7b, 0xe9, 0xb5, 0xdf, 0xa0, 0xd7, 0x7c, 0x96, 0x7e, 0xa0, 0x8e, 0x56, 0x2b, 0x22, 0x0c, 0xc6,
+	0xee, 0x4c, 0x4f, 0xd6, 0xfb, 0xbd, 0xf7, 0x7b, 0xbb, 0xfb, 0xfe, 0xfc, 0x0c, 0x28, 0xe1, 0x8c,
+	0x9d, 0x13, 0x3f, 0x88, 0xdd, 0xa9, 0xeb, 0xd8, 0xb1, 0x1b, 0xf8, 0xac, 0x1b, 0x46, 0x41, 0x1c,
+	0xa0, 0x0a, 0xff, 0x73, 0x36, 0x9b, 0xee, 0x7f, 0xe0, 0x9c, 0xdb, 0x31, 0x71, 0x27, 0xd4, 0x8f,
+	0xdd, 0x78, 0x9e, 0xba, 0xd5, 0x7f, 0xb6, 0xe1, 0xa3, 0xe1, 0x8c, 0x9d, 0x1b, 0x39, 0x2a, 0xa6,
+	0x6f, 0x5c, 0x16, 0x47, 0xfc, 0x1b, 0x99, 0x00, 0x71, 0xf0, 0x96, 0xfa, 0x24, 0x9e, 0x87, 0x54,
+	0x91, 0xda, 0x52, 0xa7, 0x71, 0xf8, 0x55, 0x37, 0x4b, 0xda, 0xdd, 0xc4, 0xed, 0x5a, 0x09, 0xd1,
+	0x9a, 0x87, 0x14, 0x57, 0xe3, 0xec, 0x13, 0x7d, 0x02, 0xf5, 0x09, 0xbd, 0x74, 0x1d, 0x4a, 0x38,
+	0xa6, 0x14, 0xda, 0x52, 0xa7, 0x8a, 0x6b, 0x29, 0xc6, 0x19, 0xe8, 0x4b, 0x68, 0xba, 0x3e, 0x8b,
+	0x6d, 0xcf, 0xe3, 0x79, 0x88, 0x3b, 0x51, 0x8a, 0x3c, 0xaa, 0x91, 0x87, 0xf5, 0x49, 0x92, 0xcb,
+	0x76, 0x1c, 0xca, 0x98, 0xc8, 0x55, 0x4a, 0x73, 0xa5, 0x58, 0x9a, 0x4b, 0x81, 0x32, 0xf5, 0xed,
+	0x33, 0x8f, 0x4e, 0x94, 0xad, 0xb6, 0xd4, 0xa9, 0xe0, 0xcc, 0x4c, 0x3c, 0x97, 0x34, 0x62, 0x6e,
+	0xe0, 0x2b, 0xdb, 0x6d, 0xa9, 0x53, 0xc2, 0x99, 0x89, 0x3a, 0x20, 0xdb, 0x9e, 0x17, 0xfc, 0x4a,
+	0x27, 0xe4, 0x2d, 0x9d, 0x13, 0xcf, 0x65, 0xb1, 0x52, 0x6e, 0x17, 0x3b, 0x75, 0xdc, 0x10, 0xf8,
+	0x09, 0x9d, 0x9f, 0xba, 0x2c, 0x46, 0x0f, 0x61, 0xf7, 0xcc, 0x0b, 0x9c, 0xb7, 0x74, 0x42, 0x78,
+	0x75, 0x79, 0x68, 0x85, 0x87, 0x36, 0x85, 0xa3, 0x7f, 0x6e, 0xc7, 0x3c, 0xf6, 0x01, 0xc0, 0xcc,
+	0x8f, 0x78, 0x7d, 0x68, 0xa4, 0x54, 0xf9, 0x65, 0x72, 0x08, 0xda, 0x83, 0xad, 0x37, 0x91, 0xed,
+	0xc7, 0x0a, 0xb4, 0xa5, 0x4e, 0x1d, 0xa7, 0x06, 0x7a, 0x0c, 0x0a, 0x3f, 0x93, 0x4c, 0xa3, 0xe0,
+	0x82, 0x38, 0x81, 0x1f, 0xdb, 0x4e, 0xcc, 0x48, 0xe0, 0x7b, 0x73, 0xa5, 0xc6, 0x73, 0xdc, 0xe5,
+	0xfe, 0xe3, 0x28, 0xb8, 0xe8, 0x0b, 0xaf, 0xe9, 0x7b, 0x73, 0xf4, 0x21, 0x54, 0xed, 0xd0, 0x27,
+	0x71, 0x10, 0xba, 0x8e, 0x52, 0xe7, 0x85, 0xa9, 0xd8, 0xa1, 0x6f, 0x25, 0x36, 0xfa, 0x1c, 0x1a,
+	0xfc, 0x7a, 0xe4, 0x22, 0x99, 0x86, 0xc0, 0x67, 0xca, 0x0e, 0xcf, 0xb5, 0xc3, 0xd1, 0x81, 0x00,
+	0xd1, 0x53, 0xd8, 0xcf, 0x0a, 0x91, 0x05, 0xe6, 0xde, 0xd9, 0xe0, 0xef, 0xbc, 0x27, 0x22, 0x32,
+	0xd2, 0xe2, 0xbd, 0x5f, 0x40, 0xf3, 0x62, 0x16, 0x2f, 0x55, 0xa6, 0xc9, 0x19, 0x3b, 0x1c, 0x5e,
+	0xc4, 0x8d, 0x40, 0xe6, 0x11, 0x61, 0x44, 0xa7, 0x34, 0xa2, 0xbe, 0x43, 0x99, 0x22, 0xb7, 0x8b,
+	0x9d, 0xda, 0x61, 0xe7, 0xfa, 0x39, 0x4b, 0xd8, 0xc3, 0x05, 0x01, 0x37, 0x9d, 0x25, 0x9b, 0xa1,
+	0x6f, 0xa0, 0xe5, 0x04, 0x9e, 0x67, 0x87, 0x8c, 0x2e, 0xaf, 0x85, 0xb2, 0x9b, 0x16, 0x2d, 0xf3,
+	0xe6, 0x93, 0x32, 0xf5, 0x18, 0xaa, 0x8b, 0xa1, 0x45, 0x2d, 0x40, 0x63, 0xe3, 0xc4, 0x30, 0x5f,
+	0x19, 0xc4, 0x32, 0x4f, 0x34, 0x83, 0x58, 0xaf, 0x87, 0x9a, 0x7c, 0x07, 0xed, 0x40, 0xb5, 0x37,
+	0x14, 0x98, 0x2c, 0x21, 0x04, 0x8d, 0x63, 0x1d, 0x6b, 0xcf, 0x7a, 0x23, 0x4d, 0x60, 0x05, 0xf5,
+	0x5d, 0x01, 0x3e, 0xdb, 0xb4, 0x1a, 0x98, 0xb2, 0x30, 0xf0, 0x19, 0x4d, 0x86, 0x90, 0xcd, 0xf8,
+	0xb8, 0xf2, 0xdd, 0xaa, 0xe0, 0xcc, 0x44, 0x06, 0x6c, 0xd1, 0x28, 0x0a, 0x22, 0xbe, 0x20, 0x8d,
+	0xc3, 0x27, 0xb7, 0xdb, 0xb9, 0x2c, 0x71, 0x57, 0x4b, 0xb8, 0x7c, 0xf7, 0xd2, 0x34, 0xe8, 0x00,
+	0x20, 0xa2, 0xbf, 0xcc, 0x28, 0x8b, 0xb3, 0x7d, 0xaa, 0xe3, 0xaa, 0x40, 0xf4, 0x89, 0xfa, 0x9b,
+	0x04, 0xd5, 0x05, 0x27, 0xff, 0x74, 0x0d, 0x63, 0x13, 0x67, 0x4f, 0xbf, 0x0b, 0xbb, 0x83, 0xde,
+	0xe9, 0xb1, 0x89, 0x07, 0xda, 0x11, 0x19, 0x68, 0xa3, 0x51, 0xef, 0xb9, 0x26, 0x4b, 0x68, 0x0f,
+	0xe4, 0x1f, 0x35, 0x3c, 0xd2, 0x4d, 0x83, 0x0c, 0xf4, 0xd1, 0xa0, 0x67, 0xf5, 0x5f, 0xc8, 0x05,
+	0xb4, 0x0f, 0xad, 0xb1, 0x31, 0x1a, 0x0f, 0x87, 0x26, 0xb6, 0xb4, 0xa3, 0x7c, 0x0d, 0x8b, 0x49,
+	0xd1, 0x74, 0xc3, 0xd2, 0xb0, 0xd1, 0x3b, 0x4d, 0x4f, 0x90, 0x4b, 0xea, 0x3b, 0x09, 0x14, 0x31,
+	0xc2, 0xfd, 0x60, 0x42, 0x7b, 0x93, 0x4b, 0x1a, 0xc5, 0x2e, 0xa3, 0xc9, 0xe8, 0xa1, 0xd7, 0xd0,
+	0x5a, 0xd1, 0x38, 0xe2, 0xfa, 0xd3, 0x40, 0x91, 0xf8, 0xac, 0x7c, 0x7a, 0x7d, 0x7d, 0x5e, 0xce,
+	0x68, 0x34, 0xd7, 0xfd, 0x69, 0x80, 0xf7, 0xc2, 0x2b, 0xae, 0x04, 0x45, 0x4f, 0x61, 0x67, 0x49,
+	0x1a, 0x79, 0xc5, 0x6b, 0x87, 0xad, 0xf7, 0x19, 0x93, 0x69, 0xd3, 0x85, 0x17, 0xd7, 0x9d, 0x9c,
+	0xa5, 0x3e, 0x81, 0xbb, 0x6b, 0xcf, 0x43, 0x1f, 0x43, 0x2d, 0x9c, 0x9d, 0x79, 0xae, 0x93, 0x68,
+	0x08, 0xe3, 0xb7, 0xac, 0x63, 0x48, 0xa1, 0x13, 0x3a, 0x67, 0xea, 0x1f, 0x05, 0xb8, 0x7f, 0xed,
+	0x55, 0x57, 0xa4, 0x4d, 0x5a, 0x95, 0xb6, 0x35, 0x32, 0x59, 0x58, 0x2b, 0x93, 0x07, 0x00, 0xef,
+	0xaf, 0x92, 0xb5, 0x7e, 0x71, 0x93, 0xb5, 0x72, 0x57, 0x5a, 0x2b, 0x77, 0x0b, 0x89, 0xda, 0xca,
+	0x4b, 0xd4, 0xf5, 0x42, 0xfa, 0x10, 0x76, 0x19, 0x8d, 0x2e, 0x69, 0x44, 0x72, 0xe7, 0x97, 0x39,
+	0xb7, 0x99, 0x3a, 0x86, 0xd9, 0x2d, 0xd4, 0x3f, 0x25, 0x38, 0x58, 0x5b, 0x8e, 0xc5, 0xae, 0x3c,
+	0x86, 0xd2, 0x7f, 0x6d, 0x38, 0x27, 0x24, 0xef, 0xbf, 0xa0, 0x8c, 0xd9, 0x6f, 0x68, 0x56, 0xa3,
+	0x3a, 0xae, 0x0a, 0x44, 0x9f, 0xe4, 0x77, 0xb0, 0xb8, 0xb4, 0x83, 0xea, 0xef, 0x45, 0x90, 0xaf,
+	0x26, 0xbf, 0x4d, 0x67, 0xee, 0x41, 0x59, 0x4c, 0x94, 0x38, 0x6d, 0x3b, 0x9d, 0x99, 0x9b, 0x3a,
+	0xb1, 0xa6, 0xa3, 0xa5, 0xb5, 0x1d, 0x55, 0xa0, 0x2c, 0xee, 0x2f, 0x5a, 0x91, 0x99, 0xa8, 0x0f,
+	0x25, 0xfe, 0x9f, 0x7a, 0x9b, 0xab, 0xc6, 0xa3, 0xeb, 0x8b, 0xb4, 0x02, 0x70, 0xb1, 0xe0, 0x64,
+	0xd4, 0x82, 0x6d, 0x7b, 0x16, 0x9f, 0x07, 0x91, 0x68, 0x96, 0xb0, 0x54, 0x06, 0x7b, 0xeb, 0x58,
+	0x48, 0x85, 0x07, 0x99, 0x5c, 0x0c, 0xc7, 0xa3, 0x17, 0xc4, 0x30, 0x2d, 0xfd, 0x58, 0xef, 0xf7,
+	0x2c, 0xdd, 0x14, 0x1b, 0x7f, 0x07, 0xd5, 0xa0, 0xfc, 0x5e, 0x30, 0xb8, 0x61, 0x24, 0x6e, 0xb9,
+	0x80, 0x0e, 0xe0, 0x3e, 0xd6, 0x5e, 0x8e, 0xb5, 0x91, 0x45, 0x2c, 0x93, 0xfc, 0x60, 0xea, 0x06,
+	0xe9, 0x9b, 0x83, 0xc1, 0xd8, 0xd0, 0xad, 0xd7, 0x72, 0x51, 0x0d, 0xe1, 0xde, 0xaa, 0xe2, 0x71,
+	0xd9, 0x42, 0xdf, 0x42, 0x45, 0x28, 0x18, 0x13, 0x53, 0xb1, 0xbf, 0x41, 0x26, 0x17, 0xb1, 0x37,
+	0x0c, 0x84, 0xfa, 0x57, 0x01, 0x5a, 0xab, 0x47, 0x86, 0x41, 0x14, 0x6f, 0xd0, 0xeb, 0xef, 0x97,
+	0xf5, 0xfa, 0xe1, 0x26, 0xbd, 0x4e, 0x52, 0xad, 0x55, 0xe8, 0xff, 0x63, 0x38, 0xd4, 0x9f, 0x6f,
+	0xa3, 0xe4, 0x4d, 0xa8, 0xbd, 0xc2, 0xa6, 0xf1, 0x3c, 0xff, 0x6f, 0xec, 0x8a, 0x22, 0x17, 0x12,
+	0xcc, 0x30, 0x2d, 0x82, 0xb5, 0xe7, 0xfa, 0xc8, 0xd2, 0xb0, 0x76, 0x24, 0x17, 0xd5, 0x19, 0x28,
+	0xab, 0x0f, 0x12, 0x1b, 0xba, 0x5c, 0x57, 0xe9, 0xea, 0xa2, 0x7d, 0x07, 0xe5, 0x88, 0xbf, 0x9d,
+	0x29, 0x05, 0xde, 0xad, 0xf6, 0x4d, 0x45, 0xc2, 0x19, 0x41, 0xfd, 0x5b, 0x82, 0x07, 0x9b, 0x7f,
+	0x04, 0xe4, 0xb7, 0x4e, 0x5a, 0xda, 0xba, 0x23, 0xd8, 0xf2, 0xe8, 0x25, 0xf5, 0x44, 0x6b, 0xba,
+	0xb7, 0xfd, 0x59, 0xd1, 0x3d, 0x4d, 0x58, 0x38, 0x25, 0xab, 0x1d, 0xd8, 0xe2, 0x36, 0x2a, 0x43,
+	0xb1, 0x77, 0x7a, 0x2a, 0xdf, 0x41, 0x75, 0xa8, 0x88, 0x29, 0x1e, 0xc9, 0x12, 0xaa, 0x40, 0xc9,
+	0x30, 0x0d, 0x4d, 0x2e, 0x3c, 0xdb, 0xf9, 0xa9, 0xd6, 0x7d, 0xf4, 0x34, 0x3b, 0xe4, 0x6c, 0x9b,
+	0x7f, 0x7d, 0xfd, 0xef, 0x00, 0x55, 0x8f, 0xba, 0x6c, 0xa5, 0x0b, 0x00, 0x00,
 }
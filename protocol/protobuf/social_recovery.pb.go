@@ -0,0 +1,340 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: social_recovery.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type SocialRecoveryShare struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	SetId                string   `protobuf:"bytes,2,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	Share                []byte   `protobuf:"bytes,3,opt,name=share,proto3" json:"share,omitempty"`
+	Payload              []byte   `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Threshold            uint32   `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Total                uint32   `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Signature            []byte   `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SocialRecoveryShare) Reset()         { *m = SocialRecoveryShare{} }
+func (m *SocialRecoveryShare) String() string { return proto.CompactTextString(m) }
+func (*SocialRecoveryShare) ProtoMessage()    {}
+func (*SocialRecoveryShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d854c991729fd39, []int{0}
+}
+
+func (m *SocialRecoveryShare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SocialRecoveryShare.Unmarshal(m, b)
+}
+func (m *SocialRecoveryShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SocialRecoveryShare.Marshal(b, m, deterministic)
+}
+func (m *SocialRecoveryShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SocialRecoveryShare.Merge(m, src)
+}
+func (m *SocialRecoveryShare) XXX_Size() int {
+	return xxx_messageInfo_SocialRecoveryShare.Size(m)
+}
+func (m *SocialRecoveryShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_SocialRecoveryShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SocialRecoveryShare proto.InternalMessageInfo
+
+func (m *SocialRecoveryShare) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SocialRecoveryShare) GetSetId() string {
+	if m != nil {
+		return m.SetId
+	}
+	return ""
+}
+
+func (m *SocialRecoveryShare) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+func (m *SocialRecoveryShare) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *SocialRecoveryShare) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *SocialRecoveryShare) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *SocialRecoveryShare) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type SocialRecoveryShareResponse struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	SetId                string   `protobuf:"bytes,2,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	Accepted             bool     `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SocialRecoveryShareResponse) Reset()         { *m = SocialRecoveryShareResponse{} }
+func (m *SocialRecoveryShareResponse) String() string { return proto.CompactTextString(m) }
+func (*SocialRecoveryShareResponse) ProtoMessage()    {}
+func (*SocialRecoveryShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d854c991729fd39, []int{1}
+}
+
+func (m *SocialRecoveryShareResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SocialRecoveryShareResponse.Unmarshal(m, b)
+}
+func (m *SocialRecoveryShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SocialRecoveryShareResponse.Marshal(b, m, deterministic)
+}
+func (m *SocialRecoveryShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SocialRecoveryShareResponse.Merge(m, src)
+}
+func (m *SocialRecoveryShareResponse) XXX_Size() int {
+	return xxx_messageInfo_SocialRecoveryShareResponse.Size(m)
+}
+func (m *SocialRecoveryShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SocialRecoveryShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SocialRecoveryShareResponse proto.InternalMessageInfo
+
+func (m *SocialRecoveryShareResponse) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SocialRecoveryShareResponse) GetSetId() string {
+	if m != nil {
+		return m.SetId
+	}
+	return ""
+}
+
+func (m *SocialRecoveryShareResponse) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+type SocialRecoveryRequest struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	OwnerPublicKey       string   `protobuf:"bytes,2,opt,name=owner_public_key,json=ownerPublicKey,proto3" json:"owner_public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SocialRecoveryRequest) Reset()         { *m = SocialRecoveryRequest{} }
+func (m *SocialRecoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*SocialRecoveryRequest) ProtoMessage()    {}
+func (*SocialRecoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d854c991729fd39, []int{2}
+}
+
+func (m *SocialRecoveryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SocialRecoveryRequest.Unmarshal(m, b)
+}
+func (m *SocialRecoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SocialRecoveryRequest.Marshal(b, m, deterministic)
+}
+func (m *SocialRecoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SocialRecoveryRequest.Merge(m, src)
+}
+func (m *SocialRecoveryRequest) XXX_Size() int {
+	return xxx_messageInfo_SocialRecoveryRequest.Size(m)
+}
+func (m *SocialRecoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SocialRecoveryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SocialRecoveryRequest proto.InternalMessageInfo
+
+func (m *SocialRecoveryRequest) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SocialRecoveryRequest) GetOwnerPublicKey() string {
+	if m != nil {
+		return m.OwnerPublicKey
+	}
+	return ""
+}
+
+type SocialRecoveryShareRelease struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	OwnerPublicKey       string   `protobuf:"bytes,2,opt,name=owner_public_key,json=ownerPublicKey,proto3" json:"owner_public_key,omitempty"`
+	SetId                string   `protobuf:"bytes,3,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	Share                []byte   `protobuf:"bytes,4,opt,name=share,proto3" json:"share,omitempty"`
+	Payload              []byte   `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Threshold            uint32   `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Total                uint32   `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	Signature            []byte   `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SocialRecoveryShareRelease) Reset()         { *m = SocialRecoveryShareRelease{} }
+func (m *SocialRecoveryShareRelease) String() string { return proto.CompactTextString(m) }
+func (*SocialRecoveryShareRelease) ProtoMessage()    {}
+func (*SocialRecoveryShareRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d854c991729fd39, []int{3}
+}
+
+func (m *SocialRecoveryShareRelease) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SocialRecoveryShareRelease.Unmarshal(m, b)
+}
+func (m *SocialRecoveryShareRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SocialRecoveryShareRelease.Marshal(b, m, deterministic)
+}
+func (m *SocialRecoveryShareRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SocialRecoveryShareRelease.Merge(m, src)
+}
+func (m *SocialRecoveryShareRelease) XXX_Size() int {
+	return xxx_messageInfo_SocialRecoveryShareRelease.Size(m)
+}
+func (m *SocialRecoveryShareRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_SocialRecoveryShareRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SocialRecoveryShareRelease proto.InternalMessageInfo
+
+func (m *SocialRecoveryShareRelease) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SocialRecoveryShareRelease) GetOwnerPublicKey() string {
+	if m != nil {
+		return m.OwnerPublicKey
+	}
+	return ""
+}
+
+func (m *SocialRecoveryShareRelease) GetSetId() string {
+	if m != nil {
+		return m.SetId
+	}
+	return ""
+}
+
+func (m *SocialRecoveryShareRelease) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+func (m *SocialRecoveryShareRelease) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *SocialRecoveryShareRelease) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *SocialRecoveryShareRelease) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *SocialRecoveryShareRelease) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SocialRecoveryShare)(nil), "protobuf.SocialRecoveryShare")
+	proto.RegisterType((*SocialRecoveryShareResponse)(nil), "protobuf.SocialRecoveryShareResponse")
+	proto.RegisterType((*SocialRecoveryRequest)(nil), "protobuf.SocialRecoveryRequest")
+	proto.RegisterType((*SocialRecoveryShareRelease)(nil), "protobuf.SocialRecoveryShareRelease")
+}
+
+func init() {
+	proto.RegisterFile("social_recovery.proto", fileDescriptor_7d854c991729fd39)
+}
+
+var fileDescriptor_7d854c991729fd39 = []byte{
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x3d, 0x4b, 0xc4, 0x30,
+	0x1c, 0xc6, 0x89, 0x77, 0x7d, 0xb9, 0xe8, 0x89, 0x54, 0x0b, 0xe1, 0x74, 0x28, 0x9d, 0x3a, 0x9d,
+	0x83, 0xa3, 0x9b, 0x9b, 0xb8, 0x48, 0x6e, 0x10, 0x5c, 0x4a, 0x9a, 0xfe, 0xcf, 0x96, 0x0b, 0x4d,
+	0x4d, 0x52, 0xa5, 0xdf, 0xcf, 0xaf, 0xe4, 0x2e, 0x4d, 0xef, 0x3c, 0x95, 0x56, 0x10, 0xa7, 0xe4,
+	0x79, 0xf2, 0x7f, 0xe1, 0xe1, 0x17, 0x1c, 0x6a, 0xc9, 0x4b, 0x26, 0x52, 0x05, 0x5c, 0xbe, 0x80,
+	0x6a, 0x97, 0xb5, 0x92, 0x46, 0x06, 0xbe, 0x3d, 0xb2, 0x66, 0x1d, 0xbf, 0x21, 0x7c, 0xba, 0xb2,
+	0x35, 0x74, 0x5b, 0xb2, 0x2a, 0x98, 0x82, 0xe0, 0x0c, 0x3b, 0x5c, 0x48, 0xbe, 0x21, 0x28, 0x42,
+	0xc9, 0x94, 0xf6, 0x22, 0x08, 0xb1, 0xab, 0xc1, 0xa4, 0x65, 0x4e, 0x0e, 0x22, 0x94, 0xcc, 0xa8,
+	0xa3, 0xc1, 0xdc, 0xe6, 0x5d, 0xb1, 0xee, 0xba, 0xc8, 0x24, 0x42, 0xc9, 0x11, 0xed, 0x45, 0x40,
+	0xb0, 0x57, 0xb3, 0x56, 0x48, 0x96, 0x93, 0xa9, 0xf5, 0x77, 0x32, 0xb8, 0xc0, 0x33, 0x53, 0x28,
+	0xd0, 0x85, 0x14, 0x39, 0x71, 0x22, 0x94, 0xcc, 0xe9, 0xde, 0xe8, 0xa6, 0x19, 0x69, 0x98, 0x20,
+	0xae, 0x7d, 0xe9, 0x45, 0xd7, 0xa3, 0xcb, 0xa7, 0x8a, 0x99, 0x46, 0x01, 0xf1, 0xec, 0xbc, 0xbd,
+	0x11, 0xaf, 0xf1, 0xf9, 0x40, 0x0a, 0x0a, 0xba, 0x96, 0x95, 0xfe, 0x63, 0x9a, 0x05, 0xf6, 0x19,
+	0xe7, 0x50, 0x1b, 0xc8, 0x6d, 0x20, 0x9f, 0x7e, 0xea, 0xf8, 0x01, 0x87, 0xdf, 0xf7, 0x50, 0x78,
+	0x6e, 0x40, 0x9b, 0x91, 0x0d, 0x09, 0x3e, 0x91, 0xaf, 0x15, 0xa8, 0xb4, 0x6e, 0x32, 0x51, 0xf2,
+	0x74, 0x03, 0xed, 0x76, 0xd7, 0xb1, 0xf5, 0xef, 0xad, 0x7d, 0x07, 0x6d, 0xfc, 0x8e, 0xf0, 0x62,
+	0x30, 0x81, 0x00, 0xa6, 0xe1, 0xbf, 0xe3, 0xbf, 0x44, 0x9d, 0x0c, 0x82, 0x9b, 0x8e, 0x80, 0x73,
+	0x7e, 0x01, 0xe7, 0x8e, 0x82, 0xf3, 0x46, 0xc1, 0xf9, 0x3f, 0xc0, 0xdd, 0xcc, 0x1f, 0x0f, 0x97,
+	0x97, 0xd7, 0xbb, 0xef, 0x98, 0xb9, 0xf6, 0x76, 0xf5, 0x31, 0x00, 0xaa, 0xed, 0xd7, 0xa6, 0xb8,
+	0x02, 0x00, 0x00,
+}
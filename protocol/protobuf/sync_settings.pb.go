@@ -23,24 +23,28 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type SyncSetting_Type int32
 
 const (
-	SyncSetting_UNKNOWN                     SyncSetting_Type = 0
-	SyncSetting_CURRENCY                    SyncSetting_Type = 1
-	SyncSetting_GIF_RECENTS                 SyncSetting_Type = 2
-	SyncSetting_GIF_FAVOURITES              SyncSetting_Type = 3
-	SyncSetting_MESSAGES_FROM_CONTACTS_ONLY SyncSetting_Type = 4
-	SyncSetting_PREFERRED_NAME              SyncSetting_Type = 5
-	SyncSetting_PREVIEW_PRIVACY             SyncSetting_Type = 6
-	SyncSetting_PROFILE_PICTURES_SHOW_TO    SyncSetting_Type = 7
-	SyncSetting_PROFILE_PICTURES_VISIBILITY SyncSetting_Type = 8
-	SyncSetting_SEND_STATUS_UPDATES         SyncSetting_Type = 9
-	SyncSetting_STICKERS_PACKS_INSTALLED    SyncSetting_Type = 10
-	SyncSetting_STICKERS_PACKS_PENDING      SyncSetting_Type = 11
-	SyncSetting_STICKERS_RECENT_STICKERS    SyncSetting_Type = 12
-	SyncSetting_DISPLAY_NAME                SyncSetting_Type = 13
-	SyncSetting_BIO                         SyncSetting_Type = 14
-	SyncSetting_MNEMONIC_REMOVED            SyncSetting_Type = 15
-	SyncSetting_ENS_USERNAMES               SyncSetting_Type = 16
-	SyncSetting_INCLUDE_WATCHONLY_ACCOUNT   SyncSetting_Type = 17
+	SyncSetting_UNKNOWN                      SyncSetting_Type = 0
+	SyncSetting_CURRENCY                     SyncSetting_Type = 1
+	SyncSetting_GIF_RECENTS                  SyncSetting_Type = 2
+	SyncSetting_GIF_FAVOURITES               SyncSetting_Type = 3
+	SyncSetting_MESSAGES_FROM_CONTACTS_ONLY  SyncSetting_Type = 4
+	SyncSetting_PREFERRED_NAME               SyncSetting_Type = 5
+	SyncSetting_PREVIEW_PRIVACY              SyncSetting_Type = 6
+	SyncSetting_PROFILE_PICTURES_SHOW_TO     SyncSetting_Type = 7
+	SyncSetting_PROFILE_PICTURES_VISIBILITY  SyncSetting_Type = 8
+	SyncSetting_SEND_STATUS_UPDATES          SyncSetting_Type = 9
+	SyncSetting_STICKERS_PACKS_INSTALLED     SyncSetting_Type = 10
+	SyncSetting_STICKERS_PACKS_PENDING       SyncSetting_Type = 11
+	SyncSetting_STICKERS_RECENT_STICKERS     SyncSetting_Type = 12
+	SyncSetting_DISPLAY_NAME                 SyncSetting_Type = 13
+	SyncSetting_BIO                          SyncSetting_Type = 14
+	SyncSetting_MNEMONIC_REMOVED             SyncSetting_Type = 15
+	SyncSetting_ENS_USERNAMES                SyncSetting_Type = 16
+	SyncSetting_INCLUDE_WATCHONLY_ACCOUNT    SyncSetting_Type = 17
+	SyncSetting_PRIVACY_MODE                 SyncSetting_Type = 18
+	SyncSetting_NOTIFICATION_KEYWORDS        SyncSetting_Type = 19
+	SyncSetting_COMMUNITY_BLOCKLISTS_ENABLED SyncSetting_Type = 20
+	SyncSetting_TEST_NETWORKS_ENABLED        SyncSetting_Type = 21
 )
 
 var SyncSetting_Type_name = map[int32]string{
@@ -62,27 +66,35 @@ var SyncSetting_Type_name = map[int32]string{
 	15: "MNEMONIC_REMOVED",
 	16: "ENS_USERNAMES",
 	17: "INCLUDE_WATCHONLY_ACCOUNT",
+	18: "PRIVACY_MODE",
+	19: "NOTIFICATION_KEYWORDS",
+	20: "COMMUNITY_BLOCKLISTS_ENABLED",
+	21: "TEST_NETWORKS_ENABLED",
 }
 
 var SyncSetting_Type_value = map[string]int32{
-	"UNKNOWN":                     0,
-	"CURRENCY":                    1,
-	"GIF_RECENTS":                 2,
-	"GIF_FAVOURITES":              3,
-	"MESSAGES_FROM_CONTACTS_ONLY": 4,
-	"PREFERRED_NAME":              5,
-	"PREVIEW_PRIVACY":             6,
-	"PROFILE_PICTURES_SHOW_TO":    7,
-	"PROFILE_PICTURES_VISIBILITY": 8,
-	"SEND_STATUS_UPDATES":         9,
-	"STICKERS_PACKS_INSTALLED":    10,
-	"STICKERS_PACKS_PENDING":      11,
-	"STICKERS_RECENT_STICKERS":    12,
-	"DISPLAY_NAME":                13,
-	"BIO":                         14,
-	"MNEMONIC_REMOVED":            15,
-	"ENS_USERNAMES":               16,
-	"INCLUDE_WATCHONLY_ACCOUNT":   17,
+	"UNKNOWN":                      0,
+	"CURRENCY":                     1,
+	"GIF_RECENTS":                  2,
+	"GIF_FAVOURITES":               3,
+	"MESSAGES_FROM_CONTACTS_ONLY":  4,
+	"PREFERRED_NAME":               5,
+	"PREVIEW_PRIVACY":              6,
+	"PROFILE_PICTURES_SHOW_TO":     7,
+	"PROFILE_PICTURES_VISIBILITY":  8,
+	"SEND_STATUS_UPDATES":          9,
+	"STICKERS_PACKS_INSTALLED":     10,
+	"STICKERS_PACKS_PENDING":       11,
+	"STICKERS_RECENT_STICKERS":     12,
+	"DISPLAY_NAME":                 13,
+	"BIO":                          14,
+	"MNEMONIC_REMOVED":             15,
+	"ENS_USERNAMES":                16,
+	"INCLUDE_WATCHONLY_ACCOUNT":    17,
+	"PRIVACY_MODE":                 18,
+	"NOTIFICATION_KEYWORDS":        19,
+	"COMMUNITY_BLOCKLISTS_ENABLED": 20,
+	"TEST_NETWORKS_ENABLED":        21,
 }
 
 func (x SyncSetting_Type) String() string {
@@ -230,38 +242,41 @@ func init() {
 }
 
 var fileDescriptor_e2f7a0bce2873c78 = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x5f, 0x6f, 0xd3, 0x3c,
-	0x14, 0xc6, 0x9b, 0xb5, 0x5d, 0xbb, 0xd3, 0x6e, 0xf3, 0xbc, 0xe9, 0x7d, 0xc3, 0x00, 0x2d, 0x8c,
-	0x9b, 0x5c, 0x05, 0x09, 0x10, 0x37, 0x5c, 0xb9, 0xce, 0xe9, 0x6a, 0x2d, 0xb1, 0x23, 0xdb, 0x69,
-	0x55, 0x6e, 0x2c, 0x56, 0x95, 0x69, 0xa2, 0x6a, 0xa6, 0x35, 0x43, 0xea, 0x97, 0xe0, 0x4b, 0xf0,
-	0x45, 0x51, 0x12, 0xca, 0xdf, 0x2b, 0xfb, 0x3c, 0xe7, 0x77, 0x1e, 0x1f, 0x1f, 0x1b, 0x4e, 0x37,
-	0xdb, 0xf5, 0xc2, 0x6d, 0x96, 0x65, 0x79, 0xb7, 0xbe, 0xdd, 0x44, 0xf7, 0x0f, 0x45, 0x59, 0xd0,
-	0x7e, 0xbd, 0xdc, 0x3c, 0x7e, 0xba, 0xfc, 0xda, 0x85, 0x81, 0xd9, 0xae, 0x17, 0xa6, 0x01, 0x68,
-	0x04, 0x9d, 0x72, 0x7b, 0xbf, 0xf4, 0xbd, 0xc0, 0x0b, 0x8f, 0x5e, 0x9f, 0x47, 0x3b, 0x30, 0xfa,
-	0x0d, 0x8a, 0xec, 0xf6, 0x7e, 0xa9, 0x6b, 0x8e, 0x9e, 0x41, 0x77, 0xb1, 0x2a, 0x16, 0x9f, 0xfd,
-	0xbd, 0xc0, 0x0b, 0x3b, 0xba, 0x09, 0xe8, 0x4b, 0x18, 0x7e, 0xf9, 0xb8, 0x7a, 0x5c, 0xba, 0x4d,
-	0xf9, 0x70, 0xb7, 0xbe, 0xf5, 0xdb, 0x81, 0x17, 0x1e, 0x4c, 0x5a, 0x7a, 0x50, 0xab, 0xa6, 0x16,
-	0xe9, 0x0b, 0x68, 0x42, 0x77, 0xb3, 0x2d, 0x97, 0x1b, 0xbf, 0x13, 0x78, 0xe1, 0x70, 0xd2, 0xd2,
-	0x50, 0x8b, 0xa3, 0x4a, 0xa3, 0x17, 0x00, 0x3f, 0x90, 0xa2, 0x58, 0xf9, 0xdd, 0xc0, 0x0b, 0xfb,
-	0x93, 0x96, 0x3e, 0x68, 0x88, 0xa2, 0x58, 0xfd, 0xf2, 0xb8, 0x5b, 0x97, 0xef, 0xde, 0xfa, 0xfb,
-	0x81, 0x17, 0xb6, 0x7f, 0x7a, 0x88, 0x4a, 0xbb, 0xfc, 0xd6, 0x86, 0x4e, 0xd5, 0x30, 0x1d, 0x40,
-	0x2f, 0x97, 0xd7, 0x52, 0xcd, 0x24, 0x69, 0xd1, 0x21, 0xf4, 0x79, 0xae, 0x35, 0x4a, 0x3e, 0x27,
-	0x1e, 0x3d, 0x86, 0xc1, 0x95, 0x18, 0x3b, 0x8d, 0x1c, 0xa5, 0x35, 0x64, 0x8f, 0x52, 0x38, 0xaa,
-	0x84, 0x31, 0x9b, 0xaa, 0x5c, 0x0b, 0x8b, 0x86, 0xb4, 0xe9, 0x05, 0x3c, 0x4d, 0xd1, 0x18, 0x76,
-	0x85, 0xc6, 0x8d, 0xb5, 0x4a, 0x1d, 0x57, 0xd2, 0x32, 0x6e, 0x8d, 0x53, 0x32, 0x99, 0x93, 0x4e,
-	0x55, 0x94, 0x69, 0x1c, 0xa3, 0xd6, 0x18, 0x3b, 0xc9, 0x52, 0x24, 0x5d, 0x7a, 0x0a, 0xc7, 0x99,
-	0xc6, 0xa9, 0xc0, 0x99, 0xcb, 0xb4, 0x98, 0x32, 0x3e, 0x27, 0xfb, 0xf4, 0x19, 0xf8, 0x99, 0x56,
-	0x63, 0x91, 0xa0, 0xcb, 0x04, 0xb7, 0xb9, 0x46, 0xe3, 0xcc, 0x44, 0xcd, 0x9c, 0x55, 0xa4, 0x57,
-	0x9d, 0xf3, 0x4f, 0x76, 0x2a, 0x8c, 0x18, 0x89, 0x44, 0xd8, 0x39, 0xe9, 0xd3, 0xff, 0xe1, 0xd4,
-	0xa0, 0x8c, 0x9d, 0xb1, 0xcc, 0xe6, 0xc6, 0xe5, 0x59, 0xcc, 0xaa, 0x0e, 0x0f, 0x2a, 0x5f, 0x63,
-	0x05, 0xbf, 0x46, 0x6d, 0x5c, 0xc6, 0xf8, 0xb5, 0x71, 0x42, 0x1a, 0xcb, 0x92, 0x04, 0x63, 0x02,
-	0xf4, 0x1c, 0xfe, 0xfb, 0x2b, 0x9b, 0xa1, 0x8c, 0x85, 0xbc, 0x22, 0x83, 0x3f, 0x2a, 0x9b, 0x29,
-	0xb8, 0x5d, 0x4c, 0x86, 0x94, 0xc0, 0x30, 0x16, 0x26, 0x4b, 0xd8, 0xbc, 0xb9, 0xd6, 0x21, 0xed,
-	0x41, 0x7b, 0x24, 0x14, 0x39, 0xa2, 0x67, 0x40, 0x52, 0x89, 0xa9, 0x92, 0x82, 0x3b, 0x8d, 0xa9,
-	0x9a, 0x62, 0x4c, 0x8e, 0xe9, 0x09, 0x1c, 0xa2, 0x34, 0x2e, 0x37, 0xa8, 0xab, 0x02, 0x43, 0x08,
-	0x7d, 0x0e, 0x4f, 0x84, 0xe4, 0x49, 0x1e, 0xa3, 0x9b, 0x31, 0xcb, 0x27, 0xd5, 0xcc, 0x1c, 0xe3,
-	0x5c, 0xe5, 0xd2, 0x92, 0x93, 0x51, 0x0f, 0xba, 0xcd, 0xab, 0x1e, 0x7e, 0x18, 0x44, 0xaf, 0xde,
-	0xef, 0xbe, 0xdd, 0xcd, 0x7e, 0xbd, 0x7b, 0xf3, 0x3d, 0x00, 0x00, 0xff, 0xff, 0x93, 0x18, 0x16,
-	0x3f, 0xc7, 0x02, 0x00, 0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0x5f, 0x4f, 0xdb, 0x3e,
+	0x14, 0x6d, 0x68, 0x4b, 0xcb, 0x6d, 0x01, 0xe3, 0xc2, 0xef, 0x17, 0x18, 0x13, 0x19, 0x7b, 0xe9,
+	0x53, 0x27, 0x6d, 0xd3, 0x5e, 0xf6, 0xe4, 0x3a, 0xb7, 0xd4, 0x6a, 0x62, 0x47, 0xb6, 0xd3, 0x2a,
+	0x7b, 0xb1, 0x46, 0xd5, 0x21, 0xb4, 0xaa, 0x41, 0x34, 0x4c, 0xea, 0x87, 0xd8, 0xf7, 0xdd, 0xe3,
+	0x94, 0x06, 0xc6, 0xfe, 0x3c, 0xd9, 0xf7, 0xdc, 0x73, 0xee, 0x9f, 0xa3, 0x0b, 0xbd, 0xf5, 0x66,
+	0x35, 0x77, 0xeb, 0x45, 0x51, 0xdc, 0xae, 0x6e, 0xd6, 0x83, 0xbb, 0xfb, 0xbc, 0xc8, 0x69, 0x7b,
+	0xfb, 0x5c, 0x3f, 0x7c, 0xb9, 0xfc, 0xd1, 0x84, 0x8e, 0xd9, 0xac, 0xe6, 0xa6, 0x22, 0xd0, 0x01,
+	0x34, 0x8a, 0xcd, 0xdd, 0xc2, 0xf7, 0x02, 0xaf, 0x7f, 0xf0, 0xf6, 0x6c, 0xf0, 0x44, 0x1c, 0xfc,
+	0x46, 0x1a, 0xd8, 0xcd, 0xdd, 0x42, 0x6f, 0x79, 0xf4, 0x18, 0x9a, 0xf3, 0x65, 0x3e, 0xff, 0xea,
+	0xef, 0x04, 0x5e, 0xbf, 0xa1, 0xab, 0x80, 0xbe, 0x86, 0xee, 0xb7, 0xcf, 0xcb, 0x87, 0x85, 0x5b,
+	0x17, 0xf7, 0xb7, 0xab, 0x1b, 0xbf, 0x1e, 0x78, 0xfd, 0xbd, 0x71, 0x4d, 0x77, 0xb6, 0xa8, 0xd9,
+	0x82, 0xf4, 0x15, 0x54, 0xa1, 0xbb, 0xde, 0x14, 0x8b, 0xb5, 0xdf, 0x08, 0xbc, 0x7e, 0x77, 0x5c,
+	0xd3, 0xb0, 0x05, 0x87, 0x25, 0x46, 0x2f, 0x00, 0x1e, 0x29, 0x79, 0xbe, 0xf4, 0x9b, 0x81, 0xd7,
+	0x6f, 0x8f, 0x6b, 0x7a, 0xaf, 0x62, 0xe4, 0xf9, 0xf2, 0xb9, 0xc6, 0xed, 0xaa, 0xf8, 0xf0, 0xde,
+	0xdf, 0x0d, 0xbc, 0x7e, 0xfd, 0x57, 0x0d, 0x51, 0x62, 0x97, 0xdf, 0x1b, 0xd0, 0x28, 0x07, 0xa6,
+	0x1d, 0x68, 0xa5, 0x72, 0x22, 0xd5, 0x4c, 0x92, 0x1a, 0xed, 0x42, 0x9b, 0xa7, 0x5a, 0xa3, 0xe4,
+	0x19, 0xf1, 0xe8, 0x21, 0x74, 0xae, 0xc4, 0xc8, 0x69, 0xe4, 0x28, 0xad, 0x21, 0x3b, 0x94, 0xc2,
+	0x41, 0x09, 0x8c, 0xd8, 0x54, 0xa5, 0x5a, 0x58, 0x34, 0xa4, 0x4e, 0x2f, 0xe0, 0x45, 0x8c, 0xc6,
+	0xb0, 0x2b, 0x34, 0x6e, 0xa4, 0x55, 0xec, 0xb8, 0x92, 0x96, 0x71, 0x6b, 0x9c, 0x92, 0x51, 0x46,
+	0x1a, 0xa5, 0x28, 0xd1, 0x38, 0x42, 0xad, 0x31, 0x74, 0x92, 0xc5, 0x48, 0x9a, 0xb4, 0x07, 0x87,
+	0x89, 0xc6, 0xa9, 0xc0, 0x99, 0x4b, 0xb4, 0x98, 0x32, 0x9e, 0x91, 0x5d, 0x7a, 0x0e, 0x7e, 0xa2,
+	0xd5, 0x48, 0x44, 0xe8, 0x12, 0xc1, 0x6d, 0xaa, 0xd1, 0x38, 0x33, 0x56, 0x33, 0x67, 0x15, 0x69,
+	0x95, 0x7d, 0xfe, 0xc9, 0x4e, 0x85, 0x11, 0x43, 0x11, 0x09, 0x9b, 0x91, 0x36, 0xfd, 0x1f, 0x7a,
+	0x06, 0x65, 0xe8, 0x8c, 0x65, 0x36, 0x35, 0x2e, 0x4d, 0x42, 0x56, 0x4e, 0xb8, 0x57, 0xd6, 0x35,
+	0x56, 0xf0, 0x09, 0x6a, 0xe3, 0x12, 0xc6, 0x27, 0xc6, 0x09, 0x69, 0x2c, 0x8b, 0x22, 0x0c, 0x09,
+	0xd0, 0x33, 0xf8, 0xef, 0xaf, 0x6c, 0x82, 0x32, 0x14, 0xf2, 0x8a, 0x74, 0xfe, 0x50, 0x56, 0x2e,
+	0xb8, 0xa7, 0x98, 0x74, 0x29, 0x81, 0x6e, 0x28, 0x4c, 0x12, 0xb1, 0xac, 0x5a, 0x6b, 0x9f, 0xb6,
+	0xa0, 0x3e, 0x14, 0x8a, 0x1c, 0xd0, 0x63, 0x20, 0xb1, 0xc4, 0x58, 0x49, 0xc1, 0x9d, 0xc6, 0x58,
+	0x4d, 0x31, 0x24, 0x87, 0xf4, 0x08, 0xf6, 0x51, 0x1a, 0x97, 0x1a, 0xd4, 0xa5, 0xc0, 0x10, 0x42,
+	0x5f, 0xc2, 0xa9, 0x90, 0x3c, 0x4a, 0x43, 0x74, 0x33, 0x66, 0xf9, 0xb8, 0xf4, 0xcc, 0x31, 0xce,
+	0x55, 0x2a, 0x2d, 0x39, 0x2a, 0x5b, 0x3c, 0xfa, 0xe3, 0x62, 0x15, 0x22, 0xa1, 0xf4, 0x14, 0x4e,
+	0xa4, 0xb2, 0x62, 0x24, 0x38, 0xb3, 0x42, 0x49, 0x37, 0xc1, 0x6c, 0xa6, 0x74, 0x68, 0x48, 0x8f,
+	0x06, 0x70, 0xce, 0x55, 0x1c, 0xa7, 0x52, 0xd8, 0xcc, 0x0d, 0x23, 0xc5, 0x27, 0x91, 0x30, 0xd6,
+	0x38, 0x94, 0x6c, 0x58, 0xee, 0x7a, 0x5c, 0x8a, 0x2d, 0x1a, 0xeb, 0x24, 0xda, 0x99, 0xd2, 0x93,
+	0xe7, 0xd4, 0xc9, 0xb0, 0x05, 0xcd, 0xea, 0x7e, 0xf6, 0x3f, 0x75, 0x06, 0x6f, 0x3e, 0x3e, 0x1d,
+	0xf8, 0xf5, 0xee, 0xf6, 0xf7, 0xee, 0xe7, 0x00, 0x2e, 0xfc, 0x83, 0xb6, 0x31, 0x03, 0x00, 0x00,
 }
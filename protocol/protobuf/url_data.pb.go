@@ -314,12 +314,68 @@ func (m *URLParams) GetEncodedSignature() string {
 	return ""
 }
 
+type CommunityInvite struct {
+	CommunityId          []byte   `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	ChannelUuid          string   `protobuf:"bytes,2,opt,name=channel_uuid,json=channelUuid,proto3" json:"channel_uuid,omitempty"`
+	Inviter              []byte   `protobuf:"bytes,3,opt,name=inviter,proto3" json:"inviter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityInvite) Reset()         { *m = CommunityInvite{} }
+func (m *CommunityInvite) String() string { return proto.CompactTextString(m) }
+func (*CommunityInvite) ProtoMessage()    {}
+func (*CommunityInvite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f1e15b5f0115710, []int{5}
+}
+
+func (m *CommunityInvite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityInvite.Unmarshal(m, b)
+}
+func (m *CommunityInvite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityInvite.Marshal(b, m, deterministic)
+}
+func (m *CommunityInvite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityInvite.Merge(m, src)
+}
+func (m *CommunityInvite) XXX_Size() int {
+	return xxx_messageInfo_CommunityInvite.Size(m)
+}
+func (m *CommunityInvite) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityInvite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityInvite proto.InternalMessageInfo
+
+func (m *CommunityInvite) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *CommunityInvite) GetChannelUuid() string {
+	if m != nil {
+		return m.ChannelUuid
+	}
+	return ""
+}
+
+func (m *CommunityInvite) GetInviter() []byte {
+	if m != nil {
+		return m.Inviter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Community)(nil), "protobuf.Community")
 	proto.RegisterType((*Channel)(nil), "protobuf.Channel")
 	proto.RegisterType((*User)(nil), "protobuf.User")
 	proto.RegisterType((*URLData)(nil), "protobuf.URLData")
 	proto.RegisterType((*URLParams)(nil), "protobuf.URLParams")
+	proto.RegisterType((*CommunityInvite)(nil), "protobuf.CommunityInvite")
 }
 
 func init() {
@@ -327,27 +383,30 @@ func init() {
 }
 
 var fileDescriptor_5f1e15b5f0115710 = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x15, 0xda, 0xb4, 0xe4, 0xd2, 0x54, 0x60, 0x3a, 0x78, 0x23, 0xa4, 0x4b, 0x24, 0xa4,
-	0x20, 0x60, 0x64, 0xa3, 0x2c, 0x48, 0x15, 0x42, 0x46, 0x59, 0x58, 0x22, 0x27, 0x31, 0xc5, 0x28,
-	0xb6, 0x2b, 0xc7, 0x19, 0xfa, 0x4e, 0xbc, 0x04, 0x6f, 0x86, 0x70, 0x62, 0xca, 0xc2, 0xd6, 0x29,
-	0x77, 0x9f, 0x4e, 0x7f, 0x3e, 0xfd, 0x32, 0xcc, 0x3b, 0xdd, 0x14, 0x35, 0x35, 0x34, 0xdb, 0x6a,
-	0x65, 0x14, 0x3a, 0xb6, 0x9f, 0xb2, 0x7b, 0x4b, 0x3e, 0x3d, 0x08, 0x56, 0x4a, 0x88, 0x4e, 0x72,
-	0xb3, 0x43, 0x17, 0x30, 0xab, 0x79, 0xbb, 0x6d, 0xe8, 0xae, 0x90, 0x54, 0x30, 0xec, 0xc5, 0x5e,
-	0x1a, 0x90, 0x70, 0x60, 0x4f, 0x54, 0x30, 0x14, 0x43, 0x58, 0xb3, 0xb6, 0xd2, 0x7c, 0x6b, 0xb8,
-	0x92, 0xf8, 0x68, 0xb8, 0xd8, 0x23, 0xb4, 0x84, 0x48, 0x30, 0x51, 0x32, 0xdd, 0x16, 0x95, 0xea,
-	0xa4, 0xc1, 0xa3, 0xd8, 0x4b, 0x23, 0x32, 0x1b, 0xe0, 0xea, 0x87, 0xa1, 0x05, 0xf8, 0x95, 0x6a,
-	0x94, 0xc6, 0x63, 0x1b, 0xd0, 0x2f, 0xe8, 0x1c, 0x42, 0x43, 0x37, 0x05, 0x97, 0x35, 0xaf, 0x58,
-	0x8b, 0xfd, 0x78, 0x94, 0x46, 0x04, 0x0c, 0xdd, 0x3c, 0xf6, 0x24, 0xf9, 0xf2, 0x60, 0xba, 0x7a,
-	0xa7, 0x52, 0xb2, 0xe6, 0x30, 0xb2, 0x0b, 0xf0, 0x99, 0x50, 0x1f, 0xdc, 0x4a, 0x06, 0xa4, 0x5f,
-	0xfe, 0xb1, 0xbb, 0x86, 0xa0, 0x72, 0x55, 0x61, 0x3f, 0xf6, 0xd2, 0xf0, 0xe6, 0x2c, 0x73, 0x4d,
-	0x66, 0xbf, 0x2d, 0x92, 0xfd, 0x15, 0x42, 0x30, 0xee, 0x3a, 0x5e, 0xe3, 0x89, 0xcd, 0xb1, 0x73,
-	0x42, 0x61, 0x9c, 0xb7, 0x4c, 0x1f, 0xcc, 0xbf, 0x37, 0x1d, 0xfd, 0x31, 0x4d, 0x96, 0x30, 0xcd,
-	0xc9, 0xfa, 0x81, 0x1a, 0x8a, 0x30, 0x4c, 0x2b, 0x25, 0x0d, 0x93, 0xc6, 0xfe, 0x60, 0x46, 0xdc,
-	0x9a, 0x94, 0x10, 0xe4, 0x64, 0xfd, 0x4c, 0x35, 0x15, 0x2d, 0x4a, 0xe1, 0x84, 0xc9, 0x4a, 0xd5,
-	0xac, 0x2e, 0xdc, 0x5b, 0x19, 0x84, 0xe6, 0x03, 0xcf, 0x75, 0x63, 0x03, 0x2f, 0xe1, 0xd4, 0x5d,
-	0xb6, 0x7c, 0x23, 0xa9, 0xe9, 0x34, 0x1b, 0xcc, 0x5c, 0xc4, 0x8b, 0xe3, 0xf7, 0xd1, 0x6b, 0x98,
-	0x5d, 0xdd, 0xb9, 0x8e, 0xca, 0x89, 0x9d, 0x6e, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xcf,
-	0x99, 0x77, 0x90, 0x02, 0x00, 0x00,
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xb1, 0x8e, 0xd4, 0x30,
+	0x10, 0x86, 0x15, 0x76, 0x73, 0x4b, 0x26, 0xc9, 0x01, 0xe6, 0x0a, 0x77, 0x84, 0x5c, 0x13, 0x09,
+	0x29, 0x08, 0x28, 0xe9, 0x58, 0x9a, 0x95, 0x4e, 0x08, 0x19, 0xa5, 0xa1, 0x89, 0x9c, 0xd8, 0x2c,
+	0x46, 0xb1, 0xbd, 0x38, 0x36, 0xd2, 0xbd, 0x13, 0x2f, 0xc1, 0x9b, 0xa1, 0x75, 0xec, 0x1c, 0x0d,
+	0xdd, 0x56, 0xf1, 0x7c, 0x1a, 0x4d, 0xbe, 0xf9, 0x6d, 0xb8, 0x76, 0x66, 0xea, 0x19, 0xb5, 0xb4,
+	0x3d, 0x19, 0x6d, 0x35, 0x7a, 0xec, 0x3f, 0x83, 0xfb, 0x56, 0xff, 0x4e, 0x20, 0xdb, 0x6b, 0x29,
+	0x9d, 0x12, 0xf6, 0x1e, 0xbd, 0x84, 0x82, 0x89, 0xf9, 0x34, 0xd1, 0xfb, 0x5e, 0x51, 0xc9, 0x71,
+	0x52, 0x25, 0x4d, 0x46, 0xf2, 0xc0, 0x3e, 0x51, 0xc9, 0x51, 0x05, 0x39, 0xe3, 0xf3, 0x68, 0xc4,
+	0xc9, 0x0a, 0xad, 0xf0, 0xa3, 0xd0, 0xf1, 0x80, 0xd0, 0x2d, 0x94, 0x92, 0xcb, 0x81, 0x9b, 0xb9,
+	0x1f, 0xb5, 0x53, 0x16, 0x6f, 0xaa, 0xa4, 0x29, 0x49, 0x11, 0xe0, 0xfe, 0xcc, 0xd0, 0x0d, 0xa4,
+	0xa3, 0x9e, 0xb4, 0xc1, 0x5b, 0x3f, 0x60, 0x29, 0xd0, 0x0b, 0xc8, 0x2d, 0x3d, 0xf6, 0x42, 0x31,
+	0x31, 0xf2, 0x19, 0xa7, 0xd5, 0xa6, 0x29, 0x09, 0x58, 0x7a, 0x3c, 0x2c, 0xa4, 0xfe, 0x93, 0xc0,
+	0x6e, 0xff, 0x9d, 0x2a, 0xc5, 0xa7, 0xcb, 0xc8, 0xde, 0x40, 0xca, 0xa5, 0xfe, 0x21, 0xbc, 0x64,
+	0x46, 0x96, 0xe2, 0x3f, 0x76, 0x6f, 0x20, 0x1b, 0x63, 0x54, 0x38, 0xad, 0x92, 0x26, 0x7f, 0xfb,
+	0xbc, 0x8d, 0x49, 0xb6, 0x6b, 0x8a, 0xe4, 0xa1, 0x0b, 0x21, 0xd8, 0x3a, 0x27, 0x18, 0xbe, 0xf2,
+	0x73, 0xfc, 0xb9, 0xa6, 0xb0, 0xed, 0x66, 0x6e, 0x2e, 0xe6, 0xbf, 0x98, 0x6e, 0xfe, 0x31, 0xad,
+	0x6f, 0x61, 0xd7, 0x91, 0xbb, 0x8f, 0xd4, 0x52, 0x84, 0x61, 0x37, 0x6a, 0x65, 0xb9, 0xb2, 0xfe,
+	0x07, 0x05, 0x89, 0x65, 0x3d, 0x40, 0xd6, 0x91, 0xbb, 0xcf, 0xd4, 0x50, 0x39, 0xa3, 0x06, 0x9e,
+	0x72, 0x35, 0x6a, 0xc6, 0x59, 0x1f, 0xdf, 0x4a, 0x10, 0xba, 0x0e, 0xbc, 0x33, 0x93, 0x1f, 0xf8,
+	0x0a, 0x9e, 0xc5, 0xce, 0x59, 0x1c, 0x15, 0xb5, 0xce, 0xf0, 0x60, 0x16, 0x47, 0x7c, 0x89, 0xbc,
+	0xfe, 0x09, 0x4f, 0xd6, 0x5c, 0x0e, 0xea, 0x97, 0xb0, 0xfc, 0xbc, 0xf6, 0x9a, 0x4f, 0x2f, 0x58,
+	0xb0, 0xca, 0x57, 0x76, 0x60, 0xbe, 0x65, 0xb9, 0xe4, 0xde, 0xa7, 0x17, 0xf6, 0x0e, 0xac, 0x73,
+	0x82, 0x9d, 0xd7, 0x12, 0x7e, 0xde, 0xb2, 0x79, 0x41, 0x62, 0xf9, 0xa1, 0xfc, 0x9a, 0xb7, 0xaf,
+	0xdf, 0xc7, 0x6b, 0x19, 0xae, 0xfc, 0xe9, 0xdd, 0xdf, 0x01, 0x00, 0x25, 0x3a, 0x15, 0xb0, 0x03,
+	0x03, 0x00, 0x00,
 }
@@ -13,6 +13,7 @@ import (
 	"io"
 	"math"
 	mrand "math/rand"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -121,6 +122,9 @@ type RegistrationOptions struct {
 	MutedChatIDs   []string
 	BlockedChatIDs []string
 	ContactIDs     []*ecdsa.PublicKey
+	// ChatPreferences are the notification levels of the chats which don't
+	// get every notification, so that the server doesn't wake the device up
+	ChatPreferences map[string]protobuf.PushNotificationChatPreference_Level
 }
 
 func (s *SentNotification) HashedPublicKey() []byte {
@@ -142,6 +146,10 @@ type Config struct {
 	// BlockMentions indicates whether we should not receive notification for mentions
 	BlockMentions bool
 
+	// CollapseNotifications indicates whether the server should group the
+	// notifications of a chat in a single summary
+	CollapseNotifications bool
+
 	// InstallationID is the installation-id for this device
 	InstallationID string
 
@@ -660,6 +668,26 @@ func (c *Client) DisablePushNotificationsBlockMentions(options *RegistrationOpti
 	return nil
 }
 
+func (c *Client) EnablePushNotificationsCollapse(options *RegistrationOptions) error {
+	c.config.Logger.Debug("enabling collapsed push notifications")
+	c.config.CollapseNotifications = true
+	if c.lastPushNotificationRegistration != nil && c.config.RemoteNotificationsEnabled {
+		c.config.Logger.Debug("re-registering after enabling collapsed push notifications")
+		return c.Register(c.deviceToken, c.apnTopic, c.tokenType, options)
+	}
+	return nil
+}
+
+func (c *Client) DisablePushNotificationsCollapse(options *RegistrationOptions) error {
+	c.config.Logger.Debug("disabling collapsed push notifications")
+	c.config.CollapseNotifications = false
+	if c.lastPushNotificationRegistration != nil && c.config.RemoteNotificationsEnabled {
+		c.config.Logger.Debug("re-registering after disabling collapsed push notifications")
+		return c.Register(c.deviceToken, c.apnTopic, c.tokenType, options)
+	}
+	return nil
+}
+
 func encryptAccessToken(plaintext []byte, key []byte, reader io.Reader) ([]byte, error) {
 	c, err := aes.NewCipher(key)
 	if err != nil {
@@ -1088,6 +1116,24 @@ func (c *Client) chatIDsHashes(chatIDs []string) [][]byte {
 	return mutedChatListHashes
 }
 
+func (c *Client) chatPreferences(preferences map[string]protobuf.PushNotificationChatPreference_Level) []*protobuf.PushNotificationChatPreference {
+	chatIDs := make([]string, 0, len(preferences))
+	for chatID := range preferences {
+		chatIDs = append(chatIDs, chatID)
+	}
+	// Keep the registration stable between identical options
+	sort.Strings(chatIDs)
+
+	var result []*protobuf.PushNotificationChatPreference
+	for _, chatID := range chatIDs {
+		result = append(result, &protobuf.PushNotificationChatPreference{
+			ChatId: common.Shake256([]byte(chatID)),
+			Level:  preferences[chatID],
+		})
+	}
+	return result
+}
+
 func (c *Client) encryptToken(publicKey *ecdsa.PublicKey, token []byte) ([]byte, error) {
 	sharedKey, err := ecies.ImportECDSA(c.config.Identity).GenerateShared(
 		ecies.ImportECDSAPublic(publicKey),
@@ -1178,6 +1224,8 @@ func (c *Client) buildPushNotificationRegistrationMessage(options *RegistrationO
 		AllowedMentionsChatList: c.chatIDsHashes(options.PublicChatIDs),
 		AllowedKeyList:          allowedKeyList,
 		MutedChatList:           c.chatIDsHashes(options.MutedChatIDs),
+		ChatPreferences:         c.chatPreferences(options.ChatPreferences),
+		CollapseNotifications:   c.config.CollapseNotifications,
 	}, nil
 }
 
@@ -2,7 +2,9 @@ package pushnotificationserver
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 
@@ -15,11 +17,17 @@ import (
 const defaultNewMessageNotificationText = "You have a new message"
 const defaultMentionNotificationText = "Someone mentioned you"
 const defaultRequestToJoinCommunityNotificationText = "Someone requested to join a community you are an admin of"
+const defaultCollapsedNotificationText = "You have %d new messages"
+const collapseIDLength = 16
 
 type GoRushRequestData struct {
 	EncryptedMessage string `json:"encryptedMessage"`
 	ChatID           string `json:"chatId"`
 	PublicKey        string `json:"publicKey"`
+	// EncryptedMessages are the messages grouped in a collapsed
+	// notification, each one encrypted for the device
+	EncryptedMessages []string `json:"encryptedMessages,omitempty"`
+	Count             int      `json:"count,omitempty"`
 }
 
 type GoRushRequestNotification struct {
@@ -28,6 +36,10 @@ type GoRushRequestNotification struct {
 	Message  string             `json:"message"`
 	Topic    string             `json:"topic"`
 	Data     *GoRushRequestData `json:"data"`
+	// CollapseID replaces the previous notification of the chat on APNs
+	CollapseID string `json:"collapse_id,omitempty"`
+	// CollapseKey replaces the previous notification of the chat on FCM
+	CollapseKey string `json:"collapse_key,omitempty"`
 }
 
 type GoRushRequest struct {
@@ -49,11 +61,45 @@ func tokenTypeToGoRushPlatform(tokenType protobuf.PushNotificationRegistration_T
 	return 0
 }
 
+// collapsible returns whether the notification can be grouped with the other
+// notifications of its chat
+func collapsible(requestAndRegistration *RequestAndRegistration) bool {
+	request := requestAndRegistration.Request
+	return requestAndRegistration.Registration.CollapseNotifications &&
+		(request.Type == protobuf.PushNotification_MESSAGE || request.Type == protobuf.PushNotification_MENTION)
+}
+
+// collapseID identifies the notifications of a chat on the device, APNs
+// limits it to 64 bytes
+func collapseID(chatID []byte) string {
+	if len(chatID) > collapseIDLength {
+		chatID = chatID[:collapseIDLength]
+	}
+	return hex.EncodeToString(chatID)
+}
+
 func PushNotificationRegistrationToGoRushRequest(requestAndRegistrations []*RequestAndRegistration) *GoRushRequest {
 	goRushRequests := &GoRushRequest{}
+	// collapsed notifications by device token and chat
+	collapsed := make(map[string]*GoRushRequestNotification)
 	for _, requestAndRegistration := range requestAndRegistrations {
 		request := requestAndRegistration.Request
 		registration := requestAndRegistration.Registration
+		encryptedMessage := types.EncodeHex(request.Message)
+
+		var collapseKey string
+		if collapsible(requestAndRegistration) {
+			collapseKey = registration.DeviceToken + types.EncodeHex(request.ChatId)
+			if notification, ok := collapsed[collapseKey]; ok {
+				// The summary carries every message, the latest one last
+				notification.Data.EncryptedMessage = encryptedMessage
+				notification.Data.EncryptedMessages = append(notification.Data.EncryptedMessages, encryptedMessage)
+				notification.Data.Count++
+				notification.Message = fmt.Sprintf(defaultCollapsedNotificationText, notification.Data.Count)
+				continue
+			}
+		}
+
 		var text string
 		if request.Type == protobuf.PushNotification_MESSAGE {
 			text = defaultNewMessageNotificationText
@@ -62,18 +108,28 @@ func PushNotificationRegistrationToGoRushRequest(requestAndRegistrations []*Requ
 		} else {
 			text = defaultMentionNotificationText
 		}
-		goRushRequests.Notifications = append(goRushRequests.Notifications,
-			&GoRushRequestNotification{
-				Tokens:   []string{registration.DeviceToken},
-				Platform: tokenTypeToGoRushPlatform(registration.TokenType),
-				Message:  text,
-				Topic:    registration.ApnTopic,
-				Data: &GoRushRequestData{
-					EncryptedMessage: types.EncodeHex(request.Message),
-					ChatID:           types.EncodeHex(request.ChatId),
-					PublicKey:        types.EncodeHex(request.PublicKey),
-				},
-			})
+		notification := &GoRushRequestNotification{
+			Tokens:   []string{registration.DeviceToken},
+			Platform: tokenTypeToGoRushPlatform(registration.TokenType),
+			Message:  text,
+			Topic:    registration.ApnTopic,
+			Data: &GoRushRequestData{
+				EncryptedMessage: encryptedMessage,
+				ChatID:           types.EncodeHex(request.ChatId),
+				PublicKey:        types.EncodeHex(request.PublicKey),
+			},
+		}
+		if collapseKey != "" {
+			notification.Data.EncryptedMessages = []string{encryptedMessage}
+			notification.Data.Count = 1
+			if registration.TokenType == protobuf.PushNotificationRegistration_APN_TOKEN {
+				notification.CollapseID = collapseID(request.ChatId)
+			} else {
+				notification.CollapseKey = collapseID(request.ChatId)
+			}
+			collapsed[collapseKey] = notification
+		}
+		goRushRequests.Notifications = append(goRushRequests.Notifications, notification)
 	}
 	return goRushRequests
 }
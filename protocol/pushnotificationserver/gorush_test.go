@@ -1,6 +1,8 @@
 package pushnotificationserver
 
 import (
+	"encoding/hex"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -108,3 +110,80 @@ func TestPushNotificationRegistrationToGoRushRequest(t *testing.T) {
 	actualRequests := PushNotificationRegistrationToGoRushRequest(requestAndRegistrations)
 	require.Equal(t, expectedRequests, actualRequests)
 }
+
+func TestCollapsedPushNotificationRegistrationToGoRushRequest(t *testing.T) {
+	message1 := []byte("message-1")
+	message2 := []byte("message-2")
+	message3 := []byte("message-3")
+	chatID1 := []byte("chat-id-1")
+	chatID2 := []byte("chat-id-2")
+	publicKey := []byte("public-key")
+	registration := &protobuf.PushNotificationRegistration{
+		DeviceToken:           "token",
+		TokenType:             protobuf.PushNotificationRegistration_APN_TOKEN,
+		CollapseNotifications: true,
+	}
+
+	requestAndRegistrations := []*RequestAndRegistration{
+		{
+			Request: &protobuf.PushNotification{
+				ChatId:    chatID1,
+				Type:      protobuf.PushNotification_MESSAGE,
+				PublicKey: publicKey,
+				Message:   message1,
+			},
+			Registration: registration,
+		},
+		{
+			Request: &protobuf.PushNotification{
+				ChatId:    chatID2,
+				Type:      protobuf.PushNotification_MESSAGE,
+				PublicKey: publicKey,
+				Message:   message2,
+			},
+			Registration: registration,
+		},
+		{
+			Request: &protobuf.PushNotification{
+				ChatId:    chatID1,
+				Type:      protobuf.PushNotification_MENTION,
+				PublicKey: publicKey,
+				Message:   message3,
+			},
+			Registration: registration,
+		},
+	}
+
+	expectedRequests := &GoRushRequest{
+		Notifications: []*GoRushRequestNotification{
+			{
+				Tokens:     []string{"token"},
+				Platform:   1,
+				Message:    fmt.Sprintf(defaultCollapsedNotificationText, 2),
+				CollapseID: hex.EncodeToString(chatID1),
+				Data: &GoRushRequestData{
+					EncryptedMessage:  types.EncodeHex(message3),
+					EncryptedMessages: []string{types.EncodeHex(message1), types.EncodeHex(message3)},
+					Count:             2,
+					ChatID:            types.EncodeHex(chatID1),
+					PublicKey:         types.EncodeHex(publicKey),
+				},
+			},
+			{
+				Tokens:     []string{"token"},
+				Platform:   1,
+				Message:    defaultNewMessageNotificationText,
+				CollapseID: hex.EncodeToString(chatID2),
+				Data: &GoRushRequestData{
+					EncryptedMessage:  types.EncodeHex(message2),
+					EncryptedMessages: []string{types.EncodeHex(message2)},
+					Count:             1,
+					ChatID:            types.EncodeHex(chatID2),
+					PublicKey:         types.EncodeHex(publicKey),
+				},
+			},
+		},
+	}
+	actualRequests := PushNotificationRegistrationToGoRushRequest(requestAndRegistrations)
+	require.Equal(t, expectedRequests, actualRequests)
+}
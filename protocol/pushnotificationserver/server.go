@@ -524,8 +524,9 @@ func (s *Server) isMentionNotification(pn *protobuf.PushNotification) bool {
 // mentions are enabled
 // the user joined the public chat
 // the author is not blocked
+// the preference of the chat allows mentions
 func (s *Server) isValidMentionNotification(pn *protobuf.PushNotification, registration *protobuf.PushNotificationRegistration) bool {
-	return s.isMentionNotification(pn) && !registration.BlockMentions && s.contains(registration.AllowedMentionsChatList, pn.ChatId) && !s.contains(registration.BlockedChatList, pn.Author)
+	return s.isMentionNotification(pn) && !registration.BlockMentions && s.contains(registration.AllowedMentionsChatList, pn.ChatId) && !s.contains(registration.BlockedChatList, pn.Author) && s.chatPreferenceLevel(registration, pn.ChatId) != protobuf.PushNotificationChatPreference_NONE
 }
 
 func (s *Server) isMessageNotification(pn *protobuf.PushNotification) bool {
@@ -536,8 +537,20 @@ func (s *Server) isMessageNotification(pn *protobuf.PushNotification) bool {
 // this is a message
 // the chat is not muted
 // the author is not blocked
+// the preference of the chat allows every message
 func (s *Server) isValidMessageNotification(pn *protobuf.PushNotification, registration *protobuf.PushNotificationRegistration) bool {
-	return s.isMessageNotification(pn) && !s.contains(registration.BlockedChatList, pn.ChatId) && !s.contains(registration.MutedChatList, pn.ChatId) && !s.contains(registration.BlockedChatList, pn.Author)
+	return s.isMessageNotification(pn) && !s.contains(registration.BlockedChatList, pn.ChatId) && !s.contains(registration.MutedChatList, pn.ChatId) && !s.contains(registration.BlockedChatList, pn.Author) && s.chatPreferenceLevel(registration, pn.ChatId) == protobuf.PushNotificationChatPreference_ALL
+}
+
+// chatPreferenceLevel returns the level the client registered for the chat,
+// chats without a preference get every notification
+func (s *Server) chatPreferenceLevel(registration *protobuf.PushNotificationRegistration, chatID []byte) protobuf.PushNotificationChatPreference_Level {
+	for _, preference := range registration.ChatPreferences {
+		if bytes.Equal(preference.ChatId, chatID) {
+			return preference.Level
+		}
+	}
+	return protobuf.PushNotificationChatPreference_ALL
 }
 
 func (s *Server) isRequestToJoinCommunityNotification(pn *protobuf.PushNotification) bool {
@@ -705,6 +705,20 @@ func (s *ServerSuite) TestBuildPushNotificationReport() {
 		BlockedChatList:         blockedChatList,
 		AllowedMentionsChatList: allowedMentionsChatList,
 	}
+	mentionsOnlyRegistration := &protobuf.PushNotificationRegistration{
+		AccessToken:             accessToken,
+		AllowedMentionsChatList: allowedMentionsChatList,
+		ChatPreferences: []*protobuf.PushNotificationChatPreference{
+			{ChatId: chatID, Level: protobuf.PushNotificationChatPreference_MENTIONS},
+		},
+	}
+	noNotificationsRegistration := &protobuf.PushNotificationRegistration{
+		AccessToken:             accessToken,
+		AllowedMentionsChatList: allowedMentionsChatList,
+		ChatPreferences: []*protobuf.PushNotificationChatPreference{
+			{ChatId: chatID, Level: protobuf.PushNotificationChatPreference_NONE},
+		},
+	}
 
 	testCases := []struct {
 		name             string
@@ -918,6 +932,39 @@ func (s *ServerSuite) TestBuildPushNotificationReport() {
 				},
 			},
 		},
+		{
+			name:         "mentions only chat preference message",
+			pn:           validMessagePN,
+			registration: mentionsOnlyRegistration,
+			expectedResponse: &reportResult{
+				sendNotification: false,
+				report: &protobuf.PushNotificationReport{
+					Success: true,
+				},
+			},
+		},
+		{
+			name:         "mentions only chat preference mention",
+			pn:           validMentionPN,
+			registration: mentionsOnlyRegistration,
+			expectedResponse: &reportResult{
+				sendNotification: true,
+				report: &protobuf.PushNotificationReport{
+					Success: true,
+				},
+			},
+		},
+		{
+			name:         "no notifications chat preference mention",
+			pn:           validMentionPN,
+			registration: noNotificationsRegistration,
+			expectedResponse: &reportResult{
+				sendNotification: false,
+				report: &protobuf.PushNotificationReport{
+					Success: true,
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -12,6 +12,10 @@ var ErrBanUserFromCommunityInvalidUser = errors.New("ban-user-from-community: in
 type BanUserFromCommunity struct {
 	CommunityID types.HexBytes `json:"communityId"`
 	User        types.HexBytes `json:"user"`
+	// DeleteAllMessages deletes all the messages of the user from every
+	// channel of the community, for all the members
+	DeleteAllMessages bool   `json:"deleteAllMessages"`
+	Reason            string `json:"reason"`
 }
 
 func (b *BanUserFromCommunity) Validate() error {
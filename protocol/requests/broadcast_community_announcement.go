@@ -0,0 +1,36 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrBroadcastCommunityAnnouncementEmptyText = errors.New("broadcast-community-announcement: empty text")
+var ErrBroadcastCommunityAnnouncementEmptyChats = errors.New("broadcast-community-announcement: empty chat ids")
+var ErrBroadcastCommunityAnnouncementDuplicateChat = errors.New("broadcast-community-announcement: duplicate chat id")
+
+// BroadcastCommunityAnnouncement posts the same announcement to a channel of
+// each of the given communities
+type BroadcastCommunityAnnouncement struct {
+	ChatIDs []string `json:"chatIds"`
+	Text    string   `json:"text"`
+}
+
+func (b *BroadcastCommunityAnnouncement) Validate() error {
+	if len(b.Text) == 0 {
+		return ErrBroadcastCommunityAnnouncementEmptyText
+	}
+
+	if len(b.ChatIDs) == 0 {
+		return ErrBroadcastCommunityAnnouncementEmptyChats
+	}
+
+	seen := make(map[string]bool)
+	for _, chatID := range b.ChatIDs {
+		if seen[chatID] {
+			return ErrBroadcastCommunityAnnouncementDuplicateChat
+		}
+		seen[chatID] = true
+	}
+
+	return nil
+}
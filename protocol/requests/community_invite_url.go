@@ -0,0 +1,23 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrCommunityInviteURLInvalidID = errors.New("community-invite-url: invalid id")
+
+type CommunityInviteURL struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	// ChannelID is set when inviting to one of the channels of the community
+	ChannelID string `json:"channelId"`
+}
+
+func (r *CommunityInviteURL) Validate() error {
+	if len(r.CommunityID) == 0 {
+		return ErrCommunityInviteURLInvalidID
+	}
+
+	return nil
+}
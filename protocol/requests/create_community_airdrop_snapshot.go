@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrCreateCommunityAirdropSnapshotInvalidCommunityID = errors.New("create-community-airdrop-snapshot: invalid community id")
+var ErrCreateCommunityAirdropSnapshotInvalidRole = errors.New("create-community-airdrop-snapshot: invalid role")
+
+// CreateCommunityAirdropSnapshot records the members of a community eligible
+// to an airdrop. The timestamps are unix timestamps in seconds, zero values
+// don't filter
+type CreateCommunityAirdropSnapshot struct {
+	CommunityID  types.HexBytes                   `json:"communityId"`
+	Roles        []protobuf.CommunityMember_Roles `json:"roles"`
+	JoinedBefore uint64                           `json:"joinedBefore"`
+	ActiveSince  uint64                           `json:"activeSince"`
+}
+
+func (c *CreateCommunityAirdropSnapshot) Validate() error {
+	if len(c.CommunityID) == 0 {
+		return ErrCreateCommunityAirdropSnapshotInvalidCommunityID
+	}
+
+	for _, role := range c.Roles {
+		if _, ok := protobuf.CommunityMember_Roles_name[int32(role)]; !ok {
+			return ErrCreateCommunityAirdropSnapshotInvalidRole
+		}
+	}
+
+	return nil
+}
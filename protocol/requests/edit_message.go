@@ -14,6 +14,9 @@ type EditMessage struct {
 	ID          types.HexBytes                   `json:"id"`
 	Text        string                           `json:"text"`
 	ContentType protobuf.ChatMessage_ContentType `json:"content-type"`
+	// ConfirmedFilterWarnings is set once the user confirmed the warnings of
+	// the outgoing message filters
+	ConfirmedFilterWarnings bool `json:"confirmedFilterWarnings,omitempty"`
 }
 
 func (e *EditMessage) Validate() error {
@@ -0,0 +1,48 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrExportChatHistoryInvalidChatID = errors.New("export-chat-history: invalid chat id")
+var ErrExportChatHistoryInvalidFormat = errors.New("export-chat-history: invalid format")
+var ErrExportChatHistoryInvalidPath = errors.New("export-chat-history: invalid path")
+var ErrExportChatHistoryInvalidTimeRange = errors.New("export-chat-history: invalid time range")
+
+type ExportChatHistoryFormat string
+
+const (
+	ExportChatHistoryFormatJSON ExportChatHistoryFormat = "json"
+	ExportChatHistoryFormatHTML ExportChatHistoryFormat = "html"
+)
+
+type ExportChatHistory struct {
+	ChatID string                  `json:"chatId"`
+	Format ExportChatHistoryFormat `json:"format"`
+	// Path is the file the history is written to
+	Path string `json:"path"`
+	// From and To are timestamps in milliseconds, a zero value leaves
+	// the range unbounded on that side
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+func (e *ExportChatHistory) Validate() error {
+	if len(e.ChatID) == 0 {
+		return ErrExportChatHistoryInvalidChatID
+	}
+
+	if e.Format != ExportChatHistoryFormatJSON && e.Format != ExportChatHistoryFormatHTML {
+		return ErrExportChatHistoryInvalidFormat
+	}
+
+	if len(e.Path) == 0 {
+		return ErrExportChatHistoryInvalidPath
+	}
+
+	if e.To != 0 && e.From > e.To {
+		return ErrExportChatHistoryInvalidTimeRange
+	}
+
+	return nil
+}
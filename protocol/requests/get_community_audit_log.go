@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrGetCommunityAuditLogInvalidID = errors.New("get-community-audit-log: invalid id")
+var ErrGetCommunityAuditLogInvalidLimit = errors.New("get-community-audit-log: invalid limit")
+
+type GetCommunityAuditLog struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	// Cursor is the cursor returned with the previous page, empty for the
+	// first one
+	Cursor string `json:"cursor"`
+	Limit  int    `json:"limit"`
+}
+
+func (g *GetCommunityAuditLog) Validate() error {
+	if len(g.CommunityID) == 0 {
+		return ErrGetCommunityAuditLogInvalidID
+	}
+
+	if g.Limit < 0 {
+		return ErrGetCommunityAuditLogInvalidLimit
+	}
+
+	return nil
+}
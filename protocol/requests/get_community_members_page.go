@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrGetCommunityMembersPageInvalidID = errors.New("get-community-members-page: invalid id")
+var ErrGetCommunityMembersPageInvalidLimit = errors.New("get-community-members-page: invalid limit")
+
+type GetCommunityMembersPage struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	// ChatID pages the members of the chat instead of the community's
+	ChatID string `json:"chatId"`
+	// Cursor is the NextCursor of the previous page, empty for the first one
+	Cursor string `json:"cursor"`
+	Limit  int    `json:"limit"`
+}
+
+func (g *GetCommunityMembersPage) Validate() error {
+	if len(g.CommunityID) == 0 {
+		return ErrGetCommunityMembersPageInvalidID
+	}
+
+	if g.Limit < 0 {
+		return ErrGetCommunityMembersPageInvalidLimit
+	}
+
+	return nil
+}
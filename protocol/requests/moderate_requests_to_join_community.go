@@ -0,0 +1,45 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var (
+	ErrModerateRequestsToJoinCommunityInvalidCommunityID = errors.New("moderate-requests-to-join-community: invalid community id")
+	ErrModerateRequestsToJoinCommunityNoRequests         = errors.New("moderate-requests-to-join-community: no requests")
+	ErrModerateRequestsToJoinCommunityInvalidID          = errors.New("moderate-requests-to-join-community: invalid id")
+	ErrModerateRequestsToJoinCommunityDuplicatedID       = errors.New("moderate-requests-to-join-community: duplicated id")
+)
+
+// ModerateRequestsToJoinCommunity accepts and declines pending requests to
+// join a community at once
+type ModerateRequestsToJoinCommunity struct {
+	CommunityID types.HexBytes   `json:"communityId"`
+	Accept      []types.HexBytes `json:"accept"`
+	Decline     []types.HexBytes `json:"decline"`
+}
+
+func (m *ModerateRequestsToJoinCommunity) Validate() error {
+	if len(m.CommunityID) == 0 {
+		return ErrModerateRequestsToJoinCommunityInvalidCommunityID
+	}
+
+	if len(m.Accept) == 0 && len(m.Decline) == 0 {
+		return ErrModerateRequestsToJoinCommunityNoRequests
+	}
+
+	ids := make(map[string]bool, len(m.Accept)+len(m.Decline))
+	for _, id := range append(append([]types.HexBytes{}, m.Accept...), m.Decline...) {
+		if len(id) == 0 {
+			return ErrModerateRequestsToJoinCommunityInvalidID
+		}
+		if ids[id.String()] {
+			return ErrModerateRequestsToJoinCommunityDuplicatedID
+		}
+		ids[id.String()] = true
+	}
+
+	return nil
+}
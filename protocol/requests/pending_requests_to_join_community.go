@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrPendingRequestsToJoinCommunityInvalidID = errors.New("pending-requests-to-join-community: invalid id")
+
+type PendingRequestsToJoinCommunity struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	// Roles keeps the requests of users who would be granted one of the
+	// roles, ROLE_NONE standing for regular members
+	Roles []protobuf.CommunityMember_Roles `json:"roles"`
+	// Addresses keeps the requests sharing at least one of the addresses
+	Addresses []string `json:"addresses"`
+	// WithSharedAddresses keeps the requests sharing at least one address
+	WithSharedAddresses bool `json:"withSharedAddresses"`
+	// WithENSName keeps the requests of users with an ENS name
+	WithENSName bool `json:"withEnsName"`
+}
+
+func (p *PendingRequestsToJoinCommunity) Validate() error {
+	if len(p.CommunityID) == 0 {
+		return ErrPendingRequestsToJoinCommunityInvalidID
+	}
+
+	return nil
+}
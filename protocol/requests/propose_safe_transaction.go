@@ -0,0 +1,45 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrProposeSafeTransactionInvalidChatID = errors.New("propose-safe-transaction: invalid chat id")
+var ErrProposeSafeTransactionInvalidChainID = errors.New("propose-safe-transaction: invalid chain id")
+var ErrProposeSafeTransactionInvalidSafeAddress = errors.New("propose-safe-transaction: invalid safe address")
+
+// ProposeSafeTransaction shares a transaction of a Safe in a chat, so that
+// its owners sign it. The amounts are decimal strings, the nonce is read from
+// the Safe when it's empty
+type ProposeSafeTransaction struct {
+	ChatID         string `json:"chatId"`
+	Text           string `json:"text"`
+	ChainID        uint64 `json:"chainId"`
+	SafeAddress    string `json:"safeAddress"`
+	To             string `json:"to"`
+	Value          string `json:"value"`
+	Data           string `json:"data"`
+	Operation      uint32 `json:"operation"`
+	SafeTxGas      string `json:"safeTxGas"`
+	BaseGas        string `json:"baseGas"`
+	GasPrice       string `json:"gasPrice"`
+	GasToken       string `json:"gasToken"`
+	RefundReceiver string `json:"refundReceiver"`
+	Nonce          string `json:"nonce"`
+}
+
+func (p *ProposeSafeTransaction) Validate() error {
+	if len(p.ChatID) == 0 {
+		return ErrProposeSafeTransactionInvalidChatID
+	}
+
+	if p.ChainID == 0 {
+		return ErrProposeSafeTransactionInvalidChainID
+	}
+
+	if len(p.SafeAddress) == 0 {
+		return ErrProposeSafeTransactionInvalidSafeAddress
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var (
+	ErrReevaluateCommunityMembersPermissionsInvalidID = errors.New("reevaluate-community-members-permissions: invalid id")
+)
+
+type ReevaluateCommunityMembersPermissions struct {
+	CommunityID types.HexBytes
+}
+
+func (r *ReevaluateCommunityMembersPermissions) Validate() error {
+	if len(r.CommunityID) == 0 {
+		return ErrReevaluateCommunityMembersPermissionsInvalidID
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrReorderPinnedMessagesInvalidChatID = errors.New("reorder-pinned-messages: invalid chat id")
+var ErrReorderPinnedMessagesInvalidMessageIDs = errors.New("reorder-pinned-messages: invalid message ids")
+
+// ReorderPinnedMessages sets the order of the pinned messages of a chat,
+// MessageIDs are listed in the order they should be displayed
+type ReorderPinnedMessages struct {
+	ChatID     string   `json:"chatId"`
+	MessageIDs []string `json:"messageIds"`
+}
+
+func (r *ReorderPinnedMessages) Validate() error {
+	if len(r.ChatID) == 0 {
+		return ErrReorderPinnedMessagesInvalidChatID
+	}
+
+	if len(r.MessageIDs) == 0 {
+		return ErrReorderPinnedMessagesInvalidMessageIDs
+	}
+
+	seen := make(map[string]bool, len(r.MessageIDs))
+	for _, id := range r.MessageIDs {
+		if len(id) == 0 || seen[id] {
+			return ErrReorderPinnedMessagesInvalidMessageIDs
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
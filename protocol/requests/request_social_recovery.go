@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrRequestSocialRecoveryInvalidOwner = errors.New("request-social-recovery: invalid owner public key")
+var ErrRequestSocialRecoveryInvalidContacts = errors.New("request-social-recovery: no contacts")
+
+// RequestSocialRecovery asks the trusted contacts of an account to release
+// their shares to this device. Only the shares of SetID are accepted when
+// it's set, otherwise the ones of the set of the first share released
+type RequestSocialRecovery struct {
+	OwnerPublicKey string   `json:"ownerPublicKey"`
+	ContactIDs     []string `json:"contactIds"`
+	SetID          string   `json:"setId"`
+}
+
+func (r *RequestSocialRecovery) Validate() error {
+	if len(r.OwnerPublicKey) == 0 {
+		return ErrRequestSocialRecoveryInvalidOwner
+	}
+
+	if len(r.ContactIDs) == 0 {
+		return ErrRequestSocialRecoveryInvalidContacts
+	}
+
+	return nil
+}
@@ -2,18 +2,28 @@ package requests
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/status-im/status-go/eth-node/types"
 )
 
 var ErrRequestToJoinCommunityInvalidCommunityID = errors.New("request-to-join-community: invalid community id")
 var ErrRequestToJoinCommunityMissingPassword = errors.New("request-to-join-community: password is necessary when sending a list of addresses")
+var ErrRequestToJoinCommunityInvalidAirdropAddress = errors.New("request-to-join-community: airdrop address must be one of the revealed addresses")
+var ErrRequestToJoinCommunityInvalidMembershipPayment = errors.New("request-to-join-community: membership payment chain id and transaction hash are required")
 
 type RequestToJoinCommunity struct {
 	CommunityID       types.HexBytes `json:"communityId"`
 	ENSName           string         `json:"ensName"`
 	Password          string         `json:"password"`
 	AddressesToReveal []string       `json:"addressesToReveal"`
+	// AirdropAddress is the revealed address the community airdrops tokens
+	// to, the first revealed address when empty
+	AirdropAddress string `json:"airdropAddress,omitempty"`
+	// Transaction paying for the membership, for communities requiring it.
+	// The payment must have been sent from one of the revealed addresses
+	MembershipPaymentChainID uint64 `json:"membershipPaymentChainId,omitempty"`
+	MembershipPaymentTxHash  string `json:"membershipPaymentTxHash,omitempty"`
 }
 
 func (j *RequestToJoinCommunity) Validate() error {
@@ -23,6 +33,21 @@ func (j *RequestToJoinCommunity) Validate() error {
 	if len(j.AddressesToReveal) > 0 && j.Password == "" {
 		return ErrRequestToJoinCommunityMissingPassword
 	}
+	if j.AirdropAddress != "" && !j.reveals(j.AirdropAddress) {
+		return ErrRequestToJoinCommunityInvalidAirdropAddress
+	}
+	if (j.MembershipPaymentChainID == 0) != (j.MembershipPaymentTxHash == "") {
+		return ErrRequestToJoinCommunityInvalidMembershipPayment
+	}
 
 	return nil
 }
+
+func (j *RequestToJoinCommunity) reveals(address string) bool {
+	for _, revealed := range j.AddressesToReveal {
+		if strings.EqualFold(revealed, address) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package requests
+
+import (
+	"errors"
+)
+
+var (
+	ErrReviewBucketedContactRequestsNoRequests          = errors.New("review-bucketed-contact-requests: no requests")
+	ErrReviewBucketedContactRequestsInvalidContactID    = errors.New("review-bucketed-contact-requests: invalid contact id")
+	ErrReviewBucketedContactRequestsDuplicatedContactID = errors.New("review-bucketed-contact-requests: duplicated contact id")
+)
+
+// ReviewBucketedContactRequests accepts and declines the requests of the
+// contacts in the requests bucket at once
+type ReviewBucketedContactRequests struct {
+	Accept  []string `json:"accept"`
+	Decline []string `json:"decline"`
+}
+
+func (r *ReviewBucketedContactRequests) Validate() error {
+	if len(r.Accept) == 0 && len(r.Decline) == 0 {
+		return ErrReviewBucketedContactRequestsNoRequests
+	}
+
+	contactIDs := make(map[string]bool, len(r.Accept)+len(r.Decline))
+	for _, contactID := range append(append([]string{}, r.Accept...), r.Decline...) {
+		if contactID == "" {
+			return ErrReviewBucketedContactRequestsInvalidContactID
+		}
+		if contactIDs[contactID] {
+			return ErrReviewBucketedContactRequestsDuplicatedContactID
+		}
+		contactIDs[contactID] = true
+	}
+
+	return nil
+}
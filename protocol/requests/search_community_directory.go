@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrSearchCommunityDirectoryInvalidLimit = errors.New("search-community-directory: invalid limit")
+var ErrSearchCommunityDirectoryInvalidTags = errors.New("search-community-directory: invalid tags")
+
+type SearchCommunityDirectory struct {
+	Query                    string   `json:"query"`
+	Tags                     []string `json:"tags"`
+	Language                 string   `json:"language"`
+	WithoutTokenRequirements bool     `json:"withoutTokenRequirements"`
+	FeaturedOnly             bool     `json:"featuredOnly"`
+	Limit                    int      `json:"limit"`
+}
+
+func (s *SearchCommunityDirectory) Validate() error {
+	if s.Limit < 0 {
+		return ErrSearchCommunityDirectoryInvalidLimit
+	}
+
+	if !ValidateTags(s.Tags) {
+		return ErrSearchCommunityDirectoryInvalidTags
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrSearchMessagesInvalidQuery = errors.New("search-messages: invalid query")
+var ErrSearchMessagesInvalidLimit = errors.New("search-messages: invalid limit")
+
+type SearchMessages struct {
+	Query string `json:"query"`
+	// ChatIDs restricts the search to the given chats, all chats are
+	// searched if empty
+	ChatIDs []string `json:"chatIds"`
+	Limit   int      `json:"limit"`
+	Cursor  string   `json:"cursor"`
+}
+
+func (s *SearchMessages) Validate() error {
+	if len(strings.TrimSpace(s.Query)) == 0 {
+		return ErrSearchMessagesInvalidQuery
+	}
+
+	if s.Limit <= 0 {
+		return ErrSearchMessagesInvalidLimit
+	}
+
+	return nil
+}
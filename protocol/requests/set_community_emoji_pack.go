@@ -0,0 +1,70 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrSetCommunityEmojiPackInvalidCommunityID = errors.New("set-community-emoji-pack: invalid community id")
+var ErrSetCommunityEmojiPackInvalidName = errors.New("set-community-emoji-pack: invalid name")
+var ErrSetCommunityEmojiPackInvalidEmojis = errors.New("set-community-emoji-pack: invalid emojis")
+
+// CommunityEmoji is an emoji of a pack. Its image is referenced by its IPFS
+// hash, the image of an emoji already in the pack is kept when no hash is
+// given
+type CommunityEmoji struct {
+	ID        string `json:"id"`
+	Shortcode string `json:"shortcode"`
+	Hash      string `json:"hash"`
+}
+
+// SetCommunityEmojiPack creates an emoji pack, or replaces it when its ID is
+// set
+type SetCommunityEmojiPack struct {
+	CommunityID types.HexBytes    `json:"communityId"`
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Emojis      []*CommunityEmoji `json:"emojis"`
+}
+
+func (s *SetCommunityEmojiPack) Validate() error {
+	if len(s.CommunityID) == 0 {
+		return ErrSetCommunityEmojiPackInvalidCommunityID
+	}
+
+	if s.Name == "" {
+		return ErrSetCommunityEmojiPackInvalidName
+	}
+
+	if len(s.Emojis) == 0 {
+		return ErrSetCommunityEmojiPackInvalidEmojis
+	}
+
+	for _, emoji := range s.Emojis {
+		if emoji.ID == "" || emoji.Shortcode == "" {
+			return ErrSetCommunityEmojiPackInvalidEmojis
+		}
+	}
+
+	return nil
+}
+
+// ToEmojiPack returns the pack, emojis without a hash are left without image
+func (s *SetCommunityEmojiPack) ToEmojiPack() *protobuf.CommunityEmojiPack {
+	pack := &protobuf.CommunityEmojiPack{
+		Id:   s.ID,
+		Name: s.Name,
+	}
+
+	for _, emoji := range s.Emojis {
+		pack.Emojis = append(pack.Emojis, &protobuf.CommunityEmoji{
+			Id:        emoji.ID,
+			Shortcode: emoji.Shortcode,
+			Hash:      emoji.Hash,
+		})
+	}
+
+	return pack
+}
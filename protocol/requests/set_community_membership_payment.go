@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrSetCommunityMembershipPaymentInvalidCommunityID = errors.New("set-community-membership-payment: invalid community id")
+
+// SetCommunityMembershipPayment sets the amount of an ERC20 token that has
+// to be paid to join a community, an empty amount makes joining free
+type SetCommunityMembershipPayment struct {
+	CommunityID     types.HexBytes `json:"communityId"`
+	ChainID         uint64         `json:"chainId"`
+	ContractAddress string         `json:"contractAddress"`
+	Amount          string         `json:"amount"`
+	Recipient       string         `json:"recipient"`
+}
+
+func (s *SetCommunityMembershipPayment) Validate() error {
+	if len(s.CommunityID) == 0 {
+		return ErrSetCommunityMembershipPaymentInvalidCommunityID
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/images"
+)
+
+var ErrSetCommunityProfileInvalidCommunityID = errors.New("set-community-profile: invalid community id")
+
+// SetCommunityProfile sets the display name and the avatar the user has in a
+// community instead of their own. Leaving both empty removes the profile
+type SetCommunityProfile struct {
+	CommunityID types.HexBytes       `json:"communityId"`
+	DisplayName string               `json:"displayName"`
+	Image       *images.CroppedImage `json:"image,omitempty"`
+}
+
+func (s *SetCommunityProfile) Validate() error {
+	if len(s.CommunityID) == 0 {
+		return ErrSetCommunityProfileInvalidCommunityID
+	}
+	return nil
+}
+
+// ToImage returns the thumbnail of the cropped image, nil when there's none
+func (s *SetCommunityProfile) ToImage() ([]byte, error) {
+	if s.Image == nil || s.Image.ImagePath == "" {
+		return nil, nil
+	}
+
+	imgs, err := images.GenerateIdentityImages(s.Image.ImagePath, s.Image.X, s.Image.Y, s.Image.X+s.Image.Width, s.Image.Y+s.Image.Height)
+	if err != nil {
+		return nil, err
+	}
+	for _, img := range imgs {
+		if img.Name == images.SmallDimName {
+			return img.Payload, nil
+		}
+	}
+	return nil, nil
+}
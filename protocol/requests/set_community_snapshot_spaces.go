@@ -0,0 +1,40 @@
+package requests
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrSetCommunitySnapshotSpacesInvalidCommunityID = errors.New("set-community-snapshot-spaces: invalid community id")
+var ErrSetCommunitySnapshotSpacesInvalidSpace = errors.New("set-community-snapshot-spaces: invalid space")
+
+// Snapshot spaces are identified by the ENS name they were created with
+var snapshotSpaceRegexp = regexp.MustCompile(`^[a-z0-9-]+(\.[a-z0-9-]+)+$`)
+
+// SetCommunitySnapshotSpaces links Snapshot spaces to a community, they're
+// published with the community description. An empty list unlinks them
+type SetCommunitySnapshotSpaces struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	Spaces      []string       `json:"spaces"`
+}
+
+func (s *SetCommunitySnapshotSpaces) Validate() error {
+	if len(s.CommunityID) == 0 {
+		return ErrSetCommunitySnapshotSpacesInvalidCommunityID
+	}
+
+	seen := make(map[string]bool, len(s.Spaces))
+	for i, space := range s.Spaces {
+		space = strings.ToLower(strings.TrimSpace(space))
+		if len(space) > 64 || !snapshotSpaceRegexp.MatchString(space) || seen[space] {
+			return ErrSetCommunitySnapshotSpacesInvalidSpace
+		}
+		seen[space] = true
+		s.Spaces[i] = space
+	}
+
+	return nil
+}
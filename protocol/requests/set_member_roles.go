@@ -0,0 +1,39 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrSetMemberRolesInvalidCommunityID = errors.New("set-member-roles: invalid community id")
+var ErrSetMemberRolesInvalidUser = errors.New("set-member-roles: invalid user id")
+var ErrSetMemberRolesInvalidRole = errors.New("set-member-roles: invalid role")
+
+type SetMemberRoles struct {
+	CommunityID types.HexBytes                   `json:"communityId"`
+	User        types.HexBytes                   `json:"user"`
+	Roles       []protobuf.CommunityMember_Roles `json:"roles"`
+}
+
+func (s *SetMemberRoles) Validate() error {
+	if len(s.CommunityID) == 0 {
+		return ErrSetMemberRolesInvalidCommunityID
+	}
+
+	if len(s.User) == 0 {
+		return ErrSetMemberRolesInvalidUser
+	}
+
+	for _, role := range s.Roles {
+		if role == protobuf.CommunityMember_ROLE_NONE || role == protobuf.CommunityMember_ROLE_OWNER {
+			return ErrSetMemberRolesInvalidRole
+		}
+		if _, ok := protobuf.CommunityMember_Roles_name[int32(role)]; !ok {
+			return ErrSetMemberRolesInvalidRole
+		}
+	}
+
+	return nil
+}
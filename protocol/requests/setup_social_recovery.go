@@ -0,0 +1,41 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrSetupSocialRecoveryInvalidContacts = errors.New("setup-social-recovery: at least two distinct contacts are needed")
+var ErrSetupSocialRecoveryInvalidThreshold = errors.New("setup-social-recovery: invalid threshold")
+var ErrSetupSocialRecoveryInvalidPayload = errors.New("setup-social-recovery: invalid payload")
+
+// SetupSocialRecovery splits the key of the recovery payload, like the
+// recovery phrase of the account, among trusted contacts. Threshold of them
+// are needed to recover it
+type SetupSocialRecovery struct {
+	ContactIDs []string `json:"contactIds"`
+	Threshold  uint32   `json:"threshold"`
+	Payload    string   `json:"payload"`
+}
+
+func (s *SetupSocialRecovery) Validate() error {
+	seen := make(map[string]bool, len(s.ContactIDs))
+	for _, contactID := range s.ContactIDs {
+		if contactID == "" || seen[contactID] {
+			return ErrSetupSocialRecoveryInvalidContacts
+		}
+		seen[contactID] = true
+	}
+	if len(s.ContactIDs) < 2 {
+		return ErrSetupSocialRecoveryInvalidContacts
+	}
+
+	if s.Threshold < 2 || int(s.Threshold) > len(s.ContactIDs) {
+		return ErrSetupSocialRecoveryInvalidThreshold
+	}
+
+	if len(s.Payload) == 0 {
+		return ErrSetupSocialRecoveryInvalidPayload
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrUpdateCommunityBlocklistInvalidCommunityID = errors.New("update-community-blocklist: invalid community id")
+var ErrUpdateCommunityBlocklistNoChanges = errors.New("update-community-blocklist: no changes")
+var ErrUpdateCommunityBlocklistInvalidPublicKey = errors.New("update-community-blocklist: invalid public key")
+
+type CommunityBlocklistEntry struct {
+	PublicKey string `json:"publicKey"`
+	Reason    string `json:"reason"`
+}
+
+// UpdateCommunityBlocklist adds users to the blocklist of a community and
+// removes others from it. The blocklist is published with the community
+// description
+type UpdateCommunityBlocklist struct {
+	CommunityID types.HexBytes             `json:"communityId"`
+	Add         []*CommunityBlocklistEntry `json:"add"`
+	Remove      []string                   `json:"remove"`
+}
+
+func (u *UpdateCommunityBlocklist) Validate() error {
+	if len(u.CommunityID) == 0 {
+		return ErrUpdateCommunityBlocklistInvalidCommunityID
+	}
+
+	if len(u.Add) == 0 && len(u.Remove) == 0 {
+		return ErrUpdateCommunityBlocklistNoChanges
+	}
+
+	for _, entry := range u.Add {
+		if entry.PublicKey == "" {
+			return ErrUpdateCommunityBlocklistInvalidPublicKey
+		}
+	}
+	for _, publicKey := range u.Remove {
+		if publicKey == "" {
+			return ErrUpdateCommunityBlocklistInvalidPublicKey
+		}
+	}
+
+	return nil
+}
+
+func (u *UpdateCommunityBlocklist) ToBlocklistEntries() []*protobuf.CommunityBlocklistEntry {
+	var entries []*protobuf.CommunityBlocklistEntry
+	for _, entry := range u.Add {
+		entries = append(entries, &protobuf.CommunityBlocklistEntry{
+			PublicKey: entry.PublicKey,
+			Reason:    entry.Reason,
+		})
+	}
+	return entries
+}
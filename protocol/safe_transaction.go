@@ -0,0 +1,181 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/services/wallet/safe"
+)
+
+var (
+	ErrInvalidSafeTransaction         = errors.New("invalid safe transaction")
+	ErrSafeTransactionChatType        = errors.New("safe transactions can only be shared in community and group chats")
+	ErrSafeTransactionNotFound        = errors.New("safe transaction not found")
+	ErrSafeTransactionNotOwner        = errors.New("account is not an owner of the safe")
+	ErrSafeTransactionThreshold       = errors.New("not enough signatures to execute the safe transaction")
+	ErrSafeTransactionAlreadyExecuted = errors.New("safe transaction already executed")
+	ErrSafeTransactionNoWallet        = errors.New("no wallet to sign or execute safe transactions")
+)
+
+// SafeTransactionSignature is the signature of a Safe transaction shared in
+// a chat by one of the owners of the Safe
+type SafeTransactionSignature struct {
+	protobuf.SafeTransactionSignature
+
+	// From is the public key of the author of the signature
+	From string `json:"from"`
+
+	// SigPubKey is the ecdsa encoded public key of the author of the signature
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (s *SafeTransactionSignature) GetSigPubKey() *ecdsa.PublicKey {
+	return s.SigPubKey
+}
+
+// GetProtoBuf returns the struct's embedded protobuf struct
+// this function is required to implement the ChatEntity interface
+func (s *SafeTransactionSignature) GetProtobuf() proto.Message {
+	return &s.SafeTransactionSignature
+}
+
+// SetMessageType a setter for the MessageType field
+// this function is required to implement the ChatEntity interface
+func (s *SafeTransactionSignature) SetMessageType(messageType protobuf.MessageType) {
+	s.MessageType = messageType
+}
+
+// WrapGroupMessage indicates whether we should wrap this in membership information
+func (s *SafeTransactionSignature) WrapGroupMessage() bool {
+	return false
+}
+
+// SafeTransactionExecution tells that a Safe transaction shared in a chat
+// was submitted
+type SafeTransactionExecution struct {
+	protobuf.SafeTransactionExecution
+
+	// From is the public key of the user who submitted the transaction
+	From string `json:"from"`
+
+	// SigPubKey is the ecdsa encoded public key of the user who submitted the
+	// transaction
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (s *SafeTransactionExecution) GetSigPubKey() *ecdsa.PublicKey {
+	return s.SigPubKey
+}
+
+// GetProtoBuf returns the struct's embedded protobuf struct
+// this function is required to implement the ChatEntity interface
+func (s *SafeTransactionExecution) GetProtobuf() proto.Message {
+	return &s.SafeTransactionExecution
+}
+
+// SetMessageType a setter for the MessageType field
+// this function is required to implement the ChatEntity interface
+func (s *SafeTransactionExecution) SetMessageType(messageType protobuf.MessageType) {
+	s.MessageType = messageType
+}
+
+// WrapGroupMessage indicates whether we should wrap this in membership information
+func (s *SafeTransactionExecution) WrapGroupMessage() bool {
+	return false
+}
+
+// SafeTransactionStatus is the progress of a Safe transaction shared in a
+// chat, TransactionHash is set once it was submitted
+type SafeTransactionStatus struct {
+	MessageID       string         `json:"messageId"`
+	ChatID          string         `json:"chatId"`
+	SafeTxHash      types.HexBytes `json:"safeTxHash"`
+	Threshold       uint32         `json:"threshold"`
+	Signers         []string       `json:"signers"`
+	TransactionHash string         `json:"transactionHash,omitempty"`
+}
+
+func parseSafeAmount(amount string) (*big.Int, error) {
+	if amount == "" {
+		return new(big.Int), nil
+	}
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, ErrInvalidSafeTransaction
+	}
+	return value, nil
+}
+
+func parseSafeAddress(address string, optional bool) (gethcommon.Address, error) {
+	if address == "" && optional {
+		return gethcommon.Address{}, nil
+	}
+	if !gethcommon.IsHexAddress(address) {
+		return gethcommon.Address{}, ErrInvalidSafeTransaction
+	}
+	return gethcommon.HexToAddress(address), nil
+}
+
+// safeTransactionFromProposal parses the proposal, the amounts are decimal
+// strings
+func safeTransactionFromProposal(proposal *protobuf.SafeTransactionProposal) (*safe.Transaction, error) {
+	if proposal.ChainId == 0 || proposal.Operation > uint32(safe.DelegateCall) {
+		return nil, ErrInvalidSafeTransaction
+	}
+	if _, err := parseSafeAddress(proposal.SafeAddress, false); err != nil {
+		return nil, err
+	}
+
+	tx := &safe.Transaction{Data: proposal.Data, Operation: safe.Operation(proposal.Operation)}
+	var err error
+	for _, address := range []struct {
+		value    string
+		optional bool
+		dest     *gethcommon.Address
+	}{
+		{proposal.To, false, &tx.To},
+		{proposal.GasToken, true, &tx.GasToken},
+		{proposal.RefundReceiver, true, &tx.RefundReceiver},
+	} {
+		*address.dest, err = parseSafeAddress(address.value, address.optional)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, amount := range []struct {
+		value string
+		dest  **big.Int
+	}{
+		{proposal.Value, &tx.Value},
+		{proposal.SafeTxGas, &tx.SafeTxGas},
+		{proposal.BaseGas, &tx.BaseGas},
+		{proposal.GasPrice, &tx.GasPrice},
+		{proposal.Nonce, &tx.Nonce},
+	} {
+		*amount.dest, err = parseSafeAmount(amount.value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+// safeTxHash returns the hash signed by the owners of the Safe
+func safeTxHash(proposal *protobuf.SafeTransactionProposal) (gethcommon.Hash, error) {
+	tx, err := safeTransactionFromProposal(proposal)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	return tx.Hash(proposal.ChainId, gethcommon.HexToAddress(proposal.SafeAddress))
+}
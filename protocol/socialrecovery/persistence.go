@@ -0,0 +1,367 @@
+package socialrecovery
+
+import (
+	"database/sql"
+)
+
+type Persistence struct {
+	db *sql.DB
+}
+
+func NewPersistence(db *sql.DB) *Persistence {
+	return &Persistence{
+		db: db,
+	}
+}
+
+type State int
+
+const (
+	StatePending State = iota
+	StateAccepted
+	StateDeclined
+)
+
+// Trustee is a trusted contact holding a share of the recovery key of the
+// user
+type Trustee struct {
+	SetID     string `json:"setId"`
+	ContactID string `json:"contactId"`
+	State     State  `json:"state"`
+	Clock     uint64 `json:"clock"`
+}
+
+// Share is a share of the recovery key of a contact, held by the user until
+// the contact asks for it from a new device
+type Share struct {
+	OwnerID   string `json:"ownerId"`
+	SetID     string `json:"setId"`
+	Share     []byte `json:"-"`
+	Payload   []byte `json:"-"`
+	Threshold uint32 `json:"threshold"`
+	Total     uint32 `json:"total"`
+	Signature []byte `json:"-"`
+	State     State  `json:"state"`
+	Clock     uint64 `json:"clock"`
+}
+
+// Request asks the user to release the share of the owner to the new device
+// of the requester
+type Request struct {
+	RequesterID string `json:"requesterId"`
+	OwnerID     string `json:"ownerId"`
+	State       State  `json:"state"`
+	Clock       uint64 `json:"clock"`
+}
+
+// ReleasedShare is a share released by a trusted contact to this device
+type ReleasedShare struct {
+	OwnerID   string
+	ContactID string
+	SetID     string
+	Share     []byte
+	Payload   []byte
+	Threshold uint32
+	Total     uint32
+	Signature []byte
+	Clock     uint64
+}
+
+// Recovery is a recovery of the account of the owner started from this
+// device. Only the contacts asked can release a share, of the set SetID once
+// it's known
+type Recovery struct {
+	OwnerID    string
+	SetID      string
+	ContactIDs []string
+}
+
+// Progress is the number of shares released to this device to recover the
+// account of the owner, out of the ones needed
+type Progress struct {
+	OwnerID   string `json:"ownerId"`
+	Released  int    `json:"released"`
+	Threshold uint32 `json:"threshold"`
+}
+
+// SaveTrustees replaces the trustees of the previous set
+func (p *Persistence) SaveTrustees(trustees []*Trustee) error {
+	tx, err := p.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`DELETE FROM social_recovery_trustees`)
+	if err != nil {
+		return err
+	}
+	for _, trustee := range trustees {
+		_, err = tx.Exec(`INSERT INTO social_recovery_trustees(set_id, contact_id, state, clock) VALUES (?, ?, ?, ?)`,
+			trustee.SetID, trustee.ContactID, trustee.State, trustee.Clock)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Persistence) Trustees() ([]*Trustee, error) {
+	rows, err := p.db.Query(`SELECT set_id, contact_id, state, clock FROM social_recovery_trustees ORDER BY contact_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var trustees []*Trustee
+	for rows.Next() {
+		trustee := &Trustee{}
+		err = rows.Scan(&trustee.SetID, &trustee.ContactID, &trustee.State, &trustee.Clock)
+		if err != nil {
+			return nil, err
+		}
+		trustees = append(trustees, trustee)
+	}
+	return trustees, rows.Err()
+}
+
+// UpdateTrusteeState records the answer of a trustee of the set, it returns
+// false when the contact isn't a trustee of the set or the answer is older
+func (p *Persistence) UpdateTrusteeState(setID string, contactID string, state State, clock uint64) (bool, error) {
+	result, err := p.db.Exec(`UPDATE social_recovery_trustees SET state = ?, clock = ? WHERE set_id = ? AND contact_id = ? AND clock < ?`,
+		state, clock, setID, contactID, clock)
+	if err != nil {
+		return false, err
+	}
+	updated, err := result.RowsAffected()
+	return updated > 0, err
+}
+
+func (p *Persistence) SaveShare(share *Share) error {
+	_, err := p.db.Exec(`INSERT INTO social_recovery_shares(owner_id, set_id, share, payload, threshold, total, signature, state, clock) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		share.OwnerID, share.SetID, share.Share, share.Payload, share.Threshold, share.Total, share.Signature, share.State, share.Clock)
+	return err
+}
+
+const selectShares = `SELECT owner_id, set_id, share, payload, threshold, total, signature, state, clock FROM social_recovery_shares`
+
+func scanShares(rows *sql.Rows) ([]*Share, error) {
+	var shares []*Share
+	for rows.Next() {
+		share := &Share{}
+		err := rows.Scan(&share.OwnerID, &share.SetID, &share.Share, &share.Payload, &share.Threshold, &share.Total, &share.Signature, &share.State, &share.Clock)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+	return shares, rows.Err()
+}
+
+// Share returns the share held for the owner, nil if there's none
+func (p *Persistence) Share(ownerID string) (*Share, error) {
+	rows, err := p.db.Query(selectShares+` WHERE owner_id = ?`, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	shares, err := scanShares(rows)
+	if err != nil || len(shares) == 0 {
+		return nil, err
+	}
+	return shares[0], nil
+}
+
+func (p *Persistence) Shares() ([]*Share, error) {
+	rows, err := p.db.Query(selectShares + ` ORDER BY clock DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanShares(rows)
+}
+
+func (p *Persistence) DeleteShare(ownerID string) error {
+	_, err := p.db.Exec(`DELETE FROM social_recovery_shares WHERE owner_id = ?`, ownerID)
+	return err
+}
+
+func (p *Persistence) SaveRequest(request *Request) error {
+	_, err := p.db.Exec(`INSERT INTO social_recovery_requests(requester_id, owner_id, state, clock) VALUES (?, ?, ?, ?)`,
+		request.RequesterID, request.OwnerID, request.State, request.Clock)
+	return err
+}
+
+// Request returns the request of the requester for the share of the owner,
+// nil if there's none
+func (p *Persistence) Request(requesterID string, ownerID string) (*Request, error) {
+	request := &Request{RequesterID: requesterID, OwnerID: ownerID}
+	err := p.db.QueryRow(`SELECT state, clock FROM social_recovery_requests WHERE requester_id = ? AND owner_id = ?`,
+		requesterID, ownerID).Scan(&request.State, &request.Clock)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// PendingRequests returns the requests the user didn't answer yet
+func (p *Persistence) PendingRequests() ([]*Request, error) {
+	rows, err := p.db.Query(`SELECT requester_id, owner_id, state, clock FROM social_recovery_requests WHERE state = ? ORDER BY clock DESC`, StatePending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requests []*Request
+	for rows.Next() {
+		request := &Request{}
+		err = rows.Scan(&request.RequesterID, &request.OwnerID, &request.State, &request.Clock)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, rows.Err()
+}
+
+func (p *Persistence) SaveReleasedShare(share *ReleasedShare) error {
+	_, err := p.db.Exec(`INSERT INTO social_recovery_released_shares(owner_id, contact_id, set_id, share, payload, threshold, total, signature, clock) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		share.OwnerID, share.ContactID, share.SetID, share.Share, share.Payload, share.Threshold, share.Total, share.Signature, share.Clock)
+	return err
+}
+
+func (p *Persistence) ReleasedShares(ownerID string) ([]*ReleasedShare, error) {
+	rows, err := p.db.Query(`SELECT owner_id, contact_id, set_id, share, payload, threshold, total, signature, clock FROM social_recovery_released_shares WHERE owner_id = ?`, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var shares []*ReleasedShare
+	for rows.Next() {
+		share := &ReleasedShare{}
+		err = rows.Scan(&share.OwnerID, &share.ContactID, &share.SetID, &share.Share, &share.Payload, &share.Threshold, &share.Total, &share.Signature, &share.Clock)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+	return shares, rows.Err()
+}
+
+// SaveRecovery records the contacts asked to release the share of the owner,
+// along with the contacts of the previous requests. An empty set ID keeps the
+// set already known
+func (p *Persistence) SaveRecovery(ownerID string, setID string, contactIDs []string) (err error) {
+	tx, err := p.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`INSERT OR IGNORE INTO social_recovery_recoveries(owner_id) VALUES (?)`, ownerID)
+	if err != nil {
+		return err
+	}
+	if setID != "" {
+		_, err = tx.Exec(`UPDATE social_recovery_recoveries SET set_id = ? WHERE owner_id = ?`, setID, ownerID)
+		if err != nil {
+			return err
+		}
+	}
+	for _, contactID := range contactIDs {
+		_, err = tx.Exec(`INSERT INTO social_recovery_recovery_contacts(owner_id, contact_id) VALUES (?, ?)`, ownerID, contactID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Recovery returns the recovery of the account of the owner, nil if it wasn't
+// started from this device
+func (p *Persistence) Recovery(ownerID string) (*Recovery, error) {
+	recovery := &Recovery{OwnerID: ownerID}
+	err := p.db.QueryRow(`SELECT set_id FROM social_recovery_recoveries WHERE owner_id = ?`, ownerID).Scan(&recovery.SetID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := p.db.Query(`SELECT contact_id FROM social_recovery_recovery_contacts WHERE owner_id = ?`, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var contactID string
+		err = rows.Scan(&contactID)
+		if err != nil {
+			return nil, err
+		}
+		recovery.ContactIDs = append(recovery.ContactIDs, contactID)
+	}
+	return recovery, rows.Err()
+}
+
+// SetRecoverySet sets the set of the shares accepted for the recovery of the
+// owner, unless it's already known
+func (p *Persistence) SetRecoverySet(ownerID string, setID string) (bool, error) {
+	result, err := p.db.Exec(`UPDATE social_recovery_recoveries SET set_id = ? WHERE owner_id = ? AND set_id = ''`, setID, ownerID)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// ResetRecovery drops the recovery of the account of the owner started from
+// this device, along with the shares released to it, so that it can be
+// started again with other contacts or another set
+func (p *Persistence) ResetRecovery(ownerID string) (err error) {
+	tx, err := p.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`DELETE FROM social_recovery_recovery_contacts WHERE owner_id = ?`, ownerID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`DELETE FROM social_recovery_recoveries WHERE owner_id = ?`, ownerID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`DELETE FROM social_recovery_released_shares WHERE owner_id = ?`, ownerID)
+	return err
+}
@@ -0,0 +1,142 @@
+package socialrecovery
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/protocol/sqlite"
+)
+
+func TestPersistenceSuite(t *testing.T) {
+	suite.Run(t, new(PersistenceSuite))
+}
+
+type PersistenceSuite struct {
+	suite.Suite
+
+	db *Persistence
+}
+
+func (s *PersistenceSuite) SetupTest() {
+	s.db = nil
+
+	dbPath, err := ioutil.TempFile("", "")
+	s.NoError(err, "creating temp file for db")
+
+	db, err := sqlite.Open(dbPath.Name(), "", sqlite.ReducedKDFIterationsNumber)
+	s.NoError(err, "creating sqlite db instance")
+
+	s.db = NewPersistence(db)
+}
+
+func (s *PersistenceSuite) TestTrustees() {
+	s.Require().NoError(s.db.SaveTrustees([]*Trustee{
+		{SetID: "old", ContactID: "0x01", Clock: 1},
+	}))
+	s.Require().NoError(s.db.SaveTrustees([]*Trustee{
+		{SetID: "new", ContactID: "0x01", Clock: 2},
+		{SetID: "new", ContactID: "0x02", Clock: 2},
+	}))
+
+	// Answers for another set or older ones are ignored
+	updated, err := s.db.UpdateTrusteeState("old", "0x01", StateAccepted, 3)
+	s.Require().NoError(err)
+	s.Require().False(updated)
+	updated, err = s.db.UpdateTrusteeState("new", "0x01", StateAccepted, 1)
+	s.Require().NoError(err)
+	s.Require().False(updated)
+
+	updated, err = s.db.UpdateTrusteeState("new", "0x02", StateDeclined, 3)
+	s.Require().NoError(err)
+	s.Require().True(updated)
+
+	trustees, err := s.db.Trustees()
+	s.Require().NoError(err)
+	s.Require().Equal([]*Trustee{
+		{SetID: "new", ContactID: "0x01", State: StatePending, Clock: 2},
+		{SetID: "new", ContactID: "0x02", State: StateDeclined, Clock: 3},
+	}, trustees)
+}
+
+func (s *PersistenceSuite) TestShares() {
+	share := &Share{OwnerID: "0x01", SetID: "set", Share: []byte{1, 2}, Payload: []byte{3}, Threshold: 2, Total: 3, Signature: []byte{4}, Clock: 1}
+	s.Require().NoError(s.db.SaveShare(share))
+
+	retrieved, err := s.db.Share("0x01")
+	s.Require().NoError(err)
+	s.Require().Equal(share, retrieved)
+
+	s.Require().NoError(s.db.DeleteShare("0x01"))
+	retrieved, err = s.db.Share("0x01")
+	s.Require().NoError(err)
+	s.Require().Nil(retrieved)
+}
+
+func (s *PersistenceSuite) TestRequests() {
+	s.Require().NoError(s.db.SaveRequest(&Request{RequesterID: "0x02", OwnerID: "0x01", Clock: 1}))
+	s.Require().NoError(s.db.SaveRequest(&Request{RequesterID: "0x03", OwnerID: "0x01", State: StateDeclined, Clock: 2}))
+
+	requests, err := s.db.PendingRequests()
+	s.Require().NoError(err)
+	s.Require().Equal([]*Request{{RequesterID: "0x02", OwnerID: "0x01", Clock: 1}}, requests)
+
+	request, err := s.db.Request("0x03", "0x01")
+	s.Require().NoError(err)
+	s.Require().Equal(StateDeclined, request.State)
+
+	request, err = s.db.Request("0x04", "0x01")
+	s.Require().NoError(err)
+	s.Require().Nil(request)
+}
+
+func (s *PersistenceSuite) TestReleasedShares() {
+	share := &ReleasedShare{OwnerID: "0x01", ContactID: "0x02", SetID: "set", Share: []byte{1}, Payload: []byte{2}, Threshold: 2, Total: 3, Signature: []byte{3}, Clock: 1}
+	s.Require().NoError(s.db.SaveReleasedShare(share))
+	s.Require().NoError(s.db.SaveReleasedShare(&ReleasedShare{OwnerID: "0x05", ContactID: "0x02", SetID: "other", Share: []byte{1}, Payload: []byte{2}, Threshold: 2, Clock: 1}))
+
+	shares, err := s.db.ReleasedShares("0x01")
+	s.Require().NoError(err)
+	s.Require().Equal([]*ReleasedShare{share}, shares)
+}
+
+func (s *PersistenceSuite) TestRecoveries() {
+	recovery, err := s.db.Recovery("0x01")
+	s.Require().NoError(err)
+	s.Require().Nil(recovery)
+
+	s.Require().NoError(s.db.SaveRecovery("0x01", "", []string{"0x02", "0x03"}))
+	s.Require().NoError(s.db.SaveRecovery("0x01", "", []string{"0x03", "0x04"}))
+	recovery, err = s.db.Recovery("0x01")
+	s.Require().NoError(err)
+	s.Require().Equal("", recovery.SetID)
+	s.Require().ElementsMatch([]string{"0x02", "0x03", "0x04"}, recovery.ContactIDs)
+
+	// The set is only set once
+	set, err := s.db.SetRecoverySet("0x01", "set")
+	s.Require().NoError(err)
+	s.Require().True(set)
+	set, err = s.db.SetRecoverySet("0x01", "other")
+	s.Require().NoError(err)
+	s.Require().False(set)
+	recovery, err = s.db.Recovery("0x01")
+	s.Require().NoError(err)
+	s.Require().Equal("set", recovery.SetID)
+
+	// Unless it's requested
+	s.Require().NoError(s.db.SaveRecovery("0x01", "other", nil))
+	recovery, err = s.db.Recovery("0x01")
+	s.Require().NoError(err)
+	s.Require().Equal("other", recovery.SetID)
+
+	// A reset drops the recovery along with the shares released for it
+	s.Require().NoError(s.db.SaveReleasedShare(&ReleasedShare{OwnerID: "0x01", ContactID: "0x02", SetID: "other", Share: []byte{1}, Payload: []byte{2}, Threshold: 2, Clock: 1}))
+	s.Require().NoError(s.db.ResetRecovery("0x01"))
+	recovery, err = s.db.Recovery("0x01")
+	s.Require().NoError(err)
+	s.Require().Nil(recovery)
+	shares, err := s.db.ReleasedShares("0x01")
+	s.Require().NoError(err)
+	s.Require().Empty(shares)
+}
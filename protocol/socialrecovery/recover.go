@@ -0,0 +1,58 @@
+package socialrecovery
+
+import (
+	"errors"
+)
+
+var ErrNotEnoughShares = errors.New("not enough shares to recover the payload")
+
+// latestSet returns the released shares of the set most of them come from,
+// the shares of a set can't be combined with the ones of another set
+func latestSet(shares []*ReleasedShare) []*ReleasedShare {
+	sets := make(map[string][]*ReleasedShare)
+	var latest string
+	var latestClock uint64
+	for _, share := range shares {
+		sets[share.SetID] = append(sets[share.SetID], share)
+		if share.Clock > latestClock {
+			latest, latestClock = share.SetID, share.Clock
+		}
+	}
+	best := sets[latest]
+	for _, set := range sets {
+		if len(set) > len(best) {
+			best = set
+		}
+	}
+	return best
+}
+
+// RecoveryProgress returns how many shares of the owner were released to this
+// device, out of the ones needed
+func RecoveryProgress(ownerID string, shares []*ReleasedShare) *Progress {
+	set := latestSet(shares)
+	progress := &Progress{OwnerID: ownerID, Released: len(set)}
+	if len(set) > 0 {
+		progress.Threshold = set[0].Threshold
+	}
+	return progress
+}
+
+// Recover rebuilds the key from the released shares and decrypts the
+// recovery payload with it
+func Recover(shares []*ReleasedShare) ([]byte, error) {
+	set := latestSet(shares)
+	if len(set) < 2 || len(set) < int(set[0].Threshold) {
+		return nil, ErrNotEnoughShares
+	}
+
+	parts := make([][]byte, 0, len(set))
+	for _, share := range set {
+		parts = append(parts, share.Share)
+	}
+	key, err := Combine(parts)
+	if err != nil {
+		return nil, err
+	}
+	return Open(set[0].Payload, key)
+}
@@ -0,0 +1,60 @@
+package socialrecovery
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+)
+
+const keyLength = 32
+
+var ErrInvalidPayload = errors.New("invalid recovery payload")
+
+// Seal encrypts the recovery payload with a new random key, the key is then
+// split among the trusted contacts and the sealed payload sent to all of them
+func Seal(payload []byte) (sealed []byte, key []byte, err error) {
+	key = make([]byte, keyLength)
+	if _, err = rand.Read(key); err != nil {
+		return nil, nil, err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	return gcm.Seal(nonce, nonce, payload, nil), key, nil
+}
+
+// Open decrypts a sealed payload, it fails when the key wasn't rebuilt from
+// enough shares
+func Open(sealed []byte, key []byte) ([]byte, error) {
+	if len(key) != keyLength {
+		return nil, ErrInvalidPayload
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, ErrInvalidPayload
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	payload, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, ErrInvalidPayload
+	}
+	return payload, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
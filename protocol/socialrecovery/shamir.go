@@ -0,0 +1,120 @@
+package socialrecovery
+
+import (
+	"crypto/rand"
+	"errors"
+)
+
+// Shares are split over GF(2^8), one polynomial per byte of the secret. A
+// share is the values of the polynomials at a point, followed by the point
+const maxShares = 255
+
+var (
+	ErrInvalidShareCount = errors.New("invalid number of shares")
+	ErrInvalidShares     = errors.New("invalid shares")
+)
+
+var expTable, logTable [256]byte
+
+func init() {
+	// 3 generates the multiplicative group of GF(2^8) with the AES
+	// polynomial x^8 + x^4 + x^3 + x + 1
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		expTable[i] = x
+		logTable[x] = byte(i)
+		x ^= x<<1 ^ (x>>7)*0x1b
+	}
+	expTable[255] = expTable[0]
+}
+
+func mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])+int(logTable[b]))%255]
+}
+
+func div(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])-int(logTable[b])+255)%255]
+}
+
+// evaluate returns the value at x of the polynomial with the given
+// coefficients, from the constant one
+func evaluate(coefficients []byte, x byte) byte {
+	var y byte
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		y = mul(y, x) ^ coefficients[i]
+	}
+	return y
+}
+
+// Split splits the secret in parts shares, any threshold of them rebuild it
+// and fewer tell nothing about it
+func Split(secret []byte, parts int, threshold int) ([][]byte, error) {
+	if threshold < 2 || parts < threshold || parts > maxShares || len(secret) == 0 {
+		return nil, ErrInvalidShareCount
+	}
+
+	shares := make([][]byte, parts)
+	for i := range shares {
+		shares[i] = make([]byte, len(secret)+1)
+		shares[i][len(secret)] = byte(i + 1)
+	}
+
+	coefficients := make([]byte, threshold)
+	for j, b := range secret {
+		coefficients[0] = b
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, err
+		}
+		for i := range shares {
+			shares[i][j] = evaluate(coefficients, byte(i+1))
+		}
+	}
+	return shares, nil
+}
+
+// Combine rebuilds the secret from shares, they must all come from the same
+// split and be at least as many as its threshold for the secret to be right
+func Combine(shares [][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, ErrInvalidShareCount
+	}
+	length := len(shares[0])
+	if length < 2 {
+		return nil, ErrInvalidShares
+	}
+
+	xs := make([]byte, len(shares))
+	seen := make(map[byte]bool, len(shares))
+	for i, share := range shares {
+		if len(share) != length {
+			return nil, ErrInvalidShares
+		}
+		x := share[length-1]
+		if x == 0 || seen[x] {
+			return nil, ErrInvalidShares
+		}
+		seen[x] = true
+		xs[i] = x
+	}
+
+	// Lagrange interpolation at 0
+	secret := make([]byte, length-1)
+	for i, share := range shares {
+		basis := byte(1)
+		for k, x := range xs {
+			if k != i {
+				basis = mul(basis, div(x, x^xs[i]))
+			}
+		}
+		for j := range secret {
+			secret[j] ^= mul(share[j], basis)
+		}
+	}
+	return secret, nil
+}
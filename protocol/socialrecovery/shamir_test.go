@@ -0,0 +1,59 @@
+package socialrecovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitAndCombine(t *testing.T) {
+	secret := []byte("correct horse battery staple")
+
+	shares, err := Split(secret, 5, 3)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+
+	for _, subset := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}, {0, 1, 2, 3, 4}} {
+		parts := make([][]byte, 0, len(subset))
+		for _, i := range subset {
+			parts = append(parts, shares[i])
+		}
+		combined, err := Combine(parts)
+		require.NoError(t, err)
+		require.Equal(t, secret, combined)
+	}
+
+	// Fewer shares than the threshold give another secret
+	combined, err := Combine([][]byte{shares[0], shares[3]})
+	require.NoError(t, err)
+	require.NotEqual(t, secret, combined)
+
+	_, err = Combine([][]byte{shares[0], shares[0]})
+	require.ErrorIs(t, err, ErrInvalidShares)
+
+	_, err = Split(secret, 2, 3)
+	require.ErrorIs(t, err, ErrInvalidShareCount)
+}
+
+func TestRecover(t *testing.T) {
+	payload := []byte("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+
+	sealed, key, err := Seal(payload)
+	require.NoError(t, err)
+	parts, err := Split(key, 3, 2)
+	require.NoError(t, err)
+
+	released := []*ReleasedShare{{ContactID: "0x01", SetID: "set", Share: parts[0], Payload: sealed, Threshold: 2, Clock: 1}}
+	_, err = Recover(released)
+	require.ErrorIs(t, err, ErrNotEnoughShares)
+	require.Equal(t, &Progress{OwnerID: "0x00", Released: 1, Threshold: 2}, RecoveryProgress("0x00", released))
+
+	// Shares of an older set aren't combined with the latest ones
+	released = append(released,
+		&ReleasedShare{ContactID: "0x02", SetID: "old", Share: []byte{1, 2}, Payload: sealed, Threshold: 2, Clock: 0},
+		&ReleasedShare{ContactID: "0x03", SetID: "set", Share: parts[2], Payload: sealed, Threshold: 2, Clock: 2},
+	)
+	recovered, err := Recover(released)
+	require.NoError(t, err)
+	require.Equal(t, payload, recovered)
+}
@@ -0,0 +1,38 @@
+package socialrecovery
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+)
+
+var ErrInvalidShareSignature = errors.New("invalid social recovery share signature")
+
+// ShareDigest is the hash the owner signs for each share of a set, so that
+// the device recovering the account only combines shares the owner handed out
+func ShareDigest(setID string, threshold uint32, total uint32, payload []byte, share []byte) []byte {
+	counts := make([]byte, 8)
+	binary.BigEndian.PutUint32(counts[:4], threshold)
+	binary.BigEndian.PutUint32(counts[4:], total)
+	return crypto.Keccak256([]byte(setID), counts, crypto.Keccak256(payload), crypto.Keccak256(share))
+}
+
+// SignShare signs a share of the set with the chat key of the owner
+func SignShare(key *ecdsa.PrivateKey, setID string, threshold uint32, total uint32, payload []byte, share []byte) ([]byte, error) {
+	return crypto.Sign(ShareDigest(setID, threshold, total, payload, share), key)
+}
+
+// VerifyShare checks that the share was signed by the owner
+func VerifyShare(owner *ecdsa.PublicKey, signature []byte, setID string, threshold uint32, total uint32, payload []byte, share []byte) error {
+	if len(signature) != 65 {
+		return ErrInvalidShareSignature
+	}
+	signer, err := crypto.SigToPub(ShareDigest(setID, threshold, total, payload, share), signature)
+	if err != nil || !bytes.Equal(crypto.FromECDSAPub(signer), crypto.FromECDSAPub(owner)) {
+		return ErrInvalidShareSignature
+	}
+	return nil
+}
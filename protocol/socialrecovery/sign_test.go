@@ -0,0 +1,28 @@
+package socialrecovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+)
+
+func TestSignShare(t *testing.T) {
+	owner, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	signature, err := SignShare(owner, "set", 2, 3, []byte{1}, []byte{2})
+	require.NoError(t, err)
+	require.NoError(t, VerifyShare(&owner.PublicKey, signature, "set", 2, 3, []byte{1}, []byte{2}))
+
+	require.Equal(t, ErrInvalidShareSignature, VerifyShare(&other.PublicKey, signature, "set", 2, 3, []byte{1}, []byte{2}))
+	require.Equal(t, ErrInvalidShareSignature, VerifyShare(&owner.PublicKey, signature, "other", 2, 3, []byte{1}, []byte{2}))
+	require.Equal(t, ErrInvalidShareSignature, VerifyShare(&owner.PublicKey, signature, "set", 3, 3, []byte{1}, []byte{2}))
+	require.Equal(t, ErrInvalidShareSignature, VerifyShare(&owner.PublicKey, signature, "set", 2, 4, []byte{1}, []byte{2}))
+	require.Equal(t, ErrInvalidShareSignature, VerifyShare(&owner.PublicKey, signature, "set", 2, 3, []byte{3}, []byte{2}))
+	require.Equal(t, ErrInvalidShareSignature, VerifyShare(&owner.PublicKey, signature, "set", 2, 3, []byte{1}, []byte{3}))
+	require.Equal(t, ErrInvalidShareSignature, VerifyShare(&owner.PublicKey, nil, "set", 2, 3, []byte{1}, []byte{2}))
+}
@@ -0,0 +1,128 @@
+package protocol
+
+import (
+	"database/sql"
+	"sync"
+)
+
+// statementCache keeps the statements of the hot paths of the persistence
+// prepared, so that they aren't parsed again every time they're run. The
+// statements are prepared once per connection by database/sql
+type statementCache struct {
+	db      *sql.DB
+	mutex   sync.Mutex
+	stmts   map[string]*sql.Stmt
+	pending map[string]bool
+	closed  bool
+}
+
+func newStatementCache(db *sql.DB) *statementCache {
+	return &statementCache{
+		db:      db,
+		stmts:   make(map[string]*sql.Stmt),
+		pending: make(map[string]bool),
+	}
+}
+
+// prepare returns the cached statement of the query, the lock isn't held
+// while the statement is prepared as it waits for a connection
+func (c *statementCache) prepare(query string) (*sql.Stmt, error) {
+	c.mutex.Lock()
+	stmt, ok := c.stmts[query]
+	c.mutex.Unlock()
+	if ok {
+		return stmt, nil
+	}
+
+	stmt, err := c.db.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if cached, ok := c.stmts[query]; ok {
+		_ = stmt.Close()
+		return cached, nil
+	}
+	if !c.closed {
+		c.stmts[query] = stmt
+	}
+	return stmt, nil
+}
+
+// txStmt returns the statement of the query bound to the transaction, it's
+// closed along with the transaction. A statement which isn't cached yet is
+// prepared on the transaction and cached in the background, as preparing it
+// on the database waits for a connection while the transaction holds one
+func (c *statementCache) txStmt(tx *sql.Tx, query string) (*sql.Stmt, error) {
+	c.mutex.Lock()
+	stmt, ok := c.stmts[query]
+	if !ok && !c.pending[query] && !c.closed {
+		c.pending[query] = true
+		go c.prepareInBackground(query)
+	}
+	c.mutex.Unlock()
+
+	if ok {
+		return tx.Stmt(stmt), nil
+	}
+	return tx.Prepare(query)
+}
+
+func (c *statementCache) prepareInBackground(query string) {
+	stmt, err := c.db.Prepare(query)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.pending, query)
+	if err != nil {
+		return
+	}
+	if _, ok := c.stmts[query]; ok || c.closed {
+		_ = stmt.Close()
+		return
+	}
+	c.stmts[query] = stmt
+}
+
+// close closes the cached statements
+func (c *statementCache) close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.closed = true
+
+	var err error
+	for query, stmt := range c.stmts {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+		delete(c.stmts, query)
+	}
+	return err
+}
+
+// cachedTxStmt returns the cached statement of the query bound to the
+// transaction
+func (db sqlitePersistence) cachedTxStmt(tx *sql.Tx, query string) (*sql.Stmt, error) {
+	if db.stmts == nil {
+		return tx.Prepare(query)
+	}
+	return db.stmts.txStmt(tx, query)
+}
+
+// cachedQuery runs the cached statement of the query
+func (db sqlitePersistence) cachedQuery(query string, args ...interface{}) (*sql.Rows, error) {
+	if db.stmts == nil {
+		return db.db.Query(query, args...)
+	}
+
+	stmt, err := db.stmts.prepare(query)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.Query(args...)
+}
@@ -221,6 +221,10 @@ func (t *Transport) GetStats() types.StatsSummary {
 	return t.waku.GetStats()
 }
 
+func (t *Transport) BandwidthByProtocol() map[string]types.ProtocolBandwidth {
+	return t.waku.BandwidthByProtocol()
+}
+
 func (t *Transport) RetrieveRawAll() (map[Filter][]*types.Message, error) {
 	result := make(map[Filter][]*types.Message)
 	logger := t.logger.With(zap.String("site", "retrieveRawAll"))
@@ -0,0 +1,102 @@
+package urls
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var (
+	ErrInvalidCommunityInvite = errors.New("invalid community invite")
+	// ErrSpoofedCommunityInvite is returned when the invite isn't signed by
+	// the community, or the member, it claims to come from
+	ErrSpoofedCommunityInvite = errors.New("community invite not signed by its issuer")
+)
+
+// EncodeCommunityInvite encodes the invite and signs it with the key of the
+// community, or with the key of the inviter when the inviter is set. Only
+// the IDs are encoded, so that the link stays short
+func EncodeCommunityInvite(invite *protobuf.CommunityInvite, key *ecdsa.PrivateKey) (string, string, error) {
+	if len(invite.CommunityId) == 0 {
+		return "", "", ErrInvalidCommunityInvite
+	}
+
+	issuer := invite.CommunityId
+	if len(invite.Inviter) != 0 {
+		issuer = invite.Inviter
+	}
+	if !bytes.Equal(crypto.CompressPubkey(&key.PublicKey), issuer) {
+		return "", "", ErrSpoofedCommunityInvite
+	}
+
+	payload, err := proto.Marshal(invite)
+	if err != nil {
+		return "", "", err
+	}
+
+	data, err := encodeDataURL(payload)
+	if err != nil {
+		return "", "", err
+	}
+
+	signature, err := crypto.SignBytes([]byte(data), key)
+	if err != nil {
+		return "", "", err
+	}
+
+	encodedSignature, err := encodeDataURL(signature)
+	if err != nil {
+		return "", "", err
+	}
+
+	return data, encodedSignature, nil
+}
+
+// DecodeCommunityInvite decodes the invite and checks that it's signed by
+// the community, or by the inviter when the inviter is set. Whether the
+// inviter is a member of the community can only be checked once the
+// community is known
+func DecodeCommunityInvite(data string, encodedSignature string) (*protobuf.CommunityInvite, error) {
+	signature, err := decodeDataURL(encodedSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := crypto.SigToPub(crypto.Keccak256([]byte(data)), signature)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := decodeDataURL(data)
+	if err != nil {
+		return nil, err
+	}
+
+	invite := &protobuf.CommunityInvite{}
+	err = proto.Unmarshal(payload, invite)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(invite.CommunityId) == 0 {
+		return nil, ErrInvalidCommunityInvite
+	}
+	if _, err := crypto.DecompressPubkey(invite.CommunityId); err != nil {
+		return nil, ErrInvalidCommunityInvite
+	}
+
+	issuer := invite.CommunityId
+	if len(invite.Inviter) != 0 {
+		issuer = invite.Inviter
+	}
+	if !bytes.Equal(crypto.CompressPubkey(signer), issuer) {
+		return nil, ErrSpoofedCommunityInvite
+	}
+
+	return invite, nil
+}
@@ -0,0 +1,59 @@
+package urls
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestCommunityInvite(t *testing.T) {
+	communityKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	memberKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	communityID := crypto.CompressPubkey(&communityKey.PublicKey)
+	memberID := crypto.CompressPubkey(&memberKey.PublicKey)
+
+	// Signed by the community
+	invite := &protobuf.CommunityInvite{CommunityId: communityID, ChannelUuid: "e8e9d6b1-4d86-4a2b-9e6c-8f1a6d3c2b10"}
+	data, signature, err := EncodeCommunityInvite(invite, communityKey)
+	require.NoError(t, err)
+
+	decoded, err := DecodeCommunityInvite(data, signature)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(invite, decoded))
+
+	// Signed by a member
+	invite = &protobuf.CommunityInvite{CommunityId: communityID, Inviter: memberID}
+	data, signature, err = EncodeCommunityInvite(invite, memberKey)
+	require.NoError(t, err)
+
+	decoded, err = DecodeCommunityInvite(data, signature)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(invite, decoded))
+
+	// A member can't sign an invite on behalf of the community
+	_, _, err = EncodeCommunityInvite(&protobuf.CommunityInvite{CommunityId: communityID}, memberKey)
+	require.Equal(t, ErrSpoofedCommunityInvite, err)
+
+	payload, err := proto.Marshal(&protobuf.CommunityInvite{CommunityId: communityID})
+	require.NoError(t, err)
+	spoofedData, err := encodeDataURL(payload)
+	require.NoError(t, err)
+	spoofedSignature, err := crypto.SignBytes([]byte(spoofedData), memberKey)
+	require.NoError(t, err)
+	encodedSpoofedSignature, err := encodeDataURL(spoofedSignature)
+	require.NoError(t, err)
+
+	_, err = DecodeCommunityInvite(spoofedData, encodedSpoofedSignature)
+	require.Equal(t, ErrSpoofedCommunityInvite, err)
+
+	// The signature of an invite doesn't match another one
+	_, err = DecodeCommunityInvite(spoofedData, signature)
+	require.Equal(t, ErrSpoofedCommunityInvite, err)
+}
@@ -0,0 +1,71 @@
+package protocol
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	// CompressionThreshold is the size of the payloads from which they are
+	// compressed, smaller ones don't shrink enough to be worth it
+	CompressionThreshold = 1024
+	// maxDecompressedSize bounds the size of the decompressed payloads, way
+	// above the size of the biggest messages
+	maxDecompressedSize = 16 * 1024 * 1024
+)
+
+var (
+	// ErrUnknownCompression means that the payload is compressed with an
+	// algorithm we don't support
+	ErrUnknownCompression = errors.New("unknown payload compression")
+
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxDecompressedSize))
+)
+
+// CompressMessageV1 compresses the payload of a wrapped message when it's
+// worth it. The signature is left as it is, as it's of the uncompressed
+// payload, so that the ID of the message doesn't depend on the compression
+func CompressMessageV1(wrappedMessage []byte) ([]byte, error) {
+	var message protobuf.ApplicationMetadataMessage
+	err := proto.Unmarshal(wrappedMessage, &message)
+	if err != nil {
+		return nil, err
+	}
+	if message.Compression != protobuf.ApplicationMetadataMessage_UNCOMPRESSED || len(message.Payload) < CompressionThreshold {
+		return wrappedMessage, nil
+	}
+
+	compressed := zstdEncoder.EncodeAll(message.Payload, nil)
+	if len(compressed) >= len(message.Payload) {
+		return wrappedMessage, nil
+	}
+
+	message.Payload = compressed
+	message.Compression = protobuf.ApplicationMetadataMessage_ZSTD
+	return proto.Marshal(&message)
+}
+
+// DecompressMessageV1 decompresses the payload of the message in place, and
+// returns the wrapped message as it was before the compression
+func DecompressMessageV1(message *protobuf.ApplicationMetadataMessage) ([]byte, error) {
+	switch message.Compression {
+	case protobuf.ApplicationMetadataMessage_UNCOMPRESSED:
+		return proto.Marshal(message)
+	case protobuf.ApplicationMetadataMessage_ZSTD:
+	default:
+		return nil, ErrUnknownCompression
+	}
+
+	payload, err := zstdDecoder.DecodeAll(message.Payload, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decompress payload")
+	}
+
+	message.Payload = payload
+	message.Compression = protobuf.ApplicationMetadataMessage_UNCOMPRESSED
+	return proto.Marshal(message)
+}
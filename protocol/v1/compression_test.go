@@ -0,0 +1,52 @@
+package protocol
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestCompressMessageV1(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	payload := bytes.Repeat([]byte("community description "), 200)
+	wrapped, err := WrapMessageV1(payload, protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION, key)
+	require.NoError(t, err)
+
+	compressed, err := CompressMessageV1(wrapped)
+	require.NoError(t, err)
+	require.Less(t, len(compressed), len(wrapped))
+
+	message := StatusMessage{DecryptedPayload: compressed}
+	require.NoError(t, message.HandleApplicationMetadata())
+	require.Equal(t, wrapped, message.DecryptedPayload)
+	require.Equal(t, payload, message.UnwrappedPayload)
+	require.Equal(t, MessageID(&key.PublicKey, wrapped), message.ID)
+	require.Equal(t, &key.PublicKey, message.ApplicationMetadataLayerSigPubKey)
+}
+
+func TestCompressMessageV1SmallPayload(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	wrapped, err := WrapMessageV1([]byte("hello"), protobuf.ApplicationMetadataMessage_CHAT_MESSAGE, key)
+	require.NoError(t, err)
+
+	compressed, err := CompressMessageV1(wrapped)
+	require.NoError(t, err)
+	require.Equal(t, wrapped, compressed)
+}
+
+func TestDecompressMessageV1UnknownCompression(t *testing.T) {
+	message := &protobuf.ApplicationMetadataMessage{
+		Payload:     []byte("payload"),
+		Compression: protobuf.ApplicationMetadataMessage_Compression(42),
+	}
+	_, err := DecompressMessageV1(message)
+	require.Equal(t, ErrUnknownCompression, err)
+}
@@ -161,6 +161,15 @@ func (m *StatusMessage) HandleApplicationMetadata() error {
 		return err
 	}
 
+	if message.Compression != protobuf.ApplicationMetadataMessage_UNCOMPRESSED {
+		// The rest of the processing, and the ID, is of the uncompressed
+		// message
+		m.DecryptedPayload, err = DecompressMessageV1(message)
+		if err != nil {
+			return err
+		}
+	}
+
 	recoveredKey, err := message.RecoverKey()
 	if err != nil {
 		return err
@@ -243,6 +252,18 @@ func (m *StatusMessage) HandleApplication() error {
 		return m.unmarshalProtobufData(new(protobuf.PushNotificationResponse))
 	case protobuf.ApplicationMetadataMessage_EMOJI_REACTION:
 		return m.unmarshalProtobufData(new(protobuf.EmojiReaction))
+	case protobuf.ApplicationMetadataMessage_SAFE_TRANSACTION_SIGNATURE:
+		return m.unmarshalProtobufData(new(protobuf.SafeTransactionSignature))
+	case protobuf.ApplicationMetadataMessage_SAFE_TRANSACTION_EXECUTION:
+		return m.unmarshalProtobufData(new(protobuf.SafeTransactionExecution))
+	case protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE:
+		return m.unmarshalProtobufData(new(protobuf.SocialRecoveryShare))
+	case protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE_RESPONSE:
+		return m.unmarshalProtobufData(new(protobuf.SocialRecoveryShareResponse))
+	case protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_REQUEST:
+		return m.unmarshalProtobufData(new(protobuf.SocialRecoveryRequest))
+	case protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE_RELEASE:
+		return m.unmarshalProtobufData(new(protobuf.SocialRecoveryShareRelease))
 	case protobuf.ApplicationMetadataMessage_GROUP_CHAT_INVITATION:
 		return m.unmarshalProtobufData(new(protobuf.GroupChatInvitation))
 	case protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION:
@@ -318,6 +339,8 @@ func (m *StatusMessage) HandleApplication() error {
 		return m.unmarshalProtobufData((new(protobuf.SyncContactRequestDecision)))
 	case protobuf.ApplicationMetadataMessage_SYNC_SAVED_ADDRESS:
 		return m.unmarshalProtobufData(new(protobuf.SyncSavedAddress))
+	case protobuf.ApplicationMetadataMessage_SYNC_CUSTOM_TOKEN:
+		return m.unmarshalProtobufData(new(protobuf.SyncCustomToken))
 	case protobuf.ApplicationMetadataMessage_SYNC_KEYCARD_ACTION:
 		return m.unmarshalProtobufData(new(protobuf.SyncKeycardAction))
 	case protobuf.ApplicationMetadataMessage_SYNC_SOCIAL_LINKS:
@@ -326,12 +349,36 @@ func (m *StatusMessage) HandleApplication() error {
 		return m.unmarshalProtobufData(new(protobuf.SyncEnsUsernameDetail))
 	case protobuf.ApplicationMetadataMessage_SYNC_KEYPAIR:
 		return m.unmarshalProtobufData(new(protobuf.SyncKeypair))
+	case protobuf.ApplicationMetadataMessage_SYNC_ACCOUNTS_POSITIONS:
+		return m.unmarshalProtobufData(new(protobuf.SyncAccountsPositions))
 	case protobuf.ApplicationMetadataMessage_SYNC_ACTIVITY_CENTER_NOTIFICATION:
 		return m.unmarshalProtobufData(new(protobuf.SyncActivityCenterNotifications))
 	case protobuf.ApplicationMetadataMessage_SYNC_ACTIVITY_CENTER_NOTIFICATION_STATE:
 		return m.unmarshalProtobufData(new(protobuf.SyncActivityCenterNotificationState))
 	case protobuf.ApplicationMetadataMessage_COMMUNITY_ADMIN_MESSAGE:
 		return m.unmarshalProtobufData(new(protobuf.CommunityAdminEvent))
+	case protobuf.ApplicationMetadataMessage_DELETE_COMMUNITY_MEMBER_MESSAGES:
+		return m.unmarshalProtobufData(new(protobuf.DeleteCommunityMemberMessages))
+	case protobuf.ApplicationMetadataMessage_SYNC_NOTIFICATION_RULE:
+		return m.unmarshalProtobufData(new(protobuf.SyncNotificationRule))
+	case protobuf.ApplicationMetadataMessage_SYNC_CHAT_READ_STATE:
+		return m.unmarshalProtobufData(new(protobuf.SyncChatReadState))
+	case protobuf.ApplicationMetadataMessage_SYNC_CHAT_DRAFT:
+		return m.unmarshalProtobufData(new(protobuf.SyncChatDraft))
+	case protobuf.ApplicationMetadataMessage_ATTACHMENT_CHUNK:
+		return m.unmarshalProtobufData(new(protobuf.AttachmentChunk))
+	case protobuf.ApplicationMetadataMessage_ATTACHMENT_CHUNK_REQUEST:
+		return m.unmarshalProtobufData(new(protobuf.AttachmentChunkRequest))
+	case protobuf.ApplicationMetadataMessage_SYNC_MUTE:
+		return m.unmarshalProtobufData(new(protobuf.SyncMute))
+	case protobuf.ApplicationMetadataMessage_COMMUNITY_MEMBER_PROFILE:
+		return m.unmarshalProtobufData(new(protobuf.CommunityMemberProfile))
+	case protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION_DELTA:
+		return m.unmarshalProtobufData(new(protobuf.CommunityDescriptionDelta))
+	case protobuf.ApplicationMetadataMessage_COMMUNITY_DESCRIPTION_REQUEST:
+		return m.unmarshalProtobufData(new(protobuf.CommunityDescriptionRequest))
+	case protobuf.ApplicationMetadataMessage_COMMUNITY_BAN:
+		return m.unmarshalProtobufData(new(protobuf.CommunityBan))
 	}
 
 	return nil
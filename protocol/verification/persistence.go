@@ -11,7 +11,8 @@ var (
 )
 
 type Persistence struct {
-	db *sql.DB
+	db                *sql.DB
+	transitionHandler func(*Transition)
 }
 
 func NewPersistence(db *sql.DB) *Persistence {
@@ -30,6 +31,8 @@ const (
 	RequestStatusCANCELED
 	RequestStatusTRUSTED
 	RequestStatusUNTRUSTWORTHY
+	RequestStatusEXPIRED
+	RequestStatusREVOKED
 )
 
 type TrustStatus int
@@ -49,30 +52,35 @@ type Request struct {
 	RequestedAt   uint64        `json:"requested_at"`
 	RequestStatus RequestStatus `json:"verification_status"`
 	RepliedAt     uint64        `json:"replied_at"`
+	// ExpiresAt is the timestamp in milliseconds after which a pending request expires, 0 if it doesn't
+	ExpiresAt uint64 `json:"expires_at"`
 }
 
-func (p *Persistence) GetVerificationRequests() ([]Request, error) {
-	rows, err := p.db.Query("SELECT id, from_user, to_user, challenge, response, requested_at, verification_status, replied_at FROM verification_requests_individual")
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
+// Expired returns whether the request is pending past its expiration
+func (r *Request) Expired(now uint64) bool {
+	return r.RequestStatus == RequestStatusPENDING && r.ExpiresAt != 0 && r.ExpiresAt <= now
+}
 
-	var result []Request
-	for rows.Next() {
-		var vr Request
-		err = rows.Scan(&vr.ID, &vr.From, &vr.To, &vr.Challenge, &vr.Response, &vr.RequestedAt, &vr.RepliedAt, &vr.RequestStatus)
-		if err != nil {
-			return nil, err
-		}
-		result = append(result, vr)
-	}
-	return result, nil
+// Transition is a change of status of a verification request. The transitions of the requests exchanged
+// with a contact are its verification audit trail
+type Transition struct {
+	RequestID      string        `json:"request_id"`
+	From           string        `json:"from"`
+	To             string        `json:"to"`
+	PreviousStatus RequestStatus `json:"previous_status"`
+	Status         RequestStatus `json:"status"`
+	Timestamp      uint64        `json:"timestamp"`
 }
 
-func (p *Persistence) GetVerificationRequest(id string) (*Request, error) {
+const requestColumns = "id, from_user, to_user, challenge, response, requested_at, verification_status, replied_at, expires_at"
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRequest(row scanner) (*Request, error) {
 	var vr Request
-	err := p.db.QueryRow(`SELECT id, from_user, to_user, challenge, response, requested_at, verification_status, replied_at FROM verification_requests_individual WHERE id = ?`, id).Scan(
+	err := row.Scan(
 		&vr.ID,
 		&vr.From,
 		&vr.To,
@@ -81,140 +89,222 @@ func (p *Persistence) GetVerificationRequest(id string) (*Request, error) {
 		&vr.RequestedAt,
 		&vr.RequestStatus,
 		&vr.RepliedAt,
+		&vr.ExpiresAt,
 	)
+	if err != nil {
+		return nil, err
+	}
+	return &vr, nil
+}
 
+func queryRequest(row *sql.Row) (*Request, error) {
+	vr, err := scanRequest(row)
 	switch err {
 	case sql.ErrNoRows:
 		return nil, nil
 	case nil:
-		return &vr, nil
+		return vr, nil
 	default:
 		return nil, err
 	}
 }
 
+// SetTransitionHandler sets the handler called with each transition once it's persisted
+func (p *Persistence) SetTransitionHandler(handler func(*Transition)) {
+	p.transitionHandler = handler
+}
+
+func (p *Persistence) GetVerificationRequests() ([]Request, error) {
+	rows, err := p.db.Query("SELECT " + requestColumns + " FROM verification_requests_individual")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []Request
+	for rows.Next() {
+		vr, err := scanRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *vr)
+	}
+	return result, nil
+}
+
+func (p *Persistence) GetVerificationRequest(id string) (*Request, error) {
+	return queryRequest(p.db.QueryRow(`SELECT `+requestColumns+` FROM verification_requests_individual WHERE id = ?`, id))
+}
+
 func (p *Persistence) GetReceivedVerificationRequests(myPublicKey string) ([]*Request, error) {
 	response := make([]*Request, 0)
 
-	query := `SELECT id, from_user, to_user, challenge, response, requested_at, verification_status, replied_at FROM verification_requests_individual WHERE to_user = ?`
+	query := `SELECT ` + requestColumns + ` FROM verification_requests_individual WHERE to_user = ?`
 	rows, err := p.db.Query(query, myPublicKey)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
-		var vr Request
-
-		err := rows.Scan(
-			&vr.ID,
-			&vr.From,
-			&vr.To,
-			&vr.Challenge,
-			&vr.Response,
-			&vr.RequestedAt,
-			&vr.RequestStatus,
-			&vr.RepliedAt,
-		)
+		vr, err := scanRequest(rows)
 		if err != nil {
 			return nil, err
 		}
 
-		response = append(response, &vr)
+		response = append(response, vr)
 	}
 
 	return response, nil
 }
 
 func (p *Persistence) GetLatestVerificationRequestSentTo(contactID string) (*Request, error) {
-	var vr Request
-	err := p.db.QueryRow(`SELECT id, from_user, to_user, challenge, response, requested_at, verification_status, replied_at FROM verification_requests_individual WHERE to_user = ? ORDER BY requested_at DESC`, contactID).Scan(
-		&vr.ID,
-		&vr.From,
-		&vr.To,
-		&vr.Challenge,
-		&vr.Response,
-		&vr.RequestedAt,
-		&vr.RequestStatus,
-		&vr.RepliedAt,
-	)
+	return queryRequest(p.db.QueryRow(`SELECT `+requestColumns+` FROM verification_requests_individual WHERE to_user = ? ORDER BY requested_at DESC`, contactID))
+}
 
-	switch err {
-	case sql.ErrNoRows:
-		return nil, nil
-	case nil:
-		return &vr, nil
-	default:
+func (p *Persistence) GetLatestVerificationRequestFrom(contactID string) (*Request, error) {
+	return queryRequest(p.db.QueryRow(`SELECT `+requestColumns+` FROM verification_requests_individual WHERE from_user = ? ORDER BY requested_at DESC`, contactID))
+}
+
+// GetExpiredVerificationRequests returns the pending requests expired at now
+func (p *Persistence) GetExpiredVerificationRequests(now uint64) ([]*Request, error) {
+	rows, err := p.db.Query(`SELECT `+requestColumns+` FROM verification_requests_individual WHERE verification_status = ? AND expires_at > 0 AND expires_at <= ?`, RequestStatusPENDING, now)
+	if err != nil {
 		return nil, err
 	}
-}
+	defer rows.Close()
 
-func (p *Persistence) GetLatestVerificationRequestFrom(contactID string) (*Request, error) {
-	var vr Request
-	err := p.db.QueryRow(`SELECT id, from_user, to_user, challenge, response, requested_at, verification_status, replied_at FROM verification_requests_individual WHERE from_user = ? ORDER BY requested_at DESC`, contactID).Scan(
-		&vr.ID,
-		&vr.From,
-		&vr.To,
-		&vr.Challenge,
-		&vr.Response,
-		&vr.RequestedAt,
-		&vr.RequestStatus,
-		&vr.RepliedAt,
-	)
+	var result []*Request
+	for rows.Next() {
+		vr, err := scanRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, vr)
+	}
+	return result, nil
+}
 
-	switch err {
-	case sql.ErrNoRows:
+// saveTransition records the transition of the request to status, if its status changed
+func saveTransition(tx *sql.Tx, id string, from string, to string, previousStatus RequestStatus, status RequestStatus) (*Transition, error) {
+	if previousStatus == status {
 		return nil, nil
-	case nil:
-		return &vr, nil
-	default:
+	}
+
+	t := &Transition{
+		RequestID:      id,
+		From:           from,
+		To:             to,
+		PreviousStatus: previousStatus,
+		Status:         status,
+		Timestamp:      uint64(time.Now().UnixMilli()),
+	}
+	_, err := tx.Exec(`INSERT INTO verification_requests_audit (request_id, from_user, to_user, previous_status, status, timestamp) VALUES (?, ?, ?, ?, ?, ?)`, t.RequestID, t.From, t.To, t.PreviousStatus, t.Status, t.Timestamp)
+	if err != nil {
 		return nil, err
 	}
+	return t, nil
 }
 
-func (p *Persistence) SaveVerificationRequest(vr *Request) error {
-	if vr == nil {
-		return errors.New("invalid verification request provided")
+func requestStatus(tx *sql.Tx, id string) (RequestStatus, error) {
+	var status RequestStatus
+	err := tx.QueryRow(`SELECT verification_status FROM verification_requests_individual WHERE id = ?`, id).Scan(&status)
+	if err == sql.ErrNoRows {
+		return RequestStatusUNKNOWN, nil
 	}
-	_, err := p.db.Exec(`INSERT INTO verification_requests_individual (id, from_user, to_user, challenge, response, requested_at, verification_status, replied_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`, vr.ID, vr.From, vr.To, vr.Challenge, vr.Response, vr.RequestedAt, vr.RequestStatus, vr.RepliedAt)
-	return err
+	return status, err
 }
 
-func (p *Persistence) AcceptContactVerificationRequest(id string, response string) error {
-	result, err := p.db.Exec("UPDATE verification_requests_individual SET response = ?, replied_at = ?, verification_status = ? WHERE id = ?", response, time.Now().Unix(), RequestStatusACCEPTED, id)
+// update runs fn in a transaction and notifies the transition it returns once committed
+func (p *Persistence) update(fn func(tx *sql.Tx) (*Transition, error)) (err error) {
+	tx, err := p.db.Begin()
 	if err != nil {
 		return err
 	}
 
-	numRows, err := result.RowsAffected()
-	if err != nil {
-		return err
-	}
+	var t *Transition
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			if err == nil && t != nil && p.transitionHandler != nil {
+				p.transitionHandler(t)
+			}
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	t, err = fn(tx)
+	return err
+}
 
-	if numRows == 0 {
-		return ErrVerificationRequestNotFound
+func (p *Persistence) SaveVerificationRequest(vr *Request) error {
+	if vr == nil {
+		return errors.New("invalid verification request provided")
 	}
+	return p.update(func(tx *sql.Tx) (*Transition, error) {
+		previousStatus, err := requestStatus(tx, vr.ID)
+		if err != nil {
+			return nil, err
+		}
+		_, err = tx.Exec(`INSERT INTO verification_requests_individual (`+requestColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, vr.ID, vr.From, vr.To, vr.Challenge, vr.Response, vr.RequestedAt, vr.RequestStatus, vr.RepliedAt, vr.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+		return saveTransition(tx, vr.ID, vr.From, vr.To, previousStatus, vr.RequestStatus)
+	})
+}
+
+// reply sets the response and status of the request
+func (p *Persistence) reply(id string, response string, status RequestStatus) error {
+	return p.update(func(tx *sql.Tx) (*Transition, error) {
+		var from, to string
+		var previousStatus RequestStatus
+		err := tx.QueryRow(`SELECT from_user, to_user, verification_status FROM verification_requests_individual WHERE id = ?`, id).Scan(&from, &to, &previousStatus)
+		if err == sql.ErrNoRows {
+			return nil, ErrVerificationRequestNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tx.Exec("UPDATE verification_requests_individual SET response = ?, replied_at = ?, verification_status = ? WHERE id = ?", response, time.Now().Unix(), status, id)
+		if err != nil {
+			return nil, err
+		}
+		return saveTransition(tx, id, from, to, previousStatus, status)
+	})
+}
 
-	return nil
+func (p *Persistence) AcceptContactVerificationRequest(id string, response string) error {
+	return p.reply(id, response, RequestStatusACCEPTED)
 }
 
 func (p *Persistence) DeclineContactVerificationRequest(id string) error {
-	result, err := p.db.Exec("UPDATE verification_requests_individual SET response = '', replied_at = ?, verification_status = ? WHERE id = ?", time.Now().Unix(), RequestStatusDECLINED, id)
-	if err != nil {
-		return err
-	}
+	return p.reply(id, "", RequestStatusDECLINED)
+}
 
-	numRows, err := result.RowsAffected()
+// GetVerificationAuditTrail returns the transitions of the requests exchanged with the contact, oldest first
+func (p *Persistence) GetVerificationAuditTrail(contactID string) ([]*Transition, error) {
+	rows, err := p.db.Query(`SELECT request_id, from_user, to_user, previous_status, status, timestamp FROM verification_requests_audit WHERE from_user = ? OR to_user = ? ORDER BY id ASC`, contactID, contactID)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer rows.Close()
 
-	if numRows == 0 {
-		return ErrVerificationRequestNotFound
+	var result []*Transition
+	for rows.Next() {
+		t := &Transition{}
+		err := rows.Scan(&t.RequestID, &t.From, &t.To, &t.PreviousStatus, &t.Status, &t.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, t)
 	}
-
-	return nil
+	return result, nil
 }
 
 func (p *Persistence) SetTrustStatus(contactID string, trust TrustStatus, updatedAt uint64) error {
@@ -149,3 +149,55 @@ func (s *PersistenceSuite) TestTrustStatus() {
 	s.NoError(err)
 	s.Equal(TrustStatusUNKNOWN, trustStatus)
 }
+
+func (s *PersistenceSuite) TestVerificationAuditTrail() {
+	var transitions []*Transition
+	s.db.SetTransitionHandler(func(t *Transition) {
+		transitions = append(transitions, t)
+	})
+
+	request := &Request{
+		ID:            "0xabc",
+		From:          "0x01",
+		To:            "0x02",
+		Challenge:     "ABC",
+		RequestedAt:   1000,
+		RequestStatus: RequestStatusPENDING,
+		ExpiresAt:     2000,
+	}
+	err := s.db.SaveVerificationRequest(request)
+	s.Require().NoError(err)
+
+	// Saving the same status again isn't a transition
+	err = s.db.SaveVerificationRequest(request)
+	s.Require().NoError(err)
+
+	expired, err := s.db.GetExpiredVerificationRequests(1999)
+	s.Require().NoError(err)
+	s.Require().Len(expired, 0)
+
+	expired, err = s.db.GetExpiredVerificationRequests(2000)
+	s.Require().NoError(err)
+	s.Require().Len(expired, 1)
+	s.Require().True(expired[0].Expired(2000))
+
+	err = s.db.AcceptContactVerificationRequest("0xabc", "XYZ")
+	s.Require().NoError(err)
+
+	expired, err = s.db.GetExpiredVerificationRequests(2000)
+	s.Require().NoError(err)
+	s.Require().Len(expired, 0)
+
+	trail, err := s.db.GetVerificationAuditTrail("0x02")
+	s.Require().NoError(err)
+	s.Require().Len(trail, 2)
+	s.Require().Equal(RequestStatusUNKNOWN, trail[0].PreviousStatus)
+	s.Require().Equal(RequestStatusPENDING, trail[0].Status)
+	s.Require().Equal(RequestStatusPENDING, trail[1].PreviousStatus)
+	s.Require().Equal(RequestStatusACCEPTED, trail[1].Status)
+	s.Require().Equal(trail, transitions)
+
+	trail, err = s.db.GetVerificationAuditTrail("0x03")
+	s.Require().NoError(err)
+	s.Require().Len(trail, 0)
+}
@@ -0,0 +1,49 @@
+package video
+
+import (
+	"errors"
+	"image"
+	"io/ioutil"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrUnsupportedVideo = errors.New("unsupported video")
+
+// Info is what's extracted from a video file to be sent along with it
+type Info struct {
+	DurationMs uint64
+	Width      uint32
+	Height     uint32
+	// Thumbnail is a frame of the video, nil when the extractor can't decode
+	// the video
+	Thumbnail image.Image
+}
+
+// Extractor extracts the information of a video file before it's sent.
+// Clients with a video decoder can provide an extractor generating the
+// thumbnail as well, see Messenger's WithVideoExtractor option
+type Extractor interface {
+	Extract(path string) (*Info, error)
+}
+
+// MetadataExtractor reads the duration and the dimensions of MP4 and
+// QuickTime files from their boxes, and of WebM files from their elements.
+// It doesn't decode the frames, so it extracts no thumbnail
+type MetadataExtractor struct{}
+
+func (MetadataExtractor) Extract(path string) (*Info, error) {
+	payload, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch Type(payload) {
+	case protobuf.VideoMessage_MP4, protobuf.VideoMessage_QUICKTIME:
+		return parseMP4(payload)
+	case protobuf.VideoMessage_WEBM:
+		return parseWebM(payload)
+	default:
+		return nil, ErrUnsupportedVideo
+	}
+}
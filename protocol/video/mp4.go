@@ -0,0 +1,123 @@
+package video
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var ErrInvalidMP4 = errors.New("invalid mp4")
+
+type mp4Box struct {
+	Type string
+	Data []byte
+}
+
+// mp4Boxes splits the data in the boxes it's made of, a truncated box is
+// kept with the data available
+func mp4Boxes(buf []byte) []mp4Box {
+	var boxes []mp4Box
+	for offset := 0; offset+8 <= len(buf); {
+		size := uint64(binary.BigEndian.Uint32(buf[offset : offset+4]))
+		boxType := string(buf[offset+4 : offset+8])
+		header := uint64(8)
+
+		switch size {
+		case 0:
+			// The box extends to the end of the file
+			size = uint64(len(buf) - offset)
+		case 1:
+			if offset+16 > len(buf) {
+				return boxes
+			}
+			size = binary.BigEndian.Uint64(buf[offset+8 : offset+16])
+			header = 16
+		}
+		if size < header {
+			return boxes
+		}
+
+		end := uint64(offset) + size
+		if end > uint64(len(buf)) {
+			end = uint64(len(buf))
+		}
+		boxes = append(boxes, mp4Box{Type: boxType, Data: buf[uint64(offset)+header : end]})
+		offset = int(end)
+	}
+	return boxes
+}
+
+func findMP4Box(boxes []mp4Box, boxType string) *mp4Box {
+	for i := range boxes {
+		if boxes[i].Type == boxType {
+			return &boxes[i]
+		}
+	}
+	return nil
+}
+
+// parseMP4 reads the duration from the movie header, and the dimensions from
+// the header of the first track with some, rotated as its matrix says
+func parseMP4(buf []byte) (*Info, error) {
+	moov := findMP4Box(mp4Boxes(buf), "moov")
+	if moov == nil {
+		return nil, ErrInvalidMP4
+	}
+
+	info := &Info{}
+	for _, box := range mp4Boxes(moov.Data) {
+		switch box.Type {
+		case "mvhd":
+			info.DurationMs = parseMVHD(box.Data)
+		case "trak":
+			if info.Width != 0 && info.Height != 0 {
+				continue
+			}
+			tkhd := findMP4Box(mp4Boxes(box.Data), "tkhd")
+			if tkhd != nil {
+				info.Width, info.Height = parseTKHD(tkhd.Data)
+			}
+		}
+	}
+	return info, nil
+}
+
+func parseMVHD(data []byte) uint64 {
+	var timescale, duration uint64
+	switch {
+	case len(data) >= 32 && data[0] == 1:
+		timescale = uint64(binary.BigEndian.Uint32(data[20:24]))
+		duration = binary.BigEndian.Uint64(data[24:32])
+	case len(data) >= 20 && data[0] == 0:
+		timescale = uint64(binary.BigEndian.Uint32(data[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(data[16:20]))
+	}
+	if timescale == 0 {
+		return 0
+	}
+	return duration * 1000 / timescale
+}
+
+func parseTKHD(data []byte) (uint32, uint32) {
+	// Version 1 headers have 64 bits times and duration
+	offset := 0
+	switch {
+	case len(data) >= 96 && data[0] == 1:
+		offset = 12
+	case len(data) >= 84 && data[0] == 0:
+	default:
+		return 0, 0
+	}
+
+	matrix := data[40+offset : 76+offset]
+	width := binary.BigEndian.Uint32(data[76+offset:80+offset]) >> 16
+	height := binary.BigEndian.Uint32(data[80+offset:84+offset]) >> 16
+
+	// Videos recorded in portrait are often stored in landscape with a
+	// rotation of 90 or 270 degrees
+	a := int32(binary.BigEndian.Uint32(matrix[0:4]))
+	b := int32(binary.BigEndian.Uint32(matrix[4:8]))
+	if a == 0 && (b == 0x10000 || b == -0x10000) {
+		width, height = height, width
+	}
+	return width, height
+}
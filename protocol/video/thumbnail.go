@@ -0,0 +1,26 @@
+package video
+
+import (
+	"bytes"
+	"errors"
+	"image/jpeg"
+)
+
+// maxThumbnailDim is the longest side of a thumbnail, the ones sent are
+// shrunk to the size of the thumbnails of image attachments
+const maxThumbnailDim = 512
+
+var ErrInvalidThumbnail = errors.New("invalid video thumbnail")
+
+// ValidateThumbnail checks that a received thumbnail is a JPEG image small
+// enough to be displayed in place of its video
+func ValidateThumbnail(thumbnail []byte) error {
+	config, err := jpeg.DecodeConfig(bytes.NewReader(thumbnail))
+	if err != nil {
+		return ErrInvalidThumbnail
+	}
+	if config.Width == 0 || config.Height == 0 || config.Width > maxThumbnailDim || config.Height > maxThumbnailDim {
+		return ErrInvalidThumbnail
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package video
+
+import (
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func isoBMFF(buf []byte) bool {
+	return len(buf) > 11 && string(buf[4:8]) == "ftyp"
+}
+
+func quickTime(buf []byte) bool {
+	if isoBMFF(buf) {
+		return string(buf[8:12]) == "qt  "
+	}
+	// Older QuickTime files have no ftyp box
+	return len(buf) > 7 && (string(buf[4:8]) == "moov" || string(buf[4:8]) == "mdat" || string(buf[4:8]) == "wide")
+}
+
+func webm(buf []byte) bool {
+	return len(buf) > 3 &&
+		buf[0] == 0x1A && buf[1] == 0x45 &&
+		buf[2] == 0xDF && buf[3] == 0xA3
+}
+
+func Type(buf []byte) protobuf.VideoMessage_VideoType {
+	switch {
+	case quickTime(buf):
+		return protobuf.VideoMessage_QUICKTIME
+	case isoBMFF(buf):
+		return protobuf.VideoMessage_MP4
+	case webm(buf):
+		return protobuf.VideoMessage_WEBM
+	default:
+		return protobuf.VideoMessage_UNKNOWN_VIDEO_TYPE
+	}
+}
+
+// MIME returns the mime type of a video type
+func MIME(videoType protobuf.VideoMessage_VideoType) string {
+	switch videoType {
+	case protobuf.VideoMessage_MP4:
+		return "video/mp4"
+	case protobuf.VideoMessage_QUICKTIME:
+		return "video/quicktime"
+	case protobuf.VideoMessage_WEBM:
+		return "video/webm"
+	default:
+		return "application/octet-stream"
+	}
+}
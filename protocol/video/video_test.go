@@ -0,0 +1,149 @@
+package video
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func box(boxType string, data ...[]byte) []byte {
+	size := 8
+	for _, d := range data {
+		size += len(d)
+	}
+	buf := make([]byte, 8, size)
+	binary.BigEndian.PutUint32(buf[0:4], uint32(size))
+	copy(buf[4:8], boxType)
+	for _, d := range data {
+		buf = append(buf, d...)
+	}
+	return buf
+}
+
+func mvhd(timescale, duration uint32) []byte {
+	data := make([]byte, 100)
+	binary.BigEndian.PutUint32(data[12:16], timescale)
+	binary.BigEndian.PutUint32(data[16:20], duration)
+	return box("mvhd", data)
+}
+
+func tkhd(width, height uint32, rotated bool) []byte {
+	data := make([]byte, 84)
+	if rotated {
+		binary.BigEndian.PutUint32(data[44:48], 0x10000)
+		binary.BigEndian.PutUint32(data[52:56], 0xFFFF0000)
+	} else {
+		binary.BigEndian.PutUint32(data[40:44], 0x10000)
+		binary.BigEndian.PutUint32(data[56:60], 0x10000)
+	}
+	binary.BigEndian.PutUint32(data[76:80], width<<16)
+	binary.BigEndian.PutUint32(data[80:84], height<<16)
+	return box("tkhd", data)
+}
+
+func mp4(brand string, rotated bool) []byte {
+	ftyp := box("ftyp", []byte(brand), make([]byte, 4))
+	// The audio track has no dimensions
+	moov := box("moov", mvhd(600, 9000), box("trak", tkhd(0, 0, false)), box("trak", tkhd(1920, 1080, rotated)))
+	return append(append(ftyp, moov...), box("mdat", make([]byte, 32))...)
+}
+
+func TestType(t *testing.T) {
+	require.Equal(t, protobuf.VideoMessage_MP4, Type(mp4("isom", false)))
+	require.Equal(t, protobuf.VideoMessage_QUICKTIME, Type(mp4("qt  ", false)))
+	require.Equal(t, protobuf.VideoMessage_WEBM, Type([]byte{0x1A, 0x45, 0xDF, 0xA3, 0x01}))
+	require.Equal(t, protobuf.VideoMessage_UNKNOWN_VIDEO_TYPE, Type([]byte("not a video")))
+}
+
+func TestMetadataExtractor(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "video.mp4")
+	require.NoError(t, os.WriteFile(path, mp4("isom", false), 0600))
+	info, err := MetadataExtractor{}.Extract(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(15000), info.DurationMs)
+	require.Equal(t, uint32(1920), info.Width)
+	require.Equal(t, uint32(1080), info.Height)
+	require.Nil(t, info.Thumbnail)
+
+	// Portrait videos recorded on phones are stored rotated
+	path = filepath.Join(dir, "portrait.mov")
+	require.NoError(t, os.WriteFile(path, mp4("qt  ", true), 0600))
+	info, err = MetadataExtractor{}.Extract(path)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1080), info.Width)
+	require.Equal(t, uint32(1920), info.Height)
+
+	path = filepath.Join(dir, "video.txt")
+	require.NoError(t, os.WriteFile(path, []byte("not a video"), 0600))
+	_, err = MetadataExtractor{}.Extract(path)
+	require.Equal(t, ErrUnsupportedVideo, err)
+}
+
+func ebml(id []byte, data ...[]byte) []byte {
+	var payload []byte
+	for _, d := range data {
+		payload = append(payload, d...)
+	}
+	size := make([]byte, 8)
+	binary.BigEndian.PutUint64(size, uint64(len(payload)))
+	size[0] = 0x01
+	return append(append(append([]byte{}, id...), size...), payload...)
+}
+
+func webmFile(unknownSegmentSize bool) []byte {
+	duration := make([]byte, 8)
+	binary.BigEndian.PutUint64(duration, math.Float64bits(15000))
+	info := ebml([]byte{0x15, 0x49, 0xA9, 0x66}, ebml([]byte{0x2A, 0xD7, 0xB1}, []byte{0x0F, 0x42, 0x40}), ebml([]byte{0x44, 0x89}, duration))
+	// The audio track has no dimensions
+	audio := ebml([]byte{0xAE}, ebml([]byte{0xD7}, []byte{0x01}))
+	videoTrack := ebml([]byte{0xAE}, ebml([]byte{0xE0}, ebml([]byte{0xB0}, []byte{0x02, 0x80}), ebml([]byte{0xBA}, []byte{0x01, 0x68})))
+	tracks := ebml([]byte{0x16, 0x54, 0xAE, 0x6B}, audio, videoTrack)
+	cluster := ebml([]byte{0x1F, 0x43, 0xB6, 0x75}, make([]byte, 32))
+
+	header := ebml([]byte{0x1A, 0x45, 0xDF, 0xA3}, ebml([]byte{0x42, 0x82}, []byte("webm")))
+	segment := ebml([]byte{0x18, 0x53, 0x80, 0x67}, info, tracks, cluster)
+	if unknownSegmentSize {
+		// Live recordings don't know the size of the segment when it starts
+		segment = append(append([]byte{0x18, 0x53, 0x80, 0x67, 0x01, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, info...), append(tracks, cluster...)...)
+	}
+	return append(header, segment...)
+}
+
+func TestWebMMetadata(t *testing.T) {
+	for _, unknownSize := range []bool{false, true} {
+		buf := webmFile(unknownSize)
+		require.Equal(t, protobuf.VideoMessage_WEBM, Type(buf))
+
+		info, err := parseWebM(buf)
+		require.NoError(t, err)
+		require.Equal(t, uint64(15000), info.DurationMs)
+		require.Equal(t, uint32(640), info.Width)
+		require.Equal(t, uint32(360), info.Height)
+	}
+
+	_, err := parseWebM([]byte{0x1A, 0x45, 0xDF, 0xA3, 0x80})
+	require.Equal(t, ErrInvalidWebM, err)
+}
+
+func TestValidateThumbnail(t *testing.T) {
+	thumbnail := func(width, height int) []byte {
+		bb := bytes.NewBuffer(nil)
+		require.NoError(t, jpeg.Encode(bb, image.NewRGBA(image.Rect(0, 0, width, height)), nil))
+		return bb.Bytes()
+	}
+
+	require.NoError(t, ValidateThumbnail(thumbnail(320, 180)))
+	require.Equal(t, ErrInvalidThumbnail, ValidateThumbnail(thumbnail(1920, 1080)))
+	require.Equal(t, ErrInvalidThumbnail, ValidateThumbnail([]byte("not a thumbnail")))
+}
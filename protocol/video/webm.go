@@ -0,0 +1,181 @@
+package video
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+var ErrInvalidWebM = errors.New("invalid webm")
+
+const (
+	ebmlSegmentID       = 0x18538067
+	ebmlInfoID          = 0x1549A966
+	ebmlTimecodeScaleID = 0x2AD7B1
+	ebmlDurationID      = 0x4489
+	ebmlTracksID        = 0x1654AE6B
+	ebmlTrackEntryID    = 0xAE
+	ebmlVideoID         = 0xE0
+	ebmlPixelWidthID    = 0xB0
+	ebmlPixelHeightID   = 0xBA
+
+	// defaultTimecodeScale is the duration of a tick in nanoseconds when the
+	// file doesn't set it
+	defaultTimecodeScale = 1000000
+)
+
+type ebmlElement struct {
+	ID   uint64
+	Data []byte
+}
+
+// ebmlVint reads a variable size integer, IDs keep their length marker while
+// sizes don't. It returns the length of the integer, 0 when it's invalid, and
+// whether all its bits are set, which means an unknown size
+func ebmlVint(buf []byte, keepMarker bool) (uint64, int, bool) {
+	if len(buf) == 0 || buf[0] == 0 {
+		return 0, 0, false
+	}
+
+	length := 1
+	for mask := byte(0x80); buf[0]&mask == 0; mask >>= 1 {
+		length++
+	}
+	if length > len(buf) {
+		return 0, 0, false
+	}
+
+	value := uint64(buf[0])
+	if !keepMarker {
+		value &= uint64(0xFF >> length)
+	}
+	allSet := value == uint64(0xFF>>length)
+	for _, b := range buf[1:length] {
+		value = value<<8 | uint64(b)
+		allSet = allSet && b == 0xFF
+	}
+	return value, length, allSet
+}
+
+// ebmlElements splits the data in the elements it's made of, an element of
+// unknown size extends to the end of the data and a truncated one is kept
+// with the data available
+func ebmlElements(buf []byte) []ebmlElement {
+	var elements []ebmlElement
+	for offset := 0; offset < len(buf); {
+		id, idLength, _ := ebmlVint(buf[offset:], true)
+		if idLength == 0 || idLength > 4 {
+			return elements
+		}
+		size, sizeLength, unknown := ebmlVint(buf[offset+idLength:], false)
+		if sizeLength == 0 {
+			return elements
+		}
+
+		start := offset + idLength + sizeLength
+		end := uint64(len(buf))
+		if !unknown && size < end-uint64(start) {
+			end = uint64(start) + size
+		}
+		elements = append(elements, ebmlElement{ID: id, Data: buf[start:end]})
+		offset = int(end)
+	}
+	return elements
+}
+
+func findEBMLElement(elements []ebmlElement, id uint64) *ebmlElement {
+	for i := range elements {
+		if elements[i].ID == id {
+			return &elements[i]
+		}
+	}
+	return nil
+}
+
+func ebmlUint(data []byte) uint64 {
+	if len(data) > 8 {
+		return 0
+	}
+	var value uint64
+	for _, b := range data {
+		value = value<<8 | uint64(b)
+	}
+	return value
+}
+
+func ebmlFloat(data []byte) float64 {
+	switch len(data) {
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(data)))
+	case 8:
+		return math.Float64frombits(binary.BigEndian.Uint64(data))
+	}
+	return 0
+}
+
+// parseWebM reads the duration from the segment information, and the
+// dimensions from the first video track
+func parseWebM(buf []byte) (*Info, error) {
+	segment := findEBMLElement(ebmlElements(buf), ebmlSegmentID)
+	if segment == nil {
+		return nil, ErrInvalidWebM
+	}
+
+	info := &Info{}
+	for _, element := range ebmlElements(segment.Data) {
+		switch element.ID {
+		case ebmlInfoID:
+			info.DurationMs = parseWebMInfo(element.Data)
+		case ebmlTracksID:
+			if info.Width != 0 && info.Height != 0 {
+				continue
+			}
+			info.Width, info.Height = parseWebMTracks(element.Data)
+		}
+	}
+	return info, nil
+}
+
+func parseWebMInfo(data []byte) uint64 {
+	scale := uint64(defaultTimecodeScale)
+	var duration float64
+	for _, element := range ebmlElements(data) {
+		switch element.ID {
+		case ebmlTimecodeScaleID:
+			if s := ebmlUint(element.Data); s != 0 {
+				scale = s
+			}
+		case ebmlDurationID:
+			duration = ebmlFloat(element.Data)
+		}
+	}
+	if duration <= 0 || math.IsNaN(duration) || math.IsInf(duration, 0) {
+		return 0
+	}
+	return uint64(duration * float64(scale) / float64(1000000))
+}
+
+func parseWebMTracks(data []byte) (uint32, uint32) {
+	for _, entry := range ebmlElements(data) {
+		if entry.ID != ebmlTrackEntryID {
+			continue
+		}
+		video := findEBMLElement(ebmlElements(entry.Data), ebmlVideoID)
+		if video == nil {
+			continue
+		}
+		var width, height uint32
+		for _, element := range ebmlElements(video.Data) {
+			switch element.ID {
+			case ebmlPixelWidthID:
+				width = uint32(ebmlUint(element.Data))
+			case ebmlPixelHeightID:
+				height = uint32(ebmlUint(element.Data))
+			}
+		}
+		if width != 0 && height != 0 {
+			return width, height
+		}
+	}
+	return 0, 0
+}
@@ -0,0 +1,270 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/services/rpcstats"
+)
+
+const (
+	// batchWindow is how long calls are collected before being sent in a
+	// single batch request
+	batchWindow = 10 * time.Millisecond
+	// maxBatchSize is the number of calls above which a batch is sent right
+	// away, providers reject larger batches
+	maxBatchSize = 100
+	batchTimeout = 20 * time.Second
+
+	invalidRequestErrorCode = -32600
+	methodNotFoundErrorCode = -32601
+)
+
+// batchedMethods are the calls coalesced by the batcher, they are read only
+// and the bulk of the calls when refreshing the wallet
+var batchedMethods = map[string]bool{
+	"eth_call":       true,
+	"eth_getBalance": true,
+}
+
+type batchCall struct {
+	key    string
+	method string
+	args   []interface{}
+	result json.RawMessage
+	err    error
+	done   chan struct{}
+}
+
+// batcher coalesces the concurrent identical calls into a single one, and
+// sends the calls collected during batchWindow in a single JSON-RPC batch
+// request. Batching is turned off for endpoints which don't support it
+type batcher struct {
+	client func() *rpc.Client
+	window time.Duration
+
+	mu          sync.Mutex
+	inFlight    map[string]*batchCall
+	queue       []*batchCall
+	timer       *time.Timer
+	unsupported bool
+}
+
+func newBatcher(client func() *rpc.Client) *batcher {
+	return &batcher{
+		client:   client,
+		window:   batchWindow,
+		inFlight: make(map[string]*batchCall),
+	}
+}
+
+func batchKey(method string, args []interface{}) (string, error) {
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+	return method + string(encoded), nil
+}
+
+// CallContext performs the call, waiting for the identical call in flight if
+// there's one
+func (b *batcher) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if !batchedMethods[method] {
+		return b.client().CallContext(ctx, result, method, args...)
+	}
+
+	key, err := batchKey(method, args)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	call, ok := b.inFlight[key]
+	if ok {
+		rpcstats.CountCall("rpc_deduplicated")
+	} else {
+		call = &batchCall{
+			key:    key,
+			method: method,
+			args:   args,
+			done:   make(chan struct{}),
+		}
+		b.inFlight[key] = call
+		b.enqueue(call)
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if call.err != nil {
+		return call.err
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(call.result, result)
+}
+
+// enqueue adds the call to the next batch, it must be called with the lock
+// held
+func (b *batcher) enqueue(call *batchCall) {
+	b.queue = append(b.queue, call)
+	if len(b.queue) >= maxBatchSize {
+		if b.timer != nil {
+			b.timer.Stop()
+			b.timer = nil
+		}
+		go b.send(b.takeQueue())
+		return
+	}
+	if b.timer == nil {
+		b.timer = time.AfterFunc(b.window, b.flush)
+	}
+}
+
+func (b *batcher) takeQueue() []*batchCall {
+	queue := b.queue
+	b.queue = nil
+	return queue
+}
+
+func (b *batcher) flush() {
+	b.mu.Lock()
+	b.timer = nil
+	queue := b.takeQueue()
+	b.mu.Unlock()
+
+	b.send(queue)
+}
+
+func (b *batcher) send(calls []*batchCall) {
+	if len(calls) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), batchTimeout)
+	defer cancel()
+
+	b.mu.Lock()
+	unsupported := b.unsupported
+	b.mu.Unlock()
+
+	client := b.client()
+	defer func() {
+		for _, call := range calls {
+			b.complete(call)
+		}
+	}()
+
+	if len(calls) == 1 || unsupported {
+		sendEach(ctx, client, calls)
+		return
+	}
+
+	elems := make([]rpc.BatchElem, len(calls))
+	for i, call := range calls {
+		elems[i] = rpc.BatchElem{
+			Method: call.method,
+			Args:   call.args,
+			Result: &calls[i].result,
+		}
+	}
+	rpcstats.CountCall("rpc_batch")
+	err := client.BatchCallContext(ctx, elems)
+	if err != nil {
+		// The endpoint may not support batches, it's known once the calls
+		// succeed one by one. Other errors, such as timeouts, don't turn
+		// batching off
+		if sendEach(ctx, client, calls) && isBatchUnsupported(err) {
+			b.mu.Lock()
+			b.unsupported = true
+			b.mu.Unlock()
+		}
+		return
+	}
+
+	for i, call := range calls {
+		call.err = elems[i].Error
+	}
+}
+
+// sendEach sends the calls one by one, and reports whether any of them
+// reached the endpoint
+func sendEach(ctx context.Context, client *rpc.Client, calls []*batchCall) bool {
+	reached := false
+	for _, call := range calls {
+		call.err = client.CallContext(ctx, &call.result, call.method, call.args...)
+		if _, ok := call.err.(rpc.Error); call.err == nil || ok {
+			reached = true
+		}
+	}
+	return reached
+}
+
+// isBatchUnsupported tells whether the error of a batch request is the
+// endpoint rejecting batches, which it does by answering a single error
+// instead of an array of responses
+func isBatchUnsupported(err error) bool {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return true
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		code := rpcErr.ErrorCode()
+		return code == invalidRequestErrorCode || code == methodNotFoundErrorCode
+	}
+	return false
+}
+
+func (b *batcher) complete(call *batchCall) {
+	b.mu.Lock()
+	delete(b.inFlight, call.key)
+	b.mu.Unlock()
+	close(call.done)
+}
+
+// toCallArg is go-ethereum's toCallArg, which leaves "to" out for contract
+// creations instead of sending null
+func toCallArg(msg ethereum.CallMsg) interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+	}
+	if msg.To != nil {
+		arg["to"] = msg.To
+	}
+	if len(msg.Data) > 0 {
+		arg["data"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	if msg.GasFeeCap != nil {
+		arg["maxFeePerGas"] = (*hexutil.Big)(msg.GasFeeCap)
+	}
+	if msg.GasTipCap != nil {
+		arg["maxPriorityFeePerGas"] = (*hexutil.Big)(msg.GasTipCap)
+	}
+	if msg.AccessList != nil {
+		arg["accessList"] = msg.AccessList
+	}
+	return arg
+}
@@ -0,0 +1,190 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/stretchr/testify/require"
+)
+
+type rpcRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params []string        `json:"params"`
+}
+
+// newTestServer answers eth_getBalance with the last byte of the address,
+// batches are rejected when batchSupported is false
+func newTestServer(batchSupported bool, requests *int32, calls *int32) *httptest.Server {
+	return httptest.NewServer(newTestHandler(batchSupported, requests, calls))
+}
+
+func isBatch(body []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(body), []byte("["))
+}
+
+func newTestHandler(batchSupported bool, requests *int32, calls *int32) http.HandlerFunc {
+	respond := func(req rpcRequest) string {
+		atomic.AddInt32(calls, 1)
+		balance := common.HexToAddress(req.Params[0]).Bytes()[19]
+		return fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":"0x%x"}`, req.ID, balance)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+
+		if isBatch(body) {
+			if !batchSupported {
+				fmt.Fprint(w, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"batch not supported"}}`)
+				return
+			}
+			var reqs []rpcRequest
+			_ = json.Unmarshal(body, &reqs)
+			responses := make([]json.RawMessage, 0, len(reqs))
+			for _, req := range reqs {
+				responses = append(responses, json.RawMessage(respond(req)))
+			}
+			_ = json.NewEncoder(w).Encode(responses)
+			return
+		}
+
+		var req rpcRequest
+		_ = json.Unmarshal(body, &req)
+		fmt.Fprint(w, respond(req))
+	}
+}
+
+func getBalances(t *testing.T, b *batcher, addresses []common.Address) {
+	var wg sync.WaitGroup
+	for _, address := range addresses {
+		wg.Add(1)
+		go func(address common.Address) {
+			defer wg.Done()
+			var balance hexutil.Big
+			err := b.CallContext(context.Background(), &balance, "eth_getBalance", address, "latest")
+			require.NoError(t, err)
+			require.Equal(t, int64(address.Bytes()[19]), balance.ToInt().Int64())
+		}(address)
+	}
+	wg.Wait()
+}
+
+func TestBatcher(t *testing.T) {
+	var requests, calls int32
+	server := newTestServer(true, &requests, &calls)
+	defer server.Close()
+
+	client, err := rpc.Dial(server.URL)
+	require.NoError(t, err)
+	b := newBatcher(func() *rpc.Client { return client })
+	b.window = 200 * time.Millisecond
+
+	// The identical calls are sent once, the others in a single batch
+	getBalances(t, b, []common.Address{
+		common.HexToAddress("0x1"),
+		common.HexToAddress("0x1"),
+		common.HexToAddress("0x2"),
+		common.HexToAddress("0x3"),
+	})
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	b.mu.Lock()
+	require.Empty(t, b.inFlight)
+	b.mu.Unlock()
+}
+
+func TestBatcherUnsupported(t *testing.T) {
+	var requests, calls int32
+	server := newTestServer(false, &requests, &calls)
+	defer server.Close()
+
+	client, err := rpc.Dial(server.URL)
+	require.NoError(t, err)
+	b := newBatcher(func() *rpc.Client { return client })
+	b.window = 200 * time.Millisecond
+
+	addresses := []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2")}
+	getBalances(t, b, addresses)
+	b.mu.Lock()
+	require.True(t, b.unsupported)
+	b.mu.Unlock()
+	require.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	// The calls are sent one by one once batches are known to be unsupported
+	getBalances(t, b, addresses)
+	require.Equal(t, int32(5), atomic.LoadInt32(&requests))
+}
+
+func TestBatcherTransientError(t *testing.T) {
+	var requests, calls, failed int32
+	handler := newTestHandler(true, &requests, &calls)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if isBatch(body) && atomic.CompareAndSwapInt32(&failed, 0, 1) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		handler(w, r)
+	}))
+	defer server.Close()
+
+	client, err := rpc.Dial(server.URL)
+	require.NoError(t, err)
+	b := newBatcher(func() *rpc.Client { return client })
+	b.window = 200 * time.Millisecond
+
+	// The failed batch is sent again one call at a time, batching stays on
+	addresses := []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2")}
+	getBalances(t, b, addresses)
+	b.mu.Lock()
+	require.False(t, b.unsupported)
+	b.mu.Unlock()
+	require.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	getBalances(t, b, addresses)
+	require.Equal(t, int32(4), atomic.LoadInt32(&requests))
+}
+
+func TestToCallArg(t *testing.T) {
+	to := common.HexToAddress("0x2")
+	accessList := types.AccessList{{Address: to}}
+	arg := toCallArg(ethereum.CallMsg{
+		From:       common.HexToAddress("0x1"),
+		To:         &to,
+		Data:       []byte{1},
+		GasFeeCap:  big.NewInt(2),
+		GasTipCap:  big.NewInt(1),
+		AccessList: accessList,
+	}).(map[string]interface{})
+	require.Equal(t, &to, arg["to"])
+	require.Equal(t, hexutil.Bytes{1}, arg["data"])
+	require.Equal(t, (*hexutil.Big)(big.NewInt(2)), arg["maxFeePerGas"])
+	require.Equal(t, (*hexutil.Big)(big.NewInt(1)), arg["maxPriorityFeePerGas"])
+	require.Equal(t, accessList, arg["accessList"])
+	require.NotContains(t, arg, "gasPrice")
+
+	// Contract creations have no recipient
+	encoded, err := json.Marshal(toCallArg(ethereum.CallMsg{Data: []byte{1}}))
+	require.NoError(t, err)
+	require.NotContains(t, string(encoded), `"to"`)
+}
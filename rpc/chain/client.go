@@ -32,8 +32,21 @@ type ClientWithFallback struct {
 
 	mainRPC     *rpc.Client
 	fallbackRPC *rpc.Client
+	// endpointsLock guards the clients above, they are swapped when the
+	// endpoints of the chain are reordered
+	endpointsLock sync.RWMutex
+	// mainBatcher batches and deduplicates the read calls to the main
+	// endpoint
+	mainBatcher *batcher
+	// mainProvider and fallbackProvider track the providers of the endpoints,
+	// they are unset when the endpoints aren't known
+	mainProvider     *Provider
+	fallbackProvider *Provider
 
 	WalletNotifier func(chainId uint64, message string)
+	// SentTransactionNotifier is called with the transactions sent through
+	// the client once the endpoint accepted them
+	SentTransactionNotifier func(chainID uint64, tx *types.Transaction)
 
 	IsConnected     bool
 	IsConnectedLock sync.RWMutex
@@ -75,7 +88,7 @@ func NewSimpleClient(main *rpc.Client, chainID uint64) *ClientWithFallback {
 		ErrorPercentThreshold: 25,
 	})
 
-	return &ClientWithFallback{
+	c := &ClientWithFallback{
 		ChainID:       chainID,
 		main:          ethclient.NewClient(main),
 		fallback:      nil,
@@ -84,6 +97,8 @@ func NewSimpleClient(main *rpc.Client, chainID uint64) *ClientWithFallback {
 		IsConnected:   true,
 		LastCheckedAt: time.Now().Unix(),
 	}
+	c.mainBatcher = newBatcher(c.mainRPCClient)
+	return c
 }
 
 func NewClient(main, fallback *rpc.Client, chainID uint64) *ClientWithFallback {
@@ -98,7 +113,7 @@ func NewClient(main, fallback *rpc.Client, chainID uint64) *ClientWithFallback {
 	if fallback != nil {
 		fallbackEthClient = ethclient.NewClient(fallback)
 	}
-	return &ClientWithFallback{
+	c := &ClientWithFallback{
 		ChainID:       chainID,
 		main:          ethclient.NewClient(main),
 		fallback:      fallbackEthClient,
@@ -107,15 +122,127 @@ func NewClient(main, fallback *rpc.Client, chainID uint64) *ClientWithFallback {
 		IsConnected:   true,
 		LastCheckedAt: time.Now().Unix(),
 	}
+	c.mainBatcher = newBatcher(c.mainRPCClient)
+	return c
 }
 
 func (c *ClientWithFallback) Close() {
+	c.endpointsLock.RLock()
+	defer c.endpointsLock.RUnlock()
 	c.main.Close()
 	if c.fallback != nil {
 		c.fallback.Close()
 	}
 }
 
+// SetEndpoints replaces the main and fallback clients, the replaced clients
+// are returned so that they can be closed once their pending calls are done
+func (c *ClientWithFallback) SetEndpoints(main, fallback *rpc.Client) (*rpc.Client, *rpc.Client) {
+	var fallbackEthClient *ethclient.Client
+	if fallback != nil {
+		fallbackEthClient = ethclient.NewClient(fallback)
+	}
+
+	c.endpointsLock.Lock()
+	defer c.endpointsLock.Unlock()
+	oldMain, oldFallback := c.mainRPC, c.fallbackRPC
+	c.main = ethclient.NewClient(main)
+	c.mainRPC = main
+	c.fallback = fallbackEthClient
+	c.fallbackRPC = fallback
+	return oldMain, oldFallback
+}
+
+// SetProviders sets the providers of the main and fallback endpoints, the
+// main endpoint is then called within the circuit of its provider
+func (c *ClientWithFallback) SetProviders(main, fallback *Provider) {
+	c.endpointsLock.Lock()
+	defer c.endpointsLock.Unlock()
+	c.mainProvider = main
+	c.fallbackProvider = fallback
+}
+
+// Providers returns the providers of the main and fallback endpoints
+func (c *ClientWithFallback) Providers() (*Provider, *Provider) {
+	c.endpointsLock.RLock()
+	defer c.endpointsLock.RUnlock()
+	return c.mainProvider, c.fallbackProvider
+}
+
+func (c *ClientWithFallback) mainCircuit() string {
+	main, _ := c.Providers()
+	if main == nil {
+		return fmt.Sprintf("ethClient_%d", c.ChainID)
+	}
+	return main.circuit
+}
+
+// callMain calls the main endpoint, unless the quota of its provider is
+// exhausted
+func (c *ClientWithFallback) callMain(call func() error) error {
+	main, _ := c.Providers()
+	if main == nil {
+		return call()
+	}
+	if main.quota.Exhausted() {
+		return ErrProviderUnavailable
+	}
+	err := call()
+	main.record(err)
+	return err
+}
+
+// callFallback calls the fallback endpoint within the circuit of its
+// provider, unless the quota of its provider is exhausted
+func (c *ClientWithFallback) callFallback(call func() error) error {
+	_, fallback := c.Providers()
+	if fallback == nil {
+		return call()
+	}
+	if fallback.quota.Exhausted() {
+		return ErrProviderUnavailable
+	}
+
+	var vmError error
+	err := hystrix.Do(fallback.circuit, func() error {
+		err := call()
+		fallback.record(err)
+		if err != nil && isVMError(err) {
+			vmError = err
+			return nil
+		}
+		return err
+	}, nil)
+	if vmError != nil {
+		return vmError
+	}
+	return err
+}
+
+func (c *ClientWithFallback) mainClient() *ethclient.Client {
+	c.endpointsLock.RLock()
+	defer c.endpointsLock.RUnlock()
+	return c.main
+}
+
+func (c *ClientWithFallback) fallbackClient() *ethclient.Client {
+	c.endpointsLock.RLock()
+	defer c.endpointsLock.RUnlock()
+	return c.fallback
+}
+
+func (c *ClientWithFallback) mainRPCClient() *rpc.Client {
+	c.endpointsLock.RLock()
+	defer c.endpointsLock.RUnlock()
+	return c.mainRPC
+}
+
+func (c *ClientWithFallback) fallbackRPCClient() *rpc.Client {
+	c.endpointsLock.RLock()
+	defer c.endpointsLock.RUnlock()
+	return c.fallbackRPC
+}
+
 func isVMError(err error) bool {
 	if strings.HasPrefix(err.Error(), "execution reverted") {
 		return true
@@ -153,8 +280,8 @@ func (c *ClientWithFallback) SetIsConnected(value bool) {
 func (c *ClientWithFallback) makeCallNoReturn(main func() error, fallback func() error) error {
 	resultChan := make(chan CommandResult, 1)
 	c.LastCheckedAt = time.Now().Unix()
-	errChan := hystrix.Go(fmt.Sprintf("ethClient_%d", c.ChainID), func() error {
-		err := main()
+	errChan := hystrix.Go(c.mainCircuit(), func() error {
+		err := c.callMain(main)
 		if err != nil {
 			if isVMError(err) {
 				resultChan <- CommandResult{vmError: err}
@@ -166,12 +293,12 @@ func (c *ClientWithFallback) makeCallNoReturn(main func() error, fallback func()
 		resultChan <- CommandResult{}
 		return nil
 	}, func(err error) error {
-		if c.fallback == nil {
+		if c.fallbackClient() == nil {
 			c.SetIsConnected(false)
 			return err
 		}
 
-		err = fallback()
+		err = c.callFallback(fallback)
 		if err != nil {
 			if isVMError(err) {
 				resultChan <- CommandResult{vmError: err}
@@ -197,8 +324,12 @@ func (c *ClientWithFallback) makeCallNoReturn(main func() error, fallback func()
 
 func (c *ClientWithFallback) makeCallSingleReturn(main func() (any, error), fallback func() (any, error), toggleIsConnected bool) (any, error) {
 	resultChan := make(chan CommandResult, 1)
-	errChan := hystrix.Go(fmt.Sprintf("ethClient_%d", c.ChainID), func() error {
-		res, err := main()
+	errChan := hystrix.Go(c.mainCircuit(), func() error {
+		var res any
+		err := c.callMain(func() (err error) {
+			res, err = main()
+			return err
+		})
 		if err != nil {
 			if isVMError(err) {
 				resultChan <- CommandResult{vmError: err}
@@ -212,14 +343,18 @@ func (c *ClientWithFallback) makeCallSingleReturn(main func() (any, error), fall
 		resultChan <- CommandResult{res1: res}
 		return nil
 	}, func(err error) error {
-		if c.fallback == nil {
+		if c.fallbackClient() == nil {
 			if toggleIsConnected {
 				c.SetIsConnected(false)
 			}
 			return err
 		}
 
-		res, err := fallback()
+		var res any
+		err = c.callFallback(func() (err error) {
+			res, err = fallback()
+			return err
+		})
 		if err != nil {
 			if isVMError(err) {
 				resultChan <- CommandResult{vmError: err}
@@ -251,8 +386,12 @@ func (c *ClientWithFallback) makeCallSingleReturn(main func() (any, error), fall
 func (c *ClientWithFallback) makeCallDoubleReturn(main func() (any, any, error), fallback func() (any, any, error)) (any, any, error) {
 	resultChan := make(chan CommandResult, 1)
 	c.LastCheckedAt = time.Now().Unix()
-	errChan := hystrix.Go(fmt.Sprintf("ethClient_%d", c.ChainID), func() error {
-		a, b, err := main()
+	errChan := hystrix.Go(c.mainCircuit(), func() error {
+		var a, b any
+		err := c.callMain(func() (err error) {
+			a, b, err = main()
+			return err
+		})
 		if err != nil {
 			if isVMError(err) {
 				resultChan <- CommandResult{vmError: err}
@@ -264,12 +403,16 @@ func (c *ClientWithFallback) makeCallDoubleReturn(main func() (any, any, error),
 		resultChan <- CommandResult{res1: a, res2: b}
 		return nil
 	}, func(err error) error {
-		if c.fallback == nil {
+		if c.fallbackClient() == nil {
 			c.SetIsConnected(false)
 			return err
 		}
 
-		a, b, err := fallback()
+		var a, b any
+		err = c.callFallback(func() (err error) {
+			a, b, err = fallback()
+			return err
+		})
 		if err != nil {
 			if isVMError(err) {
 				resultChan <- CommandResult{vmError: err}
@@ -298,8 +441,8 @@ func (c *ClientWithFallback) BlockByHash(ctx context.Context, hash common.Hash)
 	rpcstats.CountCall("eth_BlockByHash")
 
 	block, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.BlockByHash(ctx, hash) },
-		func() (any, error) { return c.fallback.BlockByHash(ctx, hash) },
+		func() (any, error) { return c.mainClient().BlockByHash(ctx, hash) },
+		func() (any, error) { return c.fallbackClient().BlockByHash(ctx, hash) },
 		true,
 	)
 
@@ -313,8 +456,8 @@ func (c *ClientWithFallback) BlockByHash(ctx context.Context, hash common.Hash)
 func (c *ClientWithFallback) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
 	rpcstats.CountCall("eth_BlockByNumber")
 	block, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.BlockByNumber(ctx, number) },
-		func() (any, error) { return c.fallback.BlockByNumber(ctx, number) },
+		func() (any, error) { return c.mainClient().BlockByNumber(ctx, number) },
+		func() (any, error) { return c.fallbackClient().BlockByNumber(ctx, number) },
 		true,
 	)
 
@@ -329,8 +472,8 @@ func (c *ClientWithFallback) BlockNumber(ctx context.Context) (uint64, error) {
 	rpcstats.CountCall("eth_BlockNumber")
 
 	number, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.BlockNumber(ctx) },
-		func() (any, error) { return c.fallback.BlockNumber(ctx) },
+		func() (any, error) { return c.mainClient().BlockNumber(ctx) },
+		func() (any, error) { return c.fallbackClient().BlockNumber(ctx) },
 		true,
 	)
 
@@ -345,8 +488,8 @@ func (c *ClientWithFallback) PeerCount(ctx context.Context) (uint64, error) {
 	rpcstats.CountCall("eth_PeerCount")
 
 	peerCount, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.PeerCount(ctx) },
-		func() (any, error) { return c.fallback.PeerCount(ctx) },
+		func() (any, error) { return c.mainClient().PeerCount(ctx) },
+		func() (any, error) { return c.fallbackClient().PeerCount(ctx) },
 		true,
 	)
 
@@ -360,8 +503,8 @@ func (c *ClientWithFallback) PeerCount(ctx context.Context) (uint64, error) {
 func (c *ClientWithFallback) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
 	rpcstats.CountCall("eth_HeaderByHash")
 	header, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.HeaderByHash(ctx, hash) },
-		func() (any, error) { return c.fallback.HeaderByHash(ctx, hash) },
+		func() (any, error) { return c.mainClient().HeaderByHash(ctx, hash) },
+		func() (any, error) { return c.fallbackClient().HeaderByHash(ctx, hash) },
 		false,
 	)
 
@@ -375,8 +518,8 @@ func (c *ClientWithFallback) HeaderByHash(ctx context.Context, hash common.Hash)
 func (c *ClientWithFallback) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
 	rpcstats.CountCall("eth_HeaderByNumber")
 	header, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.HeaderByNumber(ctx, number) },
-		func() (any, error) { return c.fallback.HeaderByNumber(ctx, number) },
+		func() (any, error) { return c.mainClient().HeaderByNumber(ctx, number) },
+		func() (any, error) { return c.fallbackClient().HeaderByNumber(ctx, number) },
 		false,
 	)
 
@@ -391,8 +534,8 @@ func (c *ClientWithFallback) TransactionByHash(ctx context.Context, hash common.
 	rpcstats.CountCall("eth_TransactionByHash")
 
 	tx, isPending, err := c.makeCallDoubleReturn(
-		func() (any, any, error) { return c.main.TransactionByHash(ctx, hash) },
-		func() (any, any, error) { return c.fallback.TransactionByHash(ctx, hash) },
+		func() (any, any, error) { return c.mainClient().TransactionByHash(ctx, hash) },
+		func() (any, any, error) { return c.fallbackClient().TransactionByHash(ctx, hash) },
 	)
 
 	if err != nil {
@@ -406,8 +549,8 @@ func (c *ClientWithFallback) TransactionSender(ctx context.Context, tx *types.Tr
 	rpcstats.CountCall("eth_TransactionSender")
 
 	address, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.TransactionSender(ctx, tx, block, index) },
-		func() (any, error) { return c.fallback.TransactionSender(ctx, tx, block, index) },
+		func() (any, error) { return c.mainClient().TransactionSender(ctx, tx, block, index) },
+		func() (any, error) { return c.fallbackClient().TransactionSender(ctx, tx, block, index) },
 		true,
 	)
 
@@ -418,8 +561,8 @@ func (c *ClientWithFallback) TransactionCount(ctx context.Context, blockHash com
 	rpcstats.CountCall("eth_TransactionCount")
 
 	count, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.TransactionCount(ctx, blockHash) },
-		func() (any, error) { return c.fallback.TransactionCount(ctx, blockHash) },
+		func() (any, error) { return c.mainClient().TransactionCount(ctx, blockHash) },
+		func() (any, error) { return c.fallbackClient().TransactionCount(ctx, blockHash) },
 		true,
 	)
 
@@ -434,8 +577,8 @@ func (c *ClientWithFallback) TransactionInBlock(ctx context.Context, blockHash c
 	rpcstats.CountCall("eth_TransactionInBlock")
 
 	transactions, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.TransactionInBlock(ctx, blockHash, index) },
-		func() (any, error) { return c.fallback.TransactionInBlock(ctx, blockHash, index) },
+		func() (any, error) { return c.mainClient().TransactionInBlock(ctx, blockHash, index) },
+		func() (any, error) { return c.fallbackClient().TransactionInBlock(ctx, blockHash, index) },
 		true,
 	)
 
@@ -450,8 +593,8 @@ func (c *ClientWithFallback) TransactionReceipt(ctx context.Context, txHash comm
 	rpcstats.CountCall("eth_TransactionReceipt")
 
 	receipt, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.TransactionReceipt(ctx, txHash) },
-		func() (any, error) { return c.fallback.TransactionReceipt(ctx, txHash) },
+		func() (any, error) { return c.mainClient().TransactionReceipt(ctx, txHash) },
+		func() (any, error) { return c.fallbackClient().TransactionReceipt(ctx, txHash) },
 		true,
 	)
 
@@ -466,8 +609,8 @@ func (c *ClientWithFallback) SyncProgress(ctx context.Context) (*ethereum.SyncPr
 	rpcstats.CountCall("eth_SyncProgress")
 
 	progress, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.SyncProgress(ctx) },
-		func() (any, error) { return c.fallback.SyncProgress(ctx) },
+		func() (any, error) { return c.mainClient().SyncProgress(ctx) },
+		func() (any, error) { return c.fallbackClient().SyncProgress(ctx) },
 		true,
 	)
 
@@ -482,8 +625,8 @@ func (c *ClientWithFallback) SubscribeNewHead(ctx context.Context, ch chan<- *ty
 	rpcstats.CountCall("eth_SubscribeNewHead")
 
 	sub, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.SubscribeNewHead(ctx, ch) },
-		func() (any, error) { return c.fallback.SubscribeNewHead(ctx, ch) },
+		func() (any, error) { return c.mainClient().SubscribeNewHead(ctx, ch) },
+		func() (any, error) { return c.fallbackClient().SubscribeNewHead(ctx, ch) },
 		true,
 	)
 
@@ -498,8 +641,8 @@ func (c *ClientWithFallback) NetworkID(ctx context.Context) (*big.Int, error) {
 	rpcstats.CountCall("eth_NetworkID")
 
 	networkID, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.NetworkID(ctx) },
-		func() (any, error) { return c.fallback.NetworkID(ctx) },
+		func() (any, error) { return c.mainClient().NetworkID(ctx) },
+		func() (any, error) { return c.fallbackClient().NetworkID(ctx) },
 		true,
 	)
 
@@ -514,8 +657,12 @@ func (c *ClientWithFallback) BalanceAt(ctx context.Context, account common.Addre
 	rpcstats.CountCall("eth_BalanceAt")
 
 	balance, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.BalanceAt(ctx, account, blockNumber) },
-		func() (any, error) { return c.fallback.BalanceAt(ctx, account, blockNumber) },
+		func() (any, error) {
+			var balance hexutil.Big
+			err := c.mainBatcher.CallContext(ctx, &balance, "eth_getBalance", account, toBlockNumArg(blockNumber))
+			return (*big.Int)(&balance), err
+		},
+		func() (any, error) { return c.fallbackClient().BalanceAt(ctx, account, blockNumber) },
 		true,
 	)
 
@@ -530,8 +677,8 @@ func (c *ClientWithFallback) StorageAt(ctx context.Context, account common.Addre
 	rpcstats.CountCall("eth_StorageAt")
 
 	storage, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.StorageAt(ctx, account, key, blockNumber) },
-		func() (any, error) { return c.fallback.StorageAt(ctx, account, key, blockNumber) },
+		func() (any, error) { return c.mainClient().StorageAt(ctx, account, key, blockNumber) },
+		func() (any, error) { return c.fallbackClient().StorageAt(ctx, account, key, blockNumber) },
 		true,
 	)
 
@@ -546,8 +693,8 @@ func (c *ClientWithFallback) CodeAt(ctx context.Context, account common.Address,
 	rpcstats.CountCall("eth_CodeAt")
 
 	code, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.CodeAt(ctx, account, blockNumber) },
-		func() (any, error) { return c.fallback.CodeAt(ctx, account, blockNumber) },
+		func() (any, error) { return c.mainClient().CodeAt(ctx, account, blockNumber) },
+		func() (any, error) { return c.fallbackClient().CodeAt(ctx, account, blockNumber) },
 		true,
 	)
 
@@ -562,8 +709,8 @@ func (c *ClientWithFallback) NonceAt(ctx context.Context, account common.Address
 	rpcstats.CountCall("eth_NonceAt")
 
 	nonce, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.NonceAt(ctx, account, blockNumber) },
-		func() (any, error) { return c.fallback.NonceAt(ctx, account, blockNumber) },
+		func() (any, error) { return c.mainClient().NonceAt(ctx, account, blockNumber) },
+		func() (any, error) { return c.fallbackClient().NonceAt(ctx, account, blockNumber) },
 		true,
 	)
 
@@ -578,8 +725,8 @@ func (c *ClientWithFallback) FilterLogs(ctx context.Context, q ethereum.FilterQu
 	rpcstats.CountCall("eth_FilterLogs")
 
 	logs, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.FilterLogs(ctx, q) },
-		func() (any, error) { return c.fallback.FilterLogs(ctx, q) },
+		func() (any, error) { return c.mainClient().FilterLogs(ctx, q) },
+		func() (any, error) { return c.fallbackClient().FilterLogs(ctx, q) },
 		true,
 	)
 
@@ -594,8 +741,8 @@ func (c *ClientWithFallback) SubscribeFilterLogs(ctx context.Context, q ethereum
 	rpcstats.CountCall("eth_SubscribeFilterLogs")
 
 	sub, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.SubscribeFilterLogs(ctx, q, ch) },
-		func() (any, error) { return c.fallback.SubscribeFilterLogs(ctx, q, ch) },
+		func() (any, error) { return c.mainClient().SubscribeFilterLogs(ctx, q, ch) },
+		func() (any, error) { return c.fallbackClient().SubscribeFilterLogs(ctx, q, ch) },
 		true,
 	)
 
@@ -610,8 +757,8 @@ func (c *ClientWithFallback) PendingBalanceAt(ctx context.Context, account commo
 	rpcstats.CountCall("eth_PendingBalanceAt")
 
 	balance, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.PendingBalanceAt(ctx, account) },
-		func() (any, error) { return c.fallback.PendingBalanceAt(ctx, account) },
+		func() (any, error) { return c.mainClient().PendingBalanceAt(ctx, account) },
+		func() (any, error) { return c.fallbackClient().PendingBalanceAt(ctx, account) },
 		true,
 	)
 
@@ -626,8 +773,8 @@ func (c *ClientWithFallback) PendingStorageAt(ctx context.Context, account commo
 	rpcstats.CountCall("eth_PendingStorageAt")
 
 	storage, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.PendingStorageAt(ctx, account, key) },
-		func() (any, error) { return c.fallback.PendingStorageAt(ctx, account, key) },
+		func() (any, error) { return c.mainClient().PendingStorageAt(ctx, account, key) },
+		func() (any, error) { return c.fallbackClient().PendingStorageAt(ctx, account, key) },
 		true,
 	)
 
@@ -642,8 +789,8 @@ func (c *ClientWithFallback) PendingCodeAt(ctx context.Context, account common.A
 	rpcstats.CountCall("eth_PendingCodeAt")
 
 	code, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.PendingCodeAt(ctx, account) },
-		func() (any, error) { return c.fallback.PendingCodeAt(ctx, account) },
+		func() (any, error) { return c.mainClient().PendingCodeAt(ctx, account) },
+		func() (any, error) { return c.fallbackClient().PendingCodeAt(ctx, account) },
 		true,
 	)
 
@@ -658,8 +805,8 @@ func (c *ClientWithFallback) PendingNonceAt(ctx context.Context, account common.
 	rpcstats.CountCall("eth_PendingNonceAt")
 
 	nonce, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.PendingNonceAt(ctx, account) },
-		func() (any, error) { return c.fallback.PendingNonceAt(ctx, account) },
+		func() (any, error) { return c.mainClient().PendingNonceAt(ctx, account) },
+		func() (any, error) { return c.fallbackClient().PendingNonceAt(ctx, account) },
 		true,
 	)
 
@@ -674,8 +821,8 @@ func (c *ClientWithFallback) PendingTransactionCount(ctx context.Context) (uint,
 	rpcstats.CountCall("eth_PendingTransactionCount")
 
 	count, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.PendingTransactionCount(ctx) },
-		func() (any, error) { return c.fallback.PendingTransactionCount(ctx) },
+		func() (any, error) { return c.mainClient().PendingTransactionCount(ctx) },
+		func() (any, error) { return c.fallbackClient().PendingTransactionCount(ctx) },
 		true,
 	)
 
@@ -690,8 +837,12 @@ func (c *ClientWithFallback) CallContract(ctx context.Context, msg ethereum.Call
 	rpcstats.CountCall("eth_CallContract")
 
 	data, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.CallContract(ctx, msg, blockNumber) },
-		func() (any, error) { return c.fallback.CallContract(ctx, msg, blockNumber) },
+		func() (any, error) {
+			var data hexutil.Bytes
+			err := c.mainBatcher.CallContext(ctx, &data, "eth_call", toCallArg(msg), toBlockNumArg(blockNumber))
+			return []byte(data), err
+		},
+		func() (any, error) { return c.fallbackClient().CallContract(ctx, msg, blockNumber) },
 		true,
 	)
 
@@ -706,8 +857,8 @@ func (c *ClientWithFallback) CallContractAtHash(ctx context.Context, msg ethereu
 	rpcstats.CountCall("eth_CallContractAtHash")
 
 	data, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.CallContractAtHash(ctx, msg, blockHash) },
-		func() (any, error) { return c.fallback.CallContractAtHash(ctx, msg, blockHash) },
+		func() (any, error) { return c.mainClient().CallContractAtHash(ctx, msg, blockHash) },
+		func() (any, error) { return c.fallbackClient().CallContractAtHash(ctx, msg, blockHash) },
 		true,
 	)
 
@@ -722,8 +873,8 @@ func (c *ClientWithFallback) PendingCallContract(ctx context.Context, msg ethere
 	rpcstats.CountCall("eth_PendingCallContract")
 
 	data, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.PendingCallContract(ctx, msg) },
-		func() (any, error) { return c.fallback.PendingCallContract(ctx, msg) },
+		func() (any, error) { return c.mainClient().PendingCallContract(ctx, msg) },
+		func() (any, error) { return c.fallbackClient().PendingCallContract(ctx, msg) },
 		true,
 	)
 
@@ -738,8 +889,8 @@ func (c *ClientWithFallback) SuggestGasPrice(ctx context.Context) (*big.Int, err
 	rpcstats.CountCall("eth_SuggestGasPrice")
 
 	gasPrice, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.SuggestGasPrice(ctx) },
-		func() (any, error) { return c.fallback.SuggestGasPrice(ctx) },
+		func() (any, error) { return c.mainClient().SuggestGasPrice(ctx) },
+		func() (any, error) { return c.fallbackClient().SuggestGasPrice(ctx) },
 		true,
 	)
 
@@ -754,8 +905,8 @@ func (c *ClientWithFallback) SuggestGasTipCap(ctx context.Context) (*big.Int, er
 	rpcstats.CountCall("eth_SuggestGasTipCap")
 
 	tip, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.SuggestGasTipCap(ctx) },
-		func() (any, error) { return c.fallback.SuggestGasTipCap(ctx) },
+		func() (any, error) { return c.mainClient().SuggestGasTipCap(ctx) },
+		func() (any, error) { return c.fallbackClient().SuggestGasTipCap(ctx) },
 		true,
 	)
 
@@ -770,8 +921,10 @@ func (c *ClientWithFallback) FeeHistory(ctx context.Context, blockCount uint64,
 	rpcstats.CountCall("eth_FeeHistory")
 
 	feeHistory, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles) },
-		func() (any, error) { return c.fallback.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles) },
+		func() (any, error) { return c.mainClient().FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles) },
+		func() (any, error) {
+			return c.fallbackClient().FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+		},
 		true,
 	)
 
@@ -786,8 +939,8 @@ func (c *ClientWithFallback) EstimateGas(ctx context.Context, msg ethereum.CallM
 	rpcstats.CountCall("eth_EstimateGas")
 
 	estimate, err := c.makeCallSingleReturn(
-		func() (any, error) { return c.main.EstimateGas(ctx, msg) },
-		func() (any, error) { return c.fallback.EstimateGas(ctx, msg) },
+		func() (any, error) { return c.mainClient().EstimateGas(ctx, msg) },
+		func() (any, error) { return c.fallbackClient().EstimateGas(ctx, msg) },
 		true,
 	)
 
@@ -801,19 +954,61 @@ func (c *ClientWithFallback) EstimateGas(ctx context.Context, msg ethereum.CallM
 func (c *ClientWithFallback) SendTransaction(ctx context.Context, tx *types.Transaction) error {
 	rpcstats.CountCall("eth_SendTransaction")
 
-	return c.makeCallNoReturn(
-		func() error { return c.main.SendTransaction(ctx, tx) },
-		func() error { return c.fallback.SendTransaction(ctx, tx) },
+	err := c.makeCallNoReturn(
+		func() error { return c.mainClient().SendTransaction(ctx, tx) },
+		func() error { return c.fallbackClient().SendTransaction(ctx, tx) },
 	)
+	if err == nil {
+		c.notifySentTransaction(tx)
+	}
+	return err
+}
+
+func (c *ClientWithFallback) notifySentTransaction(tx *types.Transaction) {
+	if c.SentTransactionNotifier != nil {
+		c.SentTransactionNotifier(c.ChainID, tx)
+	}
+}
+
+// rawTransaction decodes the argument of eth_sendRawTransaction
+func rawTransaction(arg interface{}) (*types.Transaction, error) {
+	var data []byte
+	switch v := arg.(type) {
+	case string:
+		decoded, err := hexutil.Decode(v)
+		if err != nil {
+			return nil, err
+		}
+		data = decoded
+	case hexutil.Bytes:
+		data = v
+	case []byte:
+		data = v
+	default:
+		return nil, fmt.Errorf("unexpected raw transaction type %T", arg)
+	}
+
+	tx := new(types.Transaction)
+	err := tx.UnmarshalBinary(data)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
 }
 
 func (c *ClientWithFallback) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
 	rpcstats.CountCall("eth_CallContext")
 
-	return c.makeCallNoReturn(
-		func() error { return c.mainRPC.CallContext(ctx, result, method, args...) },
-		func() error { return c.fallbackRPC.CallContext(ctx, result, method, args...) },
+	err := c.makeCallNoReturn(
+		func() error { return c.mainBatcher.CallContext(ctx, result, method, args...) },
+		func() error { return c.fallbackRPCClient().CallContext(ctx, result, method, args...) },
 	)
+	if err == nil && method == "eth_sendRawTransaction" && len(args) > 0 {
+		if tx, err := rawTransaction(args[0]); err == nil {
+			c.notifySentTransaction(tx)
+		}
+	}
+	return err
 }
 
 func (c *ClientWithFallback) ToBigInt() *big.Int {
@@ -823,7 +1018,7 @@ func (c *ClientWithFallback) ToBigInt() *big.Int {
 func (c *ClientWithFallback) GetBaseFeeFromBlock(blockNumber *big.Int) (string, error) {
 	rpcstats.CountCall("eth_GetBaseFeeFromBlock")
 	var feeHistory FeeHistory
-	err := c.mainRPC.Call(&feeHistory, "eth_feeHistory", "0x1", (*hexutil.Big)(blockNumber), nil)
+	err := c.mainRPCClient().Call(&feeHistory, "eth_feeHistory", "0x1", (*hexutil.Big)(blockNumber), nil)
 	if err != nil {
 		if err.Error() == "the method eth_feeHistory does not exist/is not available" {
 			return "", nil
@@ -847,10 +1042,10 @@ func (c *ClientWithFallback) FullTransactionByBlockNumberAndIndex(ctx context.Co
 
 	tx, err := c.makeCallSingleReturn(
 		func() (any, error) {
-			return callFullTransactionByBlockNumberAndIndex(ctx, c.mainRPC, blockNumber, index)
+			return callFullTransactionByBlockNumberAndIndex(ctx, c.mainRPCClient(), blockNumber, index)
 		},
 		func() (any, error) {
-			return callFullTransactionByBlockNumberAndIndex(ctx, c.fallbackRPC, blockNumber, index)
+			return callFullTransactionByBlockNumberAndIndex(ctx, c.fallbackRPCClient(), blockNumber, index)
 		},
 		true,
 	)
@@ -0,0 +1,189 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/afex/hystrix-go/hystrix"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// throttleBackoff is how long a provider which answered with 429 Too Many
+// Requests is skipped
+const throttleBackoff = time.Minute
+
+var ErrProviderUnavailable = errors.New("rpc provider quota exceeded")
+
+// Quota counts the daily requests to a provider, it's shared by the chains
+// the provider serves as the quotas of the providers are per account. The
+// counters are kept in memory and start over every day at midnight UTC
+type Quota struct {
+	Name string
+
+	mu             sync.Mutex
+	limit          uint64
+	day            string
+	requests       uint64
+	throttledUntil time.Time
+}
+
+func NewQuota(name string, limit uint64) *Quota {
+	return &Quota{Name: name, limit: limit}
+}
+
+func today() string {
+	return time.Now().UTC().Format("2006-01-02")
+}
+
+// rollover resets the counters on a new day, it must be called with the lock
+// held
+func (q *Quota) rollover() {
+	if day := today(); day != q.day {
+		q.day = day
+		q.requests = 0
+	}
+}
+
+func (q *Quota) SetLimit(limit uint64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.limit = limit
+}
+
+// Exhausted reports whether the daily quota is used up or the provider is
+// throttling the requests
+func (q *Quota) Exhausted() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	return (q.limit > 0 && q.requests >= q.limit) || time.Now().Before(q.throttledUntil)
+}
+
+func (q *Quota) use() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	q.requests++
+}
+
+func (q *Quota) throttle() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.throttledUntil = time.Now().Add(throttleBackoff)
+}
+
+// Provider is an RPC provider serving a chain, it has its own circuit so that
+// the traffic shifts to the fallback provider when it fails
+type Provider struct {
+	ChainID uint64
+	Name    string
+	circuit string
+	quota   *Quota
+
+	mu          sync.Mutex
+	day         string
+	requests    uint64
+	errors      uint64
+	lastError   string
+	lastErrorAt int64
+}
+
+// ProviderStatus is the state of a provider as shown to the clients, a chain
+// whose providers are all unavailable serves degraded data
+type ProviderStatus struct {
+	ChainID        uint64 `json:"chainId"`
+	Name           string `json:"name"`
+	CircuitOpen    bool   `json:"circuitOpen"`
+	QuotaExhausted bool   `json:"quotaExhausted"`
+	DailyQuota     uint64 `json:"dailyQuota"`
+	// QuotaUsed is the number of requests today to the provider on all the
+	// chains
+	QuotaUsed     uint64 `json:"quotaUsed"`
+	RequestsToday uint64 `json:"requestsToday"`
+	ErrorsToday   uint64 `json:"errorsToday"`
+	LastError     string `json:"lastError,omitempty"`
+	LastErrorAt   int64  `json:"lastErrorAt,omitempty"`
+}
+
+func NewProvider(chainID uint64, name string, quota *Quota) *Provider {
+	circuit := fmt.Sprintf("ethClient_%d_%s", chainID, name)
+	hystrix.ConfigureCommand(circuit, hystrix.CommandConfig{
+		Timeout:               20000,
+		MaxConcurrentRequests: 100,
+		SleepWindow:           300000,
+		ErrorPercentThreshold: 25,
+	})
+	return &Provider{
+		ChainID: chainID,
+		Name:    name,
+		circuit: circuit,
+		quota:   quota,
+	}
+}
+
+func (p *Provider) circuitOpen() bool {
+	circuit, _, err := hystrix.GetCircuit(p.circuit)
+	return err == nil && circuit.IsOpen()
+}
+
+// Available reports whether the provider can be called, its circuit is
+// closed and its quota isn't exhausted
+func (p *Provider) Available() bool {
+	return !p.circuitOpen() && !p.quota.Exhausted()
+}
+
+// record counts a request to the provider, the errors of the calls which
+// reached the chain aren't failures of the provider
+func (p *Provider) record(err error) {
+	p.quota.use()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if day := today(); day != p.day {
+		p.day = day
+		p.requests = 0
+		p.errors = 0
+	}
+	p.requests++
+	if err == nil || isVMError(err) {
+		return
+	}
+	p.errors++
+	p.lastError = err.Error()
+	p.lastErrorAt = time.Now().Unix()
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests {
+		p.quota.throttle()
+	}
+}
+
+func (p *Provider) Status() *ProviderStatus {
+	exhausted := p.quota.Exhausted()
+
+	p.quota.mu.Lock()
+	limit, used := p.quota.limit, p.quota.requests
+	p.quota.mu.Unlock()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	status := &ProviderStatus{
+		ChainID:        p.ChainID,
+		Name:           p.Name,
+		CircuitOpen:    p.circuitOpen(),
+		QuotaExhausted: exhausted,
+		DailyQuota:     limit,
+		QuotaUsed:      used,
+		LastError:      p.lastError,
+		LastErrorAt:    p.lastErrorAt,
+	}
+	if p.day == today() {
+		status.RequestsToday = p.requests
+		status.ErrorsToday = p.errors
+	}
+	return status
+}
@@ -0,0 +1,88 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newBlockNumberServer answers eth_blockNumber with blockNumber, or with 429
+// Too Many Requests when throttled is set
+func newBlockNumberServer(blockNumber uint64, throttled *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if throttled != nil && atomic.LoadInt32(throttled) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		var req rpcRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x%x"}`, req.ID, blockNumber)
+	}))
+}
+
+func newTestClientWithProviders(t *testing.T, chainID uint64, mainURL string, fallbackURL string, mainQuota *Quota) (*ClientWithFallback, *Provider) {
+	main, err := rpc.Dial(mainURL)
+	require.NoError(t, err)
+	fallback, err := rpc.Dial(fallbackURL)
+	require.NoError(t, err)
+
+	c := NewClient(main, fallback, chainID)
+	mainProvider := NewProvider(chainID, "main", mainQuota)
+	c.SetProviders(mainProvider, NewProvider(chainID, "fallback", NewQuota("fallback", 0)))
+	return c, mainProvider
+}
+
+func TestProviderQuota(t *testing.T) {
+	main := newBlockNumberServer(1, nil)
+	defer main.Close()
+	fallback := newBlockNumberServer(2, nil)
+	defer fallback.Close()
+
+	c, mainProvider := newTestClientWithProviders(t, 1001, main.URL, fallback.URL, NewQuota("main", 2))
+
+	for _, expected := range []uint64{1, 1, 2} {
+		blockNumber, err := c.BlockNumber(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, expected, blockNumber)
+	}
+
+	status := mainProvider.Status()
+	require.True(t, status.QuotaExhausted)
+	require.Equal(t, uint64(2), status.QuotaUsed)
+	require.Equal(t, uint64(2), status.RequestsToday)
+	require.False(t, mainProvider.Available())
+}
+
+func TestProviderThrottled(t *testing.T) {
+	var throttled int32 = 1
+	main := newBlockNumberServer(1, &throttled)
+	defer main.Close()
+	fallback := newBlockNumberServer(2, nil)
+	defer fallback.Close()
+
+	c, mainProvider := newTestClientWithProviders(t, 1002, main.URL, fallback.URL, NewQuota("main", 0))
+
+	blockNumber, err := c.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), blockNumber)
+
+	// The throttled provider is skipped even once it recovers
+	atomic.StoreInt32(&throttled, 0)
+	blockNumber, err = c.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), blockNumber)
+
+	status := mainProvider.Status()
+	require.True(t, status.QuotaExhausted)
+	require.Equal(t, uint64(1), status.ErrorsToday)
+	require.NotEmpty(t, status.LastError)
+}
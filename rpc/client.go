@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
@@ -42,9 +43,20 @@ type Client struct {
 	upstreamURL     string
 	UpstreamChainID uint64
 
-	local      *gethrpc.Client
-	upstream   *chain.ClientWithFallback
-	rpcClients map[uint64]*chain.ClientWithFallback
+	local         *gethrpc.Client
+	upstream      *chain.ClientWithFallback
+	rpcClientsMx  sync.Mutex // mx guards rpcClients and rpcClientURLs
+	rpcClients    map[uint64]*chain.ClientWithFallback
+	rpcClientURLs map[uint64][]string
+
+	endpointsMx        sync.RWMutex // mx guards endpointStats
+	endpointStats      map[uint64]map[string]*EndpointStats
+	healthChecksCancel context.CancelFunc
+
+	providersMx sync.Mutex // mx guards providers, quotas and quotaLimits
+	providers   map[uint64]map[string]*chain.Provider
+	quotas      map[string]*chain.Quota
+	quotaLimits map[string]uint64
 
 	router         *router
 	NetworkManager *network.Manager
@@ -54,6 +66,9 @@ type Client struct {
 	log        log.Logger
 
 	walletNotifier func(chainID uint64, message string)
+	// sentTransactionNotifier is called with the transactions sent through
+	// the chain clients
+	sentTransactionNotifier func(chainID uint64, tx *types.Transaction)
 }
 
 // Is initialized in a build-tag-dependent module
@@ -79,6 +94,11 @@ func NewClient(client *gethrpc.Client, upstreamChainID uint64, upstream params.U
 		NetworkManager: networkManager,
 		handlers:       make(map[string]Handler),
 		rpcClients:     make(map[uint64]*chain.ClientWithFallback),
+		rpcClientURLs:  make(map[uint64][]string),
+		endpointStats:  make(map[uint64]map[string]*EndpointStats),
+		providers:      make(map[uint64]map[string]*chain.Provider),
+		quotas:         make(map[string]*chain.Quota),
+		quotaLimits:    make(map[string]uint64),
 		log:            log,
 	}
 
@@ -106,11 +126,32 @@ func (c *Client) SetWalletNotifier(notifier func(chainID uint64, message string)
 	c.walletNotifier = notifier
 }
 
+// SetSentTransactionNotifier sets the function called with every transaction
+// sent through the chain clients, whatever service sent it
+func (c *Client) SetSentTransactionNotifier(notifier func(chainID uint64, tx *types.Transaction)) {
+	c.rpcClientsMx.Lock()
+	defer c.rpcClientsMx.Unlock()
+
+	c.sentTransactionNotifier = notifier
+	for _, client := range c.rpcClients {
+		client.SentTransactionNotifier = notifier
+	}
+	if c.upstream != nil {
+		c.upstream.SentTransactionNotifier = notifier
+	}
+}
+
 func (c *Client) getClientUsingCache(chainID uint64) (*chain.ClientWithFallback, error) {
+	c.rpcClientsMx.Lock()
+	defer c.rpcClientsMx.Unlock()
+
 	if rpcClient, ok := c.rpcClients[chainID]; ok {
 		if rpcClient.WalletNotifier == nil {
 			rpcClient.WalletNotifier = c.walletNotifier
 		}
+		if rpcClient.SentTransactionNotifier == nil {
+			rpcClient.SentTransactionNotifier = c.sentTransactionNotifier
+		}
 		return rpcClient, nil
 	}
 
@@ -122,22 +163,17 @@ func (c *Client) getClientUsingCache(chainID uint64) (*chain.ClientWithFallback,
 		return nil, fmt.Errorf("could not find network: %d", chainID)
 	}
 
-	rpcClient, err := gethrpc.Dial(network.RPCURL)
+	rpcClient, rpcFallbackClient, urls, err := c.dialEndpoints(network)
 	if err != nil {
-		return nil, fmt.Errorf("dial upstream server: %s", err)
-	}
-
-	var rpcFallbackClient *gethrpc.Client
-	if len(network.FallbackURL) > 0 {
-		rpcFallbackClient, err = gethrpc.Dial(network.FallbackURL)
-		if err != nil {
-			return nil, fmt.Errorf("dial upstream server: %s", err)
-		}
+		return nil, err
 	}
 
 	client := chain.NewClient(rpcClient, rpcFallbackClient, chainID)
 	client.WalletNotifier = c.walletNotifier
+	client.SentTransactionNotifier = c.sentTransactionNotifier
+	c.setProviders(client, urls)
 	c.rpcClients[chainID] = client
+	c.rpcClientURLs[chainID] = urls
 	return client, nil
 }
 
@@ -0,0 +1,434 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/rpc/chain"
+)
+
+const (
+	healthCheckInterval = 5 * time.Minute
+	healthCheckTimeout  = 10 * time.Second
+	// latencyBucket is the latency difference below which healthy endpoints
+	// keep their configured order, so that they aren't swapped on every check
+	latencyBucket = 250 * time.Millisecond
+	// maxBlockLag is the number of blocks an endpoint can be behind the most
+	// synced one of its chain and still be healthy
+	maxBlockLag = 10
+)
+
+var ErrChainIDMismatch = errors.New("rpc endpoint serves another chain")
+
+// EndpointStats is the result of the last health check of an RPC endpoint
+type EndpointStats struct {
+	URL string `json:"url"`
+	// Custom is set for the endpoints added by the user
+	Custom      bool   `json:"custom"`
+	Checked     bool   `json:"checked"`
+	Healthy     bool   `json:"healthy"`
+	LatencyMs   int64  `json:"latencyMs"`
+	BlockNumber uint64 `json:"blockNumber"`
+	Error       string `json:"error,omitempty"`
+	CheckedAt   int64  `json:"checkedAt"`
+
+	err error
+}
+
+func (e *EndpointStats) setError(err error) {
+	e.err = err
+	e.Error = err.Error()
+}
+
+func (e *EndpointStats) rank() int {
+	switch {
+	case e.Checked && e.Healthy:
+		return 0
+	case !e.Checked:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// orderEndpoints sorts the endpoints in the order they are used, the healthy
+// ones first and the fastest of them first
+func orderEndpoints(endpoints []*EndpointStats) {
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		if endpoints[i].rank() != endpoints[j].rank() {
+			return endpoints[i].rank() < endpoints[j].rank()
+		}
+		if endpoints[i].rank() != 0 {
+			return false
+		}
+		bucket := latencyBucket.Milliseconds()
+		return endpoints[i].LatencyMs/bucket < endpoints[j].LatencyMs/bucket
+	})
+}
+
+// markLaggingEndpoints marks unhealthy the endpoints too far behind the most
+// synced endpoint of the chain
+func markLaggingEndpoints(endpoints []*EndpointStats) {
+	var highest uint64
+	for _, endpoint := range endpoints {
+		if endpoint.Healthy && endpoint.BlockNumber > highest {
+			highest = endpoint.BlockNumber
+		}
+	}
+	for _, endpoint := range endpoints {
+		if endpoint.Healthy && endpoint.BlockNumber+maxBlockLag < highest {
+			endpoint.Healthy = false
+			endpoint.setError(fmt.Errorf("%d blocks behind", highest-endpoint.BlockNumber))
+		}
+	}
+}
+
+// checkEndpoint measures the latency of the endpoint and checks that it
+// serves the chain
+func checkEndpoint(ctx context.Context, url string, chainID uint64) *EndpointStats {
+	stats := &EndpointStats{
+		URL:       url,
+		Checked:   true,
+		CheckedAt: time.Now().Unix(),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	client, err := gethrpc.DialContext(ctx, url)
+	if err != nil {
+		stats.setError(err)
+		return stats
+	}
+	defer client.Close()
+
+	var remoteChainID hexutil.Uint64
+	err = client.CallContext(ctx, &remoteChainID, "eth_chainId")
+	if err != nil {
+		stats.setError(err)
+		return stats
+	}
+	if uint64(remoteChainID) != chainID {
+		stats.setError(ErrChainIDMismatch)
+		return stats
+	}
+
+	start := time.Now()
+	var blockNumber hexutil.Uint64
+	err = client.CallContext(ctx, &blockNumber, "eth_blockNumber")
+	if err != nil {
+		stats.setError(err)
+		return stats
+	}
+
+	stats.LatencyMs = time.Since(start).Milliseconds()
+	stats.BlockNumber = uint64(blockNumber)
+	stats.Healthy = true
+	return stats
+}
+
+// endpointURLs returns the endpoints of the network, the ones added by the
+// user before the ones of the node config
+func (c *Client) endpointURLs(network *params.Network) ([]string, map[string]bool) {
+	urls := make([]string, 0)
+	custom := make(map[string]bool)
+	seen := make(map[string]bool)
+	add := func(url string, isCustom bool) {
+		if url == "" || seen[url] {
+			return
+		}
+		seen[url] = true
+		custom[url] = isCustom
+		urls = append(urls, url)
+	}
+
+	customURLs, err := c.NetworkManager.GetCustomRPCURLs(network.ChainID)
+	if err != nil {
+		c.log.Error("failed to get custom rpc urls", "chainID", network.ChainID, "error", err)
+	}
+	for _, url := range customURLs {
+		add(url, true)
+	}
+	add(network.RPCURL, false)
+	add(network.FallbackURL, false)
+	return urls, custom
+}
+
+// orderedEndpoints returns the endpoints of the network with the stats of
+// their last health check, in the order they are used
+func (c *Client) orderedEndpoints(network *params.Network) []*EndpointStats {
+	urls, custom := c.endpointURLs(network)
+
+	c.endpointsMx.RLock()
+	defer c.endpointsMx.RUnlock()
+
+	endpoints := make([]*EndpointStats, 0, len(urls))
+	for _, url := range urls {
+		stats := &EndpointStats{URL: url}
+		if checked, ok := c.endpointStats[network.ChainID][url]; ok {
+			copied := *checked
+			stats = &copied
+		}
+		stats.Custom = custom[url]
+		if c.endpointQuota(url).Exhausted() {
+			stats.Checked = true
+			stats.Healthy = false
+			stats.setError(chain.ErrProviderUnavailable)
+		}
+		endpoints = append(endpoints, stats)
+	}
+	orderEndpoints(endpoints)
+	return endpoints
+}
+
+// dialEndpoints dials the two first endpoints of the network, the first one
+// is the main endpoint of the chain client and the second its fallback
+func (c *Client) dialEndpoints(network *params.Network) (*gethrpc.Client, *gethrpc.Client, []string, error) {
+	endpoints := c.orderedEndpoints(network)
+	if len(endpoints) == 0 {
+		return nil, nil, nil, fmt.Errorf("no rpc url for network: %d", network.ChainID)
+	}
+
+	urls := []string{endpoints[0].URL}
+	main, err := gethrpc.Dial(endpoints[0].URL)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("dial upstream server: %s", err)
+	}
+
+	var fallback *gethrpc.Client
+	if len(endpoints) > 1 {
+		urls = append(urls, endpoints[1].URL)
+		fallback, err = gethrpc.Dial(endpoints[1].URL)
+		if err != nil {
+			main.Close()
+			return nil, nil, nil, fmt.Errorf("dial upstream server: %s", err)
+		}
+	}
+	return main, fallback, urls, nil
+}
+
+func sameURLs(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// reorderEndpoints switches the chain client to the best endpoints of the
+// network, when they changed since it was created
+func (c *Client) reorderEndpoints(network *params.Network) error {
+	c.rpcClientsMx.Lock()
+	defer c.rpcClientsMx.Unlock()
+
+	client, ok := c.rpcClients[network.ChainID]
+	if !ok {
+		// The chain client isn't used yet, it picks the best endpoints when
+		// created
+		return nil
+	}
+
+	endpoints := c.orderedEndpoints(network)
+	current := c.rpcClientURLs[network.ChainID]
+	if len(endpoints) == 0 || sameURLs(current, urlsOf(endpoints)) {
+		return nil
+	}
+
+	main, fallback, urls, err := c.dialEndpoints(network)
+	if err != nil {
+		return err
+	}
+	oldMain, oldFallback := client.SetEndpoints(main, fallback)
+	c.setProviders(client, urls)
+	c.rpcClientURLs[network.ChainID] = urls
+	c.log.Info("switched rpc endpoints", "chainID", network.ChainID, "providers", providerNames(urls))
+
+	// Let the pending calls of the replaced endpoints complete
+	time.AfterFunc(DefaultCallTimeout, func() {
+		oldMain.Close()
+		if oldFallback != nil {
+			oldFallback.Close()
+		}
+	})
+	return nil
+}
+
+// urlsOf returns the URLs of the endpoints a chain client uses, its main
+// endpoint and its fallback
+func urlsOf(endpoints []*EndpointStats) []string {
+	urls := make([]string, 0, 2)
+	for i := 0; i < len(endpoints) && i < 2; i++ {
+		urls = append(urls, endpoints[i].URL)
+	}
+	return urls
+}
+
+// providerNames returns the hosts of the endpoints, their URLs hold the API
+// keys of the providers so they aren't logged
+func providerNames(urls []string) []string {
+	names := make([]string, 0, len(urls))
+	for _, rawURL := range urls {
+		u, err := url.Parse(rawURL)
+		if err != nil || u.Host == "" {
+			names = append(names, "unknown")
+			continue
+		}
+		names = append(names, u.Hostname())
+	}
+	return names
+}
+
+// CheckEndpoints checks the health of the endpoints of the network and
+// switches its chain client to the best ones
+func (c *Client) CheckEndpoints(ctx context.Context, chainID uint64) ([]*EndpointStats, error) {
+	network := c.NetworkManager.Find(chainID)
+	if network == nil {
+		return nil, fmt.Errorf("could not find network: %d", chainID)
+	}
+
+	urls, _ := c.endpointURLs(network)
+	results := make([]*EndpointStats, len(urls))
+	done := make(chan struct{}, len(urls))
+	for i, url := range urls {
+		go func(i int, url string) {
+			results[i] = checkEndpoint(ctx, url, chainID)
+			done <- struct{}{}
+		}(i, url)
+	}
+	for range urls {
+		<-done
+	}
+	markLaggingEndpoints(results)
+
+	c.endpointsMx.Lock()
+	stats := make(map[string]*EndpointStats, len(results))
+	for _, result := range results {
+		stats[result.URL] = result
+	}
+	c.endpointStats[chainID] = stats
+	c.endpointsMx.Unlock()
+
+	err := c.reorderEndpoints(network)
+	if err != nil {
+		return nil, err
+	}
+	return c.orderedEndpoints(network), nil
+}
+
+// ReloadEndpoints switches the chain client to the endpoints of the network
+// after it was edited
+func (c *Client) ReloadEndpoints(chainID uint64) error {
+	network := c.NetworkManager.Find(chainID)
+	if network == nil {
+		return fmt.Errorf("could not find network: %d", chainID)
+	}
+	return c.reorderEndpoints(network)
+}
+
+// GetEndpoints returns the endpoints of the network with the stats of their
+// last health check, in the order they are used
+func (c *Client) GetEndpoints(chainID uint64) ([]*EndpointStats, error) {
+	network := c.NetworkManager.Find(chainID)
+	if network == nil {
+		return nil, fmt.Errorf("could not find network: %d", chainID)
+	}
+	return c.orderedEndpoints(network), nil
+}
+
+// AddCustomRPCURL adds an RPC endpoint to the network, it is rejected when
+// it serves another chain
+func (c *Client) AddCustomRPCURL(ctx context.Context, chainID uint64, url string) error {
+	err := c.validateCustomRPCURL(ctx, chainID, url)
+	if err != nil {
+		return err
+	}
+	err = c.NetworkManager.AddCustomRPCURL(chainID, url)
+	if err != nil {
+		return err
+	}
+	_, err = c.CheckEndpoints(ctx, chainID)
+	return err
+}
+
+func (c *Client) UpdateCustomRPCURL(ctx context.Context, chainID uint64, oldURL string, newURL string) error {
+	err := c.validateCustomRPCURL(ctx, chainID, newURL)
+	if err != nil {
+		return err
+	}
+	err = c.NetworkManager.UpdateCustomRPCURL(chainID, oldURL, newURL)
+	if err != nil {
+		return err
+	}
+	_, err = c.CheckEndpoints(ctx, chainID)
+	return err
+}
+
+func (c *Client) RemoveCustomRPCURL(ctx context.Context, chainID uint64, url string) error {
+	err := c.NetworkManager.RemoveCustomRPCURL(chainID, url)
+	if err != nil {
+		return err
+	}
+	_, err = c.CheckEndpoints(ctx, chainID)
+	return err
+}
+
+// validateCustomRPCURL rejects the endpoints serving another chain, the
+// unreachable ones are accepted and left unhealthy until they recover
+func (c *Client) validateCustomRPCURL(ctx context.Context, chainID uint64, url string) error {
+	if c.NetworkManager.Find(chainID) == nil {
+		return fmt.Errorf("could not find network: %d", chainID)
+	}
+	stats := checkEndpoint(ctx, url, chainID)
+	if stats.err == ErrChainIDMismatch {
+		return ErrChainIDMismatch
+	}
+	return nil
+}
+
+// StartHealthChecks periodically checks the endpoints of the enabled
+// networks and fails over to the healthy ones
+func (c *Client) StartHealthChecks() {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.healthChecksCancel = cancel
+
+	go func() {
+		ticker := time.NewTicker(healthCheckInterval)
+		defer ticker.Stop()
+		for {
+			networks, err := c.NetworkManager.Get(true)
+			if err != nil {
+				c.log.Error("failed to get networks", "error", err)
+			}
+			for _, network := range networks {
+				_, err = c.CheckEndpoints(ctx, network.ChainID)
+				if err != nil && ctx.Err() == nil {
+					c.log.Warn("failed to check rpc endpoints", "chainID", network.ChainID, "error", err)
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (c *Client) StopHealthChecks() {
+	if c.healthChecksCancel != nil {
+		c.healthChecksCancel()
+	}
+}
@@ -3,11 +3,17 @@ package network
 import (
 	"bytes"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/status-im/status-go/params"
 )
 
+var (
+	ErrCustomRPCURLNotFound = errors.New("custom rpc url not found")
+	ErrNetworkNotActive     = errors.New("network isn't part of the active mode")
+)
+
 const baseQuery = "SELECT chain_id, chain_name, rpc_url, fallback_url, block_explorer_url, icon_url, native_currency_name, native_currency_symbol, native_currency_decimals, is_test, layer, enabled, chain_color, short_name FROM networks"
 
 func newNetworksQuery() *networksQuery {
@@ -46,6 +52,16 @@ func (nq *networksQuery) filterChainID(chainID uint64) *networksQuery {
 	return nq
 }
 
+// filterActiveMode keeps the test networks when the testnet mode is on, the
+// others otherwise. The mode is read in the same query so that a switch
+// never returns networks of both modes
+func (nq *networksQuery) filterActiveMode() *networksQuery {
+	nq.andOrWhere()
+	nq.added = true
+	nq.buf.WriteString(" is_test = COALESCE((SELECT test_networks_enabled FROM settings WHERE synthetic_id = 'id'), 0)")
+	return nq
+}
+
 func (nq *networksQuery) exec(db *sql.DB) ([]*params.Network, error) {
 	rows, err := db.Query(nq.buf.String(), nq.args...)
 	if err != nil {
@@ -161,6 +177,10 @@ func (nm *Manager) Upsert(network *params.Network) error {
 
 func (nm *Manager) Delete(chainID uint64) error {
 	_, err := nm.db.Exec("DELETE FROM networks WHERE chain_id = ?", chainID)
+	if err != nil {
+		return err
+	}
+	_, err = nm.db.Exec("DELETE FROM network_custom_rpc_urls WHERE chain_id = ?", chainID)
 	return err
 }
 
@@ -191,6 +211,84 @@ func (nm *Manager) Get(onlyEnabled bool) ([]*params.Network, error) {
 	return query.exec(nm.db)
 }
 
+// GetActive is Get restricted to the networks of the active mode, the test
+// networks when the testnet mode is on
+func (nm *Manager) GetActive(onlyEnabled bool) ([]*params.Network, error) {
+	query := newNetworksQuery().filterActiveMode()
+	if onlyEnabled {
+		query.filterEnabled(true)
+	}
+
+	return query.exec(nm.db)
+}
+
+// FindActive is Find restricted to the networks of the active mode
+func (nm *Manager) FindActive(chainID uint64) (*params.Network, error) {
+	networks, err := newNetworksQuery().filterChainID(chainID).filterActiveMode().exec(nm.db)
+	if err != nil {
+		return nil, err
+	}
+	if len(networks) != 1 {
+		return nil, ErrNetworkNotActive
+	}
+	return networks[0], nil
+}
+
 func (nm *Manager) GetConfiguredNetworks() []params.Network {
 	return nm.networks
 }
+
+// GetCustomRPCURLs returns the RPC endpoints added by the user for the chain,
+// in the order they were added
+func (nm *Manager) GetCustomRPCURLs(chainID uint64) ([]string, error) {
+	rows, err := nm.db.Query(`SELECT url FROM network_custom_rpc_urls WHERE chain_id = ? ORDER BY position`, chainID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	urls := make([]string, 0)
+	for rows.Next() {
+		var url string
+		err = rows.Scan(&url)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, url)
+	}
+	return urls, rows.Err()
+}
+
+func (nm *Manager) AddCustomRPCURL(chainID uint64, url string) error {
+	_, err := nm.db.Exec(`INSERT INTO network_custom_rpc_urls (chain_id, url, position)
+		SELECT ?, ?, COALESCE(MAX(position), 0) + 1 FROM network_custom_rpc_urls WHERE chain_id = ?`, chainID, url, chainID)
+	return err
+}
+
+// UpdateCustomRPCURL replaces a custom RPC endpoint, keeping its position
+func (nm *Manager) UpdateCustomRPCURL(chainID uint64, oldURL string, newURL string) error {
+	res, err := nm.db.Exec(`UPDATE network_custom_rpc_urls SET url = ? WHERE chain_id = ? AND url = ?`, newURL, chainID, oldURL)
+	if err != nil {
+		return err
+	}
+	return checkCustomRPCURLAffected(res)
+}
+
+func (nm *Manager) RemoveCustomRPCURL(chainID uint64, url string) error {
+	res, err := nm.db.Exec(`DELETE FROM network_custom_rpc_urls WHERE chain_id = ? AND url = ?`, chainID, url)
+	if err != nil {
+		return err
+	}
+	return checkCustomRPCURLAffected(res)
+}
+
+func checkCustomRPCURLAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrCustomRPCURLNotFound
+	}
+	return nil
+}
@@ -92,6 +92,29 @@ func TestGet(t *testing.T) {
 	require.Equal(t, 2, len(networks))
 }
 
+func TestGetActive(t *testing.T) {
+	db, stop := setupTestNetworkDB(t)
+	defer stop()
+
+	nm := &Manager{db: db}
+	err := nm.Init(initNetworks)
+	require.NoError(t, err)
+
+	networks, err := nm.GetActive(false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(networks))
+	for _, network := range networks {
+		require.False(t, network.IsTest)
+	}
+
+	network, err := nm.FindActive(10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), network.ChainID)
+
+	_, err = nm.FindActive(5)
+	require.Equal(t, ErrNetworkNotActive, err)
+}
+
 func TestDelete(t *testing.T) {
 	db, stop := setupTestNetworkDB(t)
 	defer stop()
@@ -127,3 +150,32 @@ func TestUpsert(t *testing.T) {
 	require.NotNil(t, network)
 	require.Equal(t, newName, network.ChainName)
 }
+
+func TestCustomRPCURLs(t *testing.T) {
+	db, stop := setupTestNetworkDB(t)
+	defer stop()
+
+	nm := &Manager{db: db}
+	err := nm.Init(initNetworks)
+	require.NoError(t, err)
+
+	require.NoError(t, nm.AddCustomRPCURL(1, "https://a.example"))
+	require.NoError(t, nm.AddCustomRPCURL(1, "https://b.example"))
+	require.NoError(t, nm.AddCustomRPCURL(10, "https://c.example"))
+	require.Error(t, nm.AddCustomRPCURL(1, "https://a.example"))
+
+	require.NoError(t, nm.UpdateCustomRPCURL(1, "https://a.example", "https://d.example"))
+	require.Equal(t, ErrCustomRPCURLNotFound, nm.UpdateCustomRPCURL(1, "https://a.example", "https://e.example"))
+
+	urls, err := nm.GetCustomRPCURLs(1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://d.example", "https://b.example"}, urls)
+
+	require.NoError(t, nm.RemoveCustomRPCURL(1, "https://d.example"))
+	require.Equal(t, ErrCustomRPCURLNotFound, nm.RemoveCustomRPCURL(1, "https://d.example"))
+
+	require.NoError(t, nm.Delete(10))
+	urls, err = nm.GetCustomRPCURLs(10)
+	require.NoError(t, err)
+	require.Empty(t, urls)
+}
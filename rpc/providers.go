@@ -0,0 +1,125 @@
+package rpc
+
+import (
+	"net/url"
+	"sort"
+
+	"github.com/status-im/status-go/rpc/chain"
+)
+
+// ChainStatus is the state of the providers of a chain, the chain is degraded
+// when its main provider is unavailable and the traffic goes to the fallback
+type ChainStatus struct {
+	ChainID   uint64                  `json:"chainId"`
+	Connected bool                    `json:"connected"`
+	Degraded  bool                    `json:"degraded"`
+	Providers []*chain.ProviderStatus `json:"providers"`
+}
+
+// providerName returns the host of the endpoint, the quotas of the providers
+// are per host
+func providerName(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+	return u.Hostname()
+}
+
+func (c *Client) quota(name string) *chain.Quota {
+	quota, ok := c.quotas[name]
+	if !ok {
+		quota = chain.NewQuota(name, c.quotaLimits[name])
+		c.quotas[name] = quota
+	}
+	return quota
+}
+
+// endpointQuota returns the quota of the provider of the endpoint, it must
+// not be called with providersMx held
+func (c *Client) endpointQuota(rawURL string) *chain.Quota {
+	c.providersMx.Lock()
+	defer c.providersMx.Unlock()
+	return c.quota(providerName(rawURL))
+}
+
+func (c *Client) provider(chainID uint64, rawURL string) *chain.Provider {
+	c.providersMx.Lock()
+	defer c.providersMx.Unlock()
+
+	name := providerName(rawURL)
+	if _, ok := c.providers[chainID]; !ok {
+		c.providers[chainID] = make(map[string]*chain.Provider)
+	}
+	provider, ok := c.providers[chainID][name]
+	if !ok {
+		provider = chain.NewProvider(chainID, name, c.quota(name))
+		c.providers[chainID][name] = provider
+	}
+	return provider
+}
+
+// setProviders sets the providers of the endpoints the chain client uses, its
+// main endpoint and its fallback
+func (c *Client) setProviders(client *chain.ClientWithFallback, urls []string) {
+	var main, fallback *chain.Provider
+	if len(urls) > 0 {
+		main = c.provider(client.ChainID, urls[0])
+	}
+	if len(urls) > 1 {
+		fallback = c.provider(client.ChainID, urls[1])
+	}
+	client.SetProviders(main, fallback)
+}
+
+// SetProviderQuotas sets the daily number of requests of the providers, by
+// host. Providers without quota aren't limited
+func (c *Client) SetProviderQuotas(limits map[string]uint64) {
+	c.providersMx.Lock()
+	defer c.providersMx.Unlock()
+
+	c.quotaLimits = make(map[string]uint64, len(limits))
+	for name, limit := range limits {
+		c.quotaLimits[name] = limit
+	}
+	for name, quota := range c.quotas {
+		quota.SetLimit(c.quotaLimits[name])
+	}
+}
+
+// GetProvidersStatus returns the state of the providers of the chains in use,
+// so that the clients can tell when the network data is degraded
+func (c *Client) GetProvidersStatus() []*ChainStatus {
+	c.rpcClientsMx.Lock()
+	clients := make([]*chain.ClientWithFallback, 0, len(c.rpcClients))
+	for _, client := range c.rpcClients {
+		clients = append(clients, client)
+	}
+	c.rpcClientsMx.Unlock()
+
+	statuses := make([]*ChainStatus, 0, len(clients))
+	for _, client := range clients {
+		client.IsConnectedLock.RLock()
+		status := &ChainStatus{
+			ChainID:   client.ChainID,
+			Connected: client.IsConnected,
+			Providers: make([]*chain.ProviderStatus, 0, 2),
+		}
+		client.IsConnectedLock.RUnlock()
+
+		main, fallback := client.Providers()
+		if main != nil {
+			status.Providers = append(status.Providers, main.Status())
+			status.Degraded = !main.Available()
+		}
+		if fallback != nil {
+			status.Providers = append(status.Providers, fallback.Status())
+		}
+		statuses = append(statuses, status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].ChainID < statuses[j].ChainID
+	})
+	return statuses
+}
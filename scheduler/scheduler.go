@@ -0,0 +1,270 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/connection"
+)
+
+// Constraints are the conditions of the device in which a job is run, a job
+// is skipped until they are met again
+type Constraints struct {
+	// Foreground runs the job only while the app is in foreground
+	Foreground bool
+	// Unmetered runs the job only on connections which aren't expensive,
+	// like Wi-Fi
+	Unmetered bool
+	// Online runs the job only while the device is connected
+	Online bool
+	// NotOnBatterySaver skips the job while the battery saver is enabled
+	NotOnBatterySaver bool
+}
+
+// Job is a periodic task
+type Job struct {
+	// Name identifies the job in the logs
+	Name     string
+	Interval time.Duration
+	// BackgroundInterval replaces the interval while the app is in
+	// background, 0 keeps the interval
+	BackgroundInterval time.Duration
+	Constraints        Constraints
+	// Run is called when the job is due, its context is cancelled when the
+	// job is unscheduled
+	Run func(ctx context.Context)
+}
+
+// State is the state of the device the jobs depend on
+type State struct {
+	Background   bool
+	Connection   connection.State
+	BatterySaver bool
+}
+
+func (s State) allows(c Constraints) bool {
+	if c.Foreground && s.Background {
+		return false
+	}
+	if c.Online && s.Connection.Offline {
+		return false
+	}
+	if c.Unmetered && s.Connection.IsExpensive() {
+		return false
+	}
+	if c.NotOnBatterySaver && s.BatterySaver {
+		return false
+	}
+	return true
+}
+
+type scheduledJob struct {
+	job     Job
+	lastRun time.Time
+	running bool
+	ctx     context.Context
+	cancel  context.CancelFunc
+	// wg tracks the run in progress
+	wg sync.WaitGroup
+}
+
+func (j *scheduledJob) interval(state State) time.Duration {
+	if state.Background && j.job.BackgroundInterval > 0 {
+		return j.job.BackgroundInterval
+	}
+	return j.job.Interval
+}
+
+// Scheduler runs the periodic jobs of the services in a single loop, taking
+// into account the state of the app, of the connection and of the battery
+type Scheduler struct {
+	mu      sync.Mutex
+	state   State
+	jobs    map[uint64]*scheduledJob
+	nextID  uint64
+	wake    chan struct{}
+	quit    chan struct{}
+	running bool
+	now     func() time.Time
+}
+
+// New returns a scheduler, which must be started to run the jobs
+func New() *Scheduler {
+	return &Scheduler{
+		jobs: make(map[uint64]*scheduledJob),
+		wake: make(chan struct{}, 1),
+		now:  time.Now,
+	}
+}
+
+var (
+	defaultOnce      sync.Once
+	defaultScheduler *Scheduler
+)
+
+// Default returns the scheduler of the process, it's started on first use
+func Default() *Scheduler {
+	defaultOnce.Do(func() {
+		defaultScheduler = New()
+		defaultScheduler.Start()
+	})
+	return defaultScheduler
+}
+
+// Start starts the loop running the jobs
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		return
+	}
+	s.running = true
+	s.quit = make(chan struct{})
+	go s.loop(s.quit)
+}
+
+// Stop stops the loop, the jobs are kept and run again when it's restarted
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.running {
+		return
+	}
+	s.running = false
+	close(s.quit)
+}
+
+// Schedule adds the job, which is first run after its interval, and returns
+// the function unscheduling it. Unscheduling cancels the context of the run
+// in progress and waits for it to return, so it must not be called by the job
+func (s *Scheduler) Schedule(job Job) (unschedule func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	j := &scheduledJob{
+		job:     job,
+		lastRun: s.now(),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+
+	s.mu.Lock()
+	s.nextID++
+	id := s.nextID
+	s.jobs[id] = j
+	s.mu.Unlock()
+	s.notify()
+
+	return func() {
+		s.mu.Lock()
+		delete(s.jobs, id)
+		s.mu.Unlock()
+		cancel()
+		j.wg.Wait()
+	}
+}
+
+// State returns the state of the device the jobs depend on
+func (s *Scheduler) State() State {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state
+}
+
+// SetBackground is called when the app goes in background or foreground
+func (s *Scheduler) SetBackground(background bool) {
+	s.updateState(func(state *State) { state.Background = background })
+}
+
+// SetConnectionState is called when the network connection changes
+func (s *Scheduler) SetConnectionState(connectionState connection.State) {
+	s.updateState(func(state *State) { state.Connection = connectionState })
+}
+
+// SetBatterySaver is called when the battery saver is enabled or disabled
+func (s *Scheduler) SetBatterySaver(enabled bool) {
+	s.updateState(func(state *State) { state.BatterySaver = enabled })
+}
+
+func (s *Scheduler) updateState(update func(*State)) {
+	s.mu.Lock()
+	update(&s.state)
+	s.mu.Unlock()
+	s.notify()
+}
+
+// notify wakes the loop up to recompute the next due job
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// runDue starts the jobs which are due and allowed, and returns how long to
+// wait for the next one. The jobs skipped because of their constraints are
+// run as soon as they are met again
+func (s *Scheduler) runDue() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	next := time.Duration(-1)
+	for _, j := range s.jobs {
+		if !s.state.allows(j.job.Constraints) {
+			continue
+		}
+		wait := j.lastRun.Add(j.interval(s.state)).Sub(now)
+		if wait <= 0 {
+			if !j.running {
+				j.running = true
+				j.wg.Add(1)
+				go s.run(j)
+			}
+			wait = j.interval(s.state)
+		}
+		if next < 0 || wait < next {
+			next = wait
+		}
+	}
+	return next
+}
+
+func (s *Scheduler) run(j *scheduledJob) {
+	defer func() {
+		s.mu.Lock()
+		j.running = false
+		j.lastRun = s.now()
+		s.mu.Unlock()
+		j.wg.Done()
+	}()
+
+	log.Debug("running scheduled job", "name", j.job.Name)
+	j.job.Run(j.ctx)
+}
+
+func (s *Scheduler) loop(quit chan struct{}) {
+	timer := time.NewTimer(0)
+	<-timer.C
+	defer timer.Stop()
+
+	for {
+		var timeout <-chan time.Time
+		if wait := s.runDue(); wait >= 0 {
+			timer.Reset(wait)
+			timeout = timer.C
+		}
+
+		select {
+		case <-quit:
+			return
+		case <-s.wake:
+		case <-timeout:
+			continue
+		}
+		if timeout != nil && !timer.Stop() {
+			<-timer.C
+		}
+	}
+}
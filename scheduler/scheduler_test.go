@@ -0,0 +1,98 @@
+package scheduler
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/connection"
+)
+
+func TestScheduleRunsPeriodically(t *testing.T) {
+	s := New()
+	s.Start()
+	defer s.Stop()
+
+	var runs int32
+	unschedule := s.Schedule(Job{
+		Name:     "test",
+		Interval: 10 * time.Millisecond,
+		Run:      func(context.Context) { atomic.AddInt32(&runs, 1) },
+	})
+
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&runs) >= 3 }, time.Second, 5*time.Millisecond)
+
+	unschedule()
+	stopped := atomic.LoadInt32(&runs)
+	time.Sleep(50 * time.Millisecond)
+	require.LessOrEqual(t, atomic.LoadInt32(&runs), stopped+1)
+}
+
+func TestConstraintsSkipJobs(t *testing.T) {
+	s := New()
+	s.Start()
+	defer s.Stop()
+
+	s.SetBackground(true)
+	s.SetConnectionState(connection.State{Type: connection.NewType(connection.Cellular)})
+
+	var runs int32
+	unschedule := s.Schedule(Job{
+		Name:        "test",
+		Interval:    10 * time.Millisecond,
+		Constraints: Constraints{Foreground: true, Unmetered: true},
+		Run:         func(context.Context) { atomic.AddInt32(&runs, 1) },
+	})
+	defer unschedule()
+
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, int32(0), atomic.LoadInt32(&runs))
+
+	s.SetBackground(false)
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, int32(0), atomic.LoadInt32(&runs))
+
+	s.SetConnectionState(connection.State{Type: connection.NewType(connection.Wifi)})
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&runs) > 0 }, time.Second, 5*time.Millisecond)
+}
+
+func TestUnscheduleCancelsRunningJob(t *testing.T) {
+	s := New()
+	s.Start()
+	defer s.Stop()
+
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+	unschedule := s.Schedule(Job{
+		Name:     "test",
+		Interval: 10 * time.Millisecond,
+		Run: func(ctx context.Context) {
+			select {
+			case started <- struct{}{}:
+			default:
+			}
+			<-ctx.Done()
+			close(cancelled)
+		},
+	})
+
+	<-started
+	unschedule()
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("job wasn't cancelled")
+	}
+}
+
+func TestBackgroundInterval(t *testing.T) {
+	job := &scheduledJob{job: Job{Interval: time.Minute, BackgroundInterval: time.Hour}}
+	require.Equal(t, time.Minute, job.interval(State{}))
+	require.Equal(t, time.Hour, job.interval(State{Background: true}))
+
+	job.job.BackgroundInterval = 0
+	require.Equal(t, time.Minute, job.interval(State{Background: true}))
+}
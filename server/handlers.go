@@ -20,17 +20,21 @@ import (
 	"github.com/status-im/status-go/protocol/identity/identicon"
 	"github.com/status-im/status-go/protocol/identity/ring"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/video"
 )
 
 const (
-	basePath                 = "/messages"
-	identiconsPath           = basePath + "/identicons"
-	imagesPath               = basePath + "/images"
-	audioPath                = basePath + "/audio"
-	ipfsPath                 = "/ipfs"
-	discordAuthorsPath       = "/discord/authors"
-	discordAttachmentsPath   = basePath + "/discord/attachments"
-	LinkPreviewThumbnailPath = "/link-preview/thumbnail"
+	basePath                  = "/messages"
+	identiconsPath            = basePath + "/identicons"
+	imagesPath                = basePath + "/images"
+	audioPath                 = basePath + "/audio"
+	videoPath                 = basePath + "/video"
+	videoThumbnailsPath       = basePath + "/video/thumbnails"
+	ipfsPath                  = "/ipfs"
+	discordAuthorsPath        = "/discord/authors"
+	discordAttachmentsPath    = basePath + "/discord/attachments"
+	LinkPreviewThumbnailPath  = "/link-preview/thumbnail"
+	StatusLinkPreviewIconPath = "/status-link-preview/icon"
 
 	// Handler routes for pairing
 	accountImagesPath = "/accountImages"
@@ -409,6 +413,71 @@ func handleAudio(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
 	}
 }
 
+func handleVideo(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+	if db == nil {
+		return handleRequestDBMissing(logger)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		messageIDs, ok := r.URL.Query()["messageId"]
+		if !ok || len(messageIDs) == 0 {
+			logger.Error("no messageID")
+			return
+		}
+		messageID := messageIDs[0]
+		var payload []byte
+		var videoType protobuf.VideoMessage_VideoType
+		err := db.QueryRow(`SELECT v.payload, COALESCE(m.video_type, 0) FROM message_videos v JOIN user_messages m ON m.id = v.message_id WHERE v.message_id = ?`, messageID).Scan(&payload, &videoType)
+		if err != nil {
+			logger.Error("failed to find video", zap.Error(err))
+			return
+		}
+		if len(payload) == 0 {
+			logger.Error("empty video")
+			return
+		}
+
+		w.Header().Set("Content-Type", video.MIME(videoType))
+		w.Header().Set("Cache-Control", "no-store")
+
+		// Players seek through videos with range requests
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(payload))
+	}
+}
+
+func handleVideoThumbnail(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+	if db == nil {
+		return handleRequestDBMissing(logger)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		messageIDs, ok := r.URL.Query()["messageId"]
+		if !ok || len(messageIDs) == 0 {
+			logger.Error("no messageID")
+			return
+		}
+		messageID := messageIDs[0]
+		var thumbnail []byte
+		err := db.QueryRow(`SELECT video_thumbnail FROM user_messages WHERE id = ?`, messageID).Scan(&thumbnail)
+		if err != nil {
+			logger.Error("failed to find video thumbnail", zap.Error(err))
+			return
+		}
+		if len(thumbnail) == 0 {
+			logger.Error("empty video thumbnail")
+			return
+		}
+
+		w.Header().Set("Content-Type", "image/jpeg")
+		w.Header().Set("Cache-Control", "no-store")
+
+		_, err = w.Write(thumbnail)
+		if err != nil {
+			logger.Error("failed to write video thumbnail", zap.Error(err))
+		}
+	}
+}
+
 func handleIPFS(downloader *ipfs.Downloader, logger *zap.Logger) http.HandlerFunc {
 	if downloader == nil {
 		return handleRequestDownloaderMissing(logger)
@@ -527,3 +596,78 @@ func handleLinkPreviewThumbnail(db *sql.DB, logger *zap.Logger) http.HandlerFunc
 		}
 	}
 }
+
+func getStatusLinkPreviewIcon(db *sql.DB, msgID string, linkURL string) ([]byte, error) {
+	var result []byte
+	err := db.QueryRow(`SELECT unfurled_status_links FROM user_messages WHERE id = ?`, msgID).Scan(&result)
+	if err != nil {
+		return nil, fmt.Errorf("could not find message with message-id '%s': %w", msgID, err)
+	}
+
+	var links []*protobuf.UnfurledStatusLink
+	err = json.Unmarshal(result, &links)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal protobuf.UnfurledStatusLink: %w", err)
+	}
+
+	for _, link := range links {
+		if link.Url != linkURL {
+			continue
+		}
+		switch {
+		case link.Contact != nil:
+			return link.Contact.Icon, nil
+		case link.Community != nil:
+			return link.Community.Icon, nil
+		case link.Channel != nil && link.Channel.Community != nil:
+			return link.Channel.Community.Icon, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func handleStatusLinkPreviewIcon(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		queryParams := r.URL.Query()
+
+		paramID, ok := queryParams["message-id"]
+		if !ok || len(paramID) == 0 {
+			http.Error(w, "missing query parameter 'message-id'", http.StatusBadRequest)
+			return
+		}
+
+		paramURL, ok := queryParams["url"]
+		if !ok || len(paramURL) == 0 {
+			http.Error(w, "missing query parameter 'url'", http.StatusBadRequest)
+			return
+		}
+
+		msgID := paramID[0]
+
+		icon, err := getStatusLinkPreviewIcon(db, msgID, paramURL[0])
+		if err != nil {
+			logger.Error("failed to get status link preview icon", zap.String("msgID", msgID), zap.Error(err))
+			http.Error(w, "failed to get icon", http.StatusInternalServerError)
+			return
+		}
+		if len(icon) == 0 {
+			http.NotFound(w, r)
+			return
+		}
+
+		mimeType, err := images.GetMimeType(icon)
+		if err != nil {
+			http.Error(w, "mime type not supported", http.StatusNotImplemented)
+			return
+		}
+
+		w.Header().Set("Content-Type", "image/"+mimeType)
+		w.Header().Set("Cache-Control", "no-store")
+
+		_, err = w.Write(icon)
+		if err != nil {
+			logger.Error("failed to write response", zap.Error(err))
+		}
+	}
+}
@@ -52,14 +52,18 @@ func createUserMessage(t *testing.T, db *sql.DB, msg *common.Message) {
 			local_chat_id,
 			response_to,
 			clock_value,
-			unfurled_links
-		) VALUES (?,?,?,?,?,?,?,?,?,?,?)
+			unfurled_links,
+			unfurled_status_links
+		) VALUES (?,?,?,?,?,?,?,?,?,?,?,?)
 	`)
 	require.NoError(t, err)
 
 	links, err := json.Marshal(msg.UnfurledLinks)
 	require.NoError(t, err)
 
+	statusLinks, err := json.Marshal(msg.UnfurledStatusLinks)
+	require.NoError(t, err)
+
 	_, err = stmt.Exec(
 		msg.ID,
 		whisperTimestamp,
@@ -72,6 +76,7 @@ func createUserMessage(t *testing.T, db *sql.DB, msg *common.Message) {
 		responseTo,
 		clockValue,
 		links,
+		statusLinks,
 	)
 	require.NoError(t, err)
 }
@@ -131,3 +136,39 @@ func TestHandleLinkPreviewThumbnail(t *testing.T) {
 	rr = httpGetReqRecorder(t, handleLinkPreviewThumbnail(db, logger), reqURL)
 	require.Equal(t, http.StatusNotImplemented, rr.Code)
 }
+
+func TestHandleStatusLinkPreviewIcon(t *testing.T) {
+	db, logger := setupTest(t)
+	communityURL := "https://status.app/c#zQ3shYSHp7GoiXaauJMnDcjwU2yNjdzpXLosAWapPS4CFxc11"
+	icon := []byte{0xff, 0xd8, 0xff, 0xdb, 0x0, 0x84, 0x0, 0x50, 0x37, 0x3c, 0x46, 0x3c, 0x32, 0x50}
+
+	msg := common.Message{
+		ID: "1",
+		ChatMessage: protobuf.ChatMessage{
+			UnfurledStatusLinks: []*protobuf.UnfurledStatusLink{
+				{
+					Url: communityURL,
+					Community: &protobuf.StatusCommunityLinkPreview{
+						DisplayName: "status",
+						Icon:        icon,
+					},
+				},
+			},
+		},
+	}
+	createUserMessage(t, db, &msg)
+
+	reqURL := "/dummy?" + url.Values{"message-id": {msg.ID}, "url": {communityURL}}.Encode()
+	rr := httpGetReqRecorder(t, handleStatusLinkPreviewIcon(db, logger), reqURL)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, icon, rr.Body.Bytes())
+	require.Equal(t, "image/jpeg", rr.HeaderMap.Get("Content-Type"))
+
+	reqURL = "/dummy?" + url.Values{"message-id": {msg.ID}, "url": {"https://status.app/u#zQ3sh"}}.Encode()
+	rr = httpGetReqRecorder(t, handleStatusLinkPreviewIcon(db, logger), reqURL)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+
+	reqURL = "/dummy?" + url.Values{"message-id": {msg.ID}}.Encode()
+	rr = httpGetReqRecorder(t, handleStatusLinkPreviewIcon(db, logger), reqURL)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
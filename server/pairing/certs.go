@@ -2,11 +2,15 @@ package pairing
 
 import (
 	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/asn1"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/url"
@@ -48,6 +52,56 @@ func GenerateCertFromKey(pk *ecdsa.PrivateKey, from time.Time, hostname string)
 	return tlsCert, certPem, nil
 }
 
+// clientCertBinding is the MAC of the client certificate's public key with the AES key of the connection params,
+// only a client given the connection string can compute it
+func clientCertBinding(ek []byte, publicKeyInfo []byte) []byte {
+	mac := hmac.New(sha256.New, ek)
+	mac.Write(publicKeyInfo)
+	return mac.Sum(nil)
+}
+
+// GenerateClientCert generates a client certificate whose SubjectKeyId binds its key to the AES key of the
+// connection params, so that the server authenticates the client it shared the connection string with
+func GenerateClientCert(ek []byte, from time.Time) (tls.Certificate, error) {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	publicKeyInfo, err := x509.MarshalPKIXPublicKey(&pk.PublicKey)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	cert := server.GenerateX509Cert(makeSerialNumberFromKey(pk), from, from.Add(time.Hour), "localhost")
+	cert.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	cert.SubjectKeyId = clientCertBinding(ek, publicKeyInfo)
+
+	certPem, keyPem, err := server.GenerateX509PEMs(cert, pk)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.X509KeyPair(certPem, keyPem)
+}
+
+// makeClientCertVerifier returns a tls.Config.VerifyPeerCertificate func accepting only client certificates
+// generated by GenerateClientCert with the given AES key. The TLS handshake proves the client holds the
+// certificate's private key
+func makeClientCertVerifier(ek []byte) func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) != 1 {
+			return fmt.Errorf("expected 1 client TLS certificate, received '%d'", len(rawCerts))
+		}
+		cert, err := x509.ParseCertificate(rawCerts[0])
+		if err != nil {
+			return err
+		}
+		if !hmac.Equal(cert.SubjectKeyId, clientCertBinding(ek, cert.RawSubjectPublicKeyInfo)) {
+			return errors.New("client certificate isn't bound to the connection params")
+		}
+		return nil
+	}
+}
+
 // verifyCertPublicKey checks that the ecdsa.PublicKey using in a x509.Certificate matches a known ecdsa.PublicKey
 func verifyCertPublicKey(cert *x509.Certificate, publicKey *ecdsa.PublicKey) error {
 	certKey, ok := cert.PublicKey.(*ecdsa.PublicKey)
@@ -104,9 +158,10 @@ func verifyCert(cert *x509.Certificate, publicKey *ecdsa.PublicKey) error {
 
 // getServerCert pings a given tls host, extracts and returns its x509.Certificate
 // the function expects there to be only 1 certificate
-func getServerCert(URL *url.URL) (*x509.Certificate, error) {
+func getServerCert(URL *url.URL, clientCert *tls.Certificate) (*x509.Certificate, error) {
 	conf := &tls.Config{
 		InsecureSkipVerify: true, // nolint: gosec // Only skip verify to get the server's TLS cert. DO NOT skip for any other reason.
+		Certificates:       []tls.Certificate{*clientCert},
 	}
 
 	conn, err := tls.Dial("tcp", URL.Host, conf)
@@ -1,6 +1,7 @@
 package pairing
 
 import (
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -26,3 +27,35 @@ func (s *CertsSuite) SetupSuite() {
 func (s *CertsSuite) Test_makeSerialNumberFromKey() {
 	s.Require().Zero(makeSerialNumberFromKey(s.PK).Cmp(s.SN))
 }
+
+func (s *CertsSuite) TestClientCertVerifier() {
+	cert, err := GenerateClientCert(s.AES, s.NotBefore)
+	s.Require().NoError(err)
+
+	verify := makeClientCertVerifier(s.AES)
+	s.Require().NoError(verify(cert.Certificate, nil))
+
+	otherCert, err := GenerateClientCert([]byte("another connection string AES key"), s.NotBefore)
+	s.Require().NoError(err)
+	s.Require().Error(verify(otherCert.Certificate, nil))
+	s.Require().Error(verify(nil, nil))
+}
+
+func (s *CertsSuite) Test_mdnsHostname() {
+	hostname := mdnsHostname(&s.PK.PublicKey)
+	s.Require().Equal(hostname, mdnsHostname(&s.PK.PublicKey))
+	s.Require().Regexp(`^status-pairing-[0-9a-f]{16}\.local$`, hostname)
+}
+
+func (s *CertsSuite) Test_resolveServerWithoutMDNS() {
+	// The mDNS port is owned by another responder
+	l, err := net.ListenUDP("udp4", &net.UDPAddr{Port: 5353})
+	if err == nil {
+		defer l.Close()
+	}
+
+	// Nothing listens on the address of the connection string and it can't be looked up as mDNS is unavailable
+	cp := NewConnectionParams(net.IPv4(127, 0, 0, 1).To4(), 1, &s.PK.PublicKey, s.AES)
+	s.Require().ErrorIs(resolveServer(cp), ErrMDNSUnavailable)
+	s.Require().Equal(net.IPv4(127, 0, 0, 1).To4(), cp.netIP)
+}
@@ -2,15 +2,18 @@ package pairing
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
+	"time"
 
 	"github.com/status-im/status-go/api"
 	"github.com/status-im/status-go/logutils"
@@ -36,12 +39,22 @@ type BaseClient struct {
 
 // NewBaseClient returns a fully qualified BaseClient from the given ConnectionParams
 func NewBaseClient(c *ConnectionParams) (*BaseClient, error) {
+	err := resolveServer(c)
+	if err != nil {
+		return nil, err
+	}
+
 	u, err := c.URL()
 	if err != nil {
 		return nil, err
 	}
 
-	serverCert, err := getServerCert(u)
+	clientCert, err := GenerateClientCert(c.aesKey, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	serverCert, err := getServerCert(u, &clientCert)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +79,7 @@ func NewBaseClient(c *ConnectionParams) (*BaseClient, error) {
 			MinVersion:         tls.VersionTLS12,
 			InsecureSkipVerify: false, // MUST BE FALSE
 			RootCAs:            rootCAs,
+			Certificates:       []tls.Certificate{clientCert},
 		},
 	}
 
@@ -227,31 +241,69 @@ func (c *SenderClient) receiveInstallationData() error {
 }
 
 // setupSendingClient creates a new SenderClient after parsing string inputs
-func setupSendingClient(backend *api.GethStatusBackend, cs, configJSON string) (*SenderClient, error) {
+func setupSendingClient(backend *api.GethStatusBackend, cs, configJSON string) (*SenderClient, *SenderClientConfig, error) {
 	ccp := new(ConnectionParams)
 	err := ccp.FromString(cs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	conf := NewSenderClientConfig()
 	err = json.Unmarshal([]byte(configJSON), conf)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	err = validateAndVerifyPassword(conf, conf.SenderConfig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	conf.SenderConfig.DB = backend.GetMultiaccountDB()
 
-	return NewSenderClient(backend, ccp, conf)
+	c, err := NewSenderClient(backend, ccp, conf)
+	return c, conf, err
+}
+
+// syncOverWaku pairs the logged in profile with its other devices over waku, for a SenderClient which can't
+// reach the ReceiverServer on the local network. Only the installation and the synced data go over waku,
+// so a receiving device which isn't logged in to the same profile can't be paired this way
+func syncOverWaku(backend *api.GethStatusBackend, config *SenderConfig) error {
+	messenger := backend.Messenger()
+	if messenger == nil {
+		return fmt.Errorf("messenger is nil when syncOverWaku")
+	}
+
+	currentAccount, err := backend.GetActiveAccount()
+	if err != nil {
+		return err
+	}
+	if config.KeyUID != currentAccount.KeyUID {
+		return fmt.Errorf("keyUID not equal")
+	}
+
+	err = messenger.SetInstallationDeviceType(config.DeviceType)
+	if err != nil {
+		return err
+	}
+	_, err = messenger.SendPairInstallation(context.TODO(), nil)
+	if err != nil {
+		return err
+	}
+	err = messenger.SyncDevices(context.TODO(), currentAccount.Name, currentAccount.Identicon, nil)
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionWakuFallback})
+		return err
+	}
+	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionWakuFallback})
+	return nil
 }
 
 // StartUpSendingClient creates a SenderClient and triggers all `send` calls in sequence to the ReceiverServer
 func StartUpSendingClient(backend *api.GethStatusBackend, cs, configJSON string) error {
-	c, err := setupSendingClient(backend, cs, configJSON)
+	c, conf, err := setupSendingClient(backend, cs, configJSON)
+	if errors.Is(err, ErrLANDiscoveryFailed) && conf.ClientConfig != nil && conf.ClientConfig.WakuFallback {
+		return syncOverWaku(backend, conf.SenderConfig)
+	}
 	if err != nil {
 		return err
 	}
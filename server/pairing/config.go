@@ -6,6 +6,7 @@ import (
 
 	"github.com/status-im/status-go/multiaccounts"
 	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/server/pairing/versioning"
 )
 
 type SenderConfig struct {
@@ -45,6 +46,11 @@ type ServerConfig struct {
 	// Timeout the number of milliseconds after which the pairing server will automatically terminate
 	Timeout uint `json:"timeout" validate:"omitempty,gte=0"`
 
+	// ConnectionParamsVersion the version of the connection string given to the peer, defaults to the latest.
+	// Peers which don't support client certificates are only given a ConnectionParamsV1 connection string, the
+	// server doesn't require them a client certificate
+	ConnectionParamsVersion versioning.ConnectionParamVersion `json:"connectionParamsVersion" validate:"omitempty,gte=0"`
+
 	// Connection fields, not json (un)marshalled
 	// Required for the server, but MUST NOT come from client
 
@@ -54,7 +60,11 @@ type ServerConfig struct {
 	Hostname string           `json:"-"`
 }
 
-type ClientConfig struct{}
+type ClientConfig struct {
+	// WakuFallback syncs a sending client's profile with its other devices over waku when the server isn't
+	// reachable on the local network. The account and its keys are never sent over waku
+	WakuFallback bool `json:"wakuFallback"`
+}
 
 type SenderServerConfig struct {
 	SenderConfig *SenderConfig `json:"senderConfig" validate:"required"`
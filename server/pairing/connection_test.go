@@ -6,11 +6,12 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/status-im/status-go/server"
+	"github.com/status-im/status-go/server/pairing/versioning"
 	"github.com/status-im/status-go/server/servertest"
 )
 
 var (
-	connectionString = "cs2:4FHRnp:Q4:uqnnMwVUfJc2Fkcaojet8F1ufKC3hZdGEt47joyBx9yd:BbnZ7Gc66t54a9kEFCf7FW8SGQuYypwHVeNkRYeNoqV6"
+	connectionString = "cs3:4FHRnp:Q4:uqnnMwVUfJc2Fkcaojet8F1ufKC3hZdGEt47joyBx9yd:BbnZ7Gc66t54a9kEFCf7FW8SGQuYypwHVeNkRYeNoqV6"
 )
 
 func TestConnectionParamsSuite(t *testing.T) {
@@ -53,6 +54,27 @@ func (s *ConnectionParamsSuite) TestConnectionParams_ToString() {
 	s.Require().Equal(connectionString, cps)
 }
 
+func (s *ConnectionParamsSuite) TestConnectionParams_V1() {
+	cert, _, err := GenerateCertFromKey(s.PK, s.NotBefore, server.DefaultIP.String())
+	s.Require().NoError(err)
+
+	bs, err := NewBaseServer(s.Logger, NewPayloadEncryptor(s.AES), &ServerConfig{
+		PK:                      &s.PK.PublicKey,
+		EK:                      s.AES,
+		Cert:                    &cert,
+		Hostname:                server.DefaultIP.String(),
+		ConnectionParamsVersion: versioning.ConnectionParamsV1,
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(bs.SetPort(1337))
+
+	// Peers which don't support client certificates are given a V1 connection string
+	cp, err := bs.MakeConnectionParams()
+	s.Require().NoError(err)
+	s.Require().Equal(versioning.ConnectionParamsV1, cp.version)
+	s.Require().Regexp("^cs2:", cp.ToString())
+}
+
 func (s *ConnectionParamsSuite) TestConnectionParams_Generate() {
 	cp := new(ConnectionParams)
 	err := cp.FromString(connectionString)
@@ -38,6 +38,7 @@ const (
 	ActionSyncDevice
 	ActionPairingInstallation
 	ActionPeerDiscovery
+	ActionWakuFallback
 )
 
 type AccountData struct {
@@ -0,0 +1,150 @@
+package pairing
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pion/mdns"
+	"go.uber.org/zap"
+	"golang.org/x/net/ipv4"
+)
+
+const (
+	// dialTimeout is how long a client tries the address of the connection string before looking up the server
+	dialTimeout = 3 * time.Second
+	// discoveryTimeout is how long a client looks up the server on the local network
+	discoveryTimeout = 5 * time.Second
+	// advertiseLimit is how long a server without timeout answers mDNS queries
+	advertiseLimit = 5 * time.Minute
+)
+
+var ErrLANDiscoveryFailed = errors.New("pairing server isn't reachable on the local network")
+
+// ErrMDNSUnavailable is returned when the mDNS port can't be bound, e.g. because the responder of the OS owns it
+var ErrMDNSUnavailable = errors.New("mDNS discovery is unavailable")
+
+// mdnsHostname returns the .local hostname of a pairing server. It's derived from the server certificate's
+// public key, so it's only known to the devices which were given the connection string
+func mdnsHostname(pk *ecdsa.PublicKey) string {
+	h := sha256.Sum256(elliptic.MarshalCompressed(pk.Curve, pk.X, pk.Y))
+	return fmt.Sprintf("status-pairing-%x.local", h[:8])
+}
+
+func listenMDNS(localNames []string) (*mdns.Conn, error) {
+	addr, err := net.ResolveUDPAddr("udp4", mdns.DefaultAddress)
+	if err != nil {
+		return nil, err
+	}
+	l, err := net.ListenUDP("udp4", addr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMDNSUnavailable, err)
+	}
+	return mdns.Server(ipv4.NewPacketConn(l), &mdns.Config{LocalNames: localNames})
+}
+
+// Advertiser answers the mDNS queries of clients looking up a pairing server
+type Advertiser struct {
+	conn *mdns.Conn
+	once sync.Once
+}
+
+// Advertise answers mDNS queries for the hostname of the server with the given public key, until stopped or
+// until the limit elapses
+func Advertise(pk *ecdsa.PublicKey, limit time.Duration) (*Advertiser, error) {
+	conn, err := listenMDNS([]string{mdnsHostname(pk)})
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Advertiser{conn: conn}
+	time.AfterFunc(limit, a.Stop)
+	return a, nil
+}
+
+func (a *Advertiser) Stop() {
+	a.once.Do(func() {
+		_ = a.conn.Close()
+	})
+}
+
+// Discover looks up the address of the pairing server with the given public key on the local network
+func Discover(ctx context.Context, pk *ecdsa.PublicKey) (net.IP, error) {
+	conn, err := listenMDNS(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	_, src, err := conn.Query(ctx, mdnsHostname(pk))
+	if err != nil {
+		return nil, err
+	}
+	addr, ok := src.(*net.UDPAddr)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mDNS answer address '%s'", src)
+	}
+	return addr.IP, nil
+}
+
+// resolveServer checks that the server of the ConnectionParams is reachable. When it isn't, e.g. because the
+// connection string holds the address of another interface of the server's device, the server is looked up
+// over mDNS and the ConnectionParams updated with the discovered address. When mDNS is unavailable the error is
+// returned, as the address of the connection string isn't reachable either
+func resolveServer(cp *ConnectionParams) error {
+	u, err := cp.URL()
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.DialTimeout("tcp", u.Host, dialTimeout)
+	if err == nil {
+		return conn.Close()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), discoveryTimeout)
+	defer cancel()
+	ip, err := Discover(ctx, cp.publicKey)
+	if errors.Is(err, ErrMDNSUnavailable) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrLANDiscoveryFailed, err)
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	cp.netIP = ip
+	return nil
+}
+
+// advertise makes the server discoverable on the local network for the duration of its timeout. A failure
+// isn't fatal, clients reach the server through the address of the connection string
+func (s *BaseServer) advertise(timeout uint) {
+	limit := advertiseLimit
+	if timeout > 0 {
+		limit = time.Duration(timeout) * time.Millisecond
+	}
+
+	a, err := Advertise(s.pk, limit)
+	if err != nil {
+		s.GetLogger().Warn("failed to advertise pairing server over mDNS", zap.Error(err))
+		return
+	}
+	s.advertiser = a
+}
+
+// Stop stops the server and its mDNS advertisement
+func (s *BaseServer) Stop() error {
+	if s.advertiser != nil {
+		s.advertiser.Stop()
+	}
+	return s.Server.Stop()
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/status-im/status-go/api"
 	"github.com/status-im/status-go/logutils"
 	"github.com/status-im/status-go/server"
+	"github.com/status-im/status-go/server/pairing/versioning"
 )
 
 /*
@@ -31,6 +32,10 @@ type BaseServer struct {
 
 	pk *ecdsa.PublicKey
 	ek []byte
+
+	timeout    uint
+	advertiser *Advertiser
+	version    versioning.ConnectionParamVersion
 }
 
 // NewBaseServer returns a *BaseServer init from the given *SenderServerConfig
@@ -50,8 +55,17 @@ func NewBaseServer(logger *zap.Logger, e *PayloadEncryptor, config *ServerConfig
 		challengeGiver: cg,
 		pk:             config.PK,
 		ek:             config.EK,
+		timeout:        config.Timeout,
+		version:        config.ConnectionParamsVersion,
+	}
+	if bs.version == 0 {
+		bs.version = versioning.LatestConnectionParamVer
 	}
 	bs.SetTimeout(config.Timeout)
+	// Clients of a ConnectionParamsV1 connection string may not present a certificate
+	if bs.version >= versioning.ConnectionParamsV2 {
+		bs.SetClientCertVerifier(makeClientCertVerifier(config.EK))
+	}
 	return bs, nil
 }
 
@@ -68,7 +82,11 @@ func (s *BaseServer) MakeConnectionParams() (*ConnectionParams, error) {
 		netIP = netIP4
 	}
 
-	return NewConnectionParams(netIP, s.MustGetPort(), s.pk, s.ek), nil
+	cp := NewConnectionParams(netIP, s.MustGetPort(), s.pk, s.ek)
+	if s.version != 0 {
+		cp.version = s.version
+	}
+	return cp, nil
 }
 
 func MakeServerConfig(config *ServerConfig) error {
@@ -150,7 +168,12 @@ func (s *SenderServer) startSendingData() error {
 		// receive installation data from receiver
 		pairingReceiveInstallation: middlewareChallenge(s.challengeGiver, handleReceiveInstallation(s.GetLogger(), s.installationMounter)),
 	})
-	return s.Start()
+	err := s.Start()
+	if err != nil {
+		return err
+	}
+	s.advertise(s.timeout)
+	return nil
 }
 
 // MakeFullSenderServer generates a fully configured and randomly seeded SenderServer
@@ -247,7 +270,12 @@ func (s *ReceiverServer) startReceivingData() error {
 		// send installation data back to sender
 		pairingSendInstallation: middlewareChallenge(s.challengeGiver, handleSendInstallation(s.GetLogger(), s.installationReceiver)),
 	})
-	return s.Start()
+	err := s.Start()
+	if err != nil {
+		return err
+	}
+	s.advertise(s.timeout)
+	return nil
 }
 
 // MakeFullReceiverServer generates a fully configured and randomly seeded ReceiverServer
@@ -4,6 +4,8 @@ type ConnectionParamVersion int
 
 const (
 	ConnectionParamsV1 ConnectionParamVersion = iota + 1
+	// ConnectionParamsV2 servers require clients to authenticate with a certificate bound to the AES key
+	ConnectionParamsV2
 )
 
 type LocalPairingVersion int
@@ -13,6 +15,6 @@ const (
 )
 
 const (
-	LatestConnectionParamVer = ConnectionParamsV1
+	LatestConnectionParamVer = ConnectionParamsV2
 	LatestLocalPairingVer    = LocalPairingV1
 )
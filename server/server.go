@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
 	"net/http"
@@ -19,6 +20,8 @@ type Server struct {
 	hostname  string
 	handlers  HandlerPatternMap
 
+	verifyClientCert func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error
+
 	portManger
 	*timeoutManager
 }
@@ -55,6 +58,10 @@ func (s *Server) mustGetHost() string {
 
 func (s *Server) listenAndServe() {
 	cfg := &tls.Config{Certificates: []tls.Certificate{*s.cert}, ServerName: s.hostname, MinVersion: tls.VersionTLS12}
+	if s.verifyClientCert != nil {
+		cfg.ClientAuth = tls.RequireAnyClientCert
+		cfg.VerifyPeerCertificate = s.verifyClientCert
+	}
 
 	// in case of restart, we should use the same port as the first start in order not to break existing links
 	listener, err := tls.Listen("tcp", s.getHost(), cfg)
@@ -152,6 +159,11 @@ func (s *Server) ToBackground() {
 	}
 }
 
+// SetClientCertVerifier requires clients to present a certificate accepted by verify
+func (s *Server) SetClientCertVerifier(verify func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error) {
+	s.verifyClientCert = verify
+}
+
 func (s *Server) SetHandlers(handlers HandlerPatternMap) {
 	s.handlers = handlers
 }
@@ -37,16 +37,19 @@ func NewMediaServer(db *sql.DB, downloader *ipfs.Downloader, multiaccountsDB *mu
 		multiaccountsDB: multiaccountsDB,
 	}
 	s.SetHandlers(HandlerPatternMap{
-		accountImagesPath:        handleAccountImages(s.multiaccountsDB, s.logger),
-		audioPath:                handleAudio(s.db, s.logger),
-		contactImagesPath:        handleContactImages(s.db, s.logger),
-		discordAttachmentsPath:   handleDiscordAttachment(s.db, s.logger),
-		discordAuthorsPath:       handleDiscordAuthorAvatar(s.db, s.logger),
-		generateQRCode:           handleQRCodeGeneration(s.multiaccountsDB, s.logger),
-		identiconsPath:           handleIdenticon(s.logger),
-		imagesPath:               handleImage(s.db, s.logger),
-		ipfsPath:                 handleIPFS(s.downloader, s.logger),
-		LinkPreviewThumbnailPath: handleLinkPreviewThumbnail(s.db, s.logger),
+		accountImagesPath:         handleAccountImages(s.multiaccountsDB, s.logger),
+		audioPath:                 handleAudio(s.db, s.logger),
+		contactImagesPath:         handleContactImages(s.db, s.logger),
+		discordAttachmentsPath:    handleDiscordAttachment(s.db, s.logger),
+		discordAuthorsPath:        handleDiscordAuthorAvatar(s.db, s.logger),
+		generateQRCode:            handleQRCodeGeneration(s.multiaccountsDB, s.logger),
+		identiconsPath:            handleIdenticon(s.logger),
+		imagesPath:                handleImage(s.db, s.logger),
+		ipfsPath:                  handleIPFS(s.downloader, s.logger),
+		LinkPreviewThumbnailPath:  handleLinkPreviewThumbnail(s.db, s.logger),
+		StatusLinkPreviewIconPath: handleStatusLinkPreviewIcon(s.db, s.logger),
+		videoPath:                 handleVideo(s.db, s.logger),
+		videoThumbnailsPath:       handleVideoThumbnail(s.db, s.logger),
 	})
 
 	return s, nil
@@ -81,6 +84,13 @@ func (s *MediaServer) MakeLinkPreviewThumbnailURL(msgID string, previewURL strin
 	return u.String()
 }
 
+func (s *MediaServer) MakeStatusLinkPreviewIconURL(msgID string, previewURL string) string {
+	u := s.MakeBaseURL()
+	u.Path = StatusLinkPreviewIconPath
+	u.RawQuery = url.Values{"message-id": {msgID}, "url": {previewURL}}.Encode()
+	return u.String()
+}
+
 func (s *MediaServer) MakeDiscordAuthorAvatarURL(authorID string) string {
 	u := s.MakeBaseURL()
 	u.Path = discordAuthorsPath
@@ -105,6 +115,22 @@ func (s *MediaServer) MakeAudioURL(id string) string {
 	return u.String()
 }
 
+func (s *MediaServer) MakeVideoURL(id string) string {
+	u := s.MakeBaseURL()
+	u.Path = videoPath
+	u.RawQuery = url.Values{"messageId": {id}}.Encode()
+
+	return u.String()
+}
+
+func (s *MediaServer) MakeVideoThumbnailURL(id string) string {
+	u := s.MakeBaseURL()
+	u.Path = videoThumbnailsPath
+	u.RawQuery = url.Values{"messageId": {id}}.Encode()
+
+	return u.String()
+}
+
 func (s *MediaServer) MakeStickerURL(stickerHash string) string {
 	u := s.MakeBaseURL()
 	u.Path = ipfsPath
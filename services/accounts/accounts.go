@@ -15,11 +15,12 @@ import (
 	"github.com/status-im/status-go/multiaccounts/accounts"
 	"github.com/status-im/status-go/params"
 	"github.com/status-im/status-go/protocol"
+	statusrpc "github.com/status-im/status-go/rpc"
 	"github.com/status-im/status-go/services/accounts/accountsevent"
 )
 
-func NewAccountsAPI(manager *account.GethManager, config *params.NodeConfig, db *accounts.Database, feed *event.Feed, messenger **protocol.Messenger) *API {
-	return &API{manager, config, db, feed, messenger}
+func NewAccountsAPI(manager *account.GethManager, config *params.NodeConfig, db *accounts.Database, feed *event.Feed, messenger **protocol.Messenger, rpcClient *statusrpc.Client) *API {
+	return &API{manager, config, db, feed, messenger, rpcClient}
 }
 
 // API is class with methods available over RPC.
@@ -29,6 +30,7 @@ type API struct {
 	db        *accounts.Database
 	feed      *event.Feed
 	messenger **protocol.Messenger
+	rpcClient *statusrpc.Client
 }
 
 type DerivedAddress struct {
@@ -81,6 +83,10 @@ func (api *API) UpdateAccountPosition(ctx context.Context, address types.Address
 	return (*api.messenger).UpdateAccountPosition(address, position)
 }
 
+func (api *API) SetAccountHidden(ctx context.Context, address types.Address, hidden bool) error {
+	return (*api.messenger).SetAccountHidden(address, hidden)
+}
+
 func (api *API) GetAccounts(ctx context.Context) ([]*accounts.Account, error) {
 	return api.db.GetAccounts()
 }
@@ -0,0 +1,185 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+)
+
+// maxDerivedAddressesPreview bounds the addresses derived in one call, each
+// of them is looked up on every network
+const maxDerivedAddressesPreview = 100
+
+// maxActivityQueries bounds the addresses looked up on chain concurrently
+const maxActivityQueries = 10
+
+var (
+	ErrInvalidDerivationRange = errors.New("invalid derivation range")
+	ErrKeypairCannotDerive    = errors.New("addresses can't be derived from a private key keypair")
+)
+
+// activityReader is the part of the chain client used to tell whether an
+// address has been used
+type activityReader interface {
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// GetDerivedAddressesPreview derives the addresses of the keypair at the paths
+// basePath/from to basePath/from+count-1 and tells which ones have been used
+// on chain, without storing any account. The password decrypts the master key
+// of the keypair
+func (api *API) GetDerivedAddressesPreview(ctx context.Context, keyUID string, password string, basePath string, from int, count int) ([]*DerivedAddress, error) {
+	log.Info("[AccountsAPI::GetDerivedAddressesPreview]")
+	keypair, err := api.db.GetKeypairByKeyUID(keyUID)
+	if err != nil {
+		return nil, err
+	}
+	if keypair.Type == accounts.KeypairTypeKey {
+		return nil, ErrKeypairCannotDerive
+	}
+
+	generator := api.manager.AccountsGenerator()
+	info, err := generator.LoadAccount(keypair.DerivedFrom, password)
+	if err != nil {
+		return nil, err
+	}
+	defer generator.DeleteAccount(info.ID)
+
+	clients, err := api.activityClients()
+	if err != nil {
+		return nil, err
+	}
+
+	return api.derivedAddressesPreview(ctx, clients, info.ID, basePath, from, count)
+}
+
+// GetDerivedAddressesPreviewForMnemonic is GetDerivedAddressesPreview for a
+// keypair being imported, which isn't stored yet
+func (api *API) GetDerivedAddressesPreviewForMnemonic(ctx context.Context, mnemonic string, basePath string, from int, count int) ([]*DerivedAddress, error) {
+	log.Info("[AccountsAPI::GetDerivedAddressesPreviewForMnemonic]")
+	generator := api.manager.AccountsGenerator()
+	info, err := generator.ImportMnemonic(strings.Join(strings.Fields(mnemonic), " "), "")
+	if err != nil {
+		return nil, err
+	}
+	defer generator.DeleteAccount(info.ID)
+
+	clients, err := api.activityClients()
+	if err != nil {
+		return nil, err
+	}
+
+	return api.derivedAddressesPreview(ctx, clients, info.ID, basePath, from, count)
+}
+
+func (api *API) derivedAddressesPreview(ctx context.Context, clients []activityReader, accountID string, basePath string, from int, count int) ([]*DerivedAddress, error) {
+	if from < 0 || count <= 0 || count > maxDerivedAddressesPreview {
+		return nil, ErrInvalidDerivationRange
+	}
+
+	basePath = strings.TrimSuffix(basePath, "/")
+	paths := make([]string, count)
+	for i := range paths {
+		paths[i] = fmt.Sprintf("%s/%d", basePath, from+i)
+	}
+
+	derived, err := api.manager.AccountsGenerator().DeriveAddresses(accountID, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := api.db.GetAccounts()
+	if err != nil {
+		return nil, err
+	}
+	created := make(map[common.Address]bool)
+	for _, acc := range existing {
+		created[common.Address(acc.Address)] = true
+	}
+
+	addresses := make([]*DerivedAddress, count)
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(maxActivityQueries)
+	for i, path := range paths {
+		i, info := i, derived[path]
+		address := common.HexToAddress(info.Address)
+		addresses[i] = &DerivedAddress{
+			Address:        address,
+			Path:           paths[i],
+			AlreadyCreated: created[address],
+		}
+
+		group.Go(func() error {
+			hasActivity, err := hasActivity(groupCtx, clients, address)
+			if err != nil {
+				return err
+			}
+			addresses[i].HasActivity = hasActivity
+			return nil
+		})
+	}
+
+	err = group.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
+
+// activityClients returns the clients of the networks the addresses are
+// looked up on, the test networks when they're enabled
+func (api *API) activityClients() ([]activityReader, error) {
+	if api.rpcClient == nil {
+		return nil, nil
+	}
+
+	networks, err := api.rpcClient.NetworkManager.GetActive(true)
+	if err != nil {
+		return nil, err
+	}
+
+	var clients []activityReader
+	for _, network := range networks {
+		client, err := api.rpcClient.EthClient(network.ChainID)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+
+	return clients, nil
+}
+
+// hasActivity tells whether the address sent a transaction or holds a balance
+// on one of the networks
+func hasActivity(ctx context.Context, clients []activityReader, address common.Address) (bool, error) {
+	for _, client := range clients {
+		nonce, err := client.NonceAt(ctx, address, nil)
+		if err != nil {
+			return false, err
+		}
+		if nonce > 0 {
+			return true, nil
+		}
+
+		balance, err := client.BalanceAt(ctx, address, nil)
+		if err != nil {
+			return false, err
+		}
+		if balance.Sign() > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,219 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+)
+
+const (
+	testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	testBasePath = "m/44'/60'/0'/0"
+)
+
+var (
+	testAddress0 = common.HexToAddress("0x9858EfFD232B4033E47d90003D41EC34EcaEda94")
+	testAddress1 = common.HexToAddress("0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0")
+	testAddress2 = common.HexToAddress("0xb6716976A3ebe8D39aCEB04372f22Ff8e6802D7A")
+)
+
+type fakeActivityReader struct {
+	nonces   map[common.Address]uint64
+	balances map[common.Address]*big.Int
+	err      error
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func (r *fakeActivityReader) enter() {
+	r.mu.Lock()
+	r.inFlight++
+	if r.inFlight > r.maxInFlight {
+		r.maxInFlight = r.inFlight
+	}
+	r.mu.Unlock()
+
+	time.Sleep(time.Millisecond)
+
+	r.mu.Lock()
+	r.inFlight--
+	r.mu.Unlock()
+}
+
+func (r *fakeActivityReader) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	r.enter()
+	if r.err != nil {
+		return 0, r.err
+	}
+	return r.nonces[account], nil
+}
+
+func (r *fakeActivityReader) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	r.enter()
+	if r.err != nil {
+		return nil, r.err
+	}
+	if balance, ok := r.balances[account]; ok {
+		return balance, nil
+	}
+	return big.NewInt(0), nil
+}
+
+func setupTestAPI(t *testing.T) (*API, func()) {
+	db, stop, err := appdatabase.SetupTestSQLDB("derived-addresses-tests-")
+	require.NoError(t, err)
+
+	accountsDB, err := accounts.NewDB(db)
+	require.NoError(t, err)
+
+	api := &API{
+		manager: account.NewGethManager(),
+		db:      accountsDB,
+	}
+	return api, func() {
+		require.NoError(t, stop())
+	}
+}
+
+func importTestMnemonic(t *testing.T, api *API) string {
+	info, err := api.manager.AccountsGenerator().ImportMnemonic(testMnemonic, "")
+	require.NoError(t, err)
+	return info.ID
+}
+
+func TestDerivedAddressesPreviewRange(t *testing.T) {
+	api, stop := setupTestAPI(t)
+	defer stop()
+
+	accountID := importTestMnemonic(t, api)
+
+	for _, tc := range []struct {
+		from  int
+		count int
+	}{
+		{from: -1, count: 1},
+		{from: 0, count: 0},
+		{from: 0, count: -1},
+		{from: 0, count: maxDerivedAddressesPreview + 1},
+	} {
+		_, err := api.derivedAddressesPreview(context.Background(), nil, accountID, testBasePath, tc.from, tc.count)
+		require.ErrorIs(t, err, ErrInvalidDerivationRange)
+	}
+
+	addresses, err := api.derivedAddressesPreview(context.Background(), nil, accountID, testBasePath, 0, maxDerivedAddressesPreview)
+	require.NoError(t, err)
+	require.Len(t, addresses, maxDerivedAddressesPreview)
+}
+
+func TestDerivedAddressesPreviewPaths(t *testing.T) {
+	api, stop := setupTestAPI(t)
+	defer stop()
+
+	accountID := importTestMnemonic(t, api)
+
+	addresses, err := api.derivedAddressesPreview(context.Background(), nil, accountID, testBasePath+"/", 1, 2)
+	require.NoError(t, err)
+	require.Len(t, addresses, 2)
+
+	require.Equal(t, testBasePath+"/1", addresses[0].Path)
+	require.Equal(t, testAddress1, addresses[0].Address)
+	require.Equal(t, testBasePath+"/2", addresses[1].Path)
+	require.Equal(t, testAddress2, addresses[1].Address)
+}
+
+func TestDerivedAddressesPreviewAlreadyCreated(t *testing.T) {
+	api, stop := setupTestAPI(t)
+	defer stop()
+
+	err := api.db.SaveOrUpdateAccounts([]*accounts.Account{{
+		Address: types.Address(testAddress1),
+		Type:    accounts.AccountTypeWatch,
+		Name:    "Watched",
+	}}, false)
+	require.NoError(t, err)
+
+	accountID := importTestMnemonic(t, api)
+
+	addresses, err := api.derivedAddressesPreview(context.Background(), nil, accountID, testBasePath, 0, 3)
+	require.NoError(t, err)
+	require.Len(t, addresses, 3)
+
+	require.False(t, addresses[0].AlreadyCreated)
+	require.True(t, addresses[1].AlreadyCreated)
+	require.False(t, addresses[2].AlreadyCreated)
+}
+
+func TestDerivedAddressesPreviewHasActivity(t *testing.T) {
+	api, stop := setupTestAPI(t)
+	defer stop()
+
+	accountID := importTestMnemonic(t, api)
+
+	// The activity found on any of the networks counts
+	withNonce := &fakeActivityReader{
+		nonces: map[common.Address]uint64{testAddress0: 1},
+	}
+	withBalance := &fakeActivityReader{
+		balances: map[common.Address]*big.Int{testAddress2: big.NewInt(1)},
+	}
+
+	addresses, err := api.derivedAddressesPreview(context.Background(), []activityReader{withNonce, withBalance}, accountID, testBasePath, 0, 3)
+	require.NoError(t, err)
+	require.Len(t, addresses, 3)
+
+	require.True(t, addresses[0].HasActivity)
+	require.False(t, addresses[1].HasActivity)
+	require.True(t, addresses[2].HasActivity)
+
+	failing := &fakeActivityReader{err: errors.New("rpc error")}
+	_, err = api.derivedAddressesPreview(context.Background(), []activityReader{failing}, accountID, testBasePath, 0, 3)
+	require.Error(t, err)
+}
+
+func TestDerivedAddressesPreviewActivityQueriesLimit(t *testing.T) {
+	api, stop := setupTestAPI(t)
+	defer stop()
+
+	accountID := importTestMnemonic(t, api)
+
+	client := &fakeActivityReader{}
+	_, err := api.derivedAddressesPreview(context.Background(), []activityReader{client}, accountID, testBasePath, 0, maxDerivedAddressesPreview)
+	require.NoError(t, err)
+	require.LessOrEqual(t, client.maxInFlight, maxActivityQueries)
+}
+
+func TestGetDerivedAddressesPreviewForMnemonic(t *testing.T) {
+	api, stop := setupTestAPI(t)
+	defer stop()
+
+	// The mnemonic is normalized before being imported
+	addresses, err := api.GetDerivedAddressesPreviewForMnemonic(context.Background(), "  "+testMnemonic+"\n", testBasePath, 0, 1)
+	require.NoError(t, err)
+	require.Len(t, addresses, 1)
+	require.Equal(t, testAddress0, addresses[0].Address)
+	require.False(t, addresses[0].HasActivity)
+}
+
+func TestGetDerivedAddressesPreviewPrivateKeyKeypair(t *testing.T) {
+	api, stop := setupTestAPI(t)
+	defer stop()
+
+	keypair := accounts.GetPrivKeyImportedKeypairForTest()
+	require.NoError(t, api.db.SaveOrUpdateKeypair(keypair))
+
+	_, err := api.GetDerivedAddressesPreview(context.Background(), keypair.KeyUID, "password", testBasePath, 0, 1)
+	require.ErrorIs(t, err, ErrKeypairCannotDerive)
+}
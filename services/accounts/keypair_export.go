@@ -0,0 +1,124 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+)
+
+var (
+	ErrProfileKeypairExport = errors.New("profile keypair can't be exported, use device pairing instead")
+	ErrKeypairExists        = errors.New("keypair already exists")
+	ErrInvalidPassword      = errors.New("invalid password")
+)
+
+// keypairKeyAddresses returns the addresses of the key files of a keypair, the
+// master key of a seed keypair first
+func keypairKeyAddresses(keypair *accounts.Keypair) []types.Address {
+	var addresses []types.Address
+	if keypair.DerivedFrom != "" {
+		addresses = append(addresses, types.HexToAddress(keypair.DerivedFrom))
+	}
+	for _, acc := range keypair.Accounts {
+		if acc.Type != accounts.AccountTypeWatch && acc.Address.Hex() != keypair.DerivedFrom {
+			addresses = append(addresses, acc.Address)
+		}
+	}
+	return addresses
+}
+
+// ExportKeypair exports the key files of a keypair, encrypted with the password
+// and split in chunks to be displayed as QR codes, to import it on another
+// device without network. The profile keypair can't be exported
+func (api *API) ExportKeypair(ctx context.Context, keyUID string, password string) ([]string, error) {
+	log.Info("[AccountsAPI::ExportKeypair]")
+	keypair, err := api.db.GetKeypairByKeyUID(keyUID)
+	if err != nil {
+		return nil, err
+	}
+	if keypair.Type == accounts.KeypairTypeProfile {
+		return nil, ErrProfileKeypairExport
+	}
+
+	keys := make(map[string]json.RawMessage)
+	for _, address := range keypairKeyAddresses(keypair) {
+		keyFile, err := api.manager.ExportKeyFile(api.config.KeyStoreDir, address.Hex(), password)
+		if err != nil {
+			// Derived accounts have no key file until they're used
+			if _, notFound := err.(*account.ErrCannotLocateKeyFile); notFound {
+				continue
+			}
+			return nil, err
+		}
+		keys[address.Hex()] = keyFile
+	}
+
+	exported := keypair.CopyKeypair()
+	exported.Keycards = nil
+	exported.SyncedFrom = ""
+	keypairData, err := json.Marshal(exported)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.EncryptKeystoreExport(&account.KeystoreExport{
+		Keypair: keypairData,
+		Keys:    keys,
+	}, password)
+}
+
+// ImportKeypair imports a keypair exported by ExportKeypair, from the scanned
+// chunks. Its key files are encrypted with the password of this profile
+func (api *API) ImportKeypair(ctx context.Context, chunks []string, exportPassword string, password string) (*accounts.Keypair, error) {
+	log.Info("[AccountsAPI::ImportKeypair]")
+	if !api.VerifyPassword(password) {
+		return nil, ErrInvalidPassword
+	}
+
+	exported, err := account.DecryptKeystoreExport(chunks, exportPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	keypair := &accounts.Keypair{}
+	if err := json.Unmarshal(exported.Keypair, keypair); err != nil {
+		return nil, err
+	}
+	if len(keypair.KeyUID) == 0 {
+		return nil, errors.New("`KeyUID` field of a keypair must be set")
+	}
+	if keypair.Type == accounts.KeypairTypeProfile {
+		return nil, ErrProfileKeypairExport
+	}
+	for _, acc := range keypair.Accounts {
+		if acc.KeyUID != keypair.KeyUID {
+			return nil, errors.New("all accounts of a keypair must have the same `KeyUID` as keypair key uid")
+		}
+	}
+
+	existing, err := api.db.GetKeypairByKeyUID(keypair.KeyUID)
+	if err != nil && err != accounts.ErrDbKeypairNotFound {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrKeypairExists
+	}
+
+	for address, keyFile := range exported.Keys {
+		err := api.manager.ImportKeyFile(api.config.KeyStoreDir, types.HexToAddress(address), keyFile, exportPassword, password)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = api.SaveKeypair(ctx, keypair)
+	if err != nil {
+		return nil, err
+	}
+	return keypair, nil
+}
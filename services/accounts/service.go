@@ -12,11 +12,12 @@ import (
 	"github.com/status-im/status-go/multiaccounts/accounts"
 	"github.com/status-im/status-go/params"
 	"github.com/status-im/status-go/protocol"
+	statusrpc "github.com/status-im/status-go/rpc"
 )
 
 // NewService initializes service instance.
-func NewService(db *accounts.Database, mdb *multiaccounts.Database, manager *account.GethManager, config *params.NodeConfig, feed *event.Feed, mediaServer *server.MediaServer) *Service {
-	return &Service{db, mdb, manager, config, feed, nil, mediaServer}
+func NewService(db *accounts.Database, mdb *multiaccounts.Database, manager *account.GethManager, config *params.NodeConfig, feed *event.Feed, mediaServer *server.MediaServer, rpcClient *statusrpc.Client) *Service {
+	return &Service{db, mdb, manager, config, feed, nil, mediaServer, rpcClient}
 }
 
 // Service is a browsers service.
@@ -28,6 +29,7 @@ type Service struct {
 	feed        *event.Feed
 	messenger   *protocol.Messenger
 	mediaServer *server.MediaServer
+	rpcClient   *statusrpc.Client
 }
 
 func (s *Service) Init(messenger *protocol.Messenger) {
@@ -55,7 +57,7 @@ func (s *Service) APIs() []rpc.API {
 		{
 			Namespace: "accounts",
 			Version:   "0.1.0",
-			Service:   NewAccountsAPI(s.manager, s.config, s.db, s.feed, &s.messenger),
+			Service:   NewAccountsAPI(s.manager, s.config, s.db, s.feed, &s.messenger, s.rpcClient),
 		},
 		{
 			Namespace: "multiaccounts",
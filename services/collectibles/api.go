@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -17,19 +18,25 @@ import (
 	"github.com/status-im/status-go/contracts/assets"
 	"github.com/status-im/status-go/contracts/collectibles"
 	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/protocol"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/rpc"
 	"github.com/status-im/status-go/services/utils"
 	"github.com/status-im/status-go/services/wallet/bigint"
+	"github.com/status-im/status-go/services/wallet/gasoracle"
 	"github.com/status-im/status-go/transactions"
 )
 
 func NewAPI(rpcClient *rpc.Client, accountsManager *account.GethManager, config *params.NodeConfig, appDb *sql.DB) *API {
+	ctx, cancel := context.WithCancel(context.Background())
 	return &API{
 		RPCClient:       rpcClient,
 		accountsManager: accountsManager,
 		config:          config,
 		db:              NewCommunityTokensDatabase(appDb),
+		gasOracle:       gasoracle.NewOracle(rpcClient),
+		ctx:             ctx,
+		cancel:          cancel,
 	}
 }
 
@@ -38,6 +45,14 @@ type API struct {
 	accountsManager *account.GethManager
 	config          *params.NodeConfig
 	db              *Database
+	gasOracle       *gasoracle.Oracle
+	messenger       *protocol.Messenger
+
+	// ctx is cancelled when the service stops, the deployment watchers are
+	// waited for
+	ctx      context.Context
+	cancel   context.CancelFunc
+	watchers sync.WaitGroup
 }
 
 type DeploymentDetails struct {
@@ -55,6 +70,12 @@ type DeploymentParameters struct {
 	Transferable       bool   `json:"transferable"`
 	RemoteSelfDestruct bool   `json:"remoteSelfDestruct"`
 	TokenURI           string `json:"tokenUri"`
+	// CommunityID is the community the token is deployed for, when set the
+	// token is stored with the community tokens until its deployment ends
+	CommunityID string `json:"communityId"`
+	Description string `json:"description"`
+	// Image is the path of the image of the token
+	Image string `json:"image"`
 }
 
 func (d *DeploymentParameters) GetSupply() *big.Int {
@@ -91,6 +112,21 @@ func (api *API) DeployCollectibles(ctx context.Context, chainID uint64, deployme
 		return DeploymentDetails{}, err
 	}
 
+	err = api.validateNetwork(chainID)
+	if err != nil {
+		return DeploymentDetails{}, err
+	}
+
+	err = api.checkCommunityPermission(deploymentParameters.CommunityID)
+	if err != nil {
+		return DeploymentDetails{}, err
+	}
+
+	err = api.fillFees(ctx, chainID, &txArgs)
+	if err != nil {
+		return DeploymentDetails{}, err
+	}
+
 	transactOpts := txArgs.ToTransactOpts(utils.GetSigner(chainID, api.accountsManager, api.config.KeyStoreDir, txArgs.From, password))
 
 	ethClient, err := api.RPCClient.EthClient(chainID)
@@ -108,6 +144,11 @@ func (api *API) DeployCollectibles(ctx context.Context, chainID uint64, deployme
 		return DeploymentDetails{}, err
 	}
 
+	err = api.addPendingDeployment(chainID, protobuf.CommunityTokenType_ERC721, address, common.Address(txArgs.From), tx, deploymentParameters)
+	if err != nil {
+		return DeploymentDetails{}, err
+	}
+
 	return DeploymentDetails{address.Hex(), tx.Hash().Hex()}, nil
 }
 
@@ -118,6 +159,21 @@ func (api *API) DeployAssets(ctx context.Context, chainID uint64, deploymentPara
 		return DeploymentDetails{}, err
 	}
 
+	err = api.validateNetwork(chainID)
+	if err != nil {
+		return DeploymentDetails{}, err
+	}
+
+	err = api.checkCommunityPermission(deploymentParameters.CommunityID)
+	if err != nil {
+		return DeploymentDetails{}, err
+	}
+
+	err = api.fillFees(ctx, chainID, &txArgs)
+	if err != nil {
+		return DeploymentDetails{}, err
+	}
+
 	transactOpts := txArgs.ToTransactOpts(utils.GetSigner(chainID, api.accountsManager, api.config.KeyStoreDir, txArgs.From, password))
 
 	ethClient, err := api.RPCClient.EthClient(chainID)
@@ -133,6 +189,11 @@ func (api *API) DeployAssets(ctx context.Context, chainID uint64, deploymentPara
 		return DeploymentDetails{}, err
 	}
 
+	err = api.addPendingDeployment(chainID, protobuf.CommunityTokenType_ERC20, address, common.Address(txArgs.From), tx, deploymentParameters)
+	if err != nil {
+		return DeploymentDetails{}, err
+	}
+
 	return DeploymentDetails{address.Hex(), tx.Hash().Hex()}, nil
 }
 
@@ -148,6 +209,20 @@ func (api *API) DeployAssetsEstimate(ctx context.Context) (uint64, error) {
 	return gasAmount + uint64(float32(gasAmount)*0.1), nil
 }
 
+// fillFees sets the medium suggested fees on the transaction arguments when
+// the caller didn't set them
+func (api *API) fillFees(ctx context.Context, chainID uint64, txArgs *transactions.SendTxArgs) error {
+	if txArgs.GasPrice != nil || txArgs.MaxFeePerGas != nil {
+		return nil
+	}
+	fees, err := api.gasOracle.SuggestFees(ctx, chainID)
+	if err != nil {
+		return err
+	}
+	fees.FillTxArgs(txArgs, gasoracle.FeeLevelMedium)
+	return nil
+}
+
 func (api *API) newCollectiblesInstance(chainID uint64, contractAddress string) (*collectibles.Collectibles, error) {
 	backend, err := api.RPCClient.EthClient(chainID)
 	if err != nil {
@@ -192,6 +267,16 @@ func (api *API) MintTo(ctx context.Context, chainID uint64, contractAddress stri
 		usersAddresses = append(usersAddresses, common.HexToAddress(k))
 	}
 
+	err = api.validateNetwork(chainID)
+	if err != nil {
+		return "", err
+	}
+
+	err = api.fillFees(ctx, chainID, &txArgs)
+	if err != nil {
+		return "", err
+	}
+
 	transactOpts := txArgs.ToTransactOpts(utils.GetSigner(chainID, api.accountsManager, api.config.KeyStoreDir, txArgs.From, password))
 
 	tx, err := contractInst.MintTo(transactOpts, usersAddresses)
@@ -218,6 +303,42 @@ func (api *API) EstimateMintTo(ctx context.Context, chainID uint64, contractAddr
 	return api.estimateMethod(ctx, chainID, contractAddress, "mintTo", usersAddresses)
 }
 
+// snapshotWalletAddresses returns the addresses recorded in an airdrop
+// snapshot of the community the token was deployed for
+func (api *API) snapshotWalletAddresses(chainID uint64, contractAddress string, snapshotID string) ([]string, error) {
+	tokenCommunityID, err := api.db.GetTokenCommunityID(chainID, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	communityID, walletAddresses, err := api.db.GetAirdropSnapshotAddresses(snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(communityID, tokenCommunityID) {
+		return nil, errors.New("airdrop snapshot of another community")
+	}
+	return walletAddresses, nil
+}
+
+// MintToSnapshot mints to the addresses recorded in an airdrop snapshot, so
+// that the recipients don't change if the membership changes meanwhile
+func (api *API) MintToSnapshot(ctx context.Context, chainID uint64, contractAddress string, txArgs transactions.SendTxArgs, password string, snapshotID string, amount int) (string, error) {
+	walletAddresses, err := api.snapshotWalletAddresses(chainID, contractAddress, snapshotID)
+	if err != nil {
+		return "", err
+	}
+	return api.MintTo(ctx, chainID, contractAddress, txArgs, password, walletAddresses, amount)
+}
+
+func (api *API) EstimateMintToSnapshot(ctx context.Context, chainID uint64, contractAddress string, snapshotID string, amount int) (uint64, error) {
+	walletAddresses, err := api.snapshotWalletAddresses(chainID, contractAddress, snapshotID)
+	if err != nil {
+		return 0, err
+	}
+	return api.EstimateMintTo(ctx, chainID, contractAddress, walletAddresses, amount)
+}
+
 // This is only ERC721 function
 func (api *API) RemoteBurn(ctx context.Context, chainID uint64, contractAddress string, txArgs transactions.SendTxArgs, password string, tokenIds []*bigint.BigInt) (string, error) {
 	err := api.validateTokens(tokenIds)
@@ -230,6 +351,16 @@ func (api *API) RemoteBurn(ctx context.Context, chainID uint64, contractAddress
 		return "", err
 	}
 
+	err = api.validateNetwork(chainID)
+	if err != nil {
+		return "", err
+	}
+
+	err = api.fillFees(ctx, chainID, &txArgs)
+	if err != nil {
+		return "", err
+	}
+
 	transactOpts := txArgs.ToTransactOpts(utils.GetSigner(chainID, api.accountsManager, api.config.KeyStoreDir, txArgs.From, password))
 
 	var tempTokenIds []*big.Int
@@ -342,6 +473,16 @@ func (api *API) Burn(ctx context.Context, chainID uint64, contractAddress string
 		return "", err
 	}
 
+	err = api.validateNetwork(chainID)
+	if err != nil {
+		return "", err
+	}
+
+	err = api.fillFees(ctx, chainID, &txArgs)
+	if err != nil {
+		return "", err
+	}
+
 	transactOpts := txArgs.ToTransactOpts(utils.GetSigner(chainID, api.accountsManager, api.config.KeyStoreDir, txArgs.From, password))
 
 	maxSupply, err := api.maxSupply(ctx, chainID, contractAddress)
@@ -375,6 +516,14 @@ func (api *API) EstimateBurn(ctx context.Context, chainID uint64, contractAddres
 	return api.estimateMethod(ctx, chainID, contractAddress, "setMaxSupply", newMaxSupply)
 }
 
+// validateNetwork checks that the chain is part of the active mode, so that
+// tokens aren't deployed or minted on mainnet while testing, or the other
+// way round
+func (api *API) validateNetwork(chainID uint64) error {
+	_, err := api.RPCClient.NetworkManager.FindActive(chainID)
+	return err
+}
+
 func (api *API) validateWalletsAndAmounts(walletAddresses []string, amount int) error {
 	if len(walletAddresses) == 0 {
 		return errors.New("wallet addresses list is empty")
@@ -15,37 +15,37 @@ func TestDeploymentParameters(t *testing.T) {
 	}{
 		{
 			name:       "emptyName",
-			parameters: DeploymentParameters{"", "SYMBOL", 123, false, false, false, ""},
+			parameters: DeploymentParameters{"", "SYMBOL", 123, false, false, false, "", "", "", ""},
 			isError:    true,
 		},
 		{
 			name:       "emptySymbol",
-			parameters: DeploymentParameters{"NAME", "", 123, false, false, false, ""},
+			parameters: DeploymentParameters{"NAME", "", 123, false, false, false, "", "", "", ""},
 			isError:    true,
 		},
 		{
 			name:       "negativeSupply",
-			parameters: DeploymentParameters{"NAME", "SYM", -123, false, false, false, ""},
+			parameters: DeploymentParameters{"NAME", "SYM", -123, false, false, false, "", "", "", ""},
 			isError:    true,
 		},
 		{
 			name:       "zeroSupply",
-			parameters: DeploymentParameters{"NAME", "SYM", 0, false, false, false, ""},
+			parameters: DeploymentParameters{"NAME", "SYM", 0, false, false, false, "", "", "", ""},
 			isError:    false,
 		},
 		{
 			name:       "negativeSupplyAndInfinite",
-			parameters: DeploymentParameters{"NAME", "SYM", -123, true, false, false, ""},
+			parameters: DeploymentParameters{"NAME", "SYM", -123, true, false, false, "", "", "", ""},
 			isError:    false,
 		},
 		{
 			name:       "supplyGreaterThanMax",
-			parameters: DeploymentParameters{"NAME", "SYM", maxSupply + 1, false, false, false, ""},
+			parameters: DeploymentParameters{"NAME", "SYM", maxSupply + 1, false, false, false, "", "", "", ""},
 			isError:    true,
 		},
 		{
 			name:       "supplyIsMax",
-			parameters: DeploymentParameters{"NAME", "SYM", maxSupply, false, false, false, ""},
+			parameters: DeploymentParameters{"NAME", "SYM", maxSupply, false, false, false, "", "", "", ""},
 			isError:    false,
 		},
 	}
@@ -61,10 +61,10 @@ func TestDeploymentParameters(t *testing.T) {
 		})
 	}
 
-	notInfiniteSupplyParams := DeploymentParameters{"NAME", "SYM", 123, false, false, false, ""}
+	notInfiniteSupplyParams := DeploymentParameters{"NAME", "SYM", 123, false, false, false, "", "", "", ""}
 	requiredSupply := big.NewInt(123)
 	require.Equal(t, notInfiniteSupplyParams.GetSupply(), requiredSupply)
-	infiniteSupplyParams := DeploymentParameters{"NAME", "SYM", 123, true, false, false, ""}
+	infiniteSupplyParams := DeploymentParameters{"NAME", "SYM", 123, true, false, false, "", "", "", ""}
 	requiredSupply = infiniteSupplyParams.GetInfiniteSupply()
 	require.Equal(t, infiniteSupplyParams.GetSupply(), requiredSupply)
 }
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/status-im/status-go/protocol/communities"
 	"github.com/status-im/status-go/protocol/protobuf"
 )
 
@@ -29,3 +30,76 @@ func (db *Database) GetTokenType(chainID uint64, contractAddress string) (protob
 	}
 	return result, fmt.Errorf("can't find token: chainId %v, contractAddress %v", chainID, contractAddress)
 }
+
+// SetDeploymentTransaction records the transaction deploying the token, the
+// deployment is watched until it's mined or its nonce is used
+func (db *Database) SetDeploymentTransaction(chainID uint64, contractAddress string, txHash string, nonce uint64) error {
+	_, err := db.db.Exec(`UPDATE community_tokens SET deploy_tx_hash = ?, deploy_tx_nonce = ? WHERE address = ? AND chain_id = ?`, txHash, nonce, contractAddress, chainID)
+	return err
+}
+
+// GetPendingDeployments returns the deployments from this device which are
+// still in progress
+func (db *Database) GetPendingDeployments() ([]*PendingDeployment, error) {
+	rows, err := db.db.Query(`SELECT community_id, chain_id, address, deployer, deploy_tx_hash, deploy_tx_nonce FROM community_tokens WHERE deploy_state = ? AND deploy_tx_hash != ''`, communities.InProgress)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deployments []*PendingDeployment
+	for rows.Next() {
+		deployment := &PendingDeployment{}
+		err := rows.Scan(&deployment.CommunityID, &deployment.ChainID, &deployment.Address, &deployment.Deployer, &deployment.TxHash, &deployment.Nonce)
+		if err != nil {
+			return nil, err
+		}
+		deployments = append(deployments, deployment)
+	}
+	return deployments, rows.Err()
+}
+
+func (db *Database) UpdateTokenState(chainID uint64, contractAddress string, deployState communities.DeployState) error {
+	_, err := db.db.Exec(`UPDATE community_tokens SET deploy_state = ? WHERE address = ? AND chain_id = ?`, deployState, contractAddress, chainID)
+	return err
+}
+
+// GetTokenCommunityID returns the community the token was deployed for
+func (db *Database) GetTokenCommunityID(chainID uint64, contractAddress string) (string, error) {
+	var communityID string
+	err := db.db.QueryRow(`SELECT community_id FROM community_tokens WHERE chain_id = ? AND address = ? LIMIT 1`, chainID, contractAddress).Scan(&communityID)
+	if err == sql.ErrNoRows {
+		return "", fmt.Errorf("can't find token: chainId %v, contractAddress %v", chainID, contractAddress)
+	}
+	return communityID, err
+}
+
+// GetAirdropSnapshotAddresses returns the community of an airdrop snapshot and
+// the airdrop addresses of its recipients, sorted
+func (db *Database) GetAirdropSnapshotAddresses(snapshotID string) (string, []string, error) {
+	var communityID string
+	err := db.db.QueryRow(`SELECT community_id FROM communities_airdrop_snapshots WHERE id = ?`, snapshotID).Scan(&communityID)
+	if err == sql.ErrNoRows {
+		return "", nil, fmt.Errorf("can't find airdrop snapshot %s", snapshotID)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	// One address per member, for snapshots recorded with all of them
+	rows, err := db.db.Query(`SELECT DISTINCT MIN(address) AS address FROM communities_airdrop_snapshot_recipients WHERE snapshot_id = ? GROUP BY public_key ORDER BY address`, snapshotID)
+	if err != nil {
+		return "", nil, err
+	}
+	defer rows.Close()
+
+	var addresses []string
+	for rows.Next() {
+		var address string
+		if err := rows.Scan(&address); err != nil {
+			return "", nil, err
+		}
+		addresses = append(addresses, address)
+	}
+	return communityID, addresses, rows.Err()
+}
@@ -103,3 +103,27 @@ func (s *DatabaseSuite) TestGetTokenType() {
 	_, err = s.db.GetTokenType(10, "0x777")
 	s.Require().Error(err)
 }
+
+func (s *DatabaseSuite) TestPendingDeployments() {
+	// Tokens without a deployment transaction aren't watched
+	deployments, err := s.db.GetPendingDeployments()
+	s.Require().NoError(err)
+	s.Require().Empty(deployments)
+
+	err = s.db.SetDeploymentTransaction(1, "0x123", "0xabc", 7)
+	s.Require().NoError(err)
+	err = s.db.SetDeploymentTransaction(2, "0x345", "0xdef", 8)
+	s.Require().NoError(err)
+
+	deployments, err = s.db.GetPendingDeployments()
+	s.Require().NoError(err)
+	s.Require().Equal([]*PendingDeployment{
+		{CommunityID: "123", ChainID: 1, Address: "0x123", TxHash: "0xabc", Nonce: 7},
+	}, deployments)
+
+	err = s.db.UpdateTokenState(1, "0x123", communities.Deployed)
+	s.Require().NoError(err)
+	deployments, err = s.db.GetPendingDeployments()
+	s.Require().NoError(err)
+	s.Require().Empty(deployments)
+}
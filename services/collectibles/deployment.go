@@ -0,0 +1,195 @@
+package collectibles
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/signal"
+)
+
+// deploymentCheckInterval is how often a deployment is checked until it's
+// mined or its nonce is used by another transaction
+const deploymentCheckInterval = 15 * time.Second
+
+// erc20Decimals are the decimals of the assets contract
+const erc20Decimals = 18
+
+var ErrMessengerNotReady = errors.New("messenger isn't ready")
+
+// PendingDeployment is a deployment sent from this device which isn't mined
+// yet
+type PendingDeployment struct {
+	CommunityID string
+	ChainID     uint64
+	Address     string
+	Deployer    string
+	TxHash      string
+	Nonce       uint64
+}
+
+// checkCommunityPermission makes sure the token can be added to the community
+// before it's deployed
+func (api *API) checkCommunityPermission(communityID string) error {
+	if communityID == "" {
+		return nil
+	}
+	if api.messenger == nil {
+		return ErrMessengerNotReady
+	}
+	return api.messenger.CheckCommunityMintPermission(communityID)
+}
+
+// addPendingDeployment adds the token being deployed to the community, so
+// that clients don't have to, and watches its deployment
+func (api *API) addPendingDeployment(chainID uint64, tokenType protobuf.CommunityTokenType, address common.Address, deployer common.Address, tx *types.Transaction, deploymentParameters DeploymentParameters) error {
+	if deploymentParameters.CommunityID == "" {
+		return nil
+	}
+	if api.messenger == nil {
+		return ErrMessengerNotReady
+	}
+
+	token := &communities.CommunityToken{
+		TokenType:          tokenType,
+		CommunityID:        deploymentParameters.CommunityID,
+		Address:            address.Hex(),
+		Name:               deploymentParameters.Name,
+		Symbol:             deploymentParameters.Symbol,
+		Description:        deploymentParameters.Description,
+		Supply:             deploymentParameters.Supply,
+		InfiniteSupply:     deploymentParameters.InfiniteSupply,
+		Transferable:       deploymentParameters.Transferable,
+		RemoteSelfDestruct: deploymentParameters.RemoteSelfDestruct,
+		ChainID:            int(chainID),
+		DeployState:        communities.InProgress,
+		// The path of the image, it's encoded by the communities manager
+		Base64Image: deploymentParameters.Image,
+		Deployer:    deployer.Hex(),
+	}
+	if tokenType == protobuf.CommunityTokenType_ERC20 {
+		token.Decimals = erc20Decimals
+	}
+
+	_, err := api.messenger.AddCommunityToken(token, nil)
+	if err != nil {
+		return err
+	}
+
+	deployment := &PendingDeployment{
+		CommunityID: deploymentParameters.CommunityID,
+		ChainID:     chainID,
+		Address:     address.Hex(),
+		Deployer:    deployer.Hex(),
+		TxHash:      tx.Hash().Hex(),
+		Nonce:       tx.Nonce(),
+	}
+	err = api.db.SetDeploymentTransaction(chainID, deployment.Address, deployment.TxHash, deployment.Nonce)
+	if err != nil {
+		return err
+	}
+	api.watchDeployment(deployment)
+	return nil
+}
+
+// resumeDeployments watches the deployments which were still in progress
+// when the service stopped
+func (api *API) resumeDeployments() error {
+	deployments, err := api.db.GetPendingDeployments()
+	if err != nil {
+		return err
+	}
+	for _, deployment := range deployments {
+		api.watchDeployment(deployment)
+	}
+	return nil
+}
+
+// watchDeployment checks the deployment until it's known whether it
+// succeeded, then updates the state of the stored token and signals it
+func (api *API) watchDeployment(deployment *PendingDeployment) {
+	api.watchers.Add(1)
+	go func() {
+		defer api.watchers.Done()
+
+		ticker := time.NewTicker(deploymentCheckInterval)
+		defer ticker.Stop()
+		for {
+			deployState, err := api.checkDeployment(api.ctx, deployment)
+			if err != nil && api.ctx.Err() == nil {
+				log.Warn("failed to check community token deployment", "address", deployment.Address, "chainID", deployment.ChainID, "error", err)
+			}
+			if err == nil && deployState != communities.InProgress {
+				api.deploymentDone(deployment, deployState)
+				return
+			}
+
+			select {
+			case <-api.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// checkDeployment returns the state of the deployment, it's deployed when its
+// transaction succeeded and failed when it reverted. When the nonce of the
+// transaction was used by another one, it's deployed if a replacement
+// deployed the contract
+func (api *API) checkDeployment(ctx context.Context, deployment *PendingDeployment) (communities.DeployState, error) {
+	ethClient, err := api.RPCClient.EthClient(deployment.ChainID)
+	if err != nil {
+		return communities.InProgress, err
+	}
+
+	receipt, err := ethClient.TransactionReceipt(ctx, common.HexToHash(deployment.TxHash))
+	if err == nil && receipt != nil {
+		if receipt.Status == types.ReceiptStatusSuccessful {
+			return communities.Deployed, nil
+		}
+		return communities.Failed, nil
+	}
+	if err != nil && !errors.Is(err, ethereum.NotFound) {
+		return communities.InProgress, err
+	}
+
+	nonce, err := ethClient.NonceAt(ctx, common.HexToAddress(deployment.Deployer), nil)
+	if err != nil {
+		return communities.InProgress, err
+	}
+	if nonce <= deployment.Nonce {
+		return communities.InProgress, nil
+	}
+
+	// The contract address only depends on the deployer and the nonce
+	code, err := ethClient.CodeAt(ctx, common.HexToAddress(deployment.Address), nil)
+	if err != nil {
+		return communities.InProgress, err
+	}
+	if len(code) > 0 {
+		return communities.Deployed, nil
+	}
+	return communities.Failed, nil
+}
+
+func (api *API) deploymentDone(deployment *PendingDeployment, deployState communities.DeployState) {
+	if deployState == communities.Failed {
+		log.Error("community token deployment failed", "address", deployment.Address, "chainID", deployment.ChainID)
+	}
+
+	err := api.db.UpdateTokenState(deployment.ChainID, deployment.Address, deployState)
+	if err != nil {
+		log.Error("failed to update community token state", "address", deployment.Address, "error", err)
+		return
+	}
+
+	signal.SendCommunityTokenDeploymentStatus(deployment.CommunityID, deployment.ChainID, deployment.Address, deployment.TxHash, deployState == communities.Deployed)
+}
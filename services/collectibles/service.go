@@ -7,6 +7,7 @@ import (
 	ethRpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/status-im/status-go/account"
 	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/protocol"
 	"github.com/status-im/status-go/rpc"
 )
 
@@ -22,6 +23,11 @@ func NewService(rpcClient *rpc.Client, accountsManager *account.GethManager, con
 	}
 }
 
+// Init sets the messenger the deployed tokens are added to the communities of
+func (s *Service) Init(messenger *protocol.Messenger) {
+	s.api.messenger = messenger
+}
+
 // Protocols returns a new protocols list. In this case, there are none.
 func (s *Service) Protocols() []p2p.Protocol {
 	return []p2p.Protocol{}
@@ -44,7 +50,15 @@ func (s *Service) Start() error {
 	return nil
 }
 
+// StartDeferred watches again the deployments still in progress, once the
+// messenger is responsive.
+func (s *Service) StartDeferred() error {
+	return s.api.resumeDeployments()
+}
+
 // Stop is run when a service is stopped.
 func (s *Service) Stop() error {
+	s.api.cancel()
+	s.api.watchers.Wait()
 	return nil
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/multiformats/go-multihash"
 	"github.com/pkg/errors"
 	"github.com/wealdtech/go-ens/v3"
+	"github.com/wealdtech/go-ens/v3/contracts/reverseregistrar"
 	"github.com/wealdtech/go-multicodec"
 
 	"github.com/ethereum/go-ethereum"
@@ -40,6 +41,12 @@ import (
 
 const StatusDomain = "stateofus.eth"
 
+// reverseDomain is owned by the reverse registrar, which sets the reverse
+// records of addresses
+const reverseDomain = "addr.reverse"
+
+var ErrPrimaryNameNotOwned = errors.New("name does not resolve to the account")
+
 func NewAPI(rpcClient *rpc.Client, accountsManager *account.GethManager, rpcFiltersSrvc *rpcfilters.Service, config *params.NodeConfig, appDb *sql.DB, timeSource func() time.Time, syncUserDetailFunc *syncUsernameDetail) *API {
 	return &API{
 		contractMaker: &contracts.ContractMaker{
@@ -50,6 +57,7 @@ func NewAPI(rpcClient *rpc.Client, accountsManager *account.GethManager, rpcFilt
 		config:          config,
 		addrPerChain:    make(map[uint64]common.Address),
 		db:              NewEnsDatabase(appDb),
+		primaryNames:    NewPrimaryNames(rpcClient, NewEnsDatabase(appDb), timeSource),
 
 		quit:               make(chan struct{}),
 		timeSource:         timeSource,
@@ -79,6 +87,7 @@ type API struct {
 	quit     chan struct{}
 
 	db                 *Database
+	primaryNames       *PrimaryNames
 	syncUserDetailFunc *syncUsernameDetail
 
 	timeSource func() time.Time
@@ -612,6 +621,127 @@ func (api *API) SetPubKeyEstimate(ctx context.Context, chainID uint64, txArgs tr
 	return estimate + 1000, nil
 }
 
+// PrimaryNames returns the primary ENS names of the addresses, set in their
+// reverse records and resolving back to them. Names are cached for a day
+func (api *API) PrimaryNames(ctx context.Context, chainID uint64, addresses []common.Address) (map[common.Address]string, error) {
+	return api.primaryNames.Get(ctx, chainID, addresses)
+}
+
+func (api *API) reverseRegistrarAddr(ctx context.Context, chainID uint64) (common.Address, error) {
+	registry, err := api.contractMaker.NewRegistry(chainID)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx, Pending: false}
+	return registry.Owner(callOpts, nameHash(reverseDomain))
+}
+
+// SetPrimaryName sets the reverse record of the account to the name, which
+// must resolve to the account. An empty name clears the primary name
+func (api *API) SetPrimaryName(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, password string, name string) (string, error) {
+	callMsg, err := api.SetPrimaryNamePrepareTxCallMsg(ctx, chainID, txArgs, name)
+	if err != nil {
+		return "", err
+	}
+
+	backend, err := api.contractMaker.RPCClient.EthClient(chainID)
+	if err != nil {
+		return "", err
+	}
+
+	reverseRegistrar, err := reverseregistrar.NewContract(*callMsg.To, backend)
+	if err != nil {
+		return "", err
+	}
+
+	txOpts := txArgs.ToTransactOpts(utils.GetSigner(chainID, api.accountsManager, api.config.KeyStoreDir, txArgs.From, password))
+	tx, err := reverseRegistrar.SetName(txOpts, name)
+	if err != nil {
+		return "", err
+	}
+
+	go api.rpcFiltersSrvc.TriggerTransactionSentToUpstreamEvent(types.Hash(tx.Hash()))
+
+	err = api.primaryNames.Invalidate(chainID, common.Address(txArgs.From))
+	if err != nil {
+		log.Warn("Setting primary ENS name: transaction successful, but invalidating cache failed")
+	}
+
+	return tx.Hash().String(), nil
+}
+
+// ClearPrimaryName removes the primary name of the account
+func (api *API) ClearPrimaryName(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, password string) (string, error) {
+	return api.SetPrimaryName(ctx, chainID, txArgs, password, "")
+}
+
+func (api *API) SetPrimaryNamePrepareTxCallMsg(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, name string) (ethereum.CallMsg, error) {
+	if name != "" {
+		err := validateENSUsername(name)
+		if err != nil {
+			return ethereum.CallMsg{}, err
+		}
+
+		address, err := api.AddressOf(ctx, chainID, name)
+		if err != nil {
+			return ethereum.CallMsg{}, err
+		}
+		if *address != common.Address(txArgs.From) {
+			return ethereum.CallMsg{}, ErrPrimaryNameNotOwned
+		}
+	}
+
+	reverseRegistrarABI, err := abi.JSON(strings.NewReader(reverseregistrar.ContractABI))
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	data, err := reverseRegistrarABI.Pack("setName", name)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	reverseRegistrarAddress, err := api.reverseRegistrarAddr(ctx, chainID)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	return ethereum.CallMsg{
+		From:  common.Address(txArgs.From),
+		To:    &reverseRegistrarAddress,
+		Value: big.NewInt(0),
+		Data:  data,
+	}, nil
+}
+
+func (api *API) SetPrimaryNamePrepareTx(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, name string) (interface{}, error) {
+	callMsg, err := api.SetPrimaryNamePrepareTxCallMsg(ctx, chainID, txArgs, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCallArg(callMsg), nil
+}
+
+func (api *API) SetPrimaryNameEstimate(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, name string) (uint64, error) {
+	ethClient, err := api.contractMaker.RPCClient.EthClient(chainID)
+	if err != nil {
+		return 0, err
+	}
+
+	callMsg, err := api.SetPrimaryNamePrepareTxCallMsg(ctx, chainID, txArgs, name)
+	if err != nil {
+		return 0, err
+	}
+
+	estimate, err := ethClient.EstimateGas(ctx, callMsg)
+	if err != nil {
+		return 0, err
+	}
+	return estimate + 1000, nil
+}
+
 func (api *API) ResourceURL(ctx context.Context, chainID uint64, username string) (*URI, error) {
 	scheme := "https"
 	contentHash, err := api.ContentHash(ctx, chainID, username)
@@ -156,3 +156,36 @@ func TestResourceURL(t *testing.T) {
 	require.Equal(t, "noahzinsmeister.com", uri.Host)
 	require.Equal(t, "", uri.Path)
 }
+
+func TestPrimaryNames(t *testing.T) {
+	db, cancel := createDB(t)
+	defer cancel()
+
+	ensDB := NewEnsDatabase(db)
+	address := common.HexToAddress("0x1")
+	noName := common.HexToAddress("0x2")
+	now := time.Now()
+
+	require.NoError(t, ensDB.SavePrimaryName(1, address, &PrimaryName{Name: "test.eth", ResolvedAt: now.Unix()}))
+	require.NoError(t, ensDB.SavePrimaryName(1, noName, &PrimaryName{ResolvedAt: now.Unix()}))
+
+	cached, err := ensDB.GetPrimaryNames(1, []common.Address{address, noName})
+	require.NoError(t, err)
+	require.Len(t, cached, 2)
+	require.Equal(t, "test.eth", cached[address].Name)
+
+	cached, err = ensDB.GetPrimaryNames(5, []common.Address{address})
+	require.NoError(t, err)
+	require.Len(t, cached, 0)
+
+	// Cached names are returned without being resolved
+	primaryNames := NewPrimaryNames(nil, ensDB, func() time.Time { return now })
+	names, err := primaryNames.Get(context.Background(), 1, []common.Address{address, noName})
+	require.NoError(t, err)
+	require.Equal(t, map[common.Address]string{address: "test.eth", noName: ""}, names)
+
+	require.NoError(t, primaryNames.Invalidate(1, address))
+	cached, err = ensDB.GetPrimaryNames(1, []common.Address{address, noName})
+	require.NoError(t, err)
+	require.Len(t, cached, 1)
+}
@@ -2,6 +2,9 @@ package ens
 
 import (
 	"database/sql"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type Database struct {
@@ -79,3 +82,54 @@ WHERE NOT EXISTS (SELECT 1 FROM ens_usernames WHERE username = ? AND chain_id =
 	_, err := db.db.Exec(sqlQuery, details.Username, details.ChainID, details.Clock, details.Removed, details.Username, details.ChainID, details.Clock)
 	return err
 }
+
+// PrimaryName is the cached primary name of an address, empty when the
+// address has no verified reverse record
+type PrimaryName struct {
+	Name       string `json:"name"`
+	ResolvedAt int64  `json:"resolvedAt"`
+}
+
+func (db *Database) GetPrimaryNames(chainID uint64, addresses []common.Address) (map[common.Address]*PrimaryName, error) {
+	result := make(map[common.Address]*PrimaryName, len(addresses))
+	if len(addresses) == 0 {
+		return result, nil
+	}
+
+	args := []interface{}{chainID}
+	for _, address := range addresses {
+		args = append(args, address.Hex())
+	}
+	query := `SELECT address, name, resolved_at FROM ens_primary_names
+			  WHERE chain_id = ? AND address IN (?` + strings.Repeat(",?", len(addresses)-1) + `)` // nolint: gosec
+
+	rows, err := db.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var address string
+		primaryName := &PrimaryName{}
+		err = rows.Scan(&address, &primaryName.Name, &primaryName.ResolvedAt)
+		if err != nil {
+			return nil, err
+		}
+		result[common.HexToAddress(address)] = primaryName
+	}
+
+	return result, rows.Err()
+}
+
+func (db *Database) SavePrimaryName(chainID uint64, address common.Address, primaryName *PrimaryName) error {
+	const sqlQuery = `INSERT OR REPLACE INTO ens_primary_names (chain_id, address, name, resolved_at)
+					  VALUES (?, ?, ?, ?)`
+	_, err := db.db.Exec(sqlQuery, chainID, address.Hex(), primaryName.Name, primaryName.ResolvedAt)
+	return err
+}
+
+func (db *Database) DeletePrimaryName(chainID uint64, address common.Address) error {
+	_, err := db.db.Exec(`DELETE FROM ens_primary_names WHERE chain_id = ? AND address = ?`, chainID, address.Hex())
+	return err
+}
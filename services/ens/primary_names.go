@@ -0,0 +1,117 @@
+package ens
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/wealdtech/go-ens/v3"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/rpc"
+)
+
+// primaryNameTTL is how long a resolved primary name is used before being
+// resolved again
+const primaryNameTTL = 24 * time.Hour
+
+// unresolvedErrors are returned by go-ens when a name or an address has no
+// record, as opposed to a failed request
+var unresolvedErrors = map[string]struct{}{
+	"no resolution":     {},
+	"unregistered name": {},
+	"no resolver":       {},
+	"no address":        {},
+}
+
+func isUnresolved(err error) bool {
+	if errors.Is(err, bind.ErrNoCode) {
+		return true
+	}
+	_, ok := unresolvedErrors[err.Error()]
+	return ok
+}
+
+// PrimaryNames resolves the primary ENS names of addresses, from their reverse
+// records, and caches them
+type PrimaryNames struct {
+	rpcClient  *rpc.Client
+	db         *Database
+	timeSource func() time.Time
+}
+
+func NewPrimaryNames(rpcClient *rpc.Client, db *Database, timeSource func() time.Time) *PrimaryNames {
+	return &PrimaryNames{
+		rpcClient:  rpcClient,
+		db:         db,
+		timeSource: timeSource,
+	}
+}
+
+// resolve returns the name of the reverse record of the address, only if the
+// name resolves back to the address. Anyone can claim any name in a reverse
+// record
+func (p *PrimaryNames) resolve(ctx context.Context, chainID uint64, address common.Address) (string, error) {
+	backend, err := p.rpcClient.EthClient(chainID)
+	if err != nil {
+		return "", err
+	}
+
+	name, err := ens.ReverseResolve(backend, address)
+	if err != nil {
+		if isUnresolved(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	resolved, err := ens.Resolve(backend, name)
+	if err != nil {
+		if isUnresolved(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if resolved != address {
+		return "", nil
+	}
+	return name, nil
+}
+
+// Get returns the primary names of the addresses, resolving the ones not
+// cached or cached for longer than primaryNameTTL. Addresses without primary
+// name are mapped to an empty string
+func (p *PrimaryNames) Get(ctx context.Context, chainID uint64, addresses []common.Address) (map[common.Address]string, error) {
+	cached, err := p.db.GetPrimaryNames(chainID, addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	now := p.timeSource()
+	result := make(map[common.Address]string, len(addresses))
+	for _, address := range addresses {
+		if primaryName, ok := cached[address]; ok && now.Sub(time.Unix(primaryName.ResolvedAt, 0)) < primaryNameTTL {
+			result[address] = primaryName.Name
+			continue
+		}
+
+		name, err := p.resolve(ctx, chainID, address)
+		if err != nil {
+			return nil, err
+		}
+		err = p.db.SavePrimaryName(chainID, address, &PrimaryName{Name: name, ResolvedAt: now.Unix()})
+		if err != nil {
+			return nil, err
+		}
+		result[address] = name
+	}
+	return result, nil
+}
+
+// Invalidate removes the cached primary name of the address, after its
+// reverse record was changed
+func (p *PrimaryNames) Invalidate(chainID uint64, address common.Address) error {
+	return p.db.DeletePrimaryName(chainID, address)
+}
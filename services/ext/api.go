@@ -10,6 +10,7 @@ import (
 
 	"github.com/status-im/status-go/services/browsers"
 	"github.com/status-im/status-go/services/wallet"
+	"github.com/status-im/status-go/services/wallet/token"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
@@ -19,6 +20,7 @@ import (
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/logutils"
 	"github.com/status-im/status-go/mailserver"
 	"github.com/status-im/status-go/multiaccounts/settings"
 	"github.com/status-im/status-go/protocol"
@@ -30,10 +32,15 @@ import (
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/pushnotificationclient"
 	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/socialrecovery"
 	"github.com/status-im/status-go/protocol/transport"
 	"github.com/status-im/status-go/protocol/urls"
 	"github.com/status-im/status-go/protocol/verification"
+	"github.com/status-im/status-go/rpc"
 	"github.com/status-im/status-go/services/ext/mailservers"
+	"github.com/status-im/status-go/sqlite"
+	"github.com/status-im/status-go/telemetry"
+	"github.com/status-im/status-go/transactions"
 )
 
 const (
@@ -395,6 +402,11 @@ func (api *PublicAPI) CuratedCommunities(parent context.Context) (*communities.K
 	return api.service.messenger.CuratedCommunities()
 }
 
+// SearchCuratedCommunities searches the last fetched curated communities, ranked by members, language and token requirements
+func (api *PublicAPI) SearchCuratedCommunities(request *requests.SearchCommunityDirectory) ([]*communities.RankedCommunity, error) {
+	return api.service.messenger.SearchCuratedCommunities(request)
+}
+
 // SpectateCommunity spectates community with the given ID
 // Meaning user is only a spectator, not a member
 func (api *PublicAPI) SpectateCommunity(parent context.Context, communityID types.HexBytes) (*protocol.MessengerResponse, error) {
@@ -476,6 +488,18 @@ func (api *PublicAPI) ShareCommunity(request *requests.ShareCommunity) (*protoco
 	return api.service.messenger.ShareCommunity(request)
 }
 
+// BroadcastCommunityAnnouncement posts an announcement to a channel of each of the given owned communities
+func (api *PublicAPI) BroadcastCommunityAnnouncement(ctx context.Context, request *requests.BroadcastCommunityAnnouncement) (*CommunityAnnouncementResponse, error) {
+	response, results, err := api.service.messenger.BroadcastCommunityAnnouncement(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &CommunityAnnouncementResponse{
+		Response: response,
+		Results:  results,
+	}, nil
+}
+
 // ShareImageMessage share the selected chat image with a set of users
 func (api *PublicAPI) ShareImageMessage(request *requests.ShareImageMessage) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.ShareImageMessage(request)
@@ -496,6 +520,24 @@ func (api *PublicAPI) BanUserFromCommunity(request *requests.BanUserFromCommunit
 	return api.service.messenger.BanUserFromCommunity(request)
 }
 
+// CommunityModerationLog returns the bans recorded for the community with ID
+func (api *PublicAPI) CommunityModerationLog(communityID types.HexBytes) ([]*communities.ModerationLogEntry, error) {
+	return api.service.messenger.CommunityModerationLog(communityID)
+}
+
+// GetCommunityAuditLog returns a page of the changes applied to a community by its privileged members
+func (api *PublicAPI) GetCommunityAuditLog(request *requests.GetCommunityAuditLog) (*CommunityAuditLogResponse, error) {
+	entries, cursor, err := api.service.messenger.GetCommunityAuditLog(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CommunityAuditLogResponse{
+		Entries: entries,
+		Cursor:  cursor,
+	}, nil
+}
+
 // UnbanUserFromCommunity removes the user's pk from the community ban list
 func (api *PublicAPI) UnbanUserFromCommunity(request *requests.UnbanUserFromCommunity) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.UnbanUserFromCommunity(request)
@@ -509,6 +551,71 @@ func (api *PublicAPI) RemoveRoleFromMember(request *requests.RemoveRoleFromMembe
 	return api.service.messenger.RemoveRoleFromMember(request)
 }
 
+// SetMemberRoles replaces the roles of a member of a community
+func (api *PublicAPI) SetMemberRoles(request *requests.SetMemberRoles) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SetMemberRoles(request)
+}
+
+// SetCommunityMembershipPayment sets the ERC20 payment required to join a community, an empty amount makes it free
+func (api *PublicAPI) SetCommunityMembershipPayment(request *requests.SetCommunityMembershipPayment) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SetCommunityMembershipPayment(request)
+}
+
+// SetCommunitySnapshotSpaces links the Snapshot spaces where the members of a community vote on its proposals
+func (api *PublicAPI) SetCommunitySnapshotSpaces(request *requests.SetCommunitySnapshotSpaces) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SetCommunitySnapshotSpaces(request)
+}
+
+// UpdateCommunityBlocklist adds users to the blocklist of a community and removes others from it
+func (api *PublicAPI) UpdateCommunityBlocklist(request *requests.UpdateCommunityBlocklist) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.UpdateCommunityBlocklist(request)
+}
+
+// SetCommunityBlocklistsEnabled opts in or out of hiding the messages of the users blocklisted by the joined communities
+func (api *PublicAPI) SetCommunityBlocklistsEnabled(enabled bool) error {
+	return api.service.messenger.SetCommunityBlocklistsEnabled(enabled)
+}
+
+// SetCommunityEmojiPack creates an emoji pack of a community, or replaces it when its ID is set
+func (api *PublicAPI) SetCommunityEmojiPack(request *requests.SetCommunityEmojiPack) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SetCommunityEmojiPack(request)
+}
+
+// SetCommunityProfile sets the display name and the avatar used in a joined community instead of the
+// ones of the account, leaving both empty goes back to the ones of the account
+func (api *PublicAPI) SetCommunityProfile(request *requests.SetCommunityProfile) (*protocol.CommunityMemberProfile, error) {
+	return api.service.messenger.SetCommunityProfile(request)
+}
+
+// CommunityProfile returns the profile used in a community, nil when it's the one of the account
+func (api *PublicAPI) CommunityProfile(communityID types.HexBytes) (*protocol.CommunityMemberProfile, error) {
+	return api.service.messenger.CommunityProfile(communityID)
+}
+
+// CommunityMemberProfiles returns the profiles the members of a community use in it
+func (api *PublicAPI) CommunityMemberProfiles(communityID types.HexBytes) ([]*protocol.CommunityMemberProfile, error) {
+	return api.service.messenger.CommunityMemberProfiles(communityID)
+}
+
+func (api *PublicAPI) DeleteCommunityEmojiPack(communityID types.HexBytes, packID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.DeleteCommunityEmojiPack(communityID, packID)
+}
+
+// SendCommunityEmojiReaction reacts to a message of a community chat with an emoji of the community emoji packs
+func (api *PublicAPI) SendCommunityEmojiReaction(ctx context.Context, chatID, messageID string, emojiID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendCommunityEmojiReaction(ctx, chatID, messageID, emojiID)
+}
+
+// GenerateCommunityMembershipPayment returns the transaction paying for joining a community, to be sent by the wallet
+func (api *PublicAPI) GenerateCommunityMembershipPayment(communityID types.HexBytes, from types.Address) (*transactions.SendTxArgs, error) {
+	return api.service.messenger.GenerateCommunityMembershipPayment(communityID, from)
+}
+
+// GetMemberRoles returns the roles of a member of a community and the permissions they grant
+func (api *PublicAPI) GetMemberRoles(communityID types.HexBytes, user types.HexBytes) (*communities.MemberRoles, error) {
+	return api.service.messenger.GetMemberRoles(communityID, user)
+}
+
 func (api *PublicAPI) CreateCommunityTokenPermission(request *requests.CreateCommunityTokenPermission) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.CreateCommunityTokenPermission(request)
 }
@@ -536,6 +643,12 @@ func (api *PublicAPI) PendingRequestsToJoinForCommunity(id types.HexBytes) ([]*c
 	return api.service.messenger.PendingRequestsToJoinForCommunity(id)
 }
 
+// GetCommunityMembersPage returns a page of the members of a community, or of
+// one of its chats
+func (api *PublicAPI) GetCommunityMembersPage(request *requests.GetCommunityMembersPage) (*communities.CommunityMembersPage, error) {
+	return api.service.messenger.GetCommunityMembersPage(request)
+}
+
 // DeclinedRequestsToJoinForCommunity returns the declined requests to join for a given community
 func (api *PublicAPI) DeclinedRequestsToJoinForCommunity(id types.HexBytes) ([]*communities.RequestToJoin, error) {
 	return api.service.messenger.DeclinedRequestsToJoinForCommunity(id)
@@ -561,6 +674,16 @@ func (api *PublicAPI) DeclineRequestToJoinCommunity(request *requests.DeclineReq
 	return api.service.messenger.DeclineRequestToJoinCommunity(request)
 }
 
+// ModerateRequestsToJoinCommunity accepts and declines pending requests to join a community at once
+func (api *PublicAPI) ModerateRequestsToJoinCommunity(request *requests.ModerateRequestsToJoinCommunity) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ModerateRequestsToJoinCommunity(request)
+}
+
+// FilterPendingRequestsToJoinCommunity returns the pending requests to join a community matching the filters
+func (api *PublicAPI) FilterPendingRequestsToJoinCommunity(request *requests.PendingRequestsToJoinCommunity) ([]*communities.RequestToJoin, error) {
+	return api.service.messenger.FilterPendingRequestsToJoinCommunity(request)
+}
+
 // RequestToJoinCommunity requests to join a particular community
 func (api *PublicAPI) RequestToJoinCommunity(request *requests.RequestToJoinCommunity) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.RequestToJoinCommunity(request)
@@ -611,6 +734,16 @@ type ApplicationPinnedMessagesResponse struct {
 	Cursor         string                  `json:"cursor"`
 }
 
+type CommunityAuditLogResponse struct {
+	Entries []*communities.AuditLogEntry `json:"entries"`
+	Cursor  string                       `json:"cursor"`
+}
+
+type CommunityAnnouncementResponse struct {
+	Response *protocol.MessengerResponse             `json:"response"`
+	Results  []*protocol.CommunityAnnouncementResult `json:"results"`
+}
+
 type ApplicationStatusUpdatesResponse struct {
 	StatusUpdates []protocol.UserStatus `json:"statusUpdates"`
 }
@@ -639,6 +772,14 @@ func (api *PublicAPI) FirstUnseenMessageID(chatID string) (string, error) {
 	return api.service.messenger.FirstUnseenMessageID(chatID)
 }
 
+func (api *PublicAPI) ExportChatHistory(request *requests.ExportChatHistory) (*protocol.ExportedChatHistory, error) {
+	return api.service.messenger.ExportChatHistory(request)
+}
+
+func (api *PublicAPI) SearchMessages(request *requests.SearchMessages) (*protocol.MessagesSearchResponse, error) {
+	return api.service.messenger.SearchMessages(request)
+}
+
 func (api *PublicAPI) AllMessagesFromChatWhichMatchTerm(chatID, searchTerm string, caseSensitive bool) (*ApplicationMessagesResponse, error) {
 	messages, err := api.service.messenger.AllMessageByChatIDWhichMatchTerm(chatID, searchTerm, caseSensitive)
 	if err != nil {
@@ -753,6 +894,26 @@ func (api *PublicAPI) DeclineContactRequest(ctx context.Context, request *reques
 	return api.service.messenger.DeclineContactRequest(ctx, request)
 }
 
+// SetContactRequestsThrottlingEnabled enables keeping the requests of non-contacts with a low trust score in the requests bucket
+func (api *PublicAPI) SetContactRequestsThrottlingEnabled(enabled bool) error {
+	return api.service.messenger.SetContactRequestsThrottlingEnabled(enabled)
+}
+
+// ContactTrust returns the trust score of a user, computed locally
+func (api *PublicAPI) ContactTrust(contactID string) (*protocol.ContactTrust, error) {
+	return api.service.messenger.ContactTrust(contactID)
+}
+
+// ContactRequestsBucket returns the contact requests and messages of non-contacts waiting to be reviewed
+func (api *PublicAPI) ContactRequestsBucket() ([]*protocol.BucketedContactRequest, error) {
+	return api.service.messenger.ContactRequestsBucket()
+}
+
+// ReviewBucketedContactRequests accepts and declines requests of the requests bucket at once
+func (api *PublicAPI) ReviewBucketedContactRequests(ctx context.Context, request *requests.ReviewBucketedContactRequests) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ReviewBucketedContactRequests(ctx, request)
+}
+
 func (api *PublicAPI) AcceptLatestContactRequestForContact(ctx context.Context, request *requests.AcceptLatestContactRequestForContact) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.AcceptLatestContactRequestForContact(ctx, request)
 }
@@ -769,6 +930,12 @@ func (api *PublicAPI) RemoveContact(ctx context.Context, pubKey string) (*protoc
 	return api.service.messenger.RemoveContact(ctx, pubKey)
 }
 
+// GetContactsDetails returns what's needed to render the users in a list, in
+// one call
+func (api *PublicAPI) GetContactsDetails(publicKeys []string) ([]*protocol.ContactDetails, error) {
+	return api.service.messenger.GetContactsDetails(publicKeys)
+}
+
 func (api *PublicAPI) SetContactLocalNickname(ctx context.Context, request *requests.SetContactLocalNickname) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.SetContactLocalNickname(request)
 }
@@ -789,6 +956,16 @@ func (api *PublicAPI) SendChatMessage(ctx context.Context, message *common.Messa
 	return api.service.messenger.SendChatMessage(ctx, message)
 }
 
+// OutboxMessages returns the messages resent automatically until they are confirmed
+func (api *PublicAPI) OutboxMessages() ([]*protocol.OutboxMessage, error) {
+	return api.service.messenger.OutboxMessages()
+}
+
+// CancelOutboxMessage stops resending a message
+func (api *PublicAPI) CancelOutboxMessage(id string) error {
+	return api.service.messenger.CancelOutboxMessage(id)
+}
+
 func (api *PublicAPI) ReSendChatMessage(ctx context.Context, messageID string) error {
 	return api.service.messenger.ReSendChatMessage(ctx, messageID)
 }
@@ -809,6 +986,32 @@ func (api *PublicAPI) EditMessage(ctx context.Context, request *requests.EditMes
 	return api.service.messenger.EditMessage(ctx, request)
 }
 
+func (api *PublicAPI) GetMessageEditHistory(messageID string) ([]*protocol.MessageEditRevision, error) {
+	return api.service.messenger.GetMessageEditHistory(messageID)
+}
+
+func (api *PublicAPI) SetEditHistoryLimit(limit uint64) error {
+	return api.service.messenger.SetEditHistoryLimit(limit)
+}
+
+// SetAttachmentAutoDownloadLimits sets the size in bytes up to which the
+// attachments are downloaded automatically on Wi-Fi and on cellular connections
+func (api *PublicAPI) SetAttachmentAutoDownloadLimits(wifi uint64, cellular uint64) error {
+	return api.service.messenger.SetAttachmentAutoDownloadLimits(wifi, cellular)
+}
+
+// SetVideoAutoDownload sets whether the videos are downloaded automatically on
+// Wi-Fi and on cellular connections
+func (api *PublicAPI) SetVideoAutoDownload(wifi bool, cellular bool) error {
+	return api.service.messenger.SetVideoAutoDownload(wifi, cellular)
+}
+
+// RequestAttachmentChunks downloads the attachment of a message, or resumes
+// its download
+func (api *PublicAPI) RequestAttachmentChunks(ctx context.Context, messageID string) error {
+	return api.service.messenger.RequestAttachmentChunks(ctx, messageID)
+}
+
 func (api *PublicAPI) DeleteMessageAndSend(ctx context.Context, messageID string) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.DeleteMessageAndSend(ctx, messageID)
 }
@@ -821,6 +1024,10 @@ func (api *PublicAPI) SendPinMessage(ctx context.Context, message *common.PinMes
 	return api.service.messenger.SendPinMessage(ctx, message)
 }
 
+func (api *PublicAPI) ReorderPinnedMessages(ctx context.Context, request *requests.ReorderPinnedMessages) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ReorderPinnedMessages(ctx, request)
+}
+
 func (api *PublicAPI) RequestTransaction(ctx context.Context, chatID, value, contract, address string) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.RequestTransaction(ctx, chatID, value, contract, address)
 }
@@ -905,6 +1112,22 @@ func (api *PublicAPI) DeclineContactVerificationRequest(ctx context.Context, id
 	return api.service.messenger.DeclineContactVerificationRequest(ctx, id)
 }
 
+// RevokeContactVerification unverifies a contact whose answer to the latest verification request was accepted
+func (api *PublicAPI) RevokeContactVerification(ctx context.Context, contactID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.RevokeContactVerification(ctx, contactID)
+}
+
+// ReRequestContactVerification sends a new verification request to a contact, with the challenge of the
+// latest request if challenge is empty
+func (api *PublicAPI) ReRequestContactVerification(ctx context.Context, contactID string, challenge string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ReRequestContactVerification(ctx, contactID, challenge)
+}
+
+// GetVerificationAuditTrail returns the status changes of the verification requests exchanged with a contact
+func (api *PublicAPI) GetVerificationAuditTrail(ctx context.Context, contactID string) ([]*verification.Transition, error) {
+	return api.service.messenger.GetVerificationAuditTrail(contactID)
+}
+
 func (api *PublicAPI) VerifiedTrusted(ctx context.Context, request *requests.VerifiedTrusted) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.VerifiedTrusted(ctx, request)
 }
@@ -971,6 +1194,16 @@ func (api *PublicAPI) DeleteSavedAddress(ctx context.Context, address ethcommon.
 	return api.service.messenger.DeleteSavedAddress(ctx, address, ens, isTest)
 }
 
+// AddCustomTokenByAddress adds the ERC20 at the address as a custom token of
+// the chain, its metadata is fetched on chain
+func (api *PublicAPI) AddCustomTokenByAddress(ctx context.Context, chainID uint64, address ethcommon.Address) (*token.DiscoveredToken, error) {
+	return api.service.messenger.AddCustomTokenByAddress(ctx, chainID, address)
+}
+
+func (api *PublicAPI) RemoveCustomToken(ctx context.Context, chainID uint64, address ethcommon.Address) error {
+	return api.service.messenger.RemoveCustomToken(ctx, chainID, address)
+}
+
 // PushNotifications server endpoints
 func (api *PublicAPI) StartPushNotificationsServer() error {
 	err := api.service.accountsDB.SaveSettingField(settings.PushNotificationsServerEnabled, true)
@@ -1049,6 +1282,22 @@ func (api *PublicAPI) DisablePushNotificationsBlockMentions(ctx context.Context)
 	return api.service.messenger.DisablePushNotificationsBlockMentions()
 }
 
+func (api *PublicAPI) EnablePushNotificationsCollapse(ctx context.Context) error {
+	err := api.service.accountsDB.SaveSettingField(settings.PushNotificationsCollapse, true)
+	if err != nil {
+		return err
+	}
+	return api.service.messenger.EnablePushNotificationsCollapse()
+}
+
+func (api *PublicAPI) DisablePushNotificationsCollapse(ctx context.Context) error {
+	err := api.service.accountsDB.SaveSettingField(settings.PushNotificationsCollapse, false)
+	if err != nil {
+		return err
+	}
+	return api.service.messenger.DisablePushNotificationsCollapse()
+}
+
 func (api *PublicAPI) AddPushNotificationsServer(ctx context.Context, publicKeyBytes types.HexBytes) error {
 	publicKey, err := crypto.UnmarshalPubkey(publicKeyBytes)
 	if err != nil {
@@ -1078,6 +1327,74 @@ func (api *PublicAPI) RegisteredForPushNotifications() (bool, error) {
 
 // Emoji
 
+func (api *PublicAPI) ProposeSafeTransaction(ctx context.Context, request *requests.ProposeSafeTransaction) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ProposeSafeTransaction(ctx, request)
+}
+
+func (api *PublicAPI) SignSafeTransaction(ctx context.Context, messageID string, address ethcommon.Address, password string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SignSafeTransaction(ctx, messageID, address, password)
+}
+
+func (api *PublicAPI) ExecuteSafeTransaction(ctx context.Context, messageID string, from ethcommon.Address, password string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ExecuteSafeTransaction(ctx, messageID, from, password)
+}
+
+func (api *PublicAPI) GetSafeTransactionStatus(messageID string) (*protocol.SafeTransactionStatus, error) {
+	return api.service.messenger.GetSafeTransactionStatus(messageID)
+}
+
+// Social recovery
+
+func (api *PublicAPI) SetupSocialRecovery(ctx context.Context, request *requests.SetupSocialRecovery) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SetupSocialRecovery(ctx, request)
+}
+
+func (api *PublicAPI) SocialRecoveryTrustees() ([]*socialrecovery.Trustee, error) {
+	return api.service.messenger.SocialRecoveryTrustees()
+}
+
+func (api *PublicAPI) SocialRecoveryShares() ([]*socialrecovery.Share, error) {
+	return api.service.messenger.SocialRecoveryShares()
+}
+
+func (api *PublicAPI) AcceptSocialRecoveryShare(ctx context.Context, ownerID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.AcceptSocialRecoveryShare(ctx, ownerID)
+}
+
+func (api *PublicAPI) DeclineSocialRecoveryShare(ctx context.Context, ownerID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.DeclineSocialRecoveryShare(ctx, ownerID)
+}
+
+func (api *PublicAPI) RequestSocialRecovery(ctx context.Context, request *requests.RequestSocialRecovery) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.RequestSocialRecovery(ctx, request)
+}
+
+func (api *PublicAPI) SocialRecoveryRequests() ([]*socialrecovery.Request, error) {
+	return api.service.messenger.SocialRecoveryRequests()
+}
+
+func (api *PublicAPI) ApproveSocialRecoveryRequest(ctx context.Context, requesterID string, ownerID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ApproveSocialRecoveryRequest(ctx, requesterID, ownerID)
+}
+
+func (api *PublicAPI) DeclineSocialRecoveryRequest(ctx context.Context, requesterID string, ownerID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.DeclineSocialRecoveryRequest(ctx, requesterID, ownerID)
+}
+
+func (api *PublicAPI) SocialRecoveryProgress(ownerID string) (*socialrecovery.Progress, error) {
+	return api.service.messenger.SocialRecoveryProgress(ownerID)
+}
+
+func (api *PublicAPI) RecoverSocialRecoveryPayload(ownerID string) (string, error) {
+	return api.service.messenger.RecoverSocialRecoveryPayload(ownerID)
+}
+
+// ResetSocialRecovery starts the recovery of the account of the owner over,
+// dropping the contacts asked and the shares they released
+func (api *PublicAPI) ResetSocialRecovery(ownerID string) error {
+	return api.service.messenger.ResetSocialRecovery(ownerID)
+}
+
 func (api *PublicAPI) SendEmojiReaction(ctx context.Context, chatID, messageID string, emojiID protobuf.EmojiReaction_Type) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.SendEmojiReaction(ctx, chatID, messageID, emojiID)
 }
@@ -1094,6 +1411,10 @@ func (api *PublicAPI) EmojiReactionsByChatIDMessageID(chatID string, messageID s
 	return api.service.messenger.EmojiReactionsByChatIDMessageID(chatID, messageID)
 }
 
+func (api *PublicAPI) GetMessageReactions(messageID string, cursor string) (*protocol.MessageReactions, error) {
+	return api.service.messenger.GetMessageReactions(messageID, cursor)
+}
+
 func (api *PublicAPI) GetLinkPreviewWhitelist() []urls.Site {
 	return urls.LinkPreviewWhitelist()
 }
@@ -1116,6 +1437,31 @@ func (api *PublicAPI) UnfurlURLs(urls []string) ([]common.LinkPreview, error) {
 	return api.service.messenger.UnfurlURLs(urls)
 }
 
+// UnfurlStatusLinks builds the previews of status.app links from the local
+// data for a message of the chat, links which can't be resolved are removed
+// from the response.
+func (api *PublicAPI) UnfurlStatusLinks(chatID string, urls []string) []common.StatusLinkPreview {
+	return api.service.messenger.UnfurlStatusLinks(chatID, urls)
+}
+
+func (api *PublicAPI) SetLinkPreviewsMode(mode settings.LinkPreviewsModeType) error {
+	return api.service.messenger.SetLinkPreviewsMode(mode)
+}
+
+func (api *PublicAPI) SetLinkPreviewsFilter(allowlist []string, denylist []string) error {
+	return api.service.messenger.SetLinkPreviewsFilter(allowlist, denylist)
+}
+
+// PrivacySettings returns the effective state of link previews, typing
+// indicators and read receipts once the global privacy mode is applied
+func (api *PublicAPI) PrivacySettings() (*protocol.PrivacySettings, error) {
+	return api.service.messenger.PrivacySettings()
+}
+
+func (api *PublicAPI) SetPrivacyMode(enabled bool) (*protocol.PrivacySettings, error) {
+	return api.service.messenger.SetPrivacyMode(enabled)
+}
+
 func (api *PublicAPI) EnsVerified(pk, ensName string) error {
 	return api.service.messenger.ENSVerified(pk, ensName)
 }
@@ -1173,6 +1519,73 @@ func (api *PublicAPI) DeleteActivityCenterNotifications(ctx context.Context, ids
 	return api.service.messenger.DeleteActivityCenterNotifications(ctx, ids, false)
 }
 
+// SaveNotificationRule creates a notification rule, or updates it when its ID
+// is set. Rules replace the mute flags of the chats they match
+func (api *PublicAPI) SaveNotificationRule(ctx context.Context, rule *protocol.NotificationRule) (*protocol.NotificationRule, error) {
+	return api.service.messenger.SaveNotificationRule(ctx, rule)
+}
+
+func (api *PublicAPI) NotificationRules() ([]*protocol.NotificationRule, error) {
+	return api.service.messenger.NotificationRules()
+}
+
+func (api *PublicAPI) DeleteNotificationRule(ctx context.Context, id string) error {
+	return api.service.messenger.DeleteNotificationRule(ctx, id)
+}
+
+// SetNotificationKeywords replaces the keywords producing activity center
+// notifications for the messages of the joined communities, even in muted chats
+func (api *PublicAPI) SetNotificationKeywords(keywords []string) ([]string, error) {
+	return api.service.messenger.SetNotificationKeywords(keywords)
+}
+
+func (api *PublicAPI) NotificationKeywords() ([]string, error) {
+	return api.service.messenger.NotificationKeywords()
+}
+
+// SaveOutgoingMessageFilter creates a filter checked against the messages
+// before they're sent, or updates it when its ID is set
+func (api *PublicAPI) SaveOutgoingMessageFilter(filter *protocol.OutgoingMessageFilter) (*protocol.OutgoingMessageFilter, error) {
+	return api.service.messenger.SaveOutgoingMessageFilter(filter)
+}
+
+func (api *PublicAPI) OutgoingMessageFilters() ([]*protocol.OutgoingMessageFilter, error) {
+	return api.service.messenger.OutgoingMessageFilters()
+}
+
+func (api *PublicAPI) DeleteOutgoingMessageFilter(id string) error {
+	return api.service.messenger.DeleteOutgoingMessageFilter(id)
+}
+
+// CheckOutgoingMessage returns the filters a message matches before sending it
+// in a chat. Messages matching a warning filter are sent only with
+// confirmedFilterWarnings set, those matching a blocking one aren't sent
+func (api *PublicAPI) CheckOutgoingMessage(chatID string, text string) ([]*protocol.OutgoingMessageFilterMatch, error) {
+	return api.service.messenger.CheckOutgoingMessage(chatID, text)
+}
+
+func (api *PublicAPI) SetOutgoingSecretsFilterEnabled(enabled bool) error {
+	return api.service.messenger.SetOutgoingSecretsFilterEnabled(enabled)
+}
+
+// SaveChatDraft stores the draft of a chat and syncs it to the paired
+// devices, an empty draft clears it
+func (api *PublicAPI) SaveChatDraft(ctx context.Context, draft *protocol.ChatDraft) (*protocol.ChatDraft, error) {
+	return api.service.messenger.SaveChatDraft(ctx, draft)
+}
+
+func (api *PublicAPI) ClearChatDraft(ctx context.Context, chatID string) error {
+	return api.service.messenger.ClearChatDraft(ctx, chatID)
+}
+
+func (api *PublicAPI) ChatDraft(chatID string) (*protocol.ChatDraft, error) {
+	return api.service.messenger.ChatDraft(chatID)
+}
+
+func (api *PublicAPI) ChatDrafts() ([]*protocol.ChatDraft, error) {
+	return api.service.messenger.ChatDrafts()
+}
+
 func (api *PublicAPI) RequestAllHistoricMessages(forceFetchingBackup bool) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.RequestAllHistoricMessages(forceFetchingBackup)
 }
@@ -1266,10 +1679,24 @@ func (api *PublicAPI) BackupData() (uint64, error) {
 	return api.service.messenger.BackupData(context.Background())
 }
 
+func (api *PublicAPI) GetBackupStatus() (*protocol.BackupStatus, error) {
+	return api.service.messenger.GetBackupStatus()
+}
+
+func (api *PublicAPI) SetBackupInterval(seconds uint64) error {
+	return api.service.messenger.SetBackupInterval(seconds)
+}
+
 func (api *PublicAPI) ImageServerURL() string {
 	return api.service.messenger.ImageServerURL()
 }
 
+// PrepareImageAttachment strips the metadata of an image and returns its
+// renditions along with its blurhash placeholder
+func (api *PublicAPI) PrepareImageAttachment(imagePath string) (*images.ImageAttachment, error) {
+	return images.PrepareImageAttachment(imagePath)
+}
+
 func (api *PublicAPI) ToggleUseMailservers(value bool) error {
 	return api.service.messenger.ToggleUseMailservers(value)
 }
@@ -1278,6 +1705,64 @@ func (api *PublicAPI) SetPinnedMailservers(pinnedMailservers map[string]string)
 	return api.service.messenger.SetPinnedMailservers(pinnedMailservers)
 }
 
+// GetLatencyTelemetry returns the histograms of the latencies of the messages sent and received
+func (api *PublicAPI) GetLatencyTelemetry() map[string]telemetry.LatencyHistogram {
+	return api.service.messenger.LatencyTelemetry()
+}
+
+// ToggleLatencyTelemetry enables recording the latencies of the messages sent and received
+func (api *PublicAPI) ToggleLatencyTelemetry(enabled bool) error {
+	return api.service.messenger.ToggleLatencyTelemetry(enabled)
+}
+
+// SetMessageRetentionPolicy sets how long the messages of a type of chat are kept, a max age of 0 keeps them forever
+func (api *PublicAPI) SetMessageRetentionPolicy(policy protocol.MessageRetentionPolicy) error {
+	return api.service.messenger.SetMessageRetentionPolicy(policy)
+}
+
+// GetMessageRetentionPolicies returns how long the messages of each type of chat are kept
+func (api *PublicAPI) GetMessageRetentionPolicies() ([]*protocol.MessageRetentionPolicy, error) {
+	return api.service.messenger.MessageRetentionPolicies()
+}
+
+// PruneMessages deletes the messages older than the retention policies without waiting for the next scheduled run
+func (api *PublicAPI) PruneMessages() (*protocol.MessageRetentionResult, error) {
+	return api.service.messenger.PruneMessages()
+}
+
+// GetBandwidthStats returns the hourly bytes exchanged by each waku protocol since the given unix timestamp
+func (api *PublicAPI) GetBandwidthStats(since int64) ([]protocol.BandwidthStats, error) {
+	return api.service.messenger.GetBandwidthStats(since)
+}
+
+// MailserverScores returns the mailservers of the current fleet with their score, best first
+func (api *PublicAPI) MailserverScores() ([]protocol.MailserverScore, error) {
+	return api.service.messenger.MailserverScores()
+}
+
+// NodeHealth consolidates the state of the subsystems of the node
+type NodeHealth struct {
+	Messenger    *protocol.MessengerHealth `json:"messenger"`
+	RPCProviders []*rpc.ChainStatus        `json:"rpcProviders"`
+}
+
+// GetNodeHealth returns the state of the messaging subsystem and of the RPC providers
+func (api *PublicAPI) GetNodeHealth() (*NodeHealth, error) {
+	messengerHealth, err := api.service.messenger.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	health := &NodeHealth{
+		Messenger:    messengerHealth,
+		RPCProviders: []*rpc.ChainStatus{},
+	}
+	if api.service.rpcClient != nil {
+		health.RPCProviders = api.service.rpcClient.GetProvidersStatus()
+	}
+	return health, nil
+}
+
 func (api *PublicAPI) RequestExtractDiscordChannelsAndCategories(filesToImport []string) {
 	api.service.messenger.RequestExtractDiscordChannelsAndCategories(filesToImport)
 }
@@ -1306,6 +1791,12 @@ func (api *PublicAPI) GetAllCommunityTokens() ([]*communities.CommunityToken, er
 	return api.service.messenger.GetAllCommunityTokens()
 }
 
+// GetCommunityTreasury returns the balances held by the deployers and token
+// contracts of a community
+func (api *PublicAPI) GetCommunityTreasury(ctx context.Context, communityID types.HexBytes) (*wallet.Treasury, error) {
+	return api.service.messenger.GetCommunityTreasury(ctx, communityID)
+}
+
 func (api *PublicAPI) AddCommunityToken(token *communities.CommunityToken, croppedImage *images.CroppedImage) (*communities.CommunityToken, error) {
 	return api.service.messenger.AddCommunityToken(token, croppedImage)
 }
@@ -1330,6 +1821,16 @@ func (api *PublicAPI) CheckPermissionsToJoinCommunity(request *requests.CheckPer
 	return api.service.messenger.CheckPermissionsToJoinCommunity(request)
 }
 
+func (api *PublicAPI) ReevaluateCommunityMembersPermissions(request *requests.ReevaluateCommunityMembersPermissions) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ReevaluateCommunityMembersPermissions(request)
+}
+
+// VerifyCommunitySoulboundTokens checks on chain that the members of a
+// community still hold its non-transferable tokens
+func (api *PublicAPI) VerifyCommunitySoulboundTokens(communityID types.HexBytes) ([]*communities.SoulboundMemberVerification, error) {
+	return api.service.messenger.VerifyCommunitySoulboundTokens(communityID)
+}
+
 func (api *PublicAPI) CheckCommunityChannelPermissions(request *requests.CheckCommunityChannelPermissions) (*communities.CheckChannelPermissionsResponse, error) {
 	return api.service.messenger.CheckCommunityChannelPermissions(request)
 }
@@ -1366,6 +1867,32 @@ func (api *PublicAPI) ShareUserURLWithData(pubKey string) (string, error) {
 	return api.service.messenger.ShareUserURLWithData(pubKey)
 }
 
+// CreateCommunityAirdropSnapshot records the members of a community eligible
+// to an airdrop, the snapshot ID can then be minted to
+func (api *PublicAPI) CreateCommunityAirdropSnapshot(request *requests.CreateCommunityAirdropSnapshot) (*communities.AirdropSnapshot, error) {
+	return api.service.messenger.CreateCommunityAirdropSnapshot(request)
+}
+
+func (api *PublicAPI) CommunityAirdropSnapshot(id string) (*communities.AirdropSnapshot, error) {
+	return api.service.messenger.CommunityAirdropSnapshot(id)
+}
+
+func (api *PublicAPI) CommunityAirdropSnapshots(communityID types.HexBytes) ([]*communities.AirdropSnapshot, error) {
+	return api.service.messenger.CommunityAirdropSnapshots(communityID)
+}
+
+func (api *PublicAPI) DeleteCommunityAirdropSnapshot(id string) error {
+	return api.service.messenger.DeleteCommunityAirdropSnapshot(id)
+}
+
+func (api *PublicAPI) ShareCommunityInviteURL(request *requests.CommunityInviteURL) (string, error) {
+	return api.service.messenger.ShareCommunityInviteURL(request)
+}
+
+func (api *PublicAPI) ShareMessageURL(messageID string) (string, error) {
+	return api.service.messenger.ShareMessageURL(messageID)
+}
+
 func (api *PublicAPI) ParseSharedURL(url string) (*protocol.URLDataResponse, error) {
 	return api.service.messenger.ParseSharedURL(url)
 }
@@ -1409,10 +1936,42 @@ func (api *PublicAPI) ChatMentionToInputField(chatID, text string) (*protocol.Ch
 	return api.service.messenger.GetMentionsManager().ToInputField(chatID, text)
 }
 
+// ChatMentionCandidates returns the users who can be mentioned in a chat and match the searched text,
+// ranked by their last message in the chat, their role and their name. A negative limit returns all of them
+func (api *PublicAPI) ChatMentionCandidates(chatID, searchedText string, limit int) ([]*protocol.MentionCandidate, error) {
+	return api.service.messenger.GetMentionsManager().MentionCandidates(chatID, searchedText, limit)
+}
+
 func (api *PublicAPI) GetCheckChannelPermissionResponses(parent context.Context, communityID types.HexBytes) (*communities.CheckAllChannelsPermissionsResponse, error) {
 	return api.service.messenger.GetCommunityCheckChannelPermissionResponses(communityID)
 }
 
+// GetDBStats returns the duration of the queries run on the database, the
+// slowest statements first, and the latest slow queries
+func (api *PublicAPI) GetDBStats() *sqlite.DBStats {
+	return api.service.messenger.DBStats()
+}
+
+// SetDBSlowQueryThreshold sets the duration, in milliseconds, above which a
+// query is recorded as slow
+func (api *PublicAPI) SetDBSlowQueryThreshold(milliseconds uint) {
+	sqlite.SetSlowQueryThreshold(time.Duration(milliseconds) * time.Millisecond)
+}
+
+// EnableDBSlowQueryLogging logs the slow queries as they're recorded
+func (api *PublicAPI) EnableDBSlowQueryLogging(enabled bool) {
+	if enabled {
+		sqlite.SetQueryLogger(logutils.ZapLogger())
+	} else {
+		sqlite.SetQueryLogger(nil)
+	}
+}
+
+// ResetDBStats clears the stats of the queries
+func (api *PublicAPI) ResetDBStats() {
+	sqlite.ResetStats()
+}
+
 // -----
 // HELPER
 // -----
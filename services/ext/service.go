@@ -76,6 +76,8 @@ type Service struct {
 	accountsDB      *accounts.Database
 	multiAccountsDB *multiaccounts.Database
 	account         *multiaccounts.Account
+	// onMessengerStarted is called once the messenger is started
+	onMessengerStarted func()
 }
 
 // Make sure that Service implements node.Service interface.
@@ -190,6 +192,10 @@ func (s *Service) StartMessenger() (*protocol.MessengerResponse, error) {
 		go s.retrieveStats(5*time.Second, s.cancelMessenger)
 	}
 
+	if s.onMessengerStarted != nil {
+		s.onMessengerStarted()
+	}
+
 	return response, nil
 }
 
@@ -367,6 +373,11 @@ func (s *Service) SetP2PServer(server *p2p.Server) {
 	s.server = server
 }
 
+// SetOnMessengerStarted sets the function called once the messenger is started
+func (s *Service) SetOnMessengerStarted(f func()) {
+	s.onMessengerStarted = f
+}
+
 // Start is run when a service is started.
 // It does nothing in this case but is required by `node.Service` interface.
 func (s *Service) Start() error {
@@ -492,6 +503,7 @@ func buildMessengerOptions(
 	options = append(options, protocol.WithPushNotificationClientConfig(&pushnotificationclient.Config{
 		DefaultServers:             pushNotifServKey,
 		BlockMentions:              settings.PushNotificationsBlockMentions,
+		CollapseNotifications:      settings.PushNotificationsCollapse,
 		SendEnabled:                settings.SendPushNotifications,
 		AllowFromContactsOnly:      settings.PushNotificationsFromContactsOnly,
 		RemoteNotificationsEnabled: settings.RemotePushNotificationsEnabled,
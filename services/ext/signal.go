@@ -5,6 +5,7 @@ import (
 	"github.com/status-im/status-go/protocol"
 	"github.com/status-im/status-go/protocol/communities"
 	"github.com/status-im/status-go/protocol/discord"
+	"github.com/status-im/status-go/protocol/verification"
 	"github.com/status-im/status-go/protocol/wakusync"
 	"github.com/status-im/status-go/signal"
 )
@@ -64,6 +65,11 @@ func (m MessengerSignalsHandler) BackupPerformed(lastBackup uint64) {
 	signal.SendBackupPerformed(lastBackup)
 }
 
+// BackupFailed passes information that a scheduled backup has failed
+func (m MessengerSignalsHandler) BackupFailed(err error) {
+	signal.SendBackupFailed(err)
+}
+
 // MessageDelivered passes info about community that was requested before
 func (m MessengerSignalsHandler) CommunityInfoFound(community *communities.Community) {
 	signal.SendCommunityInfoFound(community)
@@ -121,6 +127,10 @@ func (m *MessengerSignalsHandler) ImportingHistoryArchiveMessages(communityID st
 	signal.SendImportingHistoryArchiveMessages(communityID)
 }
 
+func (m *MessengerSignalsHandler) CommunityChannelMembersRemoved(communityID string, members map[string][]string) {
+	signal.SendCommunityChannelMembersRemoved(communityID, members)
+}
+
 func (m *MessengerSignalsHandler) DownloadingHistoryArchivesFinished(communityID string) {
 	signal.SendDownloadingHistoryArchivesFinished(communityID)
 }
@@ -164,3 +174,11 @@ func (m *MessengerSignalsHandler) SendWakuBackedUpKeypair(response *wakusync.Wak
 func (m *MessengerSignalsHandler) SendWakuBackedUpWatchOnlyAccount(response *wakusync.WakuBackedUpDataResponse) {
 	signal.SendWakuBackedUpWatchOnlyAccount(response)
 }
+
+func (m *MessengerSignalsHandler) MessagesPruned(result *protocol.MessageRetentionResult) {
+	signal.SendMessagesPruned(result.Messages, result.RawMessages, result.ActivityCenterNotifications, result.ReclaimedBytes)
+}
+
+func (m *MessengerSignalsHandler) ContactVerificationStateChanged(transition *verification.Transition) {
+	signal.SendContactVerificationStateChanged(transition)
+}
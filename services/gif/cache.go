@@ -0,0 +1,49 @@
+package gif
+
+import (
+	"database/sql"
+	"time"
+)
+
+// cacheRetention is how long the responses are kept once fetched, the stale
+// ones are only served when the provider can't be reached or in privacy mode
+const cacheRetention = 7 * 24 * time.Hour
+
+// cache keeps the responses of the provider by path, so that repeated
+// searches don't hit it
+type cache struct {
+	db *sql.DB
+}
+
+func newCache(db *sql.DB) *cache {
+	return &cache{db: db}
+}
+
+// get returns the response cached for the path and when it was fetched
+func (c *cache) get(path string) (response string, fetchedAt time.Time, found bool, err error) {
+	var fetchedAtUnix int64
+	err = c.db.QueryRow(`SELECT response, fetched_at FROM gif_search_cache WHERE path = ?`, path).Scan(&response, &fetchedAtUnix)
+	if err == sql.ErrNoRows {
+		return "", time.Time{}, false, nil
+	}
+	if err != nil {
+		return "", time.Time{}, false, err
+	}
+	return response, time.Unix(fetchedAtUnix, 0), true, nil
+}
+
+// put caches the response of the path, and drops the responses past the
+// retention
+func (c *cache) put(path string, response string, fetchedAt time.Time) error {
+	_, err := c.db.Exec(`INSERT INTO gif_search_cache(path, response, fetched_at) VALUES(?, ?, ?)`, path, response, fetchedAt.Unix())
+	if err != nil {
+		return err
+	}
+	_, err = c.db.Exec(`DELETE FROM gif_search_cache WHERE fetched_at < ?`, fetchedAt.Add(-cacheRetention).Unix())
+	return err
+}
+
+func (c *cache) clear() error {
+	_, err := c.db.Exec(`DELETE FROM gif_search_cache`)
+	return err
+}
@@ -2,9 +2,12 @@ package gif
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -31,13 +34,27 @@ var defaultParams = "&media_filter=minimal&limit=50&key="
 const maxRetry = 3
 const baseURL = "https://g.tenor.com/v1/"
 
+// How long the responses of the provider are served from the cache before
+// being fetched again
+const (
+	trendingCacheTTL = time.Hour
+	searchCacheTTL   = 24 * time.Hour
+)
+
+// The endpoints of the provider which can be fetched
+var allowedPaths = []string{"trending?", "search?", "categories?", "autocomplete?", "trending_terms?"}
+
+var ErrInvalidGifPath = errors.New("invalid gif path")
+var ErrGifsDisabledByPrivacyMode = errors.New("gifs aren't fetched in privacy mode")
+
 func NewGifAPI(db *accounts.Database) *API {
-	return &API{db}
+	return &API{db: db, cache: newCache(db.DB())}
 }
 
 // API is class with methods available over RPC.
 type API struct {
-	db *accounts.Database
+	db    *accounts.Database
+	cache *cache
 }
 
 func (api *API) SetTenorAPIKey(key string) (err error) {
@@ -92,9 +109,180 @@ func (api *API) GetContentWithRetry(path string) (value string, err error) {
 	return string(data), nil
 }
 
+// FetchGifs returns the response of the provider for the path, from the cache
+// when it was fetched recently. The API key is added to the request and
+// can't be set by the client
 func (api *API) FetchGifs(path string) (value string, err error) {
 	log.Info("[GifAPI::fetchGifs]")
-	return api.GetContentWithRetry(path)
+	if !validPath(path) {
+		return "", ErrInvalidGifPath
+	}
+	return api.fetchCached(path, cacheTTL(path))
+}
+
+// SearchGifs returns the gifs matching the query
+func (api *API) SearchGifs(query string) (gifs []Gif, err error) {
+	log.Info("[GifAPI::searchGifs]")
+	path := "search?q=" + url.QueryEscape(query)
+	return api.fetchGifs(path, searchCacheTTL)
+}
+
+func (api *API) TrendingGifs() (gifs []Gif, err error) {
+	log.Info("[GifAPI::trendingGifs]")
+	return api.fetchGifs("trending?", trendingCacheTTL)
+}
+
+// ClearGifsCache drops the responses of the provider cached so far
+func (api *API) ClearGifsCache() error {
+	log.Info("[GifAPI::clearGifsCache]")
+	return api.cache.clear()
+}
+
+func (api *API) fetchGifs(path string, ttl time.Duration) ([]Gif, error) {
+	data, err := api.fetchCached(path, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	gifs, err := parseTenorGifs(data)
+	if err != nil {
+		return nil, err
+	}
+
+	favorites, err := api.GetFavoriteGifs()
+	if err != nil {
+		return nil, err
+	}
+	favoriteIDs := make(map[string]bool, len(favorites))
+	for _, favorite := range favorites {
+		favoriteIDs[favorite.ID] = true
+	}
+	for i := range gifs {
+		gifs[i].IsFavorite = favoriteIDs[gifs[i].ID]
+	}
+	return gifs, nil
+}
+
+// fetchCached returns the cached response of the path unless it's older than
+// the TTL. In privacy mode the provider is never reached, and stale responses
+// are served when it can't be reached
+func (api *API) fetchCached(path string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	cached, fetchedAt, found, err := api.cache.get(path)
+	if err != nil {
+		return "", err
+	}
+	if found && now.Sub(fetchedAt) < ttl {
+		return cached, nil
+	}
+
+	privacyMode, err := api.db.PrivacyMode()
+	if err != nil {
+		return "", err
+	}
+	if privacyMode {
+		if found {
+			return cached, nil
+		}
+		return "", ErrGifsDisabledByPrivacyMode
+	}
+
+	err = api.loadTenorAPIKey()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := api.GetContentWithRetry(path)
+	if err != nil {
+		if found {
+			log.Warn("serving stale gifs", "path", path, "error", err)
+			return cached, nil
+		}
+		return "", err
+	}
+
+	err = api.cache.put(path, data, now)
+	if err != nil {
+		return "", err
+	}
+	return data, nil
+}
+
+// loadTenorAPIKey reads the API key from the settings when it wasn't set
+// since the start
+func (api *API) loadTenorAPIKey() error {
+	if tenorAPIKey != "" {
+		return nil
+	}
+	key, err := api.db.GifAPIKey()
+	if err != nil {
+		return err
+	}
+	tenorAPIKey = key
+	return nil
+}
+
+func validPath(path string) bool {
+	if strings.Contains(path, "key=") {
+		return false
+	}
+	for _, allowed := range allowedPaths {
+		if strings.HasPrefix(path, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+func cacheTTL(path string) time.Duration {
+	if strings.HasPrefix(path, "trending") {
+		return trendingCacheTTL
+	}
+	return searchCacheTTL
+}
+
+type tenorMedia struct {
+	URL  string `json:"url"`
+	Dims []int  `json:"dims"`
+}
+
+type tenorResponse struct {
+	Results []struct {
+		ID    string                  `json:"id"`
+		Title string                  `json:"title"`
+		Media []map[string]tenorMedia `json:"media"`
+	} `json:"results"`
+}
+
+// parseTenorGifs returns the gifs of a response of the provider, the ones
+// without media are skipped
+func parseTenorGifs(data string) ([]Gif, error) {
+	var response tenorResponse
+	err := json.Unmarshal([]byte(data), &response)
+	if err != nil {
+		return nil, err
+	}
+
+	gifs := make([]Gif, 0, len(response.Results))
+	for _, result := range response.Results {
+		if len(result.Media) == 0 {
+			continue
+		}
+		gif := Gif{
+			ID:      result.ID,
+			Title:   result.Title,
+			URL:     result.Media[0]["gif"].URL,
+			TinyURL: result.Media[0]["tinygif"].URL,
+		}
+		if dims := result.Media[0]["tinygif"].Dims; len(dims) == 2 {
+			gif.Height = dims[1]
+		}
+		if gif.URL == "" {
+			continue
+		}
+		gifs = append(gifs, gif)
+	}
+	return gifs, nil
 }
 
 func (api *API) UpdateRecentGifs(updatedGifs json.RawMessage) (err error) {
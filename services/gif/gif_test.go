@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -140,3 +141,43 @@ func TestRecentGifs(t *testing.T) {
 
 	require.NoError(t, gifAPI.UpdateRecentGifs(source))
 }
+
+func TestFetchGifsFromCache(t *testing.T) {
+	appDB, appStop := setupSQLTestDb(t)
+	defer appStop()
+
+	db, stop := setupTestDB(t, appDB)
+	defer stop()
+
+	gifAPI := NewGifAPI(db)
+
+	_, err := gifAPI.FetchGifs("search?q=cat&key=other")
+	require.Equal(t, ErrInvalidGifPath, err)
+	_, err = gifAPI.FetchGifs("../v2/search?q=cat")
+	require.Equal(t, ErrInvalidGifPath, err)
+
+	// A response fetched recently is served without reaching the provider
+	require.NoError(t, gifAPI.cache.put("search?q=cat", tenorTestResponse, time.Now()))
+	gifs, err := gifAPI.FetchGifs("search?q=cat")
+	require.NoError(t, err)
+	require.Equal(t, tenorTestResponse, gifs)
+
+	require.NoError(t, gifAPI.ClearGifsCache())
+	_, _, found, err := gifAPI.cache.get("search?q=cat")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestParseTenorGifs(t *testing.T) {
+	gifs, err := parseTenorGifs(tenorTestResponse)
+	require.NoError(t, err)
+	require.Equal(t, []Gif{{
+		ID:      "23833142",
+		Title:   "cat",
+		URL:     "https://media.tenor.com/images/b845ae14f43883e5cd6283e705f09efb/tenor.gif",
+		TinyURL: "https://media.tenor.com/images/2067bdc0375f9606dfb9fb4d2bfaafde/tenor.gif",
+		Height:  166,
+	}}, gifs)
+}
+
+const tenorTestResponse = `{"results":[{"id":"23833142","title":"cat","media":[{"gif":{"url":"https://media.tenor.com/images/b845ae14f43883e5cd6283e705f09efb/tenor.gif","dims":[498,498]},"tinygif":{"url":"https://media.tenor.com/images/2067bdc0375f9606dfb9fb4d2bfaafde/tenor.gif","dims":[166,166]}}]},{"id":"1","title":"no media","media":[]}],"next":"20"}`
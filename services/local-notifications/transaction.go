@@ -13,6 +13,7 @@ import (
 	"github.com/status-im/status-go/multiaccounts/accounts"
 	"github.com/status-im/status-go/services/wallet/transfer"
 	"github.com/status-im/status-go/services/wallet/walletevent"
+	"github.com/status-im/status-go/services/wallet/watchonly"
 )
 
 type transactionState string
@@ -128,6 +129,61 @@ func (s *Service) transactionsHandler(payload TransactionEvent) {
 	}
 }
 
+func (s *Service) buildWatchOnlyNotification(activity *watchonly.Activity) *Notification {
+	state := inbound
+	from, to := activity.Account, activity.Account
+	if activity.Direction == watchonly.DirectionOutgoing {
+		state = outbound
+		if activity.Counterparty != nil {
+			to = *activity.Counterparty
+		}
+	} else if activity.Counterparty != nil {
+		from = *activity.Counterparty
+	}
+
+	account, err := s.accountsDB.GetAccountByAddress(types.Address(activity.Account))
+	if err != nil {
+		log.Debug("Could not select watch-only account by address", "error", err)
+	}
+
+	body := transactionBody{
+		State:   state,
+		From:    from,
+		To:      to,
+		Value:   activity.Amount,
+		ERC20:   activity.TokenAddress != nil && activity.TokenID == nil,
+		Network: activity.ChainID,
+	}
+	if state == inbound {
+		body.ToAccount = account
+	} else {
+		body.FromAccount = account
+	}
+	if activity.TokenAddress != nil {
+		body.Contract = *activity.TokenAddress
+	}
+
+	return &Notification{
+		BodyType: TypeTransaction,
+		ID:       activity.ID(),
+		Body:     body,
+		Deeplink: walletDeeplinkPrefix + activity.Account.String(),
+		Category: CategoryTransaction,
+	}
+}
+
+func (s *Service) watchOnlyActivityHandler(event walletevent.Event) {
+	activities, err := watchonly.ParseActivities(event)
+	if err != nil {
+		log.Error("Could not parse watch-only activity", "error", err)
+		return
+	}
+
+	for _, activity := range activities {
+		pushMessage(s.buildWatchOnlyNotification(activity))
+	}
+}
+
 // SubscribeWallet - Subscribes to wallet signals
 func (s *Service) SubscribeWallet(publisher *event.Feed) error {
 	s.walletTransmitter.publisher = publisher
@@ -199,6 +255,8 @@ func (s *Service) StartWalletWatcher() {
 							MaxKnownBlocks: maxKnownBlocks,
 						})
 					}
+				} else if event.Type == watchonly.EventWatchOnlyActivity && s.WatchingEnabled {
+					s.watchOnlyActivityHandler(event)
 				} else if event.Type == transfer.EventRecentHistoryReady {
 					for _, address := range event.Accounts {
 						if _, ok := maxKnownBlocks[address]; !ok {
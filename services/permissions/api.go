@@ -28,3 +28,14 @@ func (api *API) DeleteDappPermissions(ctx context.Context, name string) error {
 func (api *API) DeleteDappPermissionsByNameAndAddress(ctx context.Context, name string, address string) error {
 	return api.db.DeletePermission(name, address)
 }
+
+// ListDappPermissions returns the permissions of the dapps, or of the dapp if
+// name isn't empty, ordered by dapp name. Expired grants aren't returned.
+func (api *API) ListDappPermissions(ctx context.Context, name string) ([]DappPermissions, error) {
+	return api.db.ListPermissions(name)
+}
+
+// RevokeAll revokes the permissions of every dapp.
+func (api *API) RevokeAll(ctx context.Context) error {
+	return api.db.DeleteAllPermissions()
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -100,3 +101,58 @@ func TestDappPermissionsDeleted(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, rst, 0)
 }
+
+func TestDappPermissionGrants(t *testing.T) {
+	api, cancel := setupTestAPI(t)
+	defer cancel()
+
+	now := time.Now().Unix()
+	require.NoError(t, api.AddDappPermissions(context.TODO(), DappPermissions{
+		Name:        "second",
+		Address:     "0x1",
+		Permissions: []string{"contact-code"},
+		Grants: []Grant{
+			{Permission: "web3", ChainID: 1, ExpiresAt: now + 86400},
+			{Permission: "expired", ExpiresAt: now - 1},
+		},
+	}))
+	require.NoError(t, api.AddDappPermissions(context.TODO(), DappPermissions{Name: "first", Permissions: []string{"web3"}}))
+
+	hasPermission, err := api.db.HasChainPermission("second", "0x1", "web3", 1)
+	require.NoError(t, err)
+	require.True(t, hasPermission)
+	hasPermission, err = api.db.HasChainPermission("second", "0x1", "web3", 10)
+	require.NoError(t, err)
+	require.False(t, hasPermission)
+	hasPermission, err = api.db.HasPermission("second", "0x1", "expired")
+	require.NoError(t, err)
+	require.False(t, hasPermission)
+	hasPermission, err = api.db.HasChainPermission("first", "", "web3", 10)
+	require.NoError(t, err)
+	require.True(t, hasPermission)
+	// 0 isn't a wildcard, a grant on a single chain doesn't allow other chains
+	hasPermission, err = api.db.HasChainPermission("second", "0x1", "web3", 0)
+	require.NoError(t, err)
+	require.False(t, hasPermission)
+
+	rst, err := api.ListDappPermissions(context.TODO(), "")
+	require.NoError(t, err)
+	require.Len(t, rst, 2)
+	require.Equal(t, "first", rst[0].Name)
+	require.Equal(t, []string{"contact-code"}, rst[1].Permissions)
+	require.Equal(t, []Grant{{Permission: "web3", ChainID: 1, ExpiresAt: now + 86400}}, rst[1].Grants)
+
+	rst, err = api.ListDappPermissions(context.TODO(), "second")
+	require.NoError(t, err)
+	require.Len(t, rst, 1)
+
+	require.NoError(t, api.db.DeleteExpiredPermissions())
+	hasPermission, err = api.db.HasChainPermission("second", "0x1", "web3", 1)
+	require.NoError(t, err)
+	require.True(t, hasPermission)
+
+	require.NoError(t, api.RevokeAll(context.TODO()))
+	rst, err = api.ListDappPermissions(context.TODO(), "")
+	require.NoError(t, err)
+	require.Len(t, rst, 0)
+}
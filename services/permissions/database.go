@@ -2,6 +2,8 @@ package permissions
 
 import (
 	"database/sql"
+	"sort"
+	"time"
 )
 
 // Database sql wrapper for operations with browser objects.
@@ -18,11 +20,28 @@ func NewDB(db *sql.DB) *Database {
 	return &Database{db: db}
 }
 
+// Grant is a permission which can be restricted to a chain and can expire.
+type Grant struct {
+	Permission string `json:"permission"`
+	// ChainID is the chain on which the account is exposed, 0 for every chain
+	ChainID uint64 `json:"chainId,omitempty"`
+	// ExpiresAt is the unix time at which the permission is revoked, 0 if it
+	// never expires
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+}
+
+func (g Grant) expired(now int64) bool {
+	return g.ExpiresAt != 0 && g.ExpiresAt <= now
+}
+
 type DappPermissions struct {
 	ID          int
 	Name        string   `json:"dapp"`
 	Permissions []string `json:"permissions,omitempty"`
 	Address     string   `json:"address,omitempty"`
+	// Grants are the permissions restricted to a chain or expiring. Permissions
+	// are granted on every chain without expiry
+	Grants []Grant `json:"grants,omitempty"`
 }
 
 func (db *Database) AddPermissions(perms DappPermissions) (err error) {
@@ -76,17 +95,23 @@ func (db *Database) AddPermissions(perms DappPermissions) (err error) {
 		return
 	}
 
-	if len(perms.Permissions) == 0 {
+	if len(perms.Permissions) == 0 && len(perms.Grants) == 0 {
 		return
 	}
 
-	pInsert, err := tx.Prepare("INSERT INTO permissions(dapp_id, permission) VALUES(?, ?)")
+	pInsert, err := tx.Prepare("INSERT INTO permissions(dapp_id, permission, chain_id, expires_at) VALUES(?, ?, ?, ?)")
 	if err != nil {
 		return
 	}
 	defer pInsert.Close()
 	for _, perm := range perms.Permissions {
-		_, err = pInsert.Exec(id, perm)
+		_, err = pInsert.Exec(id, perm, 0, 0)
+		if err != nil {
+			return
+		}
+	}
+	for _, grant := range perms.Grants {
+		_, err = pInsert.Exec(id, grant.Permission, grant.ChainID, grant.ExpiresAt)
 		if err != nil {
 			return
 		}
@@ -123,21 +148,29 @@ func (db *Database) GetPermissions() (rst []DappPermissions, err error) {
 		dapps[perms.ID] = &perms
 	}
 
-	pRows, err := tx.Query("SELECT dapp_id, permission from permissions")
+	pRows, err := tx.Query("SELECT dapp_id, permission, chain_id, expires_at from permissions")
 	if err != nil {
 		return
 	}
 	defer pRows.Close()
 	var (
-		id         int
-		permission string
+		id    int
+		grant Grant
 	)
+	now := time.Now().Unix()
 	for pRows.Next() {
-		err = pRows.Scan(&id, &permission)
+		err = pRows.Scan(&id, &grant.Permission, &grant.ChainID, &grant.ExpiresAt)
 		if err != nil {
 			return
 		}
-		dapps[id].Permissions = append(dapps[id].Permissions, permission)
+		if grant.expired(now) {
+			continue
+		}
+		if grant.ChainID == 0 && grant.ExpiresAt == 0 {
+			dapps[id].Permissions = append(dapps[id].Permissions, grant.Permission)
+		} else {
+			dapps[id].Grants = append(dapps[id].Grants, grant)
+		}
 	}
 	rst = make([]DappPermissions, 0, len(dapps))
 	for key := range dapps {
@@ -152,17 +185,67 @@ func (db *Database) DeletePermission(name string, address string) error {
 	return err
 }
 
+// ListPermissions returns the permissions of the dapps, or of the dapp if name
+// isn't empty, ordered by dapp name and address.
+func (db *Database) ListPermissions(name string) ([]DappPermissions, error) {
+	all, err := db.GetPermissions()
+	if err != nil {
+		return nil, err
+	}
+
+	rst := make([]DappPermissions, 0, len(all))
+	for _, perms := range all {
+		if name == "" || perms.Name == name {
+			rst = append(rst, perms)
+		}
+	}
+	sort.Slice(rst, func(i, j int) bool {
+		if rst[i].Name != rst[j].Name {
+			return rst[i].Name < rst[j].Name
+		}
+		return rst[i].Address < rst[j].Address
+	})
+	return rst, nil
+}
+
+// DeleteAllPermissions revokes the permissions of every dapp.
+func (db *Database) DeleteAllPermissions() error {
+	_, err := db.db.Exec("DELETE FROM dapps")
+	return err
+}
+
+// DeleteExpiredPermissions removes the expired grants.
+func (db *Database) DeleteExpiredPermissions() error {
+	_, err := db.db.Exec("DELETE FROM permissions WHERE expires_at != 0 AND expires_at <= ?", time.Now().Unix())
+	return err
+}
+
+// HasPermission checks that the permission is granted and not expired,
+// whatever the chain it was granted on. It's for permissions which aren't
+// about a chain, others must be checked with HasChainPermission.
 func (db *Database) HasPermission(dappName string, address string, permission string) (bool, error) {
+	return db.hasPermission(dappName, address, permission, "", nil)
+}
+
+// HasChainPermission checks that the permission is granted and not expired on
+// the chain, or granted on every chain.
+func (db *Database) HasChainPermission(dappName string, address string, permission string, chainID uint64) (bool, error) {
+	return db.hasPermission(dappName, address, permission, "AND (chain_id = 0 OR chain_id = ?)", []interface{}{chainID})
+}
+
+func (db *Database) hasPermission(dappName string, address string, permission string, chainCond string, chainArgs []interface{}) (bool, error) {
 	var id int64
 	err := db.db.QueryRow("SELECT id FROM dapps where name = ? AND address = ?", dappName, address).Scan(&id)
 	if err != nil {
 		return false, nil
 	}
 
+	args := append([]interface{}{id, permission}, chainArgs...)
+	args = append(args, time.Now().Unix())
 	var count uint64
 	err = db.db.QueryRow(
-		`SELECT COUNT(1) FROM permissions WHERE dapp_id = ? AND permission = ?`,
-		id, permission,
+		`SELECT COUNT(1) FROM permissions WHERE dapp_id = ? AND permission = ? `+chainCond+` AND (expires_at = 0 OR expires_at > ?)`, // nolint: gosec
+		args...,
 	).Scan(&count)
 	return count > 0, err
 }
@@ -14,9 +14,9 @@ type Service struct {
 	db *Database
 }
 
-// Start a service.
+// Start a service, removing the expired permissions.
 func (s *Service) Start() error {
-	return nil
+	return s.db.DeleteExpiredPermissions()
 }
 
 // Stop a service.
@@ -19,6 +19,9 @@ var (
 	// ErrInvalidPersonalSignAccount is returned when the account passed to
 	// personal_sign isn't equal to the currently selected account.
 	ErrInvalidPersonalSignAccount = errors.New("invalid account as only the selected one can generate a signature")
+	// ErrInvalidPersonalSignData is returned when the data passed to
+	// personal_sign isn't hex encoded
+	ErrInvalidPersonalSignData = errors.New("invalid data, it must be hex encoded")
 )
 
 // SignParams required to sign messages
@@ -76,6 +79,10 @@ func (api *PublicAPI) Sign(rpcParams SignParams, verifiedAccount *account.Select
 		return
 	}
 
+	if verifiedAccount.Hardware != nil {
+		return signWithHardware(rpcParams.Data, verifiedAccount.Hardware)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), api.rpcTimeout)
 	defer cancel()
 	var gethResult hexutil.Bytes
@@ -89,3 +96,23 @@ func (api *PublicAPI) Sign(rpcParams SignParams, verifiedAccount *account.Select
 
 	return
 }
+
+// signWithHardware signs the hex encoded data of personal_sign on the hardware
+// wallet storing the account, its key isn't known to the upstream node
+func signWithHardware(data interface{}, hardware *account.HardwareAccount) (types.HexBytes, error) {
+	var message []byte
+	switch d := data.(type) {
+	case string:
+		decoded, err := hexutil.Decode(d)
+		if err != nil {
+			return nil, err
+		}
+		message = decoded
+	case []byte:
+		message = d
+	default:
+		return nil, ErrInvalidPersonalSignData
+	}
+	sig, err := hardware.SignMessage(message)
+	return types.HexBytes(sig), err
+}
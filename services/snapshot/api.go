@@ -0,0 +1,137 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/params"
+)
+
+var ErrSnapshotDisabledByPrivacyMode = errors.New("snapshot isn't reached in privacy mode")
+var ErrProposalNotActive = errors.New("snapshot proposal isn't open for voting")
+
+// VoteRequest is a vote of a wallet account on a proposal. Choice depends on
+// the type of the proposal: the number of a choice, a list of numbers for
+// approval and ranked votes, or the weights of the choices by number
+type VoteRequest struct {
+	From       types.Address   `json:"from"`
+	ProposalID string          `json:"proposalId"`
+	Choice     json.RawMessage `json:"choice"`
+	Reason     string          `json:"reason"`
+}
+
+func NewAPI(db *accounts.Database, accountsManager *account.GethManager, config *params.NodeConfig) *API {
+	return &API{db: db, accountsManager: accountsManager, config: config}
+}
+
+// API is class with methods available over RPC.
+type API struct {
+	db              *accounts.Database
+	accountsManager *account.GethManager
+	config          *params.NodeConfig
+}
+
+// client returns a client of the Snapshot testnet when the test networks are
+// enabled
+func (api *API) client() (*Client, error) {
+	privacyMode, err := api.db.PrivacyMode()
+	if err != nil {
+		return nil, err
+	}
+	if privacyMode {
+		return nil, ErrSnapshotDisabledByPrivacyMode
+	}
+
+	testnet, err := api.db.GetTestNetworksEnabled()
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(testnet), nil
+}
+
+// GetSpaces returns the Snapshot spaces, like the ones linked to a community
+func (api *API) GetSpaces(ctx context.Context, spaces []string) ([]*Space, error) {
+	log.Debug("call to snapshot GetSpaces")
+	client, err := api.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.Spaces(ctx, spaces)
+}
+
+// GetProposals returns the latest proposals of the spaces, the state is one
+// of pending, active and closed or empty for all of them
+func (api *API) GetProposals(ctx context.Context, spaces []string, state string) ([]*Proposal, error) {
+	log.Debug("call to snapshot GetProposals")
+	client, err := api.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.Proposals(ctx, spaces, state)
+}
+
+func (api *API) GetProposal(ctx context.Context, proposalID string) (*Proposal, error) {
+	log.Debug("call to snapshot GetProposal")
+	client, err := api.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.Proposal(ctx, proposalID)
+}
+
+// GetVotingPower returns the voting power of an account on a proposal,
+// usually derived from the tokens it held at the snapshot of the proposal
+func (api *API) GetVotingPower(ctx context.Context, proposalID string, voter types.Address) (float64, error) {
+	log.Debug("call to snapshot GetVotingPower")
+	client, err := api.client()
+	if err != nil {
+		return 0, err
+	}
+	proposal, err := client.Proposal(ctx, proposalID)
+	if err != nil {
+		return 0, err
+	}
+	return client.VotingPower(ctx, proposal.Space.ID, proposal.ID, voter.Hex())
+}
+
+// Vote signs the vote with the key of the wallet account and sends it to
+// the Snapshot sequencer
+func (api *API) Vote(ctx context.Context, request *VoteRequest, password string) (*Receipt, error) {
+	log.Debug("call to snapshot Vote")
+	client, err := api.client()
+	if err != nil {
+		return nil, err
+	}
+	proposal, err := client.Proposal(ctx, request.ProposalID)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.State != "active" {
+		return nil, ErrProposalNotActive
+	}
+
+	from := common.Address(request.From)
+	typedData, err := voteTypedData(from, proposal, request.Choice, request.Reason, time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := api.accountsManager.VerifyAccountPassword(api.config.KeyStoreDir, request.From.Hex(), password)
+	if err != nil {
+		return nil, err
+	}
+	envelope, err := signEnvelope(typedData, from, key.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.SendVote(ctx, envelope)
+}
@@ -0,0 +1,234 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const (
+	hubURL              = "https://hub.snapshot.org/graphql"
+	sequencerURL        = "https://seq.snapshot.org/"
+	testnetHubURL       = "https://testnet.hub.snapshot.org/graphql"
+	testnetSequencerURL = "https://testnet.seq.snapshot.org/"
+
+	requestTimeout  = 15 * time.Second
+	maxResponseSize = 4 * 1024 * 1024
+
+	// Number of proposals returned by a query, the hub doesn't return more
+	maxProposals = 1000
+)
+
+var ErrProposalNotFound = errors.New("snapshot proposal not found")
+
+// Space is a Snapshot space, identified by the ENS name it was created with
+type Space struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	About          string `json:"about"`
+	Network        string `json:"network"`
+	Symbol         string `json:"symbol"`
+	Avatar         string `json:"avatar"`
+	FollowersCount int    `json:"followersCount"`
+	ProposalsCount int    `json:"proposalsCount"`
+}
+
+// Proposal is a proposal of a Snapshot space. Choices are numbered from 1 in
+// votes, Start and End are unix timestamps
+type Proposal struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Body        string    `json:"body"`
+	Choices     []string  `json:"choices"`
+	Start       int64     `json:"start"`
+	End         int64     `json:"end"`
+	Snapshot    string    `json:"snapshot"`
+	State       string    `json:"state"`
+	Type        string    `json:"type"`
+	Author      string    `json:"author"`
+	Scores      []float64 `json:"scores"`
+	ScoresTotal float64   `json:"scoresTotal"`
+	Votes       int       `json:"votes"`
+	Link        string    `json:"link"`
+	Space       struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"space"`
+}
+
+// Receipt is returned by the sequencer once a vote is accepted
+type Receipt struct {
+	ID   string `json:"id"`
+	IPFS string `json:"ipfs"`
+}
+
+const spaceFields = `id name about network symbol avatar followersCount proposalsCount`
+
+const proposalFields = `id title body choices start end snapshot state type author scores
+	scoresTotal: scores_total votes link space { id name }`
+
+// Client queries the GraphQL API of the Snapshot hub and sends votes to its
+// sequencer
+type Client struct {
+	client       *http.Client
+	hubURL       string
+	sequencerURL string
+}
+
+func NewClient(testnet bool) *Client {
+	client := &Client{
+		client:       &http.Client{Timeout: requestTimeout},
+		hubURL:       hubURL,
+		sequencerURL: sequencerURL,
+	}
+	if testnet {
+		client.hubURL = testnetHubURL
+		client.sequencerURL = testnetSequencerURL
+	}
+	return client
+}
+
+func (c *Client) post(ctx context.Context, url string, body interface{}) ([]byte, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var response struct {
+			Error            string `json:"error"`
+			ErrorDescription string `json:"error_description"`
+		}
+		if json.Unmarshal(data, &response) == nil && response.ErrorDescription != "" {
+			return nil, fmt.Errorf("snapshot: %s", response.ErrorDescription)
+		}
+		return nil, fmt.Errorf("snapshot: unexpected status %d", resp.StatusCode)
+	}
+	return data, nil
+}
+
+func (c *Client) query(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	data, err := c.post(ctx, c.hubURL, map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	err = json.Unmarshal(data, &response)
+	if err != nil {
+		return err
+	}
+	if len(response.Errors) > 0 {
+		return fmt.Errorf("snapshot: %s", response.Errors[0].Message)
+	}
+	return json.Unmarshal(response.Data, result)
+}
+
+// Spaces returns the spaces with the given IDs
+func (c *Client) Spaces(ctx context.Context, ids []string) ([]*Space, error) {
+	var result struct {
+		Spaces []*Space `json:"spaces"`
+	}
+	err := c.query(ctx, `query Spaces($ids: [String]) {
+		spaces(where: { id_in: $ids }) { `+spaceFields+` }
+	}`, map[string]interface{}{"ids": ids}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Spaces, nil
+}
+
+// Proposals returns the latest proposals of the spaces, an empty state
+// returns the proposals in any state
+func (c *Client) Proposals(ctx context.Context, spaces []string, state string) ([]*Proposal, error) {
+	if state == "" {
+		state = "all"
+	}
+	var result struct {
+		Proposals []*Proposal `json:"proposals"`
+	}
+	err := c.query(ctx, `query Proposals($spaces: [String], $state: String, $first: Int) {
+		proposals(first: $first, where: { space_in: $spaces, state: $state }, orderBy: "created", orderDirection: desc) { `+proposalFields+` }
+	}`, map[string]interface{}{"spaces": spaces, "state": state, "first": maxProposals}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Proposals, nil
+}
+
+// Proposal returns the proposal with the given ID
+func (c *Client) Proposal(ctx context.Context, id string) (*Proposal, error) {
+	var result struct {
+		Proposal *Proposal `json:"proposal"`
+	}
+	err := c.query(ctx, `query Proposal($id: String) {
+		proposal(id: $id) { `+proposalFields+` }
+	}`, map[string]interface{}{"id": id}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Proposal == nil {
+		return nil, ErrProposalNotFound
+	}
+	return result.Proposal, nil
+}
+
+// VotingPower returns the voting power of the voter on the proposal, as
+// computed by the strategies of the space at the snapshot of the proposal
+func (c *Client) VotingPower(ctx context.Context, space string, proposal string, voter string) (float64, error) {
+	var result struct {
+		VP struct {
+			VP float64 `json:"vp"`
+		} `json:"vp"`
+	}
+	err := c.query(ctx, `query VotingPower($voter: String!, $space: String!, $proposal: String) {
+		vp(voter: $voter, space: $space, proposal: $proposal) { vp }
+	}`, map[string]interface{}{"voter": voter, "space": space, "proposal": proposal}, &result)
+	if err != nil {
+		return 0, err
+	}
+	return result.VP.VP, nil
+}
+
+// SendVote submits a signed vote to the sequencer
+func (c *Client) SendVote(ctx context.Context, envelope *Envelope) (*Receipt, error) {
+	data, err := c.post(ctx, c.sequencerURL, envelope)
+	if err != nil {
+		return nil, err
+	}
+	receipt := &Receipt{}
+	err = json.Unmarshal(data, receipt)
+	if err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
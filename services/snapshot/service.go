@@ -0,0 +1,48 @@
+package snapshot
+
+import (
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/params"
+)
+
+// Service lists the proposals of Snapshot spaces and votes on them with the
+// wallet accounts
+type Service struct {
+	api *API
+}
+
+// NewService returns a new Service.
+func NewService(accountsDB *accounts.Database, accountsManager *account.GethManager, config *params.NodeConfig) *Service {
+	return &Service{api: NewAPI(accountsDB, accountsManager, config)}
+}
+
+// Protocols returns a new protocols list. In this case, there are none.
+func (s *Service) Protocols() []p2p.Protocol {
+	return []p2p.Protocol{}
+}
+
+// APIs returns a list of new APIs.
+func (s *Service) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "snapshot",
+			Version:   "0.1.0",
+			Service:   s.api,
+			Public:    true,
+		},
+	}
+}
+
+// Start is run when a service is started.
+func (s *Service) Start() error {
+	return nil
+}
+
+// Stop is run when a service is stopped.
+func (s *Service) Stop() error {
+	return nil
+}
@@ -0,0 +1,123 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+func testProposal(proposalType string) *Proposal {
+	proposal := &Proposal{
+		ID:      "0x1b0ec5a1ff8e2c3c0c2d2d1c8f0e7f8a3d54b64b9f6bb2f2e1e5d8d3a9d4f0c1",
+		Choices: []string{"For", "Against", "Abstain"},
+		State:   "active",
+		Type:    proposalType,
+	}
+	proposal.Space.ID = "status.eth"
+	return proposal
+}
+
+func TestChoiceValue(t *testing.T) {
+	for _, tc := range []struct {
+		proposalType string
+		choice       string
+		valid        bool
+	}{
+		{"single-choice", `2`, true},
+		{"single-choice", `0`, false},
+		{"single-choice", `4`, false},
+		{"approval", `[1, 3]`, true},
+		{"approval", `[1, 1]`, false},
+		{"ranked-choice", `[3, 1, 2]`, true},
+		{"ranked-choice", `[3, 1]`, false},
+		{"weighted", `{"1": 2, "3": 1}`, true},
+		{"weighted", `{"5": 1}`, false},
+	} {
+		_, _, err := choiceValue(testProposal(tc.proposalType), json.RawMessage(tc.choice))
+		if tc.valid {
+			require.NoError(t, err, tc.proposalType, tc.choice)
+		} else {
+			require.ErrorIs(t, err, ErrInvalidChoice, tc.proposalType, tc.choice)
+		}
+	}
+
+	_, _, err := choiceValue(testProposal("custom"), json.RawMessage(`1`))
+	require.ErrorIs(t, err, ErrUnsupportedProposalType)
+}
+
+func TestSendVote(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	var received Envelope
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &received))
+		_, _ = w.Write([]byte(`{"id":"0x01","ipfs":"bafy"}`))
+	}))
+	defer server.Close()
+
+	for _, proposalType := range []string{"single-choice", "approval", "weighted"} {
+		choice := map[string]string{"single-choice": `1`, "approval": `[1, 2]`, "weighted": `{"2": 1}`}[proposalType]
+		typedData, err := voteTypedData(from, testProposal(proposalType), json.RawMessage(choice), "", 1690000000)
+		require.NoError(t, err)
+		envelope, err := signEnvelope(typedData, from, key)
+		require.NoError(t, err)
+
+		client := NewClient(false)
+		client.sequencerURL = server.URL
+		receipt, err := client.SendVote(context.Background(), envelope)
+		require.NoError(t, err)
+		require.Equal(t, &Receipt{ID: "0x01", IPFS: "bafy"}, receipt)
+
+		// The sequencer rebuilds the typed data from the JSON it receives
+		received.Data.Types["EIP712Domain"] = typedData.Types["EIP712Domain"]
+		hash, _, err := apitypes.TypedDataAndHash(apitypes.TypedData{
+			Types:       received.Data.Types,
+			PrimaryType: "Vote",
+			Domain:      apitypes.TypedDataDomain{Name: received.Data.Domain["name"].(string), Version: received.Data.Domain["version"].(string)},
+			Message:     received.Data.Message,
+		})
+		require.NoError(t, err)
+
+		signature, err := hexutil.Decode(received.Sig)
+		require.NoError(t, err)
+		signature[crypto.RecoveryIDOffset] -= 27
+		publicKey, err := crypto.SigToPub(hash, signature)
+		require.NoError(t, err)
+		require.Equal(t, from, crypto.PubkeyToAddress(*publicKey))
+		require.Equal(t, from.Hex(), received.Address)
+	}
+}
+
+func TestProposals(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		require.Equal(t, "all", request.Variables["state"])
+		_, _ = w.Write([]byte(`{"data":{"proposals":[{"id":"0x01","title":"Fund","choices":["For","Against"],"state":"active","type":"basic","scoresTotal":12.5,"space":{"id":"status.eth","name":"Status"}}]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(false)
+	client.hubURL = server.URL
+	proposals, err := client.Proposals(context.Background(), []string{"status.eth"}, "")
+	require.NoError(t, err)
+	require.Len(t, proposals, 1)
+	require.Equal(t, "Fund", proposals[0].Title)
+	require.Equal(t, 12.5, proposals[0].ScoresTotal)
+	require.Equal(t, "status.eth", proposals[0].Space.ID)
+}
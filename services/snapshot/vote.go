@@ -0,0 +1,174 @@
+package snapshot
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// The EIP-712 domain of the messages signed for the sequencer
+const (
+	domainName    = "snapshot"
+	domainVersion = "0.1.4"
+)
+
+// Name of the app recorded with the votes
+const appName = "status"
+
+var ErrInvalidChoice = errors.New("invalid snapshot choice")
+var ErrUnsupportedProposalType = errors.New("unsupported snapshot proposal type")
+
+// Envelope is a signed message sent to the sequencer
+type Envelope struct {
+	Address string       `json:"address"`
+	Sig     string       `json:"sig"`
+	Data    EnvelopeData `json:"data"`
+}
+
+type EnvelopeData struct {
+	Domain  map[string]interface{}    `json:"domain"`
+	Types   apitypes.Types            `json:"types"`
+	Message apitypes.TypedDataMessage `json:"message"`
+}
+
+// choiceValue returns the EIP-712 type and value of the choice for the type
+// of the proposal. Single choices are numbers, approval and ranked votes are
+// lists of numbers and weighted votes are the JSON encoded weights of the
+// choices
+func choiceValue(proposal *Proposal, choice json.RawMessage) (string, interface{}, error) {
+	valid := func(c uint32) bool {
+		return c >= 1 && int(c) <= len(proposal.Choices)
+	}
+
+	switch proposal.Type {
+	case "single-choice", "basic":
+		var c uint32
+		if err := json.Unmarshal(choice, &c); err != nil || !valid(c) {
+			return "", nil, ErrInvalidChoice
+		}
+		return "uint32", float64(c), nil
+
+	case "approval", "ranked-choice":
+		var choices []uint32
+		if err := json.Unmarshal(choice, &choices); err != nil || len(choices) == 0 {
+			return "", nil, ErrInvalidChoice
+		}
+		if proposal.Type == "ranked-choice" && len(choices) != len(proposal.Choices) {
+			return "", nil, ErrInvalidChoice
+		}
+		seen := make(map[uint32]bool, len(choices))
+		value := make([]interface{}, 0, len(choices))
+		for _, c := range choices {
+			if !valid(c) || seen[c] {
+				return "", nil, ErrInvalidChoice
+			}
+			seen[c] = true
+			value = append(value, float64(c))
+		}
+		return "uint32[]", value, nil
+
+	case "weighted", "quadratic":
+		var weights map[string]float64
+		if err := json.Unmarshal(choice, &weights); err != nil || len(weights) == 0 {
+			return "", nil, ErrInvalidChoice
+		}
+		for key, weight := range weights {
+			c, err := strconv.ParseUint(key, 10, 32)
+			if err != nil || !valid(uint32(c)) || weight < 0 {
+				return "", nil, ErrInvalidChoice
+			}
+		}
+		encoded, err := json.Marshal(weights)
+		if err != nil {
+			return "", nil, err
+		}
+		return "string", string(encoded), nil
+	}
+
+	return "", nil, ErrUnsupportedProposalType
+}
+
+// voteTypedData returns the EIP-712 vote message for the proposal. Proposals
+// are identified by a hash, older ones by their IPFS hash
+func voteTypedData(from common.Address, proposal *Proposal, choice json.RawMessage, reason string, timestamp int64) (*apitypes.TypedData, error) {
+	choiceType, value, err := choiceValue(proposal, choice)
+	if err != nil {
+		return nil, err
+	}
+	proposalType := "string"
+	if strings.HasPrefix(proposal.ID, "0x") {
+		proposalType = "bytes32"
+	}
+
+	return &apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+			},
+			"Vote": {
+				{Name: "from", Type: "address"},
+				{Name: "space", Type: "string"},
+				{Name: "timestamp", Type: "uint64"},
+				{Name: "proposal", Type: proposalType},
+				{Name: "choice", Type: choiceType},
+				{Name: "reason", Type: "string"},
+				{Name: "app", Type: "string"},
+				{Name: "metadata", Type: "string"},
+			},
+		},
+		PrimaryType: "Vote",
+		Domain: apitypes.TypedDataDomain{
+			Name:    domainName,
+			Version: domainVersion,
+		},
+		Message: apitypes.TypedDataMessage{
+			"from":      from.Hex(),
+			"space":     proposal.Space.ID,
+			"timestamp": float64(timestamp),
+			"proposal":  proposal.ID,
+			"choice":    value,
+			"reason":    reason,
+			"app":       appName,
+			"metadata":  "{}",
+		},
+	}, nil
+}
+
+// signEnvelope signs the typed data like eth_signTypedData_v4, the sequencer
+// recovers the signer from it
+func signEnvelope(typedData *apitypes.TypedData, from common.Address, key *ecdsa.PrivateKey) (*Envelope, error) {
+	hash, _, err := apitypes.TypedDataAndHash(*typedData)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := crypto.Sign(hash, key)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+
+	types := apitypes.Types{}
+	for name, fields := range typedData.Types {
+		if name != "EIP712Domain" {
+			types[name] = fields
+		}
+	}
+
+	return &Envelope{
+		Address: from.Hex(),
+		Sig:     hexutil.Encode(signature),
+		Data: EnvelopeData{
+			Domain:  typedData.Domain.Map(),
+			Types:   types,
+			Message: typedData.Message,
+		},
+	}, nil
+}
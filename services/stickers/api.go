@@ -3,6 +3,7 @@ package stickers
 import (
 	"context"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/zenthangplus/goccm"
@@ -47,6 +48,9 @@ type API struct {
 	downloader  *ipfs.Downloader
 	httpServer  *server.MediaServer
 
+	db         *Database
+	indexMutex sync.Mutex
+
 	ctx context.Context
 }
 
@@ -98,13 +102,14 @@ func NewAPI(ctx context.Context, acc *accounts.Database, rpcClient *rpc.Client,
 		downloader:      downloader,
 		ctx:             ctx,
 		httpServer:      httpServer,
+		db:              NewDatabase(acc.DB()),
 	}
 
 	return result
 }
 
 func (api *API) Market(chainID uint64) ([]StickerPack, error) {
-	// TODO: eventually this should be changed to include pagination
+	// MarketPage should be preferred, this fetches the data of every pack
 	accs, err := api.accountsDB.GetAccounts()
 	if err != nil {
 		return nil, err
@@ -115,6 +120,27 @@ func (api *API) Market(chainID uint64) ([]StickerPack, error) {
 		return nil, err
 	}
 
+	purchasedPacks, err := api.purchasedPacks(chainID, accs)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []StickerPack
+	for _, pack := range allStickerPacks {
+		packID := uint(pack.ID.Uint64())
+		_, isPurchased := purchasedPacks[packID]
+		if isPurchased {
+			pack.Status = statusPurchased
+		} else {
+			pack.Status = statusAvailable
+		}
+		result = append(result, pack)
+	}
+
+	return result, nil
+}
+
+func (api *API) purchasedPacks(chainID uint64, accs []*accounts.Account) (map[uint]struct{}, error) {
 	purchasedPacks := make(map[uint]struct{})
 
 	purchasedPackChan := make(chan *big.Int)
@@ -134,19 +160,7 @@ func (api *API) Market(chainID uint64) ([]StickerPack, error) {
 			}
 
 		case <-doneChan:
-			var result []StickerPack
-			for _, pack := range allStickerPacks {
-				packID := uint(pack.ID.Uint64())
-				_, isPurchased := purchasedPacks[packID]
-				if isPurchased {
-					pack.Status = statusPurchased
-				} else {
-					pack.Status = statusAvailable
-				}
-				result = append(result, pack)
-			}
-
-			return result, nil
+			return purchasedPacks, nil
 		}
 	}
 }
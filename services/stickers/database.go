@@ -0,0 +1,135 @@
+package stickers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strings"
+)
+
+// Database indexes the metadata of the sticker packs of the registry, so that
+// the market can be paginated and searched without fetching every pack
+type Database struct {
+	db *sql.DB
+}
+
+func NewDatabase(db *sql.DB) *Database {
+	return &Database{db: db}
+}
+
+// IndexedAt returns when each indexed pack of the chain was fetched
+func (db *Database) IndexedAt(chainID uint64) (map[uint]int64, error) {
+	rows, err := db.db.Query(`SELECT pack_id, indexed_at FROM sticker_packs_index WHERE chain_id = ?`, chainID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[uint]int64)
+	for rows.Next() {
+		var packID uint
+		var indexedAt int64
+		err = rows.Scan(&packID, &indexedAt)
+		if err != nil {
+			return nil, err
+		}
+		result[packID] = indexedAt
+	}
+	return result, rows.Err()
+}
+
+func (db *Database) SavePacks(chainID uint64, packs []StickerPack, indexedAt int64) (err error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	insert, err := tx.Prepare(`INSERT OR REPLACE INTO sticker_packs_index (chain_id, pack_id, name, author, pack, indexed_at) VALUES (?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+
+	for _, pack := range packs {
+		var data []byte
+		data, err = json.Marshal(pack)
+		if err != nil {
+			return err
+		}
+		_, err = insert.Exec(chainID, pack.ID.Uint64(), pack.Name, pack.Author, data, indexedAt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPack returns the indexed pack, or nil if the pack isn't indexed
+func (db *Database) GetPack(chainID uint64, packID uint) (*StickerPack, error) {
+	var data []byte
+	err := db.db.QueryRow(`SELECT pack FROM sticker_packs_index WHERE chain_id = ? AND pack_id = ?`, chainID, packID).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pack := &StickerPack{}
+	err = json.Unmarshal(data, pack)
+	if err != nil {
+		return nil, err
+	}
+	return pack, nil
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// GetPacks returns a page of the indexed packs whose name or author contains
+// the query, ordered by pack ID, and the number of matching packs
+func (db *Database) GetPacks(chainID uint64, query string, offset, limit int) ([]StickerPack, int, error) {
+	where := `WHERE chain_id = ?`
+	args := []interface{}{chainID}
+	if query = strings.TrimSpace(query); query != "" {
+		pattern := "%" + escapeLike(strings.ToLower(query)) + "%"
+		where += ` AND (LOWER(name) LIKE ? ESCAPE '\' OR LOWER(author) LIKE ? ESCAPE '\')`
+		args = append(args, pattern, pattern)
+	}
+
+	var total int
+	err := db.db.QueryRow(`SELECT COUNT(*) FROM sticker_packs_index `+where, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := db.db.Query(`SELECT pack FROM sticker_packs_index `+where+` ORDER BY pack_id LIMIT ? OFFSET ?`, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	packs := make([]StickerPack, 0, limit)
+	for rows.Next() {
+		var data []byte
+		err = rows.Scan(&data)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var pack StickerPack
+		err = json.Unmarshal(data, &pack)
+		if err != nil {
+			return nil, 0, err
+		}
+		packs = append(packs, pack)
+	}
+	return packs, total, rows.Err()
+}
@@ -0,0 +1,85 @@
+package stickers
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/services/wallet/bigint"
+	"github.com/status-im/status-go/sqlite"
+)
+
+func setupTestDB(t *testing.T) (*Database, func()) {
+	tmpfile, err := ioutil.TempFile("", "stickers-tests-")
+	require.NoError(t, err)
+	db, err := appdatabase.InitializeDB(tmpfile.Name(), "stickers-tests", sqlite.ReducedKDFIterationsNumber)
+	require.NoError(t, err)
+	return NewDatabase(db), func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.Remove(tmpfile.Name()))
+	}
+}
+
+func testPack(id int64, name string, author string) StickerPack {
+	return StickerPack{
+		ID:       &bigint.BigInt{Int: big.NewInt(id)},
+		Name:     name,
+		Author:   author,
+		Price:    &bigint.BigInt{Int: big.NewInt(0)},
+		Stickers: []Sticker{{PackID: &bigint.BigInt{Int: big.NewInt(id)}, Hash: "hash"}},
+	}
+}
+
+func TestIndexedPacks(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	packs := []StickerPack{
+		testPack(0, "Status Cat", "status"),
+		testPack(1, "Ghosts", "Alice"),
+		testPack(2, "100% Cats", "bob"),
+	}
+	require.NoError(t, db.SavePacks(1, packs, 10))
+
+	indexedAt, err := db.IndexedAt(1)
+	require.NoError(t, err)
+	require.Equal(t, map[uint]int64{0: 10, 1: 10, 2: 10}, indexedAt)
+
+	page, total, err := db.GetPacks(1, "", 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, total)
+	require.Len(t, page, 1)
+	require.Equal(t, "Ghosts", page[0].Name)
+
+	page, total, err = db.GetPacks(1, "CAT", 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, total)
+	require.Equal(t, "Status Cat", page[0].Name)
+	require.Equal(t, "100% Cats", page[1].Name)
+
+	page, total, err = db.GetPacks(1, "alice", 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, "hash", page[0].Stickers[0].Hash)
+
+	// LIKE wildcards are matched literally
+	_, total, err = db.GetPacks(1, "0%", 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+
+	_, total, err = db.GetPacks(5, "", 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, total)
+
+	pack, err := db.GetPack(1, 2)
+	require.NoError(t, err)
+	require.Equal(t, "bob", pack.Author)
+
+	pack, err = db.GetPack(1, 3)
+	require.NoError(t, err)
+	require.Nil(t, pack)
+}
@@ -20,24 +20,67 @@ func (api *API) Install(chainID uint64, packID *bigint.BigInt) error {
 
 	// TODO: this does not validate if the pack is purchased. Should it?
 
-	stickerType, err := api.contractMaker.NewStickerType(chainID)
+	stickerPack, err := api.packData(chainID, packID)
 	if err != nil {
 		return err
 	}
 
-	stickerPack, err := api.fetchPackData(stickerType, packID.Int, false)
+	installedPacks[uint(packID.Uint64())] = *stickerPack
+
+	err = api.accountsDB.SaveSettingField(settings.StickersPacksInstalled, installedPacks)
 	if err != nil {
 		return err
 	}
 
-	installedPacks[uint(packID.Uint64())] = *stickerPack
+	return nil
+}
 
-	err = api.accountsDB.SaveSettingField(settings.StickersPacksInstalled, installedPacks)
+// BatchInstall installs the packs which aren't installed yet, either all of
+// them or none if the data of a pack can't be fetched
+func (api *API) BatchInstall(chainID uint64, packIDs []*bigint.BigInt) error {
+	installedPacks, err := api.installedStickerPacks()
 	if err != nil {
 		return err
 	}
 
-	return nil
+	installed := false
+	for _, packID := range packIDs {
+		if _, exists := installedPacks[uint(packID.Uint64())]; exists {
+			continue
+		}
+
+		stickerPack, err := api.packData(chainID, packID)
+		if err != nil {
+			return err
+		}
+
+		installedPacks[uint(packID.Uint64())] = *stickerPack
+		installed = true
+	}
+
+	if !installed {
+		return nil
+	}
+	return api.accountsDB.SaveSettingField(settings.StickersPacksInstalled, installedPacks)
+}
+
+// packData returns the data of the pack to install, from the market index if
+// the pack is indexed
+func (api *API) packData(chainID uint64, packID *bigint.BigInt) (*StickerPack, error) {
+	stickerPack, err := api.db.GetPack(chainID, uint(packID.Uint64()))
+	if err != nil {
+		return nil, err
+	}
+	if stickerPack != nil {
+		return stickerPack, nil
+	}
+
+	stickerType, err := api.contractMaker.NewStickerType(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.fetchPackData(stickerType, packID.Int, false)
 }
 
 func (api *API) installedStickerPacks() (StickerPackCollection, error) {
@@ -0,0 +1,146 @@
+package stickers
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/zenthangplus/goccm"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const maxMarketPageSize = 100
+
+// packIndexTTL is how long the indexed data of a pack is used before being
+// fetched again, the price of a pack can change
+const packIndexTTL = 24 * time.Hour
+
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+type MarketPage struct {
+	Packs []StickerPack `json:"packs"`
+	// Total is the number of packs matching the query
+	Total int `json:"total"`
+}
+
+// indexPacks fetches the data of the packs of the registry which aren't
+// indexed yet, or were indexed more than packIndexTTL ago. Packs whose data
+// can't be fetched are skipped until the next call
+func (api *API) indexPacks(chainID uint64) error {
+	api.indexMutex.Lock()
+	defer api.indexMutex.Unlock()
+
+	stickerType, err := api.contractMaker.NewStickerType(chainID)
+	if err != nil {
+		return err
+	}
+
+	callOpts := &bind.CallOpts{Context: api.ctx, Pending: false}
+	numPacks, err := stickerType.PackCount(callOpts)
+	if err != nil {
+		return err
+	}
+
+	indexedAt, err := api.db.IndexedAt(chainID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var packs []StickerPack
+	var mutex sync.Mutex
+
+	c := goccm.New(maxConcurrentRequests)
+	for i := uint64(0); i < numPacks.Uint64(); i++ {
+		if at, exists := indexedAt[uint(i)]; exists && now.Sub(time.Unix(at, 0)) < packIndexTTL {
+			continue
+		}
+
+		c.Wait()
+		go func(i uint64) {
+			defer c.Done()
+
+			packID := new(big.Int).SetUint64(i)
+			stickerPack, err := api.fetchPackData(stickerType, packID, false)
+			if err != nil {
+				log.Warn("Could not index stickerpack data", "packID", packID, "error", err)
+				return
+			}
+
+			mutex.Lock()
+			packs = append(packs, *stickerPack)
+			mutex.Unlock()
+		}(i)
+	}
+	c.WaitAllDone()
+
+	if len(packs) == 0 {
+		return nil
+	}
+	return api.db.SavePacks(chainID, packs, now.Unix())
+}
+
+// MarketPage returns a page of the packs of the registry whose name or author
+// contains the query, ordered by pack ID. Unlike Market, installed and pending
+// packs are included, with their status
+func (api *API) MarketPage(chainID uint64, query string, offset int, limit int) (*MarketPage, error) {
+	if limit <= 0 || limit > maxMarketPageSize || offset < 0 {
+		return nil, ErrInvalidPageSize
+	}
+
+	err := api.indexPacks(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	packs, total, err := api.db.GetPacks(chainID, query, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	installedPacks, err := api.installedStickerPacks()
+	if err != nil {
+		return nil, err
+	}
+
+	pendingPacks, err := api.pendingStickerPacks()
+	if err != nil {
+		return nil, err
+	}
+
+	accs, err := api.accountsDB.GetAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	purchasedPacks, err := api.purchasedPacks(chainID, accs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, pack := range packs {
+		packID := uint(pack.ID.Uint64())
+		if _, exists := installedPacks[packID]; exists {
+			pack.Status = statusInstalled
+		} else if _, exists := pendingPacks[packID]; exists {
+			pack.Status = statusPending
+		} else if _, exists := purchasedPacks[packID]; exists {
+			pack.Status = statusPurchased
+		} else {
+			pack.Status = statusAvailable
+		}
+
+		pack.Preview = api.hashToURL(pack.Preview)
+		pack.Thumbnail = api.hashToURL(pack.Thumbnail)
+		for j, sticker := range pack.Stickers {
+			sticker.URL = api.hashToURL(sticker.Hash)
+			pack.Stickers[j] = sticker
+		}
+		packs[i] = pack
+	}
+
+	return &MarketPage{Packs: packs, Total: total}, nil
+}
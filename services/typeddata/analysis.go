@@ -0,0 +1,273 @@
+package typeddata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	signercore "github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+type WarningType string
+
+const (
+	// WarningUnlimitedAllowance is a permit for an amount no one can spend
+	WarningUnlimitedAllowance WarningType = "unlimited-allowance"
+	// WarningNoExpiry is a permit which never expires, or expires in more
+	// than maxPermitDuration
+	WarningNoExpiry WarningType = "no-expiry"
+	// WarningChainMismatch is typed data for another chain than the selected one
+	WarningChainMismatch WarningType = "chain-mismatch"
+)
+
+const maxPermitDuration = 365 * 24 * time.Hour
+
+// atomicTypeRegexp matches the atomic types of EIP-712
+var atomicTypeRegexp = regexp.MustCompile(`^(address|bool|string|bytes([1-9]|[12][0-9]|3[0-2])?|u?int(8|16|24|32|40|48|56|64|72|80|88|96|104|112|120|128|136|144|152|160|168|176|184|192|200|208|216|224|232|240|248|256)?)$`)
+
+var (
+	// Permit2 amounts are uint160
+	maxUint160 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1))
+	// amounts at least half of the uint256 range are considered unlimited,
+	// some dapps don't use the maximum value
+	unlimitedAmount = new(big.Int).Lsh(big.NewInt(1), 255)
+)
+
+// Warning is a dangerous pattern found in typed data to be signed
+type Warning struct {
+	Type    WarningType `json:"type"`
+	Message string      `json:"message"`
+}
+
+// Analysis is the result of the validation of typed data before it's signed
+type Analysis struct {
+	Digest      common.Hash                 `json:"digest"`
+	PrimaryType string                      `json:"primaryType"`
+	Domain      signercore.TypedDataDomain  `json:"domain"`
+	Fields      []*signercore.NameValueType `json:"fields,omitempty"`
+	Warnings    []Warning                   `json:"warnings"`
+}
+
+// baseType returns the type of the elements of an array type
+func baseType(typ string) string {
+	if i := strings.Index(typ, "["); i >= 0 {
+		return typ[:i]
+	}
+	return typ
+}
+
+// ValidateV4 checks that typed data is well formed: the primary type and the
+// types of the fields are defined, and the domain and the message have no
+// field not declared in their type, it wouldn't be signed
+func ValidateV4(typed signercore.TypedData) error {
+	if _, exist := typed.Types[eip712Domain]; !exist {
+		return fmt.Errorf("`%s` must be in `types`", eip712Domain)
+	}
+	if typed.PrimaryType == "" {
+		return errors.New("`primaryType` is required")
+	}
+	if typed.PrimaryType == eip712Domain {
+		return fmt.Errorf("primary type can't be `%s`", eip712Domain)
+	}
+	if _, exist := typed.Types[typed.PrimaryType]; !exist {
+		return fmt.Errorf("primary type `%s` not defined in types", typed.PrimaryType)
+	}
+
+	for name, fields := range typed.Types {
+		seen := make(map[string]struct{}, len(fields))
+		for i, field := range fields {
+			if field.Name == "" || field.Type == "" {
+				return fmt.Errorf("field %d from type `%s` is invalid", i, name)
+			}
+			if _, exists := seen[field.Name]; exists {
+				return fmt.Errorf("field `%s` of type `%s` is duplicated", field.Name, name)
+			}
+			seen[field.Name] = struct{}{}
+
+			if _, isStruct := typed.Types[baseType(field.Type)]; !isStruct && !atomicTypeRegexp.MatchString(baseType(field.Type)) {
+				return fmt.Errorf("type `%s` of field `%s` from type `%s` not defined", field.Type, field.Name, name)
+			}
+		}
+	}
+
+	declared := make(map[string]struct{})
+	for _, field := range typed.Types[eip712Domain] {
+		declared[field.Name] = struct{}{}
+	}
+	for name := range typed.Domain.Map() {
+		if _, exists := declared[name]; !exists {
+			return fmt.Errorf("domain field `%s` not declared in `%s`", name, eip712Domain)
+		}
+	}
+
+	return validateDeclaredFields(typed.Types, typed.PrimaryType, typed.Message)
+}
+
+// validateDeclaredFields checks that every field of the message, and of its
+// nested structs, is declared in its type
+func validateDeclaredFields(types signercore.Types, typ string, message map[string]interface{}) error {
+	fields := make(map[string]string, len(types[typ]))
+	for _, field := range types[typ] {
+		fields[field.Name] = field.Type
+	}
+
+	for name, value := range message {
+		fieldType, exists := fields[name]
+		if !exists {
+			return fmt.Errorf("field `%s` not declared in type `%s`", name, typ)
+		}
+
+		if _, isStruct := types[baseType(fieldType)]; !isStruct {
+			continue
+		}
+		if strings.HasSuffix(fieldType, "]") {
+			items, _ := value.([]interface{})
+			for _, item := range items {
+				if nested, ok := item.(map[string]interface{}); ok {
+					if err := validateDeclaredFields(types, baseType(fieldType), nested); err != nil {
+						return err
+					}
+				}
+			}
+		} else if nested, ok := value.(map[string]interface{}); ok {
+			if err := validateDeclaredFields(types, fieldType, nested); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func toBigInt(value interface{}) *big.Int {
+	switch v := value.(type) {
+	case string:
+		var n math.HexOrDecimal256
+		if err := n.UnmarshalText([]byte(v)); err != nil {
+			return nil
+		}
+		return (*big.Int)(&n)
+	case float64:
+		n, _ := new(big.Float).SetFloat64(v).Int(nil)
+		return n
+	case json.Number:
+		n, ok := new(big.Int).SetString(v.String(), 0)
+		if !ok {
+			return nil
+		}
+		return n
+	}
+	return nil
+}
+
+// checkExpiry warns about an expiry too far in the future. DAI permits with a
+// zero expiry never expire
+func checkExpiry(value interface{}, zeroNeverExpires bool, now time.Time, warnings []Warning) []Warning {
+	expiry := toBigInt(value)
+	if expiry == nil {
+		return warnings
+	}
+	if (zeroNeverExpires && expiry.Sign() == 0) || expiry.Cmp(big.NewInt(now.Add(maxPermitDuration).Unix())) > 0 {
+		warnings = append(warnings, Warning{
+			Type:    WarningNoExpiry,
+			Message: "the permit doesn't expire",
+		})
+	}
+	return warnings
+}
+
+// permitWarnings detects EIP-2612, DAI and Permit2 permits granting an
+// unlimited allowance or without expiry
+func permitWarnings(typed signercore.TypedData, now time.Time) []Warning {
+	var warnings []Warning
+	message := typed.Message
+
+	switch typed.PrimaryType {
+	case "Permit":
+		if allowed, ok := message["allowed"].(bool); ok {
+			// DAI permits have no amount
+			if allowed {
+				warnings = append(warnings, Warning{
+					Type:    WarningUnlimitedAllowance,
+					Message: fmt.Sprintf("%v can spend all your tokens of %s", message["spender"], typed.Domain.VerifyingContract),
+				})
+			}
+			return checkExpiry(message["expiry"], true, now, warnings)
+		}
+		if value := toBigInt(message["value"]); value != nil && value.Cmp(unlimitedAmount) >= 0 {
+			warnings = append(warnings, Warning{
+				Type:    WarningUnlimitedAllowance,
+				Message: fmt.Sprintf("%v can spend all your tokens of %s", message["spender"], typed.Domain.VerifyingContract),
+			})
+		}
+		return checkExpiry(message["deadline"], false, now, warnings)
+
+	case "PermitSingle", "PermitBatch":
+		var details []interface{}
+		switch d := message["details"].(type) {
+		case map[string]interface{}:
+			details = []interface{}{d}
+		case []interface{}:
+			details = d
+		}
+		for _, detail := range details {
+			d, ok := detail.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if amount := toBigInt(d["amount"]); amount != nil && amount.Cmp(maxUint160) >= 0 {
+				warnings = append(warnings, Warning{
+					Type:    WarningUnlimitedAllowance,
+					Message: fmt.Sprintf("%v can spend all your tokens of %v", message["spender"], d["token"]),
+				})
+			}
+			warnings = checkExpiry(d["expiration"], false, now, warnings)
+		}
+		return checkExpiry(message["sigDeadline"], false, now, warnings)
+	}
+	return nil
+}
+
+// AnalyzeV4 validates typed data, computes its digest and detects dangerous
+// patterns to warn about before it's signed
+func AnalyzeV4(typed signercore.TypedData, chain *big.Int) (*Analysis, error) {
+	if err := ValidateV4(typed); err != nil {
+		return nil, err
+	}
+
+	digest, err := HashTypedDataV4(typed, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	// Formatting doesn't support every type, the fields are only displayed
+	// when available
+	fields, err := typed.Format()
+	if err != nil {
+		fields = nil
+	}
+
+	warnings := permitWarnings(typed, time.Now())
+	if typed.Domain.ChainId != nil && (*big.Int)(typed.Domain.ChainId).Cmp(chain) != 0 {
+		warnings = append(warnings, Warning{
+			Type:    WarningChainMismatch,
+			Message: fmt.Sprintf("chainId %s doesn't match selected chain %s", (*big.Int)(typed.Domain.ChainId), chain),
+		})
+	}
+	if warnings == nil {
+		warnings = []Warning{}
+	}
+
+	return &Analysis{
+		Digest:      digest,
+		PrimaryType: typed.PrimaryType,
+		Domain:      typed.Domain,
+		Fields:      fields,
+		Warnings:    warnings,
+	}, nil
+}
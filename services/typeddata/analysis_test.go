@@ -0,0 +1,99 @@
+package typeddata
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const permitTypedData = `
+{
+  "types": {
+    "EIP712Domain": [
+      {"name": "name", "type": "string"},
+      {"name": "version", "type": "string"},
+      {"name": "chainId", "type": "uint256"},
+      {"name": "verifyingContract", "type": "address"}
+    ],
+    "Permit": [
+      {"name": "owner", "type": "address"},
+      {"name": "spender", "type": "address"},
+      {"name": "value", "type": "uint256"},
+      {"name": "nonce", "type": "uint256"},
+      {"name": "deadline", "type": "uint256"}
+    ]
+  },
+  "primaryType": "Permit",
+  "domain": {
+    "name": "USD Coin",
+    "version": "2",
+    "chainId": 1,
+    "verifyingContract": "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+  },
+  "message": {
+    "owner": "0x1111111111111111111111111111111111111111",
+    "spender": "0x2222222222222222222222222222222222222222",
+    "value": "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+    "nonce": "0",
+    "deadline": "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+  }
+}`
+
+func TestAnalyzeV4Permit(t *testing.T) {
+	var typed apitypes.TypedData
+	require.NoError(t, json.Unmarshal([]byte(permitTypedData), &typed))
+
+	analysis, err := AnalyzeV4(typed, big.NewInt(1))
+	require.NoError(t, err)
+
+	digest, err := HashTypedDataV4(typed, big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, digest, analysis.Digest)
+	require.Equal(t, "Permit", analysis.PrimaryType)
+	require.Len(t, analysis.Warnings, 2)
+	require.Equal(t, WarningUnlimitedAllowance, analysis.Warnings[0].Type)
+	require.Equal(t, WarningNoExpiry, analysis.Warnings[1].Type)
+
+	analysis, err = AnalyzeV4(typed, big.NewInt(10))
+	require.NoError(t, err)
+	require.Equal(t, WarningChainMismatch, analysis.Warnings[2].Type)
+
+	typed.Message["value"] = "1000"
+	typed.Message["deadline"] = "1"
+	analysis, err = AnalyzeV4(typed, big.NewInt(1))
+	require.NoError(t, err)
+	require.Len(t, analysis.Warnings, 0)
+}
+
+func TestValidateV4(t *testing.T) {
+	var typed apitypes.TypedData
+	require.NoError(t, json.Unmarshal([]byte(permitTypedData), &typed))
+	require.NoError(t, ValidateV4(typed))
+
+	// Undeclared fields wouldn't be signed, the typed data is rejected
+	typed.Message["extra"] = "1"
+	require.Error(t, ValidateV4(typed))
+	_, err := AnalyzeV4(typed, big.NewInt(1))
+	require.Error(t, err)
+	_, err = HashTypedDataV4(typed, big.NewInt(1))
+	require.Error(t, err)
+	delete(typed.Message, "extra")
+
+	typed.Domain.Salt = "0x01"
+	require.Error(t, ValidateV4(typed))
+	typed.Domain.Salt = ""
+
+	typed.Types["Permit"] = append(typed.Types["Permit"], apitypes.Type{Name: "details", Type: "Details"})
+	require.Error(t, ValidateV4(typed))
+	typed.Types["Permit"] = typed.Types["Permit"][:len(typed.Types["Permit"])-1]
+
+	typed.PrimaryType = "Unknown"
+	require.Error(t, ValidateV4(typed))
+
+	typed.PrimaryType = "EIP712Domain"
+	require.Error(t, ValidateV4(typed))
+}
@@ -18,31 +18,56 @@ var (
 )
 
 func encodeData(typed TypedData) (rst common.Hash, err error) {
-	domainSeparator, err := hashStruct(eip712Domain, typed.Domain, typed.Types)
-	if err != nil {
-		return rst, err
-	}
-	primary, err := hashStruct(typed.PrimaryType, typed.Message, typed.Types)
+	domainSeparator, primary, err := hashes(typed)
 	if err != nil {
 		return rst, err
 	}
 	return crypto.Keccak256Hash(messagePadding, domainSeparator[:], primary[:]), nil
 }
 
-func encodeDataV4(typedData signercore.TypedData, chain *big.Int) ([]byte, error) {
-	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+func hashes(typed TypedData) (domainSeparator common.Hash, primary common.Hash, err error) {
+	domainSeparator, err = hashStruct(eip712Domain, typed.Domain, typed.Types)
 	if err != nil {
-		return nil, err
+		return
 	}
-	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	primary, err = hashStruct(typed.PrimaryType, typed.Message, typed.Types)
+	return
+}
+
+// Hashes returns the domain separator and the hash of the message, which
+// hardware wallets sign instead of the digest. Verify that chainId in the typed
+// data matches currently selected chain.
+func Hashes(typed TypedData, chain *big.Int) (domainSeparator common.Hash, primary common.Hash, err error) {
+	if err = typed.ValidateChainID(chain); err != nil {
+		return
+	}
+	return hashes(typed)
+}
+
+func encodeDataV4(typedData signercore.TypedData, chain *big.Int) ([]byte, error) {
+	domainSeparator, typedDataHash, err := HashesV4(typedData)
 	if err != nil {
 		return nil, err
 	}
-	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
+	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator[:]), string(typedDataHash[:])))
 	sighash := crypto.Keccak256(rawData)
 	return sighash, nil
 }
 
+// HashesV4 returns the domain separator and the hash of the message, which
+// hardware wallets sign instead of the digest
+func HashesV4(typedData signercore.TypedData) (domainSeparator common.Hash, primary common.Hash, err error) {
+	domain, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return
+	}
+	message, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return
+	}
+	return common.BytesToHash(domain), common.BytesToHash(message), nil
+}
+
 func HashTypedDataV4(typedData signercore.TypedData, chain *big.Int) (common.Hash, error) {
 	hashBytes, err := encodeDataV4(typedData, chain)
 	if err != nil {
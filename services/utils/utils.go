@@ -12,6 +12,9 @@ import (
 
 func GetSigner(chainID uint64, accountsManager *account.GethManager, keyStoreDir string, from types.Address, password string) bind.SignerFn {
 	return func(addr common.Address, tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+		if hardwareAccount := accountsManager.HardwareWallet().Account(common.Address(from)); hardwareAccount != nil {
+			return hardwareAccount.SignTx(tx, new(big.Int).SetUint64(chainID))
+		}
 		selectedAccount, err := accountsManager.VerifyAccountPassword(keyStoreDir, from.Hex(), password)
 		if err != nil {
 			return nil, err
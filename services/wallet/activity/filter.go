@@ -25,6 +25,9 @@ const (
 	BuyAT
 	SwapAT
 	BridgeAT
+	// ApproveAT and MintAT are only reported by the activity timeline
+	ApproveAT
+	MintAT
 )
 
 func allActivityTypesFilter() []Type {
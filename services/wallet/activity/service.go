@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/event"
@@ -43,6 +44,9 @@ type Service struct {
 	eventFeed    *event.Feed
 
 	scheduler *Scheduler
+
+	// timelineMutex prevents concurrent merges of the transfers in the timeline
+	timelineMutex sync.Mutex
 }
 
 func NewService(db *sql.DB, tokenManager *token.Manager, eventFeed *event.Feed) *Service {
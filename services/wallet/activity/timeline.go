@@ -0,0 +1,408 @@
+package activity
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/transfer"
+)
+
+// timelineSyncBatchSize is the number of transfers merged in the timeline in
+// a single database transaction
+const timelineSyncBatchSize = 500
+
+var (
+	// approve(address,uint256) of ERC20 and ERC721
+	approveMethodID = []byte{0x09, 0x5e, 0xa7, 0xb3}
+	// setApprovalForAll(address,bool) of ERC721 and ERC1155
+	setApprovalForAllMethodID = []byte{0xa2, 0x2c, 0xb4, 0x65}
+)
+
+// TimelineEntry is an entry of the activity timeline of an account. Bridge
+// and swap multi-transactions have an entry for each of their transfers, so
+// that they can be filtered by chain
+type TimelineEntry struct {
+	Account            eth.Address                     `json:"account"`
+	ChainID            common.ChainID                  `json:"chainId"`
+	Hash               eth.Hash                        `json:"hash"`
+	Type               Type                            `json:"type"`
+	Timestamp          int64                           `json:"timestamp"`
+	TokenType          TokenType                       `json:"tokenType"`
+	TokenAddress       *eth.Address                    `json:"tokenAddress,omitempty"`
+	TokenID            *hexutil.Big                    `json:"tokenId,omitempty"`
+	Amount             *hexutil.Big                    `json:"amount"`
+	Counterparty       *eth.Address                    `json:"counterparty,omitempty"`
+	MultiTransactionID transfer.MultiTransactionIDType `json:"multiTransactionId"`
+}
+
+// TimelineFilter selects the timeline entries matching any of the types, any
+// of the chains and any of the tokens. Empty fields don't filter
+type TimelineFilter struct {
+	Types    []Type           `json:"types"`
+	ChainIDs []common.ChainID `json:"chainIds"`
+	Tokens   []Token          `json:"tokens"`
+}
+
+type TimelineResponse struct {
+	Entries []TimelineEntry `json:"entries"`
+	Offset  int             `json:"offset"`
+	// Used to indicate that there might be more entries that were not returned
+	// based on a simple heuristic
+	HasMore bool `json:"hasMore"`
+}
+
+type timelineTransfer struct {
+	rowID              int64
+	account            []byte
+	chainID            uint64
+	hash               []byte
+	transferType       string
+	timestamp          int64
+	from               []byte
+	to                 []byte
+	tokenAddress       []byte
+	tokenID            []byte
+	amount             sql.NullString
+	multiTransactionID int64
+	multiTransaction   sql.NullInt64
+	tx                 []byte
+}
+
+func addressOrNil(address []byte) *eth.Address {
+	if len(address) == 0 {
+		return nil
+	}
+	result := eth.BytesToAddress(address)
+	return &result
+}
+
+func (t *timelineTransfer) toEntry() TimelineEntry {
+	entry := TimelineEntry{
+		Account:            eth.BytesToAddress(t.account),
+		ChainID:            common.ChainID(t.chainID),
+		Hash:               eth.BytesToHash(t.hash),
+		Timestamp:          t.timestamp,
+		MultiTransactionID: transfer.MultiTransactionIDType(t.multiTransactionID),
+	}
+
+	switch common.Type(t.transferType) {
+	case common.Erc20Transfer:
+		entry.TokenType = Erc20
+		entry.TokenAddress = addressOrNil(t.tokenAddress)
+	case common.Erc721Transfer:
+		entry.TokenType = Erc721
+		entry.TokenAddress = addressOrNil(t.tokenAddress)
+		if len(t.tokenID) > 0 {
+			entry.TokenID = (*hexutil.Big)(new(big.Int).SetBytes(t.tokenID))
+		}
+	default:
+		entry.TokenType = Native
+	}
+
+	if t.amount.Valid {
+		amount, ok := new(big.Int).SetString(t.amount.String, 16)
+		if ok {
+			entry.Amount = (*hexutil.Big)(amount)
+		}
+	}
+
+	sent := bytes.Equal(t.from, t.account)
+	if sent {
+		entry.Counterparty = addressOrNil(t.to)
+	} else {
+		entry.Counterparty = addressOrNil(t.from)
+	}
+
+	switch {
+	case t.multiTransaction.Valid && transfer.MultiTransactionType(t.multiTransaction.Int64) == transfer.MultiTransactionSwap:
+		entry.Type = SwapAT
+	case t.multiTransaction.Valid && transfer.MultiTransactionType(t.multiTransaction.Int64) == transfer.MultiTransactionBridge:
+		entry.Type = BridgeAT
+	case entry.TokenType == Erc721 && !sent && (entry.Counterparty == nil || *entry.Counterparty == eth.Address{}):
+		entry.Type = MintAT
+		entry.Counterparty = nil
+	case sent && entry.TokenType == Native && setApproval(&entry, t.tx):
+		entry.Type = ApproveAT
+	case sent:
+		entry.Type = SendAT
+	default:
+		entry.Type = ReceiveAT
+	}
+
+	return entry
+}
+
+// setApproval fills the entry with the token, spender and allowance of the
+// transaction if it's a token approval, and reports whether it is
+func setApproval(entry *TimelineEntry, txJSON []byte) bool {
+	if len(txJSON) == 0 {
+		return false
+	}
+	tx := &types.Transaction{}
+	if err := json.Unmarshal(txJSON, tx); err != nil || tx.To() == nil {
+		return false
+	}
+	data := tx.Data()
+	// method ID and two 32 bytes arguments
+	if len(data) < 68 {
+		return false
+	}
+
+	spender := eth.BytesToAddress(data[4:36])
+	tokenAddress := *tx.To()
+	switch {
+	case bytes.Equal(data[:4], approveMethodID):
+		entry.TokenType = Erc20
+		entry.Amount = (*hexutil.Big)(new(big.Int).SetBytes(data[36:68]))
+	case bytes.Equal(data[:4], setApprovalForAllMethodID):
+		entry.TokenType = Erc721
+		entry.Amount = (*hexutil.Big)(new(big.Int).SetBytes(data[36:68]))
+	default:
+		return false
+	}
+	entry.TokenAddress = &tokenAddress
+	entry.Counterparty = &spender
+	return true
+}
+
+func insertTimelineEntry(tx *sql.Tx, entry *TimelineEntry) error {
+	var tokenAddress, tokenID, amount, counterparty interface{}
+	if entry.TokenAddress != nil {
+		tokenAddress = entry.TokenAddress.Bytes()
+	}
+	if entry.TokenID != nil {
+		tokenID = (*big.Int)(entry.TokenID).Bytes()
+	}
+	if entry.Amount != nil {
+		amount = (*big.Int)(entry.Amount).Bytes()
+	}
+	if entry.Counterparty != nil {
+		counterparty = entry.Counterparty.Bytes()
+	}
+
+	_, err := tx.Exec(`INSERT OR REPLACE INTO activity_timeline
+		(account, network_id, hash, type, timestamp, token_type, token_address, token_id, amount, counterparty, multi_transaction_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		entry.Account.Bytes(), entry.ChainID, entry.Hash.Bytes(), entry.Type, entry.Timestamp, entry.TokenType,
+		tokenAddress, tokenID, amount, counterparty, entry.MultiTransactionID)
+	return err
+}
+
+// syncTimelineBatch merges in the timeline at most timelineSyncBatchSize of
+// the transfers loaded since the last call, and returns how many were merged.
+// The placeholders stored before a transfer is loaded are skipped, loading it
+// replaces them with a new row. Transfers are only removed on reorgs, which
+// store them again, so the entries left without a transfer are only looked
+// for among the hashes merged
+func syncTimelineBatch(ctx context.Context, db *sql.DB) (count int, err error) {
+	var lastRowID int64
+	err = db.QueryRowContext(ctx, `SELECT last_transfer_rowid FROM activity_timeline_sync WHERE id = 1`).Scan(&lastRowID)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT transfers.rowid, transfers.address, transfers.network_id, transfers.hash,
+			transfers.type, transfers.timestamp, transfers.tx_from_address, transfers.tx_to_address,
+			transfers.token_address, transfers.token_id, transfers.amount_padded128hex,
+			COALESCE(transfers.multi_transaction_id, 0), multi_transactions.type, transfers.tx
+		FROM transfers
+		LEFT JOIN multi_transactions ON transfers.multi_transaction_id != 0
+			AND transfers.multi_transaction_id = multi_transactions.ROWID
+		WHERE transfers.rowid > ? AND transfers.loaded = 1
+		ORDER BY transfers.rowid
+		LIMIT ?`, lastRowID, timelineSyncBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var transfers []*timelineTransfer
+	for rows.Next() {
+		t := &timelineTransfer{}
+		err = rows.Scan(&t.rowID, &t.account, &t.chainID, &t.hash, &t.transferType, &t.timestamp, &t.from, &t.to,
+			&t.tokenAddress, &t.tokenID, &t.amount, &t.multiTransactionID, &t.multiTransaction, &t.tx)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		transfers = append(transfers, t)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil || len(transfers) == 0 {
+		return 0, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	hashes := make([]interface{}, 0, len(transfers))
+	for _, t := range transfers {
+		entry := t.toEntry()
+		err = insertTimelineEntry(tx, &entry)
+		if err != nil {
+			return 0, err
+		}
+		hashes = append(hashes, t.hash)
+	}
+
+	_, err = tx.Exec(`DELETE FROM activity_timeline WHERE hash IN (`+placeholders(len(hashes))+`) AND NOT EXISTS (
+		SELECT 1 FROM transfers WHERE transfers.hash = activity_timeline.hash
+			AND transfers.address = activity_timeline.account
+			AND transfers.network_id = activity_timeline.network_id)`, hashes...)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(`INSERT OR REPLACE INTO activity_timeline_sync (id, last_transfer_rowid) VALUES (1, ?)`,
+		transfers[len(transfers)-1].rowID)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(transfers), nil
+}
+
+// syncTimeline merges the new transfers in the timeline
+func syncTimeline(ctx context.Context, db *sql.DB) error {
+	for {
+		count, err := syncTimelineBatch(ctx, db)
+		if err != nil {
+			return err
+		}
+		if count < timelineSyncBatchSize {
+			return nil
+		}
+	}
+}
+
+func placeholders(count int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", count), ",")
+}
+
+// getTimelineEntries returns the timeline entries of the addresses matching
+// the filter, newest first
+func getTimelineEntries(ctx context.Context, db *sql.DB, addresses []eth.Address, filter TimelineFilter, offset int, limit int) ([]TimelineEntry, error) {
+	var conditions []string
+	var args []interface{}
+
+	if len(addresses) > 0 {
+		conditions = append(conditions, "account IN ("+placeholders(len(addresses))+")")
+		for _, address := range addresses {
+			args = append(args, address.Bytes())
+		}
+	}
+
+	if len(filter.Types) > 0 {
+		conditions = append(conditions, "type IN ("+placeholders(len(filter.Types))+")")
+		for _, t := range filter.Types {
+			args = append(args, t)
+		}
+	}
+
+	if len(filter.ChainIDs) > 0 {
+		conditions = append(conditions, "network_id IN ("+placeholders(len(filter.ChainIDs))+")")
+		for _, chainID := range filter.ChainIDs {
+			args = append(args, chainID)
+		}
+	}
+
+	if len(filter.Tokens) > 0 {
+		tokenConditions := make([]string, 0, len(filter.Tokens))
+		for _, token := range filter.Tokens {
+			switch {
+			case token.TokenType == Native:
+				tokenConditions = append(tokenConditions, "token_type = ?")
+				args = append(args, Native)
+			case token.TokenID != nil:
+				tokenConditions = append(tokenConditions, "(token_address = ? AND token_id = ?)")
+				args = append(args, token.Address.Bytes(), (*hexutil.Big)(token.TokenID).ToInt().Bytes())
+			default:
+				tokenConditions = append(tokenConditions, "token_address = ?")
+				args = append(args, token.Address.Bytes())
+			}
+		}
+		conditions = append(conditions, "("+strings.Join(tokenConditions, " OR ")+")")
+	}
+
+	query := `SELECT account, network_id, hash, type, timestamp, token_type, token_address, token_id, amount,
+			counterparty, multi_transaction_id
+		FROM activity_timeline`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY timestamp DESC, hash DESC LIMIT ? OFFSET ?"
+	args = append(args, limit, offset)
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]TimelineEntry, 0, limit)
+	for rows.Next() {
+		var account, hash, tokenAddress, tokenID, amount, counterparty []byte
+		entry := TimelineEntry{}
+		err = rows.Scan(&account, &entry.ChainID, &hash, &entry.Type, &entry.Timestamp, &entry.TokenType,
+			&tokenAddress, &tokenID, &amount, &counterparty, &entry.MultiTransactionID)
+		if err != nil {
+			return nil, err
+		}
+
+		entry.Account = eth.BytesToAddress(account)
+		entry.Hash = eth.BytesToHash(hash)
+		entry.TokenAddress = addressOrNil(tokenAddress)
+		entry.Counterparty = addressOrNil(counterparty)
+		if tokenID != nil {
+			entry.TokenID = (*hexutil.Big)(new(big.Int).SetBytes(tokenID))
+		}
+		if amount != nil {
+			entry.Amount = (*hexutil.Big)(new(big.Int).SetBytes(amount))
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// GetTimeline merges the new transfers in the timeline, and returns a page of
+// the timeline of the addresses
+func (s *Service) GetTimeline(ctx context.Context, addresses []eth.Address, filter TimelineFilter, offset int, limit int) (*TimelineResponse, error) {
+	s.timelineMutex.Lock()
+	err := syncTimeline(ctx, s.db)
+	s.timelineMutex.Unlock()
+	if err != nil {
+		log.Error("failed to sync activity timeline", "err", err)
+		return nil, err
+	}
+
+	entries, err := getTimelineEntries(ctx, s.db, addresses, filter, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TimelineResponse{
+		Entries: entries,
+		Offset:  offset,
+		HasMore: len(entries) == limit,
+	}, nil
+}
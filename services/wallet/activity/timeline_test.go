@@ -0,0 +1,144 @@
+package activity
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/transfer"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTimelineEntries(t *testing.T) {
+	deps, close := setupTestActivityDB(t)
+	defer close()
+
+	trs, fromAddrs, _ := transfer.GenerateTestTransfers(t, deps.db, 0, 4)
+	account := fromAddrs[0]
+	ctx := context.Background()
+
+	// send of ETH on mainnet
+	trs[0].From = account
+	transfer.InsertTestTransfer(t, deps.db, account, &trs[0])
+
+	// receive of ETH on goerli
+	trs[1].To = account
+	transfer.InsertTestTransferWithOptions(t, deps.db, account, &trs[1], &transfer.TestTransferOptions{})
+
+	// bridge of USDC from mainnet
+	trs[3].From = account
+	bridge := transfer.GenerateTestBridgeMultiTransaction(trs[3], trs[3])
+	trs[3].MultiTransactionID = transfer.InsertTestMultiTransaction(t, deps.db, &bridge)
+	transfer.InsertTestTransfer(t, deps.db, account, &trs[3])
+
+	// approval of USDC, sent on optimism
+	spender := eth.HexToAddress("0x4")
+	tokenAddress := transfer.UsdcOptimism.Address
+	data := append([]byte{0x09, 0x5e, 0xa7, 0xb3}, eth.LeftPadBytes(spender.Bytes(), 32)...)
+	data = append(data, eth.LeftPadBytes(big.NewInt(100).Bytes(), 32)...)
+	approval := types.NewTransaction(0, tokenAddress, big.NewInt(0), 0, big.NewInt(0), data)
+	approvalJSON, err := json.Marshal(approval)
+	require.NoError(t, err)
+	trs[2].From = account
+	transfer.InsertTestTransferWithOptions(t, deps.db, account, &trs[2], &transfer.TestTransferOptions{})
+	_, err = deps.db.Exec(`UPDATE transfers SET tx = ? WHERE hash = ?`, approvalJSON, trs[2].Hash)
+	require.NoError(t, err)
+
+	s := &Service{db: deps.db}
+	res, err := s.GetTimeline(ctx, []eth.Address{account}, TimelineFilter{}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(res.Entries))
+	require.False(t, res.HasMore)
+
+	// newest first
+	require.Equal(t, trs[3].Hash, res.Entries[0].Hash)
+	require.Equal(t, BridgeAT, res.Entries[0].Type)
+	require.Equal(t, trs[3].MultiTransactionID, res.Entries[0].MultiTransactionID)
+
+	require.Equal(t, ApproveAT, res.Entries[1].Type)
+	require.Equal(t, Erc20, res.Entries[1].TokenType)
+	require.Equal(t, tokenAddress, *res.Entries[1].TokenAddress)
+	require.Equal(t, spender, *res.Entries[1].Counterparty)
+	require.Equal(t, int64(100), res.Entries[1].Amount.ToInt().Int64())
+
+	require.Equal(t, ReceiveAT, res.Entries[2].Type)
+	require.Equal(t, trs[1].From, *res.Entries[2].Counterparty)
+
+	require.Equal(t, SendAT, res.Entries[3].Type)
+	require.Equal(t, Native, res.Entries[3].TokenType)
+	require.Equal(t, trs[0].Value, res.Entries[3].Amount.ToInt().Int64())
+
+	// Filter by type
+	res, err = s.GetTimeline(ctx, []eth.Address{account}, TimelineFilter{Types: []Type{SendAT, ReceiveAT}}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Entries))
+
+	// Filter by chain
+	res, err = s.GetTimeline(ctx, []eth.Address{account}, TimelineFilter{ChainIDs: []common.ChainID{trs[1].ChainID}}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Entries))
+	require.Equal(t, trs[1].Hash, res.Entries[0].Hash)
+
+	// Filter by token
+	res, err = s.GetTimeline(ctx, []eth.Address{account}, TimelineFilter{Tokens: []Token{{TokenType: Erc20, Address: tokenAddress}}}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Entries))
+	require.Equal(t, ApproveAT, res.Entries[0].Type)
+
+	// Pagination
+	res, err = s.GetTimeline(ctx, []eth.Address{account}, TimelineFilter{}, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Entries))
+	require.True(t, res.HasMore)
+	require.Equal(t, ApproveAT, res.Entries[0].Type)
+
+	// Transfers removed by a reorg are removed from the timeline when the
+	// transaction is stored again
+	_, err = deps.db.Exec(`DELETE FROM transfers WHERE hash = ?`, trs[0].Hash)
+	require.NoError(t, err)
+	transfer.InsertTestTransferWithOptions(t, deps.db, trs[0].To, &trs[0], &transfer.TestTransferOptions{})
+	trs[1].Hash = eth.HexToHash("0x5")
+	transfer.InsertTestTransferWithOptions(t, deps.db, account, &trs[1], &transfer.TestTransferOptions{})
+
+	res, err = s.GetTimeline(ctx, []eth.Address{account}, TimelineFilter{Types: []Type{SendAT, ReceiveAT}}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Entries))
+	require.Equal(t, ReceiveAT, res.Entries[0].Type)
+	require.Equal(t, ReceiveAT, res.Entries[1].Type)
+}
+
+func TestGetTimelineEntriesSkipsPlaceholders(t *testing.T) {
+	deps, close := setupTestActivityDB(t)
+	defer close()
+
+	trs, fromAddrs, _ := transfer.GenerateTestTransfers(t, deps.db, 0, 1)
+	account := fromAddrs[0]
+	ctx := context.Background()
+
+	// The transfer isn't loaded yet
+	trs[0].From = account
+	transfer.InsertTestTransfer(t, deps.db, account, &trs[0])
+	_, err := deps.db.Exec(`UPDATE transfers SET loaded = 0, timestamp = 0 WHERE hash = ?`, trs[0].Hash)
+	require.NoError(t, err)
+
+	s := &Service{db: deps.db}
+	res, err := s.GetTimeline(ctx, []eth.Address{account}, TimelineFilter{}, 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+
+	// Loading the transfer replaces the placeholder
+	transfer.InsertTestTransfer(t, deps.db, account, &trs[0])
+
+	res, err = s.GetTimeline(ctx, []eth.Address{account}, TimelineFilter{}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Entries))
+	require.Equal(t, trs[0].Hash, res.Entries[0].Hash)
+	require.Equal(t, trs[0].Timestamp, res.Entries[0].Timestamp)
+	require.Equal(t, trs[0].Value, res.Entries[0].Amount.ToInt().Int64())
+}
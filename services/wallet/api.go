@@ -9,16 +9,29 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
+	signercore "github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/status-im/status-go/account"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/services/typeddata"
 	"github.com/status-im/status-go/services/wallet/activity"
+	"github.com/status-im/status-go/services/wallet/approvals"
 	"github.com/status-im/status-go/services/wallet/bridge"
+	"github.com/status-im/status-go/services/wallet/collectibles"
 	"github.com/status-im/status-go/services/wallet/currency"
+	"github.com/status-im/status-go/services/wallet/erc4337"
+	"github.com/status-im/status-go/services/wallet/gasoracle"
+	"github.com/status-im/status-go/services/wallet/hardware"
 	"github.com/status-im/status-go/services/wallet/history"
+	"github.com/status-im/status-go/services/wallet/pendingtx"
+	"github.com/status-im/status-go/services/wallet/simulation"
+	"github.com/status-im/status-go/services/wallet/spam"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 	"github.com/status-im/status-go/services/wallet/thirdparty/opensea"
 	"github.com/status-im/status-go/services/wallet/token"
 	"github.com/status-im/status-go/services/wallet/transfer"
+	"github.com/status-im/status-go/transactions"
 
 	wcommon "github.com/status-im/status-go/services/wallet/common"
 )
@@ -47,6 +60,22 @@ func (api *API) GetWalletToken(ctx context.Context, addresses []common.Address)
 	return api.reader.GetWalletToken(ctx, addresses)
 }
 
+// GetPortfolio returns the native, ERC20 and collectible balances of the
+// addresses on the given chains, valued in the currency of the user. The
+// portfolio is cached and refreshed in the background
+func (api *API) GetPortfolio(ctx context.Context, addresses []common.Address, chainIDs []uint64) (*Portfolio, error) {
+	log.Debug("call to GetPortfolio")
+	return api.s.portfolioManager.GetPortfolio(ctx, addresses, chainIDs)
+}
+
+// GetTreasury returns the native and ERC20 balances of the addresses on the
+// given chains, summed per token and chain and valued in the currency of the
+// user
+func (api *API) GetTreasury(ctx context.Context, addresses []common.Address, chainIDs []uint64) (*Treasury, error) {
+	log.Debug("call to GetTreasury")
+	return api.s.portfolioManager.GetTreasury(ctx, addresses, chainIDs)
+}
+
 func (api *API) GetCachedWalletTokensWithoutMarketData(ctx context.Context) (map[common.Address][]Token, error) {
 	return api.reader.GetCachedWalletTokensWithoutMarketData()
 }
@@ -59,6 +88,30 @@ type DerivedAddress struct {
 	AlreadyCreated bool           `json:"alreadyCreated"`
 }
 
+// ListApprovals returns the active ERC20 allowances, ERC721 token approvals
+// and operator approvals given by the addresses on the given chains
+func (api *API) ListApprovals(ctx context.Context, addresses []common.Address, chainIDs []uint64) ([]*approvals.Approval, error) {
+	log.Debug("call to ListApprovals", "addresses.count", len(addresses), "chainIDs.count", len(chainIDs))
+	return api.s.approvalsManager.ListApprovals(addresses, chainIDs)
+}
+
+// BuildRevokeTransaction returns the arguments of the transaction removing
+// the approval, which is then signed and sent like any other transaction
+func (api *API) BuildRevokeTransaction(ctx context.Context, approval approvals.Approval) (*transactions.SendTxArgs, error) {
+	log.Debug("call to BuildRevokeTransaction", "chainID", approval.ChainID, "token", approval.TokenAddress, "spender", approval.Spender)
+	args, err := approvals.BuildRevokeTransaction(approval)
+	if err != nil {
+		return nil, err
+	}
+
+	fees, err := api.s.gasOracle.SuggestFees(ctx, approval.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	fees.FillTxArgs(args, gasoracle.FeeLevelMedium)
+	return args, nil
+}
+
 // SetInitialBlocksRange sets initial blocks range
 func (api *API) SetInitialBlocksRange(ctx context.Context) error {
 	return api.s.transferController.SetInitialBlocksRange([]uint64{api.s.rpcClient.UpstreamChainID})
@@ -112,12 +165,21 @@ func (api *API) GetTransfersForIdentities(ctx context.Context, identities []tran
 	return api.s.transferController.GetTransfersForIdentities(ctx, identities)
 }
 
+// Deprecated: FetchDecodedTxData is deprecated. Use DecodeTransactionData instead
 func (api *API) FetchDecodedTxData(ctx context.Context, data string) (*thirdparty.DataParsed, error) {
 	log.Debug("[Wallet: FetchDecodedTxData]")
 
 	return api.s.decoder.Decode(data)
 }
 
+// DecodeTransactionData decodes the calldata of a transaction, locally for the
+// known contracts and with the 4byte signature directories otherwise
+func (api *API) DecodeTransactionData(ctx context.Context, data string) (*thirdparty.DataParsed, error) {
+	log.Debug("call to DecodeTransactionData")
+
+	return api.s.decoder.Decode(data)
+}
+
 // Deprecated: GetCachedBalances is deprecated. Use GetTokensBalances instead
 func (api *API) GetCachedBalances(ctx context.Context, addresses []common.Address) ([]transfer.BlockView, error) {
 	return api.s.transferController.GetCachedBalances(ctx, api.s.rpcClient.UpstreamChainID, addresses)
@@ -170,6 +232,13 @@ func (api *API) GetCustomTokens(ctx context.Context) ([]*token.Token, error) {
 	return rst, err
 }
 
+// GetTokenLists returns the token lists fetched from the configured URLs,
+// with their versions
+func (api *API) GetTokenLists(ctx context.Context) ([]*token.TokenListInfo, error) {
+	log.Debug("call to get token lists")
+	return api.s.tokenListsManager.GetTokenLists()
+}
+
 func (api *API) DiscoverToken(ctx context.Context, chainID uint64, address common.Address) (*token.Token, error) {
 	log.Debug("call to get discover token")
 	token, err := api.s.tokenManager.DiscoverToken(ctx, chainID, address)
@@ -192,33 +261,39 @@ func (api *API) ToggleVisibleToken(ctx context.Context, chainID uint64, address
 	return true, nil
 }
 
-func (api *API) AddCustomToken(ctx context.Context, token token.Token) error {
+func (api *API) AddCustomToken(ctx context.Context, t token.Token) error {
 	log.Debug("call to create or edit custom token")
-	if token.ChainID == 0 {
-		token.ChainID = api.s.rpcClient.UpstreamChainID
+	if t.ChainID == 0 {
+		t.ChainID = api.s.rpcClient.UpstreamChainID
 	}
-	err := api.s.tokenManager.UpsertCustom(token)
+	err := api.s.saveCustomToken(&token.CustomToken{Token: t})
 	log.Debug("result from database for create or edit custom token", "err", err)
 	return err
 }
 
 func (api *API) DeleteCustomToken(ctx context.Context, address common.Address) error {
-	log.Debug("call to remove custom token")
-	err := api.s.tokenManager.DeleteCustom(api.s.rpcClient.UpstreamChainID, address)
-	log.Debug("result from database for remove custom token", "err", err)
-	return err
+	return api.DeleteCustomTokenByChainID(ctx, api.s.rpcClient.UpstreamChainID, address)
 }
 
 func (api *API) DeleteCustomTokenByChainID(ctx context.Context, chainID uint64, address common.Address) error {
 	log.Debug("call to remove custom token")
-	err := api.s.tokenManager.DeleteCustom(chainID, address)
+	err := api.s.saveCustomToken(&token.CustomToken{
+		Token:   token.Token{Address: address, ChainID: chainID},
+		Removed: true,
+	})
 	log.Debug("result from database for remove custom token", "err", err)
 	return err
 }
 
+// GetSavedAddresses returns the saved addresses of the active mode, the
+// testnet ones when the testnet mode is on
 func (api *API) GetSavedAddresses(ctx context.Context) ([]SavedAddress, error) {
 	log.Debug("call to get saved addresses")
-	rst, err := api.s.savedAddressesManager.GetSavedAddresses()
+	areTestNetworksEnabled, err := api.s.accountsDB.GetTestNetworksEnabled()
+	if err != nil {
+		return nil, err
+	}
+	rst, err := api.s.savedAddressesManager.GetSavedAddressesPerMode(areTestNetworksEnabled)
 	log.Debug("result from database for saved addresses", "len", len(rst))
 	return rst, err
 }
@@ -303,6 +378,28 @@ func (api *API) DeletePendingTransactionByChainID(ctx context.Context, chainID u
 	return err
 }
 
+// GetTrackedPendingTransactions returns the pending transactions sent from
+// this device on the chains, whichever service sent them
+func (api *API) GetTrackedPendingTransactions(ctx context.Context, chainIDs []uint64) ([]*pendingtx.TrackedTransaction, error) {
+	log.Debug("call to GetTrackedPendingTransactions", "chainIDs.count", len(chainIDs))
+	return api.s.pendingTxTracker.GetPending(chainIDs)
+}
+
+// BuildSpeedUp returns the arguments of the transaction replacing the pending
+// one with higher fees, which is then signed and sent like any other
+// transaction
+func (api *API) BuildSpeedUp(ctx context.Context, chainID uint64, transactionHash common.Hash) (*transactions.SendTxArgs, error) {
+	log.Debug("call to BuildSpeedUp", "chainID", chainID, "hash", transactionHash)
+	return api.s.pendingTxTracker.BuildSpeedUp(ctx, chainID, transactionHash)
+}
+
+// BuildCancel returns the arguments of the transaction cancelling the pending
+// one, which is then signed and sent like any other transaction
+func (api *API) BuildCancel(ctx context.Context, chainID uint64, transactionHash common.Hash) (*transactions.SendTxArgs, error) {
+	log.Debug("call to BuildCancel", "chainID", chainID, "hash", transactionHash)
+	return api.s.pendingTxTracker.BuildCancel(ctx, chainID, transactionHash)
+}
+
 func (api *API) WatchTransaction(ctx context.Context, transactionHash common.Hash) error {
 	chainClient, err := api.s.rpcClient.EthClient(api.s.rpcClient.UpstreamChainID)
 	if err != nil {
@@ -339,17 +436,29 @@ func (api *API) GetOpenseaAssetsByOwnerAndCollection(ctx context.Context, chainI
 
 func (api *API) GetOpenseaAssetsByOwnerAndCollectionWithCursor(ctx context.Context, chainID uint64, owner common.Address, collectionSlug string, cursor string, limit int) (*opensea.AssetContainer, error) {
 	log.Debug("call to get opensea assets")
-	return api.s.collectiblesManager.FetchAllAssetsByOwnerAndCollection(chainID, owner, collectionSlug, cursor, limit)
+	container, err := api.s.collectiblesManager.FetchAllAssetsByOwnerAndCollection(chainID, owner, collectionSlug, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	return api.s.filterSpamAssets(chainID, container), nil
 }
 
 func (api *API) GetOpenseaAssetsByOwnerWithCursor(ctx context.Context, chainID uint64, owner common.Address, cursor string, limit int) (*opensea.AssetContainer, error) {
 	log.Debug("call to FetchAllAssetsByOwner")
-	return api.s.collectiblesManager.FetchAllAssetsByOwner(chainID, owner, cursor, limit)
+	container, err := api.s.collectiblesManager.FetchAllAssetsByOwner(chainID, owner, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	return api.s.filterSpamAssets(chainID, container), nil
 }
 
 func (api *API) GetOpenseaAssetsByOwnerAndContractAddressWithCursor(ctx context.Context, chainID uint64, owner common.Address, contractAddresses []common.Address, cursor string, limit int) (*opensea.AssetContainer, error) {
 	log.Debug("call to GetOpenseaAssetsByOwnerAndContractAddressWithCursor")
-	return api.s.collectiblesManager.FetchAllAssetsByOwnerAndContractAddress(chainID, owner, contractAddresses, cursor, limit)
+	container, err := api.s.collectiblesManager.FetchAllAssetsByOwnerAndContractAddress(chainID, owner, contractAddresses, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	return api.s.filterSpamAssets(chainID, container), nil
 }
 
 func (api *API) GetOpenseaAssetsByNFTUniqueID(ctx context.Context, chainID uint64, uniqueIDs []thirdparty.NFTUniqueID, limit int) (*opensea.AssetContainer, error) {
@@ -362,9 +471,98 @@ func (api *API) GetCollectibleOwnersByContractAddress(chainID uint64, contractAd
 	return api.s.collectiblesManager.FetchNFTOwnersByContractAddress(chainID, contractAddress)
 }
 
+// GetOwnedCollectibles returns the collectibles owned by the address from the
+// cache, updated with the transfers since it was last synced
+func (api *API) GetOwnedCollectibles(ctx context.Context, chainID uint64, owner common.Address) (*collectibles.OwnedCollectibles, error) {
+	log.Debug("call to GetOwnedCollectibles")
+	owned, err := api.s.collectiblesManager.GetOwnedCollectibles(ctx, chainID, owner)
+	if err != nil {
+		return nil, err
+	}
+	return api.s.filterSpamOwnedCollectibles(owned), nil
+}
+
+// ForceRefreshOwnedCollectibles fetches the collectibles owned by the address
+// from the providers instead of updating the cache
+func (api *API) ForceRefreshOwnedCollectibles(ctx context.Context, chainID uint64, owner common.Address) (*collectibles.OwnedCollectibles, error) {
+	log.Debug("call to ForceRefreshOwnedCollectibles")
+	owned, err := api.s.collectiblesManager.ForceRefreshOwnedCollectibles(ctx, chainID, owner)
+	if err != nil {
+		return nil, err
+	}
+	return api.s.filterSpamOwnedCollectibles(owned), nil
+}
+
+// ClassifyToken returns whether a token, or a collectible when tokenID is set,
+// is considered spam
+func (api *API) ClassifyToken(ctx context.Context, chainID uint64, address common.Address, tokenID *hexutil.Big) (spam.Result, error) {
+	log.Debug("call to ClassifyToken")
+	asset := spam.Asset{
+		ChainID: chainID,
+		Address: address,
+		TokenID: tokenID.ToInt(),
+		Listed:  api.s.tokenManager.IsListed(chainID, address),
+		// The market data is looked up by symbol, a price found for an
+		// unlisted token is likely the one of the token it impersonates
+		HasMarket: tokenID != nil,
+	}
+	if asset.Listed {
+		asset.HasMarket = true
+	}
+
+	t := api.s.tokenManager.FindTokenByAddress(chainID, address)
+	if t == nil && tokenID == nil {
+		t, _ = api.s.tokenManager.DiscoverToken(ctx, chainID, address)
+	}
+	if t != nil {
+		asset.Name = t.Name
+		asset.Symbol = t.Symbol
+	}
+	return api.s.spamManager.Classify(asset), nil
+}
+
+// HideToken hides a token, or a collectible when tokenID is set, from the
+// balances and collectibles
+func (api *API) HideToken(ctx context.Context, chainID uint64, address common.Address, tokenID *hexutil.Big) error {
+	log.Debug("call to HideToken")
+	return api.s.spamManager.Hide(chainID, address, tokenID.ToInt())
+}
+
+// ReportSpamToken hides a token, or a collectible when tokenID is set, and
+// records it as spam
+func (api *API) ReportSpamToken(ctx context.Context, chainID uint64, address common.Address, tokenID *hexutil.Big) error {
+	log.Debug("call to ReportSpamToken")
+	return api.s.spamManager.Report(chainID, address, tokenID.ToInt())
+}
+
+// MarkTokenNotSpam shows a token, or a collectible when tokenID is set, even
+// when classified as spam
+func (api *API) MarkTokenNotSpam(ctx context.Context, chainID uint64, address common.Address, tokenID *hexutil.Big) error {
+	log.Debug("call to MarkTokenNotSpam")
+	return api.s.spamManager.MarkNotSpam(chainID, address, tokenID.ToInt())
+}
+
+// UnhideToken lets the heuristics classify a token, or a collectible when
+// tokenID is set, again
+func (api *API) UnhideToken(ctx context.Context, chainID uint64, address common.Address, tokenID *hexutil.Big) error {
+	log.Debug("call to UnhideToken")
+	return api.s.spamManager.Unhide(chainID, address, tokenID.ToInt())
+}
+
+// GetHiddenTokens returns the tokens and collectibles hidden, reported or
+// marked as not spam by the user
+func (api *API) GetHiddenTokens(ctx context.Context) ([]*spam.Override, error) {
+	log.Debug("call to GetHiddenTokens")
+	return api.s.spamManager.GetOverrides()
+}
+
 func (api *API) AddEthereumChain(ctx context.Context, network params.Network) error {
 	log.Debug("call to AddEthereumChain")
-	return api.s.rpcClient.NetworkManager.Upsert(&network)
+	err := api.s.rpcClient.NetworkManager.Upsert(&network)
+	if err != nil {
+		return err
+	}
+	return api.s.rpcClient.ReloadEndpoints(network.ChainID)
 }
 
 func (api *API) DeleteEthereumChain(ctx context.Context, chainID uint64) error {
@@ -372,6 +570,44 @@ func (api *API) DeleteEthereumChain(ctx context.Context, chainID uint64) error {
 	return api.s.rpcClient.NetworkManager.Delete(chainID)
 }
 
+// AddCustomRPCURL adds an RPC endpoint to the chain, the endpoints are used
+// in the order of their health checks
+func (api *API) AddCustomRPCURL(ctx context.Context, chainID uint64, url string) error {
+	log.Debug("call to AddCustomRPCURL")
+	return api.s.rpcClient.AddCustomRPCURL(ctx, chainID, url)
+}
+
+func (api *API) UpdateCustomRPCURL(ctx context.Context, chainID uint64, oldURL string, newURL string) error {
+	log.Debug("call to UpdateCustomRPCURL")
+	return api.s.rpcClient.UpdateCustomRPCURL(ctx, chainID, oldURL, newURL)
+}
+
+func (api *API) RemoveCustomRPCURL(ctx context.Context, chainID uint64, url string) error {
+	log.Debug("call to RemoveCustomRPCURL")
+	return api.s.rpcClient.RemoveCustomRPCURL(ctx, chainID, url)
+}
+
+// GetRPCEndpoints returns the endpoints of the chain in the order they are
+// used, with the latency and health of their last check
+func (api *API) GetRPCEndpoints(ctx context.Context, chainID uint64) ([]*rpc.EndpointStats, error) {
+	log.Debug("call to GetRPCEndpoints")
+	return api.s.rpcClient.GetEndpoints(chainID)
+}
+
+// CheckRPCEndpoints checks the endpoints of the chain now instead of waiting
+// for the next periodic check
+func (api *API) CheckRPCEndpoints(ctx context.Context, chainID uint64) ([]*rpc.EndpointStats, error) {
+	log.Debug("call to CheckRPCEndpoints")
+	return api.s.rpcClient.CheckEndpoints(ctx, chainID)
+}
+
+// GetRPCProvidersStatus returns the state of the RPC providers of the chains
+// in use, a degraded chain is served by its fallback provider
+func (api *API) GetRPCProvidersStatus(ctx context.Context) ([]*rpc.ChainStatus, error) {
+	log.Debug("call to GetRPCProvidersStatus")
+	return api.s.rpcClient.GetProvidersStatus(), nil
+}
+
 func (api *API) GetEthereumChains(ctx context.Context, onlyEnabled bool) ([]*params.Network, error) {
 	log.Debug("call to GetEthereumChains")
 	return api.s.rpcClient.NetworkManager.Get(onlyEnabled)
@@ -397,11 +633,125 @@ func (api *API) GetDailyMarketValues(ctx context.Context, symbol string, currenc
 	return api.s.marketManager.FetchHistoricalDailyPrices(symbol, currency, limit, allData, aggregate)
 }
 
+// FetchHistoricalCandles returns the open, high, low and close prices of the
+// token for charting, per hour or per day
+func (api *API) FetchHistoricalCandles(ctx context.Context, symbol string, currency string, interval thirdparty.CandleInterval, limit int) ([]thirdparty.HistoricalCandle, error) {
+	log.Debug("call to FetchHistoricalCandles")
+	return api.s.marketManager.FetchHistoricalCandles(symbol, currency, interval, limit)
+}
+
 func (api *API) FetchTokenDetails(ctx context.Context, symbols []string) (map[string]thirdparty.TokenDetails, error) {
 	log.Debug("call to FetchTokenDetails")
 	return api.s.marketManager.FetchTokenDetails(symbols)
 }
 
+// SuggestFees returns the low, medium and high EIP-1559 fees of the chain,
+// based on its recent fee history, with their estimated confirmation times
+func (api *API) SuggestFees(ctx context.Context, chainID uint64) (*gasoracle.FeeSuggestions, error) {
+	log.Debug("call to SuggestFees", "chainID", chainID)
+	return api.s.gasOracle.SuggestFees(ctx, chainID)
+}
+
+// SimulateTransaction runs the transaction against the latest state of the
+// chain without sending it and returns its outcome, balance and allowance
+// changes and risks, to be shown before the user signs
+func (api *API) SimulateTransaction(ctx context.Context, chainID uint64, args transactions.SendTxArgs) (*simulation.Result, error) {
+	log.Debug("call to SimulateTransaction", "chainID", chainID, "from", args.From, "to", args.To)
+	return api.s.simulator.SimulateTransaction(ctx, chainID, args)
+}
+
+// AnalyzeTypedData validates EIP-712 typed data against its schema and returns
+// its digest with the warnings to show before the user signs it
+func (api *API) AnalyzeTypedData(ctx context.Context, chainID uint64, typed signercore.TypedData) (*typeddata.Analysis, error) {
+	log.Debug("call to AnalyzeTypedData", "chainID", chainID, "primaryType", typed.PrimaryType)
+	return typeddata.AnalyzeV4(typed, new(big.Int).SetUint64(chainID))
+}
+
+// GetLedgerAddresses returns count addresses of the connected Ledger, derived
+// under the base path from the index start
+func (api *API) GetLedgerAddresses(ctx context.Context, basePath string, start uint32, count uint32) ([]account.DerivedAddress, error) {
+	log.Debug("call to GetLedgerAddresses", "basePath", basePath, "start", start, "count", count)
+	return api.s.hardwareWallets.DeriveAddresses(basePath, start, count)
+}
+
+// AddLedgerAccount adds the wallet account of the path with the name once its
+// address is confirmed on the device, its transactions are then signed by the
+// Ledger
+func (api *API) AddLedgerAccount(ctx context.Context, path string, name string) (common.Address, error) {
+	log.Debug("call to AddLedgerAccount", "path", path, "name", name)
+	return api.s.hardwareWallets.AddAccount(path, name)
+}
+
+func (api *API) RemoveLedgerAccount(ctx context.Context, address common.Address) error {
+	log.Debug("call to RemoveLedgerAccount", "address", address)
+	return api.s.hardwareWallets.RemoveAccount(address)
+}
+
+func (api *API) GetLedgerAccounts(ctx context.Context) ([]*hardware.Account, error) {
+	log.Debug("call to GetLedgerAccounts")
+	return api.s.hardwareWallets.GetAccounts()
+}
+
+// GetSmartAccount returns the ERC-4337 smart account of the owner, which can
+// be used before it's deployed
+func (api *API) GetSmartAccount(ctx context.Context, chainID uint64, owner common.Address) (*erc4337.SmartAccount, error) {
+	log.Debug("call to GetSmartAccount", "chainID", chainID, "owner", owner)
+	return api.s.userOperations.GetSmartAccount(ctx, chainID, owner)
+}
+
+// DeploySmartAccount deploys the smart account of the owner, with the gas paid
+// by the paymaster of the chain if sponsored
+func (api *API) DeploySmartAccount(ctx context.Context, chainID uint64, owner common.Address, password string, sponsored bool) (common.Hash, error) {
+	log.Debug("call to DeploySmartAccount", "chainID", chainID, "owner", owner, "sponsored", sponsored)
+	return api.s.userOperations.DeploySmartAccount(ctx, chainID, owner, password, sponsored)
+}
+
+// BuildUserOperation returns the unsigned user operation of the smart account
+// of the owner making the calls
+func (api *API) BuildUserOperation(ctx context.Context, chainID uint64, owner common.Address, calls []erc4337.Call, sponsored bool) (*erc4337.UserOperation, error) {
+	log.Debug("call to BuildUserOperation", "chainID", chainID, "owner", owner, "sponsored", sponsored)
+	return api.s.userOperations.BuildUserOperation(ctx, chainID, owner, calls, sponsored)
+}
+
+// BuildTransferUserOperation returns the unsigned user operation transferring
+// the amount of the token, or of the native currency if token is nil, from the
+// smart account of the owner
+func (api *API) BuildTransferUserOperation(ctx context.Context, chainID uint64, owner common.Address, token *common.Address, to common.Address, amount *hexutil.Big, sponsored bool) (*erc4337.UserOperation, error) {
+	log.Debug("call to BuildTransferUserOperation", "chainID", chainID, "owner", owner, "to", to)
+	call, err := erc4337.TransferCall(token, to, amount.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	return api.s.userOperations.BuildUserOperation(ctx, chainID, owner, []erc4337.Call{call}, sponsored)
+}
+
+// BuildMintUserOperation returns the unsigned user operation minting community
+// tokens of the contract owned by the smart account of the owner
+func (api *API) BuildMintUserOperation(ctx context.Context, chainID uint64, owner common.Address, contract common.Address, collectibles bool, addresses []common.Address, amounts []*hexutil.Big, sponsored bool) (*erc4337.UserOperation, error) {
+	log.Debug("call to BuildMintUserOperation", "chainID", chainID, "owner", owner, "contract", contract)
+	intAmounts := make([]*big.Int, 0, len(amounts))
+	for _, amount := range amounts {
+		intAmounts = append(intAmounts, amount.ToInt())
+	}
+	call, err := erc4337.MintCall(contract, collectibles, addresses, intAmounts)
+	if err != nil {
+		return nil, err
+	}
+	return api.s.userOperations.BuildUserOperation(ctx, chainID, owner, []erc4337.Call{call}, sponsored)
+}
+
+// SendUserOperation signs a user operation built for the owner and sends it to
+// the bundler of the chain, its status is tracked until it's included
+func (api *API) SendUserOperation(ctx context.Context, chainID uint64, owner common.Address, op erc4337.UserOperation, password string) (common.Hash, error) {
+	log.Debug("call to SendUserOperation", "chainID", chainID, "owner", owner, "sender", op.Sender)
+	return api.s.userOperations.SendUserOperation(ctx, chainID, owner, &op, password)
+}
+
+func (api *API) GetUserOperations(ctx context.Context, owners []common.Address) ([]*erc4337.TrackedUserOperation, error) {
+	log.Debug("call to GetUserOperations", "owners", owners)
+	return api.s.userOperations.GetUserOperations(owners)
+}
+
 func (api *API) GetSuggestedFees(ctx context.Context, chainID uint64) (*SuggestedFees, error) {
 	log.Debug("call to GetSuggestedFees")
 	return api.s.feesManager.suggestedFees(ctx, chainID)
@@ -428,6 +778,13 @@ func (api *API) GetSuggestedRoutes(
 	return api.router.suggestedRoutes(ctx, sendType, account, amountIn.ToInt(), tokenSymbol, disabledFromChainIDs, disabledToChaindIDs, preferedChainIDs, gasFeeMode, fromLockedAmount)
 }
 
+// GetSendPlan returns the best route to send the amount of the request to its
+// recipient, along with the transactions to pass to CreateMultiTransaction
+func (api *API) GetSendPlan(ctx context.Context, request *SendRequest) (*SendPlan, error) {
+	log.Debug("call to GetSendPlan")
+	return api.router.planSend(ctx, request)
+}
+
 // Generates addresses for the provided paths, response doesn't include `HasActivity` value (if you need it check `GetAddressDetails` function)
 func (api *API) GetDerivedAddresses(ctx context.Context, password string, derivedFrom string, paths []string) ([]*DerivedAddress, error) {
 	info, err := api.s.gethManager.AccountsGenerator().LoadAccount(derivedFrom, password)
@@ -554,3 +911,11 @@ func (api *API) GetOldestActivityTimestampAsync(ctx context.Context, addresses [
 	api.s.activity.GetOldestTimestampAsync(ctx, addresses)
 	return nil
 }
+
+// GetActivityTimeline returns a page of the unified activity timeline of the
+// addresses across all chains, newest first
+func (api *API) GetActivityTimeline(ctx context.Context, addresses []common.Address, filter activity.TimelineFilter, offset int, limit int) (*activity.TimelineResponse, error) {
+	log.Debug("wallet.api.GetActivityTimeline", "addr.count", len(addresses), "offset", offset, "limit", limit)
+
+	return api.s.activity.GetTimeline(ctx, addresses, filter, offset, limit)
+}
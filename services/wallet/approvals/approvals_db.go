@@ -0,0 +1,131 @@
+package approvals
+
+import (
+	"database/sql"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type ApprovalsDB struct {
+	db *sql.DB
+}
+
+func NewApprovalsDB(sqlDb *sql.DB) *ApprovalsDB {
+	return &ApprovalsDB{
+		db: sqlDb,
+	}
+}
+
+func tokenIDBytes(approval *Approval) []byte {
+	if approval.TokenID == nil {
+		return []byte{}
+	}
+	return approval.TokenID.ToInt().Bytes()
+}
+
+func applyApproval(tx *sql.Tx, approval *Approval) error {
+	tokenID := tokenIDBytes(approval)
+
+	if approval.Type == Erc721TokenApproval {
+		// A token has at most one approved address, a new approval replaces it
+		_, err := tx.Exec(`DELETE FROM token_approvals WHERE chain_id = ? AND owner = ? AND token_address = ? AND type = ? AND token_id = ?`,
+			approval.ChainID, approval.Owner, approval.TokenAddress, approval.Type, tokenID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !approval.active() {
+		_, err := tx.Exec(`DELETE FROM token_approvals WHERE chain_id = ? AND owner = ? AND token_address = ? AND type = ? AND spender = ? AND token_id = ?`,
+			approval.ChainID, approval.Owner, approval.TokenAddress, approval.Type, approval.Spender, tokenID)
+		return err
+	}
+
+	var amount []byte
+	if approval.Amount != nil {
+		amount = approval.Amount.ToInt().Bytes()
+	}
+	_, err := tx.Exec(`INSERT OR REPLACE INTO token_approvals (chain_id, owner, token_address, type, spender, token_id, amount, block_number, tx_hash)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		approval.ChainID, approval.Owner, approval.TokenAddress, approval.Type, approval.Spender, tokenID, amount,
+		approval.BlockNumber, approval.TxHash)
+	return err
+}
+
+// saveApprovals applies the approvals found in the logs of an owner, in order
+func (a *ApprovalsDB) saveApprovals(approvals []*Approval) (err error) {
+	tx, err := a.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	for _, approval := range approvals {
+		err = applyApproval(tx, approval)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getApprovals returns the active approvals of the owners on the chains,
+// the most recent first. Empty owners or chains don't filter
+func (a *ApprovalsDB) getApprovals(owners []common.Address, chainIDs []uint64) ([]*Approval, error) {
+	var conditions []string
+	var args []interface{}
+	if len(owners) > 0 {
+		conditions = append(conditions, "owner IN (?"+strings.Repeat(",?", len(owners)-1)+")")
+		for _, owner := range owners {
+			args = append(args, owner)
+		}
+	}
+	if len(chainIDs) > 0 {
+		conditions = append(conditions, "chain_id IN (?"+strings.Repeat(",?", len(chainIDs)-1)+")")
+		for _, chainID := range chainIDs {
+			args = append(args, chainID)
+		}
+	}
+
+	query := `SELECT chain_id, owner, token_address, type, spender, token_id, amount, block_number, tx_hash FROM token_approvals`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY block_number DESC"
+
+	rows, err := a.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*Approval, 0)
+	for rows.Next() {
+		approval := &Approval{}
+		var tokenID, amount []byte
+		err = rows.Scan(&approval.ChainID, &approval.Owner, &approval.TokenAddress, &approval.Type, &approval.Spender,
+			&tokenID, &amount, &approval.BlockNumber, &approval.TxHash)
+		if err != nil {
+			return nil, err
+		}
+		if approval.Type == Erc721TokenApproval {
+			approval.TokenID = (*hexutil.Big)(new(big.Int).SetBytes(tokenID))
+		}
+		if amount != nil {
+			approval.Amount = (*hexutil.Big)(new(big.Int).SetBytes(amount))
+			approval.Unlimited = IsUnlimitedAllowance(approval.Amount.ToInt())
+		}
+		result = append(result, approval)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,142 @@
+package approvals
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/status-im/status-go/appdatabase"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestApprovalsDB(t *testing.T) (*ApprovalsDB, func()) {
+	db, err := appdatabase.SetupTestMemorySQLDB("approvals-tests")
+	require.NoError(t, err)
+	return NewApprovalsDB(db), func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+func erc20ApprovalLog(owner, token, spender common.Address, amount *big.Int, block uint64) types.Log {
+	return types.Log{
+		Address:     token,
+		Topics:      []common.Hash{approvalTopic, common.BytesToHash(owner.Bytes()), common.BytesToHash(spender.Bytes())},
+		Data:        common.LeftPadBytes(amount.Bytes(), 32),
+		BlockNumber: block,
+	}
+}
+
+func TestApprovalFromLog(t *testing.T) {
+	owner := common.HexToAddress("0x1")
+	token := common.HexToAddress("0x2")
+	spender := common.HexToAddress("0x3")
+
+	approval, ok := ApprovalFromLog(1, erc20ApprovalLog(owner, token, spender, big.NewInt(10), 5))
+	require.True(t, ok)
+	require.Equal(t, Erc20Allowance, approval.Type)
+	require.Equal(t, owner, approval.Owner)
+	require.Equal(t, spender, approval.Spender)
+	require.Equal(t, int64(10), approval.Amount.ToInt().Int64())
+
+	approval, ok = ApprovalFromLog(1, types.Log{
+		Address: token,
+		Topics:  []common.Hash{approvalTopic, common.BytesToHash(owner.Bytes()), common.BytesToHash(spender.Bytes()), common.BigToHash(big.NewInt(7))},
+	})
+	require.True(t, ok)
+	require.Equal(t, Erc721TokenApproval, approval.Type)
+	require.Equal(t, int64(7), approval.TokenID.ToInt().Int64())
+
+	approval, ok = ApprovalFromLog(1, types.Log{
+		Address: token,
+		Topics:  []common.Hash{approvalForAllTopic, common.BytesToHash(owner.Bytes()), common.BytesToHash(spender.Bytes())},
+		Data:    common.LeftPadBytes([]byte{1}, 32),
+	})
+	require.True(t, ok)
+	require.Equal(t, OperatorApproval, approval.Type)
+	require.True(t, approval.Approved)
+
+	_, ok = ApprovalFromLog(1, types.Log{Address: token, Topics: []common.Hash{approvalTopic}})
+	require.False(t, ok)
+}
+
+func TestSaveApprovals(t *testing.T) {
+	db, stop := setupTestApprovalsDB(t)
+	defer stop()
+
+	owner := common.HexToAddress("0x1")
+	token := common.HexToAddress("0x2")
+	spender1 := common.HexToAddress("0x3")
+	spender2 := common.HexToAddress("0x4")
+	unlimited := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	var approvals []*Approval
+	for _, l := range []types.Log{
+		erc20ApprovalLog(owner, token, spender1, big.NewInt(10), 1),
+		erc20ApprovalLog(owner, token, spender2, unlimited, 2),
+		// revoked
+		erc20ApprovalLog(owner, token, spender1, big.NewInt(0), 3),
+	} {
+		approval, ok := ApprovalFromLog(1, l)
+		require.True(t, ok)
+		approvals = append(approvals, approval)
+	}
+	require.NoError(t, db.saveApprovals(approvals))
+
+	result, err := db.getApprovals([]common.Address{owner}, []uint64{1})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, spender2, result[0].Spender)
+	require.True(t, result[0].Unlimited)
+
+	// A new approval of an ERC721 token replaces the previous one
+	tokenID := common.BigToHash(big.NewInt(7))
+	for i, spender := range []common.Address{spender1, spender2} {
+		approval, ok := ApprovalFromLog(1, types.Log{
+			Address:     token,
+			Topics:      []common.Hash{approvalTopic, common.BytesToHash(owner.Bytes()), common.BytesToHash(spender.Bytes()), tokenID},
+			BlockNumber: uint64(101 + i),
+		})
+		require.True(t, ok)
+		require.NoError(t, db.saveApprovals([]*Approval{approval}))
+	}
+
+	result, err = db.getApprovals([]common.Address{owner}, nil)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	require.Equal(t, Erc721TokenApproval, result[0].Type)
+	require.Equal(t, spender2, result[0].Spender)
+	require.Equal(t, int64(7), result[0].TokenID.ToInt().Int64())
+
+	result, err = db.getApprovals([]common.Address{owner}, []uint64{5})
+	require.NoError(t, err)
+	require.Len(t, result, 0)
+}
+
+func TestBuildRevokeTransaction(t *testing.T) {
+	approval := Approval{
+		ChainID:      1,
+		Owner:        common.HexToAddress("0x1"),
+		TokenAddress: common.HexToAddress("0x2"),
+		Type:         Erc20Allowance,
+		Spender:      common.HexToAddress("0x3"),
+	}
+
+	args, err := BuildRevokeTransaction(approval)
+	require.NoError(t, err)
+	require.Equal(t, approval.TokenAddress.Bytes(), args.To.Bytes())
+	require.Equal(t, []byte{0x09, 0x5e, 0xa7, 0xb3}, []byte(args.Data[:4]))
+	require.Equal(t, common.LeftPadBytes(approval.Spender.Bytes(), 32), []byte(args.Data[4:36]))
+	require.Equal(t, make([]byte, 32), []byte(args.Data[36:68]))
+
+	approval.Type = OperatorApproval
+	args, err = BuildRevokeTransaction(approval)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xa2, 0x2c, 0xb4, 0x65}, []byte(args.Data[:4]))
+
+	approval.Type = Erc721TokenApproval
+	_, err = BuildRevokeTransaction(approval)
+	require.ErrorIs(t, err, ErrInvalidApproval)
+}
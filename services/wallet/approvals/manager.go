@@ -0,0 +1,303 @@
+package approvals
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/contracts/collectibles"
+	"github.com/status-im/status-go/contracts/ierc20"
+	statustypes "github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/rpc/chain"
+	"github.com/status-im/status-go/services/wallet/logfetcher"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+	"github.com/status-im/status-go/transactions"
+)
+
+const (
+	// EventApprovalsUpdated is sent when the active approvals of an account changed
+	EventApprovalsUpdated walletevent.EventType = "wallet-approvals-updated"
+
+	approvalsScanInterval = 30 * time.Minute
+	// approvalsScanBlockRange is the number of blocks of a single logs request
+	approvalsScanBlockRange = 500000
+)
+
+var (
+	approvalTopic       = crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))
+	approvalForAllTopic = crypto.Keccak256Hash([]byte("ApprovalForAll(address,address,bool)"))
+
+	// unlimitedAllowanceThreshold is the allowance above which an ERC20
+	// approval is considered unlimited, no token has a supply that large
+	unlimitedAllowanceThreshold = new(big.Int).Lsh(big.NewInt(1), 128)
+
+	ErrInvalidApproval = errors.New("invalid approval")
+)
+
+type ApprovalType int
+
+const (
+	// Erc20Allowance allows the spender to transfer an amount of tokens
+	Erc20Allowance ApprovalType = iota
+	// Erc721TokenApproval allows the spender to transfer a single ERC721 token
+	Erc721TokenApproval
+	// OperatorApproval allows the spender to transfer all the ERC721 or
+	// ERC1155 tokens of a collection
+	OperatorApproval
+)
+
+type Approval struct {
+	ChainID      uint64         `json:"chainId"`
+	Owner        common.Address `json:"owner"`
+	TokenAddress common.Address `json:"tokenAddress"`
+	Type         ApprovalType   `json:"type"`
+	Spender      common.Address `json:"spender"`
+	TokenID      *hexutil.Big   `json:"tokenId,omitempty"`
+	// Amount is the allowance of ERC20 approvals, as last approved
+	Amount    *hexutil.Big `json:"amount,omitempty"`
+	Unlimited bool         `json:"unlimited"`
+	// Approved is false for operator approvals being removed
+	Approved    bool        `json:"-"`
+	BlockNumber uint64      `json:"blockNumber"`
+	TxHash      common.Hash `json:"txHash"`
+}
+
+// IsUnlimitedAllowance reports whether an ERC20 allowance lets the spender
+// transfer any amount of tokens
+func IsUnlimitedAllowance(amount *big.Int) bool {
+	return amount.Cmp(unlimitedAllowanceThreshold) >= 0
+}
+
+// active reports whether the approval still grants something to the spender
+func (a *Approval) active() bool {
+	switch a.Type {
+	case Erc20Allowance:
+		return a.Amount != nil && a.Amount.ToInt().Sign() > 0
+	case Erc721TokenApproval:
+		return a.Spender != (common.Address{})
+	default:
+		return a.Approved
+	}
+}
+
+// ApprovalFromLog parses an Approval or ApprovalForAll log, ERC20 and ERC721
+// approvals share the same signature but ERC721 indexes the token ID
+func ApprovalFromLog(chainID uint64, l types.Log) (*Approval, bool) {
+	if l.Removed || len(l.Topics) < 3 {
+		return nil, false
+	}
+
+	approval := &Approval{
+		ChainID:      chainID,
+		Owner:        common.BytesToAddress(l.Topics[1].Bytes()),
+		TokenAddress: l.Address,
+		Spender:      common.BytesToAddress(l.Topics[2].Bytes()),
+		BlockNumber:  l.BlockNumber,
+		TxHash:       l.TxHash,
+	}
+
+	switch {
+	case l.Topics[0] == approvalTopic && len(l.Topics) == 3 && len(l.Data) == 32:
+		approval.Type = Erc20Allowance
+		approval.Amount = (*hexutil.Big)(new(big.Int).SetBytes(l.Data))
+		approval.Unlimited = IsUnlimitedAllowance(approval.Amount.ToInt())
+	case l.Topics[0] == approvalTopic && len(l.Topics) == 4:
+		approval.Type = Erc721TokenApproval
+		approval.TokenID = (*hexutil.Big)(l.Topics[3].Big())
+	case l.Topics[0] == approvalForAllTopic && len(l.Topics) == 3 && len(l.Data) == 32:
+		approval.Type = OperatorApproval
+		approval.Approved = new(big.Int).SetBytes(l.Data).Sign() != 0
+	default:
+		return nil, false
+	}
+
+	return approval, true
+}
+
+// Manager scans the approval logs of the wallet accounts and keeps their
+// active approvals, so that they can be audited and revoked
+type Manager struct {
+	db          *ApprovalsDB
+	checkpoints *logfetcher.CheckpointsDB
+	rpcClient   *rpc.Client
+	accountsDB  *accounts.Database
+	eventFeed   *event.Feed
+
+	cancel context.CancelFunc
+}
+
+func NewManager(db *ApprovalsDB, checkpoints *logfetcher.CheckpointsDB, rpcClient *rpc.Client, accountsDB *accounts.Database, eventFeed *event.Feed) *Manager {
+	return &Manager{
+		db:          db,
+		checkpoints: checkpoints,
+		rpcClient:   rpcClient,
+		accountsDB:  accountsDB,
+		eventFeed:   eventFeed,
+	}
+}
+
+func (m *Manager) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	go func() {
+		ticker := time.NewTicker(approvalsScanInterval)
+		defer ticker.Stop()
+		for {
+			err := m.scanAll(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Warn("failed to scan approvals", "err", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (m *Manager) Stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+}
+
+func (m *Manager) scanAll(ctx context.Context) error {
+	addresses, err := m.accountsDB.GetWalletAddresses()
+	if err != nil {
+		return err
+	}
+
+	networks, err := m.rpcClient.NetworkManager.GetActive(true)
+	if err != nil {
+		return err
+	}
+
+	for _, network := range networks {
+		client, err := m.rpcClient.EthClient(network.ChainID)
+		if err != nil {
+			return err
+		}
+
+		for _, address := range addresses {
+			err = m.scan(ctx, client, common.Address(address))
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil {
+				log.Warn("failed to scan approvals", "chainID", network.ChainID, "address", address, "err", err)
+			}
+		}
+	}
+	return nil
+}
+
+// scan fetches the approval logs of the owner since the last scan
+func (m *Manager) scan(ctx context.Context, client *chain.ClientWithFallback, owner common.Address) error {
+	latest, err := client.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	ownerTopic := common.BytesToHash(owner.Bytes())
+	query := ethereum.FilterQuery{
+		Topics: [][]common.Hash{{approvalTopic, approvalForAllTopic}, {ownerTopic}},
+	}
+	updated := false
+	fetcher := logfetcher.NewFetcher(client.ChainID, client, m.checkpoints)
+	err = fetcher.Scan(ctx, "approvals-"+owner.Hex(), []ethereum.FilterQuery{query}, 0, latest, approvalsScanBlockRange, func(logs []types.Log, end uint64) error {
+		approvals := make([]*Approval, 0, len(logs))
+		for _, l := range logs {
+			if approval, ok := ApprovalFromLog(client.ChainID, l); ok {
+				approvals = append(approvals, approval)
+			}
+		}
+
+		err := m.db.saveApprovals(approvals)
+		if err != nil {
+			return err
+		}
+		updated = updated || len(approvals) > 0
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if updated && m.eventFeed != nil {
+		m.eventFeed.Send(walletevent.Event{
+			Type:     EventApprovalsUpdated,
+			Accounts: []common.Address{owner},
+			ChainID:  client.ChainID,
+		})
+	}
+	return nil
+}
+
+// ListApprovals returns the active approvals of the owners on the chains
+func (m *Manager) ListApprovals(owners []common.Address, chainIDs []uint64) ([]*Approval, error) {
+	return m.db.getApprovals(owners, chainIDs)
+}
+
+// BuildRevokeTransaction returns the arguments of the transaction removing
+// the approval, to be signed and sent by the owner
+func BuildRevokeTransaction(approval Approval) (*transactions.SendTxArgs, error) {
+	var data []byte
+	switch approval.Type {
+	case Erc20Allowance:
+		erc20ABI, err := abi.JSON(strings.NewReader(ierc20.IERC20ABI))
+		if err != nil {
+			return nil, err
+		}
+		data, err = erc20ABI.Pack("approve", approval.Spender, big.NewInt(0))
+		if err != nil {
+			return nil, err
+		}
+	case Erc721TokenApproval:
+		if approval.TokenID == nil {
+			return nil, ErrInvalidApproval
+		}
+		erc721ABI, err := abi.JSON(strings.NewReader(collectibles.CollectiblesABI))
+		if err != nil {
+			return nil, err
+		}
+		data, err = erc721ABI.Pack("approve", common.Address{}, approval.TokenID.ToInt())
+		if err != nil {
+			return nil, err
+		}
+	case OperatorApproval:
+		erc721ABI, err := abi.JSON(strings.NewReader(collectibles.CollectiblesABI))
+		if err != nil {
+			return nil, err
+		}
+		data, err = erc721ABI.Pack("setApprovalForAll", approval.Spender, false)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrInvalidApproval
+	}
+
+	to := statustypes.Address(approval.TokenAddress)
+	return &transactions.SendTxArgs{
+		From:  statustypes.Address(approval.Owner),
+		To:    &to,
+		Value: (*hexutil.Big)(big.NewInt(0)),
+		Data:  data,
+	}, nil
+}
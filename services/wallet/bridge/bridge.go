@@ -16,8 +16,7 @@ import (
 
 func getSigner(chainID uint64, from types.Address, verifiedAccount *account.SelectedExtKey) bind.SignerFn {
 	return func(addr common.Address, tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
-		s := ethTypes.NewLondonSigner(new(big.Int).SetUint64(chainID))
-		return ethTypes.SignTx(tx, s, verifiedAccount.AccountKey.PrivateKey)
+		return verifiedAccount.SignTx(tx, new(big.Int).SetUint64(chainID))
 	}
 }
 
@@ -59,7 +58,7 @@ func (t *TransactionBridge) To() types.Address {
 	} else if t.HopTx != nil {
 		return types.Address(t.HopTx.Recipient)
 	} else if t.CbridgeTx != nil {
-		return types.Address(t.HopTx.Recipient)
+		return types.Address(t.CbridgeTx.Recipient)
 	}
 
 	return types.HexToAddress("0x0")
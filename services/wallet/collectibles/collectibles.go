@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/status-im/status-go/contracts/collectibles"
 	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/services/wallet/logfetcher"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 	"github.com/status-im/status-go/services/wallet/thirdparty/opensea"
 )
@@ -32,6 +33,8 @@ var noTokenURIErrorPrefixes = []string{
 }
 
 type Manager struct {
+	ownershipDB                       *OwnershipDB
+	checkpoints                       *logfetcher.CheckpointsDB
 	rpcClient                         *rpc.Client
 	mainContractOwnershipProvider     thirdparty.NFTContractOwnershipProvider
 	fallbackContractOwnershipProvider thirdparty.NFTContractOwnershipProvider
@@ -42,7 +45,7 @@ type Manager struct {
 	walletFeed                        *event.Feed
 }
 
-func NewManager(rpcClient *rpc.Client, mainContractOwnershipProvider thirdparty.NFTContractOwnershipProvider, fallbackContractOwnershipProvider thirdparty.NFTContractOwnershipProvider, metadataProvider thirdparty.NFTMetadataProvider, openseaAPIKey string, walletFeed *event.Feed) *Manager {
+func NewManager(ownershipDB *OwnershipDB, checkpoints *logfetcher.CheckpointsDB, rpcClient *rpc.Client, mainContractOwnershipProvider thirdparty.NFTContractOwnershipProvider, fallbackContractOwnershipProvider thirdparty.NFTContractOwnershipProvider, metadataProvider thirdparty.NFTMetadataProvider, openseaAPIKey string, walletFeed *event.Feed) *Manager {
 	hystrix.ConfigureCommand(hystrixContractOwnershipClientName, hystrix.CommandConfig{
 		Timeout:               10000,
 		MaxConcurrentRequests: 100,
@@ -51,6 +54,8 @@ func NewManager(rpcClient *rpc.Client, mainContractOwnershipProvider thirdparty.
 	})
 
 	return &Manager{
+		ownershipDB:                       ownershipDB,
+		checkpoints:                       checkpoints,
 		rpcClient:                         rpcClient,
 		mainContractOwnershipProvider:     mainContractOwnershipProvider,
 		fallbackContractOwnershipProvider: fallbackContractOwnershipProvider,
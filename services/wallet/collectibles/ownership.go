@@ -0,0 +1,200 @@
+package collectibles
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/services/wallet/bigint"
+	"github.com/status-im/status-go/services/wallet/logfetcher"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+	"github.com/status-im/status-go/services/wallet/thirdparty/opensea"
+)
+
+const (
+	// fullRefreshInterval is how often the ownership is fetched in full from
+	// the providers, to catch the tokens the transfer logs miss
+	fullRefreshInterval = 24 * time.Hour
+	// maxIncrementalBlocks is the number of blocks above which fetching the
+	// ownership in full is cheaper than reading the transfer logs
+	maxIncrementalBlocks = 200000
+	// ownershipLogsBlockRange is the number of blocks of a single logs request
+	ownershipLogsBlockRange = 50000
+	ownershipFetchLimit     = 200
+)
+
+var transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// OwnedCollectibles is the cached ownership of an account on a chain. Stale is
+// set when the ownership couldn't be updated and may be out of date
+type OwnedCollectibles struct {
+	ChainID uint64                   `json:"chainId"`
+	Owner   common.Address           `json:"owner"`
+	IDs     []thirdparty.NFTUniqueID `json:"ids"`
+	State   *OwnershipState          `json:"state"`
+	Stale   bool                     `json:"stale"`
+}
+
+type ownershipTransfer struct {
+	from common.Address
+	to   common.Address
+	id   thirdparty.NFTUniqueID
+}
+
+// ownershipTransferFromLog parses an ERC721 Transfer log, ERC20 transfers
+// share the signature but don't index the value
+func ownershipTransferFromLog(l types.Log) (*ownershipTransfer, bool) {
+	if l.Removed || len(l.Topics) != 4 || l.Topics[0] != transferTopic {
+		return nil, false
+	}
+	return &ownershipTransfer{
+		from: common.BytesToAddress(l.Topics[1].Bytes()),
+		to:   common.BytesToAddress(l.Topics[2].Bytes()),
+		id: thirdparty.NFTUniqueID{
+			ContractAddress: l.Address,
+			TokenID:         &bigint.BigInt{Int: l.Topics[3].Big()},
+		},
+	}, true
+}
+
+// GetOwnedCollectibles returns the cached ownership of the owner, updated
+// with the transfer logs since it was last synced
+func (o *Manager) GetOwnedCollectibles(ctx context.Context, chainID uint64, owner common.Address) (*OwnedCollectibles, error) {
+	return o.fetchOwnedCollectibles(ctx, chainID, owner, false)
+}
+
+// ForceRefreshOwnedCollectibles fetches the ownership of the owner in full
+// from the providers
+func (o *Manager) ForceRefreshOwnedCollectibles(ctx context.Context, chainID uint64, owner common.Address) (*OwnedCollectibles, error) {
+	return o.fetchOwnedCollectibles(ctx, chainID, owner, true)
+}
+
+func (o *Manager) fetchOwnedCollectibles(ctx context.Context, chainID uint64, owner common.Address, forceRefresh bool) (*OwnedCollectibles, error) {
+	state, err := o.ownershipDB.getState(chainID, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	stale := false
+	err = o.updateOwnership(ctx, chainID, owner, state, forceRefresh)
+	if err != nil {
+		// Serve the cached ownership when there's one
+		if state == nil {
+			return nil, err
+		}
+		log.Warn("failed to update collectibles ownership", "chainID", chainID, "owner", owner, "err", err)
+		stale = true
+	}
+
+	state, err = o.ownershipDB.getState(chainID, owner)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := o.ownershipDB.getOwnedIDs(chainID, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OwnedCollectibles{
+		ChainID: chainID,
+		Owner:   owner,
+		IDs:     ids,
+		State:   state,
+		Stale:   stale,
+	}, nil
+}
+
+func (o *Manager) updateOwnership(ctx context.Context, chainID uint64, owner common.Address, state *OwnershipState, forceRefresh bool) error {
+	client, err := o.rpcClient.EthClient(chainID)
+	if err != nil {
+		return err
+	}
+	latest, err := client.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if forceRefresh || state == nil ||
+		now.Sub(time.Unix(state.LastFullRefreshAt, 0)) > fullRefreshInterval ||
+		latest > state.LastSyncedBlock+maxIncrementalBlocks {
+		return o.refreshOwnership(chainID, owner, latest, now)
+	}
+
+	ownerTopic := common.BytesToHash(owner.Bytes())
+	// Transfers from and to the owner are fetched by separate queries, topics
+	// of different positions can't be OR'ed in a single one. The scan merges
+	// them in order, each range is applied along with the synced block
+	queries := []ethereum.FilterQuery{
+		{Topics: [][]common.Hash{{transferTopic}, {ownerTopic}}},
+		{Topics: [][]common.Hash{{transferTopic}, {}, {ownerTopic}}},
+	}
+	fetcher := logfetcher.NewFetcher(chainID, client, o.checkpoints)
+	return fetcher.Scan(ctx, "collectibles-ownership-"+owner.Hex(), queries, state.LastSyncedBlock+1, latest, ownershipLogsBlockRange,
+		func(logs []types.Log, end uint64) error {
+			return o.ownershipDB.saveTransfers(chainID, owner, ownershipTransfersFromLogs(logs), &OwnershipState{
+				LastSyncedBlock:   end,
+				LastFullRefreshAt: state.LastFullRefreshAt,
+				UpdatedAt:         now.Unix(),
+			})
+		})
+}
+
+// ownershipTransfersFromLogs returns the ERC721 transfers of the logs in the
+// order they happened
+func ownershipTransfersFromLogs(logs []types.Log) []*ownershipTransfer {
+	sort.SliceStable(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+
+	transfers := make([]*ownershipTransfer, 0, len(logs))
+	for _, l := range logs {
+		if transfer, ok := ownershipTransferFromLog(l); ok {
+			transfers = append(transfers, transfer)
+		}
+	}
+	return transfers
+}
+
+// refreshOwnership fetches the tokens of the owner from the providers and
+// replaces the cached ownership
+func (o *Manager) refreshOwnership(chainID uint64, owner common.Address, latest uint64, now time.Time) error {
+	client, err := opensea.NewOpenseaClient(chainID, o.openseaAPIKey, o.walletFeed)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]thirdparty.NFTUniqueID, 0)
+	cursor := ""
+	for {
+		container, err := client.FetchAllAssetsByOwner(owner, cursor, ownershipFetchLimit)
+		if err != nil {
+			return err
+		}
+		for _, asset := range container.Assets {
+			ids = append(ids, thirdparty.NFTUniqueID{
+				ContractAddress: common.HexToAddress(asset.Contract.Address),
+				TokenID:         asset.TokenID,
+			})
+		}
+		if container.NextCursor == "" {
+			break
+		}
+		cursor = container.NextCursor
+	}
+
+	return o.ownershipDB.saveFullRefresh(chainID, owner, ids, &OwnershipState{
+		LastSyncedBlock:   latest,
+		LastFullRefreshAt: now.Unix(),
+		UpdatedAt:         now.Unix(),
+	})
+}
@@ -0,0 +1,132 @@
+package collectibles
+
+import (
+	"database/sql"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/services/wallet/bigint"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+)
+
+type OwnershipDB struct {
+	db *sql.DB
+}
+
+func NewOwnershipDB(sqlDb *sql.DB) *OwnershipDB {
+	return &OwnershipDB{
+		db: sqlDb,
+	}
+}
+
+// OwnershipState tells how fresh the cached ownership of an account is
+type OwnershipState struct {
+	LastSyncedBlock   uint64 `json:"lastSyncedBlock"`
+	LastFullRefreshAt int64  `json:"lastFullRefreshAt"`
+	UpdatedAt         int64  `json:"updatedAt"`
+}
+
+// getState returns the state of the cached ownership of the owner, and nil
+// if it was never fetched
+func (o *OwnershipDB) getState(chainID uint64, owner common.Address) (*OwnershipState, error) {
+	state := &OwnershipState{}
+	err := o.db.QueryRow(`SELECT last_synced_block, last_full_refresh_at, updated_at FROM collectibles_ownership_state WHERE chain_id = ? AND owner = ?`,
+		chainID, owner).Scan(&state.LastSyncedBlock, &state.LastFullRefreshAt, &state.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (o *OwnershipDB) getOwnedIDs(chainID uint64, owner common.Address) ([]thirdparty.NFTUniqueID, error) {
+	rows, err := o.db.Query(`SELECT contract_address, token_id FROM collectibles_ownership WHERE chain_id = ? AND owner = ?`, chainID, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]thirdparty.NFTUniqueID, 0)
+	for rows.Next() {
+		var contractAddress common.Address
+		var tokenID []byte
+		err = rows.Scan(&contractAddress, &tokenID)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, thirdparty.NFTUniqueID{
+			ContractAddress: contractAddress,
+			TokenID:         &bigint.BigInt{Int: new(big.Int).SetBytes(tokenID)},
+		})
+	}
+	return ids, rows.Err()
+}
+
+func withTx(db *sql.DB, fn func(tx *sql.Tx) error) (err error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	return fn(tx)
+}
+
+func insertOwnedID(tx *sql.Tx, chainID uint64, owner common.Address, id thirdparty.NFTUniqueID) error {
+	_, err := tx.Exec(`INSERT OR REPLACE INTO collectibles_ownership (chain_id, owner, contract_address, token_id) VALUES (?, ?, ?, ?)`,
+		chainID, owner, id.ContractAddress, id.TokenID.Bytes())
+	return err
+}
+
+func saveState(tx *sql.Tx, chainID uint64, owner common.Address, state *OwnershipState) error {
+	_, err := tx.Exec(`INSERT OR REPLACE INTO collectibles_ownership_state (chain_id, owner, last_synced_block, last_full_refresh_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+		chainID, owner, state.LastSyncedBlock, state.LastFullRefreshAt, state.UpdatedAt)
+	return err
+}
+
+// saveFullRefresh replaces the cached ownership of the owner with the tokens
+// fetched from the providers
+func (o *OwnershipDB) saveFullRefresh(chainID uint64, owner common.Address, ids []thirdparty.NFTUniqueID, state *OwnershipState) error {
+	return withTx(o.db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(`DELETE FROM collectibles_ownership WHERE chain_id = ? AND owner = ?`, chainID, owner)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			err = insertOwnedID(tx, chainID, owner, id)
+			if err != nil {
+				return err
+			}
+		}
+		return saveState(tx, chainID, owner, state)
+	})
+}
+
+// saveTransfers applies the transfers from and to the owner, in order, to
+// its cached ownership
+func (o *OwnershipDB) saveTransfers(chainID uint64, owner common.Address, transfers []*ownershipTransfer, state *OwnershipState) error {
+	return withTx(o.db, func(tx *sql.Tx) error {
+		for _, transfer := range transfers {
+			var err error
+			if transfer.to == owner {
+				err = insertOwnedID(tx, chainID, owner, transfer.id)
+			} else if transfer.from == owner {
+				_, err = tx.Exec(`DELETE FROM collectibles_ownership WHERE chain_id = ? AND owner = ? AND contract_address = ? AND token_id = ?`,
+					chainID, owner, transfer.id.ContractAddress, transfer.id.TokenID.Bytes())
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return saveState(tx, chainID, owner, state)
+	})
+}
@@ -0,0 +1,83 @@
+package collectibles
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/services/wallet/bigint"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestOwnershipDB(t *testing.T) (*OwnershipDB, func()) {
+	db, err := appdatabase.SetupTestMemorySQLDB("collectibles-ownership-tests")
+	require.NoError(t, err)
+	return NewOwnershipDB(db), func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+func transferLog(contract, from, to common.Address, tokenID int64, block uint64, index uint) types.Log {
+	return types.Log{
+		Address: contract,
+		Topics: []common.Hash{
+			transferTopic,
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+			common.BigToHash(big.NewInt(tokenID)),
+		},
+		BlockNumber: block,
+		Index:       index,
+	}
+}
+
+func TestOwnershipTransfers(t *testing.T) {
+	db, stop := setupTestOwnershipDB(t)
+	defer stop()
+
+	owner := common.HexToAddress("0x1")
+	other := common.HexToAddress("0x2")
+	contract := common.HexToAddress("0x3")
+
+	err := db.saveFullRefresh(1, owner, []thirdparty.NFTUniqueID{
+		{ContractAddress: contract, TokenID: &bigint.BigInt{Int: big.NewInt(1)}},
+	}, &OwnershipState{LastSyncedBlock: 10, LastFullRefreshAt: 100, UpdatedAt: 100})
+	require.NoError(t, err)
+
+	// The token 2 is received then sent back in a later block, the logs of the
+	// sent tokens are fetched before the ones of the received tokens
+	logs := []types.Log{
+		transferLog(contract, owner, other, 1, 11, 0),
+		transferLog(contract, owner, other, 2, 13, 0),
+		transferLog(contract, other, owner, 2, 12, 0),
+		transferLog(contract, other, owner, 3, 12, 1),
+		// ERC20 transfers don't index the value
+		{Address: contract, Topics: []common.Hash{transferTopic, common.BytesToHash(other.Bytes()), common.BytesToHash(owner.Bytes())}, BlockNumber: 12},
+	}
+	transfers := ownershipTransfersFromLogs(logs)
+	require.Len(t, transfers, 4)
+
+	err = db.saveTransfers(1, owner, transfers, &OwnershipState{LastSyncedBlock: 13, LastFullRefreshAt: 100, UpdatedAt: 200})
+	require.NoError(t, err)
+
+	ids, err := db.getOwnedIDs(1, owner)
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+	require.Equal(t, contract, ids[0].ContractAddress)
+	require.Equal(t, int64(3), ids[0].TokenID.Int64())
+
+	state, err := db.getState(1, owner)
+	require.NoError(t, err)
+	require.Equal(t, uint64(13), state.LastSyncedBlock)
+	require.Equal(t, int64(100), state.LastFullRefreshAt)
+	require.Equal(t, int64(200), state.UpdatedAt)
+
+	state, err = db.getState(5, owner)
+	require.NoError(t, err)
+	require.Nil(t, state)
+}
@@ -1,28 +1,205 @@
 package wallet
 
 import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/contracts/assets"
+	"github.com/status-im/status-go/contracts/collectibles"
+	"github.com/status-im/status-go/contracts/ierc20"
+	"github.com/status-im/status-go/contracts/registrar"
+	"github.com/status-im/status-go/contracts/resolver"
+	"github.com/status-im/status-go/contracts/snt"
+	"github.com/status-im/status-go/contracts/stickers"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 	"github.com/status-im/status-go/services/wallet/thirdparty/fourbyte"
 	"github.com/status-im/status-go/services/wallet/thirdparty/fourbytegithub"
 )
 
+// commonTargetsABI holds the methods dapps connected through WalletConnect
+// commonly ask to call which aren't part of the embedded contracts
+const commonTargetsABI = `[
+	{"type":"function","name":"safeTransferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"id","type":"uint256"},{"name":"amount","type":"uint256"},{"name":"data","type":"bytes"}]},
+	{"type":"function","name":"safeBatchTransferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"ids","type":"uint256[]"},{"name":"amounts","type":"uint256[]"},{"name":"data","type":"bytes"}]},
+	{"type":"function","name":"deposit","inputs":[]},
+	{"type":"function","name":"withdraw","inputs":[{"name":"wad","type":"uint256"}]},
+	{"type":"function","name":"multicall","inputs":[{"name":"data","type":"bytes[]"}]},
+	{"type":"function","name":"multicall","inputs":[{"name":"deadline","type":"uint256"},{"name":"data","type":"bytes[]"}]},
+	{"type":"function","name":"swapExactETHForTokens","inputs":[{"name":"amountOutMin","type":"uint256"},{"name":"path","type":"address[]"},{"name":"to","type":"address"},{"name":"deadline","type":"uint256"}]},
+	{"type":"function","name":"swapExactTokensForETH","inputs":[{"name":"amountIn","type":"uint256"},{"name":"amountOutMin","type":"uint256"},{"name":"path","type":"address[]"},{"name":"to","type":"address"},{"name":"deadline","type":"uint256"}]},
+	{"type":"function","name":"swapExactTokensForTokens","inputs":[{"name":"amountIn","type":"uint256"},{"name":"amountOutMin","type":"uint256"},{"name":"path","type":"address[]"},{"name":"to","type":"address"},{"name":"deadline","type":"uint256"}]}
+]`
+
+// knownABIs are the ABIs decoded locally, when several of them share a method
+// the first one wins
+var knownABIs = []string{
+	collectibles.CollectiblesABI,
+	assets.AssetsABI,
+	ierc20.IERC20ABI,
+	snt.SNTABI,
+	registrar.UsernameRegistrarABI,
+	resolver.ENSRegistryWithFallbackABI,
+	resolver.AddrResolverABI,
+	resolver.ContentHashResolverABI,
+	resolver.NameResolverABI,
+	resolver.PubkeyResolverABI,
+	stickers.StickerMarketABI,
+	stickers.StickerPackABI,
+	stickers.IERC721ABI,
+	commonTargetsABI,
+}
+
 type Decoder struct {
 	Main     *fourbytegithub.Client
 	Fallback *fourbyte.Client
+
+	known map[string]abi.Method
 }
 
 func NewDecoder() *Decoder {
 	return &Decoder{
 		Main:     fourbytegithub.NewClient(),
 		Fallback: fourbyte.NewClient(),
+		known:    knownMethods(knownABIs),
+	}
+}
+
+func knownMethods(abis []string) map[string]abi.Method {
+	known := make(map[string]abi.Method)
+	for _, abiJSON := range abis {
+		parsed, err := abi.JSON(strings.NewReader(abiJSON))
+		if err != nil {
+			log.Error("failed to parse a known ABI", "err", err)
+			continue
+		}
+		for _, method := range parsed.Methods {
+			id := hexutil.Encode(method.ID)
+			if _, ok := known[id]; !ok {
+				known[id] = method
+			}
+		}
 	}
+	return known
 }
 
+// Decode decodes the calldata with the known ABIs and falls back to the
+// 4byte signature directories
 func (d *Decoder) Decode(data string) (*thirdparty.DataParsed, error) {
-	parsed, err := d.Main.Run(data)
+	parsed, err := d.decodeLocally(data)
 	if err == nil {
 		return parsed, nil
 	}
 
-	return d.Fallback.Run(data)
+	parsed, err = d.Main.Run(data)
+	if err != nil {
+		parsed, err = d.Fallback.Run(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if parsed == nil {
+		return nil, errors.New("couldn't find a corresponding signature")
+	}
+	parsed.Call = formatCall(parsed.Name, indexedInputs(parsed.Inputs))
+	return parsed, nil
+}
+
+func (d *Decoder) decodeLocally(data string) (*thirdparty.DataParsed, error) {
+	input, err := hexutil.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(input) < 4 {
+		return nil, errors.New("input is badly formatted")
+	}
+	id := hexutil.Encode(input[:4])
+	method, ok := d.known[id]
+	if !ok {
+		return nil, errors.New("unknown method")
+	}
+
+	values, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	// Inputs are keyed by their position, the same way as the ones decoded by
+	// the 4byte clients
+	inputs := make(map[string]string, len(values))
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = formatValue(reflect.ValueOf(value))
+		inputs[strconv.Itoa(i)] = formatted[i]
+	}
+
+	return &thirdparty.DataParsed{
+		Name:      method.RawName,
+		ID:        id,
+		Inputs:    inputs,
+		Signature: method.Sig,
+		Call:      formatCall(method.RawName, formatted),
+	}, nil
+}
+
+// indexedInputs returns the inputs named after their position in order, as
+// named by the 4byte clients
+func indexedInputs(inputs map[string]string) []string {
+	values := []string{}
+	for i := 0; ; i++ {
+		value, ok := inputs[strconv.Itoa(i)]
+		if !ok {
+			return values
+		}
+		values = append(values, value)
+	}
+}
+
+func formatCall(name string, values []string) string {
+	return fmt.Sprintf("%s(%s)", name, strings.Join(values, ", "))
+}
+
+var (
+	addressType = reflect.TypeOf(common.Address{})
+	bigIntType  = reflect.TypeOf(&big.Int{})
+)
+
+// formatValue formats a value unpacked from the calldata, addresses and bytes
+// in hex and arrays and tuples with their elements in order
+func formatValue(v reflect.Value) string {
+	switch {
+	case v.Type() == addressType:
+		return v.Interface().(common.Address).Hex()
+	case v.Type() == bigIntType:
+		return v.Interface().(*big.Int).String()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			bytes := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(bytes), v)
+			return hexutil.Encode(bytes)
+		}
+		elems := make([]string, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elems[i] = formatValue(v.Index(i))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case reflect.Struct:
+		fields := make([]string, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			fields[i] = formatValue(v.Field(i))
+		}
+		return "(" + strings.Join(fields, ", ") + ")"
+	}
+	return fmt.Sprintf("%v", v.Interface())
 }
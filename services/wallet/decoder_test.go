@@ -0,0 +1,64 @@
+package wallet
+
+import (
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/contracts/collectibles"
+	"github.com/status-im/status-go/contracts/ierc20"
+)
+
+func TestDecodeLocally(t *testing.T) {
+	decoder := &Decoder{known: knownMethods(knownABIs)}
+
+	collectiblesABI, err := abi.JSON(strings.NewReader(collectibles.CollectiblesABI))
+	require.NoError(t, err)
+	first := common.HexToAddress("0xabc")
+	second := common.HexToAddress("0xdef")
+	data, err := collectiblesABI.Pack("mintTo", []common.Address{first, second})
+	require.NoError(t, err)
+
+	parsed, err := decoder.Decode(hexutil.Encode(data))
+	require.NoError(t, err)
+	require.Equal(t, "mintTo", parsed.Name)
+	require.Equal(t, "mintTo(address[])", parsed.Signature)
+	require.Equal(t, hexutil.Encode(data[:4]), parsed.ID)
+	require.Equal(t, "mintTo(["+first.Hex()+", "+second.Hex()+"])", parsed.Call)
+	require.Equal(t, "["+first.Hex()+", "+second.Hex()+"]", parsed.Inputs["0"])
+
+	erc20ABI, err := abi.JSON(strings.NewReader(ierc20.IERC20ABI))
+	require.NoError(t, err)
+	data, err = erc20ABI.Pack("transfer", first, big.NewInt(1000))
+	require.NoError(t, err)
+
+	parsed, err = decoder.Decode(hexutil.Encode(data))
+	require.NoError(t, err)
+	require.Equal(t, "transfer("+first.Hex()+", 1000)", parsed.Call)
+	require.Equal(t, map[string]string{"0": first.Hex(), "1": "1000"}, parsed.Inputs)
+
+	_, err = decoder.decodeLocally("0x12345678")
+	require.Error(t, err)
+}
+
+func TestFormatValue(t *testing.T) {
+	tuple := struct {
+		Token  common.Address
+		Amount *big.Int
+		Data   []byte
+		Flag   bool
+		Nonce  [4]byte
+	}{common.HexToAddress("0x1"), big.NewInt(5), []byte{1, 2}, true, [4]byte{0xde, 0xad, 0xbe, 0xef}}
+	require.Equal(t, "("+common.HexToAddress("0x1").Hex()+", 5, 0x0102, true, 0xdeadbeef)", formatValue(reflect.ValueOf(tuple)))
+}
+
+func TestIndexedInputs(t *testing.T) {
+	require.Equal(t, "approve(0x1, 10)", formatCall("approve", indexedInputs(map[string]string{"1": "10", "0": "0x1"})))
+}
@@ -0,0 +1,115 @@
+package erc4337
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// GasEstimate is the gas of a user operation estimated by a bundler
+type GasEstimate struct {
+	PreVerificationGas   *hexutil.Big `json:"preVerificationGas"`
+	VerificationGasLimit *hexutil.Big `json:"verificationGasLimit"`
+	CallGasLimit         *hexutil.Big `json:"callGasLimit"`
+}
+
+// Sponsorship is the paymaster data of a user operation whose gas is paid by
+// a paymaster, with the gas it was sponsored for
+type Sponsorship struct {
+	PaymasterAndData     hexutil.Bytes `json:"paymasterAndData"`
+	PreVerificationGas   *hexutil.Big  `json:"preVerificationGas,omitempty"`
+	VerificationGasLimit *hexutil.Big  `json:"verificationGasLimit,omitempty"`
+	CallGasLimit         *hexutil.Big  `json:"callGasLimit,omitempty"`
+}
+
+// UserOperationReceipt is returned by bundlers once a user operation is
+// included in a block
+type UserOperationReceipt struct {
+	UserOpHash    common.Hash    `json:"userOpHash"`
+	Sender        common.Address `json:"sender"`
+	Success       bool           `json:"success"`
+	Reason        string         `json:"reason"`
+	ActualGasCost *hexutil.Big   `json:"actualGasCost"`
+	Receipt       *types.Receipt `json:"receipt"`
+}
+
+// BundlerClient talks to the ERC-4337 RPC of a bundler, and of a paymaster
+// which can be the same endpoint
+type BundlerClient struct {
+	bundler   *gethrpc.Client
+	paymaster *gethrpc.Client
+}
+
+func DialBundler(ctx context.Context, bundlerURL string, paymasterURL string) (*BundlerClient, error) {
+	bundler, err := gethrpc.DialContext(ctx, bundlerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &BundlerClient{bundler: bundler}
+	if paymasterURL == "" {
+		return client, nil
+	}
+	if paymasterURL == bundlerURL {
+		client.paymaster = bundler
+		return client, nil
+	}
+
+	client.paymaster, err = gethrpc.DialContext(ctx, paymasterURL)
+	if err != nil {
+		bundler.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+func (c *BundlerClient) Close() {
+	c.bundler.Close()
+	if c.paymaster != nil && c.paymaster != c.bundler {
+		c.paymaster.Close()
+	}
+}
+
+func (c *BundlerClient) SupportedEntryPoints(ctx context.Context) ([]common.Address, error) {
+	var entryPoints []common.Address
+	err := c.bundler.CallContext(ctx, &entryPoints, "eth_supportedEntryPoints")
+	return entryPoints, err
+}
+
+func (c *BundlerClient) EstimateUserOperationGas(ctx context.Context, op *UserOperation, entryPoint common.Address) (*GasEstimate, error) {
+	estimate := &GasEstimate{}
+	err := c.bundler.CallContext(ctx, estimate, "eth_estimateUserOperationGas", op, entryPoint)
+	if err != nil {
+		return nil, err
+	}
+	return estimate, nil
+}
+
+// SponsorUserOperation asks the paymaster to pay for the gas of the operation
+func (c *BundlerClient) SponsorUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (*Sponsorship, error) {
+	if c.paymaster == nil {
+		return nil, ErrNoPaymaster
+	}
+	sponsorship := &Sponsorship{}
+	err := c.paymaster.CallContext(ctx, sponsorship, "pm_sponsorUserOperation", op, entryPoint)
+	if err != nil {
+		return nil, err
+	}
+	return sponsorship, nil
+}
+
+func (c *BundlerClient) SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error) {
+	var hash common.Hash
+	err := c.bundler.CallContext(ctx, &hash, "eth_sendUserOperation", op, entryPoint)
+	return hash, err
+}
+
+// GetUserOperationReceipt returns nil while the operation isn't included
+func (c *BundlerClient) GetUserOperationReceipt(ctx context.Context, hash common.Hash) (*UserOperationReceipt, error) {
+	var receipt *UserOperationReceipt
+	err := c.bundler.CallContext(ctx, &receipt, "eth_getUserOperationReceipt", hash)
+	return receipt, err
+}
@@ -0,0 +1,87 @@
+package erc4337
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusSuccess Status = "success"
+	StatusFailed  Status = "failed"
+)
+
+// TrackedUserOperation is a user operation sent to a bundler, tracked until
+// it's included
+type TrackedUserOperation struct {
+	Hash      common.Hash    `json:"hash"`
+	ChainID   uint64         `json:"chainId"`
+	Sender    common.Address `json:"sender"`
+	Owner     common.Address `json:"owner"`
+	Status    Status         `json:"status"`
+	Sponsored bool           `json:"sponsored"`
+	TxHash    common.Hash    `json:"txHash"`
+	// Reason is the revert reason of a failed operation
+	Reason    string `json:"reason,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+type Database struct {
+	db *sql.DB
+}
+
+func NewDatabase(db *sql.DB) *Database {
+	return &Database{db: db}
+}
+
+func (d *Database) Save(op *TrackedUserOperation) error {
+	_, err := d.db.Exec(`INSERT OR REPLACE INTO erc4337_user_operations (hash, chain_id, sender, owner, status, sponsored, tx_hash, reason, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		op.Hash.Hex(), op.ChainID, op.Sender.Hex(), op.Owner.Hex(), op.Status, op.Sponsored, op.TxHash.Hex(), op.Reason, op.CreatedAt, op.UpdatedAt)
+	return err
+}
+
+// Get returns the operations of the owners, or the pending ones if pending is
+// true, the most recent first
+func (d *Database) Get(owners []common.Address, pending bool) ([]*TrackedUserOperation, error) {
+	query := `SELECT hash, chain_id, sender, owner, status, sponsored, tx_hash, reason, created_at, updated_at FROM erc4337_user_operations WHERE 1 = 1`
+	args := make([]interface{}, 0, len(owners)+1)
+	if len(owners) > 0 {
+		query += ` AND owner IN (?` + strings.Repeat(",?", len(owners)-1) + `)`
+		for _, owner := range owners {
+			args = append(args, owner.Hex())
+		}
+	}
+	if pending {
+		query += ` AND status = ?`
+		args = append(args, StatusPending)
+	}
+	query += ` ORDER BY created_at DESC`
+
+	rows, err := d.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*TrackedUserOperation
+	for rows.Next() {
+		var hash, sender, owner, txHash string
+		op := &TrackedUserOperation{}
+		err = rows.Scan(&hash, &op.ChainID, &sender, &owner, &op.Status, &op.Sponsored, &txHash, &op.Reason, &op.CreatedAt, &op.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		op.Hash = common.HexToHash(hash)
+		op.Sender = common.HexToAddress(sender)
+		op.Owner = common.HexToAddress(owner)
+		op.TxHash = common.HexToHash(txHash)
+		result = append(result, op)
+	}
+	return result, rows.Err()
+}
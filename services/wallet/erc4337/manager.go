@@ -0,0 +1,444 @@
+package erc4337
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+	"github.com/status-im/status-go/transactions"
+)
+
+const (
+	// EventUserOperationStatusChanged is sent when a tracked user operation is
+	// included or dropped, the message is the JSON TrackedUserOperation
+	EventUserOperationStatusChanged walletevent.EventType = "wallet-user-operation-status-changed"
+
+	trackInterval = 15 * time.Second
+	// userOperationTimeout is how long an operation is tracked before being
+	// considered dropped by the bundler
+	userOperationTimeout = time.Hour
+)
+
+var (
+	ErrNoBundler       = errors.New("no bundler configured for the chain")
+	ErrNoPaymaster     = errors.New("no paymaster configured for the chain")
+	ErrBatchValue      = errors.New("batched calls can't transfer value")
+	ErrMintAmounts     = errors.New("an amount is required for each address")
+	ErrAlreadyDeployed = errors.New("smart account is already deployed")
+)
+
+// accountSalt is the salt of the smart account of an owner, each owner has a
+// single account
+var accountSalt = big.NewInt(0)
+
+// SmartAccount is the SimpleAccount of an owner, its address is known before
+// it's deployed by its first user operation
+type SmartAccount struct {
+	Owner    common.Address `json:"owner"`
+	Address  common.Address `json:"address"`
+	Deployed bool           `json:"deployed"`
+}
+
+// Manager builds, sends and tracks the user operations of the smart accounts
+// of the keypairs, through the bundlers and paymasters of the wallet config
+type Manager struct {
+	rpcClient     *rpc.Client
+	db            *Database
+	accountsDB    *accounts.Database
+	gethManager   *account.GethManager
+	keyStoreDir   string
+	bundlerURLs   map[uint64]string
+	paymasterURLs map[uint64]string
+	eventFeed     *event.Feed
+
+	cancel context.CancelFunc
+}
+
+func NewManager(rpcClient *rpc.Client, db *Database, accountsDB *accounts.Database, gethManager *account.GethManager, config *params.NodeConfig, eventFeed *event.Feed) *Manager {
+	return &Manager{
+		rpcClient:     rpcClient,
+		db:            db,
+		accountsDB:    accountsDB,
+		gethManager:   gethManager,
+		keyStoreDir:   config.KeyStoreDir,
+		bundlerURLs:   config.WalletConfig.BundlerURLs,
+		paymasterURLs: config.WalletConfig.PaymasterURLs,
+		eventFeed:     eventFeed,
+	}
+}
+
+func (m *Manager) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	go func() {
+		ticker := time.NewTicker(trackInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := m.trackPending(ctx)
+				if err != nil && ctx.Err() == nil {
+					log.Warn("failed to track user operations", "err", err)
+				}
+			}
+		}
+	}()
+}
+
+func (m *Manager) Stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+}
+
+func (m *Manager) bundler(ctx context.Context, chainID uint64) (*BundlerClient, error) {
+	bundlerURL, ok := m.bundlerURLs[chainID]
+	if !ok || bundlerURL == "" {
+		return nil, ErrNoBundler
+	}
+	return DialBundler(ctx, bundlerURL, m.paymasterURLs[chainID])
+}
+
+func (m *Manager) call(ctx context.Context, chainID uint64, to common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	client, err := m.rpcClient.EthClient(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := parsedContractsABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	output, err := client.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return parsedContractsABI.Unpack(method, output)
+}
+
+// GetSmartAccount returns the smart account of the owner
+func (m *Manager) GetSmartAccount(ctx context.Context, chainID uint64, owner common.Address) (*SmartAccount, error) {
+	result, err := m.call(ctx, chainID, SimpleAccountFactoryAddress, "getAddress", owner, accountSalt)
+	if err != nil {
+		return nil, err
+	}
+	address := result[0].(common.Address)
+
+	client, err := m.rpcClient.EthClient(chainID)
+	if err != nil {
+		return nil, err
+	}
+	code, err := client.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SmartAccount{
+		Owner:    owner,
+		Address:  address,
+		Deployed: len(code) > 0,
+	}, nil
+}
+
+func (m *Manager) fees(ctx context.Context, chainID uint64) (maxFee *big.Int, tip *big.Int, err error) {
+	client, err := m.rpcClient.EthClient(chainID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tip, err = client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	baseFee := header.BaseFee
+	if baseFee == nil {
+		baseFee = new(big.Int)
+	}
+	// The base fee can double before the operation is included
+	maxFee = new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tip)
+	return maxFee, tip, nil
+}
+
+// BuildUserOperation returns the unsigned operation of the smart account of
+// the owner making the calls, deploying the account if needed. The gas of a
+// sponsored operation is paid by the paymaster of the chain
+func (m *Manager) BuildUserOperation(ctx context.Context, chainID uint64, owner common.Address, calls []Call, sponsored bool) (*UserOperation, error) {
+	smartAccount, err := m.GetSmartAccount(ctx, chainID, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := m.call(ctx, chainID, EntryPointAddress, "getNonce", smartAccount.Address, new(big.Int))
+	if err != nil {
+		return nil, err
+	}
+	nonce := result[0].(*big.Int)
+
+	initCode := []byte{}
+	if !smartAccount.Deployed {
+		initCode, err = InitCode(SimpleAccountFactoryAddress, owner, accountSalt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	callData, err := ExecuteCallData(calls)
+	if err != nil {
+		return nil, err
+	}
+
+	maxFee, tip, err := m.fees(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &UserOperation{
+		Sender:               smartAccount.Address,
+		Nonce:                (*hexutil.Big)(nonce),
+		InitCode:             initCode,
+		CallData:             callData,
+		MaxFeePerGas:         (*hexutil.Big)(maxFee),
+		MaxPriorityFeePerGas: (*hexutil.Big)(tip),
+		PaymasterAndData:     []byte{},
+		Signature:            dummySignature,
+	}
+
+	bundler, err := m.bundler(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	defer bundler.Close()
+
+	err = prepareUserOperation(ctx, bundler, op, sponsored)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// prepareUserOperation sets the gas limits estimated by the bundler and then,
+// for a sponsored operation, the data of the paymaster. The paymaster signs
+// the gas limits, they have to be set before it's asked to sponsor the
+// operation. The gas limits returned by the paymaster replace the estimated
+// ones, as they account for its verification
+func prepareUserOperation(ctx context.Context, bundler *BundlerClient, op *UserOperation, sponsored bool) error {
+	estimate, err := bundler.EstimateUserOperationGas(ctx, op, EntryPointAddress)
+	if err != nil {
+		return err
+	}
+	op.CallGasLimit = estimate.CallGasLimit
+	op.VerificationGasLimit = estimate.VerificationGasLimit
+	op.PreVerificationGas = estimate.PreVerificationGas
+
+	if !sponsored {
+		return nil
+	}
+
+	sponsorship, err := bundler.SponsorUserOperation(ctx, op, EntryPointAddress)
+	if err != nil {
+		return err
+	}
+	op.PaymasterAndData = sponsorship.PaymasterAndData
+	if sponsorship.CallGasLimit != nil && sponsorship.VerificationGasLimit != nil && sponsorship.PreVerificationGas != nil {
+		op.CallGasLimit = sponsorship.CallGasLimit
+		op.VerificationGasLimit = sponsorship.VerificationGasLimit
+		op.PreVerificationGas = sponsorship.PreVerificationGas
+	}
+	return nil
+}
+
+func (m *Manager) getVerifiedWalletAccount(address, password string) (*account.SelectedExtKey, error) {
+	exists, err := m.accountsDB.AddressExists(types.HexToAddress(address))
+	if err != nil {
+		log.Error("failed to query db for a given address", "address", address, "error", err)
+		return nil, err
+	}
+
+	if !exists {
+		log.Error("failed to get a selected account", "err", transactions.ErrInvalidTxSender)
+		return nil, transactions.ErrAccountDoesntExist
+	}
+
+	key, err := m.gethManager.VerifyAccountPassword(m.keyStoreDir, address, password)
+	if err != nil {
+		log.Error("failed to verify account", "account", address, "error", err)
+		return nil, err
+	}
+
+	return &account.SelectedExtKey{
+		Address:    key.Address,
+		AccountKey: key,
+	}, nil
+}
+
+// SendUserOperation signs the operation with the key of the owner, sends it
+// to the bundler of the chain and tracks it until it's included
+func (m *Manager) SendUserOperation(ctx context.Context, chainID uint64, owner common.Address, op *UserOperation, password string) (common.Hash, error) {
+	selectedAccount, err := m.getVerifiedWalletAccount(owner.Hex(), password)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	hash, err := op.Hash(EntryPointAddress, chainID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	// SimpleAccount verifies an EIP-191 signature of the hash
+	signedHash := crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n32"), hash.Bytes())
+	key, err := selectedAccount.PrivateKey()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	signature, err := crypto.Sign(signedHash, key)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	signature[64] += 27
+	op.Signature = signature
+
+	bundler, err := m.bundler(ctx, chainID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	defer bundler.Close()
+
+	opHash, err := bundler.SendUserOperation(ctx, op, EntryPointAddress)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	now := time.Now().Unix()
+	err = m.db.Save(&TrackedUserOperation{
+		Hash:      opHash,
+		ChainID:   chainID,
+		Sender:    op.Sender,
+		Owner:     owner,
+		Status:    StatusPending,
+		Sponsored: len(op.PaymasterAndData) > 0,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	return opHash, err
+}
+
+// DeploySmartAccount sends an operation without calls deploying the smart
+// account of the owner
+func (m *Manager) DeploySmartAccount(ctx context.Context, chainID uint64, owner common.Address, password string, sponsored bool) (common.Hash, error) {
+	smartAccount, err := m.GetSmartAccount(ctx, chainID, owner)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if smartAccount.Deployed {
+		return common.Hash{}, ErrAlreadyDeployed
+	}
+
+	op, err := m.BuildUserOperation(ctx, chainID, owner, nil, sponsored)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return m.SendUserOperation(ctx, chainID, owner, op, password)
+}
+
+func (m *Manager) GetUserOperations(owners []common.Address) ([]*TrackedUserOperation, error) {
+	return m.db.Get(owners, false)
+}
+
+// trackPending checks the receipts of the pending operations
+func (m *Manager) trackPending(ctx context.Context) error {
+	pending, err := m.db.Get(nil, true)
+	if err != nil {
+		return err
+	}
+
+	bundlers := make(map[uint64]*BundlerClient)
+	defer func() {
+		for _, bundler := range bundlers {
+			bundler.Close()
+		}
+	}()
+
+	for _, op := range pending {
+		bundler, ok := bundlers[op.ChainID]
+		if !ok {
+			bundler, err = m.bundler(ctx, op.ChainID)
+			if err != nil {
+				log.Debug("no bundler to track user operation", "chainID", op.ChainID, "hash", op.Hash, "err", err)
+				continue
+			}
+			bundlers[op.ChainID] = bundler
+		}
+
+		receipt, err := bundler.GetUserOperationReceipt(ctx, op.Hash)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Debug("failed to get user operation receipt", "chainID", op.ChainID, "hash", op.Hash, "err", err)
+			continue
+		}
+
+		now := time.Now()
+		switch {
+		case receipt != nil && receipt.Success:
+			op.Status = StatusSuccess
+		case receipt != nil:
+			op.Status = StatusFailed
+			op.Reason = receipt.Reason
+		case now.Sub(time.Unix(op.CreatedAt, 0)) > userOperationTimeout:
+			op.Status = StatusFailed
+			op.Reason = "dropped"
+		default:
+			continue
+		}
+		if receipt != nil && receipt.Receipt != nil {
+			op.TxHash = receipt.Receipt.TxHash
+		}
+		op.UpdatedAt = now.Unix()
+
+		err = m.db.Save(op)
+		if err != nil {
+			return err
+		}
+		m.notify(op)
+	}
+	return nil
+}
+
+func (m *Manager) notify(op *TrackedUserOperation) {
+	if m.eventFeed == nil {
+		return
+	}
+	message, err := json.Marshal(op)
+	if err != nil {
+		log.Error("failed to marshal user operation", "err", err)
+		return
+	}
+	m.eventFeed.Send(walletevent.Event{
+		Type:     EventUserOperationStatusChanged,
+		Accounts: []common.Address{op.Owner, op.Sender},
+		Message:  string(message),
+		At:       time.Now().Unix(),
+		ChainID:  op.ChainID,
+	})
+}
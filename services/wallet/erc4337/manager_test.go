@@ -0,0 +1,82 @@
+package erc4337
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// testBundler records the calls made to the bundler and the paymaster
+type testBundler struct {
+	calls []string
+}
+
+type testBundlerAPI struct {
+	bundler *testBundler
+}
+
+func (api *testBundlerAPI) EstimateUserOperationGas(op UserOperation, entryPoint common.Address) (*GasEstimate, error) {
+	api.bundler.calls = append(api.bundler.calls, "eth_estimateUserOperationGas")
+	if len(op.PaymasterAndData) > 0 {
+		return nil, errors.New("estimated after the paymaster signed")
+	}
+	return &GasEstimate{
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(1)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(2)),
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(3)),
+	}, nil
+}
+
+type testPaymasterAPI struct {
+	bundler *testBundler
+}
+
+func (api *testPaymasterAPI) SponsorUserOperation(op UserOperation, entryPoint common.Address) (*Sponsorship, error) {
+	api.bundler.calls = append(api.bundler.calls, "pm_sponsorUserOperation")
+	if op.CallGasLimit == nil || op.VerificationGasLimit == nil || op.PreVerificationGas == nil {
+		return nil, errors.New("sponsored before the gas was estimated")
+	}
+	return &Sponsorship{PaymasterAndData: []byte{1, 2, 3}}, nil
+}
+
+func newTestBundlerClient(t *testing.T, bundler *testBundler) *BundlerClient {
+	server := gethrpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &testBundlerAPI{bundler: bundler}))
+	require.NoError(t, server.RegisterName("pm", &testPaymasterAPI{bundler: bundler}))
+	t.Cleanup(server.Stop)
+
+	client := gethrpc.DialInProc(server)
+	return &BundlerClient{bundler: client, paymaster: client}
+}
+
+func TestPrepareUserOperation(t *testing.T) {
+	bundler := &testBundler{}
+	client := newTestBundlerClient(t, bundler)
+	defer client.Close()
+
+	op := &UserOperation{
+		Sender:           common.HexToAddress("0x1"),
+		Nonce:            (*hexutil.Big)(big.NewInt(0)),
+		PaymasterAndData: []byte{},
+		Signature:        dummySignature,
+	}
+	require.NoError(t, prepareUserOperation(context.Background(), client, op, true))
+
+	// The paymaster signs the gas limits, it's asked once they're estimated
+	require.Equal(t, []string{"eth_estimateUserOperationGas", "pm_sponsorUserOperation"}, bundler.calls)
+	require.Equal(t, hexutil.Bytes{1, 2, 3}, op.PaymasterAndData)
+	require.Equal(t, int64(3), op.CallGasLimit.ToInt().Int64())
+
+	bundler.calls = nil
+	op.PaymasterAndData = []byte{}
+	require.NoError(t, prepareUserOperation(context.Background(), client, op, false))
+	require.Equal(t, []string{"eth_estimateUserOperationGas"}, bundler.calls)
+	require.Empty(t, op.PaymasterAndData)
+}
@@ -0,0 +1,172 @@
+package erc4337
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// EntryPointAddress is the address of the v0.6 EntryPoint on every chain
+	EntryPointAddress = common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+	// SimpleAccountFactoryAddress is the address of the v0.6 SimpleAccount
+	// factory on every chain
+	SimpleAccountFactoryAddress = common.HexToAddress("0x9406Cc6185a346906296840746125a0E44976454")
+
+	// dummySignature has the length of a signature, so that gas is estimated
+	// before the operation is signed
+	dummySignature = hexutil.MustDecode("0xfffffffffffffffffffffffffffffff0000000000000000000000000000000007aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1c")
+)
+
+const contractsABI = `[
+	{"name":"createAccount","type":"function","inputs":[{"name":"owner","type":"address"},{"name":"salt","type":"uint256"}],"outputs":[{"name":"ret","type":"address"}]},
+	{"name":"getAddress","type":"function","stateMutability":"view","inputs":[{"name":"owner","type":"address"},{"name":"salt","type":"uint256"}],"outputs":[{"name":"","type":"address"}]},
+	{"name":"getNonce","type":"function","stateMutability":"view","inputs":[{"name":"sender","type":"address"},{"name":"key","type":"uint192"}],"outputs":[{"name":"nonce","type":"uint256"}]},
+	{"name":"execute","type":"function","inputs":[{"name":"dest","type":"address"},{"name":"value","type":"uint256"},{"name":"func","type":"bytes"}],"outputs":[]},
+	{"name":"executeBatch","type":"function","inputs":[{"name":"dest","type":"address[]"},{"name":"func","type":"bytes[]"}],"outputs":[]},
+	{"name":"transfer","type":"function","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+const collectiblesMintABI = `[{"name":"mintTo","type":"function","inputs":[{"name":"addresses","type":"address[]"}],"outputs":[]}]`
+const assetsMintABI = `[{"name":"mintTo","type":"function","inputs":[{"name":"addresses","type":"address[]"},{"name":"amounts","type":"uint256[]"}],"outputs":[]}]`
+
+var (
+	parsedContractsABI, _    = abi.JSON(strings.NewReader(contractsABI))
+	parsedCollectiblesABI, _ = abi.JSON(strings.NewReader(collectiblesMintABI))
+	parsedAssetsABI, _       = abi.JSON(strings.NewReader(assetsMintABI))
+
+	addressType, _ = abi.NewType("address", "", nil)
+	uint256Type, _ = abi.NewType("uint256", "", nil)
+	bytes32Type, _ = abi.NewType("bytes32", "", nil)
+
+	// packedUserOperationArgs encode the fields of an operation hashed with
+	// the hashes of its dynamic fields
+	packedUserOperationArgs = abi.Arguments{
+		{Type: addressType}, {Type: uint256Type}, {Type: bytes32Type}, {Type: bytes32Type}, {Type: uint256Type},
+		{Type: uint256Type}, {Type: uint256Type}, {Type: uint256Type}, {Type: uint256Type}, {Type: bytes32Type},
+	}
+	userOperationHashArgs = abi.Arguments{{Type: bytes32Type}, {Type: addressType}, {Type: uint256Type}}
+)
+
+// UserOperation is an ERC-4337 v0.6 user operation, encoded as bundlers
+// expect it
+type UserOperation struct {
+	Sender               common.Address `json:"sender"`
+	Nonce                *hexutil.Big   `json:"nonce"`
+	InitCode             hexutil.Bytes  `json:"initCode"`
+	CallData             hexutil.Bytes  `json:"callData"`
+	CallGasLimit         *hexutil.Big   `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big   `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     hexutil.Bytes  `json:"paymasterAndData"`
+	Signature            hexutil.Bytes  `json:"signature"`
+}
+
+func toInt(b *hexutil.Big) *big.Int {
+	if b == nil {
+		return new(big.Int)
+	}
+	return b.ToInt()
+}
+
+// Hash returns the hash of the operation signed by the owner of the sender,
+// it doesn't cover the signature
+func (op *UserOperation) Hash(entryPoint common.Address, chainID uint64) (common.Hash, error) {
+	packed, err := packedUserOperationArgs.Pack(
+		op.Sender,
+		toInt(op.Nonce),
+		crypto.Keccak256Hash(op.InitCode),
+		crypto.Keccak256Hash(op.CallData),
+		toInt(op.CallGasLimit),
+		toInt(op.VerificationGasLimit),
+		toInt(op.PreVerificationGas),
+		toInt(op.MaxFeePerGas),
+		toInt(op.MaxPriorityFeePerGas),
+		crypto.Keccak256Hash(op.PaymasterAndData),
+	)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	encoded, err := userOperationHashArgs.Pack(crypto.Keccak256Hash(packed), entryPoint, new(big.Int).SetUint64(chainID))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// Call is a call made by a smart account
+type Call struct {
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value,omitempty"`
+	Data  hexutil.Bytes  `json:"data,omitempty"`
+}
+
+// InitCode returns the init code deploying the SimpleAccount of the owner
+func InitCode(factory common.Address, owner common.Address, salt *big.Int) ([]byte, error) {
+	data, err := parsedContractsABI.Pack("createAccount", owner, salt)
+	if err != nil {
+		return nil, err
+	}
+	return append(factory.Bytes(), data...), nil
+}
+
+// ExecuteCallData returns the call data of a SimpleAccount making the calls.
+// A single call can transfer value, a batch can't
+func ExecuteCallData(calls []Call) ([]byte, error) {
+	switch len(calls) {
+	case 0:
+		return []byte{}, nil
+	case 1:
+		return parsedContractsABI.Pack("execute", calls[0].To, toInt(calls[0].Value), []byte(calls[0].Data))
+	}
+
+	dests := make([]common.Address, 0, len(calls))
+	datas := make([][]byte, 0, len(calls))
+	for _, call := range calls {
+		if toInt(call.Value).Sign() != 0 {
+			return nil, ErrBatchValue
+		}
+		dests = append(dests, call.To)
+		datas = append(datas, call.Data)
+	}
+	return parsedContractsABI.Pack("executeBatch", dests, datas)
+}
+
+// TransferCall returns the call transferring the amount of the token, or of
+// the native currency if token is nil
+func TransferCall(token *common.Address, to common.Address, amount *big.Int) (Call, error) {
+	if token == nil {
+		return Call{To: to, Value: (*hexutil.Big)(amount)}, nil
+	}
+	data, err := parsedContractsABI.Pack("transfer", to, amount)
+	if err != nil {
+		return Call{}, err
+	}
+	return Call{To: *token, Data: data}, nil
+}
+
+// MintCall returns the call minting community tokens to the addresses. Amounts
+// are only used by assets, collectibles mint one token per address
+func MintCall(contract common.Address, collectibles bool, addresses []common.Address, amounts []*big.Int) (Call, error) {
+	var data []byte
+	var err error
+	if collectibles {
+		data, err = parsedCollectiblesABI.Pack("mintTo", addresses)
+	} else {
+		if len(amounts) != len(addresses) {
+			return Call{}, ErrMintAmounts
+		}
+		data, err = parsedAssetsABI.Pack("mintTo", addresses, amounts)
+	}
+	if err != nil {
+		return Call{}, err
+	}
+	return Call{To: contract, Data: data}, nil
+}
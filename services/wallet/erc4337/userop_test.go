@@ -0,0 +1,77 @@
+package erc4337
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func TestUserOperationHash(t *testing.T) {
+	op := &UserOperation{
+		Sender:               common.HexToAddress("0x1"),
+		Nonce:                (*hexutil.Big)(big.NewInt(1)),
+		CallData:             []byte{1, 2, 3},
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(100000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(2000000000)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1000000000)),
+		Signature:            dummySignature,
+	}
+
+	hash, err := op.Hash(EntryPointAddress, 1)
+	require.NoError(t, err)
+
+	// The signature isn't hashed
+	op.Signature = []byte{1}
+	sameHash, err := op.Hash(EntryPointAddress, 1)
+	require.NoError(t, err)
+	require.Equal(t, hash, sameHash)
+
+	otherChain, err := op.Hash(EntryPointAddress, 10)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherChain)
+
+	op.Nonce = (*hexutil.Big)(big.NewInt(2))
+	otherNonce, err := op.Hash(EntryPointAddress, 1)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherNonce)
+}
+
+func TestCallData(t *testing.T) {
+	owner := common.HexToAddress("0x1")
+	initCode, err := InitCode(SimpleAccountFactoryAddress, owner, accountSalt)
+	require.NoError(t, err)
+	require.Equal(t, SimpleAccountFactoryAddress.Bytes(), initCode[:20])
+	require.Equal(t, parsedContractsABI.Methods["createAccount"].ID, initCode[20:24])
+
+	token := common.HexToAddress("0x2")
+	to := common.HexToAddress("0x3")
+	transfer, err := TransferCall(&token, to, big.NewInt(10))
+	require.NoError(t, err)
+	require.Equal(t, token, transfer.To)
+
+	native, err := TransferCall(nil, to, big.NewInt(10))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), native.Value.ToInt().Int64())
+
+	callData, err := ExecuteCallData([]Call{native})
+	require.NoError(t, err)
+	require.Equal(t, parsedContractsABI.Methods["execute"].ID, callData[:4])
+
+	_, err = ExecuteCallData([]Call{native, transfer})
+	require.Equal(t, ErrBatchValue, err)
+
+	mint, err := MintCall(token, true, []common.Address{to}, nil)
+	require.NoError(t, err)
+	callData, err = ExecuteCallData([]Call{transfer, mint})
+	require.NoError(t, err)
+	require.Equal(t, parsedContractsABI.Methods["executeBatch"].ID, callData[:4])
+
+	_, err = MintCall(token, false, []common.Address{to}, nil)
+	require.Equal(t, ErrMintAmounts, err)
+}
@@ -0,0 +1,365 @@
+package gasoracle
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/transactions"
+)
+
+const (
+	// feeHistoryWindow is the number of blocks the suggestions are based on
+	feeHistoryWindow = 100
+	// feeHistoryPollBlocks is the number of blocks fetched on each poll, once
+	// the window was filled
+	feeHistoryPollBlocks   = 20
+	feeHistoryPollInterval = 30 * time.Second
+	// feeHistoryMaxAge is how long the history of a chain is used before
+	// being fetched again on request
+	feeHistoryMaxAge = time.Minute
+)
+
+var errEmptyFeeHistory = errors.New("empty fee history")
+
+type FeeLevel int
+
+const (
+	FeeLevelLow FeeLevel = iota
+	FeeLevelMedium
+	FeeLevelHigh
+)
+
+// rewardPercentiles are the percentiles of the priority fees paid in each
+// block, one per fee level
+var rewardPercentiles = []float64{10, 50, 90}
+
+// baseFeeMargins are the percentages of the next base fee the max fee of each
+// level covers. Base fee grows by 12.5% at most per block, so the high level
+// survives 6 full blocks
+var baseFeeMargins = []int64{110, 150, 200}
+
+type FeeSuggestion struct {
+	MaxFeePerGas         *hexutil.Big `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big `json:"maxPriorityFeePerGas,omitempty"`
+	// EstimatedTime is the expected number of seconds until the transaction
+	// is included, 0 when it can't be estimated
+	EstimatedTime int64 `json:"estimatedTime"`
+}
+
+// FeeSuggestions are the EIP-1559 fees for a chain at the low, medium and high
+// levels. On chains without EIP-1559 MaxFeePerGas is the gas price
+type FeeSuggestions struct {
+	ChainID        uint64        `json:"chainId"`
+	EIP1559Enabled bool          `json:"eip1559Enabled"`
+	BaseFee        *hexutil.Big  `json:"baseFee,omitempty"`
+	Low            FeeSuggestion `json:"low"`
+	Medium         FeeSuggestion `json:"medium"`
+	High           FeeSuggestion `json:"high"`
+	UpdatedAt      int64         `json:"updatedAt"`
+}
+
+func (s *FeeSuggestions) level(level FeeLevel) *FeeSuggestion {
+	switch level {
+	case FeeLevelLow:
+		return &s.Low
+	case FeeLevelHigh:
+		return &s.High
+	default:
+		return &s.Medium
+	}
+}
+
+// FillTxArgs sets the fees of the level on the transaction arguments, unless
+// they were already set by the caller
+func (s *FeeSuggestions) FillTxArgs(args *transactions.SendTxArgs, level FeeLevel) {
+	if args.GasPrice != nil || args.MaxFeePerGas != nil {
+		return
+	}
+
+	suggestion := s.level(level)
+	if !s.EIP1559Enabled {
+		args.GasPrice = suggestion.MaxFeePerGas
+		return
+	}
+	args.MaxFeePerGas = suggestion.MaxFeePerGas
+	args.MaxPriorityFeePerGas = suggestion.MaxPriorityFeePerGas
+}
+
+type blockFees struct {
+	number  uint64
+	baseFee *big.Int
+	// rewards are the priority fees at rewardPercentiles
+	rewards []*big.Int
+}
+
+type chainFeeHistory struct {
+	blocks      []*blockFees
+	nextBaseFee *big.Int
+	// gasPrice is only set on chains without EIP-1559
+	gasPrice *big.Int
+	// blockTime is the average number of seconds between blocks
+	blockTime float64
+	updatedAt time.Time
+}
+
+func (h *chainFeeHistory) eip1559Enabled() bool {
+	return h.gasPrice == nil
+}
+
+// merge adds the blocks of the fee history to the window, replacing the
+// blocks already known
+func (h *chainFeeHistory) merge(history *ethereum.FeeHistory) {
+	if history.OldestBlock == nil || len(history.BaseFee) == 0 {
+		return
+	}
+
+	byNumber := make(map[uint64]*blockFees, len(h.blocks)+len(history.Reward))
+	for _, block := range h.blocks {
+		byNumber[block.number] = block
+	}
+	oldest := history.OldestBlock.Uint64()
+	for i, rewards := range history.Reward {
+		if i >= len(history.BaseFee) || len(rewards) != len(rewardPercentiles) {
+			continue
+		}
+		byNumber[oldest+uint64(i)] = &blockFees{
+			number:  oldest + uint64(i),
+			baseFee: history.BaseFee[i],
+			rewards: rewards,
+		}
+	}
+
+	blocks := make([]*blockFees, 0, len(byNumber))
+	for _, block := range byNumber {
+		blocks = append(blocks, block)
+	}
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i].number < blocks[j].number })
+	if len(blocks) > feeHistoryWindow {
+		blocks = blocks[len(blocks)-feeHistoryWindow:]
+	}
+
+	h.blocks = blocks
+	// The base fee of the block after the newest one is returned as well
+	h.nextBaseFee = history.BaseFee[len(history.BaseFee)-1]
+}
+
+func median(values []*big.Int) *big.Int {
+	if len(values) == 0 {
+		return big.NewInt(0)
+	}
+	sorted := make([]*big.Int, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+	return new(big.Int).Set(sorted[len(sorted)/2])
+}
+
+// estimateTime returns the expected seconds until a transaction with the fees
+// is included, assuming it would have been included in any block of the
+// window whose base fee and median priority fee it covers
+func (h *chainFeeHistory) estimateTime(maxFee *big.Int, tip *big.Int) int64 {
+	if len(h.blocks) == 0 || h.blockTime == 0 {
+		return 0
+	}
+
+	included := 0
+	for _, block := range h.blocks {
+		if new(big.Int).Add(block.baseFee, tip).Cmp(maxFee) <= 0 && tip.Cmp(block.rewards[1]) >= 0 {
+			included++
+		}
+	}
+	if included == 0 {
+		return 0
+	}
+
+	// Expected number of blocks for an event of probability included/len
+	blocks := (len(h.blocks) + included - 1) / included
+	return int64(float64(blocks) * h.blockTime)
+}
+
+func (h *chainFeeHistory) suggestions(chainID uint64) *FeeSuggestions {
+	result := &FeeSuggestions{
+		ChainID:        chainID,
+		EIP1559Enabled: h.eip1559Enabled(),
+		UpdatedAt:      h.updatedAt.Unix(),
+	}
+
+	if !result.EIP1559Enabled {
+		for _, level := range []FeeLevel{FeeLevelLow, FeeLevelMedium, FeeLevelHigh} {
+			result.level(level).MaxFeePerGas = (*hexutil.Big)(h.gasPrice)
+		}
+		return result
+	}
+
+	result.BaseFee = (*hexutil.Big)(h.nextBaseFee)
+	for _, level := range []FeeLevel{FeeLevelLow, FeeLevelMedium, FeeLevelHigh} {
+		rewards := make([]*big.Int, 0, len(h.blocks))
+		for _, block := range h.blocks {
+			rewards = append(rewards, block.rewards[level])
+		}
+		tip := median(rewards)
+
+		maxFee := new(big.Int).Mul(h.nextBaseFee, big.NewInt(baseFeeMargins[level]))
+		maxFee.Div(maxFee, big.NewInt(100))
+		maxFee.Add(maxFee, tip)
+
+		suggestion := result.level(level)
+		suggestion.MaxFeePerGas = (*hexutil.Big)(maxFee)
+		suggestion.MaxPriorityFeePerGas = (*hexutil.Big)(tip)
+		suggestion.EstimatedTime = h.estimateTime(maxFee, tip)
+	}
+	return result
+}
+
+// Oracle tracks the recent base and priority fees of the chains and suggests
+// the fees of new transactions
+type Oracle struct {
+	rpcClient *rpc.Client
+
+	histories map[uint64]*chainFeeHistory
+	mutex     sync.Mutex
+	cancel    context.CancelFunc
+}
+
+func NewOracle(rpcClient *rpc.Client) *Oracle {
+	return &Oracle{
+		rpcClient: rpcClient,
+		histories: make(map[uint64]*chainFeeHistory),
+	}
+}
+
+// Start polls the fee history of the enabled networks, without it the history
+// is only fetched when fees are requested
+func (o *Oracle) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	o.cancel = cancel
+
+	go func() {
+		ticker := time.NewTicker(feeHistoryPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				o.refreshAll(ctx)
+			}
+		}
+	}()
+}
+
+func (o *Oracle) Stop() {
+	if o.cancel != nil {
+		o.cancel()
+	}
+}
+
+func (o *Oracle) refreshAll(ctx context.Context) {
+	networks, err := o.rpcClient.NetworkManager.Get(true)
+	if err != nil {
+		log.Warn("failed to get networks for fee history", "err", err)
+		return
+	}
+
+	for _, network := range networks {
+		_, err = o.refresh(ctx, network.ChainID)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Debug("failed to refresh fee history", "chainID", network.ChainID, "err", err)
+		}
+	}
+}
+
+type headerReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+func blockTime(ctx context.Context, client headerReader, oldest uint64, newest uint64) float64 {
+	if newest <= oldest {
+		return 0
+	}
+	var headers [2]*types.Header
+	for i, number := range []uint64{oldest, newest} {
+		header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return 0
+		}
+		headers[i] = header
+	}
+	return float64(headers[1].Time-headers[0].Time) / float64(newest-oldest)
+}
+
+func (o *Oracle) refresh(ctx context.Context, chainID uint64) (*chainFeeHistory, error) {
+	client, err := o.rpcClient.EthClient(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	o.mutex.Lock()
+	previous := o.histories[chainID]
+	o.mutex.Unlock()
+
+	history := &chainFeeHistory{}
+	blockCount := uint64(feeHistoryWindow)
+	if previous != nil {
+		*history = *previous
+		if previous.eip1559Enabled() {
+			blockCount = feeHistoryPollBlocks
+		}
+	}
+
+	feeHistory, err := client.FeeHistory(ctx, blockCount, nil, rewardPercentiles)
+	if err != nil || len(feeHistory.BaseFee) == 0 || feeHistory.BaseFee[0].Sign() == 0 {
+		// Chain without EIP-1559
+		gasPrice, err := client.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		history = &chainFeeHistory{gasPrice: gasPrice}
+	} else {
+		history.gasPrice = nil
+		history.merge(feeHistory)
+		if history.nextBaseFee == nil {
+			return nil, errEmptyFeeHistory
+		}
+		if history.blockTime == 0 && len(history.blocks) > 1 {
+			history.blockTime = blockTime(ctx, client, history.blocks[0].number, history.blocks[len(history.blocks)-1].number)
+		}
+	}
+	history.updatedAt = time.Now()
+
+	o.mutex.Lock()
+	o.histories[chainID] = history
+	o.mutex.Unlock()
+
+	return history, nil
+}
+
+// SuggestFees returns the fees of new transactions on the chain at the low,
+// medium and high levels, with their estimated confirmation times
+func (o *Oracle) SuggestFees(ctx context.Context, chainID uint64) (*FeeSuggestions, error) {
+	o.mutex.Lock()
+	history := o.histories[chainID]
+	o.mutex.Unlock()
+
+	if history == nil || time.Since(history.updatedAt) > feeHistoryMaxAge {
+		var err error
+		history, err = o.refresh(ctx, chainID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return history.suggestions(chainID), nil
+}
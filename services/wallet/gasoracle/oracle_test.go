@@ -0,0 +1,83 @@
+package gasoracle
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/transactions"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testFeeHistory(oldest int64, count int, baseFee int64) *ethereum.FeeHistory {
+	history := &ethereum.FeeHistory{
+		OldestBlock: big.NewInt(oldest),
+	}
+	for i := 0; i < count; i++ {
+		history.BaseFee = append(history.BaseFee, big.NewInt(baseFee))
+		history.Reward = append(history.Reward, []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(int64(3 + i%2))})
+	}
+	// next block
+	history.BaseFee = append(history.BaseFee, big.NewInt(baseFee))
+	return history
+}
+
+func TestMergeFeeHistory(t *testing.T) {
+	history := &chainFeeHistory{}
+	history.merge(testFeeHistory(1, feeHistoryWindow, 100))
+	require.Len(t, history.blocks, feeHistoryWindow)
+	require.Equal(t, uint64(1), history.blocks[0].number)
+
+	history.merge(testFeeHistory(91, feeHistoryPollBlocks, 200))
+	require.Len(t, history.blocks, feeHistoryWindow)
+	require.Equal(t, uint64(11), history.blocks[0].number)
+	require.Equal(t, uint64(110), history.blocks[feeHistoryWindow-1].number)
+	require.Equal(t, int64(200), history.blocks[feeHistoryWindow-1].baseFee.Int64())
+	require.Equal(t, int64(100), history.blocks[0].baseFee.Int64())
+	require.Equal(t, int64(200), history.nextBaseFee.Int64())
+}
+
+func TestSuggestions(t *testing.T) {
+	history := &chainFeeHistory{blockTime: 12}
+	history.merge(testFeeHistory(1, 10, 100))
+
+	fees := history.suggestions(1)
+	require.True(t, fees.EIP1559Enabled)
+	require.Equal(t, int64(100), fees.BaseFee.ToInt().Int64())
+
+	require.Equal(t, int64(1), fees.Low.MaxPriorityFeePerGas.ToInt().Int64())
+	require.Equal(t, int64(111), fees.Low.MaxFeePerGas.ToInt().Int64())
+	require.Equal(t, int64(2), fees.Medium.MaxPriorityFeePerGas.ToInt().Int64())
+	require.Equal(t, int64(152), fees.Medium.MaxFeePerGas.ToInt().Int64())
+	require.Equal(t, int64(4), fees.High.MaxPriorityFeePerGas.ToInt().Int64())
+	require.Equal(t, int64(204), fees.High.MaxFeePerGas.ToInt().Int64())
+
+	// The low tip is below the median tip of every block
+	require.Equal(t, int64(0), fees.Low.EstimatedTime)
+	require.Equal(t, int64(12), fees.Medium.EstimatedTime)
+	require.Equal(t, int64(12), fees.High.EstimatedTime)
+
+	args := &transactions.SendTxArgs{}
+	fees.FillTxArgs(args, FeeLevelHigh)
+	require.Equal(t, fees.High.MaxFeePerGas, args.MaxFeePerGas)
+	require.Equal(t, fees.High.MaxPriorityFeePerGas, args.MaxPriorityFeePerGas)
+	require.Nil(t, args.GasPrice)
+
+	// Fees set by the caller are kept
+	gasPrice := (*hexutil.Big)(big.NewInt(5))
+	args = &transactions.SendTxArgs{GasPrice: gasPrice}
+	fees.FillTxArgs(args, FeeLevelHigh)
+	require.Equal(t, gasPrice, args.GasPrice)
+	require.Nil(t, args.MaxFeePerGas)
+
+	legacy := &chainFeeHistory{gasPrice: big.NewInt(7)}
+	fees = legacy.suggestions(1)
+	require.False(t, fees.EIP1559Enabled)
+	args = &transactions.SendTxArgs{}
+	fees.FillTxArgs(args, FeeLevelLow)
+	require.Equal(t, int64(7), args.GasPrice.ToInt().Int64())
+	require.Nil(t, args.MaxFeePerGas)
+}
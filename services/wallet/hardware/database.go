@@ -0,0 +1,54 @@
+package hardware
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Account is a wallet account whose key is stored in a hardware wallet
+type Account struct {
+	Address common.Address `json:"address"`
+	Path    string         `json:"path"`
+	AddedAt int64          `json:"addedAt"`
+}
+
+type Database struct {
+	db *sql.DB
+}
+
+func NewDatabase(db *sql.DB) *Database {
+	return &Database{db: db}
+}
+
+func (d *Database) Save(address common.Address, path string) error {
+	_, err := d.db.Exec(`INSERT OR REPLACE INTO hardware_wallet_accounts (address, path, added_at) VALUES (?, ?, ?)`,
+		address.Hex(), path, time.Now().Unix())
+	return err
+}
+
+func (d *Database) Delete(address common.Address) error {
+	_, err := d.db.Exec(`DELETE FROM hardware_wallet_accounts WHERE address = ?`, address.Hex())
+	return err
+}
+
+func (d *Database) GetAll() ([]*Account, error) {
+	rows, err := d.db.Query(`SELECT address, path, added_at FROM hardware_wallet_accounts ORDER BY added_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*Account
+	for rows.Next() {
+		var address string
+		account := &Account{}
+		if err := rows.Scan(&address, &account.Path, &account.AddedAt); err != nil {
+			return nil, err
+		}
+		account.Address = common.HexToAddress(address)
+		result = append(result, account)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,134 @@
+package hardware
+
+import (
+	gethaccounts "github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/services/accounts/accountsevent"
+)
+
+// maxDerivedAddresses limits the addresses derived in one request, each one
+// is a round trip to the device
+const maxDerivedAddresses = 20
+
+// Manager keeps the hardware wallet accounts and registers them to the
+// hardware signer, so that signing requests of these accounts are routed to
+// the device
+type Manager struct {
+	db          *Database
+	accountsDB  *accounts.Database
+	wallet      *account.HardwareWallet
+	accountFeed *event.Feed
+}
+
+func NewManager(db *Database, accountsDB *accounts.Database, wallet *account.HardwareWallet, accountFeed *event.Feed) *Manager {
+	return &Manager{
+		db:          db,
+		accountsDB:  accountsDB,
+		wallet:      wallet,
+		accountFeed: accountFeed,
+	}
+}
+
+// Start registers the stored accounts to the hardware signer
+func (m *Manager) Start() {
+	accounts, err := m.db.GetAll()
+	if err != nil {
+		log.Error("failed to load hardware wallet accounts", "error", err)
+		return
+	}
+	for _, acc := range accounts {
+		path, err := gethaccounts.ParseDerivationPath(acc.Path)
+		if err != nil {
+			log.Error("invalid hardware wallet account path", "address", acc.Address, "path", acc.Path, "error", err)
+			continue
+		}
+		m.wallet.AddAccount(acc.Address, path)
+	}
+}
+
+// DeriveAddresses returns the addresses of the device under the base path,
+// to choose the accounts to add
+func (m *Manager) DeriveAddresses(basePath string, start uint32, count uint32) ([]account.DerivedAddress, error) {
+	base, err := gethaccounts.ParseDerivationPath(basePath)
+	if err != nil {
+		return nil, err
+	}
+	if count > maxDerivedAddresses {
+		count = maxDerivedAddresses
+	}
+	return m.wallet.DeriveAddresses(base, start, count)
+}
+
+// AddAccount displays the address of the path on the device and adds the
+// account once the user confirms it. The account is saved among the wallet
+// accounts, without a keypair as its key isn't on this device
+func (m *Manager) AddAccount(path string, name string) (common.Address, error) {
+	derivationPath, err := gethaccounts.ParseDerivationPath(path)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	address, err := m.wallet.Derive(derivationPath, true)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if err := m.db.Save(address, derivationPath.String()); err != nil {
+		return common.Address{}, err
+	}
+	m.wallet.AddAccount(address, derivationPath)
+
+	if err := m.saveWalletAccount(address, derivationPath.String(), name); err != nil {
+		return common.Address{}, err
+	}
+	return address, nil
+}
+
+func (m *Manager) saveWalletAccount(address common.Address, path string, name string) error {
+	_, err := m.accountsDB.GetAccountByAddress(types.Address(address))
+	if err == nil {
+		return nil
+	}
+	if err != accounts.ErrDbAccountNotFound {
+		return err
+	}
+
+	position, err := m.accountsDB.GetPositionForNextNewAccount()
+	if err != nil {
+		return err
+	}
+	err = m.accountsDB.SaveOrUpdateAccounts([]*accounts.Account{{
+		Address:  types.Address(address),
+		Path:     path,
+		Name:     name,
+		Operable: accounts.AccountFullyOperable,
+		Position: position,
+	}}, false)
+	if err != nil {
+		return err
+	}
+
+	m.accountFeed.Send(accountsevent.Event{
+		Type:     accountsevent.EventTypeAdded,
+		Accounts: []common.Address{address},
+	})
+	return nil
+}
+
+func (m *Manager) RemoveAccount(address common.Address) error {
+	if err := m.db.Delete(address); err != nil {
+		return err
+	}
+	m.wallet.RemoveAccount(address)
+	return nil
+}
+
+func (m *Manager) GetAccounts() ([]*Account, error) {
+	return m.db.GetAll()
+}
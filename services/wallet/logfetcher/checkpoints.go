@@ -0,0 +1,40 @@
+package logfetcher
+
+import (
+	"database/sql"
+)
+
+type CheckpointsDB struct {
+	db *sql.DB
+}
+
+func NewCheckpointsDB(sqlDb *sql.DB) *CheckpointsDB {
+	return &CheckpointsDB{
+		db: sqlDb,
+	}
+}
+
+// lastBlock returns the last block handled by the scan of key, and false if
+// it never ran
+func (c *CheckpointsDB) lastBlock(chainID uint64, key string) (uint64, bool, error) {
+	var block uint64
+	err := c.db.QueryRow(`SELECT block FROM log_fetch_checkpoints WHERE chain_id = ? AND key = ?`, chainID, key).Scan(&block)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return block, true, nil
+}
+
+func (c *CheckpointsDB) saveBlock(chainID uint64, key string, block uint64) error {
+	_, err := c.db.Exec(`INSERT OR REPLACE INTO log_fetch_checkpoints (chain_id, key, block) VALUES (?, ?, ?)`, chainID, key, block)
+	return err
+}
+
+// DeleteCheckpoint drops the checkpoint of key, the next scan starts over
+func (c *CheckpointsDB) DeleteCheckpoint(chainID uint64, key string) error {
+	_, err := c.db.Exec(`DELETE FROM log_fetch_checkpoints WHERE chain_id = ? AND key = ?`, chainID, key)
+	return err
+}
@@ -0,0 +1,263 @@
+package logfetcher
+
+import (
+	"context"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/time/rate"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// requestsPerSecond is the number of logs requests per second on a
+	// chain, shared by all the fetchers of the chain
+	requestsPerSecond = 10
+	requestsBurst     = 5
+	// growAfter is the number of successful requests after which a block
+	// range shrunk by the provider is doubled again, the density of the logs
+	// changes along the chain
+	growAfter = 10
+)
+
+// tooManyResultsErrors are the messages of the providers rejecting a logs
+// request because of its block range or of the size of its result. Rate
+// limiting errors aren't among them, splitting the range doesn't help
+var tooManyResultsErrors = []string{
+	"query returned more than",
+	"log response size exceeded",
+	"response size exceeded",
+	"response size should not greater than",
+	"block range",
+	"query timeout exceeded",
+}
+
+// suggestedRange matches the block range some providers suggest along with
+// the error, e.g. "this block range should work: [0x1, 0x2]"
+var suggestedRange = regexp.MustCompile(`\[(0x[0-9a-fA-F]+), (0x[0-9a-fA-F]+)\]`)
+
+var (
+	limitersLock sync.Mutex
+	limiters     = make(map[uint64]*rate.Limiter)
+)
+
+func limiter(chainID uint64) *rate.Limiter {
+	limitersLock.Lock()
+	defer limitersLock.Unlock()
+	l, ok := limiters[chainID]
+	if !ok {
+		l = rate.NewLimiter(requestsPerSecond, requestsBurst)
+		limiters[chainID] = l
+	}
+	return l
+}
+
+func isTooManyResults(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, s := range tooManyResultsErrors {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// suggestedBlockRange returns the number of blocks of the range suggested by
+// the provider, or 0 when there's none
+func suggestedBlockRange(err error) uint64 {
+	m := suggestedRange.FindStringSubmatch(err.Error())
+	if m == nil {
+		return 0
+	}
+	from, err1 := hexutil.DecodeUint64(m[1])
+	to, err2 := hexutil.DecodeUint64(m[2])
+	if err1 != nil || err2 != nil || to < from {
+		return 0
+	}
+	return to - from + 1
+}
+
+type Client interface {
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// Handler processes the logs of the blocks up to end, the ranges are handed
+// over in order
+type Handler func(logs []types.Log, end uint64) error
+
+// Fetcher fetches the logs over ranges of any size. The ranges are split when
+// the provider rejects them for returning too many results, and the requests
+// are rate limited per chain
+type Fetcher struct {
+	chainID     uint64
+	client      Client
+	checkpoints *CheckpointsDB
+	limiter     *rate.Limiter
+
+	mu         sync.Mutex
+	blockRange uint64
+	successes  int
+}
+
+// NewFetcher returns a fetcher of the logs of the chain, checkpoints may be
+// nil when the fetcher doesn't Scan
+func NewFetcher(chainID uint64, client Client, checkpoints *CheckpointsDB) *Fetcher {
+	return &Fetcher{
+		chainID:     chainID,
+		client:      client,
+		checkpoints: checkpoints,
+		limiter:     limiter(chainID),
+	}
+}
+
+// rangeEnd returns the end of the next request starting at start, it's
+// bounded by step and by the block range learnt from the provider
+func (f *Fetcher) rangeEnd(start, to, step uint64) uint64 {
+	f.mu.Lock()
+	size := f.blockRange
+	f.mu.Unlock()
+	if step > 0 && (size == 0 || step < size) {
+		size = step
+	}
+	if size > 0 && to-start >= size {
+		return start + size - 1
+	}
+	return to
+}
+
+// shrink lowers the block range after a request of size blocks was rejected
+func (f *Fetcher) shrink(size uint64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.successes = 0
+	if suggested := suggestedBlockRange(err); suggested > 0 && suggested < size {
+		f.blockRange = suggested
+	} else {
+		f.blockRange = size / 2
+	}
+	log.Debug("logs block range shrunk", "chainID", f.chainID, "blockRange", f.blockRange, "err", err)
+}
+
+func (f *Fetcher) succeeded() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.blockRange == 0 {
+		return
+	}
+	f.successes++
+	if f.successes >= growAfter {
+		f.successes = 0
+		f.blockRange *= 2
+	}
+}
+
+func (f *Fetcher) filterLogs(ctx context.Context, q ethereum.FilterQuery, from, to uint64) ([]types.Log, error) {
+	if err := f.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	q.FromBlock = new(big.Int).SetUint64(from)
+	q.ToBlock = new(big.Int).SetUint64(to)
+	return f.client.FilterLogs(ctx, q)
+}
+
+// FetchInRanges fetches the logs of the query between from and to, both
+// included, and hands them over by ranges of at most step blocks. A step of
+// 0 doesn't bound the ranges
+func (f *Fetcher) FetchInRanges(ctx context.Context, q ethereum.FilterQuery, from, to, step uint64, handle Handler) error {
+	for start := from; start <= to; {
+		end := f.rangeEnd(start, to, step)
+		logs, err := f.filterLogs(ctx, q, start, end)
+		if isTooManyResults(err) && end > start {
+			f.shrink(end-start+1, err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		f.succeeded()
+
+		err = handle(logs, end)
+		if err != nil {
+			return err
+		}
+		if end == to {
+			break
+		}
+		start = end + 1
+	}
+	return nil
+}
+
+// FetchLogs returns the logs of the query between from and to, both included
+func (f *Fetcher) FetchLogs(ctx context.Context, q ethereum.FilterQuery, from, to uint64) ([]types.Log, error) {
+	var result []types.Log
+	err := f.FetchInRanges(ctx, q, from, to, 0, func(logs []types.Log, end uint64) error {
+		result = append(result, logs...)
+		return nil
+	})
+	return result, err
+}
+
+// Scan fetches the logs of the queries up to the block to, by ranges of at
+// most step blocks. The logs of the queries are merged in the order of the
+// chain for each range. It resumes after the checkpoint of key, or starts at
+// from when there's none, and the checkpoint is moved once a range is handled
+func (f *Fetcher) Scan(ctx context.Context, key string, queries []ethereum.FilterQuery, from, to, step uint64, handle Handler) error {
+	lastBlock, ok, err := f.checkpoints.lastBlock(f.chainID, key)
+	if err != nil {
+		return err
+	}
+	if ok && lastBlock >= from {
+		from = lastBlock + 1
+	}
+
+	for start := from; start <= to; {
+		end := to
+		if step > 0 && to-start >= step {
+			end = start + step - 1
+		}
+
+		var logs []types.Log
+		for _, q := range queries {
+			queryLogs, err := f.FetchLogs(ctx, q, start, end)
+			if err != nil {
+				return err
+			}
+			logs = append(logs, queryLogs...)
+		}
+		if len(queries) > 1 {
+			sort.SliceStable(logs, func(i, j int) bool {
+				if logs[i].BlockNumber != logs[j].BlockNumber {
+					return logs[i].BlockNumber < logs[j].BlockNumber
+				}
+				return logs[i].Index < logs[j].Index
+			})
+		}
+
+		err = handle(logs, end)
+		if err != nil {
+			return err
+		}
+		err = f.checkpoints.saveBlock(f.chainID, key, end)
+		if err != nil {
+			return err
+		}
+		start = end + 1
+	}
+	return nil
+}
+
+// DeleteCheckpoint drops the checkpoint of key, the next scan starts over
+func (f *Fetcher) DeleteCheckpoint(key string) error {
+	return f.checkpoints.DeleteCheckpoint(f.chainID, key)
+}
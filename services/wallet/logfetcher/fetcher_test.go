@@ -0,0 +1,138 @@
+package logfetcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/appdatabase"
+)
+
+// testClient returns a log per block, and rejects the ranges of more than
+// maxRange blocks
+type testClient struct {
+	maxRange uint64
+	err      error
+	ranges   [][2]uint64
+}
+
+func (c *testClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	c.ranges = append(c.ranges, [2]uint64{from, to})
+	if c.err != nil {
+		return nil, c.err
+	}
+	if to-from+1 > c.maxRange {
+		return nil, errors.New("query returned more than 10000 results")
+	}
+	logs := make([]types.Log, 0, to-from+1)
+	for block := from; block <= to; block++ {
+		logs = append(logs, types.Log{BlockNumber: block})
+	}
+	return logs, nil
+}
+
+func requireBlocks(t *testing.T, logs []types.Log, from, to uint64) {
+	require.Len(t, logs, int(to-from+1))
+	for i, l := range logs {
+		require.Equal(t, from+uint64(i), l.BlockNumber)
+	}
+}
+
+func TestFetchLogsSplitsRange(t *testing.T) {
+	client := &testClient{maxRange: 30}
+	fetcher := NewFetcher(1, client, nil)
+
+	logs, err := fetcher.FetchLogs(context.Background(), ethereum.FilterQuery{}, 0, 99)
+	require.NoError(t, err)
+	requireBlocks(t, logs, 0, 99)
+
+	// The range learnt is kept for the next requests
+	client.ranges = nil
+	logs, err = fetcher.FetchLogs(context.Background(), ethereum.FilterQuery{}, 100, 149)
+	require.NoError(t, err)
+	requireBlocks(t, logs, 100, 149)
+	require.Equal(t, [][2]uint64{{100, 124}, {125, 149}}, client.ranges)
+}
+
+func TestFetchLogsSuggestedRange(t *testing.T) {
+	client := &testClient{
+		err: errors.New("Log response size exceeded. Based on your parameters, this block range should work: [0x0, 0x9]"),
+	}
+	fetcher := NewFetcher(1, client, nil)
+
+	_, err := fetcher.FetchLogs(context.Background(), ethereum.FilterQuery{}, 0, 99)
+	require.Error(t, err)
+	// The range is shrunk to the one suggested until a single block is left
+	require.Equal(t, [2]uint64{0, 9}, client.ranges[1])
+	require.Equal(t, [2]uint64{0, 0}, client.ranges[len(client.ranges)-1])
+}
+
+func TestFetchLogsOtherError(t *testing.T) {
+	client := &testClient{err: errors.New("429 Too Many Requests")}
+	fetcher := NewFetcher(1, client, nil)
+
+	_, err := fetcher.FetchLogs(context.Background(), ethereum.FilterQuery{}, 0, 99)
+	require.Error(t, err)
+	require.Len(t, client.ranges, 1)
+}
+
+func TestScanResumesFromCheckpoint(t *testing.T) {
+	db, err := appdatabase.SetupTestMemorySQLDB("logfetcher-tests")
+	require.NoError(t, err)
+	defer db.Close()
+
+	client := &testClient{maxRange: 1000}
+	fetcher := NewFetcher(1, client, NewCheckpointsDB(db))
+
+	var logs []types.Log
+	failAt := uint64(29)
+	handle := func(chunk []types.Log, end uint64) error {
+		if end == failAt {
+			return fmt.Errorf("failed at %d", end)
+		}
+		logs = append(logs, chunk...)
+		return nil
+	}
+
+	err = fetcher.Scan(context.Background(), "test", []ethereum.FilterQuery{{}}, 0, 49, 10, handle)
+	require.Error(t, err)
+	requireBlocks(t, logs, 0, 19)
+
+	failAt = 0
+	err = fetcher.Scan(context.Background(), "test", []ethereum.FilterQuery{{}}, 0, 49, 10, handle)
+	require.NoError(t, err)
+	requireBlocks(t, logs, 0, 49)
+
+	// Nothing is fetched again once the range was scanned
+	client.ranges = nil
+	err = fetcher.Scan(context.Background(), "test", []ethereum.FilterQuery{{}}, 0, 49, 10, handle)
+	require.NoError(t, err)
+	require.Empty(t, client.ranges)
+}
+
+func TestScanMergesQueries(t *testing.T) {
+	db, err := appdatabase.SetupTestMemorySQLDB("logfetcher-tests")
+	require.NoError(t, err)
+	defer db.Close()
+
+	client := &testClient{maxRange: 1000}
+	fetcher := NewFetcher(1, client, NewCheckpointsDB(db))
+
+	var logs []types.Log
+	err = fetcher.Scan(context.Background(), "test", []ethereum.FilterQuery{{}, {}}, 0, 9, 5, func(chunk []types.Log, end uint64) error {
+		logs = append(logs, chunk...)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 20)
+	for i := 1; i < len(logs); i++ {
+		require.LessOrEqual(t, logs[i-1].BlockNumber, logs[i].BlockNumber)
+	}
+}
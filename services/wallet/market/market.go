@@ -1,6 +1,8 @@
 package market
 
 import (
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -17,6 +19,17 @@ const (
 	EventMarketStatusChanged walletevent.EventType = "wallet-market-status-changed"
 )
 
+const (
+	// rateLimitBackoff is how long a provider which rate limited a request
+	// isn't called
+	rateLimitBackoff = 5 * time.Minute
+	// candlesCacheTTL is how long the historical candles are served from the
+	// cache
+	candlesCacheTTL = 5 * time.Minute
+)
+
+var ErrNoProvider = errors.New("no market data provider available")
+
 type DataPoint struct {
 	Price     float64
 	UpdatedAt int64
@@ -24,33 +37,79 @@ type DataPoint struct {
 
 type DataPerTokenAndCurrency = map[string]map[string]DataPoint
 
+type candlesCacheEntry struct {
+	candles   []thirdparty.HistoricalCandle
+	updatedAt time.Time
+}
+
+// providerCall is a call to a provider, named after the circuit breaker of
+// the provider
+type providerCall struct {
+	name string
+	call func() (any, error)
+}
+
 type Manager struct {
-	main            thirdparty.MarketDataProvider
-	fallback        thirdparty.MarketDataProvider
-	feed            *event.Feed
-	priceCache      DataPerTokenAndCurrency
-	priceCacheLock  sync.RWMutex
-	IsConnected     bool
-	LastCheckedAt   int64
-	IsConnectedLock sync.RWMutex
+	// providers are called in order, the next one is called when a provider
+	// fails or is rate limited
+	providers []thirdparty.MarketDataProvider
+	// oracles are only called for prices, after the providers
+	oracles          []thirdparty.PriceProvider
+	rateLimitedUntil map[string]time.Time
+	rateLimitLock    sync.Mutex
+	feed             *event.Feed
+	priceCache       DataPerTokenAndCurrency
+	priceCacheLock   sync.RWMutex
+	candlesCache     map[string]candlesCacheEntry
+	candlesCacheLock sync.RWMutex
+	IsConnected      bool
+	LastCheckedAt    int64
+	IsConnectedLock  sync.RWMutex
 }
 
 func NewManager(main thirdparty.MarketDataProvider, fallback thirdparty.MarketDataProvider, feed *event.Feed) *Manager {
-	hystrix.ConfigureCommand("marketClient", hystrix.CommandConfig{
+	providers := []thirdparty.MarketDataProvider{main}
+	if fallback != nil {
+		providers = append(providers, fallback)
+	}
+	return NewManagerWithProviders(providers, nil, feed)
+}
+
+func NewManagerWithProviders(providers []thirdparty.MarketDataProvider, oracles []thirdparty.PriceProvider, feed *event.Feed) *Manager {
+	for i := range providers {
+		configureCircuit(marketCircuitName(i))
+	}
+	for i := range oracles {
+		configureCircuit(oracleCircuitName(i))
+	}
+
+	return &Manager{
+		providers:        providers,
+		oracles:          oracles,
+		rateLimitedUntil: make(map[string]time.Time),
+		feed:             feed,
+		priceCache:       make(DataPerTokenAndCurrency),
+		candlesCache:     make(map[string]candlesCacheEntry),
+		IsConnected:      true,
+		LastCheckedAt:    time.Now().Unix(),
+	}
+}
+
+func configureCircuit(name string) {
+	hystrix.ConfigureCommand(name, hystrix.CommandConfig{
 		Timeout:               10000,
 		MaxConcurrentRequests: 100,
 		SleepWindow:           300000,
 		ErrorPercentThreshold: 25,
 	})
+}
 
-	return &Manager{
-		main:          main,
-		fallback:      fallback,
-		feed:          feed,
-		priceCache:    make(DataPerTokenAndCurrency),
-		IsConnected:   true,
-		LastCheckedAt: time.Now().Unix(),
-	}
+func marketCircuitName(index int) string {
+	return fmt.Sprintf("marketClient-%d", index)
+}
+
+func oracleCircuitName(index int) string {
+	return fmt.Sprintf("marketOracle-%d", index)
 }
 
 func (pm *Manager) setIsConnected(value bool) {
@@ -72,48 +131,66 @@ func (pm *Manager) setIsConnected(value bool) {
 	pm.IsConnected = value
 }
 
-func (pm *Manager) makeCall(main func() (any, error), fallback func() (any, error)) (any, error) {
-	resultChan := make(chan any, 1)
-	errChan := hystrix.Go("marketClient", func() error {
-		res, err := main()
-		if err != nil {
-			return err
-		}
-		pm.setIsConnected(true)
-		resultChan <- res
-		return nil
-	}, func(err error) error {
-		if pm.fallback == nil {
-			return err
+func (pm *Manager) isRateLimited(name string) bool {
+	pm.rateLimitLock.Lock()
+	defer pm.rateLimitLock.Unlock()
+	return time.Now().Before(pm.rateLimitedUntil[name])
+}
+
+func (pm *Manager) setRateLimited(name string) {
+	pm.rateLimitLock.Lock()
+	defer pm.rateLimitLock.Unlock()
+	pm.rateLimitedUntil[name] = time.Now().Add(rateLimitBackoff)
+}
+
+func (pm *Manager) marketCalls(call func(provider thirdparty.MarketDataProvider) (any, error)) []providerCall {
+	calls := make([]providerCall, 0, len(pm.providers))
+	for i, provider := range pm.providers {
+		provider := provider
+		calls = append(calls, providerCall{
+			name: marketCircuitName(i),
+			call: func() (any, error) { return call(provider) },
+		})
+	}
+	return calls
+}
+
+// makeCall tries the calls in order until one succeeds, skipping the
+// providers which are rate limited or whose circuit is open
+func (pm *Manager) makeCall(calls []providerCall) (any, error) {
+	err := ErrNoProvider
+	for _, c := range calls {
+		if pm.isRateLimited(c.name) {
+			continue
 		}
 
-		res, err := fallback()
-		if err != nil {
-			pm.setIsConnected(false)
-			return err
+		var result any
+		err = hystrix.Do(c.name, func() error {
+			res, err := c.call()
+			if err != nil {
+				return err
+			}
+			result = res
+			return nil
+		}, nil)
+		if err == nil {
+			pm.setIsConnected(true)
+			return result, nil
 		}
-		pm.setIsConnected(true)
-		resultChan <- res
-		return nil
-	})
-	select {
-	case result := <-resultChan:
-		return result, nil
-	case err := <-errChan:
 
-		return nil, err
+		if errors.Is(err, thirdparty.ErrRateLimited) {
+			pm.setRateLimited(c.name)
+		}
 	}
+
+	pm.setIsConnected(false)
+	return nil, err
 }
 
 func (pm *Manager) FetchHistoricalDailyPrices(symbol string, currency string, limit int, allData bool, aggregate int) ([]thirdparty.HistoricalPrice, error) {
-	prices, err := pm.makeCall(
-		func() (any, error) {
-			return pm.main.FetchHistoricalDailyPrices(symbol, currency, limit, allData, aggregate)
-		},
-		func() (any, error) {
-			return pm.fallback.FetchHistoricalDailyPrices(symbol, currency, limit, allData, aggregate)
-		},
-	)
+	prices, err := pm.makeCall(pm.marketCalls(func(provider thirdparty.MarketDataProvider) (any, error) {
+		return provider.FetchHistoricalDailyPrices(symbol, currency, limit, allData, aggregate)
+	}))
 	if err != nil {
 		return nil, err
 	}
@@ -122,14 +199,9 @@ func (pm *Manager) FetchHistoricalDailyPrices(symbol string, currency string, li
 }
 
 func (pm *Manager) FetchHistoricalHourlyPrices(symbol string, currency string, limit int, aggregate int) ([]thirdparty.HistoricalPrice, error) {
-	prices, err := pm.makeCall(
-		func() (any, error) {
-			return pm.main.FetchHistoricalHourlyPrices(symbol, currency, limit, aggregate)
-		},
-		func() (any, error) {
-			return pm.fallback.FetchHistoricalHourlyPrices(symbol, currency, limit, aggregate)
-		},
-	)
+	prices, err := pm.makeCall(pm.marketCalls(func(provider thirdparty.MarketDataProvider) (any, error) {
+		return provider.FetchHistoricalHourlyPrices(symbol, currency, limit, aggregate)
+	}))
 	if err != nil {
 		return nil, err
 	}
@@ -137,15 +209,51 @@ func (pm *Manager) FetchHistoricalHourlyPrices(symbol string, currency string, l
 	return prices.([]thirdparty.HistoricalPrice), nil
 }
 
+// FetchHistoricalCandles returns the candles of the token from the providers
+// serving them, they're cached for a few minutes
+func (pm *Manager) FetchHistoricalCandles(symbol string, currency string, interval thirdparty.CandleInterval, limit int) ([]thirdparty.HistoricalCandle, error) {
+	key := fmt.Sprintf("%s-%s-%s-%d", symbol, currency, interval, limit)
+
+	pm.candlesCacheLock.RLock()
+	entry, ok := pm.candlesCache[key]
+	pm.candlesCacheLock.RUnlock()
+	if ok && time.Since(entry.updatedAt) < candlesCacheTTL {
+		return entry.candles, nil
+	}
+
+	var calls []providerCall
+	for i, provider := range pm.providers {
+		candlesProvider, ok := provider.(thirdparty.CandlesProvider)
+		if !ok {
+			continue
+		}
+		calls = append(calls, providerCall{
+			name: marketCircuitName(i),
+			call: func() (any, error) {
+				return candlesProvider.FetchHistoricalCandles(symbol, currency, interval, limit)
+			},
+		})
+	}
+
+	candles, err := pm.makeCall(calls)
+	if err != nil {
+		return nil, err
+	}
+
+	pm.candlesCacheLock.Lock()
+	defer pm.candlesCacheLock.Unlock()
+	pm.candlesCache[key] = candlesCacheEntry{
+		candles:   candles.([]thirdparty.HistoricalCandle),
+		updatedAt: time.Now(),
+	}
+
+	return candles.([]thirdparty.HistoricalCandle), nil
+}
+
 func (pm *Manager) FetchTokenMarketValues(symbols []string, currency string) (map[string]thirdparty.TokenMarketValues, error) {
-	marketValues, err := pm.makeCall(
-		func() (any, error) {
-			return pm.main.FetchTokenMarketValues(symbols, currency)
-		},
-		func() (any, error) {
-			return pm.fallback.FetchTokenMarketValues(symbols, currency)
-		},
-	)
+	marketValues, err := pm.makeCall(pm.marketCalls(func(provider thirdparty.MarketDataProvider) (any, error) {
+		return provider.FetchTokenMarketValues(symbols, currency)
+	}))
 	if err != nil {
 		return nil, err
 	}
@@ -154,14 +262,9 @@ func (pm *Manager) FetchTokenMarketValues(symbols []string, currency string) (ma
 }
 
 func (pm *Manager) FetchTokenDetails(symbols []string) (map[string]thirdparty.TokenDetails, error) {
-	tokenDetails, err := pm.makeCall(
-		func() (any, error) {
-			return pm.main.FetchTokenDetails(symbols)
-		},
-		func() (any, error) {
-			return pm.fallback.FetchTokenDetails(symbols)
-		},
-	)
+	tokenDetails, err := pm.makeCall(pm.marketCalls(func(provider thirdparty.MarketDataProvider) (any, error) {
+		return provider.FetchTokenDetails(symbols)
+	}))
 	if err != nil {
 		return nil, err
 	}
@@ -182,16 +285,21 @@ func (pm *Manager) FetchPrice(symbol string, currency string) (float64, error) {
 	return prices[symbol][currency], nil
 }
 
+// FetchPrices returns the prices from the market data providers, and from the
+// oracles when none of the providers is available
 func (pm *Manager) FetchPrices(symbols []string, currencies []string) (map[string]map[string]float64, error) {
-	result, err := pm.makeCall(
-		func() (any, error) {
-			return pm.main.FetchPrices(symbols, currencies)
-		},
-		func() (any, error) {
-			return pm.fallback.FetchPrices(symbols, currencies)
-		},
-	)
+	calls := pm.marketCalls(func(provider thirdparty.MarketDataProvider) (any, error) {
+		return provider.FetchPrices(symbols, currencies)
+	})
+	for i, oracle := range pm.oracles {
+		oracle := oracle
+		calls = append(calls, providerCall{
+			name: oracleCircuitName(i),
+			call: func() (any, error) { return oracle.FetchPrices(symbols, currencies) },
+		})
+	}
 
+	result, err := pm.makeCall(calls)
 	if err != nil {
 		return nil, err
 	}
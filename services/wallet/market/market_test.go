@@ -113,3 +113,71 @@ func TestPrice(t *testing.T) {
 		}
 	}
 }
+
+type MockFailingProvider struct {
+	MockPriceProvider
+	err     error
+	calls   int
+	candles []thirdparty.HistoricalCandle
+}
+
+func (mfp *MockFailingProvider) FetchPrices(symbols []string, currencies []string) (map[string]map[string]float64, error) {
+	mfp.calls++
+	if mfp.err != nil {
+		return nil, mfp.err
+	}
+	return mfp.MockPriceProvider.FetchPrices(symbols, currencies)
+}
+
+func (mfp *MockFailingProvider) FetchHistoricalCandles(symbol string, currency string, interval thirdparty.CandleInterval, limit int) ([]thirdparty.HistoricalCandle, error) {
+	mfp.calls++
+	return mfp.candles, mfp.err
+}
+
+func TestPriceFailover(t *testing.T) {
+	rateLimited := &MockFailingProvider{err: thirdparty.ErrRateLimited}
+	available := &MockFailingProvider{}
+	available.setMockPrices(map[string]map[string]float64{"ETH": {"USD": 4.56789}})
+
+	manager := NewManagerWithProviders([]thirdparty.MarketDataProvider{rateLimited, available}, nil, &event.Feed{})
+
+	price, err := manager.FetchPrice("ETH", "USD")
+	require.NoError(t, err)
+	require.Equal(t, 4.56789, price)
+	require.Equal(t, 1, rateLimited.calls)
+
+	// The rate limited provider is skipped until its backoff ends
+	_, err = manager.FetchPrice("ETH", "USD")
+	require.NoError(t, err)
+	require.Equal(t, 1, rateLimited.calls)
+	require.Equal(t, 2, available.calls)
+
+	// The oracles are called when every provider fails
+	available.err = errors.New("unavailable")
+	oracle := NewMockPriceProvider()
+	oracle.setMockPrices(map[string]map[string]float64{"ETH": {"USD": 4.5}})
+	manager = NewManagerWithProviders([]thirdparty.MarketDataProvider{available}, []thirdparty.PriceProvider{oracle}, &event.Feed{})
+
+	price, err = manager.FetchPrice("ETH", "USD")
+	require.NoError(t, err)
+	require.Equal(t, 4.5, price)
+}
+
+func TestHistoricalCandlesCache(t *testing.T) {
+	provider := &MockFailingProvider{
+		candles: []thirdparty.HistoricalCandle{{Timestamp: 1, Open: 1, High: 2, Low: 0.5, Close: 1.5}},
+	}
+	manager := NewManagerWithProviders([]thirdparty.MarketDataProvider{provider}, nil, &event.Feed{})
+
+	candles, err := manager.FetchHistoricalCandles("ETH", "USD", thirdparty.CandleIntervalDay, 1)
+	require.NoError(t, err)
+	require.Equal(t, provider.candles, candles)
+
+	_, err = manager.FetchHistoricalCandles("ETH", "USD", thirdparty.CandleIntervalDay, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, provider.calls)
+
+	_, err = manager.FetchHistoricalCandles("ETH", "USD", thirdparty.CandleIntervalHour, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, provider.calls)
+}
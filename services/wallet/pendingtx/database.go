@@ -0,0 +1,172 @@
+package pendingtx
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type Status int
+
+const (
+	Pending Status = iota
+	Mined
+	// Replaced is a transaction superseded by another one with the same nonce
+	// sent from this device
+	Replaced
+	// Dropped is a transaction whose nonce was used by a transaction this
+	// device doesn't know of
+	Dropped
+)
+
+// TrackedTransaction is a transaction sent from this device
+type TrackedTransaction struct {
+	ChainID    uint64             `json:"chainId"`
+	Hash       common.Hash        `json:"hash"`
+	From       common.Address     `json:"from"`
+	Nonce      uint64             `json:"nonce"`
+	Status     Status             `json:"status"`
+	Stuck      bool               `json:"stuck"`
+	ReplacedBy *common.Hash       `json:"replacedBy,omitempty"`
+	SentAt     int64              `json:"sentAt"`
+	Tx         *types.Transaction `json:"tx"`
+}
+
+type PendingTxDB struct {
+	db *sql.DB
+}
+
+func NewPendingTxDB(sqlDb *sql.DB) *PendingTxDB {
+	return &PendingTxDB{
+		db: sqlDb,
+	}
+}
+
+const selectTracked = `SELECT network_id, hash, from_address, nonce, tx, status, stuck, replaced_by, sent_at FROM tracked_transactions`
+
+func scanTracked(row interface{ Scan(...interface{}) error }) (*TrackedTransaction, error) {
+	t := &TrackedTransaction{}
+	var encoded []byte
+	var replacedBy []byte
+	err := row.Scan(&t.ChainID, &t.Hash, &t.From, &t.Nonce, &encoded, &t.Status, &t.Stuck, &replacedBy, &t.SentAt)
+	if err != nil {
+		return nil, err
+	}
+	if len(replacedBy) > 0 {
+		hash := common.BytesToHash(replacedBy)
+		t.ReplacedBy = &hash
+	}
+	t.Tx = new(types.Transaction)
+	err = t.Tx.UnmarshalBinary(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// saveTransaction stores the transaction, the pending transactions of the
+// same sender with the same nonce are marked as replaced by it and the
+// pending entries shown to the user follow the replacement. It returns the
+// hashes of the transactions replaced
+func (p *PendingTxDB) saveTransaction(t *TrackedTransaction) (replaced []common.Hash, err error) {
+	encoded, err := t.Tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := p.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	rows, err := tx.Query(`SELECT hash FROM tracked_transactions
+		WHERE network_id = ? AND from_address = ? AND nonce = ? AND hash != ? AND status = ?`,
+		t.ChainID, t.From, t.Nonce, t.Hash, Pending)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var hash common.Hash
+		err = rows.Scan(&hash)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		replaced = append(replaced, hash)
+	}
+	rows.Close()
+
+	for _, hash := range replaced {
+		_, err = tx.Exec(`UPDATE tracked_transactions SET status = ?, stuck = FALSE, replaced_by = ? WHERE network_id = ? AND hash = ?`,
+			Replaced, t.Hash, t.ChainID, hash)
+		if err != nil {
+			return nil, err
+		}
+		_, err = tx.Exec(`UPDATE OR IGNORE pending_transactions SET hash = ? WHERE network_id = ? AND hash = ?`,
+			t.Hash, t.ChainID, hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.Exec(`INSERT OR IGNORE INTO tracked_transactions (network_id, hash, from_address, nonce, tx, status, stuck, sent_at)
+		VALUES (?, ?, ?, ?, ?, ?, FALSE, ?)`, t.ChainID, t.Hash, t.From, t.Nonce, encoded, t.Status, t.SentAt)
+	return replaced, err
+}
+
+// getTransaction returns sql.ErrNoRows if the transaction isn't tracked
+func (p *PendingTxDB) getTransaction(chainID uint64, hash common.Hash) (*TrackedTransaction, error) {
+	row := p.db.QueryRow(selectTracked+` WHERE network_id = ? AND hash = ?`, chainID, hash)
+	return scanTracked(row)
+}
+
+// getPending returns the pending transactions on the chains, or on all of
+// them when chainIDs is empty
+func (p *PendingTxDB) getPending(chainIDs []uint64) ([]*TrackedTransaction, error) {
+	query := selectTracked + ` WHERE status = ?`
+	args := []interface{}{Pending}
+	if len(chainIDs) > 0 {
+		query += fmt.Sprintf(` AND network_id IN (%s)`, strings.Repeat("?, ", len(chainIDs)-1)+"?")
+		for _, chainID := range chainIDs {
+			args = append(args, chainID)
+		}
+	}
+	rows, err := p.db.Query(query+` ORDER BY sent_at`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []*TrackedTransaction{}
+	for rows.Next() {
+		t, err := scanTracked(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, t)
+	}
+	return result, rows.Err()
+}
+
+func (p *PendingTxDB) updateStatus(chainID uint64, hash common.Hash, status Status, stuck bool) error {
+	_, err := p.db.Exec(`UPDATE tracked_transactions SET status = ?, stuck = ? WHERE network_id = ? AND hash = ?`,
+		status, stuck, chainID, hash)
+	return err
+}
+
+// deleteOlderThan drops the transactions no longer pending sent before the
+// timestamp
+func (p *PendingTxDB) deleteOlderThan(timestamp int64) error {
+	_, err := p.db.Exec(`DELETE FROM tracked_transactions WHERE status != ? AND sent_at < ?`, Pending, timestamp)
+	return err
+}
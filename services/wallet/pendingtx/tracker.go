@@ -0,0 +1,326 @@
+package pendingtx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	statustypes "github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/services/wallet/gasoracle"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+	"github.com/status-im/status-go/transactions"
+)
+
+const (
+	// EventPendingTransactionStuck is sent when a transaction sent from this
+	// device is stuck, it can be sped up or cancelled
+	EventPendingTransactionStuck walletevent.EventType = "wallet-pending-transaction-stuck"
+	// EventPendingTransactionStatusChanged is sent when a transaction sent
+	// from this device was mined, replaced or dropped
+	EventPendingTransactionStatusChanged walletevent.EventType = "wallet-pending-transaction-status-changed"
+
+	checkInterval = 30 * time.Second
+	// stuckAfter is how long a transaction stays pending before it's
+	// considered stuck
+	stuckAfter = 10 * time.Minute
+	// keepFor is how long the transactions no longer pending are kept
+	keepFor = 30 * 24 * time.Hour
+	// feeBumpPercent is the fee increase of the replacements, the nodes
+	// reject the replacements which don't pay at least 10% more
+	feeBumpPercent = 12
+	cancelGas      = 21000
+)
+
+var (
+	ErrTransactionNotTracked = errors.New("transaction not tracked")
+	ErrNotPending            = errors.New("transaction isn't pending")
+)
+
+type chainClient interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Tracker tracks the transactions sent from this device, whatever service
+// sent them, detects the ones which are stuck and builds their replacements
+type Tracker struct {
+	db        *PendingTxDB
+	client    func(chainID uint64) (chainClient, error)
+	fees      func(ctx context.Context, chainID uint64) (*gasoracle.FeeSuggestions, error)
+	eventFeed *event.Feed
+
+	cancel context.CancelFunc
+}
+
+func NewTracker(db *PendingTxDB, rpcClient *rpc.Client, gasOracle *gasoracle.Oracle, eventFeed *event.Feed) *Tracker {
+	return &Tracker{
+		db: db,
+		client: func(chainID uint64) (chainClient, error) {
+			return rpcClient.EthClient(chainID)
+		},
+		fees:      gasOracle.SuggestFees,
+		eventFeed: eventFeed,
+	}
+}
+
+func (t *Tracker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+
+	go func() {
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			err := t.checkAll(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Warn("failed to check pending transactions", "err", err)
+			}
+		}
+	}()
+}
+
+func (t *Tracker) Stop() {
+	if t.cancel != nil {
+		t.cancel()
+	}
+}
+
+func (t *Tracker) sendEvent(eventType walletevent.EventType, tracked *TrackedTransaction, message string) {
+	if t.eventFeed == nil {
+		return
+	}
+	t.eventFeed.Send(walletevent.Event{
+		Type:     eventType,
+		ChainID:  tracked.ChainID,
+		Accounts: []common.Address{tracked.From},
+		Message:  message,
+	})
+}
+
+// Track records a transaction sent through the chain client, it's the
+// notifier of the sent transactions of the RPC client
+func (t *Tracker) Track(chainID uint64, tx *types.Transaction) {
+	from, err := types.Sender(types.LatestSignerForChainID(new(big.Int).SetUint64(chainID)), tx)
+	if err != nil {
+		log.Warn("failed to get the sender of a sent transaction", "chainID", chainID, "hash", tx.Hash(), "err", err)
+		return
+	}
+
+	tracked := &TrackedTransaction{
+		ChainID: chainID,
+		Hash:    tx.Hash(),
+		From:    from,
+		Nonce:   tx.Nonce(),
+		Status:  Pending,
+		SentAt:  time.Now().Unix(),
+		Tx:      tx,
+	}
+	replaced, err := t.db.saveTransaction(tracked)
+	if err != nil {
+		log.Warn("failed to track a sent transaction", "chainID", chainID, "hash", tx.Hash(), "err", err)
+		return
+	}
+	for _, hash := range replaced {
+		t.sendEvent(EventPendingTransactionStatusChanged, &TrackedTransaction{ChainID: chainID, From: from}, hash.Hex())
+	}
+}
+
+// GetPending returns the pending transactions sent from this device on the
+// chains
+func (t *Tracker) GetPending(chainIDs []uint64) ([]*TrackedTransaction, error) {
+	return t.db.getPending(chainIDs)
+}
+
+func (t *Tracker) checkAll(ctx context.Context) error {
+	pending, err := t.db.getPending(nil)
+	if err != nil {
+		return err
+	}
+
+	byChain := make(map[uint64][]*TrackedTransaction)
+	for _, tracked := range pending {
+		byChain[tracked.ChainID] = append(byChain[tracked.ChainID], tracked)
+	}
+	for chainID, txs := range byChain {
+		err = t.checkChain(ctx, chainID, txs)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			log.Warn("failed to check pending transactions", "chainID", chainID, "err", err)
+		}
+	}
+
+	return t.db.deleteOlderThan(time.Now().Add(-keepFor).Unix())
+}
+
+func (t *Tracker) checkChain(ctx context.Context, chainID uint64, txs []*TrackedTransaction) error {
+	client, err := t.client(chainID)
+	if err != nil {
+		return err
+	}
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	nonces := make(map[common.Address]uint64)
+	for _, tracked := range txs {
+		status, stuck, err := t.check(ctx, client, head, nonces, tracked)
+		if err != nil {
+			return err
+		}
+		if status == tracked.Status && stuck == tracked.Stuck {
+			continue
+		}
+
+		err = t.db.updateStatus(chainID, tracked.Hash, status, stuck)
+		if err != nil {
+			return err
+		}
+		if status != Pending {
+			t.sendEvent(EventPendingTransactionStatusChanged, tracked, tracked.Hash.Hex())
+		} else if stuck {
+			t.sendEvent(EventPendingTransactionStuck, tracked, tracked.Hash.Hex())
+		}
+	}
+	return nil
+}
+
+// check returns the status of the transaction, and whether it's stuck when
+// it's still pending. A transaction is stuck when it's pending for long or
+// when it pays less than the base fee
+func (t *Tracker) check(ctx context.Context, client chainClient, head *types.Header, nonces map[common.Address]uint64,
+	tracked *TrackedTransaction) (Status, bool, error) {
+	// The nonce is read before the receipt, a transaction mined in between
+	// would otherwise be seen as dropped
+	nonce, ok := nonces[tracked.From]
+	if !ok {
+		var err error
+		nonce, err = client.NonceAt(ctx, tracked.From, nil)
+		if err != nil {
+			return tracked.Status, tracked.Stuck, err
+		}
+		nonces[tracked.From] = nonce
+	}
+
+	receipt, err := client.TransactionReceipt(ctx, tracked.Hash)
+	if err == nil && receipt != nil {
+		return Mined, false, nil
+	}
+	if err != nil && !errors.Is(err, ethereum.NotFound) {
+		return tracked.Status, tracked.Stuck, err
+	}
+	if nonce > tracked.Nonce {
+		return Dropped, false, nil
+	}
+
+	stuck := time.Since(time.Unix(tracked.SentAt, 0)) > stuckAfter
+	if head.BaseFee != nil && tracked.Tx.GasFeeCap().Cmp(head.BaseFee) < 0 {
+		stuck = true
+	}
+	return Pending, stuck, nil
+}
+
+// bump raises the fee by feeBumpPercent, rounding up
+func bump(fee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+feeBumpPercent))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+func maxInt(a, b *big.Int) *big.Int {
+	if b != nil && b.Cmp(a) > 0 {
+		return b
+	}
+	return a
+}
+
+// setReplacementFees sets the fees of the replacement of the transaction,
+// they are bumped from the ones of the transaction and at least the ones
+// currently suggested
+func setReplacementFees(args *transactions.SendTxArgs, tx *types.Transaction, suggestion *gasoracle.FeeSuggestion) {
+	if tx.Type() == types.DynamicFeeTxType {
+		tip := maxInt(bump(tx.GasTipCap()), suggestion.MaxPriorityFeePerGas.ToInt())
+		maxFee := maxInt(bump(tx.GasFeeCap()), suggestion.MaxFeePerGas.ToInt())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tip)
+		args.MaxFeePerGas = (*hexutil.Big)(maxInt(maxFee, tip))
+		return
+	}
+	args.GasPrice = (*hexutil.Big)(maxInt(bump(tx.GasPrice()), suggestion.MaxFeePerGas.ToInt()))
+}
+
+func (t *Tracker) buildReplacement(ctx context.Context, chainID uint64, hash common.Hash,
+	build func(tracked *TrackedTransaction) *transactions.SendTxArgs) (*transactions.SendTxArgs, error) {
+	tracked, err := t.db.getTransaction(chainID, hash)
+	if err == sql.ErrNoRows {
+		return nil, ErrTransactionNotTracked
+	}
+	if err != nil {
+		return nil, err
+	}
+	if tracked.Status != Pending {
+		return nil, ErrNotPending
+	}
+
+	fees, err := t.fees(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	args := build(tracked)
+	nonce := hexutil.Uint64(tracked.Nonce)
+	args.From = statustypes.Address(tracked.From)
+	args.Nonce = &nonce
+	setReplacementFees(args, tracked.Tx, &fees.Medium)
+	return args, nil
+}
+
+// BuildSpeedUp returns the arguments of the transaction replacing the pending
+// one with higher fees, to be signed and sent by its sender
+func (t *Tracker) BuildSpeedUp(ctx context.Context, chainID uint64, hash common.Hash) (*transactions.SendTxArgs, error) {
+	return t.buildReplacement(ctx, chainID, hash, func(tracked *TrackedTransaction) *transactions.SendTxArgs {
+		gas := hexutil.Uint64(tracked.Tx.Gas())
+		args := &transactions.SendTxArgs{
+			Gas:   &gas,
+			Value: (*hexutil.Big)(tracked.Tx.Value()),
+			Input: tracked.Tx.Data(),
+		}
+		if to := tracked.Tx.To(); to != nil {
+			address := statustypes.Address(*to)
+			args.To = &address
+		}
+		return args
+	})
+}
+
+// BuildCancel returns the arguments of the transaction cancelling the pending
+// one, an empty transfer to its sender with the same nonce and higher fees
+func (t *Tracker) BuildCancel(ctx context.Context, chainID uint64, hash common.Hash) (*transactions.SendTxArgs, error) {
+	return t.buildReplacement(ctx, chainID, hash, func(tracked *TrackedTransaction) *transactions.SendTxArgs {
+		gas := hexutil.Uint64(cancelGas)
+		to := statustypes.Address(tracked.From)
+		return &transactions.SendTxArgs{
+			To:    &to,
+			Gas:   &gas,
+			Value: (*hexutil.Big)(big.NewInt(0)),
+		}
+	})
+}
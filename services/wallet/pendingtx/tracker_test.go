@@ -0,0 +1,228 @@
+package pendingtx
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/services/wallet/gasoracle"
+	"github.com/status-im/status-go/transactions"
+)
+
+const testChainID = 5
+
+type testClient struct {
+	mined   map[common.Hash]bool
+	nonce   uint64
+	baseFee *big.Int
+	// minedOnNonce is mined once the nonce is read
+	minedOnNonce common.Hash
+}
+
+func (c *testClient) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	if c.mined[hash] {
+		return &types.Receipt{TxHash: hash}, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func (c *testClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	if c.minedOnNonce != (common.Hash{}) {
+		c.mined[c.minedOnNonce] = true
+		c.nonce++
+	}
+	return c.nonce, nil
+}
+
+func (c *testClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(100), BaseFee: c.baseFee}, nil
+}
+
+func setupTestTracker(t *testing.T) (*Tracker, *testClient, func()) {
+	db, err := appdatabase.SetupTestMemorySQLDB("pendingtx-tests")
+	require.NoError(t, err)
+
+	client := &testClient{mined: make(map[common.Hash]bool), baseFee: big.NewInt(10)}
+	tracker := &Tracker{
+		db: NewPendingTxDB(db),
+		client: func(chainID uint64) (chainClient, error) {
+			return client, nil
+		},
+		fees: func(ctx context.Context, chainID uint64) (*gasoracle.FeeSuggestions, error) {
+			return &gasoracle.FeeSuggestions{
+				ChainID:        chainID,
+				EIP1559Enabled: true,
+				Medium: gasoracle.FeeSuggestion{
+					MaxFeePerGas:         (*hexutil.Big)(big.NewInt(25)),
+					MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(5)),
+				},
+			}, nil
+		},
+	}
+	return tracker, client, func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+func signedTx(t *testing.T, nonce uint64, tip int64, feeCap int64) *types.Transaction {
+	key, err := crypto.HexToECDSA("8a1f9a8f95be41cd7ccb6168179afb4504aefe388d1e14474d32c45c72ce7b7a")
+	require.NoError(t, err)
+	to := common.HexToAddress("0x1")
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(testChainID)), &types.DynamicFeeTx{
+		ChainID:   big.NewInt(testChainID),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(tip),
+		GasFeeCap: big.NewInt(feeCap),
+		Gas:       50000,
+		To:        &to,
+		Value:     big.NewInt(1000),
+		Data:      []byte{1, 2, 3},
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+func TestTrackReplacement(t *testing.T) {
+	tracker, _, stop := setupTestTracker(t)
+	defer stop()
+
+	original := signedTx(t, 3, 2, 20)
+	tracker.Track(testChainID, original)
+	replacement := signedTx(t, 3, 3, 30)
+	tracker.Track(testChainID, replacement)
+
+	pending, err := tracker.GetPending([]uint64{testChainID})
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, replacement.Hash(), pending[0].Hash)
+
+	replaced, err := tracker.db.getTransaction(testChainID, original.Hash())
+	require.NoError(t, err)
+	require.Equal(t, Replaced, replaced.Status)
+	require.Equal(t, replacement.Hash(), *replaced.ReplacedBy)
+}
+
+func TestCheckStatus(t *testing.T) {
+	tracker, client, stop := setupTestTracker(t)
+	defer stop()
+
+	mined := signedTx(t, 1, 2, 20)
+	underpriced := signedTx(t, 2, 2, 5)
+	waiting := signedTx(t, 3, 2, 20)
+	for _, tx := range []*types.Transaction{mined, underpriced, waiting} {
+		tracker.Track(testChainID, tx)
+	}
+	client.mined[mined.Hash()] = true
+	client.nonce = 2
+
+	require.NoError(t, tracker.checkAll(context.Background()))
+
+	tracked, err := tracker.db.getTransaction(testChainID, mined.Hash())
+	require.NoError(t, err)
+	require.Equal(t, Mined, tracked.Status)
+
+	pending, err := tracker.GetPending(nil)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	for _, tracked := range pending {
+		require.Equal(t, tracked.Hash == underpriced.Hash(), tracked.Stuck)
+	}
+
+	// Pending for long
+	require.NoError(t, tracker.db.updateStatus(testChainID, waiting.Hash(), Pending, false))
+	_, err = tracker.db.db.Exec(`UPDATE tracked_transactions SET sent_at = ?`, time.Now().Add(-stuckAfter-time.Minute).Unix())
+	require.NoError(t, err)
+	require.NoError(t, tracker.checkAll(context.Background()))
+	tracked, err = tracker.db.getTransaction(testChainID, waiting.Hash())
+	require.NoError(t, err)
+	require.True(t, tracked.Stuck)
+
+	// Nonce used by a transaction this device doesn't know of
+	client.nonce = 4
+	require.NoError(t, tracker.checkAll(context.Background()))
+	tracked, err = tracker.db.getTransaction(testChainID, waiting.Hash())
+	require.NoError(t, err)
+	require.Equal(t, Dropped, tracked.Status)
+}
+
+func TestCheckMinedMeanwhile(t *testing.T) {
+	tracker, client, stop := setupTestTracker(t)
+	defer stop()
+
+	tx := signedTx(t, 1, 2, 20)
+	tracker.Track(testChainID, tx)
+	client.nonce = 1
+	client.minedOnNonce = tx.Hash()
+
+	require.NoError(t, tracker.checkAll(context.Background()))
+
+	tracked, err := tracker.db.getTransaction(testChainID, tx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, Mined, tracked.Status)
+}
+
+func TestBuildReplacements(t *testing.T) {
+	tracker, _, stop := setupTestTracker(t)
+	defer stop()
+
+	tx := signedTx(t, 7, 10, 100)
+	tracker.Track(testChainID, tx)
+	from, err := types.Sender(types.LatestSignerForChainID(big.NewInt(testChainID)), tx)
+	require.NoError(t, err)
+
+	args, err := tracker.BuildSpeedUp(context.Background(), testChainID, tx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), uint64(*args.Nonce))
+	require.Equal(t, from, common.Address(args.From))
+	require.Equal(t, *tx.To(), common.Address(*args.To))
+	require.Equal(t, tx.Data(), []byte(args.Input))
+	require.Equal(t, tx.Value(), args.Value.ToInt())
+	// Bumped from the original fees, which are above the suggested ones
+	require.Equal(t, big.NewInt(12), args.MaxPriorityFeePerGas.ToInt())
+	require.Equal(t, big.NewInt(112), args.MaxFeePerGas.ToInt())
+
+	args, err = tracker.BuildCancel(context.Background(), testChainID, tx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), uint64(*args.Nonce))
+	require.Equal(t, from, common.Address(*args.To))
+	require.Equal(t, int64(0), args.Value.ToInt().Int64())
+	require.Equal(t, uint64(cancelGas), uint64(*args.Gas))
+	require.Empty(t, args.Input)
+
+	_, err = tracker.BuildSpeedUp(context.Background(), testChainID, common.Hash{1})
+	require.ErrorIs(t, err, ErrTransactionNotTracked)
+
+	require.NoError(t, tracker.db.updateStatus(testChainID, tx.Hash(), Mined, false))
+	_, err = tracker.BuildCancel(context.Background(), testChainID, tx.Hash())
+	require.ErrorIs(t, err, ErrNotPending)
+}
+
+func TestSetReplacementFees(t *testing.T) {
+	suggestion := &gasoracle.FeeSuggestion{
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(25)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(5)),
+	}
+
+	// The suggested fees are used when the original ones are too low
+	args := &transactions.SendTxArgs{}
+	setReplacementFees(args, signedTx(t, 1, 1, 10), suggestion)
+	require.Equal(t, big.NewInt(5), args.MaxPriorityFeePerGas.ToInt())
+	require.Equal(t, big.NewInt(25), args.MaxFeePerGas.ToInt())
+
+	// Legacy transactions get a higher gas price
+	legacy := types.NewTransaction(1, common.Address{1}, big.NewInt(0), 21000, big.NewInt(100), nil)
+	args = &transactions.SendTxArgs{}
+	setReplacementFees(args, legacy, suggestion)
+	require.Nil(t, args.MaxFeePerGas)
+	require.Equal(t, big.NewInt(112), args.GasPrice.ToInt())
+}
@@ -0,0 +1,280 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/scheduler"
+	"github.com/status-im/status-go/services/wallet/bigint"
+	"github.com/status-im/status-go/services/wallet/collectibles"
+)
+
+const (
+	// portfolioCacheTTL is how long a portfolio is returned without being
+	// fetched again
+	portfolioCacheTTL = 2 * time.Minute
+	// portfolioRefreshInterval is how often the portfolios requested recently
+	// are fetched again in the background
+	portfolioRefreshInterval = time.Minute
+	// portfolioCacheIdleTimeout is how long a portfolio is kept in the cache
+	// after it was last requested
+	portfolioCacheIdleTimeout  = 10 * time.Minute
+	portfolioCollectiblesLimit = 200
+)
+
+type PortfolioCollectible struct {
+	ChainID         uint64         `json:"chainId"`
+	ContractAddress common.Address `json:"contractAddress"`
+	TokenID         *bigint.BigInt `json:"tokenId"`
+	Name            string         `json:"name"`
+	ImageURL        string         `json:"imageUrl"`
+	CollectionName  string         `json:"collectionName"`
+}
+
+type AccountPortfolio struct {
+	Address      common.Address          `json:"address"`
+	Tokens       []Token                 `json:"tokens"`
+	Collectibles []*PortfolioCollectible `json:"collectibles"`
+	FiatBalance  float64                 `json:"fiatBalance"`
+}
+
+// Portfolio holds the native, ERC20 and collectible balances of a set of
+// accounts, valued in the currency of the user
+type Portfolio struct {
+	Currency         string              `json:"currency"`
+	Accounts         []*AccountPortfolio `json:"accounts"`
+	TotalFiatBalance float64             `json:"totalFiatBalance"`
+	// UpdatedAt is the unix timestamp of when the balances were fetched
+	UpdatedAt int64 `json:"updatedAt"`
+	// HasError is set when the collectibles of some chains couldn't be fetched
+	HasError bool `json:"hasError"`
+}
+
+type portfolioCacheEntry struct {
+	addresses     []common.Address
+	chainIDs      []uint64
+	portfolio     *Portfolio
+	lastRequested time.Time
+	refreshing    bool
+}
+
+// PortfolioManager fetches portfolios and keeps them in a cache, which is
+// refreshed in the background while the portfolios keep being requested
+type PortfolioManager struct {
+	reader              *Reader
+	collectiblesManager *collectibles.Manager
+	accountsDB          *accounts.Database
+
+	cache      map[string]*portfolioCacheEntry
+	mutex      sync.Mutex
+	unschedule func()
+}
+
+func NewPortfolioManager(reader *Reader, collectiblesManager *collectibles.Manager, accountsDB *accounts.Database) *PortfolioManager {
+	return &PortfolioManager{
+		reader:              reader,
+		collectiblesManager: collectiblesManager,
+		accountsDB:          accountsDB,
+		cache:               make(map[string]*portfolioCacheEntry),
+	}
+}
+
+func portfolioCacheKey(addresses []common.Address, chainIDs []uint64) string {
+	keys := make([]string, 0, len(addresses)+len(chainIDs))
+	for _, address := range addresses {
+		keys = append(keys, address.Hex())
+	}
+	sort.Strings(keys)
+
+	sortedChainIDs := append([]uint64{}, chainIDs...)
+	sort.Slice(sortedChainIDs, func(i, j int) bool { return sortedChainIDs[i] < sortedChainIDs[j] })
+	for _, chainID := range sortedChainIDs {
+		keys = append(keys, fmt.Sprint(chainID))
+	}
+
+	return strings.Join(keys, ",")
+}
+
+func (m *PortfolioManager) Start() {
+	m.unschedule = scheduler.Default().Schedule(scheduler.Job{
+		Name:        "wallet.portfolio-refresh",
+		Interval:    portfolioRefreshInterval,
+		Constraints: scheduler.Constraints{Foreground: true, Online: true, NotOnBatterySaver: true},
+		Run:         m.refreshCache,
+	})
+}
+
+func (m *PortfolioManager) Stop() {
+	if m.unschedule != nil {
+		m.unschedule()
+		m.unschedule = nil
+	}
+}
+
+// refreshCache fetches again the stale portfolios which were requested
+// recently, and evicts the others
+func (m *PortfolioManager) refreshCache(ctx context.Context) {
+	now := time.Now()
+
+	m.mutex.Lock()
+	var toRefresh []string
+	for key, entry := range m.cache {
+		if now.Sub(entry.lastRequested) > portfolioCacheIdleTimeout {
+			delete(m.cache, key)
+			continue
+		}
+		if now.Unix()-entry.portfolio.UpdatedAt >= int64(portfolioCacheTTL.Seconds()) && !entry.refreshing {
+			entry.refreshing = true
+			toRefresh = append(toRefresh, key)
+		}
+	}
+	m.mutex.Unlock()
+
+	for _, key := range toRefresh {
+		m.refresh(ctx, key)
+	}
+}
+
+func (m *PortfolioManager) refresh(ctx context.Context, key string) {
+	m.mutex.Lock()
+	entry, ok := m.cache[key]
+	m.mutex.Unlock()
+	if !ok {
+		return
+	}
+
+	portfolio, err := m.fetch(ctx, entry.addresses, entry.chainIDs)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	entry.refreshing = false
+	if err != nil {
+		log.Warn("failed to refresh portfolio", "err", err)
+		return
+	}
+	entry.portfolio = portfolio
+}
+
+// GetPortfolio returns the cached portfolio of the addresses on the given
+// chains. A stale portfolio is returned as is and refreshed in the
+// background, only portfolios never fetched before are fetched right away
+func (m *PortfolioManager) GetPortfolio(ctx context.Context, addresses []common.Address, chainIDs []uint64) (*Portfolio, error) {
+	key := portfolioCacheKey(addresses, chainIDs)
+	now := time.Now()
+
+	m.mutex.Lock()
+	entry, ok := m.cache[key]
+	if ok {
+		entry.lastRequested = now
+		portfolio := entry.portfolio
+		if now.Unix()-portfolio.UpdatedAt >= int64(portfolioCacheTTL.Seconds()) && !entry.refreshing {
+			entry.refreshing = true
+			go m.refresh(context.Background(), key)
+		}
+		m.mutex.Unlock()
+		return portfolio, nil
+	}
+	m.mutex.Unlock()
+
+	portfolio, err := m.fetch(ctx, addresses, chainIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	m.cache[key] = &portfolioCacheEntry{
+		addresses:     addresses,
+		chainIDs:      chainIDs,
+		portfolio:     portfolio,
+		lastRequested: now,
+	}
+	m.mutex.Unlock()
+
+	return portfolio, nil
+}
+
+func tokenFiatBalance(token Token, currency string) float64 {
+	price := token.MarketValuesPerCurrency[currency].Price
+	if price == 0 {
+		return 0
+	}
+
+	var total float64
+	for _, chainBalance := range token.BalancesPerChain {
+		if chainBalance.Balance == nil {
+			continue
+		}
+		balance, _ := chainBalance.Balance.Float64()
+		total += balance * price
+	}
+	return total
+}
+
+func (m *PortfolioManager) fetchCollectibles(chainIDs []uint64, address common.Address) ([]*PortfolioCollectible, bool) {
+	hasError := false
+	result := make([]*PortfolioCollectible, 0)
+	for _, chainID := range chainIDs {
+		container, err := m.collectiblesManager.FetchAllAssetsByOwner(chainID, address, "", portfolioCollectiblesLimit)
+		if err != nil {
+			log.Debug("failed to fetch portfolio collectibles", "chainID", chainID, "err", err)
+			hasError = true
+			continue
+		}
+
+		for _, asset := range container.Assets {
+			result = append(result, &PortfolioCollectible{
+				ChainID:         chainID,
+				ContractAddress: common.HexToAddress(asset.Contract.Address),
+				TokenID:         asset.TokenID,
+				Name:            asset.Name,
+				ImageURL:        asset.ImageURL,
+				CollectionName:  asset.Collection.Name,
+			})
+		}
+	}
+	return result, hasError
+}
+
+func (m *PortfolioManager) fetch(ctx context.Context, addresses []common.Address, chainIDs []uint64) (*Portfolio, error) {
+	currency, err := m.accountsDB.GetCurrency()
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := m.reader.GetWalletTokenByChainIDs(ctx, addresses, chainIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio := &Portfolio{
+		Currency:  currency,
+		Accounts:  make([]*AccountPortfolio, 0, len(addresses)),
+		UpdatedAt: time.Now().Unix(),
+	}
+
+	for _, address := range addresses {
+		account := &AccountPortfolio{
+			Address: address,
+			Tokens:  tokens[address],
+		}
+		for _, token := range account.Tokens {
+			account.FiatBalance += tokenFiatBalance(token, currency)
+		}
+
+		accountCollectibles, hasError := m.fetchCollectibles(chainIDs, address)
+		account.Collectibles = accountCollectibles
+		portfolio.HasError = portfolio.HasError || hasError
+
+		portfolio.TotalFiatBalance += account.FiatBalance
+		portfolio.Accounts = append(portfolio.Accounts, account)
+	}
+
+	return portfolio, nil
+}
@@ -0,0 +1,74 @@
+package wallet
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestPortfolioCacheKey(t *testing.T) {
+	address1 := common.HexToAddress("0x1")
+	address2 := common.HexToAddress("0x2")
+
+	require.Equal(t,
+		portfolioCacheKey([]common.Address{address1, address2}, []uint64{1, 10}),
+		portfolioCacheKey([]common.Address{address2, address1}, []uint64{10, 1}))
+	require.NotEqual(t,
+		portfolioCacheKey([]common.Address{address1}, []uint64{1}),
+		portfolioCacheKey([]common.Address{address1}, []uint64{10}))
+}
+
+func TestTokenFiatBalance(t *testing.T) {
+	token := Token{
+		BalancesPerChain: map[uint64]ChainBalance{
+			1:  {Balance: big.NewFloat(1.5), ChainID: 1},
+			10: {Balance: big.NewFloat(0.5), ChainID: 10},
+		},
+		MarketValuesPerCurrency: map[string]TokenMarketValues{
+			"USD": {Price: 2000},
+		},
+	}
+
+	require.Equal(t, float64(4000), tokenFiatBalance(token, "USD"))
+	require.Equal(t, float64(0), tokenFiatBalance(token, "EUR"))
+}
+
+func TestTreasuryFromPortfolio(t *testing.T) {
+	eth := Token{
+		Symbol: "ETH",
+		Name:   "Ether",
+		BalancesPerChain: map[uint64]ChainBalance{
+			1:  {Balance: big.NewFloat(1), ChainID: 1},
+			10: {Balance: big.NewFloat(0), ChainID: 10},
+		},
+		MarketValuesPerCurrency: map[string]TokenMarketValues{"USD": {Price: 2000}},
+	}
+	snt := Token{
+		Symbol: "SNT",
+		Name:   "Status",
+		BalancesPerChain: map[uint64]ChainBalance{
+			1: {Balance: big.NewFloat(1000), ChainID: 1},
+		},
+		MarketValuesPerCurrency: map[string]TokenMarketValues{"USD": {Price: 0.5}},
+	}
+
+	treasury := treasuryFromPortfolio(&Portfolio{
+		Currency: "USD",
+		Accounts: []*AccountPortfolio{
+			{Address: common.HexToAddress("0x1"), Tokens: []Token{eth, snt}},
+			{Address: common.HexToAddress("0x2"), Tokens: []Token{eth}},
+		},
+	})
+
+	require.Equal(t, float64(4500), treasury.TotalFiatBalance)
+	// Empty balances are left out and holdings are sorted by value
+	require.Len(t, treasury.Holdings, 2)
+	require.Equal(t, "ETH", treasury.Holdings[0].Symbol)
+	require.Equal(t, uint64(1), treasury.Holdings[0].ChainID)
+	require.Equal(t, "2", treasury.Holdings[0].Balance.String())
+	require.Equal(t, float64(4000), treasury.Holdings[0].FiatBalance)
+	require.Equal(t, "SNT", treasury.Holdings[1].Symbol)
+}
@@ -2,6 +2,7 @@ package wallet
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"math/big"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/status-im/status-go/services/wallet/market"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 
+	"github.com/status-im/status-go/services/wallet/spam"
 	"github.com/status-im/status-go/services/wallet/token"
 	"github.com/status-im/status-go/services/wallet/walletevent"
 )
@@ -39,11 +41,12 @@ func belongsToMandatoryTokens(symbol string) bool {
 	return false
 }
 
-func NewReader(rpcClient *rpc.Client, tokenManager *token.Manager, marketManager *market.Manager, accountsDB *accounts.Database, persistence *Persistence, walletFeed *event.Feed) *Reader {
+func NewReader(rpcClient *rpc.Client, tokenManager *token.Manager, marketManager *market.Manager, spamManager *spam.Manager, accountsDB *accounts.Database, persistence *Persistence, walletFeed *event.Feed) *Reader {
 	return &Reader{
 		rpcClient,
 		tokenManager,
 		marketManager,
+		spamManager,
 		accountsDB,
 		persistence,
 		walletFeed,
@@ -54,6 +57,7 @@ type Reader struct {
 	rpcClient     *rpc.Client
 	tokenManager  *token.Manager
 	marketManager *market.Manager
+	spamManager   *spam.Manager
 	accountsDB    *accounts.Database
 	persistence   *Persistence
 	walletFeed    *event.Feed
@@ -155,23 +159,36 @@ func (r *Reader) Stop() {
 }
 
 func (r *Reader) GetWalletToken(ctx context.Context, addresses []common.Address) (map[common.Address][]Token, error) {
-	areTestNetworksEnabled, err := r.accountsDB.GetTestNetworksEnabled()
+	networks, err := r.rpcClient.NetworkManager.GetActive(false)
 	if err != nil {
 		return nil, err
 	}
 
-	networks, err := r.rpcClient.NetworkManager.Get(false)
+	result, err := r.getWalletTokenByNetworks(ctx, addresses, networks)
 	if err != nil {
 		return nil, err
 	}
-	availableNetworks := make([]*params.Network, 0)
-	for _, network := range networks {
-		if network.IsTest != areTestNetworksEnabled {
-			continue
+
+	return result, r.persistence.SaveTokens(result)
+}
+
+// GetWalletTokenByChainIDs returns the balances and market values of the
+// tokens of the addresses, on the given chains only. The chains must be part
+// of the active mode
+func (r *Reader) GetWalletTokenByChainIDs(ctx context.Context, addresses []common.Address, chainIDs []uint64) (map[common.Address][]Token, error) {
+	networks := make([]*params.Network, 0, len(chainIDs))
+	for _, chainID := range chainIDs {
+		network, err := r.rpcClient.NetworkManager.FindActive(chainID)
+		if err != nil {
+			return nil, fmt.Errorf("chain %d: %w", chainID, err)
 		}
-		availableNetworks = append(availableNetworks, network)
+		networks = append(networks, network)
 	}
 
+	return r.getWalletTokenByNetworks(ctx, addresses, networks)
+}
+
+func (r *Reader) getWalletTokenByNetworks(ctx context.Context, addresses []common.Address, availableNetworks []*params.Network) (map[common.Address][]Token, error) {
 	chainIDs := make([]uint64, 0)
 	for _, network := range availableNetworks {
 		chainIDs = append(chainIDs, network.ChainID)
@@ -257,6 +274,9 @@ func (r *Reader) GetWalletToken(ctx context.Context, addresses []common.Address)
 			decimals := tokens[0].Decimals
 			anyPositiveBalance := false
 			for _, token := range tokens {
+				if r.isSpam(token) {
+					continue
+				}
 				hexBalance := balances[token.ChainID][address][token.Address]
 				balance := big.NewFloat(0.0)
 				if hexBalance != nil {
@@ -280,7 +300,7 @@ func (r *Reader) GetWalletToken(ctx context.Context, addresses []common.Address)
 				}
 			}
 
-			if !anyPositiveBalance && !belongsToMandatoryTokens(symbol) {
+			if len(balancesPerChain) == 0 || !anyPositiveBalance && !belongsToMandatoryTokens(symbol) {
 				continue
 			}
 
@@ -316,11 +336,54 @@ func (r *Reader) GetWalletToken(ctx context.Context, addresses []common.Address)
 		}
 	}
 
-	return result, r.persistence.SaveTokens(result)
+	return result, nil
+}
+
+// isSpam reports whether the token is hidden from the balances, the tokens of
+// the token lists are only hidden when blocklisted or hidden by the user
+func (r *Reader) isSpam(t *token.Token) bool {
+	if r.spamManager == nil {
+		return false
+	}
+	return r.spamManager.IsHidden(spam.Asset{
+		ChainID:   t.ChainID,
+		Address:   t.Address,
+		Name:      t.Name,
+		Symbol:    t.Symbol,
+		Listed:    true,
+		HasMarket: true,
+	})
 }
 
 // GetCachedWalletTokensWithoutMarketData returns the latest fetched balances, minus
-// price information
+// price information. The balances on the networks of the other mode are left out
 func (r *Reader) GetCachedWalletTokensWithoutMarketData() (map[common.Address][]Token, error) {
-	return r.persistence.GetTokens()
+	networks, err := r.rpcClient.NetworkManager.GetActive(false)
+	if err != nil {
+		return nil, err
+	}
+	activeChainIDs := make(map[uint64]bool, len(networks))
+	for _, network := range networks {
+		activeChainIDs[network.ChainID] = true
+	}
+
+	tokens, err := r.persistence.GetTokens()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[common.Address][]Token)
+	for address, addressTokens := range tokens {
+		for _, t := range addressTokens {
+			for chainID := range t.BalancesPerChain {
+				if !activeChainIDs[chainID] {
+					delete(t.BalancesPerChain, chainID)
+				}
+			}
+			if len(t.BalancesPerChain) > 0 {
+				result[address] = append(result[address], t)
+			}
+		}
+	}
+	return result, nil
 }
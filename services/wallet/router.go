@@ -447,12 +447,7 @@ func (r *Router) suggestedRoutes(
 	gasFeeMode GasFeeMode,
 	fromLockedAmount map[uint64]*hexutil.Big,
 ) (*SuggestedRoutes, error) {
-	areTestNetworksEnabled, err := r.s.accountsDB.GetTestNetworksEnabled()
-	if err != nil {
-		return nil, err
-	}
-
-	networks, err := r.s.rpcClient.NetworkManager.Get(false)
+	networks, err := r.s.rpcClient.NetworkManager.GetActive(false)
 	if err != nil {
 		return nil, err
 	}
@@ -473,9 +468,6 @@ func (r *Router) suggestedRoutes(
 	)
 	for networkIdx := range networks {
 		network := networks[networkIdx]
-		if network.IsTest != areTestNetworksEnabled {
-			continue
-		}
 		if containsNetworkChainID(network, disabledFromChainIDs) {
 			continue
 		}
@@ -520,10 +512,6 @@ func (r *Router) suggestedRoutes(
 
 			for _, bridge := range r.bridges {
 				for _, dest := range networks {
-					if dest.IsTest != areTestNetworksEnabled {
-						continue
-					}
-
 					if !sendType.isAvailableFor(network) {
 						continue
 					}
@@ -0,0 +1,218 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/status-im/status-go/contracts/ierc20"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/services/wallet/bridge"
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/services/wallet/transfer"
+	"github.com/status-im/status-go/transactions"
+)
+
+var (
+	ErrInvalidSendRequest = errors.New("invalid send request")
+	ErrNoRouteFound       = errors.New("no route found")
+)
+
+// SendRequest describes an amount of token to send to a recipient, the
+// router picks the chains to send from and bridges to the destination chain
+// when it's set
+type SendRequest struct {
+	SendType             SendType                `json:"sendType"`
+	From                 common.Address          `json:"from"`
+	To                   common.Address          `json:"to"`
+	AmountIn             *hexutil.Big            `json:"amountIn"`
+	TokenSymbol          string                  `json:"tokenSymbol"`
+	DestinationChainID   uint64                  `json:"destinationChainId"`
+	DisabledFromChainIDs []uint64                `json:"disabledFromChainIds"`
+	GasFeeMode           GasFeeMode              `json:"gasFeeMode"`
+	FromLockedAmount     map[uint64]*hexutil.Big `json:"fromLockedAmount"`
+}
+
+func (r *SendRequest) Validate() error {
+	if r.SendType != Transfer && r.SendType != Bridge {
+		return ErrInvalidSendRequest
+	}
+	if r.AmountIn == nil || r.AmountIn.ToInt().Sign() <= 0 {
+		return ErrInvalidSendRequest
+	}
+	if r.TokenSymbol == "" {
+		return ErrInvalidSendRequest
+	}
+	return nil
+}
+
+// SendPlan is the best route for a send request along with the transactions
+// to send it, ready to be passed to CreateMultiTransaction
+type SendPlan struct {
+	Routes       *SuggestedRoutes                  `json:"routes"`
+	Command      *transfer.MultiTransactionCommand `json:"command"`
+	Transactions []*bridge.TransactionBridge       `json:"transactions"`
+	// TotalCost is the cost of the fees of the route in USD
+	TotalCost *big.Float `json:"totalCost"`
+	// EstimatedTime is the estimation of the slowest transaction of the route
+	EstimatedTime TransactionEstimation `json:"estimatedTime"`
+}
+
+func (r *Router) planSend(ctx context.Context, request *SendRequest) (*SendPlan, error) {
+	err := request.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	var preferedChainIDs []uint64
+	if request.DestinationChainID != 0 {
+		preferedChainIDs = []uint64{request.DestinationChainID}
+	}
+
+	routes, err := r.suggestedRoutes(ctx, request.SendType, request.From, request.AmountIn.ToInt(), request.TokenSymbol,
+		request.DisabledFromChainIDs, nil, preferedChainIDs, request.GasFeeMode, request.FromLockedAmount)
+	if err != nil {
+		return nil, err
+	}
+	if len(routes.Best) == 0 {
+		return nil, ErrNoRouteFound
+	}
+
+	plan := &SendPlan{
+		Routes: routes,
+		Command: &transfer.MultiTransactionCommand{
+			FromAddress: request.From,
+			ToAddress:   request.To,
+			FromAsset:   request.TokenSymbol,
+			ToAsset:     request.TokenSymbol,
+			FromAmount:  request.AmountIn,
+			Type:        transfer.MultiTransactionSend,
+		},
+		TotalCost: big.NewFloat(0),
+	}
+
+	for _, path := range routes.Best {
+		token := r.s.tokenManager.FindToken(path.From, request.TokenSymbol)
+		if token == nil {
+			return nil, fmt.Errorf("token %s not found on chain %d", request.TokenSymbol, path.From.ChainID)
+		}
+
+		txs, err := buildPathTransactions(request.From, request.To, token, path, request.GasFeeMode)
+		if err != nil {
+			return nil, err
+		}
+		plan.Transactions = append(plan.Transactions, txs...)
+
+		if path.From.ChainID != path.To.ChainID {
+			plan.Command.Type = transfer.MultiTransactionBridge
+		}
+		plan.TotalCost.Add(plan.TotalCost, path.Cost)
+		if path.EstimatedTime > plan.EstimatedTime {
+			plan.EstimatedTime = path.EstimatedTime
+		}
+	}
+
+	return plan, nil
+}
+
+// pathTxArgs returns the arguments of a transaction sent from the chain of
+// the path with the fees of the gas fee mode
+func pathTxArgs(from common.Address, path *Path, gasFeeMode GasFeeMode) transactions.SendTxArgs {
+	args := transactions.SendTxArgs{
+		From:  types.Address(from),
+		Value: (*hexutil.Big)(big.NewInt(0)),
+	}
+	if path.GasFees == nil {
+		return args
+	}
+	if path.GasFees.EIP1559Enabled {
+		args.MaxFeePerGas = (*hexutil.Big)(gweiToWei(path.GasFees.feeFor(gasFeeMode)))
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(gweiToWei(path.GasFees.MaxPriorityFeePerGas))
+	} else {
+		args.GasPrice = (*hexutil.Big)(gweiToWei(path.GasFees.GasPrice))
+	}
+	return args
+}
+
+// buildPathTransactions returns the transactions sending the amount of the
+// path to the recipient, preceded by the approval of the bridge contract when
+// it's required
+func buildPathTransactions(from, to common.Address, token *token.Token, path *Path, gasFeeMode GasFeeMode) ([]*bridge.TransactionBridge, error) {
+	if path.AmountIn == nil || path.AmountIn.ToInt().Sign() == 0 {
+		return nil, nil
+	}
+
+	erc20ABI, err := abi.JSON(strings.NewReader(ierc20.IERC20ABI))
+	if err != nil {
+		return nil, err
+	}
+
+	var txs []*bridge.TransactionBridge
+	if path.ApprovalRequired && path.ApprovalContractAddress != nil {
+		data, err := erc20ABI.Pack("approve", *path.ApprovalContractAddress, path.ApprovalAmountRequired.ToInt())
+		if err != nil {
+			return nil, err
+		}
+		tokenAddress := types.Address(token.Address)
+		args := pathTxArgs(from, path, gasFeeMode)
+		args.To = &tokenAddress
+		args.Data = data
+		txs = append(txs, &bridge.TransactionBridge{
+			BridgeName: "Simple",
+			ChainID:    path.From.ChainID,
+			SimpleTx:   &args,
+		})
+	}
+
+	args := pathTxArgs(from, path, gasFeeMode)
+	tx := &bridge.TransactionBridge{
+		BridgeName: path.BridgeName,
+		ChainID:    path.From.ChainID,
+	}
+
+	switch path.BridgeName {
+	case "Simple":
+		gas := hexutil.Uint64(path.GasAmount)
+		args.Gas = &gas
+		if token.IsNative() {
+			recipient := types.Address(to)
+			args.To = &recipient
+			args.Value = path.AmountIn
+		} else {
+			data, err := erc20ABI.Pack("transfer", to, path.AmountIn.ToInt())
+			if err != nil {
+				return nil, err
+			}
+			tokenAddress := types.Address(token.Address)
+			args.To = &tokenAddress
+			args.Data = data
+		}
+		tx.SimpleTx = &args
+	case "Hop":
+		tx.HopTx = &bridge.HopTxArgs{
+			SendTxArgs: args,
+			ChainID:    path.To.ChainID,
+			Symbol:     token.Symbol,
+			Recipient:  to,
+			Amount:     path.AmountIn,
+			BonderFee:  path.BonderFees,
+		}
+	case "CBridge":
+		tx.CbridgeTx = &bridge.CBridgeTxArgs{
+			SendTxArgs: args,
+			ChainID:    path.To.ChainID,
+			Symbol:     token.Symbol,
+			Recipient:  to,
+			Amount:     path.AmountIn,
+		}
+	default:
+		return nil, fmt.Errorf("unknown bridge %s", path.BridgeName)
+	}
+
+	return append(txs, tx), nil
+}
@@ -0,0 +1,93 @@
+package wallet
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/services/wallet/token"
+)
+
+func TestBuildPathTransactions(t *testing.T) {
+	from := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+	mainnet := &params.Network{ChainID: 1}
+	optimism := &params.Network{ChainID: 10}
+	fees := &SuggestedFees{
+		GasPrice:             big.NewFloat(1),
+		MaxPriorityFeePerGas: big.NewFloat(1),
+		MaxFeePerGasLow:      big.NewFloat(10),
+		MaxFeePerGasMedium:   big.NewFloat(20),
+		MaxFeePerGasHigh:     big.NewFloat(30),
+		EIP1559Enabled:       true,
+	}
+	eth := &token.Token{Symbol: "ETH", Decimals: 18}
+	usdc := &token.Token{Address: common.HexToAddress("0x3"), Symbol: "USDC", Decimals: 6}
+	amount := (*hexutil.Big)(big.NewInt(100))
+
+	// Native transfer on the same chain
+	txs, err := buildPathTransactions(from, to, eth, &Path{
+		BridgeName: "Simple",
+		From:       mainnet,
+		To:         mainnet,
+		AmountIn:   amount,
+		GasAmount:  22000,
+		GasFees:    fees,
+	}, GasFeeMedium)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, uint64(1), txs[0].ChainID)
+	require.Equal(t, types.Address(to), txs[0].To())
+	require.Equal(t, amount.ToInt(), txs[0].Value())
+	require.Equal(t, gweiToWei(big.NewFloat(20)), txs[0].SimpleTx.MaxFeePerGas.ToInt())
+
+	// ERC20 transfers call the token contract
+	txs, err = buildPathTransactions(from, to, usdc, &Path{
+		BridgeName: "Simple",
+		From:       mainnet,
+		To:         mainnet,
+		AmountIn:   amount,
+		GasFees:    fees,
+	}, GasFeeMedium)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, types.Address(usdc.Address), txs[0].To())
+	require.NotEmpty(t, txs[0].Data())
+
+	// Bridging an ERC20 approves the bridge contract first
+	bridgeAddress := common.HexToAddress("0x4")
+	txs, err = buildPathTransactions(from, to, usdc, &Path{
+		BridgeName:              "Hop",
+		From:                    mainnet,
+		To:                      optimism,
+		AmountIn:                amount,
+		BonderFees:              (*hexutil.Big)(big.NewInt(1)),
+		GasFees:                 fees,
+		ApprovalRequired:        true,
+		ApprovalAmountRequired:  amount,
+		ApprovalContractAddress: &bridgeAddress,
+	}, GasFeeMedium)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	require.Equal(t, "Simple", txs[0].BridgeName)
+	require.Equal(t, types.Address(usdc.Address), txs[0].To())
+	require.Equal(t, "Hop", txs[1].BridgeName)
+	require.Equal(t, uint64(1), txs[1].ChainID)
+	require.Equal(t, uint64(10), txs[1].HopTx.ChainID)
+	require.Equal(t, to, txs[1].HopTx.Recipient)
+
+	// Paths without amount are left out
+	txs, err = buildPathTransactions(from, to, eth, &Path{
+		BridgeName: "Simple",
+		From:       mainnet,
+		To:         mainnet,
+		AmountIn:   (*hexutil.Big)(big.NewInt(0)),
+	}, GasFeeMedium)
+	require.NoError(t, err)
+	require.Empty(t, txs)
+}
@@ -0,0 +1,243 @@
+package safe
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Operation is the kind of call made by a Safe
+type Operation uint8
+
+const (
+	Call         Operation = 0
+	DelegateCall Operation = 1
+)
+
+const safeABI = `[
+	{"name":"getOwners","type":"function","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address[]"}]},
+	{"name":"getThreshold","type":"function","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"name":"nonce","type":"function","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"name":"execTransaction","type":"function","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},{"name":"operation","type":"uint8"},{"name":"safeTxGas","type":"uint256"},{"name":"baseGas","type":"uint256"},{"name":"gasPrice","type":"uint256"},{"name":"gasToken","type":"address"},{"name":"refundReceiver","type":"address"},{"name":"signatures","type":"bytes"}],"outputs":[{"name":"success","type":"bool"}]}
+]`
+
+var (
+	ErrInvalidSignature = errors.New("invalid safe transaction signature")
+	// ErrExecutionNotVerified is returned when a transaction didn't execute
+	// the Safe transaction
+	ErrExecutionNotVerified = errors.New("safe transaction execution not verified")
+
+	parsedSafeABI, _ = abi.JSON(strings.NewReader(safeABI))
+
+	// Type hashes of the EIP-712 messages signed by the owners of a Safe,
+	// since its version 1.3.0
+	domainSeparatorTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(uint256 chainId,address verifyingContract)"))
+	safeTxTypeHash          = crypto.Keccak256Hash([]byte("SafeTx(address to,uint256 value,bytes data,uint8 operation,uint256 safeTxGas,uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)"))
+	// executionSuccessTopic is the event emitted by the Safe once a Safe
+	// transaction succeeded, with its safeTxHash
+	executionSuccessTopic = crypto.Keccak256Hash([]byte("ExecutionSuccess(bytes32,uint256)"))
+
+	addressType, _ = abi.NewType("address", "", nil)
+	uint256Type, _ = abi.NewType("uint256", "", nil)
+	uint8Type, _   = abi.NewType("uint8", "", nil)
+	bytes32Type, _ = abi.NewType("bytes32", "", nil)
+
+	domainSeparatorArgs = abi.Arguments{{Type: bytes32Type}, {Type: uint256Type}, {Type: addressType}}
+	safeTxArgs          = abi.Arguments{
+		{Type: bytes32Type}, {Type: addressType}, {Type: uint256Type}, {Type: bytes32Type}, {Type: uint8Type}, {Type: uint256Type},
+		{Type: uint256Type}, {Type: uint256Type}, {Type: addressType}, {Type: addressType}, {Type: uint256Type},
+	}
+)
+
+// Transaction is a transaction made by a Safe once enough of its owners
+// signed it
+type Transaction struct {
+	To             common.Address
+	Value          *big.Int
+	Data           []byte
+	Operation      Operation
+	SafeTxGas      *big.Int
+	BaseGas        *big.Int
+	GasPrice       *big.Int
+	GasToken       common.Address
+	RefundReceiver common.Address
+	Nonce          *big.Int
+}
+
+func orZero(i *big.Int) *big.Int {
+	if i == nil {
+		return new(big.Int)
+	}
+	return i
+}
+
+// Hash returns the safeTxHash signed by the owners of the Safe
+func (tx *Transaction) Hash(chainID uint64, safe common.Address) (common.Hash, error) {
+	domain, err := domainSeparatorArgs.Pack(domainSeparatorTypeHash, new(big.Int).SetUint64(chainID), safe)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	message, err := safeTxArgs.Pack(
+		safeTxTypeHash, tx.To, orZero(tx.Value), crypto.Keccak256Hash(tx.Data), uint8(tx.Operation), orZero(tx.SafeTxGas),
+		orZero(tx.BaseGas), orZero(tx.GasPrice), tx.GasToken, tx.RefundReceiver, orZero(tx.Nonce),
+	)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, crypto.Keccak256(domain), crypto.Keccak256(message)), nil
+}
+
+// PackExecTransaction returns the call data of execTransaction, signatures
+// are encoded by EncodeSignatures
+func (tx *Transaction) PackExecTransaction(signatures []byte) ([]byte, error) {
+	return parsedSafeABI.Pack("execTransaction",
+		tx.To, orZero(tx.Value), tx.Data, uint8(tx.Operation), orZero(tx.SafeTxGas), orZero(tx.BaseGas),
+		orZero(tx.GasPrice), tx.GasToken, tx.RefundReceiver, signatures,
+	)
+}
+
+// Sign signs the safeTxHash with the key of an owner, the signature is
+// checked by the Safe as an ECDSA signature of the hash itself
+func Sign(hash common.Hash, key *ecdsa.PrivateKey) ([]byte, error) {
+	signature, err := crypto.Sign(hash.Bytes(), key)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// RecoverSigner returns the owner who signed the safeTxHash
+func RecoverSigner(hash common.Hash, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, ErrInvalidSignature
+	}
+	v := signature[crypto.RecoveryIDOffset]
+	if v != 27 && v != 28 {
+		return common.Address{}, ErrInvalidSignature
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	sig[crypto.RecoveryIDOffset] -= 27
+
+	publicKey, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*publicKey), nil
+}
+
+// EncodeSignatures concatenates the signatures of the owners, the Safe
+// expects them sorted by owner address
+func EncodeSignatures(signatures map[common.Address][]byte) []byte {
+	owners := make([]common.Address, 0, len(signatures))
+	for owner := range signatures {
+		owners = append(owners, owner)
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		return bytes.Compare(owners[i].Bytes(), owners[j].Bytes()) < 0
+	})
+
+	encoded := make([]byte, 0, len(owners)*crypto.SignatureLength)
+	for _, owner := range owners {
+		encoded = append(encoded, signatures[owner]...)
+	}
+	return encoded
+}
+
+func call(ctx context.Context, caller ethereum.ContractCaller, safe common.Address, method string) ([]interface{}, error) {
+	data, err := parsedSafeABI.Pack(method)
+	if err != nil {
+		return nil, err
+	}
+	result, err := caller.CallContract(ctx, ethereum.CallMsg{To: &safe, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return parsedSafeABI.Unpack(method, result)
+}
+
+// Owners returns the owners of the Safe
+func Owners(ctx context.Context, caller ethereum.ContractCaller, safe common.Address) ([]common.Address, error) {
+	result, err := call(ctx, caller, safe, "getOwners")
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(result[0], new([]common.Address)).(*[]common.Address), nil
+}
+
+// Threshold returns the number of signatures needed by the Safe
+func Threshold(ctx context.Context, caller ethereum.ContractCaller, safe common.Address) (uint64, error) {
+	result, err := call(ctx, caller, safe, "getThreshold")
+	if err != nil {
+		return 0, err
+	}
+	return (*abi.ConvertType(result[0], new(*big.Int)).(**big.Int)).Uint64(), nil
+}
+
+// Nonce returns the nonce of the next transaction of the Safe
+func Nonce(ctx context.Context, caller ethereum.ContractCaller, safe common.Address) (*big.Int, error) {
+	result, err := call(ctx, caller, safe, "nonce")
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(result[0], new(*big.Int)).(**big.Int), nil
+}
+
+// ChainReader reads the state of the Safe and its transactions
+type ChainReader interface {
+	ethereum.ContractCaller
+	ethereum.TransactionReader
+}
+
+// VerifyExecution checks that the transaction was mined, called the Safe
+// and executed the Safe transaction, whose nonce the Safe used since
+func VerifyExecution(ctx context.Context, client ChainReader, safe common.Address, safeTxHash common.Hash, nonce *big.Int, transactionHash common.Hash) error {
+	tx, isPending, err := client.TransactionByHash(ctx, transactionHash)
+	if err != nil {
+		return err
+	}
+	if isPending || tx.To() == nil || *tx.To() != safe {
+		return ErrExecutionNotVerified
+	}
+
+	receipt, err := client.TransactionReceipt(ctx, transactionHash)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful || !executedSafeTransaction(receipt, safe, safeTxHash) {
+		return ErrExecutionNotVerified
+	}
+
+	current, err := Nonce(ctx, client, safe)
+	if err != nil {
+		return err
+	}
+	if current.Cmp(orZero(nonce)) <= 0 {
+		return ErrExecutionNotVerified
+	}
+	return nil
+}
+
+func executedSafeTransaction(receipt *types.Receipt, safe common.Address, safeTxHash common.Hash) bool {
+	for _, log := range receipt.Logs {
+		if log.Address != safe || len(log.Topics) == 0 || log.Topics[0] != executionSuccessTopic {
+			continue
+		}
+		if len(log.Data) >= common.HashLength && common.BytesToHash(log.Data[:common.HashLength]) == safeTxHash {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+package safe
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type fakeCaller struct {
+	results map[string][]byte
+}
+
+func (c *fakeCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return c.results[hexutil.Encode(call.Data[:4])], nil
+}
+
+type fakeChain struct {
+	fakeCaller
+	tx      *types.Transaction
+	receipt *types.Receipt
+}
+
+func (c *fakeChain) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	return c.tx, false, nil
+}
+
+func (c *fakeChain) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return c.receipt, nil
+}
+
+func TestTypeHashes(t *testing.T) {
+	require.Equal(t, common.HexToHash("0x47e79534a245952e8b16893a336b85a3d9ea9fa8c573f3d803afb92a79469218"), domainSeparatorTypeHash)
+	require.Equal(t, common.HexToHash("0xbb8310d486368db6bd6f849402fdd73ad53d316b5a4b2644ad6efe0f941286d8"), safeTxTypeHash)
+}
+
+// The hash of the EIP-712 message, as computed by the typed data encoder of
+// go-ethereum, of the transfer of 1 DAI to vitalik.eth by a Safe on mainnet
+func TestHashVector(t *testing.T) {
+	tx := &Transaction{
+		To:    common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F"),
+		Data:  hexutil.MustDecode("0xa9059cbb000000000000000000000000d8da6bf26964af9d7eed9e03e53415d37aa960450000000000000000000000000000000000000000000000000de0b6b3a7640000"),
+		Nonce: big.NewInt(42),
+	}
+	hash, err := tx.Hash(1, common.HexToAddress("0x5aFE3855358E112B5647B952709E6165e1c1eEEe"))
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash("0x19db88ec60c0d27a202ee0d603c64e302445b026e32727aad57d6d21d9a9b9ff"), hash)
+}
+
+func TestSignatures(t *testing.T) {
+	tx := &Transaction{To: common.HexToAddress("0x1"), Value: big.NewInt(1), Nonce: big.NewInt(3)}
+	hash, err := tx.Hash(1, common.HexToAddress("0x2"))
+	require.NoError(t, err)
+	other, err := tx.Hash(5, common.HexToAddress("0x2"))
+	require.NoError(t, err)
+	require.NotEqual(t, hash, other)
+
+	signatures := make(map[common.Address][]byte)
+	for i := 0; i < 3; i++ {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		signature, err := Sign(hash, key)
+		require.NoError(t, err)
+
+		signer, err := RecoverSigner(hash, signature)
+		require.NoError(t, err)
+		require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), signer)
+		signatures[signer] = signature
+	}
+
+	encoded := EncodeSignatures(signatures)
+	require.Len(t, encoded, 3*crypto.SignatureLength)
+	var previous common.Address
+	for i := 0; i < 3; i++ {
+		signer, err := RecoverSigner(hash, encoded[i*crypto.SignatureLength:(i+1)*crypto.SignatureLength])
+		require.NoError(t, err)
+		require.True(t, previous.Hash().Big().Cmp(signer.Hash().Big()) < 0)
+		previous = signer
+	}
+
+	_, err = RecoverSigner(hash, encoded[:10])
+	require.Equal(t, ErrInvalidSignature, err)
+
+	data, err := tx.PackExecTransaction(encoded)
+	require.NoError(t, err)
+	require.Equal(t, "0x6a761202", hexutil.Encode(data[:4]))
+}
+
+func TestOwnersAndThreshold(t *testing.T) {
+	owners := []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2")}
+	ownersResult, err := parsedSafeABI.Methods["getOwners"].Outputs.Pack(owners)
+	require.NoError(t, err)
+	thresholdResult, err := parsedSafeABI.Methods["getThreshold"].Outputs.Pack(big.NewInt(2))
+	require.NoError(t, err)
+	caller := &fakeCaller{results: map[string][]byte{
+		hexutil.Encode(parsedSafeABI.Methods["getOwners"].ID):    ownersResult,
+		hexutil.Encode(parsedSafeABI.Methods["getThreshold"].ID): thresholdResult,
+	}}
+
+	result, err := Owners(context.Background(), caller, common.HexToAddress("0x3"))
+	require.NoError(t, err)
+	require.Equal(t, owners, result)
+
+	threshold, err := Threshold(context.Background(), caller, common.HexToAddress("0x3"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), threshold)
+}
+
+func TestVerifyExecution(t *testing.T) {
+	safe := common.HexToAddress("0x3")
+	safeTxHash := common.HexToHash("0x1234")
+	nonceResult, err := parsedSafeABI.Methods["nonce"].Outputs.Pack(big.NewInt(8))
+	require.NoError(t, err)
+	payment := make([]byte, common.HashLength)
+
+	chain := &fakeChain{
+		fakeCaller: fakeCaller{results: map[string][]byte{
+			hexutil.Encode(parsedSafeABI.Methods["nonce"].ID): nonceResult,
+		}},
+		tx: types.NewTransaction(0, safe, new(big.Int), 100000, big.NewInt(1), nil),
+		receipt: &types.Receipt{
+			Status: types.ReceiptStatusSuccessful,
+			Logs:   []*types.Log{{Address: safe, Topics: []common.Hash{executionSuccessTopic}, Data: append(safeTxHash.Bytes(), payment...)}},
+		},
+	}
+	require.NoError(t, VerifyExecution(context.Background(), chain, safe, safeTxHash, big.NewInt(7), common.HexToHash("0x1")))
+
+	// The nonce of the Safe transaction wasn't used yet
+	require.Equal(t, ErrExecutionNotVerified, VerifyExecution(context.Background(), chain, safe, safeTxHash, big.NewInt(8), common.HexToHash("0x1")))
+	// Another Safe transaction was executed
+	require.Equal(t, ErrExecutionNotVerified, VerifyExecution(context.Background(), chain, safe, common.HexToHash("0x5678"), big.NewInt(7), common.HexToHash("0x1")))
+	// The transaction didn't call the Safe
+	require.Equal(t, ErrExecutionNotVerified, VerifyExecution(context.Background(), chain, common.HexToAddress("0x4"), safeTxHash, big.NewInt(7), common.HexToHash("0x1")))
+
+	chain.receipt.Status = types.ReceiptStatusFailed
+	require.Equal(t, ErrExecutionNotVerified, VerifyExecution(context.Background(), chain, safe, safeTxHash, big.NewInt(7), common.HexToHash("0x1")))
+}
@@ -57,13 +57,13 @@ func getSavedAddressesFromDBRows(rows *sql.Rows) ([]SavedAddress, error) {
 	return addresses, nil
 }
 
-func (sam *SavedAddressesManager) getSavedAddresses(condition string) ([]SavedAddress, error) {
+func (sam *SavedAddressesManager) getSavedAddresses(condition string, args ...interface{}) ([]SavedAddress, error) {
 	var whereCondition string
 	if condition != "" {
 		whereCondition = fmt.Sprintf("WHERE %s", condition)
 	}
 
-	rows, err := sam.db.Query(fmt.Sprintf("SELECT %s FROM saved_addresses %s", rawQueryColumnsOrder, whereCondition))
+	rows, err := sam.db.Query(fmt.Sprintf("SELECT %s FROM saved_addresses %s", rawQueryColumnsOrder, whereCondition), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +77,12 @@ func (sam *SavedAddressesManager) GetSavedAddresses() ([]SavedAddress, error) {
 	return sam.getSavedAddresses("removed != 1")
 }
 
+// GetSavedAddressesPerMode returns the saved addresses of the testnet mode
+// when isTest is set, the mainnet ones otherwise
+func (sam *SavedAddressesManager) GetSavedAddressesPerMode(isTest bool) ([]SavedAddress, error) {
+	return sam.getSavedAddresses("removed != 1 AND is_test = ?", isTest)
+}
+
 // GetRawSavedAddresses provides access to the soft-delete and sync metadata
 func (sam *SavedAddressesManager) GetRawSavedAddresses() ([]SavedAddress, error) {
 	return sam.getSavedAddresses("")
@@ -265,6 +265,32 @@ func TestSavedAddressesGet(t *testing.T) {
 	require.Equal(t, 0, len(dbSavedAddresses))
 }
 
+func TestSavedAddressesGetPerMode(t *testing.T) {
+	manager, stop := setupTestSavedAddressesDB(t)
+	defer stop()
+
+	sa := SavedAddress{
+		Address: common.Address{1},
+		ENSName: "test.ens.eth",
+		IsTest:  false,
+	}
+	saTest := sa
+	saTest.IsTest = true
+
+	require.NoError(t, manager.upsertSavedAddress(sa, nil))
+	require.NoError(t, manager.upsertSavedAddress(saTest, nil))
+
+	dbSavedAddresses, err := manager.GetSavedAddressesPerMode(false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(dbSavedAddresses))
+	require.True(t, savedAddressDataIsEqual(sa, dbSavedAddresses[0]))
+
+	dbSavedAddresses, err = manager.GetSavedAddressesPerMode(true)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(dbSavedAddresses))
+	require.True(t, savedAddressDataIsEqual(saTest, dbSavedAddresses[0]))
+}
+
 func TestSavedAddressesDelete(t *testing.T) {
 	manager, stop := setupTestSavedAddressesDB(t)
 	defer stop()
@@ -19,18 +19,28 @@ import (
 	"github.com/status-im/status-go/services/ens"
 	"github.com/status-im/status-go/services/stickers"
 	"github.com/status-im/status-go/services/wallet/activity"
+	"github.com/status-im/status-go/services/wallet/approvals"
 	"github.com/status-im/status-go/services/wallet/collectibles"
 	"github.com/status-im/status-go/services/wallet/currency"
+	"github.com/status-im/status-go/services/wallet/erc4337"
+	"github.com/status-im/status-go/services/wallet/gasoracle"
+	"github.com/status-im/status-go/services/wallet/hardware"
 	"github.com/status-im/status-go/services/wallet/history"
+	"github.com/status-im/status-go/services/wallet/logfetcher"
 	"github.com/status-im/status-go/services/wallet/market"
+	"github.com/status-im/status-go/services/wallet/pendingtx"
+	"github.com/status-im/status-go/services/wallet/simulation"
+	"github.com/status-im/status-go/services/wallet/spam"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 	"github.com/status-im/status-go/services/wallet/thirdparty/alchemy"
+	"github.com/status-im/status-go/services/wallet/thirdparty/chainlink"
 	"github.com/status-im/status-go/services/wallet/thirdparty/coingecko"
 	"github.com/status-im/status-go/services/wallet/thirdparty/cryptocompare"
 	"github.com/status-im/status-go/services/wallet/thirdparty/infura"
 	"github.com/status-im/status-go/services/wallet/token"
 	"github.com/status-im/status-go/services/wallet/transfer"
 	"github.com/status-im/status-go/services/wallet/walletevent"
+	"github.com/status-im/status-go/services/wallet/watchonly"
 	"github.com/status-im/status-go/transactions"
 )
 
@@ -89,32 +99,65 @@ func NewService(
 			ChainID:  chainID,
 		})
 	})
+	rpcClient.SetProviderQuotas(config.WalletConfig.RPCProviderQuotas)
 	tokenManager := token.NewTokenManager(db, rpcClient, rpcClient.NetworkManager)
+	tokenListsManager := token.NewTokenListsManager(token.NewTokenListsDB(db), config.WalletConfig.TokenLists, walletFeed)
 	savedAddressesManager := &SavedAddressesManager{db: db}
 	transactionManager := transfer.NewTransactionManager(db, gethManager, transactor, config, accountsDB, walletFeed)
 	transferController := transfer.NewTransferController(db, rpcClient, accountFeed, walletFeed, transactionManager, tokenManager, config.WalletConfig.LoadAllTransfers)
 	cryptoCompare := cryptocompare.NewClient()
 	coingecko := coingecko.NewClient()
-	marketManager := market.NewManager(cryptoCompare, coingecko, walletFeed)
-	reader := NewReader(rpcClient, tokenManager, marketManager, accountsDB, NewPersistence(db), walletFeed)
+	chainlink := chainlink.NewClient(rpcClient, func(symbol string) (common.Address, bool) {
+		network := rpcClient.NetworkManager.Find(1)
+		if network == nil {
+			return common.Address{}, false
+		}
+		token := tokenManager.FindToken(network, symbol)
+		if token == nil || token.IsNative() {
+			return common.Address{}, false
+		}
+		return token.Address, true
+	})
+	marketManager := market.NewManagerWithProviders(
+		[]thirdparty.MarketDataProvider{cryptoCompare, coingecko},
+		[]thirdparty.PriceProvider{chainlink},
+		walletFeed,
+	)
+	spamManager := spam.NewManager(spam.NewSpamDB(db), config.WalletConfig.SpamBlocklistURL, walletFeed)
+	reader := NewReader(rpcClient, tokenManager, marketManager, spamManager, accountsDB, NewPersistence(db), walletFeed)
 	history := history.NewService(db, walletFeed, rpcClient, tokenManager, marketManager)
 	currency := currency.NewService(db, walletFeed, tokenManager, marketManager)
 	activity := activity.NewService(db, tokenManager, walletFeed)
 
 	alchemyClient := alchemy.NewClient(config.WalletConfig.AlchemyAPIKeys)
 	infuraClient := infura.NewClient(config.WalletConfig.InfuraAPIKey, config.WalletConfig.InfuraAPIKeySecret)
-	collectiblesManager := collectibles.NewManager(rpcClient, alchemyClient, infuraClient, nftMetadataProvider, config.WalletConfig.OpenseaAPIKey, walletFeed)
+	collectiblesManager := collectibles.NewManager(collectibles.NewOwnershipDB(db), logfetcher.NewCheckpointsDB(db), rpcClient, alchemyClient, infuraClient, nftMetadataProvider, config.WalletConfig.OpenseaAPIKey, walletFeed)
+	portfolioManager := NewPortfolioManager(reader, collectiblesManager, accountsDB)
+	approvalsManager := approvals.NewManager(approvals.NewApprovalsDB(db), logfetcher.NewCheckpointsDB(db), rpcClient, accountsDB, walletFeed)
+	gasOracle := gasoracle.NewOracle(rpcClient)
+	pendingTxTracker := pendingtx.NewTracker(pendingtx.NewPendingTxDB(db), rpcClient, gasOracle, walletFeed)
+	rpcClient.SetSentTransactionNotifier(pendingTxTracker.Track)
 	return &Service{
 		db:                    db,
 		accountsDB:            accountsDB,
 		rpcClient:             rpcClient,
 		tokenManager:          tokenManager,
+		tokenListsManager:     tokenListsManager,
 		savedAddressesManager: savedAddressesManager,
 		transactionManager:    transactionManager,
 		transferController:    transferController,
 		cryptoOnRampManager:   cryptoOnRampManager,
 		collectiblesManager:   collectiblesManager,
+		portfolioManager:      portfolioManager,
+		approvalsManager:      approvalsManager,
+		spamManager:           spamManager,
 		feesManager:           &FeeManager{rpcClient},
+		gasOracle:             gasOracle,
+		pendingTxTracker:      pendingTxTracker,
+		simulator:             simulation.NewSimulator(rpcClient),
+		watchOnlyWatcher:      watchonly.NewWatcher(rpcClient, accountsDB, walletFeed),
+		userOperations:        erc4337.NewManager(rpcClient, erc4337.NewDatabase(db), accountsDB, gethManager, config, walletFeed),
+		hardwareWallets:       hardware.NewManager(hardware.NewDatabase(db), accountsDB, gethManager.HardwareWallet(), accountFeed),
 		gethManager:           gethManager,
 		marketManager:         marketManager,
 		transactor:            transactor,
@@ -137,13 +180,23 @@ type Service struct {
 	rpcClient             *rpc.Client
 	savedAddressesManager *SavedAddressesManager
 	tokenManager          *token.Manager
+	tokenListsManager     *token.TokenListsManager
 	transactionManager    *transfer.TransactionManager
 	cryptoOnRampManager   *CryptoOnRampManager
 	transferController    *transfer.Controller
 	feesManager           *FeeManager
+	gasOracle             *gasoracle.Oracle
+	pendingTxTracker      *pendingtx.Tracker
+	simulator             *simulation.Simulator
+	watchOnlyWatcher      *watchonly.Watcher
+	userOperations        *erc4337.Manager
+	hardwareWallets       *hardware.Manager
 	marketManager         *market.Manager
 	started               bool
 	collectiblesManager   *collectibles.Manager
+	portfolioManager      *PortfolioManager
+	approvalsManager      *approvals.Manager
+	spamManager           *spam.Manager
 	gethManager           *account.GethManager
 	transactor            *transactions.Transactor
 	ens                   *ens.Service
@@ -160,18 +213,75 @@ type Service struct {
 // Start signals transmitter.
 func (s *Service) Start() error {
 	s.transferController.Start()
-	s.currency.Start()
 	err := s.signals.Start()
-	s.history.Start()
+	s.pendingTxTracker.Start()
+	s.hardwareWallets.Start()
 	s.started = true
 	return err
 }
 
+// StartDeferred starts the cache warmups, the indexers and the pollers, which
+// can wait until the messenger is responsive
+func (s *Service) StartDeferred() error {
+	if !s.started {
+		return nil
+	}
+	s.currency.Start()
+	s.history.Start()
+	s.portfolioManager.Start()
+	s.approvalsManager.Start()
+	s.spamManager.Start()
+	s.tokenListsManager.Start()
+	s.gasOracle.Start()
+	s.watchOnlyWatcher.Start()
+	s.userOperations.Start()
+	return nil
+}
+
+// GetTokenManager returns the token manager of the wallet, which caches the
+// token lists and the custom tokens
+func (s *Service) GetTokenManager() *token.Manager {
+	return s.tokenManager
+}
+
+// saveCustomToken stamps the custom token with a fresh clock, stores it and
+// lets the messenger sync it with the paired devices
+func (s *Service) saveCustomToken(customToken *token.CustomToken) error {
+	clock, err := s.tokenManager.NextCustomClock(customToken.ChainID, customToken.Address)
+	if err != nil {
+		return err
+	}
+	customToken.Clock = clock
+
+	saved, err := s.tokenManager.SaveCustomIfNewer(customToken)
+	if err != nil || !saved {
+		return err
+	}
+
+	message, err := json.Marshal(customToken)
+	if err != nil {
+		return err
+	}
+
+	s.feed.Send(walletevent.Event{
+		Type:    token.EventCustomTokenChanged,
+		ChainID: customToken.ChainID,
+		Message: string(message),
+	})
+	return nil
+}
+
 // GetFeed returns signals feed.
 func (s *Service) GetFeed() *event.Feed {
 	return s.transferController.TransferFeed
 }
 
+// GetTransactor returns the transactor sending the transactions of the
+// wallet accounts
+func (s *Service) GetTransactor() *transactions.Transactor {
+	return s.transactor
+}
+
 // Stop reactor and close db.
 func (s *Service) Stop() error {
 	log.Info("wallet will be stopped")
@@ -180,6 +290,14 @@ func (s *Service) Stop() error {
 	s.currency.Stop()
 	s.reader.Stop()
 	s.history.Stop()
+	s.portfolioManager.Stop()
+	s.approvalsManager.Stop()
+	s.spamManager.Stop()
+	s.tokenListsManager.Stop()
+	s.gasOracle.Stop()
+	s.pendingTxTracker.Stop()
+	s.watchOnlyWatcher.Stop()
+	s.userOperations.Stop()
 	s.activity.Stop()
 	s.started = false
 	log.Info("wallet stopped")
@@ -0,0 +1,470 @@
+package simulation
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/services/wallet/approvals"
+	"github.com/status-im/status-go/services/wallet/bigint"
+	"github.com/status-im/status-go/transactions"
+)
+
+type AssetType string
+
+const (
+	AssetTypeNative AssetType = "native"
+	AssetTypeErc20  AssetType = "erc20"
+	AssetTypeErc721 AssetType = "erc721"
+)
+
+type RiskType string
+
+const (
+	// RiskReverted means the transaction fails and only burns its fees
+	RiskReverted RiskType = "reverted"
+	// RiskUnlimitedApproval means the spender can transfer all the tokens of
+	// the sender, now and in the future
+	RiskUnlimitedApproval RiskType = "unlimited-approval"
+	// RiskOperatorApproval means the spender can transfer all the tokens of a
+	// collection
+	RiskOperatorApproval RiskType = "operator-approval"
+	// RiskNoContractCode means call data is sent to an address without code,
+	// usually a mistake
+	RiskNoContractCode RiskType = "no-contract-code"
+)
+
+type RiskLevel int
+
+const (
+	RiskLevelNone RiskLevel = iota
+	RiskLevelLow
+	RiskLevelMedium
+	RiskLevelHigh
+)
+
+var riskLevels = map[RiskType]RiskLevel{
+	RiskReverted:          RiskLevelMedium,
+	RiskUnlimitedApproval: RiskLevelHigh,
+	RiskOperatorApproval:  RiskLevelHigh,
+	RiskNoContractCode:    RiskLevelMedium,
+}
+
+var (
+	transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+	transferSelector          = crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]
+	approveSelector           = crypto.Keccak256([]byte("approve(address,uint256)"))[:4]
+	setApprovalForAllSelector = crypto.Keccak256([]byte("setApprovalForAll(address,bool)"))[:4]
+	panicSelector             = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+type Risk struct {
+	Type  RiskType  `json:"type"`
+	Level RiskLevel `json:"level"`
+}
+
+// BalanceChange is the balance difference of an account for an asset after
+// the transaction
+type BalanceChange struct {
+	Account      common.Address `json:"account"`
+	AssetType    AssetType      `json:"assetType"`
+	TokenAddress common.Address `json:"tokenAddress"`
+	TokenID      *hexutil.Big   `json:"tokenId,omitempty"`
+	Delta        *bigint.BigInt `json:"delta"`
+}
+
+type Result struct {
+	Success      bool          `json:"success"`
+	ReturnData   hexutil.Bytes `json:"returnData,omitempty"`
+	RevertReason string        `json:"revertReason,omitempty"`
+	GasUsed      uint64        `json:"gasUsed,omitempty"`
+	// TraceAvailable is false when the node doesn't support call tracing, the
+	// changes are then decoded from the call data of the transaction only
+	TraceAvailable   bool                  `json:"traceAvailable"`
+	BalanceChanges   []*BalanceChange      `json:"balanceChanges"`
+	AllowanceChanges []*approvals.Approval `json:"allowanceChanges"`
+	Risks            []Risk                `json:"risks"`
+	RiskLevel        RiskLevel             `json:"riskLevel"`
+}
+
+type callLog struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+// callFrame is a call of the callTracer of debug_traceCall
+type callFrame struct {
+	Type    string         `json:"type"`
+	From    common.Address `json:"from"`
+	To      common.Address `json:"to"`
+	Value   *hexutil.Big   `json:"value"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Input   hexutil.Bytes  `json:"input"`
+	Output  hexutil.Bytes  `json:"output"`
+	Error   string         `json:"error"`
+	Calls   []callFrame    `json:"calls"`
+	Logs    []callLog      `json:"logs"`
+}
+
+type balanceKey struct {
+	account   common.Address
+	assetType AssetType
+	token     common.Address
+	tokenID   string
+}
+
+type balanceChanges struct {
+	changes map[balanceKey]*BalanceChange
+}
+
+func newBalanceChanges() *balanceChanges {
+	return &balanceChanges{changes: make(map[balanceKey]*BalanceChange)}
+}
+
+func (b *balanceChanges) add(account common.Address, assetType AssetType, token common.Address, tokenID *big.Int, delta *big.Int) {
+	key := balanceKey{account: account, assetType: assetType, token: token}
+	if tokenID != nil {
+		key.tokenID = tokenID.String()
+	}
+	change, ok := b.changes[key]
+	if !ok {
+		change = &BalanceChange{
+			Account:      account,
+			AssetType:    assetType,
+			TokenAddress: token,
+			Delta:        &bigint.BigInt{Int: new(big.Int)},
+		}
+		if tokenID != nil {
+			change.TokenID = (*hexutil.Big)(tokenID)
+		}
+		b.changes[key] = change
+	}
+	change.Delta.Add(change.Delta.Int, delta)
+}
+
+func (b *balanceChanges) transfer(from, to common.Address, assetType AssetType, token common.Address, tokenID *big.Int, amount *big.Int) {
+	if amount.Sign() == 0 || from == to {
+		return
+	}
+	b.add(from, assetType, token, tokenID, new(big.Int).Neg(amount))
+	b.add(to, assetType, token, tokenID, amount)
+}
+
+// list returns the non zero changes, sorted by account and asset
+func (b *balanceChanges) list() []*BalanceChange {
+	result := make([]*BalanceChange, 0, len(b.changes))
+	for _, change := range b.changes {
+		if change.Delta.Sign() != 0 {
+			result = append(result, change)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if c := bytes.Compare(result[i].Account.Bytes(), result[j].Account.Bytes()); c != 0 {
+			return c < 0
+		}
+		if result[i].AssetType != result[j].AssetType {
+			return result[i].AssetType < result[j].AssetType
+		}
+		if c := bytes.Compare(result[i].TokenAddress.Bytes(), result[j].TokenAddress.Bytes()); c != 0 {
+			return c < 0
+		}
+		if result[i].TokenID == nil || result[j].TokenID == nil {
+			return result[j].TokenID != nil
+		}
+		return result[i].TokenID.ToInt().Cmp(result[j].TokenID.ToInt()) < 0
+	})
+	return result
+}
+
+// addLog records the token transfer or approval of a log
+func (b *balanceChanges) addLog(chainID uint64, l types.Log, allowances *[]*approvals.Approval) {
+	if len(l.Topics) == 0 {
+		return
+	}
+
+	if l.Topics[0] == transferTopic && len(l.Topics) >= 3 {
+		from := common.BytesToAddress(l.Topics[1].Bytes())
+		to := common.BytesToAddress(l.Topics[2].Bytes())
+		switch {
+		case len(l.Topics) == 3 && len(l.Data) == 32:
+			b.transfer(from, to, AssetTypeErc20, l.Address, nil, new(big.Int).SetBytes(l.Data))
+		case len(l.Topics) == 4:
+			b.transfer(from, to, AssetTypeErc721, l.Address, l.Topics[3].Big(), big.NewInt(1))
+		}
+		return
+	}
+
+	if approval, ok := approvals.ApprovalFromLog(chainID, l); ok {
+		*allowances = append(*allowances, approval)
+	}
+}
+
+// addFrame records the value transfers and logs of a successful call and its
+// sub calls. Reverted calls have no effect
+func (b *balanceChanges) addFrame(chainID uint64, frame *callFrame, allowances *[]*approvals.Approval) {
+	if frame.Error != "" {
+		return
+	}
+
+	if frame.Value != nil && frame.Type != "DELEGATECALL" && frame.Type != "STATICCALL" {
+		b.transfer(frame.From, frame.To, AssetTypeNative, common.Address{}, nil, frame.Value.ToInt())
+	}
+
+	for _, l := range frame.Logs {
+		b.addLog(chainID, types.Log{Address: l.Address, Topics: l.Topics, Data: l.Data}, allowances)
+	}
+
+	for i := range frame.Calls {
+		b.addFrame(chainID, &frame.Calls[i], allowances)
+	}
+}
+
+// decodeCallData records the changes of the most common transactions from
+// their call data, used when the node can't trace the call
+func (b *balanceChanges) decodeCallData(chainID uint64, from common.Address, to common.Address, value *big.Int, data []byte, allowances *[]*approvals.Approval) {
+	if value != nil {
+		b.transfer(from, to, AssetTypeNative, common.Address{}, nil, value)
+	}
+
+	if len(data) != 68 {
+		return
+	}
+	selector := data[:4]
+	target := common.BytesToAddress(data[4:36])
+	amount := new(big.Int).SetBytes(data[36:68])
+
+	switch {
+	case bytes.Equal(selector, transferSelector):
+		b.transfer(from, target, AssetTypeErc20, to, nil, amount)
+	case bytes.Equal(selector, approveSelector):
+		// ERC721 approvals share the selector, the token ID is then read as
+		// a small allowance
+		*allowances = append(*allowances, &approvals.Approval{
+			ChainID:      chainID,
+			Owner:        from,
+			TokenAddress: to,
+			Type:         approvals.Erc20Allowance,
+			Spender:      target,
+			Amount:       (*hexutil.Big)(amount),
+			Unlimited:    approvals.IsUnlimitedAllowance(amount),
+		})
+	case bytes.Equal(selector, setApprovalForAllSelector):
+		*allowances = append(*allowances, &approvals.Approval{
+			ChainID:      chainID,
+			Owner:        from,
+			TokenAddress: to,
+			Type:         approvals.OperatorApproval,
+			Spender:      target,
+			Approved:     amount.Sign() != 0,
+		})
+	}
+}
+
+// decodeRevert returns the reason of a revert from its output, Error(string)
+// and Panic(uint256) are decoded
+func decodeRevert(output []byte) string {
+	if reason, err := abi.UnpackRevert(output); err == nil {
+		return reason
+	}
+	if len(output) == 36 && bytes.Equal(output[:4], panicSelector) {
+		return fmt.Sprintf("panic: 0x%x", new(big.Int).SetBytes(output[4:]))
+	}
+	return ""
+}
+
+// revertErrorCode is the code of the errors returned by the nodes when the
+// call reverts
+const revertErrorCode = 3
+
+// isRevert tells whether an eth_call error means the transaction reverts,
+// from the code of the error or its revert data, rather than the node or the
+// request failing
+func isRevert(err error) bool {
+	var rpcErr gethrpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == revertErrorCode {
+		return true
+	}
+
+	var dataErr gethrpc.DataError
+	if errors.As(err, &dataErr) {
+		if data, ok := dataErr.ErrorData().(string); ok {
+			_, decodeErr := hexutil.Decode(data)
+			return decodeErr == nil
+		}
+	}
+	return false
+}
+
+// revertReason returns the reason of a failed eth_call, from the revert data
+// of the error when the node returns it
+func revertReason(err error) string {
+	var dataErr gethrpc.DataError
+	if errors.As(err, &dataErr) {
+		if data, ok := dataErr.ErrorData().(string); ok {
+			if output, decodeErr := hexutil.Decode(data); decodeErr == nil {
+				if reason := decodeRevert(output); reason != "" {
+					return reason
+				}
+			}
+		}
+	}
+	return err.Error()
+}
+
+// assessRisks fills the risks of the result and its overall risk level
+func assessRisks(result *Result, sentToCode bool) {
+	result.Risks = make([]Risk, 0)
+	addRisk := func(riskType RiskType) {
+		for _, risk := range result.Risks {
+			if risk.Type == riskType {
+				return
+			}
+		}
+		result.Risks = append(result.Risks, Risk{Type: riskType, Level: riskLevels[riskType]})
+	}
+
+	if !result.Success {
+		addRisk(RiskReverted)
+	}
+	if !sentToCode {
+		addRisk(RiskNoContractCode)
+	}
+	for _, approval := range result.AllowanceChanges {
+		switch {
+		case approval.Type == approvals.Erc20Allowance && approval.Unlimited:
+			addRisk(RiskUnlimitedApproval)
+		case approval.Type == approvals.OperatorApproval && approval.Approved:
+			addRisk(RiskOperatorApproval)
+		}
+	}
+
+	result.RiskLevel = RiskLevelNone
+	for _, risk := range result.Risks {
+		if risk.Level > result.RiskLevel {
+			result.RiskLevel = risk.Level
+		}
+	}
+}
+
+func toCallArg(args transactions.SendTxArgs) map[string]interface{} {
+	arg := map[string]interface{}{
+		"from": common.Address(args.From),
+	}
+	if args.To != nil {
+		arg["to"] = common.Address(*args.To)
+	}
+	if args.Gas != nil {
+		arg["gas"] = args.Gas
+	}
+	if args.IsDynamicFeeTx() {
+		arg["maxFeePerGas"] = args.MaxFeePerGas
+		arg["maxPriorityFeePerGas"] = args.MaxPriorityFeePerGas
+	} else if args.GasPrice != nil {
+		arg["gasPrice"] = args.GasPrice
+	}
+	if args.Value != nil {
+		arg["value"] = args.Value
+	}
+	if input := args.GetInput(); len(input) > 0 {
+		arg["data"] = hexutil.Bytes(input)
+	}
+	return arg
+}
+
+// Simulator executes transactions against the latest state of a chain
+// without sending them, to show their outcome before they are signed
+type Simulator struct {
+	rpcClient *rpc.Client
+}
+
+func NewSimulator(rpcClient *rpc.Client) *Simulator {
+	return &Simulator{
+		rpcClient: rpcClient,
+	}
+}
+
+// SimulateTransaction runs the transaction with eth_call and, when the node
+// supports it, traces it to find its balance and allowance changes
+func (s *Simulator) SimulateTransaction(ctx context.Context, chainID uint64, args transactions.SendTxArgs) (*Result, error) {
+	client, err := s.rpcClient.EthClient(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	callArg := toCallArg(args)
+	result := &Result{}
+
+	var returnData hexutil.Bytes
+	err = client.CallContext(ctx, &returnData, "eth_call", callArg, "latest")
+	if err != nil {
+		if !isRevert(err) {
+			return nil, err
+		}
+		result.RevertReason = revertReason(err)
+	} else {
+		result.Success = true
+		result.ReturnData = returnData
+	}
+
+	from := common.Address(args.From)
+	var to common.Address
+	sentToCode := true
+	if args.To != nil {
+		to = common.Address(*args.To)
+		if len(args.GetInput()) > 0 {
+			code, err := client.CodeAt(ctx, to, nil)
+			if err != nil {
+				return nil, err
+			}
+			sentToCode = len(code) > 0
+		}
+	}
+
+	changes := newBalanceChanges()
+	allowances := make([]*approvals.Approval, 0)
+
+	var trace callFrame
+	err = client.CallContext(ctx, &trace, "debug_traceCall", callArg, "latest", map[string]interface{}{
+		"tracer":       "callTracer",
+		"tracerConfig": map[string]interface{}{"withLog": true},
+	})
+	if err == nil {
+		result.TraceAvailable = true
+		result.GasUsed = uint64(trace.GasUsed)
+		if trace.Error != "" && result.RevertReason == "" {
+			result.RevertReason = decodeRevert(trace.Output)
+			if result.RevertReason == "" {
+				result.RevertReason = trace.Error
+			}
+		}
+		changes.addFrame(chainID, &trace, &allowances)
+	} else {
+		log.Debug("call tracing unavailable", "chainID", chainID, "err", err)
+		if result.Success && args.To != nil {
+			var value *big.Int
+			if args.Value != nil {
+				value = args.Value.ToInt()
+			}
+			changes.decodeCallData(chainID, from, to, value, args.GetInput(), &allowances)
+		}
+	}
+
+	result.BalanceChanges = changes.list()
+	result.AllowanceChanges = allowances
+	assessRisks(result, sentToCode)
+	return result, nil
+}
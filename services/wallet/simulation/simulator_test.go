@@ -0,0 +1,160 @@
+package simulation
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/services/wallet/approvals"
+
+	"github.com/stretchr/testify/require"
+)
+
+var approvalTopic = crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))
+
+func addressTopic(address common.Address) common.Hash {
+	return common.BytesToHash(address.Bytes())
+}
+
+func TestTraceChanges(t *testing.T) {
+	sender := common.HexToAddress("0x1")
+	router := common.HexToAddress("0x2")
+	token := common.HexToAddress("0x3")
+	pool := common.HexToAddress("0x4")
+	unlimited := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	trace := &callFrame{
+		Type:  "CALL",
+		From:  sender,
+		To:    router,
+		Value: (*hexutil.Big)(big.NewInt(100)),
+		Calls: []callFrame{
+			{
+				Type: "CALL",
+				From: router,
+				To:   token,
+				Logs: []callLog{
+					{
+						Address: token,
+						Topics:  []common.Hash{transferTopic, addressTopic(pool), addressTopic(sender)},
+						Data:    common.LeftPadBytes(big.NewInt(50).Bytes(), 32),
+					},
+					{
+						Address: token,
+						Topics:  []common.Hash{approvalTopic, addressTopic(sender), addressTopic(router)},
+						Data:    common.LeftPadBytes(unlimited.Bytes(), 32),
+					},
+				},
+			},
+			{
+				Type:  "CALL",
+				From:  router,
+				To:    pool,
+				Value: (*hexutil.Big)(big.NewInt(100)),
+			},
+			// reverted calls have no effect
+			{
+				Type:  "CALL",
+				From:  router,
+				To:    pool,
+				Value: (*hexutil.Big)(big.NewInt(5)),
+				Error: "execution reverted",
+			},
+		},
+	}
+
+	changes := newBalanceChanges()
+	allowances := make([]*approvals.Approval, 0)
+	changes.addFrame(1, trace, &allowances)
+
+	list := changes.list()
+	require.Len(t, list, 4)
+	require.Equal(t, sender, list[0].Account)
+	require.Equal(t, AssetTypeErc20, list[0].AssetType)
+	require.Equal(t, int64(50), list[0].Delta.Int64())
+	require.Equal(t, sender, list[1].Account)
+	require.Equal(t, AssetTypeNative, list[1].AssetType)
+	require.Equal(t, int64(-100), list[1].Delta.Int64())
+	require.Equal(t, pool, list[2].Account)
+	require.Equal(t, AssetTypeErc20, list[2].AssetType)
+	require.Equal(t, int64(-50), list[2].Delta.Int64())
+	require.Equal(t, pool, list[3].Account)
+	require.Equal(t, AssetTypeNative, list[3].AssetType)
+	require.Equal(t, int64(100), list[3].Delta.Int64())
+
+	require.Len(t, allowances, 1)
+	require.Equal(t, router, allowances[0].Spender)
+	require.True(t, allowances[0].Unlimited)
+
+	result := &Result{Success: true, AllowanceChanges: allowances}
+	assessRisks(result, true)
+	require.Equal(t, []Risk{{Type: RiskUnlimitedApproval, Level: RiskLevelHigh}}, result.Risks)
+	require.Equal(t, RiskLevelHigh, result.RiskLevel)
+}
+
+func TestDecodeCallData(t *testing.T) {
+	sender := common.HexToAddress("0x1")
+	token := common.HexToAddress("0x3")
+	recipient := common.HexToAddress("0x5")
+
+	data := append(append([]byte{}, transferSelector...), common.LeftPadBytes(recipient.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(7).Bytes(), 32)...)
+
+	changes := newBalanceChanges()
+	allowances := make([]*approvals.Approval, 0)
+	changes.decodeCallData(1, sender, token, nil, data, &allowances)
+
+	list := changes.list()
+	require.Len(t, list, 2)
+	require.Equal(t, int64(-7), list[0].Delta.Int64())
+	require.Equal(t, recipient, list[1].Account)
+	require.Equal(t, token, list[1].TokenAddress)
+	require.Len(t, allowances, 0)
+
+	data = append(append([]byte{}, setApprovalForAllSelector...), common.LeftPadBytes(recipient.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes([]byte{1}, 32)...)
+	changes.decodeCallData(1, sender, token, nil, data, &allowances)
+	require.Len(t, allowances, 1)
+	require.Equal(t, approvals.OperatorApproval, allowances[0].Type)
+
+	result := &Result{AllowanceChanges: allowances}
+	assessRisks(result, false)
+	require.Len(t, result.Risks, 3)
+	require.Equal(t, RiskLevelHigh, result.RiskLevel)
+}
+
+type testRPCError struct {
+	code int
+	data interface{}
+}
+
+func (e *testRPCError) Error() string          { return "rpc error" }
+func (e *testRPCError) ErrorCode() int         { return e.code }
+func (e *testRPCError) ErrorData() interface{} { return e.data }
+
+func TestIsRevert(t *testing.T) {
+	require.True(t, isRevert(&testRPCError{code: revertErrorCode}))
+	require.True(t, isRevert(&testRPCError{code: -32000, data: "0x08c379a0"}))
+
+	require.False(t, isRevert(&testRPCError{code: -32000}))
+	require.False(t, isRevert(&testRPCError{code: -32000, data: "not hex"}))
+	require.False(t, isRevert(errors.New("connection refused")))
+}
+
+func TestDecodeRevert(t *testing.T) {
+	// Error("too low")
+	output := hexutil.MustDecode("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000007" +
+		"746f6f206c6f7700000000000000000000000000000000000000000000000000")
+	require.Equal(t, "too low", decodeRevert(output))
+
+	output = append(append([]byte{}, panicSelector...), common.LeftPadBytes([]byte{0x11}, 32)...)
+	require.Equal(t, "panic: 0x11", decodeRevert(output))
+
+	require.Equal(t, "", decodeRevert(nil))
+}
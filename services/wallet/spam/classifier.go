@@ -0,0 +1,112 @@
+package spam
+
+import (
+	"math/big"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Classification int
+
+const (
+	// Legit tokens are shown
+	Legit Classification = iota
+	// Suspicious tokens are shown, flagged so that the user can hide them
+	Suspicious
+	// Spam tokens are hidden by default
+	Spam
+)
+
+type Reason string
+
+const (
+	ReasonBlocklisted  Reason = "blocklisted"
+	ReasonMaliciousURL Reason = "maliciousUrl"
+	ReasonURLInName    Reason = "urlInName"
+	ReasonNoLiquidity  Reason = "noLiquidity"
+	ReasonUserHidden   Reason = "userHidden"
+	ReasonUserReported Reason = "userReported"
+	ReasonUserNotSpam  Reason = "userNotSpam"
+	ReasonNone         Reason = ""
+)
+
+// Asset is a token or a collectible as seen by the classifier, TokenID is nil
+// for ERC20 tokens
+type Asset struct {
+	ChainID     uint64
+	Address     common.Address
+	TokenID     *big.Int
+	Name        string
+	Symbol      string
+	Description string
+	// URLs are the links found in the metadata, e.g. the external link or
+	// the image of a collectible
+	URLs []string
+	// Listed is set for the tokens of the token lists
+	Listed bool
+	// HasMarket is set when the token has a price or the collectible was
+	// sold or is on sale
+	HasMarket bool
+}
+
+type Result struct {
+	Classification Classification `json:"classification"`
+	Reason         Reason         `json:"reason"`
+}
+
+// urlPattern matches the links and bare domains scam tokens put in their name
+// to lure the users into claiming an airdrop
+var urlPattern = regexp.MustCompile(`(?i)(https?://[^\s]+|www\.[^\s]+|\b[a-z0-9-]+\.(com|io|org|net|xyz|app|site|online|finance|fi|gift|top|club|claim|vip|pro|info)\b)`)
+
+func containsURL(s string) bool {
+	return urlPattern.MatchString(s)
+}
+
+// urlDomain returns the domain of a link, with or without scheme
+func urlDomain(link string) string {
+	link = strings.TrimSpace(strings.ToLower(link))
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(u.Hostname(), "www.")
+}
+
+// classify applies the heuristics to the asset, from the most to the least
+// certain
+func classify(asset Asset, blocklist *Blocklist) Result {
+	if blocklist.hasContract(asset.ChainID, asset.Address) {
+		return Result{Spam, ReasonBlocklisted}
+	}
+
+	links := append([]string{}, asset.URLs...)
+	for _, text := range []string{asset.Name, asset.Symbol, asset.Description} {
+		links = append(links, urlPattern.FindAllString(text, -1)...)
+	}
+	for _, link := range links {
+		if blocklist.hasDomain(urlDomain(link)) {
+			return Result{Spam, ReasonMaliciousURL}
+		}
+	}
+
+	// Listed tokens are vetted by the token lists
+	if asset.Listed {
+		return Result{Legit, ReasonNone}
+	}
+
+	if containsURL(asset.Name) || containsURL(asset.Symbol) {
+		return Result{Spam, ReasonURLInName}
+	}
+
+	if !asset.HasMarket {
+		return Result{Suspicious, ReasonNoLiquidity}
+	}
+
+	return Result{Legit, ReasonNone}
+}
@@ -0,0 +1,201 @@
+package spam
+
+import (
+	"database/sql"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type OverrideState int
+
+const (
+	// Hidden tokens are hidden whatever their classification
+	Hidden OverrideState = iota + 1
+	// Reported tokens are hidden and were reported as spam by the user
+	Reported
+	// NotSpam tokens are shown whatever their classification
+	NotSpam
+)
+
+const (
+	blocklistContract = 0
+	blocklistDomain   = 1
+)
+
+// Override is a classification set by the user, TokenID is nil when it
+// applies to all the tokens of the contract
+type Override struct {
+	ChainID   uint64         `json:"chainId"`
+	Address   common.Address `json:"address"`
+	TokenID   *hexutil.Big   `json:"tokenId,omitempty"`
+	State     OverrideState  `json:"state"`
+	UpdatedAt int64          `json:"updatedAt"`
+}
+
+// Blocklist is the community maintained list of scam contracts and domains
+type Blocklist struct {
+	Contracts []BlockedContract `json:"contracts"`
+	Domains   []string          `json:"domains"`
+
+	contracts map[uint64]map[common.Address]bool
+	domains   map[string]bool
+}
+
+type BlockedContract struct {
+	ChainID uint64         `json:"chainId"`
+	Address common.Address `json:"address"`
+}
+
+func (b *Blocklist) index() {
+	b.contracts = make(map[uint64]map[common.Address]bool)
+	for _, contract := range b.Contracts {
+		if _, ok := b.contracts[contract.ChainID]; !ok {
+			b.contracts[contract.ChainID] = make(map[common.Address]bool)
+		}
+		b.contracts[contract.ChainID][contract.Address] = true
+	}
+	b.domains = make(map[string]bool)
+	for _, domain := range b.Domains {
+		b.domains[strings.TrimPrefix(strings.ToLower(domain), "www.")] = true
+	}
+}
+
+func (b *Blocklist) hasContract(chainID uint64, address common.Address) bool {
+	return b != nil && b.contracts[chainID][address]
+}
+
+// hasDomain reports whether the domain or one of its parents is blocked
+func (b *Blocklist) hasDomain(domain string) bool {
+	if b == nil || domain == "" {
+		return false
+	}
+	for {
+		if b.domains[domain] {
+			return true
+		}
+		i := strings.Index(domain, ".")
+		if i < 0 {
+			return false
+		}
+		domain = domain[i+1:]
+	}
+}
+
+type SpamDB struct {
+	db *sql.DB
+}
+
+func NewSpamDB(sqlDb *sql.DB) *SpamDB {
+	return &SpamDB{
+		db: sqlDb,
+	}
+}
+
+func tokenIDBytes(tokenID *big.Int) []byte {
+	if tokenID == nil {
+		return []byte{}
+	}
+	return tokenID.Bytes()
+}
+
+func (s *SpamDB) saveOverride(chainID uint64, address common.Address, tokenID *big.Int, state OverrideState, updatedAt int64) error {
+	_, err := s.db.Exec(`INSERT OR REPLACE INTO token_spam_overrides (chain_id, address, token_id, state, updated_at) VALUES (?, ?, ?, ?, ?)`,
+		chainID, address, tokenIDBytes(tokenID), state, updatedAt)
+	return err
+}
+
+func (s *SpamDB) deleteOverride(chainID uint64, address common.Address, tokenID *big.Int) error {
+	_, err := s.db.Exec(`DELETE FROM token_spam_overrides WHERE chain_id = ? AND address = ? AND token_id = ?`,
+		chainID, address, tokenIDBytes(tokenID))
+	return err
+}
+
+func (s *SpamDB) getOverrides() ([]*Override, error) {
+	rows, err := s.db.Query(`SELECT chain_id, address, token_id, state, updated_at FROM token_spam_overrides`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	overrides := make([]*Override, 0)
+	for rows.Next() {
+		override := &Override{}
+		var tokenID []byte
+		err = rows.Scan(&override.ChainID, &override.Address, &tokenID, &override.State, &override.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if len(tokenID) > 0 {
+			override.TokenID = (*hexutil.Big)(new(big.Int).SetBytes(tokenID))
+		}
+		overrides = append(overrides, override)
+	}
+	return overrides, rows.Err()
+}
+
+func (s *SpamDB) getBlocklist() (*Blocklist, error) {
+	rows, err := s.db.Query(`SELECT kind, chain_id, value FROM token_spam_blocklist`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	blocklist := &Blocklist{}
+	for rows.Next() {
+		var kind int
+		var chainID uint64
+		var value string
+		err = rows.Scan(&kind, &chainID, &value)
+		if err != nil {
+			return nil, err
+		}
+		if kind == blocklistDomain {
+			blocklist.Domains = append(blocklist.Domains, value)
+		} else {
+			blocklist.Contracts = append(blocklist.Contracts, BlockedContract{ChainID: chainID, Address: common.HexToAddress(value)})
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	blocklist.index()
+	return blocklist, nil
+}
+
+// saveBlocklist replaces the stored blocklist with the fetched one
+func (s *SpamDB) saveBlocklist(blocklist *Blocklist) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`DELETE FROM token_spam_blocklist`)
+	if err != nil {
+		return err
+	}
+	for _, contract := range blocklist.Contracts {
+		_, err = tx.Exec(`INSERT OR REPLACE INTO token_spam_blocklist (kind, chain_id, value) VALUES (?, ?, ?)`,
+			blocklistContract, contract.ChainID, contract.Address.Hex())
+		if err != nil {
+			return err
+		}
+	}
+	for _, domain := range blocklist.Domains {
+		_, err = tx.Exec(`INSERT OR REPLACE INTO token_spam_blocklist (kind, chain_id, value) VALUES (?, 0, ?)`,
+			blocklistDomain, domain)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
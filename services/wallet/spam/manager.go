@@ -0,0 +1,290 @@
+package spam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+const (
+	// EventSpamClassificationUpdated is sent when the blocklist or the user
+	// overrides changed, the balances and collectibles should be reloaded
+	EventSpamClassificationUpdated walletevent.EventType = "wallet-spam-classification-updated"
+
+	blocklistFetchInterval = 12 * time.Hour
+	blocklistFetchTimeout  = 30 * time.Second
+)
+
+type overrideKey struct {
+	chainID uint64
+	address common.Address
+	tokenID string
+}
+
+func newOverrideKey(chainID uint64, address common.Address, tokenID *big.Int) overrideKey {
+	key := overrideKey{chainID: chainID, address: address}
+	if tokenID != nil {
+		key.tokenID = tokenID.String()
+	}
+	return key
+}
+
+// Manager classifies the tokens and collectibles of the wallet accounts, so
+// that the airdropped scams can be hidden from the balances and collectibles
+type Manager struct {
+	db           *SpamDB
+	blocklistURL string
+	httpClient   *http.Client
+	walletFeed   *event.Feed
+
+	mu        sync.RWMutex
+	blocklist *Blocklist
+	overrides map[overrideKey]*Override
+	loaded    bool
+
+	cancel context.CancelFunc
+}
+
+// NewManager creates a manager, the blocklist isn't fetched when blocklistURL
+// is empty
+func NewManager(db *SpamDB, blocklistURL string, walletFeed *event.Feed) *Manager {
+	return &Manager{
+		db:           db,
+		blocklistURL: blocklistURL,
+		httpClient:   &http.Client{Timeout: blocklistFetchTimeout},
+		walletFeed:   walletFeed,
+	}
+}
+
+func (m *Manager) Start() {
+	if m.blocklistURL == "" {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	go func() {
+		ticker := time.NewTicker(blocklistFetchInterval)
+		defer ticker.Stop()
+		for {
+			err := m.updateBlocklist(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Warn("failed to update spam blocklist", "err", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (m *Manager) Stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+}
+
+func (m *Manager) load() error {
+	m.mu.RLock()
+	loaded := m.loaded
+	m.mu.RUnlock()
+	if loaded {
+		return nil
+	}
+
+	blocklist, err := m.db.getBlocklist()
+	if err != nil {
+		return err
+	}
+	overrides, err := m.db.getOverrides()
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.blocklist == nil {
+		m.blocklist = blocklist
+	}
+	m.overrides = make(map[overrideKey]*Override)
+	for _, override := range overrides {
+		m.overrides[newOverrideKey(override.ChainID, override.Address, override.TokenID.ToInt())] = override
+	}
+	m.loaded = true
+	return nil
+}
+
+func (m *Manager) updateBlocklist(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.blocklistURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected blocklist response status %d", resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	blocklist := &Blocklist{}
+	err = json.Unmarshal(body, blocklist)
+	if err != nil {
+		return err
+	}
+	blocklist.index()
+
+	err = m.db.saveBlocklist(blocklist)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.blocklist = blocklist
+	m.mu.Unlock()
+
+	m.notifyUpdated()
+	return nil
+}
+
+func (m *Manager) notifyUpdated() {
+	if m.walletFeed == nil {
+		return
+	}
+	m.walletFeed.Send(walletevent.Event{
+		Type: EventSpamClassificationUpdated,
+	})
+}
+
+func overrideResult(override *Override) Result {
+	switch override.State {
+	case Hidden:
+		return Result{Spam, ReasonUserHidden}
+	case Reported:
+		return Result{Spam, ReasonUserReported}
+	default:
+		return Result{Legit, ReasonUserNotSpam}
+	}
+}
+
+// Classify returns the classification of the asset, the overrides of the
+// user win over the heuristics
+func (m *Manager) Classify(asset Asset) Result {
+	err := m.load()
+	if err != nil {
+		log.Error("failed to load spam classification", "err", err)
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if asset.TokenID != nil {
+		if override, ok := m.overrides[newOverrideKey(asset.ChainID, asset.Address, asset.TokenID)]; ok {
+			return overrideResult(override)
+		}
+	}
+	if override, ok := m.overrides[newOverrideKey(asset.ChainID, asset.Address, nil)]; ok {
+		return overrideResult(override)
+	}
+
+	return classify(asset, m.blocklist)
+}
+
+// IsHidden reports whether the asset is left out of the balances and
+// collectibles by default
+func (m *Manager) IsHidden(asset Asset) bool {
+	return m.Classify(asset).Classification == Spam
+}
+
+func (m *Manager) setOverride(chainID uint64, address common.Address, tokenID *big.Int, state OverrideState) error {
+	err := m.load()
+	if err != nil {
+		return err
+	}
+
+	override := &Override{
+		ChainID:   chainID,
+		Address:   address,
+		State:     state,
+		UpdatedAt: time.Now().Unix(),
+	}
+	if tokenID != nil {
+		override.TokenID = (*hexutil.Big)(tokenID)
+	}
+	err = m.db.saveOverride(chainID, address, tokenID, state, override.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.overrides[newOverrideKey(chainID, address, tokenID)] = override
+	m.mu.Unlock()
+
+	m.notifyUpdated()
+	return nil
+}
+
+// Hide hides a token, or a collectible when tokenID is set
+func (m *Manager) Hide(chainID uint64, address common.Address, tokenID *big.Int) error {
+	return m.setOverride(chainID, address, tokenID, Hidden)
+}
+
+// Report hides a token, or a collectible when tokenID is set, the user
+// reported it as spam
+func (m *Manager) Report(chainID uint64, address common.Address, tokenID *big.Int) error {
+	return m.setOverride(chainID, address, tokenID, Reported)
+}
+
+// MarkNotSpam shows a token, or a collectible when tokenID is set, whatever
+// its classification
+func (m *Manager) MarkNotSpam(chainID uint64, address common.Address, tokenID *big.Int) error {
+	return m.setOverride(chainID, address, tokenID, NotSpam)
+}
+
+// Unhide removes the override of the user, the token is classified by the
+// heuristics again
+func (m *Manager) Unhide(chainID uint64, address common.Address, tokenID *big.Int) error {
+	err := m.load()
+	if err != nil {
+		return err
+	}
+
+	err = m.db.deleteOverride(chainID, address, tokenID)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	delete(m.overrides, newOverrideKey(chainID, address, tokenID))
+	m.mu.Unlock()
+
+	m.notifyUpdated()
+	return nil
+}
+
+// GetOverrides returns the tokens and collectibles the user hid, reported or
+// marked as not spam
+func (m *Manager) GetOverrides() ([]*Override, error) {
+	return m.db.getOverrides()
+}
@@ -0,0 +1,100 @@
+package spam
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/appdatabase"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestManager(t *testing.T, blocklistURL string) (*Manager, func()) {
+	db, err := appdatabase.SetupTestMemorySQLDB("spam-tests")
+	require.NoError(t, err)
+	return NewManager(NewSpamDB(db), blocklistURL, nil), func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+func TestClassify(t *testing.T) {
+	blocklist := &Blocklist{
+		Contracts: []BlockedContract{{ChainID: 1, Address: common.HexToAddress("0x1")}},
+		Domains:   []string{"scam.xyz"},
+	}
+	blocklist.index()
+
+	testCases := []struct {
+		name   string
+		asset  Asset
+		result Result
+	}{
+		{"blocklisted contract", Asset{ChainID: 1, Address: common.HexToAddress("0x1"), Listed: true}, Result{Spam, ReasonBlocklisted}},
+		{"blocklisted contract on another chain", Asset{ChainID: 10, Address: common.HexToAddress("0x1"), HasMarket: true}, Result{Legit, ReasonNone}},
+		{"malicious subdomain in description", Asset{ChainID: 1, Description: "Claim at https://claim.scam.xyz/now", HasMarket: true}, Result{Spam, ReasonMaliciousURL}},
+		{"malicious image", Asset{ChainID: 1, URLs: []string{"https://www.scam.xyz/a.png"}, HasMarket: true}, Result{Spam, ReasonMaliciousURL}},
+		{"url in name", Asset{ChainID: 1, Name: "Visit rewards-eth.io to claim", HasMarket: true}, Result{Spam, ReasonURLInName}},
+		{"listed with url in name", Asset{ChainID: 1, Symbol: "fi.com", Listed: true}, Result{Legit, ReasonNone}},
+		{"no liquidity", Asset{ChainID: 1, Name: "Token"}, Result{Suspicious, ReasonNoLiquidity}},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.result, classify(tc.asset, blocklist), tc.name)
+	}
+}
+
+func TestOverrides(t *testing.T) {
+	m, stop := setupTestManager(t, "")
+	defer stop()
+
+	contract := common.HexToAddress("0x2")
+	scam := Asset{ChainID: 1, Address: contract, Name: "airdrop.xyz", TokenID: big.NewInt(1)}
+	other := Asset{ChainID: 1, Address: contract, Name: "Token", TokenID: big.NewInt(2), HasMarket: true}
+
+	require.True(t, m.IsHidden(scam))
+	require.False(t, m.IsHidden(other))
+
+	// Overrides of a collectible win over the ones of its contract
+	require.NoError(t, m.Hide(1, contract, nil))
+	require.NoError(t, m.MarkNotSpam(1, contract, big.NewInt(1)))
+	require.False(t, m.IsHidden(scam))
+	require.Equal(t, Result{Spam, ReasonUserHidden}, m.Classify(other))
+
+	require.NoError(t, m.Report(1, contract, big.NewInt(2)))
+	require.Equal(t, Result{Spam, ReasonUserReported}, m.Classify(other))
+
+	overrides, err := m.GetOverrides()
+	require.NoError(t, err)
+	require.Len(t, overrides, 3)
+
+	require.NoError(t, m.Unhide(1, contract, nil))
+	require.NoError(t, m.Unhide(1, contract, big.NewInt(1)))
+	require.True(t, m.IsHidden(scam))
+
+	// The overrides are loaded from the database
+	reloaded := NewManager(m.db, "", nil)
+	require.Equal(t, Result{Spam, ReasonUserReported}, reloaded.Classify(other))
+	require.True(t, reloaded.IsHidden(scam))
+}
+
+func TestUpdateBlocklist(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"contracts":[{"chainId":1,"address":"0x0000000000000000000000000000000000000003"}],"domains":["scam.xyz"]}`))
+	}))
+	defer srv.Close()
+
+	m, stop := setupTestManager(t, srv.URL)
+	defer stop()
+
+	require.NoError(t, m.updateBlocklist(context.Background()))
+	require.True(t, m.IsHidden(Asset{ChainID: 1, Address: common.HexToAddress("0x3"), Listed: true}))
+
+	// The blocklist is kept until the next fetch
+	reloaded := NewManager(m.db, "", nil)
+	require.True(t, reloaded.IsHidden(Asset{ChainID: 1, Address: common.HexToAddress("0x3"), Listed: true}))
+	require.True(t, reloaded.IsHidden(Asset{ChainID: 1, Description: "see scam.xyz", HasMarket: true}))
+}
@@ -0,0 +1,68 @@
+package wallet
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/services/wallet/collectibles"
+	"github.com/status-im/status-go/services/wallet/spam"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+	"github.com/status-im/status-go/services/wallet/thirdparty/opensea"
+)
+
+func spamAssetFromOpensea(chainID uint64, asset opensea.Asset) spam.Asset {
+	spamAsset := spam.Asset{
+		ChainID:     chainID,
+		Address:     common.HexToAddress(asset.Contract.Address),
+		Name:        asset.Name,
+		Description: asset.Description,
+		URLs:        []string{asset.ImageURL, asset.AnimationURL, asset.TokenURI},
+		HasMarket:   asset.LastSale.PaymentToken.Symbol != "" || len(asset.SellOrders) > 0,
+	}
+	if asset.TokenID != nil {
+		spamAsset.TokenID = asset.TokenID.Int
+	}
+	return spamAsset
+}
+
+// filterSpamAssets leaves out the collectibles classified as spam or hidden
+// by the user
+func (s *Service) filterSpamAssets(chainID uint64, container *opensea.AssetContainer) *opensea.AssetContainer {
+	if container == nil {
+		return nil
+	}
+	assets := make([]opensea.Asset, 0, len(container.Assets))
+	for _, asset := range container.Assets {
+		if s.spamManager.IsHidden(spamAssetFromOpensea(chainID, asset)) {
+			continue
+		}
+		assets = append(assets, asset)
+	}
+	container.Assets = assets
+	return container
+}
+
+// filterSpamOwnedCollectibles leaves out the collectibles of the blocklisted
+// contracts or hidden by the user, the cache has no metadata to classify them
+// further
+func (s *Service) filterSpamOwnedCollectibles(owned *collectibles.OwnedCollectibles) *collectibles.OwnedCollectibles {
+	if owned == nil {
+		return nil
+	}
+	ids := make([]thirdparty.NFTUniqueID, 0, len(owned.IDs))
+	for _, id := range owned.IDs {
+		spamAsset := spam.Asset{
+			ChainID:   owned.ChainID,
+			Address:   id.ContractAddress,
+			HasMarket: true,
+		}
+		if id.TokenID != nil {
+			spamAsset.TokenID = id.TokenID.Int
+		}
+		if s.spamManager.IsHidden(spamAsset) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	owned.IDs = ids
+	return owned
+}
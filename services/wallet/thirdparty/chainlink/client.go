@@ -0,0 +1,142 @@
+package chainlink
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/rpc"
+)
+
+// feedRegistryABI is the subset of the Chainlink feed registry used to read
+// the prices
+const feedRegistryABI = `[{"inputs":[{"internalType":"address","name":"base","type":"address"},{"internalType":"address","name":"quote","type":"address"}],"name":"decimals","outputs":[{"internalType":"uint8","name":"","type":"uint8"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"base","type":"address"},{"internalType":"address","name":"quote","type":"address"}],"name":"latestRoundData","outputs":[{"internalType":"uint80","name":"roundId","type":"uint80"},{"internalType":"int256","name":"answer","type":"int256"},{"internalType":"uint256","name":"startedAt","type":"uint256"},{"internalType":"uint256","name":"updatedAt","type":"uint256"},{"internalType":"uint80","name":"answeredInRound","type":"uint80"}],"stateMutability":"view","type":"function"}]`
+
+const mainnetChainID = 1
+
+const callTimeout = 10 * time.Second
+
+var feedRegistryAddress = common.HexToAddress("0x47Fb2585D2C56Fe188D0E6ec628a38b74fCeeeDf")
+
+// denominations are the addresses the feed registry uses for assets which
+// aren't tokens
+var denominations = map[string]common.Address{
+	"ETH": common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"),
+	"BTC": common.HexToAddress("0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"),
+}
+
+// fiatCodes are the ISO 4217 numeric codes of the fiat currencies, the feed
+// registry uses them as addresses
+var fiatCodes = map[string]int64{
+	"USD": 840,
+	"EUR": 978,
+	"GBP": 826,
+	"JPY": 392,
+	"CHF": 756,
+	"CAD": 124,
+	"AUD": 36,
+}
+
+var ErrNoPrice = errors.New("no price found")
+
+// TokenAddressResolver returns the mainnet address of the token with the
+// symbol
+type TokenAddressResolver func(symbol string) (common.Address, bool)
+
+// Client reads the prices from the Chainlink feed registry on mainnet, it's
+// used when the market data providers are unavailable
+type Client struct {
+	rpcClient    *rpc.Client
+	resolveToken TokenAddressResolver
+}
+
+func NewClient(rpcClient *rpc.Client, resolveToken TokenAddressResolver) *Client {
+	return &Client{rpcClient: rpcClient, resolveToken: resolveToken}
+}
+
+func (c *Client) assetAddress(symbol string) (common.Address, bool) {
+	if address, ok := denominations[symbol]; ok {
+		return address, true
+	}
+	if code, ok := fiatCodes[symbol]; ok {
+		return common.BigToAddress(big.NewInt(code)), true
+	}
+	if c.resolveToken == nil {
+		return common.Address{}, false
+	}
+	return c.resolveToken(symbol)
+}
+
+func (c *Client) FetchPrices(symbols []string, currencies []string) (map[string]map[string]float64, error) {
+	ethClient, err := c.rpcClient.EthClient(mainnetChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedABI, err := abi.JSON(strings.NewReader(feedRegistryABI))
+	if err != nil {
+		return nil, err
+	}
+	registry := bind.NewBoundContract(feedRegistryAddress, parsedABI, ethClient, nil, nil)
+
+	found := false
+	result := make(map[string]map[string]float64)
+	for _, symbol := range symbols {
+		result[symbol] = make(map[string]float64)
+		base, ok := c.assetAddress(symbol)
+		if !ok {
+			continue
+		}
+		for _, currency := range currencies {
+			quote, ok := c.assetAddress(currency)
+			if !ok {
+				continue
+			}
+			price, err := fetchPrice(registry, base, quote)
+			if err != nil {
+				// The registry has no feed for most pairs
+				continue
+			}
+			result[symbol][currency] = price
+			found = true
+		}
+	}
+
+	if !found {
+		return nil, ErrNoPrice
+	}
+	return result, nil
+}
+
+func fetchPrice(registry *bind.BoundContract, base common.Address, quote common.Address) (float64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	opts := &bind.CallOpts{Context: ctx}
+
+	var decimalsOut []interface{}
+	err := registry.Call(opts, &decimalsOut, "decimals", base, quote)
+	if err != nil {
+		return 0, err
+	}
+	decimals := *abi.ConvertType(decimalsOut[0], new(uint8)).(*uint8)
+
+	var roundOut []interface{}
+	err = registry.Call(opts, &roundOut, "latestRoundData", base, quote)
+	if err != nil {
+		return 0, err
+	}
+	answer := *abi.ConvertType(roundOut[1], new(*big.Int)).(**big.Int)
+	if answer.Sign() <= 0 {
+		return 0, ErrNoPrice
+	}
+
+	price, _ := new(big.Float).Quo(new(big.Float).SetInt(answer), big.NewFloat(math.Pow(10, float64(decimals)))).Float64()
+	return price, nil
+}
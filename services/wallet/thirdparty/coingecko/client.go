@@ -95,6 +95,10 @@ func (c *Client) DoQuery(url string) (*http.Response, error) {
 	if err != nil {
 		return nil, err
 	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		resp.Body.Close()
+		return nil, thirdparty.ErrRateLimited
+	}
 	return resp, nil
 }
 
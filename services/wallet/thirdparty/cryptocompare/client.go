@@ -25,6 +25,21 @@ type HistoricalPricesData struct {
 	Data HistoricalPricesContainer `json:"Data"`
 }
 
+type HistoricalCandle struct {
+	Timestamp  int64   `json:"time"`
+	Open       float64 `json:"open"`
+	High       float64 `json:"high"`
+	Low        float64 `json:"low"`
+	Close      float64 `json:"close"`
+	VolumeFrom float64 `json:"volumefrom"`
+}
+
+type HistoricalCandlesData struct {
+	Data struct {
+		HistoricalData []HistoricalCandle `json:"Data"`
+	} `json:"Data"`
+}
+
 type TokenDetailsContainer struct {
 	Data map[string]thirdparty.TokenDetails `json:"Data"`
 }
@@ -47,6 +62,10 @@ func (c *Client) DoQuery(url string) (*http.Response, error) {
 	if err != nil {
 		return nil, err
 	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		resp.Body.Close()
+		return nil, thirdparty.ErrRateLimited
+	}
 	return resp, nil
 }
 
@@ -194,3 +213,42 @@ func (c *Client) FetchHistoricalDailyPrices(symbol string, currency string, limi
 
 	return item, nil
 }
+
+func (c *Client) FetchHistoricalCandles(symbol string, currency string, interval thirdparty.CandleInterval, limit int) ([]thirdparty.HistoricalCandle, error) {
+	endpoint := "histoday"
+	if interval == thirdparty.CandleIntervalHour {
+		endpoint = "histohour"
+	}
+
+	url := fmt.Sprintf("%s/data/v2/%s?fsym=%s&tsym=%s&limit=%d&extraParams=Status.im", baseURL, endpoint, utils.GetRealSymbol(symbol), currency, limit)
+	resp, err := c.DoQuery(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	container := HistoricalCandlesData{}
+	err = json.Unmarshal(body, &container)
+	if err != nil {
+		return nil, err
+	}
+
+	candles := make([]thirdparty.HistoricalCandle, 0, len(container.Data.HistoricalData))
+	for _, candle := range container.Data.HistoricalData {
+		candles = append(candles, thirdparty.HistoricalCandle{
+			Timestamp: candle.Timestamp,
+			Open:      candle.Open,
+			High:      candle.High,
+			Low:       candle.Low,
+			Close:     candle.Close,
+			Volume:    candle.VolumeFrom,
+		})
+	}
+
+	return candles, nil
+}
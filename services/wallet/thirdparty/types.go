@@ -1,10 +1,16 @@
 package thirdparty
 
 import (
+	"errors"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/status-im/status-go/services/wallet/bigint"
 )
 
+// ErrRateLimited is returned by the providers when they refuse a request
+// because too many were made
+var ErrRateLimited = errors.New("rate limited")
+
 type HistoricalPrice struct {
 	Timestamp int64   `json:"time"`
 	Value     float64 `json:"close"`
@@ -33,14 +39,42 @@ type TokenDetails struct {
 	SmartContractAddress string  `json:"SmartContractAddress"`
 }
 
-type MarketDataProvider interface {
+type CandleInterval string
+
+const (
+	CandleIntervalHour CandleInterval = "hour"
+	CandleIntervalDay  CandleInterval = "day"
+)
+
+// HistoricalCandle is the open, high, low and close prices of a token during
+// an interval starting at Timestamp
+type HistoricalCandle struct {
+	Timestamp int64   `json:"time"`
+	Open      float64 `json:"open"`
+	High      float64 `json:"high"`
+	Low       float64 `json:"low"`
+	Close     float64 `json:"close"`
+	Volume    float64 `json:"volume"`
+}
+
+type PriceProvider interface {
 	FetchPrices(symbols []string, currencies []string) (map[string]map[string]float64, error)
+}
+
+type MarketDataProvider interface {
+	PriceProvider
 	FetchHistoricalDailyPrices(symbol string, currency string, limit int, allData bool, aggregate int) ([]HistoricalPrice, error)
 	FetchHistoricalHourlyPrices(symbol string, currency string, limit int, aggregate int) ([]HistoricalPrice, error)
 	FetchTokenMarketValues(symbols []string, currency string) (map[string]TokenMarketValues, error)
 	FetchTokenDetails(symbols []string) (map[string]TokenDetails, error)
 }
 
+// CandlesProvider is implemented by the market data providers serving
+// historical candles
+type CandlesProvider interface {
+	FetchHistoricalCandles(symbol string, currency string, interval CandleInterval, limit int) ([]HistoricalCandle, error)
+}
+
 type NFTUniqueID struct {
 	ContractAddress common.Address `json:"contractAddress"`
 	TokenID         *bigint.BigInt `json:"tokenID"`
@@ -87,6 +121,7 @@ type DataParsed struct {
 	ID        string            `json:"id"`
 	Inputs    map[string]string `json:"inputs"`
 	Signature string            `json:"signature"`
+	Call      string            `json:"call"`
 }
 
 type DecoderProvider interface {
@@ -0,0 +1,151 @@
+package token
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+// EventCustomTokenChanged is sent with a custom token added, edited or
+// removed through the wallet API, so that the messenger syncs it
+const EventCustomTokenChanged walletevent.EventType = "wallet-custom-token-changed"
+
+var (
+	ErrNotAContract        = errors.New("address isn't a contract")
+	ErrTokenListed         = errors.New("token is already in the token lists")
+	ErrCustomTokenNotFound = errors.New("custom token not found")
+)
+
+// CustomToken is a custom token with what's needed to sync it with the paired
+// devices. Removed tokens are kept so that an older sync doesn't add them back
+type CustomToken struct {
+	Token
+	Clock   uint64 `json:"clock"`
+	Removed bool   `json:"removed"`
+}
+
+// DiscoveredToken is a token discovered on chain. SymbolConflict is set when a
+// token of the lists has the same symbol, clients warn about it as the token
+// may impersonate the listed one, or just be a bridged or variant token
+type DiscoveredToken struct {
+	*Token
+	SymbolConflict bool `json:"symbolConflict"`
+}
+
+// NextCustomClock returns the clock of a change of the custom token made on
+// this device, after the stored one so that it takes over the changes synced
+// before
+func (tm *Manager) NextCustomClock(chainID uint64, address common.Address) (uint64, error) {
+	clock := uint64(time.Now().UnixMilli())
+
+	var stored uint64
+	err := tm.db.QueryRow("SELECT clock FROM tokens WHERE address = ? AND network_id = ?", address, chainID).Scan(&stored)
+	if err == sql.ErrNoRows {
+		return clock, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if stored >= clock {
+		clock = stored + 1
+	}
+	return clock, nil
+}
+
+// ParseCustomTokenChanged returns the custom token of an
+// EventCustomTokenChanged event
+func ParseCustomTokenChanged(event walletevent.Event) (*CustomToken, error) {
+	customToken := &CustomToken{}
+	err := json.Unmarshal([]byte(event.Message), customToken)
+	return customToken, err
+}
+
+// DiscoverCustomToken fetches the name, symbol and decimals of the ERC20 at
+// the address, and checks whether its symbol is used by a token of the lists
+func (tm *Manager) DiscoverCustomToken(ctx context.Context, chainID uint64, address common.Address) (*DiscoveredToken, error) {
+	if tm.IsListed(chainID, address) {
+		return nil, ErrTokenListed
+	}
+
+	token, err := tm.DiscoverToken(ctx, chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	token.ChainID = chainID
+	discovered := &DiscoveredToken{Token: token}
+
+	listed, err := tm.GetTokens(chainID)
+	if err != nil {
+		// No token list for the chain
+		return discovered, nil
+	}
+	for _, t := range listed {
+		if t.Symbol == token.Symbol {
+			discovered.SymbolConflict = true
+			break
+		}
+	}
+
+	return discovered, nil
+}
+
+// GetRawCustoms returns the custom tokens, the removed ones included
+func (tm *Manager) GetRawCustoms() ([]*CustomToken, error) {
+	rows, err := tm.db.Query("SELECT address, name, symbol, decimals, color, network_id, clock, removed FROM tokens")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rst []*CustomToken
+	for rows.Next() {
+		token := &CustomToken{}
+		err := rows.Scan(&token.Address, &token.Name, &token.Symbol, &token.Decimals, &token.Color, &token.ChainID, &token.Clock, &token.Removed)
+		if err != nil {
+			return nil, err
+		}
+
+		rst = append(rst, token)
+	}
+
+	return rst, rows.Err()
+}
+
+// SaveCustomIfNewer stores the custom token, or its removal, unless a change
+// with a clock at least as recent is already stored
+func (tm *Manager) SaveCustomIfNewer(token *CustomToken) (saved bool, err error) {
+	tx, err := tm.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	var clock uint64
+	err = tx.QueryRow("SELECT clock FROM tokens WHERE address = ? AND network_id = ?", token.Address, token.ChainID).Scan(&clock)
+	if err != nil && err != sql.ErrNoRows {
+		return false, err
+	}
+	if err == nil && clock >= token.Clock {
+		return false, nil
+	}
+
+	_, err = tx.Exec("INSERT OR REPLACE INTO tokens (network_id, address, name, symbol, decimals, color, clock, removed) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		token.ChainID, token.Address, token.Name, token.Symbol, token.Decimals, token.Color, token.Clock, token.Removed)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -47,13 +47,18 @@ func (t *Token) IsNative() bool {
 
 // Manager is used for accessing token store. It changes the token store based on overridden tokens
 type Manager struct {
-	db               *sql.DB
-	RPCClient        *rpc.Client
-	networkManager   *network.Manager
-	stores           []store
-	tokenList        []*Token
-	tokenMap         storeMap
-	areTokensFetched bool
+	db             *sql.DB
+	RPCClient      *rpc.Client
+	networkManager *network.Manager
+	stores         []store
+	tokenLists     *tokenListsStore
+
+	// mu guards the tokens fetched from the stores
+	mu                   sync.Mutex
+	tokenList            []*Token
+	tokenMap             storeMap
+	areTokensFetched     bool
+	tokenListsGeneration uint64
 }
 
 func NewTokenManager(
@@ -61,9 +66,31 @@ func NewTokenManager(
 	RPCClient *rpc.Client,
 	networkManager *network.Manager,
 ) *Manager {
-	// Order of stores is important when merging token lists. The former prevale
-	tokenManager := &Manager{db, RPCClient, networkManager, []store{newUniswapStore(), newDefaultStore()}, nil, nil, false}
-	return tokenManager
+	tokenLists := newTokenListsStore(db)
+	// Order of stores is important when merging token lists. The former prevale,
+	// the token lists fetched remotely come after the bundled ones
+	return &Manager{
+		db:             db,
+		RPCClient:      RPCClient,
+		networkManager: networkManager,
+		stores:         []store{newUniswapStore(), newDefaultStore(), tokenLists},
+		tokenLists:     tokenLists,
+	}
+}
+
+// fetchedTokens returns the tokens of the stores, they're fetched again once
+// the token lists were updated
+func (tm *Manager) fetchedTokens() ([]*Token, storeMap) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	generation := currentTokenListsGeneration()
+	if !tm.areTokensFetched || tm.tokenListsGeneration != generation {
+		tm.tokenLists.invalidate()
+		tm.fetchTokens()
+		tm.tokenListsGeneration = generation
+	}
+	return tm.tokenList, tm.tokenMap
 }
 
 // overrideTokensInPlace overrides tokens in the store with the ones from the networks
@@ -104,11 +131,8 @@ func (tm *Manager) inStore(address common.Address, chainID uint64) bool {
 		return true
 	}
 
-	if !tm.areTokensFetched {
-		tm.fetchTokens()
-	}
-
-	tokensMap, ok := tm.tokenMap[chainID]
+	_, tokenMap := tm.fetchedTokens()
+	tokensMap, ok := tokenMap[chainID]
 	if !ok {
 		return false
 	}
@@ -117,6 +141,13 @@ func (tm *Manager) inStore(address common.Address, chainID uint64) bool {
 	return ok
 }
 
+// IsListed reports whether the token is in one of the token lists, custom
+// tokens aren't
+func (tm *Manager) IsListed(chainID uint64, address common.Address) bool {
+	return tm.inStore(address, chainID)
+}
+
+// fetchTokens must be called with mu held
 func (tm *Manager) fetchTokens() {
 	tm.tokenList = nil
 	tm.tokenMap = nil
@@ -128,6 +159,9 @@ func (tm *Manager) fetchTokens() {
 
 	for _, store := range tm.stores {
 		tokens := store.GetTokens()
+		if store == tm.tokenLists {
+			tokens = withoutListedSymbols(tm.tokenList, tokens)
+		}
 		validTokens := make([]*Token, 0)
 		for _, token := range tokens {
 			for _, network := range networks {
@@ -256,16 +290,14 @@ func (tm *Manager) GetAllTokensAndNativeCurrencies() ([]*Token, error) {
 }
 
 func (tm *Manager) GetAllTokens() ([]*Token, error) {
-	if !tm.areTokensFetched {
-		tm.fetchTokens()
-	}
+	tokenList, _ := tm.fetchedTokens()
 
 	tokens, err := tm.GetCustoms()
 	if err != nil {
 		log.Error("can't fetch custom tokens", "error", err)
 	}
 
-	tokens = append(tm.tokenList, tokens...)
+	tokens = append(tokenList[:len(tokenList):len(tokenList)], tokens...)
 
 	overrideTokensInPlace(tm.networkManager.GetConfiguredNetworks(), tokens)
 
@@ -285,11 +317,8 @@ func (tm *Manager) GetTokensByChainIDs(chainIDs []uint64) ([]*Token, error) {
 }
 
 func (tm *Manager) GetTokens(chainID uint64) ([]*Token, error) {
-	if !tm.areTokensFetched {
-		tm.fetchTokens()
-	}
-
-	tokensMap, ok := tm.tokenMap[chainID]
+	_, tokenMap := tm.fetchedTokens()
+	tokensMap, ok := tokenMap[chainID]
 	if !ok {
 		return nil, errors.New("no tokens for this network")
 	}
@@ -308,6 +337,14 @@ func (tm *Manager) DiscoverToken(ctx context.Context, chainID uint64, address co
 	if err != nil {
 		return nil, err
 	}
+	code, err := backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, ErrNotAContract
+	}
+
 	caller, err := ierc20.NewIERC20Caller(address, backend)
 	if err != nil {
 		return nil, err
@@ -343,7 +380,7 @@ func (tm *Manager) DiscoverToken(ctx context.Context, chainID uint64, address co
 }
 
 func (tm *Manager) GetCustoms() ([]*Token, error) {
-	rows, err := tm.db.Query("SELECT address, name, symbol, decimals, color, network_id FROM tokens")
+	rows, err := tm.db.Query("SELECT address, name, symbol, decimals, color, network_id FROM tokens WHERE removed = 0")
 	if err != nil {
 		return nil, err
 	}
@@ -364,7 +401,7 @@ func (tm *Manager) GetCustoms() ([]*Token, error) {
 }
 
 func (tm *Manager) GetCustomsByChainID(chainID uint64) ([]*Token, error) {
-	rows, err := tm.db.Query("SELECT address, name, symbol, decimals, color, network_id FROM tokens where network_id=?", chainID)
+	rows, err := tm.db.Query("SELECT address, name, symbol, decimals, color, network_id FROM tokens where network_id=? AND removed = 0", chainID)
 	if err != nil {
 		return nil, err
 	}
@@ -493,16 +530,24 @@ func (tm *Manager) GetVisible(chainIDs []uint64) (map[uint64][]*Token, error) {
 }
 
 func (tm *Manager) UpsertCustom(token Token) error {
-	insert, err := tm.db.Prepare("INSERT OR REPLACE INTO TOKENS (network_id, address, name, symbol, decimals, color) VALUES (?, ?, ?, ?, ?, ?)")
+	clock, err := tm.NextCustomClock(token.ChainID, token.Address)
 	if err != nil {
 		return err
 	}
-	_, err = insert.Exec(token.ChainID, token.Address, token.Name, token.Symbol, token.Decimals, token.Color)
+	_, err = tm.SaveCustomIfNewer(&CustomToken{Token: token, Clock: clock})
 	return err
 }
 
 func (tm *Manager) DeleteCustom(chainID uint64, address common.Address) error {
-	_, err := tm.db.Exec(`DELETE FROM TOKENS WHERE address = ? and network_id = ?`, address, chainID)
+	clock, err := tm.NextCustomClock(chainID, address)
+	if err != nil {
+		return err
+	}
+	_, err = tm.SaveCustomIfNewer(&CustomToken{
+		Token:   Token{Address: address, ChainID: chainID},
+		Clock:   clock,
+		Removed: true,
+	})
 	return err
 }
 
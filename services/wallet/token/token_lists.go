@@ -0,0 +1,537 @@
+package token
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+const (
+	// EventTokenListsUpdated is sent when a newer version of a token list was
+	// fetched, the tokens should be reloaded
+	EventTokenListsUpdated walletevent.EventType = "wallet-token-lists-updated"
+
+	tokenListsFetchInterval = 24 * time.Hour
+	tokenListFetchTimeout   = 30 * time.Second
+
+	// Bounds of the token list schema of Uniswap
+	maxTokenListSize          = 10 << 20
+	maxTokenListSignatureSize = 1 << 10
+	maxTokenListNameLength    = 30
+	maxTokenListTokens        = 10000
+	maxTokenNameLength        = 60
+	maxTokenSymbolLength      = 20
+	maxTokenDecimals          = 255
+)
+
+var (
+	ErrInvalidTokenList       = errors.New("invalid token list")
+	ErrInvalidTokenListSource = errors.New("invalid token list source")
+)
+
+// TokenListVersion is the semantic version of a token list, bumped by its
+// publisher on every change
+type TokenListVersion struct {
+	Major uint `json:"major"`
+	Minor uint `json:"minor"`
+	Patch uint `json:"patch"`
+}
+
+func (v TokenListVersion) newerThan(other TokenListVersion) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor > other.Minor
+	}
+	return v.Patch > other.Patch
+}
+
+func (v TokenListVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// TokenList is a token list in the format of Uniswap
+type TokenList struct {
+	Name      string           `json:"name"`
+	Timestamp string           `json:"timestamp"`
+	Version   TokenListVersion `json:"version"`
+	Tokens    []*Token         `json:"tokens"`
+}
+
+// TokenListInfo describes a token list stored on the device, without its
+// tokens
+type TokenListInfo struct {
+	URL         string           `json:"url"`
+	Name        string           `json:"name"`
+	Version     TokenListVersion `json:"version"`
+	Timestamp   string           `json:"timestamp"`
+	TokensCount int              `json:"tokensCount"`
+	UpdatedAt   int64            `json:"updatedAt"`
+}
+
+// validate checks the list against the bounds of the token list schema, a
+// list with a single bad token is rejected as a whole
+func (l *TokenList) validate() error {
+	if l.Name == "" || len(l.Name) > maxTokenListNameLength {
+		return fmt.Errorf("%w: bad name %q", ErrInvalidTokenList, l.Name)
+	}
+	if len(l.Tokens) == 0 || len(l.Tokens) > maxTokenListTokens {
+		return fmt.Errorf("%w: %d tokens", ErrInvalidTokenList, len(l.Tokens))
+	}
+
+	seen := make(map[uint64]map[common.Address]bool)
+	for _, token := range l.Tokens {
+		if token == nil || token.ChainID == 0 || token.Address == (common.Address{}) {
+			return fmt.Errorf("%w: token without chain or address", ErrInvalidTokenList)
+		}
+		symbol := strings.TrimSpace(token.Symbol)
+		if symbol == "" || len(symbol) > maxTokenSymbolLength {
+			return fmt.Errorf("%w: bad symbol %q", ErrInvalidTokenList, token.Symbol)
+		}
+		if token.Name == "" || len(token.Name) > maxTokenNameLength {
+			return fmt.Errorf("%w: bad name %q", ErrInvalidTokenList, token.Name)
+		}
+		if token.Decimals > maxTokenDecimals {
+			return fmt.Errorf("%w: bad decimals %d", ErrInvalidTokenList, token.Decimals)
+		}
+		if seen[token.ChainID][token.Address] {
+			return fmt.Errorf("%w: duplicate token %s", ErrInvalidTokenList, token.Address.Hex())
+		}
+		if seen[token.ChainID] == nil {
+			seen[token.ChainID] = make(map[common.Address]bool)
+		}
+		seen[token.ChainID][token.Address] = true
+	}
+	return nil
+}
+
+type TokenListsDB struct {
+	db *sql.DB
+}
+
+func NewTokenListsDB(sqlDb *sql.DB) *TokenListsDB {
+	return &TokenListsDB{
+		db: sqlDb,
+	}
+}
+
+func (t *TokenListsDB) saveTokenList(url string, position int, list *TokenList, updatedAt int64) error {
+	tokens, err := json.Marshal(list.Tokens)
+	if err != nil {
+		return err
+	}
+	_, err = t.db.Exec(`INSERT OR REPLACE INTO token_lists (url, name, version_major, version_minor, version_patch, timestamp, tokens, updated_at, position) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		url, list.Name, list.Version.Major, list.Version.Minor, list.Version.Patch, list.Timestamp, tokens, updatedAt, position)
+	return err
+}
+
+// setTokenLists removes the lists which aren't configured anymore and orders
+// the others as configured
+func (t *TokenListsDB) setTokenLists(urls []string) (err error) {
+	tx, err := t.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	query := `DELETE FROM token_lists`
+	args := make([]interface{}, len(urls))
+	for i, url := range urls {
+		args[i] = url
+	}
+	if len(urls) > 0 {
+		query += ` WHERE url NOT IN (?` + strings.Repeat(",?", len(urls)-1) + `)`
+	}
+	_, err = tx.Exec(query, args...)
+	if err != nil {
+		return err
+	}
+
+	for position, url := range urls {
+		_, err = tx.Exec(`UPDATE token_lists SET position = ? WHERE url = ?`, position, url)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *TokenListsDB) getTokenListVersion(url string) (version TokenListVersion, found bool, err error) {
+	err = t.db.QueryRow(`SELECT version_major, version_minor, version_patch FROM token_lists WHERE url = ?`, url).
+		Scan(&version.Major, &version.Minor, &version.Patch)
+	if err == sql.ErrNoRows {
+		return version, false, nil
+	}
+	return version, err == nil, err
+}
+
+type storedTokenList struct {
+	info   *TokenListInfo
+	tokens []*Token
+}
+
+func (t *TokenListsDB) getTokenLists() ([]*storedTokenList, error) {
+	rows, err := t.db.Query(`SELECT url, name, version_major, version_minor, version_patch, timestamp, tokens, updated_at FROM token_lists ORDER BY position`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lists := make([]*storedTokenList, 0)
+	for rows.Next() {
+		info := &TokenListInfo{}
+		var tokens []byte
+		err = rows.Scan(&info.URL, &info.Name, &info.Version.Major, &info.Version.Minor, &info.Version.Patch, &info.Timestamp, &tokens, &info.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		list := &storedTokenList{info: info}
+		err = json.Unmarshal(tokens, &list.tokens)
+		if err != nil {
+			return nil, err
+		}
+		info.TokensCount = len(list.tokens)
+		lists = append(lists, list)
+	}
+	return lists, rows.Err()
+}
+
+// tokenListsGeneration is bumped whenever the stored token lists change, so
+// that every token manager of the process fetches its tokens again
+var tokenListsGeneration uint64
+
+func tokenListsUpdated() {
+	atomic.AddUint64(&tokenListsGeneration, 1)
+}
+
+func currentTokenListsGeneration() uint64 {
+	return atomic.LoadUint64(&tokenListsGeneration)
+}
+
+// tokenListsStore is the store of the token lists fetched by the
+// TokenListsManager, it's read from the database until it's invalidated
+type tokenListsStore struct {
+	db *TokenListsDB
+
+	mu     sync.Mutex
+	tokens []*Token
+	loaded bool
+}
+
+func newTokenListsStore(db *sql.DB) *tokenListsStore {
+	if db == nil {
+		return &tokenListsStore{}
+	}
+	return &tokenListsStore{db: NewTokenListsDB(db)}
+}
+
+func (ts *tokenListsStore) GetTokens() []*Token {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.loaded || ts.db == nil {
+		return ts.tokens
+	}
+
+	lists, err := ts.db.getTokenLists()
+	if err != nil {
+		log.Error("can't load token lists", "error", err)
+		return nil
+	}
+	tokenLists := make([][]*Token, len(lists))
+	for i, list := range lists {
+		for _, token := range list.tokens {
+			token.PegSymbol = GetTokenPegSymbol(token.Symbol)
+		}
+		tokenLists[i] = list.tokens
+	}
+	ts.tokens = mergeTokenLists(tokenLists)
+	ts.loaded = true
+	return ts.tokens
+}
+
+func (ts *tokenListsStore) invalidate() {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.tokens = nil
+	ts.loaded = false
+}
+
+// withoutListedSymbols drops the tokens of the token lists using the symbol
+// of a token already listed on the same chain, so that a remote list can't
+// impersonate a known token
+func withoutListedSymbols(listed []*Token, tokens []*Token) []*Token {
+	symbols := make(map[uint64]map[string]bool)
+	for _, token := range listed {
+		if symbols[token.ChainID] == nil {
+			symbols[token.ChainID] = make(map[string]bool)
+		}
+		symbols[token.ChainID][strings.ToUpper(token.Symbol)] = true
+	}
+
+	res := make([]*Token, 0, len(tokens))
+	for _, token := range tokens {
+		if symbols[token.ChainID][strings.ToUpper(token.Symbol)] {
+			continue
+		}
+		res = append(res, token)
+	}
+	return res
+}
+
+// verifyTokenListSource checks that the list is fetched over https and that
+// its content can be authenticated
+func verifyTokenListSource(source params.TokenListConfig) error {
+	u, err := url.Parse(source.URL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("%w: %s isn't an https URL", ErrInvalidTokenListSource, source.URL)
+	}
+	if source.SHA256 == "" && source.PublicKey == "" {
+		return fmt.Errorf("%w: %s has neither a checksum nor a public key", ErrInvalidTokenListSource, source.URL)
+	}
+	return nil
+}
+
+// TokenListsManager keeps the configured token lists up to date, so that new
+// tokens are shown without a release. A list is only replaced by a newer
+// version of it, and the former lists win when they're merged
+type TokenListsManager struct {
+	db         *TokenListsDB
+	sources    []params.TokenListConfig
+	httpClient *http.Client
+	walletFeed *event.Feed
+
+	cancel context.CancelFunc
+}
+
+// NewTokenListsManager creates a manager, the sources which aren't fetched
+// over https or can't be authenticated are ignored
+func NewTokenListsManager(db *TokenListsDB, sources []params.TokenListConfig, walletFeed *event.Feed) *TokenListsManager {
+	validSources := make([]params.TokenListConfig, 0, len(sources))
+	for _, source := range sources {
+		err := verifyTokenListSource(source)
+		if err != nil {
+			log.Warn("ignoring token list", "err", err)
+			continue
+		}
+		validSources = append(validSources, source)
+	}
+
+	return &TokenListsManager{
+		db:         db,
+		sources:    validSources,
+		httpClient: &http.Client{Timeout: tokenListFetchTimeout},
+		walletFeed: walletFeed,
+	}
+}
+
+func (m *TokenListsManager) Start() {
+	urls := make([]string, len(m.sources))
+	for i, source := range m.sources {
+		urls[i] = source.URL
+	}
+	err := m.db.setTokenLists(urls)
+	if err != nil {
+		log.Warn("failed to set token lists", "err", err)
+	}
+	tokenListsUpdated()
+	if len(m.sources) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	go func() {
+		ticker := time.NewTicker(tokenListsFetchInterval)
+		defer ticker.Stop()
+		for {
+			m.updateTokenLists(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (m *TokenListsManager) Stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+}
+
+func (m *TokenListsManager) updateTokenLists(ctx context.Context) {
+	updated := false
+	for position, source := range m.sources {
+		listUpdated, err := m.updateTokenList(ctx, source, position)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Warn("failed to update token list", "url", source.URL, "err", err)
+			continue
+		}
+		updated = updated || listUpdated
+	}
+
+	if updated {
+		tokenListsUpdated()
+		m.notifyUpdated()
+	}
+}
+
+func (m *TokenListsManager) fetch(ctx context.Context, url string, maxSize int) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected token list response status %d", resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, int64(maxSize)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxSize {
+		return nil, fmt.Errorf("%w: larger than %d bytes", ErrInvalidTokenList, maxSize)
+	}
+	return body, nil
+}
+
+// authenticate checks the list against the pinned checksum or the signature
+// of its publisher
+func (m *TokenListsManager) authenticate(ctx context.Context, source params.TokenListConfig, body []byte) error {
+	if source.SHA256 != "" {
+		checksum := sha256.Sum256(body)
+		if !strings.EqualFold(strings.TrimPrefix(source.SHA256, "0x"), hex.EncodeToString(checksum[:])) {
+			return fmt.Errorf("%w: checksum mismatch", ErrInvalidTokenList)
+		}
+		return nil
+	}
+
+	publicKey, err := hexutil.Decode(source.PublicKey)
+	if err != nil {
+		return err
+	}
+	publisher, err := crypto.UnmarshalPubkey(publicKey)
+	if err != nil {
+		return err
+	}
+	sigBody, err := m.fetch(ctx, source.URL+".sig", maxTokenListSignatureSize)
+	if err != nil {
+		return err
+	}
+	signature, err := hexutil.Decode(strings.TrimSpace(string(sigBody)))
+	if err != nil || len(signature) != crypto.SignatureLength {
+		return fmt.Errorf("%w: bad signature", ErrInvalidTokenList)
+	}
+	signer, err := crypto.SigToPub(crypto.Keccak256(body), signature)
+	if err != nil || crypto.PubkeyToAddress(*signer) != crypto.PubkeyToAddress(*publisher) {
+		return fmt.Errorf("%w: bad signature", ErrInvalidTokenList)
+	}
+	return nil
+}
+
+func (m *TokenListsManager) fetchTokenList(ctx context.Context, source params.TokenListConfig) (*TokenList, error) {
+	body, err := m.fetch(ctx, source.URL, maxTokenListSize)
+	if err != nil {
+		return nil, err
+	}
+	err = m.authenticate(ctx, source, body)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &TokenList{}
+	err = json.Unmarshal(body, list)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTokenList, err)
+	}
+	return list, list.validate()
+}
+
+// updateTokenList fetches the list and stores it when it's newer than the
+// stored one
+func (m *TokenListsManager) updateTokenList(ctx context.Context, source params.TokenListConfig, position int) (bool, error) {
+	list, err := m.fetchTokenList(ctx, source)
+	if err != nil {
+		return false, err
+	}
+
+	version, found, err := m.db.getTokenListVersion(source.URL)
+	if err != nil {
+		return false, err
+	}
+	if found && !list.Version.newerThan(version) {
+		return false, nil
+	}
+
+	err = m.db.saveTokenList(source.URL, position, list, time.Now().Unix())
+	if err != nil {
+		return false, err
+	}
+	log.Info("token list updated", "url", source.URL, "version", list.Version.String(), "tokens", len(list.Tokens))
+	return true, nil
+}
+
+func (m *TokenListsManager) notifyUpdated() {
+	if m.walletFeed == nil {
+		return
+	}
+	m.walletFeed.Send(walletevent.Event{
+		Type: EventTokenListsUpdated,
+	})
+}
+
+// GetTokenLists returns the token lists stored on the device
+func (m *TokenListsManager) GetTokenLists() ([]*TokenListInfo, error) {
+	lists, err := m.db.getTokenLists()
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]*TokenListInfo, len(lists))
+	for i, list := range lists {
+		infos[i] = list.info
+	}
+	return infos, nil
+}
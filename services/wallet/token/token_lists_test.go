@@ -0,0 +1,138 @@
+package token
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/params"
+)
+
+func TestUpdateTokenLists(t *testing.T) {
+	manager, stop := setupTestTokenDB(t)
+	defer stop()
+
+	publisher, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	body := `{"name":"Test","version":{"major":1,"minor":0,"patch":0},"tokens":[{"chainId":1,"address":"0x0000000000000000000000000000000000000001","name":"Dai","symbol":"DAI","decimals":18}]}`
+	signer := publisher
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, ".sig") {
+			signature, err := crypto.Sign(crypto.Keccak256([]byte(body)), signer)
+			require.NoError(t, err)
+			_, _ = w.Write([]byte(hexutil.Encode(signature)))
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	source := params.TokenListConfig{URL: srv.URL + "/list.json", PublicKey: hexutil.Encode(crypto.FromECDSAPub(&publisher.PublicKey))}
+	lists := NewTokenListsManager(NewTokenListsDB(manager.db), []params.TokenListConfig{source}, nil)
+	lists.httpClient = srv.Client()
+	lists.updateTokenLists(context.Background())
+
+	tokens := manager.tokenLists.GetTokens()
+	require.Len(t, tokens, 1)
+	require.Equal(t, common.HexToAddress("0x1"), tokens[0].Address)
+	require.Equal(t, "USD", tokens[0].PegSymbol)
+
+	// An older version doesn't replace the stored one
+	body = `{"name":"Test","version":{"major":0,"minor":9,"patch":0},"tokens":[{"chainId":1,"address":"0x0000000000000000000000000000000000000002","name":"Old","symbol":"OLD","decimals":18}]}`
+	lists.updateTokenLists(context.Background())
+	manager.tokenLists.invalidate()
+	require.Equal(t, common.HexToAddress("0x1"), manager.tokenLists.GetTokens()[0].Address)
+
+	// Neither does a list with an invalid token
+	body = `{"name":"Test","version":{"major":2,"minor":0,"patch":0},"tokens":[{"chainId":1,"address":"0x0000000000000000000000000000000000000002","name":"Bad","symbol":"","decimals":18}]}`
+	lists.updateTokenLists(context.Background())
+	manager.tokenLists.invalidate()
+	require.Equal(t, common.HexToAddress("0x1"), manager.tokenLists.GetTokens()[0].Address)
+
+	// Nor a list which isn't signed by the publisher
+	signer, err = crypto.GenerateKey()
+	require.NoError(t, err)
+	body = `{"name":"Test","version":{"major":3,"minor":0,"patch":0},"tokens":[{"chainId":1,"address":"0x0000000000000000000000000000000000000002","name":"Fake","symbol":"FAKE","decimals":18}]}`
+	lists.updateTokenLists(context.Background())
+	manager.tokenLists.invalidate()
+	require.Equal(t, common.HexToAddress("0x1"), manager.tokenLists.GetTokens()[0].Address)
+
+	signer = publisher
+	body = `{"name":"Test","version":{"major":1,"minor":1,"patch":0},"tokens":[{"chainId":1,"address":"0x0000000000000000000000000000000000000003","name":"New","symbol":"NEW","decimals":6}]}`
+	lists.updateTokenLists(context.Background())
+	manager.tokenLists.invalidate()
+	tokens = manager.tokenLists.GetTokens()
+	require.Len(t, tokens, 1)
+	require.Equal(t, common.HexToAddress("0x3"), tokens[0].Address)
+
+	infos, err := lists.GetTokenLists()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, TokenListVersion{Major: 1, Minor: 1}, infos[0].Version)
+	require.Equal(t, 1, infos[0].TokensCount)
+
+	// Lists which aren't configured anymore are removed
+	require.NoError(t, NewTokenListsDB(manager.db).setTokenLists(nil))
+	infos, err = lists.GetTokenLists()
+	require.NoError(t, err)
+	require.Empty(t, infos)
+}
+
+func TestTokenListChecksum(t *testing.T) {
+	manager, stop := setupTestTokenDB(t)
+	defer stop()
+
+	body := `{"name":"Test","version":{"major":1,"minor":0,"patch":0},"tokens":[{"chainId":1,"address":"0x0000000000000000000000000000000000000001","name":"Dai","symbol":"DAI","decimals":18}]}`
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	checksum := sha256.Sum256([]byte(body))
+	source := params.TokenListConfig{URL: srv.URL, SHA256: hex.EncodeToString(checksum[:])}
+	lists := NewTokenListsManager(NewTokenListsDB(manager.db), []params.TokenListConfig{source}, nil)
+	lists.httpClient = srv.Client()
+
+	body = `{"name":"Test","version":{"major":2,"minor":0,"patch":0},"tokens":[{"chainId":1,"address":"0x0000000000000000000000000000000000000002","name":"Fake","symbol":"FAKE","decimals":18}]}`
+	updated, err := lists.updateTokenList(context.Background(), source, 0)
+	require.ErrorIs(t, err, ErrInvalidTokenList)
+	require.False(t, updated)
+
+	body = `{"name":"Test","version":{"major":1,"minor":0,"patch":0},"tokens":[{"chainId":1,"address":"0x0000000000000000000000000000000000000001","name":"Dai","symbol":"DAI","decimals":18}]}`
+	updated, err = lists.updateTokenList(context.Background(), source, 0)
+	require.NoError(t, err)
+	require.True(t, updated)
+}
+
+func TestTokenListSources(t *testing.T) {
+	require.NoError(t, verifyTokenListSource(params.TokenListConfig{URL: "https://example.org/list.json", SHA256: "00"}))
+	require.ErrorIs(t, verifyTokenListSource(params.TokenListConfig{URL: "http://example.org/list.json", SHA256: "00"}), ErrInvalidTokenListSource)
+	require.ErrorIs(t, verifyTokenListSource(params.TokenListConfig{URL: "https://example.org/list.json"}), ErrInvalidTokenListSource)
+
+	lists := NewTokenListsManager(nil, []params.TokenListConfig{{URL: "http://example.org/list.json", SHA256: "00"}}, nil)
+	require.Empty(t, lists.sources)
+}
+
+func TestWithoutListedSymbols(t *testing.T) {
+	listed := []*Token{{ChainID: 1, Address: common.HexToAddress("0x1"), Symbol: "DAI"}}
+	tokens := []*Token{
+		{ChainID: 1, Address: common.HexToAddress("0x2"), Symbol: "dai"},
+		{ChainID: 5, Address: common.HexToAddress("0x2"), Symbol: "DAI"},
+		{ChainID: 1, Address: common.HexToAddress("0x3"), Symbol: "NEW"},
+	}
+	res := withoutListedSymbols(listed, tokens)
+	require.Len(t, res, 2)
+	require.Equal(t, uint64(5), res[0].ChainID)
+	require.Equal(t, "NEW", res[1].Symbol)
+}
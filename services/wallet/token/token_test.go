@@ -15,7 +15,7 @@ import (
 func setupTestTokenDB(t *testing.T) (*Manager, func()) {
 	db, err := appdatabase.InitializeDB(":memory:", "wallet-token-tests", 1)
 	require.NoError(t, err)
-	return &Manager{db, nil, nil, nil, nil, nil, false}, func() {
+	return &Manager{db: db, tokenLists: newTokenListsStore(db)}, func() {
 		require.NoError(t, db.Close())
 	}
 }
@@ -53,6 +53,52 @@ func TestCustoms(t *testing.T) {
 	require.Equal(t, 0, len(rst))
 }
 
+func TestSaveCustomIfNewer(t *testing.T) {
+	manager, stop := setupTestTokenDB(t)
+	defer stop()
+
+	token := &CustomToken{
+		Token: Token{
+			Address:  common.Address{1},
+			Name:     "Zilliqa",
+			Symbol:   "ZIL",
+			Decimals: 12,
+			ChainID:  777,
+		},
+		Clock: 10,
+	}
+
+	saved, err := manager.SaveCustomIfNewer(token)
+	require.NoError(t, err)
+	require.True(t, saved)
+
+	rst, err := manager.GetCustomsByChainID(777)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rst))
+	require.Equal(t, token.Token, *rst[0])
+
+	// The removal is kept, an older change doesn't add the token back
+	removed := *token
+	removed.Clock = 11
+	removed.Removed = true
+	saved, err = manager.SaveCustomIfNewer(&removed)
+	require.NoError(t, err)
+	require.True(t, saved)
+
+	saved, err = manager.SaveCustomIfNewer(token)
+	require.NoError(t, err)
+	require.False(t, saved)
+
+	rst, err = manager.GetCustoms()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(rst))
+
+	raw, err := manager.GetRawCustoms()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(raw))
+	require.Equal(t, removed, *raw[0])
+}
+
 func TestTokenOverride(t *testing.T) {
 	networks := []params.Network{
 		{
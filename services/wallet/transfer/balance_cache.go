@@ -167,6 +167,31 @@ func (b *balanceCache) NonceAt(ctx context.Context, client BalanceReader, accoun
 	return &int64Nonce, nil
 }
 
+// clear removes the balances and the nonces of the account from the block
+// blockNumber on, after a reorganization of the chain
+func (b *balanceCache) clear(account common.Address, blockNumber *big.Int) {
+	b.rw.Lock()
+	defer b.rw.Unlock()
+
+	from := blockNumber.Uint64()
+	for block := range b.balances[account] {
+		if block >= from {
+			delete(b.balances[account], block)
+		}
+	}
+	for block := range b.nonces[account] {
+		if block >= from {
+			delete(b.nonces[account], block)
+		}
+	}
+	for nonce, nr := range b.nonceRanges[account] {
+		if nr.max.Cmp(blockNumber) >= 0 {
+			delete(b.nonceRanges[account], nonce)
+		}
+	}
+	b.sortRanges(account)
+}
+
 func newBalanceCache() *balanceCache {
 	return &balanceCache{
 		balances:     make(map[common.Address]map[uint64]*big.Int),
@@ -30,6 +30,9 @@ const (
 	EventFetchingHistoryError walletevent.EventType = "fetching-history-error"
 	// EventNonArchivalNodeDetected emitted when a connection to a non archival node is detected
 	EventNonArchivalNodeDetected walletevent.EventType = "non-archival-node-detected"
+	// EventReorgDetected emitted when the chain was reorganized after the block number of the
+	// event, the transfers from it on are removed and fetched again
+	EventReorgDetected walletevent.EventType = "reorg-detected"
 
 	numberOfBlocksCheckedPerIteration = 40
 	noBlockLimit                      = 0
@@ -692,7 +695,7 @@ func (c *findAndCheckBlockRangeCommand) fastIndexErc20(ctx context.Context, from
 	commands := make([]*erc20HistoricalCommand, len(c.accounts))
 	for i, address := range c.accounts {
 		erc20 := &erc20HistoricalCommand{
-			erc20:        NewERC20TransfersDownloader(c.chainClient, []common.Address{address}, types.NewLondonSigner(c.chainClient.ToBigInt())),
+			erc20:        NewERC20TransfersDownloader(c.chainClient, []common.Address{address}, types.NewLondonSigner(c.chainClient.ToBigInt()), c.db),
 			chainClient:  c.chainClient,
 			feed:         c.feed,
 			address:      address,
@@ -29,11 +29,12 @@ func (c *findNewBlocksCommand) Command() async.Command {
 func (c *findNewBlocksCommand) Run(parent context.Context) (err error) {
 	log.Debug("start findNewBlocksCommand", "account", c.account, "chain", c.chainClient.ChainID, "noLimit", c.noLimit)
 
-	headNum, err := getHeadBlockNumber(parent, c.chainClient)
+	head, err := getHeadBlock(parent, c.chainClient)
 	if err != nil {
 		// c.error = err
 		return err // Might need to retry a couple of times
 	}
+	headNum := head.Number
 
 	blockRange, err := loadBlockRangeInfo(c.chainClient.ChainID, c.account, c.blockRangeDAO)
 	if err != nil {
@@ -45,18 +46,35 @@ func (c *findNewBlocksCommand) Run(parent context.Context) (err error) {
 	if blockRange != nil {
 		c.fromBlockNumber = new(big.Int).Add(blockRange.LastKnown, big.NewInt(1))
 
-		log.Debug("Launching new blocks command", "chainID", c.chainClient.ChainID, "account", c.account,
-			"from", c.fromBlockNumber, "headNum", headNum)
-
 		// In case interval between checks is set smaller than block mining time,
 		// we might need to wait for the next block to be mined
 		if c.fromBlockNumber.Cmp(headNum) > 0 {
 			return
 		}
 
+		reorgBlock, err := c.detectReorg(parent, blockRange.LastKnown)
+		if err != nil {
+			log.Error("findNewBlocksCommand detectReorg", "error", err, "chainID", c.chainClient.ChainID, "account", c.account)
+			return err
+		}
+		if reorgBlock != nil {
+			c.fromBlockNumber = reorgBlock
+		}
+
+		log.Debug("Launching new blocks command", "chainID", c.chainClient.ChainID, "account", c.account,
+			"from", c.fromBlockNumber, "headNum", headNum)
+
 		c.toBlockNumber = headNum
 
 		_ = c.findBlocksCommand.Run(parent)
+
+		if c.error == nil {
+			err = c.blockRangeDAO.saveCheckedHead(c.chainClient.ChainID, c.account, &checkedHead{Number: headNum, Hash: head.Hash()})
+			if err != nil {
+				log.Error("findNewBlocksCommand saveCheckedHead", "error", err)
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -296,7 +314,7 @@ func (c *findBlocksCommand) fastIndexErc20(ctx context.Context, fromBlockNumber
 	group := async.NewGroup(ctx)
 
 	erc20 := &erc20HistoricalCommand{
-		erc20:        NewERC20TransfersDownloader(c.chainClient, []common.Address{c.account}, types.NewLondonSigner(c.chainClient.ToBigInt())),
+		erc20:        NewERC20TransfersDownloader(c.chainClient, []common.Address{c.account}, types.NewLondonSigner(c.chainClient.ToBigInt()), c.db),
 		chainClient:  c.chainClient,
 		feed:         c.feed,
 		address:      c.account,
@@ -569,9 +587,7 @@ func (c *loadBlocksAndTransfersCommand) areAllTransfersLoaded() (bool, error) {
 // TODO - make it a common method for every service that wants head block number, that will cache the latest block
 // and updates it on timeout
 func getHeadBlockNumber(parent context.Context, chainClient *chain.ClientWithFallback) (*big.Int, error) {
-	ctx, cancel := context.WithTimeout(parent, 3*time.Second)
-	head, err := chainClient.HeaderByNumber(ctx, nil)
-	cancel()
+	head, err := getHeadBlock(parent, chainClient)
 	if err != nil {
 		return nil, err
 	}
@@ -579,6 +595,12 @@ func getHeadBlockNumber(parent context.Context, chainClient *chain.ClientWithFal
 	return head.Number, err
 }
 
+func getHeadBlock(parent context.Context, chainClient *chain.ClientWithFallback) (*types.Header, error) {
+	ctx, cancel := context.WithTimeout(parent, 3*time.Second)
+	defer cancel()
+	return chainClient.HeaderByNumber(ctx, nil)
+}
+
 func nextRange(from *big.Int, zeroBlockNumber *big.Int) (*big.Int, *big.Int) {
 	log.Debug("next range start", "from", from, "zeroBlockNumber", zeroBlockNumber)
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"math/big"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 
 	"github.com/status-im/status-go/rpc/chain"
 	w_common "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/logfetcher"
 )
 
 type MultiTransactionIDType int64
@@ -24,6 +26,10 @@ const (
 	NoMultiTransactionID = MultiTransactionIDType(0)
 )
 
+// erc20LogsScanStep is the number of blocks of a range whose transfer logs
+// are handled and stored at once
+const erc20LogsScanStep = 50000
+
 func getLogSubTxID(log types.Log) common.Hash {
 	// Get unique ID by using TxHash and log index
 	index := [4]byte{}
@@ -215,11 +221,13 @@ func (d *ETHDownloader) getTransfersInBlock(ctx context.Context, blk *types.Bloc
 }
 
 // NewERC20TransfersDownloader returns new instance.
-func NewERC20TransfersDownloader(client *chain.ClientWithFallback, accounts []common.Address, signer types.Signer) *ERC20TransfersDownloader {
+func NewERC20TransfersDownloader(client *chain.ClientWithFallback, accounts []common.Address, signer types.Signer, db *Database) *ERC20TransfersDownloader {
 	signature := w_common.GetEventSignatureHash(w_common.Erc20_721TransferEventSignature)
 
 	return &ERC20TransfersDownloader{
 		client:    client,
+		db:        db,
+		fetcher:   logfetcher.NewFetcher(client.ChainID, client, logfetcher.NewCheckpointsDB(db.client)),
 		accounts:  accounts,
 		signature: signature,
 		signer:    signer,
@@ -232,7 +240,13 @@ func NewERC20TransfersDownloader(client *chain.ClientWithFallback, accounts []co
 // database gets implemented, differentiation between erc20 and erc721 will handled
 // in the controller.
 type ERC20TransfersDownloader struct {
-	client   *chain.ClientWithFallback
+	client *chain.ClientWithFallback
+	// db stores the headers found in each scanned part of a range, so that a
+	// range interrupted midway resumes where it stopped
+	db *Database
+	// fetcher splits the ranges the provider rejects for returning too many
+	// logs
+	fetcher  *logfetcher.Fetcher
 	accounts []common.Address
 
 	// hash of the Transfer event signature
@@ -385,38 +399,48 @@ func (d *ERC20TransfersDownloader) GetHeadersInRange(parent context.Context, fro
 	headers := []*DBHeader{}
 	ctx := context.Background()
 	for _, address := range d.accounts {
-		outbound, err := d.client.FilterLogs(ctx, ethereum.FilterQuery{
-			FromBlock: from,
-			ToBlock:   to,
-			Topics:    d.outboundTopics(address),
-		})
-		if err != nil {
-			return nil, err
+		queries := []ethereum.FilterQuery{
+			{Topics: d.outboundTopics(address)},
+			{Topics: d.inboundTopics(address)},
 		}
-		inbound, err := d.client.FilterLogs(ctx, ethereum.FilterQuery{
-			FromBlock: from,
-			ToBlock:   to,
-			Topics:    d.inboundTopics(address),
+		key := fmt.Sprintf("erc20-transfers-%s-%s-%s", address.Hex(), from, to)
+		found := 0
+		err := d.fetcher.Scan(ctx, key, queries, from.Uint64(), to.Uint64(), erc20LogsScanStep, func(logs []types.Log, end uint64) error {
+			if len(logs) == 0 {
+				return nil
+			}
+
+			rst, err := d.blocksFromLogs(parent, logs, address)
+			if err != nil {
+				return err
+			}
+			if len(rst) == 0 {
+				log.Warn("no headers found in logs for account", "chainID", d.client.ChainID, "address", address, "from", from, "to", end)
+				return nil
+			}
+
+			// The headers are stored before the scan moves past them, the
+			// ones of a resumed range are then loaded from the database
+			err = d.db.SaveBlocks(d.client.ChainID, address, rst)
+			if err != nil {
+				return err
+			}
+			headers = append(headers, rst...)
+			found += len(rst)
+			return nil
 		})
 		if err != nil {
 			return nil, err
 		}
-		logs := append(outbound, inbound...)
-		if len(logs) == 0 {
-			continue
-		}
 
-		rst, err := d.blocksFromLogs(parent, logs, address)
+		// The range is done, it starts over if it's ever asked again
+		err = d.fetcher.DeleteCheckpoint(key)
 		if err != nil {
 			return nil, err
 		}
-		if len(rst) == 0 {
-			log.Warn("no headers found in logs for account", "chainID", d.client.ChainID, "address", address, "from", from, "to", to)
-			continue
-		} else {
-			headers = append(headers, rst...)
+		if found > 0 {
 			log.Debug("found erc20 transfers for account", "chainID", d.client.ChainID, "address", address,
-				"from", from, "to", to, "headers", len(headers))
+				"from", from, "to", to, "headers", found)
 		}
 	}
 	log.Debug("get erc20 transfers in range end", "chainID", d.client.ChainID,
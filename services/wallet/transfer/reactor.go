@@ -82,7 +82,7 @@ func (s *OnDemandFetchStrategy) newControlCommand(chainClient *chain.ClientWithF
 			signer:      signer,
 			db:          s.db,
 		},
-		erc20:              NewERC20TransfersDownloader(chainClient, accounts, signer),
+		erc20:              NewERC20TransfersDownloader(chainClient, accounts, signer, s.db),
 		feed:               s.feed,
 		errorsCount:        0,
 		transactionManager: s.transactionManager,
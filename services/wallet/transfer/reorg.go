@@ -0,0 +1,141 @@
+package transfer
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/services/wallet/bigint"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+// maxReorgDepth is the number of checked heads kept per account, a reorg
+// deeper than them rolls back to the oldest one
+const maxReorgDepth = 64
+
+// checkedHead is a head the transfers of an account were checked up to
+type checkedHead struct {
+	Number *big.Int
+	Hash   common.Hash
+}
+
+// getCheckedHeads returns the heads checked for the account, newest first
+func (b *BlockRangeSequentialDAO) getCheckedHeads(chainID uint64, account common.Address) ([]*checkedHead, error) {
+	rows, err := b.db.Query(`SELECT blk_number, blk_hash FROM transfers_checked_heads
+	WHERE network_id = ? AND address = ? ORDER BY blk_number DESC`, chainID, account)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	heads := []*checkedHead{}
+	for rows.Next() {
+		head := &checkedHead{Number: new(big.Int)}
+		err = rows.Scan((*bigint.SQLBigInt)(head.Number), &head.Hash)
+		if err != nil {
+			return nil, err
+		}
+		heads = append(heads, head)
+	}
+	return heads, rows.Err()
+}
+
+// saveCheckedHead stores the head and drops the ones too old to be reorganized
+func (b *BlockRangeSequentialDAO) saveCheckedHead(chainID uint64, account common.Address, head *checkedHead) error {
+	_, err := b.db.Exec(`INSERT OR REPLACE INTO transfers_checked_heads (network_id, address, blk_number, blk_hash)
+	VALUES (?, ?, ?, ?)`, chainID, account, (*bigint.SQLBigInt)(head.Number), head.Hash)
+	if err != nil {
+		return err
+	}
+
+	oldest := new(big.Int).Sub(head.Number, big.NewInt(maxReorgDepth))
+	_, err = b.db.Exec(`DELETE FROM transfers_checked_heads WHERE network_id = ? AND address = ? AND blk_number <= ?`,
+		chainID, account, (*bigint.SQLBigInt)(oldest))
+	return err
+}
+
+// rollback removes the blocks and the transfers of the account from the
+// block from on, and moves the last known block of the range before it so
+// that they are fetched again
+func (b *BlockRangeSequentialDAO) rollback(chainID uint64, account common.Address, from *big.Int) (err error) {
+	var tx *sql.Tx
+	tx, err = b.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	for _, table := range []string{"blocks", "transfers", "transfers_checked_heads"} {
+		_, err = tx.Exec(`DELETE FROM `+table+` WHERE network_id = ? AND address = ? AND blk_number >= ?`,
+			chainID, account, (*bigint.SQLBigInt)(from))
+		if err != nil {
+			return err
+		}
+	}
+
+	lastKnown := new(big.Int).Sub(from, big.NewInt(1))
+	_, err = tx.Exec(`UPDATE blocks_ranges_sequential SET blk_last = ? WHERE network_id = ? AND address = ? AND blk_last > ?`,
+		(*bigint.SQLBigInt)(lastKnown), chainID, account, (*bigint.SQLBigInt)(lastKnown))
+	return err
+}
+
+// detectReorg compares the parent hash of the block following the last known
+// one with the hash of the last head checked. When they differ, the blocks
+// after the newest head still in the canonical chain are rolled back and the
+// number of the first of them is returned
+func (c *findNewBlocksCommand) detectReorg(ctx context.Context, lastKnown *big.Int) (*big.Int, error) {
+	heads, err := c.blockRangeDAO.getCheckedHeads(c.chainClient.ChainID, c.account)
+	if err != nil || len(heads) == 0 || heads[0].Number.Cmp(lastKnown) != 0 {
+		return nil, err
+	}
+
+	next, err := c.chainClient.HeaderByNumber(ctx, new(big.Int).Add(lastKnown, big.NewInt(1)))
+	if err != nil {
+		return nil, err
+	}
+	if next.ParentHash == heads[0].Hash {
+		return nil, nil
+	}
+
+	reorgBlock := heads[len(heads)-1].Number
+	for _, head := range heads[1:] {
+		header, err := c.chainClient.HeaderByNumber(ctx, head.Number)
+		if err != nil {
+			return nil, err
+		}
+		if header.Hash() == head.Hash {
+			reorgBlock = new(big.Int).Add(head.Number, big.NewInt(1))
+			break
+		}
+	}
+
+	log.Warn("chain reorganization detected", "chainID", c.chainClient.ChainID, "account", c.account,
+		"lastKnown", lastKnown, "reorgBlock", reorgBlock)
+
+	err = c.blockRangeDAO.rollback(c.chainClient.ChainID, c.account, reorgBlock)
+	if err != nil {
+		return nil, err
+	}
+	if c.balanceCache != nil {
+		c.balanceCache.clear(c.account, reorgBlock)
+	}
+
+	if c.feed != nil {
+		c.feed.Send(walletevent.Event{
+			Type:        EventReorgDetected,
+			Accounts:    []common.Address{c.account},
+			BlockNumber: reorgBlock,
+			ChainID:     c.chainClient.ChainID,
+		})
+	}
+	return reorgBlock, nil
+}
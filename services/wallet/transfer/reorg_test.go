@@ -0,0 +1,96 @@
+package transfer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	w_common "github.com/status-im/status-go/services/wallet/common"
+)
+
+func TestCheckedHeads(t *testing.T) {
+	db, _, stop := setupTestDB(t)
+	defer stop()
+
+	dao := &BlockRangeSequentialDAO{db.client}
+	address := common.Address{1}
+	for i := int64(1); i <= maxReorgDepth+5; i++ {
+		require.NoError(t, dao.saveCheckedHead(777, address, &checkedHead{Number: big.NewInt(i), Hash: common.Hash{byte(i)}}))
+	}
+
+	heads, err := dao.getCheckedHeads(777, address)
+	require.NoError(t, err)
+	require.Len(t, heads, maxReorgDepth)
+	require.Equal(t, big.NewInt(maxReorgDepth+5), heads[0].Number)
+	require.Equal(t, common.Hash{byte(maxReorgDepth + 5)}, heads[0].Hash)
+	require.Equal(t, big.NewInt(6), heads[len(heads)-1].Number)
+}
+
+func TestRollback(t *testing.T) {
+	db, blockDAO, stop := setupTestDB(t)
+	defer stop()
+
+	dao := &BlockRangeSequentialDAO{db.client}
+	address := common.Address{1}
+	require.NoError(t, dao.upsertRange(777, address, &BlockRange{big.NewInt(0), big.NewInt(0), big.NewInt(20)}))
+	require.NoError(t, db.SaveBlocks(777, address, []*DBHeader{
+		{Number: big.NewInt(5), Hash: common.Hash{5}},
+		{Number: big.NewInt(15), Hash: common.Hash{15}},
+	}))
+	transfers := []Transfer{}
+	for i, header := range []*DBHeader{{Number: big.NewInt(5), Hash: common.Hash{5}}, {Number: big.NewInt(15), Hash: common.Hash{15}}} {
+		transfers = append(transfers, Transfer{
+			ID:          common.Hash{byte(i + 1)},
+			Type:        w_common.EthTransfer,
+			BlockHash:   header.Hash,
+			BlockNumber: header.Number,
+			Transaction: types.NewTransaction(uint64(i), common.Address{1}, nil, 10, big.NewInt(10), nil),
+			Receipt:     types.NewReceipt(nil, false, 100),
+			Address:     address,
+		})
+	}
+	require.NoError(t, db.SaveTransfers(777, address, transfers))
+	require.NoError(t, dao.saveCheckedHead(777, address, &checkedHead{Number: big.NewInt(10), Hash: common.Hash{10}}))
+	require.NoError(t, dao.saveCheckedHead(777, address, &checkedHead{Number: big.NewInt(20), Hash: common.Hash{20}}))
+
+	require.NoError(t, dao.rollback(777, address, big.NewInt(11)))
+
+	blockRange, err := dao.getBlockRange(777, address)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10), blockRange.LastKnown)
+
+	rst, err := db.GetTransfersByAddress(777, address, big.NewInt(20), 10)
+	require.NoError(t, err)
+	require.Len(t, rst, 1)
+	require.Equal(t, common.Hash{1}, rst[0].ID)
+
+	lastBlock, err := blockDAO.GetLastBlockByAddress(777, address, 10)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5), lastBlock)
+
+	heads, err := dao.getCheckedHeads(777, address)
+	require.NoError(t, err)
+	require.Len(t, heads, 1)
+	require.Equal(t, big.NewInt(10), heads[0].Number)
+}
+
+func TestBalanceCacheClear(t *testing.T) {
+	cache := newBalanceCache()
+	address := common.Address{1}
+	nonce := int64(1)
+	for _, block := range []int64{5, 10, 15} {
+		cache.addBalanceToCache(address, big.NewInt(block), big.NewInt(block))
+		cache.addNonceToCache(address, big.NewInt(block), &nonce)
+	}
+
+	cache.clear(address, big.NewInt(10))
+
+	require.NotNil(t, cache.ReadCachedBalance(address, big.NewInt(5)))
+	require.Nil(t, cache.ReadCachedBalance(address, big.NewInt(10)))
+	require.Nil(t, cache.ReadCachedNonce(address, big.NewInt(15)))
+	require.Nil(t, cache.findNonceInRange(address, big.NewInt(12)))
+}
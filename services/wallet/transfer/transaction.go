@@ -478,6 +478,13 @@ func (tm *TransactionManager) getVerifiedWalletAccount(address, password string)
 		return nil, transactions.ErrAccountDoesntExist
 	}
 
+	if hardwareAccount := tm.gethManager.HardwareWallet().Account(common.HexToAddress(address)); hardwareAccount != nil {
+		return &account.SelectedExtKey{
+			Address:  types.Address(hardwareAccount.Address),
+			Hardware: hardwareAccount,
+		}, nil
+	}
+
 	key, err := tm.gethManager.VerifyAccountPassword(tm.config.KeyStoreDir, address, password)
 	if err != nil {
 		log.Error("failed to verify account", "account", address, "error", err)
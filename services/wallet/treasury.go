@@ -0,0 +1,90 @@
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TreasuryHolding is the balance of a token on a chain, summed over the
+// addresses of a treasury
+type TreasuryHolding struct {
+	Symbol      string     `json:"symbol"`
+	Name        string     `json:"name"`
+	ChainID     uint64     `json:"chainId"`
+	Balance     *big.Float `json:"balance"`
+	Price       float64    `json:"price"`
+	FiatBalance float64    `json:"fiatBalance"`
+}
+
+// Treasury aggregates the native and ERC20 balances of a set of addresses,
+// like the ones holding the funds of a community
+type Treasury struct {
+	Currency         string             `json:"currency"`
+	Holdings         []*TreasuryHolding `json:"holdings"`
+	TotalFiatBalance float64            `json:"totalFiatBalance"`
+	UpdatedAt        int64              `json:"updatedAt"`
+}
+
+// GetTreasury returns the token balances of the addresses on the given chains
+// summed per token and chain, from their cached portfolio
+func (m *PortfolioManager) GetTreasury(ctx context.Context, addresses []common.Address, chainIDs []uint64) (*Treasury, error) {
+	portfolio, err := m.GetPortfolio(ctx, addresses, chainIDs)
+	if err != nil {
+		return nil, err
+	}
+	return treasuryFromPortfolio(portfolio), nil
+}
+
+func treasuryFromPortfolio(portfolio *Portfolio) *Treasury {
+	type holdingKey struct {
+		symbol  string
+		chainID uint64
+	}
+
+	treasury := &Treasury{
+		Currency:  portfolio.Currency,
+		Holdings:  make([]*TreasuryHolding, 0),
+		UpdatedAt: portfolio.UpdatedAt,
+	}
+	holdings := make(map[holdingKey]*TreasuryHolding)
+	for _, account := range portfolio.Accounts {
+		for _, token := range account.Tokens {
+			price := token.MarketValuesPerCurrency[portfolio.Currency].Price
+			for chainID, chainBalance := range token.BalancesPerChain {
+				if chainBalance.Balance == nil || chainBalance.Balance.Sign() == 0 {
+					continue
+				}
+
+				key := holdingKey{token.Symbol, chainID}
+				holding, ok := holdings[key]
+				if !ok {
+					holding = &TreasuryHolding{
+						Symbol:  token.Symbol,
+						Name:    token.Name,
+						ChainID: chainID,
+						Balance: new(big.Float),
+						Price:   price,
+					}
+					holdings[key] = holding
+					treasury.Holdings = append(treasury.Holdings, holding)
+				}
+				holding.Balance.Add(holding.Balance, chainBalance.Balance)
+			}
+		}
+	}
+
+	for _, holding := range treasury.Holdings {
+		balance, _ := holding.Balance.Float64()
+		holding.FiatBalance = balance * holding.Price
+		treasury.TotalFiatBalance += holding.FiatBalance
+	}
+
+	sort.SliceStable(treasury.Holdings, func(i, j int) bool {
+		return treasury.Holdings[i].FiatBalance > treasury.Holdings[j].FiatBalance
+	})
+
+	return treasury
+}
@@ -0,0 +1,356 @@
+package watchonly
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/rpc/chain"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+const (
+	// EventWatchOnlyActivity is sent with the new transfers of a watch-only
+	// account, the message is the JSON list of activities
+	EventWatchOnlyActivity walletevent.EventType = "wallet-watch-only-activity"
+
+	watchPollInterval = time.Minute
+	// watchMaxBlockRange limits the blocks checked in a single poll, older
+	// activity is skipped after a long downtime
+	watchMaxBlockRange = 10000
+)
+
+var transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+type Direction string
+
+const (
+	DirectionIncoming Direction = "incoming"
+	DirectionOutgoing Direction = "outgoing"
+)
+
+// Activity is a transfer of a watch-only account
+type Activity struct {
+	Account   common.Address `json:"account"`
+	ChainID   uint64         `json:"chainId"`
+	Direction Direction      `json:"direction"`
+	// Counterparty is unknown for native transfers, which are detected from
+	// balance and nonce changes
+	Counterparty *common.Address `json:"counterparty,omitempty"`
+	// TokenAddress is nil for native transfers
+	TokenAddress *common.Address `json:"tokenAddress,omitempty"`
+	TokenID      *hexutil.Big    `json:"tokenId,omitempty"`
+	Amount       *hexutil.Big    `json:"amount"`
+	TxHash       common.Hash     `json:"txHash"`
+	BlockNumber  uint64          `json:"blockNumber"`
+}
+
+// ID identifies the activity, the same transfer found twice has the same ID
+func (a *Activity) ID() common.Hash {
+	data := make([]byte, 0, 128)
+	data = append(data, new(big.Int).SetUint64(a.ChainID).Bytes()...)
+	data = append(data, a.Account.Bytes()...)
+	data = append(data, a.Direction...)
+	if a.TokenAddress != nil {
+		data = append(data, a.TokenAddress.Bytes()...)
+	}
+	if a.TokenID != nil {
+		data = append(data, a.TokenID.ToInt().Bytes()...)
+	}
+	if a.TxHash != (common.Hash{}) {
+		data = append(data, a.TxHash.Bytes()...)
+	} else {
+		data = append(data, new(big.Int).SetUint64(a.BlockNumber).Bytes()...)
+	}
+	return crypto.Keccak256Hash(data)
+}
+
+// ParseActivities returns the activities of an EventWatchOnlyActivity event
+func ParseActivities(event walletevent.Event) ([]*Activity, error) {
+	var activities []*Activity
+	err := json.Unmarshal([]byte(event.Message), &activities)
+	return activities, err
+}
+
+// activitiesFromLogs returns the ERC20 and ERC721 transfers of the account in
+// the Transfer logs
+func activitiesFromLogs(chainID uint64, account common.Address, logs []types.Log) []*Activity {
+	activities := make([]*Activity, 0, len(logs))
+	for _, l := range logs {
+		if l.Removed || len(l.Topics) < 3 || l.Topics[0] != transferTopic {
+			continue
+		}
+
+		from := common.BytesToAddress(l.Topics[1].Bytes())
+		to := common.BytesToAddress(l.Topics[2].Bytes())
+		token := l.Address
+		activity := &Activity{
+			Account:      account,
+			ChainID:      chainID,
+			TokenAddress: &token,
+			TxHash:       l.TxHash,
+			BlockNumber:  l.BlockNumber,
+		}
+
+		switch {
+		case len(l.Topics) == 3 && len(l.Data) == 32:
+			activity.Amount = (*hexutil.Big)(new(big.Int).SetBytes(l.Data))
+		case len(l.Topics) == 4:
+			activity.TokenID = (*hexutil.Big)(l.Topics[3].Big())
+			activity.Amount = (*hexutil.Big)(big.NewInt(1))
+		default:
+			continue
+		}
+
+		switch account {
+		case to:
+			activity.Direction = DirectionIncoming
+			activity.Counterparty = &from
+		case from:
+			activity.Direction = DirectionOutgoing
+			activity.Counterparty = &to
+		default:
+			continue
+		}
+		activities = append(activities, activity)
+	}
+	return activities
+}
+
+type accountState struct {
+	block   uint64
+	balance *big.Int
+	nonce   uint64
+}
+
+// nativeActivity returns the native transfer explaining the balance and nonce
+// changes of the account, if any. A balance increase is an incoming
+// transfer; a sent transaction that isn't a token transfer is an outgoing
+// one, whose amount includes the fees
+func nativeActivity(chainID uint64, account common.Address, previous *accountState, current *accountState, sentTokens bool) *Activity {
+	delta := new(big.Int).Sub(current.balance, previous.balance)
+	activity := &Activity{
+		Account:     account,
+		ChainID:     chainID,
+		BlockNumber: current.block,
+	}
+
+	switch {
+	case current.nonce == previous.nonce && delta.Sign() > 0:
+		activity.Direction = DirectionIncoming
+		activity.Amount = (*hexutil.Big)(delta)
+	case current.nonce > previous.nonce && !sentTokens && delta.Sign() < 0:
+		activity.Direction = DirectionOutgoing
+		activity.Amount = (*hexutil.Big)(delta.Neg(delta))
+	default:
+		return nil
+	}
+	return activity
+}
+
+// Watcher polls the enabled networks for the transfers of the watch-only
+// accounts, and sends them as events so that they can be notified like the
+// transfers of the other accounts
+type Watcher struct {
+	rpcClient  *rpc.Client
+	accountsDB *accounts.Database
+	eventFeed  *event.Feed
+
+	states map[uint64]map[common.Address]*accountState
+	mutex  sync.Mutex
+	cancel context.CancelFunc
+}
+
+func NewWatcher(rpcClient *rpc.Client, accountsDB *accounts.Database, eventFeed *event.Feed) *Watcher {
+	return &Watcher{
+		rpcClient:  rpcClient,
+		accountsDB: accountsDB,
+		eventFeed:  eventFeed,
+		states:     make(map[uint64]map[common.Address]*accountState),
+	}
+}
+
+func (w *Watcher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	go func() {
+		ticker := time.NewTicker(watchPollInterval)
+		defer ticker.Stop()
+		for {
+			err := w.pollAll(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Warn("failed to poll watch-only accounts", "err", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (w *Watcher) Stop() {
+	if w.cancel != nil {
+		w.cancel()
+	}
+}
+
+func (w *Watcher) pollAll(ctx context.Context) error {
+	watchOnly, err := w.accountsDB.GetWatchOnlyAccounts()
+	if err != nil {
+		return err
+	}
+
+	networks, err := w.rpcClient.NetworkManager.GetActive(true)
+	if err != nil {
+		return err
+	}
+
+	addresses := make(map[common.Address]struct{}, len(watchOnly))
+	for _, acc := range watchOnly {
+		addresses[common.Address(acc.Address)] = struct{}{}
+	}
+	w.pruneStates(addresses)
+
+	for _, network := range networks {
+		client, err := w.rpcClient.EthClient(network.ChainID)
+		if err != nil {
+			return err
+		}
+
+		for address := range addresses {
+			err = w.poll(ctx, client, address)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil {
+				log.Debug("failed to poll watch-only account", "chainID", network.ChainID, "address", address, "err", err)
+			}
+		}
+	}
+	return nil
+}
+
+// pruneStates forgets the accounts which are no longer watched
+func (w *Watcher) pruneStates(addresses map[common.Address]struct{}) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	for _, states := range w.states {
+		for address := range states {
+			if _, ok := addresses[address]; !ok {
+				delete(states, address)
+			}
+		}
+	}
+}
+
+func (w *Watcher) state(chainID uint64, address common.Address) *accountState {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.states[chainID][address]
+}
+
+func (w *Watcher) setState(chainID uint64, address common.Address, state *accountState) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.states[chainID] == nil {
+		w.states[chainID] = make(map[common.Address]*accountState)
+	}
+	w.states[chainID][address] = state
+}
+
+// poll checks the account for transfers since the previous poll. The first
+// poll only records the state of the account
+func (w *Watcher) poll(ctx context.Context, client *chain.ClientWithFallback, address common.Address) error {
+	latest, err := client.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	previous := w.state(client.ChainID, address)
+	if previous != nil && latest <= previous.block {
+		return nil
+	}
+
+	blockNumber := new(big.Int).SetUint64(latest)
+	balance, err := client.BalanceAt(ctx, address, blockNumber)
+	if err != nil {
+		return err
+	}
+	nonce, err := client.NonceAt(ctx, address, blockNumber)
+	if err != nil {
+		return err
+	}
+	current := &accountState{block: latest, balance: balance, nonce: nonce}
+
+	if previous == nil {
+		w.setState(client.ChainID, address, current)
+		return nil
+	}
+
+	from := previous.block + 1
+	if latest-from >= watchMaxBlockRange {
+		from = latest - watchMaxBlockRange + 1
+	}
+
+	addressTopic := common.BytesToHash(address.Bytes())
+	var logs []types.Log
+	for _, topics := range [][][]common.Hash{
+		{{transferTopic}, {addressTopic}},
+		{{transferTopic}, nil, {addressTopic}},
+	} {
+		found, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   blockNumber,
+			Topics:    topics,
+		})
+		if err != nil {
+			return err
+		}
+		logs = append(logs, found...)
+	}
+
+	activities := activitiesFromLogs(client.ChainID, address, logs)
+	sentTokens := false
+	for _, activity := range activities {
+		sentTokens = sentTokens || activity.Direction == DirectionOutgoing
+	}
+	if activity := nativeActivity(client.ChainID, address, previous, current, sentTokens); activity != nil {
+		activities = append(activities, activity)
+	}
+
+	w.setState(client.ChainID, address, current)
+
+	if len(activities) == 0 || w.eventFeed == nil {
+		return nil
+	}
+
+	message, err := json.Marshal(activities)
+	if err != nil {
+		return err
+	}
+	w.eventFeed.Send(walletevent.Event{
+		Type:        EventWatchOnlyActivity,
+		BlockNumber: blockNumber,
+		Accounts:    []common.Address{address},
+		Message:     string(message),
+		At:          time.Now().Unix(),
+		ChainID:     client.ChainID,
+	})
+	return nil
+}
@@ -0,0 +1,74 @@
+package watchonly
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivitiesFromLogs(t *testing.T) {
+	account := common.HexToAddress("0x1")
+	other := common.HexToAddress("0x2")
+	token := common.HexToAddress("0x3")
+
+	logs := []types.Log{
+		{
+			Address: token,
+			Topics:  []common.Hash{transferTopic, common.BytesToHash(other.Bytes()), common.BytesToHash(account.Bytes())},
+			Data:    common.LeftPadBytes(big.NewInt(10).Bytes(), 32),
+			TxHash:  common.HexToHash("0x10"),
+		},
+		{
+			Address: token,
+			Topics:  []common.Hash{transferTopic, common.BytesToHash(account.Bytes()), common.BytesToHash(other.Bytes()), common.BigToHash(big.NewInt(7))},
+			TxHash:  common.HexToHash("0x11"),
+		},
+		// removed by a reorg
+		{
+			Address: token,
+			Topics:  []common.Hash{transferTopic, common.BytesToHash(other.Bytes()), common.BytesToHash(account.Bytes())},
+			Data:    common.LeftPadBytes(big.NewInt(10).Bytes(), 32),
+			Removed: true,
+		},
+	}
+
+	activities := activitiesFromLogs(1, account, logs)
+	require.Len(t, activities, 2)
+
+	require.Equal(t, DirectionIncoming, activities[0].Direction)
+	require.Equal(t, other, *activities[0].Counterparty)
+	require.Equal(t, token, *activities[0].TokenAddress)
+	require.Nil(t, activities[0].TokenID)
+	require.Equal(t, int64(10), activities[0].Amount.ToInt().Int64())
+
+	require.Equal(t, DirectionOutgoing, activities[1].Direction)
+	require.Equal(t, other, *activities[1].Counterparty)
+	require.Equal(t, int64(7), activities[1].TokenID.ToInt().Int64())
+
+	require.NotEqual(t, activities[0].ID(), activities[1].ID())
+	require.Equal(t, activities[0].ID(), activitiesFromLogs(1, account, logs[:1])[0].ID())
+}
+
+func TestNativeActivity(t *testing.T) {
+	account := common.HexToAddress("0x1")
+	previous := &accountState{block: 1, balance: big.NewInt(100), nonce: 1}
+
+	activity := nativeActivity(1, account, previous, &accountState{block: 2, balance: big.NewInt(150), nonce: 1}, false)
+	require.NotNil(t, activity)
+	require.Equal(t, DirectionIncoming, activity.Direction)
+	require.Equal(t, int64(50), activity.Amount.ToInt().Int64())
+	require.Nil(t, activity.TokenAddress)
+
+	activity = nativeActivity(1, account, previous, &accountState{block: 2, balance: big.NewInt(60), nonce: 2}, false)
+	require.NotNil(t, activity)
+	require.Equal(t, DirectionOutgoing, activity.Direction)
+	require.Equal(t, int64(40), activity.Amount.ToInt().Int64())
+
+	// Only fees were paid for a token transfer
+	require.Nil(t, nativeActivity(1, account, previous, &accountState{block: 2, balance: big.NewInt(99), nonce: 2}, true))
+	require.Nil(t, nativeActivity(1, account, previous, &accountState{block: 2, balance: big.NewInt(100), nonce: 1}, false))
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 	signercore "github.com/ethereum/go-ethereum/signer/core/apitypes"
@@ -98,6 +99,8 @@ type Web3SendAsyncReadOnlyResponse struct {
 	MessageID interface{} `json:"messageId"`
 	Error     interface{} `json:"error,omitempty"`
 	Result    interface{} `json:"result,omitempty"`
+	// Warnings of the signed typed data
+	Warnings []typeddata.Warning `json:"warnings,omitempty"`
 }
 
 type APIRequest struct {
@@ -105,6 +108,8 @@ type APIRequest struct {
 	Address    string      `json:"address,omitempty"`
 	Hostname   string      `json:"hostname"`
 	Permission string      `json:"permission"`
+	// ChainID is the chain of the dapp, the active chain when it's 0
+	ChainID uint64 `json:"chainId,omitempty"`
 }
 
 type APIResponse struct {
@@ -247,6 +252,13 @@ func (api *API) getVerifiedWalletAccount(address, password string) (*account.Sel
 		return nil, transactions.ErrAccountDoesntExist
 	}
 
+	if hardwareAccount := api.s.accountsManager.HardwareWallet().Account(common.HexToAddress(address)); hardwareAccount != nil {
+		return &account.SelectedExtKey{
+			Address:  types.Address(hardwareAccount.Address),
+			Hardware: hardwareAccount,
+		}, nil
+	}
+
 	key, err := api.s.accountsManager.VerifyAccountPassword(api.s.config.KeyStoreDir, address, password)
 	if err != nil {
 		log.Error("failed to verify account", "account", address, "error", err)
@@ -262,6 +274,7 @@ func (api *API) getVerifiedWalletAccount(address, password string) (*account.Sel
 func (api *API) web3SignatureResponse(request Web3SendAsyncReadOnlyRequest) (*Web3SendAsyncReadOnlyResponse, error) {
 	var err error
 	var signature types.HexBytes
+	var warnings []typeddata.Warning
 	if request.Payload.Method == "eth_signTypedData" || request.Payload.Method == "eth_signTypedData_v3" {
 		raw := json.RawMessage(request.Payload.Params[1].(string))
 		var data typeddata.TypedData
@@ -270,7 +283,7 @@ func (api *API) web3SignatureResponse(request Web3SendAsyncReadOnlyRequest) (*We
 			signature, err = api.signTypedData(data, request.Payload.From, request.Payload.Password)
 		}
 	} else if request.Payload.Method == "eth_signTypedData_v4" {
-		signature, err = api.signTypedDataV4(request.Payload.Params[1].(signercore.TypedData), request.Payload.From, request.Payload.Password)
+		signature, warnings, err = api.signTypedDataV4(request.Payload.Params[1].(signercore.TypedData), request.Payload.From, request.Payload.Password)
 	} else {
 		signature, err = api.signMessage(request.Payload.Params[0], request.Payload.From, request.Payload.Password)
 	}
@@ -299,11 +312,20 @@ func (api *API) web3SignatureResponse(request Web3SendAsyncReadOnlyRequest) (*We
 			ID:      request.Payload.ID,
 			Result:  signature,
 		},
+		Warnings: warnings,
 	}, nil
 }
 
+// chainID returns the chain of a request, the active chain when it's not set
+func (api *API) chainID(chainID uint64) uint64 {
+	if chainID == 0 {
+		return api.s.rpcClient.UpstreamChainID
+	}
+	return chainID
+}
+
 func (api *API) ProcessWeb3ReadOnlyRequest(request Web3SendAsyncReadOnlyRequest) (*Web3SendAsyncReadOnlyResponse, error) {
-	hasPermission, err := api.s.permissionsDB.HasPermission(request.Hostname, request.Address, PermissionWeb3)
+	hasPermission, err := api.s.permissionsDB.HasChainPermission(request.Hostname, request.Address, PermissionWeb3, api.chainID(request.Payload.ChainID))
 	if err != nil {
 		return nil, err
 	}
@@ -359,7 +381,7 @@ func (api *API) ProcessAPIRequest(request APIRequest) (*APIResponse, error) {
 	if request.Permission == "" {
 		return nil, ErrorInvalidAPIRequest
 	}
-	hasPermission, err := api.s.permissionsDB.HasPermission(request.Hostname, request.Address, request.Permission)
+	hasPermission, err := api.s.permissionsDB.HasChainPermission(request.Hostname, request.Address, request.Permission, api.chainID(request.ChainID))
 	if err != nil {
 		return nil, err
 	}
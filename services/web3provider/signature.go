@@ -30,9 +30,18 @@ func (api *API) signMessage(data interface{}, address string, password string) (
 		dBytes = []byte{d}
 	}
 
+	if account.Hardware != nil {
+		sig, err := account.Hardware.SignMessage(dBytes)
+		return types.HexBytes(sig), err
+	}
+
 	hash := crypto.TextHash(dBytes)
 
-	sig, err := crypto.Sign(hash, account.AccountKey.PrivateKey)
+	key, err := account.PrivateKey()
+	if err != nil {
+		return types.HexBytes{}, err
+	}
+	sig, err := crypto.Sign(hash, key)
 	if err != nil {
 		return types.HexBytes{}, err
 	}
@@ -49,7 +58,19 @@ func (api *API) signTypedData(typed typeddata.TypedData, address string, passwor
 		return types.HexBytes{}, err
 	}
 	chain := new(big.Int).SetUint64(api.s.config.NetworkID)
-	sig, err := typeddata.Sign(typed, account.AccountKey.PrivateKey, chain)
+	if account.Hardware != nil {
+		domainSeparator, message, err := typeddata.Hashes(typed, chain)
+		if err != nil {
+			return types.HexBytes{}, err
+		}
+		sig, err := account.Hardware.SignTypedData(domainSeparator, message)
+		return types.HexBytes(sig), err
+	}
+	key, err := account.PrivateKey()
+	if err != nil {
+		return types.HexBytes{}, err
+	}
+	sig, err := typeddata.Sign(typed, key, chain)
 	if err != nil {
 		return types.HexBytes{}, err
 	}
@@ -57,17 +78,38 @@ func (api *API) signTypedData(typed typeddata.TypedData, address string, passwor
 }
 
 // signTypedDataV4 accepts data and password. Gets verified account and signs typed data.
-func (api *API) signTypedDataV4(typed signercore.TypedData, address string, password string) (types.HexBytes, error) {
+// The warnings of the typed data are returned with the signature.
+func (api *API) signTypedDataV4(typed signercore.TypedData, address string, password string) (types.HexBytes, []typeddata.Warning, error) {
+	chain := new(big.Int).SetUint64(api.s.config.NetworkID)
+	analysis, err := typeddata.AnalyzeV4(typed, chain)
+	if err != nil {
+		return types.HexBytes{}, nil, err
+	}
 	account, err := api.getVerifiedWalletAccount(address, password)
 	if err != nil {
-		return types.HexBytes{}, err
+		return types.HexBytes{}, nil, err
 	}
-	chain := new(big.Int).SetUint64(api.s.config.NetworkID)
-	sig, err := typeddata.SignTypedDataV4(typed, account.AccountKey.PrivateKey, chain)
+	var sig []byte
+	if account.Hardware != nil {
+		domainSeparator, message, err := typeddata.HashesV4(typed)
+		if err != nil {
+			return types.HexBytes{}, nil, err
+		}
+		sig, err = account.Hardware.SignTypedData(domainSeparator, message)
+		if err != nil {
+			return types.HexBytes{}, nil, err
+		}
+		return types.HexBytes(sig), analysis.Warnings, nil
+	}
+	key, err := account.PrivateKey()
 	if err != nil {
-		return types.HexBytes{}, err
+		return types.HexBytes{}, nil, err
 	}
-	return types.HexBytes(sig), err
+	sig, err = typeddata.SignTypedDataV4(typed, key, chain)
+	if err != nil {
+		return types.HexBytes{}, nil, err
+	}
+	return types.HexBytes(sig), analysis.Warnings, nil
 }
 
 // SendTransaction creates a new transaction and waits until it's complete.
@@ -0,0 +1,213 @@
+package web3provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+// Sign-In with Ethereum messages, see https://eips.ethereum.org/EIPS/eip-4361
+
+const siweHeaderSuffix = " wants you to sign in with your Ethereum account:"
+const siweVersion = "1"
+
+var ErrInvalidSIWEMessage = errors.New("invalid sign-in with ethereum message")
+var ErrSIWEDomainMismatch = errors.New("sign-in with ethereum message domain doesn't match the dapp")
+var ErrSIWEAddressMismatch = errors.New("sign-in with ethereum message address doesn't match the account")
+var ErrSIWEExpired = errors.New("sign-in with ethereum message is expired")
+var ErrSIWENotYetValid = errors.New("sign-in with ethereum message is not yet valid")
+
+var siweNonceRegexp = regexp.MustCompile(`^[a-zA-Z0-9]{8,}$`)
+
+// SIWEMessage is a parsed Sign-In with Ethereum message, to be displayed for
+// approval before being signed
+type SIWEMessage struct {
+	Scheme         string        `json:"scheme,omitempty"`
+	Domain         string        `json:"domain"`
+	Address        types.Address `json:"address"`
+	Statement      string        `json:"statement,omitempty"`
+	URI            string        `json:"uri"`
+	Version        string        `json:"version"`
+	ChainID        uint64        `json:"chainId"`
+	Nonce          string        `json:"nonce"`
+	IssuedAt       time.Time     `json:"issuedAt"`
+	ExpirationTime *time.Time    `json:"expirationTime,omitempty"`
+	NotBefore      *time.Time    `json:"notBefore,omitempty"`
+	RequestID      string        `json:"requestId,omitempty"`
+	Resources      []string      `json:"resources,omitempty"`
+}
+
+func invalidSIWE(reason string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidSIWEMessage, reason)
+}
+
+func parseSIWETime(value string) (*time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, invalidSIWE("invalid time " + value)
+	}
+	return &t, nil
+}
+
+// ParseSIWEMessage parses a Sign-In with Ethereum message. The fields are
+// validated against the syntax only, see SIWEMessage.Validate
+func ParseSIWEMessage(message string) (*SIWEMessage, error) {
+	lines := strings.Split(strings.ReplaceAll(message, "\r\n", "\n"), "\n")
+	if len(lines) < 2 || !strings.HasSuffix(lines[0], siweHeaderSuffix) {
+		return nil, invalidSIWE("missing header")
+	}
+
+	msg := &SIWEMessage{}
+	msg.Domain = strings.TrimSuffix(lines[0], siweHeaderSuffix)
+	if i := strings.Index(msg.Domain, "://"); i >= 0 {
+		msg.Scheme = msg.Domain[:i]
+		msg.Domain = msg.Domain[i+3:]
+	}
+	if msg.Domain == "" || strings.ContainsAny(msg.Domain, " /") {
+		return nil, invalidSIWE("invalid domain")
+	}
+
+	address := strings.TrimSpace(lines[1])
+	if !strings.HasPrefix(address, "0x") || !types.IsHexAddress(address) {
+		return nil, invalidSIWE("invalid address")
+	}
+	msg.Address = types.HexToAddress(address)
+
+	i := 2
+	for i < len(lines) && lines[i] == "" {
+		i++
+	}
+	if i < len(lines) && !strings.HasPrefix(lines[i], "URI: ") {
+		msg.Statement = lines[i]
+		i++
+	}
+	for i < len(lines) && lines[i] == "" {
+		i++
+	}
+
+	fields := make(map[string]string)
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if line == "" {
+			continue
+		}
+		if line == "Resources:" {
+			for i++; i < len(lines) && strings.HasPrefix(lines[i], "- "); i++ {
+				msg.Resources = append(msg.Resources, strings.TrimPrefix(lines[i], "- "))
+			}
+			if i < len(lines) && strings.TrimSpace(strings.Join(lines[i:], "")) != "" {
+				return nil, invalidSIWE("unexpected content after resources")
+			}
+			break
+		}
+
+		parts := strings.SplitN(line, ": ", 2)
+		if len(parts) != 2 {
+			return nil, invalidSIWE("invalid line " + line)
+		}
+		if _, exists := fields[parts[0]]; exists {
+			return nil, invalidSIWE("duplicated field " + parts[0])
+		}
+		fields[parts[0]] = parts[1]
+	}
+
+	var err error
+	for name, value := range fields {
+		switch name {
+		case "URI":
+			msg.URI = value
+		case "Version":
+			msg.Version = value
+		case "Chain ID":
+			msg.ChainID, err = strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, invalidSIWE("invalid chain ID")
+			}
+		case "Nonce":
+			msg.Nonce = value
+		case "Issued At":
+			var issuedAt *time.Time
+			issuedAt, err = parseSIWETime(value)
+			if err != nil {
+				return nil, err
+			}
+			msg.IssuedAt = *issuedAt
+		case "Expiration Time":
+			msg.ExpirationTime, err = parseSIWETime(value)
+			if err != nil {
+				return nil, err
+			}
+		case "Not Before":
+			msg.NotBefore, err = parseSIWETime(value)
+			if err != nil {
+				return nil, err
+			}
+		case "Request ID":
+			msg.RequestID = value
+		default:
+			return nil, invalidSIWE("unknown field " + name)
+		}
+	}
+
+	switch {
+	case msg.URI == "":
+		return nil, invalidSIWE("missing URI")
+	case msg.Version != siweVersion:
+		return nil, invalidSIWE("unsupported version")
+	case msg.ChainID == 0:
+		return nil, invalidSIWE("missing chain ID")
+	case !siweNonceRegexp.MatchString(msg.Nonce):
+		return nil, invalidSIWE("invalid nonce")
+	case msg.IssuedAt.IsZero():
+		return nil, invalidSIWE("missing issued at")
+	}
+	return msg, nil
+}
+
+// Validate checks that the message was requested by the dapp at hostname, and
+// that it is valid at the given time
+func (m *SIWEMessage) Validate(hostname string, now time.Time) error {
+	if !strings.EqualFold(m.Domain, hostname) {
+		return ErrSIWEDomainMismatch
+	}
+	if m.ExpirationTime != nil && !now.Before(*m.ExpirationTime) {
+		return ErrSIWEExpired
+	}
+	if m.NotBefore != nil && now.Before(*m.NotBefore) {
+		return ErrSIWENotYetValid
+	}
+	return nil
+}
+
+// ParseSIWEMessage parses and validates a Sign-In with Ethereum message
+// requested by the dapp at hostname, so that its fields can be displayed for
+// approval
+func (api *API) ParseSIWEMessage(message string, hostname string) (*SIWEMessage, error) {
+	msg, err := ParseSIWEMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.Validate(hostname, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// SignSIWEMessage validates a Sign-In with Ethereum message requested by the
+// dapp at hostname and signs it with the account, as personal_sign does
+func (api *API) SignSIWEMessage(message string, hostname string, address string, password string) (types.HexBytes, error) {
+	msg, err := api.ParseSIWEMessage(message, hostname)
+	if err != nil {
+		return types.HexBytes{}, err
+	}
+	if msg.Address != types.HexToAddress(address) {
+		return types.HexBytes{}, ErrSIWEAddressMismatch
+	}
+	return api.signMessage(message, address, password)
+}
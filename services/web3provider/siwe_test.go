@@ -0,0 +1,76 @@
+package web3provider
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+const testSIWEMessage = `https://example.com wants you to sign in with your Ethereum account:
+0xC6D7F4C3D8E9F0a1b2C3d4E5f6A7b8C9d0E1F2a3
+
+Sign in to Example.
+
+URI: https://example.com/login
+Version: 1
+Chain ID: 1
+Nonce: 32891756ab
+Issued At: 2023-07-01T10:00:00Z
+Expiration Time: 2023-07-01T11:00:00Z
+Resources:
+- ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/
+- https://example.com/terms`
+
+func TestParseSIWEMessage(t *testing.T) {
+	msg, err := ParseSIWEMessage(testSIWEMessage)
+	require.NoError(t, err)
+	require.Equal(t, "https", msg.Scheme)
+	require.Equal(t, "example.com", msg.Domain)
+	require.Equal(t, types.HexToAddress("0xC6D7F4C3D8E9F0a1b2C3d4E5f6A7b8C9d0E1F2a3"), msg.Address)
+	require.Equal(t, "Sign in to Example.", msg.Statement)
+	require.Equal(t, "https://example.com/login", msg.URI)
+	require.Equal(t, uint64(1), msg.ChainID)
+	require.Equal(t, "32891756ab", msg.Nonce)
+	require.Equal(t, time.Date(2023, 7, 1, 10, 0, 0, 0, time.UTC), msg.IssuedAt)
+	require.Nil(t, msg.NotBefore)
+	require.Len(t, msg.Resources, 2)
+
+	issuedAt := time.Date(2023, 7, 1, 10, 30, 0, 0, time.UTC)
+	require.NoError(t, msg.Validate("Example.com", issuedAt))
+	require.Equal(t, ErrSIWEDomainMismatch, msg.Validate("evil.com", issuedAt))
+	require.Equal(t, ErrSIWEExpired, msg.Validate("example.com", issuedAt.Add(time.Hour)))
+
+	// Without statement
+	msg, err = ParseSIWEMessage(`example.com wants you to sign in with your Ethereum account:
+0xC6D7F4C3D8E9F0a1b2C3d4E5f6A7b8C9d0E1F2a3
+
+
+URI: https://example.com
+Version: 1
+Chain ID: 10
+Nonce: abcdefgh1
+Issued At: 2023-07-01T10:00:00Z`)
+	require.NoError(t, err)
+	require.Equal(t, "", msg.Statement)
+	require.Equal(t, uint64(10), msg.ChainID)
+}
+
+func TestParseInvalidSIWEMessage(t *testing.T) {
+	for _, message := range []string{
+		"Hello",
+		"example.com wants you to sign in with your Ethereum account:\n0x123",
+		// nonce too short
+		"example.com wants you to sign in with your Ethereum account:\n0xC6D7F4C3D8E9F0a1b2C3d4E5f6A7b8C9d0E1F2a3\n\n\nURI: https://example.com\nVersion: 1\nChain ID: 1\nNonce: abc\nIssued At: 2023-07-01T10:00:00Z",
+		// missing issued at
+		"example.com wants you to sign in with your Ethereum account:\n0xC6D7F4C3D8E9F0a1b2C3d4E5f6A7b8C9d0E1F2a3\n\n\nURI: https://example.com\nVersion: 1\nChain ID: 1\nNonce: abcdefgh1",
+		// unsupported version
+		"example.com wants you to sign in with your Ethereum account:\n0xC6D7F4C3D8E9F0a1b2C3d4E5f6A7b8C9d0E1F2a3\n\n\nURI: https://example.com\nVersion: 2\nChain ID: 1\nNonce: abcdefgh1\nIssued At: 2023-07-01T10:00:00Z",
+	} {
+		_, err := ParseSIWEMessage(message)
+		require.True(t, errors.Is(err, ErrInvalidSIWEMessage), message)
+	}
+}
@@ -0,0 +1,22 @@
+package signal
+
+const (
+	// EventCommunityChannelMembersRemoved is triggered when the community owner node
+	// revoked the access to token gated channels of members that no longer
+	// satisfy the channel permissions
+	EventCommunityChannelMembersRemoved = "community.channelMembersRemoved"
+)
+
+type CommunityChannelMembersRemovedSignal struct {
+	CommunityID string `json:"communityId"`
+	// Members maps the id of each channel to the public keys of the
+	// members that were removed from it
+	Members map[string][]string `json:"members"`
+}
+
+func SendCommunityChannelMembersRemoved(communityID string, members map[string][]string) {
+	send(EventCommunityChannelMembersRemoved, CommunityChannelMembersRemovedSignal{
+		CommunityID: communityID,
+		Members:     members,
+	})
+}
@@ -0,0 +1,25 @@
+package signal
+
+const (
+	// EventCommunityTokenDeploymentStatus is triggered when the transaction
+	// deploying a community token is mined or failed
+	EventCommunityTokenDeploymentStatus = "communityToken.deploymentStatus"
+)
+
+type CommunityTokenDeploymentStatusSignal struct {
+	CommunityID     string `json:"communityId"`
+	ChainID         uint64 `json:"chainId"`
+	ContractAddress string `json:"contractAddress"`
+	TransactionHash string `json:"transactionHash"`
+	Deployed        bool   `json:"deployed"`
+}
+
+func SendCommunityTokenDeploymentStatus(communityID string, chainID uint64, contractAddress string, transactionHash string, deployed bool) {
+	send(EventCommunityTokenDeploymentStatus, CommunityTokenDeploymentStatusSignal{
+		CommunityID:     communityID,
+		ChainID:         chainID,
+		ContractAddress: contractAddress,
+		TransactionHash: transactionHash,
+		Deployed:        deployed,
+	})
+}
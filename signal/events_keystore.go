@@ -0,0 +1,22 @@
+package signal
+
+const (
+	// EventKeystoreUpgradeProgress is sent while the key files encrypted with
+	// weak KDF parameters are re-encrypted
+	EventKeystoreUpgradeProgress = "keystore.upgrade.progress"
+)
+
+// KeystoreUpgradeProgressSignal reports the progress of the re-encryption of
+// the key files
+type KeystoreUpgradeProgressSignal struct {
+	Total    int    `json:"total"`
+	Upgraded int    `json:"upgraded"`
+	Failed   int    `json:"failed"`
+	Done     bool   `json:"done"`
+	Error    string `json:"error,omitempty"`
+}
+
+// SendKeystoreUpgradeProgress sends keystore.upgrade.progress signal.
+func SendKeystoreUpgradeProgress(progress KeystoreUpgradeProgressSignal) {
+	send(EventKeystoreUpgradeProgress, progress)
+}
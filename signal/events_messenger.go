@@ -13,6 +13,12 @@ const (
 
 	// EventStatusUpdatesTimedOut Event Automatic Status Updates Timed out
 	EventStatusUpdatesTimedOut = "status.updates.timedout"
+
+	// EventMessagesPruned triggered when messages older than the retention policies were deleted
+	EventMessagesPruned = "messages.pruned"
+
+	// EventContactVerificationStateChanged triggered when the status of a contact verification request changed
+	EventContactVerificationStateChanged = "contact.verification.state.changed"
 )
 
 // MessageDeliveredSignal specifies chat and message that was delivered
@@ -52,3 +58,26 @@ func SendCommunityInfoFound(community interface{}) {
 func SendStatusUpdatesTimedOut(statusUpdates interface{}) {
 	send(EventStatusUpdatesTimedOut, statusUpdates)
 }
+
+// MessagesPrunedSignal specifies the number of rows deleted and the bytes reclaimed by the message retention
+type MessagesPrunedSignal struct {
+	Messages                    int64 `json:"messages"`
+	RawMessages                 int64 `json:"rawMessages"`
+	ActivityCenterNotifications int64 `json:"activityCenterNotifications"`
+	ReclaimedBytes              int64 `json:"reclaimedBytes"`
+}
+
+// SendMessagesPruned notifies about messages deleted by the message retention
+func SendMessagesPruned(messages, rawMessages, activityCenterNotifications, reclaimedBytes int64) {
+	send(EventMessagesPruned, MessagesPrunedSignal{
+		Messages:                    messages,
+		RawMessages:                 rawMessages,
+		ActivityCenterNotifications: activityCenterNotifications,
+		ReclaimedBytes:              reclaimedBytes,
+	})
+}
+
+// SendContactVerificationStateChanged notifies about the status change of a contact verification request
+func SendContactVerificationStateChanged(transition interface{}) {
+	send(EventContactVerificationStateChanged, transition)
+}
@@ -24,6 +24,14 @@ const (
 
 	// EventLoggedIn is once node was injected with user account and ready to be used.
 	EventLoggedIn = "node.login"
+
+	// EventServiceReady is triggered when a service whose start was deferred
+	// after the login has started
+	EventServiceReady = "node.service.ready"
+
+	// EventDeferredServicesReady is triggered when all the services whose
+	// start was deferred have started
+	EventDeferredServicesReady = "node.deferred.services.ready"
 )
 
 // NodeCrashEvent is special kind of error, used to report node crashes
@@ -31,6 +39,12 @@ type NodeCrashEvent struct {
 	Error string `json:"error"`
 }
 
+// ServiceReadyEvent is the result of the deferred start of a service
+type ServiceReadyEvent struct {
+	Service string `json:"service"`
+	Error   string `json:"error,omitempty"`
+}
+
 // NodeLoginEvent returns the result of the login event
 type NodeLoginEvent struct {
 	Error    string                 `json:"error,omitempty"`
@@ -76,3 +90,19 @@ func SendLoggedIn(account *multiaccounts.Account, settings *settings.Settings, e
 	}
 	send(EventLoggedIn, event)
 }
+
+// SendServiceReady emits a signal when a service whose start was deferred has
+// started, or failed to
+func SendServiceReady(service string, err error) {
+	event := ServiceReadyEvent{Service: service}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	send(EventServiceReady, event)
+}
+
+// SendDeferredServicesReady emits a signal when all the services whose start
+// was deferred have started.
+func SendDeferredServicesReady() {
+	send(EventDeferredServicesReady, nil)
+}
@@ -48,6 +48,9 @@ const (
 	// EventBackupPerformed is triggered when a backup has been performed
 	EventBackupPerformed = "backup.performed"
 
+	// EventBackupFailed is triggered when a scheduled backup could not be performed
+	EventBackupFailed = "backup.failed"
+
 	// EventMailserverAvailable is triggered when a mailserver becomes available
 	EventMailserverAvailable = "mailserver.available"
 
@@ -194,6 +197,11 @@ type BackupPerformedSignal struct {
 	LastBackup uint64 `json:"lastBackup"`
 }
 
+// BackupFailedSignal signals that a scheduled backup has failed
+type BackupFailedSignal struct {
+	Error string `json:"error"`
+}
+
 // SendEnodeDiscovered tiggered when an enode is discovered.
 // finds a new enode.
 func SendEnodeDiscovered(enode, topic string) {
@@ -206,6 +214,10 @@ func SendEnodeDiscovered(enode, topic string) {
 func SendBackupPerformed(lastBackup uint64) {
 	send(EventBackupPerformed, BackupPerformedSignal{lastBackup})
 }
+
+func SendBackupFailed(err error) {
+	send(EventBackupFailed, BackupFailedSignal{err.Error()})
+}
 func SendDecryptMessageFailed(sender string) {
 	send(EventDecryptMessageFailed, DecryptMessageFailedSignal{sender})
 }
@@ -6,9 +6,18 @@ const (
 	// EventPeerStats is sent when peer is added or removed.
 	// it will be a map with capability=peer count k/v's.
 	EventPeerStats = "wakuv2.peerstats"
+
+	// EventFilterHealth is sent when the health of the filter subscriptions
+	// of a light client changes
+	EventFilterHealth = "wakuv2.filterhealth"
 )
 
 // SendPeerStats sends discovery.summary signal.
 func SendPeerStats(peerStats types.ConnStatus) {
 	send(EventPeerStats, peerStats)
 }
+
+// SendFilterHealth sends wakuv2.filterhealth signal.
+func SendFilterHealth(filterHealth types.FilterHealth) {
+	send(EventFilterHealth, filterHealth)
+}
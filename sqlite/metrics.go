@@ -0,0 +1,275 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	sqlcipher "github.com/mutecomm/go-sqlcipher/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultSlowQueryThreshold is the duration above which a query is
+	// recorded as slow
+	DefaultSlowQueryThreshold = 100 * time.Millisecond
+
+	// maxSlowQueries is the number of slow queries kept, the oldest ones
+	// are dropped first
+	maxSlowQueries = 100
+	// maxTrackedStatements bounds the number of statements aggregated, the
+	// statements built with inlined values would grow it without bounds
+	maxTrackedStatements = 1000
+)
+
+// QueryStats aggregates the executions of a statement, durations are in
+// nanoseconds
+type QueryStats struct {
+	Query         string        `json:"query"`
+	Count         uint64        `json:"count"`
+	SlowCount     uint64        `json:"slowCount"`
+	TotalDuration time.Duration `json:"totalDuration"`
+	MaxDuration   time.Duration `json:"maxDuration"`
+}
+
+// SlowQuery is an execution of a statement which took longer than the slow
+// query threshold
+type SlowQuery struct {
+	Query     string        `json:"query"`
+	Duration  time.Duration `json:"duration"`
+	Timestamp int64         `json:"timestamp"`
+}
+
+// DBStats is the performance of the queries run since the stats were reset,
+// the statements taking the longest in total first and the latest slow
+// queries first
+type DBStats struct {
+	SlowQueryThreshold time.Duration `json:"slowQueryThreshold"`
+	Queries            []*QueryStats `json:"queries"`
+	SlowQueries        []*SlowQuery  `json:"slowQueries"`
+	Connections        sql.DBStats   `json:"connections"`
+}
+
+type queryMeter struct {
+	mutex       sync.Mutex
+	threshold   time.Duration
+	logger      *zap.Logger
+	statements  map[string]*QueryStats
+	slowQueries []*SlowQuery
+}
+
+var meter = &queryMeter{
+	threshold:  DefaultSlowQueryThreshold,
+	statements: make(map[string]*QueryStats),
+}
+
+// SetSlowQueryThreshold sets the duration above which a query is recorded
+// as slow
+func SetSlowQueryThreshold(threshold time.Duration) {
+	meter.mutex.Lock()
+	defer meter.mutex.Unlock()
+	meter.threshold = threshold
+}
+
+// SetQueryLogger sets the logger the slow queries are logged to, nil stops
+// logging them
+func SetQueryLogger(logger *zap.Logger) {
+	meter.mutex.Lock()
+	defer meter.mutex.Unlock()
+	meter.logger = logger
+}
+
+// ResetStats clears the stats of the queries
+func ResetStats() {
+	meter.mutex.Lock()
+	defer meter.mutex.Unlock()
+	meter.statements = make(map[string]*QueryStats)
+	meter.slowQueries = nil
+}
+
+// GetDBStats returns the stats of the queries run on all the databases, and
+// the stats of the connections of db when it's not nil
+func GetDBStats(db *sql.DB) *DBStats {
+	meter.mutex.Lock()
+	defer meter.mutex.Unlock()
+
+	stats := &DBStats{
+		SlowQueryThreshold: meter.threshold,
+		Queries:            make([]*QueryStats, 0, len(meter.statements)),
+		SlowQueries:        make([]*SlowQuery, 0, len(meter.slowQueries)),
+	}
+
+	for _, statement := range meter.statements {
+		s := *statement
+		stats.Queries = append(stats.Queries, &s)
+	}
+	sort.Slice(stats.Queries, func(i, j int) bool {
+		return stats.Queries[i].TotalDuration > stats.Queries[j].TotalDuration
+	})
+
+	for i := len(meter.slowQueries) - 1; i >= 0; i-- {
+		s := *meter.slowQueries[i]
+		stats.SlowQueries = append(stats.SlowQueries, &s)
+	}
+
+	if db != nil {
+		stats.Connections = db.Stats()
+	}
+
+	return stats
+}
+
+func (m *queryMeter) record(query string, duration time.Duration) {
+	query = strings.Join(strings.Fields(query), " ")
+	if isKeyStatement(query) {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	slow := duration >= m.threshold
+
+	statement, ok := m.statements[query]
+	if !ok && len(m.statements) < maxTrackedStatements {
+		statement = &QueryStats{Query: query}
+		m.statements[query] = statement
+	}
+	if statement != nil {
+		statement.Count++
+		statement.TotalDuration += duration
+		if duration > statement.MaxDuration {
+			statement.MaxDuration = duration
+		}
+		if slow {
+			statement.SlowCount++
+		}
+	}
+
+	if !slow {
+		return
+	}
+
+	m.slowQueries = append(m.slowQueries, &SlowQuery{
+		Query:     query,
+		Duration:  duration,
+		Timestamp: time.Now().UnixMilli(),
+	})
+	if len(m.slowQueries) > maxSlowQueries {
+		m.slowQueries = m.slowQueries[len(m.slowQueries)-maxSlowQueries:]
+	}
+
+	if m.logger != nil {
+		m.logger.Warn("slow query", zap.String("query", query), zap.Duration("duration", duration))
+	}
+}
+
+// isKeyStatement tells whether the statement may inline an encryption key,
+// those aren't recorded
+func isKeyStatement(query string) bool {
+	query = strings.ToUpper(query)
+	return strings.HasPrefix(query, "PRAGMA") || strings.HasPrefix(query, "ATTACH")
+}
+
+// meteredDriver wraps the connections of the sqlcipher driver so that the
+// duration of the queries is recorded
+type meteredDriver struct {
+	*sqlcipher.SQLiteDriver
+}
+
+func (d *meteredDriver) Open(dsn string) (driver.Conn, error) {
+	conn, err := d.SQLiteDriver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &meteredConn{SQLiteConn: conn.(*sqlcipher.SQLiteConn)}, nil
+}
+
+type meteredConn struct {
+	*sqlcipher.SQLiteConn
+}
+
+func (c *meteredConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+	result, err := c.SQLiteConn.ExecContext(ctx, query, args)
+	meter.record(query, time.Since(start))
+	return result, err
+}
+
+func (c *meteredConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+	rows, err := c.SQLiteConn.QueryContext(ctx, query, args)
+	if err != nil {
+		meter.record(query, time.Since(start))
+		return nil, err
+	}
+	return newMeteredRows(rows, query, time.Since(start)), nil
+}
+
+func (c *meteredConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	stmt, err := c.SQLiteConn.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &meteredStmt{SQLiteStmt: stmt.(*sqlcipher.SQLiteStmt), query: query}, nil
+}
+
+type meteredStmt struct {
+	*sqlcipher.SQLiteStmt
+	query string
+}
+
+func (s *meteredStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+	result, err := s.SQLiteStmt.ExecContext(ctx, args)
+	meter.record(s.query, time.Since(start))
+	return result, err
+}
+
+func (s *meteredStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+	rows, err := s.SQLiteStmt.QueryContext(ctx, args)
+	if err != nil {
+		meter.record(s.query, time.Since(start))
+		return nil, err
+	}
+	return newMeteredRows(rows, s.query, time.Since(start)), nil
+}
+
+// meteredRows adds the time spent stepping through the rows to the duration
+// of the query, as sqlite runs the statement lazily
+type meteredRows struct {
+	*sqlcipher.SQLiteRows
+	query    string
+	duration time.Duration
+	recorded bool
+}
+
+func newMeteredRows(rows driver.Rows, query string, duration time.Duration) driver.Rows {
+	sqliteRows, ok := rows.(*sqlcipher.SQLiteRows)
+	if !ok {
+		meter.record(query, duration)
+		return rows
+	}
+	return &meteredRows{SQLiteRows: sqliteRows, query: query, duration: duration}
+}
+
+func (r *meteredRows) Next(dest []driver.Value) error {
+	start := time.Now()
+	err := r.SQLiteRows.Next(dest)
+	r.duration += time.Since(start)
+	return err
+}
+
+func (r *meteredRows) Close() error {
+	err := r.SQLiteRows.Close()
+	if !r.recorded {
+		r.recorded = true
+		meter.record(r.query, r.duration)
+	}
+	return err
+}
@@ -0,0 +1,62 @@
+package sqlite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDBStats(t *testing.T) {
+	db, err := OpenDB(InMemoryPath, "", ReducedKDFIterationsNumber)
+	require.NoError(t, err)
+	defer db.Close()
+
+	ResetStats()
+	SetSlowQueryThreshold(time.Hour)
+	defer SetSlowQueryThreshold(DefaultSlowQueryThreshold)
+
+	_, err = db.Exec(`CREATE TABLE t (id INT)`)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = db.Exec(`INSERT INTO t (id)
+			VALUES (?)`, i)
+		require.NoError(t, err)
+	}
+
+	rows, err := db.Query(`SELECT id FROM t`)
+	require.NoError(t, err)
+	count := 0
+	for rows.Next() {
+		count++
+	}
+	require.NoError(t, rows.Close())
+	require.Equal(t, 3, count)
+
+	stats := GetDBStats(db)
+	require.Equal(t, time.Hour, stats.SlowQueryThreshold)
+	require.Empty(t, stats.SlowQueries)
+
+	queries := make(map[string]*QueryStats)
+	for _, q := range stats.Queries {
+		queries[q.Query] = q
+	}
+	require.Equal(t, uint64(3), queries["INSERT INTO t (id) VALUES (?)"].Count)
+	require.Equal(t, uint64(1), queries["SELECT id FROM t"].Count)
+	require.Equal(t, 1, stats.Connections.OpenConnections)
+
+	// The statements setting the encryption keys aren't recorded
+	_, err = db.Exec(`PRAGMA foreign_keys=ON`)
+	require.NoError(t, err)
+
+	SetSlowQueryThreshold(0)
+	_, err = db.Exec(`DELETE FROM t`)
+	require.NoError(t, err)
+
+	stats = GetDBStats(nil)
+	require.Len(t, stats.SlowQueries, 1)
+	require.Equal(t, "DELETE FROM t", stats.SlowQueries[0].Query)
+	for _, q := range stats.Queries {
+		require.NotContains(t, q.Query, "PRAGMA")
+	}
+}
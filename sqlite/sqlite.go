@@ -107,12 +107,19 @@ func EncryptDB(unencryptedPath string, encryptedPath string, key string, kdfIter
 
 // Export takes an encrypted database and re-encrypts it in a new file, with a new key
 func ExportDB(encryptedPath string, key string, kdfIterationsNumber int, newPath string, newKey string, onStart func(), onEnd func()) error {
+	return RekeyDB(encryptedPath, key, kdfIterationsNumber, newPath, newKey, kdfIterationsNumber, onStart, onEnd)
+}
+
+// RekeyDB exports the database to a new one encrypted with the new key and
+// KDF iterations number. The new database gets a new salt, so its derived key
+// differs from the one of the original database even when the key is the same
+func RekeyDB(encryptedPath string, key string, kdfIterationsNumber int, newPath string, newKey string, newKDFIterationsNumber int, onStart func(), onEnd func()) error {
 	db, err := openDB(encryptedPath, key, kdfIterationsNumber, V4CipherPageSize)
 	if err != nil {
 		return err
 	}
 	defer db.Close()
-	return encryptDB(db, newPath, newKey, kdfIterationsNumber, onStart, onEnd)
+	return encryptDB(db, newPath, newKey, newKDFIterationsNumber, onStart, onEnd)
 }
 
 func buildSqlcipherDSN(path string) (string, error) {
@@ -142,7 +149,7 @@ func buildSqlcipherDSN(path string) (string, error) {
 
 func openDB(path string, key string, kdfIterationsNumber int, chiperPageSize int) (*sql.DB, error) {
 	driverName := fmt.Sprintf("sqlcipher_with_extensions-%d", len(sql.Drivers()))
-	sql.Register(driverName, &sqlcipher.SQLiteDriver{
+	sql.Register(driverName, &meteredDriver{&sqlcipher.SQLiteDriver{
 		ConnectHook: func(conn *sqlcipher.SQLiteConn) error {
 			if _, err := conn.Exec("PRAGMA foreign_keys=ON", []driver.Value{}); err != nil {
 				return errors.New("failed to set `foreign_keys` pragma")
@@ -186,7 +193,7 @@ func openDB(path string, key string, kdfIterationsNumber int, chiperPageSize int
 
 			return nil
 		},
-	})
+	}})
 
 	dsn, err := buildSqlcipherDSN(path)
 
@@ -55,3 +55,21 @@ func (c *Client) PushReceivedMessages(filter transport.Filter, sshMessage *types
 		c.logger.Error("Error sending message to telemetry server", zap.Error(err))
 	}
 }
+
+// PushLatencyHistograms pushes anonymous latency histograms, the key UID
+// isn't sent
+func (c *Client) PushLatencyHistograms(histograms map[string]LatencyHistogram) {
+	if len(histograms) == 0 {
+		return
+	}
+
+	c.logger.Debug("Pushing latency histograms to telemetry server")
+	url := fmt.Sprintf("%s/latency-histograms", c.serverURL)
+	body, _ := json.Marshal(histograms)
+	resp, err := c.httpClient.Post(url, "application/json", bytes.NewBuffer(body))
+	if err != nil {
+		c.logger.Error("Error sending latency histograms to telemetry server", zap.Error(err))
+		return
+	}
+	resp.Body.Close()
+}
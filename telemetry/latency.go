@@ -0,0 +1,202 @@
+package telemetry
+
+import (
+	"sync"
+	"time"
+)
+
+// Latencies aggregated by the latency tracker
+const (
+	// LatencySendToSent is the time from dispatching a message to its
+	// envelope being confirmed sent
+	LatencySendToSent = "send-to-sent"
+	// LatencySendToDelivered is the time from dispatching a message to it
+	// being acknowledged by the recipient
+	LatencySendToDelivered = "send-to-delivered"
+	// LatencySentToReceived is the time from a received envelope being sent
+	// to it being received
+	LatencySentToReceived = "sent-to-received"
+)
+
+// latencyBucketsMs are the upper bounds of the histogram buckets
+var latencyBucketsMs = []int64{100, 250, 500, 1000, 2500, 5000, 10000, 30000, 60000, 300000}
+
+// maxPendingLatencies bounds the number of sent messages waiting for a
+// confirmation, the oldest ones are dropped
+const maxPendingLatencies = 1000
+
+// LatencyHistogram counts latencies by bucket, the last count is for the
+// latencies above the last bucket
+type LatencyHistogram struct {
+	BucketsMs []int64  `json:"bucketsMs"`
+	Counts    []uint64 `json:"counts"`
+	Count     uint64   `json:"count"`
+	SumMs     int64    `json:"sumMs"`
+}
+
+func newLatencyHistogram() *LatencyHistogram {
+	return &LatencyHistogram{
+		BucketsMs: latencyBucketsMs,
+		Counts:    make([]uint64, len(latencyBucketsMs)+1),
+	}
+}
+
+func (h *LatencyHistogram) observe(latency time.Duration) {
+	ms := latency.Milliseconds()
+	if ms < 0 {
+		ms = 0
+	}
+
+	bucket := len(h.BucketsMs)
+	for i, upperBound := range h.BucketsMs {
+		if ms <= upperBound {
+			bucket = i
+			break
+		}
+	}
+
+	h.Counts[bucket]++
+	h.Count++
+	h.SumMs += ms
+}
+
+func (h *LatencyHistogram) copy() LatencyHistogram {
+	counts := make([]uint64, len(h.Counts))
+	copy(counts, h.Counts)
+	return LatencyHistogram{
+		BucketsMs: h.BucketsMs,
+		Counts:    counts,
+		Count:     h.Count,
+		SumMs:     h.SumMs,
+	}
+}
+
+type pendingLatency struct {
+	sentAt    time.Time
+	confirmed bool
+}
+
+// LatencyTracker aggregates anonymous latency histograms of the messages
+// sent and received. Only the histograms are kept, not the messages
+type LatencyTracker struct {
+	sync.Mutex
+	pending    map[string]*pendingLatency // by message ID
+	histograms map[string]*LatencyHistogram
+	// unreported are the histograms of the latencies not pushed yet to the
+	// telemetry server
+	unreported map[string]*LatencyHistogram
+}
+
+func NewLatencyTracker() *LatencyTracker {
+	return &LatencyTracker{
+		pending:    make(map[string]*pendingLatency),
+		histograms: make(map[string]*LatencyHistogram),
+		unreported: make(map[string]*LatencyHistogram),
+	}
+}
+
+func (t *LatencyTracker) observe(name string, latency time.Duration) {
+	for _, histograms := range []map[string]*LatencyHistogram{t.histograms, t.unreported} {
+		histogram, ok := histograms[name]
+		if !ok {
+			histogram = newLatencyHistogram()
+			histograms[name] = histogram
+		}
+		histogram.observe(latency)
+	}
+}
+
+// MessageSent records when a message was dispatched
+func (t *LatencyTracker) MessageSent(messageID string, at time.Time) {
+	t.Lock()
+	defer t.Unlock()
+
+	if _, ok := t.pending[messageID]; ok {
+		// Resent, keep the time of the first attempt
+		return
+	}
+
+	if len(t.pending) >= maxPendingLatencies {
+		var oldestID string
+		var oldest time.Time
+		for id, p := range t.pending {
+			if oldestID == "" || p.sentAt.Before(oldest) {
+				oldestID = id
+				oldest = p.sentAt
+			}
+		}
+		delete(t.pending, oldestID)
+	}
+
+	t.pending[messageID] = &pendingLatency{sentAt: at}
+}
+
+// MessageConfirmed records when the envelope of a message was confirmed sent
+func (t *LatencyTracker) MessageConfirmed(messageID string, at time.Time) {
+	t.Lock()
+	defer t.Unlock()
+
+	p, ok := t.pending[messageID]
+	if !ok || p.confirmed {
+		return
+	}
+	p.confirmed = true
+	t.observe(LatencySendToSent, at.Sub(p.sentAt))
+}
+
+// MessageDelivered records when a message was acknowledged by the recipient
+func (t *LatencyTracker) MessageDelivered(messageID string, at time.Time) {
+	t.Lock()
+	defer t.Unlock()
+
+	p, ok := t.pending[messageID]
+	if !ok {
+		return
+	}
+	delete(t.pending, messageID)
+	t.observe(LatencySendToDelivered, at.Sub(p.sentAt))
+}
+
+// MessageReceived records the latency of a received envelope
+func (t *LatencyTracker) MessageReceived(sentAt time.Time, at time.Time) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.observe(LatencySentToReceived, at.Sub(sentAt))
+}
+
+// Histograms returns the histograms of all the latencies recorded
+func (t *LatencyTracker) Histograms() map[string]LatencyHistogram {
+	t.Lock()
+	defer t.Unlock()
+
+	result := make(map[string]LatencyHistogram)
+	for name, histogram := range t.histograms {
+		result[name] = histogram.copy()
+	}
+	return result
+}
+
+// TakeUnreported returns the histograms of the latencies recorded since the
+// last call
+func (t *LatencyTracker) TakeUnreported() map[string]LatencyHistogram {
+	t.Lock()
+	defer t.Unlock()
+
+	result := make(map[string]LatencyHistogram)
+	for name, histogram := range t.unreported {
+		result[name] = histogram.copy()
+	}
+	t.unreported = make(map[string]*LatencyHistogram)
+	return result
+}
+
+// Reset drops all the latencies recorded
+func (t *LatencyTracker) Reset() {
+	t.Lock()
+	defer t.Unlock()
+
+	t.pending = make(map[string]*pendingLatency)
+	t.histograms = make(map[string]*LatencyHistogram)
+	t.unreported = make(map[string]*LatencyHistogram)
+}
@@ -293,7 +293,7 @@ func (t *Transactor) validateAndPropagate(rpcWrapper *rpcWrapper, selectedAccoun
 		}
 	}
 	tx := t.buildTransactionWithOverrides(nonce, value, gas, gasPrice, args)
-	signedTx, err := gethtypes.SignTx(tx, gethtypes.NewLondonSigner(chainID), selectedAccount.AccountKey.PrivateKey)
+	signedTx, err := selectedAccount.SignTx(tx, chainID)
 	if err != nil {
 		return hash, err
 	}
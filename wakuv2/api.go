@@ -167,6 +167,11 @@ func (api *PublicWakuAPI) BloomFilter() []byte {
 	return nil
 }
 
+// SetLightClient switches between relay and light client mode (filter and lightpush) without restarting the node.
+func (api *PublicWakuAPI) SetLightClient(ctx context.Context, enabled bool) error {
+	return api.w.SetLightClient(enabled)
+}
+
 //go:generate gencodec -type NewMessage -field-override newMessageOverride -out gen_newmessage_json.go
 
 // NewMessage represents a new waku message that is posted through the RPC.
@@ -150,6 +150,18 @@ func (fs *Filters) GetWatchersByTopic(topic TopicType) []*Filter {
 	return res
 }
 
+// All returns all the filters of the collection
+func (fs *Filters) All() []*Filter {
+	fs.mutex.RLock()
+	defer fs.mutex.RUnlock()
+
+	filters := make([]*Filter, 0, len(fs.watchers))
+	for _, f := range fs.watchers {
+		filters = append(filters, f)
+	}
+	return filters
+}
+
 // Get returns a filter from the collection with a specific ID
 func (fs *Filters) Get(id string) *Filter {
 	fs.mutex.RLock()
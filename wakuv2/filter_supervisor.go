@@ -0,0 +1,202 @@
+package wakuv2
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"go.uber.org/zap"
+
+	"github.com/waku-org/go-waku/waku/v2/protocol/filter"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/wakuv2/common"
+)
+
+const (
+	// Filter peers failing this many consecutive pings are only used when
+	// no other peer is available, until the cooldown is over
+	filterPeerMaxFailures     = 3
+	filterPeerFailureCooldown = 5 * time.Minute
+
+	filterResubscribeMaxBackoff = 2 * time.Minute
+)
+
+type filterPeerFailure struct {
+	count       int
+	lastFailure time.Time
+}
+
+// filterBackoff delays the resubscriptions of a filter which couldn't
+// subscribe to enough peers
+type filterBackoff struct {
+	attempts    int
+	nextAttempt time.Time
+}
+
+func (b *filterBackoff) ready(now time.Time) bool {
+	return !now.Before(b.nextAttempt)
+}
+
+func (b *filterBackoff) failed(now time.Time, interval time.Duration) {
+	b.attempts++
+	delay := filterResubscribeMaxBackoff
+	if b.attempts < 16 && interval<<b.attempts < filterResubscribeMaxBackoff {
+		delay = interval << b.attempts
+	}
+	b.nextAttempt = now.Add(delay)
+}
+
+func (b *filterBackoff) reset() {
+	b.attempts = 0
+	b.nextAttempt = time.Time{}
+}
+
+func (w *Waku) filterPeerFailed(peerID peer.ID, now time.Time) {
+	w.filterPeerFailuresMu.Lock()
+	defer w.filterPeerFailuresMu.Unlock()
+
+	failure, ok := w.filterPeerFailures[peerID]
+	if !ok {
+		failure = &filterPeerFailure{}
+		w.filterPeerFailures[peerID] = failure
+	}
+	failure.count++
+	failure.lastFailure = now
+}
+
+func (w *Waku) filterPeerSucceeded(peerID peer.ID) {
+	w.filterPeerFailuresMu.Lock()
+	defer w.filterPeerFailuresMu.Unlock()
+
+	delete(w.filterPeerFailures, peerID)
+}
+
+// filterPeerAvoided returns whether a peer kept failing recently
+func (w *Waku) filterPeerAvoided(peerID peer.ID, now time.Time) bool {
+	w.filterPeerFailuresMu.RLock()
+	defer w.filterPeerFailuresMu.RUnlock()
+
+	failure, ok := w.filterPeerFailures[peerID]
+	return ok && failure.count >= filterPeerMaxFailures && now.Sub(failure.lastFailure) < filterPeerFailureCooldown
+}
+
+// pingFilterSubscriptions checks that full nodes still have our filter
+// subscriptions, dropping the ones that are gone
+func (w *Waku) pingFilterSubscriptions(f *common.Filter, subMap map[string]*filter.SubscriptionDetails, now time.Time) {
+	for id, sub := range subMap {
+		err := w.isFilterSubAlive(sub)
+		if err == nil {
+			w.filterPeerSucceeded(sub.PeerID)
+			continue
+		}
+
+		w.logger.Warn("wakuv2 filter subscription ping failed", zap.Any("peer", sub.PeerID), zap.Error(err))
+		w.filterPeerFailed(sub.PeerID, now)
+
+		// Unsubscribe on light node
+		contentFilter := w.buildContentFilter(f.Topics)
+		// TODO Better return value handling for WakuFilterPushResult
+		_, err = w.node.FilterLightnode().Unsubscribe(context.Background(), contentFilter, filter.Peer(sub.PeerID))
+		if err != nil {
+			w.logger.Warn("could not unsubscribe wakuv2 filter for peer", zap.Any("peer", sub.PeerID))
+			continue
+		}
+
+		// Remove entry from maps
+		w.filterPeerDisconnectMap[sub.PeerID] = now.Unix()
+		delete(subMap, id)
+	}
+}
+
+// resubscribeFilter subscribes a filter to new peers until it reaches the
+// minimum number of peers, backing off when it doesn't
+func (w *Waku) resubscribeFilter(f *common.Filter, subMap map[string]*filter.SubscriptionDetails, now time.Time, interval time.Duration) {
+	backoff, ok := w.filterBackoffs[f]
+	if !ok {
+		backoff = &filterBackoff{}
+		w.filterBackoffs[f] = backoff
+	}
+	if !backoff.ready(now) {
+		return
+	}
+
+	subscribed := make(map[peer.ID]bool)
+	for _, sub := range subMap {
+		subscribed[sub.PeerID] = true
+	}
+
+	contentFilter := w.buildContentFilter(f.Topics)
+	for _, peerID := range w.filterPeerCandidates() {
+		if len(subMap) >= w.settings.MinPeersForFilter {
+			break
+		}
+		if subscribed[peerID] {
+			continue
+		}
+
+		subDetails, err := w.node.FilterLightnode().Subscribe(context.Background(), contentFilter, filter.WithPeer(peerID))
+		if err != nil {
+			w.logger.Warn("could not add wakuv2 filter for peer", zap.Any("peer", peerID))
+			w.filterPeerFailed(peerID, now)
+			continue
+		}
+
+		subMap[subDetails.ID] = subDetails
+		go w.runFilterSubscriptionLoop(subDetails)
+	}
+
+	if len(subMap) >= w.settings.MinPeersForFilter {
+		backoff.reset()
+	} else {
+		backoff.failed(now, interval)
+	}
+}
+
+func (w *Waku) filterHealth(now time.Time) types.FilterHealth {
+	health := types.FilterHealth{IsHealthy: true}
+
+	peers := make(map[peer.ID]bool)
+	for _, subMap := range w.filterSubscriptions {
+		if len(subMap) == 0 {
+			health.IsHealthy = false
+		}
+		for _, sub := range subMap {
+			peers[sub.PeerID] = true
+		}
+	}
+	for peerID := range peers {
+		health.Peers = append(health.Peers, peerID.String())
+	}
+	sort.Strings(health.Peers)
+
+	w.filterPeerFailuresMu.RLock()
+	for peerID, failure := range w.filterPeerFailures {
+		if failure.count >= filterPeerMaxFailures && now.Sub(failure.lastFailure) < filterPeerFailureCooldown {
+			health.FailedPeers = append(health.FailedPeers, peerID.String())
+		}
+	}
+	w.filterPeerFailuresMu.RUnlock()
+	sort.Strings(health.FailedPeers)
+
+	return health
+}
+
+// updateFilterHealth notifies changes of the filter subscriptions health
+func (w *Waku) updateFilterHealth(now time.Time) {
+	health := w.filterHealth(now)
+	if w.lastFilterHealth != nil && reflect.DeepEqual(*w.lastFilterHealth, health) {
+		return
+	}
+	w.lastFilterHealth = &health
+
+	if !health.IsHealthy {
+		w.logger.Warn("wakuv2 filter subscriptions unhealthy", zap.Strings("failedPeers", health.FailedPeers))
+	}
+
+	if w.onFilterHealth != nil {
+		w.onFilterHealth(health)
+	}
+}
@@ -0,0 +1,132 @@
+package wakuv2
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/waku-org/go-waku/waku/v2/protocol/filter"
+	"github.com/waku-org/go-waku/waku/v2/protocol/relay"
+
+	"github.com/status-im/status-go/wakuv2/common"
+)
+
+// isLightClient returns whether the node uses filter and lightpush instead of
+// relay to receive and send messages
+func (w *Waku) isLightClient() bool {
+	w.settingsMu.RLock()
+	defer w.settingsMu.RUnlock()
+	return w.settings.LightClient
+}
+
+// startModeLoop starts receiving messages with relay, or with filter for light
+// clients
+func (w *Waku) startModeLoop() {
+	w.modeQuit = make(chan struct{})
+	w.modeWg.Add(1)
+	if w.isLightClient() {
+		go w.runFilterMsgLoop()
+	} else {
+		go w.runRelayMsgLoop()
+	}
+}
+
+func (w *Waku) stopModeLoop() {
+	close(w.modeQuit)
+	w.modeWg.Wait()
+}
+
+// subscribeToFilterInBackground retries subscribing a filter to full nodes
+// until it succeeds, or the node isn't a light client anymore
+func (w *Waku) subscribeToFilterInBackground(f *common.Filter) {
+	go func() {
+		ticker := time.NewTicker(1 * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.quit:
+				return
+			case <-ticker.C:
+				if !w.isLightClient() {
+					return
+				}
+				if err := w.subscribeToFilter(f); err == nil {
+					return
+				}
+			}
+		}
+	}()
+}
+
+func (w *Waku) startLightMode(ctx context.Context) error {
+	if err := w.relay.Unsubscribe(ctx, relay.DefaultWakuTopic); err != nil {
+		w.logger.Warn("could not unsubscribe from relay topic", zap.Error(err))
+	}
+	w.relay.Stop()
+
+	return w.node.FilterLightnode().Start(ctx)
+}
+
+func (w *Waku) startRelayMode(ctx context.Context) error {
+	w.node.FilterLightnode().Stop()
+	w.filterSubscriptionsMu.Lock()
+	w.filterSubscriptions = make(map[*common.Filter]map[string]*filter.SubscriptionDetails)
+	w.filterBackoffs = make(map[*common.Filter]*filterBackoff)
+	w.lastFilterHealth = nil
+	w.filterSubscriptionsMu.Unlock()
+
+	return w.relay.Start(ctx)
+}
+
+func (w *Waku) startMode(ctx context.Context, lightClient bool) error {
+	if lightClient {
+		return w.startLightMode(ctx)
+	}
+	return w.startRelayMode(ctx)
+}
+
+// SetLightClient switches the node between relay and light client mode
+// without restarting it. Light clients subscribe all the installed filters
+// to full nodes, and publish messages with lightpush
+func (w *Waku) SetLightClient(enabled bool) error {
+	w.modeMu.Lock()
+	defer w.modeMu.Unlock()
+
+	if w.node == nil {
+		return errors.New("waku node not started")
+	}
+
+	if w.isLightClient() == enabled {
+		return nil
+	}
+
+	w.logger.Info("switching wakuv2 mode", zap.Bool("lightClient", enabled))
+	w.stopModeLoop()
+
+	ctx := context.Background()
+	err := w.startMode(ctx, enabled)
+	if err != nil {
+		w.logger.Error("could not switch wakuv2 mode", zap.Bool("lightClient", enabled), zap.Error(err))
+
+		// Go back to the previous mode
+		if err := w.startMode(ctx, !enabled); err != nil {
+			w.logger.Error("could not restore wakuv2 mode", zap.Bool("lightClient", !enabled), zap.Error(err))
+		}
+	} else {
+		w.settingsMu.Lock()
+		w.settings.LightClient = enabled
+		w.settingsMu.Unlock()
+	}
+
+	w.startModeLoop()
+	if w.isLightClient() {
+		for _, f := range w.filters.All() {
+			w.subscribeToFilterInBackground(f)
+		}
+	}
+
+	return err
+}
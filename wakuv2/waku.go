@@ -34,6 +34,7 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	libp2pprotocol "github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
 	"github.com/multiformats/go-multiaddr"
 
@@ -58,6 +59,7 @@ import (
 	"github.com/waku-org/go-waku/waku/v2/peers"
 	"github.com/waku-org/go-waku/waku/v2/protocol"
 	"github.com/waku-org/go-waku/waku/v2/protocol/filter"
+	"github.com/waku-org/go-waku/waku/v2/protocol/lightpush"
 	"github.com/waku-org/go-waku/waku/v2/protocol/peer_exchange"
 	"github.com/waku-org/go-waku/waku/v2/protocol/relay"
 
@@ -94,7 +96,8 @@ type settings struct {
 // Waku represents a dark communication interface through the Ethereum
 // network, using its very own P2P communication layer.
 type Waku struct {
-	node            *node.WakuNode // reference to a libp2p waku node
+	node            *node.WakuNode   // reference to a libp2p waku node
+	relay           *relay.WakuRelay // relay protocol, configured also when the node was started as a light client
 	identifyService identify.IDService
 	appDB           *sql.DB
 
@@ -102,10 +105,15 @@ type Waku struct {
 	dnsAddressCacheLock *sync.RWMutex                       // lock to handle access to the map
 
 	// Filter-related
-	filters             *common.Filters                                           // Message filters installed with Subscribe function
-	filterSubscriptions map[*common.Filter]map[string]*filter.SubscriptionDetails // wakuv2 filter subscription details
+	filters               *common.Filters                                           // Message filters installed with Subscribe function
+	filterSubscriptions   map[*common.Filter]map[string]*filter.SubscriptionDetails // wakuv2 filter subscription details
+	filterSubscriptionsMu sync.Mutex                                                // Mutex to sync the filter subscriptions, their backoffs and health
 
 	filterPeerDisconnectMap map[peer.ID]int64
+	filterPeerFailures      map[peer.ID]*filterPeerFailure // consecutive failed pings of filter peers
+	filterPeerFailuresMu    sync.RWMutex
+	filterBackoffs          map[*common.Filter]*filterBackoff
+	lastFilterHealth        *types.FilterHealth
 	isFilterSubAlive        func(sub *filter.SubscriptionDetails) error
 
 	privateKeys map[string]*ecdsa.PrivateKey // Private key storage
@@ -127,6 +135,10 @@ type Waku struct {
 	settings   settings     // Holds configuration settings that can be dynamically changed
 	settingsMu sync.RWMutex // Mutex to sync the settings access
 
+	modeMu   sync.Mutex    // Mutex to sync the switches between relay and light client mode
+	modeQuit chan struct{} // Channel used to stop the relay or filter loop when switching mode
+	modeWg   sync.WaitGroup
+
 	envelopeFeed event.Feed
 
 	storeMsgIDs   map[gethcommon.Hash]bool // Map of the currently processing ids
@@ -156,6 +168,7 @@ type Waku struct {
 
 	onHistoricMessagesRequestFailed func([]byte, peer.ID, error)
 	onPeerStats                     func(types.ConnStatus)
+	onFilterHealth                  func(types.FilterHealth)
 }
 
 func getUsableUDPPort() (int, error) {
@@ -171,7 +184,7 @@ func getUsableUDPPort() (int, error) {
 }
 
 // New creates a WakuV2 client ready to communicate through the LibP2P network.
-func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *sql.DB, ts *timesource.NTPTimeSource, onHistoricMessagesRequestFailed func([]byte, peer.ID, error), onPeerStats func(types.ConnStatus)) (*Waku, error) {
+func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *sql.DB, ts *timesource.NTPTimeSource, onHistoricMessagesRequestFailed func([]byte, peer.ID, error), onPeerStats func(types.ConnStatus), onFilterHealth func(types.FilterHealth)) (*Waku, error) {
 	var err error
 	if logger == nil {
 		logger, err = zap.NewDevelopment()
@@ -211,6 +224,8 @@ func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *s
 		dnsAddressCacheLock:             &sync.RWMutex{},
 		storeMsgIDs:                     make(map[gethcommon.Hash]bool),
 		filterPeerDisconnectMap:         make(map[peer.ID]int64),
+		filterPeerFailures:              make(map[peer.ID]*filterPeerFailure),
+		filterBackoffs:                  make(map[*common.Filter]*filterBackoff),
 		filterSubscriptions:             make(map[*common.Filter]map[string]*filter.SubscriptionDetails),
 		timesource:                      ts,
 		storeMsgIDsMu:                   sync.RWMutex{},
@@ -218,6 +233,7 @@ func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *s
 		discV5BootstrapNodes:            cfg.DiscV5BootstrapNodes,
 		onHistoricMessagesRequestFailed: onHistoricMessagesRequestFailed,
 		onPeerStats:                     onPeerStats,
+		onFilterHealth:                  onFilterHealth,
 	}
 	// This fn is being mocked in test
 	waku.isFilterSubAlive = func(sub *filter.SubscriptionDetails) error {
@@ -286,11 +302,7 @@ func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *s
 	if cfg.LightClient {
 		opts = append(opts, node.WithWakuFilterLightNode())
 	} else {
-		relayOpts := []pubsub.Option{
-			pubsub.WithMaxMessageSize(int(waku.settings.MaxMsgSize)),
-		}
-
-		opts = append(opts, node.WithWakuRelayAndMinPeers(waku.settings.MinPeersForRelay, relayOpts...))
+		opts = append(opts, node.WithWakuRelayAndMinPeers(waku.settings.MinPeersForRelay, waku.relayOptions()...))
 	}
 
 	if cfg.EnableStore {
@@ -308,6 +320,12 @@ func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *s
 	return waku, nil
 }
 
+func (w *Waku) relayOptions() []pubsub.Option {
+	return []pubsub.Option{
+		pubsub.WithMaxMessageSize(int(w.settings.MaxMsgSize)),
+	}
+}
+
 func (w *Waku) SubscribeToConnStatusChanges() *types.ConnStatusSubscription {
 	w.connStatusMu.Lock()
 	defer w.connStatusMu.Unlock()
@@ -403,7 +421,7 @@ func (w *Waku) addWakuV2Peers(ctx context.Context, cfg *Config) error {
 	fnApply := func(d dnsdisc.DiscoveredNode, wg *sync.WaitGroup) {
 		if len(d.PeerInfo.Addrs) != 0 {
 			go func(ma multiaddr.Multiaddr) {
-				w.identifyAndConnect(ctx, w.settings.LightClient, ma)
+				w.identifyAndConnect(ctx, w.isLightClient(), ma)
 				wg.Done()
 			}(d.PeerInfo.Addrs[0])
 		}
@@ -521,12 +539,32 @@ func (w *Waku) GetStats() types.StatsSummary {
 	}
 }
 
+// BandwidthByProtocol returns the bytes exchanged by each waku protocol since
+// the node started, or since the telemetry counters were last reset
+func (w *Waku) BandwidthByProtocol() map[string]types.ProtocolBandwidth {
+	protocols := map[string][]libp2pprotocol.ID{
+		types.RelayProtocol:     {relay.WakuRelayID_v200},
+		types.FilterProtocol:    {filter.FilterSubscribeID_v20beta1, filter.FilterPushID_v20beta1},
+		types.StoreProtocol:     {store.StoreID_v20beta4},
+		types.LightpushProtocol: {lightpush.LightPushID_v20beta1},
+	}
+
+	result := make(map[string]types.ProtocolBandwidth)
+	for name, ids := range protocols {
+		var bandwidth types.ProtocolBandwidth
+		for _, id := range ids {
+			stats := w.bandwidthCounter.GetBandwidthForProtocol(id)
+			bandwidth.BytesIn += uint64(stats.TotalIn)
+			bandwidth.BytesOut += uint64(stats.TotalOut)
+		}
+		result[name] = bandwidth
+	}
+	return result
+}
+
 func (w *Waku) runPeerExchangeLoop() {
 	defer w.wg.Done()
-	if !w.settings.PeerExchange || !w.settings.LightClient {
-		// Currently peer exchange is only used for full nodes
-		// TODO: should it be used for lightpush? or lightpush nodes
-		// are only going to be selected from a specific set of peers?
+	if !w.settings.PeerExchange {
 		return
 	}
 
@@ -538,6 +576,13 @@ func (w *Waku) runPeerExchangeLoop() {
 		case <-w.quit:
 			return
 		case <-ticker.C:
+			// Currently peer exchange is only used for light clients
+			// TODO: should it be used for lightpush? or lightpush nodes
+			// are only going to be selected from a specific set of peers?
+			if !w.isLightClient() {
+				continue
+			}
+
 			w.logger.Debug("Running peer exchange loop")
 
 			connectedPeers := w.node.Host().Network().Peers()
@@ -600,13 +645,9 @@ func (w *Waku) runPeerExchangeLoop() {
 }
 
 func (w *Waku) runRelayMsgLoop() {
-	defer w.wg.Done()
+	defer w.modeWg.Done()
 
-	if w.settings.LightClient {
-		return
-	}
-
-	sub, err := w.node.Relay().Subscribe(context.Background())
+	sub, err := w.relay.Subscribe(context.Background())
 	if err != nil {
 		fmt.Println("Could not subscribe:", err)
 		return
@@ -617,6 +658,9 @@ func (w *Waku) runRelayMsgLoop() {
 		case <-w.quit:
 			sub.Unsubscribe()
 			return
+		case <-w.modeQuit:
+			sub.Unsubscribe()
+			return
 		case env := <-sub.Ch:
 			envelopeErrors, err := w.OnNewEnvelopes(env, common.RelayedMessageType)
 			if err != nil {
@@ -648,67 +692,35 @@ func (w *Waku) runFilterSubscriptionLoop(sub *filter.SubscriptionDetails) {
 }
 
 func (w *Waku) runFilterMsgLoop() {
-	defer w.wg.Done()
-
-	if !w.settings.LightClient {
-		return
-	}
+	defer w.modeWg.Done()
 
 	// Use it to ping filter peer(s) periodically
-	ticker := time.NewTicker(time.Duration(w.cfg.KeepAliveInterval) * time.Second)
+	interval := time.Duration(w.cfg.KeepAliveInterval) * time.Second
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-w.quit:
 			return
+		case <-w.modeQuit:
+			return
 		case <-ticker.C:
+			now := time.Now()
+			w.filterSubscriptionsMu.Lock()
 			for f, subMap := range w.filterSubscriptions {
-				if len(subMap) == 0 {
-					// All peers have disconnected on previous iteration,
-					// attempt full reconnect
-					err := w.subscribeToFilter(f)
-					if err != nil {
-						w.logger.Error("Failed to subscribe to filter")
-					}
-					break
-				}
-				for id, sub := range subMap {
-					err := w.isFilterSubAlive(sub)
-					if err != nil {
-						// Unsubscribe on light node
-						contentFilter := w.buildContentFilter(f.Topics)
-						// TODO Better return value handling for WakuFilterPushResult
-						_, err := w.node.FilterLightnode().Unsubscribe(context.Background(), contentFilter, filter.Peer(sub.PeerID))
-						if err != nil {
-							w.logger.Warn("could not unsubscribe wakuv2 filter for peer", zap.Any("peer", sub.PeerID))
-							continue
-						}
-
-						// Remove entry from maps
-						w.filterPeerDisconnectMap[sub.PeerID] = time.Now().Unix()
-						delete(subMap, id)
+				w.pingFilterSubscriptions(f, subMap, now)
 
-						// Re-subscribe
-						peers := w.findFilterPeers()
-						if len(peers) > 0 && len(subMap) < w.settings.MinPeersForFilter {
-							subDetails, err := w.node.FilterLightnode().Subscribe(context.Background(), contentFilter, filter.WithPeer(peers[0]))
-							if err != nil {
-								w.logger.Warn("could not add wakuv2 filter for peer", zap.Any("peer", peers[0]))
-								break
-							}
-
-							subMap[subDetails.ID] = subDetails
-							go w.runFilterSubscriptionLoop(subDetails)
-
-							break
-						}
-					}
+				if len(subMap) < w.settings.MinPeersForFilter {
+					w.resubscribeFilter(f, subMap, now, interval)
 				}
 			}
+			w.updateFilterHealth(now)
+			w.filterSubscriptionsMu.Unlock()
 		}
 	}
 }
+
 func (w *Waku) buildContentFilter(topics [][]byte) filter.ContentFilter {
 	contentFilter := filter.ContentFilter{
 		Topic: relay.DefaultWakuTopic,
@@ -1011,16 +1023,8 @@ func (w *Waku) Subscribe(f *common.Filter) (string, error) {
 		return s, err
 	}
 
-	if w.settings.LightClient {
-		go func() {
-			ticker := time.NewTicker(1 * time.Second)
-			for range ticker.C {
-				err := w.subscribeToFilter(f)
-				if err == nil {
-					break
-				}
-			}
-		}()
+	if w.isLightClient() {
+		w.subscribeToFilterInBackground(f)
 	}
 
 	return s, nil
@@ -1029,7 +1033,7 @@ func (w *Waku) Subscribe(f *common.Filter) (string, error) {
 // Unsubscribe removes an installed message handler.
 func (w *Waku) Unsubscribe(id string) error {
 	f := w.filters.Get(id)
-	if f != nil && w.settings.LightClient {
+	if f != nil && w.isLightClient() {
 		contentFilter := w.buildContentFilter(f.Topics)
 		if _, err := w.node.FilterLightnode().Unsubscribe(context.Background(), contentFilter); err != nil {
 			return fmt.Errorf("failed to unsubscribe: %w", err)
@@ -1065,12 +1069,12 @@ func (w *Waku) broadcast() {
 		select {
 		case envelope := <-w.sendQueue:
 			var err error
-			if w.settings.LightClient {
+			if w.isLightClient() {
 				w.logger.Info("publishing message via lightpush", zap.String("envelopeHash", hexutil.Encode(envelope.Hash())))
 				_, err = w.node.Lightpush().Publish(context.Background(), envelope.Message())
 			} else {
 				w.logger.Info("publishing message via relay", zap.String("envelopeHash", hexutil.Encode(envelope.Hash())))
-				_, err = w.node.Relay().Publish(context.Background(), envelope.Message())
+				_, err = w.relay.Publish(context.Background(), envelope.Message())
 			}
 
 			if err != nil {
@@ -1182,6 +1186,14 @@ func (w *Waku) Start() error {
 		return fmt.Errorf("failed to start go-waku node: %v", err)
 	}
 
+	w.relay = w.node.Relay()
+	if w.cfg.LightClient {
+		// The relay of the node isn't configured for light clients, the one
+		// used when switching to relay mode is
+		w.relay = relay.NewWakuRelay(w.node.Broadcaster(), w.settings.MinPeersForRelay, w.node.Timesource(), w.logger, w.relayOptions()...)
+		w.relay.SetHost(w.node.Host())
+	}
+
 	idService, err := identify.NewIDService(w.node.Host())
 	if err != nil {
 		return err
@@ -1200,7 +1212,7 @@ func (w *Waku) Start() error {
 		}
 	}
 
-	w.wg.Add(4)
+	w.wg.Add(2)
 
 	go func() {
 		defer w.wg.Done()
@@ -1247,8 +1259,7 @@ func (w *Waku) Start() error {
 	}()
 
 	go w.telemetryBandwidthStats(w.cfg.TelemetryServerURL)
-	go w.runFilterMsgLoop()
-	go w.runRelayMsgLoop()
+	w.startModeLoop()
 	go w.runPeerExchangeLoop()
 
 	numCPU := runtime.NumCPU()
@@ -1266,7 +1277,11 @@ func (w *Waku) Start() error {
 // of the Waku protocol.
 func (w *Waku) Stop() error {
 	close(w.quit)
+	w.modeWg.Wait()
 	w.identifyService.Close()
+	if w.relay != w.node.Relay() {
+		w.relay.Stop()
+	}
 	w.node.Stop()
 	close(w.connectionChanged)
 	w.wg.Wait()
@@ -1679,10 +1694,11 @@ func formatConnStatus(wakuNode *node.WakuNode, c node.ConnStatus) types.ConnStat
 	}
 }
 
-// Find suitable peer(s). For this we use a peerDisconnectMap, it works so that
-// peers that have been recently disconnected from have lower priority
-func (w *Waku) findFilterPeers() []peer.ID {
-
+// filterPeerCandidates returns the peers supporting filter, sorted by
+// priority. We use a peerDisconnectMap, it works so that peers that have
+// been recently disconnected from have lower priority, and peers that keep
+// failing are only used when there are no others
+func (w *Waku) filterPeerCandidates() []peer.ID {
 	allPeers := w.node.Host().Peerstore().Peers()
 	var peers peer.IDSlice
 	for _, peer := range allPeers {
@@ -1697,12 +1713,25 @@ func (w *Waku) findFilterPeers() []peer.ID {
 	}
 
 	if len(peers) > 0 {
+		now := time.Now()
 		sort.Slice(peers, func(i, j int) bool {
+			avoidedI := w.filterPeerAvoided(peers[i], now)
+			avoidedJ := w.filterPeerAvoided(peers[j], now)
+			if avoidedI != avoidedJ {
+				return avoidedJ
+			}
 			// If element not found in map, [] operator will return 0
 			return w.filterPeerDisconnectMap[peers[i]] < w.filterPeerDisconnectMap[peers[j]]
 		})
 	}
 
+	return peers
+}
+
+// Find suitable peer(s)
+func (w *Waku) findFilterPeers() []peer.ID {
+	peers := w.filterPeerCandidates()
+
 	var peerLen = len(peers)
 	if w.settings.MinPeersForFilter < peerLen {
 		peerLen = w.settings.MinPeersForFilter
@@ -1723,12 +1752,14 @@ func (w *Waku) subscribeToFilter(f *common.Filter) error {
 				continue
 			}
 
+			w.filterSubscriptionsMu.Lock()
 			subMap := w.filterSubscriptions[f]
 			if subMap == nil {
 				subMap = make(map[string]*filter.SubscriptionDetails)
 				w.filterSubscriptions[f] = subMap
 			}
 			subMap[subDetails.ID] = subDetails
+			w.filterSubscriptionsMu.Unlock()
 			go w.runFilterSubscriptionLoop(subDetails)
 		}
 
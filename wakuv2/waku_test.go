@@ -10,10 +10,12 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff/v3"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
 	"github.com/waku-org/go-waku/waku/v2/dnsdisc"
 	waku_filter "github.com/waku-org/go-waku/waku/v2/protocol/filter"
 	"github.com/waku-org/go-waku/waku/v2/protocol/pb"
+	"github.com/waku-org/go-waku/waku/v2/protocol/relay"
 	"github.com/waku-org/go-waku/waku/v2/protocol/store"
 
 	"github.com/status-im/status-go/protocol/tt"
@@ -28,7 +30,7 @@ func TestDiscoveryV5(t *testing.T) {
 	config.DiscV5BootstrapNodes = []string{testENRBootstrap}
 	config.DiscoveryLimit = 20
 	config.UDPPort = 9001
-	w, err := New("", "", config, nil, nil, nil, nil, nil)
+	w, err := New("", "", config, nil, nil, nil, nil, nil, nil)
 	require.NoError(t, err)
 
 	require.NoError(t, w.Start())
@@ -53,7 +55,7 @@ func TestRestartDiscoveryV5(t *testing.T) {
 	config.DiscV5BootstrapNodes = []string{"enrtree://AOGECG2SPND25EEFMAJ5WF3KSGJNSGV356DSTL2YVLLZWIV6SAYBM@1.1.1.2"}
 	config.DiscoveryLimit = 20
 	config.UDPPort = 9002
-	w, err := New("", "", config, nil, nil, nil, nil, nil)
+	w, err := New("", "", config, nil, nil, nil, nil, nil, nil)
 	require.NoError(t, err)
 
 	require.NoError(t, w.Start())
@@ -107,7 +109,7 @@ func TestBasicWakuV2(t *testing.T) {
 	config.DiscoveryLimit = 20
 	config.UDPPort = 9001
 	config.WakuNodes = []string{enrTreeAddress}
-	w, err := New("", "", config, nil, nil, nil, nil, nil)
+	w, err := New("", "", config, nil, nil, nil, nil, nil, nil)
 	require.NoError(t, err)
 	require.NoError(t, w.Start())
 
@@ -182,7 +184,7 @@ func TestWakuV2Filter(t *testing.T) {
 	config.UDPPort = 9001
 	config.WakuNodes = []string{enrTreeAddress}
 	fleet := "status.test" // Need a name fleet so that LightClient is not set to false
-	w, err := New("", fleet, config, nil, nil, nil, nil, nil)
+	w, err := New("", fleet, config, nil, nil, nil, nil, nil, nil)
 	require.NoError(t, err)
 	require.NoError(t, w.Start())
 
@@ -217,8 +219,10 @@ func TestWakuV2Filter(t *testing.T) {
 	time.Sleep(5 * time.Second)
 
 	// Ensure there is 1 active filter subscription
+	w.filterSubscriptionsMu.Lock()
 	require.Len(t, w.filterSubscriptions, 1)
 	subMap := w.filterSubscriptions[filter]
+	w.filterSubscriptionsMu.Unlock()
 	// Ensure there are some active peers for this filter subscription
 	require.Greater(t, len(subMap), 0)
 
@@ -248,3 +252,104 @@ func TestWakuV2Filter(t *testing.T) {
 
 	require.NoError(t, w.Stop())
 }
+
+func TestFilterResubscribeBackoff(t *testing.T) {
+	now := time.Now()
+	b := &filterBackoff{}
+	require.True(t, b.ready(now))
+
+	b.failed(now, time.Second)
+	require.False(t, b.ready(now.Add(time.Second)))
+	require.True(t, b.ready(now.Add(2*time.Second)))
+
+	for i := 0; i < 20; i++ {
+		b.failed(now, time.Second)
+	}
+	require.False(t, b.ready(now.Add(filterResubscribeMaxBackoff-time.Second)))
+	require.True(t, b.ready(now.Add(filterResubscribeMaxBackoff)))
+
+	b.reset()
+	require.True(t, b.ready(now))
+}
+
+func TestFilterHealth(t *testing.T) {
+	w := &Waku{
+		filterPeerFailures:  make(map[peer.ID]*filterPeerFailure),
+		filterSubscriptions: make(map[*common.Filter]map[string]*waku_filter.SubscriptionDetails),
+	}
+	healthyPeer := peer.ID("healthy")
+	failingPeer := peer.ID("failing")
+	now := time.Now()
+
+	f := &common.Filter{}
+	w.filterSubscriptions[f] = map[string]*waku_filter.SubscriptionDetails{
+		"1": {ID: "1", PeerID: healthyPeer},
+	}
+
+	for i := 0; i < filterPeerMaxFailures; i++ {
+		require.False(t, w.filterPeerAvoided(failingPeer, now))
+		w.filterPeerFailed(failingPeer, now)
+	}
+	require.True(t, w.filterPeerAvoided(failingPeer, now))
+	require.False(t, w.filterPeerAvoided(failingPeer, now.Add(filterPeerFailureCooldown)))
+
+	health := w.filterHealth(now)
+	require.True(t, health.IsHealthy)
+	require.Equal(t, []string{healthyPeer.String()}, health.Peers)
+	require.Equal(t, []string{failingPeer.String()}, health.FailedPeers)
+
+	// A filter without subscriptions makes the node unhealthy
+	w.filterSubscriptions[&common.Filter{}] = make(map[string]*waku_filter.SubscriptionDetails)
+	require.False(t, w.filterHealth(now).IsHealthy)
+
+	w.filterPeerSucceeded(failingPeer)
+	require.False(t, w.filterPeerAvoided(failingPeer, now))
+}
+
+func TestSwitchLightClientMode(t *testing.T) {
+	config := &Config{}
+	config.Port = 0
+	config.KeepAliveInterval = 1
+	config.MinPeersForFilter = 1
+	config.LightClient = true
+
+	w, err := New("", "", config, nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Start())
+
+	filter := &common.Filter{
+		Messages: common.NewMemoryMessageStore(),
+		Topics: [][]byte{
+			{1, 2, 3, 4},
+		},
+	}
+	_, err = w.Subscribe(filter)
+	require.NoError(t, err)
+
+	require.True(t, w.isLightClient())
+
+	// Switching to the current mode is a no-op
+	require.NoError(t, w.SetLightClient(true))
+
+	sub, err := w.relay.Events().Subscribe(new(relay.EvtRelaySubscribed))
+	require.NoError(t, err)
+	defer sub.Close()
+
+	require.NoError(t, w.SetLightClient(false))
+	require.False(t, w.isLightClient())
+
+	select {
+	case <-sub.Out():
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "timed out waiting for the relay subscription")
+	}
+
+	w.filterSubscriptionsMu.Lock()
+	require.Empty(t, w.filterSubscriptions)
+	w.filterSubscriptionsMu.Unlock()
+
+	require.NoError(t, w.SetLightClient(true))
+	require.True(t, w.isLightClient())
+
+	require.NoError(t, w.Stop())
+}